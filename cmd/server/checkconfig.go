@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/slips-ai/slips-core/pkg/config"
+)
+
+// runCheckConfig implements the --check-config flag: it loads and validates
+// the configuration at path and the environment, prints every problem found
+// and returns the exit code. Secret references are checked for syntax but
+// not resolved, so it can run where the secret managers are unreachable,
+// e.g. in CI or before a rollout.
+func runCheckConfig(path string) int {
+	if _, err := config.Load(path); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Println("config OK")
+	return 0
+}
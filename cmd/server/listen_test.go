@@ -0,0 +1,74 @@
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestListenUnixSetsPermissions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slips.sock")
+
+	lis, err := listenUnix(path, 0o600)
+	if err != nil {
+		t.Fatalf("listenUnix: %v", err)
+	}
+	defer lis.Close()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat socket: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("socket permissions = %o, want 600", perm)
+	}
+}
+
+func TestListenUnixReplacesStaleSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slips.sock")
+
+	// Leave a socket file behind without anything listening on it
+	stale, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+
+	lis, err := listenUnix(path, 0o660)
+	if err != nil {
+		t.Fatalf("listenUnix over stale socket: %v", err)
+	}
+	lis.Close()
+}
+
+func TestListenUnixRefusesSocketInUse(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slips.sock")
+
+	active, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer active.Close()
+
+	if lis, err := listenUnix(path, 0o660); err == nil {
+		lis.Close()
+		t.Fatal("listenUnix took over a socket that is in use")
+	}
+}
+
+func TestListenUnixRefusesRegularFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slips.sock")
+	if err := os.WriteFile(path, []byte("data"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if lis, err := listenUnix(path, 0o660); err == nil {
+		lis.Close()
+		t.Fatal("listenUnix replaced a regular file")
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("regular file was removed: %v", err)
+	}
+}
@@ -2,66 +2,209 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	// Digests are scheduled in the user's time zone, which must resolve
+	// even on hosts without a zoneinfo database
+	_ "time/tzdata"
 
-	"github.com/jackc/pgx/v5/pgxpool"
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	approvalv1 "github.com/slips-ai/slips-core/gen/go/approval/v1"
 	authv1 "github.com/slips-ai/slips-core/gen/go/auth/v1"
+	caldavv1 "github.com/slips-ai/slips-core/gen/go/caldav/v1"
+	digestv1 "github.com/slips-ai/slips-core/gen/go/digest/v1"
+	feedv1 "github.com/slips-ai/slips-core/gen/go/feed/v1"
 	mcptokenv1 "github.com/slips-ai/slips-core/gen/go/mcptoken/v1"
+	notificationv1 "github.com/slips-ai/slips-core/gen/go/notification/v1"
+	savedfilterv1 "github.com/slips-ai/slips-core/gen/go/savedfilter/v1"
+	serverv1 "github.com/slips-ai/slips-core/gen/go/server/v1"
+	streakv1 "github.com/slips-ai/slips-core/gen/go/streak/v1"
 	tagv1 "github.com/slips-ai/slips-core/gen/go/tag/v1"
 	taskv1 "github.com/slips-ai/slips-core/gen/go/task/v1"
+	taskv2 "github.com/slips-ai/slips-core/gen/go/task/v2"
+	usagev1 "github.com/slips-ai/slips-core/gen/go/usage/v1"
+	webhookv1 "github.com/slips-ai/slips-core/gen/go/webhook/v1"
+
+	adminapp "github.com/slips-ai/slips-core/internal/admin/application"
+	admindomain "github.com/slips-ai/slips-core/internal/admin/domain"
+	admingrpc "github.com/slips-ai/slips-core/internal/admin/infra/grpc"
+	adminpg "github.com/slips-ai/slips-core/internal/admin/infra/postgres"
+
+	approvalapp "github.com/slips-ai/slips-core/internal/approval/application"
+	approvaldomain "github.com/slips-ai/slips-core/internal/approval/domain"
+	approvalgrpc "github.com/slips-ai/slips-core/internal/approval/infra/grpc"
+	approvalpg "github.com/slips-ai/slips-core/internal/approval/infra/postgres"
 
 	mcptokenapp "github.com/slips-ai/slips-core/internal/mcptoken/application"
+	mcptokendomain "github.com/slips-ai/slips-core/internal/mcptoken/domain"
 	mcptokengrpc "github.com/slips-ai/slips-core/internal/mcptoken/infra/grpc"
 	mcptokenpg "github.com/slips-ai/slips-core/internal/mcptoken/infra/postgres"
 
 	authapp "github.com/slips-ai/slips-core/internal/auth/application"
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
 	authgrpc "github.com/slips-ai/slips-core/internal/auth/infra/grpc"
 	authpg "github.com/slips-ai/slips-core/internal/auth/infra/postgres"
 
 	taskapp "github.com/slips-ai/slips-core/internal/task/application"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
 	taskgrpc "github.com/slips-ai/slips-core/internal/task/infra/grpc"
+	taskhttp "github.com/slips-ai/slips-core/internal/task/infra/http"
 	taskpg "github.com/slips-ai/slips-core/internal/task/infra/postgres"
 
 	tagapp "github.com/slips-ai/slips-core/internal/tag/application"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
 	taggrpc "github.com/slips-ai/slips-core/internal/tag/infra/grpc"
 	tagpg "github.com/slips-ai/slips-core/internal/tag/infra/postgres"
 
+	savedfilterapp "github.com/slips-ai/slips-core/internal/savedfilter/application"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	savedfiltergrpc "github.com/slips-ai/slips-core/internal/savedfilter/infra/grpc"
+	savedfilterpg "github.com/slips-ai/slips-core/internal/savedfilter/infra/postgres"
+
+	streakapp "github.com/slips-ai/slips-core/internal/streak/application"
+	streakdomain "github.com/slips-ai/slips-core/internal/streak/domain"
+	streakgrpc "github.com/slips-ai/slips-core/internal/streak/infra/grpc"
+	streakpg "github.com/slips-ai/slips-core/internal/streak/infra/postgres"
+
+	usageapp "github.com/slips-ai/slips-core/internal/usage/application"
+	usagedomain "github.com/slips-ai/slips-core/internal/usage/domain"
+	usagegrpc "github.com/slips-ai/slips-core/internal/usage/infra/grpc"
+	usagepg "github.com/slips-ai/slips-core/internal/usage/infra/postgres"
+	webhookapp "github.com/slips-ai/slips-core/internal/webhook/application"
+	webhookdomain "github.com/slips-ai/slips-core/internal/webhook/domain"
+	webhookgrpc "github.com/slips-ai/slips-core/internal/webhook/infra/grpc"
+	webhookhttp "github.com/slips-ai/slips-core/internal/webhook/infra/http"
+	webhookpg "github.com/slips-ai/slips-core/internal/webhook/infra/postgres"
+
+	caldavapp "github.com/slips-ai/slips-core/internal/caldav/application"
+	caldavdomain "github.com/slips-ai/slips-core/internal/caldav/domain"
+	caldavgrpc "github.com/slips-ai/slips-core/internal/caldav/infra/grpc"
+	caldavhttp "github.com/slips-ai/slips-core/internal/caldav/infra/http"
+	caldavpg "github.com/slips-ai/slips-core/internal/caldav/infra/postgres"
+
+	feedapp "github.com/slips-ai/slips-core/internal/feed/application"
+	feeddomain "github.com/slips-ai/slips-core/internal/feed/domain"
+	feedgrpc "github.com/slips-ai/slips-core/internal/feed/infra/grpc"
+	feedhttp "github.com/slips-ai/slips-core/internal/feed/infra/http"
+	feedpg "github.com/slips-ai/slips-core/internal/feed/infra/postgres"
+
+	digestapp "github.com/slips-ai/slips-core/internal/digest/application"
+	digestdomain "github.com/slips-ai/slips-core/internal/digest/domain"
+	digestgrpc "github.com/slips-ai/slips-core/internal/digest/infra/grpc"
+	digestpg "github.com/slips-ai/slips-core/internal/digest/infra/postgres"
+
+	notificationapp "github.com/slips-ai/slips-core/internal/notification/application"
+	notificationdomain "github.com/slips-ai/slips-core/internal/notification/domain"
+	notificationgrpc "github.com/slips-ai/slips-core/internal/notification/infra/grpc"
+	notificationpg "github.com/slips-ai/slips-core/internal/notification/infra/postgres"
+
+	serverinfogrpc "github.com/slips-ai/slips-core/internal/serverinfo/infra/grpc"
+
+	"github.com/slips-ai/slips-core/internal/memory"
+
 	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/buildinfo"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
 	"github.com/slips-ai/slips-core/pkg/config"
+	"github.com/slips-ai/slips-core/pkg/database"
+	"github.com/slips-ai/slips-core/pkg/deadline"
+	"github.com/slips-ai/slips-core/pkg/envelope"
+	"github.com/slips-ai/slips-core/pkg/jobs"
 	"github.com/slips-ai/slips-core/pkg/logger"
+	"github.com/slips-ai/slips-core/pkg/mail"
+	"github.com/slips-ai/slips-core/pkg/queue"
+	"github.com/slips-ai/slips-core/pkg/ratelimit"
+	"github.com/slips-ai/slips-core/pkg/readiness"
+	"github.com/slips-ai/slips-core/pkg/requestsize"
+	"github.com/slips-ai/slips-core/pkg/secrets"
+	"github.com/slips-ai/slips-core/pkg/shutdown"
 	"github.com/slips-ai/slips-core/pkg/tracing"
+	"github.com/slips-ai/slips-core/pkg/webpush"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
+// Health service names for probes that must tell liveness from readiness
+const (
+	livenessService  = "liveness"
+	readinessService = "readiness"
+)
+
 func main() {
+	flags := flag.NewFlagSet("slips-core", flag.ExitOnError)
+	configPath := flags.String("config", "config.yaml", "config file; config.$ENV.yaml next to it is merged over it")
+	checkConfig := flags.Bool("check-config", false, "validate the configuration and exit")
+	_ = flags.Parse(os.Args[1:])
+	args := flags.Args()
+
+	// "slips-core --check-config" validates the configuration and exits
+	if *checkConfig {
+		os.Exit(runCheckConfig(*configPath))
+	}
+
 	// Load configuration
-	cfg, err := config.Load("config.yaml")
+	cfg, err := config.Load(*configPath)
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
+	// Replace secret manager references in the database credentials before
+	// anything builds a connection string
+	resolver := secrets.NewResolver()
+	dbPassword, err := database.ResolveSecrets(context.Background(), resolver, &cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to resolve database secrets: %v", err)
+	}
+
+	// "slips-core migrate ..." manages the schema and exits
+	if len(args) > 0 && args[0] == "migrate" {
+		os.Exit(runMigrate(cfg, args[1:]))
+	}
+
 	// Initialize logger
 	isDev := os.Getenv("ENV") != "production"
 	logr := logger.New(isDev)
 	slog.SetDefault(logr)
 
-	logr.Info("Starting slips-core service", "port", cfg.Server.GRPCPort)
+	build := buildinfo.Get()
+	logr.Info("Starting slips-core service", "port", cfg.Server.GRPCPort,
+		"version", build.Version, "git_sha", build.GitSHA, "build_time", build.BuildTime)
+	if err := buildinfo.RegisterMetric(build); err != nil {
+		logr.Warn("Failed to register build_info metric", "error", err)
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// Tracks background work such as MCP token last-used writes so shutdown
+	// can wait for it
+	coordinator := shutdown.New(cfg.Server.ShutdownTimeout, logr)
+
+	// Cancel startup work (e.g. database connect retries) and trigger a
+	// graceful shutdown on SIGINT/SIGTERM
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigChan
+		logr.Info("Shutting down gracefully...")
+		cancel()
+	}()
+
 	// Initialize tracing
-	var shutdown func(context.Context) error
+	var shutdownTracer func(context.Context) error
 	if cfg.Tracing.Enabled {
-		shutdown, err = tracing.InitTracer(cfg.Tracing.ServiceName, cfg.Tracing.Endpoint)
+		shutdownTracer, err = tracing.InitTracer(cfg.Tracing.ServiceName, cfg.Tracing.Endpoint)
 		if err != nil {
 			logr.Warn("Failed to initialize tracing", "error", err)
 		} else {
@@ -69,7 +212,7 @@ func main() {
 				// Use a fresh context with timeout for tracer shutdown
 				shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
 				defer shutdownCancel()
-				if err := shutdown(shutdownCtx); err != nil {
+				if err := shutdownTracer(shutdownCtx); err != nil {
 					logr.Error("Failed to shutdown tracer", "error", err)
 				}
 			}()
@@ -77,20 +220,6 @@ func main() {
 		}
 	}
 
-	// Connect to database
-	dbpool, err := pgxpool.New(ctx, cfg.Database.DatabaseURL())
-	if err != nil {
-		logr.Error("Failed to connect to database", "host", cfg.Database.Host, "error", err)
-		os.Exit(1)
-	}
-	defer dbpool.Close()
-
-	if err := dbpool.Ping(ctx); err != nil {
-		logr.Error("Failed to ping database", "host", cfg.Database.Host, "error", err)
-		os.Exit(1)
-	}
-	logr.Info("Database connected", "host", cfg.Database.Host)
-
 	// Initialize Identra gRPC client
 	identraClient, err := auth.NewIdentraClient(cfg.Auth.IdentraGRPCEndpoint)
 	if err != nil {
@@ -100,66 +229,451 @@ func main() {
 	defer identraClient.Close()
 	logr.Info("Identra client initialized", "endpoint", cfg.Auth.IdentraGRPCEndpoint)
 
+	// The server listens right away but serves requests only once the
+	// dependencies checked by the gate are available; failed checks are
+	// retried until then instead of exiting
+	gate := readiness.New(readiness.Options{
+		Backoff:    cfg.Database.ConnectBackoff,
+		MaxBackoff: cfg.Database.ConnectMaxBackoff,
+	}, logr)
+
 	// Initialize JWT validator
 	jwtValidator := auth.NewJWTValidator(identraClient, cfg.Auth.ExpectedIssuer)
 
 	// Fetch JWKS keys
 	// NOTE: Keys are only fetched at startup. In production, implement periodic refresh
 	// or on-demand fetching when unknown 'kid' is encountered to handle key rotation.
-	if err := jwtValidator.FetchJWKS(ctx); err != nil {
-		logr.Error("Failed to fetch JWKS", "error", err)
+	gate.Add("jwks", jwtValidator.FetchJWKS)
+
+	// Initialize repositories
+	var (
+		mcptokenRepo    mcptokendomain.Repository
+		authRepo        authdomain.Repository
+		deviceRepo      authdomain.DeviceAuthorizationRepository
+		stateRepo       authdomain.OAuthStateRepository
+		taskRepo        taskdomain.Repository
+		tagRepo         tagdomain.Repository
+		savedFilterRepo savedfilterdomain.Repository
+		streakRepo      streakdomain.Repository
+		adminRepo       admindomain.Repository
+		webhookRepo     webhookdomain.Repository
+		appPasswordRepo caldavdomain.Repository
+		feedRepo        feeddomain.Repository
+		digestRepo      digestdomain.Repository
+		pushRepo        notificationdomain.Repository
+		approvalRepo    approvaldomain.Repository
+		usageRepo       usagedomain.Repository
+		queueStore      queue.Store
+		// changes feeds WatchChanges streams; Close ends them at shutdown
+		changes interface {
+			changefeed.Feed
+			Close()
+		}
+		// forEachShard runs background jobs that scan every owner once per
+		// database shard
+		forEachShard = func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}
+	)
+	switch cfg.Storage {
+	case config.StorageMemory:
+		store := memory.NewStore()
+		mcptokenRepo = memory.NewMCPTokenRepository(store)
+		authRepo = memory.NewUserRepository(store)
+		deviceRepo = memory.NewDeviceAuthorizationRepository(store)
+		stateRepo = memory.NewOAuthStateRepository(store)
+		taskRepo = memory.NewTaskRepository(store)
+		tagRepo = memory.NewTagRepository(store)
+		savedFilterRepo = memory.NewSavedFilterRepository(store)
+		streakRepo = memory.NewStreakRepository(store)
+		adminRepo = memory.NewAdminRepository(store)
+		webhookRepo = memory.NewWebhookRepository(store)
+		appPasswordRepo = memory.NewAppPasswordRepository(store)
+		feedRepo = memory.NewFeedRepository(store)
+		digestRepo = memory.NewDigestPreferencesRepository(store)
+		pushRepo = memory.NewSubscriptionRepository(store)
+		approvalRepo = memory.NewApprovalRepository(store)
+		usageRepo = memory.NewUsageRepository(store)
+		queueStore = queue.NewMemoryStore()
+		changes = changefeed.NewHub()
+		logr.Warn("Using in-memory storage; all data will be lost on shutdown")
+	default:
+		// Connect to the primary database and any read replicas
+		// New connections pick up a rotated password without a restart
+		go dbPassword.Run(ctx, cfg.Secrets.RefreshInterval, logr)
+		dbOpts := []database.Option{database.WithPasswordSource(dbPassword.Get)}
+		if cfg.Database.RowLevelSecurity {
+			dbOpts = append(dbOpts, database.WithRowLevelSecurity(func(ctx context.Context) string {
+				userID, _ := auth.GetUserID(ctx)
+				return userID
+			}))
+		}
+		if len(cfg.Database.Shards) > 0 {
+			dbOpts = append(dbOpts, database.WithShardOwner(func(ctx context.Context) string {
+				userID, _ := auth.GetUserID(ctx)
+				return userID
+			}))
+		}
+		// Pools connect on first use; the gate waits for the databases
+		dbOpts = append(dbOpts, database.WithDeferredConnect())
+		db, err := database.Open(ctx, cfg.Database, logr, dbOpts...)
+		if err != nil {
+			logr.Error("Failed to configure database", "host", cfg.Database.Host, "error", err)
+			os.Exit(1)
+		}
+		defer db.Close()
+		forEachShard = db.ForEachShard
+		gate.Add("database", func(ctx context.Context) error {
+			if err := db.Ping(ctx); err != nil {
+				return err
+			}
+			if !cfg.Database.AutoMigrate {
+				return nil
+			}
+			if err := database.Migrate(cfg.Database.DatabaseURL(), logr); err != nil {
+				return fmt.Errorf("run database migrations: %w", err)
+			}
+			for i, shard := range cfg.Database.Shards {
+				if err := database.Migrate(shard, logr); err != nil {
+					return fmt.Errorf("run migrations of shard %d: %w", i+1, err)
+				}
+			}
+			return nil
+		})
+		logr.Info("Database configured", "host", cfg.Database.Host, "replicas", len(cfg.Database.Replicas),
+			"shards", len(cfg.Database.Shards)+1)
+
+		keyring, err := envelope.NewKeyringFromConfig(ctx, cfg.Encryption, resolver)
+		if err != nil {
+			logr.Error("Failed to load encryption keys", "error", err)
+			os.Exit(1)
+		}
+		if keyring == nil {
+			logr.Warn("Encryption keys not configured; user secrets are stored in plaintext")
+		}
+
+		// Token and user lookups stay on the primary so revocations and new
+		// tokens take effect immediately
+		mcptokenRepo = mcptokenpg.NewMCPTokenRepository(db.Primary)
+		authRepo = authpg.NewRepository(db.Primary, keyring)
+		deviceRepo = authpg.NewDeviceAuthorizationRepository(db.Primary)
+		stateRepo = authpg.NewOAuthStateRepository(db.Primary)
+		// Tasks, tags and their settings live on the owner's shard
+		taskRepo = taskpg.NewTaskRepository(db.Data(), db.DataReader(), keyring, cfg.Encryption.TaskNotes)
+		tagRepo = tagpg.NewTagRepository(db.Data(), db.DataReader())
+		savedFilterRepo = savedfilterpg.NewSavedFilterRepository(db.Data(), db.DataReader())
+		streakRepo = streakpg.NewStreakRepository(db.Data(), db.DataReader())
+		adminRepo = adminpg.NewAdminRepository(db.Primary, db.Reader())
+		webhookRepo = webhookpg.NewWebhookRepository(db.Primary, keyring)
+		appPasswordRepo = caldavpg.NewAppPasswordRepository(db.Primary)
+		feedRepo = feedpg.NewFeedRepository(db.Primary)
+		digestRepo = digestpg.NewPreferencesRepository(db.Primary)
+		pushRepo = notificationpg.NewSubscriptionRepository(db.Primary)
+		approvalRepo = approvalpg.NewApprovalRepository(db.Data(), db.DataReader())
+		usageRepo = usagepg.NewUsageRepository(db.Primary)
+		queueStore = queue.NewPostgresStore(db.Primary)
+		// Share changes with the other instances through LISTEN/NOTIFY
+		feed := changefeed.NewPostgresFeed(db.Primary, logr)
+		go feed.Run(ctx)
+		changes = feed
+	}
+
+	// Digest emails go out through the configured provider; without one
+	// the digest job does not run
+	smtpPassword, err := secrets.NewValue(ctx, resolver, cfg.Mail.SMTP.Password)
+	if err != nil {
+		logr.Error("Failed to resolve SMTP password", "error", err)
+		os.Exit(1)
+	}
+	go smtpPassword.Run(ctx, cfg.Secrets.RefreshInterval, logr)
+	mailer, err := mail.NewSenderFromConfig(ctx, cfg.Mail, smtpPassword.Get)
+	if err != nil {
+		logr.Error("Failed to configure mail", "provider", cfg.Mail.Provider, "error", err)
 		os.Exit(1)
 	}
-	logr.Info("JWT validator initialized", "issuer", cfg.Auth.ExpectedIssuer)
+	digestInterval := cfg.Jobs.Digests.Interval
+	if mailer == nil {
+		digestInterval = 0
+	}
 
-	// Initialize repositories
-	mcptokenRepo := mcptokenpg.NewMCPTokenRepository(dbpool)
-	authRepo := authpg.NewRepository(dbpool)
-	taskRepo := taskpg.NewTaskRepository(dbpool)
-	tagRepo := tagpg.NewTagRepository(dbpool)
+	// Web Push notifications need a VAPID key; without one browsers cannot
+	// subscribe
+	pushSender, err := webpush.NewSenderFromConfig(ctx, cfg.WebPush, resolver)
+	if err != nil {
+		logr.Error("Failed to configure web push", "error", err)
+		os.Exit(1)
+	}
 
 	// Initialize services
-	mcptokenService := mcptokenapp.NewService(mcptokenRepo, logr)
+	mcptokenService := mcptokenapp.NewService(mcptokenRepo, coordinator, logr)
 	authService := authapp.NewService(
 		authRepo,
+		deviceRepo,
+		stateRepo,
 		identraClient,
 		cfg.Auth.OAuth.Provider,
 		cfg.Auth.OAuth.RedirectURL,
+		cfg.Auth.OAuth.StateTTL,
+		authapp.DeviceFlowConfig{
+			VerificationURL: cfg.Auth.OAuth.Device.VerificationURL,
+			CodeTTL:         cfg.Auth.OAuth.Device.CodeTTL,
+			PollInterval:    cfg.Auth.OAuth.Device.PollInterval,
+		},
+		cfg.Auth.ProfileRefreshInterval,
 		logr,
 	)
-	taskService := taskapp.NewService(taskRepo, tagRepo, logr)
-	tagService := tagapp.NewService(tagRepo, logr)
+	taskService := taskapp.NewService(taskRepo, tagRepo, savedFilterRepo, authRepo, changes, taskdomain.ChecklistLimits{
+		MaxItems:      cfg.Checklists.MaxItems,
+		MaxItemLength: cfg.Checklists.MaxItemLength,
+	}, logr)
+	tagService := tagapp.NewService(tagRepo, taskService, changes, logr)
+	savedFilterService := savedfilterapp.NewService(savedFilterRepo, logr)
+	streakService := streakapp.NewService(streakRepo, logr)
+	// Queued jobs are shared by every instance through the queue store
+	workQueue := queue.New(queueStore, queue.Options{
+		Workers:      cfg.Queue.Workers,
+		PollInterval: cfg.Queue.PollInterval,
+		Lease:        cfg.Queue.Lease,
+		MaxAttempts:  cfg.Queue.MaxAttempts,
+		Backoff:      cfg.Queue.Backoff,
+		MaxBackoff:   cfg.Queue.MaxBackoff,
+	}, logr)
+	webhookService := webhookapp.NewService(webhookRepo, taskService, workQueue, cfg.Webhooks.RateLimit, cfg.Webhooks.RateBurst, logr)
+	workQueue.Handle(webhookapp.DeliveryJobKind, webhookService.RunQueuedDelivery)
+	caldavService := caldavapp.NewService(appPasswordRepo, taskService, tagService, logr)
+	feedService := feedapp.NewService(feedRepo, taskService, tagService, savedFilterService, cfg.Feeds.MaxItems, cfg.Feeds.Window, logr)
+	digestService := digestapp.NewService(digestRepo, taskService, authRepo, mailer, logr)
+	notificationService := notificationapp.NewService(pushRepo, pushSender, logr)
+	approvalService := approvalapp.NewService(approvalRepo, taskService, logr)
+	usageService := usageapp.NewService(usageRepo, logr)
+	adminService := adminapp.NewService(
+		adminRepo,
+		authRepo,
+		taskRepo,
+		tagRepo,
+		savedFilterRepo,
+		streakRepo,
+		mcptokenRepo,
+		taskService,
+		changes,
+		logr,
+	)
+
+	// Periodic background jobs run as coordinator workers, so shutdown waits
+	// for a run in progress
+	scheduler := jobs.NewScheduler(logr)
+	scheduler.Register(jobs.Job{
+		Name:     "auto_archive",
+		Interval: cfg.Jobs.AutoArchive.Interval,
+		Run: func(ctx context.Context) error {
+			return forEachShard(ctx, func(ctx context.Context) error {
+				_, err := taskService.RunAutoArchive(ctx, cfg.Jobs.AutoArchive.DryRun)
+				return err
+			})
+		},
+	})
+	scheduler.Register(jobs.Job{
+		Name:     "orphan_tags",
+		Interval: cfg.Jobs.OrphanTags.Interval,
+		Run: func(ctx context.Context) error {
+			return forEachShard(ctx, func(ctx context.Context) error {
+				_, err := tagService.RunOrphanCleanup(ctx)
+				return err
+			})
+		},
+	})
+	scheduler.Register(jobs.Job{
+		Name:     "digests",
+		Interval: digestInterval,
+		Run: func(ctx context.Context) error {
+			_, err := digestService.RunDigests(ctx)
+			return err
+		},
+	})
+	scheduler.Register(jobs.Job{
+		Name:     "retention",
+		Interval: cfg.Jobs.Retention.Interval,
+		Run: func(ctx context.Context) error {
+			err := forEachShard(ctx, func(ctx context.Context) error {
+				_, err := taskService.RunTombstonePurge(ctx, cfg.Jobs.Retention.TaskTombstones)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			_, err = usageService.RunUsagePurge(ctx, cfg.Jobs.Retention.APIUsage)
+			return err
+		},
+	})
+	if cfg.Usage.Enabled {
+		scheduler.Register(jobs.Job{
+			Name:     "usage_flush",
+			Interval: cfg.Usage.FlushInterval,
+			Run:      usageService.Flush,
+		})
+	}
+	// Background work needs the database, so it starts once the server is
+	// ready
+	gate.OnReady(func() {
+		scheduler.Start(coordinator)
+		workQueue.Start(coordinator)
+	})
 
 	// Initialize gRPC servers
 	mcptokenServer := mcptokengrpc.NewMCPTokenServer(mcptokenService)
 	authServer := authgrpc.NewServer(authService)
-	taskServer := taskgrpc.NewTaskServer(taskService)
+	taskServer := taskgrpc.NewTaskServer(taskService, approvalService, cfg.Transfers.AllowUsers)
+	taskServerV2 := taskgrpc.NewTaskServerV2(taskService, approvalService)
 	tagServer := taggrpc.NewTagServer(tagService)
+	savedFilterServer := savedfiltergrpc.NewSavedFilterServer(savedFilterService)
+	streakServer := streakgrpc.NewStreakServer(streakService)
+	adminServer := admingrpc.NewAdminServer(adminService)
+	webhookServer := webhookgrpc.NewWebhookServer(webhookService, cfg.Webhooks.BaseURL)
+	caldavServer := caldavgrpc.NewCalDAVServer(caldavService)
+	feedServer := feedgrpc.NewFeedServer(feedService, cfg.Feeds.BaseURL)
+	digestServer := digestgrpc.NewDigestServer(digestService)
+	notificationServer := notificationgrpc.NewNotificationServer(notificationService)
+	approvalServer := approvalgrpc.NewApprovalServer(approvalService)
+	usageServer := usagegrpc.NewUsageServer(usageService)
+	serverInfoServer := serverinfogrpc.NewServerInfoServer(build, serverFeatures(cfg, digestInterval > 0, pushSender != nil))
 
-	// Create gRPC server with interceptors
-	var opts []grpc.ServerOption
+	// Create gRPC server with the configured limits and interceptors
+	opts := serverOptions(cfg.Server)
+	if err := configureCompression(cfg.Server); err != nil {
+		logr.Error("Failed to configure gzip compression", "error", err)
+		os.Exit(1)
+	}
 
-	// Build interceptor chain in order: auth first, then (optionally) tracing
-	// Auth runs first to reject unauthenticated requests before creating trace spans
-	// Note: Auth interceptor automatically skips authentication for public Auth Service endpoints
-	// (GetAuthorizationURL, HandleCallback, RefreshToken)
-	interceptors := []grpc.UnaryServerInterceptor{
-		auth.UnaryServerInterceptorWithMCP(jwtValidator, mcptokenService),
+	// Build interceptor chain in order: (optionally) access log, readiness, deadline, request size, (optionally) auth rate limit, authentication, authorization, (optionally) usage counting, MCP token limits, (optionally) tracing, then (optionally) the profile cache
+	// The access log wraps auth so rejected requests are logged as well
+	// Readiness rejects RPCs with UNAVAILABLE until the gate's dependencies are available
+	// The deadline interceptor runs before auth, whose MCP token lookup already queries Postgres
+	// Authorization evaluates authorizationPolicy against the authenticated principal
+	// Usage counting runs before the MCP token limits to count the calls they reject
+	// Auth runs before tracing to reject unauthenticated requests before creating trace spans
+	// The profile cache runs last, so cached GetUserProfile calls are still limited and traced
+	// Note: Auth interceptor skips authentication for the public methods built by publicMethods
+	// Streaming RPCs (StreamTasks, WatchChanges, health Watch) get the same chain
+	var interceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if cfg.Logging.AccessLog.Enabled {
+		accessLog := logger.AccessLogOptions{SampleRate: cfg.Logging.AccessLog.SampleRate}
+		interceptors = append(interceptors, logger.AccessLogInterceptor(logr, accessLog))
+		streamInterceptors = append(streamInterceptors, logger.StreamAccessLogInterceptor(logr, accessLog))
+	}
+	interceptors = append(interceptors, readiness.UnaryServerInterceptor(gate))
+	streamInterceptors = append(streamInterceptors, readiness.StreamServerInterceptor(gate))
+	interceptors = append(interceptors, deadline.UnaryServerInterceptor(deadline.Options{
+		Default: cfg.Server.DefaultTimeout,
+		Max:     cfg.Server.MaxTimeout,
+	}))
+	requestSize := requestsize.Options{
+		MaxBytes: cfg.Server.RequestSize.MaxBytes,
+		Methods:  cfg.Server.RequestSize.Limits(),
+	}
+	interceptors = append(interceptors, requestsize.UnaryServerInterceptor(requestSize))
+	streamInterceptors = append(streamInterceptors, requestsize.StreamServerInterceptor(requestSize))
+	rateLimit, err := rateLimitOptions(cfg.Auth.RateLimit)
+	if err != nil {
+		logr.Error("Invalid auth rate limit configuration", "error", err)
+		os.Exit(1)
+	}
+	// The store also counts calls against the limits users set on their MCP
+	// tokens, which apply whether or not the auth rate limit is enabled
+	var rateLimitStore ratelimit.Store = ratelimit.NewMemoryStore()
+	if cfg.Auth.RateLimit.Store == "redis" {
+		redisPassword, err := resolver.Resolve(ctx, cfg.Auth.RateLimit.RedisPassword)
+		if err != nil {
+			logr.Error("Failed to resolve Redis password", "error", err)
+			os.Exit(1)
+		}
+		redisOpts, err := redisOptions(cfg.Auth.RateLimit, redisPassword)
+		if err != nil {
+			logr.Error("Invalid Redis configuration", "error", err)
+			os.Exit(1)
+		}
+		redisStore := ratelimit.NewRedisStore(redisOpts)
+		defer redisStore.Close()
+		rateLimitStore = redisStore
+	}
+	if cfg.Auth.RateLimit.Enabled {
+		interceptors = append(interceptors, ratelimit.UnaryServerInterceptor(rateLimitStore, rateLimit, logr))
+	}
+	public := publicMethods(cfg.Auth)
+	roles := auth.StaticRoles(auth.RoleAdmin, cfg.Auth.AdminUserIDs)
+	var mcpValidator auth.MCPTokenValidator = mcptokenService
+	if cfg.Auth.MCPTokenGuard.Enabled {
+		mcpValidator = auth.NewMCPTokenGuard(mcptokenService, mcpGuardOptions(cfg.Auth.MCPTokenGuard, rateLimit.TrustedProxies), logr)
+	}
+	interceptors = append(interceptors,
+		auth.UnaryServerInterceptorWithMCP(jwtValidator, mcpValidator, public),
+		auth.UnaryAuthorizationInterceptor(authorizationPolicy, roles),
+	)
+	streamInterceptors = append(streamInterceptors,
+		auth.StreamServerInterceptorWithMCP(jwtValidator, mcpValidator, public),
+		auth.StreamAuthorizationInterceptor(authorizationPolicy, roles),
+	)
+	if cfg.Usage.Enabled {
+		interceptors = append(interceptors, usagegrpc.UnaryServerInterceptor(usageService))
+		streamInterceptors = append(streamInterceptors, usagegrpc.StreamServerInterceptor(usageService))
 	}
+	interceptors = append(interceptors, ratelimit.MCPTokenUnaryServerInterceptor(rateLimitStore, logr))
+	streamInterceptors = append(streamInterceptors, ratelimit.MCPTokenStreamServerInterceptor(rateLimitStore, logr))
 	if cfg.Tracing.Enabled {
 		interceptors = append(interceptors, tracing.UnaryServerInterceptor())
+		streamInterceptors = append(streamInterceptors, tracing.StreamServerInterceptor())
 	}
-	opts = append(opts, grpc.ChainUnaryInterceptor(interceptors...))
+	if cfg.Auth.ProfileCache.Enabled {
+		profileCache := authgrpc.NewProfileCache(authgrpc.ProfileCacheOptions{
+			TTL:        cfg.Auth.ProfileCache.TTL,
+			MaxEntries: cfg.Auth.ProfileCache.MaxEntries,
+		})
+		interceptors = append(interceptors, profileCache.UnaryServerInterceptor())
+	}
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 	grpcServer := grpc.NewServer(opts...)
 
 	// Register services
 	mcptokenv1.RegisterMCPTokenServiceServer(grpcServer, mcptokenServer)
 	authv1.RegisterAuthServiceServer(grpcServer, authServer)
 	taskv1.RegisterTaskServiceServer(grpcServer, taskServer)
+	taskv2.RegisterTaskServiceServer(grpcServer, taskServerV2)
 	tagv1.RegisterTagServiceServer(grpcServer, tagServer)
+	savedfilterv1.RegisterSavedFilterServiceServer(grpcServer, savedFilterServer)
+	streakv1.RegisterStreakServiceServer(grpcServer, streakServer)
+	adminv1.RegisterAdminServiceServer(grpcServer, adminServer)
+	webhookv1.RegisterWebhookServiceServer(grpcServer, webhookServer)
+	caldavv1.RegisterCalDAVServiceServer(grpcServer, caldavServer)
+	feedv1.RegisterFeedServiceServer(grpcServer, feedServer)
+	digestv1.RegisterDigestServiceServer(grpcServer, digestServer)
+	notificationv1.RegisterNotificationServiceServer(grpcServer, notificationServer)
+	approvalv1.RegisterApprovalServiceServer(grpcServer, approvalServer)
+	serverv1.RegisterServerServiceServer(grpcServer, serverInfoServer)
+	usagev1.RegisterUsageServiceServer(grpcServer, usageServer)
+
+	// Register the standard gRPC health service for liveness, readiness and
+	// startup probes. The "liveness" service is SERVING while the process
+	// serves RPCs; the overall status and "readiness" are NOT_SERVING until
+	// the gate is ready.
+	healthServer := health.NewServer()
+	for _, service := range []string{"", readinessService} {
+		healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	healthServer.SetServingStatus(livenessService, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	gate.OnReady(func() {
+		for _, service := range []string{"", readinessService} {
+			healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+		}
+	})
 
 	// Register reflection service for grpcurl and other tools
-	reflection.Register(grpcServer)
+	if cfg.Server.Reflection {
+		reflection.Register(grpcServer)
+	}
 
 	// Start gRPC server
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.GRPCPort))
@@ -168,20 +682,107 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Handle graceful shutdown
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	// Optionally also serve on a Unix socket for sidecars and local agents
+	var unixLis net.Listener
+	if cfg.Server.UnixSocket != "" {
+		mode, _ := cfg.Server.SocketFileMode() // validated by config.Load
+		unixLis, err = listenUnix(cfg.Server.UnixSocket, mode)
+		if err != nil {
+			logr.Error("Failed to listen on unix socket", "path", cfg.Server.UnixSocket, "error", err)
+			os.Exit(1)
+		}
+	}
+
+	// Optionally serve webhook deliveries, automation triggers, CalDAV and
+	// feeds over HTTP. They authenticate with webhook secrets, MCP tokens,
+	// app passwords and feed tokens respectively, not through the gRPC
+	// interceptors.
+	var httpServer *http.Server
+	if cfg.Server.HTTPPort != 0 {
+		httpLis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.HTTPPort))
+		if err != nil {
+			logr.Error("Failed to listen for HTTP", "error", err)
+			os.Exit(1)
+		}
+		// Probes are always answered; everything else waits for the gate
+		mux := http.NewServeMux()
+		mux.Handle("/livez", readiness.LiveHandler())
+		mux.Handle("/readyz", readiness.ReadyHandler(gate))
+		app := http.NewServeMux()
+		mux.Handle("/", readiness.Middleware(gate, app))
+		app.Handle("/webhooks/", webhookhttp.NewHandler(webhookService, cfg.Webhooks.MaxBodySize, cfg.Webhooks.Async, logr))
+		app.Handle("/v1/triggers/", taskhttp.NewTriggerHandler(taskService, mcpValidator, logr))
+		caldavHandler := caldavhttp.NewHandler(caldavService, logr)
+		app.Handle("/caldav/", caldavHandler)
+		app.Handle(caldavhttp.WellKnownPath, caldavHandler)
+		app.Handle("/feeds/", feedhttp.NewHandler(feedService, cfg.Feeds.BaseURL, logr))
+		httpServer = &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			WriteTimeout:      30 * time.Second,
+		}
+		go func() {
+			logr.Info("HTTP server listening", "address", httpLis.Addr())
+			if err := httpServer.Serve(httpLis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logr.Error("Failed to serve HTTP", "error", err)
+				os.Exit(1)
+			}
+		}()
+	}
 
+	// Handle graceful shutdown: report NOT_SERVING, drain RPCs and workers
+	// within server.shutdown_timeout
 	go func() {
-		<-sigChan
-		logr.Info("Shutting down gracefully...")
-		grpcServer.GracefulStop()
-		cancel()
+		<-ctx.Done()
+		gate.Stop()
+		scheduler.Stop()
+		workQueue.Stop()
+		if httpServer != nil {
+			// Finish HTTP requests in progress while the database is still open
+			shutdownCtx := context.Background()
+			if cfg.Server.ShutdownTimeout > 0 {
+				var shutdownCancel context.CancelFunc
+				shutdownCtx, shutdownCancel = context.WithTimeout(shutdownCtx, cfg.Server.ShutdownTimeout)
+				defer shutdownCancel()
+			}
+			if err := httpServer.Shutdown(shutdownCtx); err != nil {
+				logr.Warn("HTTP server did not drain in time", "error", err)
+			}
+		}
+		// Watch streams never finish on their own, so end them before the
+		// server drains in-flight RPCs
+		changes.Close()
+		coordinator.Shutdown(healthServer, grpcServer)
 	}()
 
+	if unixLis != nil {
+		// Stopping the server closes the listener, which removes the socket file
+		go func() {
+			logr.Info("gRPC server listening", "address", unixLis.Addr())
+			if err := grpcServer.Serve(unixLis); err != nil {
+				logr.Error("Failed to serve on unix socket", "error", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
+	go gate.Run(ctx)
 	logr.Info("gRPC server listening", "address", lis.Addr())
 	if err := grpcServer.Serve(lis); err != nil {
 		logr.Error("Failed to serve", "error", err)
 		os.Exit(1)
 	}
+
+	// Serve returns as soon as shutdown begins; keep the database and
+	// tracer open until in-flight work has drained
+	<-coordinator.Done()
+	if cfg.Usage.Enabled {
+		// Store the calls counted since the last flush
+		flushCtx, flushCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer flushCancel()
+		if err := usageService.Flush(flushCtx); err != nil {
+			logr.Warn("Failed to store API usage at shutdown", "error", err)
+		}
+	}
 }
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/slips-ai/slips-core/pkg/config"
+	"github.com/slips-ai/slips-core/pkg/database"
+)
+
+const migrateUsage = `usage: slips-core migrate <command>
+
+commands:
+  up             apply all pending migrations
+  down           revert the most recent migration
+  version        print the current schema version
+  force VERSION  mark VERSION as applied without running it, e.g. to
+                 baseline a database previously migrated with Atlas`
+
+// runMigrate implements the "migrate" subcommand and returns the exit code
+func runMigrate(cfg *config.Config, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	m, err := database.NewMigrator(cfg.Database.DatabaseURL())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer m.Close()
+
+	switch args[0] {
+	case "up":
+		err = m.Up()
+	case "down":
+		err = m.Steps(-1)
+	case "version":
+		version, dirty, verr := m.Version()
+		if errors.Is(verr, migrate.ErrNilVersion) {
+			fmt.Println("no migrations applied")
+			return 0
+		}
+		if verr != nil {
+			fmt.Fprintln(os.Stderr, verr)
+			return 1
+		}
+		fmt.Printf("version %d (dirty: %t)\n", version, dirty)
+		return 0
+	case "force":
+		if len(args) != 2 {
+			fmt.Fprintln(os.Stderr, migrateUsage)
+			return 2
+		}
+		version, perr := strconv.Atoi(args[1])
+		if perr != nil {
+			fmt.Fprintf(os.Stderr, "invalid version %q\n", args[1])
+			return 2
+		}
+		err = m.Force(version)
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	if errors.Is(err, migrate.ErrNoChange) {
+		fmt.Println("no change")
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Println("ok")
+	return 0
+}
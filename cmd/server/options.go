@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/netip"
+	"os"
+	"strings"
+
+	serverinfogrpc "github.com/slips-ai/slips-core/internal/serverinfo/infra/grpc"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/config"
+	"github.com/slips-ai/slips-core/pkg/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
+)
+
+// serverOptions translates the server configuration into gRPC server
+// options. Zero values are left out so gRPC keeps its own defaults.
+func serverOptions(cfg config.ServerConfig) []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if cfg.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize))
+	}
+	if cfg.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(cfg.MaxSendMsgSize))
+	}
+	if cfg.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(cfg.MaxConcurrentStreams))
+	}
+
+	ka := cfg.Keepalive
+	opts = append(opts,
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:                  ka.Time,
+			Timeout:               ka.Timeout,
+			MaxConnectionIdle:     ka.MaxConnectionIdle,
+			MaxConnectionAge:      ka.MaxConnectionAge,
+			MaxConnectionAgeGrace: ka.MaxConnectionAgeGrace,
+		}),
+		// Clients pinging more often than MinTime get GOAWAY; mobile clients
+		// behind load balancers with short idle timeouts need a low value
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             ka.MinTime,
+			PermitWithoutStream: ka.PermitWithoutStream,
+		}),
+	)
+	return opts
+}
+
+// configureCompression applies server.gzip_level. Importing the gzip
+// package registers the compressor, and the server answers each request
+// with the compressor the client used, so clients opt in per call.
+func configureCompression(cfg config.ServerConfig) error {
+	if cfg.GzipLevel == 0 {
+		return nil
+	}
+	return gzip.SetLevel(cfg.GzipLevel)
+}
+
+// publicMethods builds the authentication allowlist: the defaults (login
+// flow, token refresh, health checks) plus configured extras, optionally
+// without RefreshToken
+func publicMethods(cfg config.AuthConfig) *auth.PublicMethods {
+	var methods []string
+	for _, method := range auth.DefaultPublicMethods {
+		if cfg.RequireAuthForRefresh && method == auth.RefreshTokenMethod {
+			continue
+		}
+		methods = append(methods, method)
+	}
+	return auth.NewPublicMethods(append(methods, cfg.PublicMethods...))
+}
+
+// authServicePrefix selects the AuthService methods among the public ones
+const authServicePrefix = "/auth.v1.AuthService/"
+
+// rateLimitOptions builds the per-IP limits of the public AuthService
+// methods: the login flows and token refresh, whether or not refresh
+// requires credentials
+func rateLimitOptions(cfg config.RateLimitConfig) (ratelimit.Options, error) {
+	opts := ratelimit.Options{Requests: cfg.Requests, Window: cfg.Window}
+	for _, method := range auth.DefaultPublicMethods {
+		if strings.HasPrefix(method, authServicePrefix) {
+			opts.Methods = append(opts.Methods, method)
+		}
+	}
+
+	var err error
+	if opts.BanList, err = ratelimit.ParsePrefixes(cfg.BanList); err != nil {
+		return opts, fmt.Errorf("auth.rate_limit.ban_list: %w", err)
+	}
+	if opts.AllowList, err = ratelimit.ParsePrefixes(cfg.AllowList); err != nil {
+		return opts, fmt.Errorf("auth.rate_limit.allow_list: %w", err)
+	}
+	if opts.TrustedProxies, err = ratelimit.ParsePrefixes(cfg.TrustedProxies); err != nil {
+		return opts, fmt.Errorf("auth.rate_limit.trusted_proxies: %w", err)
+	}
+	return opts, nil
+}
+
+// redisOptions builds the connection of the Redis rate limit store from
+// its configuration and resolved password
+func redisOptions(cfg config.RateLimitConfig, password string) (ratelimit.RedisOptions, error) {
+	opts := ratelimit.RedisOptions{
+		Addr:     cfg.RedisAddr,
+		Username: cfg.RedisUsername,
+		Password: password,
+		DB:       cfg.RedisDB,
+	}
+	if !cfg.RedisTLS {
+		return opts, nil
+	}
+	opts.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.RedisCAFile != "" {
+		pem, err := os.ReadFile(cfg.RedisCAFile)
+		if err != nil {
+			return opts, fmt.Errorf("auth.rate_limit.redis_ca_file: %w", err)
+		}
+		opts.TLS.RootCAs = x509.NewCertPool()
+		if !opts.TLS.RootCAs.AppendCertsFromPEM(pem) {
+			return opts, fmt.Errorf("auth.rate_limit.redis_ca_file: no PEM certificates in %s", cfg.RedisCAFile)
+		}
+	}
+	return opts, nil
+}
+
+// mcpGuardOptions builds the MCP token brute-force protection, taking the
+// client IP of gRPC requests the same way as the auth rate limit
+func mcpGuardOptions(cfg config.MCPTokenGuardConfig, trustedProxies []netip.Prefix) auth.MCPGuardOptions {
+	return auth.MCPGuardOptions{
+		FreeAttempts:   cfg.FreeAttempts,
+		BaseDelay:      cfg.BaseDelay,
+		MaxDelay:       cfg.MaxDelay,
+		BlockAfter:     cfg.BlockAfter,
+		BlockDuration:  cfg.BlockDuration,
+		Window:         cfg.Window,
+		MinFailureTime: cfg.MinFailureTime,
+		SourceIP: func(ctx context.Context) (string, bool) {
+			ip, ok := ratelimit.ClientIP(ctx, trustedProxies)
+			return ip.String(), ok
+		},
+	}
+}
+
+// Rules of authorizationPolicy by scope
+var (
+	readTasks     = auth.Rule{Scopes: []string{auth.ScopeTasksRead}}
+	writeTasks    = auth.Rule{Scopes: []string{auth.ScopeTasksWrite}}
+	manageAccount = auth.Rule{Scopes: []string{auth.ScopeAccount}}
+)
+
+// authorizationPolicy declares what each method requires beyond
+// authentication. Ownership of individual resources is still checked by the
+// services; this covers requirements that depend only on the caller. Every
+// service has a rule; the public methods of AuthService and ServerService
+// have none, so they stay reachable without credentials.
+var authorizationPolicy = auth.NewPolicy(map[string]auth.Rule{
+	"/task.v1.TaskService/":                     writeTasks,
+	"/task.v1.TaskService/GetTask":              readTasks,
+	"/task.v1.TaskService/BatchGetTasks":        readTasks,
+	"/task.v1.TaskService/ListTasks":            readTasks,
+	"/task.v1.TaskService/StreamTasks":          readTasks,
+	"/task.v1.TaskService/WatchChanges":         readTasks,
+	"/task.v1.TaskService/ListTasksByFilter":    readTasks,
+	"/task.v1.TaskService/GetTaskSettings":      readTasks,
+	"/task.v1.TaskService/GetCounters":          readTasks,
+	"/task.v1.TaskService/GetTaskStats":         readTasks,
+	"/task.v1.TaskService/GenerateWeeklyReview": readTasks,
+	"/task.v1.TaskService/ListStaleTasks":       readTasks,
+	"/task.v1.TaskService/ListNoteRevisions":    readTasks,
+	"/task.v1.TaskService/UpdateTaskSettings":   manageAccount,
+	"/task.v1.TaskService/TransferTasks":        manageAccount,
+
+	"/task.v2.TaskService/":          writeTasks,
+	"/task.v2.TaskService/GetTask":   readTasks,
+	"/task.v2.TaskService/ListTasks": readTasks,
+
+	"/tag.v1.TagService/":                  writeTasks,
+	"/tag.v1.TagService/GetTag":            readTasks,
+	"/tag.v1.TagService/ListTags":          readTasks,
+	"/tag.v1.TagService/GetTagSettings":    readTasks,
+	"/tag.v1.TagService/UpdateTagSettings": manageAccount,
+
+	"/savedfilter.v1.SavedFilterService/":                 writeTasks,
+	"/savedfilter.v1.SavedFilterService/GetSavedFilter":   readTasks,
+	"/savedfilter.v1.SavedFilterService/ListSavedFilters": readTasks,
+
+	"/streak.v1.StreakService/":           writeTasks,
+	"/streak.v1.StreakService/GetStreaks": readTasks,
+
+	// Agents may see what waits for approval but not decide it
+	"/approval.v1.ApprovalService/":              manageAccount,
+	"/approval.v1.ApprovalService/GetApproval":   readTasks,
+	"/approval.v1.ApprovalService/ListApprovals": readTasks,
+
+	"/auth.v1.AuthService/GetUserProfile":        manageAccount,
+	"/auth.v1.AuthService/UpdateUserProfile":     manageAccount,
+	"/auth.v1.AuthService/SyncUserProfile":       manageAccount,
+	"/auth.v1.AuthService/UpdateListDefaults":    manageAccount,
+	"/auth.v1.AuthService/GetTavilyMCPToken":     manageAccount,
+	"/auth.v1.AuthService/GetOnboardingState":    manageAccount,
+	"/auth.v1.AuthService/UpdateOnboardingState": manageAccount,
+
+	"/mcptoken.v1.MCPTokenService/":         manageAccount,
+	"/webhook.v1.WebhookService/":           manageAccount,
+	"/caldav.v1.CalDAVService/":             manageAccount,
+	"/feed.v1.FeedService/":                 manageAccount,
+	"/digest.v1.DigestService/":             manageAccount,
+	"/notification.v1.NotificationService/": manageAccount,
+	"/usage.v1.UsageService/":               manageAccount,
+
+	// slipsctl calls it with an admin's MCP token, so the role is enough
+	"/admin.v1.AdminService/": {Roles: []string{auth.RoleAdmin}},
+})
+
+// serverFeatures lists the optional features GetServerInfo reports, given
+// whether the digest job and Web Push are configured
+func serverFeatures(cfg *config.Config, digests, webPush bool) []string {
+	var features []string
+	if cfg.Server.HTTPPort != 0 {
+		features = append(features,
+			serverinfogrpc.FeatureWebhooks,
+			serverinfogrpc.FeatureTriggers,
+			serverinfogrpc.FeatureCalDAV,
+			serverinfogrpc.FeatureFeeds,
+		)
+		if cfg.Webhooks.Async {
+			features = append(features, serverinfogrpc.FeatureWebhooksAsync)
+		}
+	}
+	if digests {
+		features = append(features, serverinfogrpc.FeatureDigests)
+	}
+	if webPush {
+		features = append(features, serverinfogrpc.FeatureWebPush)
+	}
+	if cfg.Encryption.TaskNotes && cfg.Storage == config.StoragePostgres {
+		features = append(features, serverinfogrpc.FeatureEncryptedNotes)
+	}
+	if cfg.Usage.Enabled {
+		features = append(features, serverinfogrpc.FeatureUsage)
+	}
+	if cfg.Transfers.AllowUsers {
+		features = append(features, serverinfogrpc.FeatureTaskTransfers)
+	}
+	return features
+}
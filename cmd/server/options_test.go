@@ -0,0 +1,67 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/config"
+)
+
+func TestAuthorizationPolicy(t *testing.T) {
+	user := &auth.Principal{UserID: "u", Credential: auth.CredentialJWT, Scopes: auth.AllScopes}
+	agent := &auth.Principal{UserID: "u", Credential: auth.CredentialMCPToken, Scopes: auth.MCPTokenScopes}
+
+	tests := []struct {
+		method    string
+		principal *auth.Principal
+		wantErr   bool
+	}{
+		{"/mcptoken.v1.MCPTokenService/CreateMCPToken", user, false},
+		{"/mcptoken.v1.MCPTokenService/CreateMCPToken", agent, true},
+		{"/mcptoken.v1.MCPTokenService/UpdateMCPTokenLimits", user, false},
+		{"/mcptoken.v1.MCPTokenService/UpdateMCPTokenLimits", agent, true},
+		{"/mcptoken.v1.MCPTokenService/RevokeMCPToken", agent, true},
+		{"/mcptoken.v1.MCPTokenService/DeleteMCPToken", agent, true},
+		{"/auth.v1.AuthService/GetTavilyMCPToken", agent, true},
+		{"/approval.v1.ApprovalService/ApproveAction", agent, true},
+		{"/approval.v1.ApprovalService/ListApprovals", agent, false},
+		{"/task.v1.TaskService/TransferTasks", agent, true},
+		{"/task.v1.TaskService/TransferTasks", user, false},
+		{"/task.v1.TaskService/CreateTask", agent, false},
+		{"/task.v1.TaskService/ListTasks", agent, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+"/"+tt.principal.Credential, func(t *testing.T) {
+			err := authorizationPolicy.Authorize(tt.method, tt.principal)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Authorize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRedisOptions(t *testing.T) {
+	cfg := config.RateLimitConfig{RedisAddr: "redis:6379", RedisUsername: "slips", RedisDB: 3}
+	opts, err := redisOptions(cfg, "secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.Addr != "redis:6379" || opts.Username != "slips" || opts.Password != "secret" || opts.DB != 3 || opts.TLS != nil {
+		t.Errorf("redisOptions() = %+v", opts)
+	}
+
+	cfg.RedisTLS = true
+	if opts, err = redisOptions(cfg, "secret"); err != nil || opts.TLS == nil || opts.TLS.RootCAs != nil {
+		t.Errorf("redisOptions() with TLS = %+v, %v; want TLS with the system roots", opts, err)
+	}
+
+	cfg.RedisCAFile = filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(cfg.RedisCAFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := redisOptions(cfg, "secret"); err == nil {
+		t.Error("expected an error for a CA file without certificates")
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newExportCommand(opts *globalOptions) *cobra.Command {
+	var output string
+
+	export := &cobra.Command{
+		Use:   "export USER_ID",
+		Short: "Export a user's tasks, tags, saved filters and settings as JSON",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conn, ctx, cancel, err := opts.dial(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+
+			resp, err := adminv1.NewAdminServiceClient(conn).ExportUserData(ctx, &adminv1.ExportUserDataRequest{
+				UserId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(resp)
+			if err != nil {
+				return err
+			}
+			data = append(data, '\n')
+
+			if output == "" || output == "-" {
+				_, err = os.Stdout.Write(data)
+				return err
+			}
+			if err := os.WriteFile(output, data, 0o600); err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "exported %d task(s), %d tag(s) and %d saved filter(s) to %s\n",
+				len(resp.Tasks), len(resp.Tags), len(resp.SavedFilters), output)
+			return nil
+		},
+	}
+	export.Flags().StringVarP(&output, "output", "o", "", "write to this file instead of stdout")
+
+	return export
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func newLogLevelCommand(opts *globalOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "log-level [debug|info|warn|error]",
+		Short: "Show or change the server's log level without a restart",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conn, ctx, cancel, err := opts.dial(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+
+			client := adminv1.NewAdminServiceClient(conn)
+			if len(args) == 0 {
+				resp, err := client.GetLogLevel(ctx, &adminv1.GetLogLevelRequest{})
+				if err != nil {
+					return err
+				}
+				fmt.Println(resp.Level)
+				return nil
+			}
+
+			resp, err := client.SetLogLevel(ctx, &adminv1.SetLogLevelRequest{Level: args[0]})
+			if err != nil {
+				return err
+			}
+			fmt.Printf("log level changed from %s to %s\n", resp.PreviousLevel, resp.Level)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,19 @@
+// Command slipsctl is an operator CLI for slips-core.
+//
+// It talks to the gRPC API using the generated clients and authenticates with
+// an MCP token (the "MCP-Token" authorization scheme). The token's owner must
+// be listed in the server's auth.admin_user_ids. Schema migrations are applied
+// directly against the database.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/slips-ai/slips-core/pkg/config"
+	"github.com/slips-ai/slips-core/pkg/database"
+	"github.com/slips-ai/slips-core/pkg/secrets"
+	"github.com/spf13/cobra"
+)
+
+func newMigrateCommand() *cobra.Command {
+	var configPath, databaseURL string
+	var shard int
+
+	// newMigrator resolves the database from --database-url or the server config
+	newMigrator := func() (*migrate.Migrate, error) {
+		url := databaseURL
+		if url == "" {
+			cfg, err := config.Load(configPath)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := database.ResolveSecrets(context.Background(), secrets.NewResolver(), &cfg.Database); err != nil {
+				return nil, err
+			}
+			url = cfg.Database.DatabaseURL()
+			if shard > 0 {
+				if shard > len(cfg.Database.Shards) {
+					return nil, fmt.Errorf("shard %d is not configured in %s", shard, configPath)
+				}
+				url = cfg.Database.Shards[shard-1]
+			}
+		}
+		return database.NewMigrator(url)
+	}
+
+	// run executes fn against a migrator and reports ErrNoChange as success
+	run := func(fn func(m *migrate.Migrate) error) error {
+		m, err := newMigrator()
+		if err != nil {
+			return err
+		}
+		defer m.Close()
+
+		if err := fn(m); err != nil {
+			if errors.Is(err, migrate.ErrNoChange) {
+				fmt.Println("no change")
+				return nil
+			}
+			return err
+		}
+		fmt.Println("ok")
+		return nil
+	}
+
+	migrateCmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply the embedded schema migrations directly to the database",
+	}
+	flags := migrateCmd.PersistentFlags()
+	flags.StringVar(&configPath, "config", "config.yaml", "slips-core config file used to build the database URL")
+	flags.StringVar(&databaseURL, "database-url", "", "postgres:// URL (overrides --config)")
+	flags.IntVar(&shard, "shard", 0, "migrate this database shard from --config instead of the primary (shard 0)")
+
+	migrateCmd.AddCommand(
+		&cobra.Command{
+			Use:   "up",
+			Short: "Apply all pending migrations",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(func(m *migrate.Migrate) error { return m.Up() })
+			},
+		},
+		&cobra.Command{
+			Use:   "down",
+			Short: "Revert the most recent migration",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(func(m *migrate.Migrate) error { return m.Steps(-1) })
+			},
+		},
+		&cobra.Command{
+			Use:   "force VERSION",
+			Short: "Mark VERSION as applied without running it",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				version, err := strconv.Atoi(args[0])
+				if err != nil {
+					return fmt.Errorf("invalid version %q", args[0])
+				}
+				return run(func(m *migrate.Migrate) error { return m.Force(version) })
+			},
+		},
+		&cobra.Command{
+			Use:   "version",
+			Short: "Print the current schema version",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				m, err := newMigrator()
+				if err != nil {
+					return err
+				}
+				defer m.Close()
+
+				version, dirty, err := m.Version()
+				if errors.Is(err, migrate.ErrNilVersion) {
+					fmt.Println("no migrations applied")
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				fmt.Printf("version %d (dirty: %t)\n", version, dirty)
+				return nil
+			},
+		},
+	)
+	return migrateCmd
+}
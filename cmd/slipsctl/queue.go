@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/config"
+	"github.com/slips-ai/slips-core/pkg/database"
+	"github.com/slips-ai/slips-core/pkg/queue"
+	"github.com/slips-ai/slips-core/pkg/secrets"
+	"github.com/spf13/cobra"
+)
+
+func newQueueCommand() *cobra.Command {
+	var configPath string
+
+	// open connects to the primary, which holds the queue
+	open := func(ctx context.Context) (*database.DB, error) {
+		cfg, err := config.Load(configPath)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := database.ResolveSecrets(ctx, secrets.NewResolver(), &cfg.Database); err != nil {
+			return nil, err
+		}
+		return database.Open(ctx, cfg.Database, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	}
+
+	queueCmd := &cobra.Command{
+		Use:   "queue",
+		Short: "Inspect and requeue dead letters of the work queue",
+	}
+	queueCmd.PersistentFlags().StringVar(&configPath, "config", "config.yaml", "slips-core config file with the database")
+
+	var limit int
+	deadCmd := &cobra.Command{
+		Use:   "dead",
+		Short: "List jobs that failed their last attempt, most recent first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := open(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			jobs, err := queue.NewPostgresStore(db.Primary).ListDead(cmd.Context(), limit)
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tKIND\tATTEMPTS\tCREATED\tLAST ERROR")
+			for _, job := range jobs {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", job.ID, job.Kind, job.Attempts,
+					job.CreatedAt.Format(time.RFC3339), job.LastError)
+			}
+			return w.Flush()
+		},
+	}
+	deadCmd.Flags().IntVar(&limit, "limit", 50, "maximum number of jobs to list")
+
+	queueCmd.AddCommand(
+		deadCmd,
+		&cobra.Command{
+			Use:   "retry JOB_ID...",
+			Short: "Make dead letters pending again with fresh attempts",
+			Args:  cobra.MinimumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				db, err := open(cmd.Context())
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+
+				store := queue.NewPostgresStore(db.Primary)
+				var errs []error
+				for _, arg := range args {
+					id, err := uuid.Parse(arg)
+					if err != nil {
+						errs = append(errs, fmt.Errorf("invalid job ID %q", arg))
+						continue
+					}
+					if err := store.Requeue(cmd.Context(), id); err != nil {
+						errs = append(errs, fmt.Errorf("%s: %w", id, err))
+						continue
+					}
+					fmt.Printf("requeued %s\n", id)
+				}
+				return errors.Join(errs...)
+			},
+		},
+	)
+	return queueCmd
+}
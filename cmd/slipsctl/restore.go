@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newRestoreCommand(opts *globalOptions) *cobra.Command {
+	var input string
+	var dryRun bool
+
+	restore := &cobra.Command{
+		Use:   "restore USER_ID",
+		Short: "Restore a JSON export into a user under fresh IDs",
+		Long: `Restore a file written by "slipsctl export" into USER_ID, the exported
+user or another one. The tasks are added next to the user's current ones
+under fresh IDs, tags and saved filters are matched by name, and the user's
+settings and weekly goal are replaced by the exported ones. Restoring the
+same export twice duplicates its tasks.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var data []byte
+			var err error
+			if input == "" || input == "-" {
+				data, err = io.ReadAll(os.Stdin)
+			} else {
+				data, err = os.ReadFile(input)
+			}
+			if err != nil {
+				return err
+			}
+			snapshot := &adminv1.ExportUserDataResponse{}
+			if err := protojson.Unmarshal(data, snapshot); err != nil {
+				return fmt.Errorf("failed to parse export: %w", err)
+			}
+
+			conn, ctx, cancel, err := opts.dial(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+
+			resp, err := adminv1.NewAdminServiceClient(conn).RestoreUserData(ctx, &adminv1.RestoreUserDataRequest{
+				UserId:       args[0],
+				Snapshot:     snapshot,
+				ValidateOnly: dryRun,
+			})
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				fmt.Printf("would restore %d task(s) of %s into %s and create %d tag(s) and %d saved filter(s) (dry run)\n",
+					resp.RestoredTaskCount, snapshot.UserId, resp.UserId, resp.CreatedTagCount, resp.CreatedSavedFilterCount)
+				return nil
+			}
+
+			fmt.Printf("restored %d task(s) of %s into %s, created %d of %d tag(s) and %d of %d saved filter(s)\n",
+				resp.RestoredTaskCount, snapshot.UserId, resp.UserId, resp.CreatedTagCount, len(resp.TagIds),
+				resp.CreatedSavedFilterCount, len(resp.SavedFilterIds))
+			return nil
+		},
+	}
+	restore.Flags().StringVarP(&input, "file", "f", "", "read the export from this file instead of stdin")
+	restore.Flags().BoolVar(&dryRun, "dry-run", false, "check the export and report the restore without applying it")
+
+	return restore
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// globalOptions holds flags shared by all API commands
+type globalOptions struct {
+	addr    string
+	token   string
+	useTLS  bool
+	gzip    bool
+	timeout time.Duration
+}
+
+func newRootCommand() *cobra.Command {
+	opts := &globalOptions{}
+
+	root := &cobra.Command{
+		Use:           "slipsctl",
+		Short:         "Operator CLI for slips-core",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&opts.addr, "addr", envOr("SLIPSCTL_ADDR", "localhost:9090"), "slips-core gRPC address (env SLIPSCTL_ADDR)")
+	flags.StringVar(&opts.token, "token", os.Getenv("SLIPSCTL_TOKEN"), "MCP token of an admin user (env SLIPSCTL_TOKEN)")
+	flags.BoolVar(&opts.useTLS, "tls", false, "connect using TLS")
+	flags.BoolVar(&opts.gzip, "gzip", false, "gzip requests and ask for gzip responses")
+	flags.DurationVar(&opts.timeout, "timeout", 30*time.Second, "per-command request timeout")
+
+	root.AddCommand(
+		newUsersCommand(opts),
+		newTokensCommand(opts),
+		newTagsCommand(opts),
+		newExportCommand(opts),
+		newRestoreCommand(opts),
+		newTransferCommand(opts),
+		newSeedCommand(opts),
+		newLogLevelCommand(opts),
+		newVersionCommand(opts),
+		newMigrateCommand(),
+		newShardsCommand(),
+		newQueueCommand(),
+		newSecretsCommand(),
+	)
+	return root
+}
+
+// maxResponseSize matches the default server.max_send_msg_size
+const maxResponseSize = 64 << 20
+
+// dial opens a client connection that sends the MCP token with every call.
+// The returned context carries the command timeout.
+func (o *globalOptions) dial(ctx context.Context) (*grpc.ClientConn, context.Context, context.CancelFunc, error) {
+	if o.token == "" {
+		return nil, nil, nil, errors.New("an MCP token is required (--token or SLIPSCTL_TOKEN)")
+	}
+	return o.connect(ctx, grpc.WithPerRPCCredentials(mcpTokenCredentials{token: o.token, requireTLS: o.useTLS}))
+}
+
+// dialPublic is dial for public methods, sending the MCP token only when
+// one is set
+func (o *globalOptions) dialPublic(ctx context.Context) (*grpc.ClientConn, context.Context, context.CancelFunc, error) {
+	if o.token == "" {
+		return o.connect(ctx)
+	}
+	return o.dial(ctx)
+}
+
+func (o *globalOptions) connect(ctx context.Context, dialOpts ...grpc.DialOption) (*grpc.ClientConn, context.Context, context.CancelFunc, error) {
+	transport := insecure.NewCredentials()
+	if o.useTLS {
+		transport = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	// Exports can exceed the 4 MiB default; the server caps responses
+	// with server.max_send_msg_size
+	callOpts := []grpc.CallOption{grpc.MaxCallRecvMsgSize(maxResponseSize)}
+	if o.gzip {
+		callOpts = append(callOpts, grpc.UseCompressor(gzip.Name))
+	}
+
+	dialOpts = append(dialOpts,
+		grpc.WithTransportCredentials(transport),
+		grpc.WithDefaultCallOptions(callOpts...),
+	)
+	conn, err := grpc.NewClient(o.addr, dialOpts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, o.timeout)
+	return conn, callCtx, cancel, nil
+}
+
+// mcpTokenCredentials attaches "authorization: MCP-Token <token>" to each call
+type mcpTokenCredentials struct {
+	token      string
+	requireTLS bool
+}
+
+func (c mcpTokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "MCP-Token " + c.token}, nil
+}
+
+func (c mcpTokenCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	authpg "github.com/slips-ai/slips-core/internal/auth/infra/postgres"
+	taskpg "github.com/slips-ai/slips-core/internal/task/infra/postgres"
+	webhookpg "github.com/slips-ai/slips-core/internal/webhook/infra/postgres"
+	"github.com/slips-ai/slips-core/pkg/config"
+	"github.com/slips-ai/slips-core/pkg/database"
+	"github.com/slips-ai/slips-core/pkg/envelope"
+	"github.com/slips-ai/slips-core/pkg/secrets"
+	"github.com/spf13/cobra"
+)
+
+func newSecretsCommand() *cobra.Command {
+	var configPath string
+
+	secretsCmd := &cobra.Command{
+		Use:   "secrets",
+		Short: "Manage user secrets encrypted at rest",
+	}
+	secretsCmd.PersistentFlags().StringVar(&configPath, "config", "config.yaml", "slips-core config file with the database and encryption keys")
+
+	secretsCmd.AddCommand(&cobra.Command{
+		Use:   "rotate",
+		Short: "Re-encrypt user secrets with encryption.primary_key",
+		Long: "Encrypts plaintext user and webhook secrets and re-encrypts those written under an older key, " +
+			"then re-wraps the per-user data keys protecting encrypted task notes. " +
+			"Run it after changing encryption.primary_key, before removing the old key from encryption.keys.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			cfg, err := config.Load(configPath)
+			if err != nil {
+				return err
+			}
+			resolver := secrets.NewResolver()
+			if _, err := database.ResolveSecrets(ctx, resolver, &cfg.Database); err != nil {
+				return err
+			}
+			keyring, err := envelope.NewKeyringFromConfig(ctx, cfg.Encryption, resolver)
+			if err != nil {
+				return err
+			}
+			if keyring == nil {
+				return fmt.Errorf("no encryption keys configured in %s", configPath)
+			}
+
+			db, err := database.Open(ctx, cfg.Database, slog.New(slog.NewTextHandler(io.Discard, nil)))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			rotated, err := authpg.NewRepository(db.Primary, keyring).RotateSecrets(ctx)
+			if err == nil {
+				var webhooks int
+				webhooks, err = webhookpg.NewWebhookRepository(db.Primary, keyring).RotateSecrets(ctx)
+				rotated += webhooks
+			}
+			fmt.Printf("re-encrypted %d secrets with key %s\n", rotated, keyring.PrimaryKeyID())
+			if err != nil {
+				return err
+			}
+
+			// Data keys live on the shard of their user
+			taskRepo := taskpg.NewTaskRepository(db.Data(), db.Data(), keyring, cfg.Encryption.TaskNotes)
+			rewrapped := 0
+			err = db.ForEachShard(ctx, func(ctx context.Context) error {
+				n, err := taskRepo.RotateDataKeys(ctx)
+				rewrapped += n
+				return err
+			})
+			fmt.Printf("re-wrapped %d data keys with key %s\n", rewrapped, keyring.PrimaryKeyID())
+			return err
+		},
+	})
+	return secretsCmd
+}
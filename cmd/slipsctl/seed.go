@@ -0,0 +1,290 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	tagv1 "github.com/slips-ai/slips-core/gen/go/tag/v1"
+	taskv1 "github.com/slips-ai/slips-core/gen/go/task/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// seedOptions controls the volume and shape of generated data
+type seedOptions struct {
+	tasks          int
+	tags           int
+	checklistRatio float64
+	completedRatio float64
+	archivedRatio  float64
+	pinnedRatio    float64
+	seed           uint64
+	concurrency    int
+}
+
+// seedTask is one task to create, plus the follow-up state to apply to it
+type seedTask struct {
+	title     string
+	notes     string
+	tags      []string
+	startDate *string
+	deadline  *string
+	checklist []string
+	// checklistDone is how many leading checklist items are marked completed
+	checklistDone int
+	complete      bool
+	archive       bool
+	pin           bool
+}
+
+var seedTagNames = []string{
+	"work", "home", "errands", "health", "finance", "reading", "family",
+	"side-project", "travel", "learning", "garden", "admin", "fitness", "music",
+}
+
+var seedVerbs = []string{
+	"Review", "Draft", "Plan", "Book", "Call", "Email", "Fix", "Prepare",
+	"Renew", "Schedule", "Clean", "Research", "Update", "Order", "Organize",
+}
+
+var seedObjects = []string{
+	"quarterly report", "dentist appointment", "car insurance", "team offsite",
+	"project proposal", "grocery list", "tax documents", "birthday present",
+	"conference talk", "bike repair", "newsletter", "budget spreadsheet",
+	"flight to Berlin", "passport", "onboarding guide", "garage", "release notes",
+	"reading list", "vet visit", "backup drive",
+}
+
+var seedNotes = []string{
+	"",
+	"",
+	"Check with Alex before sending.",
+	"Waiting on feedback from the last round.",
+	"Keep it short, one page max.",
+	"Receipts are in the shared folder.",
+	"Ask about weekend availability.",
+	"Compare at least three options first.",
+}
+
+var seedChecklistItems = []string{
+	"Collect inputs", "Write first draft", "Get a second opinion",
+	"Double-check numbers", "Send it off", "Follow up next week",
+	"Book a time slot", "Confirm by email", "File the paperwork",
+	"Buy supplies", "Tidy up afterwards",
+}
+
+func newSeedCommand(opts *globalOptions) *cobra.Command {
+	seed := seedOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Populate the token owner's account with realistic demo data",
+		Long: `Creates tags and tasks (with start dates, deadlines and checklists) for the
+user owning --token through the public API. Some tasks are then completed,
+archived or pinned. Use --seed to generate the same data set again.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if seed.tasks < 0 || seed.tags < 0 || seed.concurrency < 1 {
+				return fmt.Errorf("--tasks and --tags must not be negative and --concurrency must be at least 1")
+			}
+
+			conn, ctx, cancel, err := opts.dial(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+
+			tagClient := tagv1.NewTagServiceClient(conn)
+			taskClient := taskv1.NewTaskServiceClient(conn)
+
+			if seed.seed == 0 {
+				seed.seed = rand.Uint64()
+			}
+			fmt.Fprintf(os.Stderr, "using seed %d\n", seed.seed)
+			rng := rand.New(rand.NewPCG(seed.seed, seed.seed))
+			tagNames := pickTagNames(rng, seed.tags)
+			tasks := planSeedTasks(rng, seed, tagNames, time.Now())
+
+			// Create tags up front so concurrent task creation only looks them up
+			for _, name := range tagNames {
+				_, err := tagClient.CreateTag(ctx, &tagv1.CreateTagRequest{Name: name})
+				if err != nil && status.Code(err) != codes.AlreadyExists {
+					return fmt.Errorf("create tag %q: %w", name, err)
+				}
+			}
+
+			start := time.Now()
+			created, err := runSeedTasks(ctx, taskClient, tasks, seed.concurrency)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "created %d tag(s) and %d task(s) in %s\n",
+				len(tagNames), created, time.Since(start).Round(time.Millisecond))
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.IntVar(&seed.tasks, "tasks", 50, "number of tasks to create")
+	flags.IntVar(&seed.tags, "tags", 6, "number of distinct tags to use (at most 14)")
+	flags.Float64Var(&seed.checklistRatio, "checklist-ratio", 0.4, "fraction of tasks that get a checklist")
+	flags.Float64Var(&seed.completedRatio, "completed-ratio", 0.3, "fraction of tasks that are completed")
+	flags.Float64Var(&seed.archivedRatio, "archived-ratio", 0.1, "fraction of tasks that are archived")
+	flags.Float64Var(&seed.pinnedRatio, "pinned-ratio", 0.05, "fraction of tasks that are pinned")
+	flags.Uint64Var(&seed.seed, "seed", 0, "random seed, reuse a printed seed to reproduce a data set (0 picks one)")
+	flags.IntVar(&seed.concurrency, "concurrency", 4, "tasks created in parallel")
+
+	return cmd
+}
+
+// pickTagNames returns n distinct tag names in random order
+func pickTagNames(rng *rand.Rand, n int) []string {
+	names := append([]string(nil), seedTagNames...)
+	rng.Shuffle(len(names), func(i, j int) {
+		names[i], names[j] = names[j], names[i]
+	})
+	return names[:min(n, len(names))]
+}
+
+// planSeedTasks generates the tasks to create. Dates are spread from two
+// weeks ago to a month ahead of today so views like "today", "upcoming" and
+// "overdue" all have content.
+func planSeedTasks(rng *rand.Rand, opts seedOptions, tagNames []string, today time.Time) []seedTask {
+	tasks := make([]seedTask, opts.tasks)
+	for i := range tasks {
+		task := seedTask{
+			title: seedVerbs[rng.IntN(len(seedVerbs))] + " " + seedObjects[rng.IntN(len(seedObjects))],
+			notes: seedNotes[rng.IntN(len(seedNotes))],
+		}
+
+		if len(tagNames) > 0 {
+			for _, idx := range rng.Perm(len(tagNames))[:rng.IntN(min(3, len(tagNames)+1))] {
+				task.tags = append(task.tags, tagNames[idx])
+			}
+		}
+
+		// Roughly a third of tasks stay in the inbox without a start date
+		if rng.Float64() >= 0.35 {
+			date := today.AddDate(0, 0, rng.IntN(45)-14).Format(time.DateOnly)
+			task.startDate = &date
+		}
+		if rng.Float64() < 0.3 {
+			date := today.AddDate(0, 0, rng.IntN(35)-5).Format(time.DateOnly)
+			task.deadline = &date
+		}
+
+		if rng.Float64() < opts.checklistRatio {
+			for _, idx := range rng.Perm(len(seedChecklistItems))[:2+rng.IntN(4)] {
+				task.checklist = append(task.checklist, seedChecklistItems[idx])
+			}
+			task.checklistDone = rng.IntN(len(task.checklist) + 1)
+		}
+
+		task.complete = rng.Float64() < opts.completedRatio
+		task.archive = rng.Float64() < opts.archivedRatio
+		task.pin = !task.archive && rng.Float64() < opts.pinnedRatio
+		if task.complete {
+			task.checklistDone = len(task.checklist)
+		}
+
+		tasks[i] = task
+	}
+	return tasks
+}
+
+// runSeedTasks creates the planned tasks with the given parallelism and
+// returns how many were created before the first error
+func runSeedTasks(ctx context.Context, client taskv1.TaskServiceClient, tasks []seedTask, concurrency int) (int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		created  atomic.Int64
+		errOnce  sync.Once
+		firstErr error
+	)
+	work := make(chan seedTask)
+
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range work {
+				if err := createSeedTask(ctx, client, task); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+				created.Add(1)
+			}
+		}()
+	}
+
+feed:
+	for _, task := range tasks {
+		select {
+		case work <- task:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	if firstErr == nil && ctx.Err() != nil {
+		firstErr = ctx.Err()
+	}
+	return int(created.Load()), firstErr
+}
+
+// createSeedTask creates a single task and applies its follow-up state
+func createSeedTask(ctx context.Context, client taskv1.TaskServiceClient, task seedTask) error {
+	resp, err := client.CreateTask(ctx, &taskv1.CreateTaskRequest{
+		Title:          task.title,
+		Notes:          task.notes,
+		TagNames:       task.tags,
+		StartDate:      task.startDate,
+		Deadline:       task.deadline,
+		ChecklistItems: task.checklist,
+	})
+	if err != nil {
+		return fmt.Errorf("create task %q: %w", task.title, err)
+	}
+	id := resp.Task.Id
+
+	items := resp.Task.ChecklistItems
+	for _, item := range items[:min(task.checklistDone, len(items))] {
+		if _, err := client.SetChecklistItemCompleted(ctx, &taskv1.SetChecklistItemCompletedRequest{
+			ItemId:    item.Id,
+			Completed: true,
+		}); err != nil {
+			return fmt.Errorf("complete checklist item of %s: %w", id, err)
+		}
+	}
+	if task.complete {
+		if _, err := client.CompleteTask(ctx, &taskv1.CompleteTaskRequest{Id: id}); err != nil {
+			return fmt.Errorf("complete task %s: %w", id, err)
+		}
+	}
+	if task.pin {
+		if _, err := client.TogglePinTask(ctx, &taskv1.TogglePinTaskRequest{Id: id}); err != nil {
+			return fmt.Errorf("pin task %s: %w", id, err)
+		}
+	}
+	if task.archive {
+		if _, err := client.ArchiveTask(ctx, &taskv1.ArchiveTaskRequest{Id: id}); err != nil {
+			return fmt.Errorf("archive task %s: %w", id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPlanSeedTasks_Deterministic(t *testing.T) {
+	opts := seedOptions{tasks: 40, tags: 5, checklistRatio: 0.5, completedRatio: 0.3, archivedRatio: 0.1, pinnedRatio: 0.1}
+	today := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	plan := func() []seedTask {
+		rng := rand.New(rand.NewPCG(42, 42))
+		return planSeedTasks(rng, opts, pickTagNames(rng, opts.tags), today)
+	}
+
+	first, second := plan(), plan()
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("expected the same seed to produce the same plan")
+	}
+	if len(first) != opts.tasks {
+		t.Fatalf("expected %d tasks, got %d", opts.tasks, len(first))
+	}
+}
+
+func TestPlanSeedTasks_RespectsOptions(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 1))
+	tagNames := pickTagNames(rng, 3)
+	if len(tagNames) != 3 {
+		t.Fatalf("expected 3 tag names, got %v", tagNames)
+	}
+
+	opts := seedOptions{tasks: 200, checklistRatio: 1, completedRatio: 0}
+	allowed := map[string]bool{}
+	for _, name := range tagNames {
+		allowed[name] = true
+	}
+
+	for _, task := range planSeedTasks(rng, opts, tagNames, time.Now()) {
+		if len(task.checklist) < 2 {
+			t.Fatalf("expected every task to get a checklist, got %v", task.checklist)
+		}
+		if task.checklistDone > len(task.checklist) {
+			t.Fatalf("checklistDone %d exceeds %d items", task.checklistDone, len(task.checklist))
+		}
+		if task.complete || task.archive || task.pin {
+			t.Fatalf("expected no completed, archived or pinned tasks, got %+v", task)
+		}
+		for _, tag := range task.tags {
+			if !allowed[tag] {
+				t.Fatalf("unexpected tag %q", tag)
+			}
+		}
+	}
+}
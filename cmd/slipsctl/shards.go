@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/slips-ai/slips-core/pkg/config"
+	"github.com/slips-ai/slips-core/pkg/database"
+	"github.com/slips-ai/slips-core/pkg/secrets"
+	"github.com/spf13/cobra"
+)
+
+func newShardsCommand() *cobra.Command {
+	var configPath string
+
+	// open connects to the primary and every shard from the server config
+	open := func(ctx context.Context, logger *slog.Logger) (*database.DB, *config.Config, error) {
+		cfg, err := config.Load(configPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(cfg.Database.Shards) == 0 {
+			return nil, nil, fmt.Errorf("no database.shards configured in %s", configPath)
+		}
+		if _, err := database.ResolveSecrets(ctx, secrets.NewResolver(), &cfg.Database); err != nil {
+			return nil, nil, err
+		}
+		db, err := database.Open(ctx, cfg.Database, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		return db, cfg, nil
+	}
+
+	shardsCmd := &cobra.Command{
+		Use:   "shards",
+		Short: "Locate users on database shards and move them between shards",
+	}
+	shardsCmd.PersistentFlags().StringVar(&configPath, "config", "config.yaml", "slips-core config file with the database and its shards")
+
+	moveCmd := &cobra.Command{
+		Use:   "move USER_ID SHARD",
+		Short: "Move a user's data to another shard",
+		Long: "Marks the user moving, waits database.shard_placement_ttl so running servers stop using " +
+			"the old shard, copies the user's tasks, tags and settings to SHARD and deletes them from the " +
+			"old one. The user's requests fail with UNAVAILABLE until the move finishes. Shard 0 is the primary.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid shard %q", args[1])
+			}
+			logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+			db, cfg, err := open(cmd.Context(), logger)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			if err := db.Shards().MoveOwner(cmd.Context(), args[0], to, cfg.Database.ShardPlacementTTL, logger); err != nil {
+				return err
+			}
+			fmt.Printf("%s\t%d\n", args[0], to)
+			return nil
+		},
+	}
+
+	shardsCmd.AddCommand(
+		&cobra.Command{
+			Use:   "locate USER_ID...",
+			Short: "Print the shard each user's data lives on",
+			Args:  cobra.MinimumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				db, _, err := open(cmd.Context(), slog.New(slog.NewTextHandler(io.Discard, nil)))
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+
+				var errs []error
+				for _, userID := range args {
+					placement, err := db.Shards().Locate(cmd.Context(), userID)
+					if err != nil {
+						errs = append(errs, fmt.Errorf("%s: %w", userID, err))
+						continue
+					}
+					state := ""
+					if placement.Moving {
+						state = "\tmoving"
+					}
+					fmt.Printf("%s\t%d%s\n", userID, placement.Shard, state)
+				}
+				return errors.Join(errs...)
+			},
+		},
+		moveCmd,
+	)
+	return shardsCmd
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func newTagsCommand(opts *globalOptions) *cobra.Command {
+	tags := &cobra.Command{
+		Use:   "tags",
+		Short: "Maintain users' tags",
+	}
+
+	var dryRun bool
+	normalize := &cobra.Command{
+		Use:   "normalize",
+		Short: "Normalize every user's tag names and merge the duplicates this creates",
+		Long: "Brings every user's tag names to Unicode NFC with whitespace trimmed and\n" +
+			"collapsed, the form new tags are stored in. A user's tags that end up with\n" +
+			"the same name are merged into one, which takes over their tasks, feeds and\n" +
+			"saved filters. Run with --dry-run first to review the report.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conn, ctx, cancel, err := opts.dial(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+
+			resp, err := adminv1.NewAdminServiceClient(conn).NormalizeTagNames(ctx, &adminv1.NormalizeTagNamesRequest{DryRun: dryRun})
+			if err != nil {
+				return err
+			}
+
+			if len(resp.Changes) > 0 {
+				w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+				fmt.Fprintln(w, "OWNER ID\tTAG ID\tOLD NAME\tNEW NAME\tMERGED INTO")
+				for _, change := range resp.Changes {
+					fmt.Fprintf(w, "%s\t%s\t%q\t%q\t%s\n",
+						change.OwnerId, change.TagId, change.OldName, change.NewName, change.GetMergedIntoTagId())
+				}
+				if err := w.Flush(); err != nil {
+					return err
+				}
+			}
+
+			if !resp.Applied {
+				fmt.Printf("would rename %d tag(s) and merge %d (dry run)\n", resp.RenamedCount, resp.MergedCount)
+				return nil
+			}
+			fmt.Printf("renamed %d tag(s), merged %d, %d task(s) reassigned\n", resp.RenamedCount, resp.MergedCount, resp.ReassignedTaskCount)
+			return nil
+		},
+	}
+	normalize.Flags().BoolVar(&dryRun, "dry-run", false, "report the changes without applying them")
+
+	tags.AddCommand(normalize)
+	return tags
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func newTokensCommand(opts *globalOptions) *cobra.Command {
+	tokens := &cobra.Command{
+		Use:   "tokens",
+		Short: "Manage users' MCP tokens",
+	}
+
+	var tokenID string
+	revoke := &cobra.Command{
+		Use:   "revoke USER_ID",
+		Short: "Revoke a user's MCP tokens (all active tokens unless --id is given)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conn, ctx, cancel, err := opts.dial(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+
+			req := &adminv1.RevokeUserMCPTokensRequest{UserId: args[0]}
+			if tokenID != "" {
+				req.TokenId = &tokenID
+			}
+
+			resp, err := adminv1.NewAdminServiceClient(conn).RevokeUserMCPTokens(ctx, req)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("revoked %d token(s)\n", resp.RevokedCount)
+			return nil
+		},
+	}
+	revoke.Flags().StringVar(&tokenID, "id", "", "revoke only the token with this ID")
+
+	tokens.AddCommand(revoke)
+	return tokens
+}
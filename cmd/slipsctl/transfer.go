@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func newTransferCommand(opts *globalOptions) *cobra.Command {
+	var taskIDs []string
+	var allTasks bool
+
+	transfer := &cobra.Command{
+		Use:   "transfer FROM_USER_ID TO_USER_ID",
+		Short: "Hand tasks from one user to another",
+		Long: `Hand the tasks given with --task, or all of them with --all, from
+FROM_USER_ID to TO_USER_ID in one transaction, e.g. to consolidate two
+accounts. The tasks keep their IDs, checklists and note revisions, and tags
+are matched by name. Both users must live on the same shard; move one
+with "slipsctl shards move" first if they do not.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if allTasks == (len(taskIDs) > 0) {
+				return errors.New("pass either --task or --all")
+			}
+
+			conn, ctx, cancel, err := opts.dial(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+
+			resp, err := adminv1.NewAdminServiceClient(conn).TransferOwnership(ctx, &adminv1.TransferOwnershipRequest{
+				FromUserId: args[0],
+				ToUserId:   args[1],
+				TaskIds:    taskIDs,
+				AllTasks:   allTasks,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("transferred %d task(s) from %s to %s, created %d tag(s)\n",
+				len(resp.TaskIds), args[0], args[1], resp.CreatedTagCount)
+			for _, id := range resp.TaskIds {
+				fmt.Println(id)
+			}
+			return nil
+		},
+	}
+	transfer.Flags().StringSliceVar(&taskIDs, "task", nil, "ID of a task to transfer; repeat or separate with commas")
+	transfer.Flags().BoolVar(&allTasks, "all", false, "transfer all of the user's tasks, including archived ones")
+
+	return transfer
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func newUsersCommand(opts *globalOptions) *cobra.Command {
+	users := &cobra.Command{
+		Use:   "users",
+		Short: "Inspect and anonymize registered users",
+	}
+
+	var pageSize int32
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List all users",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conn, ctx, cancel, err := opts.dial(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+
+			client := adminv1.NewAdminServiceClient(conn)
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tUSER ID\tUSERNAME\tEMAIL\tCREATED")
+
+			pageToken := ""
+			for {
+				resp, err := client.ListUsers(ctx, &adminv1.ListUsersRequest{
+					PageSize:  pageSize,
+					PageToken: pageToken,
+				})
+				if err != nil {
+					return err
+				}
+				for _, user := range resp.Users {
+					fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
+						user.Id, user.UserId, user.Username, user.Email,
+						user.CreatedAt.AsTime().Format("2006-01-02"))
+				}
+				if resp.NextPageToken == "" {
+					break
+				}
+				pageToken = resp.NextPageToken
+			}
+			return w.Flush()
+		},
+	}
+	list.Flags().Int32Var(&pageSize, "page-size", 100, "users fetched per request")
+
+	stats := &cobra.Command{
+		Use:   "stats USER_ID",
+		Short: "Show how much data a user owns",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conn, ctx, cancel, err := opts.dial(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+
+			resp, err := adminv1.NewAdminServiceClient(conn).GetUserStats(ctx, &adminv1.GetUserStatsRequest{
+				UserId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			user, counts := resp.User, resp.Counts
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintf(w, "User ID:\t%s\n", user.UserId)
+			fmt.Fprintf(w, "Username:\t%s\n", user.Username)
+			fmt.Fprintf(w, "Email:\t%s\n", user.Email)
+			fmt.Fprintf(w, "Created:\t%s\n", user.CreatedAt.AsTime().Format("2006-01-02 15:04:05"))
+			fmt.Fprintf(w, "Tasks:\t%d (open %d, completed %d, archived %d)\n",
+				counts.Tasks, counts.OpenTasks, counts.CompletedTasks, counts.ArchivedTasks)
+			fmt.Fprintf(w, "Tags:\t%d\n", counts.Tags)
+			fmt.Fprintf(w, "MCP tokens:\t%d (active %d)\n", counts.McpTokens, counts.ActiveMcpTokens)
+			return w.Flush()
+		},
+	}
+
+	var confirmed bool
+	anonymize := &cobra.Command{
+		Use:   "anonymize USER_ID",
+		Short: "Replace a user's profile with a pseudonym, keeping their data",
+		Long: "Replaces the username with a pseudonym and clears the email, avatar and\n" +
+			"Tavily MCP token, for GDPR restriction of processing requests. Tasks, tags\n" +
+			"and tokens are kept, and later logins do not restore the profile. This\n" +
+			"cannot be undone, so it requires --yes.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !confirmed {
+				return fmt.Errorf("anonymizing %s cannot be undone; pass --yes to confirm", args[0])
+			}
+
+			conn, ctx, cancel, err := opts.dial(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+
+			resp, err := adminv1.NewAdminServiceClient(conn).AnonymizeUser(ctx, &adminv1.AnonymizeUserRequest{
+				UserId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			counts := resp.Counts
+			fmt.Printf("anonymized %s as %s, kept %d task(s), %d tag(s) and %d MCP token(s)\n",
+				resp.User.UserId, resp.User.Username, counts.Tasks, counts.Tags, counts.McpTokens)
+			return nil
+		},
+	}
+	anonymize.Flags().BoolVar(&confirmed, "yes", false, "confirm the anonymization")
+
+	users.AddCommand(list, stats, anonymize)
+	return users
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	serverv1 "github.com/slips-ai/slips-core/gen/go/server/v1"
+	"github.com/slips-ai/slips-core/pkg/buildinfo"
+	"github.com/spf13/cobra"
+)
+
+func newVersionCommand(opts *globalOptions) *cobra.Command {
+	var clientOnly bool
+	cmd := &cobra.Command{
+		Use:   "version",
+		Short: "Show the build of slipsctl and of the server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			build := buildinfo.Get()
+			fmt.Printf("slipsctl: %s\n", formatBuild(build.Version, build.GitSHA, build.BuildTime, build.GoVersion))
+			if clientOnly {
+				return nil
+			}
+
+			conn, ctx, cancel, err := opts.dialPublic(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			defer cancel()
+
+			info, err := serverv1.NewServerServiceClient(conn).GetServerInfo(ctx, &serverv1.GetServerInfoRequest{})
+			if err != nil {
+				return err
+			}
+			var buildTime time.Time
+			if info.BuildTime != nil {
+				buildTime = info.BuildTime.AsTime()
+			}
+			fmt.Printf("server:   %s\n", formatBuild(info.Version, info.GitSha, buildTime, info.GoVersion))
+			fmt.Printf("features: %s\n", strings.Join(info.Features, ", "))
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&clientOnly, "client", false, "only show the build of slipsctl")
+	return cmd
+}
+
+// formatBuild renders a build as "v1.8.0 (3f0cd10, built 2026-10-16T11:30:00Z, go1.24.11)"
+func formatBuild(version, gitSHA string, buildTime time.Time, goVersion string) string {
+	var details []string
+	if gitSHA != "" {
+		details = append(details, gitSHA[:min(len(gitSHA), 7)])
+	}
+	if !buildTime.IsZero() {
+		details = append(details, "built "+buildTime.UTC().Format(time.RFC3339))
+	}
+	details = append(details, goVersion)
+	return fmt.Sprintf("%s (%s)", version, strings.Join(details, ", "))
+}
@@ -0,0 +1,1701 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: admin/v1/admin.proto
+
+package adminv1
+
+import (
+	v12 "github.com/slips-ai/slips-core/gen/go/savedfilter/v1"
+	v11 "github.com/slips-ai/slips-core/gen/go/tag/v1"
+	v1 "github.com/slips-ai/slips-core/gen/go/task/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// User is a registered user as seen by operators
+type User struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username  string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Email     string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	AvatarUrl string                 `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// anonymized_at is when the profile was replaced with a pseudonym; unset
+	// unless the user was anonymized
+	AnonymizedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=anonymized_at,json=anonymizedAt,proto3" json:"anonymized_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_admin_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *User) GetAnonymizedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnonymizedAt
+	}
+	return nil
+}
+
+// UserCounts summarizes how much data a user owns
+type UserCounts struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Tasks           int32                  `protobuf:"varint,1,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	OpenTasks       int32                  `protobuf:"varint,2,opt,name=open_tasks,json=openTasks,proto3" json:"open_tasks,omitempty"`
+	CompletedTasks  int32                  `protobuf:"varint,3,opt,name=completed_tasks,json=completedTasks,proto3" json:"completed_tasks,omitempty"`
+	ArchivedTasks   int32                  `protobuf:"varint,4,opt,name=archived_tasks,json=archivedTasks,proto3" json:"archived_tasks,omitempty"`
+	Tags            int32                  `protobuf:"varint,5,opt,name=tags,proto3" json:"tags,omitempty"`
+	McpTokens       int32                  `protobuf:"varint,6,opt,name=mcp_tokens,json=mcpTokens,proto3" json:"mcp_tokens,omitempty"`
+	ActiveMcpTokens int32                  `protobuf:"varint,7,opt,name=active_mcp_tokens,json=activeMcpTokens,proto3" json:"active_mcp_tokens,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserCounts) Reset() {
+	*x = UserCounts{}
+	mi := &file_admin_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCounts) ProtoMessage() {}
+
+func (x *UserCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCounts.ProtoReflect.Descriptor instead.
+func (*UserCounts) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserCounts) GetTasks() int32 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *UserCounts) GetOpenTasks() int32 {
+	if x != nil {
+		return x.OpenTasks
+	}
+	return 0
+}
+
+func (x *UserCounts) GetCompletedTasks() int32 {
+	if x != nil {
+		return x.CompletedTasks
+	}
+	return 0
+}
+
+func (x *UserCounts) GetArchivedTasks() int32 {
+	if x != nil {
+		return x.ArchivedTasks
+	}
+	return 0
+}
+
+func (x *UserCounts) GetTags() int32 {
+	if x != nil {
+		return x.Tags
+	}
+	return 0
+}
+
+func (x *UserCounts) GetMcpTokens() int32 {
+	if x != nil {
+		return x.McpTokens
+	}
+	return 0
+}
+
+func (x *UserCounts) GetActiveMcpTokens() int32 {
+	if x != nil {
+		return x.ActiveMcpTokens
+	}
+	return 0
+}
+
+// ListUsersRequest is the request message for listing users
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // opaque token from a previous ListUsersResponse
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListUsersResponse is the response message for listing users
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty when there are no more users
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetUserStatsRequest is the request message for inspecting a user
+type GetUserStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserStatsRequest) Reset() {
+	*x = GetUserStatsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStatsRequest) ProtoMessage() {}
+
+func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserStatsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUserStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetUserStatsResponse is the response message for inspecting a user
+type GetUserStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Counts        *UserCounts            `protobuf:"bytes,2,opt,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserStatsResponse) Reset() {
+	*x = GetUserStatsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStatsResponse) ProtoMessage() {}
+
+func (x *GetUserStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserStatsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetUserStatsResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetUserStatsResponse) GetCounts() *UserCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// RevokeUserMCPTokensRequest is the request message for revoking a user's MCP tokens
+type RevokeUserMCPTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TokenId       *string                `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3,oneof" json:"token_id,omitempty"` // revoke only this token; all active tokens when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserMCPTokensRequest) Reset() {
+	*x = RevokeUserMCPTokensRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserMCPTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserMCPTokensRequest) ProtoMessage() {}
+
+func (x *RevokeUserMCPTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserMCPTokensRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserMCPTokensRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RevokeUserMCPTokensRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeUserMCPTokensRequest) GetTokenId() string {
+	if x != nil && x.TokenId != nil {
+		return *x.TokenId
+	}
+	return ""
+}
+
+// RevokeUserMCPTokensResponse is the response message for revoking a user's MCP tokens
+type RevokeUserMCPTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RevokedCount  int32                  `protobuf:"varint,1,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserMCPTokensResponse) Reset() {
+	*x = RevokeUserMCPTokensResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserMCPTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserMCPTokensResponse) ProtoMessage() {}
+
+func (x *RevokeUserMCPTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserMCPTokensResponse.ProtoReflect.Descriptor instead.
+func (*RevokeUserMCPTokensResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RevokeUserMCPTokensResponse) GetRevokedCount() int32 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+// ExportUserDataRequest is the request message for exporting a user's data
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ExportUserDataResponse contains all of a user's tasks (including archived),
+// tags, saved filters, settings and weekly goal
+type ExportUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExportedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	Tasks         []*v1.Task             `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Tags          []*v11.Tag             `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	NoteRevisions []*v1.NoteRevision     `protobuf:"bytes,5,rep,name=note_revisions,json=noteRevisions,proto3" json:"note_revisions,omitempty"` // earlier notes of the tasks
+	SavedFilters  []*v12.SavedFilter     `protobuf:"bytes,6,rep,name=saved_filters,json=savedFilters,proto3" json:"saved_filters,omitempty"`
+	TaskSettings  *v1.TaskSettings       `protobuf:"bytes,7,opt,name=task_settings,json=taskSettings,proto3" json:"task_settings,omitempty"`  // unset in older exports; restores keep the owner's settings
+	TagSettings   *v11.TagSettings       `protobuf:"bytes,8,opt,name=tag_settings,json=tagSettings,proto3" json:"tag_settings,omitempty"`     // unset in older exports; restores keep the owner's settings
+	WeeklyGoal    *int32                 `protobuf:"varint,9,opt,name=weekly_goal,json=weeklyGoal,proto3,oneof" json:"weekly_goal,omitempty"` // unset when the user has no goal
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportUserDataResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportUserDataResponse) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+func (x *ExportUserDataResponse) GetTasks() []*v1.Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ExportUserDataResponse) GetTags() []*v11.Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ExportUserDataResponse) GetNoteRevisions() []*v1.NoteRevision {
+	if x != nil {
+		return x.NoteRevisions
+	}
+	return nil
+}
+
+func (x *ExportUserDataResponse) GetSavedFilters() []*v12.SavedFilter {
+	if x != nil {
+		return x.SavedFilters
+	}
+	return nil
+}
+
+func (x *ExportUserDataResponse) GetTaskSettings() *v1.TaskSettings {
+	if x != nil {
+		return x.TaskSettings
+	}
+	return nil
+}
+
+func (x *ExportUserDataResponse) GetTagSettings() *v11.TagSettings {
+	if x != nil {
+		return x.TagSettings
+	}
+	return nil
+}
+
+func (x *ExportUserDataResponse) GetWeeklyGoal() int32 {
+	if x != nil && x.WeeklyGoal != nil {
+		return *x.WeeklyGoal
+	}
+	return 0
+}
+
+// RestoreUserDataRequest is the request message for restoring an export
+// made by ExportUserData
+type RestoreUserDataRequest struct {
+	state    protoimpl.MessageState  `protogen:"open.v1"`
+	UserId   string                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // owner to restore into; may differ from snapshot.user_id
+	Snapshot *ExportUserDataResponse `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// validate_only checks the snapshot and reports the restore without
+	// saving anything. created_tag_count and created_saved_filter_count
+	// count the tags and saved filters it would create, which tag_ids and
+	// saved_filter_ids leave out, and the new IDs in task_ids are not kept.
+	ValidateOnly  bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserDataRequest) Reset() {
+	*x = RestoreUserDataRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserDataRequest) ProtoMessage() {}
+
+func (x *RestoreUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserDataRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestoreUserDataRequest) GetSnapshot() *ExportUserDataResponse {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *RestoreUserDataRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+// RestoreUserDataResponse reports the restored tasks, tags and saved filters.
+// The maps key the IDs in the snapshot to the restored ones.
+type RestoreUserDataResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	UserId                  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RestoredTaskCount       int32                  `protobuf:"varint,2,opt,name=restored_task_count,json=restoredTaskCount,proto3" json:"restored_task_count,omitempty"`
+	CreatedTagCount         int32                  `protobuf:"varint,3,opt,name=created_tag_count,json=createdTagCount,proto3" json:"created_tag_count,omitempty"` // tags the owner did not have yet; the others were matched by name
+	TaskIds                 map[string]string      `protobuf:"bytes,4,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TagIds                  map[string]string      `protobuf:"bytes,5,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedSavedFilterCount int32                  `protobuf:"varint,6,opt,name=created_saved_filter_count,json=createdSavedFilterCount,proto3" json:"created_saved_filter_count,omitempty"` // saved filters the owner did not have yet; the others were matched by name
+	SavedFilterIds          map[string]string      `protobuf:"bytes,7,rep,name=saved_filter_ids,json=savedFilterIds,proto3" json:"saved_filter_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RestoreUserDataResponse) Reset() {
+	*x = RestoreUserDataResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserDataResponse) ProtoMessage() {}
+
+func (x *RestoreUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserDataResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreUserDataResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestoreUserDataResponse) GetRestoredTaskCount() int32 {
+	if x != nil {
+		return x.RestoredTaskCount
+	}
+	return 0
+}
+
+func (x *RestoreUserDataResponse) GetCreatedTagCount() int32 {
+	if x != nil {
+		return x.CreatedTagCount
+	}
+	return 0
+}
+
+func (x *RestoreUserDataResponse) GetTaskIds() map[string]string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *RestoreUserDataResponse) GetTagIds() map[string]string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *RestoreUserDataResponse) GetCreatedSavedFilterCount() int32 {
+	if x != nil {
+		return x.CreatedSavedFilterCount
+	}
+	return 0
+}
+
+func (x *RestoreUserDataResponse) GetSavedFilterIds() map[string]string {
+	if x != nil {
+		return x.SavedFilterIds
+	}
+	return nil
+}
+
+// TransferOwnershipRequest is the request message for handing a user's
+// tasks to another user
+type TransferOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    string                 `protobuf:"bytes,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	TaskIds       []string               `protobuf:"bytes,3,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	AllTasks      bool                   `protobuf:"varint,4,opt,name=all_tasks,json=allTasks,proto3" json:"all_tasks,omitempty"` // transfer every task of from_user_id, including archived ones, instead of task_ids
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TransferOwnershipRequest) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *TransferOwnershipRequest) GetAllTasks() bool {
+	if x != nil {
+		return x.AllTasks
+	}
+	return false
+}
+
+// TransferOwnershipResponse reports the transferred tasks, which keep their
+// IDs
+type TransferOwnershipResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TaskIds         []string               `protobuf:"bytes,1,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	CreatedTagCount int32                  `protobuf:"varint,2,opt,name=created_tag_count,json=createdTagCount,proto3" json:"created_tag_count,omitempty"` // tags the recipient did not have yet; the others were matched by name
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipResponse) Reset() {
+	*x = TransferOwnershipResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransferOwnershipResponse) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *TransferOwnershipResponse) GetCreatedTagCount() int32 {
+	if x != nil {
+		return x.CreatedTagCount
+	}
+	return 0
+}
+
+// AnonymizeUserRequest is the request message for anonymizing a user
+type AnonymizeUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserRequest) Reset() {
+	*x = AnonymizeUserRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserRequest) ProtoMessage() {}
+
+func (x *AnonymizeUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserRequest.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AnonymizeUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// AnonymizeUserResponse contains the anonymized user and the counts of the
+// data they still own
+type AnonymizeUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Counts        *UserCounts            `protobuf:"bytes,2,opt,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserResponse) Reset() {
+	*x = AnonymizeUserResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserResponse) ProtoMessage() {}
+
+func (x *AnonymizeUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AnonymizeUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AnonymizeUserResponse) GetCounts() *UserCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// GetLogLevelRequest is the request message for reading the server log level
+type GetLogLevelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLogLevelRequest) Reset() {
+	*x = GetLogLevelRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogLevelRequest) ProtoMessage() {}
+
+func (x *GetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*GetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{16}
+}
+
+// GetLogLevelResponse is the response message for reading the server log level
+type GetLogLevelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"` // "debug", "info", "warn" or "error"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLogLevelResponse) Reset() {
+	*x = GetLogLevelResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogLevelResponse) ProtoMessage() {}
+
+func (x *GetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*GetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetLogLevelResponse) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// SetLogLevelRequest is the request message for changing the server log level
+type SetLogLevelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"` // "debug", "info", "warn" or "error"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// SetLogLevelResponse is the response message for changing the server log level
+type SetLogLevelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	PreviousLevel string                 `protobuf:"bytes,2,opt,name=previous_level,json=previousLevel,proto3" json:"previous_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetLogLevelResponse) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SetLogLevelResponse) GetPreviousLevel() string {
+	if x != nil {
+		return x.PreviousLevel
+	}
+	return ""
+}
+
+// NormalizeTagNamesRequest is the request message for normalizing tag names
+type NormalizeTagNamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // report the changes without applying them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalizeTagNamesRequest) Reset() {
+	*x = NormalizeTagNamesRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeTagNamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeTagNamesRequest) ProtoMessage() {}
+
+func (x *NormalizeTagNamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeTagNamesRequest.ProtoReflect.Descriptor instead.
+func (*NormalizeTagNamesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *NormalizeTagNamesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// TagNameChange is a tag renamed to its normalized name, or merged into
+// another tag of its owner with that name
+type TagNameChange struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TagId   string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	OwnerId string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OldName string                 `protobuf:"bytes,3,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName string                 `protobuf:"bytes,4,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	// merged_into_tag_id is the tag that took over the tasks, feeds and saved
+	// filters of this one, which was deleted; unset when the tag was renamed
+	MergedIntoTagId *string `protobuf:"bytes,5,opt,name=merged_into_tag_id,json=mergedIntoTagId,proto3,oneof" json:"merged_into_tag_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TagNameChange) Reset() {
+	*x = TagNameChange{}
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagNameChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagNameChange) ProtoMessage() {}
+
+func (x *TagNameChange) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagNameChange.ProtoReflect.Descriptor instead.
+func (*TagNameChange) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TagNameChange) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *TagNameChange) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *TagNameChange) GetOldName() string {
+	if x != nil {
+		return x.OldName
+	}
+	return ""
+}
+
+func (x *TagNameChange) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *TagNameChange) GetMergedIntoTagId() string {
+	if x != nil && x.MergedIntoTagId != nil {
+		return *x.MergedIntoTagId
+	}
+	return ""
+}
+
+// NormalizeTagNamesResponse reports the changes to tag names
+type NormalizeTagNamesResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Changes             []*TagNameChange       `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"` // merges first, then renames
+	RenamedCount        int32                  `protobuf:"varint,2,opt,name=renamed_count,json=renamedCount,proto3" json:"renamed_count,omitempty"`
+	MergedCount         int32                  `protobuf:"varint,3,opt,name=merged_count,json=mergedCount,proto3" json:"merged_count,omitempty"`
+	ReassignedTaskCount int64                  `protobuf:"varint,4,opt,name=reassigned_task_count,json=reassignedTaskCount,proto3" json:"reassigned_task_count,omitempty"` // tasks given the tag a duplicate was merged into
+	Applied             bool                   `protobuf:"varint,5,opt,name=applied,proto3" json:"applied,omitempty"`                                                      // false for dry runs
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NormalizeTagNamesResponse) Reset() {
+	*x = NormalizeTagNamesResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeTagNamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeTagNamesResponse) ProtoMessage() {}
+
+func (x *NormalizeTagNamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeTagNamesResponse.ProtoReflect.Descriptor instead.
+func (*NormalizeTagNamesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NormalizeTagNamesResponse) GetChanges() []*TagNameChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *NormalizeTagNamesResponse) GetRenamedCount() int32 {
+	if x != nil {
+		return x.RenamedCount
+	}
+	return 0
+}
+
+func (x *NormalizeTagNamesResponse) GetMergedCount() int32 {
+	if x != nil {
+		return x.MergedCount
+	}
+	return 0
+}
+
+func (x *NormalizeTagNamesResponse) GetReassignedTaskCount() int64 {
+	if x != nil {
+		return x.ReassignedTaskCount
+	}
+	return 0
+}
+
+func (x *NormalizeTagNamesResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+var File_admin_v1_admin_proto protoreflect.FileDescriptor
+
+const file_admin_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x14admin/v1/admin.proto\x12\badmin.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a savedfilter/v1/savedfilter.proto\x1a\x10tag/v1/tag.proto\x1a\x12task/v1/task.proto\"\xb7\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x05 \x01(\tR\tavatarUrl\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12?\n" +
+	"\ranonymized_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fanonymizedAt\"\xf0\x01\n" +
+	"\n" +
+	"UserCounts\x12\x14\n" +
+	"\x05tasks\x18\x01 \x01(\x05R\x05tasks\x12\x1d\n" +
+	"\n" +
+	"open_tasks\x18\x02 \x01(\x05R\topenTasks\x12'\n" +
+	"\x0fcompleted_tasks\x18\x03 \x01(\x05R\x0ecompletedTasks\x12%\n" +
+	"\x0earchived_tasks\x18\x04 \x01(\x05R\rarchivedTasks\x12\x12\n" +
+	"\x04tags\x18\x05 \x01(\x05R\x04tags\x12\x1d\n" +
+	"\n" +
+	"mcp_tokens\x18\x06 \x01(\x05R\tmcpTokens\x12*\n" +
+	"\x11active_mcp_tokens\x18\a \x01(\x05R\x0factiveMcpTokens\"N\n" +
+	"\x10ListUsersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"a\n" +
+	"\x11ListUsersResponse\x12$\n" +
+	"\x05users\x18\x01 \x03(\v2\x0e.admin.v1.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\".\n" +
+	"\x13GetUserStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"h\n" +
+	"\x14GetUserStatsResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.admin.v1.UserR\x04user\x12,\n" +
+	"\x06counts\x18\x02 \x01(\v2\x14.admin.v1.UserCountsR\x06counts\"b\n" +
+	"\x1aRevokeUserMCPTokensRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\btoken_id\x18\x02 \x01(\tH\x00R\atokenId\x88\x01\x01B\v\n" +
+	"\t_token_id\"B\n" +
+	"\x1bRevokeUserMCPTokensResponse\x12#\n" +
+	"\rrevoked_count\x18\x01 \x01(\x05R\frevokedCount\"0\n" +
+	"\x15ExportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xde\x03\n" +
+	"\x16ExportUserDataResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
+	"\vexported_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\x12#\n" +
+	"\x05tasks\x18\x03 \x03(\v2\r.task.v1.TaskR\x05tasks\x12\x1f\n" +
+	"\x04tags\x18\x04 \x03(\v2\v.tag.v1.TagR\x04tags\x12<\n" +
+	"\x0enote_revisions\x18\x05 \x03(\v2\x15.task.v1.NoteRevisionR\rnoteRevisions\x12@\n" +
+	"\rsaved_filters\x18\x06 \x03(\v2\x1b.savedfilter.v1.SavedFilterR\fsavedFilters\x12:\n" +
+	"\rtask_settings\x18\a \x01(\v2\x15.task.v1.TaskSettingsR\ftaskSettings\x126\n" +
+	"\ftag_settings\x18\b \x01(\v2\x13.tag.v1.TagSettingsR\vtagSettings\x12$\n" +
+	"\vweekly_goal\x18\t \x01(\x05H\x00R\n" +
+	"weeklyGoal\x88\x01\x01B\x0e\n" +
+	"\f_weekly_goal\"\x94\x01\n" +
+	"\x16RestoreUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12<\n" +
+	"\bsnapshot\x18\x02 \x01(\v2 .admin.v1.ExportUserDataResponseR\bsnapshot\x12#\n" +
+	"\rvalidate_only\x18\x03 \x01(\bR\fvalidateOnly\"\xf9\x04\n" +
+	"\x17RestoreUserDataResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x13restored_task_count\x18\x02 \x01(\x05R\x11restoredTaskCount\x12*\n" +
+	"\x11created_tag_count\x18\x03 \x01(\x05R\x0fcreatedTagCount\x12I\n" +
+	"\btask_ids\x18\x04 \x03(\v2..admin.v1.RestoreUserDataResponse.TaskIdsEntryR\ataskIds\x12F\n" +
+	"\atag_ids\x18\x05 \x03(\v2-.admin.v1.RestoreUserDataResponse.TagIdsEntryR\x06tagIds\x12;\n" +
+	"\x1acreated_saved_filter_count\x18\x06 \x01(\x05R\x17createdSavedFilterCount\x12_\n" +
+	"\x10saved_filter_ids\x18\a \x03(\v25.admin.v1.RestoreUserDataResponse.SavedFilterIdsEntryR\x0esavedFilterIds\x1a:\n" +
+	"\fTaskIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vTagIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
+	"\x13SavedFilterIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x92\x01\n" +
+	"\x18TransferOwnershipRequest\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\tR\btoUserId\x12\x19\n" +
+	"\btask_ids\x18\x03 \x03(\tR\ataskIds\x12\x1b\n" +
+	"\tall_tasks\x18\x04 \x01(\bR\ballTasks\"b\n" +
+	"\x19TransferOwnershipResponse\x12\x19\n" +
+	"\btask_ids\x18\x01 \x03(\tR\ataskIds\x12*\n" +
+	"\x11created_tag_count\x18\x02 \x01(\x05R\x0fcreatedTagCount\"/\n" +
+	"\x14AnonymizeUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"i\n" +
+	"\x15AnonymizeUserResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.admin.v1.UserR\x04user\x12,\n" +
+	"\x06counts\x18\x02 \x01(\v2\x14.admin.v1.UserCountsR\x06counts\"\x14\n" +
+	"\x12GetLogLevelRequest\"+\n" +
+	"\x13GetLogLevelResponse\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\"*\n" +
+	"\x12SetLogLevelRequest\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\"R\n" +
+	"\x13SetLogLevelResponse\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\x12%\n" +
+	"\x0eprevious_level\x18\x02 \x01(\tR\rpreviousLevel\"3\n" +
+	"\x18NormalizeTagNamesRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"\xc0\x01\n" +
+	"\rTagNameChange\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x19\n" +
+	"\bold_name\x18\x03 \x01(\tR\aoldName\x12\x19\n" +
+	"\bnew_name\x18\x04 \x01(\tR\anewName\x120\n" +
+	"\x12merged_into_tag_id\x18\x05 \x01(\tH\x00R\x0fmergedIntoTagId\x88\x01\x01B\x15\n" +
+	"\x13_merged_into_tag_id\"\xe4\x01\n" +
+	"\x19NormalizeTagNamesResponse\x121\n" +
+	"\achanges\x18\x01 \x03(\v2\x17.admin.v1.TagNameChangeR\achanges\x12#\n" +
+	"\rrenamed_count\x18\x02 \x01(\x05R\frenamedCount\x12!\n" +
+	"\fmerged_count\x18\x03 \x01(\x05R\vmergedCount\x122\n" +
+	"\x15reassigned_task_count\x18\x04 \x01(\x03R\x13reassignedTaskCount\x12\x18\n" +
+	"\aapplied\x18\x05 \x01(\bR\aapplied2\xda\x06\n" +
+	"\fAdminService\x12D\n" +
+	"\tListUsers\x12\x1a.admin.v1.ListUsersRequest\x1a\x1b.admin.v1.ListUsersResponse\x12M\n" +
+	"\fGetUserStats\x12\x1d.admin.v1.GetUserStatsRequest\x1a\x1e.admin.v1.GetUserStatsResponse\x12b\n" +
+	"\x13RevokeUserMCPTokens\x12$.admin.v1.RevokeUserMCPTokensRequest\x1a%.admin.v1.RevokeUserMCPTokensResponse\x12S\n" +
+	"\x0eExportUserData\x12\x1f.admin.v1.ExportUserDataRequest\x1a .admin.v1.ExportUserDataResponse\x12V\n" +
+	"\x0fRestoreUserData\x12 .admin.v1.RestoreUserDataRequest\x1a!.admin.v1.RestoreUserDataResponse\x12\\\n" +
+	"\x11TransferOwnership\x12\".admin.v1.TransferOwnershipRequest\x1a#.admin.v1.TransferOwnershipResponse\x12P\n" +
+	"\rAnonymizeUser\x12\x1e.admin.v1.AnonymizeUserRequest\x1a\x1f.admin.v1.AnonymizeUserResponse\x12J\n" +
+	"\vGetLogLevel\x12\x1c.admin.v1.GetLogLevelRequest\x1a\x1d.admin.v1.GetLogLevelResponse\x12J\n" +
+	"\vSetLogLevel\x12\x1c.admin.v1.SetLogLevelRequest\x1a\x1d.admin.v1.SetLogLevelResponse\x12\\\n" +
+	"\x11NormalizeTagNames\x12\".admin.v1.NormalizeTagNamesRequest\x1a#.admin.v1.NormalizeTagNamesResponseB\x93\x01\n" +
+	"\fcom.admin.v1B\n" +
+	"AdminProtoP\x01Z6github.com/slips-ai/slips-core/gen/go/admin/v1;adminv1\xa2\x02\x03AXX\xaa\x02\bAdmin.V1\xca\x02\bAdmin\\V1\xe2\x02\x14Admin\\V1\\GPBMetadata\xea\x02\tAdmin::V1b\x06proto3"
+
+var (
+	file_admin_v1_admin_proto_rawDescOnce sync.Once
+	file_admin_v1_admin_proto_rawDescData []byte
+)
+
+func file_admin_v1_admin_proto_rawDescGZIP() []byte {
+	file_admin_v1_admin_proto_rawDescOnce.Do(func() {
+		file_admin_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_v1_admin_proto_rawDesc), len(file_admin_v1_admin_proto_rawDesc)))
+	})
+	return file_admin_v1_admin_proto_rawDescData
+}
+
+var file_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_admin_v1_admin_proto_goTypes = []any{
+	(*User)(nil),                        // 0: admin.v1.User
+	(*UserCounts)(nil),                  // 1: admin.v1.UserCounts
+	(*ListUsersRequest)(nil),            // 2: admin.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),           // 3: admin.v1.ListUsersResponse
+	(*GetUserStatsRequest)(nil),         // 4: admin.v1.GetUserStatsRequest
+	(*GetUserStatsResponse)(nil),        // 5: admin.v1.GetUserStatsResponse
+	(*RevokeUserMCPTokensRequest)(nil),  // 6: admin.v1.RevokeUserMCPTokensRequest
+	(*RevokeUserMCPTokensResponse)(nil), // 7: admin.v1.RevokeUserMCPTokensResponse
+	(*ExportUserDataRequest)(nil),       // 8: admin.v1.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),      // 9: admin.v1.ExportUserDataResponse
+	(*RestoreUserDataRequest)(nil),      // 10: admin.v1.RestoreUserDataRequest
+	(*RestoreUserDataResponse)(nil),     // 11: admin.v1.RestoreUserDataResponse
+	(*TransferOwnershipRequest)(nil),    // 12: admin.v1.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil),   // 13: admin.v1.TransferOwnershipResponse
+	(*AnonymizeUserRequest)(nil),        // 14: admin.v1.AnonymizeUserRequest
+	(*AnonymizeUserResponse)(nil),       // 15: admin.v1.AnonymizeUserResponse
+	(*GetLogLevelRequest)(nil),          // 16: admin.v1.GetLogLevelRequest
+	(*GetLogLevelResponse)(nil),         // 17: admin.v1.GetLogLevelResponse
+	(*SetLogLevelRequest)(nil),          // 18: admin.v1.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),         // 19: admin.v1.SetLogLevelResponse
+	(*NormalizeTagNamesRequest)(nil),    // 20: admin.v1.NormalizeTagNamesRequest
+	(*TagNameChange)(nil),               // 21: admin.v1.TagNameChange
+	(*NormalizeTagNamesResponse)(nil),   // 22: admin.v1.NormalizeTagNamesResponse
+	nil,                                 // 23: admin.v1.RestoreUserDataResponse.TaskIdsEntry
+	nil,                                 // 24: admin.v1.RestoreUserDataResponse.TagIdsEntry
+	nil,                                 // 25: admin.v1.RestoreUserDataResponse.SavedFilterIdsEntry
+	(*timestamppb.Timestamp)(nil),       // 26: google.protobuf.Timestamp
+	(*v1.Task)(nil),                     // 27: task.v1.Task
+	(*v11.Tag)(nil),                     // 28: tag.v1.Tag
+	(*v1.NoteRevision)(nil),             // 29: task.v1.NoteRevision
+	(*v12.SavedFilter)(nil),             // 30: savedfilter.v1.SavedFilter
+	(*v1.TaskSettings)(nil),             // 31: task.v1.TaskSettings
+	(*v11.TagSettings)(nil),             // 32: tag.v1.TagSettings
+}
+var file_admin_v1_admin_proto_depIdxs = []int32{
+	26, // 0: admin.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	26, // 1: admin.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 2: admin.v1.User.anonymized_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: admin.v1.ListUsersResponse.users:type_name -> admin.v1.User
+	0,  // 4: admin.v1.GetUserStatsResponse.user:type_name -> admin.v1.User
+	1,  // 5: admin.v1.GetUserStatsResponse.counts:type_name -> admin.v1.UserCounts
+	26, // 6: admin.v1.ExportUserDataResponse.exported_at:type_name -> google.protobuf.Timestamp
+	27, // 7: admin.v1.ExportUserDataResponse.tasks:type_name -> task.v1.Task
+	28, // 8: admin.v1.ExportUserDataResponse.tags:type_name -> tag.v1.Tag
+	29, // 9: admin.v1.ExportUserDataResponse.note_revisions:type_name -> task.v1.NoteRevision
+	30, // 10: admin.v1.ExportUserDataResponse.saved_filters:type_name -> savedfilter.v1.SavedFilter
+	31, // 11: admin.v1.ExportUserDataResponse.task_settings:type_name -> task.v1.TaskSettings
+	32, // 12: admin.v1.ExportUserDataResponse.tag_settings:type_name -> tag.v1.TagSettings
+	9,  // 13: admin.v1.RestoreUserDataRequest.snapshot:type_name -> admin.v1.ExportUserDataResponse
+	23, // 14: admin.v1.RestoreUserDataResponse.task_ids:type_name -> admin.v1.RestoreUserDataResponse.TaskIdsEntry
+	24, // 15: admin.v1.RestoreUserDataResponse.tag_ids:type_name -> admin.v1.RestoreUserDataResponse.TagIdsEntry
+	25, // 16: admin.v1.RestoreUserDataResponse.saved_filter_ids:type_name -> admin.v1.RestoreUserDataResponse.SavedFilterIdsEntry
+	0,  // 17: admin.v1.AnonymizeUserResponse.user:type_name -> admin.v1.User
+	1,  // 18: admin.v1.AnonymizeUserResponse.counts:type_name -> admin.v1.UserCounts
+	21, // 19: admin.v1.NormalizeTagNamesResponse.changes:type_name -> admin.v1.TagNameChange
+	2,  // 20: admin.v1.AdminService.ListUsers:input_type -> admin.v1.ListUsersRequest
+	4,  // 21: admin.v1.AdminService.GetUserStats:input_type -> admin.v1.GetUserStatsRequest
+	6,  // 22: admin.v1.AdminService.RevokeUserMCPTokens:input_type -> admin.v1.RevokeUserMCPTokensRequest
+	8,  // 23: admin.v1.AdminService.ExportUserData:input_type -> admin.v1.ExportUserDataRequest
+	10, // 24: admin.v1.AdminService.RestoreUserData:input_type -> admin.v1.RestoreUserDataRequest
+	12, // 25: admin.v1.AdminService.TransferOwnership:input_type -> admin.v1.TransferOwnershipRequest
+	14, // 26: admin.v1.AdminService.AnonymizeUser:input_type -> admin.v1.AnonymizeUserRequest
+	16, // 27: admin.v1.AdminService.GetLogLevel:input_type -> admin.v1.GetLogLevelRequest
+	18, // 28: admin.v1.AdminService.SetLogLevel:input_type -> admin.v1.SetLogLevelRequest
+	20, // 29: admin.v1.AdminService.NormalizeTagNames:input_type -> admin.v1.NormalizeTagNamesRequest
+	3,  // 30: admin.v1.AdminService.ListUsers:output_type -> admin.v1.ListUsersResponse
+	5,  // 31: admin.v1.AdminService.GetUserStats:output_type -> admin.v1.GetUserStatsResponse
+	7,  // 32: admin.v1.AdminService.RevokeUserMCPTokens:output_type -> admin.v1.RevokeUserMCPTokensResponse
+	9,  // 33: admin.v1.AdminService.ExportUserData:output_type -> admin.v1.ExportUserDataResponse
+	11, // 34: admin.v1.AdminService.RestoreUserData:output_type -> admin.v1.RestoreUserDataResponse
+	13, // 35: admin.v1.AdminService.TransferOwnership:output_type -> admin.v1.TransferOwnershipResponse
+	15, // 36: admin.v1.AdminService.AnonymizeUser:output_type -> admin.v1.AnonymizeUserResponse
+	17, // 37: admin.v1.AdminService.GetLogLevel:output_type -> admin.v1.GetLogLevelResponse
+	19, // 38: admin.v1.AdminService.SetLogLevel:output_type -> admin.v1.SetLogLevelResponse
+	22, // 39: admin.v1.AdminService.NormalizeTagNames:output_type -> admin.v1.NormalizeTagNamesResponse
+	30, // [30:40] is the sub-list for method output_type
+	20, // [20:30] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_admin_v1_admin_proto_init() }
+func file_admin_v1_admin_proto_init() {
+	if File_admin_v1_admin_proto != nil {
+		return
+	}
+	file_admin_v1_admin_proto_msgTypes[6].OneofWrappers = []any{}
+	file_admin_v1_admin_proto_msgTypes[9].OneofWrappers = []any{}
+	file_admin_v1_admin_proto_msgTypes[21].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_admin_proto_rawDesc), len(file_admin_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_v1_admin_proto_goTypes,
+		DependencyIndexes: file_admin_v1_admin_proto_depIdxs,
+		MessageInfos:      file_admin_v1_admin_proto_msgTypes,
+	}.Build()
+	File_admin_v1_admin_proto = out.File
+	file_admin_v1_admin_proto_goTypes = nil
+	file_admin_v1_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,980 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: approval/v1/approval.proto
+
+package approvalv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ApprovalAction is the kind of change an approval holds back
+type ApprovalAction int32
+
+const (
+	ApprovalAction_APPROVAL_ACTION_UNSPECIFIED  ApprovalAction = 0
+	ApprovalAction_APPROVAL_ACTION_DELETE_TASKS ApprovalAction = 1 // deletes the tasks in task_ids
+)
+
+// Enum value maps for ApprovalAction.
+var (
+	ApprovalAction_name = map[int32]string{
+		0: "APPROVAL_ACTION_UNSPECIFIED",
+		1: "APPROVAL_ACTION_DELETE_TASKS",
+	}
+	ApprovalAction_value = map[string]int32{
+		"APPROVAL_ACTION_UNSPECIFIED":  0,
+		"APPROVAL_ACTION_DELETE_TASKS": 1,
+	}
+)
+
+func (x ApprovalAction) Enum() *ApprovalAction {
+	p := new(ApprovalAction)
+	*p = x
+	return p
+}
+
+func (x ApprovalAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_approval_v1_approval_proto_enumTypes[0].Descriptor()
+}
+
+func (ApprovalAction) Type() protoreflect.EnumType {
+	return &file_approval_v1_approval_proto_enumTypes[0]
+}
+
+func (x ApprovalAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalAction.Descriptor instead.
+func (ApprovalAction) EnumDescriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{0}
+}
+
+// ApprovalStatus is where an approval stands
+type ApprovalStatus int32
+
+const (
+	ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED ApprovalStatus = 0 // in list filters, matches every status
+	ApprovalStatus_APPROVAL_STATUS_PENDING     ApprovalStatus = 1
+	ApprovalStatus_APPROVAL_STATUS_APPROVED    ApprovalStatus = 2 // the action was carried out
+	ApprovalStatus_APPROVAL_STATUS_REJECTED    ApprovalStatus = 3 // the action was dropped
+)
+
+// Enum value maps for ApprovalStatus.
+var (
+	ApprovalStatus_name = map[int32]string{
+		0: "APPROVAL_STATUS_UNSPECIFIED",
+		1: "APPROVAL_STATUS_PENDING",
+		2: "APPROVAL_STATUS_APPROVED",
+		3: "APPROVAL_STATUS_REJECTED",
+	}
+	ApprovalStatus_value = map[string]int32{
+		"APPROVAL_STATUS_UNSPECIFIED": 0,
+		"APPROVAL_STATUS_PENDING":     1,
+		"APPROVAL_STATUS_APPROVED":    2,
+		"APPROVAL_STATUS_REJECTED":    3,
+	}
+)
+
+func (x ApprovalStatus) Enum() *ApprovalStatus {
+	p := new(ApprovalStatus)
+	*p = x
+	return p
+}
+
+func (x ApprovalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_approval_v1_approval_proto_enumTypes[1].Descriptor()
+}
+
+func (ApprovalStatus) Type() protoreflect.EnumType {
+	return &file_approval_v1_approval_proto_enumTypes[1]
+}
+
+func (x ApprovalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalStatus.Descriptor instead.
+func (ApprovalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{1}
+}
+
+// Approval is a destructive action requested with an MCP token that waits
+// for the user to approve or reject it
+type Approval struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action               ApprovalAction         `protobuf:"varint,2,opt,name=action,proto3,enum=approval.v1.ApprovalAction" json:"action,omitempty"`
+	TaskIds              []string               `protobuf:"bytes,3,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	RequestedByTokenId   string                 `protobuf:"bytes,4,opt,name=requested_by_token_id,json=requestedByTokenId,proto3" json:"requested_by_token_id,omitempty"` // the MCP token the action was requested with
+	RequestedByTokenName string                 `protobuf:"bytes,5,opt,name=requested_by_token_name,json=requestedByTokenName,proto3" json:"requested_by_token_name,omitempty"`
+	Status               ApprovalStatus         `protobuf:"varint,6,opt,name=status,proto3,enum=approval.v1.ApprovalStatus" json:"status,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"` // set once approved or rejected
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Approval) Reset() {
+	*x = Approval{}
+	mi := &file_approval_v1_approval_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Approval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Approval) ProtoMessage() {}
+
+func (x *Approval) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Approval.ProtoReflect.Descriptor instead.
+func (*Approval) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Approval) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Approval) GetAction() ApprovalAction {
+	if x != nil {
+		return x.Action
+	}
+	return ApprovalAction_APPROVAL_ACTION_UNSPECIFIED
+}
+
+func (x *Approval) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *Approval) GetRequestedByTokenId() string {
+	if x != nil {
+		return x.RequestedByTokenId
+	}
+	return ""
+}
+
+func (x *Approval) GetRequestedByTokenName() string {
+	if x != nil {
+		return x.RequestedByTokenName
+	}
+	return ""
+}
+
+func (x *Approval) GetStatus() ApprovalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
+}
+
+func (x *Approval) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Approval) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+// ApprovalSettings holds the caller's approval preferences
+type ApprovalSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// require_agent_delete_approval holds back DeleteTask calls and delete
+	// mutations of ApplyMutations made with an MCP token until approved
+	RequireAgentDeleteApproval bool `protobuf:"varint,1,opt,name=require_agent_delete_approval,json=requireAgentDeleteApproval,proto3" json:"require_agent_delete_approval,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ApprovalSettings) Reset() {
+	*x = ApprovalSettings{}
+	mi := &file_approval_v1_approval_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalSettings) ProtoMessage() {}
+
+func (x *ApprovalSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalSettings.ProtoReflect.Descriptor instead.
+func (*ApprovalSettings) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApprovalSettings) GetRequireAgentDeleteApproval() bool {
+	if x != nil {
+		return x.RequireAgentDeleteApproval
+	}
+	return false
+}
+
+// GetApprovalRequest is the request message for getting an approval
+type GetApprovalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovalRequest) Reset() {
+	*x = GetApprovalRequest{}
+	mi := &file_approval_v1_approval_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovalRequest) ProtoMessage() {}
+
+func (x *GetApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovalRequest.ProtoReflect.Descriptor instead.
+func (*GetApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetApprovalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetApprovalResponse is the response message for getting an approval
+type GetApprovalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approval      *Approval              `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovalResponse) Reset() {
+	*x = GetApprovalResponse{}
+	mi := &file_approval_v1_approval_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovalResponse) ProtoMessage() {}
+
+func (x *GetApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovalResponse.ProtoReflect.Descriptor instead.
+func (*GetApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetApprovalResponse) GetApproval() *Approval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+// ListApprovalsRequest is the request message for listing approvals
+type ListApprovalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Status        ApprovalStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=approval.v1.ApprovalStatus" json:"status,omitempty"` // unset lists approvals of every status
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalsRequest) Reset() {
+	*x = ListApprovalsRequest{}
+	mi := &file_approval_v1_approval_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalsRequest) ProtoMessage() {}
+
+func (x *ListApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListApprovalsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListApprovalsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListApprovalsRequest) GetStatus() ApprovalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
+}
+
+// ListApprovalsResponse is the response message for listing approvals
+type ListApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*Approval            `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"` // newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalsResponse) Reset() {
+	*x = ListApprovalsResponse{}
+	mi := &file_approval_v1_approval_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalsResponse) ProtoMessage() {}
+
+func (x *ListApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListApprovalsResponse) GetApprovals() []*Approval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *ListApprovalsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ApproveActionRequest is the request message for approving an action
+type ApproveActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveActionRequest) Reset() {
+	*x = ApproveActionRequest{}
+	mi := &file_approval_v1_approval_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveActionRequest) ProtoMessage() {}
+
+func (x *ApproveActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveActionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveActionRequest) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApproveActionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ApproveActionResponse is the response message for approving an action
+type ApproveActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approval      *Approval              `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveActionResponse) Reset() {
+	*x = ApproveActionResponse{}
+	mi := &file_approval_v1_approval_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveActionResponse) ProtoMessage() {}
+
+func (x *ApproveActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveActionResponse.ProtoReflect.Descriptor instead.
+func (*ApproveActionResponse) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApproveActionResponse) GetApproval() *Approval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+// RejectActionRequest is the request message for rejecting an action
+type RejectActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectActionRequest) Reset() {
+	*x = RejectActionRequest{}
+	mi := &file_approval_v1_approval_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectActionRequest) ProtoMessage() {}
+
+func (x *RejectActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectActionRequest.ProtoReflect.Descriptor instead.
+func (*RejectActionRequest) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectActionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RejectActionResponse is the response message for rejecting an action
+type RejectActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approval      *Approval              `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectActionResponse) Reset() {
+	*x = RejectActionResponse{}
+	mi := &file_approval_v1_approval_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectActionResponse) ProtoMessage() {}
+
+func (x *RejectActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectActionResponse.ProtoReflect.Descriptor instead.
+func (*RejectActionResponse) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RejectActionResponse) GetApproval() *Approval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+// GetApprovalSettingsRequest is the request message for getting approval settings
+type GetApprovalSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovalSettingsRequest) Reset() {
+	*x = GetApprovalSettingsRequest{}
+	mi := &file_approval_v1_approval_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovalSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovalSettingsRequest) ProtoMessage() {}
+
+func (x *GetApprovalSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovalSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetApprovalSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{10}
+}
+
+// GetApprovalSettingsResponse is the response message for getting approval settings
+type GetApprovalSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *ApprovalSettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovalSettingsResponse) Reset() {
+	*x = GetApprovalSettingsResponse{}
+	mi := &file_approval_v1_approval_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovalSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovalSettingsResponse) ProtoMessage() {}
+
+func (x *GetApprovalSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovalSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetApprovalSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetApprovalSettingsResponse) GetSettings() *ApprovalSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateApprovalSettingsRequest is the request message for updating approval settings
+type UpdateApprovalSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *ApprovalSettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApprovalSettingsRequest) Reset() {
+	*x = UpdateApprovalSettingsRequest{}
+	mi := &file_approval_v1_approval_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApprovalSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApprovalSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateApprovalSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApprovalSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApprovalSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateApprovalSettingsRequest) GetSettings() *ApprovalSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateApprovalSettingsResponse is the response message for updating approval settings
+type UpdateApprovalSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *ApprovalSettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApprovalSettingsResponse) Reset() {
+	*x = UpdateApprovalSettingsResponse{}
+	mi := &file_approval_v1_approval_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApprovalSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApprovalSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateApprovalSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_v1_approval_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApprovalSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateApprovalSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_approval_v1_approval_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateApprovalSettingsResponse) GetSettings() *ApprovalSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+var File_approval_v1_approval_proto protoreflect.FileDescriptor
+
+const file_approval_v1_approval_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapproval/v1/approval.proto\x12\vapproval.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xff\x02\n" +
+	"\bApproval\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x1b.approval.v1.ApprovalActionR\x06action\x12\x19\n" +
+	"\btask_ids\x18\x03 \x03(\tR\ataskIds\x121\n" +
+	"\x15requested_by_token_id\x18\x04 \x01(\tR\x12requestedByTokenId\x125\n" +
+	"\x17requested_by_token_name\x18\x05 \x01(\tR\x14requestedByTokenName\x123\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1b.approval.v1.ApprovalStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"decided_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\"U\n" +
+	"\x10ApprovalSettings\x12A\n" +
+	"\x1drequire_agent_delete_approval\x18\x01 \x01(\bR\x1arequireAgentDeleteApproval\"$\n" +
+	"\x12GetApprovalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x13GetApprovalResponse\x121\n" +
+	"\bapproval\x18\x01 \x01(\v2\x15.approval.v1.ApprovalR\bapproval\"\x87\x01\n" +
+	"\x14ListApprovalsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.approval.v1.ApprovalStatusR\x06status\"t\n" +
+	"\x15ListApprovalsResponse\x123\n" +
+	"\tapprovals\x18\x01 \x03(\v2\x15.approval.v1.ApprovalR\tapprovals\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"&\n" +
+	"\x14ApproveActionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x15ApproveActionResponse\x121\n" +
+	"\bapproval\x18\x01 \x01(\v2\x15.approval.v1.ApprovalR\bapproval\"%\n" +
+	"\x13RejectActionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
+	"\x14RejectActionResponse\x121\n" +
+	"\bapproval\x18\x01 \x01(\v2\x15.approval.v1.ApprovalR\bapproval\"\x1c\n" +
+	"\x1aGetApprovalSettingsRequest\"X\n" +
+	"\x1bGetApprovalSettingsResponse\x129\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1d.approval.v1.ApprovalSettingsR\bsettings\"Z\n" +
+	"\x1dUpdateApprovalSettingsRequest\x129\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1d.approval.v1.ApprovalSettingsR\bsettings\"[\n" +
+	"\x1eUpdateApprovalSettingsResponse\x129\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1d.approval.v1.ApprovalSettingsR\bsettings*S\n" +
+	"\x0eApprovalAction\x12\x1f\n" +
+	"\x1bAPPROVAL_ACTION_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cAPPROVAL_ACTION_DELETE_TASKS\x10\x01*\x8a\x01\n" +
+	"\x0eApprovalStatus\x12\x1f\n" +
+	"\x1bAPPROVAL_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17APPROVAL_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18APPROVAL_STATUS_APPROVED\x10\x02\x12\x1c\n" +
+	"\x18APPROVAL_STATUS_REJECTED\x10\x032\xc5\x04\n" +
+	"\x0fApprovalService\x12P\n" +
+	"\vGetApproval\x12\x1f.approval.v1.GetApprovalRequest\x1a .approval.v1.GetApprovalResponse\x12V\n" +
+	"\rListApprovals\x12!.approval.v1.ListApprovalsRequest\x1a\".approval.v1.ListApprovalsResponse\x12V\n" +
+	"\rApproveAction\x12!.approval.v1.ApproveActionRequest\x1a\".approval.v1.ApproveActionResponse\x12S\n" +
+	"\fRejectAction\x12 .approval.v1.RejectActionRequest\x1a!.approval.v1.RejectActionResponse\x12h\n" +
+	"\x13GetApprovalSettings\x12'.approval.v1.GetApprovalSettingsRequest\x1a(.approval.v1.GetApprovalSettingsResponse\x12q\n" +
+	"\x16UpdateApprovalSettings\x12*.approval.v1.UpdateApprovalSettingsRequest\x1a+.approval.v1.UpdateApprovalSettingsResponseB\xab\x01\n" +
+	"\x0fcom.approval.v1B\rApprovalProtoP\x01Z<github.com/slips-ai/slips-core/gen/go/approval/v1;approvalv1\xa2\x02\x03AXX\xaa\x02\vApproval.V1\xca\x02\vApproval\\V1\xe2\x02\x17Approval\\V1\\GPBMetadata\xea\x02\fApproval::V1b\x06proto3"
+
+var (
+	file_approval_v1_approval_proto_rawDescOnce sync.Once
+	file_approval_v1_approval_proto_rawDescData []byte
+)
+
+func file_approval_v1_approval_proto_rawDescGZIP() []byte {
+	file_approval_v1_approval_proto_rawDescOnce.Do(func() {
+		file_approval_v1_approval_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_approval_v1_approval_proto_rawDesc), len(file_approval_v1_approval_proto_rawDesc)))
+	})
+	return file_approval_v1_approval_proto_rawDescData
+}
+
+var file_approval_v1_approval_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_approval_v1_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_approval_v1_approval_proto_goTypes = []any{
+	(ApprovalAction)(0),                    // 0: approval.v1.ApprovalAction
+	(ApprovalStatus)(0),                    // 1: approval.v1.ApprovalStatus
+	(*Approval)(nil),                       // 2: approval.v1.Approval
+	(*ApprovalSettings)(nil),               // 3: approval.v1.ApprovalSettings
+	(*GetApprovalRequest)(nil),             // 4: approval.v1.GetApprovalRequest
+	(*GetApprovalResponse)(nil),            // 5: approval.v1.GetApprovalResponse
+	(*ListApprovalsRequest)(nil),           // 6: approval.v1.ListApprovalsRequest
+	(*ListApprovalsResponse)(nil),          // 7: approval.v1.ListApprovalsResponse
+	(*ApproveActionRequest)(nil),           // 8: approval.v1.ApproveActionRequest
+	(*ApproveActionResponse)(nil),          // 9: approval.v1.ApproveActionResponse
+	(*RejectActionRequest)(nil),            // 10: approval.v1.RejectActionRequest
+	(*RejectActionResponse)(nil),           // 11: approval.v1.RejectActionResponse
+	(*GetApprovalSettingsRequest)(nil),     // 12: approval.v1.GetApprovalSettingsRequest
+	(*GetApprovalSettingsResponse)(nil),    // 13: approval.v1.GetApprovalSettingsResponse
+	(*UpdateApprovalSettingsRequest)(nil),  // 14: approval.v1.UpdateApprovalSettingsRequest
+	(*UpdateApprovalSettingsResponse)(nil), // 15: approval.v1.UpdateApprovalSettingsResponse
+	(*timestamppb.Timestamp)(nil),          // 16: google.protobuf.Timestamp
+}
+var file_approval_v1_approval_proto_depIdxs = []int32{
+	0,  // 0: approval.v1.Approval.action:type_name -> approval.v1.ApprovalAction
+	1,  // 1: approval.v1.Approval.status:type_name -> approval.v1.ApprovalStatus
+	16, // 2: approval.v1.Approval.created_at:type_name -> google.protobuf.Timestamp
+	16, // 3: approval.v1.Approval.decided_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: approval.v1.GetApprovalResponse.approval:type_name -> approval.v1.Approval
+	1,  // 5: approval.v1.ListApprovalsRequest.status:type_name -> approval.v1.ApprovalStatus
+	2,  // 6: approval.v1.ListApprovalsResponse.approvals:type_name -> approval.v1.Approval
+	2,  // 7: approval.v1.ApproveActionResponse.approval:type_name -> approval.v1.Approval
+	2,  // 8: approval.v1.RejectActionResponse.approval:type_name -> approval.v1.Approval
+	3,  // 9: approval.v1.GetApprovalSettingsResponse.settings:type_name -> approval.v1.ApprovalSettings
+	3,  // 10: approval.v1.UpdateApprovalSettingsRequest.settings:type_name -> approval.v1.ApprovalSettings
+	3,  // 11: approval.v1.UpdateApprovalSettingsResponse.settings:type_name -> approval.v1.ApprovalSettings
+	4,  // 12: approval.v1.ApprovalService.GetApproval:input_type -> approval.v1.GetApprovalRequest
+	6,  // 13: approval.v1.ApprovalService.ListApprovals:input_type -> approval.v1.ListApprovalsRequest
+	8,  // 14: approval.v1.ApprovalService.ApproveAction:input_type -> approval.v1.ApproveActionRequest
+	10, // 15: approval.v1.ApprovalService.RejectAction:input_type -> approval.v1.RejectActionRequest
+	12, // 16: approval.v1.ApprovalService.GetApprovalSettings:input_type -> approval.v1.GetApprovalSettingsRequest
+	14, // 17: approval.v1.ApprovalService.UpdateApprovalSettings:input_type -> approval.v1.UpdateApprovalSettingsRequest
+	5,  // 18: approval.v1.ApprovalService.GetApproval:output_type -> approval.v1.GetApprovalResponse
+	7,  // 19: approval.v1.ApprovalService.ListApprovals:output_type -> approval.v1.ListApprovalsResponse
+	9,  // 20: approval.v1.ApprovalService.ApproveAction:output_type -> approval.v1.ApproveActionResponse
+	11, // 21: approval.v1.ApprovalService.RejectAction:output_type -> approval.v1.RejectActionResponse
+	13, // 22: approval.v1.ApprovalService.GetApprovalSettings:output_type -> approval.v1.GetApprovalSettingsResponse
+	15, // 23: approval.v1.ApprovalService.UpdateApprovalSettings:output_type -> approval.v1.UpdateApprovalSettingsResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_approval_v1_approval_proto_init() }
+func file_approval_v1_approval_proto_init() {
+	if File_approval_v1_approval_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_approval_v1_approval_proto_rawDesc), len(file_approval_v1_approval_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_approval_v1_approval_proto_goTypes,
+		DependencyIndexes: file_approval_v1_approval_proto_depIdxs,
+		EnumInfos:         file_approval_v1_approval_proto_enumTypes,
+		MessageInfos:      file_approval_v1_approval_proto_msgTypes,
+	}.Build()
+	File_approval_v1_approval_proto = out.File
+	file_approval_v1_approval_proto_goTypes = nil
+	file_approval_v1_approval_proto_depIdxs = nil
+}
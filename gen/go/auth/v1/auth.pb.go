@@ -9,6 +9,7 @@ package authv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,109 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TagMatchMode is how the filter tags of a list request are combined
+type TagMatchMode int32
+
+const (
+	TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED TagMatchMode = 0
+	TagMatchMode_TAG_MATCH_MODE_ANY         TagMatchMode = 1 // tasks carrying any filter tag
+	TagMatchMode_TAG_MATCH_MODE_ALL         TagMatchMode = 2 // tasks carrying every filter tag
+)
+
+// Enum value maps for TagMatchMode.
+var (
+	TagMatchMode_name = map[int32]string{
+		0: "TAG_MATCH_MODE_UNSPECIFIED",
+		1: "TAG_MATCH_MODE_ANY",
+		2: "TAG_MATCH_MODE_ALL",
+	}
+	TagMatchMode_value = map[string]int32{
+		"TAG_MATCH_MODE_UNSPECIFIED": 0,
+		"TAG_MATCH_MODE_ANY":         1,
+		"TAG_MATCH_MODE_ALL":         2,
+	}
+)
+
+func (x TagMatchMode) Enum() *TagMatchMode {
+	p := new(TagMatchMode)
+	*p = x
+	return p
+}
+
+func (x TagMatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagMatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_v1_auth_proto_enumTypes[0].Descriptor()
+}
+
+func (TagMatchMode) Type() protoreflect.EnumType {
+	return &file_auth_v1_auth_proto_enumTypes[0]
+}
+
+func (x TagMatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagMatchMode.Descriptor instead.
+func (TagMatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{0}
+}
+
+// DeviceAuthorizationStatus is the state of a device authorization
+type DeviceAuthorizationStatus int32
+
+const (
+	DeviceAuthorizationStatus_DEVICE_AUTHORIZATION_STATUS_UNSPECIFIED DeviceAuthorizationStatus = 0
+	DeviceAuthorizationStatus_DEVICE_AUTHORIZATION_STATUS_PENDING     DeviceAuthorizationStatus = 1 // the user has not completed OAuth yet
+	DeviceAuthorizationStatus_DEVICE_AUTHORIZATION_STATUS_SLOW_DOWN   DeviceAuthorizationStatus = 2 // polled faster than interval; keep polling, less often
+	DeviceAuthorizationStatus_DEVICE_AUTHORIZATION_STATUS_APPROVED    DeviceAuthorizationStatus = 3 // token and user_info are set
+)
+
+// Enum value maps for DeviceAuthorizationStatus.
+var (
+	DeviceAuthorizationStatus_name = map[int32]string{
+		0: "DEVICE_AUTHORIZATION_STATUS_UNSPECIFIED",
+		1: "DEVICE_AUTHORIZATION_STATUS_PENDING",
+		2: "DEVICE_AUTHORIZATION_STATUS_SLOW_DOWN",
+		3: "DEVICE_AUTHORIZATION_STATUS_APPROVED",
+	}
+	DeviceAuthorizationStatus_value = map[string]int32{
+		"DEVICE_AUTHORIZATION_STATUS_UNSPECIFIED": 0,
+		"DEVICE_AUTHORIZATION_STATUS_PENDING":     1,
+		"DEVICE_AUTHORIZATION_STATUS_SLOW_DOWN":   2,
+		"DEVICE_AUTHORIZATION_STATUS_APPROVED":    3,
+	}
+)
+
+func (x DeviceAuthorizationStatus) Enum() *DeviceAuthorizationStatus {
+	p := new(DeviceAuthorizationStatus)
+	*p = x
+	return p
+}
+
+func (x DeviceAuthorizationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeviceAuthorizationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_v1_auth_proto_enumTypes[1].Descriptor()
+}
+
+func (DeviceAuthorizationStatus) Type() protoreflect.EnumType {
+	return &file_auth_v1_auth_proto_enumTypes[1]
+}
+
+func (x DeviceAuthorizationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeviceAuthorizationStatus.Descriptor instead.
+func (DeviceAuthorizationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{1}
+}
+
 // Token represents OAuth access and refresh tokens
 type Token struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
@@ -100,12 +204,14 @@ func (x *Token) GetTokenType() string {
 
 // UserInfo contains basic user profile information
 type UserInfo struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username       string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	AvatarUrl      string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	Email          string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	TavilyMcpToken string                 `protobuf:"bytes,5,opt,name=tavily_mcp_token,json=tavilyMcpToken,proto3" json:"tavily_mcp_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username  string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	AvatarUrl string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Email     string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	// Masked to its last four characters; use GetTavilyMCPToken to read it
+	TavilyMcpToken string        `protobuf:"bytes,5,opt,name=tavily_mcp_token,json=tavilyMcpToken,proto3" json:"tavily_mcp_token,omitempty"`
+	ListDefaults   *ListDefaults `protobuf:"bytes,6,opt,name=list_defaults,json=listDefaults,proto3" json:"list_defaults,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -175,17 +281,94 @@ func (x *UserInfo) GetTavilyMcpToken() string {
 	return ""
 }
 
+func (x *UserInfo) GetListDefaults() *ListDefaults {
+	if x != nil {
+		return x.ListDefaults
+	}
+	return nil
+}
+
+// ListDefaults are the user's defaults for list parameters that requests
+// omit, shared by all of their devices
+type ListDefaults struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ListTasks page size when a request sets none; 0 uses the server default of 30
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// how ListTasks combines filter_tag_ids when a request does not say
+	TagMatchMode TagMatchMode `protobuf:"varint,2,opt,name=tag_match_mode,json=tagMatchMode,proto3,enum=auth.v1.TagMatchMode" json:"tag_match_mode,omitempty"`
+	// text searches (saved filters with a query) include archived tasks
+	SearchIncludeArchived bool `protobuf:"varint,3,opt,name=search_include_archived,json=searchIncludeArchived,proto3" json:"search_include_archived,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListDefaults) Reset() {
+	*x = ListDefaults{}
+	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDefaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDefaults) ProtoMessage() {}
+
+func (x *ListDefaults) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDefaults.ProtoReflect.Descriptor instead.
+func (*ListDefaults) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDefaults) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDefaults) GetTagMatchMode() TagMatchMode {
+	if x != nil {
+		return x.TagMatchMode
+	}
+	return TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *ListDefaults) GetSearchIncludeArchived() bool {
+	if x != nil {
+		return x.SearchIncludeArchived
+	}
+	return false
+}
+
 // GetAuthorizationURLRequest is the request for initiating OAuth flow
 type GetAuthorizationURLRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // OAuth provider (e.g., "github")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // OAuth provider (e.g., "github")
+	// PKCE (RFC 7636) code challenge for public clients such as mobile apps
+	// and SPAs. HandleCallback then requires the matching code_verifier.
+	CodeChallenge string `protobuf:"bytes,2,opt,name=code_challenge,json=codeChallenge,proto3" json:"code_challenge,omitempty"`
+	// Only "S256" is supported; empty defaults to it
+	CodeChallengeMethod string `protobuf:"bytes,3,opt,name=code_challenge_method,json=codeChallengeMethod,proto3" json:"code_challenge_method,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetAuthorizationURLRequest) Reset() {
 	*x = GetAuthorizationURLRequest{}
-	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	mi := &file_auth_v1_auth_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -197,7 +380,7 @@ func (x *GetAuthorizationURLRequest) String() string {
 func (*GetAuthorizationURLRequest) ProtoMessage() {}
 
 func (x *GetAuthorizationURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	mi := &file_auth_v1_auth_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,7 +393,7 @@ func (x *GetAuthorizationURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuthorizationURLRequest.ProtoReflect.Descriptor instead.
 func (*GetAuthorizationURLRequest) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{2}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetAuthorizationURLRequest) GetProvider() string {
@@ -220,6 +403,20 @@ func (x *GetAuthorizationURLRequest) GetProvider() string {
 	return ""
 }
 
+func (x *GetAuthorizationURLRequest) GetCodeChallenge() string {
+	if x != nil {
+		return x.CodeChallenge
+	}
+	return ""
+}
+
+func (x *GetAuthorizationURLRequest) GetCodeChallengeMethod() string {
+	if x != nil {
+		return x.CodeChallengeMethod
+	}
+	return ""
+}
+
 // GetAuthorizationURLResponse contains the authorization URL
 type GetAuthorizationURLResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -231,7 +428,7 @@ type GetAuthorizationURLResponse struct {
 
 func (x *GetAuthorizationURLResponse) Reset() {
 	*x = GetAuthorizationURLResponse{}
-	mi := &file_auth_v1_auth_proto_msgTypes[3]
+	mi := &file_auth_v1_auth_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -243,7 +440,7 @@ func (x *GetAuthorizationURLResponse) String() string {
 func (*GetAuthorizationURLResponse) ProtoMessage() {}
 
 func (x *GetAuthorizationURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[3]
+	mi := &file_auth_v1_auth_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,7 +453,7 @@ func (x *GetAuthorizationURLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuthorizationURLResponse.ProtoReflect.Descriptor instead.
 func (*GetAuthorizationURLResponse) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{3}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetAuthorizationURLResponse) GetUrl() string {
@@ -275,16 +472,18 @@ func (x *GetAuthorizationURLResponse) GetState() string {
 
 // HandleCallbackRequest processes OAuth callback
 type HandleCallbackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`   // Authorization code from OAuth provider
-	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // State token from GetAuthorizationURL
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`   // Authorization code from OAuth provider
+	State string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // State token from GetAuthorizationURL
+	// PKCE code verifier, required if GetAuthorizationURL got a code_challenge
+	CodeVerifier  string `protobuf:"bytes,3,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HandleCallbackRequest) Reset() {
 	*x = HandleCallbackRequest{}
-	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	mi := &file_auth_v1_auth_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -296,7 +495,7 @@ func (x *HandleCallbackRequest) String() string {
 func (*HandleCallbackRequest) ProtoMessage() {}
 
 func (x *HandleCallbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	mi := &file_auth_v1_auth_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +508,7 @@ func (x *HandleCallbackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandleCallbackRequest.ProtoReflect.Descriptor instead.
 func (*HandleCallbackRequest) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *HandleCallbackRequest) GetCode() string {
@@ -326,18 +525,28 @@ func (x *HandleCallbackRequest) GetState() string {
 	return ""
 }
 
+func (x *HandleCallbackRequest) GetCodeVerifier() string {
+	if x != nil {
+		return x.CodeVerifier
+	}
+	return ""
+}
+
 // HandleCallbackResponse returns tokens and user info
 type HandleCallbackResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         *Token                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	UserInfo      *UserInfo              `protobuf:"bytes,2,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Token    *Token                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserInfo *UserInfo              `protobuf:"bytes,2,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
+	// Set when the callback completed a device authorization. The tokens are
+	// delivered to the polling device instead, so token is empty.
+	DeviceAuthorized bool `protobuf:"varint,3,opt,name=device_authorized,json=deviceAuthorized,proto3" json:"device_authorized,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *HandleCallbackResponse) Reset() {
 	*x = HandleCallbackResponse{}
-	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -349,7 +558,7 @@ func (x *HandleCallbackResponse) String() string {
 func (*HandleCallbackResponse) ProtoMessage() {}
 
 func (x *HandleCallbackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +571,7 @@ func (x *HandleCallbackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandleCallbackResponse.ProtoReflect.Descriptor instead.
 func (*HandleCallbackResponse) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{5}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *HandleCallbackResponse) GetToken() *Token {
@@ -379,6 +588,13 @@ func (x *HandleCallbackResponse) GetUserInfo() *UserInfo {
 	return nil
 }
 
+func (x *HandleCallbackResponse) GetDeviceAuthorized() bool {
+	if x != nil {
+		return x.DeviceAuthorized
+	}
+	return false
+}
+
 // RefreshTokenRequest refreshes an access token
 type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -389,7 +605,7 @@ type RefreshTokenRequest struct {
 
 func (x *RefreshTokenRequest) Reset() {
 	*x = RefreshTokenRequest{}
-	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -401,7 +617,7 @@ func (x *RefreshTokenRequest) String() string {
 func (*RefreshTokenRequest) ProtoMessage() {}
 
 func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,7 +630,7 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{6}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RefreshTokenRequest) GetRefreshToken() string {
@@ -434,7 +650,7 @@ type RefreshTokenResponse struct {
 
 func (x *RefreshTokenResponse) Reset() {
 	*x = RefreshTokenResponse{}
-	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -446,7 +662,7 @@ func (x *RefreshTokenResponse) String() string {
 func (*RefreshTokenResponse) ProtoMessage() {}
 
 func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +675,7 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
 func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{7}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RefreshTokenResponse) GetToken() *Token {
@@ -478,7 +694,7 @@ type GetUserProfileRequest struct {
 
 func (x *GetUserProfileRequest) Reset() {
 	*x = GetUserProfileRequest{}
-	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -490,7 +706,7 @@ func (x *GetUserProfileRequest) String() string {
 func (*GetUserProfileRequest) ProtoMessage() {}
 
 func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +719,7 @@ func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{8}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{9}
 }
 
 // GetUserProfileResponse returns user profile information
@@ -516,7 +732,7 @@ type GetUserProfileResponse struct {
 
 func (x *GetUserProfileResponse) Reset() {
 	*x = GetUserProfileResponse{}
-	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -528,7 +744,7 @@ func (x *GetUserProfileResponse) String() string {
 func (*GetUserProfileResponse) ProtoMessage() {}
 
 func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,7 +757,7 @@ func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
 func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{9}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetUserProfileResponse) GetUserInfo() *UserInfo {
@@ -561,7 +777,7 @@ type UpdateUserProfileRequest struct {
 
 func (x *UpdateUserProfileRequest) Reset() {
 	*x = UpdateUserProfileRequest{}
-	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +789,7 @@ func (x *UpdateUserProfileRequest) String() string {
 func (*UpdateUserProfileRequest) ProtoMessage() {}
 
 func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +802,7 @@ func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserProfileRequest) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{10}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateUserProfileRequest) GetTavilyMcpToken() string {
@@ -606,7 +822,7 @@ type UpdateUserProfileResponse struct {
 
 func (x *UpdateUserProfileResponse) Reset() {
 	*x = UpdateUserProfileResponse{}
-	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	mi := &file_auth_v1_auth_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +834,7 @@ func (x *UpdateUserProfileResponse) String() string {
 func (*UpdateUserProfileResponse) ProtoMessage() {}
 
 func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	mi := &file_auth_v1_auth_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +847,7 @@ func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserProfileResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserProfileResponse) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{11}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateUserProfileResponse) GetUserInfo() *UserInfo {
@@ -641,53 +857,998 @@ func (x *UpdateUserProfileResponse) GetUserInfo() *UserInfo {
 	return nil
 }
 
-var File_auth_v1_auth_proto protoreflect.FileDescriptor
+// GetTavilyMCPTokenRequest retrieves the current user's Tavily MCP token
+type GetTavilyMCPTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_auth_v1_auth_proto_rawDesc = "" +
-	"\n" +
-	"\x12auth/v1/auth.proto\x12\aauth.v1\"\xde\x01\n" +
-	"\x05Token\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x125\n" +
-	"\x17access_token_expires_at\x18\x02 \x01(\x03R\x14accessTokenExpiresAt\x12#\n" +
-	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x127\n" +
-	"\x18refresh_token_expires_at\x18\x04 \x01(\x03R\x15refreshTokenExpiresAt\x12\x1d\n" +
-	"\n" +
-	"token_type\x18\x05 \x01(\tR\ttokenType\"\x9e\x01\n" +
-	"\bUserInfo\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
-	"\n" +
-	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x14\n" +
-	"\x05email\x18\x04 \x01(\tR\x05email\x12(\n" +
-	"\x10tavily_mcp_token\x18\x05 \x01(\tR\x0etavilyMcpToken\"8\n" +
-	"\x1aGetAuthorizationURLRequest\x12\x1a\n" +
-	"\bprovider\x18\x01 \x01(\tR\bprovider\"E\n" +
-	"\x1bGetAuthorizationURLResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
-	"\x05state\x18\x02 \x01(\tR\x05state\"A\n" +
-	"\x15HandleCallbackRequest\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
-	"\x05state\x18\x02 \x01(\tR\x05state\"n\n" +
-	"\x16HandleCallbackResponse\x12$\n" +
-	"\x05token\x18\x01 \x01(\v2\x0e.auth.v1.TokenR\x05token\x12.\n" +
-	"\tuser_info\x18\x02 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo\":\n" +
-	"\x13RefreshTokenRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"<\n" +
-	"\x14RefreshTokenResponse\x12$\n" +
-	"\x05token\x18\x01 \x01(\v2\x0e.auth.v1.TokenR\x05token\"\x17\n" +
-	"\x15GetUserProfileRequest\"H\n" +
-	"\x16GetUserProfileResponse\x12.\n" +
-	"\tuser_info\x18\x01 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo\"D\n" +
-	"\x18UpdateUserProfileRequest\x12(\n" +
-	"\x10tavily_mcp_token\x18\x01 \x01(\tR\x0etavilyMcpToken\"K\n" +
-	"\x19UpdateUserProfileResponse\x12.\n" +
-	"\tuser_info\x18\x01 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo2\xc8\x03\n" +
+func (x *GetTavilyMCPTokenRequest) Reset() {
+	*x = GetTavilyMCPTokenRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTavilyMCPTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTavilyMCPTokenRequest) ProtoMessage() {}
+
+func (x *GetTavilyMCPTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTavilyMCPTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetTavilyMCPTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+// GetTavilyMCPTokenResponse returns the token unmasked
+type GetTavilyMCPTokenResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TavilyMcpToken string                 `protobuf:"bytes,1,opt,name=tavily_mcp_token,json=tavilyMcpToken,proto3" json:"tavily_mcp_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTavilyMCPTokenResponse) Reset() {
+	*x = GetTavilyMCPTokenResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTavilyMCPTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTavilyMCPTokenResponse) ProtoMessage() {}
+
+func (x *GetTavilyMCPTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTavilyMCPTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetTavilyMCPTokenResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTavilyMCPTokenResponse) GetTavilyMcpToken() string {
+	if x != nil {
+		return x.TavilyMcpToken
+	}
+	return ""
+}
+
+// SyncUserProfileRequest refreshes the current user's profile from Identra.
+// Requires a JWT; MCP tokens cannot be used.
+type SyncUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncUserProfileRequest) Reset() {
+	*x = SyncUserProfileRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncUserProfileRequest) ProtoMessage() {}
+
+func (x *SyncUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*SyncUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{15}
+}
+
+// SyncUserProfileResponse returns the refreshed profile
+type SyncUserProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserInfo      *UserInfo              `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncUserProfileResponse) Reset() {
+	*x = SyncUserProfileResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncUserProfileResponse) ProtoMessage() {}
+
+func (x *SyncUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*SyncUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SyncUserProfileResponse) GetUserInfo() *UserInfo {
+	if x != nil {
+		return x.UserInfo
+	}
+	return nil
+}
+
+// UpdateListDefaultsRequest changes the list defaults that are set and
+// leaves the others as they are
+type UpdateListDefaultsRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PageSize              *int32                 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`                                         // 0 to 100; 0 uses the server default
+	TagMatchMode          *TagMatchMode          `protobuf:"varint,2,opt,name=tag_match_mode,json=tagMatchMode,proto3,enum=auth.v1.TagMatchMode,oneof" json:"tag_match_mode,omitempty"` // ANY or ALL
+	SearchIncludeArchived *bool                  `protobuf:"varint,3,opt,name=search_include_archived,json=searchIncludeArchived,proto3,oneof" json:"search_include_archived,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateListDefaultsRequest) Reset() {
+	*x = UpdateListDefaultsRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateListDefaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListDefaultsRequest) ProtoMessage() {}
+
+func (x *UpdateListDefaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListDefaultsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateListDefaultsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateListDefaultsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *UpdateListDefaultsRequest) GetTagMatchMode() TagMatchMode {
+	if x != nil && x.TagMatchMode != nil {
+		return *x.TagMatchMode
+	}
+	return TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *UpdateListDefaultsRequest) GetSearchIncludeArchived() bool {
+	if x != nil && x.SearchIncludeArchived != nil {
+		return *x.SearchIncludeArchived
+	}
+	return false
+}
+
+// UpdateListDefaultsResponse returns the updated list defaults
+type UpdateListDefaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListDefaults  *ListDefaults          `protobuf:"bytes,1,opt,name=list_defaults,json=listDefaults,proto3" json:"list_defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateListDefaultsResponse) Reset() {
+	*x = UpdateListDefaultsResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateListDefaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListDefaultsResponse) ProtoMessage() {}
+
+func (x *UpdateListDefaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateListDefaultsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateListDefaultsResponse) GetListDefaults() *ListDefaults {
+	if x != nil {
+		return x.ListDefaults
+	}
+	return nil
+}
+
+// OnboardingState is the user's progress through the first-run experience,
+// shared across devices
+type OnboardingState struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WelcomeCompleted  bool                   `protobuf:"varint,1,opt,name=welcome_completed,json=welcomeCompleted,proto3" json:"welcome_completed,omitempty"`
+	SampleDataCreated bool                   `protobuf:"varint,2,opt,name=sample_data_created,json=sampleDataCreated,proto3" json:"sample_data_created,omitempty"`
+	FeaturesToured    bool                   `protobuf:"varint,3,opt,name=features_toured,json=featuresToured,proto3" json:"features_toured,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // unset until progress is recorded
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OnboardingState) Reset() {
+	*x = OnboardingState{}
+	mi := &file_auth_v1_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnboardingState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnboardingState) ProtoMessage() {}
+
+func (x *OnboardingState) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnboardingState.ProtoReflect.Descriptor instead.
+func (*OnboardingState) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OnboardingState) GetWelcomeCompleted() bool {
+	if x != nil {
+		return x.WelcomeCompleted
+	}
+	return false
+}
+
+func (x *OnboardingState) GetSampleDataCreated() bool {
+	if x != nil {
+		return x.SampleDataCreated
+	}
+	return false
+}
+
+func (x *OnboardingState) GetFeaturesToured() bool {
+	if x != nil {
+		return x.FeaturesToured
+	}
+	return false
+}
+
+func (x *OnboardingState) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GetOnboardingStateRequest retrieves the current user's onboarding state
+type GetOnboardingStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnboardingStateRequest) Reset() {
+	*x = GetOnboardingStateRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnboardingStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnboardingStateRequest) ProtoMessage() {}
+
+func (x *GetOnboardingStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnboardingStateRequest.ProtoReflect.Descriptor instead.
+func (*GetOnboardingStateRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{20}
+}
+
+// GetOnboardingStateResponse returns the onboarding state
+type GetOnboardingStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Onboarding    *OnboardingState       `protobuf:"bytes,1,opt,name=onboarding,proto3" json:"onboarding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnboardingStateResponse) Reset() {
+	*x = GetOnboardingStateResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnboardingStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnboardingStateResponse) ProtoMessage() {}
+
+func (x *GetOnboardingStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnboardingStateResponse.ProtoReflect.Descriptor instead.
+func (*GetOnboardingStateResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetOnboardingStateResponse) GetOnboarding() *OnboardingState {
+	if x != nil {
+		return x.Onboarding
+	}
+	return nil
+}
+
+// UpdateOnboardingStateRequest sets the given fields; unset fields are kept
+type UpdateOnboardingStateRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WelcomeCompleted  *bool                  `protobuf:"varint,1,opt,name=welcome_completed,json=welcomeCompleted,proto3,oneof" json:"welcome_completed,omitempty"`
+	SampleDataCreated *bool                  `protobuf:"varint,2,opt,name=sample_data_created,json=sampleDataCreated,proto3,oneof" json:"sample_data_created,omitempty"`
+	FeaturesToured    *bool                  `protobuf:"varint,3,opt,name=features_toured,json=featuresToured,proto3,oneof" json:"features_toured,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateOnboardingStateRequest) Reset() {
+	*x = UpdateOnboardingStateRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOnboardingStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOnboardingStateRequest) ProtoMessage() {}
+
+func (x *UpdateOnboardingStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOnboardingStateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOnboardingStateRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateOnboardingStateRequest) GetWelcomeCompleted() bool {
+	if x != nil && x.WelcomeCompleted != nil {
+		return *x.WelcomeCompleted
+	}
+	return false
+}
+
+func (x *UpdateOnboardingStateRequest) GetSampleDataCreated() bool {
+	if x != nil && x.SampleDataCreated != nil {
+		return *x.SampleDataCreated
+	}
+	return false
+}
+
+func (x *UpdateOnboardingStateRequest) GetFeaturesToured() bool {
+	if x != nil && x.FeaturesToured != nil {
+		return *x.FeaturesToured
+	}
+	return false
+}
+
+// UpdateOnboardingStateResponse returns the updated onboarding state
+type UpdateOnboardingStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Onboarding    *OnboardingState       `protobuf:"bytes,1,opt,name=onboarding,proto3" json:"onboarding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOnboardingStateResponse) Reset() {
+	*x = UpdateOnboardingStateResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOnboardingStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOnboardingStateResponse) ProtoMessage() {}
+
+func (x *UpdateOnboardingStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOnboardingStateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOnboardingStateResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateOnboardingStateResponse) GetOnboarding() *OnboardingState {
+	if x != nil {
+		return x.Onboarding
+	}
+	return nil
+}
+
+// StartDeviceAuthorizationRequest starts the OAuth device flow
+type StartDeviceAuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // OAuth provider (e.g., "github")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartDeviceAuthorizationRequest) Reset() {
+	*x = StartDeviceAuthorizationRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartDeviceAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDeviceAuthorizationRequest) ProtoMessage() {}
+
+func (x *StartDeviceAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDeviceAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*StartDeviceAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StartDeviceAuthorizationRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// StartDeviceAuthorizationResponse tells the device what to show the user
+type StartDeviceAuthorizationResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	DeviceCode              string                 `protobuf:"bytes,1,opt,name=device_code,json=deviceCode,proto3" json:"device_code,omitempty"`                                          // Secret the device polls with; never shown to the user
+	UserCode                string                 `protobuf:"bytes,2,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`                                                // Short code the user enters, e.g. "BCDF-GHJK"
+	VerificationUri         string                 `protobuf:"bytes,3,opt,name=verification_uri,json=verificationUri,proto3" json:"verification_uri,omitempty"`                           // Page where the user enters user_code
+	VerificationUriComplete string                 `protobuf:"bytes,4,opt,name=verification_uri_complete,json=verificationUriComplete,proto3" json:"verification_uri_complete,omitempty"` // verification_uri with user_code filled in, e.g. for a QR code
+	ExpiresIn               int64                  `protobuf:"varint,5,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`                                            // Seconds until the codes expire
+	Interval                int64                  `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`                                                               // Minimum seconds between polls
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *StartDeviceAuthorizationResponse) Reset() {
+	*x = StartDeviceAuthorizationResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartDeviceAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDeviceAuthorizationResponse) ProtoMessage() {}
+
+func (x *StartDeviceAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDeviceAuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*StartDeviceAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StartDeviceAuthorizationResponse) GetDeviceCode() string {
+	if x != nil {
+		return x.DeviceCode
+	}
+	return ""
+}
+
+func (x *StartDeviceAuthorizationResponse) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *StartDeviceAuthorizationResponse) GetVerificationUri() string {
+	if x != nil {
+		return x.VerificationUri
+	}
+	return ""
+}
+
+func (x *StartDeviceAuthorizationResponse) GetVerificationUriComplete() string {
+	if x != nil {
+		return x.VerificationUriComplete
+	}
+	return ""
+}
+
+func (x *StartDeviceAuthorizationResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *StartDeviceAuthorizationResponse) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+// GetDeviceVerificationURLRequest looks up the OAuth URL for a user code
+type GetDeviceVerificationURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCode      string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceVerificationURLRequest) Reset() {
+	*x = GetDeviceVerificationURLRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceVerificationURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceVerificationURLRequest) ProtoMessage() {}
+
+func (x *GetDeviceVerificationURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceVerificationURLRequest.ProtoReflect.Descriptor instead.
+func (*GetDeviceVerificationURLRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetDeviceVerificationURLRequest) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+// GetDeviceVerificationURLResponse returns the OAuth URL the browser should open
+type GetDeviceVerificationURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceVerificationURLResponse) Reset() {
+	*x = GetDeviceVerificationURLResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceVerificationURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceVerificationURLResponse) ProtoMessage() {}
+
+func (x *GetDeviceVerificationURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceVerificationURLResponse.ProtoReflect.Descriptor instead.
+func (*GetDeviceVerificationURLResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetDeviceVerificationURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// PollDeviceAuthorizationRequest polls for the device's tokens
+type PollDeviceAuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceCode    string                 `protobuf:"bytes,1,opt,name=device_code,json=deviceCode,proto3" json:"device_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollDeviceAuthorizationRequest) Reset() {
+	*x = PollDeviceAuthorizationRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollDeviceAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollDeviceAuthorizationRequest) ProtoMessage() {}
+
+func (x *PollDeviceAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollDeviceAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*PollDeviceAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PollDeviceAuthorizationRequest) GetDeviceCode() string {
+	if x != nil {
+		return x.DeviceCode
+	}
+	return ""
+}
+
+// PollDeviceAuthorizationResponse returns the tokens once the user has
+// authorized the device. Expired codes fail with FAILED_PRECONDITION and
+// unknown or already used codes with NOT_FOUND.
+type PollDeviceAuthorizationResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Status        DeviceAuthorizationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=auth.v1.DeviceAuthorizationStatus" json:"status,omitempty"`
+	Token         *Token                    `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	UserInfo      *UserInfo                 `protobuf:"bytes,3,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollDeviceAuthorizationResponse) Reset() {
+	*x = PollDeviceAuthorizationResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollDeviceAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollDeviceAuthorizationResponse) ProtoMessage() {}
+
+func (x *PollDeviceAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollDeviceAuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*PollDeviceAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PollDeviceAuthorizationResponse) GetStatus() DeviceAuthorizationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeviceAuthorizationStatus_DEVICE_AUTHORIZATION_STATUS_UNSPECIFIED
+}
+
+func (x *PollDeviceAuthorizationResponse) GetToken() *Token {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *PollDeviceAuthorizationResponse) GetUserInfo() *UserInfo {
+	if x != nil {
+		return x.UserInfo
+	}
+	return nil
+}
+
+var File_auth_v1_auth_proto protoreflect.FileDescriptor
+
+const file_auth_v1_auth_proto_rawDesc = "" +
+	"\n" +
+	"\x12auth/v1/auth.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xde\x01\n" +
+	"\x05Token\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x125\n" +
+	"\x17access_token_expires_at\x18\x02 \x01(\x03R\x14accessTokenExpiresAt\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x127\n" +
+	"\x18refresh_token_expires_at\x18\x04 \x01(\x03R\x15refreshTokenExpiresAt\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x05 \x01(\tR\ttokenType\"\xda\x01\n" +
+	"\bUserInfo\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12(\n" +
+	"\x10tavily_mcp_token\x18\x05 \x01(\tR\x0etavilyMcpToken\x12:\n" +
+	"\rlist_defaults\x18\x06 \x01(\v2\x15.auth.v1.ListDefaultsR\flistDefaults\"\xa0\x01\n" +
+	"\fListDefaults\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12;\n" +
+	"\x0etag_match_mode\x18\x02 \x01(\x0e2\x15.auth.v1.TagMatchModeR\ftagMatchMode\x126\n" +
+	"\x17search_include_archived\x18\x03 \x01(\bR\x15searchIncludeArchived\"\x93\x01\n" +
+	"\x1aGetAuthorizationURLRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12%\n" +
+	"\x0ecode_challenge\x18\x02 \x01(\tR\rcodeChallenge\x122\n" +
+	"\x15code_challenge_method\x18\x03 \x01(\tR\x13codeChallengeMethod\"E\n" +
+	"\x1bGetAuthorizationURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"f\n" +
+	"\x15HandleCallbackRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12#\n" +
+	"\rcode_verifier\x18\x03 \x01(\tR\fcodeVerifier\"\x9b\x01\n" +
+	"\x16HandleCallbackResponse\x12$\n" +
+	"\x05token\x18\x01 \x01(\v2\x0e.auth.v1.TokenR\x05token\x12.\n" +
+	"\tuser_info\x18\x02 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo\x12+\n" +
+	"\x11device_authorized\x18\x03 \x01(\bR\x10deviceAuthorized\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"<\n" +
+	"\x14RefreshTokenResponse\x12$\n" +
+	"\x05token\x18\x01 \x01(\v2\x0e.auth.v1.TokenR\x05token\"\x17\n" +
+	"\x15GetUserProfileRequest\"H\n" +
+	"\x16GetUserProfileResponse\x12.\n" +
+	"\tuser_info\x18\x01 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo\"D\n" +
+	"\x18UpdateUserProfileRequest\x12(\n" +
+	"\x10tavily_mcp_token\x18\x01 \x01(\tR\x0etavilyMcpToken\"K\n" +
+	"\x19UpdateUserProfileResponse\x12.\n" +
+	"\tuser_info\x18\x01 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo\"\x1a\n" +
+	"\x18GetTavilyMCPTokenRequest\"E\n" +
+	"\x19GetTavilyMCPTokenResponse\x12(\n" +
+	"\x10tavily_mcp_token\x18\x01 \x01(\tR\x0etavilyMcpToken\"\x18\n" +
+	"\x16SyncUserProfileRequest\"I\n" +
+	"\x17SyncUserProfileResponse\x12.\n" +
+	"\tuser_info\x18\x01 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo\"\xf9\x01\n" +
+	"\x19UpdateListDefaultsRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05H\x00R\bpageSize\x88\x01\x01\x12@\n" +
+	"\x0etag_match_mode\x18\x02 \x01(\x0e2\x15.auth.v1.TagMatchModeH\x01R\ftagMatchMode\x88\x01\x01\x12;\n" +
+	"\x17search_include_archived\x18\x03 \x01(\bH\x02R\x15searchIncludeArchived\x88\x01\x01B\f\n" +
+	"\n" +
+	"_page_sizeB\x11\n" +
+	"\x0f_tag_match_modeB\x1a\n" +
+	"\x18_search_include_archived\"X\n" +
+	"\x1aUpdateListDefaultsResponse\x12:\n" +
+	"\rlist_defaults\x18\x01 \x01(\v2\x15.auth.v1.ListDefaultsR\flistDefaults\"\xd2\x01\n" +
+	"\x0fOnboardingState\x12+\n" +
+	"\x11welcome_completed\x18\x01 \x01(\bR\x10welcomeCompleted\x12.\n" +
+	"\x13sample_data_created\x18\x02 \x01(\bR\x11sampleDataCreated\x12'\n" +
+	"\x0ffeatures_toured\x18\x03 \x01(\bR\x0efeaturesToured\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x1b\n" +
+	"\x19GetOnboardingStateRequest\"V\n" +
+	"\x1aGetOnboardingStateResponse\x128\n" +
+	"\n" +
+	"onboarding\x18\x01 \x01(\v2\x18.auth.v1.OnboardingStateR\n" +
+	"onboarding\"\xf5\x01\n" +
+	"\x1cUpdateOnboardingStateRequest\x120\n" +
+	"\x11welcome_completed\x18\x01 \x01(\bH\x00R\x10welcomeCompleted\x88\x01\x01\x123\n" +
+	"\x13sample_data_created\x18\x02 \x01(\bH\x01R\x11sampleDataCreated\x88\x01\x01\x12,\n" +
+	"\x0ffeatures_toured\x18\x03 \x01(\bH\x02R\x0efeaturesToured\x88\x01\x01B\x14\n" +
+	"\x12_welcome_completedB\x16\n" +
+	"\x14_sample_data_createdB\x12\n" +
+	"\x10_features_toured\"Y\n" +
+	"\x1dUpdateOnboardingStateResponse\x128\n" +
+	"\n" +
+	"onboarding\x18\x01 \x01(\v2\x18.auth.v1.OnboardingStateR\n" +
+	"onboarding\"=\n" +
+	"\x1fStartDeviceAuthorizationRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"\x82\x02\n" +
+	" StartDeviceAuthorizationResponse\x12\x1f\n" +
+	"\vdevice_code\x18\x01 \x01(\tR\n" +
+	"deviceCode\x12\x1b\n" +
+	"\tuser_code\x18\x02 \x01(\tR\buserCode\x12)\n" +
+	"\x10verification_uri\x18\x03 \x01(\tR\x0fverificationUri\x12:\n" +
+	"\x19verification_uri_complete\x18\x04 \x01(\tR\x17verificationUriComplete\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x05 \x01(\x03R\texpiresIn\x12\x1a\n" +
+	"\binterval\x18\x06 \x01(\x03R\binterval\">\n" +
+	"\x1fGetDeviceVerificationURLRequest\x12\x1b\n" +
+	"\tuser_code\x18\x01 \x01(\tR\buserCode\"4\n" +
+	" GetDeviceVerificationURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"A\n" +
+	"\x1ePollDeviceAuthorizationRequest\x12\x1f\n" +
+	"\vdevice_code\x18\x01 \x01(\tR\n" +
+	"deviceCode\"\xb3\x01\n" +
+	"\x1fPollDeviceAuthorizationResponse\x12:\n" +
+	"\x06status\x18\x01 \x01(\x0e2\".auth.v1.DeviceAuthorizationStatusR\x06status\x12$\n" +
+	"\x05token\x18\x02 \x01(\v2\x0e.auth.v1.TokenR\x05token\x12.\n" +
+	"\tuser_info\x18\x03 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo*^\n" +
+	"\fTagMatchMode\x12\x1e\n" +
+	"\x1aTAG_MATCH_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TAG_MATCH_MODE_ANY\x10\x01\x12\x16\n" +
+	"\x12TAG_MATCH_MODE_ALL\x10\x02*\xc6\x01\n" +
+	"\x19DeviceAuthorizationStatus\x12+\n" +
+	"'DEVICE_AUTHORIZATION_STATUS_UNSPECIFIED\x10\x00\x12'\n" +
+	"#DEVICE_AUTHORIZATION_STATUS_PENDING\x10\x01\x12)\n" +
+	"%DEVICE_AUTHORIZATION_STATUS_SLOW_DOWN\x10\x02\x12(\n" +
+	"$DEVICE_AUTHORIZATION_STATUS_APPROVED\x10\x032\x80\n" +
+	"\n" +
 	"\vAuthService\x12b\n" +
 	"\x13GetAuthorizationURL\x12#.auth.v1.GetAuthorizationURLRequest\x1a$.auth.v1.GetAuthorizationURLResponse\"\x00\x12S\n" +
 	"\x0eHandleCallback\x12\x1e.auth.v1.HandleCallbackRequest\x1a\x1f.auth.v1.HandleCallbackResponse\"\x00\x12M\n" +
 	"\fRefreshToken\x12\x1c.auth.v1.RefreshTokenRequest\x1a\x1d.auth.v1.RefreshTokenResponse\"\x00\x12S\n" +
 	"\x0eGetUserProfile\x12\x1e.auth.v1.GetUserProfileRequest\x1a\x1f.auth.v1.GetUserProfileResponse\"\x00\x12\\\n" +
-	"\x11UpdateUserProfile\x12!.auth.v1.UpdateUserProfileRequest\x1a\".auth.v1.UpdateUserProfileResponse\"\x00B\x8b\x01\n" +
+	"\x11UpdateUserProfile\x12!.auth.v1.UpdateUserProfileRequest\x1a\".auth.v1.UpdateUserProfileResponse\"\x00\x12V\n" +
+	"\x0fSyncUserProfile\x12\x1f.auth.v1.SyncUserProfileRequest\x1a .auth.v1.SyncUserProfileResponse\"\x00\x12_\n" +
+	"\x12UpdateListDefaults\x12\".auth.v1.UpdateListDefaultsRequest\x1a#.auth.v1.UpdateListDefaultsResponse\"\x00\x12\\\n" +
+	"\x11GetTavilyMCPToken\x12!.auth.v1.GetTavilyMCPTokenRequest\x1a\".auth.v1.GetTavilyMCPTokenResponse\"\x00\x12_\n" +
+	"\x12GetOnboardingState\x12\".auth.v1.GetOnboardingStateRequest\x1a#.auth.v1.GetOnboardingStateResponse\"\x00\x12h\n" +
+	"\x15UpdateOnboardingState\x12%.auth.v1.UpdateOnboardingStateRequest\x1a&.auth.v1.UpdateOnboardingStateResponse\"\x00\x12q\n" +
+	"\x18StartDeviceAuthorization\x12(.auth.v1.StartDeviceAuthorizationRequest\x1a).auth.v1.StartDeviceAuthorizationResponse\"\x00\x12q\n" +
+	"\x18GetDeviceVerificationURL\x12(.auth.v1.GetDeviceVerificationURLRequest\x1a).auth.v1.GetDeviceVerificationURLResponse\"\x00\x12n\n" +
+	"\x17PollDeviceAuthorization\x12'.auth.v1.PollDeviceAuthorizationRequest\x1a(.auth.v1.PollDeviceAuthorizationResponse\"\x00B\x8b\x01\n" +
 	"\vcom.auth.v1B\tAuthProtoP\x01Z4github.com/slips-ai/slips-core/gen/go/auth/v1;authv1\xa2\x02\x03AXX\xaa\x02\aAuth.V1\xca\x02\aAuth\\V1\xe2\x02\x13Auth\\V1\\GPBMetadata\xea\x02\bAuth::V1b\x06proto3"
 
 var (
@@ -702,42 +1863,91 @@ func file_auth_v1_auth_proto_rawDescGZIP() []byte {
 	return file_auth_v1_auth_proto_rawDescData
 }
 
-var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_auth_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_auth_v1_auth_proto_goTypes = []any{
-	(*Token)(nil),                       // 0: auth.v1.Token
-	(*UserInfo)(nil),                    // 1: auth.v1.UserInfo
-	(*GetAuthorizationURLRequest)(nil),  // 2: auth.v1.GetAuthorizationURLRequest
-	(*GetAuthorizationURLResponse)(nil), // 3: auth.v1.GetAuthorizationURLResponse
-	(*HandleCallbackRequest)(nil),       // 4: auth.v1.HandleCallbackRequest
-	(*HandleCallbackResponse)(nil),      // 5: auth.v1.HandleCallbackResponse
-	(*RefreshTokenRequest)(nil),         // 6: auth.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),        // 7: auth.v1.RefreshTokenResponse
-	(*GetUserProfileRequest)(nil),       // 8: auth.v1.GetUserProfileRequest
-	(*GetUserProfileResponse)(nil),      // 9: auth.v1.GetUserProfileResponse
-	(*UpdateUserProfileRequest)(nil),    // 10: auth.v1.UpdateUserProfileRequest
-	(*UpdateUserProfileResponse)(nil),   // 11: auth.v1.UpdateUserProfileResponse
+	(TagMatchMode)(0),                        // 0: auth.v1.TagMatchMode
+	(DeviceAuthorizationStatus)(0),           // 1: auth.v1.DeviceAuthorizationStatus
+	(*Token)(nil),                            // 2: auth.v1.Token
+	(*UserInfo)(nil),                         // 3: auth.v1.UserInfo
+	(*ListDefaults)(nil),                     // 4: auth.v1.ListDefaults
+	(*GetAuthorizationURLRequest)(nil),       // 5: auth.v1.GetAuthorizationURLRequest
+	(*GetAuthorizationURLResponse)(nil),      // 6: auth.v1.GetAuthorizationURLResponse
+	(*HandleCallbackRequest)(nil),            // 7: auth.v1.HandleCallbackRequest
+	(*HandleCallbackResponse)(nil),           // 8: auth.v1.HandleCallbackResponse
+	(*RefreshTokenRequest)(nil),              // 9: auth.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),             // 10: auth.v1.RefreshTokenResponse
+	(*GetUserProfileRequest)(nil),            // 11: auth.v1.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),           // 12: auth.v1.GetUserProfileResponse
+	(*UpdateUserProfileRequest)(nil),         // 13: auth.v1.UpdateUserProfileRequest
+	(*UpdateUserProfileResponse)(nil),        // 14: auth.v1.UpdateUserProfileResponse
+	(*GetTavilyMCPTokenRequest)(nil),         // 15: auth.v1.GetTavilyMCPTokenRequest
+	(*GetTavilyMCPTokenResponse)(nil),        // 16: auth.v1.GetTavilyMCPTokenResponse
+	(*SyncUserProfileRequest)(nil),           // 17: auth.v1.SyncUserProfileRequest
+	(*SyncUserProfileResponse)(nil),          // 18: auth.v1.SyncUserProfileResponse
+	(*UpdateListDefaultsRequest)(nil),        // 19: auth.v1.UpdateListDefaultsRequest
+	(*UpdateListDefaultsResponse)(nil),       // 20: auth.v1.UpdateListDefaultsResponse
+	(*OnboardingState)(nil),                  // 21: auth.v1.OnboardingState
+	(*GetOnboardingStateRequest)(nil),        // 22: auth.v1.GetOnboardingStateRequest
+	(*GetOnboardingStateResponse)(nil),       // 23: auth.v1.GetOnboardingStateResponse
+	(*UpdateOnboardingStateRequest)(nil),     // 24: auth.v1.UpdateOnboardingStateRequest
+	(*UpdateOnboardingStateResponse)(nil),    // 25: auth.v1.UpdateOnboardingStateResponse
+	(*StartDeviceAuthorizationRequest)(nil),  // 26: auth.v1.StartDeviceAuthorizationRequest
+	(*StartDeviceAuthorizationResponse)(nil), // 27: auth.v1.StartDeviceAuthorizationResponse
+	(*GetDeviceVerificationURLRequest)(nil),  // 28: auth.v1.GetDeviceVerificationURLRequest
+	(*GetDeviceVerificationURLResponse)(nil), // 29: auth.v1.GetDeviceVerificationURLResponse
+	(*PollDeviceAuthorizationRequest)(nil),   // 30: auth.v1.PollDeviceAuthorizationRequest
+	(*PollDeviceAuthorizationResponse)(nil),  // 31: auth.v1.PollDeviceAuthorizationResponse
+	(*timestamppb.Timestamp)(nil),            // 32: google.protobuf.Timestamp
 }
 var file_auth_v1_auth_proto_depIdxs = []int32{
-	0,  // 0: auth.v1.HandleCallbackResponse.token:type_name -> auth.v1.Token
-	1,  // 1: auth.v1.HandleCallbackResponse.user_info:type_name -> auth.v1.UserInfo
-	0,  // 2: auth.v1.RefreshTokenResponse.token:type_name -> auth.v1.Token
-	1,  // 3: auth.v1.GetUserProfileResponse.user_info:type_name -> auth.v1.UserInfo
-	1,  // 4: auth.v1.UpdateUserProfileResponse.user_info:type_name -> auth.v1.UserInfo
-	2,  // 5: auth.v1.AuthService.GetAuthorizationURL:input_type -> auth.v1.GetAuthorizationURLRequest
-	4,  // 6: auth.v1.AuthService.HandleCallback:input_type -> auth.v1.HandleCallbackRequest
-	6,  // 7: auth.v1.AuthService.RefreshToken:input_type -> auth.v1.RefreshTokenRequest
-	8,  // 8: auth.v1.AuthService.GetUserProfile:input_type -> auth.v1.GetUserProfileRequest
-	10, // 9: auth.v1.AuthService.UpdateUserProfile:input_type -> auth.v1.UpdateUserProfileRequest
-	3,  // 10: auth.v1.AuthService.GetAuthorizationURL:output_type -> auth.v1.GetAuthorizationURLResponse
-	5,  // 11: auth.v1.AuthService.HandleCallback:output_type -> auth.v1.HandleCallbackResponse
-	7,  // 12: auth.v1.AuthService.RefreshToken:output_type -> auth.v1.RefreshTokenResponse
-	9,  // 13: auth.v1.AuthService.GetUserProfile:output_type -> auth.v1.GetUserProfileResponse
-	11, // 14: auth.v1.AuthService.UpdateUserProfile:output_type -> auth.v1.UpdateUserProfileResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	4,  // 0: auth.v1.UserInfo.list_defaults:type_name -> auth.v1.ListDefaults
+	0,  // 1: auth.v1.ListDefaults.tag_match_mode:type_name -> auth.v1.TagMatchMode
+	2,  // 2: auth.v1.HandleCallbackResponse.token:type_name -> auth.v1.Token
+	3,  // 3: auth.v1.HandleCallbackResponse.user_info:type_name -> auth.v1.UserInfo
+	2,  // 4: auth.v1.RefreshTokenResponse.token:type_name -> auth.v1.Token
+	3,  // 5: auth.v1.GetUserProfileResponse.user_info:type_name -> auth.v1.UserInfo
+	3,  // 6: auth.v1.UpdateUserProfileResponse.user_info:type_name -> auth.v1.UserInfo
+	3,  // 7: auth.v1.SyncUserProfileResponse.user_info:type_name -> auth.v1.UserInfo
+	0,  // 8: auth.v1.UpdateListDefaultsRequest.tag_match_mode:type_name -> auth.v1.TagMatchMode
+	4,  // 9: auth.v1.UpdateListDefaultsResponse.list_defaults:type_name -> auth.v1.ListDefaults
+	32, // 10: auth.v1.OnboardingState.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 11: auth.v1.GetOnboardingStateResponse.onboarding:type_name -> auth.v1.OnboardingState
+	21, // 12: auth.v1.UpdateOnboardingStateResponse.onboarding:type_name -> auth.v1.OnboardingState
+	1,  // 13: auth.v1.PollDeviceAuthorizationResponse.status:type_name -> auth.v1.DeviceAuthorizationStatus
+	2,  // 14: auth.v1.PollDeviceAuthorizationResponse.token:type_name -> auth.v1.Token
+	3,  // 15: auth.v1.PollDeviceAuthorizationResponse.user_info:type_name -> auth.v1.UserInfo
+	5,  // 16: auth.v1.AuthService.GetAuthorizationURL:input_type -> auth.v1.GetAuthorizationURLRequest
+	7,  // 17: auth.v1.AuthService.HandleCallback:input_type -> auth.v1.HandleCallbackRequest
+	9,  // 18: auth.v1.AuthService.RefreshToken:input_type -> auth.v1.RefreshTokenRequest
+	11, // 19: auth.v1.AuthService.GetUserProfile:input_type -> auth.v1.GetUserProfileRequest
+	13, // 20: auth.v1.AuthService.UpdateUserProfile:input_type -> auth.v1.UpdateUserProfileRequest
+	17, // 21: auth.v1.AuthService.SyncUserProfile:input_type -> auth.v1.SyncUserProfileRequest
+	19, // 22: auth.v1.AuthService.UpdateListDefaults:input_type -> auth.v1.UpdateListDefaultsRequest
+	15, // 23: auth.v1.AuthService.GetTavilyMCPToken:input_type -> auth.v1.GetTavilyMCPTokenRequest
+	22, // 24: auth.v1.AuthService.GetOnboardingState:input_type -> auth.v1.GetOnboardingStateRequest
+	24, // 25: auth.v1.AuthService.UpdateOnboardingState:input_type -> auth.v1.UpdateOnboardingStateRequest
+	26, // 26: auth.v1.AuthService.StartDeviceAuthorization:input_type -> auth.v1.StartDeviceAuthorizationRequest
+	28, // 27: auth.v1.AuthService.GetDeviceVerificationURL:input_type -> auth.v1.GetDeviceVerificationURLRequest
+	30, // 28: auth.v1.AuthService.PollDeviceAuthorization:input_type -> auth.v1.PollDeviceAuthorizationRequest
+	6,  // 29: auth.v1.AuthService.GetAuthorizationURL:output_type -> auth.v1.GetAuthorizationURLResponse
+	8,  // 30: auth.v1.AuthService.HandleCallback:output_type -> auth.v1.HandleCallbackResponse
+	10, // 31: auth.v1.AuthService.RefreshToken:output_type -> auth.v1.RefreshTokenResponse
+	12, // 32: auth.v1.AuthService.GetUserProfile:output_type -> auth.v1.GetUserProfileResponse
+	14, // 33: auth.v1.AuthService.UpdateUserProfile:output_type -> auth.v1.UpdateUserProfileResponse
+	18, // 34: auth.v1.AuthService.SyncUserProfile:output_type -> auth.v1.SyncUserProfileResponse
+	20, // 35: auth.v1.AuthService.UpdateListDefaults:output_type -> auth.v1.UpdateListDefaultsResponse
+	16, // 36: auth.v1.AuthService.GetTavilyMCPToken:output_type -> auth.v1.GetTavilyMCPTokenResponse
+	23, // 37: auth.v1.AuthService.GetOnboardingState:output_type -> auth.v1.GetOnboardingStateResponse
+	25, // 38: auth.v1.AuthService.UpdateOnboardingState:output_type -> auth.v1.UpdateOnboardingStateResponse
+	27, // 39: auth.v1.AuthService.StartDeviceAuthorization:output_type -> auth.v1.StartDeviceAuthorizationResponse
+	29, // 40: auth.v1.AuthService.GetDeviceVerificationURL:output_type -> auth.v1.GetDeviceVerificationURLResponse
+	31, // 41: auth.v1.AuthService.PollDeviceAuthorization:output_type -> auth.v1.PollDeviceAuthorizationResponse
+	29, // [29:42] is the sub-list for method output_type
+	16, // [16:29] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_auth_v1_auth_proto_init() }
@@ -745,18 +1955,21 @@ func file_auth_v1_auth_proto_init() {
 	if File_auth_v1_auth_proto != nil {
 		return
 	}
+	file_auth_v1_auth_proto_msgTypes[17].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[22].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_auth_proto_rawDesc), len(file_auth_v1_auth_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      2,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_auth_v1_auth_proto_goTypes,
 		DependencyIndexes: file_auth_v1_auth_proto_depIdxs,
+		EnumInfos:         file_auth_v1_auth_proto_enumTypes,
 		MessageInfos:      file_auth_v1_auth_proto_msgTypes,
 	}.Build()
 	File_auth_v1_auth_proto = out.File
@@ -19,11 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_GetAuthorizationURL_FullMethodName = "/auth.v1.AuthService/GetAuthorizationURL"
-	AuthService_HandleCallback_FullMethodName      = "/auth.v1.AuthService/HandleCallback"
-	AuthService_RefreshToken_FullMethodName        = "/auth.v1.AuthService/RefreshToken"
-	AuthService_GetUserProfile_FullMethodName      = "/auth.v1.AuthService/GetUserProfile"
-	AuthService_UpdateUserProfile_FullMethodName   = "/auth.v1.AuthService/UpdateUserProfile"
+	AuthService_GetAuthorizationURL_FullMethodName      = "/auth.v1.AuthService/GetAuthorizationURL"
+	AuthService_HandleCallback_FullMethodName           = "/auth.v1.AuthService/HandleCallback"
+	AuthService_RefreshToken_FullMethodName             = "/auth.v1.AuthService/RefreshToken"
+	AuthService_GetUserProfile_FullMethodName           = "/auth.v1.AuthService/GetUserProfile"
+	AuthService_UpdateUserProfile_FullMethodName        = "/auth.v1.AuthService/UpdateUserProfile"
+	AuthService_SyncUserProfile_FullMethodName          = "/auth.v1.AuthService/SyncUserProfile"
+	AuthService_UpdateListDefaults_FullMethodName       = "/auth.v1.AuthService/UpdateListDefaults"
+	AuthService_GetTavilyMCPToken_FullMethodName        = "/auth.v1.AuthService/GetTavilyMCPToken"
+	AuthService_GetOnboardingState_FullMethodName       = "/auth.v1.AuthService/GetOnboardingState"
+	AuthService_UpdateOnboardingState_FullMethodName    = "/auth.v1.AuthService/UpdateOnboardingState"
+	AuthService_StartDeviceAuthorization_FullMethodName = "/auth.v1.AuthService/StartDeviceAuthorization"
+	AuthService_GetDeviceVerificationURL_FullMethodName = "/auth.v1.AuthService/GetDeviceVerificationURL"
+	AuthService_PollDeviceAuthorization_FullMethodName  = "/auth.v1.AuthService/PollDeviceAuthorization"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -37,6 +45,14 @@ type AuthServiceClient interface {
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
 	GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...grpc.CallOption) (*GetUserProfileResponse, error)
 	UpdateUserProfile(ctx context.Context, in *UpdateUserProfileRequest, opts ...grpc.CallOption) (*UpdateUserProfileResponse, error)
+	SyncUserProfile(ctx context.Context, in *SyncUserProfileRequest, opts ...grpc.CallOption) (*SyncUserProfileResponse, error)
+	UpdateListDefaults(ctx context.Context, in *UpdateListDefaultsRequest, opts ...grpc.CallOption) (*UpdateListDefaultsResponse, error)
+	GetTavilyMCPToken(ctx context.Context, in *GetTavilyMCPTokenRequest, opts ...grpc.CallOption) (*GetTavilyMCPTokenResponse, error)
+	GetOnboardingState(ctx context.Context, in *GetOnboardingStateRequest, opts ...grpc.CallOption) (*GetOnboardingStateResponse, error)
+	UpdateOnboardingState(ctx context.Context, in *UpdateOnboardingStateRequest, opts ...grpc.CallOption) (*UpdateOnboardingStateResponse, error)
+	StartDeviceAuthorization(ctx context.Context, in *StartDeviceAuthorizationRequest, opts ...grpc.CallOption) (*StartDeviceAuthorizationResponse, error)
+	GetDeviceVerificationURL(ctx context.Context, in *GetDeviceVerificationURLRequest, opts ...grpc.CallOption) (*GetDeviceVerificationURLResponse, error)
+	PollDeviceAuthorization(ctx context.Context, in *PollDeviceAuthorizationRequest, opts ...grpc.CallOption) (*PollDeviceAuthorizationResponse, error)
 }
 
 type authServiceClient struct {
@@ -97,6 +113,86 @@ func (c *authServiceClient) UpdateUserProfile(ctx context.Context, in *UpdateUse
 	return out, nil
 }
 
+func (c *authServiceClient) SyncUserProfile(ctx context.Context, in *SyncUserProfileRequest, opts ...grpc.CallOption) (*SyncUserProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncUserProfileResponse)
+	err := c.cc.Invoke(ctx, AuthService_SyncUserProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdateListDefaults(ctx context.Context, in *UpdateListDefaultsRequest, opts ...grpc.CallOption) (*UpdateListDefaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateListDefaultsResponse)
+	err := c.cc.Invoke(ctx, AuthService_UpdateListDefaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetTavilyMCPToken(ctx context.Context, in *GetTavilyMCPTokenRequest, opts ...grpc.CallOption) (*GetTavilyMCPTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTavilyMCPTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetTavilyMCPToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetOnboardingState(ctx context.Context, in *GetOnboardingStateRequest, opts ...grpc.CallOption) (*GetOnboardingStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOnboardingStateResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetOnboardingState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdateOnboardingState(ctx context.Context, in *UpdateOnboardingStateRequest, opts ...grpc.CallOption) (*UpdateOnboardingStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOnboardingStateResponse)
+	err := c.cc.Invoke(ctx, AuthService_UpdateOnboardingState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) StartDeviceAuthorization(ctx context.Context, in *StartDeviceAuthorizationRequest, opts ...grpc.CallOption) (*StartDeviceAuthorizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartDeviceAuthorizationResponse)
+	err := c.cc.Invoke(ctx, AuthService_StartDeviceAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetDeviceVerificationURL(ctx context.Context, in *GetDeviceVerificationURLRequest, opts ...grpc.CallOption) (*GetDeviceVerificationURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeviceVerificationURLResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetDeviceVerificationURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) PollDeviceAuthorization(ctx context.Context, in *PollDeviceAuthorizationRequest, opts ...grpc.CallOption) (*PollDeviceAuthorizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PollDeviceAuthorizationResponse)
+	err := c.cc.Invoke(ctx, AuthService_PollDeviceAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -108,6 +204,14 @@ type AuthServiceServer interface {
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
 	GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error)
 	UpdateUserProfile(context.Context, *UpdateUserProfileRequest) (*UpdateUserProfileResponse, error)
+	SyncUserProfile(context.Context, *SyncUserProfileRequest) (*SyncUserProfileResponse, error)
+	UpdateListDefaults(context.Context, *UpdateListDefaultsRequest) (*UpdateListDefaultsResponse, error)
+	GetTavilyMCPToken(context.Context, *GetTavilyMCPTokenRequest) (*GetTavilyMCPTokenResponse, error)
+	GetOnboardingState(context.Context, *GetOnboardingStateRequest) (*GetOnboardingStateResponse, error)
+	UpdateOnboardingState(context.Context, *UpdateOnboardingStateRequest) (*UpdateOnboardingStateResponse, error)
+	StartDeviceAuthorization(context.Context, *StartDeviceAuthorizationRequest) (*StartDeviceAuthorizationResponse, error)
+	GetDeviceVerificationURL(context.Context, *GetDeviceVerificationURLRequest) (*GetDeviceVerificationURLResponse, error)
+	PollDeviceAuthorization(context.Context, *PollDeviceAuthorizationRequest) (*PollDeviceAuthorizationResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -133,6 +237,30 @@ func (UnimplementedAuthServiceServer) GetUserProfile(context.Context, *GetUserPr
 func (UnimplementedAuthServiceServer) UpdateUserProfile(context.Context, *UpdateUserProfileRequest) (*UpdateUserProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserProfile not implemented")
 }
+func (UnimplementedAuthServiceServer) SyncUserProfile(context.Context, *SyncUserProfileRequest) (*SyncUserProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncUserProfile not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdateListDefaults(context.Context, *UpdateListDefaultsRequest) (*UpdateListDefaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateListDefaults not implemented")
+}
+func (UnimplementedAuthServiceServer) GetTavilyMCPToken(context.Context, *GetTavilyMCPTokenRequest) (*GetTavilyMCPTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTavilyMCPToken not implemented")
+}
+func (UnimplementedAuthServiceServer) GetOnboardingState(context.Context, *GetOnboardingStateRequest) (*GetOnboardingStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnboardingState not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdateOnboardingState(context.Context, *UpdateOnboardingStateRequest) (*UpdateOnboardingStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOnboardingState not implemented")
+}
+func (UnimplementedAuthServiceServer) StartDeviceAuthorization(context.Context, *StartDeviceAuthorizationRequest) (*StartDeviceAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDeviceAuthorization not implemented")
+}
+func (UnimplementedAuthServiceServer) GetDeviceVerificationURL(context.Context, *GetDeviceVerificationURLRequest) (*GetDeviceVerificationURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceVerificationURL not implemented")
+}
+func (UnimplementedAuthServiceServer) PollDeviceAuthorization(context.Context, *PollDeviceAuthorizationRequest) (*PollDeviceAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollDeviceAuthorization not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -244,6 +372,150 @@ func _AuthService_UpdateUserProfile_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_SyncUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncUserProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SyncUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SyncUserProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SyncUserProfile(ctx, req.(*SyncUserProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateListDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateListDefaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateListDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateListDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateListDefaults(ctx, req.(*UpdateListDefaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetTavilyMCPToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTavilyMCPTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetTavilyMCPToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetTavilyMCPToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetTavilyMCPToken(ctx, req.(*GetTavilyMCPTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetOnboardingState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnboardingStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetOnboardingState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetOnboardingState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetOnboardingState(ctx, req.(*GetOnboardingStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateOnboardingState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOnboardingStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateOnboardingState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateOnboardingState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateOnboardingState(ctx, req.(*UpdateOnboardingStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_StartDeviceAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDeviceAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).StartDeviceAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_StartDeviceAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).StartDeviceAuthorization(ctx, req.(*StartDeviceAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetDeviceVerificationURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceVerificationURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetDeviceVerificationURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetDeviceVerificationURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetDeviceVerificationURL(ctx, req.(*GetDeviceVerificationURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_PollDeviceAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollDeviceAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).PollDeviceAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_PollDeviceAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).PollDeviceAuthorization(ctx, req.(*PollDeviceAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -271,6 +543,38 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUserProfile",
 			Handler:    _AuthService_UpdateUserProfile_Handler,
 		},
+		{
+			MethodName: "SyncUserProfile",
+			Handler:    _AuthService_SyncUserProfile_Handler,
+		},
+		{
+			MethodName: "UpdateListDefaults",
+			Handler:    _AuthService_UpdateListDefaults_Handler,
+		},
+		{
+			MethodName: "GetTavilyMCPToken",
+			Handler:    _AuthService_GetTavilyMCPToken_Handler,
+		},
+		{
+			MethodName: "GetOnboardingState",
+			Handler:    _AuthService_GetOnboardingState_Handler,
+		},
+		{
+			MethodName: "UpdateOnboardingState",
+			Handler:    _AuthService_UpdateOnboardingState_Handler,
+		},
+		{
+			MethodName: "StartDeviceAuthorization",
+			Handler:    _AuthService_StartDeviceAuthorization_Handler,
+		},
+		{
+			MethodName: "GetDeviceVerificationURL",
+			Handler:    _AuthService_GetDeviceVerificationURL_Handler,
+		},
+		{
+			MethodName: "PollDeviceAuthorization",
+			Handler:    _AuthService_PollDeviceAuthorization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth/v1/auth.proto",
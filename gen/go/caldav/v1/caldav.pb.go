@@ -0,0 +1,459 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: caldav/v1/caldav.proto
+
+package caldavv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AppPassword signs a CalDAV client, such as iOS Reminders or Thunderbird,
+// in to the caller's task collection with HTTP Basic authentication
+type AppPassword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"` // the Basic username to pair with the password
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // optional, unset before the first sign-in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppPassword) Reset() {
+	*x = AppPassword{}
+	mi := &file_caldav_v1_caldav_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppPassword) ProtoMessage() {}
+
+func (x *AppPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_caldav_v1_caldav_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppPassword.ProtoReflect.Descriptor instead.
+func (*AppPassword) Descriptor() ([]byte, []int) {
+	return file_caldav_v1_caldav_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AppPassword) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AppPassword) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppPassword) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AppPassword) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AppPassword) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+// CreateAppPasswordRequest is the request message for creating an app password
+type CreateAppPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // describes the client, e.g. "iPhone"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAppPasswordRequest) Reset() {
+	*x = CreateAppPasswordRequest{}
+	mi := &file_caldav_v1_caldav_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppPasswordRequest) ProtoMessage() {}
+
+func (x *CreateAppPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_caldav_v1_caldav_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppPasswordRequest.ProtoReflect.Descriptor instead.
+func (*CreateAppPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_caldav_v1_caldav_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAppPasswordRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CreateAppPasswordResponse is the response message for creating an app password
+type CreateAppPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppPassword   *AppPassword           `protobuf:"bytes,1,opt,name=app_password,json=appPassword,proto3" json:"app_password,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // only returned here
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAppPasswordResponse) Reset() {
+	*x = CreateAppPasswordResponse{}
+	mi := &file_caldav_v1_caldav_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppPasswordResponse) ProtoMessage() {}
+
+func (x *CreateAppPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_caldav_v1_caldav_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppPasswordResponse.ProtoReflect.Descriptor instead.
+func (*CreateAppPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_caldav_v1_caldav_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAppPasswordResponse) GetAppPassword() *AppPassword {
+	if x != nil {
+		return x.AppPassword
+	}
+	return nil
+}
+
+func (x *CreateAppPasswordResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// ListAppPasswordsRequest is the request message for listing app passwords
+type ListAppPasswordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAppPasswordsRequest) Reset() {
+	*x = ListAppPasswordsRequest{}
+	mi := &file_caldav_v1_caldav_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppPasswordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppPasswordsRequest) ProtoMessage() {}
+
+func (x *ListAppPasswordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_caldav_v1_caldav_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppPasswordsRequest.ProtoReflect.Descriptor instead.
+func (*ListAppPasswordsRequest) Descriptor() ([]byte, []int) {
+	return file_caldav_v1_caldav_proto_rawDescGZIP(), []int{3}
+}
+
+// ListAppPasswordsResponse is the response message for listing app passwords
+type ListAppPasswordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppPasswords  []*AppPassword         `protobuf:"bytes,1,rep,name=app_passwords,json=appPasswords,proto3" json:"app_passwords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAppPasswordsResponse) Reset() {
+	*x = ListAppPasswordsResponse{}
+	mi := &file_caldav_v1_caldav_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppPasswordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppPasswordsResponse) ProtoMessage() {}
+
+func (x *ListAppPasswordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_caldav_v1_caldav_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppPasswordsResponse.ProtoReflect.Descriptor instead.
+func (*ListAppPasswordsResponse) Descriptor() ([]byte, []int) {
+	return file_caldav_v1_caldav_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAppPasswordsResponse) GetAppPasswords() []*AppPassword {
+	if x != nil {
+		return x.AppPasswords
+	}
+	return nil
+}
+
+// DeleteAppPasswordRequest is the request message for deleting an app password
+type DeleteAppPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppPasswordRequest) Reset() {
+	*x = DeleteAppPasswordRequest{}
+	mi := &file_caldav_v1_caldav_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppPasswordRequest) ProtoMessage() {}
+
+func (x *DeleteAppPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_caldav_v1_caldav_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppPasswordRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAppPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_caldav_v1_caldav_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteAppPasswordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteAppPasswordResponse is the response message for deleting an app password
+type DeleteAppPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppPasswordResponse) Reset() {
+	*x = DeleteAppPasswordResponse{}
+	mi := &file_caldav_v1_caldav_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppPasswordResponse) ProtoMessage() {}
+
+func (x *DeleteAppPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_caldav_v1_caldav_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppPasswordResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAppPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_caldav_v1_caldav_proto_rawDescGZIP(), []int{6}
+}
+
+var File_caldav_v1_caldav_proto protoreflect.FileDescriptor
+
+const file_caldav_v1_caldav_proto_rawDesc = "" +
+	"\n" +
+	"\x16caldav/v1/caldav.proto\x12\tcaldav.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc6\x01\n" +
+	"\vAppPassword\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\".\n" +
+	"\x18CreateAppPasswordRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"r\n" +
+	"\x19CreateAppPasswordResponse\x129\n" +
+	"\fapp_password\x18\x01 \x01(\v2\x16.caldav.v1.AppPasswordR\vappPassword\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x19\n" +
+	"\x17ListAppPasswordsRequest\"W\n" +
+	"\x18ListAppPasswordsResponse\x12;\n" +
+	"\rapp_passwords\x18\x01 \x03(\v2\x16.caldav.v1.AppPasswordR\fappPasswords\"*\n" +
+	"\x18DeleteAppPasswordRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1b\n" +
+	"\x19DeleteAppPasswordResponse2\xac\x02\n" +
+	"\rCalDAVService\x12^\n" +
+	"\x11CreateAppPassword\x12#.caldav.v1.CreateAppPasswordRequest\x1a$.caldav.v1.CreateAppPasswordResponse\x12[\n" +
+	"\x10ListAppPasswords\x12\".caldav.v1.ListAppPasswordsRequest\x1a#.caldav.v1.ListAppPasswordsResponse\x12^\n" +
+	"\x11DeleteAppPassword\x12#.caldav.v1.DeleteAppPasswordRequest\x1a$.caldav.v1.DeleteAppPasswordResponseB\x9b\x01\n" +
+	"\rcom.caldav.v1B\vCaldavProtoP\x01Z8github.com/slips-ai/slips-core/gen/go/caldav/v1;caldavv1\xa2\x02\x03CXX\xaa\x02\tCaldav.V1\xca\x02\tCaldav\\V1\xe2\x02\x15Caldav\\V1\\GPBMetadata\xea\x02\n" +
+	"Caldav::V1b\x06proto3"
+
+var (
+	file_caldav_v1_caldav_proto_rawDescOnce sync.Once
+	file_caldav_v1_caldav_proto_rawDescData []byte
+)
+
+func file_caldav_v1_caldav_proto_rawDescGZIP() []byte {
+	file_caldav_v1_caldav_proto_rawDescOnce.Do(func() {
+		file_caldav_v1_caldav_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_caldav_v1_caldav_proto_rawDesc), len(file_caldav_v1_caldav_proto_rawDesc)))
+	})
+	return file_caldav_v1_caldav_proto_rawDescData
+}
+
+var file_caldav_v1_caldav_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_caldav_v1_caldav_proto_goTypes = []any{
+	(*AppPassword)(nil),               // 0: caldav.v1.AppPassword
+	(*CreateAppPasswordRequest)(nil),  // 1: caldav.v1.CreateAppPasswordRequest
+	(*CreateAppPasswordResponse)(nil), // 2: caldav.v1.CreateAppPasswordResponse
+	(*ListAppPasswordsRequest)(nil),   // 3: caldav.v1.ListAppPasswordsRequest
+	(*ListAppPasswordsResponse)(nil),  // 4: caldav.v1.ListAppPasswordsResponse
+	(*DeleteAppPasswordRequest)(nil),  // 5: caldav.v1.DeleteAppPasswordRequest
+	(*DeleteAppPasswordResponse)(nil), // 6: caldav.v1.DeleteAppPasswordResponse
+	(*timestamppb.Timestamp)(nil),     // 7: google.protobuf.Timestamp
+}
+var file_caldav_v1_caldav_proto_depIdxs = []int32{
+	7, // 0: caldav.v1.AppPassword.created_at:type_name -> google.protobuf.Timestamp
+	7, // 1: caldav.v1.AppPassword.last_used_at:type_name -> google.protobuf.Timestamp
+	0, // 2: caldav.v1.CreateAppPasswordResponse.app_password:type_name -> caldav.v1.AppPassword
+	0, // 3: caldav.v1.ListAppPasswordsResponse.app_passwords:type_name -> caldav.v1.AppPassword
+	1, // 4: caldav.v1.CalDAVService.CreateAppPassword:input_type -> caldav.v1.CreateAppPasswordRequest
+	3, // 5: caldav.v1.CalDAVService.ListAppPasswords:input_type -> caldav.v1.ListAppPasswordsRequest
+	5, // 6: caldav.v1.CalDAVService.DeleteAppPassword:input_type -> caldav.v1.DeleteAppPasswordRequest
+	2, // 7: caldav.v1.CalDAVService.CreateAppPassword:output_type -> caldav.v1.CreateAppPasswordResponse
+	4, // 8: caldav.v1.CalDAVService.ListAppPasswords:output_type -> caldav.v1.ListAppPasswordsResponse
+	6, // 9: caldav.v1.CalDAVService.DeleteAppPassword:output_type -> caldav.v1.DeleteAppPasswordResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_caldav_v1_caldav_proto_init() }
+func file_caldav_v1_caldav_proto_init() {
+	if File_caldav_v1_caldav_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_caldav_v1_caldav_proto_rawDesc), len(file_caldav_v1_caldav_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_caldav_v1_caldav_proto_goTypes,
+		DependencyIndexes: file_caldav_v1_caldav_proto_depIdxs,
+		MessageInfos:      file_caldav_v1_caldav_proto_msgTypes,
+	}.Build()
+	File_caldav_v1_caldav_proto = out.File
+	file_caldav_v1_caldav_proto_goTypes = nil
+	file_caldav_v1_caldav_proto_depIdxs = nil
+}
@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: caldav/v1/caldav.proto
+
+package caldavv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CalDAVService_CreateAppPassword_FullMethodName = "/caldav.v1.CalDAVService/CreateAppPassword"
+	CalDAVService_ListAppPasswords_FullMethodName  = "/caldav.v1.CalDAVService/ListAppPasswords"
+	CalDAVService_DeleteAppPassword_FullMethodName = "/caldav.v1.CalDAVService/DeleteAppPassword"
+)
+
+// CalDAVServiceClient is the client API for CalDAVService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CalDAVService manages the app passwords of the caller. The tasks
+// themselves are served over CalDAV at /caldav/ on the HTTP port, not as
+// RPCs.
+type CalDAVServiceClient interface {
+	CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, opts ...grpc.CallOption) (*CreateAppPasswordResponse, error)
+	ListAppPasswords(ctx context.Context, in *ListAppPasswordsRequest, opts ...grpc.CallOption) (*ListAppPasswordsResponse, error)
+	// Clients using the password are signed out on their next request
+	DeleteAppPassword(ctx context.Context, in *DeleteAppPasswordRequest, opts ...grpc.CallOption) (*DeleteAppPasswordResponse, error)
+}
+
+type calDAVServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCalDAVServiceClient(cc grpc.ClientConnInterface) CalDAVServiceClient {
+	return &calDAVServiceClient{cc}
+}
+
+func (c *calDAVServiceClient) CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, opts ...grpc.CallOption) (*CreateAppPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAppPasswordResponse)
+	err := c.cc.Invoke(ctx, CalDAVService_CreateAppPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calDAVServiceClient) ListAppPasswords(ctx context.Context, in *ListAppPasswordsRequest, opts ...grpc.CallOption) (*ListAppPasswordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAppPasswordsResponse)
+	err := c.cc.Invoke(ctx, CalDAVService_ListAppPasswords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calDAVServiceClient) DeleteAppPassword(ctx context.Context, in *DeleteAppPasswordRequest, opts ...grpc.CallOption) (*DeleteAppPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAppPasswordResponse)
+	err := c.cc.Invoke(ctx, CalDAVService_DeleteAppPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalDAVServiceServer is the server API for CalDAVService service.
+// All implementations must embed UnimplementedCalDAVServiceServer
+// for forward compatibility.
+//
+// CalDAVService manages the app passwords of the caller. The tasks
+// themselves are served over CalDAV at /caldav/ on the HTTP port, not as
+// RPCs.
+type CalDAVServiceServer interface {
+	CreateAppPassword(context.Context, *CreateAppPasswordRequest) (*CreateAppPasswordResponse, error)
+	ListAppPasswords(context.Context, *ListAppPasswordsRequest) (*ListAppPasswordsResponse, error)
+	// Clients using the password are signed out on their next request
+	DeleteAppPassword(context.Context, *DeleteAppPasswordRequest) (*DeleteAppPasswordResponse, error)
+	mustEmbedUnimplementedCalDAVServiceServer()
+}
+
+// UnimplementedCalDAVServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCalDAVServiceServer struct{}
+
+func (UnimplementedCalDAVServiceServer) CreateAppPassword(context.Context, *CreateAppPasswordRequest) (*CreateAppPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppPassword not implemented")
+}
+func (UnimplementedCalDAVServiceServer) ListAppPasswords(context.Context, *ListAppPasswordsRequest) (*ListAppPasswordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppPasswords not implemented")
+}
+func (UnimplementedCalDAVServiceServer) DeleteAppPassword(context.Context, *DeleteAppPasswordRequest) (*DeleteAppPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppPassword not implemented")
+}
+func (UnimplementedCalDAVServiceServer) mustEmbedUnimplementedCalDAVServiceServer() {}
+func (UnimplementedCalDAVServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCalDAVServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalDAVServiceServer will
+// result in compilation errors.
+type UnsafeCalDAVServiceServer interface {
+	mustEmbedUnimplementedCalDAVServiceServer()
+}
+
+func RegisterCalDAVServiceServer(s grpc.ServiceRegistrar, srv CalDAVServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCalDAVServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CalDAVService_ServiceDesc, srv)
+}
+
+func _CalDAVService_CreateAppPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalDAVServiceServer).CreateAppPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalDAVService_CreateAppPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalDAVServiceServer).CreateAppPassword(ctx, req.(*CreateAppPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalDAVService_ListAppPasswords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppPasswordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalDAVServiceServer).ListAppPasswords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalDAVService_ListAppPasswords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalDAVServiceServer).ListAppPasswords(ctx, req.(*ListAppPasswordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalDAVService_DeleteAppPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalDAVServiceServer).DeleteAppPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalDAVService_DeleteAppPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalDAVServiceServer).DeleteAppPassword(ctx, req.(*DeleteAppPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CalDAVService_ServiceDesc is the grpc.ServiceDesc for CalDAVService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CalDAVService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "caldav.v1.CalDAVService",
+	HandlerType: (*CalDAVServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAppPassword",
+			Handler:    _CalDAVService_CreateAppPassword_Handler,
+		},
+		{
+			MethodName: "ListAppPasswords",
+			Handler:    _CalDAVService_ListAppPasswords_Handler,
+		},
+		{
+			MethodName: "DeleteAppPassword",
+			Handler:    _CalDAVService_DeleteAppPassword_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "caldav/v1/caldav.proto",
+}
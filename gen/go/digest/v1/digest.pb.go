@@ -0,0 +1,555 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: digest/v1/digest.proto
+
+package digestv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DigestFrequency is how often the caller receives digest emails
+type DigestFrequency int32
+
+const (
+	DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED DigestFrequency = 0 // treated as OFF
+	DigestFrequency_DIGEST_FREQUENCY_OFF         DigestFrequency = 1
+	DigestFrequency_DIGEST_FREQUENCY_DAILY       DigestFrequency = 2
+	DigestFrequency_DIGEST_FREQUENCY_WEEKLY      DigestFrequency = 3
+)
+
+// Enum value maps for DigestFrequency.
+var (
+	DigestFrequency_name = map[int32]string{
+		0: "DIGEST_FREQUENCY_UNSPECIFIED",
+		1: "DIGEST_FREQUENCY_OFF",
+		2: "DIGEST_FREQUENCY_DAILY",
+		3: "DIGEST_FREQUENCY_WEEKLY",
+	}
+	DigestFrequency_value = map[string]int32{
+		"DIGEST_FREQUENCY_UNSPECIFIED": 0,
+		"DIGEST_FREQUENCY_OFF":         1,
+		"DIGEST_FREQUENCY_DAILY":       2,
+		"DIGEST_FREQUENCY_WEEKLY":      3,
+	}
+)
+
+func (x DigestFrequency) Enum() *DigestFrequency {
+	p := new(DigestFrequency)
+	*p = x
+	return p
+}
+
+func (x DigestFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DigestFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_digest_v1_digest_proto_enumTypes[0].Descriptor()
+}
+
+func (DigestFrequency) Type() protoreflect.EnumType {
+	return &file_digest_v1_digest_proto_enumTypes[0]
+}
+
+func (x DigestFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DigestFrequency.Descriptor instead.
+func (DigestFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_digest_v1_digest_proto_rawDescGZIP(), []int{0}
+}
+
+// DigestSettings holds the caller's digest email preferences
+type DigestSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Frequency     DigestFrequency        `protobuf:"varint,1,opt,name=frequency,proto3,enum=digest.v1.DigestFrequency" json:"frequency,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`                         // IANA time zone, e.g. "Europe/Berlin"
+	SendHour      int32                  `protobuf:"varint,3,opt,name=send_hour,json=sendHour,proto3" json:"send_hour,omitempty"`        // local hour digests are sent at, 0-23
+	Weekday       int32                  `protobuf:"varint,4,opt,name=weekday,proto3" json:"weekday,omitempty"`                          // day weekly digests are sent on, 0 (Sunday) to 6
+	LastSentAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_sent_at,json=lastSentAt,proto3" json:"last_sent_at,omitempty"` // optional, unset before the first digest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestSettings) Reset() {
+	*x = DigestSettings{}
+	mi := &file_digest_v1_digest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestSettings) ProtoMessage() {}
+
+func (x *DigestSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_digest_v1_digest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestSettings.ProtoReflect.Descriptor instead.
+func (*DigestSettings) Descriptor() ([]byte, []int) {
+	return file_digest_v1_digest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DigestSettings) GetFrequency() DigestFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED
+}
+
+func (x *DigestSettings) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetSendHour() int32 {
+	if x != nil {
+		return x.SendHour
+	}
+	return 0
+}
+
+func (x *DigestSettings) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *DigestSettings) GetLastSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSentAt
+	}
+	return nil
+}
+
+// GetDigestSettingsRequest is the request message for getting digest settings
+type GetDigestSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestSettingsRequest) Reset() {
+	*x = GetDigestSettingsRequest{}
+	mi := &file_digest_v1_digest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestSettingsRequest) ProtoMessage() {}
+
+func (x *GetDigestSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_digest_v1_digest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetDigestSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_digest_v1_digest_proto_rawDescGZIP(), []int{1}
+}
+
+// GetDigestSettingsResponse is the response message for getting digest settings
+type GetDigestSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *DigestSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestSettingsResponse) Reset() {
+	*x = GetDigestSettingsResponse{}
+	mi := &file_digest_v1_digest_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestSettingsResponse) ProtoMessage() {}
+
+func (x *GetDigestSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_digest_v1_digest_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetDigestSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_digest_v1_digest_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDigestSettingsResponse) GetSettings() *DigestSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateDigestSettingsRequest is the request message for updating digest settings
+type UpdateDigestSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Frequency     DigestFrequency        `protobuf:"varint,1,opt,name=frequency,proto3,enum=digest.v1.DigestFrequency" json:"frequency,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // empty keeps UTC
+	SendHour      int32                  `protobuf:"varint,3,opt,name=send_hour,json=sendHour,proto3" json:"send_hour,omitempty"`
+	Weekday       int32                  `protobuf:"varint,4,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDigestSettingsRequest) Reset() {
+	*x = UpdateDigestSettingsRequest{}
+	mi := &file_digest_v1_digest_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDigestSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDigestSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateDigestSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_digest_v1_digest_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDigestSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDigestSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_digest_v1_digest_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateDigestSettingsRequest) GetFrequency() DigestFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED
+}
+
+func (x *UpdateDigestSettingsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateDigestSettingsRequest) GetSendHour() int32 {
+	if x != nil {
+		return x.SendHour
+	}
+	return 0
+}
+
+func (x *UpdateDigestSettingsRequest) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+// UpdateDigestSettingsResponse is the response message for updating digest settings
+type UpdateDigestSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *DigestSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDigestSettingsResponse) Reset() {
+	*x = UpdateDigestSettingsResponse{}
+	mi := &file_digest_v1_digest_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDigestSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDigestSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateDigestSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_digest_v1_digest_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDigestSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDigestSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_digest_v1_digest_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateDigestSettingsResponse) GetSettings() *DigestSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// PreviewDigestRequest is the request message for previewing a digest
+type PreviewDigestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewDigestRequest) Reset() {
+	*x = PreviewDigestRequest{}
+	mi := &file_digest_v1_digest_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDigestRequest) ProtoMessage() {}
+
+func (x *PreviewDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_digest_v1_digest_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDigestRequest.ProtoReflect.Descriptor instead.
+func (*PreviewDigestRequest) Descriptor() ([]byte, []int) {
+	return file_digest_v1_digest_proto_rawDescGZIP(), []int{5}
+}
+
+// PreviewDigestResponse is the response message for previewing a digest
+type PreviewDigestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`    // plain text body
+	Empty         bool                   `protobuf:"varint,3,opt,name=empty,proto3" json:"empty,omitempty"` // nothing to report; such digests are not sent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewDigestResponse) Reset() {
+	*x = PreviewDigestResponse{}
+	mi := &file_digest_v1_digest_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDigestResponse) ProtoMessage() {}
+
+func (x *PreviewDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_digest_v1_digest_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDigestResponse.ProtoReflect.Descriptor instead.
+func (*PreviewDigestResponse) Descriptor() ([]byte, []int) {
+	return file_digest_v1_digest_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PreviewDigestResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *PreviewDigestResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PreviewDigestResponse) GetEmpty() bool {
+	if x != nil {
+		return x.Empty
+	}
+	return false
+}
+
+var File_digest_v1_digest_proto protoreflect.FileDescriptor
+
+const file_digest_v1_digest_proto_rawDesc = "" +
+	"\n" +
+	"\x16digest/v1/digest.proto\x12\tdigest.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x01\n" +
+	"\x0eDigestSettings\x128\n" +
+	"\tfrequency\x18\x01 \x01(\x0e2\x1a.digest.v1.DigestFrequencyR\tfrequency\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12\x1b\n" +
+	"\tsend_hour\x18\x03 \x01(\x05R\bsendHour\x12\x18\n" +
+	"\aweekday\x18\x04 \x01(\x05R\aweekday\x12<\n" +
+	"\flast_sent_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSentAt\"\x1a\n" +
+	"\x18GetDigestSettingsRequest\"R\n" +
+	"\x19GetDigestSettingsResponse\x125\n" +
+	"\bsettings\x18\x01 \x01(\v2\x19.digest.v1.DigestSettingsR\bsettings\"\xaa\x01\n" +
+	"\x1bUpdateDigestSettingsRequest\x128\n" +
+	"\tfrequency\x18\x01 \x01(\x0e2\x1a.digest.v1.DigestFrequencyR\tfrequency\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12\x1b\n" +
+	"\tsend_hour\x18\x03 \x01(\x05R\bsendHour\x12\x18\n" +
+	"\aweekday\x18\x04 \x01(\x05R\aweekday\"U\n" +
+	"\x1cUpdateDigestSettingsResponse\x125\n" +
+	"\bsettings\x18\x01 \x01(\v2\x19.digest.v1.DigestSettingsR\bsettings\"\x16\n" +
+	"\x14PreviewDigestRequest\"[\n" +
+	"\x15PreviewDigestResponse\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x14\n" +
+	"\x05empty\x18\x03 \x01(\bR\x05empty*\x86\x01\n" +
+	"\x0fDigestFrequency\x12 \n" +
+	"\x1cDIGEST_FREQUENCY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14DIGEST_FREQUENCY_OFF\x10\x01\x12\x1a\n" +
+	"\x16DIGEST_FREQUENCY_DAILY\x10\x02\x12\x1b\n" +
+	"\x17DIGEST_FREQUENCY_WEEKLY\x10\x032\xac\x02\n" +
+	"\rDigestService\x12^\n" +
+	"\x11GetDigestSettings\x12#.digest.v1.GetDigestSettingsRequest\x1a$.digest.v1.GetDigestSettingsResponse\x12g\n" +
+	"\x14UpdateDigestSettings\x12&.digest.v1.UpdateDigestSettingsRequest\x1a'.digest.v1.UpdateDigestSettingsResponse\x12R\n" +
+	"\rPreviewDigest\x12\x1f.digest.v1.PreviewDigestRequest\x1a .digest.v1.PreviewDigestResponseB\x9b\x01\n" +
+	"\rcom.digest.v1B\vDigestProtoP\x01Z8github.com/slips-ai/slips-core/gen/go/digest/v1;digestv1\xa2\x02\x03DXX\xaa\x02\tDigest.V1\xca\x02\tDigest\\V1\xe2\x02\x15Digest\\V1\\GPBMetadata\xea\x02\n" +
+	"Digest::V1b\x06proto3"
+
+var (
+	file_digest_v1_digest_proto_rawDescOnce sync.Once
+	file_digest_v1_digest_proto_rawDescData []byte
+)
+
+func file_digest_v1_digest_proto_rawDescGZIP() []byte {
+	file_digest_v1_digest_proto_rawDescOnce.Do(func() {
+		file_digest_v1_digest_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_digest_v1_digest_proto_rawDesc), len(file_digest_v1_digest_proto_rawDesc)))
+	})
+	return file_digest_v1_digest_proto_rawDescData
+}
+
+var file_digest_v1_digest_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_digest_v1_digest_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_digest_v1_digest_proto_goTypes = []any{
+	(DigestFrequency)(0),                 // 0: digest.v1.DigestFrequency
+	(*DigestSettings)(nil),               // 1: digest.v1.DigestSettings
+	(*GetDigestSettingsRequest)(nil),     // 2: digest.v1.GetDigestSettingsRequest
+	(*GetDigestSettingsResponse)(nil),    // 3: digest.v1.GetDigestSettingsResponse
+	(*UpdateDigestSettingsRequest)(nil),  // 4: digest.v1.UpdateDigestSettingsRequest
+	(*UpdateDigestSettingsResponse)(nil), // 5: digest.v1.UpdateDigestSettingsResponse
+	(*PreviewDigestRequest)(nil),         // 6: digest.v1.PreviewDigestRequest
+	(*PreviewDigestResponse)(nil),        // 7: digest.v1.PreviewDigestResponse
+	(*timestamppb.Timestamp)(nil),        // 8: google.protobuf.Timestamp
+}
+var file_digest_v1_digest_proto_depIdxs = []int32{
+	0, // 0: digest.v1.DigestSettings.frequency:type_name -> digest.v1.DigestFrequency
+	8, // 1: digest.v1.DigestSettings.last_sent_at:type_name -> google.protobuf.Timestamp
+	1, // 2: digest.v1.GetDigestSettingsResponse.settings:type_name -> digest.v1.DigestSettings
+	0, // 3: digest.v1.UpdateDigestSettingsRequest.frequency:type_name -> digest.v1.DigestFrequency
+	1, // 4: digest.v1.UpdateDigestSettingsResponse.settings:type_name -> digest.v1.DigestSettings
+	2, // 5: digest.v1.DigestService.GetDigestSettings:input_type -> digest.v1.GetDigestSettingsRequest
+	4, // 6: digest.v1.DigestService.UpdateDigestSettings:input_type -> digest.v1.UpdateDigestSettingsRequest
+	6, // 7: digest.v1.DigestService.PreviewDigest:input_type -> digest.v1.PreviewDigestRequest
+	3, // 8: digest.v1.DigestService.GetDigestSettings:output_type -> digest.v1.GetDigestSettingsResponse
+	5, // 9: digest.v1.DigestService.UpdateDigestSettings:output_type -> digest.v1.UpdateDigestSettingsResponse
+	7, // 10: digest.v1.DigestService.PreviewDigest:output_type -> digest.v1.PreviewDigestResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_digest_v1_digest_proto_init() }
+func file_digest_v1_digest_proto_init() {
+	if File_digest_v1_digest_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_digest_v1_digest_proto_rawDesc), len(file_digest_v1_digest_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_digest_v1_digest_proto_goTypes,
+		DependencyIndexes: file_digest_v1_digest_proto_depIdxs,
+		EnumInfos:         file_digest_v1_digest_proto_enumTypes,
+		MessageInfos:      file_digest_v1_digest_proto_msgTypes,
+	}.Build()
+	File_digest_v1_digest_proto = out.File
+	file_digest_v1_digest_proto_goTypes = nil
+	file_digest_v1_digest_proto_depIdxs = nil
+}
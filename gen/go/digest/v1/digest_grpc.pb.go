@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: digest/v1/digest.proto
+
+package digestv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DigestService_GetDigestSettings_FullMethodName    = "/digest.v1.DigestService/GetDigestSettings"
+	DigestService_UpdateDigestSettings_FullMethodName = "/digest.v1.DigestService/UpdateDigestSettings"
+	DigestService_PreviewDigest_FullMethodName        = "/digest.v1.DigestService/PreviewDigest"
+)
+
+// DigestServiceClient is the client API for DigestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DigestService manages the caller's digest emails: a daily or weekly
+// summary of today's tasks, overdue tasks and recent completions
+type DigestServiceClient interface {
+	GetDigestSettings(ctx context.Context, in *GetDigestSettingsRequest, opts ...grpc.CallOption) (*GetDigestSettingsResponse, error)
+	// Turning digests on fails with FAILED_PRECONDITION when the server has no
+	// mail provider or the caller has no email address
+	UpdateDigestSettings(ctx context.Context, in *UpdateDigestSettingsRequest, opts ...grpc.CallOption) (*UpdateDigestSettingsResponse, error)
+	// PreviewDigest renders the digest the caller would receive now
+	PreviewDigest(ctx context.Context, in *PreviewDigestRequest, opts ...grpc.CallOption) (*PreviewDigestResponse, error)
+}
+
+type digestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDigestServiceClient(cc grpc.ClientConnInterface) DigestServiceClient {
+	return &digestServiceClient{cc}
+}
+
+func (c *digestServiceClient) GetDigestSettings(ctx context.Context, in *GetDigestSettingsRequest, opts ...grpc.CallOption) (*GetDigestSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDigestSettingsResponse)
+	err := c.cc.Invoke(ctx, DigestService_GetDigestSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *digestServiceClient) UpdateDigestSettings(ctx context.Context, in *UpdateDigestSettingsRequest, opts ...grpc.CallOption) (*UpdateDigestSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDigestSettingsResponse)
+	err := c.cc.Invoke(ctx, DigestService_UpdateDigestSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *digestServiceClient) PreviewDigest(ctx context.Context, in *PreviewDigestRequest, opts ...grpc.CallOption) (*PreviewDigestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewDigestResponse)
+	err := c.cc.Invoke(ctx, DigestService_PreviewDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DigestServiceServer is the server API for DigestService service.
+// All implementations must embed UnimplementedDigestServiceServer
+// for forward compatibility.
+//
+// DigestService manages the caller's digest emails: a daily or weekly
+// summary of today's tasks, overdue tasks and recent completions
+type DigestServiceServer interface {
+	GetDigestSettings(context.Context, *GetDigestSettingsRequest) (*GetDigestSettingsResponse, error)
+	// Turning digests on fails with FAILED_PRECONDITION when the server has no
+	// mail provider or the caller has no email address
+	UpdateDigestSettings(context.Context, *UpdateDigestSettingsRequest) (*UpdateDigestSettingsResponse, error)
+	// PreviewDigest renders the digest the caller would receive now
+	PreviewDigest(context.Context, *PreviewDigestRequest) (*PreviewDigestResponse, error)
+	mustEmbedUnimplementedDigestServiceServer()
+}
+
+// UnimplementedDigestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDigestServiceServer struct{}
+
+func (UnimplementedDigestServiceServer) GetDigestSettings(context.Context, *GetDigestSettingsRequest) (*GetDigestSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDigestSettings not implemented")
+}
+func (UnimplementedDigestServiceServer) UpdateDigestSettings(context.Context, *UpdateDigestSettingsRequest) (*UpdateDigestSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDigestSettings not implemented")
+}
+func (UnimplementedDigestServiceServer) PreviewDigest(context.Context, *PreviewDigestRequest) (*PreviewDigestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewDigest not implemented")
+}
+func (UnimplementedDigestServiceServer) mustEmbedUnimplementedDigestServiceServer() {}
+func (UnimplementedDigestServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeDigestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DigestServiceServer will
+// result in compilation errors.
+type UnsafeDigestServiceServer interface {
+	mustEmbedUnimplementedDigestServiceServer()
+}
+
+func RegisterDigestServiceServer(s grpc.ServiceRegistrar, srv DigestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDigestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DigestService_ServiceDesc, srv)
+}
+
+func _DigestService_GetDigestSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDigestSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DigestServiceServer).GetDigestSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DigestService_GetDigestSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DigestServiceServer).GetDigestSettings(ctx, req.(*GetDigestSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DigestService_UpdateDigestSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDigestSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DigestServiceServer).UpdateDigestSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DigestService_UpdateDigestSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DigestServiceServer).UpdateDigestSettings(ctx, req.(*UpdateDigestSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DigestService_PreviewDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DigestServiceServer).PreviewDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DigestService_PreviewDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DigestServiceServer).PreviewDigest(ctx, req.(*PreviewDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DigestService_ServiceDesc is the grpc.ServiceDesc for DigestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DigestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "digest.v1.DigestService",
+	HandlerType: (*DigestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDigestSettings",
+			Handler:    _DigestService_GetDigestSettings_Handler,
+		},
+		{
+			MethodName: "UpdateDigestSettings",
+			Handler:    _DigestService_UpdateDigestSettings_Handler,
+		},
+		{
+			MethodName: "PreviewDigest",
+			Handler:    _DigestService_PreviewDigest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "digest/v1/digest.proto",
+}
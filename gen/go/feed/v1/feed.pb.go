@@ -0,0 +1,575 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: feed/v1/feed.proto
+
+package feedv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Feed publishes recent changes to the tasks of a tag or saved filter as
+// RSS and JSON Feed, for dashboards and read-only monitoring
+type Feed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*Feed_TagId
+	//	*Feed_SavedFilterId
+	Source        isFeed_Source          `protobuf_oneof:"source"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastFetchedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_fetched_at,json=lastFetchedAt,proto3" json:"last_fetched_at,omitempty"` // optional, unset before the first fetch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feed) Reset() {
+	*x = Feed{}
+	mi := &file_feed_v1_feed_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed) ProtoMessage() {}
+
+func (x *Feed) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_v1_feed_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed.ProtoReflect.Descriptor instead.
+func (*Feed) Descriptor() ([]byte, []int) {
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Feed) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Feed) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Feed) GetSource() isFeed_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Feed) GetTagId() string {
+	if x != nil {
+		if x, ok := x.Source.(*Feed_TagId); ok {
+			return x.TagId
+		}
+	}
+	return ""
+}
+
+func (x *Feed) GetSavedFilterId() string {
+	if x != nil {
+		if x, ok := x.Source.(*Feed_SavedFilterId); ok {
+			return x.SavedFilterId
+		}
+	}
+	return ""
+}
+
+func (x *Feed) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Feed) GetLastFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFetchedAt
+	}
+	return nil
+}
+
+type isFeed_Source interface {
+	isFeed_Source()
+}
+
+type Feed_TagId struct {
+	TagId string `protobuf:"bytes,3,opt,name=tag_id,json=tagId,proto3,oneof"`
+}
+
+type Feed_SavedFilterId struct {
+	SavedFilterId string `protobuf:"bytes,4,opt,name=saved_filter_id,json=savedFilterId,proto3,oneof"`
+}
+
+func (*Feed_TagId) isFeed_Source() {}
+
+func (*Feed_SavedFilterId) isFeed_Source() {}
+
+// CreateFeedRequest is the request message for creating a feed
+type CreateFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*CreateFeedRequest_TagId
+	//	*CreateFeedRequest_SavedFilterId
+	Source        isCreateFeedRequest_Source `protobuf_oneof:"source"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeedRequest) Reset() {
+	*x = CreateFeedRequest{}
+	mi := &file_feed_v1_feed_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeedRequest) ProtoMessage() {}
+
+func (x *CreateFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_v1_feed_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeedRequest.ProtoReflect.Descriptor instead.
+func (*CreateFeedRequest) Descriptor() ([]byte, []int) {
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFeedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFeedRequest) GetSource() isCreateFeedRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *CreateFeedRequest) GetTagId() string {
+	if x != nil {
+		if x, ok := x.Source.(*CreateFeedRequest_TagId); ok {
+			return x.TagId
+		}
+	}
+	return ""
+}
+
+func (x *CreateFeedRequest) GetSavedFilterId() string {
+	if x != nil {
+		if x, ok := x.Source.(*CreateFeedRequest_SavedFilterId); ok {
+			return x.SavedFilterId
+		}
+	}
+	return ""
+}
+
+type isCreateFeedRequest_Source interface {
+	isCreateFeedRequest_Source()
+}
+
+type CreateFeedRequest_TagId struct {
+	TagId string `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3,oneof"`
+}
+
+type CreateFeedRequest_SavedFilterId struct {
+	SavedFilterId string `protobuf:"bytes,3,opt,name=saved_filter_id,json=savedFilterId,proto3,oneof"`
+}
+
+func (*CreateFeedRequest_TagId) isCreateFeedRequest_Source() {}
+
+func (*CreateFeedRequest_SavedFilterId) isCreateFeedRequest_Source() {}
+
+// CreateFeedResponse is the response message for creating a feed. The
+// token authenticates readers and is only returned here.
+type CreateFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feed          *Feed                  `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RssUrl        string                 `protobuf:"bytes,3,opt,name=rss_url,json=rssUrl,proto3" json:"rss_url,omitempty"`    // empty when the server has no feeds.base_url
+	JsonUrl       string                 `protobuf:"bytes,4,opt,name=json_url,json=jsonUrl,proto3" json:"json_url,omitempty"` // same as rss_url
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeedResponse) Reset() {
+	*x = CreateFeedResponse{}
+	mi := &file_feed_v1_feed_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeedResponse) ProtoMessage() {}
+
+func (x *CreateFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_v1_feed_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeedResponse.ProtoReflect.Descriptor instead.
+func (*CreateFeedResponse) Descriptor() ([]byte, []int) {
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateFeedResponse) GetFeed() *Feed {
+	if x != nil {
+		return x.Feed
+	}
+	return nil
+}
+
+func (x *CreateFeedResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateFeedResponse) GetRssUrl() string {
+	if x != nil {
+		return x.RssUrl
+	}
+	return ""
+}
+
+func (x *CreateFeedResponse) GetJsonUrl() string {
+	if x != nil {
+		return x.JsonUrl
+	}
+	return ""
+}
+
+// ListFeedsRequest is the request message for listing feeds
+type ListFeedsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeedsRequest) Reset() {
+	*x = ListFeedsRequest{}
+	mi := &file_feed_v1_feed_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedsRequest) ProtoMessage() {}
+
+func (x *ListFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_v1_feed_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedsRequest.ProtoReflect.Descriptor instead.
+func (*ListFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{3}
+}
+
+// ListFeedsResponse is the response message for listing feeds
+type ListFeedsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feeds         []*Feed                `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeedsResponse) Reset() {
+	*x = ListFeedsResponse{}
+	mi := &file_feed_v1_feed_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedsResponse) ProtoMessage() {}
+
+func (x *ListFeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_v1_feed_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedsResponse.ProtoReflect.Descriptor instead.
+func (*ListFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListFeedsResponse) GetFeeds() []*Feed {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+// DeleteFeedRequest is the request message for deleting a feed
+type DeleteFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeedRequest) Reset() {
+	*x = DeleteFeedRequest{}
+	mi := &file_feed_v1_feed_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeedRequest) ProtoMessage() {}
+
+func (x *DeleteFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_v1_feed_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeedRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFeedRequest) Descriptor() ([]byte, []int) {
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteFeedRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteFeedResponse is the response message for deleting a feed
+type DeleteFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeedResponse) Reset() {
+	*x = DeleteFeedResponse{}
+	mi := &file_feed_v1_feed_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeedResponse) ProtoMessage() {}
+
+func (x *DeleteFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_v1_feed_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeedResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFeedResponse) Descriptor() ([]byte, []int) {
+	return file_feed_v1_feed_proto_rawDescGZIP(), []int{6}
+}
+
+var File_feed_v1_feed_proto protoreflect.FileDescriptor
+
+const file_feed_v1_feed_proto_rawDesc = "" +
+	"\n" +
+	"\x12feed/v1/feed.proto\x12\afeed.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf6\x01\n" +
+	"\x04Feed\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\x06tag_id\x18\x03 \x01(\tH\x00R\x05tagId\x12(\n" +
+	"\x0fsaved_filter_id\x18\x04 \x01(\tH\x00R\rsavedFilterId\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\x0flast_fetched_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rlastFetchedAtB\b\n" +
+	"\x06source\"t\n" +
+	"\x11CreateFeedRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\x06tag_id\x18\x02 \x01(\tH\x00R\x05tagId\x12(\n" +
+	"\x0fsaved_filter_id\x18\x03 \x01(\tH\x00R\rsavedFilterIdB\b\n" +
+	"\x06source\"\x81\x01\n" +
+	"\x12CreateFeedResponse\x12!\n" +
+	"\x04feed\x18\x01 \x01(\v2\r.feed.v1.FeedR\x04feed\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x17\n" +
+	"\arss_url\x18\x03 \x01(\tR\x06rssUrl\x12\x19\n" +
+	"\bjson_url\x18\x04 \x01(\tR\ajsonUrl\"\x12\n" +
+	"\x10ListFeedsRequest\"8\n" +
+	"\x11ListFeedsResponse\x12#\n" +
+	"\x05feeds\x18\x01 \x03(\v2\r.feed.v1.FeedR\x05feeds\"#\n" +
+	"\x11DeleteFeedRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteFeedResponse2\xdf\x01\n" +
+	"\vFeedService\x12E\n" +
+	"\n" +
+	"CreateFeed\x12\x1a.feed.v1.CreateFeedRequest\x1a\x1b.feed.v1.CreateFeedResponse\x12B\n" +
+	"\tListFeeds\x12\x19.feed.v1.ListFeedsRequest\x1a\x1a.feed.v1.ListFeedsResponse\x12E\n" +
+	"\n" +
+	"DeleteFeed\x12\x1a.feed.v1.DeleteFeedRequest\x1a\x1b.feed.v1.DeleteFeedResponseB\x8b\x01\n" +
+	"\vcom.feed.v1B\tFeedProtoP\x01Z4github.com/slips-ai/slips-core/gen/go/feed/v1;feedv1\xa2\x02\x03FXX\xaa\x02\aFeed.V1\xca\x02\aFeed\\V1\xe2\x02\x13Feed\\V1\\GPBMetadata\xea\x02\bFeed::V1b\x06proto3"
+
+var (
+	file_feed_v1_feed_proto_rawDescOnce sync.Once
+	file_feed_v1_feed_proto_rawDescData []byte
+)
+
+func file_feed_v1_feed_proto_rawDescGZIP() []byte {
+	file_feed_v1_feed_proto_rawDescOnce.Do(func() {
+		file_feed_v1_feed_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_feed_v1_feed_proto_rawDesc), len(file_feed_v1_feed_proto_rawDesc)))
+	})
+	return file_feed_v1_feed_proto_rawDescData
+}
+
+var file_feed_v1_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_feed_v1_feed_proto_goTypes = []any{
+	(*Feed)(nil),                  // 0: feed.v1.Feed
+	(*CreateFeedRequest)(nil),     // 1: feed.v1.CreateFeedRequest
+	(*CreateFeedResponse)(nil),    // 2: feed.v1.CreateFeedResponse
+	(*ListFeedsRequest)(nil),      // 3: feed.v1.ListFeedsRequest
+	(*ListFeedsResponse)(nil),     // 4: feed.v1.ListFeedsResponse
+	(*DeleteFeedRequest)(nil),     // 5: feed.v1.DeleteFeedRequest
+	(*DeleteFeedResponse)(nil),    // 6: feed.v1.DeleteFeedResponse
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_feed_v1_feed_proto_depIdxs = []int32{
+	7, // 0: feed.v1.Feed.created_at:type_name -> google.protobuf.Timestamp
+	7, // 1: feed.v1.Feed.last_fetched_at:type_name -> google.protobuf.Timestamp
+	0, // 2: feed.v1.CreateFeedResponse.feed:type_name -> feed.v1.Feed
+	0, // 3: feed.v1.ListFeedsResponse.feeds:type_name -> feed.v1.Feed
+	1, // 4: feed.v1.FeedService.CreateFeed:input_type -> feed.v1.CreateFeedRequest
+	3, // 5: feed.v1.FeedService.ListFeeds:input_type -> feed.v1.ListFeedsRequest
+	5, // 6: feed.v1.FeedService.DeleteFeed:input_type -> feed.v1.DeleteFeedRequest
+	2, // 7: feed.v1.FeedService.CreateFeed:output_type -> feed.v1.CreateFeedResponse
+	4, // 8: feed.v1.FeedService.ListFeeds:output_type -> feed.v1.ListFeedsResponse
+	6, // 9: feed.v1.FeedService.DeleteFeed:output_type -> feed.v1.DeleteFeedResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_feed_v1_feed_proto_init() }
+func file_feed_v1_feed_proto_init() {
+	if File_feed_v1_feed_proto != nil {
+		return
+	}
+	file_feed_v1_feed_proto_msgTypes[0].OneofWrappers = []any{
+		(*Feed_TagId)(nil),
+		(*Feed_SavedFilterId)(nil),
+	}
+	file_feed_v1_feed_proto_msgTypes[1].OneofWrappers = []any{
+		(*CreateFeedRequest_TagId)(nil),
+		(*CreateFeedRequest_SavedFilterId)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_feed_v1_feed_proto_rawDesc), len(file_feed_v1_feed_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_feed_v1_feed_proto_goTypes,
+		DependencyIndexes: file_feed_v1_feed_proto_depIdxs,
+		MessageInfos:      file_feed_v1_feed_proto_msgTypes,
+	}.Build()
+	File_feed_v1_feed_proto = out.File
+	file_feed_v1_feed_proto_goTypes = nil
+	file_feed_v1_feed_proto_depIdxs = nil
+}
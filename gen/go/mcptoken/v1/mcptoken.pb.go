@@ -32,6 +32,7 @@ type MCPToken struct {
 	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // optional, null means never expires
 	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // optional
 	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Limits        *MCPTokenLimits        `protobuf:"bytes,8,opt,name=limits,proto3" json:"limits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -115,18 +116,83 @@ func (x *MCPToken) GetIsActive() bool {
 	return false
 }
 
+func (x *MCPToken) GetLimits() *MCPTokenLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// MCPTokenLimits caps how much an agent may do with a token, so a
+// misbehaving agent cannot flood the account. 0 means unlimited.
+type MCPTokenLimits struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RPCs the token may make per minute; further calls fail with
+	// RESOURCE_EXHAUSTED and a retry-after header
+	RequestsPerMinute int32 `protobuf:"varint,1,opt,name=requests_per_minute,json=requestsPerMinute,proto3" json:"requests_per_minute,omitempty"`
+	// RPCs changing data the token may make per UTC day
+	DailyMutationBudget int32 `protobuf:"varint,2,opt,name=daily_mutation_budget,json=dailyMutationBudget,proto3" json:"daily_mutation_budget,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MCPTokenLimits) Reset() {
+	*x = MCPTokenLimits{}
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MCPTokenLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MCPTokenLimits) ProtoMessage() {}
+
+func (x *MCPTokenLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MCPTokenLimits.ProtoReflect.Descriptor instead.
+func (*MCPTokenLimits) Descriptor() ([]byte, []int) {
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MCPTokenLimits) GetRequestsPerMinute() int32 {
+	if x != nil {
+		return x.RequestsPerMinute
+	}
+	return 0
+}
+
+func (x *MCPTokenLimits) GetDailyMutationBudget() int32 {
+	if x != nil {
+		return x.DailyMutationBudget
+	}
+	return 0
+}
+
 // CreateMCPTokenRequest is the request message for creating an MCP token
 type CreateMCPTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // optional, null means never expires
+	Limits        *MCPTokenLimits        `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`                        // optional, null means unlimited
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateMCPTokenRequest) Reset() {
 	*x = CreateMCPTokenRequest{}
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[1]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -138,7 +204,7 @@ func (x *CreateMCPTokenRequest) String() string {
 func (*CreateMCPTokenRequest) ProtoMessage() {}
 
 func (x *CreateMCPTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[1]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,7 +217,7 @@ func (x *CreateMCPTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMCPTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateMCPTokenRequest) Descriptor() ([]byte, []int) {
-	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{1}
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateMCPTokenRequest) GetName() string {
@@ -168,6 +234,13 @@ func (x *CreateMCPTokenRequest) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CreateMCPTokenRequest) GetLimits() *MCPTokenLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
 // CreateMCPTokenResponse is the response message for creating an MCP token
 type CreateMCPTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -178,7 +251,7 @@ type CreateMCPTokenResponse struct {
 
 func (x *CreateMCPTokenResponse) Reset() {
 	*x = CreateMCPTokenResponse{}
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[2]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -190,7 +263,7 @@ func (x *CreateMCPTokenResponse) String() string {
 func (*CreateMCPTokenResponse) ProtoMessage() {}
 
 func (x *CreateMCPTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[2]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,7 +276,7 @@ func (x *CreateMCPTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMCPTokenResponse.ProtoReflect.Descriptor instead.
 func (*CreateMCPTokenResponse) Descriptor() ([]byte, []int) {
-	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{2}
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateMCPTokenResponse) GetToken() *MCPToken {
@@ -223,7 +296,7 @@ type GetMCPTokenRequest struct {
 
 func (x *GetMCPTokenRequest) Reset() {
 	*x = GetMCPTokenRequest{}
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[3]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -235,7 +308,7 @@ func (x *GetMCPTokenRequest) String() string {
 func (*GetMCPTokenRequest) ProtoMessage() {}
 
 func (x *GetMCPTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[3]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,7 +321,7 @@ func (x *GetMCPTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMCPTokenRequest.ProtoReflect.Descriptor instead.
 func (*GetMCPTokenRequest) Descriptor() ([]byte, []int) {
-	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{3}
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetMCPTokenRequest) GetId() string {
@@ -268,7 +341,7 @@ type GetMCPTokenResponse struct {
 
 func (x *GetMCPTokenResponse) Reset() {
 	*x = GetMCPTokenResponse{}
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[4]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -280,7 +353,7 @@ func (x *GetMCPTokenResponse) String() string {
 func (*GetMCPTokenResponse) ProtoMessage() {}
 
 func (x *GetMCPTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[4]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,7 +366,7 @@ func (x *GetMCPTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMCPTokenResponse.ProtoReflect.Descriptor instead.
 func (*GetMCPTokenResponse) Descriptor() ([]byte, []int) {
-	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{4}
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetMCPTokenResponse) GetToken() *MCPToken {
@@ -312,7 +385,7 @@ type ListMCPTokensRequest struct {
 
 func (x *ListMCPTokensRequest) Reset() {
 	*x = ListMCPTokensRequest{}
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[5]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -324,7 +397,7 @@ func (x *ListMCPTokensRequest) String() string {
 func (*ListMCPTokensRequest) ProtoMessage() {}
 
 func (x *ListMCPTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[5]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -337,7 +410,7 @@ func (x *ListMCPTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMCPTokensRequest.ProtoReflect.Descriptor instead.
 func (*ListMCPTokensRequest) Descriptor() ([]byte, []int) {
-	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{5}
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{6}
 }
 
 // ListMCPTokensResponse is the response message for listing MCP tokens
@@ -350,7 +423,7 @@ type ListMCPTokensResponse struct {
 
 func (x *ListMCPTokensResponse) Reset() {
 	*x = ListMCPTokensResponse{}
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[6]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -362,7 +435,7 @@ func (x *ListMCPTokensResponse) String() string {
 func (*ListMCPTokensResponse) ProtoMessage() {}
 
 func (x *ListMCPTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[6]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,7 +448,7 @@ func (x *ListMCPTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMCPTokensResponse.ProtoReflect.Descriptor instead.
 func (*ListMCPTokensResponse) Descriptor() ([]byte, []int) {
-	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{6}
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListMCPTokensResponse) GetTokens() []*MCPToken {
@@ -385,6 +458,106 @@ func (x *ListMCPTokensResponse) GetTokens() []*MCPToken {
 	return nil
 }
 
+// UpdateMCPTokenLimitsRequest is the request message for changing the limits
+// of an MCP token
+type UpdateMCPTokenLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limits        *MCPTokenLimits        `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"` // replaces the current limits; null means unlimited
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMCPTokenLimitsRequest) Reset() {
+	*x = UpdateMCPTokenLimitsRequest{}
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMCPTokenLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMCPTokenLimitsRequest) ProtoMessage() {}
+
+func (x *UpdateMCPTokenLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMCPTokenLimitsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMCPTokenLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateMCPTokenLimitsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMCPTokenLimitsRequest) GetLimits() *MCPTokenLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// UpdateMCPTokenLimitsResponse is the response message for changing the
+// limits of an MCP token
+type UpdateMCPTokenLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *MCPToken              `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMCPTokenLimitsResponse) Reset() {
+	*x = UpdateMCPTokenLimitsResponse{}
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMCPTokenLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMCPTokenLimitsResponse) ProtoMessage() {}
+
+func (x *UpdateMCPTokenLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMCPTokenLimitsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMCPTokenLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateMCPTokenLimitsResponse) GetToken() *MCPToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
 // RevokeMCPTokenRequest is the request message for revoking an MCP token
 type RevokeMCPTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -395,7 +568,7 @@ type RevokeMCPTokenRequest struct {
 
 func (x *RevokeMCPTokenRequest) Reset() {
 	*x = RevokeMCPTokenRequest{}
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[7]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +580,7 @@ func (x *RevokeMCPTokenRequest) String() string {
 func (*RevokeMCPTokenRequest) ProtoMessage() {}
 
 func (x *RevokeMCPTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[7]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +593,7 @@ func (x *RevokeMCPTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeMCPTokenRequest.ProtoReflect.Descriptor instead.
 func (*RevokeMCPTokenRequest) Descriptor() ([]byte, []int) {
-	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{7}
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RevokeMCPTokenRequest) GetId() string {
@@ -439,7 +612,7 @@ type RevokeMCPTokenResponse struct {
 
 func (x *RevokeMCPTokenResponse) Reset() {
 	*x = RevokeMCPTokenResponse{}
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[8]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -451,7 +624,7 @@ func (x *RevokeMCPTokenResponse) String() string {
 func (*RevokeMCPTokenResponse) ProtoMessage() {}
 
 func (x *RevokeMCPTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[8]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,7 +637,7 @@ func (x *RevokeMCPTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeMCPTokenResponse.ProtoReflect.Descriptor instead.
 func (*RevokeMCPTokenResponse) Descriptor() ([]byte, []int) {
-	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{8}
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{11}
 }
 
 // DeleteMCPTokenRequest is the request message for deleting an MCP token
@@ -477,7 +650,7 @@ type DeleteMCPTokenRequest struct {
 
 func (x *DeleteMCPTokenRequest) Reset() {
 	*x = DeleteMCPTokenRequest{}
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[9]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -489,7 +662,7 @@ func (x *DeleteMCPTokenRequest) String() string {
 func (*DeleteMCPTokenRequest) ProtoMessage() {}
 
 func (x *DeleteMCPTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[9]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,7 +675,7 @@ func (x *DeleteMCPTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMCPTokenRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMCPTokenRequest) Descriptor() ([]byte, []int) {
-	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{9}
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteMCPTokenRequest) GetId() string {
@@ -521,7 +694,7 @@ type DeleteMCPTokenResponse struct {
 
 func (x *DeleteMCPTokenResponse) Reset() {
 	*x = DeleteMCPTokenResponse{}
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[10]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -533,7 +706,7 @@ func (x *DeleteMCPTokenResponse) String() string {
 func (*DeleteMCPTokenResponse) ProtoMessage() {}
 
 func (x *DeleteMCPTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[10]
+	mi := &file_mcptoken_v1_mcptoken_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,14 +719,14 @@ func (x *DeleteMCPTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMCPTokenResponse.ProtoReflect.Descriptor instead.
 func (*DeleteMCPTokenResponse) Descriptor() ([]byte, []int) {
-	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{10}
+	return file_mcptoken_v1_mcptoken_proto_rawDescGZIP(), []int{13}
 }
 
 var File_mcptoken_v1_mcptoken_proto protoreflect.FileDescriptor
 
 const file_mcptoken_v1_mcptoken_proto_rawDesc = "" +
 	"\n" +
-	"\x1amcptoken/v1/mcptoken.proto\x12\vmcptoken.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x95\x02\n" +
+	"\x1amcptoken/v1/mcptoken.proto\x12\vmcptoken.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xca\x02\n" +
 	"\bMCPToken\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12\x12\n" +
@@ -564,11 +737,16 @@ const file_mcptoken_v1_mcptoken_proto_rawDesc = "" +
 	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
 	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"lastUsedAt\x12\x1b\n" +
-	"\tis_active\x18\a \x01(\bR\bisActive\"f\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x123\n" +
+	"\x06limits\x18\b \x01(\v2\x1b.mcptoken.v1.MCPTokenLimitsR\x06limits\"t\n" +
+	"\x0eMCPTokenLimits\x12.\n" +
+	"\x13requests_per_minute\x18\x01 \x01(\x05R\x11requestsPerMinute\x122\n" +
+	"\x15daily_mutation_budget\x18\x02 \x01(\x05R\x13dailyMutationBudget\"\x9b\x01\n" +
 	"\x15CreateMCPTokenRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
 	"\n" +
-	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"E\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x123\n" +
+	"\x06limits\x18\x03 \x01(\v2\x1b.mcptoken.v1.MCPTokenLimitsR\x06limits\"E\n" +
 	"\x16CreateMCPTokenResponse\x12+\n" +
 	"\x05token\x18\x01 \x01(\v2\x15.mcptoken.v1.MCPTokenR\x05token\"$\n" +
 	"\x12GetMCPTokenRequest\x12\x0e\n" +
@@ -577,17 +755,23 @@ const file_mcptoken_v1_mcptoken_proto_rawDesc = "" +
 	"\x05token\x18\x01 \x01(\v2\x15.mcptoken.v1.MCPTokenR\x05token\"\x16\n" +
 	"\x14ListMCPTokensRequest\"F\n" +
 	"\x15ListMCPTokensResponse\x12-\n" +
-	"\x06tokens\x18\x01 \x03(\v2\x15.mcptoken.v1.MCPTokenR\x06tokens\"'\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x15.mcptoken.v1.MCPTokenR\x06tokens\"b\n" +
+	"\x1bUpdateMCPTokenLimitsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\x06limits\x18\x02 \x01(\v2\x1b.mcptoken.v1.MCPTokenLimitsR\x06limits\"K\n" +
+	"\x1cUpdateMCPTokenLimitsResponse\x12+\n" +
+	"\x05token\x18\x01 \x01(\v2\x15.mcptoken.v1.MCPTokenR\x05token\"'\n" +
 	"\x15RevokeMCPTokenRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
 	"\x16RevokeMCPTokenResponse\"'\n" +
 	"\x15DeleteMCPTokenRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
-	"\x16DeleteMCPTokenResponse2\xd6\x03\n" +
+	"\x16DeleteMCPTokenResponse2\xc5\x04\n" +
 	"\x0fMCPTokenService\x12[\n" +
 	"\x0eCreateMCPToken\x12\".mcptoken.v1.CreateMCPTokenRequest\x1a#.mcptoken.v1.CreateMCPTokenResponse\"\x00\x12R\n" +
 	"\vGetMCPToken\x12\x1f.mcptoken.v1.GetMCPTokenRequest\x1a .mcptoken.v1.GetMCPTokenResponse\"\x00\x12X\n" +
-	"\rListMCPTokens\x12!.mcptoken.v1.ListMCPTokensRequest\x1a\".mcptoken.v1.ListMCPTokensResponse\"\x00\x12[\n" +
+	"\rListMCPTokens\x12!.mcptoken.v1.ListMCPTokensRequest\x1a\".mcptoken.v1.ListMCPTokensResponse\"\x00\x12m\n" +
+	"\x14UpdateMCPTokenLimits\x12(.mcptoken.v1.UpdateMCPTokenLimitsRequest\x1a).mcptoken.v1.UpdateMCPTokenLimitsResponse\"\x00\x12[\n" +
 	"\x0eRevokeMCPToken\x12\".mcptoken.v1.RevokeMCPTokenRequest\x1a#.mcptoken.v1.RevokeMCPTokenResponse\"\x00\x12[\n" +
 	"\x0eDeleteMCPToken\x12\".mcptoken.v1.DeleteMCPTokenRequest\x1a#.mcptoken.v1.DeleteMCPTokenResponse\"\x00B\xab\x01\n" +
 	"\x0fcom.mcptoken.v1B\rMcptokenProtoP\x01Z<github.com/slips-ai/slips-core/gen/go/mcptoken/v1;mcptokenv1\xa2\x02\x03MXX\xaa\x02\vMcptoken.V1\xca\x02\vMcptoken\\V1\xe2\x02\x17Mcptoken\\V1\\GPBMetadata\xea\x02\fMcptoken::V1b\x06proto3"
@@ -604,44 +788,53 @@ func file_mcptoken_v1_mcptoken_proto_rawDescGZIP() []byte {
 	return file_mcptoken_v1_mcptoken_proto_rawDescData
 }
 
-var file_mcptoken_v1_mcptoken_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_mcptoken_v1_mcptoken_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_mcptoken_v1_mcptoken_proto_goTypes = []any{
-	(*MCPToken)(nil),               // 0: mcptoken.v1.MCPToken
-	(*CreateMCPTokenRequest)(nil),  // 1: mcptoken.v1.CreateMCPTokenRequest
-	(*CreateMCPTokenResponse)(nil), // 2: mcptoken.v1.CreateMCPTokenResponse
-	(*GetMCPTokenRequest)(nil),     // 3: mcptoken.v1.GetMCPTokenRequest
-	(*GetMCPTokenResponse)(nil),    // 4: mcptoken.v1.GetMCPTokenResponse
-	(*ListMCPTokensRequest)(nil),   // 5: mcptoken.v1.ListMCPTokensRequest
-	(*ListMCPTokensResponse)(nil),  // 6: mcptoken.v1.ListMCPTokensResponse
-	(*RevokeMCPTokenRequest)(nil),  // 7: mcptoken.v1.RevokeMCPTokenRequest
-	(*RevokeMCPTokenResponse)(nil), // 8: mcptoken.v1.RevokeMCPTokenResponse
-	(*DeleteMCPTokenRequest)(nil),  // 9: mcptoken.v1.DeleteMCPTokenRequest
-	(*DeleteMCPTokenResponse)(nil), // 10: mcptoken.v1.DeleteMCPTokenResponse
-	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
+	(*MCPToken)(nil),                     // 0: mcptoken.v1.MCPToken
+	(*MCPTokenLimits)(nil),               // 1: mcptoken.v1.MCPTokenLimits
+	(*CreateMCPTokenRequest)(nil),        // 2: mcptoken.v1.CreateMCPTokenRequest
+	(*CreateMCPTokenResponse)(nil),       // 3: mcptoken.v1.CreateMCPTokenResponse
+	(*GetMCPTokenRequest)(nil),           // 4: mcptoken.v1.GetMCPTokenRequest
+	(*GetMCPTokenResponse)(nil),          // 5: mcptoken.v1.GetMCPTokenResponse
+	(*ListMCPTokensRequest)(nil),         // 6: mcptoken.v1.ListMCPTokensRequest
+	(*ListMCPTokensResponse)(nil),        // 7: mcptoken.v1.ListMCPTokensResponse
+	(*UpdateMCPTokenLimitsRequest)(nil),  // 8: mcptoken.v1.UpdateMCPTokenLimitsRequest
+	(*UpdateMCPTokenLimitsResponse)(nil), // 9: mcptoken.v1.UpdateMCPTokenLimitsResponse
+	(*RevokeMCPTokenRequest)(nil),        // 10: mcptoken.v1.RevokeMCPTokenRequest
+	(*RevokeMCPTokenResponse)(nil),       // 11: mcptoken.v1.RevokeMCPTokenResponse
+	(*DeleteMCPTokenRequest)(nil),        // 12: mcptoken.v1.DeleteMCPTokenRequest
+	(*DeleteMCPTokenResponse)(nil),       // 13: mcptoken.v1.DeleteMCPTokenResponse
+	(*timestamppb.Timestamp)(nil),        // 14: google.protobuf.Timestamp
 }
 var file_mcptoken_v1_mcptoken_proto_depIdxs = []int32{
-	11, // 0: mcptoken.v1.MCPToken.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: mcptoken.v1.MCPToken.expires_at:type_name -> google.protobuf.Timestamp
-	11, // 2: mcptoken.v1.MCPToken.last_used_at:type_name -> google.protobuf.Timestamp
-	11, // 3: mcptoken.v1.CreateMCPTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: mcptoken.v1.CreateMCPTokenResponse.token:type_name -> mcptoken.v1.MCPToken
-	0,  // 5: mcptoken.v1.GetMCPTokenResponse.token:type_name -> mcptoken.v1.MCPToken
-	0,  // 6: mcptoken.v1.ListMCPTokensResponse.tokens:type_name -> mcptoken.v1.MCPToken
-	1,  // 7: mcptoken.v1.MCPTokenService.CreateMCPToken:input_type -> mcptoken.v1.CreateMCPTokenRequest
-	3,  // 8: mcptoken.v1.MCPTokenService.GetMCPToken:input_type -> mcptoken.v1.GetMCPTokenRequest
-	5,  // 9: mcptoken.v1.MCPTokenService.ListMCPTokens:input_type -> mcptoken.v1.ListMCPTokensRequest
-	7,  // 10: mcptoken.v1.MCPTokenService.RevokeMCPToken:input_type -> mcptoken.v1.RevokeMCPTokenRequest
-	9,  // 11: mcptoken.v1.MCPTokenService.DeleteMCPToken:input_type -> mcptoken.v1.DeleteMCPTokenRequest
-	2,  // 12: mcptoken.v1.MCPTokenService.CreateMCPToken:output_type -> mcptoken.v1.CreateMCPTokenResponse
-	4,  // 13: mcptoken.v1.MCPTokenService.GetMCPToken:output_type -> mcptoken.v1.GetMCPTokenResponse
-	6,  // 14: mcptoken.v1.MCPTokenService.ListMCPTokens:output_type -> mcptoken.v1.ListMCPTokensResponse
-	8,  // 15: mcptoken.v1.MCPTokenService.RevokeMCPToken:output_type -> mcptoken.v1.RevokeMCPTokenResponse
-	10, // 16: mcptoken.v1.MCPTokenService.DeleteMCPToken:output_type -> mcptoken.v1.DeleteMCPTokenResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	14, // 0: mcptoken.v1.MCPToken.created_at:type_name -> google.protobuf.Timestamp
+	14, // 1: mcptoken.v1.MCPToken.expires_at:type_name -> google.protobuf.Timestamp
+	14, // 2: mcptoken.v1.MCPToken.last_used_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: mcptoken.v1.MCPToken.limits:type_name -> mcptoken.v1.MCPTokenLimits
+	14, // 4: mcptoken.v1.CreateMCPTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: mcptoken.v1.CreateMCPTokenRequest.limits:type_name -> mcptoken.v1.MCPTokenLimits
+	0,  // 6: mcptoken.v1.CreateMCPTokenResponse.token:type_name -> mcptoken.v1.MCPToken
+	0,  // 7: mcptoken.v1.GetMCPTokenResponse.token:type_name -> mcptoken.v1.MCPToken
+	0,  // 8: mcptoken.v1.ListMCPTokensResponse.tokens:type_name -> mcptoken.v1.MCPToken
+	1,  // 9: mcptoken.v1.UpdateMCPTokenLimitsRequest.limits:type_name -> mcptoken.v1.MCPTokenLimits
+	0,  // 10: mcptoken.v1.UpdateMCPTokenLimitsResponse.token:type_name -> mcptoken.v1.MCPToken
+	2,  // 11: mcptoken.v1.MCPTokenService.CreateMCPToken:input_type -> mcptoken.v1.CreateMCPTokenRequest
+	4,  // 12: mcptoken.v1.MCPTokenService.GetMCPToken:input_type -> mcptoken.v1.GetMCPTokenRequest
+	6,  // 13: mcptoken.v1.MCPTokenService.ListMCPTokens:input_type -> mcptoken.v1.ListMCPTokensRequest
+	8,  // 14: mcptoken.v1.MCPTokenService.UpdateMCPTokenLimits:input_type -> mcptoken.v1.UpdateMCPTokenLimitsRequest
+	10, // 15: mcptoken.v1.MCPTokenService.RevokeMCPToken:input_type -> mcptoken.v1.RevokeMCPTokenRequest
+	12, // 16: mcptoken.v1.MCPTokenService.DeleteMCPToken:input_type -> mcptoken.v1.DeleteMCPTokenRequest
+	3,  // 17: mcptoken.v1.MCPTokenService.CreateMCPToken:output_type -> mcptoken.v1.CreateMCPTokenResponse
+	5,  // 18: mcptoken.v1.MCPTokenService.GetMCPToken:output_type -> mcptoken.v1.GetMCPTokenResponse
+	7,  // 19: mcptoken.v1.MCPTokenService.ListMCPTokens:output_type -> mcptoken.v1.ListMCPTokensResponse
+	9,  // 20: mcptoken.v1.MCPTokenService.UpdateMCPTokenLimits:output_type -> mcptoken.v1.UpdateMCPTokenLimitsResponse
+	11, // 21: mcptoken.v1.MCPTokenService.RevokeMCPToken:output_type -> mcptoken.v1.RevokeMCPTokenResponse
+	13, // 22: mcptoken.v1.MCPTokenService.DeleteMCPToken:output_type -> mcptoken.v1.DeleteMCPTokenResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_mcptoken_v1_mcptoken_proto_init() }
@@ -655,7 +848,7 @@ func file_mcptoken_v1_mcptoken_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mcptoken_v1_mcptoken_proto_rawDesc), len(file_mcptoken_v1_mcptoken_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
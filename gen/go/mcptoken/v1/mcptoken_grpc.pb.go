@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MCPTokenService_CreateMCPToken_FullMethodName = "/mcptoken.v1.MCPTokenService/CreateMCPToken"
-	MCPTokenService_GetMCPToken_FullMethodName    = "/mcptoken.v1.MCPTokenService/GetMCPToken"
-	MCPTokenService_ListMCPTokens_FullMethodName  = "/mcptoken.v1.MCPTokenService/ListMCPTokens"
-	MCPTokenService_RevokeMCPToken_FullMethodName = "/mcptoken.v1.MCPTokenService/RevokeMCPToken"
-	MCPTokenService_DeleteMCPToken_FullMethodName = "/mcptoken.v1.MCPTokenService/DeleteMCPToken"
+	MCPTokenService_CreateMCPToken_FullMethodName       = "/mcptoken.v1.MCPTokenService/CreateMCPToken"
+	MCPTokenService_GetMCPToken_FullMethodName          = "/mcptoken.v1.MCPTokenService/GetMCPToken"
+	MCPTokenService_ListMCPTokens_FullMethodName        = "/mcptoken.v1.MCPTokenService/ListMCPTokens"
+	MCPTokenService_UpdateMCPTokenLimits_FullMethodName = "/mcptoken.v1.MCPTokenService/UpdateMCPTokenLimits"
+	MCPTokenService_RevokeMCPToken_FullMethodName       = "/mcptoken.v1.MCPTokenService/RevokeMCPToken"
+	MCPTokenService_DeleteMCPToken_FullMethodName       = "/mcptoken.v1.MCPTokenService/DeleteMCPToken"
 )
 
 // MCPTokenServiceClient is the client API for MCPTokenService service.
@@ -35,6 +36,7 @@ type MCPTokenServiceClient interface {
 	CreateMCPToken(ctx context.Context, in *CreateMCPTokenRequest, opts ...grpc.CallOption) (*CreateMCPTokenResponse, error)
 	GetMCPToken(ctx context.Context, in *GetMCPTokenRequest, opts ...grpc.CallOption) (*GetMCPTokenResponse, error)
 	ListMCPTokens(ctx context.Context, in *ListMCPTokensRequest, opts ...grpc.CallOption) (*ListMCPTokensResponse, error)
+	UpdateMCPTokenLimits(ctx context.Context, in *UpdateMCPTokenLimitsRequest, opts ...grpc.CallOption) (*UpdateMCPTokenLimitsResponse, error)
 	RevokeMCPToken(ctx context.Context, in *RevokeMCPTokenRequest, opts ...grpc.CallOption) (*RevokeMCPTokenResponse, error)
 	DeleteMCPToken(ctx context.Context, in *DeleteMCPTokenRequest, opts ...grpc.CallOption) (*DeleteMCPTokenResponse, error)
 }
@@ -77,6 +79,16 @@ func (c *mCPTokenServiceClient) ListMCPTokens(ctx context.Context, in *ListMCPTo
 	return out, nil
 }
 
+func (c *mCPTokenServiceClient) UpdateMCPTokenLimits(ctx context.Context, in *UpdateMCPTokenLimitsRequest, opts ...grpc.CallOption) (*UpdateMCPTokenLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMCPTokenLimitsResponse)
+	err := c.cc.Invoke(ctx, MCPTokenService_UpdateMCPTokenLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mCPTokenServiceClient) RevokeMCPToken(ctx context.Context, in *RevokeMCPTokenRequest, opts ...grpc.CallOption) (*RevokeMCPTokenResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RevokeMCPTokenResponse)
@@ -106,6 +118,7 @@ type MCPTokenServiceServer interface {
 	CreateMCPToken(context.Context, *CreateMCPTokenRequest) (*CreateMCPTokenResponse, error)
 	GetMCPToken(context.Context, *GetMCPTokenRequest) (*GetMCPTokenResponse, error)
 	ListMCPTokens(context.Context, *ListMCPTokensRequest) (*ListMCPTokensResponse, error)
+	UpdateMCPTokenLimits(context.Context, *UpdateMCPTokenLimitsRequest) (*UpdateMCPTokenLimitsResponse, error)
 	RevokeMCPToken(context.Context, *RevokeMCPTokenRequest) (*RevokeMCPTokenResponse, error)
 	DeleteMCPToken(context.Context, *DeleteMCPTokenRequest) (*DeleteMCPTokenResponse, error)
 	mustEmbedUnimplementedMCPTokenServiceServer()
@@ -127,6 +140,9 @@ func (UnimplementedMCPTokenServiceServer) GetMCPToken(context.Context, *GetMCPTo
 func (UnimplementedMCPTokenServiceServer) ListMCPTokens(context.Context, *ListMCPTokensRequest) (*ListMCPTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMCPTokens not implemented")
 }
+func (UnimplementedMCPTokenServiceServer) UpdateMCPTokenLimits(context.Context, *UpdateMCPTokenLimitsRequest) (*UpdateMCPTokenLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMCPTokenLimits not implemented")
+}
 func (UnimplementedMCPTokenServiceServer) RevokeMCPToken(context.Context, *RevokeMCPTokenRequest) (*RevokeMCPTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeMCPToken not implemented")
 }
@@ -208,6 +224,24 @@ func _MCPTokenService_ListMCPTokens_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MCPTokenService_UpdateMCPTokenLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMCPTokenLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCPTokenServiceServer).UpdateMCPTokenLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MCPTokenService_UpdateMCPTokenLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCPTokenServiceServer).UpdateMCPTokenLimits(ctx, req.(*UpdateMCPTokenLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MCPTokenService_RevokeMCPToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevokeMCPTokenRequest)
 	if err := dec(in); err != nil {
@@ -263,6 +297,10 @@ var MCPTokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMCPTokens",
 			Handler:    _MCPTokenService_ListMCPTokens_Handler,
 		},
+		{
+			MethodName: "UpdateMCPTokenLimits",
+			Handler:    _MCPTokenService_UpdateMCPTokenLimits_Handler,
+		},
 		{
 			MethodName: "RevokeMCPToken",
 			Handler:    _MCPTokenService_RevokeMCPToken_Handler,
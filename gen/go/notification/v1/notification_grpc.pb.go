@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: notification/v1/notification.proto
+
+package notificationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NotificationService_GetWebPushConfig_FullMethodName          = "/notification.v1.NotificationService/GetWebPushConfig"
+	NotificationService_CreateWebPushSubscription_FullMethodName = "/notification.v1.NotificationService/CreateWebPushSubscription"
+	NotificationService_ListWebPushSubscriptions_FullMethodName  = "/notification.v1.NotificationService/ListWebPushSubscriptions"
+	NotificationService_DeleteWebPushSubscription_FullMethodName = "/notification.v1.NotificationService/DeleteWebPushSubscription"
+	NotificationService_SendTestNotification_FullMethodName      = "/notification.v1.NotificationService/SendTestNotification"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NotificationService manages where the caller receives notifications
+type NotificationServiceClient interface {
+	GetWebPushConfig(ctx context.Context, in *GetWebPushConfigRequest, opts ...grpc.CallOption) (*GetWebPushConfigResponse, error)
+	// Registering an endpoint that already exists updates its keys
+	CreateWebPushSubscription(ctx context.Context, in *CreateWebPushSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebPushSubscriptionResponse, error)
+	ListWebPushSubscriptions(ctx context.Context, in *ListWebPushSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebPushSubscriptionsResponse, error)
+	DeleteWebPushSubscription(ctx context.Context, in *DeleteWebPushSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebPushSubscriptionResponse, error)
+	// Subscriptions the push service reports as expired are deleted
+	SendTestNotification(ctx context.Context, in *SendTestNotificationRequest, opts ...grpc.CallOption) (*SendTestNotificationResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) GetWebPushConfig(ctx context.Context, in *GetWebPushConfigRequest, opts ...grpc.CallOption) (*GetWebPushConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebPushConfigResponse)
+	err := c.cc.Invoke(ctx, NotificationService_GetWebPushConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CreateWebPushSubscription(ctx context.Context, in *CreateWebPushSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebPushSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebPushSubscriptionResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CreateWebPushSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListWebPushSubscriptions(ctx context.Context, in *ListWebPushSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebPushSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebPushSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListWebPushSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteWebPushSubscription(ctx context.Context, in *DeleteWebPushSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebPushSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebPushSubscriptionResponse)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteWebPushSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendTestNotification(ctx context.Context, in *SendTestNotificationRequest, opts ...grpc.CallOption) (*SendTestNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendTestNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SendTestNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+//
+// NotificationService manages where the caller receives notifications
+type NotificationServiceServer interface {
+	GetWebPushConfig(context.Context, *GetWebPushConfigRequest) (*GetWebPushConfigResponse, error)
+	// Registering an endpoint that already exists updates its keys
+	CreateWebPushSubscription(context.Context, *CreateWebPushSubscriptionRequest) (*CreateWebPushSubscriptionResponse, error)
+	ListWebPushSubscriptions(context.Context, *ListWebPushSubscriptionsRequest) (*ListWebPushSubscriptionsResponse, error)
+	DeleteWebPushSubscription(context.Context, *DeleteWebPushSubscriptionRequest) (*DeleteWebPushSubscriptionResponse, error)
+	// Subscriptions the push service reports as expired are deleted
+	SendTestNotification(context.Context, *SendTestNotificationRequest) (*SendTestNotificationResponse, error)
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+// UnimplementedNotificationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) GetWebPushConfig(context.Context, *GetWebPushConfigRequest) (*GetWebPushConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebPushConfig not implemented")
+}
+func (UnimplementedNotificationServiceServer) CreateWebPushSubscription(context.Context, *CreateWebPushSubscriptionRequest) (*CreateWebPushSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebPushSubscription not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListWebPushSubscriptions(context.Context, *ListWebPushSubscriptionsRequest) (*ListWebPushSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebPushSubscriptions not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteWebPushSubscription(context.Context, *DeleteWebPushSubscriptionRequest) (*DeleteWebPushSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebPushSubscription not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendTestNotification(context.Context, *SendTestNotificationRequest) (*SendTestNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTestNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_GetWebPushConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebPushConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetWebPushConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetWebPushConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetWebPushConfig(ctx, req.(*GetWebPushConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CreateWebPushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebPushSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CreateWebPushSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CreateWebPushSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CreateWebPushSubscription(ctx, req.(*CreateWebPushSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListWebPushSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebPushSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListWebPushSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListWebPushSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListWebPushSubscriptions(ctx, req.(*ListWebPushSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteWebPushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebPushSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteWebPushSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteWebPushSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteWebPushSubscription(ctx, req.(*DeleteWebPushSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendTestNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTestNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendTestNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SendTestNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendTestNotification(ctx, req.(*SendTestNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notification.v1.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWebPushConfig",
+			Handler:    _NotificationService_GetWebPushConfig_Handler,
+		},
+		{
+			MethodName: "CreateWebPushSubscription",
+			Handler:    _NotificationService_CreateWebPushSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebPushSubscriptions",
+			Handler:    _NotificationService_ListWebPushSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteWebPushSubscription",
+			Handler:    _NotificationService_DeleteWebPushSubscription_Handler,
+		},
+		{
+			MethodName: "SendTestNotification",
+			Handler:    _NotificationService_SendTestNotification_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notification/v1/notification.proto",
+}
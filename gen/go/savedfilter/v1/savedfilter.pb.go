@@ -0,0 +1,822 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: savedfilter/v1/savedfilter.proto
+
+package savedfilterv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FilterCriteria describes which tasks a saved filter matches
+type FilterCriteria struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TagIds              []string               `protobuf:"bytes,1,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`                                         // tasks carrying any of these tags
+	StartDateFrom       *string                `protobuf:"bytes,2,opt,name=start_date_from,json=startDateFrom,proto3,oneof" json:"start_date_from,omitempty"`            // format "YYYY-MM-DD", inclusive
+	StartDateTo         *string                `protobuf:"bytes,3,opt,name=start_date_to,json=startDateTo,proto3,oneof" json:"start_date_to,omitempty"`                  // format "YYYY-MM-DD", inclusive
+	DeadlineApproaching bool                   `protobuf:"varint,4,opt,name=deadline_approaching,json=deadlineApproaching,proto3" json:"deadline_approaching,omitempty"` // overdue or due soon, relative to execution time
+	Query               string                 `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`                                                         // text contained in title or notes
+	IncludeArchived     bool                   `protobuf:"varint,6,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	Contexts            []string               `protobuf:"bytes,7,rep,name=contexts,proto3" json:"contexts,omitempty"` // tasks in any of these contexts, e.g. "@errands"
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *FilterCriteria) Reset() {
+	*x = FilterCriteria{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterCriteria) ProtoMessage() {}
+
+func (x *FilterCriteria) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterCriteria.ProtoReflect.Descriptor instead.
+func (*FilterCriteria) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FilterCriteria) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *FilterCriteria) GetStartDateFrom() string {
+	if x != nil && x.StartDateFrom != nil {
+		return *x.StartDateFrom
+	}
+	return ""
+}
+
+func (x *FilterCriteria) GetStartDateTo() string {
+	if x != nil && x.StartDateTo != nil {
+		return *x.StartDateTo
+	}
+	return ""
+}
+
+func (x *FilterCriteria) GetDeadlineApproaching() bool {
+	if x != nil {
+		return x.DeadlineApproaching
+	}
+	return false
+}
+
+func (x *FilterCriteria) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *FilterCriteria) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *FilterCriteria) GetContexts() []string {
+	if x != nil {
+		return x.Contexts
+	}
+	return nil
+}
+
+// SavedFilter represents a named, persisted task filter
+type SavedFilter struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Criteria  *FilterCriteria        `protobuf:"bytes,3,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// users/{user}/savedFilters/{filter}. Output only: requests take the id,
+	// and name holds the filter's display name.
+	ResourceName  string `protobuf:"bytes,6,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedFilter) Reset() {
+	*x = SavedFilter{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedFilter) ProtoMessage() {}
+
+func (x *SavedFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedFilter.ProtoReflect.Descriptor instead.
+func (*SavedFilter) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SavedFilter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetCriteria() *FilterCriteria {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *SavedFilter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SavedFilter) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SavedFilter) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+// CreateSavedFilterRequest is the request message for creating a saved filter
+type CreateSavedFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Criteria      *FilterCriteria        `protobuf:"bytes,2,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedFilterRequest) Reset() {
+	*x = CreateSavedFilterRequest{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedFilterRequest) ProtoMessage() {}
+
+func (x *CreateSavedFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedFilterRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedFilterRequest) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateSavedFilterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavedFilterRequest) GetCriteria() *FilterCriteria {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+// CreateSavedFilterResponse is the response message for creating a saved filter
+type CreateSavedFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedFilter   *SavedFilter           `protobuf:"bytes,1,opt,name=saved_filter,json=savedFilter,proto3" json:"saved_filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedFilterResponse) Reset() {
+	*x = CreateSavedFilterResponse{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedFilterResponse) ProtoMessage() {}
+
+func (x *CreateSavedFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedFilterResponse.ProtoReflect.Descriptor instead.
+func (*CreateSavedFilterResponse) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSavedFilterResponse) GetSavedFilter() *SavedFilter {
+	if x != nil {
+		return x.SavedFilter
+	}
+	return nil
+}
+
+// GetSavedFilterRequest is the request message for getting a saved filter
+type GetSavedFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedFilterRequest) Reset() {
+	*x = GetSavedFilterRequest{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedFilterRequest) ProtoMessage() {}
+
+func (x *GetSavedFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedFilterRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedFilterRequest) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSavedFilterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetSavedFilterResponse is the response message for getting a saved filter
+type GetSavedFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedFilter   *SavedFilter           `protobuf:"bytes,1,opt,name=saved_filter,json=savedFilter,proto3" json:"saved_filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedFilterResponse) Reset() {
+	*x = GetSavedFilterResponse{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedFilterResponse) ProtoMessage() {}
+
+func (x *GetSavedFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedFilterResponse.ProtoReflect.Descriptor instead.
+func (*GetSavedFilterResponse) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSavedFilterResponse) GetSavedFilter() *SavedFilter {
+	if x != nil {
+		return x.SavedFilter
+	}
+	return nil
+}
+
+// UpdateSavedFilterRequest is the request message for updating a saved filter
+type UpdateSavedFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Criteria      *FilterCriteria        `protobuf:"bytes,3,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavedFilterRequest) Reset() {
+	*x = UpdateSavedFilterRequest{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedFilterRequest) ProtoMessage() {}
+
+func (x *UpdateSavedFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedFilterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedFilterRequest) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateSavedFilterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSavedFilterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSavedFilterRequest) GetCriteria() *FilterCriteria {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+// UpdateSavedFilterResponse is the response message for updating a saved filter
+type UpdateSavedFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedFilter   *SavedFilter           `protobuf:"bytes,1,opt,name=saved_filter,json=savedFilter,proto3" json:"saved_filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavedFilterResponse) Reset() {
+	*x = UpdateSavedFilterResponse{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedFilterResponse) ProtoMessage() {}
+
+func (x *UpdateSavedFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedFilterResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSavedFilterResponse) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateSavedFilterResponse) GetSavedFilter() *SavedFilter {
+	if x != nil {
+		return x.SavedFilter
+	}
+	return nil
+}
+
+// DeleteSavedFilterRequest is the request message for deleting a saved filter
+type DeleteSavedFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedFilterRequest) Reset() {
+	*x = DeleteSavedFilterRequest{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedFilterRequest) ProtoMessage() {}
+
+func (x *DeleteSavedFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedFilterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedFilterRequest) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteSavedFilterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteSavedFilterResponse is the response message for deleting a saved filter
+type DeleteSavedFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedFilterResponse) Reset() {
+	*x = DeleteSavedFilterResponse{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedFilterResponse) ProtoMessage() {}
+
+func (x *DeleteSavedFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedFilterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedFilterResponse) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{9}
+}
+
+// ListSavedFiltersRequest is the request message for listing saved filters
+type ListSavedFiltersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedFiltersRequest) Reset() {
+	*x = ListSavedFiltersRequest{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedFiltersRequest) ProtoMessage() {}
+
+func (x *ListSavedFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedFiltersRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSavedFiltersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSavedFiltersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListSavedFiltersResponse is the response message for listing saved filters
+type ListSavedFiltersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedFilters  []*SavedFilter         `protobuf:"bytes,1,rep,name=saved_filters,json=savedFilters,proto3" json:"saved_filters,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedFiltersResponse) Reset() {
+	*x = ListSavedFiltersResponse{}
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedFiltersResponse) ProtoMessage() {}
+
+func (x *ListSavedFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_savedfilter_v1_savedfilter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedFiltersResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_savedfilter_v1_savedfilter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSavedFiltersResponse) GetSavedFilters() []*SavedFilter {
+	if x != nil {
+		return x.SavedFilters
+	}
+	return nil
+}
+
+func (x *ListSavedFiltersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_savedfilter_v1_savedfilter_proto protoreflect.FileDescriptor
+
+const file_savedfilter_v1_savedfilter_proto_rawDesc = "" +
+	"\n" +
+	" savedfilter/v1/savedfilter.proto\x12\x0esavedfilter.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb5\x02\n" +
+	"\x0eFilterCriteria\x12\x17\n" +
+	"\atag_ids\x18\x01 \x03(\tR\x06tagIds\x12+\n" +
+	"\x0fstart_date_from\x18\x02 \x01(\tH\x00R\rstartDateFrom\x88\x01\x01\x12'\n" +
+	"\rstart_date_to\x18\x03 \x01(\tH\x01R\vstartDateTo\x88\x01\x01\x121\n" +
+	"\x14deadline_approaching\x18\x04 \x01(\bR\x13deadlineApproaching\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x12)\n" +
+	"\x10include_archived\x18\x06 \x01(\bR\x0fincludeArchived\x12\x1a\n" +
+	"\bcontexts\x18\a \x03(\tR\bcontextsB\x12\n" +
+	"\x10_start_date_fromB\x10\n" +
+	"\x0e_start_date_to\"\x88\x02\n" +
+	"\vSavedFilter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12:\n" +
+	"\bcriteria\x18\x03 \x01(\v2\x1e.savedfilter.v1.FilterCriteriaR\bcriteria\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rresource_name\x18\x06 \x01(\tR\fresourceName\"j\n" +
+	"\x18CreateSavedFilterRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12:\n" +
+	"\bcriteria\x18\x02 \x01(\v2\x1e.savedfilter.v1.FilterCriteriaR\bcriteria\"[\n" +
+	"\x19CreateSavedFilterResponse\x12>\n" +
+	"\fsaved_filter\x18\x01 \x01(\v2\x1b.savedfilter.v1.SavedFilterR\vsavedFilter\"'\n" +
+	"\x15GetSavedFilterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"X\n" +
+	"\x16GetSavedFilterResponse\x12>\n" +
+	"\fsaved_filter\x18\x01 \x01(\v2\x1b.savedfilter.v1.SavedFilterR\vsavedFilter\"z\n" +
+	"\x18UpdateSavedFilterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12:\n" +
+	"\bcriteria\x18\x03 \x01(\v2\x1e.savedfilter.v1.FilterCriteriaR\bcriteria\"[\n" +
+	"\x19UpdateSavedFilterResponse\x12>\n" +
+	"\fsaved_filter\x18\x01 \x01(\v2\x1b.savedfilter.v1.SavedFilterR\vsavedFilter\"*\n" +
+	"\x18DeleteSavedFilterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1b\n" +
+	"\x19DeleteSavedFilterResponse\"U\n" +
+	"\x17ListSavedFiltersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x84\x01\n" +
+	"\x18ListSavedFiltersResponse\x12@\n" +
+	"\rsaved_filters\x18\x01 \x03(\v2\x1b.savedfilter.v1.SavedFilterR\fsavedFilters\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\x9a\x04\n" +
+	"\x12SavedFilterService\x12h\n" +
+	"\x11CreateSavedFilter\x12(.savedfilter.v1.CreateSavedFilterRequest\x1a).savedfilter.v1.CreateSavedFilterResponse\x12_\n" +
+	"\x0eGetSavedFilter\x12%.savedfilter.v1.GetSavedFilterRequest\x1a&.savedfilter.v1.GetSavedFilterResponse\x12h\n" +
+	"\x11UpdateSavedFilter\x12(.savedfilter.v1.UpdateSavedFilterRequest\x1a).savedfilter.v1.UpdateSavedFilterResponse\x12h\n" +
+	"\x11DeleteSavedFilter\x12(.savedfilter.v1.DeleteSavedFilterRequest\x1a).savedfilter.v1.DeleteSavedFilterResponse\x12e\n" +
+	"\x10ListSavedFilters\x12'.savedfilter.v1.ListSavedFiltersRequest\x1a(.savedfilter.v1.ListSavedFiltersResponseB\xc3\x01\n" +
+	"\x12com.savedfilter.v1B\x10SavedfilterProtoP\x01ZBgithub.com/slips-ai/slips-core/gen/go/savedfilter/v1;savedfilterv1\xa2\x02\x03SXX\xaa\x02\x0eSavedfilter.V1\xca\x02\x0eSavedfilter\\V1\xe2\x02\x1aSavedfilter\\V1\\GPBMetadata\xea\x02\x0fSavedfilter::V1b\x06proto3"
+
+var (
+	file_savedfilter_v1_savedfilter_proto_rawDescOnce sync.Once
+	file_savedfilter_v1_savedfilter_proto_rawDescData []byte
+)
+
+func file_savedfilter_v1_savedfilter_proto_rawDescGZIP() []byte {
+	file_savedfilter_v1_savedfilter_proto_rawDescOnce.Do(func() {
+		file_savedfilter_v1_savedfilter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_savedfilter_v1_savedfilter_proto_rawDesc), len(file_savedfilter_v1_savedfilter_proto_rawDesc)))
+	})
+	return file_savedfilter_v1_savedfilter_proto_rawDescData
+}
+
+var file_savedfilter_v1_savedfilter_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_savedfilter_v1_savedfilter_proto_goTypes = []any{
+	(*FilterCriteria)(nil),            // 0: savedfilter.v1.FilterCriteria
+	(*SavedFilter)(nil),               // 1: savedfilter.v1.SavedFilter
+	(*CreateSavedFilterRequest)(nil),  // 2: savedfilter.v1.CreateSavedFilterRequest
+	(*CreateSavedFilterResponse)(nil), // 3: savedfilter.v1.CreateSavedFilterResponse
+	(*GetSavedFilterRequest)(nil),     // 4: savedfilter.v1.GetSavedFilterRequest
+	(*GetSavedFilterResponse)(nil),    // 5: savedfilter.v1.GetSavedFilterResponse
+	(*UpdateSavedFilterRequest)(nil),  // 6: savedfilter.v1.UpdateSavedFilterRequest
+	(*UpdateSavedFilterResponse)(nil), // 7: savedfilter.v1.UpdateSavedFilterResponse
+	(*DeleteSavedFilterRequest)(nil),  // 8: savedfilter.v1.DeleteSavedFilterRequest
+	(*DeleteSavedFilterResponse)(nil), // 9: savedfilter.v1.DeleteSavedFilterResponse
+	(*ListSavedFiltersRequest)(nil),   // 10: savedfilter.v1.ListSavedFiltersRequest
+	(*ListSavedFiltersResponse)(nil),  // 11: savedfilter.v1.ListSavedFiltersResponse
+	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
+}
+var file_savedfilter_v1_savedfilter_proto_depIdxs = []int32{
+	0,  // 0: savedfilter.v1.SavedFilter.criteria:type_name -> savedfilter.v1.FilterCriteria
+	12, // 1: savedfilter.v1.SavedFilter.created_at:type_name -> google.protobuf.Timestamp
+	12, // 2: savedfilter.v1.SavedFilter.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: savedfilter.v1.CreateSavedFilterRequest.criteria:type_name -> savedfilter.v1.FilterCriteria
+	1,  // 4: savedfilter.v1.CreateSavedFilterResponse.saved_filter:type_name -> savedfilter.v1.SavedFilter
+	1,  // 5: savedfilter.v1.GetSavedFilterResponse.saved_filter:type_name -> savedfilter.v1.SavedFilter
+	0,  // 6: savedfilter.v1.UpdateSavedFilterRequest.criteria:type_name -> savedfilter.v1.FilterCriteria
+	1,  // 7: savedfilter.v1.UpdateSavedFilterResponse.saved_filter:type_name -> savedfilter.v1.SavedFilter
+	1,  // 8: savedfilter.v1.ListSavedFiltersResponse.saved_filters:type_name -> savedfilter.v1.SavedFilter
+	2,  // 9: savedfilter.v1.SavedFilterService.CreateSavedFilter:input_type -> savedfilter.v1.CreateSavedFilterRequest
+	4,  // 10: savedfilter.v1.SavedFilterService.GetSavedFilter:input_type -> savedfilter.v1.GetSavedFilterRequest
+	6,  // 11: savedfilter.v1.SavedFilterService.UpdateSavedFilter:input_type -> savedfilter.v1.UpdateSavedFilterRequest
+	8,  // 12: savedfilter.v1.SavedFilterService.DeleteSavedFilter:input_type -> savedfilter.v1.DeleteSavedFilterRequest
+	10, // 13: savedfilter.v1.SavedFilterService.ListSavedFilters:input_type -> savedfilter.v1.ListSavedFiltersRequest
+	3,  // 14: savedfilter.v1.SavedFilterService.CreateSavedFilter:output_type -> savedfilter.v1.CreateSavedFilterResponse
+	5,  // 15: savedfilter.v1.SavedFilterService.GetSavedFilter:output_type -> savedfilter.v1.GetSavedFilterResponse
+	7,  // 16: savedfilter.v1.SavedFilterService.UpdateSavedFilter:output_type -> savedfilter.v1.UpdateSavedFilterResponse
+	9,  // 17: savedfilter.v1.SavedFilterService.DeleteSavedFilter:output_type -> savedfilter.v1.DeleteSavedFilterResponse
+	11, // 18: savedfilter.v1.SavedFilterService.ListSavedFilters:output_type -> savedfilter.v1.ListSavedFiltersResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_savedfilter_v1_savedfilter_proto_init() }
+func file_savedfilter_v1_savedfilter_proto_init() {
+	if File_savedfilter_v1_savedfilter_proto != nil {
+		return
+	}
+	file_savedfilter_v1_savedfilter_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_savedfilter_v1_savedfilter_proto_rawDesc), len(file_savedfilter_v1_savedfilter_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_savedfilter_v1_savedfilter_proto_goTypes,
+		DependencyIndexes: file_savedfilter_v1_savedfilter_proto_depIdxs,
+		MessageInfos:      file_savedfilter_v1_savedfilter_proto_msgTypes,
+	}.Build()
+	File_savedfilter_v1_savedfilter_proto = out.File
+	file_savedfilter_v1_savedfilter_proto_goTypes = nil
+	file_savedfilter_v1_savedfilter_proto_depIdxs = nil
+}
@@ -0,0 +1,310 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: streak/v1/streak.proto
+
+package streakv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetStreaksRequest is the request message for getting streaks
+type GetStreaksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStreaksRequest) Reset() {
+	*x = GetStreaksRequest{}
+	mi := &file_streak_v1_streak_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStreaksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreaksRequest) ProtoMessage() {}
+
+func (x *GetStreaksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streak_v1_streak_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreaksRequest.ProtoReflect.Descriptor instead.
+func (*GetStreaksRequest) Descriptor() ([]byte, []int) {
+	return file_streak_v1_streak_proto_rawDescGZIP(), []int{0}
+}
+
+// GetStreaksResponse is the response message for getting streaks.
+// Days are UTC calendar days.
+type GetStreaksResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CurrentStreakDays  int32                  `protobuf:"varint,1,opt,name=current_streak_days,json=currentStreakDays,proto3" json:"current_streak_days,omitempty"` // consecutive completion days ending today or yesterday
+	LongestStreakDays  int32                  `protobuf:"varint,2,opt,name=longest_streak_days,json=longestStreakDays,proto3" json:"longest_streak_days,omitempty"`
+	LastCompletionDate *string                `protobuf:"bytes,3,opt,name=last_completion_date,json=lastCompletionDate,proto3,oneof" json:"last_completion_date,omitempty"` // format "YYYY-MM-DD"
+	WeeklyGoal         *int32                 `protobuf:"varint,4,opt,name=weekly_goal,json=weeklyGoal,proto3,oneof" json:"weekly_goal,omitempty"`                          // null when no goal is set
+	CompletedThisWeek  int32                  `protobuf:"varint,5,opt,name=completed_this_week,json=completedThisWeek,proto3" json:"completed_this_week,omitempty"`         // completions since Monday 00:00 UTC
+	WeeklyGoalMet      bool                   `protobuf:"varint,6,opt,name=weekly_goal_met,json=weeklyGoalMet,proto3" json:"weekly_goal_met,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetStreaksResponse) Reset() {
+	*x = GetStreaksResponse{}
+	mi := &file_streak_v1_streak_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStreaksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreaksResponse) ProtoMessage() {}
+
+func (x *GetStreaksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streak_v1_streak_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreaksResponse.ProtoReflect.Descriptor instead.
+func (*GetStreaksResponse) Descriptor() ([]byte, []int) {
+	return file_streak_v1_streak_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetStreaksResponse) GetCurrentStreakDays() int32 {
+	if x != nil {
+		return x.CurrentStreakDays
+	}
+	return 0
+}
+
+func (x *GetStreaksResponse) GetLongestStreakDays() int32 {
+	if x != nil {
+		return x.LongestStreakDays
+	}
+	return 0
+}
+
+func (x *GetStreaksResponse) GetLastCompletionDate() string {
+	if x != nil && x.LastCompletionDate != nil {
+		return *x.LastCompletionDate
+	}
+	return ""
+}
+
+func (x *GetStreaksResponse) GetWeeklyGoal() int32 {
+	if x != nil && x.WeeklyGoal != nil {
+		return *x.WeeklyGoal
+	}
+	return 0
+}
+
+func (x *GetStreaksResponse) GetCompletedThisWeek() int32 {
+	if x != nil {
+		return x.CompletedThisWeek
+	}
+	return 0
+}
+
+func (x *GetStreaksResponse) GetWeeklyGoalMet() bool {
+	if x != nil {
+		return x.WeeklyGoalMet
+	}
+	return false
+}
+
+// SetWeeklyGoalRequest is the request message for setting the weekly completion goal
+type SetWeeklyGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WeeklyGoal    int32                  `protobuf:"varint,1,opt,name=weekly_goal,json=weeklyGoal,proto3" json:"weekly_goal,omitempty"` // 0 clears the goal
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWeeklyGoalRequest) Reset() {
+	*x = SetWeeklyGoalRequest{}
+	mi := &file_streak_v1_streak_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWeeklyGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWeeklyGoalRequest) ProtoMessage() {}
+
+func (x *SetWeeklyGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streak_v1_streak_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWeeklyGoalRequest.ProtoReflect.Descriptor instead.
+func (*SetWeeklyGoalRequest) Descriptor() ([]byte, []int) {
+	return file_streak_v1_streak_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetWeeklyGoalRequest) GetWeeklyGoal() int32 {
+	if x != nil {
+		return x.WeeklyGoal
+	}
+	return 0
+}
+
+// SetWeeklyGoalResponse is the response message for setting the weekly completion goal
+type SetWeeklyGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWeeklyGoalResponse) Reset() {
+	*x = SetWeeklyGoalResponse{}
+	mi := &file_streak_v1_streak_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWeeklyGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWeeklyGoalResponse) ProtoMessage() {}
+
+func (x *SetWeeklyGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streak_v1_streak_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWeeklyGoalResponse.ProtoReflect.Descriptor instead.
+func (*SetWeeklyGoalResponse) Descriptor() ([]byte, []int) {
+	return file_streak_v1_streak_proto_rawDescGZIP(), []int{3}
+}
+
+var File_streak_v1_streak_proto protoreflect.FileDescriptor
+
+const file_streak_v1_streak_proto_rawDesc = "" +
+	"\n" +
+	"\x16streak/v1/streak.proto\x12\tstreak.v1\"\x13\n" +
+	"\x11GetStreaksRequest\"\xd2\x02\n" +
+	"\x12GetStreaksResponse\x12.\n" +
+	"\x13current_streak_days\x18\x01 \x01(\x05R\x11currentStreakDays\x12.\n" +
+	"\x13longest_streak_days\x18\x02 \x01(\x05R\x11longestStreakDays\x125\n" +
+	"\x14last_completion_date\x18\x03 \x01(\tH\x00R\x12lastCompletionDate\x88\x01\x01\x12$\n" +
+	"\vweekly_goal\x18\x04 \x01(\x05H\x01R\n" +
+	"weeklyGoal\x88\x01\x01\x12.\n" +
+	"\x13completed_this_week\x18\x05 \x01(\x05R\x11completedThisWeek\x12&\n" +
+	"\x0fweekly_goal_met\x18\x06 \x01(\bR\rweeklyGoalMetB\x17\n" +
+	"\x15_last_completion_dateB\x0e\n" +
+	"\f_weekly_goal\"7\n" +
+	"\x14SetWeeklyGoalRequest\x12\x1f\n" +
+	"\vweekly_goal\x18\x01 \x01(\x05R\n" +
+	"weeklyGoal\"\x17\n" +
+	"\x15SetWeeklyGoalResponse2\xae\x01\n" +
+	"\rStreakService\x12I\n" +
+	"\n" +
+	"GetStreaks\x12\x1c.streak.v1.GetStreaksRequest\x1a\x1d.streak.v1.GetStreaksResponse\x12R\n" +
+	"\rSetWeeklyGoal\x12\x1f.streak.v1.SetWeeklyGoalRequest\x1a .streak.v1.SetWeeklyGoalResponseB\x9b\x01\n" +
+	"\rcom.streak.v1B\vStreakProtoP\x01Z8github.com/slips-ai/slips-core/gen/go/streak/v1;streakv1\xa2\x02\x03SXX\xaa\x02\tStreak.V1\xca\x02\tStreak\\V1\xe2\x02\x15Streak\\V1\\GPBMetadata\xea\x02\n" +
+	"Streak::V1b\x06proto3"
+
+var (
+	file_streak_v1_streak_proto_rawDescOnce sync.Once
+	file_streak_v1_streak_proto_rawDescData []byte
+)
+
+func file_streak_v1_streak_proto_rawDescGZIP() []byte {
+	file_streak_v1_streak_proto_rawDescOnce.Do(func() {
+		file_streak_v1_streak_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_streak_v1_streak_proto_rawDesc), len(file_streak_v1_streak_proto_rawDesc)))
+	})
+	return file_streak_v1_streak_proto_rawDescData
+}
+
+var file_streak_v1_streak_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_streak_v1_streak_proto_goTypes = []any{
+	(*GetStreaksRequest)(nil),     // 0: streak.v1.GetStreaksRequest
+	(*GetStreaksResponse)(nil),    // 1: streak.v1.GetStreaksResponse
+	(*SetWeeklyGoalRequest)(nil),  // 2: streak.v1.SetWeeklyGoalRequest
+	(*SetWeeklyGoalResponse)(nil), // 3: streak.v1.SetWeeklyGoalResponse
+}
+var file_streak_v1_streak_proto_depIdxs = []int32{
+	0, // 0: streak.v1.StreakService.GetStreaks:input_type -> streak.v1.GetStreaksRequest
+	2, // 1: streak.v1.StreakService.SetWeeklyGoal:input_type -> streak.v1.SetWeeklyGoalRequest
+	1, // 2: streak.v1.StreakService.GetStreaks:output_type -> streak.v1.GetStreaksResponse
+	3, // 3: streak.v1.StreakService.SetWeeklyGoal:output_type -> streak.v1.SetWeeklyGoalResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_streak_v1_streak_proto_init() }
+func file_streak_v1_streak_proto_init() {
+	if File_streak_v1_streak_proto != nil {
+		return
+	}
+	file_streak_v1_streak_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_streak_v1_streak_proto_rawDesc), len(file_streak_v1_streak_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_streak_v1_streak_proto_goTypes,
+		DependencyIndexes: file_streak_v1_streak_proto_depIdxs,
+		MessageInfos:      file_streak_v1_streak_proto_msgTypes,
+	}.Build()
+	File_streak_v1_streak_proto = out.File
+	file_streak_v1_streak_proto_goTypes = nil
+	file_streak_v1_streak_proto_depIdxs = nil
+}
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: streak/v1/streak.proto
+
+package streakv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StreakService_GetStreaks_FullMethodName    = "/streak.v1.StreakService/GetStreaks"
+	StreakService_SetWeeklyGoal_FullMethodName = "/streak.v1.StreakService/SetWeeklyGoal"
+)
+
+// StreakServiceClient is the client API for StreakService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// StreakService tracks daily completion streaks and weekly goals
+type StreakServiceClient interface {
+	GetStreaks(ctx context.Context, in *GetStreaksRequest, opts ...grpc.CallOption) (*GetStreaksResponse, error)
+	SetWeeklyGoal(ctx context.Context, in *SetWeeklyGoalRequest, opts ...grpc.CallOption) (*SetWeeklyGoalResponse, error)
+}
+
+type streakServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreakServiceClient(cc grpc.ClientConnInterface) StreakServiceClient {
+	return &streakServiceClient{cc}
+}
+
+func (c *streakServiceClient) GetStreaks(ctx context.Context, in *GetStreaksRequest, opts ...grpc.CallOption) (*GetStreaksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStreaksResponse)
+	err := c.cc.Invoke(ctx, StreakService_GetStreaks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streakServiceClient) SetWeeklyGoal(ctx context.Context, in *SetWeeklyGoalRequest, opts ...grpc.CallOption) (*SetWeeklyGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetWeeklyGoalResponse)
+	err := c.cc.Invoke(ctx, StreakService_SetWeeklyGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StreakServiceServer is the server API for StreakService service.
+// All implementations must embed UnimplementedStreakServiceServer
+// for forward compatibility.
+//
+// StreakService tracks daily completion streaks and weekly goals
+type StreakServiceServer interface {
+	GetStreaks(context.Context, *GetStreaksRequest) (*GetStreaksResponse, error)
+	SetWeeklyGoal(context.Context, *SetWeeklyGoalRequest) (*SetWeeklyGoalResponse, error)
+	mustEmbedUnimplementedStreakServiceServer()
+}
+
+// UnimplementedStreakServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStreakServiceServer struct{}
+
+func (UnimplementedStreakServiceServer) GetStreaks(context.Context, *GetStreaksRequest) (*GetStreaksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStreaks not implemented")
+}
+func (UnimplementedStreakServiceServer) SetWeeklyGoal(context.Context, *SetWeeklyGoalRequest) (*SetWeeklyGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWeeklyGoal not implemented")
+}
+func (UnimplementedStreakServiceServer) mustEmbedUnimplementedStreakServiceServer() {}
+func (UnimplementedStreakServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeStreakServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreakServiceServer will
+// result in compilation errors.
+type UnsafeStreakServiceServer interface {
+	mustEmbedUnimplementedStreakServiceServer()
+}
+
+func RegisterStreakServiceServer(s grpc.ServiceRegistrar, srv StreakServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStreakServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StreakService_ServiceDesc, srv)
+}
+
+func _StreakService_GetStreaks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStreaksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreakServiceServer).GetStreaks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreakService_GetStreaks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreakServiceServer).GetStreaks(ctx, req.(*GetStreaksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreakService_SetWeeklyGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWeeklyGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreakServiceServer).SetWeeklyGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreakService_SetWeeklyGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreakServiceServer).SetWeeklyGoal(ctx, req.(*SetWeeklyGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StreakService_ServiceDesc is the grpc.ServiceDesc for StreakService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StreakService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "streak.v1.StreakService",
+	HandlerType: (*StreakServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStreaks",
+			Handler:    _StreakService_GetStreaks_Handler,
+		},
+		{
+			MethodName: "SetWeeklyGoal",
+			Handler:    _StreakService_SetWeeklyGoal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "streak/v1/streak.proto",
+}
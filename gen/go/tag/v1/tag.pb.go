@@ -22,13 +22,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// OrphanTagCleanup selects when tags no longer used by any task are deleted.
+// Cleanup runs in a periodic server job, not when a task changes.
+type OrphanTagCleanup int32
+
+const (
+	OrphanTagCleanup_ORPHAN_TAG_CLEANUP_UNSPECIFIED OrphanTagCleanup = 0 // treated as IMMEDIATE
+	OrphanTagCleanup_ORPHAN_TAG_CLEANUP_IMMEDIATE   OrphanTagCleanup = 1 // on the next cleanup run once unused for an hour
+	OrphanTagCleanup_ORPHAN_TAG_CLEANUP_AFTER_DAYS  OrphanTagCleanup = 2 // once unused for orphan_cleanup_after_days
+	OrphanTagCleanup_ORPHAN_TAG_CLEANUP_NEVER       OrphanTagCleanup = 3 // only when deleted with DeleteTag
+)
+
+// Enum value maps for OrphanTagCleanup.
+var (
+	OrphanTagCleanup_name = map[int32]string{
+		0: "ORPHAN_TAG_CLEANUP_UNSPECIFIED",
+		1: "ORPHAN_TAG_CLEANUP_IMMEDIATE",
+		2: "ORPHAN_TAG_CLEANUP_AFTER_DAYS",
+		3: "ORPHAN_TAG_CLEANUP_NEVER",
+	}
+	OrphanTagCleanup_value = map[string]int32{
+		"ORPHAN_TAG_CLEANUP_UNSPECIFIED": 0,
+		"ORPHAN_TAG_CLEANUP_IMMEDIATE":   1,
+		"ORPHAN_TAG_CLEANUP_AFTER_DAYS":  2,
+		"ORPHAN_TAG_CLEANUP_NEVER":       3,
+	}
+)
+
+func (x OrphanTagCleanup) Enum() *OrphanTagCleanup {
+	p := new(OrphanTagCleanup)
+	*p = x
+	return p
+}
+
+func (x OrphanTagCleanup) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrphanTagCleanup) Descriptor() protoreflect.EnumDescriptor {
+	return file_tag_v1_tag_proto_enumTypes[0].Descriptor()
+}
+
+func (OrphanTagCleanup) Type() protoreflect.EnumType {
+	return &file_tag_v1_tag_proto_enumTypes[0]
+}
+
+func (x OrphanTagCleanup) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrphanTagCleanup.Descriptor instead.
+func (OrphanTagCleanup) EnumDescriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{0}
+}
+
 // Tag represents a tag entity
 type Tag struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ClientRequestId string                 `protobuf:"bytes,5,opt,name=client_request_id,json=clientRequestId,proto3" json:"client_request_id,omitempty"` // set when the tag was created with one
+	// users/{user}/tags/{tag}, the tag's name in task.v2. Output only: v1
+	// requests take the id, and name holds the tag's display name.
+	ResourceName  string `protobuf:"bytes,6,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -91,12 +149,29 @@ func (x *Tag) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Tag) GetClientRequestId() string {
+	if x != nil {
+		return x.ClientRequestId
+	}
+	return ""
+}
+
+func (x *Tag) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
 // CreateTagRequest is the request message for creating a tag
 type CreateTagRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional ID chosen by the client, unique per user. Creating a tag with
+	// an ID that was used before returns that tag instead of a duplicate.
+	ClientRequestId string `protobuf:"bytes,2,opt,name=client_request_id,json=clientRequestId,proto3" json:"client_request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateTagRequest) Reset() {
@@ -136,6 +211,13 @@ func (x *CreateTagRequest) GetName() string {
 	return ""
 }
 
+func (x *CreateTagRequest) GetClientRequestId() string {
+	if x != nil {
+		return x.ClientRequestId
+	}
+	return ""
+}
+
 // CreateTagResponse is the response message for creating a tag
 type CreateTagResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -181,17 +263,177 @@ func (x *CreateTagResponse) GetTag() *Tag {
 	return nil
 }
 
+// CreateTagsRequest is the request message for getting or creating several
+// tags at once
+type CreateTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagsRequest) Reset() {
+	*x = CreateTagsRequest{}
+	mi := &file_tag_v1_tag_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagsRequest) ProtoMessage() {}
+
+func (x *CreateTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagsRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagsRequest) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTagsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// CreateTagsResponse is the response message for getting or creating several
+// tags at once
+type CreateTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*CreateTagsResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // one per name, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagsResponse) Reset() {
+	*x = CreateTagsResponse{}
+	mi := &file_tag_v1_tag_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagsResponse) ProtoMessage() {}
+
+func (x *CreateTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagsResponse.ProtoReflect.Descriptor instead.
+func (*CreateTagsResponse) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTagsResponse) GetResults() []*CreateTagsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// CreateTagsResult is the tag a requested name resolved to
+type CreateTagsResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag   *Tag                   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// created is false when the tag already existed, or for repeats of a name
+	// earlier in the request.
+	Created       bool `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagsResult) Reset() {
+	*x = CreateTagsResult{}
+	mi := &file_tag_v1_tag_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagsResult) ProtoMessage() {}
+
+func (x *CreateTagsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagsResult.ProtoReflect.Descriptor instead.
+func (*CreateTagsResult) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTagsResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTagsResult) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *CreateTagsResult) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
 // GetTagRequest is the request message for getting a tag
 type GetTagRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recent_task_count asks for up to this many of the tag's most recently
+	// updated tasks, at most 50; none when 0.
+	RecentTaskCount int32 `protobuf:"varint,2,opt,name=recent_task_count,json=recentTaskCount,proto3" json:"recent_task_count,omitempty"`
+	// include_stats asks for the tag's task counts.
+	IncludeStats  bool `protobuf:"varint,3,opt,name=include_stats,json=includeStats,proto3" json:"include_stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTagRequest) Reset() {
 	*x = GetTagRequest{}
-	mi := &file_tag_v1_tag_proto_msgTypes[3]
+	mi := &file_tag_v1_tag_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -203,7 +445,7 @@ func (x *GetTagRequest) String() string {
 func (*GetTagRequest) ProtoMessage() {}
 
 func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tag_v1_tag_proto_msgTypes[3]
+	mi := &file_tag_v1_tag_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -216,7 +458,7 @@ func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTagRequest.ProtoReflect.Descriptor instead.
 func (*GetTagRequest) Descriptor() ([]byte, []int) {
-	return file_tag_v1_tag_proto_rawDescGZIP(), []int{3}
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetTagRequest) GetId() string {
@@ -226,17 +468,36 @@ func (x *GetTagRequest) GetId() string {
 	return ""
 }
 
+func (x *GetTagRequest) GetRecentTaskCount() int32 {
+	if x != nil {
+		return x.RecentTaskCount
+	}
+	return 0
+}
+
+func (x *GetTagRequest) GetIncludeStats() bool {
+	if x != nil {
+		return x.IncludeStats
+	}
+	return false
+}
+
 // GetTagResponse is the response message for getting a tag
 type GetTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tag   *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// recent_tasks are most recently updated first; archived tasks are
+	// included. Use TaskService.GetTask for the whole task.
+	RecentTasks []*TagTask `protobuf:"bytes,2,rep,name=recent_tasks,json=recentTasks,proto3" json:"recent_tasks,omitempty"`
+	// stats is set when include_stats was.
+	Stats         *TagTaskStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTagResponse) Reset() {
 	*x = GetTagResponse{}
-	mi := &file_tag_v1_tag_proto_msgTypes[4]
+	mi := &file_tag_v1_tag_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +509,7 @@ func (x *GetTagResponse) String() string {
 func (*GetTagResponse) ProtoMessage() {}
 
 func (x *GetTagResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tag_v1_tag_proto_msgTypes[4]
+	mi := &file_tag_v1_tag_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +522,7 @@ func (x *GetTagResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTagResponse.ProtoReflect.Descriptor instead.
 func (*GetTagResponse) Descriptor() ([]byte, []int) {
-	return file_tag_v1_tag_proto_rawDescGZIP(), []int{4}
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetTagResponse) GetTag() *Tag {
@@ -271,6 +532,174 @@ func (x *GetTagResponse) GetTag() *Tag {
 	return nil
 }
 
+func (x *GetTagResponse) GetRecentTasks() []*TagTask {
+	if x != nil {
+		return x.RecentTasks
+	}
+	return nil
+}
+
+func (x *GetTagResponse) GetStats() *TagTaskStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// TagTask summarizes a task carrying a tag, as listed on the tag's page
+type TagTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"` // null means the task is open
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	Pinned        bool                   `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	StartDate     *string                `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // format "YYYY-MM-DD", null means inbox
+	Deadline      *string                `protobuf:"bytes,8,opt,name=deadline,proto3,oneof" json:"deadline,omitempty"`                    // format "YYYY-MM-DD", null means no deadline
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagTask) Reset() {
+	*x = TagTask{}
+	mi := &file_tag_v1_tag_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagTask) ProtoMessage() {}
+
+func (x *TagTask) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagTask.ProtoReflect.Descriptor instead.
+func (*TagTask) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TagTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TagTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TagTask) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TagTask) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *TagTask) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *TagTask) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *TagTask) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *TagTask) GetDeadline() string {
+	if x != nil && x.Deadline != nil {
+		return *x.Deadline
+	}
+	return ""
+}
+
+// TagTaskStats counts the tasks carrying a tag
+type TagTaskStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OpenCount      int32                  `protobuf:"varint,1,opt,name=open_count,json=openCount,proto3" json:"open_count,omitempty"`                // neither completed nor archived
+	CompletedCount int32                  `protobuf:"varint,2,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"` // including archived ones
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TagTaskStats) Reset() {
+	*x = TagTaskStats{}
+	mi := &file_tag_v1_tag_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagTaskStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagTaskStats) ProtoMessage() {}
+
+func (x *TagTaskStats) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagTaskStats.ProtoReflect.Descriptor instead.
+func (*TagTaskStats) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TagTaskStats) GetOpenCount() int32 {
+	if x != nil {
+		return x.OpenCount
+	}
+	return 0
+}
+
+func (x *TagTaskStats) GetCompletedCount() int32 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
 // UpdateTagRequest is the request message for updating a tag
 type UpdateTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -282,7 +711,7 @@ type UpdateTagRequest struct {
 
 func (x *UpdateTagRequest) Reset() {
 	*x = UpdateTagRequest{}
-	mi := &file_tag_v1_tag_proto_msgTypes[5]
+	mi := &file_tag_v1_tag_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -294,7 +723,7 @@ func (x *UpdateTagRequest) String() string {
 func (*UpdateTagRequest) ProtoMessage() {}
 
 func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tag_v1_tag_proto_msgTypes[5]
+	mi := &file_tag_v1_tag_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +736,7 @@ func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
-	return file_tag_v1_tag_proto_rawDescGZIP(), []int{5}
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateTagRequest) GetId() string {
@@ -334,7 +763,7 @@ type UpdateTagResponse struct {
 
 func (x *UpdateTagResponse) Reset() {
 	*x = UpdateTagResponse{}
-	mi := &file_tag_v1_tag_proto_msgTypes[6]
+	mi := &file_tag_v1_tag_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -346,7 +775,7 @@ func (x *UpdateTagResponse) String() string {
 func (*UpdateTagResponse) ProtoMessage() {}
 
 func (x *UpdateTagResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tag_v1_tag_proto_msgTypes[6]
+	mi := &file_tag_v1_tag_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,7 +788,7 @@ func (x *UpdateTagResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTagResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTagResponse) Descriptor() ([]byte, []int) {
-	return file_tag_v1_tag_proto_rawDescGZIP(), []int{6}
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateTagResponse) GetTag() *Tag {
@@ -371,15 +800,26 @@ func (x *UpdateTagResponse) GetTag() *Tag {
 
 // DeleteTagRequest is the request message for deleting a tag
 type DeleteTagRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// What happens to the tasks carrying the tag. When neither is set the tag
+	// is stripped from them, as with strip_tasks.
+	//
+	// Types that are valid to be assigned to Tasks:
+	//
+	//	*DeleteTagRequest_ReassignToTagId
+	//	*DeleteTagRequest_StripTasks
+	Tasks isDeleteTagRequest_Tasks `protobuf_oneof:"tasks"`
+	// validate_only checks the request and returns affected_task_count
+	// without deleting the tag or changing its tasks
+	ValidateOnly  bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteTagRequest) Reset() {
 	*x = DeleteTagRequest{}
-	mi := &file_tag_v1_tag_proto_msgTypes[7]
+	mi := &file_tag_v1_tag_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -391,7 +831,7 @@ func (x *DeleteTagRequest) String() string {
 func (*DeleteTagRequest) ProtoMessage() {}
 
 func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tag_v1_tag_proto_msgTypes[7]
+	mi := &file_tag_v1_tag_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,7 +844,7 @@ func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
-	return file_tag_v1_tag_proto_rawDescGZIP(), []int{7}
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteTagRequest) GetId() string {
@@ -414,16 +854,68 @@ func (x *DeleteTagRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteTagRequest) GetTasks() isDeleteTagRequest_Tasks {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *DeleteTagRequest) GetReassignToTagId() string {
+	if x != nil {
+		if x, ok := x.Tasks.(*DeleteTagRequest_ReassignToTagId); ok {
+			return x.ReassignToTagId
+		}
+	}
+	return ""
+}
+
+func (x *DeleteTagRequest) GetStripTasks() bool {
+	if x != nil {
+		if x, ok := x.Tasks.(*DeleteTagRequest_StripTasks); ok {
+			return x.StripTasks
+		}
+	}
+	return false
+}
+
+func (x *DeleteTagRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type isDeleteTagRequest_Tasks interface {
+	isDeleteTagRequest_Tasks()
+}
+
+type DeleteTagRequest_ReassignToTagId struct {
+	// reassign_to_tag_id gives the tasks this tag in place of the deleted one.
+	ReassignToTagId string `protobuf:"bytes,2,opt,name=reassign_to_tag_id,json=reassignToTagId,proto3,oneof"`
+}
+
+type DeleteTagRequest_StripTasks struct {
+	// strip_tasks removes the tag from its tasks without replacing it.
+	StripTasks bool `protobuf:"varint,3,opt,name=strip_tasks,json=stripTasks,proto3,oneof"`
+}
+
+func (*DeleteTagRequest_ReassignToTagId) isDeleteTagRequest_Tasks() {}
+
+func (*DeleteTagRequest_StripTasks) isDeleteTagRequest_Tasks() {}
+
 // DeleteTagResponse is the response message for deleting a tag
 type DeleteTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// affected_task_count is the number of tasks that carried the tag.
+	AffectedTaskCount int64 `protobuf:"varint,1,opt,name=affected_task_count,json=affectedTaskCount,proto3" json:"affected_task_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *DeleteTagResponse) Reset() {
 	*x = DeleteTagResponse{}
-	mi := &file_tag_v1_tag_proto_msgTypes[8]
+	mi := &file_tag_v1_tag_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -435,7 +927,7 @@ func (x *DeleteTagResponse) String() string {
 func (*DeleteTagResponse) ProtoMessage() {}
 
 func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tag_v1_tag_proto_msgTypes[8]
+	mi := &file_tag_v1_tag_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -448,7 +940,14 @@ func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
-	return file_tag_v1_tag_proto_rawDescGZIP(), []int{8}
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteTagResponse) GetAffectedTaskCount() int64 {
+	if x != nil {
+		return x.AffectedTaskCount
+	}
+	return 0
 }
 
 // ListTagsRequest is the request message for listing tags
@@ -462,7 +961,7 @@ type ListTagsRequest struct {
 
 func (x *ListTagsRequest) Reset() {
 	*x = ListTagsRequest{}
-	mi := &file_tag_v1_tag_proto_msgTypes[9]
+	mi := &file_tag_v1_tag_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -474,7 +973,7 @@ func (x *ListTagsRequest) String() string {
 func (*ListTagsRequest) ProtoMessage() {}
 
 func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tag_v1_tag_proto_msgTypes[9]
+	mi := &file_tag_v1_tag_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +986,7 @@ func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
 func (*ListTagsRequest) Descriptor() ([]byte, []int) {
-	return file_tag_v1_tag_proto_rawDescGZIP(), []int{9}
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListTagsRequest) GetPageSize() int32 {
@@ -515,7 +1014,7 @@ type ListTagsResponse struct {
 
 func (x *ListTagsResponse) Reset() {
 	*x = ListTagsResponse{}
-	mi := &file_tag_v1_tag_proto_msgTypes[10]
+	mi := &file_tag_v1_tag_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -527,7 +1026,7 @@ func (x *ListTagsResponse) String() string {
 func (*ListTagsResponse) ProtoMessage() {}
 
 func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tag_v1_tag_proto_msgTypes[10]
+	mi := &file_tag_v1_tag_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +1039,7 @@ func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
 func (*ListTagsResponse) Descriptor() ([]byte, []int) {
-	return file_tag_v1_tag_proto_rawDescGZIP(), []int{10}
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListTagsResponse) GetTags() []*Tag {
@@ -557,48 +1056,333 @@ func (x *ListTagsResponse) GetNextPageToken() string {
 	return ""
 }
 
+// TagSettings holds the caller's tag preferences
+type TagSettings struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	OrphanCleanup          OrphanTagCleanup       `protobuf:"varint,1,opt,name=orphan_cleanup,json=orphanCleanup,proto3,enum=tag.v1.OrphanTagCleanup" json:"orphan_cleanup,omitempty"`
+	OrphanCleanupAfterDays int32                  `protobuf:"varint,2,opt,name=orphan_cleanup_after_days,json=orphanCleanupAfterDays,proto3" json:"orphan_cleanup_after_days,omitempty"` // 1-3650, only with ORPHAN_TAG_CLEANUP_AFTER_DAYS
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TagSettings) Reset() {
+	*x = TagSettings{}
+	mi := &file_tag_v1_tag_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSettings) ProtoMessage() {}
+
+func (x *TagSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSettings.ProtoReflect.Descriptor instead.
+func (*TagSettings) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TagSettings) GetOrphanCleanup() OrphanTagCleanup {
+	if x != nil {
+		return x.OrphanCleanup
+	}
+	return OrphanTagCleanup_ORPHAN_TAG_CLEANUP_UNSPECIFIED
+}
+
+func (x *TagSettings) GetOrphanCleanupAfterDays() int32 {
+	if x != nil {
+		return x.OrphanCleanupAfterDays
+	}
+	return 0
+}
+
+// GetTagSettingsRequest is the request message for getting tag settings
+type GetTagSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagSettingsRequest) Reset() {
+	*x = GetTagSettingsRequest{}
+	mi := &file_tag_v1_tag_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagSettingsRequest) ProtoMessage() {}
+
+func (x *GetTagSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTagSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{17}
+}
+
+// GetTagSettingsResponse is the response message for getting tag settings
+type GetTagSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TagSettings           `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagSettingsResponse) Reset() {
+	*x = GetTagSettingsResponse{}
+	mi := &file_tag_v1_tag_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagSettingsResponse) ProtoMessage() {}
+
+func (x *GetTagSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetTagSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTagSettingsResponse) GetSettings() *TagSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateTagSettingsRequest is the request message for updating tag settings
+type UpdateTagSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TagSettings           `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagSettingsRequest) Reset() {
+	*x = UpdateTagSettingsRequest{}
+	mi := &file_tag_v1_tag_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateTagSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateTagSettingsRequest) GetSettings() *TagSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateTagSettingsResponse is the response message for updating tag settings
+type UpdateTagSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TagSettings           `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagSettingsResponse) Reset() {
+	*x = UpdateTagSettingsResponse{}
+	mi := &file_tag_v1_tag_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateTagSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_v1_tag_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTagSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_tag_v1_tag_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateTagSettingsResponse) GetSettings() *TagSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
 var File_tag_v1_tag_proto protoreflect.FileDescriptor
 
 const file_tag_v1_tag_proto_rawDesc = "" +
 	"\n" +
-	"\x10tag/v1/tag.proto\x12\x06tag.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9f\x01\n" +
+	"\x10tag/v1/tag.proto\x12\x06tag.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf0\x01\n" +
 	"\x03Tag\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"&\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12*\n" +
+	"\x11client_request_id\x18\x05 \x01(\tR\x0fclientRequestId\x12#\n" +
+	"\rresource_name\x18\x06 \x01(\tR\fresourceName\"R\n" +
 	"\x10CreateTagRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"2\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12*\n" +
+	"\x11client_request_id\x18\x02 \x01(\tR\x0fclientRequestId\"2\n" +
 	"\x11CreateTagResponse\x12\x1d\n" +
-	"\x03tag\x18\x01 \x01(\v2\v.tag.v1.TagR\x03tag\"\x1f\n" +
+	"\x03tag\x18\x01 \x01(\v2\v.tag.v1.TagR\x03tag\")\n" +
+	"\x11CreateTagsRequest\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"H\n" +
+	"\x12CreateTagsResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.tag.v1.CreateTagsResultR\aresults\"_\n" +
+	"\x10CreateTagsResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\x03tag\x18\x02 \x01(\v2\v.tag.v1.TagR\x03tag\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\bR\acreated\"p\n" +
 	"\rGetTagRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11recent_task_count\x18\x02 \x01(\x05R\x0frecentTaskCount\x12#\n" +
+	"\rinclude_stats\x18\x03 \x01(\bR\fincludeStats\"\x8f\x01\n" +
 	"\x0eGetTagResponse\x12\x1d\n" +
-	"\x03tag\x18\x01 \x01(\v2\v.tag.v1.TagR\x03tag\"6\n" +
+	"\x03tag\x18\x01 \x01(\v2\v.tag.v1.TagR\x03tag\x122\n" +
+	"\frecent_tasks\x18\x02 \x03(\v2\x0f.tag.v1.TagTaskR\vrecentTasks\x12*\n" +
+	"\x05stats\x18\x03 \x01(\v2\x14.tag.v1.TagTaskStatsR\x05stats\"\x8a\x03\n" +
+	"\aTagTask\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\fcompleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vcompletedAt\x88\x01\x01\x12@\n" +
+	"\varchived_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"archivedAt\x88\x01\x01\x12\x16\n" +
+	"\x06pinned\x18\x06 \x01(\bR\x06pinned\x12\"\n" +
+	"\n" +
+	"start_date\x18\a \x01(\tH\x02R\tstartDate\x88\x01\x01\x12\x1f\n" +
+	"\bdeadline\x18\b \x01(\tH\x03R\bdeadline\x88\x01\x01B\x0f\n" +
+	"\r_completed_atB\x0e\n" +
+	"\f_archived_atB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_deadline\"V\n" +
+	"\fTagTaskStats\x12\x1d\n" +
+	"\n" +
+	"open_count\x18\x01 \x01(\x05R\topenCount\x12'\n" +
+	"\x0fcompleted_count\x18\x02 \x01(\x05R\x0ecompletedCount\"6\n" +
 	"\x10UpdateTagRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"2\n" +
 	"\x11UpdateTagResponse\x12\x1d\n" +
-	"\x03tag\x18\x01 \x01(\v2\v.tag.v1.TagR\x03tag\"\"\n" +
+	"\x03tag\x18\x01 \x01(\v2\v.tag.v1.TagR\x03tag\"\xa2\x01\n" +
 	"\x10DeleteTagRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x13\n" +
-	"\x11DeleteTagResponse\"M\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x12reassign_to_tag_id\x18\x02 \x01(\tH\x00R\x0freassignToTagId\x12!\n" +
+	"\vstrip_tasks\x18\x03 \x01(\bH\x00R\n" +
+	"stripTasks\x12#\n" +
+	"\rvalidate_only\x18\x04 \x01(\bR\fvalidateOnlyB\a\n" +
+	"\x05tasks\"C\n" +
+	"\x11DeleteTagResponse\x12.\n" +
+	"\x13affected_task_count\x18\x01 \x01(\x03R\x11affectedTaskCount\"M\n" +
 	"\x0fListTagsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\"[\n" +
 	"\x10ListTagsResponse\x12\x1f\n" +
 	"\x04tags\x18\x01 \x03(\v2\v.tag.v1.TagR\x04tags\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xca\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x89\x01\n" +
+	"\vTagSettings\x12?\n" +
+	"\x0eorphan_cleanup\x18\x01 \x01(\x0e2\x18.tag.v1.OrphanTagCleanupR\rorphanCleanup\x129\n" +
+	"\x19orphan_cleanup_after_days\x18\x02 \x01(\x05R\x16orphanCleanupAfterDays\"\x17\n" +
+	"\x15GetTagSettingsRequest\"I\n" +
+	"\x16GetTagSettingsResponse\x12/\n" +
+	"\bsettings\x18\x01 \x01(\v2\x13.tag.v1.TagSettingsR\bsettings\"K\n" +
+	"\x18UpdateTagSettingsRequest\x12/\n" +
+	"\bsettings\x18\x01 \x01(\v2\x13.tag.v1.TagSettingsR\bsettings\"L\n" +
+	"\x19UpdateTagSettingsResponse\x12/\n" +
+	"\bsettings\x18\x01 \x01(\v2\x13.tag.v1.TagSettingsR\bsettings*\x99\x01\n" +
+	"\x10OrphanTagCleanup\x12\"\n" +
+	"\x1eORPHAN_TAG_CLEANUP_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cORPHAN_TAG_CLEANUP_IMMEDIATE\x10\x01\x12!\n" +
+	"\x1dORPHAN_TAG_CLEANUP_AFTER_DAYS\x10\x02\x12\x1c\n" +
+	"\x18ORPHAN_TAG_CLEANUP_NEVER\x10\x032\xba\x04\n" +
 	"\n" +
 	"TagService\x12@\n" +
-	"\tCreateTag\x12\x18.tag.v1.CreateTagRequest\x1a\x19.tag.v1.CreateTagResponse\x127\n" +
+	"\tCreateTag\x12\x18.tag.v1.CreateTagRequest\x1a\x19.tag.v1.CreateTagResponse\x12C\n" +
+	"\n" +
+	"CreateTags\x12\x19.tag.v1.CreateTagsRequest\x1a\x1a.tag.v1.CreateTagsResponse\x127\n" +
 	"\x06GetTag\x12\x15.tag.v1.GetTagRequest\x1a\x16.tag.v1.GetTagResponse\x12@\n" +
 	"\tUpdateTag\x12\x18.tag.v1.UpdateTagRequest\x1a\x19.tag.v1.UpdateTagResponse\x12@\n" +
 	"\tDeleteTag\x12\x18.tag.v1.DeleteTagRequest\x1a\x19.tag.v1.DeleteTagResponse\x12=\n" +
-	"\bListTags\x12\x17.tag.v1.ListTagsRequest\x1a\x18.tag.v1.ListTagsResponseB\x83\x01\n" +
+	"\bListTags\x12\x17.tag.v1.ListTagsRequest\x1a\x18.tag.v1.ListTagsResponse\x12O\n" +
+	"\x0eGetTagSettings\x12\x1d.tag.v1.GetTagSettingsRequest\x1a\x1e.tag.v1.GetTagSettingsResponse\x12X\n" +
+	"\x11UpdateTagSettings\x12 .tag.v1.UpdateTagSettingsRequest\x1a!.tag.v1.UpdateTagSettingsResponseB\x83\x01\n" +
 	"\n" +
 	"com.tag.v1B\bTagProtoP\x01Z2github.com/slips-ai/slips-core/gen/go/tag/v1;tagv1\xa2\x02\x03TXX\xaa\x02\x06Tag.V1\xca\x02\x06Tag\\V1\xe2\x02\x12Tag\\V1\\GPBMetadata\xea\x02\aTag::V1b\x06proto3"
 
@@ -614,43 +1398,72 @@ func file_tag_v1_tag_proto_rawDescGZIP() []byte {
 	return file_tag_v1_tag_proto_rawDescData
 }
 
-var file_tag_v1_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_tag_v1_tag_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tag_v1_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_tag_v1_tag_proto_goTypes = []any{
-	(*Tag)(nil),                   // 0: tag.v1.Tag
-	(*CreateTagRequest)(nil),      // 1: tag.v1.CreateTagRequest
-	(*CreateTagResponse)(nil),     // 2: tag.v1.CreateTagResponse
-	(*GetTagRequest)(nil),         // 3: tag.v1.GetTagRequest
-	(*GetTagResponse)(nil),        // 4: tag.v1.GetTagResponse
-	(*UpdateTagRequest)(nil),      // 5: tag.v1.UpdateTagRequest
-	(*UpdateTagResponse)(nil),     // 6: tag.v1.UpdateTagResponse
-	(*DeleteTagRequest)(nil),      // 7: tag.v1.DeleteTagRequest
-	(*DeleteTagResponse)(nil),     // 8: tag.v1.DeleteTagResponse
-	(*ListTagsRequest)(nil),       // 9: tag.v1.ListTagsRequest
-	(*ListTagsResponse)(nil),      // 10: tag.v1.ListTagsResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(OrphanTagCleanup)(0),             // 0: tag.v1.OrphanTagCleanup
+	(*Tag)(nil),                       // 1: tag.v1.Tag
+	(*CreateTagRequest)(nil),          // 2: tag.v1.CreateTagRequest
+	(*CreateTagResponse)(nil),         // 3: tag.v1.CreateTagResponse
+	(*CreateTagsRequest)(nil),         // 4: tag.v1.CreateTagsRequest
+	(*CreateTagsResponse)(nil),        // 5: tag.v1.CreateTagsResponse
+	(*CreateTagsResult)(nil),          // 6: tag.v1.CreateTagsResult
+	(*GetTagRequest)(nil),             // 7: tag.v1.GetTagRequest
+	(*GetTagResponse)(nil),            // 8: tag.v1.GetTagResponse
+	(*TagTask)(nil),                   // 9: tag.v1.TagTask
+	(*TagTaskStats)(nil),              // 10: tag.v1.TagTaskStats
+	(*UpdateTagRequest)(nil),          // 11: tag.v1.UpdateTagRequest
+	(*UpdateTagResponse)(nil),         // 12: tag.v1.UpdateTagResponse
+	(*DeleteTagRequest)(nil),          // 13: tag.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),         // 14: tag.v1.DeleteTagResponse
+	(*ListTagsRequest)(nil),           // 15: tag.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),          // 16: tag.v1.ListTagsResponse
+	(*TagSettings)(nil),               // 17: tag.v1.TagSettings
+	(*GetTagSettingsRequest)(nil),     // 18: tag.v1.GetTagSettingsRequest
+	(*GetTagSettingsResponse)(nil),    // 19: tag.v1.GetTagSettingsResponse
+	(*UpdateTagSettingsRequest)(nil),  // 20: tag.v1.UpdateTagSettingsRequest
+	(*UpdateTagSettingsResponse)(nil), // 21: tag.v1.UpdateTagSettingsResponse
+	(*timestamppb.Timestamp)(nil),     // 22: google.protobuf.Timestamp
 }
 var file_tag_v1_tag_proto_depIdxs = []int32{
-	11, // 0: tag.v1.Tag.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: tag.v1.Tag.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: tag.v1.CreateTagResponse.tag:type_name -> tag.v1.Tag
-	0,  // 3: tag.v1.GetTagResponse.tag:type_name -> tag.v1.Tag
-	0,  // 4: tag.v1.UpdateTagResponse.tag:type_name -> tag.v1.Tag
-	0,  // 5: tag.v1.ListTagsResponse.tags:type_name -> tag.v1.Tag
-	1,  // 6: tag.v1.TagService.CreateTag:input_type -> tag.v1.CreateTagRequest
-	3,  // 7: tag.v1.TagService.GetTag:input_type -> tag.v1.GetTagRequest
-	5,  // 8: tag.v1.TagService.UpdateTag:input_type -> tag.v1.UpdateTagRequest
-	7,  // 9: tag.v1.TagService.DeleteTag:input_type -> tag.v1.DeleteTagRequest
-	9,  // 10: tag.v1.TagService.ListTags:input_type -> tag.v1.ListTagsRequest
-	2,  // 11: tag.v1.TagService.CreateTag:output_type -> tag.v1.CreateTagResponse
-	4,  // 12: tag.v1.TagService.GetTag:output_type -> tag.v1.GetTagResponse
-	6,  // 13: tag.v1.TagService.UpdateTag:output_type -> tag.v1.UpdateTagResponse
-	8,  // 14: tag.v1.TagService.DeleteTag:output_type -> tag.v1.DeleteTagResponse
-	10, // 15: tag.v1.TagService.ListTags:output_type -> tag.v1.ListTagsResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	22, // 0: tag.v1.Tag.created_at:type_name -> google.protobuf.Timestamp
+	22, // 1: tag.v1.Tag.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: tag.v1.CreateTagResponse.tag:type_name -> tag.v1.Tag
+	6,  // 3: tag.v1.CreateTagsResponse.results:type_name -> tag.v1.CreateTagsResult
+	1,  // 4: tag.v1.CreateTagsResult.tag:type_name -> tag.v1.Tag
+	1,  // 5: tag.v1.GetTagResponse.tag:type_name -> tag.v1.Tag
+	9,  // 6: tag.v1.GetTagResponse.recent_tasks:type_name -> tag.v1.TagTask
+	10, // 7: tag.v1.GetTagResponse.stats:type_name -> tag.v1.TagTaskStats
+	22, // 8: tag.v1.TagTask.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 9: tag.v1.TagTask.completed_at:type_name -> google.protobuf.Timestamp
+	22, // 10: tag.v1.TagTask.archived_at:type_name -> google.protobuf.Timestamp
+	1,  // 11: tag.v1.UpdateTagResponse.tag:type_name -> tag.v1.Tag
+	1,  // 12: tag.v1.ListTagsResponse.tags:type_name -> tag.v1.Tag
+	0,  // 13: tag.v1.TagSettings.orphan_cleanup:type_name -> tag.v1.OrphanTagCleanup
+	17, // 14: tag.v1.GetTagSettingsResponse.settings:type_name -> tag.v1.TagSettings
+	17, // 15: tag.v1.UpdateTagSettingsRequest.settings:type_name -> tag.v1.TagSettings
+	17, // 16: tag.v1.UpdateTagSettingsResponse.settings:type_name -> tag.v1.TagSettings
+	2,  // 17: tag.v1.TagService.CreateTag:input_type -> tag.v1.CreateTagRequest
+	4,  // 18: tag.v1.TagService.CreateTags:input_type -> tag.v1.CreateTagsRequest
+	7,  // 19: tag.v1.TagService.GetTag:input_type -> tag.v1.GetTagRequest
+	11, // 20: tag.v1.TagService.UpdateTag:input_type -> tag.v1.UpdateTagRequest
+	13, // 21: tag.v1.TagService.DeleteTag:input_type -> tag.v1.DeleteTagRequest
+	15, // 22: tag.v1.TagService.ListTags:input_type -> tag.v1.ListTagsRequest
+	18, // 23: tag.v1.TagService.GetTagSettings:input_type -> tag.v1.GetTagSettingsRequest
+	20, // 24: tag.v1.TagService.UpdateTagSettings:input_type -> tag.v1.UpdateTagSettingsRequest
+	3,  // 25: tag.v1.TagService.CreateTag:output_type -> tag.v1.CreateTagResponse
+	5,  // 26: tag.v1.TagService.CreateTags:output_type -> tag.v1.CreateTagsResponse
+	8,  // 27: tag.v1.TagService.GetTag:output_type -> tag.v1.GetTagResponse
+	12, // 28: tag.v1.TagService.UpdateTag:output_type -> tag.v1.UpdateTagResponse
+	14, // 29: tag.v1.TagService.DeleteTag:output_type -> tag.v1.DeleteTagResponse
+	16, // 30: tag.v1.TagService.ListTags:output_type -> tag.v1.ListTagsResponse
+	19, // 31: tag.v1.TagService.GetTagSettings:output_type -> tag.v1.GetTagSettingsResponse
+	21, // 32: tag.v1.TagService.UpdateTagSettings:output_type -> tag.v1.UpdateTagSettingsResponse
+	25, // [25:33] is the sub-list for method output_type
+	17, // [17:25] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_tag_v1_tag_proto_init() }
@@ -658,18 +1471,24 @@ func file_tag_v1_tag_proto_init() {
 	if File_tag_v1_tag_proto != nil {
 		return
 	}
+	file_tag_v1_tag_proto_msgTypes[8].OneofWrappers = []any{}
+	file_tag_v1_tag_proto_msgTypes[12].OneofWrappers = []any{
+		(*DeleteTagRequest_ReassignToTagId)(nil),
+		(*DeleteTagRequest_StripTasks)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tag_v1_tag_proto_rawDesc), len(file_tag_v1_tag_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_tag_v1_tag_proto_goTypes,
 		DependencyIndexes: file_tag_v1_tag_proto_depIdxs,
+		EnumInfos:         file_tag_v1_tag_proto_enumTypes,
 		MessageInfos:      file_tag_v1_tag_proto_msgTypes,
 	}.Build()
 	File_tag_v1_tag_proto = out.File
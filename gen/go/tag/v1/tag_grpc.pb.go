@@ -19,11 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TagService_CreateTag_FullMethodName = "/tag.v1.TagService/CreateTag"
-	TagService_GetTag_FullMethodName    = "/tag.v1.TagService/GetTag"
-	TagService_UpdateTag_FullMethodName = "/tag.v1.TagService/UpdateTag"
-	TagService_DeleteTag_FullMethodName = "/tag.v1.TagService/DeleteTag"
-	TagService_ListTags_FullMethodName  = "/tag.v1.TagService/ListTags"
+	TagService_CreateTag_FullMethodName         = "/tag.v1.TagService/CreateTag"
+	TagService_CreateTags_FullMethodName        = "/tag.v1.TagService/CreateTags"
+	TagService_GetTag_FullMethodName            = "/tag.v1.TagService/GetTag"
+	TagService_UpdateTag_FullMethodName         = "/tag.v1.TagService/UpdateTag"
+	TagService_DeleteTag_FullMethodName         = "/tag.v1.TagService/DeleteTag"
+	TagService_ListTags_FullMethodName          = "/tag.v1.TagService/ListTags"
+	TagService_GetTagSettings_FullMethodName    = "/tag.v1.TagService/GetTagSettings"
+	TagService_UpdateTagSettings_FullMethodName = "/tag.v1.TagService/UpdateTagSettings"
 )
 
 // TagServiceClient is the client API for TagService service.
@@ -33,10 +36,14 @@ const (
 // TagService provides CRUD operations for tags
 type TagServiceClient interface {
 	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error)
+	// CreateTags gets or creates a tag for each name in one round trip
+	CreateTags(ctx context.Context, in *CreateTagsRequest, opts ...grpc.CallOption) (*CreateTagsResponse, error)
 	GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error)
 	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error)
 	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
 	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	GetTagSettings(ctx context.Context, in *GetTagSettingsRequest, opts ...grpc.CallOption) (*GetTagSettingsResponse, error)
+	UpdateTagSettings(ctx context.Context, in *UpdateTagSettingsRequest, opts ...grpc.CallOption) (*UpdateTagSettingsResponse, error)
 }
 
 type tagServiceClient struct {
@@ -57,6 +64,16 @@ func (c *tagServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest,
 	return out, nil
 }
 
+func (c *tagServiceClient) CreateTags(ctx context.Context, in *CreateTagsRequest, opts ...grpc.CallOption) (*CreateTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_CreateTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tagServiceClient) GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTagResponse)
@@ -97,6 +114,26 @@ func (c *tagServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, op
 	return out, nil
 }
 
+func (c *tagServiceClient) GetTagSettings(ctx context.Context, in *GetTagSettingsRequest, opts ...grpc.CallOption) (*GetTagSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagSettingsResponse)
+	err := c.cc.Invoke(ctx, TagService_GetTagSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) UpdateTagSettings(ctx context.Context, in *UpdateTagSettingsRequest, opts ...grpc.CallOption) (*UpdateTagSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTagSettingsResponse)
+	err := c.cc.Invoke(ctx, TagService_UpdateTagSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TagServiceServer is the server API for TagService service.
 // All implementations must embed UnimplementedTagServiceServer
 // for forward compatibility.
@@ -104,10 +141,14 @@ func (c *tagServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, op
 // TagService provides CRUD operations for tags
 type TagServiceServer interface {
 	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
+	// CreateTags gets or creates a tag for each name in one round trip
+	CreateTags(context.Context, *CreateTagsRequest) (*CreateTagsResponse, error)
 	GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error)
 	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error)
 	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
 	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	GetTagSettings(context.Context, *GetTagSettingsRequest) (*GetTagSettingsResponse, error)
+	UpdateTagSettings(context.Context, *UpdateTagSettingsRequest) (*UpdateTagSettingsResponse, error)
 	mustEmbedUnimplementedTagServiceServer()
 }
 
@@ -121,6 +162,9 @@ type UnimplementedTagServiceServer struct{}
 func (UnimplementedTagServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
 }
+func (UnimplementedTagServiceServer) CreateTags(context.Context, *CreateTagsRequest) (*CreateTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTags not implemented")
+}
 func (UnimplementedTagServiceServer) GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTag not implemented")
 }
@@ -133,6 +177,12 @@ func (UnimplementedTagServiceServer) DeleteTag(context.Context, *DeleteTagReques
 func (UnimplementedTagServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
 }
+func (UnimplementedTagServiceServer) GetTagSettings(context.Context, *GetTagSettingsRequest) (*GetTagSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagSettings not implemented")
+}
+func (UnimplementedTagServiceServer) UpdateTagSettings(context.Context, *UpdateTagSettingsRequest) (*UpdateTagSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTagSettings not implemented")
+}
 func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
 func (UnimplementedTagServiceServer) testEmbeddedByValue()                    {}
 
@@ -172,6 +222,24 @@ func _TagService_CreateTag_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TagService_CreateTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).CreateTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_CreateTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).CreateTags(ctx, req.(*CreateTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TagService_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTagRequest)
 	if err := dec(in); err != nil {
@@ -244,6 +312,42 @@ func _TagService_ListTags_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TagService_GetTagSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).GetTagSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_GetTagSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).GetTagSettings(ctx, req.(*GetTagSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_UpdateTagSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).UpdateTagSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_UpdateTagSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).UpdateTagSettings(ctx, req.(*UpdateTagSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -255,6 +359,10 @@ var TagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTag",
 			Handler:    _TagService_CreateTag_Handler,
 		},
+		{
+			MethodName: "CreateTags",
+			Handler:    _TagService_CreateTags_Handler,
+		},
 		{
 			MethodName: "GetTag",
 			Handler:    _TagService_GetTag_Handler,
@@ -271,6 +379,14 @@ var TagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTags",
 			Handler:    _TagService_ListTags_Handler,
 		},
+		{
+			MethodName: "GetTagSettings",
+			Handler:    _TagService_GetTagSettings_Handler,
+		},
+		{
+			MethodName: "UpdateTagSettings",
+			Handler:    _TagService_UpdateTagSettings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tag/v1/tag.proto",
@@ -7,8 +7,10 @@
 package taskv1
 
 import (
+	v1 "github.com/slips-ai/slips-core/gen/go/tag/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,37 +24,4174 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ChangeSource is the kind of caller that changed a task
+type ChangeSource int32
+
+const (
+	ChangeSource_CHANGE_SOURCE_UNSPECIFIED ChangeSource = 0 // unknown, e.g. changed before this was recorded
+	ChangeSource_CHANGE_SOURCE_USER        ChangeSource = 1 // the user, signed in on one of their devices
+	ChangeSource_CHANGE_SOURCE_AGENT       ChangeSource = 2 // an AI agent acting with one of the user's MCP tokens
+	ChangeSource_CHANGE_SOURCE_SYSTEM      ChangeSource = 3 // the server, e.g. the auto-archive job
+)
+
+// Enum value maps for ChangeSource.
+var (
+	ChangeSource_name = map[int32]string{
+		0: "CHANGE_SOURCE_UNSPECIFIED",
+		1: "CHANGE_SOURCE_USER",
+		2: "CHANGE_SOURCE_AGENT",
+		3: "CHANGE_SOURCE_SYSTEM",
+	}
+	ChangeSource_value = map[string]int32{
+		"CHANGE_SOURCE_UNSPECIFIED": 0,
+		"CHANGE_SOURCE_USER":        1,
+		"CHANGE_SOURCE_AGENT":       2,
+		"CHANGE_SOURCE_SYSTEM":      3,
+	}
+)
+
+func (x ChangeSource) Enum() *ChangeSource {
+	p := new(ChangeSource)
+	*p = x
+	return p
+}
+
+func (x ChangeSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[0].Descriptor()
+}
+
+func (ChangeSource) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[0]
+}
+
+func (x ChangeSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeSource.Descriptor instead.
+func (ChangeSource) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{0}
+}
+
+// StatsBucket selects the time granularity of task statistics
+type StatsBucket int32
+
+const (
+	StatsBucket_STATS_BUCKET_UNSPECIFIED StatsBucket = 0 // treated as DAY
+	StatsBucket_STATS_BUCKET_DAY         StatsBucket = 1
+	StatsBucket_STATS_BUCKET_WEEK        StatsBucket = 2 // ISO weeks starting Monday
+)
+
+// Enum value maps for StatsBucket.
+var (
+	StatsBucket_name = map[int32]string{
+		0: "STATS_BUCKET_UNSPECIFIED",
+		1: "STATS_BUCKET_DAY",
+		2: "STATS_BUCKET_WEEK",
+	}
+	StatsBucket_value = map[string]int32{
+		"STATS_BUCKET_UNSPECIFIED": 0,
+		"STATS_BUCKET_DAY":         1,
+		"STATS_BUCKET_WEEK":        2,
+	}
+)
+
+func (x StatsBucket) Enum() *StatsBucket {
+	p := new(StatsBucket)
+	*p = x
+	return p
+}
+
+func (x StatsBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[1].Descriptor()
+}
+
+func (StatsBucket) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[1]
+}
+
+func (x StatsBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsBucket.Descriptor instead.
+func (StatsBucket) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{1}
+}
+
+// TagMatchMode controls how multiple filter tags are combined
+type TagMatchMode int32
+
+const (
+	TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED TagMatchMode = 0 // the user's default, ANY unless set
+	TagMatchMode_TAG_MATCH_MODE_ANY         TagMatchMode = 1 // task carries at least one of the tags
+	TagMatchMode_TAG_MATCH_MODE_ALL         TagMatchMode = 2 // task carries every tag
+)
+
+// Enum value maps for TagMatchMode.
+var (
+	TagMatchMode_name = map[int32]string{
+		0: "TAG_MATCH_MODE_UNSPECIFIED",
+		1: "TAG_MATCH_MODE_ANY",
+		2: "TAG_MATCH_MODE_ALL",
+	}
+	TagMatchMode_value = map[string]int32{
+		"TAG_MATCH_MODE_UNSPECIFIED": 0,
+		"TAG_MATCH_MODE_ANY":         1,
+		"TAG_MATCH_MODE_ALL":         2,
+	}
+)
+
+func (x TagMatchMode) Enum() *TagMatchMode {
+	p := new(TagMatchMode)
+	*p = x
+	return p
+}
+
+func (x TagMatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagMatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[2].Descriptor()
+}
+
+func (TagMatchMode) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[2]
+}
+
+func (x TagMatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagMatchMode.Descriptor instead.
+func (TagMatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
+// TaskGroupBy selects how listed tasks are grouped for section headers.
+// Tasks have no project of their own: projects are tags, grouped with
+// TASK_GROUP_BY_TAG.
+type TaskGroupBy int32
+
+const (
+	TaskGroupBy_TASK_GROUP_BY_UNSPECIFIED TaskGroupBy = 0 // no grouping
+	TaskGroupBy_TASK_GROUP_BY_START_DATE  TaskGroupBy = 1 // group by start_date, inbox tasks use an empty key
+	TaskGroupBy_TASK_GROUP_BY_DEADLINE    TaskGroupBy = 2 // group by deadline, tasks without one use an empty key
+	TaskGroupBy_TASK_GROUP_BY_TAG         TaskGroupBy = 3 // group by tag ID, a task with several tags is in each group, untagged tasks use an empty key
+)
+
+// Enum value maps for TaskGroupBy.
+var (
+	TaskGroupBy_name = map[int32]string{
+		0: "TASK_GROUP_BY_UNSPECIFIED",
+		1: "TASK_GROUP_BY_START_DATE",
+		2: "TASK_GROUP_BY_DEADLINE",
+		3: "TASK_GROUP_BY_TAG",
+	}
+	TaskGroupBy_value = map[string]int32{
+		"TASK_GROUP_BY_UNSPECIFIED": 0,
+		"TASK_GROUP_BY_START_DATE":  1,
+		"TASK_GROUP_BY_DEADLINE":    2,
+		"TASK_GROUP_BY_TAG":         3,
+	}
+)
+
+func (x TaskGroupBy) Enum() *TaskGroupBy {
+	p := new(TaskGroupBy)
+	*p = x
+	return p
+}
+
+func (x TaskGroupBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskGroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[3].Descriptor()
+}
+
+func (TaskGroupBy) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[3]
+}
+
+func (x TaskGroupBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskGroupBy.Descriptor instead.
+func (TaskGroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
+// TaskOrderBy selects the order of listed tasks
+type TaskOrderBy int32
+
+const (
+	TaskOrderBy_TASK_ORDER_BY_UNSPECIFIED      TaskOrderBy = 0 // pinned tasks first, then newest first
+	TaskOrderBy_TASK_ORDER_BY_ARCHIVED_AT_DESC TaskOrderBy = 1 // most recently archived first, tasks not archived last
+	TaskOrderBy_TASK_ORDER_BY_ARCHIVED_AT_ASC  TaskOrderBy = 2 // earliest archived first, tasks not archived last
+)
+
+// Enum value maps for TaskOrderBy.
+var (
+	TaskOrderBy_name = map[int32]string{
+		0: "TASK_ORDER_BY_UNSPECIFIED",
+		1: "TASK_ORDER_BY_ARCHIVED_AT_DESC",
+		2: "TASK_ORDER_BY_ARCHIVED_AT_ASC",
+	}
+	TaskOrderBy_value = map[string]int32{
+		"TASK_ORDER_BY_UNSPECIFIED":      0,
+		"TASK_ORDER_BY_ARCHIVED_AT_DESC": 1,
+		"TASK_ORDER_BY_ARCHIVED_AT_ASC":  2,
+	}
+)
+
+func (x TaskOrderBy) Enum() *TaskOrderBy {
+	p := new(TaskOrderBy)
+	*p = x
+	return p
+}
+
+func (x TaskOrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskOrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[4].Descriptor()
+}
+
+func (TaskOrderBy) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[4]
+}
+
+func (x TaskOrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskOrderBy.Descriptor instead.
+func (TaskOrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{4}
+}
+
+// ChangeResource is the kind of resource a change event is about
+type ChangeResource int32
+
+const (
+	ChangeResource_CHANGE_RESOURCE_UNSPECIFIED    ChangeResource = 0 // every kind, used by resync events
+	ChangeResource_CHANGE_RESOURCE_TASK           ChangeResource = 1
+	ChangeResource_CHANGE_RESOURCE_TAG            ChangeResource = 2
+	ChangeResource_CHANGE_RESOURCE_CHECKLIST_ITEM ChangeResource = 3
+)
+
+// Enum value maps for ChangeResource.
+var (
+	ChangeResource_name = map[int32]string{
+		0: "CHANGE_RESOURCE_UNSPECIFIED",
+		1: "CHANGE_RESOURCE_TASK",
+		2: "CHANGE_RESOURCE_TAG",
+		3: "CHANGE_RESOURCE_CHECKLIST_ITEM",
+	}
+	ChangeResource_value = map[string]int32{
+		"CHANGE_RESOURCE_UNSPECIFIED":    0,
+		"CHANGE_RESOURCE_TASK":           1,
+		"CHANGE_RESOURCE_TAG":            2,
+		"CHANGE_RESOURCE_CHECKLIST_ITEM": 3,
+	}
+)
+
+func (x ChangeResource) Enum() *ChangeResource {
+	p := new(ChangeResource)
+	*p = x
+	return p
+}
+
+func (x ChangeResource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeResource) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[5].Descriptor()
+}
+
+func (ChangeResource) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[5]
+}
+
+func (x ChangeResource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeResource.Descriptor instead.
+func (ChangeResource) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{5}
+}
+
+// ChangeOperation is what happened to the resource of a change event
+type ChangeOperation int32
+
+const (
+	ChangeOperation_CHANGE_OPERATION_UNSPECIFIED ChangeOperation = 0
+	ChangeOperation_CHANGE_OPERATION_UPSERT      ChangeOperation = 1 // created or changed; the current version is attached
+	ChangeOperation_CHANGE_OPERATION_DELETE      ChangeOperation = 2
+	// Changes were not reported individually, e.g. after a bulk archive or a
+	// server reconnect. Reload resources of the kind, e.g. with ListTasks
+	// and updated_after.
+	ChangeOperation_CHANGE_OPERATION_RESYNC ChangeOperation = 3
+)
+
+// Enum value maps for ChangeOperation.
+var (
+	ChangeOperation_name = map[int32]string{
+		0: "CHANGE_OPERATION_UNSPECIFIED",
+		1: "CHANGE_OPERATION_UPSERT",
+		2: "CHANGE_OPERATION_DELETE",
+		3: "CHANGE_OPERATION_RESYNC",
+	}
+	ChangeOperation_value = map[string]int32{
+		"CHANGE_OPERATION_UNSPECIFIED": 0,
+		"CHANGE_OPERATION_UPSERT":      1,
+		"CHANGE_OPERATION_DELETE":      2,
+		"CHANGE_OPERATION_RESYNC":      3,
+	}
+)
+
+func (x ChangeOperation) Enum() *ChangeOperation {
+	p := new(ChangeOperation)
+	*p = x
+	return p
+}
+
+func (x ChangeOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[6].Descriptor()
+}
+
+func (ChangeOperation) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[6]
+}
+
+func (x ChangeOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeOperation.Descriptor instead.
+func (ChangeOperation) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{6}
+}
+
+// MutationConflict explains why a mutation was not applied
+type MutationConflict int32
+
+const (
+	MutationConflict_MUTATION_CONFLICT_UNSPECIFIED      MutationConflict = 0 // no conflict, the mutation was applied
+	MutationConflict_MUTATION_CONFLICT_ALREADY_EXISTS   MutationConflict = 1 // a create used the ID of one of the caller's tasks
+	MutationConflict_MUTATION_CONFLICT_NOT_FOUND        MutationConflict = 2 // the task does not exist, e.g. it was deleted, or a create used the ID of another user's task
+	MutationConflict_MUTATION_CONFLICT_CHANGED          MutationConflict = 3 // the task was modified after base_updated_at
+	MutationConflict_MUTATION_CONFLICT_PENDING_APPROVAL MutationConflict = 4 // a delete waits for the user's approval
+)
+
+// Enum value maps for MutationConflict.
+var (
+	MutationConflict_name = map[int32]string{
+		0: "MUTATION_CONFLICT_UNSPECIFIED",
+		1: "MUTATION_CONFLICT_ALREADY_EXISTS",
+		2: "MUTATION_CONFLICT_NOT_FOUND",
+		3: "MUTATION_CONFLICT_CHANGED",
+		4: "MUTATION_CONFLICT_PENDING_APPROVAL",
+	}
+	MutationConflict_value = map[string]int32{
+		"MUTATION_CONFLICT_UNSPECIFIED":      0,
+		"MUTATION_CONFLICT_ALREADY_EXISTS":   1,
+		"MUTATION_CONFLICT_NOT_FOUND":        2,
+		"MUTATION_CONFLICT_CHANGED":          3,
+		"MUTATION_CONFLICT_PENDING_APPROVAL": 4,
+	}
+)
+
+func (x MutationConflict) Enum() *MutationConflict {
+	p := new(MutationConflict)
+	*p = x
+	return p
+}
+
+func (x MutationConflict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MutationConflict) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[7].Descriptor()
+}
+
+func (MutationConflict) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[7]
+}
+
+func (x MutationConflict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MutationConflict.Descriptor instead.
+func (MutationConflict) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{7}
+}
+
 // Task represents a task entity
 type Task struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Notes          string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	TagIds         []string               `protobuf:"bytes,6,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
-	ArchivedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	StartDate      *string                `protobuf:"bytes,9,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // format "YYYY-MM-DD", null means inbox
-	ChecklistItems []*ChecklistItem       `protobuf:"bytes,10,rep,name=checklist_items,json=checklistItems,proto3" json:"checklist_items,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Notes                   string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt               *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TagIds                  []string               `protobuf:"bytes,6,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	ArchivedAt              *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	StartDate               *string                `protobuf:"bytes,9,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // format "YYYY-MM-DD", null means inbox
+	ChecklistItems          []*ChecklistItem       `protobuf:"bytes,10,rep,name=checklist_items,json=checklistItems,proto3" json:"checklist_items,omitempty"`
+	Deadline                *string                `protobuf:"bytes,11,opt,name=deadline,proto3,oneof" json:"deadline,omitempty"`                                               // format "YYYY-MM-DD", null means no deadline
+	DaysRemaining           *int32                 `protobuf:"varint,12,opt,name=days_remaining,json=daysRemaining,proto3,oneof" json:"days_remaining,omitempty"`               // days until deadline (negative when overdue), null when no deadline
+	Pinned                  bool                   `protobuf:"varint,13,opt,name=pinned,proto3" json:"pinned,omitempty"`                                                        // pinned tasks are listed first
+	CompletedAt             *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`                      // null means the task is open
+	ChecklistTotalCount     int32                  `protobuf:"varint,15,opt,name=checklist_total_count,json=checklistTotalCount,proto3" json:"checklist_total_count,omitempty"` // also set when checklist_items are not loaded (e.g. ListTasks)
+	ChecklistCompletedCount int32                  `protobuf:"varint,16,opt,name=checklist_completed_count,json=checklistCompletedCount,proto3" json:"checklist_completed_count,omitempty"`
+	ClientRequestId         string                 `protobuf:"bytes,17,opt,name=client_request_id,json=clientRequestId,proto3" json:"client_request_id,omitempty"` // set when the task was created with one
+	// Who made the last change; unset for tasks not changed since this was
+	// first recorded. Clients compare it to their own client ID to tell
+	// whether another device or an agent changed the task.
+	LastModifiedBy *TaskModifier `protobuf:"bytes,18,opt,name=last_modified_by,json=lastModifiedBy,proto3" json:"last_modified_by,omitempty"`
+	// GTD context such as "@home" or "@low-energy"; empty when the task has
+	// none. Unlike tags, a task is in at most one context.
+	Context      string                 `protobuf:"bytes,19,opt,name=context,proto3" json:"context,omitempty"`
+	LastViewedAt *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=last_viewed_at,json=lastViewedAt,proto3,oneof" json:"last_viewed_at,omitempty"` // when the user last opened the task, null when never
+	// Set when an agent or the server added the task and the user has not
+	// opened it yet
+	IsNew bool `protobuf:"varint,21,opt,name=is_new,json=isNew,proto3" json:"is_new,omitempty"`
+	// Set when an agent or the server changed the task after last_viewed_at
+	UpdatedSinceViewed bool `protobuf:"varint,22,opt,name=updated_since_viewed,json=updatedSinceViewed,proto3" json:"updated_since_viewed,omitempty"`
+	// Who created the task; unset for tasks created before this was recorded.
+	// For agents it names the MCP token, e.g. "created by agent Xbot".
+	CreatedBy *TaskModifier `protobuf:"bytes,23,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Place to remind the user of the task at; unset when the task has none.
+	// Set with SetTaskGeofence.
+	Geofence *Geofence `protobuf:"bytes,24,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	// users/{user}/tasks/{task}, the task's name in task.v2. Output only:
+	// v1 requests take the id.
+	ResourceName  string `protobuf:"bytes,25,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_task_v1_task_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Task) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *Task) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Task) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *Task) GetChecklistItems() []*ChecklistItem {
+	if x != nil {
+		return x.ChecklistItems
+	}
+	return nil
+}
+
+func (x *Task) GetDeadline() string {
+	if x != nil && x.Deadline != nil {
+		return *x.Deadline
+	}
+	return ""
+}
+
+func (x *Task) GetDaysRemaining() int32 {
+	if x != nil && x.DaysRemaining != nil {
+		return *x.DaysRemaining
+	}
+	return 0
+}
+
+func (x *Task) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetChecklistTotalCount() int32 {
+	if x != nil {
+		return x.ChecklistTotalCount
+	}
+	return 0
+}
+
+func (x *Task) GetChecklistCompletedCount() int32 {
+	if x != nil {
+		return x.ChecklistCompletedCount
+	}
+	return 0
+}
+
+func (x *Task) GetClientRequestId() string {
+	if x != nil {
+		return x.ClientRequestId
+	}
+	return ""
+}
+
+func (x *Task) GetLastModifiedBy() *TaskModifier {
+	if x != nil {
+		return x.LastModifiedBy
+	}
+	return nil
+}
+
+func (x *Task) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *Task) GetLastViewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastViewedAt
+	}
+	return nil
+}
+
+func (x *Task) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *Task) GetUpdatedSinceViewed() bool {
+	if x != nil {
+		return x.UpdatedSinceViewed
+	}
+	return false
+}
+
+func (x *Task) GetCreatedBy() *TaskModifier {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *Task) GetGeofence() *Geofence {
+	if x != nil {
+		return x.Geofence
+	}
+	return nil
+}
+
+func (x *Task) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+// Geofence is a circle around a place. Mobile clients register it with the
+// device's location services and remind the user of the task on arriving at
+// or leaving the place; the server only stores and syncs it.
+type Geofence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`                            // -90 to 90
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`                          // -180 to 180
+	RadiusMeters  int32                  `protobuf:"varint,3,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"` // 50 to 50000
+	OnArrive      bool                   `protobuf:"varint,4,opt,name=on_arrive,json=onArrive,proto3" json:"on_arrive,omitempty"`             // remind when entering the circle
+	OnLeave       bool                   `protobuf:"varint,5,opt,name=on_leave,json=onLeave,proto3" json:"on_leave,omitempty"`                // remind when leaving it; at least one of the two is set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Geofence) Reset() {
+	*x = Geofence{}
+	mi := &file_task_v1_task_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Geofence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Geofence) ProtoMessage() {}
+
+func (x *Geofence) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Geofence.ProtoReflect.Descriptor instead.
+func (*Geofence) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Geofence) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Geofence) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Geofence) GetRadiusMeters() int32 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+func (x *Geofence) GetOnArrive() bool {
+	if x != nil {
+		return x.OnArrive
+	}
+	return false
+}
+
+func (x *Geofence) GetOnLeave() bool {
+	if x != nil {
+		return x.OnLeave
+	}
+	return false
+}
+
+// TaskModifier identifies the caller behind a change to a task
+type TaskModifier struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Source ChangeSource           `protobuf:"varint,1,opt,name=source,proto3,enum=task.v1.ChangeSource" json:"source,omitempty"`
+	// Device or app instance the caller identified itself as with the
+	// x-client-id request header; empty when it sent none
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// ID and name of the MCP token an agent authenticated with; empty for
+	// other sources
+	TokenId       string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	TokenName     string `protobuf:"bytes,4,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskModifier) Reset() {
+	*x = TaskModifier{}
+	mi := &file_task_v1_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskModifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskModifier) ProtoMessage() {}
+
+func (x *TaskModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskModifier.ProtoReflect.Descriptor instead.
+func (*TaskModifier) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaskModifier) GetSource() ChangeSource {
+	if x != nil {
+		return x.Source
+	}
+	return ChangeSource_CHANGE_SOURCE_UNSPECIFIED
+}
+
+func (x *TaskModifier) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *TaskModifier) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *TaskModifier) GetTokenName() string {
+	if x != nil {
+		return x.TokenName
+	}
+	return ""
+}
+
+// ChecklistItem represents one checklist row under a task
+type ChecklistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Completed     bool                   `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistItem) Reset() {
+	*x = ChecklistItem{}
+	mi := &file_task_v1_task_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistItem) ProtoMessage() {}
+
+func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistItem.ProtoReflect.Descriptor instead.
+func (*ChecklistItem) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChecklistItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *ChecklistItem) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *ChecklistItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChecklistItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateTaskRequest is the request message for creating a task
+type CreateTaskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Notes          string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+	TagNames       []string               `protobuf:"bytes,3,rep,name=tag_names,json=tagNames,proto3" json:"tag_names,omitempty"`
+	StartDate      *string                `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // optional
+	ChecklistItems []string               `protobuf:"bytes,6,rep,name=checklist_items,json=checklistItems,proto3" json:"checklist_items,omitempty"`
+	Deadline       *string                `protobuf:"bytes,7,opt,name=deadline,proto3,oneof" json:"deadline,omitempty"` // optional, format "YYYY-MM-DD"
+	// Optional ID chosen by the client, unique per user. Creating a task with
+	// an ID that was used before returns that task instead of a duplicate.
+	ClientRequestId string `protobuf:"bytes,8,opt,name=client_request_id,json=clientRequestId,proto3" json:"client_request_id,omitempty"`
+	// Optional GTD context. It is stored lower case with a leading "@", so
+	// "Home" becomes "@home"; it must be a single word of at most 64
+	// characters.
+	Context       string `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetTagNames() []string {
+	if x != nil {
+		return x.TagNames
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetChecklistItems() []string {
+	if x != nil {
+		return x.ChecklistItems
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetDeadline() string {
+	if x != nil && x.Deadline != nil {
+		return *x.Deadline
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetClientRequestId() string {
+	if x != nil {
+		return x.ClientRequestId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+// CreateTaskResponse is the response message for creating a task
+type CreateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// GetTaskRequest is the request message for getting a task
+type GetTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Task fields to return, e.g. "title" and "start_date"; all when empty.
+	// The id and resource_name are always returned. Leaving out notes,
+	// tag_ids or the checklist fields also skips loading them.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTaskRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+// GetTaskResponse is the response message for getting a task
+type GetTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskResponse) Reset() {
+	*x = GetTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskResponse) ProtoMessage() {}
+
+func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// BatchGetTasksRequest is the request message for getting multiple tasks
+type BatchGetTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // at most 100 IDs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetTasksRequest) Reset() {
+	*x = BatchGetTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetTasksRequest) ProtoMessage() {}
+
+func (x *BatchGetTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchGetTasksRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// BatchGetTasksResponse is the response message for getting multiple tasks
+type BatchGetTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`                             // found tasks, in request order
+	MissingIds    []string               `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"` // IDs that do not exist or are not owned by the caller
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetTasksResponse) Reset() {
+	*x = BatchGetTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetTasksResponse) ProtoMessage() {}
+
+func (x *BatchGetTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchGetTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *BatchGetTasksResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+// UpdateTaskRequest is the request message for updating a task
+type UpdateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	TagNames      []string               `protobuf:"bytes,4,rep,name=tag_names,json=tagNames,proto3" json:"tag_names,omitempty"`
+	StartDate     *string                `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // optional
+	Deadline      *string                `protobuf:"bytes,7,opt,name=deadline,proto3,oneof" json:"deadline,omitempty"`                    // optional, empty string clears the deadline
+	Context       *string                `protobuf:"bytes,8,opt,name=context,proto3,oneof" json:"context,omitempty"`                      // optional, empty string clears the context
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetTagNames() []string {
+	if x != nil {
+		return x.TagNames
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDeadline() string {
+	if x != nil && x.Deadline != nil {
+		return *x.Deadline
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetContext() string {
+	if x != nil && x.Context != nil {
+		return *x.Context
+	}
+	return ""
+}
+
+// UpdateTaskResponse is the response message for updating a task
+type UpdateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// DeleteTaskRequest is the request message for deleting a task
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ValidateOnly  bool                   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"` // return the task without deleting it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTaskRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+// DeleteTaskResponse is the response message for deleting a task
+type DeleteTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task is the task that would be deleted; set only with validate_only,
+	// and unset when there is no such task, which deleting ignores
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// approval_id is set instead of deleting when the call uses an MCP token
+	// and the user requires approval of agent deletions; the task is deleted
+	// once the user approves it with ApprovalService.ApproveAction
+	ApprovalId    string `protobuf:"bytes,2,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *DeleteTaskResponse) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+// ArchiveTaskRequest is the request message for archiving a task
+type ArchiveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskRequest) Reset() {
+	*x = ArchiveTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskRequest) ProtoMessage() {}
+
+func (x *ArchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ArchiveTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ArchiveTaskResponse is the response message for archiving a task
+type ArchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskResponse) Reset() {
+	*x = ArchiveTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskResponse) ProtoMessage() {}
+
+func (x *ArchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ArchiveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// UnarchiveTaskRequest is the request message for unarchiving a task
+type UnarchiveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskRequest) Reset() {
+	*x = UnarchiveTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskRequest) ProtoMessage() {}
+
+func (x *UnarchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnarchiveTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UnarchiveTaskResponse is the response message for unarchiving a task
+type UnarchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskResponse) Reset() {
+	*x = UnarchiveTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskResponse) ProtoMessage() {}
+
+func (x *UnarchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnarchiveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// CompleteTaskRequest is the request message for completing a task
+type CompleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskRequest) Reset() {
+	*x = CompleteTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskRequest) ProtoMessage() {}
+
+func (x *CompleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompleteTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// CompleteTaskResponse is the response message for completing a task
+type CompleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskResponse) Reset() {
+	*x = CompleteTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskResponse) ProtoMessage() {}
+
+func (x *CompleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CompleteTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// ReopenTaskRequest is the request message for reopening a completed task
+type ReopenTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTaskRequest) Reset() {
+	*x = ReopenTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTaskRequest) ProtoMessage() {}
+
+func (x *ReopenTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTaskRequest.ProtoReflect.Descriptor instead.
+func (*ReopenTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReopenTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ReopenTaskResponse is the response message for reopening a completed task
+type ReopenTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTaskResponse) Reset() {
+	*x = ReopenTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTaskResponse) ProtoMessage() {}
+
+func (x *ReopenTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTaskResponse.ProtoReflect.Descriptor instead.
+func (*ReopenTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReopenTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// ArchiveCompletedTasksRequest is the request message for archiving completed tasks in bulk
+type ArchiveCompletedTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OlderThanDays *int32                 `protobuf:"varint,1,opt,name=older_than_days,json=olderThanDays,proto3,oneof" json:"older_than_days,omitempty"` // only tasks completed at least this many days ago
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCompletedTasksRequest) Reset() {
+	*x = ArchiveCompletedTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCompletedTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCompletedTasksRequest) ProtoMessage() {}
+
+func (x *ArchiveCompletedTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCompletedTasksRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveCompletedTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ArchiveCompletedTasksRequest) GetOlderThanDays() int32 {
+	if x != nil && x.OlderThanDays != nil {
+		return *x.OlderThanDays
+	}
+	return 0
+}
+
+// ArchiveCompletedTasksResponse is the response message for archiving completed tasks in bulk
+type ArchiveCompletedTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArchivedCount int64                  `protobuf:"varint,1,opt,name=archived_count,json=archivedCount,proto3" json:"archived_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCompletedTasksResponse) Reset() {
+	*x = ArchiveCompletedTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCompletedTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCompletedTasksResponse) ProtoMessage() {}
+
+func (x *ArchiveCompletedTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCompletedTasksResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveCompletedTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ArchiveCompletedTasksResponse) GetArchivedCount() int64 {
+	if x != nil {
+		return x.ArchivedCount
+	}
+	return 0
+}
+
+// ArchiveTasksByTagRequest is the request message for archiving every task with a tag
+type ArchiveTasksByTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTasksByTagRequest) Reset() {
+	*x = ArchiveTasksByTagRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTasksByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTasksByTagRequest) ProtoMessage() {}
+
+func (x *ArchiveTasksByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTasksByTagRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveTasksByTagRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ArchiveTasksByTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+// ArchiveTasksByTagResponse is the response message for archiving every task with a tag
+type ArchiveTasksByTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArchivedCount int64                  `protobuf:"varint,1,opt,name=archived_count,json=archivedCount,proto3" json:"archived_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTasksByTagResponse) Reset() {
+	*x = ArchiveTasksByTagResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTasksByTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTasksByTagResponse) ProtoMessage() {}
+
+func (x *ArchiveTasksByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTasksByTagResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveTasksByTagResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ArchiveTasksByTagResponse) GetArchivedCount() int64 {
+	if x != nil {
+		return x.ArchivedCount
+	}
+	return 0
+}
+
+// UnarchiveTasksByTagRequest is the request message for restoring every archived task with a tag
+type UnarchiveTasksByTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveTasksByTagRequest) Reset() {
+	*x = UnarchiveTasksByTagRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTasksByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTasksByTagRequest) ProtoMessage() {}
+
+func (x *UnarchiveTasksByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTasksByTagRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveTasksByTagRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UnarchiveTasksByTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+// UnarchiveTasksByTagResponse is the response message for restoring every archived task with a tag
+type UnarchiveTasksByTagResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UnarchivedCount int64                  `protobuf:"varint,1,opt,name=unarchived_count,json=unarchivedCount,proto3" json:"unarchived_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnarchiveTasksByTagResponse) Reset() {
+	*x = UnarchiveTasksByTagResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTasksByTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTasksByTagResponse) ProtoMessage() {}
+
+func (x *UnarchiveTasksByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTasksByTagResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveTasksByTagResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UnarchiveTasksByTagResponse) GetUnarchivedCount() int64 {
+	if x != nil {
+		return x.UnarchivedCount
+	}
+	return 0
+}
+
+// GetCountersRequest is the request message for getting badge counts
+type GetCountersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Today         *string                `protobuf:"bytes,1,opt,name=today,proto3,oneof" json:"today,omitempty"` // caller's local date, format "YYYY-MM-DD", defaults to the server's UTC date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCountersRequest) Reset() {
+	*x = GetCountersRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCountersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCountersRequest) ProtoMessage() {}
+
+func (x *GetCountersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCountersRequest.ProtoReflect.Descriptor instead.
+func (*GetCountersRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCountersRequest) GetToday() string {
+	if x != nil && x.Today != nil {
+		return *x.Today
+	}
+	return ""
+}
+
+// GetCountersResponse counts the caller's open tasks for app icon badges and sidebars
+type GetCountersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InboxCount    int64                  `protobuf:"varint,1,opt,name=inbox_count,json=inboxCount,proto3" json:"inbox_count,omitempty"`       // tasks without a start date
+	TodayCount    int64                  `protobuf:"varint,2,opt,name=today_count,json=todayCount,proto3" json:"today_count,omitempty"`       // tasks starting on or before today or due today
+	OverdueCount  int64                  `protobuf:"varint,3,opt,name=overdue_count,json=overdueCount,proto3" json:"overdue_count,omitempty"` // tasks due before today
+	UnreadCount   int64                  `protobuf:"varint,4,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`    // tasks an agent or the system added or changed since they were last viewed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCountersResponse) Reset() {
+	*x = GetCountersResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCountersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCountersResponse) ProtoMessage() {}
+
+func (x *GetCountersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCountersResponse.ProtoReflect.Descriptor instead.
+func (*GetCountersResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetCountersResponse) GetInboxCount() int64 {
+	if x != nil {
+		return x.InboxCount
+	}
+	return 0
+}
+
+func (x *GetCountersResponse) GetTodayCount() int64 {
+	if x != nil {
+		return x.TodayCount
+	}
+	return 0
+}
+
+func (x *GetCountersResponse) GetOverdueCount() int64 {
+	if x != nil {
+		return x.OverdueCount
+	}
+	return 0
+}
+
+func (x *GetCountersResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+// RolloverOverdueTasksRequest is the request message for rolling over tasks that started before today
+type RolloverOverdueTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Today         *string                `protobuf:"bytes,1,opt,name=today,proto3,oneof" json:"today,omitempty"` // caller's local date, format "YYYY-MM-DD", defaults to the server's UTC date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloverOverdueTasksRequest) Reset() {
+	*x = RolloverOverdueTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloverOverdueTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverOverdueTasksRequest) ProtoMessage() {}
+
+func (x *RolloverOverdueTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverOverdueTasksRequest.ProtoReflect.Descriptor instead.
+func (*RolloverOverdueTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RolloverOverdueTasksRequest) GetToday() string {
+	if x != nil && x.Today != nil {
+		return *x.Today
+	}
+	return ""
+}
+
+// RolloverOverdueTasksResponse is the response message for rolling over tasks that started before today
+type RolloverOverdueTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // the moved tasks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloverOverdueTasksResponse) Reset() {
+	*x = RolloverOverdueTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloverOverdueTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverOverdueTasksResponse) ProtoMessage() {}
+
+func (x *RolloverOverdueTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverOverdueTasksResponse.ProtoReflect.Descriptor instead.
+func (*RolloverOverdueTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RolloverOverdueTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// TaskSettings holds the caller's task preferences
+type TaskSettings struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AutoArchiveAfterDays *int32                 `protobuf:"varint,1,opt,name=auto_archive_after_days,json=autoArchiveAfterDays,proto3,oneof" json:"auto_archive_after_days,omitempty"` // unset means auto-archiving is off
+	RolloverToInbox      bool                   `protobuf:"varint,2,opt,name=rollover_to_inbox,json=rolloverToInbox,proto3" json:"rollover_to_inbox,omitempty"`                        // RolloverOverdueTasks moves tasks to the inbox instead of today
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TaskSettings) Reset() {
+	*x = TaskSettings{}
+	mi := &file_task_v1_task_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSettings) ProtoMessage() {}
+
+func (x *TaskSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSettings.ProtoReflect.Descriptor instead.
+func (*TaskSettings) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TaskSettings) GetAutoArchiveAfterDays() int32 {
+	if x != nil && x.AutoArchiveAfterDays != nil {
+		return *x.AutoArchiveAfterDays
+	}
+	return 0
+}
+
+func (x *TaskSettings) GetRolloverToInbox() bool {
+	if x != nil {
+		return x.RolloverToInbox
+	}
+	return false
+}
+
+// GetTaskSettingsRequest is the request message for getting task settings
+type GetTaskSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskSettingsRequest) Reset() {
+	*x = GetTaskSettingsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskSettingsRequest) ProtoMessage() {}
+
+func (x *GetTaskSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{33}
+}
+
+// GetTaskSettingsResponse is the response message for getting task settings
+type GetTaskSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TaskSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskSettingsResponse) Reset() {
+	*x = GetTaskSettingsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskSettingsResponse) ProtoMessage() {}
+
+func (x *GetTaskSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTaskSettingsResponse) GetSettings() *TaskSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateTaskSettingsRequest is the request message for updating task settings
+type UpdateTaskSettingsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AutoArchiveAfterDays int32                  `protobuf:"varint,1,opt,name=auto_archive_after_days,json=autoArchiveAfterDays,proto3" json:"auto_archive_after_days,omitempty"` // 0 disables auto-archiving, at most 3650
+	ValidateOnly         bool                   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`                             // report auto_archive_pending_count without saving
+	RolloverToInbox      *bool                  `protobuf:"varint,3,opt,name=rollover_to_inbox,json=rolloverToInbox,proto3,oneof" json:"rollover_to_inbox,omitempty"`            // RolloverOverdueTasks moves tasks to the inbox instead of today; unset keeps the current preference
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateTaskSettingsRequest) Reset() {
+	*x = UpdateTaskSettingsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateTaskSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateTaskSettingsRequest) GetAutoArchiveAfterDays() int32 {
+	if x != nil {
+		return x.AutoArchiveAfterDays
+	}
+	return 0
+}
+
+func (x *UpdateTaskSettingsRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *UpdateTaskSettingsRequest) GetRolloverToInbox() bool {
+	if x != nil && x.RolloverToInbox != nil {
+		return *x.RolloverToInbox
+	}
+	return false
+}
+
+// UpdateTaskSettingsResponse is the response message for updating task settings
+type UpdateTaskSettingsResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Settings                *TaskSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	AutoArchivePendingCount int64                  `protobuf:"varint,2,opt,name=auto_archive_pending_count,json=autoArchivePendingCount,proto3" json:"auto_archive_pending_count,omitempty"` // completed tasks the next auto-archive run would archive
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UpdateTaskSettingsResponse) Reset() {
+	*x = UpdateTaskSettingsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateTaskSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateTaskSettingsResponse) GetSettings() *TaskSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateTaskSettingsResponse) GetAutoArchivePendingCount() int64 {
+	if x != nil {
+		return x.AutoArchivePendingCount
+	}
+	return 0
+}
+
+// ActivityBucket holds task activity within one time bucket
+type ActivityBucket struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BucketStart    string                 `protobuf:"bytes,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"` // format "YYYY-MM-DD" (UTC)
+	CreatedCount   int32                  `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	CompletedCount int32                  `protobuf:"varint,3,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`
+	ArchivedCount  int32                  `protobuf:"varint,4,opt,name=archived_count,json=archivedCount,proto3" json:"archived_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ActivityBucket) Reset() {
+	*x = ActivityBucket{}
+	mi := &file_task_v1_task_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityBucket) ProtoMessage() {}
+
+func (x *ActivityBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityBucket.ProtoReflect.Descriptor instead.
+func (*ActivityBucket) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ActivityBucket) GetBucketStart() string {
+	if x != nil {
+		return x.BucketStart
+	}
+	return ""
+}
+
+func (x *ActivityBucket) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ActivityBucket) GetCompletedCount() int32 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
+func (x *ActivityBucket) GetArchivedCount() int32 {
+	if x != nil {
+		return x.ArchivedCount
+	}
+	return 0
+}
+
+// TagStats holds task counts for a single tag
+type TagStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TagId          string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	TagName        string                 `protobuf:"bytes,2,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	OpenCount      int32                  `protobuf:"varint,3,opt,name=open_count,json=openCount,proto3" json:"open_count,omitempty"`
+	CompletedCount int32                  `protobuf:"varint,4,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TagStats) Reset() {
+	*x = TagStats{}
+	mi := &file_task_v1_task_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagStats) ProtoMessage() {}
+
+func (x *TagStats) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagStats.ProtoReflect.Descriptor instead.
+func (*TagStats) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TagStats) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *TagStats) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *TagStats) GetOpenCount() int32 {
+	if x != nil {
+		return x.OpenCount
+	}
+	return 0
+}
+
+func (x *TagStats) GetCompletedCount() int32 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
+// GetTaskStatsRequest is the request message for task statistics
+type GetTaskStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        StatsBucket            `protobuf:"varint,1,opt,name=bucket,proto3,enum=task.v1.StatsBucket" json:"bucket,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // lookback window including today, defaults to 30, at most 365
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskStatsRequest) Reset() {
+	*x = GetTaskStatsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskStatsRequest) ProtoMessage() {}
+
+func (x *GetTaskStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskStatsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTaskStatsRequest) GetBucket() StatsBucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return StatsBucket_STATS_BUCKET_UNSPECIFIED
+}
+
+func (x *GetTaskStatsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// GetTaskStatsResponse is the response message for task statistics
+type GetTaskStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activity      []*ActivityBucket      `protobuf:"bytes,1,rep,name=activity,proto3" json:"activity,omitempty"` // buckets with activity, oldest first
+	TagStats      []*TagStats            `protobuf:"bytes,2,rep,name=tag_stats,json=tagStats,proto3" json:"tag_stats,omitempty"`
+	BacklogSize   int32                  `protobuf:"varint,3,opt,name=backlog_size,json=backlogSize,proto3" json:"backlog_size,omitempty"` // open tasks that are neither completed nor archived
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskStatsResponse) Reset() {
+	*x = GetTaskStatsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskStatsResponse) ProtoMessage() {}
+
+func (x *GetTaskStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskStatsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetTaskStatsResponse) GetActivity() []*ActivityBucket {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+func (x *GetTaskStatsResponse) GetTagStats() []*TagStats {
+	if x != nil {
+		return x.TagStats
+	}
+	return nil
+}
+
+func (x *GetTaskStatsResponse) GetBacklogSize() int32 {
+	if x != nil {
+		return x.BacklogSize
+	}
+	return 0
+}
+
+// GenerateWeeklyReviewRequest is the request message for building a weekly review
+type GenerateWeeklyReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaleDays     int32                  `protobuf:"varint,1,opt,name=stale_days,json=staleDays,proto3" json:"stale_days,omitempty"` // open tasks untouched for this many days are stale, defaults to 14, at most 365
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateWeeklyReviewRequest) Reset() {
+	*x = GenerateWeeklyReviewRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateWeeklyReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateWeeklyReviewRequest) ProtoMessage() {}
+
+func (x *GenerateWeeklyReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateWeeklyReviewRequest.ProtoReflect.Descriptor instead.
+func (*GenerateWeeklyReviewRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GenerateWeeklyReviewRequest) GetStaleDays() int32 {
+	if x != nil {
+		return x.StaleDays
+	}
+	return 0
+}
+
+// GenerateWeeklyReviewResponse is the response message for building a weekly review.
+// Each section holds at most 50 tasks.
+type GenerateWeeklyReviewResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WeekStart         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`                           // Monday 00:00 UTC of the current week
+	StaleTasks        []*Task                `protobuf:"bytes,2,rep,name=stale_tasks,json=staleTasks,proto3" json:"stale_tasks,omitempty"`                        // least recently updated first
+	UndatedTasks      []*Task                `protobuf:"bytes,3,rep,name=undated_tasks,json=undatedTasks,proto3" json:"undated_tasks,omitempty"`                  // open tasks without start_date or deadline, oldest first
+	CompletedThisWeek []*Task                `protobuf:"bytes,4,rep,name=completed_this_week,json=completedThisWeek,proto3" json:"completed_this_week,omitempty"` // most recently completed first
+	OverdueTasks      []*Task                `protobuf:"bytes,5,rep,name=overdue_tasks,json=overdueTasks,proto3" json:"overdue_tasks,omitempty"`                  // earliest deadline first
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GenerateWeeklyReviewResponse) Reset() {
+	*x = GenerateWeeklyReviewResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateWeeklyReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateWeeklyReviewResponse) ProtoMessage() {}
+
+func (x *GenerateWeeklyReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateWeeklyReviewResponse.ProtoReflect.Descriptor instead.
+func (*GenerateWeeklyReviewResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GenerateWeeklyReviewResponse) GetWeekStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekStart
+	}
+	return nil
+}
+
+func (x *GenerateWeeklyReviewResponse) GetStaleTasks() []*Task {
+	if x != nil {
+		return x.StaleTasks
+	}
+	return nil
+}
+
+func (x *GenerateWeeklyReviewResponse) GetUndatedTasks() []*Task {
+	if x != nil {
+		return x.UndatedTasks
+	}
+	return nil
+}
+
+func (x *GenerateWeeklyReviewResponse) GetCompletedThisWeek() []*Task {
+	if x != nil {
+		return x.CompletedThisWeek
+	}
+	return nil
+}
+
+func (x *GenerateWeeklyReviewResponse) GetOverdueTasks() []*Task {
+	if x != nil {
+		return x.OverdueTasks
+	}
+	return nil
+}
+
+// ListStaleTasksRequest is the request message for listing tasks left untouched
+type ListStaleTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThresholdDays int32                  `protobuf:"varint,1,opt,name=threshold_days,json=thresholdDays,proto3" json:"threshold_days,omitempty"` // open tasks neither updated nor viewed for this many days are stale, defaults to 30, at most 3650
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                // defaults to 50, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaleTasksRequest) Reset() {
+	*x = ListStaleTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaleTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaleTasksRequest) ProtoMessage() {}
+
+func (x *ListStaleTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaleTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListStaleTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListStaleTasksRequest) GetThresholdDays() int32 {
+	if x != nil {
+		return x.ThresholdDays
+	}
+	return 0
+}
+
+func (x *ListStaleTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListStaleTasksResponse is the response message for listing tasks left untouched
+type ListStaleTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // longest untouched first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaleTasksResponse) Reset() {
+	*x = ListStaleTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaleTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaleTasksResponse) ProtoMessage() {}
+
+func (x *ListStaleTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaleTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListStaleTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListStaleTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// SetTaskGeofenceRequest is the request message for setting or removing the
+// geofence of a task
+type SetTaskGeofenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Geofence      *Geofence              `protobuf:"bytes,2,opt,name=geofence,proto3" json:"geofence,omitempty"` // unset removes the task's geofence
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaskGeofenceRequest) Reset() {
+	*x = SetTaskGeofenceRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaskGeofenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaskGeofenceRequest) ProtoMessage() {}
+
+func (x *SetTaskGeofenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaskGeofenceRequest.ProtoReflect.Descriptor instead.
+func (*SetTaskGeofenceRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetTaskGeofenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetTaskGeofenceRequest) GetGeofence() *Geofence {
+	if x != nil {
+		return x.Geofence
+	}
+	return nil
+}
+
+// SetTaskGeofenceResponse is the response message for setting or removing the
+// geofence of a task
+type SetTaskGeofenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaskGeofenceResponse) Reset() {
+	*x = SetTaskGeofenceResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaskGeofenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaskGeofenceResponse) ProtoMessage() {}
+
+func (x *SetTaskGeofenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaskGeofenceResponse.ProtoReflect.Descriptor instead.
+func (*SetTaskGeofenceResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetTaskGeofenceResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// MarkTaskViewedRequest is the request message for recording that a task was opened
+type MarkTaskViewedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkTaskViewedRequest) Reset() {
+	*x = MarkTaskViewedRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkTaskViewedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkTaskViewedRequest) ProtoMessage() {}
+
+func (x *MarkTaskViewedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkTaskViewedRequest.ProtoReflect.Descriptor instead.
+func (*MarkTaskViewedRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MarkTaskViewedRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// MarkTaskViewedResponse is the response message for recording that a task was opened
+type MarkTaskViewedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkTaskViewedResponse) Reset() {
+	*x = MarkTaskViewedResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkTaskViewedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkTaskViewedResponse) ProtoMessage() {}
+
+func (x *MarkTaskViewedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkTaskViewedResponse.ProtoReflect.Descriptor instead.
+func (*MarkTaskViewedResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{48}
+}
+
+// AddTagToTasksRequest is the request message for tagging many tasks at once
+type AddTagToTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tag_name is created if the user has no tag with that name.
+	TagName       string   `protobuf:"bytes,1,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	TaskIds       []string `protobuf:"bytes,2,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTagToTasksRequest) Reset() {
+	*x = AddTagToTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTagToTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTagToTasksRequest) ProtoMessage() {}
+
+func (x *AddTagToTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTagToTasksRequest.ProtoReflect.Descriptor instead.
+func (*AddTagToTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AddTagToTasksRequest) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *AddTagToTasksRequest) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+// AddTagToTasksResponse is the response message for tagging many tasks at once
+type AddTagToTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tasks are the tagged tasks, in request order.
+	Tasks         []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTagToTasksResponse) Reset() {
+	*x = AddTagToTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTagToTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTagToTasksResponse) ProtoMessage() {}
+
+func (x *AddTagToTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTagToTasksResponse.ProtoReflect.Descriptor instead.
+func (*AddTagToTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AddTagToTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// RemoveTagFromTasksRequest is the request message for untagging many tasks at once
+type RemoveTagFromTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagName       string                 `protobuf:"bytes,1,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	TaskIds       []string               `protobuf:"bytes,2,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTagFromTasksRequest) Reset() {
+	*x = RemoveTagFromTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTagFromTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTagFromTasksRequest) ProtoMessage() {}
+
+func (x *RemoveTagFromTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTagFromTasksRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTagFromTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RemoveTagFromTasksRequest) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *RemoveTagFromTasksRequest) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+// RemoveTagFromTasksResponse is the response message for untagging many tasks at once
+type RemoveTagFromTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tasks are the untagged tasks, in request order.
+	Tasks         []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTagFromTasksResponse) Reset() {
+	*x = RemoveTagFromTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTagFromTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTagFromTasksResponse) ProtoMessage() {}
+
+func (x *RemoveTagFromTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTagFromTasksResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTagFromTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RemoveTagFromTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// TransferTasksRequest is the request message for handing tasks to another user
+type TransferTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferTasksRequest) Reset() {
+	*x = TransferTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTasksRequest) ProtoMessage() {}
+
+func (x *TransferTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTasksRequest.ProtoReflect.Descriptor instead.
+func (*TransferTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TransferTasksRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *TransferTasksRequest) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+// TransferTasksResponse is the response message for handing tasks to another user
+type TransferTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task_ids lists the transferred tasks, which keep their IDs.
+	TaskIds []string `protobuf:"bytes,1,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	// created_tag_count counts the tags the recipient did not have yet; the
+	// others were matched by name.
+	CreatedTagCount int32 `protobuf:"varint,2,opt,name=created_tag_count,json=createdTagCount,proto3" json:"created_tag_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferTasksResponse) Reset() {
+	*x = TransferTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTasksResponse) ProtoMessage() {}
+
+func (x *TransferTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTasksResponse.ProtoReflect.Descriptor instead.
+func (*TransferTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TransferTasksResponse) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *TransferTasksResponse) GetCreatedTagCount() int32 {
+	if x != nil {
+		return x.CreatedTagCount
+	}
+	return 0
+}
+
+// TogglePinTaskRequest is the request message for pinning or unpinning a task
+type TogglePinTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TogglePinTaskRequest) Reset() {
+	*x = TogglePinTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TogglePinTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TogglePinTaskRequest) ProtoMessage() {}
+
+func (x *TogglePinTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TogglePinTaskRequest.ProtoReflect.Descriptor instead.
+func (*TogglePinTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TogglePinTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// TogglePinTaskResponse is the response message for pinning or unpinning a task
+type TogglePinTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TogglePinTaskResponse) Reset() {
+	*x = TogglePinTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TogglePinTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TogglePinTaskResponse) ProtoMessage() {}
+
+func (x *TogglePinTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TogglePinTaskResponse.ProtoReflect.Descriptor instead.
+func (*TogglePinTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TogglePinTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type TaskGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`      // "YYYY-MM-DD" for date groupings, the tag ID for TASK_GROUP_BY_TAG, empty for tasks without one
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // tasks in the group across all pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskGroup) Reset() {
+	*x = TaskGroup{}
+	mi := &file_task_v1_task_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskGroup) ProtoMessage() {}
+
+func (x *TaskGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskGroup.ProtoReflect.Descriptor instead.
+func (*TaskGroup) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TaskGroup) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TaskGroup) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ListTasksRequest is the request message for listing tasks
+type ListTasksRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PageSize            int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to the user's default or 30, at most 100
+	PageToken           string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	FilterTagIds        []string               `protobuf:"bytes,3,rep,name=filter_tag_ids,json=filterTagIds,proto3" json:"filter_tag_ids,omitempty"`
+	IncludeArchived     *bool                  `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3,oneof" json:"include_archived,omitempty"`
+	ArchivedOnly        *bool                  `protobuf:"varint,5,opt,name=archived_only,json=archivedOnly,proto3,oneof" json:"archived_only,omitempty"`
+	DeadlineApproaching *bool                  `protobuf:"varint,6,opt,name=deadline_approaching,json=deadlineApproaching,proto3,oneof" json:"deadline_approaching,omitempty"`  // only tasks overdue or due within the next 3 days
+	TagMatchMode        TagMatchMode           `protobuf:"varint,7,opt,name=tag_match_mode,json=tagMatchMode,proto3,enum=task.v1.TagMatchMode" json:"tag_match_mode,omitempty"` // how filter_tag_ids are combined, defaults to the user's default
+	ExcludeTagIds       []string               `protobuf:"bytes,8,rep,name=exclude_tag_ids,json=excludeTagIds,proto3" json:"exclude_tag_ids,omitempty"`                         // drop tasks carrying any of these tags
+	UntaggedOnly        *bool                  `protobuf:"varint,9,opt,name=untagged_only,json=untaggedOnly,proto3,oneof" json:"untagged_only,omitempty"`                       // only tasks without tags
+	StartDateFrom       *string                `protobuf:"bytes,10,opt,name=start_date_from,json=startDateFrom,proto3,oneof" json:"start_date_from,omitempty"`                  // format "YYYY-MM-DD", inclusive lower bound on start_date
+	StartDateTo         *string                `protobuf:"bytes,11,opt,name=start_date_to,json=startDateTo,proto3,oneof" json:"start_date_to,omitempty"`                        // format "YYYY-MM-DD", inclusive upper bound on start_date
+	InboxOnly           *bool                  `protobuf:"varint,12,opt,name=inbox_only,json=inboxOnly,proto3,oneof" json:"inbox_only,omitempty"`                               // only tasks without a start_date
+	GroupBy             TaskGroupBy            `protobuf:"varint,13,opt,name=group_by,json=groupBy,proto3,enum=task.v1.TaskGroupBy" json:"group_by,omitempty"`                  // grouping reported in ListTasksResponse.groups
+	// only tasks modified after this instant; deletions since then are returned in deleted_tasks.
+	// Combine with include_archived to also observe archive changes.
+	UpdatedAfter *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_after,json=updatedAfter,proto3,oneof" json:"updated_after,omitempty"`
+	Contexts     []string               `protobuf:"bytes,15,rep,name=contexts,proto3" json:"contexts,omitempty"` // only tasks in any of these contexts, e.g. "@home"
+	// only tasks archived at or after archived_after and before archived_before.
+	// Setting either implies archived_only unless include_archived is set.
+	ArchivedAfter  *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=archived_after,json=archivedAfter,proto3,oneof" json:"archived_after,omitempty"`
+	ArchivedBefore *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=archived_before,json=archivedBefore,proto3,oneof" json:"archived_before,omitempty"`
+	OrderBy        TaskOrderBy            `protobuf:"varint,18,opt,name=order_by,json=orderBy,proto3,enum=task.v1.TaskOrderBy" json:"order_by,omitempty"`
+	// Task fields to return, as in GetTaskRequest.read_mask
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,19,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// continues deleted_tasks from next_deleted_tasks_page_token of a previous
+	// response with the same updated_after
+	DeletedTasksPageToken string `protobuf:"bytes,20,opt,name=deleted_tasks_page_token,json=deletedTasksPageToken,proto3" json:"deleted_tasks_page_token,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetFilterTagIds() []string {
+	if x != nil {
+		return x.FilterTagIds
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetIncludeArchived() bool {
+	if x != nil && x.IncludeArchived != nil {
+		return *x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetArchivedOnly() bool {
+	if x != nil && x.ArchivedOnly != nil {
+		return *x.ArchivedOnly
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetDeadlineApproaching() bool {
+	if x != nil && x.DeadlineApproaching != nil {
+		return *x.DeadlineApproaching
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetTagMatchMode() TagMatchMode {
+	if x != nil {
+		return x.TagMatchMode
+	}
+	return TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetExcludeTagIds() []string {
+	if x != nil {
+		return x.ExcludeTagIds
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetUntaggedOnly() bool {
+	if x != nil && x.UntaggedOnly != nil {
+		return *x.UntaggedOnly
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetStartDateFrom() string {
+	if x != nil && x.StartDateFrom != nil {
+		return *x.StartDateFrom
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetStartDateTo() string {
+	if x != nil && x.StartDateTo != nil {
+		return *x.StartDateTo
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetInboxOnly() bool {
+	if x != nil && x.InboxOnly != nil {
+		return *x.InboxOnly
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetGroupBy() TaskGroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return TaskGroupBy_TASK_GROUP_BY_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetContexts() []string {
+	if x != nil {
+		return x.Contexts
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetArchivedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAfter
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetArchivedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedBefore
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetOrderBy() TaskOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return TaskOrderBy_TASK_ORDER_BY_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetDeletedTasksPageToken() string {
+	if x != nil {
+		return x.DeletedTasksPageToken
+	}
+	return ""
+}
+
+// DeletedTask is a tombstone for a task deleted after ListTasksRequest.updated_after
+type DeletedTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletedTask) Reset() {
+	*x = DeletedTask{}
+	mi := &file_task_v1_task_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedTask) ProtoMessage() {}
+
+func (x *DeletedTask) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedTask.ProtoReflect.Descriptor instead.
+func (*DeletedTask) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeletedTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeletedTask) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+// ListTasksResponse is the response message for listing tasks
+type ListTasksResponse struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Tasks                     []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken             string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize                 int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`                                                      // tasks matching the filters across all pages
+	Groups                    []*TaskGroup           `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`                                                                              // groups represented on this page, in page order
+	DeletedTasks              []*DeletedTask         `protobuf:"bytes,5,rep,name=deleted_tasks,json=deletedTasks,proto3" json:"deleted_tasks,omitempty"`                                              // only set when updated_after is provided, oldest first, at most page_size
+	NextDeletedTasksPageToken string                 `protobuf:"bytes,6,opt,name=next_deleted_tasks_page_token,json=nextDeletedTasksPageToken,proto3" json:"next_deleted_tasks_page_token,omitempty"` // empty when there are no more deleted tasks
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTasksResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *ListTasksResponse) GetGroups() []*TaskGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetDeletedTasks() []*DeletedTask {
+	if x != nil {
+		return x.DeletedTasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetNextDeletedTasksPageToken() string {
+	if x != nil {
+		return x.NextDeletedTasksPageToken
+	}
+	return ""
+}
+
+// StreamTasksRequest is the request message for streaming all of the caller's tasks
+type StreamTasksRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeArchived *bool                  `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3,oneof" json:"include_archived,omitempty"`
+	ArchivedOnly    *bool                  `protobuf:"varint,2,opt,name=archived_only,json=archivedOnly,proto3,oneof" json:"archived_only,omitempty"`
+	ChunkSize       int32                  `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"` // tasks per response message, defaults to 100, at most 500
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamTasksRequest) Reset() {
+	*x = StreamTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTasksRequest) ProtoMessage() {}
+
+func (x *StreamTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTasksRequest.ProtoReflect.Descriptor instead.
+func (*StreamTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *StreamTasksRequest) GetIncludeArchived() bool {
+	if x != nil && x.IncludeArchived != nil {
+		return *x.IncludeArchived
+	}
+	return false
+}
+
+func (x *StreamTasksRequest) GetArchivedOnly() bool {
+	if x != nil && x.ArchivedOnly != nil {
+		return *x.ArchivedOnly
+	}
+	return false
+}
+
+func (x *StreamTasksRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+// StreamTasksResponse carries one chunk of tasks, in ID order, with their checklists
+type StreamTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamTasksResponse) Reset() {
+	*x = StreamTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTasksResponse) ProtoMessage() {}
+
+func (x *StreamTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTasksResponse.ProtoReflect.Descriptor instead.
+func (*StreamTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *StreamTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
-	mi := &file_task_v1_task_proto_msgTypes[0]
+// WatchChangesRequest is the request message for watching the caller's changes
+type WatchChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchChangesRequest) Reset() {
+	*x = WatchChangesRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *WatchChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*WatchChangesRequest) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[0]
+func (x *WatchChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -63,103 +4202,170 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use WatchChangesRequest.ProtoReflect.Descriptor instead.
+func (*WatchChangesRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{63}
+}
+
+// WatchChangesResponse is one change event
+type WatchChangesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Resource  ChangeResource         `protobuf:"varint,1,opt,name=resource,proto3,enum=task.v1.ChangeResource" json:"resource,omitempty"`
+	Operation ChangeOperation        `protobuf:"varint,2,opt,name=operation,proto3,enum=task.v1.ChangeOperation" json:"operation,omitempty"`
+	Id        string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`                       // the changed resource; empty for resync events
+	TaskId    string                 `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // task of a checklist item, when known
+	// Current version of an upserted resource
+	//
+	// Types that are valid to be assigned to ResourceValue:
+	//
+	//	*WatchChangesResponse_Task
+	//	*WatchChangesResponse_Tag
+	//	*WatchChangesResponse_ChecklistItem
+	ResourceValue isWatchChangesResponse_ResourceValue `protobuf_oneof:"resource_value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) GetId() string {
+func (x *WatchChangesResponse) Reset() {
+	*x = WatchChangesResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChangesResponse) ProtoMessage() {}
+
+func (x *WatchChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[64]
 	if x != nil {
-		return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetTitle() string {
+// Deprecated: Use WatchChangesResponse.ProtoReflect.Descriptor instead.
+func (*WatchChangesResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *WatchChangesResponse) GetResource() ChangeResource {
 	if x != nil {
-		return x.Title
+		return x.Resource
 	}
-	return ""
+	return ChangeResource_CHANGE_RESOURCE_UNSPECIFIED
 }
 
-func (x *Task) GetNotes() string {
+func (x *WatchChangesResponse) GetOperation() ChangeOperation {
 	if x != nil {
-		return x.Notes
+		return x.Operation
 	}
-	return ""
+	return ChangeOperation_CHANGE_OPERATION_UNSPECIFIED
 }
 
-func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+func (x *WatchChangesResponse) GetId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *WatchChangesResponse) GetTaskId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.TaskId
 	}
-	return nil
+	return ""
 }
 
-func (x *Task) GetTagIds() []string {
+func (x *WatchChangesResponse) GetResourceValue() isWatchChangesResponse_ResourceValue {
 	if x != nil {
-		return x.TagIds
+		return x.ResourceValue
 	}
 	return nil
 }
 
-func (x *Task) GetArchivedAt() *timestamppb.Timestamp {
+func (x *WatchChangesResponse) GetTask() *Task {
 	if x != nil {
-		return x.ArchivedAt
+		if x, ok := x.ResourceValue.(*WatchChangesResponse_Task); ok {
+			return x.Task
+		}
 	}
 	return nil
 }
 
-func (x *Task) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
+func (x *WatchChangesResponse) GetTag() *v1.Tag {
+	if x != nil {
+		if x, ok := x.ResourceValue.(*WatchChangesResponse_Tag); ok {
+			return x.Tag
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *Task) GetChecklistItems() []*ChecklistItem {
+func (x *WatchChangesResponse) GetChecklistItem() *ChecklistItem {
 	if x != nil {
-		return x.ChecklistItems
+		if x, ok := x.ResourceValue.(*WatchChangesResponse_ChecklistItem); ok {
+			return x.ChecklistItem
+		}
 	}
 	return nil
 }
 
-// ChecklistItem represents one checklist row under a task
-type ChecklistItem struct {
+type isWatchChangesResponse_ResourceValue interface {
+	isWatchChangesResponse_ResourceValue()
+}
+
+type WatchChangesResponse_Task struct {
+	Task *Task `protobuf:"bytes,5,opt,name=task,proto3,oneof"` // with its checklist items
+}
+
+type WatchChangesResponse_Tag struct {
+	Tag *v1.Tag `protobuf:"bytes,6,opt,name=tag,proto3,oneof"`
+}
+
+type WatchChangesResponse_ChecklistItem struct {
+	ChecklistItem *ChecklistItem `protobuf:"bytes,7,opt,name=checklist_item,json=checklistItem,proto3,oneof"`
+}
+
+func (*WatchChangesResponse_Task) isWatchChangesResponse_ResourceValue() {}
+
+func (*WatchChangesResponse_Tag) isWatchChangesResponse_ResourceValue() {}
+
+func (*WatchChangesResponse_ChecklistItem) isWatchChangesResponse_ResourceValue() {}
+
+// ListTasksByFilterRequest is the request message for listing tasks matching a saved filter
+type ListTasksByFilterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Completed     bool                   `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
-	SortOrder     int32                  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FilterId      string                 `protobuf:"bytes,1,opt,name=filter_id,json=filterId,proto3" json:"filter_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to the user's default or 30, at most 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	GroupBy       TaskGroupBy            `protobuf:"varint,4,opt,name=group_by,json=groupBy,proto3,enum=task.v1.TaskGroupBy" json:"group_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChecklistItem) Reset() {
-	*x = ChecklistItem{}
-	mi := &file_task_v1_task_proto_msgTypes[1]
+func (x *ListTasksByFilterRequest) Reset() {
+	*x = ListTasksByFilterRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChecklistItem) String() string {
+func (x *ListTasksByFilterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChecklistItem) ProtoMessage() {}
+func (*ListTasksByFilterRequest) ProtoMessage() {}
 
-func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[1]
+func (x *ListTasksByFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,87 +4376,132 @@ func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChecklistItem.ProtoReflect.Descriptor instead.
-func (*ChecklistItem) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ListTasksByFilterRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksByFilterRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ChecklistItem) GetId() string {
+func (x *ListTasksByFilterRequest) GetFilterId() string {
 	if x != nil {
-		return x.Id
+		return x.FilterId
 	}
 	return ""
 }
 
-func (x *ChecklistItem) GetTaskId() string {
+func (x *ListTasksByFilterRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.TaskId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *ChecklistItem) GetContent() string {
+func (x *ListTasksByFilterRequest) GetPageToken() string {
 	if x != nil {
-		return x.Content
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *ChecklistItem) GetCompleted() bool {
+func (x *ListTasksByFilterRequest) GetGroupBy() TaskGroupBy {
 	if x != nil {
-		return x.Completed
+		return x.GroupBy
 	}
-	return false
+	return TaskGroupBy_TASK_GROUP_BY_UNSPECIFIED
 }
 
-func (x *ChecklistItem) GetSortOrder() int32 {
+// ListTasksByFilterResponse is the response message for listing tasks matching a saved filter
+type ListTasksByFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Groups        []*TaskGroup           `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksByFilterResponse) Reset() {
+	*x = ListTasksByFilterResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksByFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksByFilterResponse) ProtoMessage() {}
+
+func (x *ListTasksByFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[66]
 	if x != nil {
-		return x.SortOrder
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ChecklistItem) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use ListTasksByFilterResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksByFilterResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListTasksByFilterResponse) GetTasks() []*Task {
 	if x != nil {
-		return x.CreatedAt
+		return x.Tasks
 	}
 	return nil
 }
 
-func (x *ChecklistItem) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ListTasksByFilterResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTasksByFilterResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *ListTasksByFilterResponse) GetGroups() []*TaskGroup {
+	if x != nil {
+		return x.Groups
 	}
 	return nil
 }
 
-// CreateTaskRequest is the request message for creating a task
-type CreateTaskRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Notes          string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
-	TagNames       []string               `protobuf:"bytes,3,rep,name=tag_names,json=tagNames,proto3" json:"tag_names,omitempty"`
-	StartDate      *string                `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // optional
-	ChecklistItems []string               `protobuf:"bytes,6,rep,name=checklist_items,json=checklistItems,proto3" json:"checklist_items,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// AddChecklistItemRequest creates a new checklist item for a task
+type AddChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskRequest) Reset() {
-	*x = CreateTaskRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[2]
+func (x *AddChecklistItemRequest) Reset() {
+	*x = AddChecklistItemRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskRequest) String() string {
+func (x *AddChecklistItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskRequest) ProtoMessage() {}
+func (*AddChecklistItemRequest) ProtoMessage() {}
 
-func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[2]
+func (x *AddChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,69 +4512,50 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *CreateTaskRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use AddChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*AddChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CreateTaskRequest) GetNotes() string {
+func (x *AddChecklistItemRequest) GetTaskId() string {
 	if x != nil {
-		return x.Notes
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *CreateTaskRequest) GetTagNames() []string {
+func (x *AddChecklistItemRequest) GetContent() string {
 	if x != nil {
-		return x.TagNames
-	}
-	return nil
-}
-
-func (x *CreateTaskRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
+		return x.Content
 	}
 	return ""
 }
 
-func (x *CreateTaskRequest) GetChecklistItems() []string {
-	if x != nil {
-		return x.ChecklistItems
-	}
-	return nil
-}
-
-// CreateTaskResponse is the response message for creating a task
-type CreateTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// AddChecklistItemResponse returns the created checklist item
+type AddChecklistItemResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Item                *ChecklistItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	ChecklistTotalCount int32                  `protobuf:"varint,2,opt,name=checklist_total_count,json=checklistTotalCount,proto3" json:"checklist_total_count,omitempty"` // items the task has now, including this one
+	MaxChecklistItems   int32                  `protobuf:"varint,3,opt,name=max_checklist_items,json=maxChecklistItems,proto3" json:"max_checklist_items,omitempty"`       // items a task may have; adding more fails with FAILED_PRECONDITION
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *CreateTaskResponse) Reset() {
-	*x = CreateTaskResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[3]
+func (x *AddChecklistItemResponse) Reset() {
+	*x = AddChecklistItemResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskResponse) String() string {
+func (x *AddChecklistItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskResponse) ProtoMessage() {}
+func (*AddChecklistItemResponse) ProtoMessage() {}
 
-func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[3]
+func (x *AddChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,41 +4566,56 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AddChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*AddChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *CreateTaskResponse) GetTask() *Task {
+func (x *AddChecklistItemResponse) GetItem() *ChecklistItem {
 	if x != nil {
-		return x.Task
+		return x.Item
 	}
 	return nil
 }
 
-// GetTaskRequest is the request message for getting a task
-type GetTaskRequest struct {
+func (x *AddChecklistItemResponse) GetChecklistTotalCount() int32 {
+	if x != nil {
+		return x.ChecklistTotalCount
+	}
+	return 0
+}
+
+func (x *AddChecklistItemResponse) GetMaxChecklistItems() int32 {
+	if x != nil {
+		return x.MaxChecklistItems
+	}
+	return 0
+}
+
+// UpdateChecklistItemRequest updates checklist item content
+type UpdateChecklistItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskRequest) Reset() {
-	*x = GetTaskRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[4]
+func (x *UpdateChecklistItemRequest) Reset() {
+	*x = UpdateChecklistItemRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskRequest) String() string {
+func (x *UpdateChecklistItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskRequest) ProtoMessage() {}
+func (*UpdateChecklistItemRequest) ProtoMessage() {}
 
-func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[4]
+func (x *UpdateChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -379,41 +4626,48 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UpdateChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetTaskRequest) GetId() string {
+func (x *UpdateChecklistItemRequest) GetItemId() string {
 	if x != nil {
-		return x.Id
+		return x.ItemId
 	}
 	return ""
 }
 
-// GetTaskResponse is the response message for getting a task
-type GetTaskResponse struct {
+func (x *UpdateChecklistItemRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// UpdateChecklistItemResponse returns the updated checklist item
+type UpdateChecklistItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Item          *ChecklistItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskResponse) Reset() {
-	*x = GetTaskResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[5]
+func (x *UpdateChecklistItemResponse) Reset() {
+	*x = UpdateChecklistItemResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskResponse) String() string {
+func (x *UpdateChecklistItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskResponse) ProtoMessage() {}
+func (*UpdateChecklistItemResponse) ProtoMessage() {}
 
-func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[5]
+func (x *UpdateChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,45 +4678,42 @@ func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use UpdateChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetTaskResponse) GetTask() *Task {
+func (x *UpdateChecklistItemResponse) GetItem() *ChecklistItem {
 	if x != nil {
-		return x.Task
+		return x.Item
 	}
 	return nil
 }
 
-// UpdateTaskRequest is the request message for updating a task
-type UpdateTaskRequest struct {
+// SetChecklistItemCompletedRequest sets checklist item completion state
+type SetChecklistItemCompletedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
-	TagNames      []string               `protobuf:"bytes,4,rep,name=tag_names,json=tagNames,proto3" json:"tag_names,omitempty"`
-	StartDate     *string                `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // optional
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Completed     bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[6]
+func (x *SetChecklistItemCompletedRequest) Reset() {
+	*x = SetChecklistItemCompletedRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *SetChecklistItemCompletedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*SetChecklistItemCompletedRequest) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[6]
+func (x *SetChecklistItemCompletedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,69 +4724,93 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SetChecklistItemCompletedRequest.ProtoReflect.Descriptor instead.
+func (*SetChecklistItemCompletedRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *UpdateTaskRequest) GetId() string {
+func (x *SetChecklistItemCompletedRequest) GetItemId() string {
 	if x != nil {
-		return x.Id
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *UpdateTaskRequest) GetTitle() string {
+func (x *SetChecklistItemCompletedRequest) GetCompleted() bool {
 	if x != nil {
-		return x.Title
+		return x.Completed
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateTaskRequest) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
+// SetChecklistItemCompletedResponse returns the updated checklist item
+type SetChecklistItemCompletedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *ChecklistItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) GetTagNames() []string {
+func (x *SetChecklistItemCompletedResponse) Reset() {
+	*x = SetChecklistItemCompletedResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChecklistItemCompletedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChecklistItemCompletedResponse) ProtoMessage() {}
+
+func (x *SetChecklistItemCompletedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[72]
 	if x != nil {
-		return x.TagNames
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateTaskRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
+// Deprecated: Use SetChecklistItemCompletedResponse.ProtoReflect.Descriptor instead.
+func (*SetChecklistItemCompletedResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetChecklistItemCompletedResponse) GetItem() *ChecklistItem {
+	if x != nil {
+		return x.Item
 	}
-	return ""
+	return nil
 }
 
-// UpdateTaskResponse is the response message for updating a task
-type UpdateTaskResponse struct {
+// DeleteChecklistItemRequest deletes a checklist item
+type DeleteChecklistItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskResponse) Reset() {
-	*x = UpdateTaskResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[7]
+func (x *DeleteChecklistItemRequest) Reset() {
+	*x = DeleteChecklistItemRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskResponse) String() string {
+func (x *DeleteChecklistItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskResponse) ProtoMessage() {}
+func (*DeleteChecklistItemRequest) ProtoMessage() {}
 
-func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[7]
+func (x *DeleteChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,41 +4821,40 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DeleteChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UpdateTaskResponse) GetTask() *Task {
+func (x *DeleteChecklistItemRequest) GetItemId() string {
 	if x != nil {
-		return x.Task
+		return x.ItemId
 	}
-	return nil
+	return ""
 }
 
-// DeleteTaskRequest is the request message for deleting a task
-type DeleteTaskRequest struct {
+// DeleteChecklistItemResponse indicates successful deletion
+type DeleteChecklistItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[8]
+func (x *DeleteChecklistItemResponse) Reset() {
+	*x = DeleteChecklistItemResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *DeleteChecklistItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*DeleteChecklistItemResponse) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[8]
+func (x *DeleteChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,40 +4865,36 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *DeleteTaskRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use DeleteChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{74}
 }
 
-// DeleteTaskResponse is the response message for deleting a task
-type DeleteTaskResponse struct {
+// ReorderChecklistItemsRequest reorders all checklist items for a task.
+// item_ids must contain all checklist item IDs for the task in final order.
+type ReorderChecklistItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ItemIds       []string               `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskResponse) Reset() {
-	*x = DeleteTaskResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[9]
+func (x *ReorderChecklistItemsRequest) Reset() {
+	*x = ReorderChecklistItemsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskResponse) String() string {
+func (x *ReorderChecklistItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskResponse) ProtoMessage() {}
+func (*ReorderChecklistItemsRequest) ProtoMessage() {}
 
-func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[9]
+func (x *ReorderChecklistItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,34 +4905,48 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ReorderChecklistItemsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderChecklistItemsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{75}
 }
 
-// ArchiveTaskRequest is the request message for archiving a task
-type ArchiveTaskRequest struct {
+func (x *ReorderChecklistItemsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ReorderChecklistItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+// ReorderChecklistItemsResponse returns checklist items in updated order
+type ReorderChecklistItemsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Items         []*ChecklistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveTaskRequest) Reset() {
-	*x = ArchiveTaskRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[10]
+func (x *ReorderChecklistItemsResponse) Reset() {
+	*x = ReorderChecklistItemsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveTaskRequest) String() string {
+func (x *ReorderChecklistItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveTaskRequest) ProtoMessage() {}
+func (*ReorderChecklistItemsResponse) ProtoMessage() {}
 
-func (x *ArchiveTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[10]
+func (x *ReorderChecklistItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,41 +4957,45 @@ func (x *ArchiveTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveTaskRequest.ProtoReflect.Descriptor instead.
-func (*ArchiveTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ReorderChecklistItemsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderChecklistItemsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ArchiveTaskRequest) GetId() string {
+func (x *ReorderChecklistItemsResponse) GetItems() []*ChecklistItem {
 	if x != nil {
-		return x.Id
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-// ArchiveTaskResponse is the response message for archiving a task
-type ArchiveTaskResponse struct {
+// NoteRevision is a previous version of a task's notes, recorded when an
+// update replaced them
+type NoteRevision struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // when the notes were replaced
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveTaskResponse) Reset() {
-	*x = ArchiveTaskResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[11]
+func (x *NoteRevision) Reset() {
+	*x = NoteRevision{}
+	mi := &file_task_v1_task_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveTaskResponse) String() string {
+func (x *NoteRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveTaskResponse) ProtoMessage() {}
+func (*NoteRevision) ProtoMessage() {}
 
-func (x *ArchiveTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[11]
+func (x *NoteRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,41 +5006,62 @@ func (x *ArchiveTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveTaskResponse.ProtoReflect.Descriptor instead.
-func (*ArchiveTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use NoteRevision.ProtoReflect.Descriptor instead.
+func (*NoteRevision) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ArchiveTaskResponse) GetTask() *Task {
+func (x *NoteRevision) GetId() string {
 	if x != nil {
-		return x.Task
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-// UnarchiveTaskRequest is the request message for unarchiving a task
-type UnarchiveTaskRequest struct {
+// ListNoteRevisionsRequest is the request message for listing note revisions
+type ListNoteRevisionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnarchiveTaskRequest) Reset() {
-	*x = UnarchiveTaskRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[12]
+func (x *ListNoteRevisionsRequest) Reset() {
+	*x = ListNoteRevisionsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnarchiveTaskRequest) String() string {
+func (x *ListNoteRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnarchiveTaskRequest) ProtoMessage() {}
+func (*ListNoteRevisionsRequest) ProtoMessage() {}
 
-func (x *UnarchiveTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[12]
+func (x *ListNoteRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,41 +5072,42 @@ func (x *UnarchiveTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnarchiveTaskRequest.ProtoReflect.Descriptor instead.
-func (*UnarchiveTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListNoteRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListNoteRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *UnarchiveTaskRequest) GetId() string {
+func (x *ListNoteRevisionsRequest) GetTaskId() string {
 	if x != nil {
-		return x.Id
+		return x.TaskId
 	}
 	return ""
 }
 
-// UnarchiveTaskResponse is the response message for unarchiving a task
-type UnarchiveTaskResponse struct {
+// ListNoteRevisionsResponse lists a task's note revisions, newest first.
+// Only the most recent 20 revisions per task are kept.
+type ListNoteRevisionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Revisions     []*NoteRevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnarchiveTaskResponse) Reset() {
-	*x = UnarchiveTaskResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[13]
+func (x *ListNoteRevisionsResponse) Reset() {
+	*x = ListNoteRevisionsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnarchiveTaskResponse) String() string {
+func (x *ListNoteRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnarchiveTaskResponse) ProtoMessage() {}
+func (*ListNoteRevisionsResponse) ProtoMessage() {}
 
-func (x *UnarchiveTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[13]
+func (x *ListNoteRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,45 +5118,42 @@ func (x *UnarchiveTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnarchiveTaskResponse.ProtoReflect.Descriptor instead.
-func (*UnarchiveTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListNoteRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListNoteRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *UnarchiveTaskResponse) GetTask() *Task {
+func (x *ListNoteRevisionsResponse) GetRevisions() []*NoteRevision {
 	if x != nil {
-		return x.Task
+		return x.Revisions
 	}
 	return nil
 }
 
-// ListTasksRequest is the request message for listing tasks
-type ListTasksRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	FilterTagIds    []string               `protobuf:"bytes,3,rep,name=filter_tag_ids,json=filterTagIds,proto3" json:"filter_tag_ids,omitempty"`
-	IncludeArchived *bool                  `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3,oneof" json:"include_archived,omitempty"`
-	ArchivedOnly    *bool                  `protobuf:"varint,5,opt,name=archived_only,json=archivedOnly,proto3,oneof" json:"archived_only,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// RestoreNoteRevisionRequest is the request message for restoring task notes
+type RestoreNoteRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	RevisionId    string                 `protobuf:"bytes,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksRequest) Reset() {
-	*x = ListTasksRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[14]
+func (x *RestoreNoteRevisionRequest) Reset() {
+	*x = RestoreNoteRevisionRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksRequest) String() string {
+func (x *RestoreNoteRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksRequest) ProtoMessage() {}
+func (*RestoreNoteRevisionRequest) ProtoMessage() {}
 
-func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[14]
+func (x *RestoreNoteRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,70 +5164,49 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *ListTasksRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use RestoreNoteRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreNoteRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ListTasksRequest) GetPageToken() string {
+func (x *RestoreNoteRevisionRequest) GetTaskId() string {
 	if x != nil {
-		return x.PageToken
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *ListTasksRequest) GetFilterTagIds() []string {
+func (x *RestoreNoteRevisionRequest) GetRevisionId() string {
 	if x != nil {
-		return x.FilterTagIds
-	}
-	return nil
-}
-
-func (x *ListTasksRequest) GetIncludeArchived() bool {
-	if x != nil && x.IncludeArchived != nil {
-		return *x.IncludeArchived
-	}
-	return false
-}
-
-func (x *ListTasksRequest) GetArchivedOnly() bool {
-	if x != nil && x.ArchivedOnly != nil {
-		return *x.ArchivedOnly
+		return x.RevisionId
 	}
-	return false
+	return ""
 }
 
-// ListTasksResponse is the response message for listing tasks
-type ListTasksResponse struct {
+// RestoreNoteRevisionResponse returns the task with its restored notes.
+// The replaced notes are kept as a new revision.
+type RestoreNoteRevisionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksResponse) Reset() {
-	*x = ListTasksResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[15]
+func (x *RestoreNoteRevisionResponse) Reset() {
+	*x = RestoreNoteRevisionResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksResponse) String() string {
+func (x *RestoreNoteRevisionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksResponse) ProtoMessage() {}
+func (*RestoreNoteRevisionResponse) ProtoMessage() {}
 
-func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[15]
+func (x *RestoreNoteRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,49 +5217,48 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RestoreNoteRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreNoteRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ListTasksResponse) GetTasks() []*Task {
+func (x *RestoreNoteRevisionResponse) GetTask() *Task {
 	if x != nil {
-		return x.Tasks
+		return x.Task
 	}
 	return nil
 }
 
-func (x *ListTasksResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-// AddChecklistItemRequest creates a new checklist item for a task
-type AddChecklistItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CreateTaskMutation creates a task under an ID generated by the client
+type CreateTaskMutation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // client-generated UUID
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Notes          string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	TagNames       []string               `protobuf:"bytes,4,rep,name=tag_names,json=tagNames,proto3" json:"tag_names,omitempty"`
+	StartDate      *string                `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // optional, format "YYYY-MM-DD"
+	ChecklistItems []string               `protobuf:"bytes,6,rep,name=checklist_items,json=checklistItems,proto3" json:"checklist_items,omitempty"`
+	Deadline       *string                `protobuf:"bytes,7,opt,name=deadline,proto3,oneof" json:"deadline,omitempty"` // optional, format "YYYY-MM-DD"
+	Context        string                 `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`         // optional GTD context
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *AddChecklistItemRequest) Reset() {
-	*x = AddChecklistItemRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[16]
+func (x *CreateTaskMutation) Reset() {
+	*x = CreateTaskMutation{}
+	mi := &file_task_v1_task_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddChecklistItemRequest) String() string {
+func (x *CreateTaskMutation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddChecklistItemRequest) ProtoMessage() {}
+func (*CreateTaskMutation) ProtoMessage() {}
 
-func (x *AddChecklistItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[16]
+func (x *CreateTaskMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,48 +5269,100 @@ func (x *AddChecklistItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddChecklistItemRequest.ProtoReflect.Descriptor instead.
-func (*AddChecklistItemRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CreateTaskMutation.ProtoReflect.Descriptor instead.
+func (*CreateTaskMutation) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *AddChecklistItemRequest) GetTaskId() string {
+func (x *CreateTaskMutation) GetId() string {
 	if x != nil {
-		return x.TaskId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AddChecklistItemRequest) GetContent() string {
+func (x *CreateTaskMutation) GetTitle() string {
 	if x != nil {
-		return x.Content
+		return x.Title
 	}
 	return ""
 }
 
-// AddChecklistItemResponse returns the created checklist item
-type AddChecklistItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *ChecklistItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateTaskMutation) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
 }
 
-func (x *AddChecklistItemResponse) Reset() {
-	*x = AddChecklistItemResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[17]
+func (x *CreateTaskMutation) GetTagNames() []string {
+	if x != nil {
+		return x.TagNames
+	}
+	return nil
+}
+
+func (x *CreateTaskMutation) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateTaskMutation) GetChecklistItems() []string {
+	if x != nil {
+		return x.ChecklistItems
+	}
+	return nil
+}
+
+func (x *CreateTaskMutation) GetDeadline() string {
+	if x != nil && x.Deadline != nil {
+		return *x.Deadline
+	}
+	return ""
+}
+
+func (x *CreateTaskMutation) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+// UpdateTaskMutation changes the fields of a task that are set
+type UpdateTaskMutation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// updated_at of the task version the client edited. The mutation conflicts
+	// when the task changed since; unset skips the check.
+	BaseUpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=base_updated_at,json=baseUpdatedAt,proto3" json:"base_updated_at,omitempty"`
+	Title           *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Notes           *string                `protobuf:"bytes,4,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	ReplaceTagNames bool                   `protobuf:"varint,5,opt,name=replace_tag_names,json=replaceTagNames,proto3" json:"replace_tag_names,omitempty"` // when set, tag_names replaces the task's tags
+	TagNames        []string               `protobuf:"bytes,6,rep,name=tag_names,json=tagNames,proto3" json:"tag_names,omitempty"`
+	StartDate       *string                `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // empty string moves the task to the inbox
+	Deadline        *string                `protobuf:"bytes,8,opt,name=deadline,proto3,oneof" json:"deadline,omitempty"`                    // empty string clears the deadline
+	Context         *string                `protobuf:"bytes,9,opt,name=context,proto3,oneof" json:"context,omitempty"`                      // empty string clears the context
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTaskMutation) Reset() {
+	*x = UpdateTaskMutation{}
+	mi := &file_task_v1_task_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddChecklistItemResponse) String() string {
+func (x *UpdateTaskMutation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddChecklistItemResponse) ProtoMessage() {}
+func (*UpdateTaskMutation) ProtoMessage() {}
 
-func (x *AddChecklistItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[17]
+func (x *UpdateTaskMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,94 +5373,100 @@ func (x *AddChecklistItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddChecklistItemResponse.ProtoReflect.Descriptor instead.
-func (*AddChecklistItemResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UpdateTaskMutation.ProtoReflect.Descriptor instead.
+func (*UpdateTaskMutation) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *AddChecklistItemResponse) GetItem() *ChecklistItem {
+func (x *UpdateTaskMutation) GetId() string {
 	if x != nil {
-		return x.Item
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// UpdateChecklistItemRequest updates checklist item content
-type UpdateChecklistItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateTaskMutation) GetBaseUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaseUpdatedAt
+	}
+	return nil
 }
 
-func (x *UpdateChecklistItemRequest) Reset() {
-	*x = UpdateChecklistItemRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateTaskMutation) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
 }
 
-func (x *UpdateChecklistItemRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateTaskMutation) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
 }
 
-func (*UpdateChecklistItemRequest) ProtoMessage() {}
+func (x *UpdateTaskMutation) GetReplaceTagNames() bool {
+	if x != nil {
+		return x.ReplaceTagNames
+	}
+	return false
+}
 
-func (x *UpdateChecklistItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[18]
+func (x *UpdateTaskMutation) GetTagNames() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TagNames
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UpdateChecklistItemRequest.ProtoReflect.Descriptor instead.
-func (*UpdateChecklistItemRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{18}
+func (x *UpdateTaskMutation) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
 }
 
-func (x *UpdateChecklistItemRequest) GetItemId() string {
-	if x != nil {
-		return x.ItemId
+func (x *UpdateTaskMutation) GetDeadline() string {
+	if x != nil && x.Deadline != nil {
+		return *x.Deadline
 	}
 	return ""
 }
 
-func (x *UpdateChecklistItemRequest) GetContent() string {
-	if x != nil {
-		return x.Content
+func (x *UpdateTaskMutation) GetContext() string {
+	if x != nil && x.Context != nil {
+		return *x.Context
 	}
 	return ""
 }
 
-// UpdateChecklistItemResponse returns the updated checklist item
-type UpdateChecklistItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *ChecklistItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+// DeleteTaskMutation deletes a task
+type DeleteTaskMutation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// updated_at of the task version the client deleted. The mutation
+	// conflicts when the task changed since; unset skips the check.
+	BaseUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=base_updated_at,json=baseUpdatedAt,proto3" json:"base_updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateChecklistItemResponse) Reset() {
-	*x = UpdateChecklistItemResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[19]
+func (x *DeleteTaskMutation) Reset() {
+	*x = DeleteTaskMutation{}
+	mi := &file_task_v1_task_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateChecklistItemResponse) String() string {
+func (x *DeleteTaskMutation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateChecklistItemResponse) ProtoMessage() {}
+func (*DeleteTaskMutation) ProtoMessage() {}
 
-func (x *UpdateChecklistItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[19]
+func (x *DeleteTaskMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1134,42 +5477,54 @@ func (x *UpdateChecklistItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateChecklistItemResponse.ProtoReflect.Descriptor instead.
-func (*UpdateChecklistItemResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DeleteTaskMutation.ProtoReflect.Descriptor instead.
+func (*DeleteTaskMutation) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *UpdateChecklistItemResponse) GetItem() *ChecklistItem {
+func (x *DeleteTaskMutation) GetId() string {
 	if x != nil {
-		return x.Item
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTaskMutation) GetBaseUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaseUpdatedAt
 	}
 	return nil
 }
 
-// SetChecklistItemCompletedRequest sets checklist item completion state
-type SetChecklistItemCompletedRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	Completed     bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+// TaskMutation is one operation recorded by an offline client
+type TaskMutation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ClientMutationId string                 `protobuf:"bytes,1,opt,name=client_mutation_id,json=clientMutationId,proto3" json:"client_mutation_id,omitempty"` // chosen by the client, echoed in the result
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*TaskMutation_Create
+	//	*TaskMutation_Update
+	//	*TaskMutation_Delete
+	Operation     isTaskMutation_Operation `protobuf_oneof:"operation"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetChecklistItemCompletedRequest) Reset() {
-	*x = SetChecklistItemCompletedRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[20]
+func (x *TaskMutation) Reset() {
+	*x = TaskMutation{}
+	mi := &file_task_v1_task_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetChecklistItemCompletedRequest) String() string {
+func (x *TaskMutation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetChecklistItemCompletedRequest) ProtoMessage() {}
+func (*TaskMutation) ProtoMessage() {}
 
-func (x *SetChecklistItemCompletedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[20]
+func (x *TaskMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,93 +5535,107 @@ func (x *SetChecklistItemCompletedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetChecklistItemCompletedRequest.ProtoReflect.Descriptor instead.
-func (*SetChecklistItemCompletedRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use TaskMutation.ProtoReflect.Descriptor instead.
+func (*TaskMutation) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *SetChecklistItemCompletedRequest) GetItemId() string {
+func (x *TaskMutation) GetClientMutationId() string {
 	if x != nil {
-		return x.ItemId
+		return x.ClientMutationId
 	}
 	return ""
 }
 
-func (x *SetChecklistItemCompletedRequest) GetCompleted() bool {
+func (x *TaskMutation) GetOperation() isTaskMutation_Operation {
 	if x != nil {
-		return x.Completed
+		return x.Operation
 	}
-	return false
-}
-
-// SetChecklistItemCompletedResponse returns the updated checklist item
-type SetChecklistItemCompletedResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *ChecklistItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *SetChecklistItemCompletedResponse) Reset() {
-	*x = SetChecklistItemCompletedResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TaskMutation) GetCreate() *CreateTaskMutation {
+	if x != nil {
+		if x, ok := x.Operation.(*TaskMutation_Create); ok {
+			return x.Create
+		}
+	}
+	return nil
 }
 
-func (x *SetChecklistItemCompletedResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TaskMutation) GetUpdate() *UpdateTaskMutation {
+	if x != nil {
+		if x, ok := x.Operation.(*TaskMutation_Update); ok {
+			return x.Update
+		}
+	}
+	return nil
 }
 
-func (*SetChecklistItemCompletedResponse) ProtoMessage() {}
-
-func (x *SetChecklistItemCompletedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[21]
+func (x *TaskMutation) GetDelete() *DeleteTaskMutation {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Operation.(*TaskMutation_Delete); ok {
+			return x.Delete
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SetChecklistItemCompletedResponse.ProtoReflect.Descriptor instead.
-func (*SetChecklistItemCompletedResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{21}
+type isTaskMutation_Operation interface {
+	isTaskMutation_Operation()
 }
 
-func (x *SetChecklistItemCompletedResponse) GetItem() *ChecklistItem {
-	if x != nil {
-		return x.Item
-	}
-	return nil
+type TaskMutation_Create struct {
+	Create *CreateTaskMutation `protobuf:"bytes,2,opt,name=create,proto3,oneof"`
 }
 
-// DeleteChecklistItemRequest deletes a checklist item
-type DeleteChecklistItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+type TaskMutation_Update struct {
+	Update *UpdateTaskMutation `protobuf:"bytes,3,opt,name=update,proto3,oneof"`
+}
+
+type TaskMutation_Delete struct {
+	Delete *DeleteTaskMutation `protobuf:"bytes,4,opt,name=delete,proto3,oneof"`
+}
+
+func (*TaskMutation_Create) isTaskMutation_Operation() {}
+
+func (*TaskMutation_Update) isTaskMutation_Operation() {}
+
+func (*TaskMutation_Delete) isTaskMutation_Operation() {}
+
+// TaskMutationResult is the outcome of one mutation
+type TaskMutationResult struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ClientMutationId string                 `protobuf:"bytes,1,opt,name=client_mutation_id,json=clientMutationId,proto3" json:"client_mutation_id,omitempty"`
+	TaskId           string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Applied          bool                   `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+	Conflict         MutationConflict       `protobuf:"varint,4,opt,name=conflict,proto3,enum=task.v1.MutationConflict" json:"conflict,omitempty"` // set when applied is false
+	// The task after an applied create or update, or the server's current
+	// version of a conflicting task. Unset after a delete and when the task
+	// does not exist.
+	Task *Task `protobuf:"bytes,5,opt,name=task,proto3" json:"task,omitempty"`
+	// approval_id is the approval a delete waits for, with
+	// MUTATION_CONFLICT_PENDING_APPROVAL
+	ApprovalId    string `protobuf:"bytes,6,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteChecklistItemRequest) Reset() {
-	*x = DeleteChecklistItemRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[22]
+func (x *TaskMutationResult) Reset() {
+	*x = TaskMutationResult{}
+	mi := &file_task_v1_task_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteChecklistItemRequest) String() string {
+func (x *TaskMutationResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteChecklistItemRequest) ProtoMessage() {}
+func (*TaskMutationResult) ProtoMessage() {}
 
-func (x *DeleteChecklistItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[22]
+func (x *TaskMutationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1277,80 +5646,79 @@ func (x *DeleteChecklistItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteChecklistItemRequest.ProtoReflect.Descriptor instead.
-func (*DeleteChecklistItemRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use TaskMutationResult.ProtoReflect.Descriptor instead.
+func (*TaskMutationResult) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *DeleteChecklistItemRequest) GetItemId() string {
+func (x *TaskMutationResult) GetClientMutationId() string {
 	if x != nil {
-		return x.ItemId
+		return x.ClientMutationId
 	}
 	return ""
 }
 
-// DeleteChecklistItemResponse indicates successful deletion
-type DeleteChecklistItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TaskMutationResult) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
 }
 
-func (x *DeleteChecklistItemResponse) Reset() {
-	*x = DeleteChecklistItemResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TaskMutationResult) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
 }
 
-func (x *DeleteChecklistItemResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TaskMutationResult) GetConflict() MutationConflict {
+	if x != nil {
+		return x.Conflict
+	}
+	return MutationConflict_MUTATION_CONFLICT_UNSPECIFIED
 }
 
-func (*DeleteChecklistItemResponse) ProtoMessage() {}
-
-func (x *DeleteChecklistItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[23]
+func (x *TaskMutationResult) GetTask() *Task {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Task
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteChecklistItemResponse.ProtoReflect.Descriptor instead.
-func (*DeleteChecklistItemResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{23}
+func (x *TaskMutationResult) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
 }
 
-// ReorderChecklistItemsRequest reorders all checklist items for a task.
-// item_ids must contain all checklist item IDs for the task in final order.
-type ReorderChecklistItemsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	ItemIds       []string               `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+// ApplyMutationsRequest is the request message for applying an offline batch
+type ApplyMutationsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Mutations []*TaskMutation        `protobuf:"bytes,1,rep,name=mutations,proto3" json:"mutations,omitempty"` // at most 100, applied in order
+	// validate_only checks the batch and returns the results applying it would
+	// have, without saving anything or creating tags
+	ValidateOnly  bool `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReorderChecklistItemsRequest) Reset() {
-	*x = ReorderChecklistItemsRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[24]
+func (x *ApplyMutationsRequest) Reset() {
+	*x = ApplyMutationsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReorderChecklistItemsRequest) String() string {
+func (x *ApplyMutationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReorderChecklistItemsRequest) ProtoMessage() {}
+func (*ApplyMutationsRequest) ProtoMessage() {}
 
-func (x *ReorderChecklistItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[24]
+func (x *ApplyMutationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1361,48 +5729,49 @@ func (x *ReorderChecklistItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReorderChecklistItemsRequest.ProtoReflect.Descriptor instead.
-func (*ReorderChecklistItemsRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ApplyMutationsRequest.ProtoReflect.Descriptor instead.
+func (*ApplyMutationsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *ReorderChecklistItemsRequest) GetTaskId() string {
+func (x *ApplyMutationsRequest) GetMutations() []*TaskMutation {
 	if x != nil {
-		return x.TaskId
+		return x.Mutations
 	}
-	return ""
+	return nil
 }
 
-func (x *ReorderChecklistItemsRequest) GetItemIds() []string {
+func (x *ApplyMutationsRequest) GetValidateOnly() bool {
 	if x != nil {
-		return x.ItemIds
+		return x.ValidateOnly
 	}
-	return nil
+	return false
 }
 
-// ReorderChecklistItemsResponse returns checklist items in updated order
-type ReorderChecklistItemsResponse struct {
+// ApplyMutationsResponse reports the outcome of every mutation
+type ApplyMutationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*ChecklistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Results       []*TaskMutationResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // one per mutation, in request order
+	ConflictCount int32                  `protobuf:"varint,2,opt,name=conflict_count,json=conflictCount,proto3" json:"conflict_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReorderChecklistItemsResponse) Reset() {
-	*x = ReorderChecklistItemsResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[25]
+func (x *ApplyMutationsResponse) Reset() {
+	*x = ApplyMutationsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReorderChecklistItemsResponse) String() string {
+func (x *ApplyMutationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReorderChecklistItemsResponse) ProtoMessage() {}
+func (*ApplyMutationsResponse) ProtoMessage() {}
 
-func (x *ReorderChecklistItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[25]
+func (x *ApplyMutationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,23 +5782,30 @@ func (x *ReorderChecklistItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReorderChecklistItemsResponse.ProtoReflect.Descriptor instead.
-func (*ReorderChecklistItemsResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ApplyMutationsResponse.ProtoReflect.Descriptor instead.
+func (*ApplyMutationsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *ReorderChecklistItemsResponse) GetItems() []*ChecklistItem {
+func (x *ApplyMutationsResponse) GetResults() []*TaskMutationResult {
 	if x != nil {
-		return x.Items
+		return x.Results
 	}
 	return nil
 }
 
+func (x *ApplyMutationsResponse) GetConflictCount() int32 {
+	if x != nil {
+		return x.ConflictCount
+	}
+	return 0
+}
+
 var File_task_v1_task_proto protoreflect.FileDescriptor
 
 const file_task_v1_task_proto_rawDesc = "" +
 	"\n" +
-	"\x12task/v1/task.proto\x12\atask.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x97\x03\n" +
+	"\x12task/v1/task.proto\x12\atask.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10tag/v1/tag.proto\"\x95\t\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
@@ -1444,9 +5820,41 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"start_date\x18\t \x01(\tH\x01R\tstartDate\x88\x01\x01\x12?\n" +
 	"\x0fchecklist_items\x18\n" +
-	" \x03(\v2\x16.task.v1.ChecklistItemR\x0echecklistItemsB\x0e\n" +
+	" \x03(\v2\x16.task.v1.ChecklistItemR\x0echecklistItems\x12\x1f\n" +
+	"\bdeadline\x18\v \x01(\tH\x02R\bdeadline\x88\x01\x01\x12*\n" +
+	"\x0edays_remaining\x18\f \x01(\x05H\x03R\rdaysRemaining\x88\x01\x01\x12\x16\n" +
+	"\x06pinned\x18\r \x01(\bR\x06pinned\x12B\n" +
+	"\fcompleted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x04R\vcompletedAt\x88\x01\x01\x122\n" +
+	"\x15checklist_total_count\x18\x0f \x01(\x05R\x13checklistTotalCount\x12:\n" +
+	"\x19checklist_completed_count\x18\x10 \x01(\x05R\x17checklistCompletedCount\x12*\n" +
+	"\x11client_request_id\x18\x11 \x01(\tR\x0fclientRequestId\x12?\n" +
+	"\x10last_modified_by\x18\x12 \x01(\v2\x15.task.v1.TaskModifierR\x0elastModifiedBy\x12\x18\n" +
+	"\acontext\x18\x13 \x01(\tR\acontext\x12E\n" +
+	"\x0elast_viewed_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x05R\flastViewedAt\x88\x01\x01\x12\x15\n" +
+	"\x06is_new\x18\x15 \x01(\bR\x05isNew\x120\n" +
+	"\x14updated_since_viewed\x18\x16 \x01(\bR\x12updatedSinceViewed\x124\n" +
+	"\n" +
+	"created_by\x18\x17 \x01(\v2\x15.task.v1.TaskModifierR\tcreatedBy\x12-\n" +
+	"\bgeofence\x18\x18 \x01(\v2\x11.task.v1.GeofenceR\bgeofence\x12#\n" +
+	"\rresource_name\x18\x19 \x01(\tR\fresourceNameB\x0e\n" +
 	"\f_archived_atB\r\n" +
-	"\v_start_date\"\x85\x02\n" +
+	"\v_start_dateB\v\n" +
+	"\t_deadlineB\x11\n" +
+	"\x0f_days_remainingB\x0f\n" +
+	"\r_completed_atB\x11\n" +
+	"\x0f_last_viewed_at\"\xa1\x01\n" +
+	"\bGeofence\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12#\n" +
+	"\rradius_meters\x18\x03 \x01(\x05R\fradiusMeters\x12\x1b\n" +
+	"\ton_arrive\x18\x04 \x01(\bR\bonArrive\x12\x19\n" +
+	"\bon_leave\x18\x05 \x01(\bR\aonLeave\"\x94\x01\n" +
+	"\fTaskModifier\x12-\n" +
+	"\x06source\x18\x01 \x01(\x0e2\x15.task.v1.ChangeSourceR\x06source\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x1d\n" +
+	"\n" +
+	"token_name\x18\x04 \x01(\tR\ttokenName\"\x85\x02\n" +
 	"\rChecklistItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x18\n" +
@@ -1457,34 +5865,54 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb8\x01\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xac\x02\n" +
 	"\x11CreateTaskRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
 	"\x05notes\x18\x02 \x01(\tR\x05notes\x12\x1b\n" +
 	"\ttag_names\x18\x03 \x03(\tR\btagNames\x12\"\n" +
 	"\n" +
 	"start_date\x18\x05 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12'\n" +
-	"\x0fchecklist_items\x18\x06 \x03(\tR\x0echecklistItemsB\r\n" +
-	"\v_start_date\"7\n" +
+	"\x0fchecklist_items\x18\x06 \x03(\tR\x0echecklistItems\x12\x1f\n" +
+	"\bdeadline\x18\a \x01(\tH\x01R\bdeadline\x88\x01\x01\x12*\n" +
+	"\x11client_request_id\x18\b \x01(\tR\x0fclientRequestId\x12\x18\n" +
+	"\acontext\x18\t \x01(\tR\acontextB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_deadline\"7\n" +
 	"\x12CreateTaskResponse\x12!\n" +
-	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\" \n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"Y\n" +
 	"\x0eGetTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"4\n" +
 	"\x0fGetTaskResponse\x12!\n" +
-	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"\x9f\x01\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"(\n" +
+	"\x14BatchGetTasksRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"]\n" +
+	"\x15BatchGetTasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\tR\n" +
+	"missingIds\"\xf8\x01\n" +
 	"\x11UpdateTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
 	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x1b\n" +
 	"\ttag_names\x18\x04 \x03(\tR\btagNames\x12\"\n" +
 	"\n" +
-	"start_date\x18\x06 \x01(\tH\x00R\tstartDate\x88\x01\x01B\r\n" +
-	"\v_start_date\"7\n" +
+	"start_date\x18\x06 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1f\n" +
+	"\bdeadline\x18\a \x01(\tH\x01R\bdeadline\x88\x01\x01\x12\x1d\n" +
+	"\acontext\x18\b \x01(\tH\x02R\acontext\x88\x01\x01B\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_deadlineB\n" +
+	"\n" +
+	"\b_context\"7\n" +
 	"\x12UpdateTaskResponse\x12!\n" +
-	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"#\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"H\n" +
 	"\x11DeleteTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
-	"\x12DeleteTaskResponse\"$\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rvalidate_only\x18\x02 \x01(\bR\fvalidateOnly\"X\n" +
+	"\x12DeleteTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\x12\x1f\n" +
+	"\vapproval_id\x18\x02 \x01(\tR\n" +
+	"approvalId\"$\n" +
 	"\x12ArchiveTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
 	"\x13ArchiveTaskResponse\x12!\n" +
@@ -1492,24 +5920,208 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\x14UnarchiveTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
 	"\x15UnarchiveTaskResponse\x12!\n" +
-	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"\xf5\x01\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"%\n" +
+	"\x13CompleteTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x14CompleteTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"#\n" +
+	"\x11ReopenTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x12ReopenTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"_\n" +
+	"\x1cArchiveCompletedTasksRequest\x12+\n" +
+	"\x0folder_than_days\x18\x01 \x01(\x05H\x00R\rolderThanDays\x88\x01\x01B\x12\n" +
+	"\x10_older_than_days\"F\n" +
+	"\x1dArchiveCompletedTasksResponse\x12%\n" +
+	"\x0earchived_count\x18\x01 \x01(\x03R\rarchivedCount\"1\n" +
+	"\x18ArchiveTasksByTagRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\"B\n" +
+	"\x19ArchiveTasksByTagResponse\x12%\n" +
+	"\x0earchived_count\x18\x01 \x01(\x03R\rarchivedCount\"3\n" +
+	"\x1aUnarchiveTasksByTagRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\"H\n" +
+	"\x1bUnarchiveTasksByTagResponse\x12)\n" +
+	"\x10unarchived_count\x18\x01 \x01(\x03R\x0funarchivedCount\"9\n" +
+	"\x12GetCountersRequest\x12\x19\n" +
+	"\x05today\x18\x01 \x01(\tH\x00R\x05today\x88\x01\x01B\b\n" +
+	"\x06_today\"\x9f\x01\n" +
+	"\x13GetCountersResponse\x12\x1f\n" +
+	"\vinbox_count\x18\x01 \x01(\x03R\n" +
+	"inboxCount\x12\x1f\n" +
+	"\vtoday_count\x18\x02 \x01(\x03R\n" +
+	"todayCount\x12#\n" +
+	"\roverdue_count\x18\x03 \x01(\x03R\foverdueCount\x12!\n" +
+	"\funread_count\x18\x04 \x01(\x03R\vunreadCount\"B\n" +
+	"\x1bRolloverOverdueTasksRequest\x12\x19\n" +
+	"\x05today\x18\x01 \x01(\tH\x00R\x05today\x88\x01\x01B\b\n" +
+	"\x06_today\"C\n" +
+	"\x1cRolloverOverdueTasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\"\x92\x01\n" +
+	"\fTaskSettings\x12:\n" +
+	"\x17auto_archive_after_days\x18\x01 \x01(\x05H\x00R\x14autoArchiveAfterDays\x88\x01\x01\x12*\n" +
+	"\x11rollover_to_inbox\x18\x02 \x01(\bR\x0frolloverToInboxB\x1a\n" +
+	"\x18_auto_archive_after_days\"\x18\n" +
+	"\x16GetTaskSettingsRequest\"L\n" +
+	"\x17GetTaskSettingsResponse\x121\n" +
+	"\bsettings\x18\x01 \x01(\v2\x15.task.v1.TaskSettingsR\bsettings\"\xbe\x01\n" +
+	"\x19UpdateTaskSettingsRequest\x125\n" +
+	"\x17auto_archive_after_days\x18\x01 \x01(\x05R\x14autoArchiveAfterDays\x12#\n" +
+	"\rvalidate_only\x18\x02 \x01(\bR\fvalidateOnly\x12/\n" +
+	"\x11rollover_to_inbox\x18\x03 \x01(\bH\x00R\x0frolloverToInbox\x88\x01\x01B\x14\n" +
+	"\x12_rollover_to_inbox\"\x8c\x01\n" +
+	"\x1aUpdateTaskSettingsResponse\x121\n" +
+	"\bsettings\x18\x01 \x01(\v2\x15.task.v1.TaskSettingsR\bsettings\x12;\n" +
+	"\x1aauto_archive_pending_count\x18\x02 \x01(\x03R\x17autoArchivePendingCount\"\xa8\x01\n" +
+	"\x0eActivityBucket\x12!\n" +
+	"\fbucket_start\x18\x01 \x01(\tR\vbucketStart\x12#\n" +
+	"\rcreated_count\x18\x02 \x01(\x05R\fcreatedCount\x12'\n" +
+	"\x0fcompleted_count\x18\x03 \x01(\x05R\x0ecompletedCount\x12%\n" +
+	"\x0earchived_count\x18\x04 \x01(\x05R\rarchivedCount\"\x84\x01\n" +
+	"\bTagStats\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\x12\x19\n" +
+	"\btag_name\x18\x02 \x01(\tR\atagName\x12\x1d\n" +
+	"\n" +
+	"open_count\x18\x03 \x01(\x05R\topenCount\x12'\n" +
+	"\x0fcompleted_count\x18\x04 \x01(\x05R\x0ecompletedCount\"W\n" +
+	"\x13GetTaskStatsRequest\x12,\n" +
+	"\x06bucket\x18\x01 \x01(\x0e2\x14.task.v1.StatsBucketR\x06bucket\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"\x9e\x01\n" +
+	"\x14GetTaskStatsResponse\x123\n" +
+	"\bactivity\x18\x01 \x03(\v2\x17.task.v1.ActivityBucketR\bactivity\x12.\n" +
+	"\ttag_stats\x18\x02 \x03(\v2\x11.task.v1.TagStatsR\btagStats\x12!\n" +
+	"\fbacklog_size\x18\x03 \x01(\x05R\vbacklogSize\"<\n" +
+	"\x1bGenerateWeeklyReviewRequest\x12\x1d\n" +
+	"\n" +
+	"stale_days\x18\x01 \x01(\x05R\tstaleDays\"\xb0\x02\n" +
+	"\x1cGenerateWeeklyReviewResponse\x129\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tweekStart\x12.\n" +
+	"\vstale_tasks\x18\x02 \x03(\v2\r.task.v1.TaskR\n" +
+	"staleTasks\x122\n" +
+	"\rundated_tasks\x18\x03 \x03(\v2\r.task.v1.TaskR\fundatedTasks\x12=\n" +
+	"\x13completed_this_week\x18\x04 \x03(\v2\r.task.v1.TaskR\x11completedThisWeek\x122\n" +
+	"\roverdue_tasks\x18\x05 \x03(\v2\r.task.v1.TaskR\foverdueTasks\"[\n" +
+	"\x15ListStaleTasksRequest\x12%\n" +
+	"\x0ethreshold_days\x18\x01 \x01(\x05R\rthresholdDays\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"=\n" +
+	"\x16ListStaleTasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\"W\n" +
+	"\x16SetTaskGeofenceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\bgeofence\x18\x02 \x01(\v2\x11.task.v1.GeofenceR\bgeofence\"<\n" +
+	"\x17SetTaskGeofenceResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"'\n" +
+	"\x15MarkTaskViewedRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16MarkTaskViewedResponse\"L\n" +
+	"\x14AddTagToTasksRequest\x12\x19\n" +
+	"\btag_name\x18\x01 \x01(\tR\atagName\x12\x19\n" +
+	"\btask_ids\x18\x02 \x03(\tR\ataskIds\"<\n" +
+	"\x15AddTagToTasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\"Q\n" +
+	"\x19RemoveTagFromTasksRequest\x12\x19\n" +
+	"\btag_name\x18\x01 \x01(\tR\atagName\x12\x19\n" +
+	"\btask_ids\x18\x02 \x03(\tR\ataskIds\"A\n" +
+	"\x1aRemoveTagFromTasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\"F\n" +
+	"\x14TransferTasksRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\tR\btoUserId\"^\n" +
+	"\x15TransferTasksResponse\x12\x19\n" +
+	"\btask_ids\x18\x01 \x03(\tR\ataskIds\x12*\n" +
+	"\x11created_tag_count\x18\x02 \x01(\x05R\x0fcreatedTagCount\"&\n" +
+	"\x14TogglePinTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
+	"\x15TogglePinTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"3\n" +
+	"\tTaskGroup\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x97\t\n" +
 	"\x10ListTasksRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\x12$\n" +
 	"\x0efilter_tag_ids\x18\x03 \x03(\tR\ffilterTagIds\x12.\n" +
 	"\x10include_archived\x18\x04 \x01(\bH\x00R\x0fincludeArchived\x88\x01\x01\x12(\n" +
-	"\rarchived_only\x18\x05 \x01(\bH\x01R\farchivedOnly\x88\x01\x01B\x13\n" +
+	"\rarchived_only\x18\x05 \x01(\bH\x01R\farchivedOnly\x88\x01\x01\x126\n" +
+	"\x14deadline_approaching\x18\x06 \x01(\bH\x02R\x13deadlineApproaching\x88\x01\x01\x12;\n" +
+	"\x0etag_match_mode\x18\a \x01(\x0e2\x15.task.v1.TagMatchModeR\ftagMatchMode\x12&\n" +
+	"\x0fexclude_tag_ids\x18\b \x03(\tR\rexcludeTagIds\x12(\n" +
+	"\runtagged_only\x18\t \x01(\bH\x03R\funtaggedOnly\x88\x01\x01\x12+\n" +
+	"\x0fstart_date_from\x18\n" +
+	" \x01(\tH\x04R\rstartDateFrom\x88\x01\x01\x12'\n" +
+	"\rstart_date_to\x18\v \x01(\tH\x05R\vstartDateTo\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"inbox_only\x18\f \x01(\bH\x06R\tinboxOnly\x88\x01\x01\x12/\n" +
+	"\bgroup_by\x18\r \x01(\x0e2\x14.task.v1.TaskGroupByR\agroupBy\x12D\n" +
+	"\rupdated_after\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\aR\fupdatedAfter\x88\x01\x01\x12\x1a\n" +
+	"\bcontexts\x18\x0f \x03(\tR\bcontexts\x12F\n" +
+	"\x0earchived_after\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampH\bR\rarchivedAfter\x88\x01\x01\x12H\n" +
+	"\x0farchived_before\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampH\tR\x0earchivedBefore\x88\x01\x01\x12/\n" +
+	"\border_by\x18\x12 \x01(\x0e2\x14.task.v1.TaskOrderByR\aorderBy\x127\n" +
+	"\tread_mask\x18\x13 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x127\n" +
+	"\x18deleted_tasks_page_token\x18\x14 \x01(\tR\x15deletedTasksPageTokenB\x13\n" +
 	"\x11_include_archivedB\x10\n" +
-	"\x0e_archived_only\"`\n" +
+	"\x0e_archived_onlyB\x17\n" +
+	"\x15_deadline_approachingB\x10\n" +
+	"\x0e_untagged_onlyB\x12\n" +
+	"\x10_start_date_fromB\x10\n" +
+	"\x0e_start_date_toB\r\n" +
+	"\v_inbox_onlyB\x10\n" +
+	"\x0e_updated_afterB\x11\n" +
+	"\x0f_archived_afterB\x12\n" +
+	"\x10_archived_before\"X\n" +
+	"\vDeletedTask\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\xa8\x02\n" +
 	"\x11ListTasksResponse\x12#\n" +
 	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"L\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\x12*\n" +
+	"\x06groups\x18\x04 \x03(\v2\x12.task.v1.TaskGroupR\x06groups\x129\n" +
+	"\rdeleted_tasks\x18\x05 \x03(\v2\x14.task.v1.DeletedTaskR\fdeletedTasks\x12@\n" +
+	"\x1dnext_deleted_tasks_page_token\x18\x06 \x01(\tR\x19nextDeletedTasksPageToken\"\xb4\x01\n" +
+	"\x12StreamTasksRequest\x12.\n" +
+	"\x10include_archived\x18\x01 \x01(\bH\x00R\x0fincludeArchived\x88\x01\x01\x12(\n" +
+	"\rarchived_only\x18\x02 \x01(\bH\x01R\farchivedOnly\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x03 \x01(\x05R\tchunkSizeB\x13\n" +
+	"\x11_include_archivedB\x10\n" +
+	"\x0e_archived_only\":\n" +
+	"\x13StreamTasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\"\x15\n" +
+	"\x13WatchChangesRequest\"\xc5\x02\n" +
+	"\x14WatchChangesResponse\x123\n" +
+	"\bresource\x18\x01 \x01(\x0e2\x17.task.v1.ChangeResourceR\bresource\x126\n" +
+	"\toperation\x18\x02 \x01(\x0e2\x18.task.v1.ChangeOperationR\toperation\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x04 \x01(\tR\x06taskId\x12#\n" +
+	"\x04task\x18\x05 \x01(\v2\r.task.v1.TaskH\x00R\x04task\x12\x1f\n" +
+	"\x03tag\x18\x06 \x01(\v2\v.tag.v1.TagH\x00R\x03tag\x12?\n" +
+	"\x0echecklist_item\x18\a \x01(\v2\x16.task.v1.ChecklistItemH\x00R\rchecklistItemB\x10\n" +
+	"\x0eresource_value\"\xa4\x01\n" +
+	"\x18ListTasksByFilterRequest\x12\x1b\n" +
+	"\tfilter_id\x18\x01 \x01(\tR\bfilterId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12/\n" +
+	"\bgroup_by\x18\x04 \x01(\x0e2\x14.task.v1.TaskGroupByR\agroupBy\"\xb3\x01\n" +
+	"\x19ListTasksByFilterResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\x12*\n" +
+	"\x06groups\x18\x04 \x03(\v2\x12.task.v1.TaskGroupR\x06groups\"L\n" +
 	"\x17AddChecklistItemRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"F\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\xaa\x01\n" +
 	"\x18AddChecklistItemResponse\x12*\n" +
-	"\x04item\x18\x01 \x01(\v2\x16.task.v1.ChecklistItemR\x04item\"O\n" +
+	"\x04item\x18\x01 \x01(\v2\x16.task.v1.ChecklistItemR\x04item\x122\n" +
+	"\x15checklist_total_count\x18\x02 \x01(\x05R\x13checklistTotalCount\x12.\n" +
+	"\x13max_checklist_items\x18\x03 \x01(\x05R\x11maxChecklistItems\"O\n" +
 	"\x1aUpdateChecklistItemRequest\x12\x17\n" +
 	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\"I\n" +
@@ -1527,23 +6139,155 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x19\n" +
 	"\bitem_ids\x18\x02 \x03(\tR\aitemIds\"M\n" +
 	"\x1dReorderChecklistItemsResponse\x12,\n" +
-	"\x05items\x18\x01 \x03(\v2\x16.task.v1.ChecklistItemR\x05items2\xf7\a\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.task.v1.ChecklistItemR\x05items\"\x88\x01\n" +
+	"\fNoteRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"\x18ListNoteRevisionsRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"P\n" +
+	"\x19ListNoteRevisionsResponse\x123\n" +
+	"\trevisions\x18\x01 \x03(\v2\x15.task.v1.NoteRevisionR\trevisions\"V\n" +
+	"\x1aRestoreNoteRevisionRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\tR\n" +
+	"revisionId\"@\n" +
+	"\x1bRestoreNoteRevisionResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"\x91\x02\n" +
+	"\x12CreateTaskMutation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x1b\n" +
+	"\ttag_names\x18\x04 \x03(\tR\btagNames\x12\"\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12'\n" +
+	"\x0fchecklist_items\x18\x06 \x03(\tR\x0echecklistItems\x12\x1f\n" +
+	"\bdeadline\x18\a \x01(\tH\x01R\bdeadline\x88\x01\x01\x12\x18\n" +
+	"\acontext\x18\b \x01(\tR\acontextB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_deadline\"\x87\x03\n" +
+	"\x12UpdateTaskMutation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12B\n" +
+	"\x0fbase_updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rbaseUpdatedAt\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\x04 \x01(\tH\x01R\x05notes\x88\x01\x01\x12*\n" +
+	"\x11replace_tag_names\x18\x05 \x01(\bR\x0freplaceTagNames\x12\x1b\n" +
+	"\ttag_names\x18\x06 \x03(\tR\btagNames\x12\"\n" +
+	"\n" +
+	"start_date\x18\a \x01(\tH\x02R\tstartDate\x88\x01\x01\x12\x1f\n" +
+	"\bdeadline\x18\b \x01(\tH\x03R\bdeadline\x88\x01\x01\x12\x1d\n" +
+	"\acontext\x18\t \x01(\tH\x04R\acontext\x88\x01\x01B\b\n" +
+	"\x06_titleB\b\n" +
+	"\x06_notesB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_deadlineB\n" +
+	"\n" +
+	"\b_context\"h\n" +
+	"\x12DeleteTaskMutation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12B\n" +
+	"\x0fbase_updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rbaseUpdatedAt\"\xee\x01\n" +
+	"\fTaskMutation\x12,\n" +
+	"\x12client_mutation_id\x18\x01 \x01(\tR\x10clientMutationId\x125\n" +
+	"\x06create\x18\x02 \x01(\v2\x1b.task.v1.CreateTaskMutationH\x00R\x06create\x125\n" +
+	"\x06update\x18\x03 \x01(\v2\x1b.task.v1.UpdateTaskMutationH\x00R\x06update\x125\n" +
+	"\x06delete\x18\x04 \x01(\v2\x1b.task.v1.DeleteTaskMutationH\x00R\x06deleteB\v\n" +
+	"\toperation\"\xf0\x01\n" +
+	"\x12TaskMutationResult\x12,\n" +
+	"\x12client_mutation_id\x18\x01 \x01(\tR\x10clientMutationId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x18\n" +
+	"\aapplied\x18\x03 \x01(\bR\aapplied\x125\n" +
+	"\bconflict\x18\x04 \x01(\x0e2\x19.task.v1.MutationConflictR\bconflict\x12!\n" +
+	"\x04task\x18\x05 \x01(\v2\r.task.v1.TaskR\x04task\x12\x1f\n" +
+	"\vapproval_id\x18\x06 \x01(\tR\n" +
+	"approvalId\"q\n" +
+	"\x15ApplyMutationsRequest\x123\n" +
+	"\tmutations\x18\x01 \x03(\v2\x15.task.v1.TaskMutationR\tmutations\x12#\n" +
+	"\rvalidate_only\x18\x02 \x01(\bR\fvalidateOnly\"v\n" +
+	"\x16ApplyMutationsResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.task.v1.TaskMutationResultR\aresults\x12%\n" +
+	"\x0econflict_count\x18\x02 \x01(\x05R\rconflictCount*x\n" +
+	"\fChangeSource\x12\x1d\n" +
+	"\x19CHANGE_SOURCE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CHANGE_SOURCE_USER\x10\x01\x12\x17\n" +
+	"\x13CHANGE_SOURCE_AGENT\x10\x02\x12\x18\n" +
+	"\x14CHANGE_SOURCE_SYSTEM\x10\x03*X\n" +
+	"\vStatsBucket\x12\x1c\n" +
+	"\x18STATS_BUCKET_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10STATS_BUCKET_DAY\x10\x01\x12\x15\n" +
+	"\x11STATS_BUCKET_WEEK\x10\x02*^\n" +
+	"\fTagMatchMode\x12\x1e\n" +
+	"\x1aTAG_MATCH_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TAG_MATCH_MODE_ANY\x10\x01\x12\x16\n" +
+	"\x12TAG_MATCH_MODE_ALL\x10\x02*}\n" +
+	"\vTaskGroupBy\x12\x1d\n" +
+	"\x19TASK_GROUP_BY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18TASK_GROUP_BY_START_DATE\x10\x01\x12\x1a\n" +
+	"\x16TASK_GROUP_BY_DEADLINE\x10\x02\x12\x15\n" +
+	"\x11TASK_GROUP_BY_TAG\x10\x03*s\n" +
+	"\vTaskOrderBy\x12\x1d\n" +
+	"\x19TASK_ORDER_BY_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eTASK_ORDER_BY_ARCHIVED_AT_DESC\x10\x01\x12!\n" +
+	"\x1dTASK_ORDER_BY_ARCHIVED_AT_ASC\x10\x02*\x88\x01\n" +
+	"\x0eChangeResource\x12\x1f\n" +
+	"\x1bCHANGE_RESOURCE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CHANGE_RESOURCE_TASK\x10\x01\x12\x17\n" +
+	"\x13CHANGE_RESOURCE_TAG\x10\x02\x12\"\n" +
+	"\x1eCHANGE_RESOURCE_CHECKLIST_ITEM\x10\x03*\x8a\x01\n" +
+	"\x0fChangeOperation\x12 \n" +
+	"\x1cCHANGE_OPERATION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17CHANGE_OPERATION_UPSERT\x10\x01\x12\x1b\n" +
+	"\x17CHANGE_OPERATION_DELETE\x10\x02\x12\x1b\n" +
+	"\x17CHANGE_OPERATION_RESYNC\x10\x03*\xc3\x01\n" +
+	"\x10MutationConflict\x12!\n" +
+	"\x1dMUTATION_CONFLICT_UNSPECIFIED\x10\x00\x12$\n" +
+	" MUTATION_CONFLICT_ALREADY_EXISTS\x10\x01\x12\x1f\n" +
+	"\x1bMUTATION_CONFLICT_NOT_FOUND\x10\x02\x12\x1d\n" +
+	"\x19MUTATION_CONFLICT_CHANGED\x10\x03\x12&\n" +
+	"\"MUTATION_CONFLICT_PENDING_APPROVAL\x10\x042\xea\x18\n" +
 	"\vTaskService\x12E\n" +
 	"\n" +
 	"CreateTask\x12\x1a.task.v1.CreateTaskRequest\x1a\x1b.task.v1.CreateTaskResponse\x12<\n" +
-	"\aGetTask\x12\x17.task.v1.GetTaskRequest\x1a\x18.task.v1.GetTaskResponse\x12E\n" +
+	"\aGetTask\x12\x17.task.v1.GetTaskRequest\x1a\x18.task.v1.GetTaskResponse\x12N\n" +
+	"\rBatchGetTasks\x12\x1d.task.v1.BatchGetTasksRequest\x1a\x1e.task.v1.BatchGetTasksResponse\x12E\n" +
 	"\n" +
 	"UpdateTask\x12\x1a.task.v1.UpdateTaskRequest\x1a\x1b.task.v1.UpdateTaskResponse\x12E\n" +
 	"\n" +
 	"DeleteTask\x12\x1a.task.v1.DeleteTaskRequest\x1a\x1b.task.v1.DeleteTaskResponse\x12B\n" +
-	"\tListTasks\x12\x19.task.v1.ListTasksRequest\x1a\x1a.task.v1.ListTasksResponse\x12H\n" +
+	"\tListTasks\x12\x19.task.v1.ListTasksRequest\x1a\x1a.task.v1.ListTasksResponse\x12J\n" +
+	"\vStreamTasks\x12\x1b.task.v1.StreamTasksRequest\x1a\x1c.task.v1.StreamTasksResponse0\x01\x12M\n" +
+	"\fWatchChanges\x12\x1c.task.v1.WatchChangesRequest\x1a\x1d.task.v1.WatchChangesResponse0\x01\x12Z\n" +
+	"\x11ListTasksByFilter\x12!.task.v1.ListTasksByFilterRequest\x1a\".task.v1.ListTasksByFilterResponse\x12H\n" +
 	"\vArchiveTask\x12\x1b.task.v1.ArchiveTaskRequest\x1a\x1c.task.v1.ArchiveTaskResponse\x12N\n" +
-	"\rUnarchiveTask\x12\x1d.task.v1.UnarchiveTaskRequest\x1a\x1e.task.v1.UnarchiveTaskResponse\x12W\n" +
+	"\rUnarchiveTask\x12\x1d.task.v1.UnarchiveTaskRequest\x1a\x1e.task.v1.UnarchiveTaskResponse\x12N\n" +
+	"\rTogglePinTask\x12\x1d.task.v1.TogglePinTaskRequest\x1a\x1e.task.v1.TogglePinTaskResponse\x12K\n" +
+	"\fCompleteTask\x12\x1c.task.v1.CompleteTaskRequest\x1a\x1d.task.v1.CompleteTaskResponse\x12E\n" +
+	"\n" +
+	"ReopenTask\x12\x1a.task.v1.ReopenTaskRequest\x1a\x1b.task.v1.ReopenTaskResponse\x12f\n" +
+	"\x15ArchiveCompletedTasks\x12%.task.v1.ArchiveCompletedTasksRequest\x1a&.task.v1.ArchiveCompletedTasksResponse\x12Z\n" +
+	"\x11ArchiveTasksByTag\x12!.task.v1.ArchiveTasksByTagRequest\x1a\".task.v1.ArchiveTasksByTagResponse\x12`\n" +
+	"\x13UnarchiveTasksByTag\x12#.task.v1.UnarchiveTasksByTagRequest\x1a$.task.v1.UnarchiveTasksByTagResponse\x12c\n" +
+	"\x14RolloverOverdueTasks\x12$.task.v1.RolloverOverdueTasksRequest\x1a%.task.v1.RolloverOverdueTasksResponse\x12T\n" +
+	"\x0fGetTaskSettings\x12\x1f.task.v1.GetTaskSettingsRequest\x1a .task.v1.GetTaskSettingsResponse\x12]\n" +
+	"\x12UpdateTaskSettings\x12\".task.v1.UpdateTaskSettingsRequest\x1a#.task.v1.UpdateTaskSettingsResponse\x12H\n" +
+	"\vGetCounters\x12\x1b.task.v1.GetCountersRequest\x1a\x1c.task.v1.GetCountersResponse\x12K\n" +
+	"\fGetTaskStats\x12\x1c.task.v1.GetTaskStatsRequest\x1a\x1d.task.v1.GetTaskStatsResponse\x12c\n" +
+	"\x14GenerateWeeklyReview\x12$.task.v1.GenerateWeeklyReviewRequest\x1a%.task.v1.GenerateWeeklyReviewResponse\x12Q\n" +
+	"\x0eListStaleTasks\x12\x1e.task.v1.ListStaleTasksRequest\x1a\x1f.task.v1.ListStaleTasksResponse\x12T\n" +
+	"\x0fSetTaskGeofence\x12\x1f.task.v1.SetTaskGeofenceRequest\x1a .task.v1.SetTaskGeofenceResponse\x12Q\n" +
+	"\x0eMarkTaskViewed\x12\x1e.task.v1.MarkTaskViewedRequest\x1a\x1f.task.v1.MarkTaskViewedResponse\x12N\n" +
+	"\rAddTagToTasks\x12\x1d.task.v1.AddTagToTasksRequest\x1a\x1e.task.v1.AddTagToTasksResponse\x12]\n" +
+	"\x12RemoveTagFromTasks\x12\".task.v1.RemoveTagFromTasksRequest\x1a#.task.v1.RemoveTagFromTasksResponse\x12W\n" +
 	"\x10AddChecklistItem\x12 .task.v1.AddChecklistItemRequest\x1a!.task.v1.AddChecklistItemResponse\x12`\n" +
 	"\x13UpdateChecklistItem\x12#.task.v1.UpdateChecklistItemRequest\x1a$.task.v1.UpdateChecklistItemResponse\x12r\n" +
 	"\x19SetChecklistItemCompleted\x12).task.v1.SetChecklistItemCompletedRequest\x1a*.task.v1.SetChecklistItemCompletedResponse\x12`\n" +
 	"\x13DeleteChecklistItem\x12#.task.v1.DeleteChecklistItemRequest\x1a$.task.v1.DeleteChecklistItemResponse\x12f\n" +
-	"\x15ReorderChecklistItems\x12%.task.v1.ReorderChecklistItemsRequest\x1a&.task.v1.ReorderChecklistItemsResponseB\x8b\x01\n" +
+	"\x15ReorderChecklistItems\x12%.task.v1.ReorderChecklistItemsRequest\x1a&.task.v1.ReorderChecklistItemsResponse\x12Z\n" +
+	"\x11ListNoteRevisions\x12!.task.v1.ListNoteRevisionsRequest\x1a\".task.v1.ListNoteRevisionsResponse\x12`\n" +
+	"\x13RestoreNoteRevision\x12#.task.v1.RestoreNoteRevisionRequest\x1a$.task.v1.RestoreNoteRevisionResponse\x12Q\n" +
+	"\x0eApplyMutations\x12\x1e.task.v1.ApplyMutationsRequest\x1a\x1f.task.v1.ApplyMutationsResponse\x12N\n" +
+	"\rTransferTasks\x12\x1d.task.v1.TransferTasksRequest\x1a\x1e.task.v1.TransferTasksResponseB\x8b\x01\n" +
 	"\vcom.task.v1B\tTaskProtoP\x01Z4github.com/slips-ai/slips-core/gen/go/task/v1;taskv1\xa2\x02\x03TXX\xaa\x02\aTask.V1\xca\x02\aTask\\V1\xe2\x02\x13Task\\V1\\GPBMetadata\xea\x02\bTask::V1b\x06proto3"
 
 var (
@@ -1558,82 +6302,265 @@ func file_task_v1_task_proto_rawDescGZIP() []byte {
 	return file_task_v1_task_proto_rawDescData
 }
 
-var file_task_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_task_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_task_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_task_v1_task_proto_goTypes = []any{
-	(*Task)(nil),                              // 0: task.v1.Task
-	(*ChecklistItem)(nil),                     // 1: task.v1.ChecklistItem
-	(*CreateTaskRequest)(nil),                 // 2: task.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),                // 3: task.v1.CreateTaskResponse
-	(*GetTaskRequest)(nil),                    // 4: task.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),                   // 5: task.v1.GetTaskResponse
-	(*UpdateTaskRequest)(nil),                 // 6: task.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),                // 7: task.v1.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),                 // 8: task.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),                // 9: task.v1.DeleteTaskResponse
-	(*ArchiveTaskRequest)(nil),                // 10: task.v1.ArchiveTaskRequest
-	(*ArchiveTaskResponse)(nil),               // 11: task.v1.ArchiveTaskResponse
-	(*UnarchiveTaskRequest)(nil),              // 12: task.v1.UnarchiveTaskRequest
-	(*UnarchiveTaskResponse)(nil),             // 13: task.v1.UnarchiveTaskResponse
-	(*ListTasksRequest)(nil),                  // 14: task.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),                 // 15: task.v1.ListTasksResponse
-	(*AddChecklistItemRequest)(nil),           // 16: task.v1.AddChecklistItemRequest
-	(*AddChecklistItemResponse)(nil),          // 17: task.v1.AddChecklistItemResponse
-	(*UpdateChecklistItemRequest)(nil),        // 18: task.v1.UpdateChecklistItemRequest
-	(*UpdateChecklistItemResponse)(nil),       // 19: task.v1.UpdateChecklistItemResponse
-	(*SetChecklistItemCompletedRequest)(nil),  // 20: task.v1.SetChecklistItemCompletedRequest
-	(*SetChecklistItemCompletedResponse)(nil), // 21: task.v1.SetChecklistItemCompletedResponse
-	(*DeleteChecklistItemRequest)(nil),        // 22: task.v1.DeleteChecklistItemRequest
-	(*DeleteChecklistItemResponse)(nil),       // 23: task.v1.DeleteChecklistItemResponse
-	(*ReorderChecklistItemsRequest)(nil),      // 24: task.v1.ReorderChecklistItemsRequest
-	(*ReorderChecklistItemsResponse)(nil),     // 25: task.v1.ReorderChecklistItemsResponse
-	(*timestamppb.Timestamp)(nil),             // 26: google.protobuf.Timestamp
+	(ChangeSource)(0),                         // 0: task.v1.ChangeSource
+	(StatsBucket)(0),                          // 1: task.v1.StatsBucket
+	(TagMatchMode)(0),                         // 2: task.v1.TagMatchMode
+	(TaskGroupBy)(0),                          // 3: task.v1.TaskGroupBy
+	(TaskOrderBy)(0),                          // 4: task.v1.TaskOrderBy
+	(ChangeResource)(0),                       // 5: task.v1.ChangeResource
+	(ChangeOperation)(0),                      // 6: task.v1.ChangeOperation
+	(MutationConflict)(0),                     // 7: task.v1.MutationConflict
+	(*Task)(nil),                              // 8: task.v1.Task
+	(*Geofence)(nil),                          // 9: task.v1.Geofence
+	(*TaskModifier)(nil),                      // 10: task.v1.TaskModifier
+	(*ChecklistItem)(nil),                     // 11: task.v1.ChecklistItem
+	(*CreateTaskRequest)(nil),                 // 12: task.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),                // 13: task.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),                    // 14: task.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),                   // 15: task.v1.GetTaskResponse
+	(*BatchGetTasksRequest)(nil),              // 16: task.v1.BatchGetTasksRequest
+	(*BatchGetTasksResponse)(nil),             // 17: task.v1.BatchGetTasksResponse
+	(*UpdateTaskRequest)(nil),                 // 18: task.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),                // 19: task.v1.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),                 // 20: task.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),                // 21: task.v1.DeleteTaskResponse
+	(*ArchiveTaskRequest)(nil),                // 22: task.v1.ArchiveTaskRequest
+	(*ArchiveTaskResponse)(nil),               // 23: task.v1.ArchiveTaskResponse
+	(*UnarchiveTaskRequest)(nil),              // 24: task.v1.UnarchiveTaskRequest
+	(*UnarchiveTaskResponse)(nil),             // 25: task.v1.UnarchiveTaskResponse
+	(*CompleteTaskRequest)(nil),               // 26: task.v1.CompleteTaskRequest
+	(*CompleteTaskResponse)(nil),              // 27: task.v1.CompleteTaskResponse
+	(*ReopenTaskRequest)(nil),                 // 28: task.v1.ReopenTaskRequest
+	(*ReopenTaskResponse)(nil),                // 29: task.v1.ReopenTaskResponse
+	(*ArchiveCompletedTasksRequest)(nil),      // 30: task.v1.ArchiveCompletedTasksRequest
+	(*ArchiveCompletedTasksResponse)(nil),     // 31: task.v1.ArchiveCompletedTasksResponse
+	(*ArchiveTasksByTagRequest)(nil),          // 32: task.v1.ArchiveTasksByTagRequest
+	(*ArchiveTasksByTagResponse)(nil),         // 33: task.v1.ArchiveTasksByTagResponse
+	(*UnarchiveTasksByTagRequest)(nil),        // 34: task.v1.UnarchiveTasksByTagRequest
+	(*UnarchiveTasksByTagResponse)(nil),       // 35: task.v1.UnarchiveTasksByTagResponse
+	(*GetCountersRequest)(nil),                // 36: task.v1.GetCountersRequest
+	(*GetCountersResponse)(nil),               // 37: task.v1.GetCountersResponse
+	(*RolloverOverdueTasksRequest)(nil),       // 38: task.v1.RolloverOverdueTasksRequest
+	(*RolloverOverdueTasksResponse)(nil),      // 39: task.v1.RolloverOverdueTasksResponse
+	(*TaskSettings)(nil),                      // 40: task.v1.TaskSettings
+	(*GetTaskSettingsRequest)(nil),            // 41: task.v1.GetTaskSettingsRequest
+	(*GetTaskSettingsResponse)(nil),           // 42: task.v1.GetTaskSettingsResponse
+	(*UpdateTaskSettingsRequest)(nil),         // 43: task.v1.UpdateTaskSettingsRequest
+	(*UpdateTaskSettingsResponse)(nil),        // 44: task.v1.UpdateTaskSettingsResponse
+	(*ActivityBucket)(nil),                    // 45: task.v1.ActivityBucket
+	(*TagStats)(nil),                          // 46: task.v1.TagStats
+	(*GetTaskStatsRequest)(nil),               // 47: task.v1.GetTaskStatsRequest
+	(*GetTaskStatsResponse)(nil),              // 48: task.v1.GetTaskStatsResponse
+	(*GenerateWeeklyReviewRequest)(nil),       // 49: task.v1.GenerateWeeklyReviewRequest
+	(*GenerateWeeklyReviewResponse)(nil),      // 50: task.v1.GenerateWeeklyReviewResponse
+	(*ListStaleTasksRequest)(nil),             // 51: task.v1.ListStaleTasksRequest
+	(*ListStaleTasksResponse)(nil),            // 52: task.v1.ListStaleTasksResponse
+	(*SetTaskGeofenceRequest)(nil),            // 53: task.v1.SetTaskGeofenceRequest
+	(*SetTaskGeofenceResponse)(nil),           // 54: task.v1.SetTaskGeofenceResponse
+	(*MarkTaskViewedRequest)(nil),             // 55: task.v1.MarkTaskViewedRequest
+	(*MarkTaskViewedResponse)(nil),            // 56: task.v1.MarkTaskViewedResponse
+	(*AddTagToTasksRequest)(nil),              // 57: task.v1.AddTagToTasksRequest
+	(*AddTagToTasksResponse)(nil),             // 58: task.v1.AddTagToTasksResponse
+	(*RemoveTagFromTasksRequest)(nil),         // 59: task.v1.RemoveTagFromTasksRequest
+	(*RemoveTagFromTasksResponse)(nil),        // 60: task.v1.RemoveTagFromTasksResponse
+	(*TransferTasksRequest)(nil),              // 61: task.v1.TransferTasksRequest
+	(*TransferTasksResponse)(nil),             // 62: task.v1.TransferTasksResponse
+	(*TogglePinTaskRequest)(nil),              // 63: task.v1.TogglePinTaskRequest
+	(*TogglePinTaskResponse)(nil),             // 64: task.v1.TogglePinTaskResponse
+	(*TaskGroup)(nil),                         // 65: task.v1.TaskGroup
+	(*ListTasksRequest)(nil),                  // 66: task.v1.ListTasksRequest
+	(*DeletedTask)(nil),                       // 67: task.v1.DeletedTask
+	(*ListTasksResponse)(nil),                 // 68: task.v1.ListTasksResponse
+	(*StreamTasksRequest)(nil),                // 69: task.v1.StreamTasksRequest
+	(*StreamTasksResponse)(nil),               // 70: task.v1.StreamTasksResponse
+	(*WatchChangesRequest)(nil),               // 71: task.v1.WatchChangesRequest
+	(*WatchChangesResponse)(nil),              // 72: task.v1.WatchChangesResponse
+	(*ListTasksByFilterRequest)(nil),          // 73: task.v1.ListTasksByFilterRequest
+	(*ListTasksByFilterResponse)(nil),         // 74: task.v1.ListTasksByFilterResponse
+	(*AddChecklistItemRequest)(nil),           // 75: task.v1.AddChecklistItemRequest
+	(*AddChecklistItemResponse)(nil),          // 76: task.v1.AddChecklistItemResponse
+	(*UpdateChecklistItemRequest)(nil),        // 77: task.v1.UpdateChecklistItemRequest
+	(*UpdateChecklistItemResponse)(nil),       // 78: task.v1.UpdateChecklistItemResponse
+	(*SetChecklistItemCompletedRequest)(nil),  // 79: task.v1.SetChecklistItemCompletedRequest
+	(*SetChecklistItemCompletedResponse)(nil), // 80: task.v1.SetChecklistItemCompletedResponse
+	(*DeleteChecklistItemRequest)(nil),        // 81: task.v1.DeleteChecklistItemRequest
+	(*DeleteChecklistItemResponse)(nil),       // 82: task.v1.DeleteChecklistItemResponse
+	(*ReorderChecklistItemsRequest)(nil),      // 83: task.v1.ReorderChecklistItemsRequest
+	(*ReorderChecklistItemsResponse)(nil),     // 84: task.v1.ReorderChecklistItemsResponse
+	(*NoteRevision)(nil),                      // 85: task.v1.NoteRevision
+	(*ListNoteRevisionsRequest)(nil),          // 86: task.v1.ListNoteRevisionsRequest
+	(*ListNoteRevisionsResponse)(nil),         // 87: task.v1.ListNoteRevisionsResponse
+	(*RestoreNoteRevisionRequest)(nil),        // 88: task.v1.RestoreNoteRevisionRequest
+	(*RestoreNoteRevisionResponse)(nil),       // 89: task.v1.RestoreNoteRevisionResponse
+	(*CreateTaskMutation)(nil),                // 90: task.v1.CreateTaskMutation
+	(*UpdateTaskMutation)(nil),                // 91: task.v1.UpdateTaskMutation
+	(*DeleteTaskMutation)(nil),                // 92: task.v1.DeleteTaskMutation
+	(*TaskMutation)(nil),                      // 93: task.v1.TaskMutation
+	(*TaskMutationResult)(nil),                // 94: task.v1.TaskMutationResult
+	(*ApplyMutationsRequest)(nil),             // 95: task.v1.ApplyMutationsRequest
+	(*ApplyMutationsResponse)(nil),            // 96: task.v1.ApplyMutationsResponse
+	(*timestamppb.Timestamp)(nil),             // 97: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),             // 98: google.protobuf.FieldMask
+	(*v1.Tag)(nil),                            // 99: tag.v1.Tag
 }
 var file_task_v1_task_proto_depIdxs = []int32{
-	26, // 0: task.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	26, // 1: task.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	26, // 2: task.v1.Task.archived_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: task.v1.Task.checklist_items:type_name -> task.v1.ChecklistItem
-	26, // 4: task.v1.ChecklistItem.created_at:type_name -> google.protobuf.Timestamp
-	26, // 5: task.v1.ChecklistItem.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: task.v1.CreateTaskResponse.task:type_name -> task.v1.Task
-	0,  // 7: task.v1.GetTaskResponse.task:type_name -> task.v1.Task
-	0,  // 8: task.v1.UpdateTaskResponse.task:type_name -> task.v1.Task
-	0,  // 9: task.v1.ArchiveTaskResponse.task:type_name -> task.v1.Task
-	0,  // 10: task.v1.UnarchiveTaskResponse.task:type_name -> task.v1.Task
-	0,  // 11: task.v1.ListTasksResponse.tasks:type_name -> task.v1.Task
-	1,  // 12: task.v1.AddChecklistItemResponse.item:type_name -> task.v1.ChecklistItem
-	1,  // 13: task.v1.UpdateChecklistItemResponse.item:type_name -> task.v1.ChecklistItem
-	1,  // 14: task.v1.SetChecklistItemCompletedResponse.item:type_name -> task.v1.ChecklistItem
-	1,  // 15: task.v1.ReorderChecklistItemsResponse.items:type_name -> task.v1.ChecklistItem
-	2,  // 16: task.v1.TaskService.CreateTask:input_type -> task.v1.CreateTaskRequest
-	4,  // 17: task.v1.TaskService.GetTask:input_type -> task.v1.GetTaskRequest
-	6,  // 18: task.v1.TaskService.UpdateTask:input_type -> task.v1.UpdateTaskRequest
-	8,  // 19: task.v1.TaskService.DeleteTask:input_type -> task.v1.DeleteTaskRequest
-	14, // 20: task.v1.TaskService.ListTasks:input_type -> task.v1.ListTasksRequest
-	10, // 21: task.v1.TaskService.ArchiveTask:input_type -> task.v1.ArchiveTaskRequest
-	12, // 22: task.v1.TaskService.UnarchiveTask:input_type -> task.v1.UnarchiveTaskRequest
-	16, // 23: task.v1.TaskService.AddChecklistItem:input_type -> task.v1.AddChecklistItemRequest
-	18, // 24: task.v1.TaskService.UpdateChecklistItem:input_type -> task.v1.UpdateChecklistItemRequest
-	20, // 25: task.v1.TaskService.SetChecklistItemCompleted:input_type -> task.v1.SetChecklistItemCompletedRequest
-	22, // 26: task.v1.TaskService.DeleteChecklistItem:input_type -> task.v1.DeleteChecklistItemRequest
-	24, // 27: task.v1.TaskService.ReorderChecklistItems:input_type -> task.v1.ReorderChecklistItemsRequest
-	3,  // 28: task.v1.TaskService.CreateTask:output_type -> task.v1.CreateTaskResponse
-	5,  // 29: task.v1.TaskService.GetTask:output_type -> task.v1.GetTaskResponse
-	7,  // 30: task.v1.TaskService.UpdateTask:output_type -> task.v1.UpdateTaskResponse
-	9,  // 31: task.v1.TaskService.DeleteTask:output_type -> task.v1.DeleteTaskResponse
-	15, // 32: task.v1.TaskService.ListTasks:output_type -> task.v1.ListTasksResponse
-	11, // 33: task.v1.TaskService.ArchiveTask:output_type -> task.v1.ArchiveTaskResponse
-	13, // 34: task.v1.TaskService.UnarchiveTask:output_type -> task.v1.UnarchiveTaskResponse
-	17, // 35: task.v1.TaskService.AddChecklistItem:output_type -> task.v1.AddChecklistItemResponse
-	19, // 36: task.v1.TaskService.UpdateChecklistItem:output_type -> task.v1.UpdateChecklistItemResponse
-	21, // 37: task.v1.TaskService.SetChecklistItemCompleted:output_type -> task.v1.SetChecklistItemCompletedResponse
-	23, // 38: task.v1.TaskService.DeleteChecklistItem:output_type -> task.v1.DeleteChecklistItemResponse
-	25, // 39: task.v1.TaskService.ReorderChecklistItems:output_type -> task.v1.ReorderChecklistItemsResponse
-	28, // [28:40] is the sub-list for method output_type
-	16, // [16:28] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	97,  // 0: task.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	97,  // 1: task.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	97,  // 2: task.v1.Task.archived_at:type_name -> google.protobuf.Timestamp
+	11,  // 3: task.v1.Task.checklist_items:type_name -> task.v1.ChecklistItem
+	97,  // 4: task.v1.Task.completed_at:type_name -> google.protobuf.Timestamp
+	10,  // 5: task.v1.Task.last_modified_by:type_name -> task.v1.TaskModifier
+	97,  // 6: task.v1.Task.last_viewed_at:type_name -> google.protobuf.Timestamp
+	10,  // 7: task.v1.Task.created_by:type_name -> task.v1.TaskModifier
+	9,   // 8: task.v1.Task.geofence:type_name -> task.v1.Geofence
+	0,   // 9: task.v1.TaskModifier.source:type_name -> task.v1.ChangeSource
+	97,  // 10: task.v1.ChecklistItem.created_at:type_name -> google.protobuf.Timestamp
+	97,  // 11: task.v1.ChecklistItem.updated_at:type_name -> google.protobuf.Timestamp
+	8,   // 12: task.v1.CreateTaskResponse.task:type_name -> task.v1.Task
+	98,  // 13: task.v1.GetTaskRequest.read_mask:type_name -> google.protobuf.FieldMask
+	8,   // 14: task.v1.GetTaskResponse.task:type_name -> task.v1.Task
+	8,   // 15: task.v1.BatchGetTasksResponse.tasks:type_name -> task.v1.Task
+	8,   // 16: task.v1.UpdateTaskResponse.task:type_name -> task.v1.Task
+	8,   // 17: task.v1.DeleteTaskResponse.task:type_name -> task.v1.Task
+	8,   // 18: task.v1.ArchiveTaskResponse.task:type_name -> task.v1.Task
+	8,   // 19: task.v1.UnarchiveTaskResponse.task:type_name -> task.v1.Task
+	8,   // 20: task.v1.CompleteTaskResponse.task:type_name -> task.v1.Task
+	8,   // 21: task.v1.ReopenTaskResponse.task:type_name -> task.v1.Task
+	8,   // 22: task.v1.RolloverOverdueTasksResponse.tasks:type_name -> task.v1.Task
+	40,  // 23: task.v1.GetTaskSettingsResponse.settings:type_name -> task.v1.TaskSettings
+	40,  // 24: task.v1.UpdateTaskSettingsResponse.settings:type_name -> task.v1.TaskSettings
+	1,   // 25: task.v1.GetTaskStatsRequest.bucket:type_name -> task.v1.StatsBucket
+	45,  // 26: task.v1.GetTaskStatsResponse.activity:type_name -> task.v1.ActivityBucket
+	46,  // 27: task.v1.GetTaskStatsResponse.tag_stats:type_name -> task.v1.TagStats
+	97,  // 28: task.v1.GenerateWeeklyReviewResponse.week_start:type_name -> google.protobuf.Timestamp
+	8,   // 29: task.v1.GenerateWeeklyReviewResponse.stale_tasks:type_name -> task.v1.Task
+	8,   // 30: task.v1.GenerateWeeklyReviewResponse.undated_tasks:type_name -> task.v1.Task
+	8,   // 31: task.v1.GenerateWeeklyReviewResponse.completed_this_week:type_name -> task.v1.Task
+	8,   // 32: task.v1.GenerateWeeklyReviewResponse.overdue_tasks:type_name -> task.v1.Task
+	8,   // 33: task.v1.ListStaleTasksResponse.tasks:type_name -> task.v1.Task
+	9,   // 34: task.v1.SetTaskGeofenceRequest.geofence:type_name -> task.v1.Geofence
+	8,   // 35: task.v1.SetTaskGeofenceResponse.task:type_name -> task.v1.Task
+	8,   // 36: task.v1.AddTagToTasksResponse.tasks:type_name -> task.v1.Task
+	8,   // 37: task.v1.RemoveTagFromTasksResponse.tasks:type_name -> task.v1.Task
+	8,   // 38: task.v1.TogglePinTaskResponse.task:type_name -> task.v1.Task
+	2,   // 39: task.v1.ListTasksRequest.tag_match_mode:type_name -> task.v1.TagMatchMode
+	3,   // 40: task.v1.ListTasksRequest.group_by:type_name -> task.v1.TaskGroupBy
+	97,  // 41: task.v1.ListTasksRequest.updated_after:type_name -> google.protobuf.Timestamp
+	97,  // 42: task.v1.ListTasksRequest.archived_after:type_name -> google.protobuf.Timestamp
+	97,  // 43: task.v1.ListTasksRequest.archived_before:type_name -> google.protobuf.Timestamp
+	4,   // 44: task.v1.ListTasksRequest.order_by:type_name -> task.v1.TaskOrderBy
+	98,  // 45: task.v1.ListTasksRequest.read_mask:type_name -> google.protobuf.FieldMask
+	97,  // 46: task.v1.DeletedTask.deleted_at:type_name -> google.protobuf.Timestamp
+	8,   // 47: task.v1.ListTasksResponse.tasks:type_name -> task.v1.Task
+	65,  // 48: task.v1.ListTasksResponse.groups:type_name -> task.v1.TaskGroup
+	67,  // 49: task.v1.ListTasksResponse.deleted_tasks:type_name -> task.v1.DeletedTask
+	8,   // 50: task.v1.StreamTasksResponse.tasks:type_name -> task.v1.Task
+	5,   // 51: task.v1.WatchChangesResponse.resource:type_name -> task.v1.ChangeResource
+	6,   // 52: task.v1.WatchChangesResponse.operation:type_name -> task.v1.ChangeOperation
+	8,   // 53: task.v1.WatchChangesResponse.task:type_name -> task.v1.Task
+	99,  // 54: task.v1.WatchChangesResponse.tag:type_name -> tag.v1.Tag
+	11,  // 55: task.v1.WatchChangesResponse.checklist_item:type_name -> task.v1.ChecklistItem
+	3,   // 56: task.v1.ListTasksByFilterRequest.group_by:type_name -> task.v1.TaskGroupBy
+	8,   // 57: task.v1.ListTasksByFilterResponse.tasks:type_name -> task.v1.Task
+	65,  // 58: task.v1.ListTasksByFilterResponse.groups:type_name -> task.v1.TaskGroup
+	11,  // 59: task.v1.AddChecklistItemResponse.item:type_name -> task.v1.ChecklistItem
+	11,  // 60: task.v1.UpdateChecklistItemResponse.item:type_name -> task.v1.ChecklistItem
+	11,  // 61: task.v1.SetChecklistItemCompletedResponse.item:type_name -> task.v1.ChecklistItem
+	11,  // 62: task.v1.ReorderChecklistItemsResponse.items:type_name -> task.v1.ChecklistItem
+	97,  // 63: task.v1.NoteRevision.created_at:type_name -> google.protobuf.Timestamp
+	85,  // 64: task.v1.ListNoteRevisionsResponse.revisions:type_name -> task.v1.NoteRevision
+	8,   // 65: task.v1.RestoreNoteRevisionResponse.task:type_name -> task.v1.Task
+	97,  // 66: task.v1.UpdateTaskMutation.base_updated_at:type_name -> google.protobuf.Timestamp
+	97,  // 67: task.v1.DeleteTaskMutation.base_updated_at:type_name -> google.protobuf.Timestamp
+	90,  // 68: task.v1.TaskMutation.create:type_name -> task.v1.CreateTaskMutation
+	91,  // 69: task.v1.TaskMutation.update:type_name -> task.v1.UpdateTaskMutation
+	92,  // 70: task.v1.TaskMutation.delete:type_name -> task.v1.DeleteTaskMutation
+	7,   // 71: task.v1.TaskMutationResult.conflict:type_name -> task.v1.MutationConflict
+	8,   // 72: task.v1.TaskMutationResult.task:type_name -> task.v1.Task
+	93,  // 73: task.v1.ApplyMutationsRequest.mutations:type_name -> task.v1.TaskMutation
+	94,  // 74: task.v1.ApplyMutationsResponse.results:type_name -> task.v1.TaskMutationResult
+	12,  // 75: task.v1.TaskService.CreateTask:input_type -> task.v1.CreateTaskRequest
+	14,  // 76: task.v1.TaskService.GetTask:input_type -> task.v1.GetTaskRequest
+	16,  // 77: task.v1.TaskService.BatchGetTasks:input_type -> task.v1.BatchGetTasksRequest
+	18,  // 78: task.v1.TaskService.UpdateTask:input_type -> task.v1.UpdateTaskRequest
+	20,  // 79: task.v1.TaskService.DeleteTask:input_type -> task.v1.DeleteTaskRequest
+	66,  // 80: task.v1.TaskService.ListTasks:input_type -> task.v1.ListTasksRequest
+	69,  // 81: task.v1.TaskService.StreamTasks:input_type -> task.v1.StreamTasksRequest
+	71,  // 82: task.v1.TaskService.WatchChanges:input_type -> task.v1.WatchChangesRequest
+	73,  // 83: task.v1.TaskService.ListTasksByFilter:input_type -> task.v1.ListTasksByFilterRequest
+	22,  // 84: task.v1.TaskService.ArchiveTask:input_type -> task.v1.ArchiveTaskRequest
+	24,  // 85: task.v1.TaskService.UnarchiveTask:input_type -> task.v1.UnarchiveTaskRequest
+	63,  // 86: task.v1.TaskService.TogglePinTask:input_type -> task.v1.TogglePinTaskRequest
+	26,  // 87: task.v1.TaskService.CompleteTask:input_type -> task.v1.CompleteTaskRequest
+	28,  // 88: task.v1.TaskService.ReopenTask:input_type -> task.v1.ReopenTaskRequest
+	30,  // 89: task.v1.TaskService.ArchiveCompletedTasks:input_type -> task.v1.ArchiveCompletedTasksRequest
+	32,  // 90: task.v1.TaskService.ArchiveTasksByTag:input_type -> task.v1.ArchiveTasksByTagRequest
+	34,  // 91: task.v1.TaskService.UnarchiveTasksByTag:input_type -> task.v1.UnarchiveTasksByTagRequest
+	38,  // 92: task.v1.TaskService.RolloverOverdueTasks:input_type -> task.v1.RolloverOverdueTasksRequest
+	41,  // 93: task.v1.TaskService.GetTaskSettings:input_type -> task.v1.GetTaskSettingsRequest
+	43,  // 94: task.v1.TaskService.UpdateTaskSettings:input_type -> task.v1.UpdateTaskSettingsRequest
+	36,  // 95: task.v1.TaskService.GetCounters:input_type -> task.v1.GetCountersRequest
+	47,  // 96: task.v1.TaskService.GetTaskStats:input_type -> task.v1.GetTaskStatsRequest
+	49,  // 97: task.v1.TaskService.GenerateWeeklyReview:input_type -> task.v1.GenerateWeeklyReviewRequest
+	51,  // 98: task.v1.TaskService.ListStaleTasks:input_type -> task.v1.ListStaleTasksRequest
+	53,  // 99: task.v1.TaskService.SetTaskGeofence:input_type -> task.v1.SetTaskGeofenceRequest
+	55,  // 100: task.v1.TaskService.MarkTaskViewed:input_type -> task.v1.MarkTaskViewedRequest
+	57,  // 101: task.v1.TaskService.AddTagToTasks:input_type -> task.v1.AddTagToTasksRequest
+	59,  // 102: task.v1.TaskService.RemoveTagFromTasks:input_type -> task.v1.RemoveTagFromTasksRequest
+	75,  // 103: task.v1.TaskService.AddChecklistItem:input_type -> task.v1.AddChecklistItemRequest
+	77,  // 104: task.v1.TaskService.UpdateChecklistItem:input_type -> task.v1.UpdateChecklistItemRequest
+	79,  // 105: task.v1.TaskService.SetChecklistItemCompleted:input_type -> task.v1.SetChecklistItemCompletedRequest
+	81,  // 106: task.v1.TaskService.DeleteChecklistItem:input_type -> task.v1.DeleteChecklistItemRequest
+	83,  // 107: task.v1.TaskService.ReorderChecklistItems:input_type -> task.v1.ReorderChecklistItemsRequest
+	86,  // 108: task.v1.TaskService.ListNoteRevisions:input_type -> task.v1.ListNoteRevisionsRequest
+	88,  // 109: task.v1.TaskService.RestoreNoteRevision:input_type -> task.v1.RestoreNoteRevisionRequest
+	95,  // 110: task.v1.TaskService.ApplyMutations:input_type -> task.v1.ApplyMutationsRequest
+	61,  // 111: task.v1.TaskService.TransferTasks:input_type -> task.v1.TransferTasksRequest
+	13,  // 112: task.v1.TaskService.CreateTask:output_type -> task.v1.CreateTaskResponse
+	15,  // 113: task.v1.TaskService.GetTask:output_type -> task.v1.GetTaskResponse
+	17,  // 114: task.v1.TaskService.BatchGetTasks:output_type -> task.v1.BatchGetTasksResponse
+	19,  // 115: task.v1.TaskService.UpdateTask:output_type -> task.v1.UpdateTaskResponse
+	21,  // 116: task.v1.TaskService.DeleteTask:output_type -> task.v1.DeleteTaskResponse
+	68,  // 117: task.v1.TaskService.ListTasks:output_type -> task.v1.ListTasksResponse
+	70,  // 118: task.v1.TaskService.StreamTasks:output_type -> task.v1.StreamTasksResponse
+	72,  // 119: task.v1.TaskService.WatchChanges:output_type -> task.v1.WatchChangesResponse
+	74,  // 120: task.v1.TaskService.ListTasksByFilter:output_type -> task.v1.ListTasksByFilterResponse
+	23,  // 121: task.v1.TaskService.ArchiveTask:output_type -> task.v1.ArchiveTaskResponse
+	25,  // 122: task.v1.TaskService.UnarchiveTask:output_type -> task.v1.UnarchiveTaskResponse
+	64,  // 123: task.v1.TaskService.TogglePinTask:output_type -> task.v1.TogglePinTaskResponse
+	27,  // 124: task.v1.TaskService.CompleteTask:output_type -> task.v1.CompleteTaskResponse
+	29,  // 125: task.v1.TaskService.ReopenTask:output_type -> task.v1.ReopenTaskResponse
+	31,  // 126: task.v1.TaskService.ArchiveCompletedTasks:output_type -> task.v1.ArchiveCompletedTasksResponse
+	33,  // 127: task.v1.TaskService.ArchiveTasksByTag:output_type -> task.v1.ArchiveTasksByTagResponse
+	35,  // 128: task.v1.TaskService.UnarchiveTasksByTag:output_type -> task.v1.UnarchiveTasksByTagResponse
+	39,  // 129: task.v1.TaskService.RolloverOverdueTasks:output_type -> task.v1.RolloverOverdueTasksResponse
+	42,  // 130: task.v1.TaskService.GetTaskSettings:output_type -> task.v1.GetTaskSettingsResponse
+	44,  // 131: task.v1.TaskService.UpdateTaskSettings:output_type -> task.v1.UpdateTaskSettingsResponse
+	37,  // 132: task.v1.TaskService.GetCounters:output_type -> task.v1.GetCountersResponse
+	48,  // 133: task.v1.TaskService.GetTaskStats:output_type -> task.v1.GetTaskStatsResponse
+	50,  // 134: task.v1.TaskService.GenerateWeeklyReview:output_type -> task.v1.GenerateWeeklyReviewResponse
+	52,  // 135: task.v1.TaskService.ListStaleTasks:output_type -> task.v1.ListStaleTasksResponse
+	54,  // 136: task.v1.TaskService.SetTaskGeofence:output_type -> task.v1.SetTaskGeofenceResponse
+	56,  // 137: task.v1.TaskService.MarkTaskViewed:output_type -> task.v1.MarkTaskViewedResponse
+	58,  // 138: task.v1.TaskService.AddTagToTasks:output_type -> task.v1.AddTagToTasksResponse
+	60,  // 139: task.v1.TaskService.RemoveTagFromTasks:output_type -> task.v1.RemoveTagFromTasksResponse
+	76,  // 140: task.v1.TaskService.AddChecklistItem:output_type -> task.v1.AddChecklistItemResponse
+	78,  // 141: task.v1.TaskService.UpdateChecklistItem:output_type -> task.v1.UpdateChecklistItemResponse
+	80,  // 142: task.v1.TaskService.SetChecklistItemCompleted:output_type -> task.v1.SetChecklistItemCompletedResponse
+	82,  // 143: task.v1.TaskService.DeleteChecklistItem:output_type -> task.v1.DeleteChecklistItemResponse
+	84,  // 144: task.v1.TaskService.ReorderChecklistItems:output_type -> task.v1.ReorderChecklistItemsResponse
+	87,  // 145: task.v1.TaskService.ListNoteRevisions:output_type -> task.v1.ListNoteRevisionsResponse
+	89,  // 146: task.v1.TaskService.RestoreNoteRevision:output_type -> task.v1.RestoreNoteRevisionResponse
+	96,  // 147: task.v1.TaskService.ApplyMutations:output_type -> task.v1.ApplyMutationsResponse
+	62,  // 148: task.v1.TaskService.TransferTasks:output_type -> task.v1.TransferTasksResponse
+	112, // [112:149] is the sub-list for method output_type
+	75,  // [75:112] is the sub-list for method input_type
+	75,  // [75:75] is the sub-list for extension type_name
+	75,  // [75:75] is the sub-list for extension extendee
+	0,   // [0:75] is the sub-list for field type_name
 }
 
 func init() { file_task_v1_task_proto_init() }
@@ -1642,21 +6569,40 @@ func file_task_v1_task_proto_init() {
 		return
 	}
 	file_task_v1_task_proto_msgTypes[0].OneofWrappers = []any{}
-	file_task_v1_task_proto_msgTypes[2].OneofWrappers = []any{}
-	file_task_v1_task_proto_msgTypes[6].OneofWrappers = []any{}
-	file_task_v1_task_proto_msgTypes[14].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[4].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[10].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[22].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[28].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[30].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[32].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[35].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[58].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[61].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[64].OneofWrappers = []any{
+		(*WatchChangesResponse_Task)(nil),
+		(*WatchChangesResponse_Tag)(nil),
+		(*WatchChangesResponse_ChecklistItem)(nil),
+	}
+	file_task_v1_task_proto_msgTypes[82].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[83].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[85].OneofWrappers = []any{
+		(*TaskMutation_Create)(nil),
+		(*TaskMutation_Update)(nil),
+		(*TaskMutation_Delete)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_v1_task_proto_rawDesc), len(file_task_v1_task_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   26,
+			NumEnums:      8,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_task_v1_task_proto_goTypes,
 		DependencyIndexes: file_task_v1_task_proto_depIdxs,
+		EnumInfos:         file_task_v1_task_proto_enumTypes,
 		MessageInfos:      file_task_v1_task_proto_msgTypes,
 	}.Build()
 	File_task_v1_task_proto = out.File
@@ -21,16 +21,41 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	TaskService_CreateTask_FullMethodName                = "/task.v1.TaskService/CreateTask"
 	TaskService_GetTask_FullMethodName                   = "/task.v1.TaskService/GetTask"
+	TaskService_BatchGetTasks_FullMethodName             = "/task.v1.TaskService/BatchGetTasks"
 	TaskService_UpdateTask_FullMethodName                = "/task.v1.TaskService/UpdateTask"
 	TaskService_DeleteTask_FullMethodName                = "/task.v1.TaskService/DeleteTask"
 	TaskService_ListTasks_FullMethodName                 = "/task.v1.TaskService/ListTasks"
+	TaskService_StreamTasks_FullMethodName               = "/task.v1.TaskService/StreamTasks"
+	TaskService_WatchChanges_FullMethodName              = "/task.v1.TaskService/WatchChanges"
+	TaskService_ListTasksByFilter_FullMethodName         = "/task.v1.TaskService/ListTasksByFilter"
 	TaskService_ArchiveTask_FullMethodName               = "/task.v1.TaskService/ArchiveTask"
 	TaskService_UnarchiveTask_FullMethodName             = "/task.v1.TaskService/UnarchiveTask"
+	TaskService_TogglePinTask_FullMethodName             = "/task.v1.TaskService/TogglePinTask"
+	TaskService_CompleteTask_FullMethodName              = "/task.v1.TaskService/CompleteTask"
+	TaskService_ReopenTask_FullMethodName                = "/task.v1.TaskService/ReopenTask"
+	TaskService_ArchiveCompletedTasks_FullMethodName     = "/task.v1.TaskService/ArchiveCompletedTasks"
+	TaskService_ArchiveTasksByTag_FullMethodName         = "/task.v1.TaskService/ArchiveTasksByTag"
+	TaskService_UnarchiveTasksByTag_FullMethodName       = "/task.v1.TaskService/UnarchiveTasksByTag"
+	TaskService_RolloverOverdueTasks_FullMethodName      = "/task.v1.TaskService/RolloverOverdueTasks"
+	TaskService_GetTaskSettings_FullMethodName           = "/task.v1.TaskService/GetTaskSettings"
+	TaskService_UpdateTaskSettings_FullMethodName        = "/task.v1.TaskService/UpdateTaskSettings"
+	TaskService_GetCounters_FullMethodName               = "/task.v1.TaskService/GetCounters"
+	TaskService_GetTaskStats_FullMethodName              = "/task.v1.TaskService/GetTaskStats"
+	TaskService_GenerateWeeklyReview_FullMethodName      = "/task.v1.TaskService/GenerateWeeklyReview"
+	TaskService_ListStaleTasks_FullMethodName            = "/task.v1.TaskService/ListStaleTasks"
+	TaskService_SetTaskGeofence_FullMethodName           = "/task.v1.TaskService/SetTaskGeofence"
+	TaskService_MarkTaskViewed_FullMethodName            = "/task.v1.TaskService/MarkTaskViewed"
+	TaskService_AddTagToTasks_FullMethodName             = "/task.v1.TaskService/AddTagToTasks"
+	TaskService_RemoveTagFromTasks_FullMethodName        = "/task.v1.TaskService/RemoveTagFromTasks"
 	TaskService_AddChecklistItem_FullMethodName          = "/task.v1.TaskService/AddChecklistItem"
 	TaskService_UpdateChecklistItem_FullMethodName       = "/task.v1.TaskService/UpdateChecklistItem"
 	TaskService_SetChecklistItemCompleted_FullMethodName = "/task.v1.TaskService/SetChecklistItemCompleted"
 	TaskService_DeleteChecklistItem_FullMethodName       = "/task.v1.TaskService/DeleteChecklistItem"
 	TaskService_ReorderChecklistItems_FullMethodName     = "/task.v1.TaskService/ReorderChecklistItems"
+	TaskService_ListNoteRevisions_FullMethodName         = "/task.v1.TaskService/ListNoteRevisions"
+	TaskService_RestoreNoteRevision_FullMethodName       = "/task.v1.TaskService/RestoreNoteRevision"
+	TaskService_ApplyMutations_FullMethodName            = "/task.v1.TaskService/ApplyMutations"
+	TaskService_TransferTasks_FullMethodName             = "/task.v1.TaskService/TransferTasks"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -41,16 +66,78 @@ const (
 type TaskServiceClient interface {
 	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error)
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskResponse, error)
+	BatchGetTasks(ctx context.Context, in *BatchGetTasksRequest, opts ...grpc.CallOption) (*BatchGetTasksResponse, error)
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error)
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// StreamTasks sends every matching task in chunks, for exports and full
+	// syncs too large for a single ListTasks response
+	StreamTasks(ctx context.Context, in *StreamTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamTasksResponse], error)
+	// WatchChanges streams changes to the caller's tasks, tags and checklist
+	// items, made through any client or agent, until the client cancels. The
+	// server sends response headers once the watch is active.
+	WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchChangesResponse], error)
+	ListTasksByFilter(ctx context.Context, in *ListTasksByFilterRequest, opts ...grpc.CallOption) (*ListTasksByFilterResponse, error)
 	ArchiveTask(ctx context.Context, in *ArchiveTaskRequest, opts ...grpc.CallOption) (*ArchiveTaskResponse, error)
 	UnarchiveTask(ctx context.Context, in *UnarchiveTaskRequest, opts ...grpc.CallOption) (*UnarchiveTaskResponse, error)
+	TogglePinTask(ctx context.Context, in *TogglePinTaskRequest, opts ...grpc.CallOption) (*TogglePinTaskResponse, error)
+	CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*CompleteTaskResponse, error)
+	ReopenTask(ctx context.Context, in *ReopenTaskRequest, opts ...grpc.CallOption) (*ReopenTaskResponse, error)
+	ArchiveCompletedTasks(ctx context.Context, in *ArchiveCompletedTasksRequest, opts ...grpc.CallOption) (*ArchiveCompletedTasksResponse, error)
+	// ArchiveTasksByTag archives every task with the tag, open or completed,
+	// in one statement, e.g. when a project is finished. UnarchiveTasksByTag
+	// restores every archived task with the tag. Both fail with NOT_FOUND when
+	// the tag does not exist.
+	ArchiveTasksByTag(ctx context.Context, in *ArchiveTasksByTagRequest, opts ...grpc.CallOption) (*ArchiveTasksByTagResponse, error)
+	UnarchiveTasksByTag(ctx context.Context, in *UnarchiveTasksByTagRequest, opts ...grpc.CallOption) (*UnarchiveTasksByTagResponse, error)
+	// RolloverOverdueTasks moves every open task that started before today to
+	// today, or to the inbox per the rollover_to_inbox setting, in one
+	// transaction. Tasks without a start date are left alone.
+	RolloverOverdueTasks(ctx context.Context, in *RolloverOverdueTasksRequest, opts ...grpc.CallOption) (*RolloverOverdueTasksResponse, error)
+	// Task settings are per user. Auto-archiving is applied by a periodic
+	// server job, not when the setting is changed.
+	GetTaskSettings(ctx context.Context, in *GetTaskSettingsRequest, opts ...grpc.CallOption) (*GetTaskSettingsResponse, error)
+	UpdateTaskSettings(ctx context.Context, in *UpdateTaskSettingsRequest, opts ...grpc.CallOption) (*UpdateTaskSettingsResponse, error)
+	// GetCounters counts the caller's inbox, today, overdue and unread tasks
+	// in one aggregate query, cheap enough for clients to poll for badges
+	GetCounters(ctx context.Context, in *GetCountersRequest, opts ...grpc.CallOption) (*GetCountersResponse, error)
+	GetTaskStats(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*GetTaskStatsResponse, error)
+	GenerateWeeklyReview(ctx context.Context, in *GenerateWeeklyReviewRequest, opts ...grpc.CallOption) (*GenerateWeeklyReviewResponse, error)
+	// ListStaleTasks lists open tasks neither updated nor viewed recently, so
+	// the backlog can be pruned or rescheduled. Tasks that are acted on drop
+	// out, so calling again returns the next ones.
+	ListStaleTasks(ctx context.Context, in *ListStaleTasksRequest, opts ...grpc.CallOption) (*ListStaleTasksResponse, error)
+	// SetTaskGeofence sets or removes the place a task reminds the user at.
+	// The task counts as updated, so other devices pick the change up in
+	// their next sync.
+	SetTaskGeofence(ctx context.Context, in *SetTaskGeofenceRequest, opts ...grpc.CallOption) (*SetTaskGeofenceResponse, error)
+	// MarkTaskViewed records that the user opened a task. Clients call it when
+	// showing a task's details; it does not change updated_at.
+	MarkTaskViewed(ctx context.Context, in *MarkTaskViewedRequest, opts ...grpc.CallOption) (*MarkTaskViewedResponse, error)
+	// AddTagToTasks and RemoveTagFromTasks add a tag to or remove it from up
+	// to 100 tasks in one statement. Unknown task IDs fail the whole request
+	// with NOT_FOUND and nothing changes.
+	AddTagToTasks(ctx context.Context, in *AddTagToTasksRequest, opts ...grpc.CallOption) (*AddTagToTasksResponse, error)
+	RemoveTagFromTasks(ctx context.Context, in *RemoveTagFromTasksRequest, opts ...grpc.CallOption) (*RemoveTagFromTasksResponse, error)
 	AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest, opts ...grpc.CallOption) (*AddChecklistItemResponse, error)
 	UpdateChecklistItem(ctx context.Context, in *UpdateChecklistItemRequest, opts ...grpc.CallOption) (*UpdateChecklistItemResponse, error)
 	SetChecklistItemCompleted(ctx context.Context, in *SetChecklistItemCompletedRequest, opts ...grpc.CallOption) (*SetChecklistItemCompletedResponse, error)
 	DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest, opts ...grpc.CallOption) (*DeleteChecklistItemResponse, error)
 	ReorderChecklistItems(ctx context.Context, in *ReorderChecklistItemsRequest, opts ...grpc.CallOption) (*ReorderChecklistItemsResponse, error)
+	ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error)
+	RestoreNoteRevision(ctx context.Context, in *RestoreNoteRevisionRequest, opts ...grpc.CallOption) (*RestoreNoteRevisionResponse, error)
+	// ApplyMutations applies an offline client's queued creates, updates and
+	// deletes in one transaction. Conflicting mutations are skipped and
+	// reported instead of failing the batch.
+	ApplyMutations(ctx context.Context, in *ApplyMutationsRequest, opts ...grpc.CallOption) (*ApplyMutationsResponse, error)
+	// TransferTasks hands up to 100 of the user's tasks, with their tags and
+	// checklists, to another user in one transaction. The tasks keep their
+	// IDs and note revisions, their tags are matched to the recipient's by
+	// name, and they are deleted for the caller. Unknown task IDs fail the whole request with NOT_FOUND and
+	// nothing changes. MCP tokens cannot transfer tasks, and the server must
+	// allow transfers (transfers.allow_users); both users must live on the
+	// same shard.
+	TransferTasks(ctx context.Context, in *TransferTasksRequest, opts ...grpc.CallOption) (*TransferTasksResponse, error)
 }
 
 type taskServiceClient struct {
@@ -81,6 +168,16 @@ func (c *taskServiceClient) GetTask(ctx context.Context, in *GetTaskRequest, opt
 	return out, nil
 }
 
+func (c *taskServiceClient) BatchGetTasks(ctx context.Context, in *BatchGetTasksRequest, opts ...grpc.CallOption) (*BatchGetTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_BatchGetTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskServiceClient) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateTaskResponse)
@@ -111,6 +208,54 @@ func (c *taskServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest,
 	return out, nil
 }
 
+func (c *taskServiceClient) StreamTasks(ctx context.Context, in *StreamTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamTasksResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[0], TaskService_StreamTasks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamTasksRequest, StreamTasksResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_StreamTasksClient = grpc.ServerStreamingClient[StreamTasksResponse]
+
+func (c *taskServiceClient) WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchChangesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[1], TaskService_WatchChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchChangesRequest, WatchChangesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchChangesClient = grpc.ServerStreamingClient[WatchChangesResponse]
+
+func (c *taskServiceClient) ListTasksByFilter(ctx context.Context, in *ListTasksByFilterRequest, opts ...grpc.CallOption) (*ListTasksByFilterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksByFilterResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTasksByFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskServiceClient) ArchiveTask(ctx context.Context, in *ArchiveTaskRequest, opts ...grpc.CallOption) (*ArchiveTaskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ArchiveTaskResponse)
@@ -131,6 +276,176 @@ func (c *taskServiceClient) UnarchiveTask(ctx context.Context, in *UnarchiveTask
 	return out, nil
 }
 
+func (c *taskServiceClient) TogglePinTask(ctx context.Context, in *TogglePinTaskRequest, opts ...grpc.CallOption) (*TogglePinTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TogglePinTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_TogglePinTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*CompleteTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_CompleteTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReopenTask(ctx context.Context, in *ReopenTaskRequest, opts ...grpc.CallOption) (*ReopenTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_ReopenTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ArchiveCompletedTasks(ctx context.Context, in *ArchiveCompletedTasksRequest, opts ...grpc.CallOption) (*ArchiveCompletedTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveCompletedTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ArchiveCompletedTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ArchiveTasksByTag(ctx context.Context, in *ArchiveTasksByTagRequest, opts ...grpc.CallOption) (*ArchiveTasksByTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveTasksByTagResponse)
+	err := c.cc.Invoke(ctx, TaskService_ArchiveTasksByTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UnarchiveTasksByTag(ctx context.Context, in *UnarchiveTasksByTagRequest, opts ...grpc.CallOption) (*UnarchiveTasksByTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveTasksByTagResponse)
+	err := c.cc.Invoke(ctx, TaskService_UnarchiveTasksByTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RolloverOverdueTasks(ctx context.Context, in *RolloverOverdueTasksRequest, opts ...grpc.CallOption) (*RolloverOverdueTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolloverOverdueTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_RolloverOverdueTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskSettings(ctx context.Context, in *GetTaskSettingsRequest, opts ...grpc.CallOption) (*GetTaskSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaskSettingsResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetTaskSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateTaskSettings(ctx context.Context, in *UpdateTaskSettingsRequest, opts ...grpc.CallOption) (*UpdateTaskSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTaskSettingsResponse)
+	err := c.cc.Invoke(ctx, TaskService_UpdateTaskSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetCounters(ctx context.Context, in *GetCountersRequest, opts ...grpc.CallOption) (*GetCountersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCountersResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetCounters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskStats(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*GetTaskStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaskStatsResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetTaskStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GenerateWeeklyReview(ctx context.Context, in *GenerateWeeklyReviewRequest, opts ...grpc.CallOption) (*GenerateWeeklyReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateWeeklyReviewResponse)
+	err := c.cc.Invoke(ctx, TaskService_GenerateWeeklyReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListStaleTasks(ctx context.Context, in *ListStaleTasksRequest, opts ...grpc.CallOption) (*ListStaleTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaleTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListStaleTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) SetTaskGeofence(ctx context.Context, in *SetTaskGeofenceRequest, opts ...grpc.CallOption) (*SetTaskGeofenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTaskGeofenceResponse)
+	err := c.cc.Invoke(ctx, TaskService_SetTaskGeofence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) MarkTaskViewed(ctx context.Context, in *MarkTaskViewedRequest, opts ...grpc.CallOption) (*MarkTaskViewedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkTaskViewedResponse)
+	err := c.cc.Invoke(ctx, TaskService_MarkTaskViewed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) AddTagToTasks(ctx context.Context, in *AddTagToTasksRequest, opts ...grpc.CallOption) (*AddTagToTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTagToTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_AddTagToTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RemoveTagFromTasks(ctx context.Context, in *RemoveTagFromTasksRequest, opts ...grpc.CallOption) (*RemoveTagFromTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTagFromTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_RemoveTagFromTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskServiceClient) AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest, opts ...grpc.CallOption) (*AddChecklistItemResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddChecklistItemResponse)
@@ -181,6 +496,46 @@ func (c *taskServiceClient) ReorderChecklistItems(ctx context.Context, in *Reord
 	return out, nil
 }
 
+func (c *taskServiceClient) ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNoteRevisionsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListNoteRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RestoreNoteRevision(ctx context.Context, in *RestoreNoteRevisionRequest, opts ...grpc.CallOption) (*RestoreNoteRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreNoteRevisionResponse)
+	err := c.cc.Invoke(ctx, TaskService_RestoreNoteRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ApplyMutations(ctx context.Context, in *ApplyMutationsRequest, opts ...grpc.CallOption) (*ApplyMutationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyMutationsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ApplyMutations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) TransferTasks(ctx context.Context, in *TransferTasksRequest, opts ...grpc.CallOption) (*TransferTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_TransferTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
@@ -189,16 +544,78 @@ func (c *taskServiceClient) ReorderChecklistItems(ctx context.Context, in *Reord
 type TaskServiceServer interface {
 	CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error)
 	GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error)
+	BatchGetTasks(context.Context, *BatchGetTasksRequest) (*BatchGetTasksResponse, error)
 	UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error)
 	DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error)
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// StreamTasks sends every matching task in chunks, for exports and full
+	// syncs too large for a single ListTasks response
+	StreamTasks(*StreamTasksRequest, grpc.ServerStreamingServer[StreamTasksResponse]) error
+	// WatchChanges streams changes to the caller's tasks, tags and checklist
+	// items, made through any client or agent, until the client cancels. The
+	// server sends response headers once the watch is active.
+	WatchChanges(*WatchChangesRequest, grpc.ServerStreamingServer[WatchChangesResponse]) error
+	ListTasksByFilter(context.Context, *ListTasksByFilterRequest) (*ListTasksByFilterResponse, error)
 	ArchiveTask(context.Context, *ArchiveTaskRequest) (*ArchiveTaskResponse, error)
 	UnarchiveTask(context.Context, *UnarchiveTaskRequest) (*UnarchiveTaskResponse, error)
+	TogglePinTask(context.Context, *TogglePinTaskRequest) (*TogglePinTaskResponse, error)
+	CompleteTask(context.Context, *CompleteTaskRequest) (*CompleteTaskResponse, error)
+	ReopenTask(context.Context, *ReopenTaskRequest) (*ReopenTaskResponse, error)
+	ArchiveCompletedTasks(context.Context, *ArchiveCompletedTasksRequest) (*ArchiveCompletedTasksResponse, error)
+	// ArchiveTasksByTag archives every task with the tag, open or completed,
+	// in one statement, e.g. when a project is finished. UnarchiveTasksByTag
+	// restores every archived task with the tag. Both fail with NOT_FOUND when
+	// the tag does not exist.
+	ArchiveTasksByTag(context.Context, *ArchiveTasksByTagRequest) (*ArchiveTasksByTagResponse, error)
+	UnarchiveTasksByTag(context.Context, *UnarchiveTasksByTagRequest) (*UnarchiveTasksByTagResponse, error)
+	// RolloverOverdueTasks moves every open task that started before today to
+	// today, or to the inbox per the rollover_to_inbox setting, in one
+	// transaction. Tasks without a start date are left alone.
+	RolloverOverdueTasks(context.Context, *RolloverOverdueTasksRequest) (*RolloverOverdueTasksResponse, error)
+	// Task settings are per user. Auto-archiving is applied by a periodic
+	// server job, not when the setting is changed.
+	GetTaskSettings(context.Context, *GetTaskSettingsRequest) (*GetTaskSettingsResponse, error)
+	UpdateTaskSettings(context.Context, *UpdateTaskSettingsRequest) (*UpdateTaskSettingsResponse, error)
+	// GetCounters counts the caller's inbox, today, overdue and unread tasks
+	// in one aggregate query, cheap enough for clients to poll for badges
+	GetCounters(context.Context, *GetCountersRequest) (*GetCountersResponse, error)
+	GetTaskStats(context.Context, *GetTaskStatsRequest) (*GetTaskStatsResponse, error)
+	GenerateWeeklyReview(context.Context, *GenerateWeeklyReviewRequest) (*GenerateWeeklyReviewResponse, error)
+	// ListStaleTasks lists open tasks neither updated nor viewed recently, so
+	// the backlog can be pruned or rescheduled. Tasks that are acted on drop
+	// out, so calling again returns the next ones.
+	ListStaleTasks(context.Context, *ListStaleTasksRequest) (*ListStaleTasksResponse, error)
+	// SetTaskGeofence sets or removes the place a task reminds the user at.
+	// The task counts as updated, so other devices pick the change up in
+	// their next sync.
+	SetTaskGeofence(context.Context, *SetTaskGeofenceRequest) (*SetTaskGeofenceResponse, error)
+	// MarkTaskViewed records that the user opened a task. Clients call it when
+	// showing a task's details; it does not change updated_at.
+	MarkTaskViewed(context.Context, *MarkTaskViewedRequest) (*MarkTaskViewedResponse, error)
+	// AddTagToTasks and RemoveTagFromTasks add a tag to or remove it from up
+	// to 100 tasks in one statement. Unknown task IDs fail the whole request
+	// with NOT_FOUND and nothing changes.
+	AddTagToTasks(context.Context, *AddTagToTasksRequest) (*AddTagToTasksResponse, error)
+	RemoveTagFromTasks(context.Context, *RemoveTagFromTasksRequest) (*RemoveTagFromTasksResponse, error)
 	AddChecklistItem(context.Context, *AddChecklistItemRequest) (*AddChecklistItemResponse, error)
 	UpdateChecklistItem(context.Context, *UpdateChecklistItemRequest) (*UpdateChecklistItemResponse, error)
 	SetChecklistItemCompleted(context.Context, *SetChecklistItemCompletedRequest) (*SetChecklistItemCompletedResponse, error)
 	DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error)
 	ReorderChecklistItems(context.Context, *ReorderChecklistItemsRequest) (*ReorderChecklistItemsResponse, error)
+	ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error)
+	RestoreNoteRevision(context.Context, *RestoreNoteRevisionRequest) (*RestoreNoteRevisionResponse, error)
+	// ApplyMutations applies an offline client's queued creates, updates and
+	// deletes in one transaction. Conflicting mutations are skipped and
+	// reported instead of failing the batch.
+	ApplyMutations(context.Context, *ApplyMutationsRequest) (*ApplyMutationsResponse, error)
+	// TransferTasks hands up to 100 of the user's tasks, with their tags and
+	// checklists, to another user in one transaction. The tasks keep their
+	// IDs and note revisions, their tags are matched to the recipient's by
+	// name, and they are deleted for the caller. Unknown task IDs fail the whole request with NOT_FOUND and
+	// nothing changes. MCP tokens cannot transfer tasks, and the server must
+	// allow transfers (transfers.allow_users); both users must live on the
+	// same shard.
+	TransferTasks(context.Context, *TransferTasksRequest) (*TransferTasksResponse, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -215,6 +632,9 @@ func (UnimplementedTaskServiceServer) CreateTask(context.Context, *CreateTaskReq
 func (UnimplementedTaskServiceServer) GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
 }
+func (UnimplementedTaskServiceServer) BatchGetTasks(context.Context, *BatchGetTasksRequest) (*BatchGetTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetTasks not implemented")
+}
 func (UnimplementedTaskServiceServer) UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
 }
@@ -224,12 +644,72 @@ func (UnimplementedTaskServiceServer) DeleteTask(context.Context, *DeleteTaskReq
 func (UnimplementedTaskServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
+func (UnimplementedTaskServiceServer) StreamTasks(*StreamTasksRequest, grpc.ServerStreamingServer[StreamTasksResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) WatchChanges(*WatchChangesRequest, grpc.ServerStreamingServer[WatchChangesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChanges not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTasksByFilter(context.Context, *ListTasksByFilterRequest) (*ListTasksByFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasksByFilter not implemented")
+}
 func (UnimplementedTaskServiceServer) ArchiveTask(context.Context, *ArchiveTaskRequest) (*ArchiveTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTask not implemented")
 }
 func (UnimplementedTaskServiceServer) UnarchiveTask(context.Context, *UnarchiveTaskRequest) (*UnarchiveTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveTask not implemented")
 }
+func (UnimplementedTaskServiceServer) TogglePinTask(context.Context, *TogglePinTaskRequest) (*TogglePinTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TogglePinTask not implemented")
+}
+func (UnimplementedTaskServiceServer) CompleteTask(context.Context, *CompleteTaskRequest) (*CompleteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ReopenTask(context.Context, *ReopenTaskRequest) (*ReopenTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ArchiveCompletedTasks(context.Context, *ArchiveCompletedTasksRequest) (*ArchiveCompletedTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCompletedTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) ArchiveTasksByTag(context.Context, *ArchiveTasksByTagRequest) (*ArchiveTasksByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTasksByTag not implemented")
+}
+func (UnimplementedTaskServiceServer) UnarchiveTasksByTag(context.Context, *UnarchiveTasksByTagRequest) (*UnarchiveTasksByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveTasksByTag not implemented")
+}
+func (UnimplementedTaskServiceServer) RolloverOverdueTasks(context.Context, *RolloverOverdueTasksRequest) (*RolloverOverdueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloverOverdueTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskSettings(context.Context, *GetTaskSettingsRequest) (*GetTaskSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskSettings not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateTaskSettings(context.Context, *UpdateTaskSettingsRequest) (*UpdateTaskSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskSettings not implemented")
+}
+func (UnimplementedTaskServiceServer) GetCounters(context.Context, *GetCountersRequest) (*GetCountersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCounters not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskStats(context.Context, *GetTaskStatsRequest) (*GetTaskStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskStats not implemented")
+}
+func (UnimplementedTaskServiceServer) GenerateWeeklyReview(context.Context, *GenerateWeeklyReviewRequest) (*GenerateWeeklyReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateWeeklyReview not implemented")
+}
+func (UnimplementedTaskServiceServer) ListStaleTasks(context.Context, *ListStaleTasksRequest) (*ListStaleTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaleTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) SetTaskGeofence(context.Context, *SetTaskGeofenceRequest) (*SetTaskGeofenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaskGeofence not implemented")
+}
+func (UnimplementedTaskServiceServer) MarkTaskViewed(context.Context, *MarkTaskViewedRequest) (*MarkTaskViewedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkTaskViewed not implemented")
+}
+func (UnimplementedTaskServiceServer) AddTagToTasks(context.Context, *AddTagToTasksRequest) (*AddTagToTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTagToTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) RemoveTagFromTasks(context.Context, *RemoveTagFromTasksRequest) (*RemoveTagFromTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTagFromTasks not implemented")
+}
 func (UnimplementedTaskServiceServer) AddChecklistItem(context.Context, *AddChecklistItemRequest) (*AddChecklistItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddChecklistItem not implemented")
 }
@@ -245,6 +725,18 @@ func (UnimplementedTaskServiceServer) DeleteChecklistItem(context.Context, *Dele
 func (UnimplementedTaskServiceServer) ReorderChecklistItems(context.Context, *ReorderChecklistItemsRequest) (*ReorderChecklistItemsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReorderChecklistItems not implemented")
 }
+func (UnimplementedTaskServiceServer) ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNoteRevisions not implemented")
+}
+func (UnimplementedTaskServiceServer) RestoreNoteRevision(context.Context, *RestoreNoteRevisionRequest) (*RestoreNoteRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNoteRevision not implemented")
+}
+func (UnimplementedTaskServiceServer) ApplyMutations(context.Context, *ApplyMutationsRequest) (*ApplyMutationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyMutations not implemented")
+}
+func (UnimplementedTaskServiceServer) TransferTasks(context.Context, *TransferTasksRequest) (*TransferTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTasks not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -302,6 +794,24 @@ func _TaskService_GetTask_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_BatchGetTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BatchGetTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchGetTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchGetTasks(ctx, req.(*BatchGetTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskService_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTaskRequest)
 	if err := dec(in); err != nil {
@@ -356,6 +866,46 @@ func _TaskService_ListTasks_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_StreamTasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTasksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServiceServer).StreamTasks(m, &grpc.GenericServerStream[StreamTasksRequest, StreamTasksResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_StreamTasksServer = grpc.ServerStreamingServer[StreamTasksResponse]
+
+func _TaskService_WatchChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServiceServer).WatchChanges(m, &grpc.GenericServerStream[WatchChangesRequest, WatchChangesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchChangesServer = grpc.ServerStreamingServer[WatchChangesResponse]
+
+func _TaskService_ListTasksByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTasksByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTasksByFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTasksByFilter(ctx, req.(*ListTasksByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskService_ArchiveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArchiveTaskRequest)
 	if err := dec(in); err != nil {
@@ -392,53 +942,359 @@ func _TaskService_UnarchiveTask_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_AddChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddChecklistItemRequest)
+func _TaskService_TogglePinTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TogglePinTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).AddChecklistItem(ctx, in)
+		return srv.(TaskServiceServer).TogglePinTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_AddChecklistItem_FullMethodName,
+		FullMethod: TaskService_TogglePinTask_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).AddChecklistItem(ctx, req.(*AddChecklistItemRequest))
+		return srv.(TaskServiceServer).TogglePinTask(ctx, req.(*TogglePinTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_UpdateChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateChecklistItemRequest)
+func _TaskService_CompleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).UpdateChecklistItem(ctx, in)
+		return srv.(TaskServiceServer).CompleteTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_UpdateChecklistItem_FullMethodName,
+		FullMethod: TaskService_CompleteTask_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).UpdateChecklistItem(ctx, req.(*UpdateChecklistItemRequest))
+		return srv.(TaskServiceServer).CompleteTask(ctx, req.(*CompleteTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_SetChecklistItemCompleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetChecklistItemCompletedRequest)
+func _TaskService_ReopenTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).SetChecklistItemCompleted(ctx, in)
+		return srv.(TaskServiceServer).ReopenTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_SetChecklistItemCompleted_FullMethodName,
+		FullMethod: TaskService_ReopenTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReopenTask(ctx, req.(*ReopenTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ArchiveCompletedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCompletedTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ArchiveCompletedTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ArchiveCompletedTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ArchiveCompletedTasks(ctx, req.(*ArchiveCompletedTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ArchiveTasksByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveTasksByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ArchiveTasksByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ArchiveTasksByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ArchiveTasksByTag(ctx, req.(*ArchiveTasksByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UnarchiveTasksByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveTasksByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UnarchiveTasksByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UnarchiveTasksByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UnarchiveTasksByTag(ctx, req.(*UnarchiveTasksByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RolloverOverdueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloverOverdueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RolloverOverdueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RolloverOverdueTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RolloverOverdueTasks(ctx, req.(*RolloverOverdueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTaskSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskSettings(ctx, req.(*GetTaskSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateTaskSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateTaskSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateTaskSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateTaskSettings(ctx, req.(*UpdateTaskSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCountersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetCounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetCounters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetCounters(ctx, req.(*GetCountersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTaskStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskStats(ctx, req.(*GetTaskStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GenerateWeeklyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateWeeklyReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GenerateWeeklyReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GenerateWeeklyReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GenerateWeeklyReview(ctx, req.(*GenerateWeeklyReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListStaleTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaleTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListStaleTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListStaleTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListStaleTasks(ctx, req.(*ListStaleTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_SetTaskGeofence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaskGeofenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).SetTaskGeofence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_SetTaskGeofence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).SetTaskGeofence(ctx, req.(*SetTaskGeofenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_MarkTaskViewed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkTaskViewedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).MarkTaskViewed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_MarkTaskViewed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).MarkTaskViewed(ctx, req.(*MarkTaskViewedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_AddTagToTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTagToTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).AddTagToTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_AddTagToTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddTagToTasks(ctx, req.(*AddTagToTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RemoveTagFromTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTagFromTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RemoveTagFromTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RemoveTagFromTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RemoveTagFromTasks(ctx, req.(*RemoveTagFromTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_AddChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).AddChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_AddChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddChecklistItem(ctx, req.(*AddChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateChecklistItem(ctx, req.(*UpdateChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_SetChecklistItemCompleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChecklistItemCompletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).SetChecklistItemCompleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_SetChecklistItemCompleted_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(TaskServiceServer).SetChecklistItemCompleted(ctx, req.(*SetChecklistItemCompletedRequest))
@@ -482,6 +1338,78 @@ func _TaskService_ReorderChecklistItems_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_ListNoteRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNoteRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListNoteRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListNoteRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListNoteRevisions(ctx, req.(*ListNoteRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RestoreNoteRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNoteRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RestoreNoteRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RestoreNoteRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RestoreNoteRevision(ctx, req.(*RestoreNoteRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ApplyMutations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyMutationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ApplyMutations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ApplyMutations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ApplyMutations(ctx, req.(*ApplyMutationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_TransferTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).TransferTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_TransferTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).TransferTasks(ctx, req.(*TransferTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,6 +1425,10 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTask",
 			Handler:    _TaskService_GetTask_Handler,
 		},
+		{
+			MethodName: "BatchGetTasks",
+			Handler:    _TaskService_BatchGetTasks_Handler,
+		},
 		{
 			MethodName: "UpdateTask",
 			Handler:    _TaskService_UpdateTask_Handler,
@@ -509,6 +1441,10 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTasks",
 			Handler:    _TaskService_ListTasks_Handler,
 		},
+		{
+			MethodName: "ListTasksByFilter",
+			Handler:    _TaskService_ListTasksByFilter_Handler,
+		},
 		{
 			MethodName: "ArchiveTask",
 			Handler:    _TaskService_ArchiveTask_Handler,
@@ -517,6 +1453,74 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnarchiveTask",
 			Handler:    _TaskService_UnarchiveTask_Handler,
 		},
+		{
+			MethodName: "TogglePinTask",
+			Handler:    _TaskService_TogglePinTask_Handler,
+		},
+		{
+			MethodName: "CompleteTask",
+			Handler:    _TaskService_CompleteTask_Handler,
+		},
+		{
+			MethodName: "ReopenTask",
+			Handler:    _TaskService_ReopenTask_Handler,
+		},
+		{
+			MethodName: "ArchiveCompletedTasks",
+			Handler:    _TaskService_ArchiveCompletedTasks_Handler,
+		},
+		{
+			MethodName: "ArchiveTasksByTag",
+			Handler:    _TaskService_ArchiveTasksByTag_Handler,
+		},
+		{
+			MethodName: "UnarchiveTasksByTag",
+			Handler:    _TaskService_UnarchiveTasksByTag_Handler,
+		},
+		{
+			MethodName: "RolloverOverdueTasks",
+			Handler:    _TaskService_RolloverOverdueTasks_Handler,
+		},
+		{
+			MethodName: "GetTaskSettings",
+			Handler:    _TaskService_GetTaskSettings_Handler,
+		},
+		{
+			MethodName: "UpdateTaskSettings",
+			Handler:    _TaskService_UpdateTaskSettings_Handler,
+		},
+		{
+			MethodName: "GetCounters",
+			Handler:    _TaskService_GetCounters_Handler,
+		},
+		{
+			MethodName: "GetTaskStats",
+			Handler:    _TaskService_GetTaskStats_Handler,
+		},
+		{
+			MethodName: "GenerateWeeklyReview",
+			Handler:    _TaskService_GenerateWeeklyReview_Handler,
+		},
+		{
+			MethodName: "ListStaleTasks",
+			Handler:    _TaskService_ListStaleTasks_Handler,
+		},
+		{
+			MethodName: "SetTaskGeofence",
+			Handler:    _TaskService_SetTaskGeofence_Handler,
+		},
+		{
+			MethodName: "MarkTaskViewed",
+			Handler:    _TaskService_MarkTaskViewed_Handler,
+		},
+		{
+			MethodName: "AddTagToTasks",
+			Handler:    _TaskService_AddTagToTasks_Handler,
+		},
+		{
+			MethodName: "RemoveTagFromTasks",
+			Handler:    _TaskService_RemoveTagFromTasks_Handler,
+		},
 		{
 			MethodName: "AddChecklistItem",
 			Handler:    _TaskService_AddChecklistItem_Handler,
@@ -537,7 +1541,34 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReorderChecklistItems",
 			Handler:    _TaskService_ReorderChecklistItems_Handler,
 		},
+		{
+			MethodName: "ListNoteRevisions",
+			Handler:    _TaskService_ListNoteRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreNoteRevision",
+			Handler:    _TaskService_RestoreNoteRevision_Handler,
+		},
+		{
+			MethodName: "ApplyMutations",
+			Handler:    _TaskService_ApplyMutations_Handler,
+		},
+		{
+			MethodName: "TransferTasks",
+			Handler:    _TaskService_TransferTasks_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTasks",
+			Handler:       _TaskService_StreamTasks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchChanges",
+			Handler:       _TaskService_WatchChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "task/v1/task.proto",
 }
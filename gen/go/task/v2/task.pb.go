@@ -0,0 +1,2086 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: task/v2/task.proto
+
+package taskv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Schedule describes when a task is meant to be worked on
+type Schedule int32
+
+const (
+	Schedule_SCHEDULE_UNSPECIFIED Schedule = 0 // treated as INBOX on create, ignored in list filters
+	Schedule_SCHEDULE_INBOX       Schedule = 1 // no start date
+	Schedule_SCHEDULE_DATED       Schedule = 2 // start_date is set
+)
+
+// Enum value maps for Schedule.
+var (
+	Schedule_name = map[int32]string{
+		0: "SCHEDULE_UNSPECIFIED",
+		1: "SCHEDULE_INBOX",
+		2: "SCHEDULE_DATED",
+	}
+	Schedule_value = map[string]int32{
+		"SCHEDULE_UNSPECIFIED": 0,
+		"SCHEDULE_INBOX":       1,
+		"SCHEDULE_DATED":       2,
+	}
+)
+
+func (x Schedule) Enum() *Schedule {
+	p := new(Schedule)
+	*p = x
+	return p
+}
+
+func (x Schedule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Schedule) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v2_task_proto_enumTypes[0].Descriptor()
+}
+
+func (Schedule) Type() protoreflect.EnumType {
+	return &file_task_v2_task_proto_enumTypes[0]
+}
+
+func (x Schedule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Schedule.Descriptor instead.
+func (Schedule) EnumDescriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{0}
+}
+
+// TaskState is the completion state of a task
+type TaskState int32
+
+const (
+	TaskState_TASK_STATE_UNSPECIFIED TaskState = 0
+	TaskState_TASK_STATE_OPEN        TaskState = 1
+	TaskState_TASK_STATE_COMPLETED   TaskState = 2
+)
+
+// Enum value maps for TaskState.
+var (
+	TaskState_name = map[int32]string{
+		0: "TASK_STATE_UNSPECIFIED",
+		1: "TASK_STATE_OPEN",
+		2: "TASK_STATE_COMPLETED",
+	}
+	TaskState_value = map[string]int32{
+		"TASK_STATE_UNSPECIFIED": 0,
+		"TASK_STATE_OPEN":        1,
+		"TASK_STATE_COMPLETED":   2,
+	}
+)
+
+func (x TaskState) Enum() *TaskState {
+	p := new(TaskState)
+	*p = x
+	return p
+}
+
+func (x TaskState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskState) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v2_task_proto_enumTypes[1].Descriptor()
+}
+
+func (TaskState) Type() protoreflect.EnumType {
+	return &file_task_v2_task_proto_enumTypes[1]
+}
+
+func (x TaskState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskState.Descriptor instead.
+func (TaskState) EnumDescriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{1}
+}
+
+// ArchiveFilter selects archived tasks in ListTasks
+type ArchiveFilter int32
+
+const (
+	ArchiveFilter_ARCHIVE_FILTER_UNSPECIFIED ArchiveFilter = 0 // treated as EXCLUDE
+	ArchiveFilter_ARCHIVE_FILTER_EXCLUDE     ArchiveFilter = 1
+	ArchiveFilter_ARCHIVE_FILTER_INCLUDE     ArchiveFilter = 2
+	ArchiveFilter_ARCHIVE_FILTER_ONLY        ArchiveFilter = 3
+)
+
+// Enum value maps for ArchiveFilter.
+var (
+	ArchiveFilter_name = map[int32]string{
+		0: "ARCHIVE_FILTER_UNSPECIFIED",
+		1: "ARCHIVE_FILTER_EXCLUDE",
+		2: "ARCHIVE_FILTER_INCLUDE",
+		3: "ARCHIVE_FILTER_ONLY",
+	}
+	ArchiveFilter_value = map[string]int32{
+		"ARCHIVE_FILTER_UNSPECIFIED": 0,
+		"ARCHIVE_FILTER_EXCLUDE":     1,
+		"ARCHIVE_FILTER_INCLUDE":     2,
+		"ARCHIVE_FILTER_ONLY":        3,
+	}
+)
+
+func (x ArchiveFilter) Enum() *ArchiveFilter {
+	p := new(ArchiveFilter)
+	*p = x
+	return p
+}
+
+func (x ArchiveFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v2_task_proto_enumTypes[2].Descriptor()
+}
+
+func (ArchiveFilter) Type() protoreflect.EnumType {
+	return &file_task_v2_task_proto_enumTypes[2]
+}
+
+func (x ArchiveFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveFilter.Descriptor instead.
+func (ArchiveFilter) EnumDescriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{2}
+}
+
+// TagMatchMode controls how multiple filter tags are combined
+type TagMatchMode int32
+
+const (
+	TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED TagMatchMode = 0 // the user's default, ANY unless set
+	TagMatchMode_TAG_MATCH_MODE_ANY         TagMatchMode = 1
+	TagMatchMode_TAG_MATCH_MODE_ALL         TagMatchMode = 2
+)
+
+// Enum value maps for TagMatchMode.
+var (
+	TagMatchMode_name = map[int32]string{
+		0: "TAG_MATCH_MODE_UNSPECIFIED",
+		1: "TAG_MATCH_MODE_ANY",
+		2: "TAG_MATCH_MODE_ALL",
+	}
+	TagMatchMode_value = map[string]int32{
+		"TAG_MATCH_MODE_UNSPECIFIED": 0,
+		"TAG_MATCH_MODE_ANY":         1,
+		"TAG_MATCH_MODE_ALL":         2,
+	}
+)
+
+func (x TagMatchMode) Enum() *TagMatchMode {
+	p := new(TagMatchMode)
+	*p = x
+	return p
+}
+
+func (x TagMatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagMatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v2_task_proto_enumTypes[3].Descriptor()
+}
+
+func (TagMatchMode) Type() protoreflect.EnumType {
+	return &file_task_v2_task_proto_enumTypes[3]
+}
+
+func (x TagMatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagMatchMode.Descriptor instead.
+func (TagMatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{3}
+}
+
+// Date is a calendar date without a time zone
+type Date struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"` // 1-12
+	Day           int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`     // 1-31
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Date) Reset() {
+	*x = Date{}
+	mi := &file_task_v2_task_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Date) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Date) ProtoMessage() {}
+
+func (x *Date) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Date.ProtoReflect.Descriptor instead.
+func (*Date) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Date) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Date) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *Date) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+// Task represents a task entity
+type Task struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // users/{user}/tasks/{task}
+	Title                   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Notes                   string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags                    []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"` // output only, users/{user}/tags/{tag}
+	Schedule                Schedule               `protobuf:"varint,5,opt,name=schedule,proto3,enum=task.v2.Schedule" json:"schedule,omitempty"`
+	StartDate               *Date                  `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                                               // set when schedule is SCHEDULE_DATED
+	Deadline                *Date                  `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`                                                                  // unset means no deadline
+	DaysRemaining           *wrapperspb.Int32Value `protobuf:"bytes,8,opt,name=days_remaining,json=daysRemaining,proto3" json:"days_remaining,omitempty"`                                   // output only, negative when overdue, unset without deadline
+	Pinned                  bool                   `protobuf:"varint,9,opt,name=pinned,proto3" json:"pinned,omitempty"`                                                                     // output only, see v1 TogglePinTask
+	State                   TaskState              `protobuf:"varint,10,opt,name=state,proto3,enum=task.v2.TaskState" json:"state,omitempty"`                                               // output only, see CompleteTask and ReopenTask
+	Archived                bool                   `protobuf:"varint,11,opt,name=archived,proto3" json:"archived,omitempty"`                                                                // output only, see ArchiveTask and UnarchiveTask
+	ChecklistItems          []*ChecklistItem       `protobuf:"bytes,12,rep,name=checklist_items,json=checklistItems,proto3" json:"checklist_items,omitempty"`                               // output only, only set by GetTask and CreateTask
+	ChecklistTotalCount     int32                  `protobuf:"varint,13,opt,name=checklist_total_count,json=checklistTotalCount,proto3" json:"checklist_total_count,omitempty"`             // output only
+	ChecklistCompletedCount int32                  `protobuf:"varint,14,opt,name=checklist_completed_count,json=checklistCompletedCount,proto3" json:"checklist_completed_count,omitempty"` // output only
+	CreateTime              *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                                           // output only
+	UpdateTime              *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`                                           // output only
+	CompleteTime            *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=complete_time,json=completeTime,proto3" json:"complete_time,omitempty"`                                     // output only
+	ArchiveTime             *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=archive_time,json=archiveTime,proto3" json:"archive_time,omitempty"`                                        // output only
+	Context                 string                 `protobuf:"bytes,19,opt,name=context,proto3" json:"context,omitempty"`                                                                   // GTD context such as "@home", empty for none
+	ViewTime                *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=view_time,json=viewTime,proto3" json:"view_time,omitempty"`                                                 // output only, when the user last opened the task
+	New                     bool                   `protobuf:"varint,21,opt,name=new,proto3" json:"new,omitempty"`                                                                          // output only, added by an agent or the server and not opened yet
+	UpdatedSinceViewed      bool                   `protobuf:"varint,22,opt,name=updated_since_viewed,json=updatedSinceViewed,proto3" json:"updated_since_viewed,omitempty"`                // output only, changed by an agent or the server since view_time
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_task_v2_task_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Task) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Task) GetSchedule() Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return Schedule_SCHEDULE_UNSPECIFIED
+}
+
+func (x *Task) GetStartDate() *Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Task) GetDeadline() *Date {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *Task) GetDaysRemaining() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.DaysRemaining
+	}
+	return nil
+}
+
+func (x *Task) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Task) GetState() TaskState {
+	if x != nil {
+		return x.State
+	}
+	return TaskState_TASK_STATE_UNSPECIFIED
+}
+
+func (x *Task) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Task) GetChecklistItems() []*ChecklistItem {
+	if x != nil {
+		return x.ChecklistItems
+	}
+	return nil
+}
+
+func (x *Task) GetChecklistTotalCount() int32 {
+	if x != nil {
+		return x.ChecklistTotalCount
+	}
+	return 0
+}
+
+func (x *Task) GetChecklistCompletedCount() int32 {
+	if x != nil {
+		return x.ChecklistCompletedCount
+	}
+	return 0
+}
+
+func (x *Task) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Task) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Task) GetCompleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompleteTime
+	}
+	return nil
+}
+
+func (x *Task) GetArchiveTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchiveTime
+	}
+	return nil
+}
+
+func (x *Task) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *Task) GetViewTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ViewTime
+	}
+	return nil
+}
+
+func (x *Task) GetNew() bool {
+	if x != nil {
+		return x.New
+	}
+	return false
+}
+
+func (x *Task) GetUpdatedSinceViewed() bool {
+	if x != nil {
+		return x.UpdatedSinceViewed
+	}
+	return false
+}
+
+// ChecklistItem represents one checklist row under a task
+type ChecklistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // users/{user}/tasks/{task}/checklistItems/{item}
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Completed     bool                   `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`   // output only
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // output only
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"` // output only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistItem) Reset() {
+	*x = ChecklistItem{}
+	mi := &file_task_v2_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistItem) ProtoMessage() {}
+
+func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistItem.ProtoReflect.Descriptor instead.
+func (*ChecklistItem) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChecklistItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *ChecklistItem) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *ChecklistItem) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ChecklistItem) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// CreateTaskRequest is the request message for creating a task
+type CreateTaskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Task           *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`                                           // title is required; name and output only fields are ignored
+	TagNames       []string               `protobuf:"bytes,2,rep,name=tag_names,json=tagNames,proto3" json:"tag_names,omitempty"`                   // tags are created when missing
+	ChecklistItems []string               `protobuf:"bytes,3,rep,name=checklist_items,json=checklistItems,proto3" json:"checklist_items,omitempty"` // contents of the initial checklist, in order
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTaskRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetTagNames() []string {
+	if x != nil {
+		return x.TagNames
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetChecklistItems() []string {
+	if x != nil {
+		return x.ChecklistItems
+	}
+	return nil
+}
+
+// CreateTaskResponse is the response message for creating a task
+type CreateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// GetTaskRequest is the request message for getting a task
+type GetTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetTaskResponse is the response message for getting a task
+type GetTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskResponse) Reset() {
+	*x = GetTaskResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskResponse) ProtoMessage() {}
+
+func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// UpdateTaskRequest is the request message for updating a task.
+// Supported update_mask paths: title, notes, schedule, start_date, deadline,
+// context and tag_names. tag_names refers to the field of this request because
+// Task.tags is output only. An empty mask is rejected.
+type UpdateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"` // task.name selects the task
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	TagNames      []string               `protobuf:"bytes,3,rep,name=tag_names,json=tagNames,proto3" json:"tag_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateTaskRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetTagNames() []string {
+	if x != nil {
+		return x.TagNames
+	}
+	return nil
+}
+
+// UpdateTaskResponse is the response message for updating a task
+type UpdateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// DeleteTaskRequest is the request message for deleting a task
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ValidateOnly  bool                   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"` // return the task without deleting it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteTaskRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+// DeleteTaskResponse is the response message for deleting a task
+type DeleteTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task is the task that would be deleted; set only with validate_only,
+	// and unset when there is no such task, which deleting ignores
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// approval_id is set instead of deleting when the call uses an MCP token
+	// and the user requires approval of agent deletions; the task is deleted
+	// once the user approves it with ApprovalService.ApproveAction
+	ApprovalId    string `protobuf:"bytes,2,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *DeleteTaskResponse) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+// ListTasksRequest is the request message for listing tasks
+type ListTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // defaults to the user's default or 30, at most 100
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // not supported yet
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`                            // users/{user}/tags/{tag}
+	TagMatchMode  TagMatchMode           `protobuf:"varint,4,opt,name=tag_match_mode,json=tagMatchMode,proto3,enum=task.v2.TagMatchMode" json:"tag_match_mode,omitempty"`
+	ArchiveFilter ArchiveFilter          `protobuf:"varint,5,opt,name=archive_filter,json=archiveFilter,proto3,enum=task.v2.ArchiveFilter" json:"archive_filter,omitempty"`
+	Schedule      Schedule               `protobuf:"varint,6,opt,name=schedule,proto3,enum=task.v2.Schedule" json:"schedule,omitempty"` // only tasks with this schedule
+	Contexts      []string               `protobuf:"bytes,7,rep,name=contexts,proto3" json:"contexts,omitempty"`                        // only tasks in any of these contexts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetTagMatchMode() TagMatchMode {
+	if x != nil {
+		return x.TagMatchMode
+	}
+	return TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetArchiveFilter() ArchiveFilter {
+	if x != nil {
+		return x.ArchiveFilter
+	}
+	return ArchiveFilter_ARCHIVE_FILTER_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetSchedule() Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return Schedule_SCHEDULE_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetContexts() []string {
+	if x != nil {
+		return x.Contexts
+	}
+	return nil
+}
+
+// ListTasksResponse is the response message for listing tasks
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTasksResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// ArchiveTaskRequest is the request message for archiving a task
+type ArchiveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskRequest) Reset() {
+	*x = ArchiveTaskRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskRequest) ProtoMessage() {}
+
+func (x *ArchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ArchiveTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ArchiveTaskResponse is the response message for archiving a task
+type ArchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskResponse) Reset() {
+	*x = ArchiveTaskResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskResponse) ProtoMessage() {}
+
+func (x *ArchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ArchiveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// UnarchiveTaskRequest is the request message for unarchiving a task
+type UnarchiveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskRequest) Reset() {
+	*x = UnarchiveTaskRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskRequest) ProtoMessage() {}
+
+func (x *UnarchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UnarchiveTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// UnarchiveTaskResponse is the response message for unarchiving a task
+type UnarchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskResponse) Reset() {
+	*x = UnarchiveTaskResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskResponse) ProtoMessage() {}
+
+func (x *UnarchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnarchiveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// CompleteTaskRequest is the request message for completing a task
+type CompleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskRequest) Reset() {
+	*x = CompleteTaskRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskRequest) ProtoMessage() {}
+
+func (x *CompleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CompleteTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CompleteTaskResponse is the response message for completing a task
+type CompleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskResponse) Reset() {
+	*x = CompleteTaskResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskResponse) ProtoMessage() {}
+
+func (x *CompleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompleteTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// ReopenTaskRequest is the request message for reopening a completed task
+type ReopenTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTaskRequest) Reset() {
+	*x = ReopenTaskRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTaskRequest) ProtoMessage() {}
+
+func (x *ReopenTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTaskRequest.ProtoReflect.Descriptor instead.
+func (*ReopenTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReopenTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ReopenTaskResponse is the response message for reopening a completed task
+type ReopenTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTaskResponse) Reset() {
+	*x = ReopenTaskResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTaskResponse) ProtoMessage() {}
+
+func (x *ReopenTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTaskResponse.ProtoReflect.Descriptor instead.
+func (*ReopenTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReopenTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// CreateChecklistItemRequest adds a checklist item to the end of a task's checklist
+type CreateChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parent        string                 `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"` // users/{user}/tasks/{task}
+	ChecklistItem *ChecklistItem         `protobuf:"bytes,2,opt,name=checklist_item,json=checklistItem,proto3" json:"checklist_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChecklistItemRequest) Reset() {
+	*x = CreateChecklistItemRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChecklistItemRequest) ProtoMessage() {}
+
+func (x *CreateChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateChecklistItemRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateChecklistItemRequest) GetChecklistItem() *ChecklistItem {
+	if x != nil {
+		return x.ChecklistItem
+	}
+	return nil
+}
+
+// CreateChecklistItemResponse returns the created checklist item
+type CreateChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistItem *ChecklistItem         `protobuf:"bytes,1,opt,name=checklist_item,json=checklistItem,proto3" json:"checklist_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChecklistItemResponse) Reset() {
+	*x = CreateChecklistItemResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChecklistItemResponse) ProtoMessage() {}
+
+func (x *CreateChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*CreateChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateChecklistItemResponse) GetChecklistItem() *ChecklistItem {
+	if x != nil {
+		return x.ChecklistItem
+	}
+	return nil
+}
+
+// UpdateChecklistItemRequest updates a checklist item.
+// Supported update_mask paths: content and completed.
+type UpdateChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistItem *ChecklistItem         `protobuf:"bytes,1,opt,name=checklist_item,json=checklistItem,proto3" json:"checklist_item,omitempty"` // checklist_item.name selects the item
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChecklistItemRequest) Reset() {
+	*x = UpdateChecklistItemRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChecklistItemRequest) ProtoMessage() {}
+
+func (x *UpdateChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateChecklistItemRequest) GetChecklistItem() *ChecklistItem {
+	if x != nil {
+		return x.ChecklistItem
+	}
+	return nil
+}
+
+func (x *UpdateChecklistItemRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateChecklistItemResponse returns the updated checklist item
+type UpdateChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChecklistItem *ChecklistItem         `protobuf:"bytes,1,opt,name=checklist_item,json=checklistItem,proto3" json:"checklist_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChecklistItemResponse) Reset() {
+	*x = UpdateChecklistItemResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChecklistItemResponse) ProtoMessage() {}
+
+func (x *UpdateChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateChecklistItemResponse) GetChecklistItem() *ChecklistItem {
+	if x != nil {
+		return x.ChecklistItem
+	}
+	return nil
+}
+
+// DeleteChecklistItemRequest deletes a checklist item
+type DeleteChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemRequest) Reset() {
+	*x = DeleteChecklistItemRequest{}
+	mi := &file_task_v2_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemRequest) ProtoMessage() {}
+
+func (x *DeleteChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteChecklistItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DeleteChecklistItemResponse indicates successful deletion
+type DeleteChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemResponse) Reset() {
+	*x = DeleteChecklistItemResponse{}
+	mi := &file_task_v2_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemResponse) ProtoMessage() {}
+
+func (x *DeleteChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v2_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_task_v2_task_proto_rawDescGZIP(), []int{26}
+}
+
+var File_task_v2_task_proto protoreflect.FileDescriptor
+
+const file_task_v2_task_proto_rawDesc = "" +
+	"\n" +
+	"\x12task/v2/task.proto\x12\atask.v2\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"B\n" +
+	"\x04Date\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\"\xc6\a\n" +
+	"\x04Task\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12-\n" +
+	"\bschedule\x18\x05 \x01(\x0e2\x11.task.v2.ScheduleR\bschedule\x12,\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\v2\r.task.v2.DateR\tstartDate\x12)\n" +
+	"\bdeadline\x18\a \x01(\v2\r.task.v2.DateR\bdeadline\x12B\n" +
+	"\x0edays_remaining\x18\b \x01(\v2\x1b.google.protobuf.Int32ValueR\rdaysRemaining\x12\x16\n" +
+	"\x06pinned\x18\t \x01(\bR\x06pinned\x12(\n" +
+	"\x05state\x18\n" +
+	" \x01(\x0e2\x12.task.v2.TaskStateR\x05state\x12\x1a\n" +
+	"\barchived\x18\v \x01(\bR\barchived\x12?\n" +
+	"\x0fchecklist_items\x18\f \x03(\v2\x16.task.v2.ChecklistItemR\x0echecklistItems\x122\n" +
+	"\x15checklist_total_count\x18\r \x01(\x05R\x13checklistTotalCount\x12:\n" +
+	"\x19checklist_completed_count\x18\x0e \x01(\x05R\x17checklistCompletedCount\x12;\n" +
+	"\vcreate_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12?\n" +
+	"\rcomplete_time\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\fcompleteTime\x12=\n" +
+	"\farchive_time\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\varchiveTime\x12\x18\n" +
+	"\acontext\x18\x13 \x01(\tR\acontext\x127\n" +
+	"\tview_time\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\bviewTime\x12\x10\n" +
+	"\x03new\x18\x15 \x01(\bR\x03new\x120\n" +
+	"\x14updated_since_viewed\x18\x16 \x01(\bR\x12updatedSinceViewed\"\xf4\x01\n" +
+	"\rChecklistItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\bR\tcompleted\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x05R\tsortOrder\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"|\n" +
+	"\x11CreateTaskRequest\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v2.TaskR\x04task\x12\x1b\n" +
+	"\ttag_names\x18\x02 \x03(\tR\btagNames\x12'\n" +
+	"\x0fchecklist_items\x18\x03 \x03(\tR\x0echecklistItems\"7\n" +
+	"\x12CreateTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v2.TaskR\x04task\"$\n" +
+	"\x0eGetTaskRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"4\n" +
+	"\x0fGetTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v2.TaskR\x04task\"\x90\x01\n" +
+	"\x11UpdateTaskRequest\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v2.TaskR\x04task\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x1b\n" +
+	"\ttag_names\x18\x03 \x03(\tR\btagNames\"7\n" +
+	"\x12UpdateTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v2.TaskR\x04task\"L\n" +
+	"\x11DeleteTaskRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rvalidate_only\x18\x02 \x01(\bR\fvalidateOnly\"X\n" +
+	"\x12DeleteTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v2.TaskR\x04task\x12\x1f\n" +
+	"\vapproval_id\x18\x02 \x01(\tR\n" +
+	"approvalId\"\xa9\x02\n" +
+	"\x10ListTasksRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12;\n" +
+	"\x0etag_match_mode\x18\x04 \x01(\x0e2\x15.task.v2.TagMatchModeR\ftagMatchMode\x12=\n" +
+	"\x0earchive_filter\x18\x05 \x01(\x0e2\x16.task.v2.ArchiveFilterR\rarchiveFilter\x12-\n" +
+	"\bschedule\x18\x06 \x01(\x0e2\x11.task.v2.ScheduleR\bschedule\x12\x1a\n" +
+	"\bcontexts\x18\a \x03(\tR\bcontexts\"\x7f\n" +
+	"\x11ListTasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.task.v2.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"(\n" +
+	"\x12ArchiveTaskRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"8\n" +
+	"\x13ArchiveTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v2.TaskR\x04task\"*\n" +
+	"\x14UnarchiveTaskRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\":\n" +
+	"\x15UnarchiveTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v2.TaskR\x04task\")\n" +
+	"\x13CompleteTaskRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"9\n" +
+	"\x14CompleteTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v2.TaskR\x04task\"'\n" +
+	"\x11ReopenTaskRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"7\n" +
+	"\x12ReopenTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v2.TaskR\x04task\"s\n" +
+	"\x1aCreateChecklistItemRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12=\n" +
+	"\x0echecklist_item\x18\x02 \x01(\v2\x16.task.v2.ChecklistItemR\rchecklistItem\"\\\n" +
+	"\x1bCreateChecklistItemResponse\x12=\n" +
+	"\x0echecklist_item\x18\x01 \x01(\v2\x16.task.v2.ChecklistItemR\rchecklistItem\"\x98\x01\n" +
+	"\x1aUpdateChecklistItemRequest\x12=\n" +
+	"\x0echecklist_item\x18\x01 \x01(\v2\x16.task.v2.ChecklistItemR\rchecklistItem\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\\\n" +
+	"\x1bUpdateChecklistItemResponse\x12=\n" +
+	"\x0echecklist_item\x18\x01 \x01(\v2\x16.task.v2.ChecklistItemR\rchecklistItem\"0\n" +
+	"\x1aDeleteChecklistItemRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x1d\n" +
+	"\x1bDeleteChecklistItemResponse*L\n" +
+	"\bSchedule\x12\x18\n" +
+	"\x14SCHEDULE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSCHEDULE_INBOX\x10\x01\x12\x12\n" +
+	"\x0eSCHEDULE_DATED\x10\x02*V\n" +
+	"\tTaskState\x12\x1a\n" +
+	"\x16TASK_STATE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fTASK_STATE_OPEN\x10\x01\x12\x18\n" +
+	"\x14TASK_STATE_COMPLETED\x10\x02*\x80\x01\n" +
+	"\rArchiveFilter\x12\x1e\n" +
+	"\x1aARCHIVE_FILTER_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ARCHIVE_FILTER_EXCLUDE\x10\x01\x12\x1a\n" +
+	"\x16ARCHIVE_FILTER_INCLUDE\x10\x02\x12\x17\n" +
+	"\x13ARCHIVE_FILTER_ONLY\x10\x03*^\n" +
+	"\fTagMatchMode\x12\x1e\n" +
+	"\x1aTAG_MATCH_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TAG_MATCH_MODE_ANY\x10\x01\x12\x16\n" +
+	"\x12TAG_MATCH_MODE_ALL\x10\x022\xb8\a\n" +
+	"\vTaskService\x12E\n" +
+	"\n" +
+	"CreateTask\x12\x1a.task.v2.CreateTaskRequest\x1a\x1b.task.v2.CreateTaskResponse\x12<\n" +
+	"\aGetTask\x12\x17.task.v2.GetTaskRequest\x1a\x18.task.v2.GetTaskResponse\x12E\n" +
+	"\n" +
+	"UpdateTask\x12\x1a.task.v2.UpdateTaskRequest\x1a\x1b.task.v2.UpdateTaskResponse\x12E\n" +
+	"\n" +
+	"DeleteTask\x12\x1a.task.v2.DeleteTaskRequest\x1a\x1b.task.v2.DeleteTaskResponse\x12B\n" +
+	"\tListTasks\x12\x19.task.v2.ListTasksRequest\x1a\x1a.task.v2.ListTasksResponse\x12H\n" +
+	"\vArchiveTask\x12\x1b.task.v2.ArchiveTaskRequest\x1a\x1c.task.v2.ArchiveTaskResponse\x12N\n" +
+	"\rUnarchiveTask\x12\x1d.task.v2.UnarchiveTaskRequest\x1a\x1e.task.v2.UnarchiveTaskResponse\x12K\n" +
+	"\fCompleteTask\x12\x1c.task.v2.CompleteTaskRequest\x1a\x1d.task.v2.CompleteTaskResponse\x12E\n" +
+	"\n" +
+	"ReopenTask\x12\x1a.task.v2.ReopenTaskRequest\x1a\x1b.task.v2.ReopenTaskResponse\x12`\n" +
+	"\x13CreateChecklistItem\x12#.task.v2.CreateChecklistItemRequest\x1a$.task.v2.CreateChecklistItemResponse\x12`\n" +
+	"\x13UpdateChecklistItem\x12#.task.v2.UpdateChecklistItemRequest\x1a$.task.v2.UpdateChecklistItemResponse\x12`\n" +
+	"\x13DeleteChecklistItem\x12#.task.v2.DeleteChecklistItemRequest\x1a$.task.v2.DeleteChecklistItemResponseB\x8b\x01\n" +
+	"\vcom.task.v2B\tTaskProtoP\x01Z4github.com/slips-ai/slips-core/gen/go/task/v2;taskv2\xa2\x02\x03TXX\xaa\x02\aTask.V2\xca\x02\aTask\\V2\xe2\x02\x13Task\\V2\\GPBMetadata\xea\x02\bTask::V2b\x06proto3"
+
+var (
+	file_task_v2_task_proto_rawDescOnce sync.Once
+	file_task_v2_task_proto_rawDescData []byte
+)
+
+func file_task_v2_task_proto_rawDescGZIP() []byte {
+	file_task_v2_task_proto_rawDescOnce.Do(func() {
+		file_task_v2_task_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_task_v2_task_proto_rawDesc), len(file_task_v2_task_proto_rawDesc)))
+	})
+	return file_task_v2_task_proto_rawDescData
+}
+
+var file_task_v2_task_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_task_v2_task_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_task_v2_task_proto_goTypes = []any{
+	(Schedule)(0),                       // 0: task.v2.Schedule
+	(TaskState)(0),                      // 1: task.v2.TaskState
+	(ArchiveFilter)(0),                  // 2: task.v2.ArchiveFilter
+	(TagMatchMode)(0),                   // 3: task.v2.TagMatchMode
+	(*Date)(nil),                        // 4: task.v2.Date
+	(*Task)(nil),                        // 5: task.v2.Task
+	(*ChecklistItem)(nil),               // 6: task.v2.ChecklistItem
+	(*CreateTaskRequest)(nil),           // 7: task.v2.CreateTaskRequest
+	(*CreateTaskResponse)(nil),          // 8: task.v2.CreateTaskResponse
+	(*GetTaskRequest)(nil),              // 9: task.v2.GetTaskRequest
+	(*GetTaskResponse)(nil),             // 10: task.v2.GetTaskResponse
+	(*UpdateTaskRequest)(nil),           // 11: task.v2.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),          // 12: task.v2.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),           // 13: task.v2.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),          // 14: task.v2.DeleteTaskResponse
+	(*ListTasksRequest)(nil),            // 15: task.v2.ListTasksRequest
+	(*ListTasksResponse)(nil),           // 16: task.v2.ListTasksResponse
+	(*ArchiveTaskRequest)(nil),          // 17: task.v2.ArchiveTaskRequest
+	(*ArchiveTaskResponse)(nil),         // 18: task.v2.ArchiveTaskResponse
+	(*UnarchiveTaskRequest)(nil),        // 19: task.v2.UnarchiveTaskRequest
+	(*UnarchiveTaskResponse)(nil),       // 20: task.v2.UnarchiveTaskResponse
+	(*CompleteTaskRequest)(nil),         // 21: task.v2.CompleteTaskRequest
+	(*CompleteTaskResponse)(nil),        // 22: task.v2.CompleteTaskResponse
+	(*ReopenTaskRequest)(nil),           // 23: task.v2.ReopenTaskRequest
+	(*ReopenTaskResponse)(nil),          // 24: task.v2.ReopenTaskResponse
+	(*CreateChecklistItemRequest)(nil),  // 25: task.v2.CreateChecklistItemRequest
+	(*CreateChecklistItemResponse)(nil), // 26: task.v2.CreateChecklistItemResponse
+	(*UpdateChecklistItemRequest)(nil),  // 27: task.v2.UpdateChecklistItemRequest
+	(*UpdateChecklistItemResponse)(nil), // 28: task.v2.UpdateChecklistItemResponse
+	(*DeleteChecklistItemRequest)(nil),  // 29: task.v2.DeleteChecklistItemRequest
+	(*DeleteChecklistItemResponse)(nil), // 30: task.v2.DeleteChecklistItemResponse
+	(*wrapperspb.Int32Value)(nil),       // 31: google.protobuf.Int32Value
+	(*timestamppb.Timestamp)(nil),       // 32: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 33: google.protobuf.FieldMask
+}
+var file_task_v2_task_proto_depIdxs = []int32{
+	0,  // 0: task.v2.Task.schedule:type_name -> task.v2.Schedule
+	4,  // 1: task.v2.Task.start_date:type_name -> task.v2.Date
+	4,  // 2: task.v2.Task.deadline:type_name -> task.v2.Date
+	31, // 3: task.v2.Task.days_remaining:type_name -> google.protobuf.Int32Value
+	1,  // 4: task.v2.Task.state:type_name -> task.v2.TaskState
+	6,  // 5: task.v2.Task.checklist_items:type_name -> task.v2.ChecklistItem
+	32, // 6: task.v2.Task.create_time:type_name -> google.protobuf.Timestamp
+	32, // 7: task.v2.Task.update_time:type_name -> google.protobuf.Timestamp
+	32, // 8: task.v2.Task.complete_time:type_name -> google.protobuf.Timestamp
+	32, // 9: task.v2.Task.archive_time:type_name -> google.protobuf.Timestamp
+	32, // 10: task.v2.Task.view_time:type_name -> google.protobuf.Timestamp
+	32, // 11: task.v2.ChecklistItem.create_time:type_name -> google.protobuf.Timestamp
+	32, // 12: task.v2.ChecklistItem.update_time:type_name -> google.protobuf.Timestamp
+	5,  // 13: task.v2.CreateTaskRequest.task:type_name -> task.v2.Task
+	5,  // 14: task.v2.CreateTaskResponse.task:type_name -> task.v2.Task
+	5,  // 15: task.v2.GetTaskResponse.task:type_name -> task.v2.Task
+	5,  // 16: task.v2.UpdateTaskRequest.task:type_name -> task.v2.Task
+	33, // 17: task.v2.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 18: task.v2.UpdateTaskResponse.task:type_name -> task.v2.Task
+	5,  // 19: task.v2.DeleteTaskResponse.task:type_name -> task.v2.Task
+	3,  // 20: task.v2.ListTasksRequest.tag_match_mode:type_name -> task.v2.TagMatchMode
+	2,  // 21: task.v2.ListTasksRequest.archive_filter:type_name -> task.v2.ArchiveFilter
+	0,  // 22: task.v2.ListTasksRequest.schedule:type_name -> task.v2.Schedule
+	5,  // 23: task.v2.ListTasksResponse.tasks:type_name -> task.v2.Task
+	5,  // 24: task.v2.ArchiveTaskResponse.task:type_name -> task.v2.Task
+	5,  // 25: task.v2.UnarchiveTaskResponse.task:type_name -> task.v2.Task
+	5,  // 26: task.v2.CompleteTaskResponse.task:type_name -> task.v2.Task
+	5,  // 27: task.v2.ReopenTaskResponse.task:type_name -> task.v2.Task
+	6,  // 28: task.v2.CreateChecklistItemRequest.checklist_item:type_name -> task.v2.ChecklistItem
+	6,  // 29: task.v2.CreateChecklistItemResponse.checklist_item:type_name -> task.v2.ChecklistItem
+	6,  // 30: task.v2.UpdateChecklistItemRequest.checklist_item:type_name -> task.v2.ChecklistItem
+	33, // 31: task.v2.UpdateChecklistItemRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 32: task.v2.UpdateChecklistItemResponse.checklist_item:type_name -> task.v2.ChecklistItem
+	7,  // 33: task.v2.TaskService.CreateTask:input_type -> task.v2.CreateTaskRequest
+	9,  // 34: task.v2.TaskService.GetTask:input_type -> task.v2.GetTaskRequest
+	11, // 35: task.v2.TaskService.UpdateTask:input_type -> task.v2.UpdateTaskRequest
+	13, // 36: task.v2.TaskService.DeleteTask:input_type -> task.v2.DeleteTaskRequest
+	15, // 37: task.v2.TaskService.ListTasks:input_type -> task.v2.ListTasksRequest
+	17, // 38: task.v2.TaskService.ArchiveTask:input_type -> task.v2.ArchiveTaskRequest
+	19, // 39: task.v2.TaskService.UnarchiveTask:input_type -> task.v2.UnarchiveTaskRequest
+	21, // 40: task.v2.TaskService.CompleteTask:input_type -> task.v2.CompleteTaskRequest
+	23, // 41: task.v2.TaskService.ReopenTask:input_type -> task.v2.ReopenTaskRequest
+	25, // 42: task.v2.TaskService.CreateChecklistItem:input_type -> task.v2.CreateChecklistItemRequest
+	27, // 43: task.v2.TaskService.UpdateChecklistItem:input_type -> task.v2.UpdateChecklistItemRequest
+	29, // 44: task.v2.TaskService.DeleteChecklistItem:input_type -> task.v2.DeleteChecklistItemRequest
+	8,  // 45: task.v2.TaskService.CreateTask:output_type -> task.v2.CreateTaskResponse
+	10, // 46: task.v2.TaskService.GetTask:output_type -> task.v2.GetTaskResponse
+	12, // 47: task.v2.TaskService.UpdateTask:output_type -> task.v2.UpdateTaskResponse
+	14, // 48: task.v2.TaskService.DeleteTask:output_type -> task.v2.DeleteTaskResponse
+	16, // 49: task.v2.TaskService.ListTasks:output_type -> task.v2.ListTasksResponse
+	18, // 50: task.v2.TaskService.ArchiveTask:output_type -> task.v2.ArchiveTaskResponse
+	20, // 51: task.v2.TaskService.UnarchiveTask:output_type -> task.v2.UnarchiveTaskResponse
+	22, // 52: task.v2.TaskService.CompleteTask:output_type -> task.v2.CompleteTaskResponse
+	24, // 53: task.v2.TaskService.ReopenTask:output_type -> task.v2.ReopenTaskResponse
+	26, // 54: task.v2.TaskService.CreateChecklistItem:output_type -> task.v2.CreateChecklistItemResponse
+	28, // 55: task.v2.TaskService.UpdateChecklistItem:output_type -> task.v2.UpdateChecklistItemResponse
+	30, // 56: task.v2.TaskService.DeleteChecklistItem:output_type -> task.v2.DeleteChecklistItemResponse
+	45, // [45:57] is the sub-list for method output_type
+	33, // [33:45] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_task_v2_task_proto_init() }
+func file_task_v2_task_proto_init() {
+	if File_task_v2_task_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_v2_task_proto_rawDesc), len(file_task_v2_task_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_task_v2_task_proto_goTypes,
+		DependencyIndexes: file_task_v2_task_proto_depIdxs,
+		EnumInfos:         file_task_v2_task_proto_enumTypes,
+		MessageInfos:      file_task_v2_task_proto_msgTypes,
+	}.Build()
+	File_task_v2_task_proto = out.File
+	file_task_v2_task_proto_goTypes = nil
+	file_task_v2_task_proto_depIdxs = nil
+}
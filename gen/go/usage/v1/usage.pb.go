@@ -0,0 +1,393 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: usage/v1/usage.proto
+
+package usagev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MethodClass groups RPCs by whether they may change data
+type MethodClass int32
+
+const (
+	MethodClass_METHOD_CLASS_UNSPECIFIED MethodClass = 0
+	MethodClass_METHOD_CLASS_READ        MethodClass = 1 // Get, List, Stream, Watch and other reads
+	MethodClass_METHOD_CLASS_WRITE       MethodClass = 2 // every other RPC
+)
+
+// Enum value maps for MethodClass.
+var (
+	MethodClass_name = map[int32]string{
+		0: "METHOD_CLASS_UNSPECIFIED",
+		1: "METHOD_CLASS_READ",
+		2: "METHOD_CLASS_WRITE",
+	}
+	MethodClass_value = map[string]int32{
+		"METHOD_CLASS_UNSPECIFIED": 0,
+		"METHOD_CLASS_READ":        1,
+		"METHOD_CLASS_WRITE":       2,
+	}
+)
+
+func (x MethodClass) Enum() *MethodClass {
+	p := new(MethodClass)
+	*p = x
+	return p
+}
+
+func (x MethodClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MethodClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_usage_v1_usage_proto_enumTypes[0].Descriptor()
+}
+
+func (MethodClass) Type() protoreflect.EnumType {
+	return &file_usage_v1_usage_proto_enumTypes[0]
+}
+
+func (x MethodClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MethodClass.Descriptor instead.
+func (MethodClass) EnumDescriptor() ([]byte, []int) {
+	return file_usage_v1_usage_proto_rawDescGZIP(), []int{0}
+}
+
+// AuthType is the credential calls were made with
+type AuthType int32
+
+const (
+	AuthType_AUTH_TYPE_UNSPECIFIED AuthType = 0
+	AuthType_AUTH_TYPE_APP         AuthType = 1 // a signed-in app, with a JWT
+	AuthType_AUTH_TYPE_AGENT       AuthType = 2 // an agent, with an MCP token
+)
+
+// Enum value maps for AuthType.
+var (
+	AuthType_name = map[int32]string{
+		0: "AUTH_TYPE_UNSPECIFIED",
+		1: "AUTH_TYPE_APP",
+		2: "AUTH_TYPE_AGENT",
+	}
+	AuthType_value = map[string]int32{
+		"AUTH_TYPE_UNSPECIFIED": 0,
+		"AUTH_TYPE_APP":         1,
+		"AUTH_TYPE_AGENT":       2,
+	}
+)
+
+func (x AuthType) Enum() *AuthType {
+	p := new(AuthType)
+	*p = x
+	return p
+}
+
+func (x AuthType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuthType) Descriptor() protoreflect.EnumDescriptor {
+	return file_usage_v1_usage_proto_enumTypes[1].Descriptor()
+}
+
+func (AuthType) Type() protoreflect.EnumType {
+	return &file_usage_v1_usage_proto_enumTypes[1]
+}
+
+func (x AuthType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuthType.Descriptor instead.
+func (AuthType) EnumDescriptor() ([]byte, []int) {
+	return file_usage_v1_usage_proto_rawDescGZIP(), []int{1}
+}
+
+// UsageRecord counts the calls of one UTC day in one method class made with
+// one auth type
+type UsageRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // format "YYYY-MM-DD"
+	MethodClass   MethodClass            `protobuf:"varint,2,opt,name=method_class,json=methodClass,proto3,enum=usage.v1.MethodClass" json:"method_class,omitempty"`
+	AuthType      AuthType               `protobuf:"varint,3,opt,name=auth_type,json=authType,proto3,enum=usage.v1.AuthType" json:"auth_type,omitempty"`
+	Requests      int64                  `protobuf:"varint,4,opt,name=requests,proto3" json:"requests,omitempty"`
+	RateLimited   int64                  `protobuf:"varint,5,opt,name=rate_limited,json=rateLimited,proto3" json:"rate_limited,omitempty"` // requests rejected with RESOURCE_EXHAUSTED by a rate limit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageRecord) Reset() {
+	*x = UsageRecord{}
+	mi := &file_usage_v1_usage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRecord) ProtoMessage() {}
+
+func (x *UsageRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_usage_v1_usage_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRecord.ProtoReflect.Descriptor instead.
+func (*UsageRecord) Descriptor() ([]byte, []int) {
+	return file_usage_v1_usage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UsageRecord) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetMethodClass() MethodClass {
+	if x != nil {
+		return x.MethodClass
+	}
+	return MethodClass_METHOD_CLASS_UNSPECIFIED
+}
+
+func (x *UsageRecord) GetAuthType() AuthType {
+	if x != nil {
+		return x.AuthType
+	}
+	return AuthType_AUTH_TYPE_UNSPECIFIED
+}
+
+func (x *UsageRecord) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetRateLimited() int64 {
+	if x != nil {
+		return x.RateLimited
+	}
+	return 0
+}
+
+// GetMyUsageRequest is the request message for getting the caller's usage
+type GetMyUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromDate      *string                `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3,oneof" json:"from_date,omitempty"` // format "YYYY-MM-DD", inclusive; defaults to 29 days before to_date
+	ToDate        *string                `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3,oneof" json:"to_date,omitempty"`       // format "YYYY-MM-DD", inclusive; defaults to today (UTC)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyUsageRequest) Reset() {
+	*x = GetMyUsageRequest{}
+	mi := &file_usage_v1_usage_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyUsageRequest) ProtoMessage() {}
+
+func (x *GetMyUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_usage_v1_usage_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetMyUsageRequest) Descriptor() ([]byte, []int) {
+	return file_usage_v1_usage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetMyUsageRequest) GetFromDate() string {
+	if x != nil && x.FromDate != nil {
+		return *x.FromDate
+	}
+	return ""
+}
+
+func (x *GetMyUsageRequest) GetToDate() string {
+	if x != nil && x.ToDate != nil {
+		return *x.ToDate
+	}
+	return ""
+}
+
+// GetMyUsageResponse is the response message for getting the caller's usage.
+// Days without calls have no records.
+type GetMyUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*UsageRecord         `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"` // ordered by date, method class and auth type
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyUsageResponse) Reset() {
+	*x = GetMyUsageResponse{}
+	mi := &file_usage_v1_usage_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyUsageResponse) ProtoMessage() {}
+
+func (x *GetMyUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_usage_v1_usage_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetMyUsageResponse) Descriptor() ([]byte, []int) {
+	return file_usage_v1_usage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMyUsageResponse) GetRecords() []*UsageRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+var File_usage_v1_usage_proto protoreflect.FileDescriptor
+
+const file_usage_v1_usage_proto_rawDesc = "" +
+	"\n" +
+	"\x14usage/v1/usage.proto\x12\busage.v1\"\xcb\x01\n" +
+	"\vUsageRecord\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x128\n" +
+	"\fmethod_class\x18\x02 \x01(\x0e2\x15.usage.v1.MethodClassR\vmethodClass\x12/\n" +
+	"\tauth_type\x18\x03 \x01(\x0e2\x12.usage.v1.AuthTypeR\bauthType\x12\x1a\n" +
+	"\brequests\x18\x04 \x01(\x03R\brequests\x12!\n" +
+	"\frate_limited\x18\x05 \x01(\x03R\vrateLimited\"m\n" +
+	"\x11GetMyUsageRequest\x12 \n" +
+	"\tfrom_date\x18\x01 \x01(\tH\x00R\bfromDate\x88\x01\x01\x12\x1c\n" +
+	"\ato_date\x18\x02 \x01(\tH\x01R\x06toDate\x88\x01\x01B\f\n" +
+	"\n" +
+	"_from_dateB\n" +
+	"\n" +
+	"\b_to_date\"E\n" +
+	"\x12GetMyUsageResponse\x12/\n" +
+	"\arecords\x18\x01 \x03(\v2\x15.usage.v1.UsageRecordR\arecords*Z\n" +
+	"\vMethodClass\x12\x1c\n" +
+	"\x18METHOD_CLASS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11METHOD_CLASS_READ\x10\x01\x12\x16\n" +
+	"\x12METHOD_CLASS_WRITE\x10\x02*M\n" +
+	"\bAuthType\x12\x19\n" +
+	"\x15AUTH_TYPE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rAUTH_TYPE_APP\x10\x01\x12\x13\n" +
+	"\x0fAUTH_TYPE_AGENT\x10\x022W\n" +
+	"\fUsageService\x12G\n" +
+	"\n" +
+	"GetMyUsage\x12\x1b.usage.v1.GetMyUsageRequest\x1a\x1c.usage.v1.GetMyUsageResponseB\x93\x01\n" +
+	"\fcom.usage.v1B\n" +
+	"UsageProtoP\x01Z6github.com/slips-ai/slips-core/gen/go/usage/v1;usagev1\xa2\x02\x03UXX\xaa\x02\bUsage.V1\xca\x02\bUsage\\V1\xe2\x02\x14Usage\\V1\\GPBMetadata\xea\x02\tUsage::V1b\x06proto3"
+
+var (
+	file_usage_v1_usage_proto_rawDescOnce sync.Once
+	file_usage_v1_usage_proto_rawDescData []byte
+)
+
+func file_usage_v1_usage_proto_rawDescGZIP() []byte {
+	file_usage_v1_usage_proto_rawDescOnce.Do(func() {
+		file_usage_v1_usage_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_usage_v1_usage_proto_rawDesc), len(file_usage_v1_usage_proto_rawDesc)))
+	})
+	return file_usage_v1_usage_proto_rawDescData
+}
+
+var file_usage_v1_usage_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_usage_v1_usage_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_usage_v1_usage_proto_goTypes = []any{
+	(MethodClass)(0),           // 0: usage.v1.MethodClass
+	(AuthType)(0),              // 1: usage.v1.AuthType
+	(*UsageRecord)(nil),        // 2: usage.v1.UsageRecord
+	(*GetMyUsageRequest)(nil),  // 3: usage.v1.GetMyUsageRequest
+	(*GetMyUsageResponse)(nil), // 4: usage.v1.GetMyUsageResponse
+}
+var file_usage_v1_usage_proto_depIdxs = []int32{
+	0, // 0: usage.v1.UsageRecord.method_class:type_name -> usage.v1.MethodClass
+	1, // 1: usage.v1.UsageRecord.auth_type:type_name -> usage.v1.AuthType
+	2, // 2: usage.v1.GetMyUsageResponse.records:type_name -> usage.v1.UsageRecord
+	3, // 3: usage.v1.UsageService.GetMyUsage:input_type -> usage.v1.GetMyUsageRequest
+	4, // 4: usage.v1.UsageService.GetMyUsage:output_type -> usage.v1.GetMyUsageResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_usage_v1_usage_proto_init() }
+func file_usage_v1_usage_proto_init() {
+	if File_usage_v1_usage_proto != nil {
+		return
+	}
+	file_usage_v1_usage_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_usage_v1_usage_proto_rawDesc), len(file_usage_v1_usage_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_usage_v1_usage_proto_goTypes,
+		DependencyIndexes: file_usage_v1_usage_proto_depIdxs,
+		EnumInfos:         file_usage_v1_usage_proto_enumTypes,
+		MessageInfos:      file_usage_v1_usage_proto_msgTypes,
+	}.Build()
+	File_usage_v1_usage_proto = out.File
+	file_usage_v1_usage_proto_goTypes = nil
+	file_usage_v1_usage_proto_depIdxs = nil
+}
@@ -0,0 +1,600 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/admin/domain"
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	mcptokendomain "github.com/slips-ai/slips-core/internal/mcptoken/domain"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	streakdomain "github.com/slips-ai/slips-core/internal/streak/domain"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+	"github.com/slips-ai/slips-core/pkg/database"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+	"github.com/slips-ai/slips-core/pkg/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("admin-service")
+
+// exportPageSize is the page size used when walking a user's data for export
+const exportPageSize = 100
+
+var (
+	// ErrPermissionDenied is returned when the caller is not a configured admin
+	ErrPermissionDenied = domainerrors.New(domainerrors.ErrPermissionDenied, "admin access required")
+	// ErrInvalidLogLevel is returned when SetLogLevel receives an unknown level
+	ErrInvalidLogLevel = errors.New("invalid log level: expected debug, info, warn or error")
+)
+
+// Service provides operator-only business logic
+type Service struct {
+	repo            domain.Repository
+	userRepo        authdomain.Repository
+	taskRepo        taskdomain.Repository
+	tagRepo         tagdomain.Repository
+	savedFilterRepo savedfilterdomain.Repository
+	streakRepo      streakdomain.Repository
+	tokenRepo       mcptokendomain.Repository
+	transfers       TaskTransferer
+	events          changefeed.Publisher
+	logger          *slog.Logger
+}
+
+// NewService creates a new admin service that publishes changes to user
+// data to events and hands tasks to other users through transfers. Only
+// callers holding the admin role (granted by the authorization layer) may
+// use it.
+func NewService(
+	repo domain.Repository,
+	userRepo authdomain.Repository,
+	taskRepo taskdomain.Repository,
+	tagRepo tagdomain.Repository,
+	savedFilterRepo savedfilterdomain.Repository,
+	streakRepo streakdomain.Repository,
+	tokenRepo mcptokendomain.Repository,
+	transfers TaskTransferer,
+	events changefeed.Publisher,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		repo:            repo,
+		userRepo:        userRepo,
+		taskRepo:        taskRepo,
+		tagRepo:         tagRepo,
+		savedFilterRepo: savedFilterRepo,
+		streakRepo:      streakRepo,
+		tokenRepo:       tokenRepo,
+		transfers:       transfers,
+		events:          events,
+		logger:          logger,
+	}
+}
+
+// ListUsers lists registered users
+func (s *Service) ListUsers(ctx context.Context, limit, offset int) ([]*authdomain.User, error) {
+	ctx, span := tracer.Start(ctx, "ListUsers", trace.WithAttributes(
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
+	))
+	defer span.End()
+
+	if _, err := s.requireAdmin(ctx); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	users, err := s.userRepo.ListUsers(ctx, limit, offset)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list users", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// GetUserStats returns a user together with counts of the data they own
+func (s *Service) GetUserStats(ctx context.Context, userID string) (*domain.UserStats, error) {
+	ctx, span := tracer.Start(ctx, "GetUserStats", trace.WithAttributes(
+		attribute.String("user_id", userID),
+	))
+	defer span.End()
+
+	if _, err := s.requireAdmin(ctx); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	// Row-level security must expose the inspected user's rows, not the admin's
+	ctx = database.WithSessionUser(ctx, userID)
+
+	user, err := s.userRepo.GetUserByUserID(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user", "user_id", userID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	counts, err := s.repo.CountUserData(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to count user data", "user_id", userID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &domain.UserStats{
+		User:   user,
+		Counts: counts,
+	}, nil
+}
+
+// RevokeMCPTokens revokes a user's active MCP tokens. When tokenID is set,
+// only that token is revoked and it must belong to the user.
+// It returns the number of tokens revoked.
+func (s *Service) RevokeMCPTokens(ctx context.Context, userID string, tokenID *uuid.UUID) (int, error) {
+	ctx, span := tracer.Start(ctx, "RevokeMCPTokens", trace.WithAttributes(
+		attribute.String("user_id", userID),
+		attribute.Bool("single_token", tokenID != nil),
+	))
+	defer span.End()
+
+	adminID, err := s.requireAdmin(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	var tokens []*mcptokendomain.MCPToken
+	if tokenID != nil {
+		token, err := s.tokenRepo.GetByID(ctx, *tokenID)
+		if errors.Is(err, mcptokendomain.ErrTokenNotFound) {
+			return 0, err
+		}
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to get MCP token", "id", *tokenID, "error", err)
+			span.RecordError(err)
+			return 0, err
+		}
+		if token.UserID != userID {
+			// Report a token owned by someone else as missing
+			return 0, mcptokendomain.ErrTokenNotFound
+		}
+		tokens = []*mcptokendomain.MCPToken{token}
+	} else {
+		tokens, err = s.tokenRepo.ListByUserID(ctx, userID)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to list MCP tokens", "user_id", userID, "error", err)
+			span.RecordError(err)
+			return 0, err
+		}
+	}
+
+	revoked := 0
+	for _, token := range tokens {
+		if !token.IsActive {
+			continue
+		}
+		if err := s.tokenRepo.Revoke(ctx, token.ID); err != nil {
+			s.logger.ErrorContext(ctx, "failed to revoke MCP token", "id", token.ID, "error", err)
+			span.RecordError(err)
+			return revoked, err
+		}
+		revoked++
+	}
+
+	s.logger.InfoContext(ctx, "admin revoked MCP tokens", "admin_id", adminID, "user_id", userID, "count", revoked)
+	return revoked, nil
+}
+
+// ExportUserData collects all of a user's tasks (including archived ones,
+// with checklists, geofences and note revisions), tags, saved filters,
+// settings and weekly goal
+func (s *Service) ExportUserData(ctx context.Context, userID string) (*domain.Export, error) {
+	ctx, span := tracer.Start(ctx, "ExportUserData", trace.WithAttributes(
+		attribute.String("user_id", userID),
+	))
+	defer span.End()
+
+	adminID, err := s.requireAdmin(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	ctx = database.WithSessionUser(ctx, userID)
+
+	export := &domain.Export{
+		UserID:        userID,
+		Tasks:         []*taskdomain.Task{},
+		Tags:          []*tagdomain.Tag{},
+		NoteRevisions: []taskdomain.NoteRevision{},
+		SavedFilters:  []*savedfilterdomain.SavedFilter{},
+	}
+
+	// Walk the tasks by ID, so tasks deleted meanwhile do not shift the pages
+	after := uuid.Nil
+	for {
+		tasks, err := s.taskRepo.ListAfter(ctx, userID, after, exportPageSize, taskdomain.ScanOptions{IncludeArchived: true})
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to list tasks for export", "user_id", userID, "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+		export.Tasks = append(export.Tasks, tasks...)
+
+		ids := make([]uuid.UUID, len(tasks))
+		for i, task := range tasks {
+			ids[i] = task.ID
+		}
+		revisions, err := s.taskRepo.ListNoteRevisionsForTasks(ctx, ids, userID)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to list note revisions for export", "user_id", userID, "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+		export.NoteRevisions = append(export.NoteRevisions, revisions...)
+
+		if len(tasks) < exportPageSize {
+			break
+		}
+		after = tasks[len(tasks)-1].ID
+	}
+
+	for offset := 0; ; offset += exportPageSize {
+		tags, err := s.tagRepo.List(ctx, userID, exportPageSize, offset)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to list tags for export", "user_id", userID, "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+		export.Tags = append(export.Tags, tags...)
+
+		if len(tags) < exportPageSize {
+			break
+		}
+	}
+
+	for offset := 0; ; offset += exportPageSize {
+		filters, err := s.savedFilterRepo.List(ctx, userID, exportPageSize, offset)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to list saved filters for export", "user_id", userID, "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+		export.SavedFilters = append(export.SavedFilters, filters...)
+
+		if len(filters) < exportPageSize {
+			break
+		}
+	}
+
+	if export.TaskSettings, err = s.taskRepo.GetSettings(ctx, userID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to get task settings for export", "user_id", userID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if export.TagSettings, err = s.tagRepo.GetSettings(ctx, userID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to get tag settings for export", "user_id", userID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if export.WeeklyGoal, err = s.streakRepo.GetWeeklyGoal(ctx, userID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to get weekly goal for export", "user_id", userID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "admin exported user data",
+		"admin_id", adminID, "user_id", userID, "tasks", len(export.Tasks), "tags", len(export.Tags),
+		"saved_filters", len(export.SavedFilters))
+	return export, nil
+}
+
+// RestoreUserData restores an export made by ExportUserData into userID,
+// the exported user or another one. Tasks are added next to the owner's
+// current ones under fresh IDs, so restoring twice duplicates them; tags and
+// saved filters are matched to the owner's by name and created when
+// missing. The tags, tasks, note revisions and task settings are written in
+// one transaction; saved filters, tag settings and the weekly goal follow
+// it, and matching by name makes a repeated restore skip the filters that
+// were already created. Settings and the goal in the export replace the
+// owner's. Clients of the owner reload their data. With validateOnly set
+// nothing is saved: the report counts the tags and saved filters that would
+// be created and maps only the ones the owner already has.
+func (s *Service) RestoreUserData(ctx context.Context, export *domain.Export, userID string, validateOnly bool) (*domain.RestoreReport, error) {
+	ctx, span := tracer.Start(ctx, "RestoreUserData", trace.WithAttributes(
+		attribute.String("user_id", userID),
+		attribute.String("exported_user_id", export.UserID),
+		attribute.Int("tasks", len(export.Tasks)),
+		attribute.Bool("validate_only", validateOnly),
+	))
+	defer span.End()
+
+	adminID, err := s.requireAdmin(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	ctx = database.WithSessionUser(ctx, userID)
+
+	by := taskdomain.Modifier{Source: taskdomain.ChangeSourceSystem, ClientID: "admin:" + adminID}
+	plan, taskIDs := domain.PlanRestore(export, userID, by, time.Now())
+	report := &domain.RestoreReport{
+		UserID:         userID,
+		TaskIDs:        taskIDs,
+		SavedFilterIDs: make(map[uuid.UUID]uuid.UUID, len(export.SavedFilters)),
+	}
+
+	if validateOnly {
+		report.TagIDs = make(map[uuid.UUID]uuid.UUID, len(export.Tags))
+		for _, exported := range export.Tags {
+			tag, err := s.tagRepo.GetByName(ctx, plan.Tags[exported.ID], userID)
+			if errors.Is(err, tagdomain.ErrTagNotFound) {
+				report.CreatedTags++
+				continue
+			}
+			if err != nil {
+				s.logger.ErrorContext(ctx, "failed to get tag", "user_id", userID, "error", err)
+				span.RecordError(err)
+				return nil, err
+			}
+			report.TagIDs[exported.ID] = tag.ID
+		}
+	} else {
+		restored, err := s.taskRepo.Restore(ctx, plan)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to restore tasks", "user_id", userID, "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+		report.TagIDs = restored.IDs
+		report.CreatedTags = restored.Created
+	}
+
+	if err := s.restoreSavedFilters(ctx, export, userID, report, validateOnly); err != nil {
+		s.logger.ErrorContext(ctx, "failed to restore saved filters", "user_id", userID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if validateOnly {
+		return report, nil
+	}
+
+	if export.TagSettings != nil {
+		if err := s.tagRepo.SetSettings(ctx, userID, export.TagSettings); err != nil {
+			s.logger.ErrorContext(ctx, "failed to restore tag settings", "user_id", userID, "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+	if export.WeeklyGoal != nil {
+		if err := s.streakRepo.SetWeeklyGoal(ctx, userID, export.WeeklyGoal); err != nil {
+			s.logger.ErrorContext(ctx, "failed to restore weekly goal", "user_id", userID, "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	s.events.Publish(ctx, changefeed.Event{
+		OwnerID:   userID,
+		Resource:  changefeed.ResourceAll,
+		Operation: changefeed.OperationResync,
+	})
+
+	s.logger.WarnContext(ctx, "audit", "event", "user_data.restored",
+		"admin_id", adminID, "user_id", userID, "exported_user_id", export.UserID,
+		"tasks", len(plan.Tasks), "tags", len(report.TagIDs), "created_tags", report.CreatedTags,
+		"saved_filters", len(report.SavedFilterIDs), "created_saved_filters", report.CreatedSavedFilters)
+	return report, nil
+}
+
+// restoreSavedFilters matches the saved filters of export to the owner's by
+// name and creates the missing ones, unless validateOnly is set. Their
+// criteria refer to the tags in report.TagIDs.
+func (s *Service) restoreSavedFilters(ctx context.Context, export *domain.Export, userID string, report *domain.RestoreReport, validateOnly bool) error {
+	if len(export.SavedFilters) == 0 {
+		return nil
+	}
+
+	existing := make(map[string]uuid.UUID)
+	for offset := 0; ; offset += exportPageSize {
+		filters, err := s.savedFilterRepo.List(ctx, userID, exportPageSize, offset)
+		if err != nil {
+			return err
+		}
+		for _, filter := range filters {
+			existing[filter.Name] = filter.ID
+		}
+		if len(filters) < exportPageSize {
+			break
+		}
+	}
+
+	for i, filter := range domain.PlanSavedFilters(export, userID, report.TagIDs) {
+		exportedID := export.SavedFilters[i].ID
+		if id, ok := existing[filter.Name]; ok {
+			report.SavedFilterIDs[exportedID] = id
+			continue
+		}
+		report.CreatedSavedFilters++
+		if validateOnly {
+			continue
+		}
+		if err := s.savedFilterRepo.Create(ctx, filter); err != nil {
+			return err
+		}
+		existing[filter.Name] = filter.ID
+		report.SavedFilterIDs[exportedID] = filter.ID
+	}
+	return nil
+}
+
+// AnonymizeUser replaces a user's profile with a pseudonym for a GDPR
+// restriction of processing request. Everything the user owns is kept, so
+// it returns the user together with their unchanged data counts.
+func (s *Service) AnonymizeUser(ctx context.Context, userID string) (*domain.UserStats, error) {
+	ctx, span := tracer.Start(ctx, "AnonymizeUser", trace.WithAttributes(
+		attribute.String("user_id", userID),
+	))
+	defer span.End()
+
+	adminID, err := s.requireAdmin(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	ctx = database.WithSessionUser(ctx, userID)
+
+	user, err := s.userRepo.AnonymizeUser(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, authdomain.ErrUserNotFound) {
+			s.logger.ErrorContext(ctx, "failed to anonymize user", "user_id", userID, "error", err)
+		}
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// Logged at warn as an audit event so it is kept at every level
+	s.logger.WarnContext(ctx, "audit", "event", "user.anonymized", "admin_id", adminID, "user_id", userID)
+
+	counts, err := s.repo.CountUserData(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to count user data", "user_id", userID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &domain.UserStats{
+		User:   user,
+		Counts: counts,
+	}, nil
+}
+
+// NormalizeTagNames brings the tag names of every user to the form new tags
+// are stored in (NFC, trimmed, whitespace collapsed) and merges the tags of a
+// user that end up with the same name. With dryRun it only reports the
+// changes. Users whose tags changed reload their data on connected clients.
+func (s *Service) NormalizeTagNames(ctx context.Context, dryRun bool) (*domain.TagNormalizationReport, error) {
+	ctx, span := tracer.Start(ctx, "NormalizeTagNames", trace.WithAttributes(
+		attribute.Bool("dry_run", dryRun),
+	))
+	defer span.End()
+
+	adminID, err := s.requireAdmin(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	// Row-level security must expose every user's tags, not the admin's
+	ctx = database.WithSessionUser(ctx, "")
+
+	tags, err := s.repo.ListTagNames(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list tag names", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	report := domain.PlanTagNormalization(tags)
+	if dryRun {
+		return report, nil
+	}
+	if len(report.Changes) == 0 {
+		report.Applied = true
+		return report, nil
+	}
+
+	by := taskdomain.Modifier{Source: taskdomain.ChangeSourceSystem, ClientID: "admin:" + adminID}
+	report.ReassignedTasks, err = s.repo.ApplyTagNameChanges(ctx, report.Changes, by)
+	if err != nil {
+		if !errors.Is(err, domain.ErrTagNamesChanged) {
+			s.logger.ErrorContext(ctx, "failed to apply tag name changes", "error", err)
+			span.RecordError(err)
+		}
+		return nil, err
+	}
+	report.Applied = true
+
+	owners := make(map[string]struct{})
+	for _, change := range report.Changes {
+		if _, ok := owners[change.OwnerID]; ok {
+			continue
+		}
+		owners[change.OwnerID] = struct{}{}
+		s.events.Publish(ctx, changefeed.Event{
+			OwnerID:   change.OwnerID,
+			Resource:  changefeed.ResourceAll,
+			Operation: changefeed.OperationResync,
+		})
+	}
+
+	// Logged at warn so the change is visible at every level
+	s.logger.WarnContext(ctx, "admin normalized tag names",
+		"admin_id", adminID, "renamed", report.Renamed, "merged", report.Merged,
+		"reassigned_tasks", report.ReassignedTasks, "users", len(owners))
+	return report, nil
+}
+
+// GetLogLevel returns the server's current minimum log level
+func (s *Service) GetLogLevel(ctx context.Context) (slog.Level, error) {
+	ctx, span := tracer.Start(ctx, "GetLogLevel")
+	defer span.End()
+
+	if _, err := s.requireAdmin(ctx); err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+	return logger.Level(), nil
+}
+
+// SetLogLevel changes the server's minimum log level at runtime and returns
+// the previous level
+func (s *Service) SetLogLevel(ctx context.Context, level string) (slog.Level, error) {
+	ctx, span := tracer.Start(ctx, "SetLogLevel", trace.WithAttributes(
+		attribute.String("level", level),
+	))
+	defer span.End()
+
+	adminID, err := s.requireAdmin(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	parsed, err := logger.ParseLevel(level)
+	if err != nil {
+		return 0, ErrInvalidLogLevel
+	}
+
+	previous := logger.Level()
+	logger.SetLevel(parsed)
+
+	// Logged at warn so the change is visible at every level
+	s.logger.WarnContext(ctx, "log level changed", "admin_id", adminID, "from", previous.String(), "to", parsed.String())
+	return previous, nil
+}
+
+// requireAdmin returns the caller's user ID if they hold the admin role. The
+// authorization policy already rejects other callers; this keeps the service
+// safe if it is ever served without that interceptor.
+func (s *Service) requireAdmin(ctx context.Context) (string, error) {
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		return "", err
+	}
+
+	if !auth.HasRole(ctx, auth.RoleAdmin) {
+		s.logger.WarnContext(ctx, "non-admin user attempted admin operation", "user_id", userID)
+		return "", ErrPermissionDenied
+	}
+	return userID, nil
+}
@@ -0,0 +1,214 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/internal/memory"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	taskapp "github.com/slips-ai/slips-core/internal/task/application"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+func TestAnonymizeUser(t *testing.T) {
+	store := memory.NewStore()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	users := memory.NewUserRepository(store)
+	hub := changefeed.NewHub()
+	tasks := taskapp.NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, hub, taskdomain.DefaultChecklistLimits, logger)
+	service := NewService(memory.NewAdminRepository(store), users, memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), memory.NewStreakRepository(store), memory.NewMCPTokenRepository(store), tasks, hub, logger)
+	admin := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin", Roles: []string{auth.RoleAdmin}})
+	owner := auth.WithUserID(context.Background(), "owner")
+
+	user := authdomain.NewUser("owner", "Ada", "https://example.com/ada.png", "ada@example.com")
+	user.TavilyMCPToken = "tvly-secret"
+	if _, err := users.UpsertUser(owner, user); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	if _, err := tasks.CreateTask(owner, "kept", "", nil, nil, nil, "", nil, ""); err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+
+	if _, err := service.AnonymizeUser(owner, "owner"); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("AnonymizeUser as non-admin error = %v, want ErrPermissionDenied", err)
+	}
+
+	stats, err := service.AnonymizeUser(admin, "owner")
+	if err != nil {
+		t.Fatalf("AnonymizeUser() error = %v", err)
+	}
+	got := stats.User
+	if got.Username != authdomain.AnonymizedUsername("owner") || got.Email != "" || got.AvatarURL != "" || got.TavilyMCPToken != "" || !got.IsAnonymized() {
+		t.Errorf("anonymized user = %+v", got)
+	}
+	if stats.Counts.Tasks != 1 {
+		t.Errorf("tasks = %d, want 1", stats.Counts.Tasks)
+	}
+
+	// Logging in again must not bring the profile back
+	if _, err := users.SyncUserProfile(owner, authdomain.NewUser("owner", "Ada", "https://example.com/ada.png", "ada@example.com")); err != nil {
+		t.Fatalf("sync profile: %v", err)
+	}
+	if _, err := users.UpsertUser(owner, authdomain.NewUser("owner", "Ada", "https://example.com/ada.png", "ada@example.com")); err != nil {
+		t.Fatalf("upsert user: %v", err)
+	}
+	after, err := users.GetUserByUserID(owner, "owner")
+	if err != nil {
+		t.Fatalf("get user: %v", err)
+	}
+	if after.Username != got.Username || after.Email != "" || after.AvatarURL != "" {
+		t.Errorf("profile restored after login: %+v", after)
+	}
+
+	if _, err := service.AnonymizeUser(admin, "missing"); err == nil {
+		t.Error("AnonymizeUser(missing) error = nil")
+	}
+}
+
+func TestRestoreUserData(t *testing.T) {
+	store := memory.NewStore()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	hub := changefeed.NewHub()
+	tasks := taskapp.NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, hub, taskdomain.DefaultChecklistLimits, logger)
+	service := NewService(memory.NewAdminRepository(store), memory.NewUserRepository(store), memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), memory.NewStreakRepository(store), memory.NewMCPTokenRepository(store), tasks, hub, logger)
+	admin := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin", Roles: []string{auth.RoleAdmin}})
+	owner := auth.WithUserID(context.Background(), "owner")
+	fresh := auth.WithUserID(context.Background(), "fresh")
+
+	original, err := tasks.CreateTask(owner, "plan trip", "book flights", []string{"travel"}, nil, nil, "", []string{"passport"}, "req-1")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	filters := memory.NewSavedFilterRepository(store)
+	filter := savedfilterdomain.NewSavedFilter("trips", "owner", savedfilterdomain.Criteria{TagIDs: original.TagIDs})
+	if err := filters.Create(owner, filter); err != nil {
+		t.Fatalf("create saved filter: %v", err)
+	}
+	streaks := memory.NewStreakRepository(store)
+	goal := 5
+	if err := streaks.SetWeeklyGoal(owner, "owner", &goal); err != nil {
+		t.Fatalf("set weekly goal: %v", err)
+	}
+	export, err := service.ExportUserData(admin, "owner")
+	if err != nil {
+		t.Fatalf("ExportUserData() error = %v", err)
+	}
+	if err := tasks.DeleteTask(owner, original.ID); err != nil {
+		t.Fatalf("delete task: %v", err)
+	}
+
+	if _, err := service.RestoreUserData(owner, export, "owner", false); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("RestoreUserData as non-admin error = %v, want ErrPermissionDenied", err)
+	}
+
+	preview, err := service.RestoreUserData(admin, export, "fresh", true)
+	if err != nil {
+		t.Fatalf("RestoreUserData(validate only) error = %v", err)
+	}
+	if preview.CreatedTags != 1 || preview.CreatedSavedFilters != 1 || len(preview.TaskIDs) != 1 {
+		t.Errorf("validate-only report = %+v", preview)
+	}
+	if list, err := tasks.ListTasks(fresh, nil, 10, 0, taskdomain.ListOptions{}, false); err != nil || len(list.Tasks) != 0 {
+		t.Fatalf("validate-only restore saved tasks: %v, %v", list, err)
+	}
+
+	for _, tt := range []struct {
+		ctx            context.Context
+		userID         string
+		createdTags    int
+		createdFilters int
+	}{
+		{owner, "owner", 0, 0},
+		{fresh, "fresh", 1, 1},
+	} {
+		report, err := service.RestoreUserData(admin, export, tt.userID, false)
+		if err != nil {
+			t.Fatalf("RestoreUserData(%s) error = %v", tt.userID, err)
+		}
+		if report.CreatedTags != tt.createdTags {
+			t.Errorf("RestoreUserData(%s) created %d tags, want %d", tt.userID, report.CreatedTags, tt.createdTags)
+		}
+		restoredID, ok := report.TaskIDs[original.ID]
+		if !ok || restoredID == original.ID {
+			t.Fatalf("RestoreUserData(%s) task IDs = %v", tt.userID, report.TaskIDs)
+		}
+
+		restored, err := tasks.GetTask(tt.ctx, restoredID)
+		if err != nil {
+			t.Fatalf("get restored task of %s: %v", tt.userID, err)
+		}
+		if restored.Title != "plan trip" || restored.Notes != "book flights" || len(restored.Checklist) != 1 || restored.Checklist[0].Content != "passport" {
+			t.Errorf("restored task of %s = %+v", tt.userID, restored)
+		}
+		if len(restored.TagIDs) != 1 || restored.TagIDs[0] != report.TagIDs[original.TagIDs[0]] {
+			t.Errorf("restored tags of %s = %v, want %v", tt.userID, restored.TagIDs, report.TagIDs)
+		}
+
+		if report.CreatedSavedFilters != tt.createdFilters {
+			t.Errorf("RestoreUserData(%s) created %d saved filters, want %d", tt.userID, report.CreatedSavedFilters, tt.createdFilters)
+		}
+		restoredFilter, err := filters.Get(tt.ctx, report.SavedFilterIDs[filter.ID], tt.userID)
+		if err != nil {
+			t.Fatalf("get restored saved filter of %s: %v", tt.userID, err)
+		}
+		if len(restoredFilter.Criteria.TagIDs) != 1 || restoredFilter.Criteria.TagIDs[0] != restored.TagIDs[0] {
+			t.Errorf("restored saved filter tags of %s = %v, want %v", tt.userID, restoredFilter.Criteria.TagIDs, restored.TagIDs)
+		}
+		if got, err := streaks.GetWeeklyGoal(tt.ctx, tt.userID); err != nil || got == nil || *got != goal {
+			t.Errorf("restored weekly goal of %s = %v, %v", tt.userID, got, err)
+		}
+	}
+}
+
+func TestTransferOwnership_AllTasks(t *testing.T) {
+	store := memory.NewStore()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	hub := changefeed.NewHub()
+	tasks := taskapp.NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, hub, taskdomain.DefaultChecklistLimits, logger)
+	service := NewService(memory.NewAdminRepository(store), memory.NewUserRepository(store), memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), memory.NewStreakRepository(store), memory.NewMCPTokenRepository(store), tasks, hub, logger)
+	admin := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin", Roles: []string{auth.RoleAdmin}})
+	old := auth.WithUserID(context.Background(), "old")
+	merged := auth.WithUserID(context.Background(), "merged")
+
+	open, err := tasks.CreateTask(old, "open", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	archived, err := tasks.CreateTask(old, "archived", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if _, err := tasks.ArchiveTask(old, archived.ID); err != nil {
+		t.Fatalf("archive task: %v", err)
+	}
+
+	if _, err := service.TransferOwnership(old, "old", "merged", nil, true); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("TransferOwnership as non-admin error = %v, want ErrPermissionDenied", err)
+	}
+
+	result, err := service.TransferOwnership(admin, "old", "merged", nil, true)
+	if err != nil {
+		t.Fatalf("TransferOwnership() error = %v", err)
+	}
+	if len(result.TaskIDs) != 2 {
+		t.Fatalf("transferred task IDs = %v, want both tasks", result.TaskIDs)
+	}
+	for _, original := range []*taskdomain.Task{open, archived} {
+		got, err := tasks.GetTask(merged, original.ID)
+		if err != nil {
+			t.Fatalf("get transferred task: %v", err)
+		}
+		if got.Title != original.Title || got.LastModifiedBy.ClientID != "admin:admin" {
+			t.Errorf("transferred task = %+v", got)
+		}
+	}
+	if list, err := tasks.ListTasks(old, nil, 10, 0, taskdomain.ListOptions{IncludeArchived: true}, false); err != nil || len(list.Tasks) != 0 {
+		t.Errorf("tasks left with the previous owner: %v, %v", list, err)
+	}
+}
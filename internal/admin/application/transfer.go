@@ -0,0 +1,76 @@
+package application
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/database"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TaskTransferer hands tasks from one user to another
+type TaskTransferer interface {
+	TransferOwnership(ctx context.Context, fromUserID, toUserID string, ids []uuid.UUID, by taskdomain.Modifier) (*taskdomain.TransferResult, error)
+}
+
+// TransferOwnership hands the tasks ids of fromUserID, or all of them
+// including archived ones when allTasks is set, to toUserID, e.g. to
+// consolidate two accounts of one person. See
+// taskapp.Service.TransferOwnership for what the recipient gets.
+func (s *Service) TransferOwnership(ctx context.Context, fromUserID, toUserID string, ids []uuid.UUID, allTasks bool) (*taskdomain.TransferResult, error) {
+	ctx, span := tracer.Start(ctx, "TransferOwnership", trace.WithAttributes(
+		attribute.String("from_user_id", fromUserID),
+		attribute.String("to_user_id", toUserID),
+		attribute.Bool("all_tasks", allTasks),
+	))
+	defer span.End()
+
+	adminID, err := s.requireAdmin(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if allTasks {
+		ids, err = s.listTaskIDs(database.WithSessionUser(ctx, fromUserID), fromUserID)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	by := taskdomain.Modifier{Source: taskdomain.ChangeSourceSystem, ClientID: "admin:" + adminID}
+	result, err := s.transfers.TransferOwnership(ctx, fromUserID, toUserID, ids, by)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.WarnContext(ctx, "audit", "event", "user_data.transferred",
+		"admin_id", adminID, "from_user_id", fromUserID, "to_user_id", toUserID,
+		"tasks", len(result.TaskIDs), "created_tags", result.CreatedTags)
+	return result, nil
+}
+
+// listTaskIDs returns the IDs of all of a user's tasks, including archived
+// ones
+func (s *Service) listTaskIDs(ctx context.Context, userID string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	after := uuid.Nil
+	for {
+		tasks, err := s.taskRepo.ListAfter(ctx, userID, after, exportPageSize, taskdomain.ScanOptions{IncludeArchived: true})
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to list tasks for transfer", "user_id", userID, "error", err)
+			return nil, err
+		}
+		for _, task := range tasks {
+			ids = append(ids, task.ID)
+		}
+		if len(tasks) < exportPageSize {
+			return ids, nil
+		}
+		after = tasks[len(tasks)-1].ID
+	}
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// UserCounts summarizes how much data a user owns
+type UserCounts struct {
+	Tasks           int
+	OpenTasks       int
+	CompletedTasks  int
+	ArchivedTasks   int
+	Tags            int
+	MCPTokens       int
+	ActiveMCPTokens int
+}
+
+// UserStats pairs a user with their data counts
+type UserStats struct {
+	User   *authdomain.User
+	Counts *UserCounts
+}
+
+// Export holds a full copy of a user's data
+type Export struct {
+	UserID string
+	Tasks  []*taskdomain.Task
+	Tags   []*tagdomain.Tag
+	// NoteRevisions hold the earlier notes of Tasks
+	NoteRevisions []taskdomain.NoteRevision
+	SavedFilters  []*savedfilterdomain.SavedFilter
+	// TaskSettings, TagSettings and WeeklyGoal are nil when the export does
+	// not carry them; WeeklyGoal also when the user has no goal
+	TaskSettings *taskdomain.Settings
+	TagSettings  *tagdomain.Settings
+	WeeklyGoal   *int
+}
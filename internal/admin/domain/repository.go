@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// Repository defines cross-module queries used by operators
+type Repository interface {
+	// CountUserData counts the tasks, tags and MCP tokens owned by a user
+	CountUserData(ctx context.Context, userID string) (*UserCounts, error)
+	// ListTagNames lists the tags of every owner, ordered by owner, creation
+	// time and ID
+	ListTagNames(ctx context.Context) ([]TagName, error)
+	// ApplyTagNameChanges applies changes in order in one transaction.
+	// Merged tags hand their tasks, feeds and saved filters to the tag they
+	// are merged into; tasks that did not carry it yet are attributed to by.
+	// It returns the number of such tasks, or ErrTagNamesChanged when a tag
+	// no longer has its OldName.
+	ApplyTagNameChanges(ctx context.Context, changes []TagNameChange, by taskdomain.Modifier) (int64, error)
+}
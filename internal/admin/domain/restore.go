@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// RestoreReport describes an export restored into an owner
+type RestoreReport struct {
+	UserID string
+	// TaskIDs, TagIDs and SavedFilterIDs map the IDs in the export to the
+	// restored ones
+	TaskIDs        map[uuid.UUID]uuid.UUID
+	TagIDs         map[uuid.UUID]uuid.UUID
+	SavedFilterIDs map[uuid.UUID]uuid.UUID
+	// CreatedTags and CreatedSavedFilters count what the owner did not have
+	// yet; the others were matched by name
+	CreatedTags         int
+	CreatedSavedFilters int
+}
+
+// PlanRestore copies the tasks of export, with their note revisions, to
+// ownerID under fresh task and checklist item IDs and returns them with the
+// map from exported to new task IDs. The tasks keep the exported tag IDs;
+// the repository matches them to the owner's tags by name. The copies keep
+// their creation time and state, but are stamped as updated at now by by,
+// so clients pick them up in their next incremental sync. Client request IDs
+// are not kept, since the owner may still have the original tasks.
+func PlanRestore(export *Export, ownerID string, by taskdomain.Modifier, now time.Time) (*taskdomain.Restore, map[uuid.UUID]uuid.UUID) {
+	restore := &taskdomain.Restore{
+		OwnerID:  ownerID,
+		Tags:     make(map[uuid.UUID]string, len(export.Tags)),
+		Tasks:    make([]*taskdomain.Task, 0, len(export.Tasks)),
+		Settings: export.TaskSettings,
+	}
+	for _, tag := range export.Tags {
+		restore.Tags[tag.ID] = tagdomain.NormalizeName(tag.Name)
+	}
+
+	taskIDs := make(map[uuid.UUID]uuid.UUID, len(export.Tasks))
+	for _, exported := range export.Tasks {
+		if _, ok := taskIDs[exported.ID]; ok {
+			continue
+		}
+		task := *exported
+		task.ID = uuid.New()
+		task.OwnerID = ownerID
+		task.UpdatedAt = now
+		task.ClientRequestID = ""
+		task.LastModifiedBy = by
+
+		task.TagIDs = make([]uuid.UUID, 0, len(exported.TagIDs))
+		for _, tagID := range exported.TagIDs {
+			if _, ok := restore.Tags[tagID]; ok {
+				task.TagIDs = append(task.TagIDs, tagID)
+			}
+		}
+
+		task.Checklist = make([]taskdomain.ChecklistItem, len(exported.Checklist))
+		for i, item := range exported.Checklist {
+			item.ID = uuid.New()
+			item.TaskID = task.ID
+			task.Checklist[i] = item
+		}
+
+		taskIDs[exported.ID] = task.ID
+		restore.Tasks = append(restore.Tasks, &task)
+	}
+
+	for _, revision := range export.NoteRevisions {
+		taskID, ok := taskIDs[revision.TaskID]
+		if !ok {
+			continue
+		}
+		revision.ID = uuid.New()
+		revision.TaskID = taskID
+		restore.NoteRevisions = append(restore.NoteRevisions, revision)
+	}
+	return restore, taskIDs
+}
+
+// PlanSavedFilters copies the saved filters of export to ownerID under
+// fresh IDs. tagIDs maps the exported tag IDs to the owner's tags; criteria
+// tags missing from it are dropped.
+func PlanSavedFilters(export *Export, ownerID string, tagIDs map[uuid.UUID]uuid.UUID) []*savedfilterdomain.SavedFilter {
+	filters := make([]*savedfilterdomain.SavedFilter, len(export.SavedFilters))
+	for i, exported := range export.SavedFilters {
+		criteria := exported.Criteria
+		criteria.TagIDs = make([]uuid.UUID, 0, len(exported.Criteria.TagIDs))
+		for _, tagID := range exported.Criteria.TagIDs {
+			if restored, ok := tagIDs[tagID]; ok {
+				criteria.TagIDs = append(criteria.TagIDs, restored)
+			}
+		}
+		filters[i] = savedfilterdomain.NewSavedFilter(exported.Name, ownerID, criteria)
+	}
+	return filters
+}
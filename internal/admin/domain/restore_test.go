@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+func TestPlanRestore(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	completed := created.Add(time.Hour)
+	now := created.Add(24 * time.Hour)
+	keptTag, droppedTag := uuid.New(), uuid.New()
+	exported := &taskdomain.Task{
+		ID:              uuid.New(),
+		Title:           "write report",
+		OwnerID:         "old-owner",
+		TagIDs:          []uuid.UUID{keptTag, droppedTag},
+		Checklist:       []taskdomain.ChecklistItem{{ID: uuid.New(), Content: "draft", Completed: true, SortOrder: 0}},
+		CreatedAt:       created,
+		UpdatedAt:       created,
+		CompletedAt:     &completed,
+		Pinned:          true,
+		ClientRequestID: "req-1",
+	}
+	by := taskdomain.Modifier{Source: taskdomain.ChangeSourceSystem, ClientID: "admin:root"}
+
+	revision := taskdomain.NoteRevision{ID: uuid.New(), TaskID: exported.ID, Notes: "first draft", CreatedAt: created}
+	orphan := taskdomain.NoteRevision{ID: uuid.New(), TaskID: uuid.New(), Notes: "gone"}
+	settings := &taskdomain.Settings{RolloverToInbox: true}
+
+	restore, taskIDs := PlanRestore(&Export{
+		Tasks:         []*taskdomain.Task{exported, exported},
+		Tags:          []*tagdomain.Tag{{ID: keptTag, Name: " Work "}},
+		NoteRevisions: []taskdomain.NoteRevision{revision, orphan},
+		TaskSettings:  settings,
+	}, "new-owner", by, now)
+
+	if restore.OwnerID != "new-owner" || restore.Settings != settings || restore.Tags[keptTag] != "Work" {
+		t.Errorf("restore = %+v", restore)
+	}
+	if len(restore.Tasks) != 1 {
+		t.Fatalf("restored %d tasks, want 1 (duplicates skipped)", len(restore.Tasks))
+	}
+	task := restore.Tasks[0]
+	if task.ID == exported.ID || taskIDs[exported.ID] != task.ID {
+		t.Errorf("task ID %s not remapped (map %v)", task.ID, taskIDs)
+	}
+	if task.OwnerID != "new-owner" || task.Title != exported.Title || !task.Pinned || task.CompletedAt != &completed {
+		t.Errorf("restored task = %+v", task)
+	}
+	if !task.CreatedAt.Equal(created) || !task.UpdatedAt.Equal(now) || task.LastModifiedBy != by || task.ClientRequestID != "" {
+		t.Errorf("restored task timestamps or attribution = %+v", task)
+	}
+	if len(task.TagIDs) != 1 || task.TagIDs[0] != keptTag {
+		t.Errorf("tag IDs = %v, want [%s]", task.TagIDs, keptTag)
+	}
+	if len(restore.NoteRevisions) != 1 || restore.NoteRevisions[0].TaskID != task.ID ||
+		restore.NoteRevisions[0].ID == revision.ID || restore.NoteRevisions[0].Notes != "first draft" {
+		t.Errorf("note revisions = %+v", restore.NoteRevisions)
+	}
+	item := task.Checklist[0]
+	if item.ID == exported.Checklist[0].ID || item.TaskID != task.ID || !item.Completed || item.Content != "draft" {
+		t.Errorf("checklist item = %+v", item)
+	}
+	if exported.OwnerID != "old-owner" || exported.Checklist[0].TaskID != uuid.Nil {
+		t.Error("export was modified")
+	}
+}
+
+func TestPlanSavedFilters(t *testing.T) {
+	keptTag, droppedTag, restoredTag := uuid.New(), uuid.New(), uuid.New()
+	exported := &savedfilterdomain.SavedFilter{
+		ID:       uuid.New(),
+		Name:     "work",
+		OwnerID:  "old-owner",
+		Criteria: savedfilterdomain.Criteria{TagIDs: []uuid.UUID{keptTag, droppedTag}, Query: "report"},
+	}
+
+	filters := PlanSavedFilters(&Export{SavedFilters: []*savedfilterdomain.SavedFilter{exported}}, "new-owner",
+		map[uuid.UUID]uuid.UUID{keptTag: restoredTag})
+
+	if len(filters) != 1 {
+		t.Fatalf("planned %d saved filters, want 1", len(filters))
+	}
+	filter := filters[0]
+	if filter.ID == exported.ID || filter.OwnerID != "new-owner" || filter.Name != "work" || filter.Criteria.Query != "report" {
+		t.Errorf("saved filter = %+v", filter)
+	}
+	if len(filter.Criteria.TagIDs) != 1 || filter.Criteria.TagIDs[0] != restoredTag {
+		t.Errorf("criteria tag IDs = %v, want [%s]", filter.Criteria.TagIDs, restoredTag)
+	}
+	if len(exported.Criteria.TagIDs) != 2 {
+		t.Error("export was modified")
+	}
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+)
+
+// ErrTagNamesChanged is returned when tags were renamed or deleted between
+// planning and applying the tag name normalization; nothing was changed and
+// it can be run again
+var ErrTagNamesChanged = errors.New("tag names changed while normalizing them")
+
+// TagName is a stored tag as seen by the tag name normalization
+type TagName struct {
+	ID        uuid.UUID
+	OwnerID   string
+	Name      string
+	CreatedAt time.Time
+}
+
+// TagNameChange is one step of normalizing the stored tag names. The tag is
+// renamed to NewName, or merged into MergedInto when another tag of the
+// owner already has that name once normalized.
+type TagNameChange struct {
+	TagID   uuid.UUID
+	OwnerID string
+	OldName string
+	NewName string
+	// MergedInto is the tag that takes over this tag's tasks, feeds and
+	// saved filters before it is deleted; nil when the tag is renamed
+	MergedInto *uuid.UUID
+}
+
+// TagNormalizationReport describes what normalizing the stored tag names
+// changes, or changed when Applied is set
+type TagNormalizationReport struct {
+	Changes []TagNameChange
+	Renamed int
+	Merged  int
+	// ReassignedTasks is the number of tasks that got the tag a duplicate
+	// was merged into; only known once applied
+	ReassignedTasks int64
+	Applied         bool
+}
+
+// PlanTagNormalization works out the changes that bring tags, ordered by
+// owner, creation time and ID, to their normalized names. Tags whose names
+// normalize to the same name are merged into one per owner: the tag already
+// carrying the normalized name if there is one, otherwise the oldest. Merges
+// come before renames, so a rename never collides with a tag about to be
+// merged away. Tags whose names normalize to nothing are left alone.
+func PlanTagNormalization(tags []TagName) *TagNormalizationReport {
+	type key struct{ ownerID, name string }
+	var order []key
+	groups := make(map[key][]TagName)
+	for _, tag := range tags {
+		k := key{ownerID: tag.OwnerID, name: tagdomain.NormalizeName(tag.Name)}
+		if k.name == "" {
+			continue
+		}
+		if _, ok := groups[k]; !ok {
+			order = append(order, k)
+		}
+		groups[k] = append(groups[k], tag)
+	}
+
+	report := &TagNormalizationReport{}
+	var renames []TagNameChange
+	for _, k := range order {
+		group := groups[k]
+		keep := 0
+		for i, tag := range group {
+			if tag.Name == k.name {
+				keep = i
+				break
+			}
+		}
+		survivor := group[keep]
+		for i, tag := range group {
+			if i == keep {
+				continue
+			}
+			report.Changes = append(report.Changes, TagNameChange{
+				TagID:      tag.ID,
+				OwnerID:    tag.OwnerID,
+				OldName:    tag.Name,
+				NewName:    k.name,
+				MergedInto: &survivor.ID,
+			})
+			report.Merged++
+		}
+		if survivor.Name != k.name {
+			renames = append(renames, TagNameChange{
+				TagID:   survivor.ID,
+				OwnerID: survivor.OwnerID,
+				OldName: survivor.Name,
+				NewName: k.name,
+			})
+			report.Renamed++
+		}
+	}
+	report.Changes = append(report.Changes, renames...)
+	return report
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPlanTagNormalization(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tag := func(ownerID, name string, age int) TagName {
+		return TagName{ID: uuid.New(), OwnerID: ownerID, Name: name, CreatedAt: base.Add(time.Duration(age) * time.Hour)}
+	}
+
+	oldestSpaced := tag("alice", " deep  work", 0)
+	normalized := tag("alice", "deep work", 1)
+	tabbed := tag("alice", "deep\twork", 2)
+	decomposed := tag("alice", "cafe\u0301", 3)
+	clean := tag("alice", "home", 4)
+	otherOwner := tag("bob", "deep  work", 0)
+
+	report := PlanTagNormalization([]TagName{oldestSpaced, normalized, tabbed, decomposed, clean, otherOwner})
+
+	if report.Merged != 2 || report.Renamed != 2 {
+		t.Fatalf("merged %d and renamed %d, want 2 and 2", report.Merged, report.Renamed)
+	}
+	want := []struct {
+		id         uuid.UUID
+		newName    string
+		mergedInto *uuid.UUID
+	}{
+		{oldestSpaced.ID, "deep work", &normalized.ID},
+		{tabbed.ID, "deep work", &normalized.ID},
+		{decomposed.ID, "caf\u00e9", nil},
+		{otherOwner.ID, "deep work", nil},
+	}
+	if len(report.Changes) != len(want) {
+		t.Fatalf("got %d changes, want %d: %+v", len(report.Changes), len(want), report.Changes)
+	}
+	for i, w := range want {
+		change := report.Changes[i]
+		if change.TagID != w.id || change.NewName != w.newName {
+			t.Errorf("change %d = %+v, want tag %s named %q", i, change, w.id, w.newName)
+		}
+		if (change.MergedInto == nil) != (w.mergedInto == nil) ||
+			(w.mergedInto != nil && *change.MergedInto != *w.mergedInto) {
+			t.Errorf("change %d merged into %v, want %v", i, change.MergedInto, w.mergedInto)
+		}
+	}
+}
+
+func TestPlanTagNormalization_MergesIntoOldestWithoutExactName(t *testing.T) {
+	older := TagName{ID: uuid.New(), OwnerID: "alice", Name: "work ", CreatedAt: time.Unix(1, 0)}
+	newer := TagName{ID: uuid.New(), OwnerID: "alice", Name: " work", CreatedAt: time.Unix(2, 0)}
+
+	report := PlanTagNormalization([]TagName{older, newer})
+
+	if len(report.Changes) != 2 {
+		t.Fatalf("got %d changes, want 2", len(report.Changes))
+	}
+	if merge := report.Changes[0]; merge.TagID != newer.ID || merge.MergedInto == nil || *merge.MergedInto != older.ID {
+		t.Errorf("first change = %+v, want the newer tag merged into the older", merge)
+	}
+	if rename := report.Changes[1]; rename.TagID != older.ID || rename.NewName != "work" || rename.MergedInto != nil {
+		t.Errorf("second change = %+v, want the older tag renamed", rename)
+	}
+}
@@ -0,0 +1,526 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	savedfilterv1 "github.com/slips-ai/slips-core/gen/go/savedfilter/v1"
+	tagv1 "github.com/slips-ai/slips-core/gen/go/tag/v1"
+	taskv1 "github.com/slips-ai/slips-core/gen/go/task/v1"
+	"github.com/slips-ai/slips-core/internal/admin/application"
+	"github.com/slips-ai/slips-core/internal/admin/domain"
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	savedfiltergrpc "github.com/slips-ai/slips-core/internal/savedfilter/infra/grpc"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	taggrpc "github.com/slips-ai/slips-core/internal/tag/infra/grpc"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	taskgrpc "github.com/slips-ai/slips-core/internal/task/infra/grpc"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"github.com/slips-ai/slips-core/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminServer implements the AdminService gRPC server
+type AdminServer struct {
+	adminv1.UnimplementedAdminServiceServer
+	service *application.Service
+}
+
+// NewAdminServer creates a new admin gRPC server
+func NewAdminServer(service *application.Service) *AdminServer {
+	return &AdminServer{
+		service: service,
+	}
+}
+
+// ListUsers lists registered users. Page tokens encode the offset of the next page.
+func (s *AdminServer) ListUsers(ctx context.Context, req *adminv1.ListUsersRequest) (*adminv1.ListUsersResponse, error) {
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 30
+	}
+
+	offset := 0
+	if req.PageToken != "" {
+		parsed, err := strconv.Atoi(req.PageToken)
+		if err != nil || parsed < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		offset = parsed
+	}
+
+	// Validate int32 bounds at gRPC layer before calling repository
+	if err := grpcerrors.ValidateInt32Range(pageSize, "limit"); err != nil {
+		return nil, err
+	}
+	if err := grpcerrors.ValidateInt32Range(offset, "offset"); err != nil {
+		return nil, err
+	}
+
+	users, err := s.service.ListUsers(ctx, pageSize, offset)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to list users")
+	}
+
+	protoUsers := make([]*adminv1.User, len(users))
+	for i, user := range users {
+		protoUsers[i] = userToProto(user)
+	}
+
+	resp := &adminv1.ListUsersResponse{
+		Users: protoUsers,
+	}
+	if len(users) == pageSize {
+		resp.NextPageToken = strconv.Itoa(offset + pageSize)
+	}
+	return resp, nil
+}
+
+// GetUserStats returns a user with counts of the data they own
+func (s *AdminServer) GetUserStats(ctx context.Context, req *adminv1.GetUserStatsRequest) (*adminv1.GetUserStatsResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.UserId, "user_id"); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.service.GetUserStats(ctx, req.UserId)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to get user stats")
+	}
+
+	return &adminv1.GetUserStatsResponse{
+		User:   userToProto(stats.User),
+		Counts: countsToProto(stats.Counts),
+	}, nil
+}
+
+// RevokeUserMCPTokens revokes one or all of a user's MCP tokens
+func (s *AdminServer) RevokeUserMCPTokens(ctx context.Context, req *adminv1.RevokeUserMCPTokensRequest) (*adminv1.RevokeUserMCPTokensResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.UserId, "user_id"); err != nil {
+		return nil, err
+	}
+
+	var tokenID *uuid.UUID
+	if req.TokenId != nil {
+		parsed, err := uuid.Parse(*req.TokenId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid token ID format")
+		}
+		tokenID = &parsed
+	}
+
+	revoked, err := s.service.RevokeMCPTokens(ctx, req.UserId, tokenID)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to revoke MCP tokens")
+	}
+
+	return &adminv1.RevokeUserMCPTokensResponse{
+		RevokedCount: int32(revoked),
+	}, nil
+}
+
+// ExportUserData exports all of a user's tasks, tags, saved filters,
+// settings and weekly goal
+func (s *AdminServer) ExportUserData(ctx context.Context, req *adminv1.ExportUserDataRequest) (*adminv1.ExportUserDataResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.UserId, "user_id"); err != nil {
+		return nil, err
+	}
+
+	export, err := s.service.ExportUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to export user data")
+	}
+
+	protoTags := make([]*tagv1.Tag, len(export.Tags))
+	for i, tag := range export.Tags {
+		protoTags[i] = &tagv1.Tag{
+			Id:              tag.ID.String(),
+			Name:            tag.Name,
+			CreatedAt:       timestamppb.New(tag.CreatedAt),
+			UpdatedAt:       timestamppb.New(tag.UpdatedAt),
+			ClientRequestId: tag.ClientRequestID,
+		}
+	}
+
+	protoRevisions := make([]*taskv1.NoteRevision, len(export.NoteRevisions))
+	for i := range export.NoteRevisions {
+		protoRevisions[i] = taskgrpc.NoteRevisionToProto(&export.NoteRevisions[i])
+	}
+	protoFilters := make([]*savedfilterv1.SavedFilter, len(export.SavedFilters))
+	for i, filter := range export.SavedFilters {
+		protoFilters[i] = savedfiltergrpc.SavedFilterToProto(filter)
+	}
+
+	resp := &adminv1.ExportUserDataResponse{
+		UserId:        export.UserID,
+		ExportedAt:    timestamppb.New(time.Now()),
+		Tasks:         taskgrpc.TasksToProto(export.Tasks),
+		Tags:          protoTags,
+		NoteRevisions: protoRevisions,
+		SavedFilters:  protoFilters,
+		TaskSettings:  taskgrpc.SettingsToProto(export.TaskSettings),
+		TagSettings:   taggrpc.SettingsToProto(export.TagSettings),
+	}
+	if export.WeeklyGoal != nil {
+		goal := int32(*export.WeeklyGoal)
+		resp.WeeklyGoal = &goal
+	}
+	return resp, nil
+}
+
+// RestoreUserData restores an ExportUserData snapshot into a user under
+// fresh IDs
+func (s *AdminServer) RestoreUserData(ctx context.Context, req *adminv1.RestoreUserDataRequest) (*adminv1.RestoreUserDataResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.UserId, "user_id"); err != nil {
+		return nil, err
+	}
+	if req.Snapshot == nil {
+		return nil, status.Error(codes.InvalidArgument, "snapshot is required")
+	}
+
+	export, err := exportFromProto(req.Snapshot)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	report, err := s.service.RestoreUserData(ctx, export, req.UserId, req.ValidateOnly)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to restore user data")
+	}
+
+	return &adminv1.RestoreUserDataResponse{
+		UserId:                  report.UserID,
+		RestoredTaskCount:       int32(len(report.TaskIDs)),
+		CreatedTagCount:         int32(report.CreatedTags),
+		TaskIds:                 idMapToProto(report.TaskIDs),
+		TagIds:                  idMapToProto(report.TagIDs),
+		CreatedSavedFilterCount: int32(report.CreatedSavedFilters),
+		SavedFilterIds:          idMapToProto(report.SavedFilterIDs),
+	}, nil
+}
+
+// TransferOwnership hands a user's tasks to another user
+func (s *AdminServer) TransferOwnership(ctx context.Context, req *adminv1.TransferOwnershipRequest) (*adminv1.TransferOwnershipResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.FromUserId, "from_user_id"); err != nil {
+		return nil, err
+	}
+	if err := grpcerrors.ValidateNotEmpty(req.ToUserId, "to_user_id"); err != nil {
+		return nil, err
+	}
+	if req.AllTasks == (len(req.TaskIds) > 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of task_ids and all_tasks is required")
+	}
+
+	ids := make([]uuid.UUID, len(req.TaskIds))
+	for i, idStr := range req.TaskIds {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid task ID format: %s", idStr)
+		}
+		ids[i] = id
+	}
+
+	result, err := s.service.TransferOwnership(ctx, req.FromUserId, req.ToUserId, ids, req.AllTasks)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to transfer tasks")
+	}
+
+	taskIDs := make([]string, len(result.TaskIDs))
+	for i, id := range result.TaskIDs {
+		taskIDs[i] = id.String()
+	}
+	return &adminv1.TransferOwnershipResponse{
+		TaskIds:         taskIDs,
+		CreatedTagCount: int32(result.CreatedTags),
+	}, nil
+}
+
+// AnonymizeUser replaces a user's profile with a pseudonym
+func (s *AdminServer) AnonymizeUser(ctx context.Context, req *adminv1.AnonymizeUserRequest) (*adminv1.AnonymizeUserResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.UserId, "user_id"); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.service.AnonymizeUser(ctx, req.UserId)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to anonymize user")
+	}
+
+	return &adminv1.AnonymizeUserResponse{
+		User:   userToProto(stats.User),
+		Counts: countsToProto(stats.Counts),
+	}, nil
+}
+
+// GetLogLevel returns the server's current log level
+func (s *AdminServer) GetLogLevel(ctx context.Context, req *adminv1.GetLogLevelRequest) (*adminv1.GetLogLevelResponse, error) {
+	level, err := s.service.GetLogLevel(ctx)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to get log level")
+	}
+
+	return &adminv1.GetLogLevelResponse{
+		Level: levelToProto(level),
+	}, nil
+}
+
+// SetLogLevel changes the server's log level without a restart
+func (s *AdminServer) SetLogLevel(ctx context.Context, req *adminv1.SetLogLevelRequest) (*adminv1.SetLogLevelResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.Level, "level"); err != nil {
+		return nil, err
+	}
+
+	previous, err := s.service.SetLogLevel(ctx, req.Level)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to set log level")
+	}
+
+	level, _ := logger.ParseLevel(req.Level)
+	return &adminv1.SetLogLevelResponse{
+		Level:         levelToProto(level),
+		PreviousLevel: levelToProto(previous),
+	}, nil
+}
+
+// NormalizeTagNames normalizes every user's tag names, or reports what that
+// would change
+func (s *AdminServer) NormalizeTagNames(ctx context.Context, req *adminv1.NormalizeTagNamesRequest) (*adminv1.NormalizeTagNamesResponse, error) {
+	report, err := s.service.NormalizeTagNames(ctx, req.DryRun)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to normalize tag names")
+	}
+
+	resp := &adminv1.NormalizeTagNamesResponse{
+		Changes:             make([]*adminv1.TagNameChange, len(report.Changes)),
+		RenamedCount:        int32(report.Renamed),
+		MergedCount:         int32(report.Merged),
+		ReassignedTaskCount: report.ReassignedTasks,
+		Applied:             report.Applied,
+	}
+	for i, change := range report.Changes {
+		protoChange := &adminv1.TagNameChange{
+			TagId:   change.TagID.String(),
+			OwnerId: change.OwnerID,
+			OldName: change.OldName,
+			NewName: change.NewName,
+		}
+		if change.MergedInto != nil {
+			mergedInto := change.MergedInto.String()
+			protoChange.MergedIntoTagId = &mergedInto
+		}
+		resp.Changes[i] = protoChange
+	}
+	return resp, nil
+}
+
+// toGRPCError maps log level and restore failures to status codes and
+// defers everything else to grpcerrors.ToGRPCError
+func toGRPCError(err error, defaultMsg string) error {
+	if errors.Is(err, application.ErrInvalidLogLevel) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, domain.ErrTagNamesChanged) || errors.Is(err, taskdomain.ErrTransferConflict) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	if errors.Is(err, taskdomain.ErrTransferToSelf) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return grpcerrors.ToGRPCError(err, defaultMsg)
+}
+
+// levelToProto renders a level as the lowercase name accepted by SetLogLevel
+func levelToProto(level slog.Level) string {
+	return strings.ToLower(level.String())
+}
+
+func userToProto(user *authdomain.User) *adminv1.User {
+	pb := &adminv1.User{
+		Id:        user.ID,
+		UserId:    user.UserID,
+		Username:  user.Username,
+		Email:     user.Email,
+		AvatarUrl: user.AvatarURL,
+		CreatedAt: timestamppb.New(user.CreatedAt),
+		UpdatedAt: timestamppb.New(user.UpdatedAt),
+	}
+	if user.AnonymizedAt != nil {
+		pb.AnonymizedAt = timestamppb.New(*user.AnonymizedAt)
+	}
+	return pb
+}
+
+func countsToProto(counts *domain.UserCounts) *adminv1.UserCounts {
+	return &adminv1.UserCounts{
+		Tasks:           int32(counts.Tasks),
+		OpenTasks:       int32(counts.OpenTasks),
+		CompletedTasks:  int32(counts.CompletedTasks),
+		ArchivedTasks:   int32(counts.ArchivedTasks),
+		Tags:            int32(counts.Tags),
+		McpTokens:       int32(counts.MCPTokens),
+		ActiveMcpTokens: int32(counts.ActiveMCPTokens),
+	}
+}
+
+func idMapToProto(ids map[uuid.UUID]uuid.UUID) map[string]string {
+	pb := make(map[string]string, len(ids))
+	for from, to := range ids {
+		pb[from.String()] = to.String()
+	}
+	return pb
+}
+
+// exportFromProto parses an ExportUserData snapshot. Only the fields a
+// restore keeps are read; the others are derived or replaced.
+func exportFromProto(pb *adminv1.ExportUserDataResponse) (*domain.Export, error) {
+	export := &domain.Export{
+		UserID:        pb.UserId,
+		Tasks:         make([]*taskdomain.Task, len(pb.Tasks)),
+		Tags:          make([]*tagdomain.Tag, len(pb.Tags)),
+		NoteRevisions: make([]taskdomain.NoteRevision, len(pb.NoteRevisions)),
+		SavedFilters:  make([]*savedfilterdomain.SavedFilter, len(pb.SavedFilters)),
+	}
+	for i, tag := range pb.Tags {
+		id, err := uuid.Parse(tag.Id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID of tag %d: %q", i, tag.Id)
+		}
+		export.Tags[i] = &tagdomain.Tag{ID: id, Name: tag.Name}
+	}
+	for i, task := range pb.Tasks {
+		parsed, err := taskFromProto(task)
+		if err != nil {
+			return nil, fmt.Errorf("invalid task %d: %w", i, err)
+		}
+		export.Tasks[i] = parsed
+	}
+	for i, revision := range pb.NoteRevisions {
+		taskID, err := uuid.Parse(revision.TaskId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid task ID of note revision %d: %q", i, revision.TaskId)
+		}
+		export.NoteRevisions[i] = taskdomain.NoteRevision{
+			TaskID:    taskID,
+			Notes:     revision.Notes,
+			CreatedAt: revision.CreatedAt.AsTime(),
+		}
+	}
+	for i, filter := range pb.SavedFilters {
+		id, err := uuid.Parse(filter.Id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID of saved filter %d: %q", i, filter.Id)
+		}
+		if filter.Name == "" {
+			return nil, fmt.Errorf("saved filter %d has no name", i)
+		}
+		criteria, err := savedfiltergrpc.CriteriaFromProto(filter.Criteria)
+		if err != nil {
+			return nil, fmt.Errorf("invalid criteria of saved filter %d: %s", i, status.Convert(err).Message())
+		}
+		export.SavedFilters[i] = &savedfilterdomain.SavedFilter{ID: id, Name: filter.Name, Criteria: criteria}
+	}
+	if settings := pb.TaskSettings; settings != nil {
+		export.TaskSettings = &taskdomain.Settings{RolloverToInbox: settings.RolloverToInbox}
+		if settings.AutoArchiveAfterDays != nil {
+			days := int(*settings.AutoArchiveAfterDays)
+			if days < 1 || days > taskdomain.MaxAutoArchiveAfterDays {
+				return nil, fmt.Errorf("task_settings.auto_archive_after_days must be between 1 and %d", taskdomain.MaxAutoArchiveAfterDays)
+			}
+			export.TaskSettings.AutoArchiveAfterDays = &days
+		}
+	}
+	if pb.TagSettings != nil {
+		export.TagSettings = taggrpc.SettingsFromProto(pb.TagSettings)
+		if err := export.TagSettings.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid tag_settings: %w", err)
+		}
+	}
+	if pb.WeeklyGoal != nil {
+		if *pb.WeeklyGoal < 1 {
+			return nil, errors.New("weekly_goal must be positive")
+		}
+		goal := int(*pb.WeeklyGoal)
+		export.WeeklyGoal = &goal
+	}
+	return export, nil
+}
+
+func taskFromProto(pb *taskv1.Task) (*taskdomain.Task, error) {
+	id, err := uuid.Parse(pb.Id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID %q", pb.Id)
+	}
+	task := &taskdomain.Task{
+		ID:           id,
+		Title:        pb.Title,
+		Notes:        pb.Notes,
+		TagIDs:       make([]uuid.UUID, len(pb.TagIds)),
+		Checklist:    make([]taskdomain.ChecklistItem, len(pb.ChecklistItems)),
+		CreatedAt:    pb.CreatedAt.AsTime(),
+		Pinned:       pb.Pinned,
+		Context:      pb.Context,
+		ArchivedAt:   optionalTime(pb.ArchivedAt),
+		CompletedAt:  optionalTime(pb.CompletedAt),
+		LastViewedAt: optionalTime(pb.LastViewedAt),
+	}
+	for i, tagID := range pb.TagIds {
+		if task.TagIDs[i], err = uuid.Parse(tagID); err != nil {
+			return nil, fmt.Errorf("invalid tag ID %q", tagID)
+		}
+	}
+	if task.StartDate, err = optionalDate(pb.StartDate); err != nil {
+		return nil, fmt.Errorf("invalid start_date %q", *pb.StartDate)
+	}
+	if task.Deadline, err = optionalDate(pb.Deadline); err != nil {
+		return nil, fmt.Errorf("invalid deadline %q", *pb.Deadline)
+	}
+	for i, item := range pb.ChecklistItems {
+		task.Checklist[i] = taskdomain.ChecklistItem{
+			Content:   item.Content,
+			Completed: item.Completed,
+			SortOrder: item.SortOrder,
+			CreatedAt: item.CreatedAt.AsTime(),
+			UpdatedAt: item.UpdatedAt.AsTime(),
+		}
+	}
+	if g := pb.Geofence; g != nil {
+		task.Geofence = &taskdomain.Geofence{
+			Latitude:     g.Latitude,
+			Longitude:    g.Longitude,
+			RadiusMeters: int(g.RadiusMeters),
+			OnArrive:     g.OnArrive,
+			OnLeave:      g.OnLeave,
+		}
+		if err := task.Geofence.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return task, nil
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func optionalDate(date *string) (*time.Time, error) {
+	if date == nil {
+		return nil, nil
+	}
+	parsed, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
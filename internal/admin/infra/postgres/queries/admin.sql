@@ -0,0 +1,14 @@
+-- name: CountUserData :one
+SELECT
+    (SELECT COUNT(*) FROM tasks t WHERE t.owner_id = sqlc.arg(user_id)) AS task_count,
+    (SELECT COUNT(*) FROM tasks t
+     WHERE t.owner_id = sqlc.arg(user_id) AND t.completed_at IS NULL AND t.archived_at IS NULL) AS open_task_count,
+    (SELECT COUNT(*) FROM tasks t
+     WHERE t.owner_id = sqlc.arg(user_id) AND t.completed_at IS NOT NULL) AS completed_task_count,
+    (SELECT COUNT(*) FROM tasks t
+     WHERE t.owner_id = sqlc.arg(user_id) AND t.archived_at IS NOT NULL) AS archived_task_count,
+    (SELECT COUNT(*) FROM tags g WHERE g.owner_id = sqlc.arg(user_id)) AS tag_count,
+    (SELECT COUNT(*) FROM mcp_tokens m WHERE m.user_id = sqlc.arg(user_id)) AS mcp_token_count,
+    (SELECT COUNT(*) FROM mcp_tokens m
+     WHERE m.user_id = sqlc.arg(user_id) AND m.is_active
+       AND (m.expires_at IS NULL OR m.expires_at > NOW())) AS active_mcp_token_count;
@@ -0,0 +1,49 @@
+-- name: ListTagNames :many
+SELECT id, owner_id, name, created_at
+FROM tags
+ORDER BY owner_id, created_at, id;
+
+-- name: RenameTag :execrows
+-- Renames a tag unless its name changed since it was read.
+UPDATE tags
+SET name = sqlc.arg(new_name), updated_at = NOW()
+WHERE id = sqlc.arg(id) AND name = sqlc.arg(old_name);
+
+-- name: MergeTagTasks :execrows
+-- Gives the tasks carrying from_tag_id the tag to_tag_id as well and stamps
+-- the tasks that did not have it yet.
+WITH merged AS (
+    INSERT INTO task_tags (task_id, tag_id)
+    SELECT task_id, sqlc.arg(to_tag_id)::uuid
+    FROM task_tags
+    WHERE tag_id = sqlc.arg(from_tag_id)::uuid
+    ON CONFLICT DO NOTHING
+    RETURNING task_id
+)
+UPDATE tasks
+SET updated_at = NOW(),
+    last_modified_source = sqlc.arg(last_modified_source), last_modified_client_id = sqlc.arg(last_modified_client_id),
+    last_modified_token_id = sqlc.arg(last_modified_token_id), last_modified_token_name = sqlc.arg(last_modified_token_name)
+FROM merged
+WHERE tasks.id = merged.task_id;
+
+-- name: MergeTagFeeds :exec
+UPDATE feeds
+SET tag_id = sqlc.arg(to_tag_id)::uuid
+WHERE tag_id = sqlc.arg(from_tag_id)::uuid;
+
+-- name: MergeTagSavedFilters :exec
+-- Replaces from_tag_id by to_tag_id in the tag_ids of saved filter criteria,
+-- dropping the duplicate when a filter already had both.
+UPDATE saved_filters
+SET criteria = jsonb_set(criteria, '{tag_ids}', (
+        SELECT jsonb_agg(DISTINCT CASE WHEN e = to_jsonb(sqlc.arg(from_tag_id)::text) THEN to_jsonb(sqlc.arg(to_tag_id)::text) ELSE e END)
+        FROM jsonb_array_elements(criteria->'tag_ids') e
+    )),
+    updated_at = NOW()
+WHERE criteria->'tag_ids' @> jsonb_build_array(sqlc.arg(from_tag_id)::text);
+
+-- name: DeleteMergedTag :execrows
+-- Deletes a tag unless its name changed since it was read.
+DELETE FROM tags
+WHERE id = sqlc.arg(id) AND name = sqlc.arg(name);
@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/slips-ai/slips-core/internal/admin/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// AdminRepository implements domain.Repository using PostgreSQL
+type AdminRepository struct {
+	pool        *pgxpool.Pool
+	queries     *Queries
+	readQueries *Queries
+}
+
+// NewAdminRepository creates a new admin repository.
+// Counts run on reader, so operator queries stay off the primary when
+// replicas are configured.
+func NewAdminRepository(pool *pgxpool.Pool, reader DBTX) *AdminRepository {
+	return &AdminRepository{
+		pool:        pool,
+		queries:     New(pool),
+		readQueries: New(reader),
+	}
+}
+
+// CountUserData counts the tasks, tags and MCP tokens owned by a user
+func (r *AdminRepository) CountUserData(ctx context.Context, userID string) (*domain.UserCounts, error) {
+	result, err := r.readQueries.CountUserData(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.UserCounts{
+		Tasks:           int(result.TaskCount),
+		OpenTasks:       int(result.OpenTaskCount),
+		CompletedTasks:  int(result.CompletedTaskCount),
+		ArchivedTasks:   int(result.ArchivedTaskCount),
+		Tags:            int(result.TagCount),
+		MCPTokens:       int(result.McpTokenCount),
+		ActiveMCPTokens: int(result.ActiveMcpTokenCount),
+	}, nil
+}
+
+// ListTagNames lists the tags of every owner. It reads the primary, since
+// the changes planned from it are applied there.
+func (r *AdminRepository) ListTagNames(ctx context.Context) ([]domain.TagName, error) {
+	rows, err := r.queries.ListTagNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]domain.TagName, len(rows))
+	for i, row := range rows {
+		tagID, err := uuid.FromBytes(row.ID.Bytes[:])
+		if err != nil {
+			return nil, err
+		}
+		tags[i] = domain.TagName{
+			ID:        tagID,
+			OwnerID:   row.OwnerID,
+			Name:      row.Name,
+			CreatedAt: row.CreatedAt.Time,
+		}
+	}
+	return tags, nil
+}
+
+// ApplyTagNameChanges applies changes in order in one transaction
+func (r *AdminRepository) ApplyTagNameChanges(ctx context.Context, changes []domain.TagNameChange, by taskdomain.Modifier) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+	txQueries := r.queries.WithTx(tx)
+
+	var reassigned int64
+	for _, change := range changes {
+		pgID := pgtype.UUID{Bytes: change.TagID, Valid: true}
+
+		if change.MergedInto == nil {
+			renamed, err := txQueries.RenameTag(ctx, RenameTagParams{
+				NewName: change.NewName,
+				ID:      pgID,
+				OldName: change.OldName,
+			})
+			if err != nil {
+				return 0, err
+			}
+			if renamed == 0 {
+				return 0, domain.ErrTagNamesChanged
+			}
+			continue
+		}
+
+		intoID := pgtype.UUID{Bytes: *change.MergedInto, Valid: true}
+		tasks, err := txQueries.MergeTagTasks(ctx, MergeTagTasksParams{
+			ToTagID:               intoID,
+			FromTagID:             pgID,
+			LastModifiedSource:    pgtype.Text{String: string(by.Source), Valid: by.Source != ""},
+			LastModifiedClientID:  pgtype.Text{String: by.ClientID, Valid: by.ClientID != ""},
+			LastModifiedTokenID:   pgtype.UUID{Bytes: by.TokenID, Valid: by.TokenID != uuid.Nil},
+			LastModifiedTokenName: pgtype.Text{String: by.TokenName, Valid: by.TokenName != ""},
+		})
+		if err != nil {
+			return 0, err
+		}
+		reassigned += tasks
+
+		if err := txQueries.MergeTagFeeds(ctx, MergeTagFeedsParams{
+			ToTagID:   intoID,
+			FromTagID: pgID,
+		}); err != nil {
+			return 0, err
+		}
+		if err := txQueries.MergeTagSavedFilters(ctx, MergeTagSavedFiltersParams{
+			FromTagID: change.TagID.String(),
+			ToTagID:   change.MergedInto.String(),
+		}); err != nil {
+			return 0, err
+		}
+
+		deleted, err := txQueries.DeleteMergedTag(ctx, DeleteMergedTagParams{
+			ID:   pgID,
+			Name: change.OldName,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if deleted == 0 {
+			return 0, domain.ErrTagNamesChanged
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return reassigned, nil
+}
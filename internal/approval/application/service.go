@@ -0,0 +1,265 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/approval/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("approval-service")
+
+// TaskDeleter deletes the tasks of approved deletions; the task service
+// implements it
+type TaskDeleter interface {
+	DeleteTask(ctx context.Context, id uuid.UUID) error
+}
+
+// Service provides approval business logic
+type Service struct {
+	repo   domain.Repository
+	tasks  TaskDeleter
+	logger *slog.Logger
+}
+
+// NewService creates a new approval service
+func NewService(repo domain.Repository, tasks TaskDeleter, logger *slog.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		tasks:  tasks,
+		logger: logger,
+	}
+}
+
+// RequiresDeleteApproval reports whether task deletions by the caller must
+// wait for approval: the caller uses an MCP token and its user turned
+// approvals on
+func (s *Service) RequiresDeleteApproval(ctx context.Context) (bool, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || !principal.IsAgent() {
+		return false, nil
+	}
+
+	settings, err := s.repo.GetSettings(ctx, principal.UserID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get approval settings", "error", err)
+		return false, err
+	}
+	return settings.RequireAgentDeleteApproval, nil
+}
+
+// RequestDeletion records a pending approval for deleting the tasks,
+// attributed to the caller's MCP token
+func (s *Service) RequestDeletion(ctx context.Context, taskIDs []uuid.UUID) (*domain.Approval, error) {
+	ctx, span := tracer.Start(ctx, "RequestDeletion", trace.WithAttributes(
+		attribute.Int("task_count", len(taskIDs)),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var tokenID uuid.UUID
+	var tokenName string
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		tokenID, tokenName = principal.TokenID, principal.TokenName
+	}
+
+	approval := domain.NewApproval(userID, domain.ActionDeleteTasks, taskIDs, tokenID, tokenName)
+	if err := s.repo.Create(ctx, approval); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create approval", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.WarnContext(ctx, "audit", "event", "approval.requested", "id", approval.ID,
+		"action", approval.Action, "task_ids", taskIDs)
+	return approval, nil
+}
+
+// GetApproval retrieves an approval by ID
+func (s *Service) GetApproval(ctx context.Context, id uuid.UUID) (*domain.Approval, error) {
+	ctx, span := tracer.Start(ctx, "GetApproval", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	approval, err := s.repo.Get(ctx, id, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get approval", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return approval, nil
+}
+
+// ListApprovals lists approvals newest first; an empty status lists
+// approvals of every status
+func (s *Service) ListApprovals(ctx context.Context, status domain.Status, limit, offset int) ([]*domain.Approval, error) {
+	ctx, span := tracer.Start(ctx, "ListApprovals", trace.WithAttributes(
+		attribute.String("status", string(status)),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	approvals, err := s.repo.List(ctx, userID, status, limit, offset)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list approvals", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return approvals, nil
+}
+
+// ApproveAction carries out a pending approval and marks it approved. If a
+// task fails to delete the approval stays pending, so approving it again
+// retries; tasks deleted in the meantime are skipped.
+func (s *Service) ApproveAction(ctx context.Context, id uuid.UUID) (*domain.Approval, error) {
+	ctx, span := tracer.Start(ctx, "ApproveAction", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	approval, err := s.repo.Get(ctx, id, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get approval for approving", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if approval.Status != domain.StatusPending {
+		return nil, domain.ErrNotPending
+	}
+
+	for _, taskID := range approval.TaskIDs {
+		if err := s.tasks.DeleteTask(ctx, taskID); err != nil {
+			s.logger.ErrorContext(ctx, "failed to delete approved task", "id", id, "task_id", taskID, "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	approval, err = s.repo.Decide(ctx, id, userID, domain.StatusApproved)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to approve approval", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.WarnContext(ctx, "audit", "event", "approval.approved", "id", id,
+		"action", approval.Action, "task_ids", approval.TaskIDs)
+	return approval, nil
+}
+
+// RejectAction marks a pending approval rejected without carrying it out
+func (s *Service) RejectAction(ctx context.Context, id uuid.UUID) (*domain.Approval, error) {
+	ctx, span := tracer.Start(ctx, "RejectAction", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	approval, err := s.repo.Decide(ctx, id, userID, domain.StatusRejected)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to reject approval", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.WarnContext(ctx, "audit", "event", "approval.rejected", "id", id,
+		"action", approval.Action, "task_ids", approval.TaskIDs)
+	return approval, nil
+}
+
+// GetSettings returns the caller's approval settings
+func (s *Service) GetSettings(ctx context.Context) (*domain.Settings, error) {
+	ctx, span := tracer.Start(ctx, "GetApprovalSettings")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	settings, err := s.repo.GetSettings(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get approval settings", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpdateSettings replaces the caller's approval settings. The authorization
+// policy keeps agents from calling it, or they could turn approvals off for
+// themselves.
+func (s *Service) UpdateSettings(ctx context.Context, settings *domain.Settings) (*domain.Settings, error) {
+	ctx, span := tracer.Start(ctx, "UpdateApprovalSettings", trace.WithAttributes(
+		attribute.Bool("require_agent_delete_approval", settings.RequireAgentDeleteApproval),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := s.repo.SetSettings(ctx, userID, settings); err != nil {
+		s.logger.ErrorContext(ctx, "failed to update approval settings", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "approval settings updated", "owner_id", userID,
+		"require_agent_delete_approval", settings.RequireAgentDeleteApproval)
+	return settings, nil
+}
@@ -0,0 +1,115 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/approval/domain"
+	"github.com/slips-ai/slips-core/internal/memory"
+	taskapp "github.com/slips-ai/slips-core/internal/task/application"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+func newTestServices() (*Service, *taskapp.Service) {
+	store := memory.NewStore()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tasks := taskapp.NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), taskdomain.DefaultChecklistLimits, logger)
+	return NewService(memory.NewApprovalRepository(store), tasks, logger), tasks
+}
+
+func userContext() context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "owner", Credential: auth.CredentialJWT})
+}
+
+func agentContext() context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{
+		UserID:     "owner",
+		Credential: auth.CredentialMCPToken,
+		TokenID:    uuid.New(),
+		TokenName:  "assistant",
+	})
+}
+
+func TestRequiresDeleteApproval(t *testing.T) {
+	service, _ := newTestServices()
+
+	if required, err := service.RequiresDeleteApproval(agentContext()); err != nil || required {
+		t.Fatalf("before opting in = %v, %v; want false", required, err)
+	}
+	if _, err := service.UpdateSettings(userContext(), &domain.Settings{RequireAgentDeleteApproval: true}); err != nil {
+		t.Fatalf("update settings: %v", err)
+	}
+	if required, err := service.RequiresDeleteApproval(agentContext()); err != nil || !required {
+		t.Errorf("agent = %v, %v; want true", required, err)
+	}
+	if required, err := service.RequiresDeleteApproval(userContext()); err != nil || required {
+		t.Errorf("user = %v, %v; want false", required, err)
+	}
+}
+
+func TestApproveAction(t *testing.T) {
+	service, tasks := newTestServices()
+	task, err := tasks.CreateTask(userContext(), "doomed", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+
+	approval, err := service.RequestDeletion(agentContext(), []uuid.UUID{task.ID})
+	if err != nil {
+		t.Fatalf("request deletion: %v", err)
+	}
+	if approval.Status != domain.StatusPending || approval.RequestedByTokenName != "assistant" {
+		t.Fatalf("approval = %+v", approval)
+	}
+	if _, err := tasks.GetTask(userContext(), task.ID); err != nil {
+		t.Fatalf("task deleted before approval: %v", err)
+	}
+
+	approved, err := service.ApproveAction(userContext(), approval.ID)
+	if err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+	if approved.Status != domain.StatusApproved || approved.DecidedAt == nil {
+		t.Errorf("approved = %+v", approved)
+	}
+	if _, err := tasks.GetTask(userContext(), task.ID); err == nil {
+		t.Error("task still exists after approval")
+	}
+	if _, err := service.RejectAction(userContext(), approval.ID); !errors.Is(err, domain.ErrNotPending) {
+		t.Errorf("reject after approval error = %v, want ErrNotPending", err)
+	}
+}
+
+func TestRejectAction(t *testing.T) {
+	service, tasks := newTestServices()
+	task, err := tasks.CreateTask(userContext(), "kept", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	approval, err := service.RequestDeletion(agentContext(), []uuid.UUID{task.ID})
+	if err != nil {
+		t.Fatalf("request deletion: %v", err)
+	}
+
+	rejected, err := service.RejectAction(userContext(), approval.ID)
+	if err != nil {
+		t.Fatalf("reject: %v", err)
+	}
+	if rejected.Status != domain.StatusRejected {
+		t.Errorf("status = %q, want rejected", rejected.Status)
+	}
+	if _, err := tasks.GetTask(userContext(), task.ID); err != nil {
+		t.Errorf("task deleted after rejection: %v", err)
+	}
+
+	pending, err := service.ListApprovals(userContext(), domain.StatusPending, 10, 0)
+	if err != nil || len(pending) != 0 {
+		t.Errorf("pending approvals = %v, %v; want none", pending, err)
+	}
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+// Action is the kind of change an approval holds back
+type Action string
+
+const (
+	// ActionDeleteTasks deletes the tasks of the approval
+	ActionDeleteTasks Action = "delete_tasks"
+)
+
+// Status is where an approval stands
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+)
+
+var (
+	// ErrNotPending is returned when deciding an approval that was already
+	// approved or rejected
+	ErrNotPending = errors.New("approval is not pending")
+	// ErrApprovalNotFound is returned for approvals that do not exist or
+	// belong to another user
+	ErrApprovalNotFound = domainerrors.New(domainerrors.ErrNotFound, "approval not found")
+)
+
+// Approval is a destructive action requested by an agent, waiting for its
+// user to approve or reject it
+type Approval struct {
+	ID      uuid.UUID
+	OwnerID string
+	Action  Action
+	TaskIDs []uuid.UUID
+	// RequestedByTokenID and RequestedByTokenName identify the MCP token the
+	// action was requested with
+	RequestedByTokenID   uuid.UUID
+	RequestedByTokenName string
+	Status               Status
+	CreatedAt            time.Time
+	// DecidedAt is set once the approval is approved or rejected
+	DecidedAt *time.Time
+}
+
+// NewApproval creates a pending approval
+// Note: CreatedAt is not set here.
+// It will be populated by the database on insertion (DEFAULT NOW()).
+func NewApproval(ownerID string, action Action, taskIDs []uuid.UUID, tokenID uuid.UUID, tokenName string) *Approval {
+	return &Approval{
+		ID:                   uuid.New(),
+		OwnerID:              ownerID,
+		Action:               action,
+		TaskIDs:              taskIDs,
+		RequestedByTokenID:   tokenID,
+		RequestedByTokenName: tokenName,
+		Status:               StatusPending,
+	}
+}
+
+// Settings holds a user's approval preferences
+type Settings struct {
+	// RequireAgentDeleteApproval holds back task deletions requested with an
+	// MCP token until the user approves them
+	RequireAgentDeleteApproval bool
+}
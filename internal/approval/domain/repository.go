@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for approval persistence
+type Repository interface {
+	Create(ctx context.Context, approval *Approval) error
+	// Get returns ErrApprovalNotFound for approvals that do not exist or
+	// belong to another owner
+	Get(ctx context.Context, id uuid.UUID, ownerID string) (*Approval, error)
+	// List returns the owner's approvals, newest first; an empty status
+	// lists approvals of every status
+	List(ctx context.Context, ownerID string, status Status, limit, offset int) ([]*Approval, error)
+	// Decide moves a pending approval to status, returning ErrNotPending if
+	// it was decided already
+	Decide(ctx context.Context, id uuid.UUID, ownerID string, status Status) (*Approval, error)
+	// GetSettings returns the owner's approval settings, or the defaults if
+	// never set
+	GetSettings(ctx context.Context, ownerID string) (*Settings, error)
+	SetSettings(ctx context.Context, ownerID string, settings *Settings) error
+}
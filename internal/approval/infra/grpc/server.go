@@ -0,0 +1,219 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	approvalv1 "github.com/slips-ai/slips-core/gen/go/approval/v1"
+	"github.com/slips-ai/slips-core/internal/approval/application"
+	"github.com/slips-ai/slips-core/internal/approval/domain"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ApprovalServer implements the ApprovalService gRPC server
+type ApprovalServer struct {
+	approvalv1.UnimplementedApprovalServiceServer
+	service *application.Service
+}
+
+// NewApprovalServer creates a new approval gRPC server
+func NewApprovalServer(service *application.Service) *ApprovalServer {
+	return &ApprovalServer{
+		service: service,
+	}
+}
+
+// GetApproval retrieves an approval by ID
+func (s *ApprovalServer) GetApproval(ctx context.Context, req *approvalv1.GetApprovalRequest) (*approvalv1.GetApprovalResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid approval ID format")
+	}
+
+	approval, err := s.service.GetApproval(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to get approval")
+	}
+
+	return &approvalv1.GetApprovalResponse{
+		Approval: approvalToProto(approval),
+	}, nil
+}
+
+// ListApprovals lists approvals newest first with pagination
+func (s *ApprovalServer) ListApprovals(ctx context.Context, req *approvalv1.ListApprovalsRequest) (*approvalv1.ListApprovalsResponse, error) {
+	// Reject page_token if provided (not yet implemented)
+	if req.PageToken != "" {
+		return nil, status.Errorf(codes.Unimplemented, "page_token is not supported yet")
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 30
+	}
+
+	// Always return the first page (offset 0) until pagination tokens are implemented
+	offset := 0
+
+	approvalStatus, err := statusFromProto(req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals, err := s.service.ListApprovals(ctx, approvalStatus, pageSize, offset)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to list approvals")
+	}
+
+	protoApprovals := make([]*approvalv1.Approval, len(approvals))
+	for i, approval := range approvals {
+		protoApprovals[i] = approvalToProto(approval)
+	}
+
+	return &approvalv1.ListApprovalsResponse{
+		Approvals: protoApprovals,
+	}, nil
+}
+
+// ApproveAction carries out a pending action
+func (s *ApprovalServer) ApproveAction(ctx context.Context, req *approvalv1.ApproveActionRequest) (*approvalv1.ApproveActionResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid approval ID format")
+	}
+
+	approval, err := s.service.ApproveAction(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to approve action")
+	}
+
+	return &approvalv1.ApproveActionResponse{
+		Approval: approvalToProto(approval),
+	}, nil
+}
+
+// RejectAction drops a pending action
+func (s *ApprovalServer) RejectAction(ctx context.Context, req *approvalv1.RejectActionRequest) (*approvalv1.RejectActionResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid approval ID format")
+	}
+
+	approval, err := s.service.RejectAction(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to reject action")
+	}
+
+	return &approvalv1.RejectActionResponse{
+		Approval: approvalToProto(approval),
+	}, nil
+}
+
+// GetApprovalSettings returns the caller's approval settings
+func (s *ApprovalServer) GetApprovalSettings(ctx context.Context, req *approvalv1.GetApprovalSettingsRequest) (*approvalv1.GetApprovalSettingsResponse, error) {
+	settings, err := s.service.GetSettings(ctx)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to get approval settings")
+	}
+
+	return &approvalv1.GetApprovalSettingsResponse{
+		Settings: settingsToProto(settings),
+	}, nil
+}
+
+// UpdateApprovalSettings replaces the caller's approval settings
+func (s *ApprovalServer) UpdateApprovalSettings(ctx context.Context, req *approvalv1.UpdateApprovalSettingsRequest) (*approvalv1.UpdateApprovalSettingsResponse, error) {
+	if req.Settings == nil {
+		return nil, status.Error(codes.InvalidArgument, "settings is required")
+	}
+
+	settings, err := s.service.UpdateSettings(ctx, &domain.Settings{
+		RequireAgentDeleteApproval: req.Settings.RequireAgentDeleteApproval,
+	})
+	if err != nil {
+		return nil, toGRPCError(err, "failed to update approval settings")
+	}
+
+	return &approvalv1.UpdateApprovalSettingsResponse{
+		Settings: settingsToProto(settings),
+	}, nil
+}
+
+// toGRPCError maps decisions on settled approvals to FailedPrecondition and
+// defers everything else to grpcerrors.ToGRPCError
+func toGRPCError(err error, defaultMsg string) error {
+	if errors.Is(err, domain.ErrNotPending) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return grpcerrors.ToGRPCError(err, defaultMsg)
+}
+
+func statusFromProto(s approvalv1.ApprovalStatus) (domain.Status, error) {
+	switch s {
+	case approvalv1.ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED:
+		return "", nil
+	case approvalv1.ApprovalStatus_APPROVAL_STATUS_PENDING:
+		return domain.StatusPending, nil
+	case approvalv1.ApprovalStatus_APPROVAL_STATUS_APPROVED:
+		return domain.StatusApproved, nil
+	case approvalv1.ApprovalStatus_APPROVAL_STATUS_REJECTED:
+		return domain.StatusRejected, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "unknown status %d", s)
+	}
+}
+
+func statusToProto(s domain.Status) approvalv1.ApprovalStatus {
+	switch s {
+	case domain.StatusPending:
+		return approvalv1.ApprovalStatus_APPROVAL_STATUS_PENDING
+	case domain.StatusApproved:
+		return approvalv1.ApprovalStatus_APPROVAL_STATUS_APPROVED
+	case domain.StatusRejected:
+		return approvalv1.ApprovalStatus_APPROVAL_STATUS_REJECTED
+	default:
+		return approvalv1.ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
+	}
+}
+
+func actionToProto(a domain.Action) approvalv1.ApprovalAction {
+	switch a {
+	case domain.ActionDeleteTasks:
+		return approvalv1.ApprovalAction_APPROVAL_ACTION_DELETE_TASKS
+	default:
+		return approvalv1.ApprovalAction_APPROVAL_ACTION_UNSPECIFIED
+	}
+}
+
+func approvalToProto(approval *domain.Approval) *approvalv1.Approval {
+	taskIDs := make([]string, len(approval.TaskIDs))
+	for i, taskID := range approval.TaskIDs {
+		taskIDs[i] = taskID.String()
+	}
+
+	protoApproval := &approvalv1.Approval{
+		Id:                   approval.ID.String(),
+		Action:               actionToProto(approval.Action),
+		TaskIds:              taskIDs,
+		RequestedByTokenName: approval.RequestedByTokenName,
+		Status:               statusToProto(approval.Status),
+		CreatedAt:            timestamppb.New(approval.CreatedAt),
+	}
+	if approval.RequestedByTokenID != uuid.Nil {
+		protoApproval.RequestedByTokenId = approval.RequestedByTokenID.String()
+	}
+	if approval.DecidedAt != nil {
+		protoApproval.DecidedAt = timestamppb.New(*approval.DecidedAt)
+	}
+	return protoApproval
+}
+
+func settingsToProto(settings *domain.Settings) *approvalv1.ApprovalSettings {
+	return &approvalv1.ApprovalSettings{
+		RequireAgentDeleteApproval: settings.RequireAgentDeleteApproval,
+	}
+}
@@ -0,0 +1,23 @@
+-- name: CreateApproval :one
+INSERT INTO approvals (id, owner_id, action, task_ids, requested_by_token_id, requested_by_token_name)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, owner_id, action, task_ids, requested_by_token_id, requested_by_token_name, status, created_at, decided_at;
+
+-- name: GetApproval :one
+SELECT id, owner_id, action, task_ids, requested_by_token_id, requested_by_token_name, status, created_at, decided_at
+FROM approvals
+WHERE id = $1 AND owner_id = $2;
+
+-- name: ListApprovals :many
+SELECT id, owner_id, action, task_ids, requested_by_token_id, requested_by_token_name, status, created_at, decided_at
+FROM approvals
+WHERE owner_id = sqlc.arg(owner_id)
+  AND (sqlc.arg(status)::text = '' OR status = sqlc.arg(status)::text)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: DecideApproval :one
+UPDATE approvals
+SET status = $3, decided_at = NOW()
+WHERE id = $1 AND owner_id = $2 AND status = 'pending'
+RETURNING id, owner_id, action, task_ids, requested_by_token_id, requested_by_token_name, status, created_at, decided_at;
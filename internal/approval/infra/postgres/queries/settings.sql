@@ -0,0 +1,11 @@
+-- name: GetApprovalSettings :one
+SELECT owner_id, require_agent_delete_approval, created_at, updated_at
+FROM approval_settings
+WHERE owner_id = $1;
+
+-- name: UpsertApprovalSettings :exec
+INSERT INTO approval_settings (owner_id, require_agent_delete_approval)
+VALUES ($1, $2)
+ON CONFLICT (owner_id) DO UPDATE
+SET require_agent_delete_approval = EXCLUDED.require_agent_delete_approval,
+    updated_at = NOW();
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/approval/domain"
+)
+
+// ApprovalRepository implements domain.Repository using PostgreSQL
+type ApprovalRepository struct {
+	queries     *Queries
+	readQueries *Queries
+}
+
+// NewApprovalRepository creates a new approval repository.
+// List runs on reader, which may be a read replica router.
+func NewApprovalRepository(pool DBTX, reader DBTX) *ApprovalRepository {
+	return &ApprovalRepository{
+		queries:     New(pool),
+		readQueries: New(reader),
+	}
+}
+
+// Create creates a new approval
+func (r *ApprovalRepository) Create(ctx context.Context, approval *domain.Approval) error {
+	taskIDs := make([]pgtype.UUID, len(approval.TaskIDs))
+	for i, id := range approval.TaskIDs {
+		taskIDs[i] = pgtype.UUID{Bytes: id, Valid: true}
+	}
+
+	result, err := r.queries.CreateApproval(ctx, CreateApprovalParams{
+		ID:                   pgtype.UUID{Bytes: approval.ID, Valid: true},
+		OwnerID:              approval.OwnerID,
+		Action:               string(approval.Action),
+		TaskIds:              taskIDs,
+		RequestedByTokenID:   pgtype.UUID{Bytes: approval.RequestedByTokenID, Valid: approval.RequestedByTokenID != uuid.Nil},
+		RequestedByTokenName: approval.RequestedByTokenName,
+	})
+	if err != nil {
+		return err
+	}
+
+	approval.Status = domain.Status(result.Status)
+	approval.CreatedAt = result.CreatedAt.Time
+	return nil
+}
+
+// Get retrieves an approval by ID
+func (r *ApprovalRepository) Get(ctx context.Context, id uuid.UUID, ownerID string) (*domain.Approval, error) {
+	result, err := r.queries.GetApproval(ctx, GetApprovalParams{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		OwnerID: ownerID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrApprovalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return approvalFromDB(result), nil
+}
+
+// List lists approvals with pagination, newest first
+func (r *ApprovalRepository) List(ctx context.Context, ownerID string, status domain.Status, limit, offset int) ([]*domain.Approval, error) {
+	// Validate parameters to prevent negative values and potential overflow
+	if limit < 0 {
+		limit = 0
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Convert to int32 (validation is done at gRPC layer)
+	results, err := r.readQueries.ListApprovals(ctx, ListApprovalsParams{
+		OwnerID:   ownerID,
+		Status:    string(status),
+		RowLimit:  int32(limit),
+		RowOffset: int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]*domain.Approval, len(results))
+	for i, result := range results {
+		approvals[i] = approvalFromDB(result)
+	}
+	return approvals, nil
+}
+
+// Decide moves a pending approval to status. An approval that exists but
+// is no longer pending returns ErrNotPending rather than ErrApprovalNotFound.
+func (r *ApprovalRepository) Decide(ctx context.Context, id uuid.UUID, ownerID string, status domain.Status) (*domain.Approval, error) {
+	result, err := r.queries.DecideApproval(ctx, DecideApprovalParams{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		OwnerID: ownerID,
+		Status:  string(status),
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		if _, getErr := r.Get(ctx, id, ownerID); getErr != nil {
+			return nil, getErr
+		}
+		return nil, domain.ErrNotPending
+	}
+
+	return approvalFromDB(result), nil
+}
+
+// GetSettings returns the owner's approval settings, or the defaults if
+// never set
+func (r *ApprovalRepository) GetSettings(ctx context.Context, ownerID string) (*domain.Settings, error) {
+	result, err := r.queries.GetApprovalSettings(ctx, ownerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domain.Settings{}, nil
+		}
+		return nil, err
+	}
+
+	return &domain.Settings{
+		RequireAgentDeleteApproval: result.RequireAgentDeleteApproval,
+	}, nil
+}
+
+// SetSettings stores the owner's approval settings
+func (r *ApprovalRepository) SetSettings(ctx context.Context, ownerID string, settings *domain.Settings) error {
+	return r.queries.UpsertApprovalSettings(ctx, UpsertApprovalSettingsParams{
+		OwnerID:                    ownerID,
+		RequireAgentDeleteApproval: settings.RequireAgentDeleteApproval,
+	})
+}
+
+func approvalFromDB(row Approval) *domain.Approval {
+	taskIDs := make([]uuid.UUID, len(row.TaskIds))
+	for i, id := range row.TaskIds {
+		taskIDs[i] = id.Bytes
+	}
+
+	approval := &domain.Approval{
+		ID:                   row.ID.Bytes,
+		OwnerID:              row.OwnerID,
+		Action:               domain.Action(row.Action),
+		TaskIDs:              taskIDs,
+		RequestedByTokenName: row.RequestedByTokenName,
+		Status:               domain.Status(row.Status),
+		CreatedAt:            row.CreatedAt.Time,
+	}
+	if row.RequestedByTokenID.Valid {
+		approval.RequestedByTokenID = row.RequestedByTokenID.Bytes
+	}
+	if row.DecidedAt.Valid {
+		decidedAt := row.DecidedAt.Time
+		approval.DecidedAt = &decidedAt
+	}
+	return approval
+}
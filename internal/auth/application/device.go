@@ -0,0 +1,307 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	// ErrDeviceCodeExpired is returned for device or user codes past their expiry
+	ErrDeviceCodeExpired = errors.New("device code expired")
+	// ErrDeviceAlreadyAuthorized is returned when a user code is entered again
+	// after the device was authorized
+	ErrDeviceAlreadyAuthorized = errors.New("device already authorized")
+)
+
+// DeviceFlowConfig configures the OAuth device authorization flow
+type DeviceFlowConfig struct {
+	// VerificationURL is the page where users enter their user code
+	VerificationURL string
+	// CodeTTL is how long the device and user codes stay valid
+	CodeTTL time.Duration
+	// PollInterval is the minimum time between two polls of a device
+	PollInterval time.Duration
+}
+
+// DeviceStatus is the state reported to a polling device
+type DeviceStatus int
+
+const (
+	// DeviceStatusPending means the user has not completed OAuth yet
+	DeviceStatusPending DeviceStatus = iota
+	// DeviceStatusSlowDown means the device polled faster than the interval
+	DeviceStatusSlowDown
+	// DeviceStatusApproved means the tokens are included in the result
+	DeviceStatusApproved
+)
+
+// DeviceAuthorizationResult is returned to a device starting the flow
+type DeviceAuthorizationResult struct {
+	DeviceCode              string
+	UserCode                string
+	VerificationURI         string
+	VerificationURIComplete string
+	ExpiresIn               time.Duration
+	Interval                time.Duration
+}
+
+// DevicePollResult is returned to a polling device
+type DevicePollResult struct {
+	Status DeviceStatus
+	UserID string
+	Token  *TokenResult
+}
+
+// userCodeAlphabet leaves out vowels, so codes never spell words, and
+// characters that are easily confused (0/O, 1/I)
+const userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+
+// StartDeviceAuthorization starts a device flow: it obtains an OAuth URL and
+// state from Identra and issues a device code and user code bound to them
+func (s *Service) StartDeviceAuthorization(ctx context.Context, provider string) (*DeviceAuthorizationResult, error) {
+	ctx, span := tracer.Start(ctx, "StartDeviceAuthorization", trace.WithAttributes(
+		attribute.String("provider", provider),
+	))
+	defer span.End()
+
+	now := time.Now()
+	if deleted, err := s.deviceRepo.DeleteExpiredDeviceAuthorizations(ctx, now); err != nil {
+		s.logger.WarnContext(ctx, "failed to delete expired device authorizations", "error", err)
+	} else if deleted > 0 {
+		s.logger.DebugContext(ctx, "deleted expired device authorizations", "count", deleted)
+	}
+
+	resp, err := s.identraClient.GetOAuthAuthorizationURL(ctx, provider, s.redirectURL)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get OAuth authorization URL", "error", err, "provider", provider)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := s.issueOAuthState(ctx, resp.State, provider, "", "", s.device.CodeTTL); err != nil {
+		s.logger.ErrorContext(ctx, "failed to store OAuth state", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	deviceCode, err := newDeviceCode()
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	userCode, err := newUserCode()
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	authorization := &domain.DeviceAuthorization{
+		DeviceCodeHash:   hashDeviceCode(deviceCode),
+		UserCode:         userCode,
+		OAuthState:       resp.State,
+		AuthorizationURL: resp.Url,
+		Interval:         s.device.PollInterval,
+		ExpiresAt:        now.Add(s.device.CodeTTL),
+	}
+	if err := s.deviceRepo.CreateDeviceAuthorization(ctx, authorization); err != nil {
+		s.logger.ErrorContext(ctx, "failed to store device authorization", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "device authorization started", "provider", provider)
+	return &DeviceAuthorizationResult{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationURI:         s.device.VerificationURL,
+		VerificationURIComplete: verificationURIComplete(s.device.VerificationURL, userCode),
+		ExpiresIn:               s.device.CodeTTL,
+		Interval:                s.device.PollInterval,
+	}, nil
+}
+
+// GetDeviceVerificationURL returns the OAuth URL for the device
+// authorization a user code belongs to, for the browser to open
+func (s *Service) GetDeviceVerificationURL(ctx context.Context, userCode string) (string, error) {
+	ctx, span := tracer.Start(ctx, "GetDeviceVerificationURL")
+	defer span.End()
+
+	authorization, err := s.deviceRepo.GetDeviceAuthorizationByUserCode(ctx, NormalizeUserCode(userCode))
+	if err != nil {
+		s.logger.DebugContext(ctx, "device user code not found", "error", err)
+		span.RecordError(err)
+		return "", err
+	}
+	if authorization.IsExpired(time.Now()) {
+		return "", ErrDeviceCodeExpired
+	}
+	if authorization.IsApproved() {
+		return "", ErrDeviceAlreadyAuthorized
+	}
+	return authorization.AuthorizationURL, nil
+}
+
+// PollDeviceAuthorization reports whether the user has authorized the
+// device and hands out its tokens exactly once
+func (s *Service) PollDeviceAuthorization(ctx context.Context, deviceCode string) (*DevicePollResult, error) {
+	ctx, span := tracer.Start(ctx, "PollDeviceAuthorization")
+	defer span.End()
+
+	hash := hashDeviceCode(deviceCode)
+	authorization, err := s.deviceRepo.GetDeviceAuthorizationByDeviceCode(ctx, hash)
+	if err != nil {
+		s.logger.DebugContext(ctx, "device code not found", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	now := time.Now()
+	if authorization.IsExpired(now) {
+		if err := s.deviceRepo.DeleteDeviceAuthorization(ctx, hash); err != nil {
+			s.logger.WarnContext(ctx, "failed to delete expired device authorization", "error", err)
+		}
+		return nil, ErrDeviceCodeExpired
+	}
+
+	if authorization.IsApproved() {
+		consumed, err := s.deviceRepo.ConsumeDeviceAuthorization(ctx, hash)
+		if err != nil {
+			// A concurrent poll collected the tokens first
+			span.RecordError(err)
+			return nil, err
+		}
+		s.logger.InfoContext(ctx, "device authorized", "user_id", consumed.UserID)
+		return &DevicePollResult{
+			Status: DeviceStatusApproved,
+			UserID: consumed.UserID,
+			Token: &TokenResult{
+				AccessToken:           consumed.Token.AccessToken,
+				AccessTokenExpiresAt:  consumed.Token.AccessTokenExpiresAt,
+				RefreshToken:          consumed.Token.RefreshToken,
+				RefreshTokenExpiresAt: consumed.Token.RefreshTokenExpiresAt,
+				TokenType:             consumed.Token.TokenType,
+			},
+		}, nil
+	}
+
+	status := DeviceStatusPending
+	if authorization.LastPolledAt != nil && now.Sub(*authorization.LastPolledAt) < authorization.Interval {
+		status = DeviceStatusSlowDown
+	}
+	if err := s.deviceRepo.TouchDeviceAuthorization(ctx, hash, now); err != nil {
+		s.logger.WarnContext(ctx, "failed to record device poll", "error", err)
+	}
+	return &DevicePollResult{Status: status}, nil
+}
+
+// lookupDeviceState returns the device authorization for an OAuth state,
+// or nil for regular browser logins
+func (s *Service) lookupDeviceState(ctx context.Context, state string) (*domain.DeviceAuthorization, error) {
+	authorization, err := s.deviceRepo.GetDeviceAuthorizationByState(ctx, state)
+	if errors.Is(err, domain.ErrDeviceAuthorizationNotFound) {
+		return nil, nil
+	}
+	return authorization, err
+}
+
+// NormalizeUserCode accepts user codes typed in lower case, with spaces or
+// without the dash and returns them in the stored "XXXX-XXXX" form
+func NormalizeUserCode(code string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(code) {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteRune(r)
+		}
+	}
+	letters := b.String()
+	if len(letters) != 8 {
+		return letters
+	}
+	return letters[:4] + "-" + letters[4:]
+}
+
+func newDeviceCode() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func newUserCode() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	// 256 is not a multiple of the alphabet size, but the bias is small and
+	// the code only has to be hard to guess within its short lifetime
+	letters := make([]byte, len(buf))
+	for i, b := range buf {
+		letters[i] = userCodeAlphabet[int(b)%len(userCodeAlphabet)]
+	}
+	return string(letters[:4]) + "-" + string(letters[4:]), nil
+}
+
+// hashDeviceCode is what gets stored, so a database leak does not expose
+// codes that could still be exchanged for tokens
+func hashDeviceCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func verificationURIComplete(verificationURL, userCode string) string {
+	if verificationURL == "" {
+		return ""
+	}
+	u, err := url.Parse(verificationURL)
+	if err != nil {
+		return ""
+	}
+	q := u.Query()
+	q.Set("user_code", userCode)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// approveDevice hands the tokens of an OAuth login to the device
+// authorization that started it and strips them from the browser's result
+func (s *Service) approveDevice(ctx context.Context, state string, result *CallbackResult) (*CallbackResult, error) {
+	userID, err := auth.ExtractUserIDFromToken(result.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.deviceRepo.ApproveDeviceAuthorization(ctx, state, userID, &domain.DeviceToken{
+		AccessToken:           result.AccessToken,
+		AccessTokenExpiresAt:  result.AccessTokenExpiresAt,
+		RefreshToken:          result.RefreshToken,
+		RefreshTokenExpiresAt: result.RefreshTokenExpiresAt,
+		TokenType:             result.TokenType,
+	})
+	if errors.Is(err, domain.ErrDeviceAuthorizationNotFound) {
+		return nil, ErrDeviceAlreadyAuthorized
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "device authorization approved", "user_id", userID)
+	return &CallbackResult{
+		Username:         result.Username,
+		Email:            result.Email,
+		AvatarURL:        result.AvatarURL,
+		DeviceAuthorized: true,
+	}, nil
+}
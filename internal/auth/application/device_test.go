@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/internal/memory"
+)
+
+func TestNormalizeUserCode(t *testing.T) {
+	tests := map[string]string{
+		"BCDF-GHJK":   "BCDF-GHJK",
+		"bcdf-ghjk":   "BCDF-GHJK",
+		"bcdfghjk":    "BCDF-GHJK",
+		" BCDF GHJK ": "BCDF-GHJK",
+		"BCD":         "BCD",
+	}
+	for input, want := range tests {
+		if got := NormalizeUserCode(input); got != want {
+			t.Errorf("NormalizeUserCode(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestNewUserCode(t *testing.T) {
+	code, err := newUserCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(code) != 9 || code[4] != '-' {
+		t.Fatalf("user code %q is not in XXXX-XXXX form", code)
+	}
+	for _, r := range strings.ReplaceAll(code, "-", "") {
+		if !strings.ContainsRune(userCodeAlphabet, r) {
+			t.Errorf("user code %q contains %q outside the alphabet", code, r)
+		}
+	}
+	if NormalizeUserCode(code) != code {
+		t.Errorf("generated code %q is not normalized", code)
+	}
+}
+
+func newDeviceTestService(t *testing.T) (*Service, *memory.DeviceAuthorizationRepository) {
+	t.Helper()
+	store := memory.NewStore()
+	deviceRepo := memory.NewDeviceAuthorizationRepository(store)
+	service := NewService(
+		memory.NewUserRepository(store),
+		deviceRepo,
+		memory.NewOAuthStateRepository(store),
+		nil,
+		"github",
+		"",
+		time.Minute,
+		DeviceFlowConfig{CodeTTL: time.Minute, PollInterval: time.Hour},
+		0,
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+	return service, deviceRepo
+}
+
+func TestPollDeviceAuthorization(t *testing.T) {
+	ctx := context.Background()
+	service, repo := newDeviceTestService(t)
+
+	const deviceCode = "device-code"
+	err := repo.CreateDeviceAuthorization(ctx, &domain.DeviceAuthorization{
+		DeviceCodeHash:   hashDeviceCode(deviceCode),
+		UserCode:         "BCDF-GHJK",
+		OAuthState:       "state-1",
+		AuthorizationURL: "https://github.example/authorize",
+		Interval:         time.Hour,
+		ExpiresAt:        time.Now().Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := service.PollDeviceAuthorization(ctx, deviceCode)
+	if err != nil {
+		t.Fatalf("first poll: %v", err)
+	}
+	if result.Status != DeviceStatusPending {
+		t.Errorf("first poll status = %v, want pending", result.Status)
+	}
+
+	result, err = service.PollDeviceAuthorization(ctx, deviceCode)
+	if err != nil {
+		t.Fatalf("second poll: %v", err)
+	}
+	if result.Status != DeviceStatusSlowDown {
+		t.Errorf("poll within the interval status = %v, want slow down", result.Status)
+	}
+
+	url, err := service.GetDeviceVerificationURL(ctx, "bcdfghjk")
+	if err != nil || url != "https://github.example/authorize" {
+		t.Fatalf("GetDeviceVerificationURL = %q, %v", url, err)
+	}
+
+	err = repo.ApproveDeviceAuthorization(ctx, "state-1", "user-1", &domain.DeviceToken{AccessToken: "access", RefreshToken: "refresh"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.GetDeviceVerificationURL(ctx, "BCDF-GHJK"); !errors.Is(err, ErrDeviceAlreadyAuthorized) {
+		t.Errorf("GetDeviceVerificationURL after approval error = %v, want ErrDeviceAlreadyAuthorized", err)
+	}
+
+	result, err = service.PollDeviceAuthorization(ctx, deviceCode)
+	if err != nil {
+		t.Fatalf("poll after approval: %v", err)
+	}
+	if result.Status != DeviceStatusApproved || result.UserID != "user-1" || result.Token.AccessToken != "access" {
+		t.Errorf("unexpected approved result: %+v", result)
+	}
+
+	// Tokens are handed out only once
+	if _, err := service.PollDeviceAuthorization(ctx, deviceCode); !errors.Is(err, domain.ErrDeviceAuthorizationNotFound) {
+		t.Errorf("poll after tokens were collected error = %v, want ErrDeviceAuthorizationNotFound", err)
+	}
+}
+
+func TestPollDeviceAuthorizationExpired(t *testing.T) {
+	ctx := context.Background()
+	service, repo := newDeviceTestService(t)
+
+	const deviceCode = "expired-code"
+	err := repo.CreateDeviceAuthorization(ctx, &domain.DeviceAuthorization{
+		DeviceCodeHash: hashDeviceCode(deviceCode),
+		UserCode:       "BCDF-GHJK",
+		OAuthState:     "state-1",
+		Interval:       time.Second,
+		ExpiresAt:      time.Now().Add(-time.Second),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.PollDeviceAuthorization(ctx, deviceCode); !errors.Is(err, ErrDeviceCodeExpired) {
+		t.Fatalf("poll of expired code error = %v, want ErrDeviceCodeExpired", err)
+	}
+	if _, err := repo.GetDeviceAuthorizationByDeviceCode(ctx, hashDeviceCode(deviceCode)); !errors.Is(err, domain.ErrDeviceAuthorizationNotFound) {
+		t.Errorf("expired authorization was not deleted: %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/auth/domain"
+)
+
+var (
+	// ErrInvalidOAuthState is returned for a callback whose state slips-core
+	// did not issue or that already completed a login
+	ErrInvalidOAuthState = errors.New("invalid or already used OAuth state")
+	// ErrOAuthStateExpired is returned for a callback that arrives after the
+	// state's TTL
+	ErrOAuthStateExpired = errors.New("OAuth state expired")
+	// ErrInvalidPKCEChallenge is returned for a malformed code challenge or
+	// an unsupported challenge method
+	ErrInvalidPKCEChallenge = errors.New("invalid PKCE code challenge")
+	// ErrPKCEVerificationFailed is returned when a callback's code verifier
+	// is missing, malformed or does not match the stored challenge
+	ErrPKCEVerificationFailed = errors.New("PKCE verification failed")
+)
+
+// issueOAuthState records a state Identra issued for a login started with
+// provider, together with the PKCE challenge of a public client if any
+func (s *Service) issueOAuthState(ctx context.Context, state, provider, challenge, method string, ttl time.Duration) error {
+	if challenge != "" {
+		if method == "" {
+			method = domain.PKCEChallengeMethodS256
+		}
+		if method != domain.PKCEChallengeMethodS256 {
+			return ErrInvalidPKCEChallenge
+		}
+		// A base64url encoded SHA-256 digest without padding
+		if len(challenge) != 43 || !isPKCEString(challenge) {
+			return ErrInvalidPKCEChallenge
+		}
+	} else {
+		method = ""
+	}
+
+	now := time.Now()
+	if deleted, err := s.stateRepo.DeleteExpiredOAuthStates(ctx, now); err != nil {
+		s.logger.WarnContext(ctx, "failed to delete expired OAuth states", "error", err)
+	} else if deleted > 0 {
+		s.logger.DebugContext(ctx, "deleted expired OAuth states", "count", deleted)
+	}
+
+	return s.stateRepo.CreateOAuthState(ctx, &domain.OAuthState{
+		State:               state,
+		Provider:            provider,
+		RedirectURL:         s.redirectURL,
+		CodeChallenge:       challenge,
+		CodeChallengeMethod: method,
+		ExpiresAt:           now.Add(ttl),
+	})
+}
+
+// consumeOAuthState marks a callback's state as used and checks its expiry
+// and, for PKCE logins, the code verifier. Logins started without a
+// challenge must not send a verifier either.
+func (s *Service) consumeOAuthState(ctx context.Context, state, verifier string) (*domain.OAuthState, error) {
+	issued, err := s.stateRepo.ConsumeOAuthState(ctx, state)
+	if errors.Is(err, domain.ErrOAuthStateNotFound) {
+		return nil, ErrInvalidOAuthState
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if issued.IsExpired(time.Now()) {
+		return nil, ErrOAuthStateExpired
+	}
+	if issued.CodeChallenge == "" {
+		if verifier != "" {
+			return nil, ErrPKCEVerificationFailed
+		}
+		return issued, nil
+	}
+	if !verifyPKCE(issued.CodeChallenge, verifier) {
+		return nil, ErrPKCEVerificationFailed
+	}
+	return issued, nil
+}
+
+// verifyPKCE checks an S256 code verifier against its challenge
+func verifyPKCE(challenge, verifier string) bool {
+	// RFC 7636 section 4.1: 43 to 128 unreserved characters
+	if len(verifier) < 43 || len(verifier) > 128 || !isPKCEString(verifier) {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
+
+// isPKCEString reports whether s only contains the unreserved characters
+// allowed in code verifiers and challenges
+func isPKCEString(s string) bool {
+	for _, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+		case r == '-', r == '.', r == '_', r == '~':
+		default:
+			return false
+		}
+	}
+	return true
+}
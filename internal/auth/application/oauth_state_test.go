@@ -0,0 +1,100 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// testCodeChallenge is BASE64URL(SHA256(testCodeVerifier))
+const (
+	testCodeVerifier  = "dBjftJeZ4CVP-mB92K2uhbWJxMnrhvk8ULiojvvgG4U"
+	testCodeChallenge = "XtBQokgXcCUKpC9LDdJIPcXkne_fhYmsYTjX_PLDwS0"
+)
+
+func TestConsumeOAuthState(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newDeviceTestService(t)
+
+	if err := service.issueOAuthState(ctx, "state-1", "github", "", "", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	issued, err := service.consumeOAuthState(ctx, "state-1", "")
+	if err != nil {
+		t.Fatalf("issued state rejected: %v", err)
+	}
+	if issued.Provider != "github" {
+		t.Errorf("provider = %q, want github", issued.Provider)
+	}
+	if _, err := service.consumeOAuthState(ctx, "state-1", ""); !errors.Is(err, ErrInvalidOAuthState) {
+		t.Errorf("replayed state error = %v, want ErrInvalidOAuthState", err)
+	}
+	if _, err := service.consumeOAuthState(ctx, "unknown", ""); !errors.Is(err, ErrInvalidOAuthState) {
+		t.Errorf("unknown state error = %v, want ErrInvalidOAuthState", err)
+	}
+
+	if err := service.issueOAuthState(ctx, "state-2", "github", "", "", -time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.consumeOAuthState(ctx, "state-2", ""); !errors.Is(err, ErrOAuthStateExpired) {
+		t.Errorf("expired state error = %v, want ErrOAuthStateExpired", err)
+	}
+
+	// A verifier for a login started without a challenge is rejected
+	if err := service.issueOAuthState(ctx, "state-3", "github", "", "", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.consumeOAuthState(ctx, "state-3", testCodeVerifier); !errors.Is(err, ErrPKCEVerificationFailed) {
+		t.Errorf("unexpected verifier error = %v, want ErrPKCEVerificationFailed", err)
+	}
+}
+
+func TestConsumeOAuthStatePKCE(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newDeviceTestService(t)
+
+	if err := service.issueOAuthState(ctx, "state-1", "github", testCodeChallenge, "", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.consumeOAuthState(ctx, "state-1", testCodeVerifier); err != nil {
+		t.Fatalf("matching verifier rejected: %v", err)
+	}
+
+	if err := service.issueOAuthState(ctx, "state-2", "github", testCodeChallenge, "S256", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.consumeOAuthState(ctx, "state-2", testCodeVerifier[1:]+"A"); !errors.Is(err, ErrPKCEVerificationFailed) {
+		t.Errorf("wrong verifier error = %v, want ErrPKCEVerificationFailed", err)
+	}
+
+	if err := service.issueOAuthState(ctx, "state-3", "github", testCodeChallenge, "", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.consumeOAuthState(ctx, "state-3", ""); !errors.Is(err, ErrPKCEVerificationFailed) {
+		t.Errorf("missing verifier error = %v, want ErrPKCEVerificationFailed", err)
+	}
+}
+
+func TestIssueOAuthStateChallengeValidation(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newDeviceTestService(t)
+
+	tests := []struct {
+		name      string
+		challenge string
+		method    string
+	}{
+		{"plain method", testCodeChallenge, "plain"},
+		{"too short", testCodeChallenge[:42], "S256"},
+		{"padding", testCodeChallenge[:42] + "=", "S256"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.issueOAuthState(ctx, "state", "github", tt.challenge, tt.method, time.Minute)
+			if !errors.Is(err, ErrInvalidPKCEChallenge) {
+				t.Errorf("error = %v, want ErrInvalidPKCEChallenge", err)
+			}
+		})
+	}
+}
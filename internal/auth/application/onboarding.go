@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+
+	"github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+)
+
+// GetOnboarding returns the current user's onboarding state
+func (s *Service) GetOnboarding(ctx context.Context) (*domain.Onboarding, error) {
+	ctx, span := tracer.Start(ctx, "GetOnboarding")
+	defer span.End()
+
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	onboarding, err := s.repo.GetUserOnboarding(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get onboarding state", "error", err, "user_id", userID)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return onboarding, nil
+}
+
+// UpdateOnboarding changes the fields of the current user's onboarding
+// state that are set in update
+func (s *Service) UpdateOnboarding(ctx context.Context, update *domain.OnboardingUpdate) (*domain.Onboarding, error) {
+	ctx, span := tracer.Start(ctx, "UpdateOnboarding")
+	defer span.End()
+
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	onboarding, err := s.repo.UpdateUserOnboarding(ctx, userID, update)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to update onboarding state", "error", err, "user_id", userID)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return onboarding, nil
+}
@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+)
+
+// ErrProfileSyncRequiresJWT is returned when a profile sync is requested
+// with a credential Identra cannot act on, such as an MCP token
+var ErrProfileSyncRequiresJWT = errors.New("profile sync requires a JWT access token")
+
+// SyncUserProfile pulls the caller's current profile from Identra and
+// stores it, overwriting the values stored at the last login
+func (s *Service) SyncUserProfile(ctx context.Context) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "SyncUserProfile")
+	defer span.End()
+
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	user, err := s.syncProfile(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to sync user profile", "error", err, "user_id", userID)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "user profile synced", "user_id", userID)
+	return user, nil
+}
+
+func (s *Service) syncProfile(ctx context.Context, userID string) (*domain.User, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.Credential != auth.CredentialJWT || principal.AccessToken == "" {
+		return nil, ErrProfileSyncRequiresJWT
+	}
+
+	info, err := s.identraClient.GetCurrentUserLoginInfo(ctx, principal.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if info.UserId != userID {
+		return nil, errors.New("identra returned a different user")
+	}
+
+	// Identra only exposes the email outside of login; username and avatar
+	// come from the OAuth provider and replace the stored ones at every login
+	return s.repo.SyncUserProfile(ctx, &domain.User{
+		UserID: userID,
+		Email:  info.Email,
+	})
+}
+
+// profileStale reports whether GetUserProfile should refresh user
+func (s *Service) profileStale(user *domain.User, now time.Time) bool {
+	if s.profileRefresh <= 0 {
+		return false
+	}
+	return user.ProfileSyncedAt == nil || now.Sub(*user.ProfileSyncedAt) >= s.profileRefresh
+}
@@ -2,7 +2,9 @@ package application
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/slips-ai/slips-core/internal/auth/domain"
 	"github.com/slips-ai/slips-core/pkg/auth"
@@ -16,25 +18,48 @@ var tracer = otel.Tracer("auth-service")
 // Service provides authentication business logic including OAuth
 type Service struct {
 	repo          domain.Repository
+	deviceRepo    domain.DeviceAuthorizationRepository
+	stateRepo     domain.OAuthStateRepository
 	identraClient *auth.IdentraClient
 	logger        *slog.Logger
 	provider      string
 	redirectURL   string
+	stateTTL      time.Duration
+	device        DeviceFlowConfig
+	// profileRefresh re-syncs profiles older than this on GetUserProfile;
+	// zero disables it
+	profileRefresh time.Duration
 }
 
 // NewService creates a new OAuth service
-func NewService(repo domain.Repository, identraClient *auth.IdentraClient, provider, redirectURL string, logger *slog.Logger) *Service {
+func NewService(
+	repo domain.Repository,
+	deviceRepo domain.DeviceAuthorizationRepository,
+	stateRepo domain.OAuthStateRepository,
+	identraClient *auth.IdentraClient,
+	provider, redirectURL string,
+	stateTTL time.Duration,
+	device DeviceFlowConfig,
+	profileRefresh time.Duration,
+	logger *slog.Logger,
+) *Service {
 	return &Service{
-		repo:          repo,
-		identraClient: identraClient,
-		logger:        logger,
-		provider:      provider,
-		redirectURL:   redirectURL,
+		repo:           repo,
+		deviceRepo:     deviceRepo,
+		stateRepo:      stateRepo,
+		identraClient:  identraClient,
+		logger:         logger,
+		provider:       provider,
+		redirectURL:    redirectURL,
+		stateTTL:       stateTTL,
+		device:         device,
+		profileRefresh: profileRefresh,
 	}
 }
 
-// GetAuthorizationURL generates OAuth authorization URL
-func (s *Service) GetAuthorizationURL(ctx context.Context, provider string) (string, string, error) {
+// GetAuthorizationURL generates OAuth authorization URL. Public clients pass
+// a PKCE code challenge, which the callback's code verifier must match.
+func (s *Service) GetAuthorizationURL(ctx context.Context, provider, codeChallenge, codeChallengeMethod string) (string, string, error) {
 	ctx, span := tracer.Start(ctx, "GetAuthorizationURL", trace.WithAttributes(
 		attribute.String("provider", provider),
 	))
@@ -47,19 +72,50 @@ func (s *Service) GetAuthorizationURL(ctx context.Context, provider string) (str
 		return "", "", err
 	}
 
-	s.logger.InfoContext(ctx, "OAuth authorization URL generated", "provider", provider)
+	if err := s.issueOAuthState(ctx, resp.State, provider, codeChallenge, codeChallengeMethod, s.stateTTL); err != nil {
+		s.logger.ErrorContext(ctx, "failed to store OAuth state", "error", err)
+		span.RecordError(err)
+		return "", "", err
+	}
+
+	s.logger.InfoContext(ctx, "OAuth authorization URL generated", "provider", provider, "pkce", codeChallenge != "")
 	return resp.Url, resp.State, nil
 }
 
-// HandleCallback processes OAuth callback and returns tokens and user info
-func (s *Service) HandleCallback(ctx context.Context, code, state string) (*CallbackResult, error) {
+// HandleCallback processes OAuth callback and returns tokens and user info.
+// The state must have been issued by this service and not used before;
+// codeVerifier is required if the login was started with a code challenge.
+func (s *Service) HandleCallback(ctx context.Context, code, state, codeVerifier string) (*CallbackResult, error) {
 	ctx, span := tracer.Start(ctx, "HandleCallback")
 	defer span.End()
 
-	// Exchange code for tokens via identra
+	// States issued by StartDeviceAuthorization complete a device login
+	device, err := s.lookupDeviceState(ctx, state)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to look up device authorization", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if device != nil && device.IsExpired(time.Now()) {
+		return nil, ErrDeviceCodeExpired
+	}
+
+	// Check the state and verifier before the code is redeemed, so replayed
+	// callbacks and intercepted codes never reach Identra
+	issued, err := s.consumeOAuthState(ctx, state, codeVerifier)
+	if err != nil {
+		s.logger.WarnContext(ctx, "rejected OAuth callback", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("provider", issued.Provider))
+
+	// Exchange code for tokens via identra. LoginByOAuth has no PKCE
+	// fields, so the verifier checked above is not forwarded; Identra
+	// redeems the code as a confidential client.
 	resp, err := s.identraClient.LoginByOAuth(ctx, code, state)
 	if err != nil {
-		s.logger.ErrorContext(ctx, "failed to login by OAuth", "error", err)
+		s.logger.ErrorContext(ctx, "failed to login by OAuth", "error", err, "redirect_url", issued.RedirectURL)
 		span.RecordError(err)
 		return nil, err
 	}
@@ -74,7 +130,8 @@ func (s *Service) HandleCallback(ctx context.Context, code, state string) (*Call
 			return nil, err
 		}
 
-		// Upsert user (only updates if fields are NULL)
+		// Upsert user, taking the provider's current username, avatar and
+		// email over the stored ones
 		user := domain.NewUser(userID, resp.Username, resp.AvatarUrl, resp.Email)
 		_, err = s.repo.UpsertUser(ctx, user)
 		if err != nil {
@@ -98,6 +155,15 @@ func (s *Service) HandleCallback(ctx context.Context, code, state string) (*Call
 		Email:                 resp.Email,
 	}
 
+	if device != nil {
+		result, err = s.approveDevice(ctx, state, result)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to approve device authorization", "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
 	return result, nil
 }
 
@@ -145,6 +211,18 @@ func (s *Service) GetUserProfile(ctx context.Context) (*domain.User, error) {
 		return nil, err
 	}
 
+	// Refreshing is best effort; a stale profile is still returned. MCP
+	// token callers cannot refresh, the next JWT caller will.
+	if s.profileStale(user, time.Now()) {
+		synced, err := s.syncProfile(ctx, userID)
+		switch {
+		case err == nil:
+			user = synced
+		case !errors.Is(err, ErrProfileSyncRequiresJWT):
+			s.logger.WarnContext(ctx, "failed to refresh user profile", "error", err, "user_id", userID)
+		}
+	}
+
 	return user, nil
 }
 
@@ -170,6 +248,52 @@ func (s *Service) UpdateUserProfile(ctx context.Context, tavilyMCPToken string)
 	return updatedUser, nil
 }
 
+// UpdateListDefaults changes the current user's list defaults that are
+// set in update
+func (s *Service) UpdateListDefaults(ctx context.Context, update *domain.ListDefaultsUpdate) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UpdateListDefaults")
+	defer span.End()
+
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	user, err := s.repo.UpdateUserListDefaults(ctx, userID, update)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to update list defaults", "error", err, "user_id", userID)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// GetTavilyMCPToken returns the current user's Tavily MCP token in
+// plaintext; profile responses only carry a masked copy
+func (s *Service) GetTavilyMCPToken(ctx context.Context) (string, error) {
+	ctx, span := tracer.Start(ctx, "GetTavilyMCPToken")
+	defer span.End()
+
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return "", err
+	}
+
+	user, err := s.repo.GetUserByUserID(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user", "error", err, "user_id", userID)
+		span.RecordError(err)
+		return "", err
+	}
+
+	return user.TavilyMCPToken, nil
+}
+
 // CallbackResult contains the result of OAuth callback processing
 type CallbackResult struct {
 	AccessToken           string
@@ -180,6 +304,9 @@ type CallbackResult struct {
 	Username              string
 	Email                 string
 	AvatarURL             string
+	// DeviceAuthorized is set when the login completed a device
+	// authorization; the tokens went to the device and are left empty
+	DeviceAuthorized bool
 }
 
 // TokenResult contains the result of token refresh
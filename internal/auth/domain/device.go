@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+// ErrDeviceAuthorizationNotFound is returned for device and user codes that
+// were never issued, expired or were already used
+var ErrDeviceAuthorizationNotFound = domainerrors.New(domainerrors.ErrNotFound, "device authorization not found")
+
+// DeviceAuthorization is a pending OAuth device authorization. The device
+// holds the device code (only its hash is stored) and polls for tokens; the
+// user enters the user code in a browser on another machine and completes
+// the regular OAuth flow, whose state ties the callback to this record.
+type DeviceAuthorization struct {
+	DeviceCodeHash   string
+	UserCode         string
+	OAuthState       string
+	AuthorizationURL string
+	Interval         time.Duration
+	ExpiresAt        time.Time
+	LastPolledAt     *time.Time
+	// UserID and Token are set once the user has completed OAuth
+	UserID     string
+	Token      *DeviceToken
+	ApprovedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// DeviceToken is the token pair issued to an approved device
+type DeviceToken struct {
+	AccessToken           string
+	AccessTokenExpiresAt  int64
+	RefreshToken          string
+	RefreshTokenExpiresAt int64
+	TokenType             string
+}
+
+// IsExpired reports whether the device code can no longer be used
+func (d *DeviceAuthorization) IsExpired(now time.Time) bool {
+	return !now.Before(d.ExpiresAt)
+}
+
+// IsApproved reports whether the user has completed OAuth for this device
+func (d *DeviceAuthorization) IsApproved() bool {
+	return d.ApprovedAt != nil
+}
+
+// DeviceAuthorizationRepository persists pending device authorizations
+type DeviceAuthorizationRepository interface {
+	// CreateDeviceAuthorization stores a new pending authorization
+	CreateDeviceAuthorization(ctx context.Context, auth *DeviceAuthorization) error
+
+	// GetDeviceAuthorizationByDeviceCode looks up an authorization by the
+	// hash of its device code. The lookups return
+	// ErrDeviceAuthorizationNotFound if there is none.
+	GetDeviceAuthorizationByDeviceCode(ctx context.Context, deviceCodeHash string) (*DeviceAuthorization, error)
+
+	// GetDeviceAuthorizationByUserCode looks up an authorization by user code
+	GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (*DeviceAuthorization, error)
+
+	// GetDeviceAuthorizationByState looks up an authorization by the OAuth
+	// state of its browser flow
+	GetDeviceAuthorizationByState(ctx context.Context, state string) (*DeviceAuthorization, error)
+
+	// ApproveDeviceAuthorization stores the tokens for the pending
+	// authorization with the given OAuth state. It returns
+	// ErrDeviceAuthorizationNotFound if there is none or it was already
+	// approved.
+	ApproveDeviceAuthorization(ctx context.Context, state, userID string, token *DeviceToken) error
+
+	// TouchDeviceAuthorization records that the device polled at polledAt
+	TouchDeviceAuthorization(ctx context.Context, deviceCodeHash string, polledAt time.Time) error
+
+	// ConsumeDeviceAuthorization deletes an approved authorization and
+	// returns it, so its tokens are handed out only once. It returns
+	// ErrDeviceAuthorizationNotFound if the authorization does not exist or
+	// is not approved.
+	ConsumeDeviceAuthorization(ctx context.Context, deviceCodeHash string) (*DeviceAuthorization, error)
+
+	// DeleteDeviceAuthorization deletes an authorization
+	DeleteDeviceAuthorization(ctx context.Context, deviceCodeHash string) error
+
+	// DeleteExpiredDeviceAuthorizations deletes authorizations that expired
+	// before the given time and returns how many were removed
+	DeleteExpiredDeviceAuthorizations(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+// ErrOAuthStateNotFound is returned for states that were never issued or
+// were already used
+var ErrOAuthStateNotFound = domainerrors.New(domainerrors.ErrNotFound, "OAuth state not found")
+
+// PKCEChallengeMethodS256 is the only PKCE challenge method accepted
+const PKCEChallengeMethodS256 = "S256"
+
+// OAuthState is an OAuth state issued to a login. slips-core records every
+// state it hands out, so a callback with a state it did not issue, one that
+// was already used or one past its expiry is rejected before the code
+// reaches Identra.
+type OAuthState struct {
+	State    string
+	Provider string
+	// RedirectURL is the callback URL the login was started with
+	RedirectURL string
+	// CodeChallenge and CodeChallengeMethod are set for PKCE logins of
+	// public clients; the callback must present the matching verifier
+	CodeChallenge       string
+	CodeChallengeMethod string
+	ExpiresAt           time.Time
+	CreatedAt           time.Time
+}
+
+// IsExpired reports whether the login this state belongs to timed out
+func (s *OAuthState) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+// OAuthStateRepository persists issued OAuth states until their callback
+type OAuthStateRepository interface {
+	// CreateOAuthState records an issued state
+	CreateOAuthState(ctx context.Context, state *OAuthState) error
+
+	// ConsumeOAuthState deletes a state and returns it, so it completes at
+	// most one login. It returns ErrOAuthStateNotFound if the state is
+	// unknown or was already used.
+	ConsumeOAuthState(ctx context.Context, state string) (*OAuthState, error)
+
+	// DeleteExpiredOAuthStates deletes states that expired before the given
+	// time and returns how many were removed
+	DeleteExpiredOAuthStates(ctx context.Context, before time.Time) (int64, error)
+}
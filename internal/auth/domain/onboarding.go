@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+)
+
+// Onboarding is a user's progress through the first-run experience, shared
+// by all of their devices
+type Onboarding struct {
+	UserID            string
+	WelcomeCompleted  bool
+	SampleDataCreated bool
+	FeaturesToured    bool
+	// UpdatedAt is zero if the user has not recorded any progress yet
+	UpdatedAt time.Time
+}
+
+// OnboardingUpdate changes the onboarding fields that are set and leaves
+// the others as they are
+type OnboardingUpdate struct {
+	WelcomeCompleted  *bool
+	SampleDataCreated *bool
+	FeaturesToured    *bool
+}
@@ -6,16 +6,48 @@ import (
 
 // Repository defines the interface for user persistence
 type Repository interface {
-	// UpsertUser creates or updates a user
-	// Only updates username and avatar_url if they are currently NULL
+	// UpsertUser creates or updates a user. The non-empty username,
+	// avatar_url and email of user replace the stored ones unless the user
+	// is anonymized; the Tavily MCP token is only set for new users.
 	UpsertUser(ctx context.Context, user *User) (*User, error)
 
+	// SyncUserProfile overwrites username, avatar_url and email with the
+	// non-empty fields of profile and records the sync time. The user is
+	// created if it does not exist yet.
+	SyncUserProfile(ctx context.Context, profile *User) (*User, error)
+
 	// GetUserByUserID retrieves a user by their user ID (from JWT claims)
 	GetUserByUserID(ctx context.Context, userID string) (*User, error)
 
 	// GetUserByID retrieves a user by their database ID
 	GetUserByID(ctx context.Context, id int64) (*User, error)
 
+	// GetListDefaults reads only a user's list defaults, leaving their
+	// secrets sealed. It returns ErrUserNotFound for unknown users.
+	GetListDefaults(ctx context.Context, userID string) (*ListDefaults, error)
+
+	// UserExists reports whether userID has a profile
+	UserExists(ctx context.Context, userID string) (bool, error)
+
 	// UpdateUserTavilyMCPToken updates Tavily MCP token for the given user ID
 	UpdateUserTavilyMCPToken(ctx context.Context, userID, tavilyMCPToken string) (*User, error)
+
+	// UpdateUserListDefaults applies update to the list defaults of a user
+	UpdateUserListDefaults(ctx context.Context, userID string, update *ListDefaultsUpdate) (*User, error)
+
+	// GetUserOnboarding returns the onboarding state of a user, all false
+	// if nothing was recorded yet
+	GetUserOnboarding(ctx context.Context, userID string) (*Onboarding, error)
+
+	// UpdateUserOnboarding applies update to the onboarding state of a user
+	UpdateUserOnboarding(ctx context.Context, userID string, update *OnboardingUpdate) (*Onboarding, error)
+
+	// ListUsers lists users ordered by database ID with pagination
+	ListUsers(ctx context.Context, limit, offset int) ([]*User, error)
+
+	// AnonymizeUser replaces the username of a user with
+	// AnonymizedUsername, clears the email, avatar URL and Tavily MCP token
+	// and records the time. UpsertUser and SyncUserProfile no longer fill
+	// in the profile of an anonymized user.
+	AnonymizeUser(ctx context.Context, userID string) (*User, error)
 }
@@ -1,9 +1,16 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
+
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
 )
 
+// ErrUserNotFound is returned for users that never logged in
+var ErrUserNotFound = domainerrors.New(domainerrors.ErrNotFound, "user not found")
+
 // User represents a user entity in the OAuth context
 type User struct {
 	ID             int64
@@ -12,8 +19,39 @@ type User struct {
 	AvatarURL      string
 	Email          string
 	TavilyMCPToken string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	// ProfileSyncedAt is when the profile was last refreshed from Identra,
+	// nil if it was only captured at login
+	ProfileSyncedAt *time.Time
+	// AnonymizedAt is when an operator replaced the profile with a
+	// pseudonym, nil if they never did
+	AnonymizedAt *time.Time
+	// ListDefaults apply to list requests that omit the parameters
+	ListDefaults ListDefaults
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// MaxListPageSize is the largest page size ListTasks serves
+const MaxListPageSize = 100
+
+// ListDefaults are a user's defaults for list parameters that requests
+// omit, shared by all of their devices
+type ListDefaults struct {
+	// PageSize is the ListTasks page size; 0 uses the server default
+	PageSize int
+	// TagMatchAll requires listed tasks to carry every filter tag instead
+	// of any of them
+	TagMatchAll bool
+	// SearchIncludeArchived includes archived tasks in text searches
+	SearchIncludeArchived bool
+}
+
+// ListDefaultsUpdate changes the list defaults that are set and leaves the
+// others as they are
+type ListDefaultsUpdate struct {
+	PageSize              *int
+	TagMatchAll           *bool
+	SearchIncludeArchived *bool
 }
 
 // NewUser creates a new user instance
@@ -25,3 +63,15 @@ func NewUser(userID, username, avatarURL, email string) *User {
 		Email:     email,
 	}
 }
+
+// IsAnonymized reports whether the profile was replaced with a pseudonym
+func (u *User) IsAnonymized() bool {
+	return u.AnonymizedAt != nil
+}
+
+// AnonymizedUsername returns the pseudonym an anonymized user is shown as.
+// It is derived from the user ID, so anonymizing twice gives the same name.
+func AnonymizedUsername(userID string) string {
+	sum := sha256.Sum256([]byte(userID))
+	return "anonymous-" + hex.EncodeToString(sum[:6])
+}
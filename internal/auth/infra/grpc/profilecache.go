@@ -0,0 +1,192 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	authv1 "github.com/slips-ai/slips-core/gen/go/auth/v1"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// Methods whose responses ProfileCache serves or whose calls change a profile
+const (
+	methodGetUserProfile          = "/auth.v1.AuthService/GetUserProfile"
+	methodUpdateUserProfile       = "/auth.v1.AuthService/UpdateUserProfile"
+	methodSyncUserProfile         = "/auth.v1.AuthService/SyncUserProfile"
+	methodUpdateListDefaults      = "/auth.v1.AuthService/UpdateListDefaults"
+	methodHandleCallback          = "/auth.v1.AuthService/HandleCallback"
+	methodPollDeviceAuthorization = "/auth.v1.AuthService/PollDeviceAuthorization"
+	methodAnonymizeUser           = "/admin.v1.AdminService/AnonymizeUser"
+)
+
+// profileLookups counts GetUserProfile calls by whether the cache served them
+var profileLookups, _ = otel.Meter("auth-service").Int64Counter(
+	"auth.profile_cache.lookups",
+	metric.WithDescription("GetUserProfile calls by result of the profile cache lookup"),
+	metric.WithUnit("{call}"),
+)
+
+var (
+	lookupHit  = metric.WithAttributes(attribute.String("result", "hit"))
+	lookupMiss = metric.WithAttributes(attribute.String("result", "miss"))
+)
+
+// ProfileCacheOptions configures a ProfileCache
+type ProfileCacheOptions struct {
+	TTL        time.Duration
+	MaxEntries int
+}
+
+// ProfileCache keeps GetUserProfile responses per user in memory, so app
+// launches do not read the database every time. Calls through this process
+// that change a profile drop its entry: UpdateUserProfile, SyncUserProfile,
+// UpdateListDefaults, logins and AdminService.AnonymizeUser. Changes made
+// elsewhere show after at most TTL.
+type ProfileCache struct {
+	opts ProfileCacheOptions
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]profileEntry
+	// generation changes on every invalidation, so a lookup that started
+	// before one does not store the profile it read
+	generation uint64
+}
+
+type profileEntry struct {
+	resp    *authv1.GetUserProfileResponse
+	expires time.Time
+}
+
+// NewProfileCache creates an empty cache
+func NewProfileCache(opts ProfileCacheOptions) *ProfileCache {
+	return &ProfileCache{
+		opts:    opts,
+		now:     time.Now,
+		entries: make(map[string]profileEntry),
+	}
+}
+
+// UnaryServerInterceptor serves GetUserProfile from the cache and drops
+// entries after calls that change a profile. It must run after
+// authentication.
+func (c *ProfileCache) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		switch info.FullMethod {
+		case methodGetUserProfile:
+			userID, err := auth.GetUserID(ctx)
+			if err != nil {
+				return handler(ctx, req)
+			}
+			if resp, ok := c.get(userID); ok {
+				profileLookups.Add(ctx, 1, lookupHit)
+				return resp, nil
+			}
+			profileLookups.Add(ctx, 1, lookupMiss)
+
+			generation := c.currentGeneration()
+			resp, err := handler(ctx, req)
+			if profile, ok := resp.(*authv1.GetUserProfileResponse); ok && err == nil {
+				c.put(userID, generation, profile)
+			}
+			return resp, err
+
+		case methodUpdateUserProfile, methodSyncUserProfile, methodUpdateListDefaults:
+			resp, err := handler(ctx, req)
+			// Even a failed call may have changed the profile
+			if userID, idErr := auth.GetUserID(ctx); idErr == nil {
+				c.Invalidate(userID)
+			}
+			return resp, err
+
+		case methodHandleCallback, methodPollDeviceAuthorization:
+			// Logins store the profile returned by the OAuth provider
+			resp, err := handler(ctx, req)
+			if user, ok := resp.(interface{ GetUserInfo() *authv1.UserInfo }); ok && err == nil {
+				if userID := user.GetUserInfo().GetUserId(); userID != "" {
+					c.Invalidate(userID)
+				}
+			}
+			return resp, err
+
+		case methodAnonymizeUser:
+			resp, err := handler(ctx, req)
+			if anonymize, ok := req.(*adminv1.AnonymizeUserRequest); ok {
+				c.Invalidate(anonymize.GetUserId())
+			}
+			return resp, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Invalidate drops the cached profile of userID
+func (c *ProfileCache) Invalidate(userID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, userID)
+	c.generation++
+}
+
+func (c *ProfileCache) get(userID string) (*authv1.GetUserProfileResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[userID]
+	if !ok || !c.now().Before(entry.expires) {
+		return nil, false
+	}
+	return entry.resp, true
+}
+
+func (c *ProfileCache) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// put stores resp unless the cache was invalidated since generation
+func (c *ProfileCache) put(userID string, generation uint64, resp *authv1.GetUserProfileResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	now := c.now()
+	if _, ok := c.entries[userID]; !ok && len(c.entries) >= c.opts.MaxEntries {
+		c.evict(now)
+	}
+	// Responses are shared between calls, so keep a copy the handler
+	// cannot change
+	c.entries[userID] = profileEntry{
+		resp:    proto.Clone(resp).(*authv1.GetUserProfileResponse),
+		expires: now.Add(c.opts.TTL),
+	}
+}
+
+// evict makes room for one entry: expired entries go first, otherwise an
+// arbitrary one. c.mu must be held.
+func (c *ProfileCache) evict(now time.Time) {
+	for userID, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, userID)
+		}
+	}
+	for userID := range c.entries {
+		if len(c.entries) < c.opts.MaxEntries {
+			return
+		}
+		delete(c.entries, userID)
+	}
+}
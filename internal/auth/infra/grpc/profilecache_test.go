@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	authv1 "github.com/slips-ai/slips-core/gen/go/auth/v1"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"google.golang.org/grpc"
+)
+
+// profileBackend stands in for the AuthService handlers, counting the
+// GetUserProfile reads that reach it
+type profileBackend struct {
+	reads    int
+	username string
+}
+
+func (b *profileBackend) handler(method string) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		userID, _ := auth.GetUserID(ctx)
+		info := &authv1.UserInfo{UserId: userID, Username: b.username}
+		switch method {
+		case methodGetUserProfile:
+			b.reads++
+			return &authv1.GetUserProfileResponse{UserInfo: info}, nil
+		case methodHandleCallback:
+			return &authv1.HandleCallbackResponse{UserInfo: &authv1.UserInfo{UserId: "user-1"}}, nil
+		}
+		return &authv1.UpdateUserProfileResponse{UserInfo: info}, nil
+	}
+}
+
+func TestProfileCache(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	cache := NewProfileCache(ProfileCacheOptions{TTL: time.Minute, MaxEntries: 10})
+	cache.now = func() time.Time { return now }
+	interceptor := cache.UnaryServerInterceptor()
+	backend := &profileBackend{username: "ada"}
+	ctx := auth.WithUserID(context.Background(), "user-1")
+
+	call := func(ctx context.Context, method string, req interface{}) interface{} {
+		t.Helper()
+		resp, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, backend.handler(method))
+		if err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+		return resp
+	}
+	username := func() string {
+		t.Helper()
+		return call(ctx, methodGetUserProfile, &authv1.GetUserProfileRequest{}).(*authv1.GetUserProfileResponse).UserInfo.Username
+	}
+
+	username()
+	if got := username(); got != "ada" || backend.reads != 1 {
+		t.Fatalf("second GetUserProfile: %q after %d reads, want ada from the cache", got, backend.reads)
+	}
+
+	backend.username = "ada.l"
+	call(ctx, methodUpdateUserProfile, &authv1.UpdateUserProfileRequest{})
+	if got := username(); got != "ada.l" || backend.reads != 2 {
+		t.Errorf("after UpdateUserProfile: %q after %d reads, want ada.l read again", got, backend.reads)
+	}
+
+	for _, invalidate := range []struct {
+		method string
+		ctx    context.Context
+		req    interface{}
+	}{
+		{methodSyncUserProfile, ctx, &authv1.SyncUserProfileRequest{}},
+		{methodUpdateListDefaults, ctx, &authv1.UpdateListDefaultsRequest{}},
+		{methodHandleCallback, context.Background(), &authv1.HandleCallbackRequest{}},
+		{methodAnonymizeUser, auth.WithUserID(context.Background(), "admin"), &adminv1.AnonymizeUserRequest{UserId: "user-1"}},
+	} {
+		reads := backend.reads
+		call(invalidate.ctx, invalidate.method, invalidate.req)
+		username()
+		if backend.reads != reads+1 {
+			t.Errorf("GetUserProfile after %s was served from the cache", invalidate.method)
+		}
+	}
+
+	reads := backend.reads
+	now = now.Add(time.Minute)
+	username()
+	if backend.reads != reads+1 {
+		t.Errorf("GetUserProfile after the TTL was served from the cache")
+	}
+
+	other := auth.WithUserID(context.Background(), "user-2")
+	if got := call(other, methodGetUserProfile, &authv1.GetUserProfileRequest{}).(*authv1.GetUserProfileResponse); got.UserInfo.UserId != "user-2" {
+		t.Errorf("user-2 got the profile of %s", got.UserInfo.UserId)
+	}
+}
+
+func TestProfileCache_SkipsStoreAfterConcurrentInvalidation(t *testing.T) {
+	cache := NewProfileCache(ProfileCacheOptions{TTL: time.Minute, MaxEntries: 10})
+	interceptor := cache.UnaryServerInterceptor()
+	ctx := auth.WithUserID(context.Background(), "user-1")
+
+	reads := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		reads++
+		if reads == 1 {
+			// An update lands while the first read is in flight
+			cache.Invalidate("user-1")
+		}
+		return &authv1.GetUserProfileResponse{UserInfo: &authv1.UserInfo{UserId: "user-1"}}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: methodGetUserProfile}
+	for range 2 {
+		if _, err := interceptor(ctx, &authv1.GetUserProfileRequest{}, info, handler); err != nil {
+			t.Fatalf("GetUserProfile: %v", err)
+		}
+	}
+	if reads != 2 {
+		t.Errorf("reads = %d, want the profile read during the update not cached", reads)
+	}
+}
+
+func TestProfileCache_Evicts(t *testing.T) {
+	cache := NewProfileCache(ProfileCacheOptions{TTL: time.Minute, MaxEntries: 2})
+	for _, userID := range []string{"a", "b", "c"} {
+		cache.put(userID, cache.currentGeneration(), &authv1.GetUserProfileResponse{})
+	}
+	if len(cache.entries) != 2 {
+		t.Errorf("entries = %d, want at most MaxEntries", len(cache.entries))
+	}
+	if _, ok := cache.get("c"); !ok {
+		t.Errorf("newest entry was evicted")
+	}
+}
@@ -2,13 +2,16 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	authv1 "github.com/slips-ai/slips-core/gen/go/auth/v1"
 	"github.com/slips-ai/slips-core/internal/auth/application"
+	"github.com/slips-ai/slips-core/internal/auth/domain"
 	"github.com/slips-ai/slips-core/pkg/auth"
 	"github.com/slips-ai/slips-core/pkg/grpcerrors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Server implements the AuthService gRPC server
@@ -26,19 +29,13 @@ func NewServer(service *application.Service) *Server {
 
 // GetAuthorizationURL generates OAuth authorization URL
 func (s *Server) GetAuthorizationURL(ctx context.Context, req *authv1.GetAuthorizationURLRequest) (*authv1.GetAuthorizationURLResponse, error) {
-	// Validate provider
-	if req.Provider == "" {
-		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	if err := validateProvider(req.Provider); err != nil {
+		return nil, err
 	}
 
-	// Currently only support GitHub
-	if req.Provider != "github" {
-		return nil, status.Errorf(codes.InvalidArgument, "unsupported provider: %s (only 'github' is supported)", req.Provider)
-	}
-
-	url, state, err := s.service.GetAuthorizationURL(ctx, req.Provider)
+	url, state, err := s.service.GetAuthorizationURL(ctx, req.Provider, req.CodeChallenge, req.CodeChallengeMethod)
 	if err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to get authorization URL")
+		return nil, toGRPCError(err, "failed to get authorization URL")
 	}
 
 	return &authv1.GetAuthorizationURLResponse{
@@ -57,9 +54,21 @@ func (s *Server) HandleCallback(ctx context.Context, req *authv1.HandleCallbackR
 		return nil, status.Error(codes.InvalidArgument, "state is required")
 	}
 
-	result, err := s.service.HandleCallback(ctx, req.Code, req.State)
+	result, err := s.service.HandleCallback(ctx, req.Code, req.State, req.CodeVerifier)
 	if err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to handle OAuth callback")
+		return nil, toGRPCError(err, "failed to handle OAuth callback")
+	}
+
+	// The tokens of a device login were handed to the device
+	if result.DeviceAuthorized {
+		return &authv1.HandleCallbackResponse{
+			UserInfo: &authv1.UserInfo{
+				Username:  result.Username,
+				AvatarUrl: result.AvatarURL,
+				Email:     result.Email,
+			},
+			DeviceAuthorized: true,
+		}, nil
 	}
 
 	// Extract user ID from token for the response
@@ -120,13 +129,7 @@ func (s *Server) GetUserProfile(ctx context.Context, req *authv1.GetUserProfileR
 	}
 
 	return &authv1.GetUserProfileResponse{
-		UserInfo: &authv1.UserInfo{
-			UserId:         user.UserID,
-			Username:       user.Username,
-			Email:          user.Email,
-			AvatarUrl:      user.AvatarURL,
-			TavilyMcpToken: user.TavilyMCPToken,
-		},
+		UserInfo: userInfoToProto(user),
 	}, nil
 }
 
@@ -138,12 +141,255 @@ func (s *Server) UpdateUserProfile(ctx context.Context, req *authv1.UpdateUserPr
 	}
 
 	return &authv1.UpdateUserProfileResponse{
-		UserInfo: &authv1.UserInfo{
-			UserId:         user.UserID,
-			Username:       user.Username,
-			Email:          user.Email,
-			AvatarUrl:      user.AvatarURL,
-			TavilyMcpToken: user.TavilyMCPToken,
-		},
+		UserInfo: userInfoToProto(user),
+	}, nil
+}
+
+// GetTavilyMCPToken returns the current user's Tavily MCP token unmasked
+func (s *Server) GetTavilyMCPToken(ctx context.Context, req *authv1.GetTavilyMCPTokenRequest) (*authv1.GetTavilyMCPTokenResponse, error) {
+	token, err := s.service.GetTavilyMCPToken(ctx)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to get Tavily MCP token")
+	}
+
+	return &authv1.GetTavilyMCPTokenResponse{
+		TavilyMcpToken: token,
+	}, nil
+}
+
+// SyncUserProfile refreshes the current user's profile from Identra
+func (s *Server) SyncUserProfile(ctx context.Context, req *authv1.SyncUserProfileRequest) (*authv1.SyncUserProfileResponse, error) {
+	user, err := s.service.SyncUserProfile(ctx)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to sync user profile")
+	}
+
+	return &authv1.SyncUserProfileResponse{
+		UserInfo: userInfoToProto(user),
+	}, nil
+}
+
+// UpdateListDefaults changes the current user's defaults for omitted list
+// parameters
+func (s *Server) UpdateListDefaults(ctx context.Context, req *authv1.UpdateListDefaultsRequest) (*authv1.UpdateListDefaultsResponse, error) {
+	update := &domain.ListDefaultsUpdate{
+		SearchIncludeArchived: req.SearchIncludeArchived,
+	}
+	if req.PageSize != nil {
+		if *req.PageSize < 0 || *req.PageSize > domain.MaxListPageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "page_size must be between 0 and %d", domain.MaxListPageSize)
+		}
+		pageSize := int(*req.PageSize)
+		update.PageSize = &pageSize
+	}
+	if req.TagMatchMode != nil {
+		var matchAll bool
+		switch *req.TagMatchMode {
+		case authv1.TagMatchMode_TAG_MATCH_MODE_ANY:
+		case authv1.TagMatchMode_TAG_MATCH_MODE_ALL:
+			matchAll = true
+		default:
+			return nil, status.Error(codes.InvalidArgument, "tag_match_mode must be ANY or ALL")
+		}
+		update.TagMatchAll = &matchAll
+	}
+
+	user, err := s.service.UpdateListDefaults(ctx, update)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to update list defaults")
+	}
+
+	return &authv1.UpdateListDefaultsResponse{
+		ListDefaults: listDefaultsToProto(user.ListDefaults),
+	}, nil
+}
+
+// GetOnboardingState retrieves the current user's onboarding state
+func (s *Server) GetOnboardingState(ctx context.Context, req *authv1.GetOnboardingStateRequest) (*authv1.GetOnboardingStateResponse, error) {
+	onboarding, err := s.service.GetOnboarding(ctx)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to get onboarding state")
+	}
+
+	return &authv1.GetOnboardingStateResponse{
+		Onboarding: onboardingToProto(onboarding),
+	}, nil
+}
+
+// UpdateOnboardingState updates the current user's onboarding state
+func (s *Server) UpdateOnboardingState(ctx context.Context, req *authv1.UpdateOnboardingStateRequest) (*authv1.UpdateOnboardingStateResponse, error) {
+	onboarding, err := s.service.UpdateOnboarding(ctx, &domain.OnboardingUpdate{
+		WelcomeCompleted:  req.WelcomeCompleted,
+		SampleDataCreated: req.SampleDataCreated,
+		FeaturesToured:    req.FeaturesToured,
+	})
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to update onboarding state")
+	}
+
+	return &authv1.UpdateOnboardingStateResponse{
+		Onboarding: onboardingToProto(onboarding),
+	}, nil
+}
+
+// StartDeviceAuthorization starts the OAuth device flow for a client that
+// cannot open a browser itself
+func (s *Server) StartDeviceAuthorization(ctx context.Context, req *authv1.StartDeviceAuthorizationRequest) (*authv1.StartDeviceAuthorizationResponse, error) {
+	if err := validateProvider(req.Provider); err != nil {
+		return nil, err
+	}
+
+	result, err := s.service.StartDeviceAuthorization(ctx, req.Provider)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to start device authorization")
+	}
+
+	return &authv1.StartDeviceAuthorizationResponse{
+		DeviceCode:              result.DeviceCode,
+		UserCode:                result.UserCode,
+		VerificationUri:         result.VerificationURI,
+		VerificationUriComplete: result.VerificationURIComplete,
+		ExpiresIn:               int64(result.ExpiresIn.Seconds()),
+		Interval:                int64(result.Interval.Seconds()),
 	}, nil
 }
+
+// GetDeviceVerificationURL returns the OAuth URL for a user code entered on
+// the verification page
+func (s *Server) GetDeviceVerificationURL(ctx context.Context, req *authv1.GetDeviceVerificationURLRequest) (*authv1.GetDeviceVerificationURLResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.UserCode, "user_code"); err != nil {
+		return nil, err
+	}
+
+	url, err := s.service.GetDeviceVerificationURL(ctx, req.UserCode)
+	if err != nil {
+		return nil, toGRPCError(err, "unknown user code")
+	}
+
+	return &authv1.GetDeviceVerificationURLResponse{Url: url}, nil
+}
+
+// PollDeviceAuthorization returns the device's tokens once the user has
+// authorized it
+func (s *Server) PollDeviceAuthorization(ctx context.Context, req *authv1.PollDeviceAuthorizationRequest) (*authv1.PollDeviceAuthorizationResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.DeviceCode, "device_code"); err != nil {
+		return nil, err
+	}
+
+	result, err := s.service.PollDeviceAuthorization(ctx, req.DeviceCode)
+	if err != nil {
+		return nil, toGRPCError(err, "unknown device code")
+	}
+
+	switch result.Status {
+	case application.DeviceStatusApproved:
+		return &authv1.PollDeviceAuthorizationResponse{
+			Status: authv1.DeviceAuthorizationStatus_DEVICE_AUTHORIZATION_STATUS_APPROVED,
+			Token: &authv1.Token{
+				AccessToken:           result.Token.AccessToken,
+				AccessTokenExpiresAt:  result.Token.AccessTokenExpiresAt,
+				RefreshToken:          result.Token.RefreshToken,
+				RefreshTokenExpiresAt: result.Token.RefreshTokenExpiresAt,
+				TokenType:             result.Token.TokenType,
+			},
+			UserInfo: &authv1.UserInfo{UserId: result.UserID},
+		}, nil
+	case application.DeviceStatusSlowDown:
+		return &authv1.PollDeviceAuthorizationResponse{
+			Status: authv1.DeviceAuthorizationStatus_DEVICE_AUTHORIZATION_STATUS_SLOW_DOWN,
+		}, nil
+	default:
+		return &authv1.PollDeviceAuthorizationResponse{
+			Status: authv1.DeviceAuthorizationStatus_DEVICE_AUTHORIZATION_STATUS_PENDING,
+		}, nil
+	}
+}
+
+// maskSecret hides a secret in profile responses, keeping the last four
+// characters so users can tell which one is configured
+func maskSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	if len(secret) <= 8 {
+		return "********"
+	}
+	return "****" + secret[len(secret)-4:]
+}
+
+// userInfoToProto converts a stored profile; the Tavily MCP token is masked
+func userInfoToProto(user *domain.User) *authv1.UserInfo {
+	return &authv1.UserInfo{
+		UserId:         user.UserID,
+		Username:       user.Username,
+		Email:          user.Email,
+		AvatarUrl:      user.AvatarURL,
+		TavilyMcpToken: maskSecret(user.TavilyMCPToken),
+		ListDefaults:   listDefaultsToProto(user.ListDefaults),
+	}
+}
+
+func listDefaultsToProto(defaults domain.ListDefaults) *authv1.ListDefaults {
+	mode := authv1.TagMatchMode_TAG_MATCH_MODE_ANY
+	if defaults.TagMatchAll {
+		mode = authv1.TagMatchMode_TAG_MATCH_MODE_ALL
+	}
+	return &authv1.ListDefaults{
+		PageSize:              int32(defaults.PageSize),
+		TagMatchMode:          mode,
+		SearchIncludeArchived: defaults.SearchIncludeArchived,
+	}
+}
+
+func onboardingToProto(onboarding *domain.Onboarding) *authv1.OnboardingState {
+	state := &authv1.OnboardingState{
+		WelcomeCompleted:  onboarding.WelcomeCompleted,
+		SampleDataCreated: onboarding.SampleDataCreated,
+		FeaturesToured:    onboarding.FeaturesToured,
+	}
+	if !onboarding.UpdatedAt.IsZero() {
+		state.UpdatedAt = timestamppb.New(onboarding.UpdatedAt)
+	}
+	return state
+}
+
+// validateProvider checks the OAuth provider of a login request
+func validateProvider(provider string) error {
+	if provider == "" {
+		return status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	// Currently only support GitHub
+	if provider != "github" {
+		return status.Errorf(codes.InvalidArgument, "unsupported provider: %s (only 'github' is supported)", provider)
+	}
+	return nil
+}
+
+// toGRPCError maps OAuth state, device flow, PKCE and profile sync
+// failures to status codes and defers everything else to
+// grpcerrors.ToGRPCError
+func toGRPCError(err error, defaultMsg string) error {
+	if errors.Is(err, application.ErrProfileSyncRequiresJWT) {
+		return status.Error(codes.FailedPrecondition, "profile sync requires a JWT access token")
+	}
+	if errors.Is(err, application.ErrInvalidOAuthState) {
+		return status.Error(codes.InvalidArgument, "invalid or already used state")
+	}
+	if errors.Is(err, application.ErrOAuthStateExpired) {
+		return status.Error(codes.FailedPrecondition, "login expired, start again")
+	}
+	if errors.Is(err, application.ErrInvalidPKCEChallenge) {
+		return status.Error(codes.InvalidArgument, "code_challenge must be a base64url SHA-256 digest with method S256")
+	}
+	if errors.Is(err, application.ErrPKCEVerificationFailed) {
+		return status.Error(codes.PermissionDenied, "code_verifier does not match the code challenge")
+	}
+	if errors.Is(err, application.ErrDeviceCodeExpired) {
+		return status.Error(codes.FailedPrecondition, "device code expired")
+	}
+	if errors.Is(err, application.ErrDeviceAlreadyAuthorized) {
+		return status.Error(codes.FailedPrecondition, "device already authorized")
+	}
+	return grpcerrors.ToGRPCError(err, defaultMsg)
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/slips-ai/slips-core/internal/auth/domain"
+)
+
+// DeviceAuthorizationRepository implements domain.DeviceAuthorizationRepository using PostgreSQL
+type DeviceAuthorizationRepository struct {
+	queries *Queries
+}
+
+// NewDeviceAuthorizationRepository creates a new device authorization repository
+func NewDeviceAuthorizationRepository(pool *pgxpool.Pool) *DeviceAuthorizationRepository {
+	return &DeviceAuthorizationRepository{
+		queries: New(pool),
+	}
+}
+
+// CreateDeviceAuthorization stores a new pending authorization
+func (r *DeviceAuthorizationRepository) CreateDeviceAuthorization(ctx context.Context, auth *domain.DeviceAuthorization) error {
+	return r.queries.CreateDeviceAuthorization(ctx, CreateDeviceAuthorizationParams{
+		DeviceCodeHash:   auth.DeviceCodeHash,
+		UserCode:         auth.UserCode,
+		OauthState:       auth.OAuthState,
+		AuthorizationUrl: auth.AuthorizationURL,
+		IntervalSeconds:  int32(auth.Interval / time.Second),
+		ExpiresAt:        pgtype.Timestamptz{Time: auth.ExpiresAt, Valid: true},
+	})
+}
+
+// GetDeviceAuthorizationByDeviceCode looks up an authorization by device code hash
+func (r *DeviceAuthorizationRepository) GetDeviceAuthorizationByDeviceCode(ctx context.Context, deviceCodeHash string) (*domain.DeviceAuthorization, error) {
+	row, err := r.queries.GetDeviceAuthorizationByDeviceCode(ctx, deviceCodeHash)
+	if err != nil {
+		return nil, deviceAuthorizationNotFound(err)
+	}
+	return deviceAuthorizationFromRow(row), nil
+}
+
+// GetDeviceAuthorizationByUserCode looks up an authorization by user code
+func (r *DeviceAuthorizationRepository) GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (*domain.DeviceAuthorization, error) {
+	row, err := r.queries.GetDeviceAuthorizationByUserCode(ctx, userCode)
+	if err != nil {
+		return nil, deviceAuthorizationNotFound(err)
+	}
+	return deviceAuthorizationFromRow(row), nil
+}
+
+// GetDeviceAuthorizationByState looks up an authorization by OAuth state
+func (r *DeviceAuthorizationRepository) GetDeviceAuthorizationByState(ctx context.Context, state string) (*domain.DeviceAuthorization, error) {
+	row, err := r.queries.GetDeviceAuthorizationByState(ctx, state)
+	if err != nil {
+		return nil, deviceAuthorizationNotFound(err)
+	}
+	return deviceAuthorizationFromRow(row), nil
+}
+
+// ApproveDeviceAuthorization stores the tokens for a pending authorization
+func (r *DeviceAuthorizationRepository) ApproveDeviceAuthorization(ctx context.Context, state, userID string, token *domain.DeviceToken) error {
+	rows, err := r.queries.ApproveDeviceAuthorization(ctx, ApproveDeviceAuthorizationParams{
+		OauthState:            state,
+		UserID:                textFromString(userID),
+		AccessToken:           textFromString(token.AccessToken),
+		AccessTokenExpiresAt:  pgtype.Int8{Int64: token.AccessTokenExpiresAt, Valid: true},
+		RefreshToken:          textFromString(token.RefreshToken),
+		RefreshTokenExpiresAt: pgtype.Int8{Int64: token.RefreshTokenExpiresAt, Valid: true},
+		TokenType:             textFromString(token.TokenType),
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrDeviceAuthorizationNotFound
+	}
+	return nil
+}
+
+// TouchDeviceAuthorization records a poll
+func (r *DeviceAuthorizationRepository) TouchDeviceAuthorization(ctx context.Context, deviceCodeHash string, polledAt time.Time) error {
+	return r.queries.TouchDeviceAuthorization(ctx, TouchDeviceAuthorizationParams{
+		DeviceCodeHash: deviceCodeHash,
+		LastPolledAt:   pgtype.Timestamptz{Time: polledAt, Valid: true},
+	})
+}
+
+// ConsumeDeviceAuthorization deletes and returns an approved authorization
+func (r *DeviceAuthorizationRepository) ConsumeDeviceAuthorization(ctx context.Context, deviceCodeHash string) (*domain.DeviceAuthorization, error) {
+	row, err := r.queries.ConsumeDeviceAuthorization(ctx, deviceCodeHash)
+	if err != nil {
+		return nil, deviceAuthorizationNotFound(err)
+	}
+	return deviceAuthorizationFromRow(row), nil
+}
+
+// DeleteDeviceAuthorization deletes an authorization
+func (r *DeviceAuthorizationRepository) DeleteDeviceAuthorization(ctx context.Context, deviceCodeHash string) error {
+	return r.queries.DeleteDeviceAuthorization(ctx, deviceCodeHash)
+}
+
+// DeleteExpiredDeviceAuthorizations deletes authorizations that expired before the given time
+func (r *DeviceAuthorizationRepository) DeleteExpiredDeviceAuthorizations(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.DeleteExpiredDeviceAuthorizations(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
+
+// deviceAuthorizationNotFound reports a missing row as
+// domain.ErrDeviceAuthorizationNotFound
+func deviceAuthorizationNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrDeviceAuthorizationNotFound
+	}
+	return err
+}
+
+func deviceAuthorizationFromRow(row DeviceAuthorization) *domain.DeviceAuthorization {
+	auth := &domain.DeviceAuthorization{
+		DeviceCodeHash:   row.DeviceCodeHash,
+		UserCode:         row.UserCode,
+		OAuthState:       row.OauthState,
+		AuthorizationURL: row.AuthorizationUrl,
+		Interval:         time.Duration(row.IntervalSeconds) * time.Second,
+		ExpiresAt:        row.ExpiresAt.Time,
+		UserID:           stringFromText(row.UserID),
+		CreatedAt:        row.CreatedAt.Time,
+	}
+	if row.LastPolledAt.Valid {
+		auth.LastPolledAt = &row.LastPolledAt.Time
+	}
+	if row.ApprovedAt.Valid {
+		auth.ApprovedAt = &row.ApprovedAt.Time
+		auth.Token = &domain.DeviceToken{
+			AccessToken:           stringFromText(row.AccessToken),
+			AccessTokenExpiresAt:  row.AccessTokenExpiresAt.Int64,
+			RefreshToken:          stringFromText(row.RefreshToken),
+			RefreshTokenExpiresAt: row.RefreshTokenExpiresAt.Int64,
+			TokenType:             stringFromText(row.TokenType),
+		}
+	}
+	return auth
+}
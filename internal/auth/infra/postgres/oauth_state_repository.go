@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/slips-ai/slips-core/internal/auth/domain"
+)
+
+// OAuthStateRepository implements domain.OAuthStateRepository using PostgreSQL
+type OAuthStateRepository struct {
+	queries *Queries
+}
+
+// NewOAuthStateRepository creates a new OAuth state repository
+func NewOAuthStateRepository(pool *pgxpool.Pool) *OAuthStateRepository {
+	return &OAuthStateRepository{
+		queries: New(pool),
+	}
+}
+
+// CreateOAuthState records an issued state
+func (r *OAuthStateRepository) CreateOAuthState(ctx context.Context, state *domain.OAuthState) error {
+	return r.queries.CreateOAuthState(ctx, CreateOAuthStateParams{
+		State:               state.State,
+		Provider:            state.Provider,
+		RedirectUrl:         state.RedirectURL,
+		CodeChallenge:       textFromString(state.CodeChallenge),
+		CodeChallengeMethod: textFromString(state.CodeChallengeMethod),
+		ExpiresAt:           pgtype.Timestamptz{Time: state.ExpiresAt, Valid: true},
+	})
+}
+
+// ConsumeOAuthState deletes and returns an issued state
+func (r *OAuthStateRepository) ConsumeOAuthState(ctx context.Context, state string) (*domain.OAuthState, error) {
+	row, err := r.queries.ConsumeOAuthState(ctx, state)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrOAuthStateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain.OAuthState{
+		State:               row.State,
+		Provider:            row.Provider,
+		RedirectURL:         row.RedirectUrl,
+		CodeChallenge:       stringFromText(row.CodeChallenge),
+		CodeChallengeMethod: stringFromText(row.CodeChallengeMethod),
+		ExpiresAt:           row.ExpiresAt.Time,
+		CreatedAt:           row.CreatedAt.Time,
+	}, nil
+}
+
+// DeleteExpiredOAuthStates deletes states that expired before the given time
+func (r *OAuthStateRepository) DeleteExpiredOAuthStates(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.DeleteExpiredOAuthStates(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
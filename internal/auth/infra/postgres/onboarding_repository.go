@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/auth/domain"
+)
+
+// GetUserOnboarding returns the onboarding state of a user
+func (r *Repository) GetUserOnboarding(ctx context.Context, userID string) (*domain.Onboarding, error) {
+	result, err := r.queries.GetUserOnboarding(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domain.Onboarding{UserID: userID}, nil
+		}
+		return nil, err
+	}
+	return onboardingFromRow(result), nil
+}
+
+// UpdateUserOnboarding applies an update to the onboarding state of a user
+func (r *Repository) UpdateUserOnboarding(ctx context.Context, userID string, update *domain.OnboardingUpdate) (*domain.Onboarding, error) {
+	result, err := r.queries.UpdateUserOnboarding(ctx, UpdateUserOnboardingParams{
+		UserID:            userID,
+		WelcomeCompleted:  boolFromPtr(update.WelcomeCompleted),
+		SampleDataCreated: boolFromPtr(update.SampleDataCreated),
+		FeaturesToured:    boolFromPtr(update.FeaturesToured),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return onboardingFromRow(result), nil
+}
+
+func onboardingFromRow(row UserOnboarding) *domain.Onboarding {
+	return &domain.Onboarding{
+		UserID:            row.UserID,
+		WelcomeCompleted:  row.WelcomeCompleted,
+		SampleDataCreated: row.SampleDataCreated,
+		FeaturesToured:    row.FeaturesToured,
+		UpdatedAt:         row.UpdatedAt.Time,
+	}
+}
+
+// boolFromPtr converts an optional bool to pgtype.Bool
+func boolFromPtr(b *bool) pgtype.Bool {
+	if b == nil {
+		return pgtype.Bool{}
+	}
+	return pgtype.Bool{Bool: *b, Valid: true}
+}
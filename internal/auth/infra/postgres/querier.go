@@ -6,13 +6,41 @@ package postgres
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	// AnonymizeUser replaces the username with a pseudonym and clears the rest of
+	// the profile. The user ID and everything the user owns are kept.
+	AnonymizeUser(ctx context.Context, arg AnonymizeUserParams) (AnonymizeUserRow, error)
+	ApproveDeviceAuthorization(ctx context.Context, arg ApproveDeviceAuthorizationParams) (int64, error)
+	ConsumeDeviceAuthorization(ctx context.Context, deviceCodeHash string) (DeviceAuthorization, error)
+	ConsumeOAuthState(ctx context.Context, state string) (OauthState, error)
+	CreateDeviceAuthorization(ctx context.Context, arg CreateDeviceAuthorizationParams) error
+	CreateOAuthState(ctx context.Context, arg CreateOAuthStateParams) error
+	DeleteDeviceAuthorization(ctx context.Context, deviceCodeHash string) error
+	DeleteExpiredDeviceAuthorizations(ctx context.Context, expiresAt pgtype.Timestamptz) (int64, error)
+	DeleteExpiredOAuthStates(ctx context.Context, expiresAt pgtype.Timestamptz) (int64, error)
+	GetDeviceAuthorizationByDeviceCode(ctx context.Context, deviceCodeHash string) (DeviceAuthorization, error)
+	GetDeviceAuthorizationByState(ctx context.Context, oauthState string) (DeviceAuthorization, error)
+	GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (DeviceAuthorization, error)
+	// Reads only the list defaults, so listing tasks does not decrypt the
+	// user's secrets.
+	GetListDefaults(ctx context.Context, userID string) (GetListDefaultsRow, error)
 	GetUserByID(ctx context.Context, id int32) (GetUserByIDRow, error)
 	GetUserByUserID(ctx context.Context, userID string) (GetUserByUserIDRow, error)
+	GetUserOnboarding(ctx context.Context, userID string) (UserOnboarding, error)
+	ListUserSecrets(ctx context.Context) ([]ListUserSecretsRow, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]ListUsersRow, error)
+	ReplaceUserTavilyMCPToken(ctx context.Context, arg ReplaceUserTavilyMCPTokenParams) (int64, error)
+	SyncUserProfile(ctx context.Context, arg SyncUserProfileParams) (SyncUserProfileRow, error)
+	TouchDeviceAuthorization(ctx context.Context, arg TouchDeviceAuthorizationParams) error
+	UpdateUserListDefaults(ctx context.Context, arg UpdateUserListDefaultsParams) (UpdateUserListDefaultsRow, error)
+	UpdateUserOnboarding(ctx context.Context, arg UpdateUserOnboardingParams) (UserOnboarding, error)
 	UpdateUserTavilyMCPToken(ctx context.Context, arg UpdateUserTavilyMCPTokenParams) (UpdateUserTavilyMCPTokenRow, error)
 	UpsertUser(ctx context.Context, arg UpsertUserParams) (UpsertUserRow, error)
+	UserExists(ctx context.Context, userID string) (bool, error)
 }
 
 var _ Querier = (*Queries)(nil)
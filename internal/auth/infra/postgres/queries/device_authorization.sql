@@ -0,0 +1,56 @@
+-- name: CreateDeviceAuthorization :exec
+INSERT INTO device_authorizations (
+    device_code_hash, user_code, oauth_state, authorization_url, interval_seconds, expires_at
+) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetDeviceAuthorizationByDeviceCode :one
+SELECT device_code_hash, user_code, oauth_state, authorization_url, interval_seconds, expires_at,
+       last_polled_at, user_id, access_token, access_token_expires_at, refresh_token,
+       refresh_token_expires_at, token_type, approved_at, created_at
+FROM device_authorizations
+WHERE device_code_hash = $1;
+
+-- name: GetDeviceAuthorizationByUserCode :one
+SELECT device_code_hash, user_code, oauth_state, authorization_url, interval_seconds, expires_at,
+       last_polled_at, user_id, access_token, access_token_expires_at, refresh_token,
+       refresh_token_expires_at, token_type, approved_at, created_at
+FROM device_authorizations
+WHERE user_code = $1;
+
+-- name: GetDeviceAuthorizationByState :one
+SELECT device_code_hash, user_code, oauth_state, authorization_url, interval_seconds, expires_at,
+       last_polled_at, user_id, access_token, access_token_expires_at, refresh_token,
+       refresh_token_expires_at, token_type, approved_at, created_at
+FROM device_authorizations
+WHERE oauth_state = $1;
+
+-- name: ApproveDeviceAuthorization :execrows
+UPDATE device_authorizations
+SET user_id = $2,
+    access_token = $3,
+    access_token_expires_at = $4,
+    refresh_token = $5,
+    refresh_token_expires_at = $6,
+    token_type = $7,
+    approved_at = NOW()
+WHERE oauth_state = $1 AND approved_at IS NULL;
+
+-- name: TouchDeviceAuthorization :exec
+UPDATE device_authorizations
+SET last_polled_at = $2
+WHERE device_code_hash = $1;
+
+-- name: ConsumeDeviceAuthorization :one
+DELETE FROM device_authorizations
+WHERE device_code_hash = $1 AND approved_at IS NOT NULL
+RETURNING device_code_hash, user_code, oauth_state, authorization_url, interval_seconds, expires_at,
+          last_polled_at, user_id, access_token, access_token_expires_at, refresh_token,
+          refresh_token_expires_at, token_type, approved_at, created_at;
+
+-- name: DeleteDeviceAuthorization :exec
+DELETE FROM device_authorizations
+WHERE device_code_hash = $1;
+
+-- name: DeleteExpiredDeviceAuthorizations :execrows
+DELETE FROM device_authorizations
+WHERE expires_at < $1;
@@ -0,0 +1,13 @@
+-- name: CreateOAuthState :exec
+INSERT INTO oauth_states (
+    state, provider, redirect_url, code_challenge, code_challenge_method, expires_at
+) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ConsumeOAuthState :one
+DELETE FROM oauth_states
+WHERE state = $1
+RETURNING state, provider, redirect_url, code_challenge, code_challenge_method, expires_at, created_at;
+
+-- name: DeleteExpiredOAuthStates :execrows
+DELETE FROM oauth_states
+WHERE expires_at < $1;
@@ -0,0 +1,20 @@
+-- name: GetUserOnboarding :one
+SELECT user_id, welcome_completed, sample_data_created, features_toured, created_at, updated_at
+FROM user_onboarding
+WHERE user_id = $1;
+
+-- name: UpdateUserOnboarding :one
+INSERT INTO user_onboarding (user_id, welcome_completed, sample_data_created, features_toured)
+VALUES (
+    sqlc.arg(user_id),
+    COALESCE(sqlc.narg(welcome_completed)::BOOLEAN, FALSE),
+    COALESCE(sqlc.narg(sample_data_created)::BOOLEAN, FALSE),
+    COALESCE(sqlc.narg(features_toured)::BOOLEAN, FALSE)
+)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    welcome_completed = COALESCE(sqlc.narg(welcome_completed)::BOOLEAN, user_onboarding.welcome_completed),
+    sample_data_created = COALESCE(sqlc.narg(sample_data_created)::BOOLEAN, user_onboarding.sample_data_created),
+    features_toured = COALESCE(sqlc.narg(features_toured)::BOOLEAN, user_onboarding.features_toured),
+    updated_at = NOW()
+RETURNING user_id, welcome_completed, sample_data_created, features_toured, created_at, updated_at;
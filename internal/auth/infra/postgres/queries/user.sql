@@ -3,19 +3,29 @@ INSERT INTO users (user_id, username, avatar_url, email, tavily_mcp_token, updat
 VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
 ON CONFLICT (user_id) DO UPDATE
 SET 
-    username = COALESCE(users.username, EXCLUDED.username),
-    avatar_url = COALESCE(users.avatar_url, EXCLUDED.avatar_url),
-    email = COALESCE(users.email, EXCLUDED.email),
+    username = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.username, users.username) ELSE users.username END,
+    avatar_url = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.avatar_url, users.avatar_url) END,
+    email = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.email, users.email) END,
     updated_at = CURRENT_TIMESTAMP
-RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, created_at, updated_at;
+RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at;
+
+-- name: GetListDefaults :one
+-- Reads only the list defaults, so listing tasks does not decrypt the
+-- user's secrets.
+SELECT list_page_size, list_tag_match_all, search_include_archived
+FROM users
+WHERE user_id = $1;
+
+-- name: UserExists :one
+SELECT EXISTS (SELECT 1 FROM users WHERE user_id = $1);
 
 -- name: GetUserByUserID :one
-SELECT id, user_id, username, avatar_url, email, tavily_mcp_token, created_at, updated_at
+SELECT id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at
 FROM users
 WHERE user_id = $1;
 
 -- name: GetUserByID :one
-SELECT id, user_id, username, avatar_url, email, tavily_mcp_token, created_at, updated_at
+SELECT id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at
 FROM users
 WHERE id = $1;
 
@@ -24,4 +34,55 @@ UPDATE users
 SET tavily_mcp_token = $2,
     updated_at = CURRENT_TIMESTAMP
 WHERE user_id = $1
-RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, created_at, updated_at;
+RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at;
+
+-- name: ListUsers :many
+SELECT id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at
+FROM users
+ORDER BY id ASC
+LIMIT $1 OFFSET $2;
+
+-- name: SyncUserProfile :one
+INSERT INTO users (user_id, username, avatar_url, email, profile_synced_at, updated_at)
+VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    username = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.username, users.username) ELSE users.username END,
+    avatar_url = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.avatar_url, users.avatar_url) END,
+    email = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.email, users.email) END,
+    profile_synced_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at;
+
+-- name: ListUserSecrets :many
+SELECT user_id, tavily_mcp_token
+FROM users
+WHERE tavily_mcp_token IS NOT NULL
+ORDER BY id ASC;
+
+-- name: ReplaceUserTavilyMCPToken :execrows
+UPDATE users
+SET tavily_mcp_token = sqlc.arg(new_token)
+WHERE user_id = sqlc.arg(user_id) AND tavily_mcp_token = sqlc.arg(old_token);
+
+-- name: AnonymizeUser :one
+-- AnonymizeUser replaces the username with a pseudonym and clears the rest of
+-- the profile. The user ID and everything the user owns are kept.
+UPDATE users
+SET username = sqlc.arg(username),
+    avatar_url = NULL,
+    email = NULL,
+    tavily_mcp_token = NULL,
+    anonymized_at = COALESCE(anonymized_at, CURRENT_TIMESTAMP),
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = sqlc.arg(user_id)
+RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at;
+
+-- name: UpdateUserListDefaults :one
+UPDATE users
+SET list_page_size = COALESCE(sqlc.narg(list_page_size)::INTEGER, list_page_size),
+    list_tag_match_all = COALESCE(sqlc.narg(list_tag_match_all)::BOOLEAN, list_tag_match_all),
+    search_include_archived = COALESCE(sqlc.narg(search_include_archived)::BOOLEAN, search_include_archived),
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = sqlc.arg(user_id)
+RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at;
@@ -2,109 +2,372 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/pkg/envelope"
 )
 
 // Repository implements domain.Repository using PostgreSQL
 type Repository struct {
 	queries *Queries
+	// keyring encrypts user secrets at rest; nil stores them in plaintext
+	keyring *envelope.Keyring
 }
 
-// NewRepository creates a new Auth repository
-func NewRepository(pool *pgxpool.Pool) *Repository {
+// NewRepository creates a new Auth repository. User secrets are encrypted
+// with keyring when it is not nil.
+func NewRepository(pool *pgxpool.Pool, keyring *envelope.Keyring) *Repository {
 	return &Repository{
 		queries: New(pool),
+		keyring: keyring,
 	}
 }
 
 // UpsertUser creates or updates a user
 func (r *Repository) UpsertUser(ctx context.Context, user *domain.User) (*domain.User, error) {
+	tavilyMCPToken, err := r.sealTavilyMCPToken(ctx, user.UserID, user.TavilyMCPToken)
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := r.queries.UpsertUser(ctx, UpsertUserParams{
 		UserID:         user.UserID,
 		Username:       textFromString(user.Username),
 		AvatarUrl:      textFromString(user.AvatarURL),
 		Email:          textFromString(user.Email),
-		TavilyMcpToken: textFromString(user.TavilyMCPToken),
+		TavilyMcpToken: textFromString(tavilyMCPToken),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &domain.User{
-		ID:             int64(result.ID),
-		UserID:         result.UserID,
-		Username:       stringFromText(result.Username),
-		AvatarURL:      stringFromText(result.AvatarUrl),
-		Email:          stringFromText(result.Email),
-		TavilyMCPToken: stringFromText(result.TavilyMcpToken),
-		CreatedAt:      result.CreatedAt.Time,
-		UpdatedAt:      result.UpdatedAt.Time,
-	}, nil
+	return r.openSecrets(ctx, &domain.User{
+		ID:              int64(result.ID),
+		UserID:          result.UserID,
+		Username:        stringFromText(result.Username),
+		AvatarURL:       stringFromText(result.AvatarUrl),
+		Email:           stringFromText(result.Email),
+		TavilyMCPToken:  stringFromText(result.TavilyMcpToken),
+		ProfileSyncedAt: timeFromTimestamptz(result.ProfileSyncedAt),
+		AnonymizedAt:    timeFromTimestamptz(result.AnonymizedAt),
+		ListDefaults:    listDefaults(result.ListPageSize, result.ListTagMatchAll, result.SearchIncludeArchived),
+		CreatedAt:       result.CreatedAt.Time,
+		UpdatedAt:       result.UpdatedAt.Time,
+	})
+}
+
+// SyncUserProfile overwrites the profile fields of a user with the
+// non-empty fields of profile, creating the user if needed
+func (r *Repository) SyncUserProfile(ctx context.Context, profile *domain.User) (*domain.User, error) {
+	result, err := r.queries.SyncUserProfile(ctx, SyncUserProfileParams{
+		UserID:    profile.UserID,
+		Username:  textFromString(profile.Username),
+		AvatarUrl: textFromString(profile.AvatarURL),
+		Email:     textFromString(profile.Email),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.openSecrets(ctx, &domain.User{
+		ID:              int64(result.ID),
+		UserID:          result.UserID,
+		Username:        stringFromText(result.Username),
+		AvatarURL:       stringFromText(result.AvatarUrl),
+		Email:           stringFromText(result.Email),
+		TavilyMCPToken:  stringFromText(result.TavilyMcpToken),
+		ProfileSyncedAt: timeFromTimestamptz(result.ProfileSyncedAt),
+		AnonymizedAt:    timeFromTimestamptz(result.AnonymizedAt),
+		ListDefaults:    listDefaults(result.ListPageSize, result.ListTagMatchAll, result.SearchIncludeArchived),
+		CreatedAt:       result.CreatedAt.Time,
+		UpdatedAt:       result.UpdatedAt.Time,
+	})
 }
 
 // GetUserByUserID retrieves a user by their user ID
 func (r *Repository) GetUserByUserID(ctx context.Context, userID string) (*domain.User, error) {
 	result, err := r.queries.GetUserByUserID(ctx, userID)
 	if err != nil {
-		return nil, err
+		return nil, userNotFound(err)
 	}
 
-	return &domain.User{
-		ID:             int64(result.ID),
-		UserID:         result.UserID,
-		Email:          stringFromText(result.Email),
-		Username:       stringFromText(result.Username),
-		AvatarURL:      stringFromText(result.AvatarUrl),
-		TavilyMCPToken: stringFromText(result.TavilyMcpToken),
-		CreatedAt:      result.CreatedAt.Time,
-		UpdatedAt:      result.UpdatedAt.Time,
-	}, nil
+	return r.openSecrets(ctx, &domain.User{
+		ID:              int64(result.ID),
+		UserID:          result.UserID,
+		Email:           stringFromText(result.Email),
+		Username:        stringFromText(result.Username),
+		AvatarURL:       stringFromText(result.AvatarUrl),
+		TavilyMCPToken:  stringFromText(result.TavilyMcpToken),
+		ProfileSyncedAt: timeFromTimestamptz(result.ProfileSyncedAt),
+		AnonymizedAt:    timeFromTimestamptz(result.AnonymizedAt),
+		ListDefaults:    listDefaults(result.ListPageSize, result.ListTagMatchAll, result.SearchIncludeArchived),
+		CreatedAt:       result.CreatedAt.Time,
+		UpdatedAt:       result.UpdatedAt.Time,
+	})
+}
+
+// GetListDefaults reads only a user's list defaults, leaving their secrets
+// sealed
+func (r *Repository) GetListDefaults(ctx context.Context, userID string) (*domain.ListDefaults, error) {
+	result, err := r.queries.GetListDefaults(ctx, userID)
+	if err != nil {
+		return nil, userNotFound(err)
+	}
+
+	defaults := listDefaults(result.ListPageSize, result.ListTagMatchAll, result.SearchIncludeArchived)
+	return &defaults, nil
+}
+
+// UserExists reports whether userID has a profile
+func (r *Repository) UserExists(ctx context.Context, userID string) (bool, error) {
+	return r.queries.UserExists(ctx, userID)
 }
 
 // GetUserByID retrieves a user by their database ID
 func (r *Repository) GetUserByID(ctx context.Context, id int64) (*domain.User, error) {
 	result, err := r.queries.GetUserByID(ctx, int32(id))
 	if err != nil {
-		return nil, err
+		return nil, userNotFound(err)
 	}
 
-	return &domain.User{
-		ID:             int64(result.ID),
-		UserID:         result.UserID,
-		Username:       stringFromText(result.Username),
-		Email:          stringFromText(result.Email),
-		AvatarURL:      stringFromText(result.AvatarUrl),
-		TavilyMCPToken: stringFromText(result.TavilyMcpToken),
-		CreatedAt:      result.CreatedAt.Time,
-		UpdatedAt:      result.UpdatedAt.Time,
-	}, nil
+	return r.openSecrets(ctx, &domain.User{
+		ID:              int64(result.ID),
+		UserID:          result.UserID,
+		Username:        stringFromText(result.Username),
+		Email:           stringFromText(result.Email),
+		AvatarURL:       stringFromText(result.AvatarUrl),
+		TavilyMCPToken:  stringFromText(result.TavilyMcpToken),
+		ProfileSyncedAt: timeFromTimestamptz(result.ProfileSyncedAt),
+		AnonymizedAt:    timeFromTimestamptz(result.AnonymizedAt),
+		ListDefaults:    listDefaults(result.ListPageSize, result.ListTagMatchAll, result.SearchIncludeArchived),
+		CreatedAt:       result.CreatedAt.Time,
+		UpdatedAt:       result.UpdatedAt.Time,
+	})
 }
 
 // UpdateUserTavilyMCPToken updates Tavily MCP token for a user
 func (r *Repository) UpdateUserTavilyMCPToken(ctx context.Context, userID, tavilyMCPToken string) (*domain.User, error) {
+	sealed, err := r.sealTavilyMCPToken(ctx, userID, tavilyMCPToken)
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := r.queries.UpdateUserTavilyMCPToken(ctx, UpdateUserTavilyMCPTokenParams{
 		UserID:         userID,
-		TavilyMcpToken: textFromString(tavilyMCPToken),
+		TavilyMcpToken: textFromString(sealed),
+	})
+	if err != nil {
+		return nil, userNotFound(err)
+	}
+
+	return r.openSecrets(ctx, &domain.User{
+		ID:              int64(result.ID),
+		UserID:          result.UserID,
+		Username:        stringFromText(result.Username),
+		AvatarURL:       stringFromText(result.AvatarUrl),
+		Email:           stringFromText(result.Email),
+		TavilyMCPToken:  stringFromText(result.TavilyMcpToken),
+		ProfileSyncedAt: timeFromTimestamptz(result.ProfileSyncedAt),
+		AnonymizedAt:    timeFromTimestamptz(result.AnonymizedAt),
+		ListDefaults:    listDefaults(result.ListPageSize, result.ListTagMatchAll, result.SearchIncludeArchived),
+		CreatedAt:       result.CreatedAt.Time,
+		UpdatedAt:       result.UpdatedAt.Time,
+	})
+}
+
+// ListUsers lists users ordered by database ID with pagination
+func (r *Repository) ListUsers(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	// Validate parameters to prevent negative values and potential overflow
+	if limit < 0 {
+		limit = 0
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Convert to int32 (validation is done at gRPC layer)
+	results, err := r.queries.ListUsers(ctx, ListUsersParams{
+		Limit:  int32(limit),
+		Offset: int32(offset),
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	users := make([]*domain.User, len(results))
+	for i, result := range results {
+		user, err := r.openSecrets(ctx, &domain.User{
+			ID:              int64(result.ID),
+			UserID:          result.UserID,
+			Username:        stringFromText(result.Username),
+			AvatarURL:       stringFromText(result.AvatarUrl),
+			Email:           stringFromText(result.Email),
+			TavilyMCPToken:  stringFromText(result.TavilyMcpToken),
+			ProfileSyncedAt: timeFromTimestamptz(result.ProfileSyncedAt),
+			AnonymizedAt:    timeFromTimestamptz(result.AnonymizedAt),
+			ListDefaults:    listDefaults(result.ListPageSize, result.ListTagMatchAll, result.SearchIncludeArchived),
+			CreatedAt:       result.CreatedAt.Time,
+			UpdatedAt:       result.UpdatedAt.Time,
+		})
+		if err != nil {
+			return nil, err
+		}
+		users[i] = user
+	}
+
+	return users, nil
+}
+
+// AnonymizeUser replaces the profile of a user with a pseudonym
+func (r *Repository) AnonymizeUser(ctx context.Context, userID string) (*domain.User, error) {
+	result, err := r.queries.AnonymizeUser(ctx, AnonymizeUserParams{
+		Username: textFromString(domain.AnonymizedUsername(userID)),
+		UserID:   userID,
+	})
+	if err != nil {
+		return nil, userNotFound(err)
+	}
+
 	return &domain.User{
-		ID:             int64(result.ID),
-		UserID:         result.UserID,
-		Username:       stringFromText(result.Username),
-		AvatarURL:      stringFromText(result.AvatarUrl),
-		Email:          stringFromText(result.Email),
-		TavilyMCPToken: stringFromText(result.TavilyMcpToken),
-		CreatedAt:      result.CreatedAt.Time,
-		UpdatedAt:      result.UpdatedAt.Time,
+		ID:              int64(result.ID),
+		UserID:          result.UserID,
+		Username:        stringFromText(result.Username),
+		ProfileSyncedAt: timeFromTimestamptz(result.ProfileSyncedAt),
+		AnonymizedAt:    timeFromTimestamptz(result.AnonymizedAt),
+		ListDefaults:    listDefaults(result.ListPageSize, result.ListTagMatchAll, result.SearchIncludeArchived),
+		CreatedAt:       result.CreatedAt.Time,
+		UpdatedAt:       result.UpdatedAt.Time,
 	}, nil
 }
 
+// UpdateUserListDefaults applies an update to the list defaults of a user
+func (r *Repository) UpdateUserListDefaults(ctx context.Context, userID string, update *domain.ListDefaultsUpdate) (*domain.User, error) {
+	params := UpdateUserListDefaultsParams{
+		ListTagMatchAll:       boolFromPtr(update.TagMatchAll),
+		SearchIncludeArchived: boolFromPtr(update.SearchIncludeArchived),
+		UserID:                userID,
+	}
+	if update.PageSize != nil {
+		params.ListPageSize = pgtype.Int4{Int32: int32(*update.PageSize), Valid: true}
+	}
+	result, err := r.queries.UpdateUserListDefaults(ctx, params)
+	if err != nil {
+		return nil, userNotFound(err)
+	}
+
+	return r.openSecrets(ctx, &domain.User{
+		ID:              int64(result.ID),
+		UserID:          result.UserID,
+		Username:        stringFromText(result.Username),
+		AvatarURL:       stringFromText(result.AvatarUrl),
+		Email:           stringFromText(result.Email),
+		TavilyMCPToken:  stringFromText(result.TavilyMcpToken),
+		ProfileSyncedAt: timeFromTimestamptz(result.ProfileSyncedAt),
+		AnonymizedAt:    timeFromTimestamptz(result.AnonymizedAt),
+		ListDefaults:    listDefaults(result.ListPageSize, result.ListTagMatchAll, result.SearchIncludeArchived),
+		CreatedAt:       result.CreatedAt.Time,
+		UpdatedAt:       result.UpdatedAt.Time,
+	})
+}
+
+// userNotFound reports a missing user row as domain.ErrUserNotFound
+func userNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrUserNotFound
+	}
+	return err
+}
+
+// listDefaults converts the list default columns of a user row
+func listDefaults(pageSize int32, tagMatchAll, searchIncludeArchived bool) domain.ListDefaults {
+	return domain.ListDefaults{
+		PageSize:              int(pageSize),
+		TagMatchAll:           tagMatchAll,
+		SearchIncludeArchived: searchIncludeArchived,
+	}
+}
+
+// tavilyMCPTokenAAD binds an encrypted token to its user, so a value copied
+// to another row fails to decrypt
+func tavilyMCPTokenAAD(userID string) string {
+	return "users.tavily_mcp_token:" + userID
+}
+
+// sealTavilyMCPToken encrypts a token for storage if encryption is enabled
+// and escapes it otherwise
+func (r *Repository) sealTavilyMCPToken(ctx context.Context, userID, token string) (string, error) {
+	if r.keyring == nil {
+		return envelope.Escape(token), nil
+	}
+	return r.keyring.Encrypt(ctx, token, tavilyMCPTokenAAD(userID))
+}
+
+// openSecrets decrypts the secret fields of a user read from the database.
+// Plaintext values written before encryption was enabled pass through.
+func (r *Repository) openSecrets(ctx context.Context, user *domain.User) (*domain.User, error) {
+	if !envelope.IsEncrypted(user.TavilyMCPToken) {
+		user.TavilyMCPToken = envelope.Unescape(user.TavilyMCPToken)
+		return user, nil
+	}
+	if r.keyring == nil {
+		return nil, errors.New("tavily_mcp_token is encrypted but no encryption keys are configured")
+	}
+	token, err := r.keyring.Decrypt(ctx, user.TavilyMCPToken, tavilyMCPTokenAAD(user.UserID))
+	if err != nil {
+		return nil, err
+	}
+	user.TavilyMCPToken = token
+	return user, nil
+}
+
+// RotateSecrets re-encrypts every user secret that is stored in plaintext
+// or under a key other than the primary key, and returns how many were
+// rewritten. Values changed concurrently are skipped and picked up by the
+// next run.
+func (r *Repository) RotateSecrets(ctx context.Context) (int, error) {
+	if r.keyring == nil {
+		return 0, errors.New("encryption is not configured")
+	}
+
+	rows, err := r.queries.ListUserSecrets(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rotated := 0
+	for _, row := range rows {
+		stored := stringFromText(row.TavilyMcpToken)
+		if !r.keyring.NeedsRotation(stored) {
+			continue
+		}
+		aad := tavilyMCPTokenAAD(row.UserID)
+		token, err := r.keyring.Decrypt(ctx, stored, aad)
+		if err != nil {
+			return rotated, fmt.Errorf("decrypt tavily_mcp_token of user %s: %w", row.UserID, err)
+		}
+		sealed, err := r.keyring.Encrypt(ctx, token, aad)
+		if err != nil {
+			return rotated, err
+		}
+		n, err := r.queries.ReplaceUserTavilyMCPToken(ctx, ReplaceUserTavilyMCPTokenParams{
+			NewToken: textFromString(sealed),
+			UserID:   row.UserID,
+			OldToken: row.TavilyMcpToken,
+		})
+		if err != nil {
+			return rotated, err
+		}
+		rotated += int(n)
+	}
+	return rotated, nil
+}
+
 // textFromString converts a string to pgtype.Text
 func textFromString(s string) pgtype.Text {
 	if s == "" {
@@ -120,3 +383,11 @@ func stringFromText(t pgtype.Text) string {
 	}
 	return t.String
 }
+
+// timeFromTimestamptz converts a nullable pgtype.Timestamptz to a time pointer
+func timeFromTimestamptz(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -11,21 +11,103 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const anonymizeUser = `-- name: AnonymizeUser :one
+UPDATE users
+SET username = $1,
+    avatar_url = NULL,
+    email = NULL,
+    tavily_mcp_token = NULL,
+    anonymized_at = COALESCE(anonymized_at, CURRENT_TIMESTAMP),
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $2
+RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at
+`
+
+type AnonymizeUserParams struct {
+	Username pgtype.Text `json:"username"`
+	UserID   string      `json:"user_id"`
+}
+
+type AnonymizeUserRow struct {
+	ID                    int32              `json:"id"`
+	UserID                string             `json:"user_id"`
+	Username              pgtype.Text        `json:"username"`
+	AvatarUrl             pgtype.Text        `json:"avatar_url"`
+	Email                 pgtype.Text        `json:"email"`
+	TavilyMcpToken        pgtype.Text        `json:"tavily_mcp_token"`
+	ProfileSyncedAt       pgtype.Timestamptz `json:"profile_synced_at"`
+	AnonymizedAt          pgtype.Timestamptz `json:"anonymized_at"`
+	ListPageSize          int32              `json:"list_page_size"`
+	ListTagMatchAll       bool               `json:"list_tag_match_all"`
+	SearchIncludeArchived bool               `json:"search_include_archived"`
+	CreatedAt             pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt             pgtype.Timestamp   `json:"updated_at"`
+}
+
+// AnonymizeUser replaces the username with a pseudonym and clears the rest of
+// the profile. The user ID and everything the user owns are kept.
+func (q *Queries) AnonymizeUser(ctx context.Context, arg AnonymizeUserParams) (AnonymizeUserRow, error) {
+	row := q.db.QueryRow(ctx, anonymizeUser, arg.Username, arg.UserID)
+	var i AnonymizeUserRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Username,
+		&i.AvatarUrl,
+		&i.Email,
+		&i.TavilyMcpToken,
+		&i.ProfileSyncedAt,
+		&i.AnonymizedAt,
+		&i.ListPageSize,
+		&i.ListTagMatchAll,
+		&i.SearchIncludeArchived,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getListDefaults = `-- name: GetListDefaults :one
+SELECT list_page_size, list_tag_match_all, search_include_archived
+FROM users
+WHERE user_id = $1
+`
+
+type GetListDefaultsRow struct {
+	ListPageSize          int32 `json:"list_page_size"`
+	ListTagMatchAll       bool  `json:"list_tag_match_all"`
+	SearchIncludeArchived bool  `json:"search_include_archived"`
+}
+
+// Reads only the list defaults, so listing tasks does not decrypt the
+// user's secrets.
+func (q *Queries) GetListDefaults(ctx context.Context, userID string) (GetListDefaultsRow, error) {
+	row := q.db.QueryRow(ctx, getListDefaults, userID)
+	var i GetListDefaultsRow
+	err := row.Scan(&i.ListPageSize, &i.ListTagMatchAll, &i.SearchIncludeArchived)
+	return i, err
+}
+
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, user_id, username, avatar_url, email, tavily_mcp_token, created_at, updated_at
+SELECT id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at
 FROM users
 WHERE id = $1
 `
 
 type GetUserByIDRow struct {
-	ID             int32            `json:"id"`
-	UserID         string           `json:"user_id"`
-	Username       pgtype.Text      `json:"username"`
-	AvatarUrl      pgtype.Text      `json:"avatar_url"`
-	Email          pgtype.Text      `json:"email"`
-	TavilyMcpToken pgtype.Text      `json:"tavily_mcp_token"`
-	CreatedAt      pgtype.Timestamp `json:"created_at"`
-	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+	ID                    int32              `json:"id"`
+	UserID                string             `json:"user_id"`
+	Username              pgtype.Text        `json:"username"`
+	AvatarUrl             pgtype.Text        `json:"avatar_url"`
+	Email                 pgtype.Text        `json:"email"`
+	TavilyMcpToken        pgtype.Text        `json:"tavily_mcp_token"`
+	ProfileSyncedAt       pgtype.Timestamptz `json:"profile_synced_at"`
+	AnonymizedAt          pgtype.Timestamptz `json:"anonymized_at"`
+	ListPageSize          int32              `json:"list_page_size"`
+	ListTagMatchAll       bool               `json:"list_tag_match_all"`
+	SearchIncludeArchived bool               `json:"search_include_archived"`
+	CreatedAt             pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt             pgtype.Timestamp   `json:"updated_at"`
 }
 
 func (q *Queries) GetUserByID(ctx context.Context, id int32) (GetUserByIDRow, error) {
@@ -38,6 +120,11 @@ func (q *Queries) GetUserByID(ctx context.Context, id int32) (GetUserByIDRow, er
 		&i.AvatarUrl,
 		&i.Email,
 		&i.TavilyMcpToken,
+		&i.ProfileSyncedAt,
+		&i.AnonymizedAt,
+		&i.ListPageSize,
+		&i.ListTagMatchAll,
+		&i.SearchIncludeArchived,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -45,20 +132,25 @@ func (q *Queries) GetUserByID(ctx context.Context, id int32) (GetUserByIDRow, er
 }
 
 const getUserByUserID = `-- name: GetUserByUserID :one
-SELECT id, user_id, username, avatar_url, email, tavily_mcp_token, created_at, updated_at
+SELECT id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at
 FROM users
 WHERE user_id = $1
 `
 
 type GetUserByUserIDRow struct {
-	ID             int32            `json:"id"`
-	UserID         string           `json:"user_id"`
-	Username       pgtype.Text      `json:"username"`
-	AvatarUrl      pgtype.Text      `json:"avatar_url"`
-	Email          pgtype.Text      `json:"email"`
-	TavilyMcpToken pgtype.Text      `json:"tavily_mcp_token"`
-	CreatedAt      pgtype.Timestamp `json:"created_at"`
-	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+	ID                    int32              `json:"id"`
+	UserID                string             `json:"user_id"`
+	Username              pgtype.Text        `json:"username"`
+	AvatarUrl             pgtype.Text        `json:"avatar_url"`
+	Email                 pgtype.Text        `json:"email"`
+	TavilyMcpToken        pgtype.Text        `json:"tavily_mcp_token"`
+	ProfileSyncedAt       pgtype.Timestamptz `json:"profile_synced_at"`
+	AnonymizedAt          pgtype.Timestamptz `json:"anonymized_at"`
+	ListPageSize          int32              `json:"list_page_size"`
+	ListTagMatchAll       bool               `json:"list_tag_match_all"`
+	SearchIncludeArchived bool               `json:"search_include_archived"`
+	CreatedAt             pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt             pgtype.Timestamp   `json:"updated_at"`
 }
 
 func (q *Queries) GetUserByUserID(ctx context.Context, userID string) (GetUserByUserIDRow, error) {
@@ -71,6 +163,246 @@ func (q *Queries) GetUserByUserID(ctx context.Context, userID string) (GetUserBy
 		&i.AvatarUrl,
 		&i.Email,
 		&i.TavilyMcpToken,
+		&i.ProfileSyncedAt,
+		&i.AnonymizedAt,
+		&i.ListPageSize,
+		&i.ListTagMatchAll,
+		&i.SearchIncludeArchived,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listUserSecrets = `-- name: ListUserSecrets :many
+SELECT user_id, tavily_mcp_token
+FROM users
+WHERE tavily_mcp_token IS NOT NULL
+ORDER BY id ASC
+`
+
+type ListUserSecretsRow struct {
+	UserID         string      `json:"user_id"`
+	TavilyMcpToken pgtype.Text `json:"tavily_mcp_token"`
+}
+
+func (q *Queries) ListUserSecrets(ctx context.Context) ([]ListUserSecretsRow, error) {
+	rows, err := q.db.Query(ctx, listUserSecrets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserSecretsRow{}
+	for rows.Next() {
+		var i ListUserSecretsRow
+		if err := rows.Scan(&i.UserID, &i.TavilyMcpToken); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at
+FROM users
+ORDER BY id ASC
+LIMIT $1 OFFSET $2
+`
+
+type ListUsersParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type ListUsersRow struct {
+	ID                    int32              `json:"id"`
+	UserID                string             `json:"user_id"`
+	Username              pgtype.Text        `json:"username"`
+	AvatarUrl             pgtype.Text        `json:"avatar_url"`
+	Email                 pgtype.Text        `json:"email"`
+	TavilyMcpToken        pgtype.Text        `json:"tavily_mcp_token"`
+	ProfileSyncedAt       pgtype.Timestamptz `json:"profile_synced_at"`
+	AnonymizedAt          pgtype.Timestamptz `json:"anonymized_at"`
+	ListPageSize          int32              `json:"list_page_size"`
+	ListTagMatchAll       bool               `json:"list_tag_match_all"`
+	SearchIncludeArchived bool               `json:"search_include_archived"`
+	CreatedAt             pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt             pgtype.Timestamp   `json:"updated_at"`
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]ListUsersRow, error) {
+	rows, err := q.db.Query(ctx, listUsers, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUsersRow{}
+	for rows.Next() {
+		var i ListUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Username,
+			&i.AvatarUrl,
+			&i.Email,
+			&i.TavilyMcpToken,
+			&i.ProfileSyncedAt,
+			&i.AnonymizedAt,
+			&i.ListPageSize,
+			&i.ListTagMatchAll,
+			&i.SearchIncludeArchived,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const replaceUserTavilyMCPToken = `-- name: ReplaceUserTavilyMCPToken :execrows
+UPDATE users
+SET tavily_mcp_token = $1
+WHERE user_id = $2 AND tavily_mcp_token = $3
+`
+
+type ReplaceUserTavilyMCPTokenParams struct {
+	NewToken pgtype.Text `json:"new_token"`
+	UserID   string      `json:"user_id"`
+	OldToken pgtype.Text `json:"old_token"`
+}
+
+func (q *Queries) ReplaceUserTavilyMCPToken(ctx context.Context, arg ReplaceUserTavilyMCPTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, replaceUserTavilyMCPToken, arg.NewToken, arg.UserID, arg.OldToken)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const syncUserProfile = `-- name: SyncUserProfile :one
+INSERT INTO users (user_id, username, avatar_url, email, profile_synced_at, updated_at)
+VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    username = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.username, users.username) ELSE users.username END,
+    avatar_url = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.avatar_url, users.avatar_url) END,
+    email = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.email, users.email) END,
+    profile_synced_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at
+`
+
+type SyncUserProfileParams struct {
+	UserID    string      `json:"user_id"`
+	Username  pgtype.Text `json:"username"`
+	AvatarUrl pgtype.Text `json:"avatar_url"`
+	Email     pgtype.Text `json:"email"`
+}
+
+type SyncUserProfileRow struct {
+	ID                    int32              `json:"id"`
+	UserID                string             `json:"user_id"`
+	Username              pgtype.Text        `json:"username"`
+	AvatarUrl             pgtype.Text        `json:"avatar_url"`
+	Email                 pgtype.Text        `json:"email"`
+	TavilyMcpToken        pgtype.Text        `json:"tavily_mcp_token"`
+	ProfileSyncedAt       pgtype.Timestamptz `json:"profile_synced_at"`
+	AnonymizedAt          pgtype.Timestamptz `json:"anonymized_at"`
+	ListPageSize          int32              `json:"list_page_size"`
+	ListTagMatchAll       bool               `json:"list_tag_match_all"`
+	SearchIncludeArchived bool               `json:"search_include_archived"`
+	CreatedAt             pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt             pgtype.Timestamp   `json:"updated_at"`
+}
+
+func (q *Queries) SyncUserProfile(ctx context.Context, arg SyncUserProfileParams) (SyncUserProfileRow, error) {
+	row := q.db.QueryRow(ctx, syncUserProfile,
+		arg.UserID,
+		arg.Username,
+		arg.AvatarUrl,
+		arg.Email,
+	)
+	var i SyncUserProfileRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Username,
+		&i.AvatarUrl,
+		&i.Email,
+		&i.TavilyMcpToken,
+		&i.ProfileSyncedAt,
+		&i.AnonymizedAt,
+		&i.ListPageSize,
+		&i.ListTagMatchAll,
+		&i.SearchIncludeArchived,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateUserListDefaults = `-- name: UpdateUserListDefaults :one
+UPDATE users
+SET list_page_size = COALESCE($1::INTEGER, list_page_size),
+    list_tag_match_all = COALESCE($2::BOOLEAN, list_tag_match_all),
+    search_include_archived = COALESCE($3::BOOLEAN, search_include_archived),
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $4
+RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at
+`
+
+type UpdateUserListDefaultsParams struct {
+	ListPageSize          pgtype.Int4 `json:"list_page_size"`
+	ListTagMatchAll       pgtype.Bool `json:"list_tag_match_all"`
+	SearchIncludeArchived pgtype.Bool `json:"search_include_archived"`
+	UserID                string      `json:"user_id"`
+}
+
+type UpdateUserListDefaultsRow struct {
+	ID                    int32              `json:"id"`
+	UserID                string             `json:"user_id"`
+	Username              pgtype.Text        `json:"username"`
+	AvatarUrl             pgtype.Text        `json:"avatar_url"`
+	Email                 pgtype.Text        `json:"email"`
+	TavilyMcpToken        pgtype.Text        `json:"tavily_mcp_token"`
+	ProfileSyncedAt       pgtype.Timestamptz `json:"profile_synced_at"`
+	AnonymizedAt          pgtype.Timestamptz `json:"anonymized_at"`
+	ListPageSize          int32              `json:"list_page_size"`
+	ListTagMatchAll       bool               `json:"list_tag_match_all"`
+	SearchIncludeArchived bool               `json:"search_include_archived"`
+	CreatedAt             pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt             pgtype.Timestamp   `json:"updated_at"`
+}
+
+func (q *Queries) UpdateUserListDefaults(ctx context.Context, arg UpdateUserListDefaultsParams) (UpdateUserListDefaultsRow, error) {
+	row := q.db.QueryRow(ctx, updateUserListDefaults,
+		arg.ListPageSize,
+		arg.ListTagMatchAll,
+		arg.SearchIncludeArchived,
+		arg.UserID,
+	)
+	var i UpdateUserListDefaultsRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Username,
+		&i.AvatarUrl,
+		&i.Email,
+		&i.TavilyMcpToken,
+		&i.ProfileSyncedAt,
+		&i.AnonymizedAt,
+		&i.ListPageSize,
+		&i.ListTagMatchAll,
+		&i.SearchIncludeArchived,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -82,7 +414,7 @@ UPDATE users
 SET tavily_mcp_token = $2,
     updated_at = CURRENT_TIMESTAMP
 WHERE user_id = $1
-RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, created_at, updated_at
+RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at
 `
 
 type UpdateUserTavilyMCPTokenParams struct {
@@ -91,14 +423,19 @@ type UpdateUserTavilyMCPTokenParams struct {
 }
 
 type UpdateUserTavilyMCPTokenRow struct {
-	ID             int32            `json:"id"`
-	UserID         string           `json:"user_id"`
-	Username       pgtype.Text      `json:"username"`
-	AvatarUrl      pgtype.Text      `json:"avatar_url"`
-	Email          pgtype.Text      `json:"email"`
-	TavilyMcpToken pgtype.Text      `json:"tavily_mcp_token"`
-	CreatedAt      pgtype.Timestamp `json:"created_at"`
-	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+	ID                    int32              `json:"id"`
+	UserID                string             `json:"user_id"`
+	Username              pgtype.Text        `json:"username"`
+	AvatarUrl             pgtype.Text        `json:"avatar_url"`
+	Email                 pgtype.Text        `json:"email"`
+	TavilyMcpToken        pgtype.Text        `json:"tavily_mcp_token"`
+	ProfileSyncedAt       pgtype.Timestamptz `json:"profile_synced_at"`
+	AnonymizedAt          pgtype.Timestamptz `json:"anonymized_at"`
+	ListPageSize          int32              `json:"list_page_size"`
+	ListTagMatchAll       bool               `json:"list_tag_match_all"`
+	SearchIncludeArchived bool               `json:"search_include_archived"`
+	CreatedAt             pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt             pgtype.Timestamp   `json:"updated_at"`
 }
 
 func (q *Queries) UpdateUserTavilyMCPToken(ctx context.Context, arg UpdateUserTavilyMCPTokenParams) (UpdateUserTavilyMCPTokenRow, error) {
@@ -111,6 +448,11 @@ func (q *Queries) UpdateUserTavilyMCPToken(ctx context.Context, arg UpdateUserTa
 		&i.AvatarUrl,
 		&i.Email,
 		&i.TavilyMcpToken,
+		&i.ProfileSyncedAt,
+		&i.AnonymizedAt,
+		&i.ListPageSize,
+		&i.ListTagMatchAll,
+		&i.SearchIncludeArchived,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -122,11 +464,11 @@ INSERT INTO users (user_id, username, avatar_url, email, tavily_mcp_token, updat
 VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP)
 ON CONFLICT (user_id) DO UPDATE
 SET 
-    username = COALESCE(users.username, EXCLUDED.username),
-    avatar_url = COALESCE(users.avatar_url, EXCLUDED.avatar_url),
-    email = COALESCE(users.email, EXCLUDED.email),
+    username = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.username, users.username) ELSE users.username END,
+    avatar_url = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.avatar_url, users.avatar_url) END,
+    email = CASE WHEN users.anonymized_at IS NULL THEN COALESCE(EXCLUDED.email, users.email) END,
     updated_at = CURRENT_TIMESTAMP
-RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, created_at, updated_at
+RETURNING id, user_id, username, avatar_url, email, tavily_mcp_token, profile_synced_at, anonymized_at, list_page_size, list_tag_match_all, search_include_archived, created_at, updated_at
 `
 
 type UpsertUserParams struct {
@@ -138,14 +480,19 @@ type UpsertUserParams struct {
 }
 
 type UpsertUserRow struct {
-	ID             int32            `json:"id"`
-	UserID         string           `json:"user_id"`
-	Username       pgtype.Text      `json:"username"`
-	AvatarUrl      pgtype.Text      `json:"avatar_url"`
-	Email          pgtype.Text      `json:"email"`
-	TavilyMcpToken pgtype.Text      `json:"tavily_mcp_token"`
-	CreatedAt      pgtype.Timestamp `json:"created_at"`
-	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+	ID                    int32              `json:"id"`
+	UserID                string             `json:"user_id"`
+	Username              pgtype.Text        `json:"username"`
+	AvatarUrl             pgtype.Text        `json:"avatar_url"`
+	Email                 pgtype.Text        `json:"email"`
+	TavilyMcpToken        pgtype.Text        `json:"tavily_mcp_token"`
+	ProfileSyncedAt       pgtype.Timestamptz `json:"profile_synced_at"`
+	AnonymizedAt          pgtype.Timestamptz `json:"anonymized_at"`
+	ListPageSize          int32              `json:"list_page_size"`
+	ListTagMatchAll       bool               `json:"list_tag_match_all"`
+	SearchIncludeArchived bool               `json:"search_include_archived"`
+	CreatedAt             pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt             pgtype.Timestamp   `json:"updated_at"`
 }
 
 func (q *Queries) UpsertUser(ctx context.Context, arg UpsertUserParams) (UpsertUserRow, error) {
@@ -164,8 +511,24 @@ func (q *Queries) UpsertUser(ctx context.Context, arg UpsertUserParams) (UpsertU
 		&i.AvatarUrl,
 		&i.Email,
 		&i.TavilyMcpToken,
+		&i.ProfileSyncedAt,
+		&i.AnonymizedAt,
+		&i.ListPageSize,
+		&i.ListTagMatchAll,
+		&i.SearchIncludeArchived,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
+
+const userExists = `-- name: UserExists :one
+SELECT EXISTS (SELECT 1 FROM users WHERE user_id = $1)
+`
+
+func (q *Queries) UserExists(ctx context.Context, userID string) (bool, error) {
+	row := q.db.QueryRow(ctx, userExists, userID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,303 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/caldav/domain"
+	taskapp "github.com/slips-ai/slips-core/internal/task/application"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// clientRequestPrefix marks the client request ID of tasks created over
+// CalDAV; the rest of it is the VTODO's UID, which also names the resource
+const clientRequestPrefix = "caldav:"
+
+const (
+	// streamChunkSize is the number of tasks loaded at a time when listing
+	// the collection
+	streamChunkSize = 200
+	// tagPageSize is the number of tags loaded at a time when resolving tag
+	// names
+	tagPageSize = 100
+	// maxTodoTags is the maximum number of CATEGORIES on a stored VTODO
+	maxTodoTags = 20
+)
+
+// ListObjects returns every task of the caller as a calendar object.
+// Archived tasks are left out.
+func (s *Service) ListObjects(ctx context.Context) ([]*domain.Object, error) {
+	ctx, span := tracer.Start(ctx, "ListObjects")
+	defer span.End()
+
+	objects, err := s.listObjects(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list calendar objects", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("count", len(objects)))
+	return objects, nil
+}
+
+// GetObject returns the named calendar object, or domain.ErrObjectNotFound
+// when there is none
+func (s *Service) GetObject(ctx context.Context, name string) (*domain.Object, error) {
+	ctx, span := tracer.Start(ctx, "GetObject", trace.WithAttributes(
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	object, err := s.findObject(ctx, name)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return object, nil
+}
+
+// PutObject creates or replaces the named calendar object and reports
+// whether it was created. ifMatch and ifNoneMatch are the request's
+// conditional headers; when they do not hold, domain.ErrPreconditionFailed
+// is returned and nothing changes.
+func (s *Service) PutObject(ctx context.Context, name string, todo *domain.Todo, ifMatch, ifNoneMatch string) (*domain.Object, bool, error) {
+	ctx, span := tracer.Start(ctx, "PutObject", trace.WithAttributes(
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	if err := validateTodo(todo); err != nil {
+		return nil, false, err
+	}
+
+	existing, err := s.findObject(ctx, name)
+	if err != nil && !errors.Is(err, domain.ErrObjectNotFound) {
+		span.RecordError(err)
+		return nil, false, err
+	}
+
+	if existing == nil {
+		if ifMatch != "" {
+			return nil, false, domain.ErrPreconditionFailed
+		}
+		if todo.UID != name {
+			return nil, false, domain.ErrUIDMismatch
+		}
+		if len(clientRequestPrefix+todo.UID) > grpcerrors.MaxClientRequestIDLength {
+			return nil, false, fmt.Errorf("%w: UID exceeds %d characters", domain.ErrInvalidCalendarData, grpcerrors.MaxClientRequestIDLength-len(clientRequestPrefix))
+		}
+		task, err := s.tasks.CreateTask(ctx, todo.Summary, todo.Description, todo.Categories, todo.Start, todo.Due, "", nil, clientRequestPrefix+todo.UID)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to create task from calendar object", "name", name, "error", err)
+			span.RecordError(err)
+			return nil, false, err
+		}
+		if todo.Completed {
+			if _, err := s.tasks.CompleteTask(ctx, task.ID); err != nil {
+				s.logger.ErrorContext(ctx, "failed to complete task from calendar object", "id", task.ID, "error", err)
+				span.RecordError(err)
+				return nil, false, err
+			}
+		}
+		object, err := s.loadObject(ctx, task.ID)
+		if err != nil {
+			span.RecordError(err)
+			return nil, false, err
+		}
+		s.logger.InfoContext(ctx, "calendar object created", "name", name, "task_id", task.ID)
+		return object, true, nil
+	}
+
+	if ifNoneMatch == "*" || (ifMatch != "" && ifMatch != "*" && ifMatch != existing.ETag) {
+		return nil, false, domain.ErrPreconditionFailed
+	}
+	if todo.UID != existing.Todo.UID {
+		return nil, false, domain.ErrUIDMismatch
+	}
+
+	patch := taskapp.TaskPatch{
+		Title:        &todo.Summary,
+		Notes:        &todo.Description,
+		SetTagNames:  todo.HasCategories,
+		TagNames:     todo.Categories,
+		SetStartDate: true,
+		StartDate:    todo.Start,
+		SetDeadline:  true,
+		Deadline:     todo.Due,
+	}
+	if _, err := s.tasks.PatchTask(ctx, existing.TaskID, patch); err != nil {
+		s.logger.ErrorContext(ctx, "failed to update task from calendar object", "id", existing.TaskID, "error", err)
+		span.RecordError(err)
+		return nil, false, err
+	}
+	switch {
+	case todo.Completed && !existing.Todo.Completed:
+		_, err = s.tasks.CompleteTask(ctx, existing.TaskID)
+	case !todo.Completed && existing.Todo.Completed:
+		_, err = s.tasks.ReopenTask(ctx, existing.TaskID)
+	}
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to change task completion from calendar object", "id", existing.TaskID, "error", err)
+		span.RecordError(err)
+		return nil, false, err
+	}
+
+	object, err := s.loadObject(ctx, existing.TaskID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, false, err
+	}
+	return object, false, nil
+}
+
+// DeleteObject deletes the task behind the named calendar object. It
+// returns domain.ErrObjectNotFound when there is none and
+// domain.ErrPreconditionFailed when ifMatch does not hold.
+func (s *Service) DeleteObject(ctx context.Context, name, ifMatch string) error {
+	ctx, span := tracer.Start(ctx, "DeleteObject", trace.WithAttributes(
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	object, err := s.findObject(ctx, name)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if ifMatch != "" && ifMatch != "*" && ifMatch != object.ETag {
+		return domain.ErrPreconditionFailed
+	}
+
+	if err := s.tasks.DeleteTask(ctx, object.TaskID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete task of calendar object", "id", object.TaskID, "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "calendar object deleted", "name", name, "task_id", object.TaskID)
+	return nil
+}
+
+// findObject looks the named object up among the caller's tasks. Names
+// are not stored, so this walks the collection.
+func (s *Service) findObject(ctx context.Context, name string) (*domain.Object, error) {
+	objects, err := s.listObjects(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list calendar objects", "error", err)
+		return nil, err
+	}
+	for _, object := range objects {
+		if object.Name == name {
+			return object, nil
+		}
+	}
+	return nil, domain.ErrObjectNotFound
+}
+
+// loadObject returns the calendar object of a task
+func (s *Service) loadObject(ctx context.Context, taskID uuid.UUID) (*domain.Object, error) {
+	task, err := s.tasks.GetTask(ctx, taskID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get task", "id", taskID, "error", err)
+		return nil, err
+	}
+	tagNames, err := s.tagNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return taskToObject(task, tagNames), nil
+}
+
+func (s *Service) listObjects(ctx context.Context) ([]*domain.Object, error) {
+	tagNames, err := s.tagNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []*domain.Object
+	err = s.tasks.StreamTasks(ctx, streamChunkSize, taskdomain.ScanOptions{}, func(tasks []*taskdomain.Task) error {
+		for _, task := range tasks {
+			objects = append(objects, taskToObject(task, tagNames))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// tagNames maps the caller's tag IDs to tag names
+func (s *Service) tagNames(ctx context.Context) (map[uuid.UUID]string, error) {
+	names := make(map[uuid.UUID]string)
+	for offset := 0; ; offset += tagPageSize {
+		tags, err := s.tags.ListTags(ctx, tagPageSize, offset)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to list tags", "error", err)
+			return nil, err
+		}
+		for _, tag := range tags {
+			names[tag.ID] = tag.Name
+		}
+		if len(tags) < tagPageSize {
+			return names, nil
+		}
+	}
+}
+
+// taskToObject presents a task as a calendar object. Tasks created over
+// CalDAV keep the client's UID and resource name; others use their ID.
+func taskToObject(task *taskdomain.Task, tagNames map[uuid.UUID]string) *domain.Object {
+	uid := task.ID.String()
+	if rest, ok := strings.CutPrefix(task.ClientRequestID, clientRequestPrefix); ok && rest != "" {
+		uid = rest
+	}
+
+	todo := &domain.Todo{
+		UID:          uid,
+		Summary:      task.Title,
+		Description:  task.Notes,
+		Start:        task.StartDate,
+		Due:          task.Deadline,
+		Completed:    task.CompletedAt != nil,
+		CompletedAt:  task.CompletedAt,
+		Created:      task.CreatedAt,
+		LastModified: task.UpdatedAt,
+	}
+	for _, tagID := range task.TagIDs {
+		if name, ok := tagNames[tagID]; ok {
+			todo.Categories = append(todo.Categories, name)
+		}
+	}
+	return domain.NewObject(uid, task.ID, todo)
+}
+
+// validateTodo applies the limits of the task API to a VTODO before it is
+// stored
+func validateTodo(todo *domain.Todo) error {
+	if strings.TrimSpace(todo.Summary) == "" {
+		return fmt.Errorf("%w: SUMMARY is required", domain.ErrInvalidCalendarData)
+	}
+	if len(todo.Summary) > grpcerrors.MaxTitleLength {
+		return fmt.Errorf("%w: SUMMARY exceeds %d characters", domain.ErrInvalidCalendarData, grpcerrors.MaxTitleLength)
+	}
+	if len(todo.Description) > grpcerrors.MaxNotesLength {
+		return fmt.Errorf("%w: DESCRIPTION exceeds %d characters", domain.ErrInvalidCalendarData, grpcerrors.MaxNotesLength)
+	}
+	if len(todo.Categories) > maxTodoTags {
+		return fmt.Errorf("%w: more than %d CATEGORIES", domain.ErrInvalidCalendarData, maxTodoTags)
+	}
+	for _, name := range todo.Categories {
+		if err := grpcerrors.ValidateTagName(name); err != nil {
+			return fmt.Errorf("%w: category %q is invalid", domain.ErrInvalidCalendarData, name)
+		}
+	}
+	return nil
+}
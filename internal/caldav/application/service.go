@@ -0,0 +1,185 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/caldav/domain"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	taskapp "github.com/slips-ai/slips-core/internal/task/application"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("caldav-service")
+
+// lastUsedInterval is how stale LastUsedAt may get before a sign-in
+// refreshes it, so clients syncing every few seconds do not write each time
+const lastUsedInterval = time.Minute
+
+// TaskService reads and writes the tasks exposed as calendar objects; the
+// task service implements it
+type TaskService interface {
+	CreateTask(ctx context.Context, title, notes string, tagNames []string, startDate, deadline *time.Time, taskContext string, checklistItems []string, clientRequestID string) (*taskdomain.Task, error)
+	GetTask(ctx context.Context, id uuid.UUID) (*taskdomain.Task, error)
+	StreamTasks(ctx context.Context, chunkSize int, opts taskdomain.ScanOptions, send func([]*taskdomain.Task) error) error
+	PatchTask(ctx context.Context, id uuid.UUID, patch taskapp.TaskPatch) (*taskdomain.Task, error)
+	CompleteTask(ctx context.Context, id uuid.UUID) (*taskdomain.Task, error)
+	ReopenTask(ctx context.Context, id uuid.UUID) (*taskdomain.Task, error)
+	DeleteTask(ctx context.Context, id uuid.UUID) error
+}
+
+// TagLister resolves tag IDs to names; the tag service implements it
+type TagLister interface {
+	ListTags(ctx context.Context, limit, offset int) ([]*tagdomain.Tag, error)
+}
+
+// Service provides app passwords and the CalDAV view of a user's tasks
+type Service struct {
+	repo   domain.Repository
+	tasks  TaskService
+	tags   TagLister
+	logger *slog.Logger
+}
+
+// NewService creates a new CalDAV service
+func NewService(repo domain.Repository, tasks TaskService, tags TagLister, logger *slog.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		tasks:  tasks,
+		tags:   tags,
+		logger: logger,
+	}
+}
+
+// CreateAppPassword creates an app password and returns it together with
+// the password, which cannot be retrieved later
+func (s *Service) CreateAppPassword(ctx context.Context, name string) (*domain.AppPassword, string, error) {
+	ctx, span := tracer.Start(ctx, "CreateAppPassword", trace.WithAttributes(
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+
+	existing, err := s.repo.List(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list app passwords", "error", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+	if len(existing) >= domain.MaxAppPasswordsPerUser {
+		return nil, "", domain.ErrTooManyAppPasswords
+	}
+
+	appPassword, password, err := domain.NewAppPassword(name, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to generate app password", "error", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+	if err := s.repo.Create(ctx, appPassword); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create app password", "error", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+
+	s.logger.InfoContext(ctx, "app password created", "id", appPassword.ID, "user_id", userID)
+	return appPassword, password, nil
+}
+
+// ListAppPasswords lists the caller's app passwords
+func (s *Service) ListAppPasswords(ctx context.Context) ([]*domain.AppPassword, error) {
+	ctx, span := tracer.Start(ctx, "ListAppPasswords")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	passwords, err := s.repo.List(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list app passwords", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return passwords, nil
+}
+
+// DeleteAppPassword deletes an app password; clients using it are signed
+// out on their next request
+func (s *Service) DeleteAppPassword(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "DeleteAppPassword", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, userID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete app password", "id", id, "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "app password deleted", "id", id)
+	return nil
+}
+
+// Authenticate checks HTTP Basic credentials and returns the principal
+// they sign in as. It returns domain.ErrInvalidCredentials when they do not
+// match an app password.
+func (s *Service) Authenticate(ctx context.Context, username, password string) (*auth.Principal, error) {
+	ctx, span := tracer.Start(ctx, "Authenticate")
+	defer span.End()
+
+	appPassword, err := s.repo.GetByHash(ctx, domain.HashPassword(password))
+	if errors.Is(err, domain.ErrAppPasswordNotFound) {
+		return nil, domain.ErrInvalidCredentials
+	}
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to look up app password", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if appPassword.Username() != username {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	now := time.Now()
+	if appPassword.LastUsedAt == nil || now.Sub(*appPassword.LastUsedAt) > lastUsedInterval {
+		if err := s.repo.MarkUsed(ctx, appPassword.ID, now); err != nil {
+			// Signing in matters more than the timestamp
+			s.logger.WarnContext(ctx, "failed to record app password use", "id", appPassword.ID, "error", err)
+		}
+	}
+
+	return &auth.Principal{
+		UserID:     appPassword.UserID,
+		Credential: auth.CredentialAppPassword,
+		ClientID:   "caldav:" + appPassword.ID.String(),
+	}, nil
+}
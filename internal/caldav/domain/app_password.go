@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+// MaxAppPasswordsPerUser bounds how many app passwords a user can create
+const MaxAppPasswordsPerUser = 20
+
+// passwordAlphabet avoids characters that are easily confused when a
+// password is typed on a phone
+const passwordAlphabet = "abcdefghijkmnpqrstuvwxyz23456789"
+
+var (
+	// ErrInvalidCredentials is returned for a user name and password that
+	// do not match an app password
+	ErrInvalidCredentials = errors.New("invalid user name or app password")
+	// ErrTooManyAppPasswords is returned when a user already has
+	// MaxAppPasswordsPerUser app passwords
+	ErrTooManyAppPasswords = domainerrors.New(domainerrors.ErrQuotaExceeded, fmt.Sprintf("at most %d app passwords are allowed", MaxAppPasswordsPerUser))
+	// ErrAppPasswordNotFound is returned for passwords that match no app
+	// password
+	ErrAppPasswordNotFound = domainerrors.New(domainerrors.ErrNotFound, "app password not found")
+)
+
+// AppPassword lets a CalDAV client sign in as its owner with HTTP Basic
+// authentication. Only the hash of the password is stored; the password
+// itself is shown once, when it is created.
+type AppPassword struct {
+	ID           uuid.UUID
+	UserID       string
+	Name         string
+	PasswordHash string
+	CreatedAt    time.Time
+	LastUsedAt   *time.Time
+}
+
+// NewAppPassword creates an app password and returns it with the password
+// Note: CreatedAt is not set here. It will be populated by the database on
+// insertion (DEFAULT NOW()).
+func NewAppPassword(name, userID string) (*AppPassword, string, error) {
+	password, err := generatePassword()
+	if err != nil {
+		return nil, "", err
+	}
+	return &AppPassword{
+		ID:           uuid.New(),
+		UserID:       userID,
+		Name:         name,
+		PasswordHash: HashPassword(password),
+	}, password, nil
+}
+
+// Username is the user name clients sign in with, alongside the password
+func (p *AppPassword) Username() string {
+	return p.ID.String()
+}
+
+// HashPassword returns the hex SHA-256 of a password. App passwords are
+// random and long, so a fast hash is enough to protect them at rest.
+func HashPassword(password string) string {
+	sum := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(sum[:])
+}
+
+// generatePassword returns 24 random characters in groups of six, about
+// 120 bits of entropy
+func generatePassword() (string, error) {
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for i, c := range raw {
+		if i > 0 && i%6 == 0 {
+			b.WriteByte('-')
+		}
+		b.WriteByte(passwordAlphabet[int(c)%len(passwordAlphabet)])
+	}
+	return b.String(), nil
+}
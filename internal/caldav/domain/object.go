@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+var (
+	// ErrPreconditionFailed is returned when an If-Match or If-None-Match
+	// condition does not hold
+	ErrPreconditionFailed = errors.New("precondition failed")
+	// ErrUIDMismatch is returned when a new calendar object is not stored
+	// under its own UID
+	ErrUIDMismatch = errors.New("calendar object UID must match its resource name")
+	// ErrObjectNotFound is returned for names that match none of the
+	// caller's calendar objects
+	ErrObjectNotFound = domainerrors.New(domainerrors.ErrNotFound, "calendar object not found")
+)
+
+// Object is a task exposed as a calendar object resource
+type Object struct {
+	// Name is the resource name without the ".ics" suffix
+	Name   string
+	TaskID uuid.UUID
+	Todo   *Todo
+	// Data is the encoded Todo and ETag a strong validator of it
+	Data []byte
+	ETag string
+}
+
+// NewObject encodes todo as the named calendar object
+func NewObject(name string, taskID uuid.UUID, todo *Todo) *Object {
+	data := todo.Encode()
+	return &Object{
+		Name:   name,
+		TaskID: taskID,
+		Todo:   todo,
+		Data:   data,
+		ETag:   quotedHash(data),
+	}
+}
+
+// CollectionTag changes whenever an object of the collection is added,
+// changed or removed. Clients compare it to skip syncing unchanged
+// collections.
+func CollectionTag(objects []*Object) string {
+	keys := make([]string, len(objects))
+	for i, object := range objects {
+		keys[i] = object.Name + "\x00" + object.ETag
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, key := range keys {
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+func quotedHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
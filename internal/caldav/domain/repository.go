@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for app password persistence
+type Repository interface {
+	Create(ctx context.Context, password *AppPassword) error
+	// GetByHash retrieves the app password with the given PasswordHash, for
+	// clients that authenticate with it rather than as its owner. It
+	// returns ErrAppPasswordNotFound if there is none.
+	GetByHash(ctx context.Context, hash string) (*AppPassword, error)
+	// List returns the user's app passwords, oldest first
+	List(ctx context.Context, userID string) ([]*AppPassword, error)
+	Delete(ctx context.Context, id uuid.UUID, userID string) error
+	MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+}
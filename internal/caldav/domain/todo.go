@@ -0,0 +1,279 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType is the media type of calendar object resources
+const ContentType = "text/calendar; charset=utf-8; component=VTODO"
+
+// productID identifies the server in the calendar objects it writes
+const productID = "-//Slips//Slips Core//EN"
+
+// maxLineLength is the longest content line, in octets, before it is folded
+const maxLineLength = 75
+
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405Z"
+)
+
+// ErrInvalidCalendarData is returned for request bodies that are not an
+// iCalendar object with a VTODO
+var ErrInvalidCalendarData = errors.New("invalid calendar data")
+
+// Todo is a task as exchanged with CalDAV clients, in a VTODO component
+type Todo struct {
+	UID         string
+	Summary     string
+	Description string
+	// Categories carries the task's tag names. HasCategories reports whether
+	// a parsed VTODO had any CATEGORIES, since clients without tag support
+	// omit them and should leave tags unchanged.
+	Categories    []string
+	HasCategories bool
+	// Start and Due are calendar dates at midnight UTC
+	Start *time.Time
+	Due   *time.Time
+	// Completed is set for STATUS:COMPLETED; CompletedAt is written when
+	// known
+	Completed    bool
+	CompletedAt  *time.Time
+	Created      time.Time
+	LastModified time.Time
+}
+
+// Encode renders the todo as an iCalendar object
+func (t *Todo) Encode() []byte {
+	var b strings.Builder
+	line := func(name, value string) {
+		writeFolded(&b, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", productID)
+	line("BEGIN", "VTODO")
+	line("UID", escapeText(t.UID))
+	line("DTSTAMP", t.LastModified.UTC().Format(dateTimeFormat))
+	line("CREATED", t.Created.UTC().Format(dateTimeFormat))
+	line("LAST-MODIFIED", t.LastModified.UTC().Format(dateTimeFormat))
+	line("SUMMARY", escapeText(t.Summary))
+	if t.Description != "" {
+		line("DESCRIPTION", escapeText(t.Description))
+	}
+	if len(t.Categories) > 0 {
+		escaped := make([]string, len(t.Categories))
+		for i, category := range t.Categories {
+			escaped[i] = escapeText(category)
+		}
+		line("CATEGORIES", strings.Join(escaped, ","))
+	}
+	if t.Start != nil {
+		line("DTSTART;VALUE=DATE", t.Start.Format(dateFormat))
+	}
+	if t.Due != nil {
+		line("DUE;VALUE=DATE", t.Due.Format(dateFormat))
+	}
+	if t.Completed {
+		line("STATUS", "COMPLETED")
+		line("PERCENT-COMPLETE", "100")
+		if t.CompletedAt != nil {
+			line("COMPLETED", t.CompletedAt.UTC().Format(dateTimeFormat))
+		}
+	} else {
+		line("STATUS", "NEEDS-ACTION")
+	}
+	line("END", "VTODO")
+	line("END", "VCALENDAR")
+	return []byte(b.String())
+}
+
+// ParseTodo reads the first VTODO of an iCalendar object. Properties this
+// server does not store are ignored, as are nested components such as
+// alarms.
+func ParseTodo(data []byte) (*Todo, error) {
+	var todo *Todo
+	depth := 0 // components open inside the VTODO
+	inCalendar := false
+	for _, raw := range unfold(string(data)) {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		name, value, err := parseLine(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case name == "BEGIN" && !inCalendar:
+			if !strings.EqualFold(value, "VCALENDAR") {
+				return nil, ErrInvalidCalendarData
+			}
+			inCalendar = true
+			continue
+		case name == "BEGIN" && todo == nil && strings.EqualFold(value, "VTODO"):
+			todo = &Todo{}
+			continue
+		case name == "BEGIN" && todo != nil:
+			depth++
+			continue
+		case name == "END" && todo != nil && depth > 0:
+			depth--
+			continue
+		case name == "END" && todo != nil && strings.EqualFold(value, "VTODO"):
+			if todo.UID == "" {
+				return nil, ErrInvalidCalendarData
+			}
+			return todo, nil
+		}
+		if todo == nil || depth > 0 {
+			continue
+		}
+
+		switch name {
+		case "UID":
+			todo.UID = strings.TrimSpace(unescapeText(value))
+		case "SUMMARY":
+			todo.Summary = unescapeText(value)
+		case "DESCRIPTION":
+			todo.Description = unescapeText(value)
+		case "CATEGORIES":
+			todo.HasCategories = true
+			for _, category := range splitText(value) {
+				if category = strings.TrimSpace(category); category != "" {
+					todo.Categories = append(todo.Categories, category)
+				}
+			}
+		case "DTSTART":
+			if todo.Start, err = parseDate(value); err != nil {
+				return nil, err
+			}
+		case "DUE":
+			if todo.Due, err = parseDate(value); err != nil {
+				return nil, err
+			}
+		case "STATUS":
+			todo.Completed = strings.EqualFold(value, "COMPLETED")
+		case "COMPLETED":
+			todo.Completed = true
+			if at, err := time.Parse(dateTimeFormat, value); err == nil {
+				todo.CompletedAt = &at
+			}
+		}
+	}
+	return nil, ErrInvalidCalendarData
+}
+
+// unfold joins continuation lines, which start with a space or tab, onto
+// the line before them
+func unfold(data string) []string {
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	var lines []string
+	for _, line := range strings.Split(data, "\n") {
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// parseLine splits a content line into its upper-cased name and its value,
+// dropping parameters. Parameter values may be quoted and contain colons.
+func parseLine(line string) (name, value string, err error) {
+	quoted := false
+	for i, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ':' && !quoted:
+			name, _, _ = strings.Cut(line[:i], ";")
+			return strings.ToUpper(strings.TrimSpace(name)), line[i+1:], nil
+		}
+	}
+	return "", "", ErrInvalidCalendarData
+}
+
+// parseDate reads the date of a DATE or DATE-TIME value. Times are dropped:
+// a DATE-TIME in a time zone keeps its local date.
+func parseDate(value string) (*time.Time, error) {
+	if len(value) < len(dateFormat) {
+		return nil, ErrInvalidCalendarData
+	}
+	date, err := time.Parse(dateFormat, value[:len(dateFormat)])
+	if err != nil {
+		return nil, ErrInvalidCalendarData
+	}
+	return &date, nil
+}
+
+// escapeText escapes a TEXT value
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// unescapeText reverses escapeText
+func unescapeText(s string) string {
+	var b strings.Builder
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			if r == 'n' || r == 'N' {
+				b.WriteByte('\n')
+			} else {
+				b.WriteRune(r)
+			}
+			escaped = false
+		case r == '\\':
+			escaped = true
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// splitText splits a multi-valued TEXT property on unescaped commas and
+// unescapes each value
+func splitText(s string) []string {
+	var values []string
+	start := 0
+	escaped := false
+	for i, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',':
+			values = append(values, unescapeText(s[start:i]))
+			start = i + 1
+		}
+	}
+	return append(values, unescapeText(s[start:]))
+}
+
+// writeFolded writes a content line, folding it every maxLineLength octets
+// without splitting a UTF-8 sequence
+func writeFolded(b *strings.Builder, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines lose an octet to the leading space
+		limit = maxLineLength - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
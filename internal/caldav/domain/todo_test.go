@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTodo_EncodeParseRoundTrip(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	completedAt := time.Date(2026, 3, 4, 9, 30, 0, 0, time.UTC)
+	todo := &Todo{
+		UID:          "ABC-123",
+		Summary:      "Pay rent; call landlord, then relax",
+		Description:  strings.Repeat("ünïcode notes ", 20) + "\nsecond line \\ done",
+		Categories:   []string{"home", "bills,monthly"},
+		Start:        &start,
+		Due:          &due,
+		Completed:    true,
+		CompletedAt:  &completedAt,
+		Created:      start,
+		LastModified: completedAt,
+	}
+
+	data := todo.Encode()
+	for _, line := range strings.Split(strings.TrimSuffix(string(data), "\r\n"), "\r\n") {
+		if len(line) > maxLineLength {
+			t.Errorf("line of %d octets is not folded: %q", len(line), line)
+		}
+	}
+
+	parsed, err := ParseTodo(data)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if parsed.UID != todo.UID || parsed.Summary != todo.Summary || parsed.Description != todo.Description {
+		t.Errorf("parsed text = %q %q %q, want %q %q %q", parsed.UID, parsed.Summary, parsed.Description, todo.UID, todo.Summary, todo.Description)
+	}
+	if !parsed.HasCategories || !slices.Equal(parsed.Categories, todo.Categories) {
+		t.Errorf("categories = %q, want %q", parsed.Categories, todo.Categories)
+	}
+	if parsed.Start == nil || !parsed.Start.Equal(start) || parsed.Due == nil || !parsed.Due.Equal(due) {
+		t.Errorf("dates = %v, %v; want %v, %v", parsed.Start, parsed.Due, start, due)
+	}
+	if !parsed.Completed || parsed.CompletedAt == nil || !parsed.CompletedAt.Equal(completedAt) {
+		t.Errorf("completion = %v at %v, want completed at %v", parsed.Completed, parsed.CompletedAt, completedAt)
+	}
+}
+
+func TestParseTodo_ClientObject(t *testing.T) {
+	// Shaped like what iOS Reminders sends: a time-zoned DUE, an alarm and
+	// no categories
+	data := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"PRODID:-//Apple Inc.//iOS 17.0//EN\r\n" +
+		"BEGIN:VTODO\r\n" +
+		"UID:E6F1C0A2-1\r\n" +
+		" 234\r\n" +
+		"SUMMARY:Buy milk\r\n" +
+		"DUE;TZID=\"Europe/Berlin\":20260310T090000\r\n" +
+		"STATUS:NEEDS-ACTION\r\n" +
+		"BEGIN:VALARM\r\n" +
+		"SUMMARY:Alarm\r\n" +
+		"END:VALARM\r\n" +
+		"END:VTODO\r\n" +
+		"END:VCALENDAR\r\n"
+
+	todo, err := ParseTodo([]byte(data))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if todo.UID != "E6F1C0A2-1234" || todo.Summary != "Buy milk" {
+		t.Errorf("UID, summary = %q, %q; want unfolded UID and the VTODO's own summary", todo.UID, todo.Summary)
+	}
+	if todo.Due == nil || todo.Due.Format(dateFormat) != "20260310" {
+		t.Errorf("due = %v, want 2026-03-10", todo.Due)
+	}
+	if todo.HasCategories || todo.Completed {
+		t.Errorf("HasCategories, Completed = %v, %v; want false, false", todo.HasCategories, todo.Completed)
+	}
+}
+
+func TestParseTodo_Invalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"empty":    "",
+		"no todo":  "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+		"no uid":   "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nSUMMARY:x\r\nEND:VTODO\r\nEND:VCALENDAR\r\n",
+		"bad date": "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nUID:1\r\nDUE:soon\r\nEND:VTODO\r\nEND:VCALENDAR\r\n",
+		"not ical": "hello world",
+	} {
+		if _, err := ParseTodo([]byte(data)); !errors.Is(err, ErrInvalidCalendarData) {
+			t.Errorf("%s: err = %v, want ErrInvalidCalendarData", name, err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	caldavv1 "github.com/slips-ai/slips-core/gen/go/caldav/v1"
+	"github.com/slips-ai/slips-core/internal/caldav/application"
+	"github.com/slips-ai/slips-core/internal/caldav/domain"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CalDAVServer implements the CalDAVService gRPC server
+type CalDAVServer struct {
+	caldavv1.UnimplementedCalDAVServiceServer
+	service *application.Service
+}
+
+// NewCalDAVServer creates a new CalDAV gRPC server
+func NewCalDAVServer(service *application.Service) *CalDAVServer {
+	return &CalDAVServer{
+		service: service,
+	}
+}
+
+// CreateAppPassword creates a new app password
+func (s *CalDAVServer) CreateAppPassword(ctx context.Context, req *caldavv1.CreateAppPasswordRequest) (*caldavv1.CreateAppPasswordResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.Name, "name"); err != nil {
+		return nil, err
+	}
+	if err := grpcerrors.ValidateLength(req.Name, "name", grpcerrors.MaxAppPasswordNameLength); err != nil {
+		return nil, err
+	}
+
+	appPassword, password, err := s.service.CreateAppPassword(ctx, req.Name)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to create app password")
+	}
+
+	return &caldavv1.CreateAppPasswordResponse{
+		AppPassword: appPasswordToProto(appPassword),
+		Password:    password,
+	}, nil
+}
+
+// ListAppPasswords lists the caller's app passwords
+func (s *CalDAVServer) ListAppPasswords(ctx context.Context, req *caldavv1.ListAppPasswordsRequest) (*caldavv1.ListAppPasswordsResponse, error) {
+	appPasswords, err := s.service.ListAppPasswords(ctx)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to list app passwords")
+	}
+
+	protoAppPasswords := make([]*caldavv1.AppPassword, len(appPasswords))
+	for i, appPassword := range appPasswords {
+		protoAppPasswords[i] = appPasswordToProto(appPassword)
+	}
+
+	return &caldavv1.ListAppPasswordsResponse{
+		AppPasswords: protoAppPasswords,
+	}, nil
+}
+
+// DeleteAppPassword deletes an app password
+func (s *CalDAVServer) DeleteAppPassword(ctx context.Context, req *caldavv1.DeleteAppPasswordRequest) (*caldavv1.DeleteAppPasswordResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid app password ID format")
+	}
+
+	if err := s.service.DeleteAppPassword(ctx, id); err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to delete app password")
+	}
+
+	return &caldavv1.DeleteAppPasswordResponse{}, nil
+}
+
+func appPasswordToProto(appPassword *domain.AppPassword) *caldavv1.AppPassword {
+	protoAppPassword := &caldavv1.AppPassword{
+		Id:        appPassword.ID.String(),
+		Name:      appPassword.Name,
+		Username:  appPassword.Username(),
+		CreatedAt: timestamppb.New(appPassword.CreatedAt),
+	}
+	if appPassword.LastUsedAt != nil {
+		protoAppPassword.LastUsedAt = timestamppb.New(*appPassword.LastUsedAt)
+	}
+	return protoAppPassword
+}
@@ -0,0 +1,377 @@
+// Package http serves the user's tasks over CalDAV, so clients such as iOS
+// Reminders and Thunderbird can list and edit them as VTODOs.
+package http
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/slips-ai/slips-core/internal/caldav/application"
+	"github.com/slips-ai/slips-core/internal/caldav/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+const (
+	// WellKnownPath redirects to rootPath for client discovery (RFC 6764)
+	WellKnownPath = "/.well-known/caldav"
+
+	rootPath       = "/caldav/"
+	principalPath  = "/caldav/principal/"
+	homePath       = "/caldav/calendars/"
+	collectionPath = "/caldav/calendars/tasks/"
+
+	// objectSuffix ends the path of every calendar object
+	objectSuffix = ".ics"
+	// collectionName is the display name of the task collection
+	collectionName = "Slips"
+	// maxBodySize caps request bodies; a VTODO at the task limits is far
+	// smaller
+	maxBodySize = 1 << 20
+)
+
+// Handler serves the CalDAV tree under /caldav/ to clients signed in with
+// an app password
+type Handler struct {
+	service *application.Service
+	logger  *slog.Logger
+}
+
+// NewHandler creates a CalDAV handler. Mount it on both rootPath and
+// WellKnownPath.
+func NewHandler(service *application.Service, logger *slog.Logger) http.Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ServeHTTP authenticates the request and dispatches it on its method and
+// the kind of resource its path names
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == WellKnownPath {
+		http.Redirect(w, r, rootPath, http.StatusMovedPermanently)
+		return
+	}
+	if r.Method == http.MethodOptions {
+		w.Header().Set("DAV", "1, 3, calendar-access")
+		w.Header().Set("Allow", "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, PROPPATCH, REPORT")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		unauthorized(w)
+		return
+	}
+	principal, err := h.service.Authenticate(r.Context(), username, password)
+	if errors.Is(err, domain.ErrInvalidCredentials) {
+		unauthorized(w)
+		return
+	}
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "failed to authenticate CalDAV client", "error", err)
+		http.Error(w, "failed to authenticate", http.StatusInternalServerError)
+		return
+	}
+	r = r.WithContext(auth.WithPrincipal(r.Context(), principal))
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+
+	kind, name, ok := route(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch {
+	case r.Method == "PROPFIND":
+		h.propfind(w, r, kind, name)
+	case r.Method == "PROPPATCH":
+		h.proppatch(w, r)
+	case r.Method == "REPORT" && kind == kindCollection:
+		h.report(w, r)
+	case (r.Method == http.MethodGet || r.Method == http.MethodHead) && kind == kindObject:
+		h.get(w, r, name)
+	case r.Method == http.MethodPut && kind == kindObject:
+		h.put(w, r, name)
+	case r.Method == http.MethodDelete && kind == kindObject:
+		h.delete(w, r, name)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// route maps a path to the resource it names. Collections are matched with
+// or without their trailing slash.
+func route(path string) (resourceKind, string, bool) {
+	if !strings.HasSuffix(path, "/") && !strings.HasSuffix(path, objectSuffix) {
+		path += "/"
+	}
+	switch path {
+	case rootPath:
+		return kindRoot, "", true
+	case principalPath:
+		return kindPrincipal, "", true
+	case homePath:
+		return kindHome, "", true
+	case collectionPath:
+		return kindCollection, "", true
+	}
+
+	name, ok := strings.CutPrefix(path, collectionPath)
+	if !ok || strings.Contains(name, "/") {
+		return 0, "", false
+	}
+	name, ok = strings.CutSuffix(name, objectSuffix)
+	if !ok || name == "" {
+		return 0, "", false
+	}
+	return kindObject, name, true
+}
+
+// propfind describes the resource, and its members at Depth 1
+func (h *Handler) propfind(w http.ResponseWriter, r *http.Request, kind resourceKind, name string) {
+	var req propfindRequest
+	if err := decodeBody(r, &req); err != nil {
+		http.Error(w, "invalid PROPFIND body", http.StatusBadRequest)
+		return
+	}
+	names, namesOnly := []xml.Name(req.Prop), req.PropName != nil
+	if len(names) == 0 {
+		names = allProps
+	}
+	// Depth defaults to infinity, which is served as 1 since the tree is
+	// only two levels deep below the home
+	members := r.Header.Get("Depth") != "0"
+
+	ms := newMultistatus()
+	switch kind {
+	case kindRoot:
+		ms.addProps(&resource{href: rootPath, kind: kindRoot}, names, namesOnly)
+	case kindPrincipal:
+		ms.addProps(&resource{href: principalPath, kind: kindPrincipal}, names, namesOnly)
+	case kindHome:
+		ms.addProps(&resource{href: homePath, kind: kindHome}, names, namesOnly)
+		if members {
+			objects, ok := h.listObjects(w, r)
+			if !ok {
+				return
+			}
+			ms.addProps(collectionResource(objects), names, namesOnly)
+		}
+	case kindCollection:
+		objects, ok := h.listObjects(w, r)
+		if !ok {
+			return
+		}
+		ms.addProps(collectionResource(objects), names, namesOnly)
+		if members {
+			for _, object := range objects {
+				ms.addProps(objectResource(object), names, namesOnly)
+			}
+		}
+	case kindObject:
+		object, ok := h.getObject(w, r, name)
+		if !ok {
+			return
+		}
+		ms.addProps(objectResource(object), names, namesOnly)
+	}
+	ms.write(w)
+}
+
+// proppatch refuses every change: the properties served are computed
+func (h *Handler) proppatch(w http.ResponseWriter, r *http.Request) {
+	var req proppatchRequest
+	if err := decodeBody(r, &req); err != nil || req.XMLName.Local == "" {
+		http.Error(w, "invalid PROPPATCH body", http.StatusBadRequest)
+		return
+	}
+	var names []xml.Name
+	for _, set := range req.Set {
+		names = append(names, set.Prop...)
+	}
+	for _, remove := range req.Remove {
+		names = append(names, remove.Prop...)
+	}
+
+	ms := newMultistatus()
+	ms.addPropStatus(r.URL.Path, names, http.StatusForbidden)
+	ms.write(w)
+}
+
+// report answers calendar-multiget and calendar-query REPORTs on the
+// collection
+func (h *Handler) report(w http.ResponseWriter, r *http.Request) {
+	var req reportRequest
+	if err := decodeBody(r, &req); err != nil || req.XMLName.Space != nsCalDAV {
+		http.Error(w, "invalid REPORT body", http.StatusBadRequest)
+		return
+	}
+	names := []xml.Name(req.Prop)
+	if len(names) == 0 {
+		names = []xml.Name{propETag, propCalendarData}
+	}
+
+	objects, ok := h.listObjects(w, r)
+	if !ok {
+		return
+	}
+
+	ms := newMultistatus()
+	switch req.XMLName.Local {
+	case "calendar-multiget":
+		byName := make(map[string]*domain.Object, len(objects))
+		for _, object := range objects {
+			byName[object.Name] = object
+		}
+		for _, raw := range req.Hrefs {
+			path := strings.TrimSpace(raw)
+			if u, err := url.Parse(path); err == nil {
+				path = u.Path
+			}
+			kind, name, ok := route(path)
+			object := byName[name]
+			if !ok || kind != kindObject || object == nil {
+				ms.addStatus(path, http.StatusNotFound)
+				continue
+			}
+			ms.addProps(objectResource(object), names, false)
+		}
+	case "calendar-query":
+		for _, object := range objects {
+			if req.Filter != nil && !req.Filter.CompFilter.matches(object) {
+				continue
+			}
+			ms.addProps(objectResource(object), names, false)
+		}
+	default:
+		http.Error(w, "unsupported report", http.StatusForbidden)
+		return
+	}
+	ms.write(w)
+}
+
+// get responds with the calendar object
+func (h *Handler) get(w http.ResponseWriter, r *http.Request, name string) {
+	object, ok := h.getObject(w, r, name)
+	if !ok {
+		return
+	}
+	w.Header().Set("Content-Type", domain.ContentType)
+	w.Header().Set("ETag", object.ETag)
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		_, _ = w.Write(object.Data)
+	}
+}
+
+// put creates or replaces the calendar object. No ETag is returned since
+// the object is stored as the server re-encodes it, not byte for byte.
+func (h *Handler) put(w http.ResponseWriter, r *http.Request, name string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	todo, err := domain.ParseTodo(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, created, err := h.service.PutObject(r.Context(), name, todo, r.Header.Get("If-Match"), r.Header.Get("If-None-Match"))
+	switch {
+	case err == nil && created:
+		w.WriteHeader(http.StatusCreated)
+	case err == nil:
+		w.WriteHeader(http.StatusNoContent)
+	case errors.Is(err, domain.ErrInvalidCalendarData):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrUIDMismatch):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrPreconditionFailed):
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+	default:
+		h.logger.ErrorContext(r.Context(), "failed to store calendar object", "name", name, "error", err)
+		http.Error(w, "failed to store calendar object", http.StatusInternalServerError)
+	}
+}
+
+// delete deletes the task behind the calendar object
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request, name string) {
+	err := h.service.DeleteObject(r.Context(), name, r.Header.Get("If-Match"))
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusNoContent)
+	case errors.Is(err, domainerrors.ErrNotFound):
+		// The object is missing or its task was deleted concurrently
+		http.NotFound(w, r)
+	case errors.Is(err, domain.ErrPreconditionFailed):
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+	default:
+		h.logger.ErrorContext(r.Context(), "failed to delete calendar object", "name", name, "error", err)
+		http.Error(w, "failed to delete calendar object", http.StatusInternalServerError)
+	}
+}
+
+// listObjects lists the collection, responding with an error when that
+// fails
+func (h *Handler) listObjects(w http.ResponseWriter, r *http.Request) ([]*domain.Object, bool) {
+	objects, err := h.service.ListObjects(r.Context())
+	if err != nil {
+		http.Error(w, "failed to list calendar objects", http.StatusInternalServerError)
+		return nil, false
+	}
+	return objects, true
+}
+
+// getObject looks up a calendar object, responding with an error when that
+// fails
+func (h *Handler) getObject(w http.ResponseWriter, r *http.Request, name string) (*domain.Object, bool) {
+	object, err := h.service.GetObject(r.Context(), name)
+	switch {
+	case err == nil:
+		return object, true
+	case errors.Is(err, domain.ErrObjectNotFound):
+		http.NotFound(w, r)
+	default:
+		h.logger.ErrorContext(r.Context(), "failed to get calendar object", "name", name, "error", err)
+		http.Error(w, "failed to get calendar object", http.StatusInternalServerError)
+	}
+	return nil, false
+}
+
+func collectionResource(objects []*domain.Object) *resource {
+	return &resource{href: collectionPath, kind: kindCollection, ctag: domain.CollectionTag(objects)}
+}
+
+func objectResource(object *domain.Object) *resource {
+	return &resource{href: collectionPath + object.Name + objectSuffix, kind: kindObject, object: object}
+}
+
+// decodeBody decodes an XML request body into v; an empty body leaves v
+// unchanged
+func decodeBody(r *http.Request, v any) error {
+	err := xml.NewDecoder(r.Body).Decode(v)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+func unauthorized(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", `Basic realm="Slips", charset="UTF-8"`)
+	http.Error(w, "unauthorized", http.StatusUnauthorized)
+}
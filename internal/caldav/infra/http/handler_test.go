@@ -0,0 +1,158 @@
+package http
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/slips-ai/slips-core/internal/caldav/application"
+	"github.com/slips-ai/slips-core/internal/memory"
+	tagapp "github.com/slips-ai/slips-core/internal/tag/application"
+	taskapp "github.com/slips-ai/slips-core/internal/task/application"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+const todoBody = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:reminder-1\r\n" +
+	"SUMMARY:Buy milk\r\n" +
+	"CATEGORIES:errands\r\n" +
+	"DUE;VALUE=DATE:20260310\r\n" +
+	"END:VTODO\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestHandler_Sync(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	store := memory.NewStore()
+	changes := changefeed.NewHub()
+	tasks := taskapp.NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changes, taskdomain.DefaultChecklistLimits, logger)
+	tags := tagapp.NewService(memory.NewTagRepository(store), tasks, changes, logger)
+	service := application.NewService(memory.NewAppPasswordRepository(store), tasks, tags, logger)
+	handler := NewHandler(service, logger)
+
+	owner := auth.WithUserID(context.Background(), "owner")
+	appPassword, password, err := service.CreateAppPassword(owner, "iPhone")
+	if err != nil {
+		t.Fatalf("create app password: %v", err)
+	}
+	existing, err := tasks.CreateTask(owner, "Existing task", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+
+	do := func(method, path, body string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.SetBasicAuth(appPassword.Username(), password)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Wrong passwords are refused with a Basic challenge
+	req := httptest.NewRequest("PROPFIND", collectionPath, nil)
+	req.SetBasicAuth(appPassword.Username(), "wrong")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized || rec.Header().Get("WWW-Authenticate") == "" {
+		t.Fatalf("wrong password: status %d, challenge %q", rec.Code, rec.Header().Get("WWW-Authenticate"))
+	}
+
+	// Discovery leads from the principal to the task collection
+	rec = do("PROPFIND", principalPath, `<d:propfind xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav"><d:prop><c:calendar-home-set/></d:prop></d:propfind>`, http.Header{"Depth": {"0"}})
+	if rec.Code != http.StatusMultiStatus || !strings.Contains(rec.Body.String(), homePath) {
+		t.Fatalf("principal PROPFIND: status %d, body %s", rec.Code, rec.Body)
+	}
+
+	// A new reminder becomes a task with its tag and deadline
+	rec = do(http.MethodPut, collectionPath+"reminder-1.ics", todoBody, http.Header{"If-None-Match": {"*"}})
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("PUT new: status %d, body %s", rec.Code, rec.Body)
+	}
+	rec = do(http.MethodPut, collectionPath+"reminder-1.ics", todoBody, http.Header{"If-None-Match": {"*"}})
+	if rec.Code != http.StatusPreconditionFailed {
+		t.Errorf("PUT again with If-None-Match: status %d, want 412", rec.Code)
+	}
+	rec = do(http.MethodPut, collectionPath+"other.ics", todoBody, nil)
+	if rec.Code != http.StatusConflict {
+		t.Errorf("PUT under another name: status %d, want 409", rec.Code)
+	}
+
+	rec = do(http.MethodGet, collectionPath+"reminder-1.ics", "", nil)
+	etag := rec.Header().Get("ETag")
+	if rec.Code != http.StatusOK || etag == "" {
+		t.Fatalf("GET: status %d, ETag %q", rec.Code, etag)
+	}
+	for _, want := range []string{"UID:reminder-1", "SUMMARY:Buy milk", "CATEGORIES:errands", "DUE;VALUE=DATE:20260310"} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("GET body lacks %q:\n%s", want, rec.Body)
+		}
+	}
+
+	// The collection lists both tasks, the older one under its ID
+	rec = do("PROPFIND", collectionPath, `<d:propfind xmlns:d="DAV:"><d:prop><d:getetag/><d:unknown/></d:prop></d:propfind>`, http.Header{"Depth": {"1"}})
+	body := rec.Body.String()
+	if rec.Code != http.StatusMultiStatus {
+		t.Fatalf("collection PROPFIND: status %d, body %s", rec.Code, body)
+	}
+	for _, want := range []string{collectionPath + "reminder-1.ics", collectionPath + existing.ID.String() + ".ics", "404 Not Found"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("collection PROPFIND lacks %q:\n%s", want, body)
+		}
+	}
+
+	// Completing on the client completes the task, guarded by the ETag
+	completed := strings.Replace(todoBody, "END:VTODO", "STATUS:COMPLETED\r\nEND:VTODO", 1)
+	rec = do(http.MethodPut, collectionPath+"reminder-1.ics", completed, http.Header{"If-Match": {`"stale"`}})
+	if rec.Code != http.StatusPreconditionFailed {
+		t.Errorf("PUT with stale If-Match: status %d, want 412", rec.Code)
+	}
+	rec = do(http.MethodPut, collectionPath+"reminder-1.ics", completed, http.Header{"If-Match": {etag}})
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("PUT update: status %d, body %s", rec.Code, rec.Body)
+	}
+
+	// Incomplete reminders are queried with is-not-defined on COMPLETED
+	query := `<c:calendar-query xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav"><d:prop><d:getetag/></d:prop>` +
+		`<c:filter><c:comp-filter name="VCALENDAR"><c:comp-filter name="VTODO">` +
+		`<c:prop-filter name="COMPLETED"><c:is-not-defined/></c:prop-filter>` +
+		`</c:comp-filter></c:comp-filter></c:filter></c:calendar-query>`
+	rec = do("REPORT", collectionPath, query, nil)
+	body = rec.Body.String()
+	if rec.Code != http.StatusMultiStatus || strings.Contains(body, "reminder-1") || !strings.Contains(body, existing.ID.String()) {
+		t.Errorf("calendar-query: status %d, want only the open task:\n%s", rec.Code, body)
+	}
+
+	multiget := `<c:calendar-multiget xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav"><d:prop><c:calendar-data/></d:prop>` +
+		`<d:href>` + collectionPath + `reminder-1.ics</d:href><d:href>` + collectionPath + `gone.ics</d:href></c:calendar-multiget>`
+	rec = do("REPORT", collectionPath, multiget, nil)
+	body = rec.Body.String()
+	if rec.Code != http.StatusMultiStatus || !strings.Contains(body, "STATUS:COMPLETED") || !strings.Contains(body, "404 Not Found") {
+		t.Errorf("calendar-multiget: status %d, want the completed object and a 404:\n%s", rec.Code, body)
+	}
+
+	rec = do(http.MethodDelete, collectionPath+"reminder-1.ics", "", nil)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("DELETE: status %d, body %s", rec.Code, rec.Body)
+	}
+	if rec = do(http.MethodGet, collectionPath+"reminder-1.ics", "", nil); rec.Code != http.StatusNotFound {
+		t.Errorf("GET after DELETE: status %d, want 404", rec.Code)
+	}
+
+	// Deleting the app password signs the client out
+	if err := service.DeleteAppPassword(owner, appPassword.ID); err != nil {
+		t.Fatalf("delete app password: %v", err)
+	}
+	if rec = do("PROPFIND", collectionPath, "", nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("PROPFIND after deleting the app password: status %d, want 401", rec.Code)
+	}
+}
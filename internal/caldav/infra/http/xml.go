@@ -0,0 +1,341 @@
+package http
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/slips-ai/slips-core/internal/caldav/domain"
+)
+
+const (
+	nsDAV            = "DAV:"
+	nsCalDAV         = "urn:ietf:params:xml:ns:caldav"
+	nsCalendarServer = "http://calendarserver.org/ns/"
+)
+
+// prefixes are the namespace prefixes declared on every multistatus
+var prefixes = map[string]string{
+	nsDAV:            "d",
+	nsCalDAV:         "c",
+	nsCalendarServer: "cs",
+}
+
+// resourceKind is the kind of resource a path names
+type resourceKind int
+
+const (
+	kindRoot resourceKind = iota
+	kindPrincipal
+	kindHome
+	kindCollection
+	kindObject
+)
+
+// resource is a resource a multistatus response describes
+type resource struct {
+	href string
+	kind resourceKind
+	// ctag is set for kindCollection, object for kindObject
+	ctag   string
+	object *domain.Object
+}
+
+// propFunc renders the value of a property of res as XML, and reports
+// whether res has the property
+type propFunc func(res *resource) (string, bool)
+
+var (
+	propResourceType = xml.Name{Space: nsDAV, Local: "resourcetype"}
+	propDisplayName  = xml.Name{Space: nsDAV, Local: "displayname"}
+	propPrincipal    = xml.Name{Space: nsDAV, Local: "current-user-principal"}
+	propPrincipalURL = xml.Name{Space: nsDAV, Local: "principal-URL"}
+	propPrivileges   = xml.Name{Space: nsDAV, Local: "current-user-privilege-set"}
+	propReports      = xml.Name{Space: nsDAV, Local: "supported-report-set"}
+	propETag         = xml.Name{Space: nsDAV, Local: "getetag"}
+	propContentType  = xml.Name{Space: nsDAV, Local: "getcontenttype"}
+	propHomeSet      = xml.Name{Space: nsCalDAV, Local: "calendar-home-set"}
+	propComponents   = xml.Name{Space: nsCalDAV, Local: "supported-calendar-component-set"}
+	propCalendarData = xml.Name{Space: nsCalDAV, Local: "calendar-data"}
+	propCTag         = xml.Name{Space: nsCalendarServer, Local: "getctag"}
+)
+
+// allProps are the properties returned for allprop and propname requests.
+// calendar-data is left out since it is the whole object.
+var allProps = []xml.Name{
+	propResourceType,
+	propDisplayName,
+	propPrincipal,
+	propPrincipalURL,
+	propPrivileges,
+	propReports,
+	propETag,
+	propContentType,
+	propHomeSet,
+	propComponents,
+	propCTag,
+}
+
+var props = map[xml.Name]propFunc{
+	propResourceType: func(res *resource) (string, bool) {
+		switch res.kind {
+		case kindPrincipal:
+			return "<d:principal/>", true
+		case kindCollection:
+			return "<d:collection/><c:calendar/>", true
+		case kindObject:
+			return "", true
+		default:
+			return "<d:collection/>", true
+		}
+	},
+	propDisplayName: func(res *resource) (string, bool) {
+		if res.kind == kindCollection {
+			return collectionName, true
+		}
+		return "", false
+	},
+	propPrincipal: func(res *resource) (string, bool) {
+		return href(principalPath), true
+	},
+	propPrincipalURL: func(res *resource) (string, bool) {
+		return href(principalPath), res.kind == kindPrincipal
+	},
+	propPrivileges: func(res *resource) (string, bool) {
+		privileges := []string{"read"}
+		if res.kind == kindCollection || res.kind == kindObject {
+			privileges = append(privileges, "write", "write-content", "bind", "unbind")
+		}
+		var b strings.Builder
+		for _, privilege := range privileges {
+			b.WriteString("<d:privilege><d:" + privilege + "/></d:privilege>")
+		}
+		return b.String(), true
+	},
+	propReports: func(res *resource) (string, bool) {
+		return "<d:supported-report><d:report><c:calendar-multiget/></d:report></d:supported-report>" +
+			"<d:supported-report><d:report><c:calendar-query/></d:report></d:supported-report>", res.kind == kindCollection
+	},
+	propETag: func(res *resource) (string, bool) {
+		if res.kind != kindObject {
+			return "", false
+		}
+		return escape(res.object.ETag), true
+	},
+	propContentType: func(res *resource) (string, bool) {
+		return domain.ContentType, res.kind == kindObject
+	},
+	propHomeSet: func(res *resource) (string, bool) {
+		return href(homePath), res.kind == kindRoot || res.kind == kindPrincipal
+	},
+	propComponents: func(res *resource) (string, bool) {
+		return `<c:comp name="VTODO"/>`, res.kind == kindCollection
+	},
+	propCalendarData: func(res *resource) (string, bool) {
+		if res.kind != kindObject {
+			return "", false
+		}
+		return escape(string(res.object.Data)), true
+	},
+	propCTag: func(res *resource) (string, bool) {
+		return escape(res.ctag), res.kind == kindCollection
+	},
+}
+
+// propNames reads the names of the child elements of a DAV:prop element
+type propNames []xml.Name
+
+func (p *propNames) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			*p = append(*p, token.Name)
+			if err := d.Skip(); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// propfindRequest is the body of a PROPFIND request
+type propfindRequest struct {
+	XMLName  xml.Name  `xml:"DAV: propfind"`
+	AllProp  *struct{} `xml:"DAV: allprop"`
+	PropName *struct{} `xml:"DAV: propname"`
+	Prop     propNames `xml:"DAV: prop"`
+}
+
+// proppatchRequest is the body of a PROPPATCH request
+type proppatchRequest struct {
+	XMLName xml.Name `xml:"DAV: propertyupdate"`
+	Set     []struct {
+		Prop propNames `xml:"DAV: prop"`
+	} `xml:"DAV: set"`
+	Remove []struct {
+		Prop propNames `xml:"DAV: prop"`
+	} `xml:"DAV: remove"`
+}
+
+// reportRequest is the body of a calendar-multiget or calendar-query
+// REPORT
+type reportRequest struct {
+	XMLName xml.Name
+	Prop    propNames `xml:"DAV: prop"`
+	Hrefs   []string  `xml:"DAV: href"`
+	Filter  *struct {
+		CompFilter compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	} `xml:"urn:ietf:params:xml:ns:caldav filter"`
+}
+
+type compFilter struct {
+	Name        string       `xml:"name,attr"`
+	CompFilters []compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	PropFilters []struct {
+		Name         string    `xml:"name,attr"`
+		IsNotDefined *struct{} `xml:"urn:ietf:params:xml:ns:caldav is-not-defined"`
+	} `xml:"urn:ietf:params:xml:ns:caldav prop-filter"`
+}
+
+// matches reports whether object passes the filter, which starts at the
+// VCALENDAR component. Only component names and is-not-defined on
+// COMPLETED, DTSTART and DUE are evaluated; other conditions match
+// everything, which leaves clients to filter the extra objects themselves.
+func (f *compFilter) matches(object *domain.Object) bool {
+	if !strings.EqualFold(f.Name, "VCALENDAR") {
+		return false
+	}
+	for _, todoFilter := range f.CompFilters {
+		if !strings.EqualFold(todoFilter.Name, "VTODO") {
+			return false
+		}
+		for _, propFilter := range todoFilter.PropFilters {
+			if propFilter.IsNotDefined == nil {
+				continue
+			}
+			switch strings.ToUpper(propFilter.Name) {
+			case "COMPLETED":
+				if object.Todo.Completed {
+					return false
+				}
+			case "DTSTART":
+				if object.Todo.Start != nil {
+					return false
+				}
+			case "DUE":
+				if object.Todo.Due != nil {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// multistatus builds a 207 Multi-Status body
+type multistatus struct {
+	b strings.Builder
+}
+
+func newMultistatus() *multistatus {
+	m := &multistatus{}
+	m.b.WriteString(xml.Header)
+	m.b.WriteString(`<d:multistatus xmlns:d="DAV:" xmlns:c="` + nsCalDAV + `" xmlns:cs="` + nsCalendarServer + `">`)
+	return m
+}
+
+// addProps describes res with the requested properties. Properties res
+// does not have are listed as 404 Not Found; names alone are listed when
+// namesOnly is set.
+func (m *multistatus) addProps(res *resource, names []xml.Name, namesOnly bool) {
+	var found, missing strings.Builder
+	for _, name := range names {
+		value, ok := "", false
+		if prop, known := props[name]; known {
+			value, ok = prop(res)
+		}
+		switch {
+		case !ok:
+			missing.WriteString(element(name, ""))
+		case namesOnly:
+			found.WriteString(element(name, ""))
+		default:
+			found.WriteString(element(name, value))
+		}
+	}
+
+	m.b.WriteString("<d:response>" + href(res.href))
+	if found.Len() > 0 || missing.Len() == 0 {
+		m.addPropstat(found.String(), http.StatusOK)
+	}
+	if missing.Len() > 0 {
+		m.addPropstat(missing.String(), http.StatusNotFound)
+	}
+	m.b.WriteString("</d:response>")
+}
+
+// addStatus describes path with a status and no properties
+func (m *multistatus) addStatus(path string, code int) {
+	m.b.WriteString("<d:response>" + href(path) + "<d:status>" + statusLine(code) + "</d:status></d:response>")
+}
+
+// addPropStatus lists properties that all got the same status, as for a
+// PROPPATCH
+func (m *multistatus) addPropStatus(path string, names []xml.Name, code int) {
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(element(name, ""))
+	}
+	m.b.WriteString("<d:response>" + href(path))
+	m.addPropstat(b.String(), code)
+	m.b.WriteString("</d:response>")
+}
+
+func (m *multistatus) addPropstat(props string, code int) {
+	m.b.WriteString("<d:propstat><d:prop>" + props + "</d:prop><d:status>" + statusLine(code) + "</d:status></d:propstat>")
+}
+
+// write sends the response
+func (m *multistatus) write(w http.ResponseWriter) {
+	m.b.WriteString("</d:multistatus>")
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	_, _ = w.Write([]byte(m.b.String()))
+}
+
+// element renders a property. Properties outside the declared namespaces
+// declare their own.
+func element(name xml.Name, value string) string {
+	tag, decl := name.Local, ""
+	if prefix, ok := prefixes[name.Space]; ok {
+		tag = prefix + ":" + name.Local
+	} else if name.Space != "" {
+		tag = "x:" + name.Local
+		decl = ` xmlns:x="` + escape(name.Space) + `"`
+	}
+	if value == "" {
+		return "<" + tag + decl + "/>"
+	}
+	return "<" + tag + decl + ">" + value + "</" + tag + ">"
+}
+
+func href(path string) string {
+	return "<d:href>" + escape((&url.URL{Path: path}).EscapedPath()) + "</d:href>"
+}
+
+func statusLine(code int) string {
+	return fmt.Sprintf("HTTP/1.1 %d %s", code, http.StatusText(code))
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
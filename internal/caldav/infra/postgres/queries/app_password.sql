@@ -0,0 +1,24 @@
+-- name: CreateAppPassword :one
+INSERT INTO app_passwords (id, user_id, name, password_hash)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, name, password_hash, created_at, last_used_at;
+
+-- name: GetAppPasswordByHash :one
+SELECT id, user_id, name, password_hash, created_at, last_used_at
+FROM app_passwords
+WHERE password_hash = $1;
+
+-- name: ListAppPasswords :many
+SELECT id, user_id, name, password_hash, created_at, last_used_at
+FROM app_passwords
+WHERE user_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: DeleteAppPassword :exec
+DELETE FROM app_passwords
+WHERE id = $1 AND user_id = $2;
+
+-- name: MarkAppPasswordUsed :exec
+UPDATE app_passwords
+SET last_used_at = $2
+WHERE id = $1;
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/slips-ai/slips-core/internal/caldav/domain"
+)
+
+// AppPasswordRepository implements domain.Repository using PostgreSQL
+type AppPasswordRepository struct {
+	queries *Queries
+}
+
+// NewAppPasswordRepository creates a new app password repository
+func NewAppPasswordRepository(pool *pgxpool.Pool) *AppPasswordRepository {
+	return &AppPasswordRepository{
+		queries: New(pool),
+	}
+}
+
+// Create creates a new app password
+func (r *AppPasswordRepository) Create(ctx context.Context, password *domain.AppPassword) error {
+	result, err := r.queries.CreateAppPassword(ctx, CreateAppPasswordParams{
+		ID:           pgtype.UUID{Bytes: password.ID, Valid: true},
+		UserID:       password.UserID,
+		Name:         password.Name,
+		PasswordHash: password.PasswordHash,
+	})
+	if err != nil {
+		return err
+	}
+
+	password.CreatedAt = result.CreatedAt.Time
+	return nil
+}
+
+// GetByHash retrieves an app password by the hash of its password
+func (r *AppPasswordRepository) GetByHash(ctx context.Context, hash string) (*domain.AppPassword, error) {
+	result, err := r.queries.GetAppPasswordByHash(ctx, hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrAppPasswordNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomain(result)
+}
+
+// List lists the user's app passwords, oldest first
+func (r *AppPasswordRepository) List(ctx context.Context, userID string) ([]*domain.AppPassword, error) {
+	results, err := r.queries.ListAppPasswords(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	passwords := make([]*domain.AppPassword, len(results))
+	for i, result := range results {
+		password, err := toDomain(result)
+		if err != nil {
+			return nil, err
+		}
+		passwords[i] = password
+	}
+	return passwords, nil
+}
+
+// Delete deletes an app password
+func (r *AppPasswordRepository) Delete(ctx context.Context, id uuid.UUID, userID string) error {
+	return r.queries.DeleteAppPassword(ctx, DeleteAppPasswordParams{
+		ID:     pgtype.UUID{Bytes: id, Valid: true},
+		UserID: userID,
+	})
+}
+
+// MarkUsed records the time the app password last signed in
+func (r *AppPasswordRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.queries.MarkAppPasswordUsed(ctx, MarkAppPasswordUsedParams{
+		ID:         pgtype.UUID{Bytes: id, Valid: true},
+		LastUsedAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+}
+
+func toDomain(row AppPassword) (*domain.AppPassword, error) {
+	id, err := uuid.FromBytes(row.ID.Bytes[:])
+	if err != nil {
+		return nil, err
+	}
+
+	password := &domain.AppPassword{
+		ID:           id,
+		UserID:       row.UserID,
+		Name:         row.Name,
+		PasswordHash: row.PasswordHash,
+		CreatedAt:    row.CreatedAt.Time,
+	}
+	if row.LastUsedAt.Valid {
+		password.LastUsedAt = &row.LastUsedAt.Time
+	}
+	return password, nil
+}
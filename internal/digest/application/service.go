@@ -0,0 +1,329 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/internal/digest/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/mail"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("digest-service")
+
+// sendTimeout bounds the delivery of one digest, so a hanging mail server
+// cannot stall the whole run
+const sendTimeout = 30 * time.Second
+
+// TaskDigester collects the tasks of a digest; the task service implements
+// it
+type TaskDigester interface {
+	GetDigest(ctx context.Context, opts taskdomain.DigestOptions) (*taskdomain.Digest, error)
+}
+
+// UserGetter looks up the email address of a user; the user repository
+// implements it
+type UserGetter interface {
+	GetUserByUserID(ctx context.Context, userID string) (*authdomain.User, error)
+}
+
+// Service provides digest preferences and sends digests
+type Service struct {
+	repo   domain.Repository
+	tasks  TaskDigester
+	users  UserGetter
+	mailer mail.Sender
+	logger *slog.Logger
+}
+
+// NewService creates a new digest service. mailer may be nil when no mail
+// provider is configured; digests cannot be turned on then.
+func NewService(repo domain.Repository, tasks TaskDigester, users UserGetter, mailer mail.Sender, logger *slog.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		tasks:  tasks,
+		users:  users,
+		mailer: mailer,
+		logger: logger,
+	}
+}
+
+// GetPreferences returns the caller's digest preferences, the defaults if
+// they never set any
+func (s *Service) GetPreferences(ctx context.Context) (*domain.Preferences, error) {
+	ctx, span := tracer.Start(ctx, "GetPreferences")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	prefs, err := s.preferences(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return prefs, nil
+}
+
+// UpdatePreferences saves the caller's frequency, time zone and schedule.
+// Turning digests on requires a mail provider and an email address.
+func (s *Service) UpdatePreferences(ctx context.Context, frequency domain.Frequency, timezone string, sendHour int, weekday time.Weekday) (*domain.Preferences, error) {
+	ctx, span := tracer.Start(ctx, "UpdatePreferences", trace.WithAttributes(
+		attribute.String("frequency", string(frequency)),
+		attribute.String("timezone", timezone),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	prefs, err := s.preferences(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	prefs.Frequency = frequency
+	prefs.Timezone = timezone
+	prefs.SendHour = sendHour
+	prefs.Weekday = weekday
+	if err := prefs.Validate(); err != nil {
+		return nil, err
+	}
+
+	if frequency != domain.FrequencyOff {
+		if s.mailer == nil {
+			return nil, domain.ErrMailDisabled
+		}
+		if _, err := s.recipient(ctx, userID); err != nil {
+			if !errors.Is(err, domain.ErrNoEmail) {
+				span.RecordError(err)
+			}
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Upsert(ctx, prefs); err != nil {
+		s.logger.ErrorContext(ctx, "failed to save digest preferences", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "digest preferences updated", "owner_id", userID, "frequency", frequency, "timezone", timezone)
+	return prefs, nil
+}
+
+// PreviewDigest returns the digest the caller would receive now, even if
+// it is empty or digests are off
+func (s *Service) PreviewDigest(ctx context.Context) (*domain.Summary, error) {
+	ctx, span := tracer.Start(ctx, "PreviewDigest")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	prefs, err := s.preferences(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if prefs.Frequency == domain.FrequencyOff {
+		prefs.Frequency = domain.FrequencyDaily
+	}
+
+	summary, err := s.summarize(ctx, prefs, prefs.ScheduledAt(time.Now()))
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return summary, nil
+}
+
+// RunDigests sends the digests that are due to every user who turned them
+// on. It is run by the scheduled digest job, outside of any request. A
+// failing user does not stop the run; all failures are returned together
+// with the partial report.
+func (s *Service) RunDigests(ctx context.Context) (*domain.RunReport, error) {
+	ctx, span := tracer.Start(ctx, "RunDigests")
+	defer span.End()
+
+	prefsList, err := s.repo.ListEnabled(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list digest preferences", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	report := &domain.RunReport{}
+	var errs []error
+	for _, prefs := range prefsList {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		now := time.Now()
+		scheduled, due := prefs.Due(now)
+		if !due {
+			continue
+		}
+		claimed, err := s.repo.Claim(ctx, prefs.OwnerID, scheduled, now)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to claim digest", "owner_id", prefs.OwnerID, "error", err)
+			span.RecordError(err)
+			errs = append(errs, err)
+			continue
+		}
+		if !claimed {
+			// Another instance is sending it
+			continue
+		}
+
+		sent, err := s.send(ctx, prefs, scheduled)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to send digest", "owner_id", prefs.OwnerID, "error", err)
+			span.RecordError(err)
+			errs = append(errs, err)
+			// Let the next run retry while the digest is still due
+			if err := s.repo.Unclaim(ctx, prefs.OwnerID, now, prefs.LastSentAt); err != nil {
+				s.logger.ErrorContext(ctx, "failed to release digest claim", "owner_id", prefs.OwnerID, "error", err)
+			}
+			continue
+		}
+		if sent {
+			report.Sent++
+		} else {
+			report.Skipped++
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("sent", report.Sent),
+		attribute.Int("skipped", report.Skipped),
+	)
+	if report.Sent > 0 || report.Skipped > 0 || len(errs) > 0 {
+		s.logger.InfoContext(ctx, "digest run finished",
+			"sent", report.Sent, "skipped", report.Skipped, "failures", len(errs))
+	}
+	return report, errors.Join(errs...)
+}
+
+// send emails the digest scheduled at scheduled. It reports false without
+// an error when there was nothing to send.
+func (s *Service) send(ctx context.Context, prefs *domain.Preferences, scheduled time.Time) (bool, error) {
+	if s.mailer == nil {
+		return false, domain.ErrMailDisabled
+	}
+
+	// Scope the user's queries like a request would, so row-level security
+	// applies when it is enabled
+	userCtx := auth.WithUserID(ctx, prefs.OwnerID)
+	to, err := s.recipient(userCtx, prefs.OwnerID)
+	if errors.Is(err, domain.ErrNoEmail) {
+		s.logger.WarnContext(ctx, "skipping digest, user has no email address", "owner_id", prefs.OwnerID)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	summary, err := s.summarize(userCtx, prefs, scheduled)
+	if err != nil {
+		return false, err
+	}
+	if summary.Empty() {
+		return false, nil
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	if err := s.mailer.Send(sendCtx, &mail.Message{
+		To:      to,
+		Subject: summary.Subject(),
+		Text:    summary.Text(),
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// summarize collects the digest of prefs' owner scheduled at scheduled; ctx
+// must carry the owner's identity
+func (s *Service) summarize(ctx context.Context, prefs *domain.Preferences, scheduled time.Time) (*domain.Summary, error) {
+	today, completedFrom, completedTo := prefs.Period(scheduled)
+	digest, err := s.tasks.GetDigest(ctx, taskdomain.DigestOptions{
+		Today:         today,
+		CompletedFrom: completedFrom,
+		CompletedTo:   completedTo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Summary{
+		Frequency: prefs.Frequency,
+		Date:      today,
+		Today:     entries(digest.Today),
+		Overdue:   entries(digest.Overdue),
+		Completed: entries(digest.Completed),
+	}, nil
+}
+
+// preferences returns a user's preferences, the defaults if they never set
+// any
+func (s *Service) preferences(ctx context.Context, userID string) (*domain.Preferences, error) {
+	prefs, err := s.repo.Get(ctx, userID)
+	if errors.Is(err, domain.ErrPreferencesNotFound) {
+		return domain.DefaultPreferences(userID), nil
+	}
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get digest preferences", "error", err)
+		return nil, err
+	}
+	return prefs, nil
+}
+
+// recipient returns a user's email address, or domain.ErrNoEmail
+func (s *Service) recipient(ctx context.Context, userID string) (string, error) {
+	user, err := s.users.GetUserByUserID(ctx, userID)
+	if errors.Is(err, authdomain.ErrUserNotFound) {
+		return "", domain.ErrNoEmail
+	}
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user", "owner_id", userID, "error", err)
+		return "", err
+	}
+	if user.Email == "" {
+		return "", domain.ErrNoEmail
+	}
+	return user.Email, nil
+}
+
+func entries(tasks []*taskdomain.Task) []domain.Entry {
+	result := make([]domain.Entry, len(tasks))
+	for i, task := range tasks {
+		result[i] = domain.Entry{Title: task.Title, Deadline: task.Deadline}
+	}
+	return result
+}
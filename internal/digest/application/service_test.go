@@ -0,0 +1,117 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/internal/digest/domain"
+	"github.com/slips-ai/slips-core/internal/memory"
+	taskapp "github.com/slips-ai/slips-core/internal/task/application"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+	"github.com/slips-ai/slips-core/pkg/mail"
+)
+
+type fakeMailer struct {
+	sent []*mail.Message
+	err  error
+}
+
+func (m *fakeMailer) Send(ctx context.Context, msg *mail.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func TestRunDigests(t *testing.T) {
+	store := memory.NewStore()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tasks := taskapp.NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), taskdomain.DefaultChecklistLimits, logger)
+	users := memory.NewUserRepository(store)
+	mailer := &fakeMailer{}
+	service := NewService(memory.NewDigestPreferencesRepository(store), tasks, users, mailer, logger)
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	if _, err := users.UpsertUser(ctx, authdomain.NewUser("owner", "owner", "", "owner@example.com")); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	yesterday := today.AddDate(0, 0, -1)
+	if _, err := tasks.CreateTask(ctx, "due today", "", nil, nil, &today, "", nil, ""); err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if _, err := tasks.CreateTask(ctx, "overdue", "", nil, nil, &yesterday, "", nil, ""); err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if _, err := service.UpdatePreferences(ctx, domain.FrequencyDaily, "UTC", now.Hour(), time.Monday); err != nil {
+		t.Fatalf("update preferences: %v", err)
+	}
+
+	// A failed send is released so the next run retries it
+	mailer.err = errors.New("connection refused")
+	if _, err := service.RunDigests(context.Background()); err == nil {
+		t.Fatal("run with failing mailer succeeded")
+	}
+	prefs, err := service.GetPreferences(ctx)
+	if err != nil || prefs.LastSentAt != nil {
+		t.Fatalf("after failed send LastSentAt = %v, %v; want nil", prefs.LastSentAt, err)
+	}
+
+	mailer.err = nil
+	report, err := service.RunDigests(context.Background())
+	if err != nil {
+		t.Fatalf("run digests: %v", err)
+	}
+	if report.Sent != 1 || len(mailer.sent) != 1 {
+		t.Fatalf("sent %d (%d messages); want 1", report.Sent, len(mailer.sent))
+	}
+	msg := mailer.sent[0]
+	if msg.To != "owner@example.com" {
+		t.Errorf("To = %q; want owner@example.com", msg.To)
+	}
+	for _, want := range []string{"Today (1)", "- due today", "Overdue (1)", "- overdue"} {
+		if !strings.Contains(msg.Text, want) {
+			t.Errorf("text is missing %q:\n%s", want, msg.Text)
+		}
+	}
+
+	// The digest goes out once per period
+	report, err = service.RunDigests(context.Background())
+	if err != nil {
+		t.Fatalf("run digests again: %v", err)
+	}
+	if report.Sent != 0 || len(mailer.sent) != 1 {
+		t.Fatalf("second run sent %d; want 0", report.Sent)
+	}
+}
+
+func TestUpdatePreferences_RequiresMail(t *testing.T) {
+	store := memory.NewStore()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tasks := taskapp.NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), taskdomain.DefaultChecklistLimits, logger)
+	users := memory.NewUserRepository(store)
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	disabled := NewService(memory.NewDigestPreferencesRepository(store), tasks, users, nil, logger)
+	if _, err := disabled.UpdatePreferences(ctx, domain.FrequencyDaily, "UTC", 7, time.Monday); !errors.Is(err, domain.ErrMailDisabled) {
+		t.Errorf("enable without mailer = %v; want ErrMailDisabled", err)
+	}
+	if _, err := disabled.UpdatePreferences(ctx, domain.FrequencyOff, "UTC", 7, time.Monday); err != nil {
+		t.Errorf("turn off without mailer = %v; want nil", err)
+	}
+
+	enabled := NewService(memory.NewDigestPreferencesRepository(store), tasks, users, &fakeMailer{}, logger)
+	if _, err := enabled.UpdatePreferences(ctx, domain.FrequencyWeekly, "UTC", 7, time.Monday); !errors.Is(err, domain.ErrNoEmail) {
+		t.Errorf("enable without email = %v; want ErrNoEmail", err)
+	}
+}
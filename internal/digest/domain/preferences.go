@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+// Frequency is how often a user receives digests
+type Frequency string
+
+const (
+	// FrequencyOff sends no digests; it is the default
+	FrequencyOff Frequency = "off"
+	// FrequencyDaily sends a digest every day at the send hour
+	FrequencyDaily Frequency = "daily"
+	// FrequencyWeekly sends a digest once a week, on the weekday at the
+	// send hour
+	FrequencyWeekly Frequency = "weekly"
+)
+
+const (
+	// DefaultTimezone, DefaultSendHour and DefaultWeekday apply to users
+	// who never set their preferences
+	DefaultTimezone = "UTC"
+	DefaultSendHour = 7
+	DefaultWeekday  = time.Monday
+
+	// MaxLateness is how long after its scheduled time a digest is still
+	// sent. Digests missed for longer, e.g. during an outage, are skipped
+	// rather than arriving in the middle of the night.
+	MaxLateness = 6 * time.Hour
+)
+
+var (
+	// ErrInvalidFrequency is returned for an unknown frequency
+	ErrInvalidFrequency = errors.New("invalid digest frequency")
+	// ErrInvalidTimezone is returned for a time zone that is not a known
+	// IANA zone name
+	ErrInvalidTimezone = errors.New("unknown time zone")
+	// ErrInvalidSchedule is returned for a send hour or weekday out of range
+	ErrInvalidSchedule = errors.New("send hour must be between 0 and 23 and weekday between 0 and 6")
+	// ErrMailDisabled is returned when turning digests on while the server
+	// has no mail provider configured
+	ErrMailDisabled = errors.New("email is not configured on this server")
+	// ErrNoEmail is returned when turning digests on for a user without an
+	// email address
+	ErrNoEmail = errors.New("no email address on file")
+	// ErrPreferencesNotFound is returned for users who never set their
+	// preferences
+	ErrPreferencesNotFound = domainerrors.New(domainerrors.ErrNotFound, "digest preferences not found")
+)
+
+// Preferences holds a user's email digest settings
+type Preferences struct {
+	OwnerID   string
+	Frequency Frequency
+	// Timezone is the IANA zone SendHour and Weekday are local to
+	Timezone string
+	SendHour int
+	// Weekday is the day weekly digests are sent on
+	Weekday time.Weekday
+	// LastSentAt is when the last digest was sent, nil if none was
+	LastSentAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// DefaultPreferences returns the preferences of a user who never set any
+func DefaultPreferences(ownerID string) *Preferences {
+	return &Preferences{
+		OwnerID:   ownerID,
+		Frequency: FrequencyOff,
+		Timezone:  DefaultTimezone,
+		SendHour:  DefaultSendHour,
+		Weekday:   DefaultWeekday,
+	}
+}
+
+// Validate checks the frequency, time zone and schedule
+func (p *Preferences) Validate() error {
+	switch p.Frequency {
+	case FrequencyOff, FrequencyDaily, FrequencyWeekly:
+	default:
+		return ErrInvalidFrequency
+	}
+	if _, err := time.LoadLocation(p.Timezone); err != nil || p.Timezone == "" || p.Timezone == "Local" {
+		return fmt.Errorf("%w: %q", ErrInvalidTimezone, p.Timezone)
+	}
+	if p.SendHour < 0 || p.SendHour > 23 || p.Weekday < time.Sunday || p.Weekday > time.Saturday {
+		return ErrInvalidSchedule
+	}
+	return nil
+}
+
+// Location returns the user's time zone, or UTC when it cannot be loaded
+func (p *Preferences) Location() *time.Location {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// ScheduledAt returns the latest scheduled send time at or before now. It
+// is meaningless when digests are off.
+func (p *Preferences) ScheduledAt(now time.Time) time.Time {
+	loc := p.Location()
+	local := now.In(loc)
+	scheduled := time.Date(local.Year(), local.Month(), local.Day(), p.SendHour, 0, 0, 0, loc)
+	step := 1
+	if p.Frequency == FrequencyWeekly {
+		step = 7
+		scheduled = scheduled.AddDate(0, 0, -((int(local.Weekday()) - int(p.Weekday) + 7) % 7))
+	}
+	if scheduled.After(now) {
+		scheduled = scheduled.AddDate(0, 0, -step)
+	}
+	return scheduled
+}
+
+// Due reports whether a digest should be sent at now, and for which
+// scheduled time: digests are on, the scheduled time passed less than
+// MaxLateness ago and no digest was sent since.
+func (p *Preferences) Due(now time.Time) (time.Time, bool) {
+	if p.Frequency != FrequencyDaily && p.Frequency != FrequencyWeekly {
+		return time.Time{}, false
+	}
+	scheduled := p.ScheduledAt(now)
+	if now.Sub(scheduled) > MaxLateness {
+		return time.Time{}, false
+	}
+	if p.LastSentAt != nil && !p.LastSentAt.Before(scheduled) {
+		return time.Time{}, false
+	}
+	return scheduled, true
+}
+
+// Period returns what a digest scheduled at scheduled covers: the local
+// date it is for, as a UTC midnight like task dates, and the half-open
+// range of completions it reports, the day (or week) before that date.
+func (p *Preferences) Period(scheduled time.Time) (today, completedFrom, completedTo time.Time) {
+	local := scheduled.In(p.Location())
+	year, month, day := local.Date()
+	today = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	completedTo = time.Date(year, month, day, 0, 0, 0, 0, local.Location())
+	if p.Frequency == FrequencyWeekly {
+		completedFrom = completedTo.AddDate(0, 0, -7)
+	} else {
+		completedFrom = completedTo.AddDate(0, 0, -1)
+	}
+	return today, completedFrom, completedTo
+}
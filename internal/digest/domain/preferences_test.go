@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPreferencesDue(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	at := func(s string) time.Time {
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", s, berlin)
+		if err != nil {
+			t.Fatalf("parse %q: %v", s, err)
+		}
+		return parsed
+	}
+	sent := func(s string) *time.Time {
+		t := at(s)
+		return &t
+	}
+
+	tests := []struct {
+		name          string
+		prefs         Preferences
+		now           time.Time
+		wantDue       bool
+		wantScheduled time.Time
+	}{
+		{
+			name:    "off",
+			prefs:   Preferences{Frequency: FrequencyOff, Timezone: "Europe/Berlin", SendHour: 7},
+			now:     at("2026-03-10 08:00"),
+			wantDue: false,
+		},
+		{
+			name:          "daily after the send hour",
+			prefs:         Preferences{Frequency: FrequencyDaily, Timezone: "Europe/Berlin", SendHour: 7},
+			now:           at("2026-03-10 07:05"),
+			wantDue:       true,
+			wantScheduled: at("2026-03-10 07:00"),
+		},
+		{
+			name:    "daily before the send hour, yesterday's already sent",
+			prefs:   Preferences{Frequency: FrequencyDaily, Timezone: "Europe/Berlin", SendHour: 7, LastSentAt: sent("2026-03-09 07:01")},
+			now:     at("2026-03-10 06:55"),
+			wantDue: false,
+		},
+		{
+			name:    "daily already sent today",
+			prefs:   Preferences{Frequency: FrequencyDaily, Timezone: "Europe/Berlin", SendHour: 7, LastSentAt: sent("2026-03-10 07:01")},
+			now:     at("2026-03-10 09:00"),
+			wantDue: false,
+		},
+		{
+			name:    "daily too late",
+			prefs:   Preferences{Frequency: FrequencyDaily, Timezone: "Europe/Berlin", SendHour: 7},
+			now:     at("2026-03-10 14:00"),
+			wantDue: false,
+		},
+		{
+			name:          "weekly on the weekday",
+			prefs:         Preferences{Frequency: FrequencyWeekly, Timezone: "Europe/Berlin", SendHour: 7, Weekday: time.Monday},
+			now:           at("2026-03-09 07:30"),
+			wantDue:       true,
+			wantScheduled: at("2026-03-09 07:00"),
+		},
+		{
+			name:    "weekly on another day",
+			prefs:   Preferences{Frequency: FrequencyWeekly, Timezone: "Europe/Berlin", SendHour: 7, Weekday: time.Monday},
+			now:     at("2026-03-10 07:30"),
+			wantDue: false,
+		},
+		{
+			name:          "send hour is local across a DST change",
+			prefs:         Preferences{Frequency: FrequencyDaily, Timezone: "Europe/Berlin", SendHour: 7},
+			now:           at("2026-03-29 07:10"),
+			wantDue:       true,
+			wantScheduled: at("2026-03-29 07:00"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduled, due := tt.prefs.Due(tt.now)
+			if due != tt.wantDue {
+				t.Fatalf("Due() = %t, want %t", due, tt.wantDue)
+			}
+			if due && !scheduled.Equal(tt.wantScheduled) {
+				t.Errorf("scheduled = %s, want %s", scheduled, tt.wantScheduled)
+			}
+		})
+	}
+}
+
+func TestPreferencesPeriod(t *testing.T) {
+	prefs := Preferences{Frequency: FrequencyWeekly, Timezone: "America/New_York", SendHour: 7, Weekday: time.Monday}
+	loc := prefs.Location()
+	scheduled := time.Date(2026, 3, 9, 7, 0, 0, 0, loc)
+
+	today, from, to := prefs.Period(scheduled)
+	if want := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC); !today.Equal(want) {
+		t.Errorf("today = %s, want %s", today, want)
+	}
+	if want := time.Date(2026, 3, 2, 0, 0, 0, 0, loc); !from.Equal(want) {
+		t.Errorf("completed from = %s, want %s", from, want)
+	}
+	if want := time.Date(2026, 3, 9, 0, 0, 0, 0, loc); !to.Equal(want) {
+		t.Errorf("completed to = %s, want %s", to, want)
+	}
+}
+
+func TestPreferencesValidate(t *testing.T) {
+	valid := *DefaultPreferences("user")
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("default preferences: %v", err)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		modify func(p *Preferences)
+		want   error
+	}{
+		{"frequency", func(p *Preferences) { p.Frequency = "hourly" }, ErrInvalidFrequency},
+		{"time zone", func(p *Preferences) { p.Timezone = "Mars/Olympus" }, ErrInvalidTimezone},
+		{"empty time zone", func(p *Preferences) { p.Timezone = "" }, ErrInvalidTimezone},
+		{"hour", func(p *Preferences) { p.SendHour = 24 }, ErrInvalidSchedule},
+		{"weekday", func(p *Preferences) { p.Weekday = 7 }, ErrInvalidSchedule},
+	} {
+		prefs := valid
+		tt.modify(&prefs)
+		if err := prefs.Validate(); !errors.Is(err, tt.want) {
+			t.Errorf("%s: Validate() = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for digest preference persistence
+type Repository interface {
+	// Get returns a user's preferences, or ErrPreferencesNotFound when they
+	// never set any
+	Get(ctx context.Context, ownerID string) (*Preferences, error)
+	// Upsert saves the frequency, time zone and schedule of prefs, keeping
+	// LastSentAt, and fills in its timestamps
+	Upsert(ctx context.Context, prefs *Preferences) error
+	// ListEnabled lists the preferences of every user with digests on
+	ListEnabled(ctx context.Context) ([]*Preferences, error)
+	// Claim sets LastSentAt to sentAt unless a digest was already sent at
+	// or after scheduledAt, and reports whether it did. Only one instance
+	// wins the claim, so each digest is sent once.
+	Claim(ctx context.Context, ownerID string, scheduledAt, sentAt time.Time) (bool, error)
+	// Unclaim restores LastSentAt to previous after a failed send, unless
+	// it changed since the claim at sentAt
+	Unclaim(ctx context.Context, ownerID string, sentAt time.Time, previous *time.Time) error
+}
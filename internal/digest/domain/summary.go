@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Entry is a task listed in a digest
+type Entry struct {
+	Title    string
+	Deadline *time.Time
+}
+
+// Summary is the content of one digest
+type Summary struct {
+	Frequency Frequency
+	// Date is the local date the digest is for, as a UTC midnight
+	Date      time.Time
+	Today     []Entry
+	Overdue   []Entry
+	Completed []Entry
+}
+
+// Empty reports whether the digest has nothing to report; empty digests
+// are not sent
+func (s *Summary) Empty() bool {
+	return len(s.Today) == 0 && len(s.Overdue) == 0 && len(s.Completed) == 0
+}
+
+// Subject returns the email subject
+func (s *Summary) Subject() string {
+	if s.Frequency == FrequencyWeekly {
+		return "Your weekly Slips digest for the week of " + s.Date.Format("January 2")
+	}
+	return "Your Slips digest for " + s.Date.Format("Monday, January 2")
+}
+
+// Text renders the digest as a plain text email body
+func (s *Summary) Text() string {
+	var b strings.Builder
+	if s.Frequency == FrequencyWeekly {
+		fmt.Fprintf(&b, "Here is your weekly Slips digest for the week of %s.\n", s.Date.Format("Monday, January 2"))
+	} else {
+		fmt.Fprintf(&b, "Here is your Slips digest for %s.\n", s.Date.Format("Monday, January 2"))
+	}
+
+	completed := "Completed yesterday"
+	if s.Frequency == FrequencyWeekly {
+		completed = "Completed in the last 7 days"
+	}
+	s.section(&b, "Today", s.Today, true)
+	s.section(&b, "Overdue", s.Overdue, true)
+	s.section(&b, completed, s.Completed, false)
+
+	frequency := "daily"
+	if s.Frequency == FrequencyWeekly {
+		frequency = "weekly"
+	}
+	fmt.Fprintf(&b, "\n-- \nYou get this email because you turned on %s digests in Slips.\nYou can change or turn them off in your settings.\n", frequency)
+	return b.String()
+}
+
+func (s *Summary) section(b *strings.Builder, title string, entries []Entry, deadlines bool) {
+	if len(entries) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n%s (%d)\n", title, len(entries))
+	for _, entry := range entries {
+		b.WriteString("- " + entry.Title)
+		if deadlines && entry.Deadline != nil {
+			b.WriteString(" (due " + s.formatDate(*entry.Deadline) + ")")
+		}
+		b.WriteString("\n")
+	}
+}
+
+// formatDate formats a task date relative to the digest date
+func (s *Summary) formatDate(date time.Time) string {
+	switch {
+	case date.Equal(s.Date):
+		return "today"
+	case date.Year() == s.Date.Year():
+		return date.Format("Jan 2")
+	default:
+		return date.Format("Jan 2, 2006")
+	}
+}
+
+// RunReport summarizes one run of the digest job
+type RunReport struct {
+	// Sent is the number of digests sent.
+	Sent int
+	// Skipped is the number of digests due but not sent because they were
+	// empty or the user has no email address.
+	Skipped int
+}
@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	digestv1 "github.com/slips-ai/slips-core/gen/go/digest/v1"
+	"github.com/slips-ai/slips-core/internal/digest/application"
+	"github.com/slips-ai/slips-core/internal/digest/domain"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DigestServer implements the DigestService gRPC server
+type DigestServer struct {
+	digestv1.UnimplementedDigestServiceServer
+	service *application.Service
+}
+
+// NewDigestServer creates a new digest gRPC server
+func NewDigestServer(service *application.Service) *DigestServer {
+	return &DigestServer{
+		service: service,
+	}
+}
+
+// GetDigestSettings returns the caller's digest settings
+func (s *DigestServer) GetDigestSettings(ctx context.Context, req *digestv1.GetDigestSettingsRequest) (*digestv1.GetDigestSettingsResponse, error) {
+	prefs, err := s.service.GetPreferences(ctx)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to get digest settings")
+	}
+
+	return &digestv1.GetDigestSettingsResponse{
+		Settings: settingsToProto(prefs),
+	}, nil
+}
+
+// UpdateDigestSettings replaces the caller's digest settings
+func (s *DigestServer) UpdateDigestSettings(ctx context.Context, req *digestv1.UpdateDigestSettingsRequest) (*digestv1.UpdateDigestSettingsResponse, error) {
+	var frequency domain.Frequency
+	switch req.Frequency {
+	case digestv1.DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED, digestv1.DigestFrequency_DIGEST_FREQUENCY_OFF:
+		frequency = domain.FrequencyOff
+	case digestv1.DigestFrequency_DIGEST_FREQUENCY_DAILY:
+		frequency = domain.FrequencyDaily
+	case digestv1.DigestFrequency_DIGEST_FREQUENCY_WEEKLY:
+		frequency = domain.FrequencyWeekly
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid frequency")
+	}
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = domain.DefaultTimezone
+	}
+
+	prefs, err := s.service.UpdatePreferences(ctx, frequency, timezone, int(req.SendHour), time.Weekday(req.Weekday))
+	if err != nil {
+		return nil, toGRPCError(err, "failed to update digest settings")
+	}
+
+	return &digestv1.UpdateDigestSettingsResponse{
+		Settings: settingsToProto(prefs),
+	}, nil
+}
+
+// PreviewDigest renders the digest the caller would receive now
+func (s *DigestServer) PreviewDigest(ctx context.Context, req *digestv1.PreviewDigestRequest) (*digestv1.PreviewDigestResponse, error) {
+	summary, err := s.service.PreviewDigest(ctx)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to preview digest")
+	}
+
+	return &digestv1.PreviewDigestResponse{
+		Subject: summary.Subject(),
+		Text:    summary.Text(),
+		Empty:   summary.Empty(),
+	}, nil
+}
+
+// toGRPCError maps validation and precondition failures to status codes
+// and defers everything else to grpcerrors.ToGRPCError
+func toGRPCError(err error, defaultMsg string) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidFrequency),
+		errors.Is(err, domain.ErrInvalidTimezone),
+		errors.Is(err, domain.ErrInvalidSchedule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrMailDisabled), errors.Is(err, domain.ErrNoEmail):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return grpcerrors.ToGRPCError(err, defaultMsg)
+}
+
+func settingsToProto(prefs *domain.Preferences) *digestv1.DigestSettings {
+	settings := &digestv1.DigestSettings{
+		Frequency: digestv1.DigestFrequency_DIGEST_FREQUENCY_OFF,
+		Timezone:  prefs.Timezone,
+		SendHour:  int32(prefs.SendHour),
+		Weekday:   int32(prefs.Weekday),
+	}
+	switch prefs.Frequency {
+	case domain.FrequencyDaily:
+		settings.Frequency = digestv1.DigestFrequency_DIGEST_FREQUENCY_DAILY
+	case domain.FrequencyWeekly:
+		settings.Frequency = digestv1.DigestFrequency_DIGEST_FREQUENCY_WEEKLY
+	}
+	if prefs.LastSentAt != nil {
+		settings.LastSentAt = timestamppb.New(*prefs.LastSentAt)
+	}
+	return settings
+}
@@ -0,0 +1,32 @@
+-- name: GetDigestPreferences :one
+SELECT owner_id, frequency, timezone, send_hour, weekday, last_sent_at, created_at, updated_at
+FROM digest_preferences
+WHERE owner_id = $1;
+
+-- name: UpsertDigestPreferences :one
+INSERT INTO digest_preferences (owner_id, frequency, timezone, send_hour, weekday)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (owner_id) DO UPDATE
+SET frequency = EXCLUDED.frequency,
+    timezone = EXCLUDED.timezone,
+    send_hour = EXCLUDED.send_hour,
+    weekday = EXCLUDED.weekday,
+    updated_at = NOW()
+RETURNING owner_id, frequency, timezone, send_hour, weekday, last_sent_at, created_at, updated_at;
+
+-- name: ListEnabledDigestPreferences :many
+SELECT owner_id, frequency, timezone, send_hour, weekday, last_sent_at, created_at, updated_at
+FROM digest_preferences
+WHERE frequency <> 'off'
+ORDER BY owner_id ASC;
+
+-- name: ClaimDigest :execrows
+UPDATE digest_preferences
+SET last_sent_at = sqlc.arg(sent_at)
+WHERE owner_id = sqlc.arg(owner_id)
+  AND (last_sent_at IS NULL OR last_sent_at < sqlc.arg(scheduled_at));
+
+-- name: UnclaimDigest :exec
+UPDATE digest_preferences
+SET last_sent_at = sqlc.narg(previous)
+WHERE owner_id = sqlc.arg(owner_id) AND last_sent_at = sqlc.arg(sent_at);
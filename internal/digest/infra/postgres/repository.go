@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/slips-ai/slips-core/internal/digest/domain"
+)
+
+// PreferencesRepository implements domain.Repository using PostgreSQL
+type PreferencesRepository struct {
+	queries *Queries
+}
+
+// NewPreferencesRepository creates a new digest preferences repository
+func NewPreferencesRepository(pool *pgxpool.Pool) *PreferencesRepository {
+	return &PreferencesRepository{
+		queries: New(pool),
+	}
+}
+
+// Get retrieves a user's digest preferences
+func (r *PreferencesRepository) Get(ctx context.Context, ownerID string) (*domain.Preferences, error) {
+	result, err := r.queries.GetDigestPreferences(ctx, ownerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPreferencesNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomain(result), nil
+}
+
+// Upsert creates or updates a user's digest preferences
+func (r *PreferencesRepository) Upsert(ctx context.Context, prefs *domain.Preferences) error {
+	result, err := r.queries.UpsertDigestPreferences(ctx, UpsertDigestPreferencesParams{
+		OwnerID:   prefs.OwnerID,
+		Frequency: string(prefs.Frequency),
+		Timezone:  prefs.Timezone,
+		SendHour:  int16(prefs.SendHour),
+		Weekday:   int16(prefs.Weekday),
+	})
+	if err != nil {
+		return err
+	}
+
+	*prefs = *toDomain(result)
+	return nil
+}
+
+// ListEnabled lists the preferences of users with digests on
+func (r *PreferencesRepository) ListEnabled(ctx context.Context) ([]*domain.Preferences, error) {
+	results, err := r.queries.ListEnabledDigestPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prefs := make([]*domain.Preferences, len(results))
+	for i, result := range results {
+		prefs[i] = toDomain(result)
+	}
+	return prefs, nil
+}
+
+// Claim records a digest as sent unless one was sent since scheduledAt
+func (r *PreferencesRepository) Claim(ctx context.Context, ownerID string, scheduledAt, sentAt time.Time) (bool, error) {
+	rows, err := r.queries.ClaimDigest(ctx, ClaimDigestParams{
+		SentAt:      pgtype.Timestamptz{Time: sentAt, Valid: true},
+		OwnerID:     ownerID,
+		ScheduledAt: pgtype.Timestamptz{Time: scheduledAt, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// Unclaim restores the previous send time after a failed send
+func (r *PreferencesRepository) Unclaim(ctx context.Context, ownerID string, sentAt time.Time, previous *time.Time) error {
+	var pgPrevious pgtype.Timestamptz
+	if previous != nil {
+		pgPrevious = pgtype.Timestamptz{Time: *previous, Valid: true}
+	}
+	return r.queries.UnclaimDigest(ctx, UnclaimDigestParams{
+		Previous: pgPrevious,
+		OwnerID:  ownerID,
+		SentAt:   pgtype.Timestamptz{Time: sentAt, Valid: true},
+	})
+}
+
+func toDomain(row DigestPreference) *domain.Preferences {
+	prefs := &domain.Preferences{
+		OwnerID:   row.OwnerID,
+		Frequency: domain.Frequency(row.Frequency),
+		Timezone:  row.Timezone,
+		SendHour:  int(row.SendHour),
+		Weekday:   time.Weekday(row.Weekday),
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+	if row.LastSentAt.Valid {
+		prefs.LastSentAt = &row.LastSentAt.Time
+	}
+	return prefs
+}
@@ -0,0 +1,297 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/feed/domain"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	taskapp "github.com/slips-ai/slips-core/internal/task/application"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("feed-service")
+
+const (
+	// lastFetchedInterval is how stale LastFetchedAt may get before a fetch
+	// refreshes it, so readers polling every few seconds do not write each
+	// time
+	lastFetchedInterval = time.Minute
+	// tagPageSize is the number of tags loaded at a time when resolving tag
+	// names
+	tagPageSize = 100
+)
+
+// TaskLister lists the recently updated tasks of a feed; the task service
+// implements it
+type TaskLister interface {
+	ListRecentlyUpdated(ctx context.Context, scope taskapp.RecentScope, since time.Time, limit int) ([]*taskdomain.Task, error)
+}
+
+// TagReader resolves the tags of a feed and its tasks; the tag service
+// implements it
+type TagReader interface {
+	GetTag(ctx context.Context, id uuid.UUID) (*tagdomain.Tag, error)
+	ListTags(ctx context.Context, limit, offset int) ([]*tagdomain.Tag, error)
+}
+
+// FilterGetter resolves the saved filter of a feed; the saved filter service
+// implements it
+type FilterGetter interface {
+	GetSavedFilter(ctx context.Context, id uuid.UUID) (*savedfilterdomain.SavedFilter, error)
+}
+
+// Service provides feed business logic
+type Service struct {
+	repo     domain.Repository
+	tasks    TaskLister
+	tags     TagReader
+	filters  FilterGetter
+	maxItems int
+	window   time.Duration
+	logger   *slog.Logger
+}
+
+// NewService creates a new feed service. Feeds list up to maxItems tasks
+// changed within window.
+func NewService(repo domain.Repository, tasks TaskLister, tags TagReader, filters FilterGetter, maxItems int, window time.Duration, logger *slog.Logger) *Service {
+	return &Service{
+		repo:     repo,
+		tasks:    tasks,
+		tags:     tags,
+		filters:  filters,
+		maxItems: maxItems,
+		window:   window,
+		logger:   logger,
+	}
+}
+
+// CreateFeed creates a feed of the tasks carrying tagID or matching the
+// saved filter savedFilterID, exactly one of which must be set. It returns
+// the feed with its token, which cannot be retrieved later.
+func (s *Service) CreateFeed(ctx context.Context, name string, tagID, savedFilterID *uuid.UUID) (*domain.Feed, string, error) {
+	ctx, span := tracer.Start(ctx, "CreateFeed", trace.WithAttributes(
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+
+	// Check the source exists, so a typo does not produce a feed that never
+	// serves
+	if _, err := s.sourceTitle(ctx, tagID, savedFilterID); err != nil {
+		if !errors.Is(err, domain.ErrSourceNotFound) {
+			span.RecordError(err)
+		}
+		return nil, "", err
+	}
+
+	existing, err := s.repo.List(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list feeds", "error", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+	if len(existing) >= domain.MaxFeedsPerUser {
+		return nil, "", domain.ErrTooManyFeeds
+	}
+
+	feed, token, err := domain.NewFeed(name, userID, tagID, savedFilterID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to generate feed token", "error", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+	if err := s.repo.Create(ctx, feed); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create feed", "error", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+
+	s.logger.InfoContext(ctx, "feed created", "id", feed.ID, "owner_id", userID)
+	return feed, token, nil
+}
+
+// ListFeeds lists the caller's feeds
+func (s *Service) ListFeeds(ctx context.Context) ([]*domain.Feed, error) {
+	ctx, span := tracer.Start(ctx, "ListFeeds")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	feeds, err := s.repo.List(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list feeds", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return feeds, nil
+}
+
+// DeleteFeed deletes a feed; its URL stops working immediately
+func (s *Service) DeleteFeed(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "DeleteFeed", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, userID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete feed", "id", id, "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "feed deleted", "id", id)
+	return nil
+}
+
+// Fetch renders the feed a token belongs to. It returns
+// domain.ErrFeedNotFound for unknown tokens and domain.ErrSourceNotFound
+// once the feed's tag or saved filter is deleted.
+func (s *Service) Fetch(ctx context.Context, token string) (*domain.Document, error) {
+	ctx, span := tracer.Start(ctx, "Fetch")
+	defer span.End()
+
+	feed, err := s.repo.GetByTokenHash(ctx, domain.HashToken(token))
+	if err != nil {
+		if !errors.Is(err, domain.ErrFeedNotFound) {
+			s.logger.ErrorContext(ctx, "failed to look up feed", "error", err)
+			span.RecordError(err)
+		}
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("id", feed.ID.String()))
+
+	now := time.Now()
+	if feed.LastFetchedAt == nil || now.Sub(*feed.LastFetchedAt) > lastFetchedInterval {
+		if err := s.repo.MarkFetched(ctx, feed.ID, now); err != nil {
+			// Serving the feed matters more than the timestamp
+			s.logger.WarnContext(ctx, "failed to record feed fetch", "id", feed.ID, "error", err)
+		}
+	}
+
+	// Read the tasks as the owner, attributed to the feed
+	ctx = auth.WithPrincipal(ctx, &auth.Principal{
+		UserID:     feed.OwnerID,
+		Credential: auth.CredentialFeedToken,
+		ClientID:   "feed:" + feed.ID.String(),
+	})
+
+	title, err := s.sourceTitle(ctx, feed.TagID, feed.SavedFilterID)
+	if err != nil {
+		if !errors.Is(err, domain.ErrSourceNotFound) {
+			span.RecordError(err)
+		}
+		return nil, err
+	}
+	tasks, err := s.tasks.ListRecentlyUpdated(ctx, taskapp.RecentScope{TagID: feed.TagID, FilterID: feed.SavedFilterID}, now.Add(-s.window), s.maxItems)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list feed tasks", "id", feed.ID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	tagNames, err := s.tagNames(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	doc := &domain.Document{
+		Title: title,
+		Items: make([]domain.Item, len(tasks)),
+	}
+	for i, task := range tasks {
+		item := domain.Item{
+			TaskID:    task.ID,
+			Title:     task.Title,
+			Notes:     task.Notes,
+			Completed: task.CompletedAt != nil,
+			Archived:  task.ArchivedAt != nil,
+			CreatedAt: task.CreatedAt,
+			UpdatedAt: task.UpdatedAt,
+		}
+		for _, tagID := range task.TagIDs {
+			if name, ok := tagNames[tagID]; ok {
+				item.TagNames = append(item.TagNames, name)
+			}
+		}
+		doc.Items[i] = item
+	}
+	return doc, nil
+}
+
+// sourceTitle returns the name of the tag or saved filter a feed follows,
+// or domain.ErrSourceNotFound when it does not exist
+func (s *Service) sourceTitle(ctx context.Context, tagID, savedFilterID *uuid.UUID) (string, error) {
+	switch {
+	case tagID != nil:
+		tag, err := s.tags.GetTag(ctx, *tagID)
+		if errors.Is(err, tagdomain.ErrTagNotFound) {
+			return "", domain.ErrSourceNotFound
+		}
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to get feed tag", "tag_id", *tagID, "error", err)
+			return "", err
+		}
+		return tag.Name, nil
+	case savedFilterID != nil:
+		filter, err := s.filters.GetSavedFilter(ctx, *savedFilterID)
+		if errors.Is(err, savedfilterdomain.ErrSavedFilterNotFound) {
+			return "", domain.ErrSourceNotFound
+		}
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to get feed saved filter", "saved_filter_id", *savedFilterID, "error", err)
+			return "", err
+		}
+		return filter.Name, nil
+	default:
+		return "", domain.ErrSourceNotFound
+	}
+}
+
+// tagNames maps the caller's tag IDs to tag names
+func (s *Service) tagNames(ctx context.Context) (map[uuid.UUID]string, error) {
+	names := make(map[uuid.UUID]string)
+	for offset := 0; ; offset += tagPageSize {
+		tags, err := s.tags.ListTags(ctx, tagPageSize, offset)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to list tags", "error", err)
+			return nil, err
+		}
+		for _, tag := range tags {
+			names[tag.ID] = tag.Name
+		}
+		if len(tags) < tagPageSize {
+			return names, nil
+		}
+	}
+}
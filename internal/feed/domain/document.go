@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// RSSContentType and JSONContentType are the media types feeds are
+	// served with
+	RSSContentType  = "application/rss+xml; charset=utf-8"
+	JSONContentType = "application/feed+json; charset=utf-8"
+
+	jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+	generator       = "Slips"
+)
+
+// Document is a rendered feed: the latest change to each task in its
+// source, most recent first
+type Document struct {
+	Title string
+	Items []Item
+}
+
+// Item is the state of a task after its latest change
+type Item struct {
+	TaskID    uuid.UUID
+	Title     string
+	Notes     string
+	TagNames  []string
+	Completed bool
+	Archived  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ID identifies the change, so readers show a task again each time it
+// changes
+func (i Item) ID() string {
+	return fmt.Sprintf("%s:%d", i.TaskID, i.UpdatedAt.UnixMicro())
+}
+
+// Updated returns the time of the latest change in the document, or the
+// zero time when it is empty
+func (d *Document) Updated() time.Time {
+	if len(d.Items) == 0 {
+		return time.Time{}
+	}
+	return d.Items[0].UpdatedAt
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Generator     string    `xml:"generator"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Description string   `xml:"description,omitempty"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSS renders the document as RSS 2.0. link is the URL of the feed itself,
+// since tasks have no web page to link to.
+func (d *Document) RSS(link string) ([]byte, error) {
+	channel := rssChannel{
+		Title:       d.Title,
+		Link:        link,
+		Description: "Recent changes to " + d.Title,
+		Generator:   generator,
+		Items:       make([]rssItem, len(d.Items)),
+	}
+	if updated := d.Updated(); !updated.IsZero() {
+		channel.LastBuildDate = updated.UTC().Format(time.RFC1123Z)
+	}
+	for i, item := range d.Items {
+		channel.Items[i] = rssItem{
+			Title:       item.Title,
+			Description: item.Notes,
+			GUID:        rssGUID{Value: item.ID()},
+			PubDate:     item.UpdatedAt.UTC().Format(time.RFC1123Z),
+			Categories:  item.TagNames,
+		}
+	}
+
+	body, err := xml.MarshalIndent(rss{Version: "2.0", Channel: channel}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+type jsonFeed struct {
+	Version string         `json:"version"`
+	Title   string         `json:"title"`
+	FeedURL string         `json:"feed_url,omitempty"`
+	Items   []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string       `json:"id"`
+	Title         string       `json:"title"`
+	ContentText   string       `json:"content_text"`
+	DatePublished time.Time    `json:"date_published"`
+	DateModified  time.Time    `json:"date_modified"`
+	Tags          []string     `json:"tags,omitempty"`
+	Slips         jsonFeedTask `json:"_slips"`
+}
+
+// jsonFeedTask is the JSON Feed extension carrying task state for
+// dashboards
+type jsonFeedTask struct {
+	TaskID    string `json:"task_id"`
+	Completed bool   `json:"completed"`
+	Archived  bool   `json:"archived"`
+}
+
+// JSON renders the document as JSON Feed 1.1. feedURL is the URL of the
+// feed itself, omitted when empty.
+func (d *Document) JSON(feedURL string) ([]byte, error) {
+	feed := jsonFeed{
+		Version: jsonFeedVersion,
+		Title:   d.Title,
+		FeedURL: feedURL,
+		Items:   make([]jsonFeedItem, len(d.Items)),
+	}
+	for i, item := range d.Items {
+		feed.Items[i] = jsonFeedItem{
+			ID:            item.ID(),
+			Title:         item.Title,
+			ContentText:   item.Notes,
+			DatePublished: item.CreatedAt.UTC(),
+			DateModified:  item.UpdatedAt.UTC(),
+			Tags:          item.TagNames,
+			Slips: jsonFeedTask{
+				TaskID:    item.TaskID.String(),
+				Completed: item.Completed,
+				Archived:  item.Archived,
+			},
+		}
+	}
+	return json.MarshalIndent(feed, "", "  ")
+}
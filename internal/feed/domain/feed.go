@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+// MaxFeedsPerUser bounds how many feeds a user can create
+const MaxFeedsPerUser = 25
+
+// tokenPrefix marks feed tokens so they are recognisable when leaked
+const tokenPrefix = "feed_"
+
+var (
+	// ErrTooManyFeeds is returned when a user already has MaxFeedsPerUser
+	// feeds
+	ErrTooManyFeeds = domainerrors.New(domainerrors.ErrQuotaExceeded, fmt.Sprintf("at most %d feeds are allowed", MaxFeedsPerUser))
+	// ErrSourceNotFound is returned when a feed's tag or saved filter does
+	// not exist, or no longer does
+	ErrSourceNotFound = errors.New("feed source not found")
+	// ErrFeedNotFound is returned for tokens that belong to no feed
+	ErrFeedNotFound = domainerrors.New(domainerrors.ErrNotFound, "feed not found")
+)
+
+// Format is the document format a feed is served in
+type Format string
+
+const (
+	// FormatRSS is RSS 2.0
+	FormatRSS Format = "rss"
+	// FormatJSON is JSON Feed 1.1
+	FormatJSON Format = "json"
+)
+
+// Feed publishes recent changes to the tasks carrying a tag or matching a
+// saved filter. Exactly one of TagID and SavedFilterID is set. Readers
+// authenticate with the token in the feed URL; only its hash is stored, so
+// the URL is shown once, when the feed is created.
+type Feed struct {
+	ID            uuid.UUID
+	OwnerID       string
+	Name          string
+	TagID         *uuid.UUID
+	SavedFilterID *uuid.UUID
+	TokenHash     string
+	CreatedAt     time.Time
+	LastFetchedAt *time.Time
+}
+
+// NewFeed creates a feed and returns it with its token
+// Note: CreatedAt is not set here. It will be populated by the database on
+// insertion (DEFAULT NOW()).
+func NewFeed(name, ownerID string, tagID, savedFilterID *uuid.UUID) (*Feed, string, error) {
+	token, err := generateToken()
+	if err != nil {
+		return nil, "", err
+	}
+	return &Feed{
+		ID:            uuid.New(),
+		OwnerID:       ownerID,
+		Name:          name,
+		TagID:         tagID,
+		SavedFilterID: savedFilterID,
+		TokenHash:     HashToken(token),
+	}, token, nil
+}
+
+// HashToken returns the hex SHA-256 of a feed token. Tokens are random and
+// long, so a fast hash is enough to protect them at rest.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateToken returns a random URL-safe token with 256 bits of entropy
+func generateToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return tokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
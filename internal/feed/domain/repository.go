@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for feed persistence
+type Repository interface {
+	Create(ctx context.Context, feed *Feed) error
+	// GetByTokenHash retrieves the feed with the given TokenHash, for
+	// readers that authenticate with its token rather than as its owner. It
+	// returns ErrFeedNotFound if there is none.
+	GetByTokenHash(ctx context.Context, hash string) (*Feed, error)
+	// List returns the owner's feeds ordered by name
+	List(ctx context.Context, ownerID string) ([]*Feed, error)
+	Delete(ctx context.Context, id uuid.UUID, ownerID string) error
+	MarkFetched(ctx context.Context, id uuid.UUID, at time.Time) error
+}
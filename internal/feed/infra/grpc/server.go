@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	feedv1 "github.com/slips-ai/slips-core/gen/go/feed/v1"
+	"github.com/slips-ai/slips-core/internal/feed/application"
+	"github.com/slips-ai/slips-core/internal/feed/domain"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FeedServer implements the FeedService gRPC server
+type FeedServer struct {
+	feedv1.UnimplementedFeedServiceServer
+	service *application.Service
+	baseURL string
+}
+
+// NewFeedServer creates a new feed gRPC server. baseURL is the public URL
+// feeds are served on; feed URLs are empty without it.
+func NewFeedServer(service *application.Service, baseURL string) *FeedServer {
+	return &FeedServer{
+		service: service,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// CreateFeed creates a new feed
+func (s *FeedServer) CreateFeed(ctx context.Context, req *feedv1.CreateFeedRequest) (*feedv1.CreateFeedResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.Name, "name"); err != nil {
+		return nil, err
+	}
+	if err := grpcerrors.ValidateLength(req.Name, "name", grpcerrors.MaxFeedNameLength); err != nil {
+		return nil, err
+	}
+
+	var tagID, savedFilterID *uuid.UUID
+	switch source := req.Source.(type) {
+	case *feedv1.CreateFeedRequest_TagId:
+		id, err := uuid.Parse(source.TagId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid tag ID format")
+		}
+		tagID = &id
+	case *feedv1.CreateFeedRequest_SavedFilterId:
+		id, err := uuid.Parse(source.SavedFilterId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid saved filter ID format")
+		}
+		savedFilterID = &id
+	default:
+		return nil, status.Error(codes.InvalidArgument, "tag_id or saved_filter_id is required")
+	}
+
+	feed, token, err := s.service.CreateFeed(ctx, req.Name, tagID, savedFilterID)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to create feed")
+	}
+
+	resp := &feedv1.CreateFeedResponse{
+		Feed:  feedToProto(feed),
+		Token: token,
+	}
+	if s.baseURL != "" {
+		resp.RssUrl = s.baseURL + "/feeds/" + token + ".rss"
+		resp.JsonUrl = s.baseURL + "/feeds/" + token + ".json"
+	}
+	return resp, nil
+}
+
+// ListFeeds lists the caller's feeds
+func (s *FeedServer) ListFeeds(ctx context.Context, req *feedv1.ListFeedsRequest) (*feedv1.ListFeedsResponse, error) {
+	feeds, err := s.service.ListFeeds(ctx)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to list feeds")
+	}
+
+	protoFeeds := make([]*feedv1.Feed, len(feeds))
+	for i, feed := range feeds {
+		protoFeeds[i] = feedToProto(feed)
+	}
+
+	return &feedv1.ListFeedsResponse{
+		Feeds: protoFeeds,
+	}, nil
+}
+
+// DeleteFeed deletes a feed
+func (s *FeedServer) DeleteFeed(ctx context.Context, req *feedv1.DeleteFeedRequest) (*feedv1.DeleteFeedResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid feed ID format")
+	}
+
+	if err := s.service.DeleteFeed(ctx, id); err != nil {
+		return nil, toGRPCError(err, "failed to delete feed")
+	}
+
+	return &feedv1.DeleteFeedResponse{}, nil
+}
+
+// toGRPCError maps source failures to NotFound and defers everything else
+// to grpcerrors.ToGRPCError
+func toGRPCError(err error, defaultMsg string) error {
+	if errors.Is(err, domain.ErrSourceNotFound) {
+		return status.Error(codes.NotFound, "tag or saved filter not found")
+	}
+	return grpcerrors.ToGRPCError(err, defaultMsg)
+}
+
+func feedToProto(feed *domain.Feed) *feedv1.Feed {
+	protoFeed := &feedv1.Feed{
+		Id:        feed.ID.String(),
+		Name:      feed.Name,
+		CreatedAt: timestamppb.New(feed.CreatedAt),
+	}
+	switch {
+	case feed.TagID != nil:
+		protoFeed.Source = &feedv1.Feed_TagId{TagId: feed.TagID.String()}
+	case feed.SavedFilterID != nil:
+		protoFeed.Source = &feedv1.Feed_SavedFilterId{SavedFilterId: feed.SavedFilterID.String()}
+	}
+	if feed.LastFetchedAt != nil {
+		protoFeed.LastFetchedAt = timestamppb.New(*feed.LastFetchedAt)
+	}
+	return protoFeed
+}
@@ -0,0 +1,118 @@
+// Package http serves feeds over plain HTTP, for feed readers and
+// dashboards that cannot call gRPC.
+package http
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/slips-ai/slips-core/internal/feed/application"
+	"github.com/slips-ai/slips-core/internal/feed/domain"
+)
+
+// Handler serves GET /feeds/{token}.rss and GET /feeds/{token}.json
+type Handler struct {
+	service *application.Service
+	baseURL string
+	logger  *slog.Logger
+}
+
+// NewHandler creates a feed handler. baseURL is the public URL feeds are
+// served on, used for the links feeds carry to themselves; without it they
+// are derived from the request.
+func NewHandler(service *application.Service, baseURL string, logger *slog.Logger) http.Handler {
+	h := &Handler{
+		service: service,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		logger:  logger,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /feeds/{file}", h.fetch)
+	return mux
+}
+
+// fetch responds with the feed in the format named by the file extension.
+// Readers that send back the ETag get 304 Not Modified until the feed
+// changes.
+func (h *Handler) fetch(w http.ResponseWriter, r *http.Request) {
+	file := r.PathValue("file")
+	token, format := "", domain.Format("")
+	if name, ok := strings.CutSuffix(file, ".rss"); ok {
+		token, format = name, domain.FormatRSS
+	} else if name, ok := strings.CutSuffix(file, ".json"); ok {
+		token, format = name, domain.FormatJSON
+	}
+	if token == "" {
+		writeError(w, http.StatusNotFound, "feed not found")
+		return
+	}
+
+	doc, err := h.service.Fetch(r.Context(), token)
+	switch {
+	case errors.Is(err, domain.ErrFeedNotFound):
+		writeError(w, http.StatusNotFound, "feed not found")
+		return
+	case errors.Is(err, domain.ErrSourceNotFound):
+		writeError(w, http.StatusGone, "the feed's tag or saved filter was deleted")
+		return
+	case err != nil:
+		h.logger.ErrorContext(r.Context(), "failed to fetch feed", "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to render feed")
+		return
+	}
+
+	var body []byte
+	var contentType string
+	switch format {
+	case domain.FormatRSS:
+		body, err = doc.RSS(h.selfURL(r))
+		contentType = domain.RSSContentType
+	default:
+		body, err = doc.JSON(h.selfURL(r))
+		contentType = domain.JSONContentType
+	}
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "failed to encode feed", "format", format, "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to render feed")
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// selfURL returns the public URL of the requested feed
+func (h *Handler) selfURL(r *http.Request) string {
+	if h.baseURL != "" {
+		return h.baseURL + r.URL.Path
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + r.URL.Path
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]string{"error": message})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/feed/application"
+	"github.com/slips-ai/slips-core/internal/memory"
+	savedfilterapp "github.com/slips-ai/slips-core/internal/savedfilter/application"
+	tagapp "github.com/slips-ai/slips-core/internal/tag/application"
+	taskapp "github.com/slips-ai/slips-core/internal/task/application"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+func TestHandler_Fetch(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	store := memory.NewStore()
+	changes := changefeed.NewHub()
+	tasks := taskapp.NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changes, taskdomain.DefaultChecklistLimits, logger)
+	tags := tagapp.NewService(memory.NewTagRepository(store), tasks, changes, logger)
+	filters := savedfilterapp.NewService(memory.NewSavedFilterRepository(store), logger)
+	service := application.NewService(memory.NewFeedRepository(store), tasks, tags, filters, 50, 24*time.Hour, logger)
+	handler := NewHandler(service, "https://slips.example.com/", logger)
+
+	owner := auth.WithUserID(context.Background(), "owner")
+	if _, err := tasks.CreateTask(owner, "Buy milk", "Semi-skimmed", []string{"errands"}, nil, nil, "", nil, ""); err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if _, err := tasks.CreateTask(owner, "Write report", "", []string{"work"}, nil, nil, "", nil, ""); err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	allTags, err := tags.ListTags(owner, 10, 0)
+	if err != nil {
+		t.Fatalf("list tags: %v", err)
+	}
+	var errands uuid.UUID
+	for _, tag := range allTags {
+		if tag.Name == "errands" {
+			errands = tag.ID
+		}
+	}
+	_, token, err := service.CreateFeed(owner, "Errands", &errands, nil)
+	if err != nil {
+		t.Fatalf("create feed: %v", err)
+	}
+
+	get := func(path string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// RSS lists only the tasks carrying the tag
+	rec := get("/feeds/"+token+".rss", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("RSS: status %d, body %s", rec.Code, rec.Body)
+	}
+	if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, "application/rss+xml") {
+		t.Errorf("RSS content type = %q", got)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "<title>Buy milk</title>") || strings.Contains(body, "Write report") {
+		t.Errorf("RSS body = %s", body)
+	}
+	if !strings.Contains(body, "<link>https://slips.example.com/feeds/"+token+".rss</link>") {
+		t.Errorf("RSS link missing from %s", body)
+	}
+
+	// Unchanged feeds are not sent again
+	rec = get("/feeds/"+token+".rss", http.Header{"If-None-Match": {rec.Header().Get("ETag")}})
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("conditional RSS: status %d, want 304", rec.Code)
+	}
+
+	// JSON Feed carries the task state
+	rec = get("/feeds/"+token+".json", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("JSON: status %d, body %s", rec.Code, rec.Body)
+	}
+	var feed struct {
+		Title string `json:"title"`
+		Items []struct {
+			Title string   `json:"title"`
+			Tags  []string `json:"tags"`
+			Slips struct {
+				Completed bool `json:"completed"`
+			} `json:"_slips"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &feed); err != nil {
+		t.Fatalf("decode JSON feed: %v", err)
+	}
+	if feed.Title != "errands" || len(feed.Items) != 1 || feed.Items[0].Title != "Buy milk" || len(feed.Items[0].Tags) != 1 || feed.Items[0].Tags[0] != "errands" {
+		t.Errorf("JSON feed = %+v", feed)
+	}
+
+	// Unknown tokens and formats are not found
+	for _, path := range []string{"/feeds/feed_unknown.rss", "/feeds/" + token + ".atom", "/feeds/.json"} {
+		if rec := get(path, nil); rec.Code != http.StatusNotFound {
+			t.Errorf("GET %s: status %d, want 404", path, rec.Code)
+		}
+	}
+
+	// Deleting the tag retires the feed
+	if _, err := tags.DeleteTag(owner, errands, nil, false); err != nil {
+		t.Fatalf("delete tag: %v", err)
+	}
+	if rec := get("/feeds/"+token+".json", nil); rec.Code != http.StatusGone {
+		t.Errorf("after tag deletion: status %d, want 410", rec.Code)
+	}
+}
@@ -0,0 +1,24 @@
+-- name: CreateFeed :one
+INSERT INTO feeds (id, owner_id, name, tag_id, saved_filter_id, token_hash)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, owner_id, name, tag_id, saved_filter_id, token_hash, created_at, last_fetched_at;
+
+-- name: GetFeedByTokenHash :one
+SELECT id, owner_id, name, tag_id, saved_filter_id, token_hash, created_at, last_fetched_at
+FROM feeds
+WHERE token_hash = $1;
+
+-- name: ListFeeds :many
+SELECT id, owner_id, name, tag_id, saved_filter_id, token_hash, created_at, last_fetched_at
+FROM feeds
+WHERE owner_id = $1
+ORDER BY name ASC, created_at ASC;
+
+-- name: DeleteFeed :exec
+DELETE FROM feeds
+WHERE id = $1 AND owner_id = $2;
+
+-- name: MarkFeedFetched :exec
+UPDATE feeds
+SET last_fetched_at = $2
+WHERE id = $1;
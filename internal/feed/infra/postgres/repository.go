@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/slips-ai/slips-core/internal/feed/domain"
+)
+
+// FeedRepository implements domain.Repository using PostgreSQL
+type FeedRepository struct {
+	queries *Queries
+}
+
+// NewFeedRepository creates a new feed repository
+func NewFeedRepository(pool *pgxpool.Pool) *FeedRepository {
+	return &FeedRepository{
+		queries: New(pool),
+	}
+}
+
+// Create creates a new feed
+func (r *FeedRepository) Create(ctx context.Context, feed *domain.Feed) error {
+	result, err := r.queries.CreateFeed(ctx, CreateFeedParams{
+		ID:            pgtype.UUID{Bytes: feed.ID, Valid: true},
+		OwnerID:       feed.OwnerID,
+		Name:          feed.Name,
+		TagID:         uuidPtrToPg(feed.TagID),
+		SavedFilterID: uuidPtrToPg(feed.SavedFilterID),
+		TokenHash:     feed.TokenHash,
+	})
+	if err != nil {
+		return err
+	}
+
+	feed.CreatedAt = result.CreatedAt.Time
+	return nil
+}
+
+// GetByTokenHash retrieves a feed by the hash of its token
+func (r *FeedRepository) GetByTokenHash(ctx context.Context, hash string) (*domain.Feed, error) {
+	result, err := r.queries.GetFeedByTokenHash(ctx, hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrFeedNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomain(result)
+}
+
+// List lists the owner's feeds ordered by name
+func (r *FeedRepository) List(ctx context.Context, ownerID string) ([]*domain.Feed, error) {
+	results, err := r.queries.ListFeeds(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	feeds := make([]*domain.Feed, len(results))
+	for i, result := range results {
+		feed, err := toDomain(result)
+		if err != nil {
+			return nil, err
+		}
+		feeds[i] = feed
+	}
+	return feeds, nil
+}
+
+// Delete deletes a feed
+func (r *FeedRepository) Delete(ctx context.Context, id uuid.UUID, ownerID string) error {
+	return r.queries.DeleteFeed(ctx, DeleteFeedParams{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		OwnerID: ownerID,
+	})
+}
+
+// MarkFetched records the time the feed was last fetched
+func (r *FeedRepository) MarkFetched(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.queries.MarkFeedFetched(ctx, MarkFeedFetchedParams{
+		ID:            pgtype.UUID{Bytes: id, Valid: true},
+		LastFetchedAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+}
+
+func toDomain(row Feed) (*domain.Feed, error) {
+	id, err := uuid.FromBytes(row.ID.Bytes[:])
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &domain.Feed{
+		ID:            id,
+		OwnerID:       row.OwnerID,
+		Name:          row.Name,
+		TagID:         pgToUUIDPtr(row.TagID),
+		SavedFilterID: pgToUUIDPtr(row.SavedFilterID),
+		TokenHash:     row.TokenHash,
+		CreatedAt:     row.CreatedAt.Time,
+	}
+	if row.LastFetchedAt.Valid {
+		feed.LastFetchedAt = &row.LastFetchedAt.Time
+	}
+	return feed, nil
+}
+
+func uuidPtrToPg(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func pgToUUIDPtr(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	value := uuid.UUID(id.Bytes)
+	return &value
+}
@@ -16,26 +16,32 @@ import (
 
 var tracer = otel.Tracer("mcptoken-service")
 
-var (
-	ErrUnauthorized = errors.New("unauthorized: user mismatch")
-)
+// BackgroundRunner runs work that outlives the request that started it,
+// such as *shutdown.Coordinator
+type BackgroundRunner interface {
+	Go(fn func(ctx context.Context)) bool
+}
 
 // Service provides MCP token business logic
 type Service struct {
-	repo   domain.Repository
-	logger *slog.Logger
+	repo       domain.Repository
+	background BackgroundRunner
+	logger     *slog.Logger
 }
 
-// NewService creates a new MCP token service
-func NewService(repo domain.Repository, logger *slog.Logger) *Service {
+// NewService creates a new MCP token service. Last-used timestamps are
+// written asynchronously through background.
+func NewService(repo domain.Repository, background BackgroundRunner, logger *slog.Logger) *Service {
 	return &Service{
-		repo:   repo,
-		logger: logger,
+		repo:       repo,
+		background: background,
+		logger:     logger,
 	}
 }
 
-// CreateToken creates a new MCP token for the authenticated user
-func (s *Service) CreateToken(ctx context.Context, name string, expiresAt *time.Time) (*domain.MCPToken, error) {
+// CreateToken creates a new MCP token for the authenticated user. It
+// returns domain.ErrInvalidLimits for negative limits.
+func (s *Service) CreateToken(ctx context.Context, name string, expiresAt *time.Time, limits domain.Limits) (*domain.MCPToken, error) {
 	ctx, span := tracer.Start(ctx, "CreateToken", trace.WithAttributes(
 		attribute.String("name", name),
 	))
@@ -49,6 +55,11 @@ func (s *Service) CreateToken(ctx context.Context, name string, expiresAt *time.
 		return nil, err
 	}
 
+	if err := limits.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	// Create new token
 	token := &domain.MCPToken{
 		Token:     uuid.New(),
@@ -56,6 +67,7 @@ func (s *Service) CreateToken(ctx context.Context, name string, expiresAt *time.
 		Name:      name,
 		ExpiresAt: expiresAt,
 		IsActive:  true,
+		Limits:    limits,
 	}
 
 	if err := s.repo.Create(ctx, token); err != nil {
@@ -83,19 +95,12 @@ func (s *Service) GetToken(ctx context.Context, id uuid.UUID) (*domain.MCPToken,
 		return nil, err
 	}
 
-	token, err := s.repo.GetByID(ctx, id)
+	token, err := s.ownedToken(ctx, id, userID)
 	if err != nil {
-		s.logger.ErrorContext(ctx, "failed to get MCP token", "id", id, "error", err)
 		span.RecordError(err)
 		return nil, err
 	}
 
-	// Verify ownership
-	if token.UserID != userID {
-		s.logger.WarnContext(ctx, "unauthorized MCP token access attempt", "token_id", id, "token_owner", token.UserID, "requester", userID)
-		return nil, ErrUnauthorized
-	}
-
 	return token, nil
 }
 
@@ -123,6 +128,48 @@ func (s *Service) ListTokens(ctx context.Context) ([]*domain.MCPToken, error) {
 	return tokens, nil
 }
 
+// UpdateTokenLimits replaces the limits of an MCP token (only if owned by
+// the authenticated user). It returns domain.ErrInvalidLimits for negative
+// limits.
+func (s *Service) UpdateTokenLimits(ctx context.Context, id uuid.UUID, limits domain.Limits) (*domain.MCPToken, error) {
+	ctx, span := tracer.Start(ctx, "UpdateTokenLimits", trace.WithAttributes(
+		attribute.String("id", id.String()),
+		attribute.Int("requests_per_minute", int(limits.RequestsPerMinute)),
+		attribute.Int("daily_mutation_budget", int(limits.DailyMutationBudget)),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := limits.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	token, err := s.ownedToken(ctx, id, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	token, err = s.repo.UpdateLimits(ctx, id, limits)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to update MCP token limits", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "MCP token limits updated", "id", id, "owner_id", userID,
+		"requests_per_minute", limits.RequestsPerMinute, "daily_mutation_budget", limits.DailyMutationBudget)
+	return token, nil
+}
+
 // RevokeToken revokes an MCP token (only if owned by the authenticated user)
 func (s *Service) RevokeToken(ctx context.Context, id uuid.UUID) error {
 	ctx, span := tracer.Start(ctx, "RevokeToken", trace.WithAttributes(
@@ -138,20 +185,11 @@ func (s *Service) RevokeToken(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
-	// Get token to verify ownership
-	token, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		s.logger.ErrorContext(ctx, "failed to get MCP token for revocation", "id", id, "error", err)
+	if _, err := s.ownedToken(ctx, id, userID); err != nil {
 		span.RecordError(err)
 		return err
 	}
 
-	// Verify ownership
-	if token.UserID != userID {
-		s.logger.WarnContext(ctx, "unauthorized MCP token revoke attempt", "token_id", id, "token_owner", token.UserID, "requester", userID)
-		return ErrUnauthorized
-	}
-
 	if err := s.repo.Revoke(ctx, id); err != nil {
 		s.logger.ErrorContext(ctx, "failed to revoke MCP token", "id", id, "error", err)
 		span.RecordError(err)
@@ -177,20 +215,11 @@ func (s *Service) DeleteToken(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
-	// Get token to verify ownership
-	token, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		s.logger.ErrorContext(ctx, "failed to get MCP token for deletion", "id", id, "error", err)
+	if _, err := s.ownedToken(ctx, id, userID); err != nil {
 		span.RecordError(err)
 		return err
 	}
 
-	// Verify ownership
-	if token.UserID != userID {
-		s.logger.WarnContext(ctx, "unauthorized MCP token delete attempt", "token_id", id, "token_owner", token.UserID, "requester", userID)
-		return ErrUnauthorized
-	}
-
 	if err := s.repo.Delete(ctx, id); err != nil {
 		s.logger.ErrorContext(ctx, "failed to delete MCP token", "id", id, "error", err)
 		span.RecordError(err)
@@ -201,40 +230,74 @@ func (s *Service) DeleteToken(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-// ValidateToken validates an MCP token and returns the associated user ID
-// This is used by the auth interceptor and does not require authentication
-func (s *Service) ValidateToken(ctx context.Context, tokenValue uuid.UUID) (string, error) {
+// ownedToken loads the token id of userID. Tokens of other users are
+// reported as domain.ErrTokenNotFound, like missing ones.
+func (s *Service) ownedToken(ctx context.Context, id uuid.UUID, userID string) (*domain.MCPToken, error) {
+	token, err := s.repo.GetByID(ctx, id)
+	if errors.Is(err, domain.ErrTokenNotFound) {
+		return nil, domain.ErrTokenNotFound
+	}
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get MCP token", "id", id, "error", err)
+		return nil, err
+	}
+	if token.UserID != userID {
+		s.logger.WarnContext(ctx, "MCP token of another user requested", "token_id", id, "token_owner", token.UserID, "requester", userID)
+		return nil, domain.ErrTokenNotFound
+	}
+	return token, nil
+}
+
+// ValidateToken validates an MCP token and returns its user, ID and name
+// This is used by the auth interceptor and does not require authentication.
+// It returns domain.ErrInvalidToken alike for unknown, inactive and expired
+// tokens; the reason is only logged.
+func (s *Service) ValidateToken(ctx context.Context, tokenValue uuid.UUID) (*auth.MCPTokenInfo, error) {
 	ctx, span := tracer.Start(ctx, "ValidateToken")
 	defer span.End()
 
 	token, err := s.repo.GetByToken(ctx, tokenValue)
+	if errors.Is(err, domain.ErrTokenNotFound) {
+		s.logger.DebugContext(ctx, "MCP token not found")
+		span.RecordError(domain.ErrInvalidToken)
+		return nil, domain.ErrInvalidToken
+	}
 	if err != nil {
-		s.logger.DebugContext(ctx, "MCP token not found", "error", err)
+		s.logger.ErrorContext(ctx, "failed to look up MCP token", "error", err)
 		span.RecordError(err)
-		return "", err
+		return nil, err
 	}
 
 	// Check if token is valid (active and not expired)
 	if !token.IsValid() {
 		if !token.IsActive {
 			s.logger.DebugContext(ctx, "MCP token is inactive", "token_id", token.ID)
-			return "", errors.New("token is inactive")
-		}
-		if token.IsExpired() {
+		} else {
 			s.logger.DebugContext(ctx, "MCP token is expired", "token_id", token.ID)
-			return "", errors.New("token is expired")
 		}
+		span.RecordError(domain.ErrInvalidToken)
+		return nil, domain.ErrInvalidToken
 	}
 
-	// Update last used timestamp asynchronously
-	go func() {
-		// Use background context to avoid cancellation
-		updateCtx := context.Background()
+	// Update last used timestamp asynchronously. The worker context is not
+	// tied to the request, so the write survives the RPC returning.
+	started := s.background.Go(func(updateCtx context.Context) {
 		if err := s.repo.UpdateLastUsedAt(updateCtx, token.ID); err != nil {
 			s.logger.WarnContext(updateCtx, "failed to update MCP token last used timestamp", "token_id", token.ID, "error", err)
 		}
-	}()
+	})
+	if !started {
+		s.logger.DebugContext(ctx, "shutting down, skipped MCP token last used update", "token_id", token.ID)
+	}
 
 	s.logger.DebugContext(ctx, "MCP token validated", "token_id", token.ID, "user_id", token.UserID)
-	return token.UserID, nil
+	return &auth.MCPTokenInfo{
+		UserID:  token.UserID,
+		TokenID: token.ID,
+		Name:    token.Name,
+		Limits: auth.MCPTokenLimits{
+			RequestsPerMinute:   int(token.Limits.RequestsPerMinute),
+			DailyMutationBudget: int(token.Limits.DailyMutationBudget),
+		},
+	}, nil
 }
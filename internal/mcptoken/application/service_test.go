@@ -0,0 +1,139 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/mcptoken/domain"
+	"github.com/slips-ai/slips-core/internal/memory"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type inlineRunner struct{}
+
+func (inlineRunner) Go(fn func(ctx context.Context)) bool {
+	fn(context.Background())
+	return true
+}
+
+func TestValidateToken_UniformError(t *testing.T) {
+	store := memory.NewStore()
+	repo := memory.NewMCPTokenRepository(store)
+	service := NewService(repo, inlineRunner{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	active, err := service.CreateToken(ctx, "active", nil, domain.Limits{})
+	if err != nil {
+		t.Fatalf("create token: %v", err)
+	}
+	revoked, err := service.CreateToken(ctx, "revoked", nil, domain.Limits{})
+	if err != nil {
+		t.Fatalf("create token: %v", err)
+	}
+	if err := service.RevokeToken(ctx, revoked.ID); err != nil {
+		t.Fatalf("revoke token: %v", err)
+	}
+	past := time.Now().Add(-time.Hour)
+	expired := &domain.MCPToken{Token: uuid.New(), UserID: "owner", Name: "expired", ExpiresAt: &past}
+	if err := repo.Create(ctx, expired); err != nil {
+		t.Fatalf("create token: %v", err)
+	}
+
+	info, err := service.ValidateToken(context.Background(), active.Token)
+	if err != nil {
+		t.Fatalf("ValidateToken(active) error = %v", err)
+	}
+	if info.UserID != "owner" || info.TokenID != active.ID || info.Name != "active" {
+		t.Fatalf("ValidateToken(active) = %+v, want the owner, ID and name of the token", info)
+	}
+	for name, token := range map[string]uuid.UUID{
+		"unknown": uuid.New(),
+		"revoked": revoked.Token,
+		"expired": expired.Token,
+	} {
+		if _, err := service.ValidateToken(context.Background(), token); !errors.Is(err, domain.ErrInvalidToken) || err.Error() != "invalid MCP token" {
+			t.Errorf("ValidateToken(%s) error = %v, want ErrInvalidToken", name, err)
+		}
+	}
+}
+
+func TestUpdateTokenLimits(t *testing.T) {
+	store := memory.NewStore()
+	service := NewService(memory.NewMCPTokenRepository(store), inlineRunner{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	if _, err := service.CreateToken(ctx, "bot", nil, domain.Limits{RequestsPerMinute: -1}); !errors.Is(err, domain.ErrInvalidLimits) {
+		t.Fatalf("CreateToken with a negative limit error = %v, want ErrInvalidLimits", err)
+	}
+	token, err := service.CreateToken(ctx, "bot", nil, domain.Limits{RequestsPerMinute: 60})
+	if err != nil {
+		t.Fatalf("create token: %v", err)
+	}
+
+	limits := domain.Limits{RequestsPerMinute: 10, DailyMutationBudget: 500}
+	updated, err := service.UpdateTokenLimits(ctx, token.ID, limits)
+	if err != nil || updated.Limits != limits {
+		t.Fatalf("UpdateTokenLimits = %+v, %v; want %+v", updated, err, limits)
+	}
+	info, err := service.ValidateToken(context.Background(), token.Token)
+	if err != nil || info.Limits != (auth.MCPTokenLimits{RequestsPerMinute: 10, DailyMutationBudget: 500}) {
+		t.Errorf("ValidateToken = %+v, %v; want the new limits", info, err)
+	}
+
+	other := auth.WithUserID(context.Background(), "intruder")
+	if _, err := service.UpdateTokenLimits(other, token.ID, domain.Limits{}); !errors.Is(err, domain.ErrTokenNotFound) {
+		t.Errorf("UpdateTokenLimits by another user error = %v, want ErrTokenNotFound", err)
+	}
+	if _, err := service.UpdateTokenLimits(ctx, token.ID, domain.Limits{DailyMutationBudget: -5}); !errors.Is(err, domain.ErrInvalidLimits) {
+		t.Errorf("UpdateTokenLimits with a negative limit error = %v, want ErrInvalidLimits", err)
+	}
+}
+
+func TestTokensOfOtherUsersReadAsMissing(t *testing.T) {
+	service := NewService(memory.NewMCPTokenRepository(memory.NewStore()), inlineRunner{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	token, err := service.CreateToken(auth.WithUserID(context.Background(), "owner"), "bot", nil, domain.Limits{})
+	if err != nil {
+		t.Fatalf("create token: %v", err)
+	}
+
+	other := auth.WithUserID(context.Background(), "intruder")
+	calls := map[string]func(id uuid.UUID) error{
+		"GetToken": func(id uuid.UUID) error {
+			_, err := service.GetToken(other, id)
+			return err
+		},
+		"UpdateTokenLimits": func(id uuid.UUID) error {
+			_, err := service.UpdateTokenLimits(other, id, domain.Limits{})
+			return err
+		},
+		"RevokeToken": func(id uuid.UUID) error { return service.RevokeToken(other, id) },
+		"DeleteToken": func(id uuid.UUID) error { return service.DeleteToken(other, id) },
+	}
+	for name, call := range calls {
+		foreign := grpcerrors.ToGRPCError(call(token.ID), "failed")
+		missing := grpcerrors.ToGRPCError(call(uuid.New()), "failed")
+		if status.Code(foreign) != codes.NotFound || foreign.Error() != missing.Error() {
+			t.Errorf("%s of another user's token = %v, want NotFound like a missing one (%v)", name, foreign, missing)
+		}
+	}
+	if _, err := service.GetToken(auth.WithUserID(context.Background(), "owner"), token.ID); err != nil {
+		t.Errorf("token changed by another user's calls: %v", err)
+	}
+}
+
+// HashToken must match the backfill of migration 041, which hashes the
+// canonical text form of each token
+func TestHashToken(t *testing.T) {
+	token := uuid.MustParse("3F2504E0-4F89-11D3-9A0C-0305E82C3301")
+	if got, want := domain.HashToken(token), "d1bfaf4aff653cb27984b7d978e51a7d406d1572df95d205c254beb18dc134d3"; got != want {
+		t.Errorf("HashToken() = %q, want %q", got, want)
+	}
+}
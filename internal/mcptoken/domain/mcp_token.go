@@ -1,11 +1,48 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
 )
 
+// ErrInvalidToken is returned for a token that does not exist, is revoked or
+// is expired. The cases are not told apart, so a caller guessing tokens
+// cannot learn which ones exist. It is auth.ErrMCPTokenRejected, the only
+// validation error the MCP token guard counts as a failed guess.
+var ErrInvalidToken = auth.ErrMCPTokenRejected
+
+// ErrTokenNotFound is returned for a token ID that does not exist or belongs
+// to another user. Both read the same, so callers cannot probe for the
+// token IDs of others.
+var ErrTokenNotFound = domainerrors.New(domainerrors.ErrNotFound, "MCP token not found")
+
+// ErrInvalidLimits is returned for negative limits
+var ErrInvalidLimits = errors.New("MCP token limits must not be negative")
+
+// Limits caps how much an agent may do with a token, so a misbehaving agent
+// cannot flood its user's account. Zero means unlimited.
+type Limits struct {
+	// RequestsPerMinute is the number of RPCs the token may make per minute
+	RequestsPerMinute int32
+	// DailyMutationBudget is the number of RPCs changing data the token may
+	// make per UTC day
+	DailyMutationBudget int32
+}
+
+// Validate returns ErrInvalidLimits when a limit is negative
+func (l Limits) Validate() error {
+	if l.RequestsPerMinute < 0 || l.DailyMutationBudget < 0 {
+		return ErrInvalidLimits
+	}
+	return nil
+}
+
 // MCPToken represents an MCP authentication token
 type MCPToken struct {
 	ID         uuid.UUID
@@ -16,6 +53,7 @@ type MCPToken struct {
 	ExpiresAt  *time.Time
 	LastUsedAt *time.Time
 	IsActive   bool
+	Limits     Limits
 }
 
 // IsExpired checks if the token has expired
@@ -30,3 +68,10 @@ func (t *MCPToken) IsExpired() bool {
 func (t *MCPToken) IsValid() bool {
 	return t.IsActive && !t.IsExpired()
 }
+
+// HashToken returns the hex SHA-256 of a token, which tokens are looked up
+// by. Tokens are random UUIDs, so a fast hash is enough.
+func HashToken(token uuid.UUID) string {
+	sum := sha256.Sum256([]byte(token.String()))
+	return hex.EncodeToString(sum[:])
+}
@@ -11,7 +11,10 @@ type Repository interface {
 	// Create creates a new MCP token
 	Create(ctx context.Context, token *MCPToken) error
 
-	// GetByToken retrieves an MCP token by its token value
+	// GetByToken retrieves an MCP token by its token value. Lookups go
+	// through the token hash and the value is compared in constant time.
+	// GetByToken, GetByID and UpdateLimits return ErrTokenNotFound for
+	// tokens that do not exist.
 	GetByToken(ctx context.Context, token uuid.UUID) (*MCPToken, error)
 
 	// GetByID retrieves an MCP token by its ID
@@ -23,6 +26,9 @@ type Repository interface {
 	// UpdateLastUsedAt updates the last used timestamp
 	UpdateLastUsedAt(ctx context.Context, id uuid.UUID) error
 
+	// UpdateLimits replaces the limits of an MCP token and returns it
+	UpdateLimits(ctx context.Context, id uuid.UUID, limits Limits) (*MCPToken, error)
+
 	// Revoke revokes (deactivates) an MCP token
 	Revoke(ctx context.Context, id uuid.UUID) error
 
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -44,7 +45,10 @@ func (s *MCPTokenServer) CreateMCPToken(ctx context.Context, req *mcptokenv1.Cre
 		expiresAt = &t
 	}
 
-	token, err := s.service.CreateToken(ctx, req.Name, expiresAt)
+	token, err := s.service.CreateToken(ctx, req.Name, expiresAt, limitsFromProto(req.Limits))
+	if errors.Is(err, domain.ErrInvalidLimits) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, grpcerrors.ToGRPCError(err, "failed to create MCP token")
 	}
@@ -88,6 +92,26 @@ func (s *MCPTokenServer) ListMCPTokens(ctx context.Context, req *mcptokenv1.List
 	}, nil
 }
 
+// UpdateMCPTokenLimits replaces the limits of an MCP token
+func (s *MCPTokenServer) UpdateMCPTokenLimits(ctx context.Context, req *mcptokenv1.UpdateMCPTokenLimitsRequest) (*mcptokenv1.UpdateMCPTokenLimitsResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid token ID format")
+	}
+
+	token, err := s.service.UpdateTokenLimits(ctx, id, limitsFromProto(req.Limits))
+	if errors.Is(err, domain.ErrInvalidLimits) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to update MCP token limits")
+	}
+
+	return &mcptokenv1.UpdateMCPTokenLimitsResponse{
+		Token: s.toProto(token),
+	}, nil
+}
+
 // RevokeMCPToken revokes an MCP token
 func (s *MCPTokenServer) RevokeMCPToken(ctx context.Context, req *mcptokenv1.RevokeMCPTokenRequest) (*mcptokenv1.RevokeMCPTokenResponse, error) {
 	id, err := uuid.Parse(req.Id)
@@ -124,6 +148,10 @@ func (s *MCPTokenServer) toProto(token *domain.MCPToken) *mcptokenv1.MCPToken {
 		Name:      token.Name,
 		CreatedAt: timestamppb.New(token.CreatedAt),
 		IsActive:  token.IsActive,
+		Limits: &mcptokenv1.MCPTokenLimits{
+			RequestsPerMinute:   token.Limits.RequestsPerMinute,
+			DailyMutationBudget: token.Limits.DailyMutationBudget,
+		},
 	}
 
 	if token.ExpiresAt != nil {
@@ -136,3 +164,11 @@ func (s *MCPTokenServer) toProto(token *domain.MCPToken) *mcptokenv1.MCPToken {
 
 	return protoToken
 }
+
+// limitsFromProto converts token limits; nil means unlimited
+func limitsFromProto(limits *mcptokenv1.MCPTokenLimits) domain.Limits {
+	return domain.Limits{
+		RequestsPerMinute:   limits.GetRequestsPerMinute(),
+		DailyMutationBudget: limits.GetDailyMutationBudget(),
+	}
+}
@@ -12,24 +12,30 @@ import (
 )
 
 const createMCPToken = `-- name: CreateMCPToken :one
-INSERT INTO mcp_tokens (token, user_id, name, expires_at)
-VALUES ($1, $2, $3, $4)
-RETURNING id, token, user_id, name, created_at, expires_at, last_used_at, is_active
+INSERT INTO mcp_tokens (token, token_hash, user_id, name, expires_at, requests_per_minute, daily_mutation_budget)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, token, user_id, name, created_at, expires_at, last_used_at, is_active, token_hash, requests_per_minute, daily_mutation_budget
 `
 
 type CreateMCPTokenParams struct {
-	Token     pgtype.UUID      `json:"token"`
-	UserID    string           `json:"user_id"`
-	Name      string           `json:"name"`
-	ExpiresAt pgtype.Timestamp `json:"expires_at"`
+	Token               pgtype.UUID      `json:"token"`
+	TokenHash           string           `json:"token_hash"`
+	UserID              string           `json:"user_id"`
+	Name                string           `json:"name"`
+	ExpiresAt           pgtype.Timestamp `json:"expires_at"`
+	RequestsPerMinute   int32            `json:"requests_per_minute"`
+	DailyMutationBudget int32            `json:"daily_mutation_budget"`
 }
 
 func (q *Queries) CreateMCPToken(ctx context.Context, arg CreateMCPTokenParams) (McpToken, error) {
 	row := q.db.QueryRow(ctx, createMCPToken,
 		arg.Token,
+		arg.TokenHash,
 		arg.UserID,
 		arg.Name,
 		arg.ExpiresAt,
+		arg.RequestsPerMinute,
+		arg.DailyMutationBudget,
 	)
 	var i McpToken
 	err := row.Scan(
@@ -41,6 +47,9 @@ func (q *Queries) CreateMCPToken(ctx context.Context, arg CreateMCPTokenParams)
 		&i.ExpiresAt,
 		&i.LastUsedAt,
 		&i.IsActive,
+		&i.TokenHash,
+		&i.RequestsPerMinute,
+		&i.DailyMutationBudget,
 	)
 	return i, err
 }
@@ -56,7 +65,7 @@ func (q *Queries) DeleteMCPToken(ctx context.Context, id pgtype.UUID) error {
 }
 
 const getMCPTokenByID = `-- name: GetMCPTokenByID :one
-SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active
+SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active, token_hash, requests_per_minute, daily_mutation_budget
 FROM mcp_tokens
 WHERE id = $1
 `
@@ -73,18 +82,21 @@ func (q *Queries) GetMCPTokenByID(ctx context.Context, id pgtype.UUID) (McpToken
 		&i.ExpiresAt,
 		&i.LastUsedAt,
 		&i.IsActive,
+		&i.TokenHash,
+		&i.RequestsPerMinute,
+		&i.DailyMutationBudget,
 	)
 	return i, err
 }
 
-const getMCPTokenByToken = `-- name: GetMCPTokenByToken :one
-SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active
+const getMCPTokenByTokenHash = `-- name: GetMCPTokenByTokenHash :one
+SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active, token_hash, requests_per_minute, daily_mutation_budget
 FROM mcp_tokens
-WHERE token = $1
+WHERE token_hash = $1
 `
 
-func (q *Queries) GetMCPTokenByToken(ctx context.Context, token pgtype.UUID) (McpToken, error) {
-	row := q.db.QueryRow(ctx, getMCPTokenByToken, token)
+func (q *Queries) GetMCPTokenByTokenHash(ctx context.Context, tokenHash string) (McpToken, error) {
+	row := q.db.QueryRow(ctx, getMCPTokenByTokenHash, tokenHash)
 	var i McpToken
 	err := row.Scan(
 		&i.ID,
@@ -95,12 +107,15 @@ func (q *Queries) GetMCPTokenByToken(ctx context.Context, token pgtype.UUID) (Mc
 		&i.ExpiresAt,
 		&i.LastUsedAt,
 		&i.IsActive,
+		&i.TokenHash,
+		&i.RequestsPerMinute,
+		&i.DailyMutationBudget,
 	)
 	return i, err
 }
 
 const listMCPTokensByUserID = `-- name: ListMCPTokensByUserID :many
-SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active
+SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active, token_hash, requests_per_minute, daily_mutation_budget
 FROM mcp_tokens
 WHERE user_id = $1
 ORDER BY created_at DESC
@@ -124,6 +139,9 @@ func (q *Queries) ListMCPTokensByUserID(ctx context.Context, userID string) ([]M
 			&i.ExpiresAt,
 			&i.LastUsedAt,
 			&i.IsActive,
+			&i.TokenHash,
+			&i.RequestsPerMinute,
+			&i.DailyMutationBudget,
 		); err != nil {
 			return nil, err
 		}
@@ -146,6 +164,38 @@ func (q *Queries) RevokeMCPToken(ctx context.Context, id pgtype.UUID) error {
 	return err
 }
 
+const updateMCPTokenLimits = `-- name: UpdateMCPTokenLimits :one
+UPDATE mcp_tokens
+SET requests_per_minute = $2, daily_mutation_budget = $3
+WHERE id = $1
+RETURNING id, token, user_id, name, created_at, expires_at, last_used_at, is_active, token_hash, requests_per_minute, daily_mutation_budget
+`
+
+type UpdateMCPTokenLimitsParams struct {
+	ID                  pgtype.UUID `json:"id"`
+	RequestsPerMinute   int32       `json:"requests_per_minute"`
+	DailyMutationBudget int32       `json:"daily_mutation_budget"`
+}
+
+func (q *Queries) UpdateMCPTokenLimits(ctx context.Context, arg UpdateMCPTokenLimitsParams) (McpToken, error) {
+	row := q.db.QueryRow(ctx, updateMCPTokenLimits, arg.ID, arg.RequestsPerMinute, arg.DailyMutationBudget)
+	var i McpToken
+	err := row.Scan(
+		&i.ID,
+		&i.Token,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.IsActive,
+		&i.TokenHash,
+		&i.RequestsPerMinute,
+		&i.DailyMutationBudget,
+	)
+	return i, err
+}
+
 const updateMCPTokenLastUsedAt = `-- name: UpdateMCPTokenLastUsedAt :exec
 UPDATE mcp_tokens
 SET last_used_at = CURRENT_TIMESTAMP
@@ -14,10 +14,11 @@ type Querier interface {
 	CreateMCPToken(ctx context.Context, arg CreateMCPTokenParams) (McpToken, error)
 	DeleteMCPToken(ctx context.Context, id pgtype.UUID) error
 	GetMCPTokenByID(ctx context.Context, id pgtype.UUID) (McpToken, error)
-	GetMCPTokenByToken(ctx context.Context, token pgtype.UUID) (McpToken, error)
+	GetMCPTokenByTokenHash(ctx context.Context, tokenHash string) (McpToken, error)
 	ListMCPTokensByUserID(ctx context.Context, userID string) ([]McpToken, error)
 	RevokeMCPToken(ctx context.Context, id pgtype.UUID) error
 	UpdateMCPTokenLastUsedAt(ctx context.Context, id pgtype.UUID) error
+	UpdateMCPTokenLimits(ctx context.Context, arg UpdateMCPTokenLimitsParams) (McpToken, error)
 }
 
 var _ Querier = (*Queries)(nil)
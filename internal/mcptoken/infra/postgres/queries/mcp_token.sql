@@ -1,20 +1,20 @@
 -- name: CreateMCPToken :one
-INSERT INTO mcp_tokens (token, user_id, name, expires_at)
-VALUES ($1, $2, $3, $4)
-RETURNING id, token, user_id, name, created_at, expires_at, last_used_at, is_active;
+INSERT INTO mcp_tokens (token, token_hash, user_id, name, expires_at, requests_per_minute, daily_mutation_budget)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, token, user_id, name, created_at, expires_at, last_used_at, is_active, token_hash, requests_per_minute, daily_mutation_budget;
 
--- name: GetMCPTokenByToken :one
-SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active
+-- name: GetMCPTokenByTokenHash :one
+SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active, token_hash, requests_per_minute, daily_mutation_budget
 FROM mcp_tokens
-WHERE token = $1;
+WHERE token_hash = $1;
 
 -- name: GetMCPTokenByID :one
-SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active
+SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active, token_hash, requests_per_minute, daily_mutation_budget
 FROM mcp_tokens
 WHERE id = $1;
 
 -- name: ListMCPTokensByUserID :many
-SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active
+SELECT id, token, user_id, name, created_at, expires_at, last_used_at, is_active, token_hash, requests_per_minute, daily_mutation_budget
 FROM mcp_tokens
 WHERE user_id = $1
 ORDER BY created_at DESC;
@@ -24,6 +24,12 @@ UPDATE mcp_tokens
 SET last_used_at = CURRENT_TIMESTAMP
 WHERE id = $1;
 
+-- name: UpdateMCPTokenLimits :one
+UPDATE mcp_tokens
+SET requests_per_minute = $2, daily_mutation_budget = $3
+WHERE id = $1
+RETURNING id, token, user_id, name, created_at, expires_at, last_used_at, is_active, token_hash, requests_per_minute, daily_mutation_budget;
+
 -- name: RevokeMCPToken :exec
 UPDATE mcp_tokens
 SET is_active = FALSE
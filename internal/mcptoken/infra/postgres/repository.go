@@ -2,8 +2,11 @@ package postgres
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/slips-ai/slips-core/internal/mcptoken/domain"
@@ -37,10 +40,13 @@ func (r *MCPTokenRepository) Create(ctx context.Context, token *domain.MCPToken)
 	}
 
 	result, err := r.queries.CreateMCPToken(ctx, CreateMCPTokenParams{
-		Token:     pgToken,
-		UserID:    token.UserID,
-		Name:      token.Name,
-		ExpiresAt: pgExpiresAt,
+		Token:               pgToken,
+		TokenHash:           domain.HashToken(token.Token),
+		UserID:              token.UserID,
+		Name:                token.Name,
+		ExpiresAt:           pgExpiresAt,
+		RequestsPerMinute:   token.Limits.RequestsPerMinute,
+		DailyMutationBudget: token.Limits.DailyMutationBudget,
 	})
 	if err != nil {
 		return err
@@ -65,16 +71,16 @@ func (r *MCPTokenRepository) Create(ctx context.Context, token *domain.MCPToken)
 	return nil
 }
 
-// GetByToken retrieves an MCP token by its token value
+// GetByToken retrieves an MCP token by its token value. The row is found by
+// the token hash, so the index is never searched with the token itself, and
+// the stored value is then compared in constant time.
 func (r *MCPTokenRepository) GetByToken(ctx context.Context, token uuid.UUID) (*domain.MCPToken, error) {
-	pgToken := pgtype.UUID{
-		Bytes: token,
-		Valid: true,
-	}
-
-	result, err := r.queries.GetMCPTokenByToken(ctx, pgToken)
+	result, err := r.queries.GetMCPTokenByTokenHash(ctx, domain.HashToken(token))
 	if err != nil {
-		return nil, err
+		return nil, tokenNotFound(err)
+	}
+	if subtle.ConstantTimeCompare(result.Token.Bytes[:], token[:]) != 1 {
+		return nil, domain.ErrTokenNotFound
 	}
 
 	return r.toDomain(&result)
@@ -89,7 +95,7 @@ func (r *MCPTokenRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 
 	result, err := r.queries.GetMCPTokenByID(ctx, pgID)
 	if err != nil {
-		return nil, err
+		return nil, tokenNotFound(err)
 	}
 
 	return r.toDomain(&result)
@@ -124,6 +130,20 @@ func (r *MCPTokenRepository) UpdateLastUsedAt(ctx context.Context, id uuid.UUID)
 	return r.queries.UpdateMCPTokenLastUsedAt(ctx, pgID)
 }
 
+// UpdateLimits replaces the limits of an MCP token and returns it
+func (r *MCPTokenRepository) UpdateLimits(ctx context.Context, id uuid.UUID, limits domain.Limits) (*domain.MCPToken, error) {
+	result, err := r.queries.UpdateMCPTokenLimits(ctx, UpdateMCPTokenLimitsParams{
+		ID:                  pgtype.UUID{Bytes: id, Valid: true},
+		RequestsPerMinute:   limits.RequestsPerMinute,
+		DailyMutationBudget: limits.DailyMutationBudget,
+	})
+	if err != nil {
+		return nil, tokenNotFound(err)
+	}
+
+	return r.toDomain(&result)
+}
+
 // Revoke revokes (deactivates) an MCP token
 func (r *MCPTokenRepository) Revoke(ctx context.Context, id uuid.UUID) error {
 	pgID := pgtype.UUID{
@@ -163,6 +183,10 @@ func (r *MCPTokenRepository) toDomain(row *McpToken) (*domain.MCPToken, error) {
 		Name:      row.Name,
 		CreatedAt: row.CreatedAt.Time,
 		IsActive:  row.IsActive,
+		Limits: domain.Limits{
+			RequestsPerMinute:   row.RequestsPerMinute,
+			DailyMutationBudget: row.DailyMutationBudget,
+		},
 	}
 
 	if row.ExpiresAt.Valid {
@@ -175,3 +199,11 @@ func (r *MCPTokenRepository) toDomain(row *McpToken) (*domain.MCPToken, error) {
 
 	return mcpToken, nil
 }
+
+// tokenNotFound reports a missing token row as domain.ErrTokenNotFound
+func tokenNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrTokenNotFound
+	}
+	return err
+}
@@ -0,0 +1,156 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/admin/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// AdminRepository implements the admin domain.Repository in memory
+type AdminRepository struct {
+	store *Store
+}
+
+// NewAdminRepository creates a new in-memory admin repository
+func NewAdminRepository(store *Store) *AdminRepository {
+	return &AdminRepository{
+		store: store,
+	}
+}
+
+// CountUserData counts the tasks, tags and MCP tokens owned by a user
+func (r *AdminRepository) CountUserData(ctx context.Context, userID string) (*domain.UserCounts, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	counts := &domain.UserCounts{}
+	for _, task := range r.store.tasks {
+		if task.OwnerID != userID {
+			continue
+		}
+		counts.Tasks++
+		if task.CompletedAt == nil && task.ArchivedAt == nil {
+			counts.OpenTasks++
+		}
+		if task.CompletedAt != nil {
+			counts.CompletedTasks++
+		}
+		if task.ArchivedAt != nil {
+			counts.ArchivedTasks++
+		}
+	}
+	for _, tag := range r.store.tags {
+		if tag.OwnerID == userID {
+			counts.Tags++
+		}
+	}
+	for _, token := range r.store.mcpTokens {
+		if token.UserID != userID {
+			continue
+		}
+		counts.MCPTokens++
+		if token.IsValid() {
+			counts.ActiveMCPTokens++
+		}
+	}
+	return counts, nil
+}
+
+// ListTagNames lists the tags of every owner, ordered by owner, creation
+// time and ID
+func (r *AdminRepository) ListTagNames(ctx context.Context) ([]domain.TagName, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	tags := make([]domain.TagName, 0, len(r.store.tags))
+	for _, stored := range r.store.tags {
+		tags = append(tags, domain.TagName{
+			ID:        stored.ID,
+			OwnerID:   stored.OwnerID,
+			Name:      stored.Name,
+			CreatedAt: stored.CreatedAt,
+		})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].OwnerID != tags[j].OwnerID {
+			return tags[i].OwnerID < tags[j].OwnerID
+		}
+		if !tags[i].CreatedAt.Equal(tags[j].CreatedAt) {
+			return tags[i].CreatedAt.Before(tags[j].CreatedAt)
+		}
+		return tags[i].ID.String() < tags[j].ID.String()
+	})
+	return tags, nil
+}
+
+// ApplyTagNameChanges applies changes in order under a single lock. The
+// changes are checked before any is applied, so a stale plan changes
+// nothing.
+func (r *AdminRepository) ApplyTagNameChanges(ctx context.Context, changes []domain.TagNameChange, by taskdomain.Modifier) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, change := range changes {
+		stored, ok := r.store.tags[change.TagID]
+		if !ok || stored.Name != change.OldName {
+			return 0, domain.ErrTagNamesChanged
+		}
+	}
+
+	now := time.Now()
+	var reassigned int64
+	for _, change := range changes {
+		if change.MergedInto == nil {
+			stored := r.store.tags[change.TagID]
+			stored.Name = change.NewName
+			stored.UpdatedAt = now
+			continue
+		}
+
+		from, into := change.TagID, *change.MergedInto
+		for _, task := range r.store.tasks {
+			if !slices.Contains(task.TagIDs, from) {
+				continue
+			}
+			task.TagIDs = slices.DeleteFunc(task.TagIDs, func(tagID uuid.UUID) bool {
+				return tagID == from
+			})
+			delete(r.store.tagAddedAt[task.ID], from)
+			if !slices.Contains(task.TagIDs, into) {
+				task.TagIDs = append(task.TagIDs, into)
+				task.UpdatedAt = now
+				task.LastModifiedBy = by
+				if r.store.tagAddedAt[task.ID] == nil {
+					r.store.tagAddedAt[task.ID] = make(map[uuid.UUID]time.Time)
+				}
+				r.store.tagAddedAt[task.ID][into] = now
+				reassigned++
+			}
+		}
+		for _, feed := range r.store.feeds {
+			if feed.TagID != nil && *feed.TagID == from {
+				feed.TagID = &into
+			}
+		}
+		for _, filter := range r.store.savedFilters {
+			if !slices.Contains(filter.Criteria.TagIDs, from) {
+				continue
+			}
+			filter.Criteria.TagIDs = slices.DeleteFunc(filter.Criteria.TagIDs, func(tagID uuid.UUID) bool {
+				return tagID == from
+			})
+			if !slices.Contains(filter.Criteria.TagIDs, into) {
+				filter.Criteria.TagIDs = append(filter.Criteria.TagIDs, into)
+			}
+			filter.UpdatedAt = now
+		}
+		delete(r.store.tags, from)
+		delete(r.store.tagOrphanedAt, from)
+	}
+	return reassigned, nil
+}
@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/caldav/domain"
+)
+
+// AppPasswordRepository implements domain.Repository in memory
+type AppPasswordRepository struct {
+	store *Store
+}
+
+// NewAppPasswordRepository creates a new in-memory app password repository
+func NewAppPasswordRepository(store *Store) *AppPasswordRepository {
+	return &AppPasswordRepository{
+		store: store,
+	}
+}
+
+// Create creates a new app password
+func (r *AppPasswordRepository) Create(ctx context.Context, password *domain.AppPassword) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.appPasswords[password.ID]; ok {
+		return uniqueViolation("app_passwords_pkey")
+	}
+	for _, stored := range r.store.appPasswords {
+		if stored.PasswordHash == password.PasswordHash {
+			return uniqueViolation("app_passwords_password_hash_key")
+		}
+	}
+
+	password.CreatedAt = time.Now()
+	r.store.appPasswords[password.ID] = cloneAppPassword(password)
+	return nil
+}
+
+// GetByHash retrieves an app password by the hash of its password
+func (r *AppPasswordRepository) GetByHash(ctx context.Context, hash string) (*domain.AppPassword, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, stored := range r.store.appPasswords {
+		if stored.PasswordHash == hash {
+			return cloneAppPassword(stored), nil
+		}
+	}
+	return nil, domain.ErrAppPasswordNotFound
+}
+
+// List lists the user's app passwords, oldest first
+func (r *AppPasswordRepository) List(ctx context.Context, userID string) ([]*domain.AppPassword, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var passwords []*domain.AppPassword
+	for _, stored := range r.store.appPasswords {
+		if stored.UserID == userID {
+			passwords = append(passwords, cloneAppPassword(stored))
+		}
+	}
+	sort.Slice(passwords, func(i, j int) bool {
+		if !passwords[i].CreatedAt.Equal(passwords[j].CreatedAt) {
+			return passwords[i].CreatedAt.Before(passwords[j].CreatedAt)
+		}
+		return passwords[i].ID.String() < passwords[j].ID.String()
+	})
+	return passwords, nil
+}
+
+// Delete deletes an app password
+func (r *AppPasswordRepository) Delete(ctx context.Context, id uuid.UUID, userID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.appPasswords[id]; ok && stored.UserID == userID {
+		delete(r.store.appPasswords, id)
+	}
+	return nil
+}
+
+// MarkUsed records the time the app password last signed in
+func (r *AppPasswordRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.appPasswords[id]; ok {
+		stored.LastUsedAt = &at
+	}
+	return nil
+}
+
+// cloneAppPassword copies an app password so callers cannot mutate stored
+// state
+func cloneAppPassword(password *domain.AppPassword) *domain.AppPassword {
+	copied := *password
+	copied.LastUsedAt = cloneTime(password.LastUsedAt)
+	return &copied
+}
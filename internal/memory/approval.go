@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/approval/domain"
+)
+
+// ApprovalRepository implements domain.Repository in memory
+type ApprovalRepository struct {
+	store *Store
+}
+
+// NewApprovalRepository creates a new in-memory approval repository
+func NewApprovalRepository(store *Store) *ApprovalRepository {
+	return &ApprovalRepository{
+		store: store,
+	}
+}
+
+// Create creates a new approval
+func (r *ApprovalRepository) Create(ctx context.Context, approval *domain.Approval) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.approvals[approval.ID]; ok {
+		return uniqueViolation("approvals_pkey")
+	}
+
+	approval.Status = domain.StatusPending
+	approval.CreatedAt = time.Now()
+	r.store.approvals[approval.ID] = cloneApproval(approval)
+	return nil
+}
+
+// Get retrieves an approval by ID
+func (r *ApprovalRepository) Get(ctx context.Context, id uuid.UUID, ownerID string) (*domain.Approval, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.approvals[id]
+	if !ok || stored.OwnerID != ownerID {
+		return nil, domain.ErrApprovalNotFound
+	}
+	return cloneApproval(stored), nil
+}
+
+// List lists approvals newest first with pagination
+func (r *ApprovalRepository) List(ctx context.Context, ownerID string, status domain.Status, limit, offset int) ([]*domain.Approval, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var owned []*domain.Approval
+	for _, stored := range r.store.approvals {
+		if stored.OwnerID == ownerID && (status == "" || stored.Status == status) {
+			owned = append(owned, stored)
+		}
+	}
+	sort.Slice(owned, func(i, j int) bool {
+		return owned[i].CreatedAt.After(owned[j].CreatedAt)
+	})
+
+	start, end := paginate(len(owned), limit, offset)
+	approvals := make([]*domain.Approval, 0, end-start)
+	for _, stored := range owned[start:end] {
+		approvals = append(approvals, cloneApproval(stored))
+	}
+	return approvals, nil
+}
+
+// Decide moves a pending approval to status
+func (r *ApprovalRepository) Decide(ctx context.Context, id uuid.UUID, ownerID string, status domain.Status) (*domain.Approval, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.approvals[id]
+	if !ok || stored.OwnerID != ownerID {
+		return nil, domain.ErrApprovalNotFound
+	}
+	if stored.Status != domain.StatusPending {
+		return nil, domain.ErrNotPending
+	}
+
+	now := time.Now()
+	stored.Status = status
+	stored.DecidedAt = &now
+	return cloneApproval(stored), nil
+}
+
+// GetSettings returns the owner's approval settings, or the defaults if
+// never set
+func (r *ApprovalRepository) GetSettings(ctx context.Context, ownerID string) (*domain.Settings, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	settings := r.store.approvalSettings[ownerID]
+	return &settings, nil
+}
+
+// SetSettings stores the owner's approval settings
+func (r *ApprovalRepository) SetSettings(ctx context.Context, ownerID string, settings *domain.Settings) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.approvalSettings[ownerID] = *settings
+	return nil
+}
+
+// cloneApproval copies an approval so callers cannot mutate stored state
+func cloneApproval(approval *domain.Approval) *domain.Approval {
+	copied := *approval
+	copied.TaskIDs = slices.Clone(approval.TaskIDs)
+	copied.DecidedAt = cloneTime(approval.DecidedAt)
+	return &copied
+}
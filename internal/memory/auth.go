@@ -0,0 +1,260 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/auth/domain"
+)
+
+// UserRepository implements the auth domain.Repository in memory
+type UserRepository struct {
+	store *Store
+}
+
+// NewUserRepository creates a new in-memory user repository
+func NewUserRepository(store *Store) *UserRepository {
+	return &UserRepository{
+		store: store,
+	}
+}
+
+// UpsertUser creates or updates a user. Non-empty profile fields replace
+// the stored ones unless the user is anonymized.
+func (r *UserRepository) UpsertUser(ctx context.Context, user *domain.User) (*domain.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	stored, ok := r.store.users[user.UserID]
+	if !ok {
+		r.store.nextUserID++
+		stored = &domain.User{
+			ID:             r.store.nextUserID,
+			UserID:         user.UserID,
+			Username:       user.Username,
+			AvatarURL:      user.AvatarURL,
+			Email:          user.Email,
+			TavilyMCPToken: user.TavilyMCPToken,
+			CreatedAt:      now,
+		}
+		r.store.users[user.UserID] = stored
+	} else if !stored.IsAnonymized() {
+		stored.Username = coalesce(user.Username, stored.Username)
+		stored.AvatarURL = coalesce(user.AvatarURL, stored.AvatarURL)
+		stored.Email = coalesce(user.Email, stored.Email)
+	}
+	stored.UpdatedAt = now
+
+	result := *stored
+	return &result, nil
+}
+
+// SyncUserProfile overwrites profile fields with the non-empty fields of profile
+func (r *UserRepository) SyncUserProfile(ctx context.Context, profile *domain.User) (*domain.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	stored, ok := r.store.users[profile.UserID]
+	if !ok {
+		r.store.nextUserID++
+		stored = &domain.User{
+			ID:        r.store.nextUserID,
+			UserID:    profile.UserID,
+			CreatedAt: now,
+		}
+		r.store.users[profile.UserID] = stored
+	}
+	if !stored.IsAnonymized() {
+		stored.Username = coalesce(profile.Username, stored.Username)
+		stored.AvatarURL = coalesce(profile.AvatarURL, stored.AvatarURL)
+		stored.Email = coalesce(profile.Email, stored.Email)
+	}
+	stored.ProfileSyncedAt = &now
+	stored.UpdatedAt = now
+
+	result := *stored
+	return &result, nil
+}
+
+// GetUserByUserID retrieves a user by their user ID
+func (r *UserRepository) GetUserByUserID(ctx context.Context, userID string) (*domain.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.users[userID]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	result := *stored
+	return &result, nil
+}
+
+// GetUserByID retrieves a user by their database ID
+func (r *UserRepository) GetUserByID(ctx context.Context, id int64) (*domain.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, stored := range r.store.users {
+		if stored.ID == id {
+			result := *stored
+			return &result, nil
+		}
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+// GetListDefaults returns a user's list defaults
+func (r *UserRepository) GetListDefaults(ctx context.Context, userID string) (*domain.ListDefaults, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.users[userID]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	defaults := stored.ListDefaults
+	return &defaults, nil
+}
+
+// UserExists reports whether userID has a profile
+func (r *UserRepository) UserExists(ctx context.Context, userID string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, ok := r.store.users[userID]
+	return ok, nil
+}
+
+// UpdateUserTavilyMCPToken updates Tavily MCP token for a user
+func (r *UserRepository) UpdateUserTavilyMCPToken(ctx context.Context, userID, tavilyMCPToken string) (*domain.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.users[userID]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	stored.TavilyMCPToken = tavilyMCPToken
+	stored.UpdatedAt = time.Now()
+
+	result := *stored
+	return &result, nil
+}
+
+// UpdateUserListDefaults applies an update to the list defaults of a user
+func (r *UserRepository) UpdateUserListDefaults(ctx context.Context, userID string, update *domain.ListDefaultsUpdate) (*domain.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.users[userID]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	if update.PageSize != nil {
+		stored.ListDefaults.PageSize = *update.PageSize
+	}
+	if update.TagMatchAll != nil {
+		stored.ListDefaults.TagMatchAll = *update.TagMatchAll
+	}
+	if update.SearchIncludeArchived != nil {
+		stored.ListDefaults.SearchIncludeArchived = *update.SearchIncludeArchived
+	}
+	stored.UpdatedAt = time.Now()
+
+	result := *stored
+	return &result, nil
+}
+
+// AnonymizeUser replaces the profile of a user with a pseudonym
+func (r *UserRepository) AnonymizeUser(ctx context.Context, userID string) (*domain.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.users[userID]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	now := time.Now()
+	stored.Username = domain.AnonymizedUsername(userID)
+	stored.AvatarURL = ""
+	stored.Email = ""
+	stored.TavilyMCPToken = ""
+	if stored.AnonymizedAt == nil {
+		stored.AnonymizedAt = &now
+	}
+	stored.UpdatedAt = now
+
+	result := *stored
+	return &result, nil
+}
+
+// ListUsers lists users ordered by database ID with pagination
+func (r *UserRepository) ListUsers(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	all := make([]*domain.User, 0, len(r.store.users))
+	for _, stored := range r.store.users {
+		all = append(all, stored)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].ID < all[j].ID
+	})
+
+	start, end := paginate(len(all), limit, offset)
+	users := make([]*domain.User, 0, end-start)
+	for _, stored := range all[start:end] {
+		user := *stored
+		users = append(users, &user)
+	}
+	return users, nil
+}
+
+// coalesce returns current unless it is empty, mirroring SQL COALESCE on NULL columns
+func coalesce(current, fallback string) string {
+	if current != "" {
+		return current
+	}
+	return fallback
+}
+
+// GetUserOnboarding returns the onboarding state of a user
+func (r *UserRepository) GetUserOnboarding(ctx context.Context, userID string) (*domain.Onboarding, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.onboarding[userID]
+	if !ok {
+		return &domain.Onboarding{UserID: userID}, nil
+	}
+	result := *stored
+	return &result, nil
+}
+
+// UpdateUserOnboarding applies an update to the onboarding state of a user
+func (r *UserRepository) UpdateUserOnboarding(ctx context.Context, userID string, update *domain.OnboardingUpdate) (*domain.Onboarding, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.onboarding[userID]
+	if !ok {
+		stored = &domain.Onboarding{UserID: userID}
+		r.store.onboarding[userID] = stored
+	}
+	if update.WelcomeCompleted != nil {
+		stored.WelcomeCompleted = *update.WelcomeCompleted
+	}
+	if update.SampleDataCreated != nil {
+		stored.SampleDataCreated = *update.SampleDataCreated
+	}
+	if update.FeaturesToured != nil {
+		stored.FeaturesToured = *update.FeaturesToured
+	}
+	stored.UpdatedAt = time.Now()
+
+	result := *stored
+	return &result, nil
+}
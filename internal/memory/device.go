@@ -0,0 +1,166 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/auth/domain"
+)
+
+// DeviceAuthorizationRepository implements the auth domain.DeviceAuthorizationRepository in memory
+type DeviceAuthorizationRepository struct {
+	store *Store
+}
+
+// NewDeviceAuthorizationRepository creates a new in-memory device authorization repository
+func NewDeviceAuthorizationRepository(store *Store) *DeviceAuthorizationRepository {
+	return &DeviceAuthorizationRepository{
+		store: store,
+	}
+}
+
+// CreateDeviceAuthorization stores a new pending authorization
+func (r *DeviceAuthorizationRepository) CreateDeviceAuthorization(ctx context.Context, auth *domain.DeviceAuthorization) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.deviceAuthorizations[auth.DeviceCodeHash]; ok {
+		return uniqueViolation("device_authorizations_pkey")
+	}
+	for _, stored := range r.store.deviceAuthorizations {
+		if stored.UserCode == auth.UserCode {
+			return uniqueViolation("device_authorizations_user_code_key")
+		}
+		if stored.OAuthState == auth.OAuthState {
+			return uniqueViolation("device_authorizations_oauth_state_key")
+		}
+	}
+
+	stored := &domain.DeviceAuthorization{
+		DeviceCodeHash:   auth.DeviceCodeHash,
+		UserCode:         auth.UserCode,
+		OAuthState:       auth.OAuthState,
+		AuthorizationURL: auth.AuthorizationURL,
+		Interval:         auth.Interval.Truncate(time.Second),
+		ExpiresAt:        auth.ExpiresAt,
+		CreatedAt:        time.Now(),
+	}
+	r.store.deviceAuthorizations[auth.DeviceCodeHash] = stored
+	return nil
+}
+
+// GetDeviceAuthorizationByDeviceCode looks up an authorization by device code hash
+func (r *DeviceAuthorizationRepository) GetDeviceAuthorizationByDeviceCode(ctx context.Context, deviceCodeHash string) (*domain.DeviceAuthorization, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.deviceAuthorizations[deviceCodeHash]
+	if !ok {
+		return nil, domain.ErrDeviceAuthorizationNotFound
+	}
+	return cloneDeviceAuthorization(stored), nil
+}
+
+// GetDeviceAuthorizationByUserCode looks up an authorization by user code
+func (r *DeviceAuthorizationRepository) GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (*domain.DeviceAuthorization, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, stored := range r.store.deviceAuthorizations {
+		if stored.UserCode == userCode {
+			return cloneDeviceAuthorization(stored), nil
+		}
+	}
+	return nil, domain.ErrDeviceAuthorizationNotFound
+}
+
+// GetDeviceAuthorizationByState looks up an authorization by OAuth state
+func (r *DeviceAuthorizationRepository) GetDeviceAuthorizationByState(ctx context.Context, state string) (*domain.DeviceAuthorization, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, stored := range r.store.deviceAuthorizations {
+		if stored.OAuthState == state {
+			return cloneDeviceAuthorization(stored), nil
+		}
+	}
+	return nil, domain.ErrDeviceAuthorizationNotFound
+}
+
+// ApproveDeviceAuthorization stores the tokens for a pending authorization
+func (r *DeviceAuthorizationRepository) ApproveDeviceAuthorization(ctx context.Context, state, userID string, token *domain.DeviceToken) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, stored := range r.store.deviceAuthorizations {
+		if stored.OAuthState != state || stored.IsApproved() {
+			continue
+		}
+		now := time.Now()
+		issued := *token
+		stored.UserID = userID
+		stored.Token = &issued
+		stored.ApprovedAt = &now
+		return nil
+	}
+	return domain.ErrDeviceAuthorizationNotFound
+}
+
+// TouchDeviceAuthorization records a poll
+func (r *DeviceAuthorizationRepository) TouchDeviceAuthorization(ctx context.Context, deviceCodeHash string, polledAt time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.deviceAuthorizations[deviceCodeHash]; ok {
+		stored.LastPolledAt = &polledAt
+	}
+	return nil
+}
+
+// ConsumeDeviceAuthorization deletes and returns an approved authorization
+func (r *DeviceAuthorizationRepository) ConsumeDeviceAuthorization(ctx context.Context, deviceCodeHash string) (*domain.DeviceAuthorization, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.deviceAuthorizations[deviceCodeHash]
+	if !ok || !stored.IsApproved() {
+		return nil, domain.ErrDeviceAuthorizationNotFound
+	}
+	delete(r.store.deviceAuthorizations, deviceCodeHash)
+	return cloneDeviceAuthorization(stored), nil
+}
+
+// DeleteDeviceAuthorization deletes an authorization
+func (r *DeviceAuthorizationRepository) DeleteDeviceAuthorization(ctx context.Context, deviceCodeHash string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.deviceAuthorizations, deviceCodeHash)
+	return nil
+}
+
+// DeleteExpiredDeviceAuthorizations deletes authorizations that expired before the given time
+func (r *DeviceAuthorizationRepository) DeleteExpiredDeviceAuthorizations(ctx context.Context, before time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var deleted int64
+	for hash, stored := range r.store.deviceAuthorizations {
+		if stored.ExpiresAt.Before(before) {
+			delete(r.store.deviceAuthorizations, hash)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func cloneDeviceAuthorization(stored *domain.DeviceAuthorization) *domain.DeviceAuthorization {
+	clone := *stored
+	clone.LastPolledAt = cloneTime(stored.LastPolledAt)
+	clone.ApprovedAt = cloneTime(stored.ApprovedAt)
+	if stored.Token != nil {
+		token := *stored.Token
+		clone.Token = &token
+	}
+	return &clone
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/digest/domain"
+)
+
+// DigestPreferencesRepository implements domain.Repository in memory
+type DigestPreferencesRepository struct {
+	store *Store
+}
+
+// NewDigestPreferencesRepository creates a new in-memory digest preferences
+// repository
+func NewDigestPreferencesRepository(store *Store) *DigestPreferencesRepository {
+	return &DigestPreferencesRepository{
+		store: store,
+	}
+}
+
+// Get retrieves a user's digest preferences
+func (r *DigestPreferencesRepository) Get(ctx context.Context, ownerID string) (*domain.Preferences, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.digestPrefs[ownerID]
+	if !ok {
+		return nil, domain.ErrPreferencesNotFound
+	}
+	return clonePreferences(stored), nil
+}
+
+// Upsert creates or updates a user's digest preferences, keeping the last
+// send time
+func (r *DigestPreferencesRepository) Upsert(ctx context.Context, prefs *domain.Preferences) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	stored, ok := r.store.digestPrefs[prefs.OwnerID]
+	if !ok {
+		stored = &domain.Preferences{OwnerID: prefs.OwnerID, CreatedAt: now}
+		r.store.digestPrefs[prefs.OwnerID] = stored
+	}
+	stored.Frequency = prefs.Frequency
+	stored.Timezone = prefs.Timezone
+	stored.SendHour = prefs.SendHour
+	stored.Weekday = prefs.Weekday
+	stored.UpdatedAt = now
+
+	*prefs = *clonePreferences(stored)
+	return nil
+}
+
+// ListEnabled lists the preferences of users with digests on
+func (r *DigestPreferencesRepository) ListEnabled(ctx context.Context) ([]*domain.Preferences, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var result []*domain.Preferences
+	for _, stored := range r.store.digestPrefs {
+		if stored.Frequency != domain.FrequencyOff {
+			result = append(result, clonePreferences(stored))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].OwnerID < result[j].OwnerID })
+	return result, nil
+}
+
+// Claim records a digest as sent unless one was sent since scheduledAt
+func (r *DigestPreferencesRepository) Claim(ctx context.Context, ownerID string, scheduledAt, sentAt time.Time) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.digestPrefs[ownerID]
+	if !ok || (stored.LastSentAt != nil && !stored.LastSentAt.Before(scheduledAt)) {
+		return false, nil
+	}
+	stored.LastSentAt = &sentAt
+	return true, nil
+}
+
+// Unclaim restores the previous send time after a failed send
+func (r *DigestPreferencesRepository) Unclaim(ctx context.Context, ownerID string, sentAt time.Time, previous *time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.digestPrefs[ownerID]
+	if ok && stored.LastSentAt != nil && stored.LastSentAt.Equal(sentAt) {
+		stored.LastSentAt = cloneTime(previous)
+	}
+	return nil
+}
+
+func clonePreferences(prefs *domain.Preferences) *domain.Preferences {
+	copied := *prefs
+	copied.LastSentAt = cloneTime(prefs.LastSentAt)
+	return &copied
+}
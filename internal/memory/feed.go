@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/feed/domain"
+)
+
+// FeedRepository implements domain.Repository in memory
+type FeedRepository struct {
+	store *Store
+}
+
+// NewFeedRepository creates a new in-memory feed repository
+func NewFeedRepository(store *Store) *FeedRepository {
+	return &FeedRepository{
+		store: store,
+	}
+}
+
+// Create creates a new feed
+func (r *FeedRepository) Create(ctx context.Context, feed *domain.Feed) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.feeds[feed.ID]; ok {
+		return uniqueViolation("feeds_pkey")
+	}
+	for _, stored := range r.store.feeds {
+		if stored.TokenHash == feed.TokenHash {
+			return uniqueViolation("feeds_token_hash_key")
+		}
+	}
+
+	feed.CreatedAt = time.Now()
+	r.store.feeds[feed.ID] = cloneFeed(feed)
+	return nil
+}
+
+// GetByTokenHash retrieves a feed by the hash of its token
+func (r *FeedRepository) GetByTokenHash(ctx context.Context, hash string) (*domain.Feed, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, stored := range r.store.feeds {
+		if stored.TokenHash == hash {
+			return cloneFeed(stored), nil
+		}
+	}
+	return nil, domain.ErrFeedNotFound
+}
+
+// List lists the owner's feeds ordered by name
+func (r *FeedRepository) List(ctx context.Context, ownerID string) ([]*domain.Feed, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var feeds []*domain.Feed
+	for _, stored := range r.store.feeds {
+		if stored.OwnerID == ownerID {
+			feeds = append(feeds, cloneFeed(stored))
+		}
+	}
+	sort.Slice(feeds, func(i, j int) bool {
+		if feeds[i].Name != feeds[j].Name {
+			return feeds[i].Name < feeds[j].Name
+		}
+		return feeds[i].CreatedAt.Before(feeds[j].CreatedAt)
+	})
+	return feeds, nil
+}
+
+// Delete deletes a feed
+func (r *FeedRepository) Delete(ctx context.Context, id uuid.UUID, ownerID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.feeds[id]; ok && stored.OwnerID == ownerID {
+		delete(r.store.feeds, id)
+	}
+	return nil
+}
+
+// MarkFetched records the time the feed was last fetched
+func (r *FeedRepository) MarkFetched(ctx context.Context, id uuid.UUID, at time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.feeds[id]; ok {
+		stored.LastFetchedAt = &at
+	}
+	return nil
+}
+
+// cloneFeed copies a feed so callers cannot mutate stored state
+func cloneFeed(feed *domain.Feed) *domain.Feed {
+	copied := *feed
+	if feed.TagID != nil {
+		tagID := *feed.TagID
+		copied.TagID = &tagID
+	}
+	if feed.SavedFilterID != nil {
+		savedFilterID := *feed.SavedFilterID
+		copied.SavedFilterID = &savedFilterID
+	}
+	copied.LastFetchedAt = cloneTime(feed.LastFetchedAt)
+	return &copied
+}
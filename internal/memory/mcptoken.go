@@ -0,0 +1,143 @@
+package memory
+
+import (
+	"context"
+	"crypto/subtle"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/mcptoken/domain"
+)
+
+// MCPTokenRepository implements domain.Repository in memory
+type MCPTokenRepository struct {
+	store *Store
+}
+
+// NewMCPTokenRepository creates a new in-memory MCP token repository
+func NewMCPTokenRepository(store *Store) *MCPTokenRepository {
+	return &MCPTokenRepository{
+		store: store,
+	}
+}
+
+// Create creates a new MCP token
+func (r *MCPTokenRepository) Create(ctx context.Context, token *domain.MCPToken) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, stored := range r.store.mcpTokens {
+		if stored.Token == token.Token {
+			return uniqueViolation("mcp_tokens_token_key")
+		}
+	}
+
+	token.ID = uuid.New()
+	token.CreatedAt = time.Now()
+	token.IsActive = true
+	token.LastUsedAt = nil
+
+	r.store.mcpTokens[token.ID] = cloneMCPToken(token)
+	return nil
+}
+
+// GetByToken retrieves an MCP token by its token value, comparing every
+// stored token in constant time
+func (r *MCPTokenRepository) GetByToken(ctx context.Context, token uuid.UUID) (*domain.MCPToken, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var found *domain.MCPToken
+	for _, stored := range r.store.mcpTokens {
+		if subtle.ConstantTimeCompare(stored.Token[:], token[:]) == 1 {
+			found = stored
+		}
+	}
+	if found == nil {
+		return nil, domain.ErrTokenNotFound
+	}
+	return cloneMCPToken(found), nil
+}
+
+// GetByID retrieves an MCP token by its ID
+func (r *MCPTokenRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.MCPToken, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.mcpTokens[id]
+	if !ok {
+		return nil, domain.ErrTokenNotFound
+	}
+	return cloneMCPToken(stored), nil
+}
+
+// ListByUserID retrieves all MCP tokens for a user, newest first
+func (r *MCPTokenRepository) ListByUserID(ctx context.Context, userID string) ([]*domain.MCPToken, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	tokens := []*domain.MCPToken{}
+	for _, stored := range r.store.mcpTokens {
+		if stored.UserID == userID {
+			tokens = append(tokens, cloneMCPToken(stored))
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.After(tokens[j].CreatedAt)
+	})
+	return tokens, nil
+}
+
+// UpdateLastUsedAt updates the last used timestamp
+func (r *MCPTokenRepository) UpdateLastUsedAt(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.mcpTokens[id]; ok {
+		now := time.Now()
+		stored.LastUsedAt = &now
+	}
+	return nil
+}
+
+// UpdateLimits replaces the limits of an MCP token and returns it
+func (r *MCPTokenRepository) UpdateLimits(ctx context.Context, id uuid.UUID, limits domain.Limits) (*domain.MCPToken, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.mcpTokens[id]
+	if !ok {
+		return nil, domain.ErrTokenNotFound
+	}
+	stored.Limits = limits
+	return cloneMCPToken(stored), nil
+}
+
+// Revoke revokes (deactivates) an MCP token
+func (r *MCPTokenRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.mcpTokens[id]; ok {
+		stored.IsActive = false
+	}
+	return nil
+}
+
+// Delete permanently deletes an MCP token
+func (r *MCPTokenRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.mcpTokens, id)
+	return nil
+}
+
+// cloneMCPToken copies a token so callers cannot mutate stored state
+func cloneMCPToken(token *domain.MCPToken) *domain.MCPToken {
+	copied := *token
+	copied.ExpiresAt = cloneTime(token.ExpiresAt)
+	copied.LastUsedAt = cloneTime(token.LastUsedAt)
+	return &copied
+}
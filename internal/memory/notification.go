@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/notification/domain"
+)
+
+// SubscriptionRepository implements domain.Repository in memory
+type SubscriptionRepository struct {
+	store *Store
+}
+
+// NewSubscriptionRepository creates a new in-memory push subscription
+// repository
+func NewSubscriptionRepository(store *Store) *SubscriptionRepository {
+	return &SubscriptionRepository{
+		store: store,
+	}
+}
+
+// SaveWebPushSubscription stores a subscription, replacing the one with
+// the same endpoint
+func (r *SubscriptionRepository) SaveWebPushSubscription(ctx context.Context, sub *domain.WebPushSubscription) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, stored := range r.store.webPushSubs {
+		if stored.Endpoint == sub.Endpoint {
+			stored.OwnerID = sub.OwnerID
+			stored.P256dh = sub.P256dh
+			stored.Auth = sub.Auth
+			stored.UserAgent = sub.UserAgent
+			*sub = *cloneWebPushSubscription(stored)
+			return nil
+		}
+	}
+	if _, ok := r.store.webPushSubs[sub.ID]; ok {
+		return uniqueViolation("web_push_subscriptions_pkey")
+	}
+
+	sub.CreatedAt = time.Now()
+	r.store.webPushSubs[sub.ID] = cloneWebPushSubscription(sub)
+	return nil
+}
+
+// ListWebPushSubscriptions lists the owner's subscriptions, oldest first
+func (r *SubscriptionRepository) ListWebPushSubscriptions(ctx context.Context, ownerID string) ([]*domain.WebPushSubscription, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var subs []*domain.WebPushSubscription
+	for _, stored := range r.store.webPushSubs {
+		if stored.OwnerID == ownerID {
+			subs = append(subs, cloneWebPushSubscription(stored))
+		}
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].CreatedAt.Before(subs[j].CreatedAt)
+	})
+	return subs, nil
+}
+
+// DeleteWebPushSubscription deletes a subscription
+func (r *SubscriptionRepository) DeleteWebPushSubscription(ctx context.Context, id uuid.UUID, ownerID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.webPushSubs[id]; ok && stored.OwnerID == ownerID {
+		delete(r.store.webPushSubs, id)
+	}
+	return nil
+}
+
+// MarkWebPushSubscriptionUsed records the time a notification was last
+// delivered to the subscription
+func (r *SubscriptionRepository) MarkWebPushSubscriptionUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.webPushSubs[id]; ok {
+		stored.LastUsedAt = &at
+	}
+	return nil
+}
+
+// cloneWebPushSubscription copies a subscription so callers cannot mutate
+// stored state
+func cloneWebPushSubscription(sub *domain.WebPushSubscription) *domain.WebPushSubscription {
+	copied := *sub
+	copied.LastUsedAt = cloneTime(sub.LastUsedAt)
+	return &copied
+}
@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/auth/domain"
+)
+
+// OAuthStateRepository implements the auth domain.OAuthStateRepository in memory
+type OAuthStateRepository struct {
+	store *Store
+}
+
+// NewOAuthStateRepository creates a new in-memory OAuth state repository
+func NewOAuthStateRepository(store *Store) *OAuthStateRepository {
+	return &OAuthStateRepository{
+		store: store,
+	}
+}
+
+// CreateOAuthState records an issued state
+func (r *OAuthStateRepository) CreateOAuthState(ctx context.Context, state *domain.OAuthState) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.oauthStates[state.State]; ok {
+		return uniqueViolation("oauth_states_pkey")
+	}
+	stored := *state
+	stored.CreatedAt = time.Now()
+	r.store.oauthStates[state.State] = &stored
+	return nil
+}
+
+// ConsumeOAuthState deletes and returns an issued state
+func (r *OAuthStateRepository) ConsumeOAuthState(ctx context.Context, state string) (*domain.OAuthState, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.oauthStates[state]
+	if !ok {
+		return nil, domain.ErrOAuthStateNotFound
+	}
+	delete(r.store.oauthStates, state)
+	consumed := *stored
+	return &consumed, nil
+}
+
+// DeleteExpiredOAuthStates deletes states that expired before the given time
+func (r *OAuthStateRepository) DeleteExpiredOAuthStates(ctx context.Context, before time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var deleted int64
+	for key, stored := range r.store.oauthStates {
+		if stored.ExpiresAt.Before(before) {
+			delete(r.store.oauthStates, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
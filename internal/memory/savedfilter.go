@@ -0,0 +1,128 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/savedfilter/domain"
+)
+
+// SavedFilterRepository implements domain.Repository in memory
+type SavedFilterRepository struct {
+	store *Store
+}
+
+// NewSavedFilterRepository creates a new in-memory saved filter repository
+func NewSavedFilterRepository(store *Store) *SavedFilterRepository {
+	return &SavedFilterRepository{
+		store: store,
+	}
+}
+
+// Create creates a new saved filter; names are unique per owner
+func (r *SavedFilterRepository) Create(ctx context.Context, filter *domain.SavedFilter) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.nameTaken(filter.Name, filter.OwnerID, uuid.Nil) {
+		return fmt.Errorf("%w: %w", domain.ErrSavedFilterNameConflict, uniqueViolation("saved_filters_owner_id_name_key"))
+	}
+
+	now := time.Now()
+	filter.ID = uuid.New()
+	filter.CreatedAt = now
+	filter.UpdatedAt = now
+
+	r.store.savedFilters[filter.ID] = cloneSavedFilter(filter)
+	return nil
+}
+
+// Get retrieves a saved filter by ID
+func (r *SavedFilterRepository) Get(ctx context.Context, id uuid.UUID, ownerID string) (*domain.SavedFilter, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.savedFilters[id]
+	if !ok || stored.OwnerID != ownerID {
+		return nil, domain.ErrSavedFilterNotFound
+	}
+	return cloneSavedFilter(stored), nil
+}
+
+// Update updates a saved filter
+func (r *SavedFilterRepository) Update(ctx context.Context, filter *domain.SavedFilter) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.savedFilters[filter.ID]
+	if !ok || stored.OwnerID != filter.OwnerID {
+		return domain.ErrSavedFilterNotFound
+	}
+	if r.nameTaken(filter.Name, filter.OwnerID, filter.ID) {
+		return fmt.Errorf("%w: %w", domain.ErrSavedFilterNameConflict, uniqueViolation("saved_filters_owner_id_name_key"))
+	}
+
+	filter.UpdatedAt = time.Now()
+	updated := cloneSavedFilter(filter)
+	updated.CreatedAt = stored.CreatedAt
+	r.store.savedFilters[filter.ID] = updated
+	return nil
+}
+
+// Delete deletes a saved filter
+func (r *SavedFilterRepository) Delete(ctx context.Context, id uuid.UUID, ownerID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.savedFilters[id]; ok && stored.OwnerID == ownerID {
+		delete(r.store.savedFilters, id)
+	}
+	return nil
+}
+
+// List lists saved filters ordered by name with pagination
+func (r *SavedFilterRepository) List(ctx context.Context, ownerID string, limit, offset int) ([]*domain.SavedFilter, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var owned []*domain.SavedFilter
+	for _, stored := range r.store.savedFilters {
+		if stored.OwnerID == ownerID {
+			owned = append(owned, stored)
+		}
+	}
+	sort.Slice(owned, func(i, j int) bool {
+		return owned[i].Name < owned[j].Name
+	})
+
+	start, end := paginate(len(owned), limit, offset)
+	filters := make([]*domain.SavedFilter, 0, end-start)
+	for _, stored := range owned[start:end] {
+		filters = append(filters, cloneSavedFilter(stored))
+	}
+	return filters, nil
+}
+
+// nameTaken reports whether another filter of the owner uses name.
+// Callers must hold the store lock.
+func (r *SavedFilterRepository) nameTaken(name, ownerID string, exceptID uuid.UUID) bool {
+	for id, stored := range r.store.savedFilters {
+		if id != exceptID && stored.OwnerID == ownerID && stored.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// cloneSavedFilter copies a filter so callers cannot mutate stored state
+func cloneSavedFilter(filter *domain.SavedFilter) *domain.SavedFilter {
+	copied := *filter
+	copied.Criteria.TagIDs = slices.Clone(filter.Criteria.TagIDs)
+	copied.Criteria.StartDateFrom = cloneTime(filter.Criteria.StartDateFrom)
+	copied.Criteria.StartDateTo = cloneTime(filter.Criteria.StartDateTo)
+	return &copied
+}
@@ -0,0 +1,169 @@
+// Package memory provides in-memory implementations of the repository
+// interfaces for running the server without PostgreSQL.
+//
+// All repositories created from the same Store share its data, so
+// cross-module behaviour such as tag cascades and streaks computed from task
+// completions matches the PostgreSQL implementations. Errors mirror the ones
+// those return, typed domain errors for missing rows and PostgreSQL unique
+// violations, so they are mapped to gRPC status codes in the same way. Data
+// is lost when the process exits.
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	admindomain "github.com/slips-ai/slips-core/internal/admin/domain"
+	approvaldomain "github.com/slips-ai/slips-core/internal/approval/domain"
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	caldavdomain "github.com/slips-ai/slips-core/internal/caldav/domain"
+	digestdomain "github.com/slips-ai/slips-core/internal/digest/domain"
+	feeddomain "github.com/slips-ai/slips-core/internal/feed/domain"
+	mcptokendomain "github.com/slips-ai/slips-core/internal/mcptoken/domain"
+	notificationdomain "github.com/slips-ai/slips-core/internal/notification/domain"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	streakdomain "github.com/slips-ai/slips-core/internal/streak/domain"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	usagedomain "github.com/slips-ai/slips-core/internal/usage/domain"
+	webhookdomain "github.com/slips-ai/slips-core/internal/webhook/domain"
+)
+
+var (
+	_ taskdomain.Repository                    = (*TaskRepository)(nil)
+	_ tagdomain.Repository                     = (*TagRepository)(nil)
+	_ savedfilterdomain.Repository             = (*SavedFilterRepository)(nil)
+	_ streakdomain.Repository                  = (*StreakRepository)(nil)
+	_ mcptokendomain.Repository                = (*MCPTokenRepository)(nil)
+	_ authdomain.Repository                    = (*UserRepository)(nil)
+	_ authdomain.DeviceAuthorizationRepository = (*DeviceAuthorizationRepository)(nil)
+	_ authdomain.OAuthStateRepository          = (*OAuthStateRepository)(nil)
+	_ admindomain.Repository                   = (*AdminRepository)(nil)
+	_ webhookdomain.Repository                 = (*WebhookRepository)(nil)
+	_ caldavdomain.Repository                  = (*AppPasswordRepository)(nil)
+	_ feeddomain.Repository                    = (*FeedRepository)(nil)
+	_ digestdomain.Repository                  = (*DigestPreferencesRepository)(nil)
+	_ notificationdomain.Repository            = (*SubscriptionRepository)(nil)
+	_ approvaldomain.Repository                = (*ApprovalRepository)(nil)
+	_ usagedomain.Repository                   = (*UsageRepository)(nil)
+)
+
+// Store holds the data shared by the in-memory repositories
+type Store struct {
+	mu sync.RWMutex
+
+	tasks          map[uuid.UUID]*taskdomain.Task
+	checklistItems map[uuid.UUID]*taskdomain.ChecklistItem
+	taskTombstones map[uuid.UUID]taskTombstone
+	// noteRevisions is keyed by task ID, oldest first
+	noteRevisions map[uuid.UUID][]taskdomain.NoteRevision
+	// tagAddedAt is keyed by task ID, then tag ID
+	tagAddedAt    map[uuid.UUID]map[uuid.UUID]time.Time
+	tags          map[uuid.UUID]*tagdomain.Tag
+	tagOrphanedAt map[uuid.UUID]time.Time
+	tagSettings   map[string]tagdomain.Settings
+	savedFilters  map[uuid.UUID]*savedfilterdomain.SavedFilter
+	webhooks      map[uuid.UUID]*webhookdomain.Webhook
+	weeklyGoals   map[string]int
+	autoArchive   map[string]int
+	rolloverInbox map[string]bool
+	mcpTokens     map[uuid.UUID]*mcptokendomain.MCPToken
+	appPasswords  map[uuid.UUID]*caldavdomain.AppPassword
+	feeds         map[uuid.UUID]*feeddomain.Feed
+	digestPrefs   map[string]*digestdomain.Preferences
+	webPushSubs   map[uuid.UUID]*notificationdomain.WebPushSubscription
+	approvals     map[uuid.UUID]*approvaldomain.Approval
+	users         map[string]*authdomain.User
+	onboarding    map[string]*authdomain.Onboarding
+	nextUserID    int64
+
+	// deviceAuthorizations is keyed by device code hash
+	deviceAuthorizations map[string]*authdomain.DeviceAuthorization
+	// oauthStates is keyed by state
+	oauthStates map[string]*authdomain.OAuthState
+	// approvalSettings is keyed by owner ID
+	approvalSettings map[string]approvaldomain.Settings
+	apiUsage         map[usagedomain.Key]usagedomain.Counts
+}
+
+type taskTombstone struct {
+	ownerID   string
+	deletedAt time.Time
+}
+
+// NewStore creates an empty in-memory store
+func NewStore() *Store {
+	return &Store{
+		tasks:          make(map[uuid.UUID]*taskdomain.Task),
+		checklistItems: make(map[uuid.UUID]*taskdomain.ChecklistItem),
+		taskTombstones: make(map[uuid.UUID]taskTombstone),
+		noteRevisions:  make(map[uuid.UUID][]taskdomain.NoteRevision),
+		tagAddedAt:     make(map[uuid.UUID]map[uuid.UUID]time.Time),
+		tags:           make(map[uuid.UUID]*tagdomain.Tag),
+		tagOrphanedAt:  make(map[uuid.UUID]time.Time),
+		tagSettings:    make(map[string]tagdomain.Settings),
+		savedFilters:   make(map[uuid.UUID]*savedfilterdomain.SavedFilter),
+		webhooks:       make(map[uuid.UUID]*webhookdomain.Webhook),
+		weeklyGoals:    make(map[string]int),
+		autoArchive:    make(map[string]int),
+		rolloverInbox:  make(map[string]bool),
+		mcpTokens:      make(map[uuid.UUID]*mcptokendomain.MCPToken),
+		appPasswords:   make(map[uuid.UUID]*caldavdomain.AppPassword),
+		feeds:          make(map[uuid.UUID]*feeddomain.Feed),
+		digestPrefs:    make(map[string]*digestdomain.Preferences),
+		webPushSubs:    make(map[uuid.UUID]*notificationdomain.WebPushSubscription),
+		approvals:      make(map[uuid.UUID]*approvaldomain.Approval),
+		users:          make(map[string]*authdomain.User),
+		onboarding:     make(map[string]*authdomain.Onboarding),
+
+		deviceAuthorizations: make(map[string]*authdomain.DeviceAuthorization),
+		oauthStates:          make(map[string]*authdomain.OAuthState),
+		approvalSettings:     make(map[string]approvaldomain.Settings),
+		apiUsage:             make(map[usagedomain.Key]usagedomain.Counts),
+	}
+}
+
+// uniqueViolation returns the error PostgreSQL reports for a duplicate key
+func uniqueViolation(constraint string) error {
+	return &pgconn.PgError{
+		Severity:       "ERROR",
+		Code:           "23505",
+		Message:        "duplicate key value violates unique constraint",
+		ConstraintName: constraint,
+	}
+}
+
+// cloneTime copies a nullable timestamp so stored values are never aliased
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	return &copied
+}
+
+// dateOnly truncates a nullable timestamp to its UTC calendar date, matching
+// how PostgreSQL DATE columns store values.
+func dateOnly(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	year, month, day := t.In(time.UTC).Date()
+	normalized := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &normalized
+}
+
+// paginate returns the [offset, offset+limit) window of n items
+func paginate(n, limit, offset int) (int, int) {
+	if limit < 0 {
+		limit = 0
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	start := min(offset, n)
+	end := min(start+limit, n)
+	return start, end
+}
@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// StreakRepository implements the streak domain.Repository in memory,
+// reading completions from the tasks held by the same store
+type StreakRepository struct {
+	store *Store
+}
+
+// NewStreakRepository creates a new in-memory streak repository
+func NewStreakRepository(store *Store) *StreakRepository {
+	return &StreakRepository{
+		store: store,
+	}
+}
+
+// ListCompletionDays returns distinct UTC days with a completion, newest first
+func (r *StreakRepository) ListCompletionDays(ctx context.Context, ownerID string) ([]time.Time, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	seen := make(map[time.Time]struct{})
+	days := []time.Time{}
+	for _, task := range r.store.tasks {
+		if task.OwnerID != ownerID || task.CompletedAt == nil {
+			continue
+		}
+		day := *dateOnly(task.CompletedAt)
+		if _, ok := seen[day]; ok {
+			continue
+		}
+		seen[day] = struct{}{}
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].After(days[j])
+	})
+	return days, nil
+}
+
+// CountCompletedSince counts tasks completed at or after the given instant
+func (r *StreakRepository) CountCompletedSince(ctx context.Context, ownerID string, since time.Time) (int, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	count := 0
+	for _, task := range r.store.tasks {
+		if task.OwnerID == ownerID && task.CompletedAt != nil && !task.CompletedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// GetWeeklyGoal returns the user's weekly completion goal, or nil if unset
+func (r *StreakRepository) GetWeeklyGoal(ctx context.Context, ownerID string) (*int, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	goal, ok := r.store.weeklyGoals[ownerID]
+	if !ok {
+		return nil, nil
+	}
+	return &goal, nil
+}
+
+// SetWeeklyGoal stores the user's weekly completion goal; nil clears it
+func (r *StreakRepository) SetWeeklyGoal(ctx context.Context, ownerID string, goal *int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if goal == nil {
+		delete(r.store.weeklyGoals, ownerID)
+		return nil
+	}
+	r.store.weeklyGoals[ownerID] = *goal
+	return nil
+}
@@ -0,0 +1,332 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/tag/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// TagRepository implements domain.Repository in memory
+type TagRepository struct {
+	store *Store
+}
+
+// NewTagRepository creates a new in-memory tag repository
+func NewTagRepository(store *Store) *TagRepository {
+	return &TagRepository{
+		store: store,
+	}
+}
+
+// Create creates a new tag; names and client request IDs are unique per owner
+func (r *TagRepository) Create(ctx context.Context, tag *domain.Tag) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if tag.ClientRequestID != "" {
+		for _, stored := range r.store.tags {
+			if stored.OwnerID == tag.OwnerID && stored.ClientRequestID == tag.ClientRequestID {
+				*tag = *stored
+				return nil
+			}
+		}
+	}
+	if r.findByName(tag.Name, tag.OwnerID) != nil {
+		return fmt.Errorf("%w: %w", domain.ErrTagNameConflict, uniqueViolation("idx_tags_owner_name"))
+	}
+
+	now := time.Now()
+	tag.ID = uuid.New()
+	tag.CreatedAt = now
+	tag.UpdatedAt = now
+
+	stored := *tag
+	r.store.tags[tag.ID] = &stored
+	return nil
+}
+
+// Get retrieves a tag by ID
+func (r *TagRepository) Get(ctx context.Context, id uuid.UUID, ownerID string) (*domain.Tag, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.tags[id]
+	if !ok || stored.OwnerID != ownerID {
+		return nil, domain.ErrTagNotFound
+	}
+	tag := *stored
+	return &tag, nil
+}
+
+// GetByName retrieves a tag by name
+func (r *TagRepository) GetByName(ctx context.Context, name, ownerID string) (*domain.Tag, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored := r.findByName(name, ownerID)
+	if stored == nil {
+		return nil, domain.ErrTagNotFound
+	}
+	tag := *stored
+	return &tag, nil
+}
+
+// GetOrCreate retrieves a tag by name or creates it if it doesn't exist
+func (r *TagRepository) GetOrCreate(ctx context.Context, name, ownerID string) (*domain.Tag, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored := r.findByName(name, ownerID); stored != nil {
+		tag := *stored
+		return &tag, nil
+	}
+
+	now := time.Now()
+	stored := &domain.Tag{
+		ID:        uuid.New(),
+		Name:      name,
+		OwnerID:   ownerID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	r.store.tags[stored.ID] = stored
+
+	tag := *stored
+	return &tag, nil
+}
+
+// GetOrCreateMany gets or creates a tag for each name under a single lock
+func (r *TagRepository) GetOrCreateMany(ctx context.Context, names []string, ownerID string) ([]domain.CreatedTag, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	results := make([]domain.CreatedTag, len(names))
+	for i, name := range names {
+		stored := r.findByName(name, ownerID)
+		if stored == nil {
+			now := time.Now()
+			stored = &domain.Tag{
+				ID:        uuid.New(),
+				Name:      name,
+				OwnerID:   ownerID,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}
+			r.store.tags[stored.ID] = stored
+			results[i].Created = true
+		}
+		tag := *stored
+		results[i].Tag = &tag
+	}
+	return results, nil
+}
+
+// Update updates a tag
+func (r *TagRepository) Update(ctx context.Context, tag *domain.Tag) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.tags[tag.ID]
+	if !ok || stored.OwnerID != tag.OwnerID {
+		return domain.ErrTagNotFound
+	}
+	if existing := r.findByName(tag.Name, tag.OwnerID); existing != nil && existing.ID != tag.ID {
+		return fmt.Errorf("%w: %w", domain.ErrTagNameConflict, uniqueViolation("idx_tags_owner_name"))
+	}
+
+	stored.Name = tag.Name
+	stored.UpdatedAt = time.Now()
+	tag.UpdatedAt = stored.UpdatedAt
+	return nil
+}
+
+// Delete deletes a tag and removes it from any tasks carrying it, giving
+// them reassignTo instead when it is set. It returns the number of tasks
+// that carried the tag.
+func (r *TagRepository) Delete(ctx context.Context, id uuid.UUID, ownerID string, reassignTo *uuid.UUID, by taskdomain.Modifier) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.tags[id]
+	if !ok || stored.OwnerID != ownerID {
+		return 0, nil
+	}
+
+	delete(r.store.tags, id)
+	delete(r.store.tagOrphanedAt, id)
+	now := time.Now()
+	var affected int64
+	for _, task := range r.store.tasks {
+		if !slices.Contains(task.TagIDs, id) {
+			continue
+		}
+		affected++
+		task.TagIDs = slices.DeleteFunc(task.TagIDs, func(tagID uuid.UUID) bool {
+			return tagID == id
+		})
+		delete(r.store.tagAddedAt[task.ID], id)
+		if reassignTo != nil && !slices.Contains(task.TagIDs, *reassignTo) {
+			task.TagIDs = append(task.TagIDs, *reassignTo)
+			task.UpdatedAt = now
+			task.LastModifiedBy = by
+			if r.store.tagAddedAt[task.ID] == nil {
+				r.store.tagAddedAt[task.ID] = make(map[uuid.UUID]time.Time)
+			}
+			r.store.tagAddedAt[task.ID][*reassignTo] = now
+		}
+	}
+	return affected, nil
+}
+
+// CountTasks returns the number of tasks carrying the tag
+func (r *TagRepository) CountTasks(ctx context.Context, id uuid.UUID, ownerID string) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.tags[id]
+	if !ok || stored.OwnerID != ownerID {
+		return 0, nil
+	}
+	var count int64
+	for _, task := range r.store.tasks {
+		if slices.Contains(task.TagIDs, id) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// List lists tags ordered by name with pagination
+func (r *TagRepository) List(ctx context.Context, ownerID string, limit, offset int) ([]*domain.Tag, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var owned []*domain.Tag
+	for _, stored := range r.store.tags {
+		if stored.OwnerID == ownerID {
+			owned = append(owned, stored)
+		}
+	}
+	sort.Slice(owned, func(i, j int) bool {
+		return owned[i].Name < owned[j].Name
+	})
+
+	start, end := paginate(len(owned), limit, offset)
+	tags := make([]*domain.Tag, 0, end-start)
+	for _, stored := range owned[start:end] {
+		tag := *stored
+		tags = append(tags, &tag)
+	}
+	return tags, nil
+}
+
+// findByName returns the stored tag with the given name, or nil.
+// Callers must hold the store lock.
+func (r *TagRepository) findByName(name, ownerID string) *domain.Tag {
+	for _, stored := range r.store.tags {
+		if stored.OwnerID == ownerID && stored.Name == name {
+			return stored
+		}
+	}
+	return nil
+}
+
+// GetSettings returns the owner's tag settings, or the defaults if never set
+func (r *TagRepository) GetSettings(ctx context.Context, ownerID string) (*domain.Settings, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	settings, ok := r.store.tagSettings[ownerID]
+	if !ok {
+		return domain.DefaultSettings(), nil
+	}
+	return &settings, nil
+}
+
+// SetSettings stores the owner's tag settings
+func (r *TagRepository) SetSettings(ctx context.Context, ownerID string, settings *domain.Settings) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored := *settings
+	if stored.OrphanCleanup != domain.OrphanCleanupAfterDays {
+		stored.OrphanCleanupAfterDays = 0
+	}
+	r.store.tagSettings[ownerID] = stored
+	return nil
+}
+
+// MarkOrphans records when tags lost their last task and forgets it for
+// tags that are used again
+func (r *TagRepository) MarkOrphans(ctx context.Context) (int64, int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	inUse := r.tagsInUse()
+	now := time.Now()
+	var orphaned, adopted int64
+	for id := range r.store.tags {
+		_, used := inUse[id]
+		_, marked := r.store.tagOrphanedAt[id]
+		switch {
+		case !used && !marked:
+			r.store.tagOrphanedAt[id] = now
+			orphaned++
+		case used && marked:
+			delete(r.store.tagOrphanedAt, id)
+			adopted++
+		}
+	}
+	return orphaned, adopted, nil
+}
+
+// DeleteExpiredOrphans deletes tags marked as orphan for at least
+// domain.MinOrphanAge whose owner's settings no longer keep them at now
+func (r *TagRepository) DeleteExpiredOrphans(ctx context.Context, now time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	inUse := r.tagsInUse()
+	var deleted int64
+	for id, orphanedAt := range r.store.tagOrphanedAt {
+		tag, ok := r.store.tags[id]
+		if !ok {
+			delete(r.store.tagOrphanedAt, id)
+			continue
+		}
+		if _, used := inUse[id]; used || orphanedAt.After(now.Add(-domain.MinOrphanAge)) {
+			continue
+		}
+		if settings, ok := r.store.tagSettings[tag.OwnerID]; ok {
+			if settings.OrphanCleanup == domain.OrphanCleanupNever {
+				continue
+			}
+			if settings.OrphanCleanup == domain.OrphanCleanupAfterDays &&
+				orphanedAt.After(now.AddDate(0, 0, -settings.OrphanCleanupAfterDays)) {
+				continue
+			}
+		}
+		delete(r.store.tags, id)
+		delete(r.store.tagOrphanedAt, id)
+		deleted++
+	}
+	return deleted, nil
+}
+
+// tagsInUse returns the IDs of tags referenced by any task.
+// The caller must hold the store lock.
+func (r *TagRepository) tagsInUse() map[uuid.UUID]struct{} {
+	inUse := make(map[uuid.UUID]struct{})
+	for _, task := range r.store.tasks {
+		for _, tagID := range task.TagIDs {
+			inUse[tagID] = struct{}{}
+		}
+	}
+	return inUse
+}
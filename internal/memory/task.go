@@ -0,0 +1,1631 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// TaskRepository implements domain.Repository in memory
+type TaskRepository struct {
+	store *Store
+}
+
+// NewTaskRepository creates a new in-memory task repository
+func NewTaskRepository(store *Store) *TaskRepository {
+	return &TaskRepository{
+		store: store,
+	}
+}
+
+// Create creates a new task together with its tag associations and checklist.
+// A task the owner created before under the same client request ID is
+// returned in place of a new one.
+func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if task.ClientRequestID != "" {
+		for _, stored := range r.store.tasks {
+			if stored.OwnerID == task.OwnerID && stored.ClientRequestID == task.ClientRequestID {
+				*task = *r.loadTask(stored)
+				task.Checklist = r.checklistForTask(stored.ID)
+				return nil
+			}
+		}
+	}
+
+	task.ID = uuid.New()
+	r.create(task)
+	return nil
+}
+
+// create stores task under its ID. Callers must hold the store write lock.
+func (r *TaskRepository) create(task *domain.Task) {
+	now := time.Now()
+	task.CreatedAt = now
+	task.UpdatedAt = now
+	task.ArchivedAt = nil
+	task.CompletedAt = nil
+	task.LastViewedAt = nil
+	task.Geofence = nil
+	task.CreatedBy = task.LastModifiedBy
+	task.Pinned = false
+	task.StartDate = dateOnly(task.StartDate)
+	task.Deadline = dateOnly(task.Deadline)
+	task.TagIDs = dedupeIDs(task.TagIDs)
+
+	stored := cloneTask(task)
+	stored.Checklist = nil
+	r.store.tasks[task.ID] = stored
+	r.recordTagsAdded(task.ID, task.TagIDs, now)
+
+	createdChecklist := make([]domain.ChecklistItem, 0, len(task.Checklist))
+	for _, item := range task.Checklist {
+		created := domain.ChecklistItem{
+			ID:        uuid.New(),
+			TaskID:    task.ID,
+			Content:   item.Content,
+			SortOrder: item.SortOrder,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		r.store.checklistItems[created.ID] = &created
+		createdChecklist = append(createdChecklist, created)
+	}
+	task.Checklist = createdChecklist
+}
+
+// Get retrieves a task by ID
+func (r *TaskRepository) Get(ctx context.Context, id uuid.UUID, ownerID string) (*domain.Task, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, err := r.ownedTask(id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	task := r.loadTask(stored)
+	task.Checklist = r.checklistForTask(id)
+	return task, nil
+}
+
+// GetPartial retrieves a task without the parts load leaves out
+func (r *TaskRepository) GetPartial(ctx context.Context, id uuid.UUID, ownerID string, load domain.LoadOptions) (*domain.Task, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, err := r.ownedTask(id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	task := r.partialTask(stored, load)
+	if !load.SkipChecklist {
+		task.Checklist = r.checklistForTask(id)
+	}
+	return task, nil
+}
+
+// GetMany retrieves the tasks with the given IDs owned by ownerID.
+// IDs that do not exist or belong to another owner are silently skipped.
+func (r *TaskRepository) GetMany(ctx context.Context, ids []uuid.UUID, ownerID string) ([]*domain.Task, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	tasks := make([]*domain.Task, 0, len(ids))
+	for _, id := range dedupeIDs(ids) {
+		stored, err := r.ownedTask(id, ownerID)
+		if err != nil {
+			continue
+		}
+		task := r.loadTask(stored)
+		task.Checklist = r.checklistForTask(id)
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// ListAfter returns the next page of an owner's tasks in ID order
+func (r *TaskRepository) ListAfter(ctx context.Context, ownerID string, after uuid.UUID, limit int, opts domain.ScanOptions) ([]*domain.Task, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var matches []*domain.Task
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID || bytes.Compare(stored.ID[:], after[:]) <= 0 {
+			continue
+		}
+		switch {
+		case opts.ArchivedOnly:
+			if stored.ArchivedAt == nil {
+				continue
+			}
+		case !opts.IncludeArchived:
+			if stored.ArchivedAt != nil {
+				continue
+			}
+		}
+		matches = append(matches, stored)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return bytes.Compare(matches[i].ID[:], matches[j].ID[:]) < 0
+	})
+
+	tasks := make([]*domain.Task, 0, min(limit, len(matches)))
+	for _, stored := range matches[:max(0, min(limit, len(matches)))] {
+		task := r.loadTask(stored)
+		task.Checklist = r.checklistForTask(stored.ID)
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// ListTriggerEvents returns a page of an owner's trigger events, newest first
+func (r *TaskRepository) ListTriggerEvents(ctx context.Context, ownerID string, kind domain.TriggerKind, after *domain.TriggerCursor, limit int) ([]domain.TriggerEvent, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var events []domain.TriggerEvent
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID {
+			continue
+		}
+		switch kind {
+		case domain.TriggerTaskCreated:
+			events = append(events, domain.TriggerEvent{Kind: kind, OccurredAt: stored.CreatedAt, TaskID: stored.ID})
+		case domain.TriggerTaskCompleted:
+			if stored.CompletedAt != nil {
+				events = append(events, domain.TriggerEvent{Kind: kind, OccurredAt: *stored.CompletedAt, TaskID: stored.ID})
+			}
+		case domain.TriggerTagAdded:
+			for _, tagID := range stored.TagIDs {
+				event := domain.TriggerEvent{Kind: kind, OccurredAt: r.store.tagAddedAt[stored.ID][tagID], TaskID: stored.ID, TagID: tagID}
+				if tag, ok := r.store.tags[tagID]; ok {
+					event.TagName = tag.Name
+				}
+				events = append(events, event)
+			}
+		}
+	}
+
+	events = slices.DeleteFunc(events, func(e domain.TriggerEvent) bool {
+		return after != nil && compareTriggerCursors(e.Cursor(), *after) >= 0
+	})
+	slices.SortFunc(events, func(a, b domain.TriggerEvent) int {
+		return compareTriggerCursors(b.Cursor(), a.Cursor())
+	})
+	events = events[:max(0, min(limit, len(events)))]
+
+	for i := range events {
+		stored := r.store.tasks[events[i].TaskID]
+		events[i].Task = r.loadTask(stored)
+		events[i].Task.Checklist = r.checklistForTask(stored.ID)
+	}
+	return events, nil
+}
+
+// Update updates a task and replaces its tag associations
+func (r *TaskRepository) Update(ctx context.Context, task *domain.Task) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, err := r.ownedTask(task.ID, task.OwnerID)
+	if err != nil {
+		return err
+	}
+	r.update(stored, task)
+	return nil
+}
+
+// update copies task onto its stored version, keeping the previous notes as
+// a revision. Callers must hold the store write lock.
+func (r *TaskRepository) update(stored, task *domain.Task) {
+	if stored.Notes != "" && stored.Notes != task.Notes {
+		revisions := append(r.store.noteRevisions[task.ID], domain.NoteRevision{
+			ID:        uuid.New(),
+			TaskID:    task.ID,
+			Notes:     stored.Notes,
+			CreatedAt: time.Now(),
+		})
+		if len(revisions) > domain.MaxNoteRevisions {
+			revisions = revisions[len(revisions)-domain.MaxNoteRevisions:]
+		}
+		r.store.noteRevisions[task.ID] = revisions
+	}
+
+	stored.Title = task.Title
+	stored.Notes = task.Notes
+	stored.StartDate = dateOnly(task.StartDate)
+	stored.Deadline = dateOnly(task.Deadline)
+	stored.Context = task.Context
+	stored.TagIDs = dedupeIDs(task.TagIDs)
+	stored.UpdatedAt = time.Now()
+	r.recordTagsAdded(task.ID, stored.TagIDs, stored.UpdatedAt)
+	stored.LastModifiedBy = task.LastModifiedBy
+
+	task.UpdatedAt = stored.UpdatedAt
+}
+
+// recordTagsAdded keeps when each of tagIDs was added to the task, stamping
+// new ones with now and forgetting removed ones. Callers must hold the store
+// write lock.
+func (r *TaskRepository) recordTagsAdded(taskID uuid.UUID, tagIDs []uuid.UUID, now time.Time) {
+	previous := r.store.tagAddedAt[taskID]
+	addedAt := make(map[uuid.UUID]time.Time, len(tagIDs))
+	for _, tagID := range tagIDs {
+		if at, ok := previous[tagID]; ok {
+			addedAt[tagID] = at
+		} else {
+			addedAt[tagID] = now
+		}
+	}
+	r.store.tagAddedAt[taskID] = addedAt
+}
+
+// Delete deletes a task and records a tombstone for sync clients
+func (r *TaskRepository) Delete(ctx context.Context, id uuid.UUID, ownerID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, err := r.ownedTask(id, ownerID); err != nil {
+		return nil
+	}
+	r.delete(id, ownerID)
+	return nil
+}
+
+// delete removes a task with its checklist and note revisions and records a
+// tombstone. Callers must hold the store write lock.
+func (r *TaskRepository) delete(id uuid.UUID, ownerID string) {
+	delete(r.store.tasks, id)
+	delete(r.store.noteRevisions, id)
+	delete(r.store.tagAddedAt, id)
+	for itemID, item := range r.store.checklistItems {
+		if item.TaskID == id {
+			delete(r.store.checklistItems, itemID)
+		}
+	}
+	r.store.taskTombstones[id] = taskTombstone{ownerID: ownerID, deletedAt: time.Now()}
+}
+
+// ApplyMutations applies an offline batch in order under one write lock, so
+// other requests never see part of it. With validateOnly set the task data
+// is put back as it was afterwards.
+func (r *TaskRepository) ApplyMutations(ctx context.Context, ownerID string, mutations []domain.Mutation, by domain.Modifier, validateOnly bool) ([]domain.MutationResult, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if validateOnly {
+		defer r.restoreState(r.saveState())
+	}
+
+	results := make([]domain.MutationResult, len(mutations))
+	for i, mutation := range mutations {
+		result := domain.MutationResult{
+			ClientMutationID: mutation.ClientMutationID,
+			TaskID:           mutation.TaskID,
+		}
+		stored, exists := r.store.tasks[mutation.TaskID]
+
+		switch {
+		case mutation.Kind == domain.MutationCreate:
+			if exists && stored.OwnerID != ownerID {
+				result.Conflict = domain.ConflictNotFound
+				break
+			}
+			if exists {
+				result.Conflict = domain.ConflictAlreadyExists
+				result.Task = r.loadTask(stored)
+				result.Task.Checklist = r.checklistForTask(stored.ID)
+				break
+			}
+			task := mutation.Task
+			task.ID = mutation.TaskID
+			task.OwnerID = ownerID
+			task.LastModifiedBy = by
+			r.create(task)
+			result.Task = task
+		case !exists || stored.OwnerID != ownerID:
+			result.Conflict = domain.ConflictNotFound
+		case mutation.IsStale(stored.UpdatedAt):
+			result.Conflict = domain.ConflictChanged
+			result.Task = r.loadTask(stored)
+			result.Task.Checklist = r.checklistForTask(stored.ID)
+		case mutation.Kind == domain.MutationDelete:
+			r.delete(mutation.TaskID, ownerID)
+		default:
+			task := r.loadTask(stored)
+			mutation.Changes.Apply(task)
+			task.LastModifiedBy = by
+			r.update(stored, task)
+			task.Checklist = r.checklistForTask(stored.ID)
+			result.Task = task
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// taskState is a copy of the store's task data
+type taskState struct {
+	tasks          map[uuid.UUID]*domain.Task
+	checklistItems map[uuid.UUID]*domain.ChecklistItem
+	taskTombstones map[uuid.UUID]taskTombstone
+	noteRevisions  map[uuid.UUID][]domain.NoteRevision
+	tagAddedAt     map[uuid.UUID]map[uuid.UUID]time.Time
+}
+
+// saveState copies the task data, so a batch applied with validateOnly can be
+// undone. Callers must hold the store write lock.
+func (r *TaskRepository) saveState() taskState {
+	state := taskState{
+		tasks:          make(map[uuid.UUID]*domain.Task, len(r.store.tasks)),
+		checklistItems: make(map[uuid.UUID]*domain.ChecklistItem, len(r.store.checklistItems)),
+		taskTombstones: maps.Clone(r.store.taskTombstones),
+		noteRevisions:  make(map[uuid.UUID][]domain.NoteRevision, len(r.store.noteRevisions)),
+		tagAddedAt:     make(map[uuid.UUID]map[uuid.UUID]time.Time, len(r.store.tagAddedAt)),
+	}
+	for id, task := range r.store.tasks {
+		state.tasks[id] = cloneTask(task)
+	}
+	for id, item := range r.store.checklistItems {
+		copied := *item
+		state.checklistItems[id] = &copied
+	}
+	for id, revisions := range r.store.noteRevisions {
+		state.noteRevisions[id] = slices.Clone(revisions)
+	}
+	for id, addedAt := range r.store.tagAddedAt {
+		state.tagAddedAt[id] = maps.Clone(addedAt)
+	}
+	return state
+}
+
+// restoreState puts back task data copied by saveState. Callers must hold
+// the store write lock.
+func (r *TaskRepository) restoreState(state taskState) {
+	r.store.tasks = state.tasks
+	r.store.checklistItems = state.checklistItems
+	r.store.taskTombstones = state.taskTombstones
+	r.store.noteRevisions = state.noteRevisions
+	r.store.tagAddedAt = state.tagAddedAt
+}
+
+// ListTombstones lists a page of tasks deleted after the given instant
+func (r *TaskRepository) ListTombstones(ctx context.Context, ownerID string, deletedAfter time.Time, after *domain.TombstoneCursor, limit int) ([]domain.Tombstone, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	tombstones := []domain.Tombstone{}
+	for taskID, tombstone := range r.store.taskTombstones {
+		if tombstone.ownerID != ownerID || !tombstone.deletedAt.After(deletedAfter) {
+			continue
+		}
+		if after != nil && compareTombstone(tombstone.deletedAt, taskID, *after) <= 0 {
+			continue
+		}
+		tombstones = append(tombstones, domain.Tombstone{TaskID: taskID, DeletedAt: tombstone.deletedAt})
+	}
+	sort.Slice(tombstones, func(i, j int) bool {
+		return compareTombstone(tombstones[i].DeletedAt, tombstones[i].TaskID, tombstones[j].Cursor()) < 0
+	})
+	if len(tombstones) > limit {
+		tombstones = tombstones[:max(limit, 0)]
+	}
+	return tombstones, nil
+}
+
+// compareTombstone orders a tombstone against a cursor by deletion time,
+// then task ID, as the postgres repository does
+func compareTombstone(deletedAt time.Time, taskID uuid.UUID, cursor domain.TombstoneCursor) int {
+	if c := deletedAt.Compare(cursor.DeletedAt); c != 0 {
+		return c
+	}
+	return bytes.Compare(taskID[:], cursor.TaskID[:])
+}
+
+// PurgeTombstones deletes the tombstones of every owner recorded before
+// deletedBefore
+func (r *TaskRepository) PurgeTombstones(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var purged int64
+	for taskID, tombstone := range r.store.taskTombstones {
+		if tombstone.deletedAt.Before(deletedBefore) {
+			delete(r.store.taskTombstones, taskID)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// Restore writes a backup into its owner under a single lock, matching the
+// tags by name and creating the missing ones. Nothing changes when one of
+// the IDs is taken.
+func (r *TaskRepository) Restore(ctx context.Context, restore *domain.Restore) (*domain.RestoredTags, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, task := range restore.Tasks {
+		if _, ok := r.store.tasks[task.ID]; ok {
+			return nil, uniqueViolation("tasks_pkey")
+		}
+		for _, item := range task.Checklist {
+			if _, ok := r.store.checklistItems[item.ID]; ok {
+				return nil, uniqueViolation("task_checklist_items_pkey")
+			}
+		}
+	}
+
+	now := time.Now()
+	restored := &domain.RestoredTags{IDs: make(map[uuid.UUID]uuid.UUID, len(restore.Tags))}
+	byName := make(map[string]uuid.UUID, len(restore.Tags))
+	for _, tag := range r.store.tags {
+		if tag.OwnerID == restore.OwnerID {
+			byName[tag.Name] = tag.ID
+		}
+	}
+	for id, name := range restore.Tags {
+		tagID, ok := byName[name]
+		if !ok {
+			tagID = uuid.New()
+			r.store.tags[tagID] = &tagdomain.Tag{
+				ID:        tagID,
+				Name:      name,
+				OwnerID:   restore.OwnerID,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}
+			byName[name] = tagID
+			restored.Created++
+		}
+		restored.IDs[id] = tagID
+	}
+
+	for _, task := range restore.Tasks {
+		stored := cloneTask(task)
+		stored.OwnerID = restore.OwnerID
+		stored.StartDate = dateOnly(task.StartDate)
+		stored.Deadline = dateOnly(task.Deadline)
+		stored.TagIDs = make([]uuid.UUID, 0, len(task.TagIDs))
+		for _, tagID := range task.TagIDs {
+			if restoredID, ok := restored.IDs[tagID]; ok {
+				stored.TagIDs = append(stored.TagIDs, restoredID)
+			}
+		}
+		stored.TagIDs = dedupeIDs(stored.TagIDs)
+		stored.Checklist = nil
+		r.store.tasks[task.ID] = stored
+		r.recordTagsAdded(task.ID, stored.TagIDs, task.UpdatedAt)
+
+		for _, item := range task.Checklist {
+			restoredItem := item
+			restoredItem.TaskID = task.ID
+			r.store.checklistItems[item.ID] = &restoredItem
+		}
+	}
+
+	// Revisions are kept oldest first
+	revisions := slices.Clone(restore.NoteRevisions)
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].CreatedAt.Before(revisions[j].CreatedAt)
+	})
+	for _, revision := range revisions {
+		revision.ID = uuid.New()
+		r.store.noteRevisions[revision.TaskID] = append(r.store.noteRevisions[revision.TaskID], revision)
+	}
+
+	if settings := restore.Settings; settings != nil {
+		if settings.AutoArchiveAfterDays != nil {
+			r.store.autoArchive[restore.OwnerID] = *settings.AutoArchiveAfterDays
+		} else {
+			delete(r.store.autoArchive, restore.OwnerID)
+		}
+		if settings.RolloverToInbox {
+			r.store.rolloverInbox[restore.OwnerID] = true
+		} else {
+			delete(r.store.rolloverInbox, restore.OwnerID)
+		}
+	}
+	return restored, nil
+}
+
+// Transfer gives the tasks ids of fromOwnerID to toOwnerID in place,
+// matching their tags to the recipient's by name and creating the missing
+// ones. Nothing changes when one of the tasks is missing.
+func (r *TaskRepository) Transfer(ctx context.Context, fromOwnerID, toOwnerID string, ids []uuid.UUID, by domain.Modifier) ([]uuid.UUID, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	tasks := make([]*domain.Task, len(ids))
+	for i, id := range ids {
+		stored, err := r.ownedTask(id, fromOwnerID)
+		if err != nil {
+			return nil, err
+		}
+		tasks[i] = stored
+	}
+
+	// Match the tags by name, creating the recipient's missing ones
+	now := time.Now()
+	tagIDs := make(map[uuid.UUID]uuid.UUID)
+	var created []uuid.UUID
+	for _, stored := range tasks {
+		for _, tagID := range stored.TagIDs {
+			if _, ok := tagIDs[tagID]; ok {
+				continue
+			}
+			source := r.store.tags[tagID]
+			var target *tagdomain.Tag
+			for _, tag := range r.store.tags {
+				if tag.OwnerID == toOwnerID && tag.Name == source.Name {
+					target = tag
+					break
+				}
+			}
+			if target == nil {
+				target = &tagdomain.Tag{
+					ID:        uuid.New(),
+					Name:      source.Name,
+					OwnerID:   toOwnerID,
+					CreatedAt: now,
+					UpdatedAt: now,
+				}
+				r.store.tags[target.ID] = target
+				created = append(created, target.ID)
+			}
+			tagIDs[tagID] = target.ID
+		}
+	}
+
+	for _, stored := range tasks {
+		if stored.ClientRequestID != "" {
+			for _, other := range r.store.tasks {
+				if other.OwnerID == toOwnerID && other.ClientRequestID == stored.ClientRequestID {
+					stored.ClientRequestID = ""
+					break
+				}
+			}
+		}
+		stored.OwnerID = toOwnerID
+		stored.UpdatedAt = now
+		stored.LastModifiedBy = by
+
+		addedAt := make(map[uuid.UUID]time.Time, len(stored.TagIDs))
+		remapped := make([]uuid.UUID, len(stored.TagIDs))
+		for i, tagID := range stored.TagIDs {
+			remapped[i] = tagIDs[tagID]
+			addedAt[remapped[i]] = r.store.tagAddedAt[stored.ID][tagID]
+		}
+		stored.TagIDs = remapped
+		r.store.tagAddedAt[stored.ID] = addedAt
+		r.store.taskTombstones[stored.ID] = taskTombstone{ownerID: fromOwnerID, deletedAt: now}
+	}
+	return created, nil
+}
+
+// List lists tasks with pagination
+func (r *TaskRepository) List(ctx context.Context, ownerID string, filterTagIDs []uuid.UUID, limit, offset int, opts domain.ListOptions) (*domain.ListResult, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	filterTagIDs = dedupeIDs(filterTagIDs)
+	deadlineBefore := dateOnly(opts.DeadlineBefore)
+	startDateFrom := dateOnly(opts.StartDateFrom)
+	startDateTo := dateOnly(opts.StartDateTo)
+	query := strings.ToLower(opts.Query)
+
+	var matches []*domain.Task
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID {
+			continue
+		}
+		if !matchesTagFilters(stored.TagIDs, filterTagIDs, opts) {
+			continue
+		}
+		switch {
+		case opts.ArchivedOnly:
+			if stored.ArchivedAt == nil {
+				continue
+			}
+		case !opts.IncludeArchived:
+			if stored.ArchivedAt != nil {
+				continue
+			}
+		}
+		if deadlineBefore != nil && (stored.Deadline == nil || stored.Deadline.After(*deadlineBefore)) {
+			continue
+		}
+		if startDateFrom != nil && (stored.StartDate == nil || stored.StartDate.Before(*startDateFrom)) {
+			continue
+		}
+		if startDateTo != nil && (stored.StartDate == nil || stored.StartDate.After(*startDateTo)) {
+			continue
+		}
+		if opts.InboxOnly && stored.StartDate != nil {
+			continue
+		}
+		if opts.UpdatedAfter != nil && !stored.UpdatedAt.After(*opts.UpdatedAfter) {
+			continue
+		}
+		if len(opts.Contexts) > 0 && !slices.Contains(opts.Contexts, stored.Context) {
+			continue
+		}
+		if opts.ArchivedAfter != nil && (stored.ArchivedAt == nil || stored.ArchivedAt.Before(*opts.ArchivedAfter)) {
+			continue
+		}
+		if opts.ArchivedBefore != nil && (stored.ArchivedAt == nil || !stored.ArchivedAt.Before(*opts.ArchivedBefore)) {
+			continue
+		}
+		if query != "" &&
+			!strings.Contains(strings.ToLower(stored.Title), query) &&
+			!strings.Contains(strings.ToLower(stored.Notes), query) {
+			continue
+		}
+		matches = append(matches, stored)
+	}
+
+	// In archive order, archived tasks first by archive time; then pinned
+	// tasks first, then newest first
+	sort.Slice(matches, func(i, j int) bool {
+		if opts.Order != domain.ListOrderDefault {
+			a, b := matches[i].ArchivedAt, matches[j].ArchivedAt
+			switch {
+			case a != nil && b == nil:
+				return true
+			case a == nil && b != nil:
+				return false
+			case a != nil && !a.Equal(*b):
+				if opts.Order == domain.ListOrderArchivedAsc {
+					return a.Before(*b)
+				}
+				return a.After(*b)
+			}
+		}
+		if matches[i].Pinned != matches[j].Pinned {
+			return matches[i].Pinned
+		}
+		return matches[i].CreatedAt.After(matches[j].CreatedAt)
+	})
+
+	// Group counts cover the full result set, not just the page
+	groupCounts := make(map[string]int)
+	for _, stored := range matches {
+		for _, key := range groupKeys(stored, opts.GroupBy) {
+			groupCounts[key]++
+		}
+	}
+
+	start, end := paginate(len(matches), limit, offset)
+	listResult := &domain.ListResult{
+		Tasks:     make([]*domain.Task, 0, end-start),
+		TotalSize: len(matches),
+	}
+	seenGroups := make(map[string]struct{})
+	for _, stored := range matches[start:end] {
+		task := r.partialTask(stored, opts.Load)
+		for _, item := range r.store.checklistItems {
+			if opts.Load.SkipChecklist || item.TaskID != task.ID {
+				continue
+			}
+			task.ChecklistTotal++
+			if item.Completed {
+				task.ChecklistCompleted++
+			}
+		}
+		listResult.Tasks = append(listResult.Tasks, task)
+
+		for _, key := range groupKeys(stored, opts.GroupBy) {
+			if _, seen := seenGroups[key]; !seen {
+				seenGroups[key] = struct{}{}
+				listResult.Groups = append(listResult.Groups, domain.TaskGroup{Key: key, Count: groupCounts[key]})
+			}
+		}
+	}
+
+	return listResult, nil
+}
+
+// Archive archives a task by setting archived_at to current timestamp
+func (r *TaskRepository) Archive(ctx context.Context, id uuid.UUID, ownerID string, by domain.Modifier) (*domain.Task, error) {
+	return r.mutate(id, ownerID, by, func(stored *domain.Task, now time.Time) {
+		stored.ArchivedAt = &now
+	})
+}
+
+// Unarchive unarchives a task by clearing archived_at
+func (r *TaskRepository) Unarchive(ctx context.Context, id uuid.UUID, ownerID string, by domain.Modifier) (*domain.Task, error) {
+	return r.mutate(id, ownerID, by, func(stored *domain.Task, now time.Time) {
+		stored.ArchivedAt = nil
+	})
+}
+
+// TogglePin flips the pinned flag of a task
+func (r *TaskRepository) TogglePin(ctx context.Context, id uuid.UUID, ownerID string, by domain.Modifier) (*domain.Task, error) {
+	return r.mutate(id, ownerID, by, func(stored *domain.Task, now time.Time) {
+		stored.Pinned = !stored.Pinned
+	})
+}
+
+// Complete marks a task as completed, keeping the original completion time
+func (r *TaskRepository) Complete(ctx context.Context, id uuid.UUID, ownerID string, by domain.Modifier) (*domain.Task, error) {
+	return r.mutate(id, ownerID, by, func(stored *domain.Task, now time.Time) {
+		if stored.CompletedAt == nil {
+			stored.CompletedAt = &now
+		}
+	})
+}
+
+// Reopen clears the completion time of a task
+func (r *TaskRepository) Reopen(ctx context.Context, id uuid.UUID, ownerID string, by domain.Modifier) (*domain.Task, error) {
+	return r.mutate(id, ownerID, by, func(stored *domain.Task, now time.Time) {
+		stored.CompletedAt = nil
+	})
+}
+
+// ArchiveCompleted archives the owner's completed, unarchived tasks.
+// When completedBefore is set, only tasks completed at or before it are archived.
+func (r *TaskRepository) ArchiveCompleted(ctx context.Context, ownerID string, completedBefore *time.Time, by domain.Modifier) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	var archived int64
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID || stored.CompletedAt == nil || stored.ArchivedAt != nil {
+			continue
+		}
+		if completedBefore != nil && stored.CompletedAt.After(*completedBefore) {
+			continue
+		}
+		archivedAt := now
+		stored.ArchivedAt = &archivedAt
+		stored.UpdatedAt = now
+		stored.LastModifiedBy = by
+		archived++
+	}
+	return archived, nil
+}
+
+// ArchiveByTag archives the owner's unarchived tasks carrying tagID
+func (r *TaskRepository) ArchiveByTag(ctx context.Context, ownerID string, tagID uuid.UUID, by domain.Modifier) (int64, error) {
+	return r.setArchivedByTag(ownerID, tagID, true, by), nil
+}
+
+// UnarchiveByTag restores the owner's archived tasks carrying tagID
+func (r *TaskRepository) UnarchiveByTag(ctx context.Context, ownerID string, tagID uuid.UUID, by domain.Modifier) (int64, error) {
+	return r.setArchivedByTag(ownerID, tagID, false, by), nil
+}
+
+// setArchivedByTag archives or restores the owner's tasks carrying tagID
+// and returns how many changed
+func (r *TaskRepository) setArchivedByTag(ownerID string, tagID uuid.UUID, archived bool, by domain.Modifier) int64 {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	var changed int64
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID || (stored.ArchivedAt != nil) == archived || !slices.Contains(stored.TagIDs, tagID) {
+			continue
+		}
+		if archived {
+			archivedAt := now
+			stored.ArchivedAt = &archivedAt
+		} else {
+			stored.ArchivedAt = nil
+		}
+		stored.UpdatedAt = now
+		stored.LastModifiedBy = by
+		changed++
+	}
+	return changed
+}
+
+// RolloverTasks moves the owner's open tasks that started before today to
+// today, or to the inbox when toInbox is set, and returns them
+func (r *TaskRepository) RolloverTasks(ctx context.Context, ownerID string, today time.Time, toInbox bool, by domain.Modifier) ([]*domain.Task, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	year, month, day := today.In(time.UTC).Date()
+	todayDate := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	now := time.Now()
+	moved := []*domain.Task{}
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID || stored.CompletedAt != nil || stored.ArchivedAt != nil {
+			continue
+		}
+		if stored.StartDate == nil || !stored.StartDate.Before(todayDate) {
+			continue
+		}
+		if toInbox {
+			stored.StartDate = nil
+		} else {
+			startDate := todayDate
+			stored.StartDate = &startDate
+		}
+		stored.UpdatedAt = now
+		stored.LastModifiedBy = by
+		moved = append(moved, r.loadTask(stored))
+	}
+	sort.Slice(moved, func(i, j int) bool { return moved[i].ID.String() < moved[j].ID.String() })
+	return moved, nil
+}
+
+// AddTag adds a tag to the owner's tasks in taskIDs and returns the IDs of
+// the tasks that did not carry it yet
+func (r *TaskRepository) AddTag(ctx context.Context, ownerID string, tagID uuid.UUID, taskIDs []uuid.UUID, by domain.Modifier) ([]uuid.UUID, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	changed := []uuid.UUID{}
+	for _, id := range dedupeIDs(taskIDs) {
+		stored, err := r.ownedTask(id, ownerID)
+		if err != nil || slices.Contains(stored.TagIDs, tagID) {
+			continue
+		}
+		stored.TagIDs = append(slices.Clone(stored.TagIDs), tagID)
+		stored.UpdatedAt = now
+		stored.LastModifiedBy = by
+		r.recordTagsAdded(id, stored.TagIDs, now)
+		changed = append(changed, id)
+	}
+	return changed, nil
+}
+
+// RemoveTag removes a tag from the owner's tasks in taskIDs and returns the
+// IDs of the tasks that carried it
+func (r *TaskRepository) RemoveTag(ctx context.Context, ownerID string, tagID uuid.UUID, taskIDs []uuid.UUID, by domain.Modifier) ([]uuid.UUID, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	changed := []uuid.UUID{}
+	for _, id := range dedupeIDs(taskIDs) {
+		stored, err := r.ownedTask(id, ownerID)
+		if err != nil || !slices.Contains(stored.TagIDs, tagID) {
+			continue
+		}
+		stored.TagIDs = slices.DeleteFunc(slices.Clone(stored.TagIDs), func(existing uuid.UUID) bool { return existing == tagID })
+		stored.UpdatedAt = now
+		stored.LastModifiedBy = by
+		r.recordTagsAdded(id, stored.TagIDs, now)
+		changed = append(changed, id)
+	}
+	return changed, nil
+}
+
+// SetGeofence sets the geofence of a task, or removes it when geofence is nil
+func (r *TaskRepository) SetGeofence(ctx context.Context, id uuid.UUID, ownerID string, geofence *domain.Geofence, by domain.Modifier) (*domain.Task, error) {
+	return r.mutate(id, ownerID, by, func(stored *domain.Task, now time.Time) {
+		stored.Geofence = nil
+		if geofence != nil {
+			copied := *geofence
+			stored.Geofence = &copied
+		}
+	})
+}
+
+// MarkViewed records that the owner opened a task, without changing it
+func (r *TaskRepository) MarkViewed(ctx context.Context, id uuid.UUID, ownerID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, err := r.ownedTask(id, ownerID)
+	if err != nil {
+		return err
+	}
+	viewedAt := time.Now()
+	stored.LastViewedAt = &viewedAt
+	return nil
+}
+
+// ListStale returns up to limit open tasks neither updated nor viewed since
+// inactiveBefore, longest inactive first
+func (r *TaskRepository) ListStale(ctx context.Context, ownerID string, inactiveBefore time.Time, limit int) ([]*domain.Task, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	type staleTask struct {
+		task         *domain.Task
+		lastActiveAt time.Time
+	}
+	var stale []staleTask
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID || stored.CompletedAt != nil || stored.ArchivedAt != nil {
+			continue
+		}
+		lastActiveAt := stored.UpdatedAt
+		if stored.LastViewedAt != nil && stored.LastViewedAt.After(lastActiveAt) {
+			lastActiveAt = *stored.LastViewedAt
+		}
+		if !lastActiveAt.Before(inactiveBefore) {
+			continue
+		}
+		stale = append(stale, staleTask{task: stored, lastActiveAt: lastActiveAt})
+	}
+	sort.Slice(stale, func(i, j int) bool {
+		if !stale[i].lastActiveAt.Equal(stale[j].lastActiveAt) {
+			return stale[i].lastActiveAt.Before(stale[j].lastActiveAt)
+		}
+		return stale[i].task.ID.String() < stale[j].task.ID.String()
+	})
+
+	tasks := make([]*domain.Task, 0, min(limit, len(stale)))
+	for _, entry := range stale[:min(limit, len(stale))] {
+		task := r.loadTask(entry.task)
+		task.Checklist = r.checklistForTask(task.ID)
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// GetTagActivity counts the owner's open and completed tasks carrying a tag
+// and returns up to recentLimit of them, most recently updated first
+func (r *TaskRepository) GetTagActivity(ctx context.Context, ownerID string, tagID uuid.UUID, recentLimit int) (*domain.TagActivity, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	activity := &domain.TagActivity{RecentTasks: []*domain.Task{}}
+	var tagged []*domain.Task
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID || !slices.Contains(stored.TagIDs, tagID) {
+			continue
+		}
+		switch {
+		case stored.CompletedAt != nil:
+			activity.CompletedCount++
+		case stored.ArchivedAt == nil:
+			activity.OpenCount++
+		}
+		tagged = append(tagged, stored)
+	}
+	sort.Slice(tagged, func(i, j int) bool {
+		if !tagged[i].UpdatedAt.Equal(tagged[j].UpdatedAt) {
+			return tagged[i].UpdatedAt.After(tagged[j].UpdatedAt)
+		}
+		return tagged[i].ID.String() > tagged[j].ID.String()
+	})
+
+	for _, stored := range tagged[:min(max(recentLimit, 0), len(tagged))] {
+		task := r.loadTask(stored)
+		task.Checklist = r.checklistForTask(task.ID)
+		activity.RecentTasks = append(activity.RecentTasks, task)
+	}
+	return activity, nil
+}
+
+// ListNoteRevisions returns the note revisions of a task, newest first
+func (r *TaskRepository) ListNoteRevisions(ctx context.Context, taskID uuid.UUID, ownerID string) ([]domain.NoteRevision, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	revisions := []domain.NoteRevision{}
+	if _, err := r.ownedTask(taskID, ownerID); err != nil {
+		return revisions, nil
+	}
+	stored := r.store.noteRevisions[taskID]
+	for i := len(stored) - 1; i >= 0; i-- {
+		revisions = append(revisions, stored[i])
+	}
+	return revisions, nil
+}
+
+// ListNoteRevisionsForTasks returns the note revisions of the owner's tasks
+// among taskIDs, grouped by task and newest first within a task
+func (r *TaskRepository) ListNoteRevisionsForTasks(ctx context.Context, taskIDs []uuid.UUID, ownerID string) ([]domain.NoteRevision, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	revisions := []domain.NoteRevision{}
+	for _, taskID := range taskIDs {
+		if _, err := r.ownedTask(taskID, ownerID); err != nil {
+			continue
+		}
+		stored := r.store.noteRevisions[taskID]
+		for i := len(stored) - 1; i >= 0; i-- {
+			revisions = append(revisions, stored[i])
+		}
+	}
+	return revisions, nil
+}
+
+// GetNoteRevision returns one note revision of a task
+func (r *TaskRepository) GetNoteRevision(ctx context.Context, id, taskID uuid.UUID, ownerID string) (*domain.NoteRevision, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	if _, err := r.ownedTask(taskID, ownerID); err != nil {
+		return nil, domain.ErrNoteRevisionNotFound
+	}
+	for _, revision := range r.store.noteRevisions[taskID] {
+		if revision.ID == id {
+			return &revision, nil
+		}
+	}
+	return nil, domain.ErrNoteRevisionNotFound
+}
+
+// CountArchivable counts the tasks ArchiveCompleted would archive
+func (r *TaskRepository) CountArchivable(ctx context.Context, ownerID string, completedBefore *time.Time) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var count int64
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID || stored.CompletedAt == nil || stored.ArchivedAt != nil {
+			continue
+		}
+		if completedBefore != nil && stored.CompletedAt.After(*completedBefore) {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// GetSettings returns the owner's task settings, or the defaults if never set
+func (r *TaskRepository) GetSettings(ctx context.Context, ownerID string) (*domain.Settings, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	settings := &domain.Settings{RolloverToInbox: r.store.rolloverInbox[ownerID]}
+	if days, ok := r.store.autoArchive[ownerID]; ok {
+		settings.AutoArchiveAfterDays = &days
+	}
+	return settings, nil
+}
+
+// UpdateSettings applies update to the owner's task settings
+func (r *TaskRepository) UpdateSettings(ctx context.Context, ownerID string, update domain.SettingsUpdate) (*domain.Settings, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if days := update.AutoArchiveAfterDays; days != nil {
+		r.store.autoArchive[ownerID] = *days
+	} else {
+		delete(r.store.autoArchive, ownerID)
+	}
+	if toInbox := update.RolloverToInbox; toInbox != nil && *toInbox {
+		r.store.rolloverInbox[ownerID] = true
+	} else if toInbox != nil {
+		delete(r.store.rolloverInbox, ownerID)
+	}
+
+	settings := &domain.Settings{RolloverToInbox: r.store.rolloverInbox[ownerID]}
+	if days, ok := r.store.autoArchive[ownerID]; ok {
+		settings.AutoArchiveAfterDays = &days
+	}
+	return settings, nil
+}
+
+// ListAutoArchivePolicies returns every owner with auto-archiving enabled
+func (r *TaskRepository) ListAutoArchivePolicies(ctx context.Context) ([]domain.AutoArchivePolicy, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	policies := make([]domain.AutoArchivePolicy, 0, len(r.store.autoArchive))
+	for ownerID, days := range r.store.autoArchive {
+		policies = append(policies, domain.AutoArchivePolicy{OwnerID: ownerID, AfterDays: days})
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].OwnerID < policies[j].OwnerID })
+	return policies, nil
+}
+
+// GetStats aggregates task activity, per-tag counts and the open backlog
+func (r *TaskRepository) GetStats(ctx context.Context, ownerID string, since time.Time, bucket domain.StatsBucket) (*domain.Stats, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stats := &domain.Stats{
+		Activity: []domain.ActivityCount{},
+		Tags:     []domain.TagStats{},
+	}
+
+	activity := make(map[time.Time]*domain.ActivityCount)
+	record := func(at *time.Time, apply func(*domain.ActivityCount)) {
+		if at == nil || at.Before(since) {
+			return
+		}
+		start := bucketStart(*at, bucket)
+		count, ok := activity[start]
+		if !ok {
+			count = &domain.ActivityCount{BucketStart: start}
+			activity[start] = count
+		}
+		apply(count)
+	}
+
+	tagCounts := make(map[uuid.UUID]*domain.TagStats)
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID {
+			continue
+		}
+		createdAt := stored.CreatedAt
+		record(&createdAt, func(c *domain.ActivityCount) { c.Created++ })
+		record(stored.CompletedAt, func(c *domain.ActivityCount) { c.Completed++ })
+		record(stored.ArchivedAt, func(c *domain.ActivityCount) { c.Archived++ })
+
+		open := stored.CompletedAt == nil && stored.ArchivedAt == nil
+		if open {
+			stats.BacklogSize++
+		}
+
+		for _, tagID := range stored.TagIDs {
+			tag, ok := r.store.tags[tagID]
+			if !ok || tag.OwnerID != ownerID {
+				continue
+			}
+			counts, ok := tagCounts[tagID]
+			if !ok {
+				counts = &domain.TagStats{TagID: tagID, TagName: tag.Name}
+				tagCounts[tagID] = counts
+			}
+			if open {
+				counts.OpenCount++
+			}
+			if stored.CompletedAt != nil {
+				counts.CompletedCount++
+			}
+		}
+	}
+
+	for _, count := range activity {
+		stats.Activity = append(stats.Activity, *count)
+	}
+	sort.Slice(stats.Activity, func(i, j int) bool {
+		return stats.Activity[i].BucketStart.Before(stats.Activity[j].BucketStart)
+	})
+
+	for _, counts := range tagCounts {
+		stats.Tags = append(stats.Tags, *counts)
+	}
+	sort.Slice(stats.Tags, func(i, j int) bool {
+		return stats.Tags[i].TagName < stats.Tags[j].TagName
+	})
+
+	return stats, nil
+}
+
+// GetWeeklyReview collects the tasks for each weekly review section
+func (r *TaskRepository) GetWeeklyReview(ctx context.Context, ownerID string, opts domain.ReviewOptions) (*domain.WeeklyReview, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	today := dateOnly(&opts.Today)
+	var stale, undated, completed, overdue []*domain.Task
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID {
+			continue
+		}
+		open := stored.CompletedAt == nil && stored.ArchivedAt == nil
+		if open && stored.UpdatedAt.Before(opts.StaleBefore) {
+			stale = append(stale, stored)
+		}
+		if open && stored.StartDate == nil && stored.Deadline == nil {
+			undated = append(undated, stored)
+		}
+		if stored.CompletedAt != nil && !stored.CompletedAt.Before(opts.WeekStart) {
+			completed = append(completed, stored)
+		}
+		if open && stored.Deadline != nil && stored.Deadline.Before(*today) {
+			overdue = append(overdue, stored)
+		}
+	}
+
+	sort.Slice(stale, func(i, j int) bool { return stale[i].UpdatedAt.Before(stale[j].UpdatedAt) })
+	sort.Slice(undated, func(i, j int) bool { return undated[i].CreatedAt.Before(undated[j].CreatedAt) })
+	sort.Slice(completed, func(i, j int) bool { return completed[i].CompletedAt.After(*completed[j].CompletedAt) })
+	sort.Slice(overdue, func(i, j int) bool { return overdue[i].Deadline.Before(*overdue[j].Deadline) })
+
+	resolve := func(section []*domain.Task) []*domain.Task {
+		section = section[:min(len(section), domain.MaxReviewSectionSize)]
+		result := make([]*domain.Task, len(section))
+		for i, stored := range section {
+			result[i] = r.loadTask(stored)
+			result[i].Checklist = r.checklistForTask(stored.ID)
+		}
+		return result
+	}
+
+	return &domain.WeeklyReview{
+		WeekStart:         opts.WeekStart,
+		StaleTasks:        resolve(stale),
+		UndatedTasks:      resolve(undated),
+		CompletedThisWeek: resolve(completed),
+		OverdueTasks:      resolve(overdue),
+	}, nil
+}
+
+// GetCounters counts the owner's open tasks for badges
+func (r *TaskRepository) GetCounters(ctx context.Context, ownerID string, today time.Time) (*domain.Counters, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	day := dateOnly(&today)
+	counters := &domain.Counters{}
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID || stored.CompletedAt != nil || stored.ArchivedAt != nil {
+			continue
+		}
+		if stored.StartDate == nil {
+			counters.Inbox++
+		}
+		switch {
+		case stored.Deadline != nil && stored.Deadline.Before(*day):
+			counters.Overdue++
+		case stored.Deadline != nil && stored.Deadline.Equal(*day),
+			stored.StartDate != nil && !stored.StartDate.After(*day):
+			counters.Today++
+		}
+		if stored.IsNew() || stored.UpdatedSinceViewed() {
+			counters.Unread++
+		}
+	}
+	return counters, nil
+}
+
+// GetDigest collects the tasks for each digest section
+func (r *TaskRepository) GetDigest(ctx context.Context, ownerID string, opts domain.DigestOptions) (*domain.Digest, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	today := dateOnly(&opts.Today)
+	var todays, overdue, completed []*domain.Task
+	for _, stored := range r.store.tasks {
+		if stored.OwnerID != ownerID {
+			continue
+		}
+		open := stored.CompletedAt == nil && stored.ArchivedAt == nil
+		switch {
+		case open && stored.Deadline != nil && stored.Deadline.Before(*today):
+			overdue = append(overdue, stored)
+		case open && stored.Deadline != nil && stored.Deadline.Equal(*today),
+			open && stored.StartDate != nil && !stored.StartDate.After(*today):
+			todays = append(todays, stored)
+		}
+		if stored.CompletedAt != nil && !stored.CompletedAt.Before(opts.CompletedFrom) && stored.CompletedAt.Before(opts.CompletedTo) {
+			completed = append(completed, stored)
+		}
+	}
+
+	sort.Slice(todays, func(i, j int) bool {
+		a, b := todays[i], todays[j]
+		if a.Pinned != b.Pinned {
+			return a.Pinned
+		}
+		if (a.Deadline == nil) != (b.Deadline == nil) {
+			return a.Deadline != nil
+		}
+		if a.Deadline != nil && !a.Deadline.Equal(*b.Deadline) {
+			return a.Deadline.Before(*b.Deadline)
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+	sort.Slice(overdue, func(i, j int) bool { return overdue[i].Deadline.Before(*overdue[j].Deadline) })
+	sort.Slice(completed, func(i, j int) bool { return completed[i].CompletedAt.After(*completed[j].CompletedAt) })
+
+	resolve := func(section []*domain.Task) []*domain.Task {
+		section = section[:min(len(section), domain.MaxDigestSectionSize)]
+		result := make([]*domain.Task, len(section))
+		for i, stored := range section {
+			result[i] = r.loadTask(stored)
+			result[i].Checklist = r.checklistForTask(stored.ID)
+		}
+		return result
+	}
+
+	return &domain.Digest{
+		Today:     resolve(todays),
+		Overdue:   resolve(overdue),
+		Completed: resolve(completed),
+	}, nil
+}
+
+// ListChecklistItems lists checklist items for a task.
+func (r *TaskRepository) ListChecklistItems(ctx context.Context, taskID uuid.UUID, ownerID string) ([]domain.ChecklistItem, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	if _, err := r.ownedTask(taskID, ownerID); err != nil {
+		return []domain.ChecklistItem{}, nil
+	}
+	return r.checklistForTask(taskID), nil
+}
+
+// AddChecklistItem creates a new checklist item at the end of a task's checklist.
+func (r *TaskRepository) AddChecklistItem(ctx context.Context, taskID uuid.UUID, ownerID, content string) (*domain.ChecklistItem, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, err := r.ownedTask(taskID, ownerID); err != nil {
+		return nil, err
+	}
+
+	var sortOrder int32
+	for _, item := range r.store.checklistItems {
+		if item.TaskID == taskID && item.SortOrder >= sortOrder {
+			sortOrder = item.SortOrder + 1
+		}
+	}
+
+	now := time.Now()
+	item := &domain.ChecklistItem{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		Content:   content,
+		SortOrder: sortOrder,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	r.store.checklistItems[item.ID] = item
+
+	created := *item
+	return &created, nil
+}
+
+// UpdateChecklistItemContent updates checklist item text.
+func (r *TaskRepository) UpdateChecklistItemContent(ctx context.Context, itemID uuid.UUID, ownerID, content string) (*domain.ChecklistItem, error) {
+	return r.mutateChecklistItem(itemID, ownerID, func(item *domain.ChecklistItem) {
+		item.Content = content
+	})
+}
+
+// SetChecklistItemCompleted sets checklist completion state.
+func (r *TaskRepository) SetChecklistItemCompleted(ctx context.Context, itemID uuid.UUID, ownerID string, completed bool) (*domain.ChecklistItem, error) {
+	return r.mutateChecklistItem(itemID, ownerID, func(item *domain.ChecklistItem) {
+		item.Completed = completed
+	})
+}
+
+// DeleteChecklistItem deletes a checklist item.
+func (r *TaskRepository) DeleteChecklistItem(ctx context.Context, itemID uuid.UUID, ownerID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, err := r.ownedChecklistItem(itemID, ownerID); err != nil {
+		return err
+	}
+	delete(r.store.checklistItems, itemID)
+	return nil
+}
+
+// ReorderChecklistItems updates checklist item sort order.
+// Items are numbered in the order given; unknown IDs are ignored.
+func (r *TaskRepository) ReorderChecklistItems(ctx context.Context, taskID uuid.UUID, ownerID string, itemIDs []uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, err := r.ownedTask(taskID, ownerID); err != nil {
+		return nil
+	}
+
+	now := time.Now()
+	for i, itemID := range itemIDs {
+		item, ok := r.store.checklistItems[itemID]
+		if !ok || item.TaskID != taskID {
+			continue
+		}
+		item.SortOrder = int32(i)
+		item.UpdatedAt = now
+	}
+	return nil
+}
+
+// mutate applies fn to a stored task, bumps updated_at, attributes the
+// change to by and returns a copy
+func (r *TaskRepository) mutate(id uuid.UUID, ownerID string, by domain.Modifier, fn func(stored *domain.Task, now time.Time)) (*domain.Task, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, err := r.ownedTask(id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	fn(stored, now)
+	stored.UpdatedAt = now
+	stored.LastModifiedBy = by
+	return r.loadTask(stored), nil
+}
+
+// mutateChecklistItem applies fn to a stored checklist item and returns a copy
+func (r *TaskRepository) mutateChecklistItem(itemID uuid.UUID, ownerID string, fn func(item *domain.ChecklistItem)) (*domain.ChecklistItem, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	item, err := r.ownedChecklistItem(itemID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	fn(item)
+	item.UpdatedAt = time.Now()
+
+	updated := *item
+	return &updated, nil
+}
+
+// ownedTask returns the stored task, or domain.ErrTaskNotFound if it does
+// not exist or belongs to another owner. Callers must hold the store lock.
+func (r *TaskRepository) ownedTask(id uuid.UUID, ownerID string) (*domain.Task, error) {
+	stored, ok := r.store.tasks[id]
+	if !ok || stored.OwnerID != ownerID {
+		return nil, domain.ErrTaskNotFound
+	}
+	return stored, nil
+}
+
+// ownedChecklistItem returns the stored checklist item, or
+// domain.ErrChecklistItemNotFound if it does not exist or its task belongs
+// to another owner. Callers must hold the store lock.
+func (r *TaskRepository) ownedChecklistItem(itemID uuid.UUID, ownerID string) (*domain.ChecklistItem, error) {
+	item, ok := r.store.checklistItems[itemID]
+	if !ok {
+		return nil, domain.ErrChecklistItemNotFound
+	}
+	if _, err := r.ownedTask(item.TaskID, ownerID); err != nil {
+		return nil, domain.ErrChecklistItemNotFound
+	}
+	return item, nil
+}
+
+// loadTask copies a stored task for callers, without its checklist.
+// Callers must hold the store lock.
+func (r *TaskRepository) loadTask(stored *domain.Task) *domain.Task {
+	task := cloneTask(stored)
+	if task.TagIDs == nil {
+		task.TagIDs = []uuid.UUID{}
+	}
+	return task
+}
+
+// partialTask copies a stored task without the parts load leaves out
+func (r *TaskRepository) partialTask(stored *domain.Task, load domain.LoadOptions) *domain.Task {
+	task := r.loadTask(stored)
+	if load.SkipNotes {
+		task.Notes = ""
+	}
+	if load.SkipTags {
+		task.TagIDs = []uuid.UUID{}
+	}
+	return task
+}
+
+// checklistForTask returns copies of a task's checklist items in display
+// order. Callers must hold the store lock.
+func (r *TaskRepository) checklistForTask(taskID uuid.UUID) []domain.ChecklistItem {
+	items := []domain.ChecklistItem{}
+	for _, item := range r.store.checklistItems {
+		if item.TaskID == taskID {
+			items = append(items, *item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].SortOrder != items[j].SortOrder {
+			return items[i].SortOrder < items[j].SortOrder
+		}
+		return items[i].CreatedAt.Before(items[j].CreatedAt)
+	})
+	return items
+}
+
+// matchesTagFilters applies the tag-related list filters to a task's tags
+func matchesTagFilters(taskTagIDs, filterTagIDs []uuid.UUID, opts domain.ListOptions) bool {
+	if len(filterTagIDs) > 0 {
+		matched := 0
+		for _, tagID := range filterTagIDs {
+			if slices.Contains(taskTagIDs, tagID) {
+				matched++
+			}
+		}
+		if opts.TagMatchAll && matched != len(filterTagIDs) {
+			return false
+		}
+		if !opts.TagMatchAll && matched == 0 {
+			return false
+		}
+	}
+	for _, tagID := range opts.ExcludeTagIDs {
+		if slices.Contains(taskTagIDs, tagID) {
+			return false
+		}
+	}
+	if opts.UntaggedOnly && len(taskTagIDs) > 0 {
+		return false
+	}
+	return true
+}
+
+// groupKeys returns the groups a task belongs to, or nil when not grouping
+func groupKeys(task *domain.Task, groupBy domain.GroupBy) []string {
+	var date *time.Time
+	switch groupBy {
+	case domain.GroupByStartDate:
+		date = task.StartDate
+	case domain.GroupByDeadline:
+		date = task.Deadline
+	case domain.GroupByTag:
+		if len(task.TagIDs) == 0 {
+			return []string{""}
+		}
+		keys := make([]string, len(task.TagIDs))
+		for i, tagID := range task.TagIDs {
+			keys[i] = tagID.String()
+		}
+		return keys
+	default:
+		return nil
+	}
+	if date == nil {
+		return []string{""}
+	}
+	return []string{date.Format("2006-01-02")}
+}
+
+// bucketStart truncates an instant to the start of its UTC day or ISO week
+func bucketStart(t time.Time, bucket domain.StatsBucket) time.Time {
+	day := *dateOnly(&t)
+	if bucket == domain.StatsBucketWeek {
+		offset := (int(day.Weekday()) + 6) % 7
+		day = day.AddDate(0, 0, -offset)
+	}
+	return day
+}
+
+// cloneTask copies a task so callers cannot mutate stored state
+func cloneTask(task *domain.Task) *domain.Task {
+	copied := *task
+	copied.TagIDs = slices.Clone(task.TagIDs)
+	copied.Checklist = slices.Clone(task.Checklist)
+	copied.ArchivedAt = cloneTime(task.ArchivedAt)
+	copied.StartDate = cloneTime(task.StartDate)
+	copied.Deadline = cloneTime(task.Deadline)
+	copied.CompletedAt = cloneTime(task.CompletedAt)
+	copied.LastViewedAt = cloneTime(task.LastViewedAt)
+	if task.Geofence != nil {
+		geofence := *task.Geofence
+		copied.Geofence = &geofence
+	}
+	return &copied
+}
+
+// compareTriggerCursors orders cursors by time, then task ID, then tag ID
+func compareTriggerCursors(a, b domain.TriggerCursor) int {
+	if c := a.OccurredAt.Compare(b.OccurredAt); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(a.TaskID[:], b.TaskID[:]); c != 0 {
+		return c
+	}
+	return bytes.Compare(a.TagID[:], b.TagID[:])
+}
+
+// dedupeIDs removes duplicate IDs while preserving order
+func dedupeIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
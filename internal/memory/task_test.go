@@ -0,0 +1,778 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	admindomain "github.com/slips-ai/slips-core/internal/admin/domain"
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	feeddomain "github.com/slips-ai/slips-core/internal/feed/domain"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+func createTask(t *testing.T, repo *TaskRepository, title string, tagIDs []uuid.UUID) *domain.Task {
+	t.Helper()
+	task := domain.NewTask(title, "", "owner", tagIDs)
+	if err := repo.Create(context.Background(), task); err != nil {
+		t.Fatalf("create %q: %v", title, err)
+	}
+	return task
+}
+
+func TestTaskRepository_GetOtherOwnerReturnsNotFound(t *testing.T) {
+	repo := NewTaskRepository(NewStore())
+	task := createTask(t, repo, "mine", nil)
+
+	_, err := repo.Get(context.Background(), task.ID, "someone-else")
+	if !errors.Is(err, domain.ErrTaskNotFound) {
+		t.Fatalf("expected ErrTaskNotFound, got %v", err)
+	}
+}
+
+func TestTaskRepository_ListFiltersAndOrders(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository(NewStore())
+	tagID := uuid.New()
+
+	first := createTask(t, repo, "first", []uuid.UUID{tagID})
+	time.Sleep(time.Millisecond)
+	second := createTask(t, repo, "second", nil)
+	time.Sleep(time.Millisecond)
+	archived := createTask(t, repo, "archived", []uuid.UUID{tagID})
+	if _, err := repo.Archive(ctx, archived.ID, "owner", domain.Modifier{}); err != nil {
+		t.Fatalf("archive: %v", err)
+	}
+	if _, err := repo.TogglePin(ctx, first.ID, "owner", domain.Modifier{}); err != nil {
+		t.Fatalf("pin: %v", err)
+	}
+
+	result, err := repo.List(ctx, "owner", nil, 10, 0, domain.ListOptions{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if result.TotalSize != 2 || len(result.Tasks) != 2 {
+		t.Fatalf("expected 2 active tasks, got total=%d len=%d", result.TotalSize, len(result.Tasks))
+	}
+	if result.Tasks[0].ID != first.ID || result.Tasks[1].ID != second.ID {
+		t.Fatalf("expected pinned task first, got %q then %q", result.Tasks[0].Title, result.Tasks[1].Title)
+	}
+
+	result, err = repo.List(ctx, "owner", []uuid.UUID{tagID}, 10, 0, domain.ListOptions{IncludeArchived: true})
+	if err != nil {
+		t.Fatalf("list by tag: %v", err)
+	}
+	if result.TotalSize != 2 {
+		t.Fatalf("expected 2 tagged tasks including archived, got %d", result.TotalSize)
+	}
+
+	result, err = repo.List(ctx, "owner", nil, 10, 0, domain.ListOptions{UntaggedOnly: true})
+	if err != nil {
+		t.Fatalf("list untagged: %v", err)
+	}
+	if len(result.Tasks) != 1 || result.Tasks[0].ID != second.ID {
+		t.Fatalf("expected only the untagged task, got %d tasks", len(result.Tasks))
+	}
+}
+
+func TestTaskRepository_ListArchiveRangeAndOrder(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository(NewStore())
+
+	var archiveTimes []time.Time
+	for _, title := range []string{"oldest", "middle", "newest"} {
+		task := createTask(t, repo, title, nil)
+		archived, err := repo.Archive(ctx, task.ID, "owner", domain.Modifier{})
+		if err != nil {
+			t.Fatalf("archive: %v", err)
+		}
+		archiveTimes = append(archiveTimes, *archived.ArchivedAt)
+		time.Sleep(time.Millisecond)
+	}
+	createTask(t, repo, "open", nil)
+
+	titles := func(opts domain.ListOptions) []string {
+		t.Helper()
+		result, err := repo.List(ctx, "owner", nil, 10, 0, opts)
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		var titles []string
+		for _, task := range result.Tasks {
+			titles = append(titles, task.Title)
+		}
+		return titles
+	}
+
+	got := titles(domain.ListOptions{ArchivedOnly: true, Order: domain.ListOrderArchivedAsc})
+	if fmt.Sprint(got) != "[oldest middle newest]" {
+		t.Errorf("ascending archive order = %v", got)
+	}
+	got = titles(domain.ListOptions{IncludeArchived: true, Order: domain.ListOrderArchivedDesc})
+	if fmt.Sprint(got) != "[newest middle oldest open]" {
+		t.Errorf("descending archive order = %v, want tasks not archived last", got)
+	}
+
+	// The range includes its lower bound but not its upper bound
+	got = titles(domain.ListOptions{
+		ArchivedOnly:   true,
+		ArchivedAfter:  &archiveTimes[1],
+		ArchivedBefore: &archiveTimes[2],
+		Order:          domain.ListOrderArchivedDesc,
+	})
+	if fmt.Sprint(got) != "[middle]" {
+		t.Errorf("archive range = %v, want [middle]", got)
+	}
+}
+
+func TestTaskRepository_ListGroupCountsCoverAllPages(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository(NewStore())
+	d := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		task := domain.NewTask("dated", "", "owner", nil)
+		task.SetStartDate(&d)
+		if err := repo.Create(ctx, task); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+
+	result, err := repo.List(ctx, "owner", nil, 1, 0, domain.ListOptions{GroupBy: domain.GroupByStartDate})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(result.Groups) != 1 || result.Groups[0].Key != "2025-06-15" || result.Groups[0].Count != 3 {
+		t.Fatalf("expected one group 2025-06-15 with 3 tasks, got %+v", result.Groups)
+	}
+}
+
+func TestTaskRepository_ListQueryMatchesWildcardsLiterally(t *testing.T) {
+	repo := NewTaskRepository(NewStore())
+	createTask(t, repo, "Save 50% on tickets", nil)
+	createTask(t, repo, "Save 500 on tickets", nil)
+	createTask(t, repo, "rename my_file", nil)
+	createTask(t, repo, "rename myXfile", nil)
+	createTask(t, repo, `C:\temp`, nil)
+
+	for query, want := range map[string]int{"50%": 1, "MY_F": 1, `:\t`: 1, "%": 1, "_": 1} {
+		result, err := repo.List(context.Background(), "owner", nil, 10, 0, domain.ListOptions{Query: query})
+		if err != nil {
+			t.Fatalf("list %q: %v", query, err)
+		}
+		if result.TotalSize != want {
+			t.Errorf("query %q matched %d tasks, want %d", query, result.TotalSize, want)
+		}
+	}
+}
+
+func TestTaskRepository_ListGroupByTag(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository(NewStore())
+	work, home := uuid.New(), uuid.New()
+	createTask(t, repo, "both", []uuid.UUID{work, home})
+	createTask(t, repo, "work", []uuid.UUID{work})
+	createTask(t, repo, "untagged", nil)
+
+	result, err := repo.List(ctx, "owner", nil, 10, 0, domain.ListOptions{
+		GroupBy: domain.GroupByTag,
+		Load:    domain.LoadOptions{SkipTags: true},
+	})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	counts := make(map[string]int)
+	for _, group := range result.Groups {
+		counts[group.Key] = group.Count
+	}
+	want := map[string]int{work.String(): 2, home.String(): 1, "": 1}
+	if fmt.Sprint(counts) != fmt.Sprint(want) {
+		t.Errorf("tag groups = %v, want %v", counts, want)
+	}
+	for _, task := range result.Tasks {
+		if len(task.TagIDs) != 0 {
+			t.Errorf("task %q tags = %v, want none with SkipTags", task.Title, task.TagIDs)
+		}
+	}
+}
+
+func TestTaskRepository_ListTotalSizePastLastPage(t *testing.T) {
+	repo := NewTaskRepository(NewStore())
+	createTask(t, repo, "a", nil)
+	createTask(t, repo, "b", nil)
+
+	result, err := repo.List(context.Background(), "owner", nil, 10, 10, domain.ListOptions{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(result.Tasks) != 0 || result.TotalSize != 2 {
+		t.Errorf("page past the end = %d tasks of %d, want 0 of 2", len(result.Tasks), result.TotalSize)
+	}
+}
+
+func TestTaskRepository_DeleteRecordsTombstone(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository(NewStore())
+	before := time.Now().Add(-time.Second)
+	task := createTask(t, repo, "gone", nil)
+
+	if err := repo.Delete(ctx, task.ID, "owner"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	tombstones, err := repo.ListTombstones(ctx, "owner", before, nil, 10)
+	if err != nil {
+		t.Fatalf("list tombstones: %v", err)
+	}
+	if len(tombstones) != 1 || tombstones[0].TaskID != task.ID {
+		t.Fatalf("expected tombstone for %s, got %+v", task.ID, tombstones)
+	}
+}
+
+func TestTaskRepository_ListTombstonesPages(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository(NewStore())
+	before := time.Now().Add(-time.Second)
+	deleted := make(map[uuid.UUID]bool)
+	for i := 0; i < 5; i++ {
+		task := createTask(t, repo, fmt.Sprintf("gone %d", i), nil)
+		if err := repo.Delete(ctx, task.ID, "owner"); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		deleted[task.ID] = true
+	}
+
+	var after *domain.TombstoneCursor
+	seen := 0
+	for {
+		page, err := repo.ListTombstones(ctx, "owner", before, after, 2)
+		if err != nil {
+			t.Fatalf("list tombstones: %v", err)
+		}
+		for _, tombstone := range page {
+			if !deleted[tombstone.TaskID] {
+				t.Fatalf("tombstone %s listed twice or unknown", tombstone.TaskID)
+			}
+			delete(deleted, tombstone.TaskID)
+			seen++
+		}
+		if len(page) < 2 {
+			break
+		}
+		cursor, err := domain.ParseTombstoneCursor(page[len(page)-1].Cursor().Encode())
+		if err != nil {
+			t.Fatalf("parse cursor: %v", err)
+		}
+		after = cursor
+	}
+	if seen != 5 {
+		t.Errorf("paged through %d tombstones, want 5", seen)
+	}
+}
+
+func TestTaskRepository_ListAfterWalksAllTasks(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository(NewStore())
+	for i := 0; i < 5; i++ {
+		createTask(t, repo, "task", nil)
+	}
+	archived := createTask(t, repo, "archived", nil)
+	if _, err := repo.Archive(ctx, archived.ID, "owner", domain.Modifier{}); err != nil {
+		t.Fatalf("archive: %v", err)
+	}
+
+	var after uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for {
+		page, err := repo.ListAfter(ctx, "owner", after, 2, domain.ScanOptions{})
+		if err != nil {
+			t.Fatalf("list after: %v", err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		for _, task := range page {
+			if seen[task.ID] {
+				t.Fatalf("task %s returned twice", task.ID)
+			}
+			seen[task.ID] = true
+		}
+		after = page[len(page)-1].ID
+	}
+	if len(seen) != 5 || seen[archived.ID] {
+		t.Fatalf("expected the 5 active tasks, got %d (archived included: %t)", len(seen), seen[archived.ID])
+	}
+
+	page, err := repo.ListAfter(ctx, "owner", uuid.Nil, 10, domain.ScanOptions{ArchivedOnly: true})
+	if err != nil {
+		t.Fatalf("list archived: %v", err)
+	}
+	if len(page) != 1 || page[0].ID != archived.ID {
+		t.Fatalf("expected only the archived task, got %d tasks", len(page))
+	}
+}
+
+func TestTagRepository_DeleteRemovesTagFromTasks(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	tasks := NewTaskRepository(store)
+	tags := NewTagRepository(store)
+
+	tag := &tagdomain.Tag{Name: "work", OwnerID: "owner"}
+	if err := tags.Create(ctx, tag); err != nil {
+		t.Fatalf("create tag: %v", err)
+	}
+	task := createTask(t, tasks, "tagged", []uuid.UUID{tag.ID})
+
+	affected, err := tags.Delete(ctx, tag.ID, "owner", nil, domain.Modifier{})
+	if err != nil {
+		t.Fatalf("delete tag: %v", err)
+	}
+	if affected != 1 {
+		t.Errorf("affected = %d, want 1", affected)
+	}
+
+	got, err := tasks.Get(ctx, task.ID, "owner")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if len(got.TagIDs) != 0 {
+		t.Fatalf("expected tag associations to be removed, got %v", got.TagIDs)
+	}
+}
+
+func TestTagRepository_DeleteReassignsTasks(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	tasks := NewTaskRepository(store)
+	tags := NewTagRepository(store)
+
+	old := &tagdomain.Tag{Name: "q2", OwnerID: "owner"}
+	replacement := &tagdomain.Tag{Name: "q3", OwnerID: "owner"}
+	for _, tag := range []*tagdomain.Tag{old, replacement} {
+		if err := tags.Create(ctx, tag); err != nil {
+			t.Fatalf("create tag: %v", err)
+		}
+	}
+	moved := createTask(t, tasks, "moved", []uuid.UUID{old.ID})
+	both := createTask(t, tasks, "both", []uuid.UUID{old.ID, replacement.ID})
+
+	by := domain.Modifier{Source: domain.ChangeSourceAgent}
+	affected, err := tags.Delete(ctx, old.ID, "owner", &replacement.ID, by)
+	if err != nil {
+		t.Fatalf("delete tag: %v", err)
+	}
+	if affected != 2 {
+		t.Errorf("affected = %d, want 2", affected)
+	}
+
+	got, err := tasks.Get(ctx, moved.ID, "owner")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if fmt.Sprint(got.TagIDs) != fmt.Sprint([]uuid.UUID{replacement.ID}) || got.LastModifiedBy != by {
+		t.Errorf("reassigned task has tags %v, modified by %+v", got.TagIDs, got.LastModifiedBy)
+	}
+	got, err = tasks.Get(ctx, both.ID, "owner")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if len(got.TagIDs) != 1 || got.LastModifiedBy == by {
+		t.Errorf("task that already had the tag has tags %v, modified by %+v", got.TagIDs, got.LastModifiedBy)
+	}
+}
+
+func TestTaskRepository_GetTagActivity(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository(NewStore())
+	tagID := uuid.New()
+
+	createTask(t, repo, "older", []uuid.UUID{tagID})
+	done := createTask(t, repo, "done", []uuid.UUID{tagID})
+	createTask(t, repo, "untagged", nil)
+	time.Sleep(time.Millisecond)
+	if _, err := repo.Complete(ctx, done.ID, "owner", domain.Modifier{}); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+
+	activity, err := repo.GetTagActivity(ctx, "owner", tagID, 1)
+	if err != nil {
+		t.Fatalf("get tag activity: %v", err)
+	}
+	if activity.OpenCount != 1 || activity.CompletedCount != 1 {
+		t.Errorf("counts = %d open, %d completed, want 1 and 1", activity.OpenCount, activity.CompletedCount)
+	}
+	if len(activity.RecentTasks) != 1 || activity.RecentTasks[0].ID != done.ID {
+		t.Errorf("recent tasks = %v, want only the completed task", activity.RecentTasks)
+	}
+
+	activity, err = repo.GetTagActivity(ctx, "owner", tagID, 0)
+	if err != nil {
+		t.Fatalf("get tag activity: %v", err)
+	}
+	if len(activity.RecentTasks) != 0 {
+		t.Errorf("got %d recent tasks without asking for any", len(activity.RecentTasks))
+	}
+}
+
+func TestTagRepository_DuplicateNameIsUniqueViolation(t *testing.T) {
+	ctx := context.Background()
+	tags := NewTagRepository(NewStore())
+
+	if err := tags.Create(ctx, &tagdomain.Tag{Name: "work", OwnerID: "owner"}); err != nil {
+		t.Fatalf("create tag: %v", err)
+	}
+	err := tags.Create(ctx, &tagdomain.Tag{Name: "work", OwnerID: "owner"})
+	if !errors.Is(err, tagdomain.ErrTagNameConflict) {
+		t.Fatalf("duplicate tag name error = %v, want ErrTagNameConflict", err)
+	}
+	if err := tags.Create(ctx, &tagdomain.Tag{Name: "work", OwnerID: "other"}); err != nil {
+		t.Fatalf("expected the same name to be allowed for another owner: %v", err)
+	}
+}
+
+func TestTagRepository_GetOrCreateMany(t *testing.T) {
+	ctx := context.Background()
+	tags := NewTagRepository(NewStore())
+
+	existing, err := tags.GetOrCreate(ctx, "work", "owner")
+	if err != nil {
+		t.Fatalf("create tag: %v", err)
+	}
+
+	results, err := tags.GetOrCreateMany(ctx, []string{"home", "work", "home"}, "owner")
+	if err != nil {
+		t.Fatalf("get or create tags: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	if !results[0].Created || results[0].Tag.Name != "home" {
+		t.Errorf("first result = %+v, want home created", results[0])
+	}
+	if results[1].Created || results[1].Tag.ID != existing.ID {
+		t.Errorf("second result = %+v, want the existing work tag", results[1])
+	}
+	if results[2].Created || results[2].Tag.ID != results[0].Tag.ID {
+		t.Errorf("repeated name = %+v, want the tag created for its first occurrence", results[2])
+	}
+}
+
+func TestAdminRepository_ApplyTagNameChangesMergesReferences(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	tasks := NewTaskRepository(store)
+	tags := NewTagRepository(store)
+	feeds := NewFeedRepository(store)
+	filters := NewSavedFilterRepository(store)
+	admin := NewAdminRepository(store)
+
+	keep, err := tags.GetOrCreate(ctx, "work", "owner")
+	if err != nil {
+		t.Fatalf("create tag: %v", err)
+	}
+	dup, err := tags.GetOrCreate(ctx, "work ", "owner")
+	if err != nil {
+		t.Fatalf("create tag: %v", err)
+	}
+	both := createTask(t, tasks, "both", []uuid.UUID{keep.ID, dup.ID})
+	onlyDup := createTask(t, tasks, "only dup", []uuid.UUID{dup.ID})
+
+	feed, token, err := feeddomain.NewFeed("work", "owner", &dup.ID, nil)
+	if err != nil {
+		t.Fatalf("new feed: %v", err)
+	}
+	if err := feeds.Create(ctx, feed); err != nil {
+		t.Fatalf("create feed: %v", err)
+	}
+	filter := savedfilterdomain.NewSavedFilter("work", "owner", savedfilterdomain.Criteria{TagIDs: []uuid.UUID{dup.ID, keep.ID}})
+	if err := filters.Create(ctx, filter); err != nil {
+		t.Fatalf("create saved filter: %v", err)
+	}
+
+	names, err := admin.ListTagNames(ctx)
+	if err != nil {
+		t.Fatalf("list tag names: %v", err)
+	}
+	report := admindomain.PlanTagNormalization(names)
+	if report.Merged != 1 || report.Renamed != 0 {
+		t.Fatalf("merged %d and renamed %d, want 1 and 0", report.Merged, report.Renamed)
+	}
+
+	reassigned, err := admin.ApplyTagNameChanges(ctx, report.Changes, domain.Modifier{Source: domain.ChangeSourceSystem})
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if reassigned != 1 {
+		t.Errorf("reassigned %d tasks, want only the one without the kept tag", reassigned)
+	}
+	if _, err := tags.Get(ctx, dup.ID, "owner"); !errors.Is(err, tagdomain.ErrTagNotFound) {
+		t.Errorf("merged tag still exists: %v", err)
+	}
+	for _, id := range []uuid.UUID{both.ID, onlyDup.ID} {
+		task, err := tasks.Get(ctx, id, "owner")
+		if err != nil {
+			t.Fatalf("get task: %v", err)
+		}
+		if len(task.TagIDs) != 1 || task.TagIDs[0] != keep.ID {
+			t.Errorf("task %q tags = %v, want only the kept tag", task.Title, task.TagIDs)
+		}
+	}
+	storedFeed, err := feeds.GetByTokenHash(ctx, feeddomain.HashToken(token))
+	if err != nil {
+		t.Fatalf("get feed: %v", err)
+	}
+	if storedFeed.TagID == nil || *storedFeed.TagID != keep.ID {
+		t.Errorf("feed tag = %v, want the kept tag", storedFeed.TagID)
+	}
+	storedFilter, err := filters.Get(ctx, filter.ID, "owner")
+	if err != nil {
+		t.Fatalf("get saved filter: %v", err)
+	}
+	if len(storedFilter.Criteria.TagIDs) != 1 || storedFilter.Criteria.TagIDs[0] != keep.ID {
+		t.Errorf("saved filter tags = %v, want only the kept tag", storedFilter.Criteria.TagIDs)
+	}
+
+	if _, err := admin.ApplyTagNameChanges(ctx, report.Changes, domain.Modifier{}); !errors.Is(err, admindomain.ErrTagNamesChanged) {
+		t.Errorf("reapplying a stale plan = %v, want ErrTagNamesChanged", err)
+	}
+}
+
+func TestTagRepository_DeleteExpiredOrphansHonoursSettings(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	tasks := NewTaskRepository(store)
+	tags := NewTagRepository(store)
+
+	owners := []string{"immediate", "after-days", "never"}
+	orphans := make(map[string]*tagdomain.Tag)
+	for _, owner := range owners {
+		tag := &tagdomain.Tag{Name: "unused", OwnerID: owner}
+		if err := tags.Create(ctx, tag); err != nil {
+			t.Fatalf("create tag: %v", err)
+		}
+		orphans[owner] = tag
+	}
+	used := &tagdomain.Tag{Name: "used", OwnerID: "owner"}
+	if err := tags.Create(ctx, used); err != nil {
+		t.Fatalf("create tag: %v", err)
+	}
+	createTask(t, tasks, "tagged", []uuid.UUID{used.ID})
+
+	if err := tags.SetSettings(ctx, "after-days", &tagdomain.Settings{
+		OrphanCleanup:          tagdomain.OrphanCleanupAfterDays,
+		OrphanCleanupAfterDays: 7,
+	}); err != nil {
+		t.Fatalf("set settings: %v", err)
+	}
+	if err := tags.SetSettings(ctx, "never", &tagdomain.Settings{OrphanCleanup: tagdomain.OrphanCleanupNever}); err != nil {
+		t.Fatalf("set settings: %v", err)
+	}
+
+	orphaned, _, err := tags.MarkOrphans(ctx)
+	if err != nil || orphaned != 3 {
+		t.Fatalf("MarkOrphans() = %d, %v; want 3 orphans", orphaned, err)
+	}
+
+	exists := func(tag *tagdomain.Tag) bool {
+		_, err := tags.Get(ctx, tag.ID, tag.OwnerID)
+		return err == nil
+	}
+
+	// Tags found orphaned just now may be about to be used by a task
+	if deleted, err := tags.DeleteExpiredOrphans(ctx, time.Now()); err != nil || deleted != 0 {
+		t.Fatalf("DeleteExpiredOrphans(now) = %d, %v; want 0", deleted, err)
+	}
+
+	// Only the default policy deletes once the orphans are old enough
+	if deleted, err := tags.DeleteExpiredOrphans(ctx, time.Now().Add(tagdomain.MinOrphanAge)); err != nil || deleted != 1 {
+		t.Fatalf("DeleteExpiredOrphans(+MinOrphanAge) = %d, %v; want 1", deleted, err)
+	}
+	if exists(orphans["immediate"]) || !exists(orphans["after-days"]) || !exists(orphans["never"]) || !exists(used) {
+		t.Fatal("unexpected tags deleted right away")
+	}
+
+	// After the grace period only "never" keeps its orphan
+	if deleted, err := tags.DeleteExpiredOrphans(ctx, time.Now().AddDate(0, 0, 8)); err != nil || deleted != 1 {
+		t.Fatalf("DeleteExpiredOrphans(+8d) = %d, %v; want 1", deleted, err)
+	}
+	if exists(orphans["after-days"]) || !exists(orphans["never"]) || !exists(used) {
+		t.Fatal("unexpected tags deleted after the grace period")
+	}
+}
+
+func TestTaskRepository_UpdateKeepsNoteRevisions(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository(NewStore())
+	task := createTask(t, repo, "notes", nil)
+
+	for i := 0; i <= domain.MaxNoteRevisions+1; i++ {
+		task.Notes = fmt.Sprintf("v%d", i)
+		if err := repo.Update(ctx, task); err != nil {
+			t.Fatalf("update %d: %v", i, err)
+		}
+	}
+	// Unchanged notes are not snapshotted.
+	if err := repo.Update(ctx, task); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	revisions, err := repo.ListNoteRevisions(ctx, task.ID, "owner")
+	if err != nil {
+		t.Fatalf("list revisions: %v", err)
+	}
+	if len(revisions) != domain.MaxNoteRevisions {
+		t.Fatalf("expected %d revisions, got %d", domain.MaxNoteRevisions, len(revisions))
+	}
+	if want := fmt.Sprintf("v%d", domain.MaxNoteRevisions); revisions[0].Notes != want {
+		t.Errorf("newest revision = %q, want %q", revisions[0].Notes, want)
+	}
+
+	if _, err := repo.GetNoteRevision(ctx, revisions[0].ID, task.ID, "someone-else"); !errors.Is(err, domain.ErrNoteRevisionNotFound) {
+		t.Errorf("expected ErrNoteRevisionNotFound for other owner, got %v", err)
+	}
+}
+
+func TestRepositories_CreateWithClientRequestIDIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	tasks := NewTaskRepository(store)
+	tags := NewTagRepository(store)
+
+	first := domain.NewTask("offline", "", "owner", nil)
+	first.ClientRequestID = "local-1"
+	if err := tasks.Create(ctx, first); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	retry := domain.NewTask("offline, retried", "", "owner", nil)
+	retry.ClientRequestID = "local-1"
+	if err := tasks.Create(ctx, retry); err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if retry.ID != first.ID || retry.Title != "offline" {
+		t.Errorf("retry created %s %q, want existing task %s", retry.ID, retry.Title, first.ID)
+	}
+	other := domain.NewTask("other user", "", "someone-else", nil)
+	other.ClientRequestID = "local-1"
+	if err := tasks.Create(ctx, other); err != nil || other.ID == first.ID {
+		t.Errorf("client request IDs must be scoped per owner, got %s, %v", other.ID, err)
+	}
+
+	tag := &tagdomain.Tag{Name: "home", OwnerID: "owner", ClientRequestID: "local-2"}
+	if err := tags.Create(ctx, tag); err != nil {
+		t.Fatalf("create tag: %v", err)
+	}
+	retriedTag := &tagdomain.Tag{Name: "home", OwnerID: "owner", ClientRequestID: "local-2"}
+	if err := tags.Create(ctx, retriedTag); err != nil || retriedTag.ID != tag.ID {
+		t.Errorf("retried tag = %s, %v; want existing tag %s", retriedTag.ID, err, tag.ID)
+	}
+}
+
+func TestTaskRepository_SetGeofence(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository(NewStore())
+	task := createTask(t, repo, "buy milk", nil)
+	time.Sleep(time.Millisecond)
+
+	geofence := &domain.Geofence{Latitude: 52.52, Longitude: 13.405, RadiusMeters: 150, OnArrive: true}
+	by := domain.Modifier{Source: domain.ChangeSourceUser, ClientID: "phone"}
+	updated, err := repo.SetGeofence(ctx, task.ID, "owner", geofence, by)
+	if err != nil {
+		t.Fatalf("set geofence: %v", err)
+	}
+	if updated.Geofence == nil || *updated.Geofence != *geofence {
+		t.Fatalf("geofence = %+v, want %+v", updated.Geofence, geofence)
+	}
+	if !updated.UpdatedAt.After(task.UpdatedAt) || updated.LastModifiedBy != by {
+		t.Errorf("task not stamped as updated by the phone: %+v", updated)
+	}
+
+	// The stored geofence is a copy, and listed tasks carry it for syncs
+	geofence.RadiusMeters = 1000
+	listed, err := repo.List(ctx, "owner", nil, 10, 0, domain.ListOptions{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(listed.Tasks) != 1 || listed.Tasks[0].Geofence == nil || listed.Tasks[0].Geofence.RadiusMeters != 150 {
+		t.Fatalf("listed tasks = %+v, want the geofence with radius 150", listed.Tasks)
+	}
+
+	if _, err := repo.SetGeofence(ctx, task.ID, "someone-else", geofence, by); !errors.Is(err, domain.ErrTaskNotFound) {
+		t.Errorf("set geofence by other owner: expected ErrTaskNotFound, got %v", err)
+	}
+
+	cleared, err := repo.SetGeofence(ctx, task.ID, "owner", nil, by)
+	if err != nil {
+		t.Fatalf("clear geofence: %v", err)
+	}
+	if cleared.Geofence != nil {
+		t.Errorf("geofence after clearing = %+v, want nil", cleared.Geofence)
+	}
+}
+
+func TestTaskRepository_ListStaleSkipsViewedTasks(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository(NewStore())
+
+	viewed := createTask(t, repo, "viewed", nil)
+	untouched := createTask(t, repo, "untouched", nil)
+	completed := createTask(t, repo, "completed", nil)
+	if _, err := repo.Complete(ctx, completed.ID, "owner", domain.Modifier{}); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+
+	time.Sleep(time.Millisecond)
+	cutoff := time.Now()
+	time.Sleep(time.Millisecond)
+
+	if err := repo.MarkViewed(ctx, viewed.ID, "owner"); err != nil {
+		t.Fatalf("mark viewed: %v", err)
+	}
+	if err := repo.MarkViewed(ctx, viewed.ID, "someone-else"); !errors.Is(err, domain.ErrTaskNotFound) {
+		t.Fatalf("mark viewed by other owner: expected ErrTaskNotFound, got %v", err)
+	}
+
+	stale, err := repo.ListStale(ctx, "owner", cutoff, 10)
+	if err != nil {
+		t.Fatalf("list stale: %v", err)
+	}
+	if len(stale) != 1 || stale[0].ID != untouched.ID {
+		t.Fatalf("stale tasks = %v, want only %q", stale, untouched.Title)
+	}
+	// Viewing does not count as an update
+	if got, _ := repo.Get(ctx, viewed.ID, "owner"); !got.UpdatedAt.Before(cutoff) {
+		t.Errorf("viewing bumped updated_at to %v", got.UpdatedAt)
+	}
+}
+
+func TestUserRepository_UpsertUserRefreshesProfile(t *testing.T) {
+	ctx := context.Background()
+	users := NewUserRepository(NewStore())
+
+	if _, err := users.UpsertUser(ctx, authdomain.NewUser("ada", "ada", "https://example.com/old.png", "ada@example.com")); err != nil {
+		t.Fatalf("upsert user: %v", err)
+	}
+	got, err := users.UpsertUser(ctx, authdomain.NewUser("ada", "ada-lovelace", "https://example.com/new.png", ""))
+	if err != nil {
+		t.Fatalf("upsert user: %v", err)
+	}
+	if got.Username != "ada-lovelace" || got.AvatarURL != "https://example.com/new.png" || got.Email != "ada@example.com" {
+		t.Errorf("user after login = %+v, want the new username and avatar and the kept email", got)
+	}
+
+	anonymized, err := users.AnonymizeUser(ctx, "ada")
+	if err != nil {
+		t.Fatalf("anonymize user: %v", err)
+	}
+	got, err = users.UpsertUser(ctx, authdomain.NewUser("ada", "ada", "https://example.com/new.png", "ada@example.com"))
+	if err != nil {
+		t.Fatalf("upsert user: %v", err)
+	}
+	if got.Username != anonymized.Username || got.AvatarURL != "" || got.Email != "" {
+		t.Errorf("anonymized user after login = %+v, want the profile left anonymized", got)
+	}
+}
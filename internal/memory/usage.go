@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/usage/domain"
+)
+
+// UsageRepository implements domain.Repository in memory
+type UsageRepository struct {
+	store *Store
+}
+
+// NewUsageRepository creates a new in-memory usage repository
+func NewUsageRepository(store *Store) *UsageRepository {
+	return &UsageRepository{
+		store: store,
+	}
+}
+
+// AddUsage adds counts to the stored counts of their keys
+func (r *UsageRepository) AddUsage(ctx context.Context, counts map[domain.Key]domain.Counts) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for key, count := range counts {
+		stored := r.store.apiUsage[key]
+		stored.Requests += count.Requests
+		stored.RateLimited += count.RateLimited
+		r.store.apiUsage[key] = stored
+	}
+	return nil
+}
+
+// ListUsage returns the usage of a user from one UTC day to another
+func (r *UsageRepository) ListUsage(ctx context.Context, userID string, from, to time.Time) ([]*domain.Day, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	days := []*domain.Day{}
+	for key, count := range r.store.apiUsage {
+		if key.UserID != userID || key.Day.Before(from) || key.Day.After(to) {
+			continue
+		}
+		days = append(days, &domain.Day{
+			Day:         key.Day,
+			MethodClass: key.MethodClass,
+			AuthType:    key.AuthType,
+			Counts:      count,
+		})
+	}
+	slices.SortFunc(days, func(a, b *domain.Day) int {
+		return cmp.Or(
+			a.Day.Compare(b.Day),
+			cmp.Compare(a.MethodClass, b.MethodClass),
+			cmp.Compare(a.AuthType, b.AuthType),
+		)
+	})
+	return days, nil
+}
+
+// PurgeUsage deletes the usage of days before a UTC day
+func (r *UsageRepository) PurgeUsage(ctx context.Context, before time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var purged int64
+	for key := range r.store.apiUsage {
+		if key.Day.Before(before) {
+			delete(r.store.apiUsage, key)
+			purged++
+		}
+	}
+	return purged, nil
+}
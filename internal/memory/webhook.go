@@ -0,0 +1,132 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/webhook/domain"
+)
+
+// WebhookRepository implements domain.Repository in memory
+type WebhookRepository struct {
+	store *Store
+}
+
+// NewWebhookRepository creates a new in-memory webhook repository
+func NewWebhookRepository(store *Store) *WebhookRepository {
+	return &WebhookRepository{
+		store: store,
+	}
+}
+
+// Create creates a new webhook
+func (r *WebhookRepository) Create(ctx context.Context, webhook *domain.Webhook) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.webhooks[webhook.ID]; ok {
+		return uniqueViolation("webhooks_pkey")
+	}
+
+	now := time.Now()
+	webhook.CreatedAt = now
+	webhook.UpdatedAt = now
+
+	r.store.webhooks[webhook.ID] = cloneWebhook(webhook)
+	return nil
+}
+
+// Get retrieves a webhook by ID
+func (r *WebhookRepository) Get(ctx context.Context, id uuid.UUID, ownerID string) (*domain.Webhook, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.webhooks[id]
+	if !ok || stored.OwnerID != ownerID {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return cloneWebhook(stored), nil
+}
+
+// GetForDelivery retrieves a webhook by ID regardless of its owner
+func (r *WebhookRepository) GetForDelivery(ctx context.Context, id uuid.UUID) (*domain.Webhook, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stored, ok := r.store.webhooks[id]
+	if !ok {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return cloneWebhook(stored), nil
+}
+
+// Update updates the name, template and secret of a webhook
+func (r *WebhookRepository) Update(ctx context.Context, webhook *domain.Webhook) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	stored, ok := r.store.webhooks[webhook.ID]
+	if !ok || stored.OwnerID != webhook.OwnerID {
+		return domain.ErrWebhookNotFound
+	}
+
+	webhook.UpdatedAt = time.Now()
+	updated := cloneWebhook(webhook)
+	updated.CreatedAt = stored.CreatedAt
+	updated.LastDeliveredAt = cloneTime(stored.LastDeliveredAt)
+	r.store.webhooks[webhook.ID] = updated
+	return nil
+}
+
+// Delete deletes a webhook
+func (r *WebhookRepository) Delete(ctx context.Context, id uuid.UUID, ownerID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.webhooks[id]; ok && stored.OwnerID == ownerID {
+		delete(r.store.webhooks, id)
+	}
+	return nil
+}
+
+// List lists the owner's webhooks ordered by name
+func (r *WebhookRepository) List(ctx context.Context, ownerID string) ([]*domain.Webhook, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var webhooks []*domain.Webhook
+	for _, stored := range r.store.webhooks {
+		if stored.OwnerID == ownerID {
+			webhooks = append(webhooks, cloneWebhook(stored))
+		}
+	}
+	sort.Slice(webhooks, func(i, j int) bool {
+		if webhooks[i].Name != webhooks[j].Name {
+			return webhooks[i].Name < webhooks[j].Name
+		}
+		return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt)
+	})
+	return webhooks, nil
+}
+
+// MarkDelivered records the time of the latest delivery
+func (r *WebhookRepository) MarkDelivered(ctx context.Context, id uuid.UUID, at time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if stored, ok := r.store.webhooks[id]; ok {
+		stored.LastDeliveredAt = &at
+	}
+	return nil
+}
+
+// cloneWebhook copies a webhook so callers cannot mutate stored state
+func cloneWebhook(webhook *domain.Webhook) *domain.Webhook {
+	copied := *webhook
+	copied.Template.TagNames = slices.Clone(webhook.Template.TagNames)
+	copied.LastDeliveredAt = cloneTime(webhook.LastDeliveredAt)
+	return &copied
+}
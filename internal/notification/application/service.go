@@ -0,0 +1,236 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/notification/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/webpush"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("notification-service")
+
+// testNotificationTTL is how long a test notification waits for an offline
+// browser; it is pointless once the user has stopped looking for it
+const testNotificationTTL = 5 * time.Minute
+
+// Service provides push subscriptions and notification delivery
+type Service struct {
+	repo   domain.Repository
+	sender *webpush.Sender
+	logger *slog.Logger
+}
+
+// NewService creates a new notification service. sender is nil when web
+// push is not configured.
+func NewService(repo domain.Repository, sender *webpush.Sender, logger *slog.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		sender: sender,
+		logger: logger,
+	}
+}
+
+// WebPushPublicKey returns the VAPID public key browsers subscribe with,
+// or "" when web push is not configured
+func (s *Service) WebPushPublicKey() string {
+	if s.sender == nil {
+		return ""
+	}
+	return s.sender.PublicKey()
+}
+
+// CreateWebPushSubscription registers a browser's push subscription for
+// the caller. Registering an endpoint again updates its keys.
+func (s *Service) CreateWebPushSubscription(ctx context.Context, endpoint, p256dh, authSecret, userAgent string) (*domain.WebPushSubscription, error) {
+	ctx, span := tracer.Start(ctx, "CreateWebPushSubscription")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if s.sender == nil {
+		return nil, domain.ErrPushDisabled
+	}
+	sub, err := domain.NewWebPushSubscription(userID, endpoint, p256dh, authSecret, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.ListWebPushSubscriptions(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list push subscriptions", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if len(existing) >= domain.MaxWebPushSubscriptionsPerUser && !hasEndpoint(existing, endpoint) {
+		return nil, domain.ErrTooManySubscriptions
+	}
+
+	if err := s.repo.SaveWebPushSubscription(ctx, sub); err != nil {
+		s.logger.ErrorContext(ctx, "failed to save push subscription", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "push subscription saved", "id", sub.ID, "owner_id", userID)
+	return sub, nil
+}
+
+// ListWebPushSubscriptions lists the caller's push subscriptions
+func (s *Service) ListWebPushSubscriptions(ctx context.Context) ([]*domain.WebPushSubscription, error) {
+	ctx, span := tracer.Start(ctx, "ListWebPushSubscriptions")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	subs, err := s.repo.ListWebPushSubscriptions(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list push subscriptions", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return subs, nil
+}
+
+// DeleteWebPushSubscription deletes a push subscription, for example when
+// the user signs out of the web client
+func (s *Service) DeleteWebPushSubscription(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "DeleteWebPushSubscription", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	if err := s.repo.DeleteWebPushSubscription(ctx, id, userID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete push subscription", "id", id, "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "push subscription deleted", "id", id)
+	return nil
+}
+
+// SendTestNotification sends a notification to each of the caller's
+// subscriptions, so users can check notifications reach them. It returns
+// how many were delivered.
+func (s *Service) SendTestNotification(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "SendTestNotification")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return 0, err
+	}
+
+	if s.sender == nil {
+		return 0, domain.ErrPushDisabled
+	}
+	return s.Notify(ctx, userID, &domain.Notification{
+		Title:   "Notifications are on",
+		Body:    "Reminders from Slips will appear like this.",
+		Tag:     "test",
+		TTL:     testNotificationTTL,
+		Urgency: webpush.UrgencyHigh,
+	})
+}
+
+// Notify delivers a notification to every push subscription of ownerID
+// and returns how many were delivered. Subscriptions the push service
+// reports as gone are deleted. It does nothing when web push is not
+// configured; delivery failures are returned together after trying every
+// subscription.
+func (s *Service) Notify(ctx context.Context, ownerID string, notification *domain.Notification) (int, error) {
+	ctx, span := tracer.Start(ctx, "Notify")
+	defer span.End()
+
+	if s.sender == nil {
+		return 0, nil
+	}
+	payload, err := notification.Payload()
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+	subs, err := s.repo.ListWebPushSubscriptions(ctx, ownerID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list push subscriptions", "owner_id", ownerID, "error", err)
+		span.RecordError(err)
+		return 0, err
+	}
+
+	opts := webpush.Options{
+		TTL:     notification.TTL,
+		Urgency: notification.Urgency,
+		Topic:   notification.Tag,
+	}
+	delivered := 0
+	var errs []error
+	for _, sub := range subs {
+		err := s.sender.Send(ctx, sub.Target(), payload, opts)
+		switch {
+		case errors.Is(err, webpush.ErrGone):
+			s.logger.InfoContext(ctx, "push subscription gone; deleting it", "id", sub.ID, "owner_id", ownerID)
+			if err := s.repo.DeleteWebPushSubscription(ctx, sub.ID, ownerID); err != nil {
+				s.logger.ErrorContext(ctx, "failed to delete push subscription", "id", sub.ID, "error", err)
+				errs = append(errs, err)
+			}
+		case err != nil:
+			s.logger.WarnContext(ctx, "failed to deliver push notification", "id", sub.ID, "owner_id", ownerID, "error", err)
+			errs = append(errs, err)
+		default:
+			delivered++
+			if err := s.repo.MarkWebPushSubscriptionUsed(ctx, sub.ID, time.Now()); err != nil {
+				// Delivery matters more than the timestamp
+				s.logger.WarnContext(ctx, "failed to record push delivery", "id", sub.ID, "error", err)
+			}
+		}
+	}
+
+	span.SetAttributes(attribute.Int("delivered", delivered))
+	if len(errs) > 0 {
+		err := errors.Join(errs...)
+		span.RecordError(err)
+		return delivered, err
+	}
+	return delivered, nil
+}
+
+func hasEndpoint(subs []*domain.WebPushSubscription, endpoint string) bool {
+	for _, sub := range subs {
+		if sub.Endpoint == endpoint {
+			return true
+		}
+	}
+	return false
+}
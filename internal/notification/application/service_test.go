@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/slips-ai/slips-core/internal/memory"
+	"github.com/slips-ai/slips-core/internal/notification/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/webpush"
+)
+
+// newKey returns a base64url encoded P-256 key, as a private VAPID key or
+// the public key of a browser subscription
+func newKey(t *testing.T, public bool) string {
+	t.Helper()
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	if public {
+		return base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes())
+	}
+	return base64.RawURLEncoding.EncodeToString(key.Bytes())
+}
+
+func TestNotify(t *testing.T) {
+	// The push service accepts messages for /live and has forgotten /gone
+	var mu sync.Mutex
+	received := map[string]int{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		received[r.URL.Path]++
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	// The test certificate names example.com; connect it to the server
+	const origin = "https://example.com"
+	client := server.Client()
+	client.Transport.(*http.Transport).DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+
+	sender, err := webpush.NewSender(newKey(t, false), "mailto:ops@example.com", client)
+	if err != nil {
+		t.Fatalf("new sender: %v", err)
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	service := NewService(memory.NewSubscriptionRepository(memory.NewStore()), sender, logger)
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	authSecret := base64.RawURLEncoding.EncodeToString([]byte("0123456789abcdef"))
+	for _, path := range []string{"/live", "/gone"} {
+		if _, err := service.CreateWebPushSubscription(ctx, origin+path, newKey(t, true), authSecret, "Firefox"); err != nil {
+			t.Fatalf("create subscription %s: %v", path, err)
+		}
+	}
+	// Registering an endpoint again replaces it
+	if _, err := service.CreateWebPushSubscription(ctx, origin+"/live", newKey(t, true), authSecret, "Firefox 140"); err != nil {
+		t.Fatalf("create subscription again: %v", err)
+	}
+	if _, err := service.CreateWebPushSubscription(ctx, "http://push.example.com/x", newKey(t, true), authSecret, ""); !errors.Is(err, domain.ErrInvalidSubscription) {
+		t.Errorf("create plain http subscription = %v, want ErrInvalidSubscription", err)
+	}
+	if _, err := service.CreateWebPushSubscription(ctx, server.URL+"/live", newKey(t, true), authSecret, ""); !errors.Is(err, domain.ErrInvalidSubscription) {
+		t.Errorf("create loopback subscription = %v, want ErrInvalidSubscription", err)
+	}
+
+	delivered, err := service.SendTestNotification(ctx)
+	if err != nil || delivered != 1 {
+		t.Fatalf("send test notification = %d, %v; want 1", delivered, err)
+	}
+	if received["/live"] != 1 || received["/gone"] != 1 {
+		t.Errorf("push service received %v", received)
+	}
+
+	// The gone subscription is deleted and the live one marked as used
+	subs, err := service.ListWebPushSubscriptions(ctx)
+	if err != nil {
+		t.Fatalf("list subscriptions: %v", err)
+	}
+	if len(subs) != 1 || subs[0].Endpoint != origin+"/live" || subs[0].UserAgent != "Firefox 140" || subs[0].LastUsedAt == nil {
+		t.Fatalf("subscriptions after send = %+v", subs)
+	}
+
+	// Without a VAPID key browsers cannot subscribe
+	disabled := NewService(memory.NewSubscriptionRepository(memory.NewStore()), nil, logger)
+	if _, err := disabled.CreateWebPushSubscription(ctx, origin+"/live", newKey(t, true), authSecret, ""); !errors.Is(err, domain.ErrPushDisabled) {
+		t.Errorf("create subscription without VAPID key = %v, want ErrPushDisabled", err)
+	}
+	if disabled.WebPushPublicKey() != "" {
+		t.Error("public key is set without a VAPID key")
+	}
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for push subscription persistence
+type Repository interface {
+	// SaveWebPushSubscription stores sub, replacing the subscription with
+	// the same endpoint if there is one. sub's ID and CreatedAt are set to
+	// those of the stored subscription.
+	SaveWebPushSubscription(ctx context.Context, sub *WebPushSubscription) error
+	// ListWebPushSubscriptions returns the owner's subscriptions, oldest
+	// first
+	ListWebPushSubscriptions(ctx context.Context, ownerID string) ([]*WebPushSubscription, error)
+	DeleteWebPushSubscription(ctx context.Context, id uuid.UUID, ownerID string) error
+	MarkWebPushSubscriptionUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+}
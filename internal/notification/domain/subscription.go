@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+	"github.com/slips-ai/slips-core/pkg/webpush"
+)
+
+// MaxWebPushSubscriptionsPerUser bounds how many browsers a user can
+// receive notifications in
+const MaxWebPushSubscriptionsPerUser = 20
+
+var (
+	// ErrInvalidSubscription is returned for subscriptions whose endpoint
+	// or keys are malformed
+	ErrInvalidSubscription = webpush.ErrInvalidSubscription
+	// ErrTooManySubscriptions is returned when a user already has
+	// MaxWebPushSubscriptionsPerUser subscriptions
+	ErrTooManySubscriptions = domainerrors.New(domainerrors.ErrQuotaExceeded, fmt.Sprintf("at most %d push subscriptions are allowed", MaxWebPushSubscriptionsPerUser))
+	// ErrPushDisabled is returned when the server has no VAPID key
+	// configured
+	ErrPushDisabled = errors.New("web push is not configured")
+)
+
+// WebPushSubscription is a browser's Web Push subscription. The endpoint
+// is unique to the browser profile; P256dh and Auth are the keys payloads
+// are encrypted with.
+type WebPushSubscription struct {
+	ID        uuid.UUID
+	OwnerID   string
+	Endpoint  string
+	P256dh    string
+	Auth      string
+	UserAgent string
+	CreatedAt time.Time
+	// LastUsedAt is when a notification was last delivered, nil before the
+	// first
+	LastUsedAt *time.Time
+}
+
+// NewWebPushSubscription creates a subscription from the fields of a
+// browser's PushSubscription, after checking they are well formed
+// Note: CreatedAt is not set here. It will be populated by the database on
+// insertion (DEFAULT NOW()).
+func NewWebPushSubscription(ownerID, endpoint, p256dh, auth, userAgent string) (*WebPushSubscription, error) {
+	sub := &WebPushSubscription{
+		ID:        uuid.New(),
+		OwnerID:   ownerID,
+		Endpoint:  endpoint,
+		P256dh:    p256dh,
+		Auth:      auth,
+		UserAgent: userAgent,
+	}
+	if err := sub.Target().Validate(); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// Target returns the subscription in the form the push sender takes
+func (s *WebPushSubscription) Target() webpush.Subscription {
+	return webpush.Subscription{
+		Endpoint: s.Endpoint,
+		P256dh:   s.P256dh,
+		Auth:     s.Auth,
+	}
+}
+
+// Notification is a message shown by the web client's service worker
+type Notification struct {
+	Title string
+	Body  string
+	// URL is opened when the notification is clicked
+	URL string
+	// Tag groups notifications, so a newer one replaces an older one with
+	// the same tag on screen and in the push service's queue
+	Tag string
+	// TTL is how long the push service keeps the notification for a
+	// browser that is offline
+	TTL     time.Duration
+	Urgency webpush.Urgency
+}
+
+// Payload returns the JSON the service worker receives in its push event
+func (n *Notification) Payload() ([]byte, error) {
+	return json.Marshal(struct {
+		Title string `json:"title"`
+		Body  string `json:"body,omitempty"`
+		URL   string `json:"url,omitempty"`
+		Tag   string `json:"tag,omitempty"`
+	}{n.Title, n.Body, n.URL, n.Tag})
+}
@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	notificationv1 "github.com/slips-ai/slips-core/gen/go/notification/v1"
+	"github.com/slips-ai/slips-core/internal/notification/application"
+	"github.com/slips-ai/slips-core/internal/notification/domain"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NotificationServer implements the NotificationService gRPC server
+type NotificationServer struct {
+	notificationv1.UnimplementedNotificationServiceServer
+	service *application.Service
+}
+
+// NewNotificationServer creates a new notification gRPC server
+func NewNotificationServer(service *application.Service) *NotificationServer {
+	return &NotificationServer{
+		service: service,
+	}
+}
+
+// GetWebPushConfig returns the key browsers subscribe with
+func (s *NotificationServer) GetWebPushConfig(ctx context.Context, req *notificationv1.GetWebPushConfigRequest) (*notificationv1.GetWebPushConfigResponse, error) {
+	publicKey := s.service.WebPushPublicKey()
+	return &notificationv1.GetWebPushConfigResponse{
+		Enabled:        publicKey != "",
+		VapidPublicKey: publicKey,
+	}, nil
+}
+
+// CreateWebPushSubscription registers a browser's push subscription
+func (s *NotificationServer) CreateWebPushSubscription(ctx context.Context, req *notificationv1.CreateWebPushSubscriptionRequest) (*notificationv1.CreateWebPushSubscriptionResponse, error) {
+	if err := grpcerrors.ValidateNotEmpty(req.Endpoint, "endpoint"); err != nil {
+		return nil, err
+	}
+	if err := grpcerrors.ValidateLength(req.UserAgent, "user_agent", grpcerrors.MaxUserAgentLength); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.service.CreateWebPushSubscription(ctx, req.Endpoint, req.P256Dh, req.Auth, req.UserAgent)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to create push subscription")
+	}
+
+	return &notificationv1.CreateWebPushSubscriptionResponse{
+		Subscription: subscriptionToProto(sub),
+	}, nil
+}
+
+// ListWebPushSubscriptions lists the caller's push subscriptions
+func (s *NotificationServer) ListWebPushSubscriptions(ctx context.Context, req *notificationv1.ListWebPushSubscriptionsRequest) (*notificationv1.ListWebPushSubscriptionsResponse, error) {
+	subs, err := s.service.ListWebPushSubscriptions(ctx)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to list push subscriptions")
+	}
+
+	protoSubs := make([]*notificationv1.WebPushSubscription, len(subs))
+	for i, sub := range subs {
+		protoSubs[i] = subscriptionToProto(sub)
+	}
+
+	return &notificationv1.ListWebPushSubscriptionsResponse{
+		Subscriptions: protoSubs,
+	}, nil
+}
+
+// DeleteWebPushSubscription deletes a push subscription
+func (s *NotificationServer) DeleteWebPushSubscription(ctx context.Context, req *notificationv1.DeleteWebPushSubscriptionRequest) (*notificationv1.DeleteWebPushSubscriptionResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid subscription ID format")
+	}
+
+	if err := s.service.DeleteWebPushSubscription(ctx, id); err != nil {
+		return nil, toGRPCError(err, "failed to delete push subscription")
+	}
+
+	return &notificationv1.DeleteWebPushSubscriptionResponse{}, nil
+}
+
+// SendTestNotification sends a test notification to the caller's browsers
+func (s *NotificationServer) SendTestNotification(ctx context.Context, req *notificationv1.SendTestNotificationRequest) (*notificationv1.SendTestNotificationResponse, error) {
+	delivered, err := s.service.SendTestNotification(ctx)
+	if err != nil && delivered == 0 {
+		return nil, toGRPCError(err, "failed to send test notification")
+	}
+
+	// Reaching some browsers is a success; the rest are logged
+	return &notificationv1.SendTestNotificationResponse{
+		Delivered: int32(delivered),
+	}, nil
+}
+
+// toGRPCError maps validation and configuration failures to status codes
+// and defers everything else to grpcerrors.ToGRPCError
+func toGRPCError(err error, defaultMsg string) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidSubscription):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrPushDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return grpcerrors.ToGRPCError(err, defaultMsg)
+}
+
+func subscriptionToProto(sub *domain.WebPushSubscription) *notificationv1.WebPushSubscription {
+	protoSub := &notificationv1.WebPushSubscription{
+		Id:        sub.ID.String(),
+		Endpoint:  sub.Endpoint,
+		UserAgent: sub.UserAgent,
+		CreatedAt: timestamppb.New(sub.CreatedAt),
+	}
+	if sub.LastUsedAt != nil {
+		protoSub.LastUsedAt = timestamppb.New(*sub.LastUsedAt)
+	}
+	return protoSub
+}
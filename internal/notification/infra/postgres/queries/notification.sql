@@ -0,0 +1,24 @@
+-- name: UpsertWebPushSubscription :one
+INSERT INTO web_push_subscriptions (id, owner_id, endpoint, p256dh, auth, user_agent)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (endpoint) DO UPDATE
+SET owner_id = EXCLUDED.owner_id,
+    p256dh = EXCLUDED.p256dh,
+    auth = EXCLUDED.auth,
+    user_agent = EXCLUDED.user_agent
+RETURNING id, owner_id, endpoint, p256dh, auth, user_agent, created_at, last_used_at;
+
+-- name: ListWebPushSubscriptions :many
+SELECT id, owner_id, endpoint, p256dh, auth, user_agent, created_at, last_used_at
+FROM web_push_subscriptions
+WHERE owner_id = $1
+ORDER BY created_at ASC;
+
+-- name: DeleteWebPushSubscription :exec
+DELETE FROM web_push_subscriptions
+WHERE id = $1 AND owner_id = $2;
+
+-- name: MarkWebPushSubscriptionUsed :exec
+UPDATE web_push_subscriptions
+SET last_used_at = $2
+WHERE id = $1;
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/slips-ai/slips-core/internal/notification/domain"
+)
+
+// SubscriptionRepository implements domain.Repository using PostgreSQL
+type SubscriptionRepository struct {
+	queries *Queries
+}
+
+// NewSubscriptionRepository creates a new push subscription repository
+func NewSubscriptionRepository(pool *pgxpool.Pool) *SubscriptionRepository {
+	return &SubscriptionRepository{
+		queries: New(pool),
+	}
+}
+
+// SaveWebPushSubscription stores a subscription, replacing the one with
+// the same endpoint
+func (r *SubscriptionRepository) SaveWebPushSubscription(ctx context.Context, sub *domain.WebPushSubscription) error {
+	result, err := r.queries.UpsertWebPushSubscription(ctx, UpsertWebPushSubscriptionParams{
+		ID:        pgtype.UUID{Bytes: sub.ID, Valid: true},
+		OwnerID:   sub.OwnerID,
+		Endpoint:  sub.Endpoint,
+		P256dh:    sub.P256dh,
+		Auth:      sub.Auth,
+		UserAgent: sub.UserAgent,
+	})
+	if err != nil {
+		return err
+	}
+
+	stored, err := toDomain(result)
+	if err != nil {
+		return err
+	}
+	*sub = *stored
+	return nil
+}
+
+// ListWebPushSubscriptions lists the owner's subscriptions, oldest first
+func (r *SubscriptionRepository) ListWebPushSubscriptions(ctx context.Context, ownerID string) ([]*domain.WebPushSubscription, error) {
+	results, err := r.queries.ListWebPushSubscriptions(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]*domain.WebPushSubscription, len(results))
+	for i, result := range results {
+		sub, err := toDomain(result)
+		if err != nil {
+			return nil, err
+		}
+		subs[i] = sub
+	}
+	return subs, nil
+}
+
+// DeleteWebPushSubscription deletes a subscription
+func (r *SubscriptionRepository) DeleteWebPushSubscription(ctx context.Context, id uuid.UUID, ownerID string) error {
+	return r.queries.DeleteWebPushSubscription(ctx, DeleteWebPushSubscriptionParams{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		OwnerID: ownerID,
+	})
+}
+
+// MarkWebPushSubscriptionUsed records the time a notification was last
+// delivered to the subscription
+func (r *SubscriptionRepository) MarkWebPushSubscriptionUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.queries.MarkWebPushSubscriptionUsed(ctx, MarkWebPushSubscriptionUsedParams{
+		ID:         pgtype.UUID{Bytes: id, Valid: true},
+		LastUsedAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+}
+
+func toDomain(row WebPushSubscription) (*domain.WebPushSubscription, error) {
+	id, err := uuid.FromBytes(row.ID.Bytes[:])
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &domain.WebPushSubscription{
+		ID:        id,
+		OwnerID:   row.OwnerID,
+		Endpoint:  row.Endpoint,
+		P256dh:    row.P256dh,
+		Auth:      row.Auth,
+		UserAgent: row.UserAgent,
+		CreatedAt: row.CreatedAt.Time,
+	}
+	if row.LastUsedAt.Valid {
+		sub.LastUsedAt = &row.LastUsedAt.Time
+	}
+	return sub, nil
+}
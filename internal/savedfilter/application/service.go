@@ -0,0 +1,165 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("savedfilter-service")
+
+// Service provides saved filter business logic
+type Service struct {
+	repo   domain.Repository
+	logger *slog.Logger
+}
+
+// NewService creates a new saved filter service
+func NewService(repo domain.Repository, logger *slog.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// CreateSavedFilter creates a new saved filter
+func (s *Service) CreateSavedFilter(ctx context.Context, name string, criteria domain.Criteria) (*domain.SavedFilter, error) {
+	ctx, span := tracer.Start(ctx, "CreateSavedFilter", trace.WithAttributes(
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	filter := domain.NewSavedFilter(name, userID, criteria)
+	if err := s.repo.Create(ctx, filter); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create saved filter", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "saved filter created", "id", filter.ID, "owner_id", userID)
+	return filter, nil
+}
+
+// GetSavedFilter retrieves a saved filter by ID
+func (s *Service) GetSavedFilter(ctx context.Context, id uuid.UUID) (*domain.SavedFilter, error) {
+	ctx, span := tracer.Start(ctx, "GetSavedFilter", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	filter, err := s.repo.Get(ctx, id, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get saved filter", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// UpdateSavedFilter replaces the name and criteria of a saved filter
+func (s *Service) UpdateSavedFilter(ctx context.Context, id uuid.UUID, name string, criteria domain.Criteria) (*domain.SavedFilter, error) {
+	ctx, span := tracer.Start(ctx, "UpdateSavedFilter", trace.WithAttributes(
+		attribute.String("id", id.String()),
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	filter, err := s.repo.Get(ctx, id, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get saved filter for update", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	filter.Update(name, criteria)
+	if err := s.repo.Update(ctx, filter); err != nil {
+		s.logger.ErrorContext(ctx, "failed to update saved filter", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "saved filter updated", "id", filter.ID)
+	return filter, nil
+}
+
+// DeleteSavedFilter deletes a saved filter
+func (s *Service) DeleteSavedFilter(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "DeleteSavedFilter", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, userID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete saved filter", "id", id, "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "saved filter deleted", "id", id)
+	return nil
+}
+
+// ListSavedFilters lists saved filters
+func (s *Service) ListSavedFilters(ctx context.Context, limit, offset int) ([]*domain.SavedFilter, error) {
+	ctx, span := tracer.Start(ctx, "ListSavedFilters", trace.WithAttributes(
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	filters, err := s.repo.List(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list saved filters", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return filters, nil
+}
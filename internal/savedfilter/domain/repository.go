@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for saved filter persistence
+type Repository interface {
+	// Create and Update return ErrSavedFilterNameConflict when the owner
+	// already has a filter with the name
+	Create(ctx context.Context, filter *SavedFilter) error
+	// Get and Update return ErrSavedFilterNotFound for filters that do not
+	// exist or belong to another owner
+	Get(ctx context.Context, id uuid.UUID, ownerID string) (*SavedFilter, error)
+	Update(ctx context.Context, filter *SavedFilter) error
+	Delete(ctx context.Context, id uuid.UUID, ownerID string) error
+	List(ctx context.Context, ownerID string, limit, offset int) ([]*SavedFilter, error)
+}
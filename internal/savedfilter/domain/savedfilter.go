@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+var (
+	// ErrSavedFilterNotFound is returned for saved filters that do not
+	// exist or belong to another user
+	ErrSavedFilterNotFound = domainerrors.New(domainerrors.ErrNotFound, "saved filter not found")
+	// ErrSavedFilterNameConflict is returned when a saved filter is created
+	// or renamed to the name of another filter of its owner
+	ErrSavedFilterNameConflict = domainerrors.New(domainerrors.ErrConflict, "a saved filter with this name already exists")
+)
+
+// Criteria holds the task filter conditions persisted with a saved filter.
+// It is stored as JSON so new conditions can be added without migrations.
+type Criteria struct {
+	// TagIDs matches tasks that carry any of the given tags.
+	TagIDs []uuid.UUID `json:"tag_ids,omitempty"`
+	// StartDateFrom and StartDateTo bound the task start date (inclusive).
+	StartDateFrom *time.Time `json:"start_date_from,omitempty"`
+	StartDateTo   *time.Time `json:"start_date_to,omitempty"`
+	// DeadlineApproaching matches tasks that are overdue or due soon.
+	// It is evaluated relative to the day the filter is executed.
+	DeadlineApproaching bool `json:"deadline_approaching,omitempty"`
+	// Query matches tasks whose title or notes contain the text.
+	Query           string `json:"query,omitempty"`
+	IncludeArchived bool   `json:"include_archived,omitempty"`
+	// Contexts matches tasks in any of the given normalized contexts.
+	Contexts []string `json:"contexts,omitempty"`
+}
+
+// SavedFilter represents a named, persisted task filter (smart list)
+type SavedFilter struct {
+	ID        uuid.UUID
+	Name      string
+	OwnerID   string
+	Criteria  Criteria
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewSavedFilter creates a new saved filter
+// Note: CreatedAt and UpdatedAt timestamps are not set here.
+// They will be populated by the database on insertion (DEFAULT NOW()).
+func NewSavedFilter(name, ownerID string, criteria Criteria) *SavedFilter {
+	return &SavedFilter{
+		ID:       uuid.New(),
+		Name:     name,
+		OwnerID:  ownerID,
+		Criteria: criteria,
+	}
+}
+
+// Update updates the saved filter
+func (f *SavedFilter) Update(name string, criteria Criteria) {
+	f.Name = name
+	f.Criteria = criteria
+}
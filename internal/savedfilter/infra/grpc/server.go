@@ -0,0 +1,252 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	savedfilterv1 "github.com/slips-ai/slips-core/gen/go/savedfilter/v1"
+	"github.com/slips-ai/slips-core/internal/savedfilter/application"
+	"github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"github.com/slips-ai/slips-core/pkg/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SavedFilterServer implements the SavedFilterService gRPC server
+type SavedFilterServer struct {
+	savedfilterv1.UnimplementedSavedFilterServiceServer
+	service *application.Service
+}
+
+// NewSavedFilterServer creates a new saved filter gRPC server
+func NewSavedFilterServer(service *application.Service) *SavedFilterServer {
+	return &SavedFilterServer{
+		service: service,
+	}
+}
+
+// CreateSavedFilter creates a new saved filter
+func (s *SavedFilterServer) CreateSavedFilter(ctx context.Context, req *savedfilterv1.CreateSavedFilterRequest) (*savedfilterv1.CreateSavedFilterResponse, error) {
+	if err := validateName(req.Name); err != nil {
+		return nil, err
+	}
+	criteria, err := CriteriaFromProto(req.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := s.service.CreateSavedFilter(ctx, req.Name, criteria)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to create saved filter")
+	}
+
+	return &savedfilterv1.CreateSavedFilterResponse{
+		SavedFilter: SavedFilterToProto(filter),
+	}, nil
+}
+
+// GetSavedFilter retrieves a saved filter by ID
+func (s *SavedFilterServer) GetSavedFilter(ctx context.Context, req *savedfilterv1.GetSavedFilterRequest) (*savedfilterv1.GetSavedFilterResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid saved filter ID format")
+	}
+
+	filter, err := s.service.GetSavedFilter(ctx, id)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to get saved filter")
+	}
+
+	return &savedfilterv1.GetSavedFilterResponse{
+		SavedFilter: SavedFilterToProto(filter),
+	}, nil
+}
+
+// UpdateSavedFilter updates a saved filter
+func (s *SavedFilterServer) UpdateSavedFilter(ctx context.Context, req *savedfilterv1.UpdateSavedFilterRequest) (*savedfilterv1.UpdateSavedFilterResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid saved filter ID format")
+	}
+
+	if err := validateName(req.Name); err != nil {
+		return nil, err
+	}
+	criteria, err := CriteriaFromProto(req.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := s.service.UpdateSavedFilter(ctx, id, req.Name, criteria)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to update saved filter")
+	}
+
+	return &savedfilterv1.UpdateSavedFilterResponse{
+		SavedFilter: SavedFilterToProto(filter),
+	}, nil
+}
+
+// DeleteSavedFilter deletes a saved filter
+func (s *SavedFilterServer) DeleteSavedFilter(ctx context.Context, req *savedfilterv1.DeleteSavedFilterRequest) (*savedfilterv1.DeleteSavedFilterResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid saved filter ID format")
+	}
+
+	if err := s.service.DeleteSavedFilter(ctx, id); err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to delete saved filter")
+	}
+
+	return &savedfilterv1.DeleteSavedFilterResponse{}, nil
+}
+
+// ListSavedFilters lists saved filters with pagination
+func (s *SavedFilterServer) ListSavedFilters(ctx context.Context, req *savedfilterv1.ListSavedFiltersRequest) (*savedfilterv1.ListSavedFiltersResponse, error) {
+	// Reject page_token if provided (not yet implemented)
+	if req.PageToken != "" {
+		return nil, status.Errorf(codes.Unimplemented, "page_token is not supported yet")
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 30
+	}
+
+	// Always return the first page (offset 0) until pagination tokens are implemented
+	offset := 0
+
+	// Validate int32 bounds at gRPC layer before calling repository
+	if err := grpcerrors.ValidateInt32Range(pageSize, "limit"); err != nil {
+		return nil, err
+	}
+	if err := grpcerrors.ValidateInt32Range(offset, "offset"); err != nil {
+		return nil, err
+	}
+
+	filters, err := s.service.ListSavedFilters(ctx, pageSize, offset)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to list saved filters")
+	}
+
+	protoFilters := make([]*savedfilterv1.SavedFilter, len(filters))
+	for i, filter := range filters {
+		protoFilters[i] = SavedFilterToProto(filter)
+	}
+
+	return &savedfilterv1.ListSavedFiltersResponse{
+		SavedFilters: protoFilters,
+	}, nil
+}
+
+func validateName(name string) error {
+	if err := grpcerrors.ValidateNotEmpty(name, "name"); err != nil {
+		return err
+	}
+	return grpcerrors.ValidateLength(name, "name", grpcerrors.MaxSavedFilterNameLength)
+}
+
+// CriteriaFromProto validates and converts filter criteria. A nil message
+// yields empty criteria, which matches every active task.
+func CriteriaFromProto(c *savedfilterv1.FilterCriteria) (domain.Criteria, error) {
+	var criteria domain.Criteria
+	if c == nil {
+		return criteria, nil
+	}
+
+	if err := grpcerrors.ValidateLength(c.Query, "query", grpcerrors.MaxFilterQueryLength); err != nil {
+		return criteria, err
+	}
+
+	if len(c.TagIds) > 0 {
+		criteria.TagIDs = make([]uuid.UUID, 0, len(c.TagIds))
+		for _, tagIDStr := range c.TagIds {
+			tagID, err := uuid.Parse(tagIDStr)
+			if err != nil {
+				return criteria, status.Errorf(codes.InvalidArgument, "invalid tag ID format: %s", tagIDStr)
+			}
+			criteria.TagIDs = append(criteria.TagIDs, tagID)
+		}
+	}
+
+	startDateFrom, err := parseDate(c.StartDateFrom, "start_date_from")
+	if err != nil {
+		return criteria, err
+	}
+	startDateTo, err := parseDate(c.StartDateTo, "start_date_to")
+	if err != nil {
+		return criteria, err
+	}
+	if startDateFrom != nil && startDateTo != nil && startDateTo.Before(*startDateFrom) {
+		return criteria, status.Error(codes.InvalidArgument, "start_date_to must not be before start_date_from")
+	}
+
+	criteria.StartDateFrom = startDateFrom
+	criteria.StartDateTo = startDateTo
+	criteria.DeadlineApproaching = c.DeadlineApproaching
+	criteria.Query = c.Query
+	criteria.IncludeArchived = c.IncludeArchived
+
+	for _, value := range c.Contexts {
+		taskContext, err := taskdomain.NormalizeContext(value)
+		if err != nil {
+			return criteria, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if taskContext == "" {
+			return criteria, status.Error(codes.InvalidArgument, "contexts cannot contain empty values")
+		}
+		criteria.Contexts = append(criteria.Contexts, taskContext)
+	}
+	return criteria, nil
+}
+
+func parseDate(datePtr *string, fieldName string) (*time.Time, error) {
+	if datePtr == nil || *datePtr == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse("2006-01-02", *datePtr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s format: expected YYYY-MM-DD", fieldName)
+	}
+	return &parsed, nil
+}
+
+// SavedFilterToProto converts a saved filter. It is shared with the admin
+// export.
+func SavedFilterToProto(filter *domain.SavedFilter) *savedfilterv1.SavedFilter {
+	criteria := filter.Criteria
+
+	tagIDs := make([]string, len(criteria.TagIDs))
+	for i, tagID := range criteria.TagIDs {
+		tagIDs[i] = tagID.String()
+	}
+
+	protoCriteria := &savedfilterv1.FilterCriteria{
+		TagIds:              tagIDs,
+		DeadlineApproaching: criteria.DeadlineApproaching,
+		Query:               criteria.Query,
+		IncludeArchived:     criteria.IncludeArchived,
+		Contexts:            criteria.Contexts,
+	}
+	if criteria.StartDateFrom != nil {
+		formatted := criteria.StartDateFrom.Format("2006-01-02")
+		protoCriteria.StartDateFrom = &formatted
+	}
+	if criteria.StartDateTo != nil {
+		formatted := criteria.StartDateTo.Format("2006-01-02")
+		protoCriteria.StartDateTo = &formatted
+	}
+
+	return &savedfilterv1.SavedFilter{
+		Id:           filter.ID.String(),
+		Name:         filter.Name,
+		Criteria:     protoCriteria,
+		CreatedAt:    timestamppb.New(filter.CreatedAt),
+		UpdatedAt:    timestamppb.New(filter.UpdatedAt),
+		ResourceName: resourcename.SavedFilter{User: filter.OwnerID, Filter: filter.ID}.String(),
+	}
+}
@@ -0,0 +1,26 @@
+-- name: CreateSavedFilter :one
+INSERT INTO saved_filters (name, owner_id, criteria)
+VALUES ($1, $2, $3)
+RETURNING id, name, owner_id, criteria, created_at, updated_at;
+
+-- name: GetSavedFilter :one
+SELECT id, name, owner_id, criteria, created_at, updated_at
+FROM saved_filters
+WHERE id = $1 AND owner_id = $2;
+
+-- name: UpdateSavedFilter :one
+UPDATE saved_filters
+SET name = $2, criteria = $3, updated_at = NOW()
+WHERE id = $1 AND owner_id = $4
+RETURNING id, name, owner_id, criteria, created_at, updated_at;
+
+-- name: DeleteSavedFilter :exec
+DELETE FROM saved_filters
+WHERE id = $1 AND owner_id = $2;
+
+-- name: ListSavedFilters :many
+SELECT id, name, owner_id, criteria, created_at, updated_at
+FROM saved_filters
+WHERE owner_id = $1
+ORDER BY name ASC
+LIMIT $2 OFFSET $3;
@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/savedfilter/domain"
+)
+
+// SavedFilterRepository implements domain.Repository using PostgreSQL
+type SavedFilterRepository struct {
+	queries     *Queries
+	readQueries *Queries
+}
+
+// NewSavedFilterRepository creates a new saved filter repository.
+// List runs on reader, which may be a read replica router.
+func NewSavedFilterRepository(pool DBTX, reader DBTX) *SavedFilterRepository {
+	return &SavedFilterRepository{
+		queries:     New(pool),
+		readQueries: New(reader),
+	}
+}
+
+// Create creates a new saved filter
+func (r *SavedFilterRepository) Create(ctx context.Context, filter *domain.SavedFilter) error {
+	criteria, err := json.Marshal(filter.Criteria)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.queries.CreateSavedFilter(ctx, CreateSavedFilterParams{
+		Name:     filter.Name,
+		OwnerID:  filter.OwnerID,
+		Criteria: criteria,
+	})
+	if err != nil {
+		return nameConflict(err)
+	}
+
+	filterID, err := uuid.FromBytes(result.ID.Bytes[:])
+	if err != nil {
+		return err
+	}
+	filter.ID = filterID
+	filter.CreatedAt = result.CreatedAt.Time
+	filter.UpdatedAt = result.UpdatedAt.Time
+	return nil
+}
+
+// Get retrieves a saved filter by ID
+func (r *SavedFilterRepository) Get(ctx context.Context, id uuid.UUID, ownerID string) (*domain.SavedFilter, error) {
+	result, err := r.queries.GetSavedFilter(ctx, GetSavedFilterParams{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		OwnerID: ownerID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrSavedFilterNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return savedFilterFromDB(result.ID, result.Name, result.OwnerID, result.Criteria, result.CreatedAt, result.UpdatedAt)
+}
+
+// Update updates a saved filter
+func (r *SavedFilterRepository) Update(ctx context.Context, filter *domain.SavedFilter) error {
+	criteria, err := json.Marshal(filter.Criteria)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.queries.UpdateSavedFilter(ctx, UpdateSavedFilterParams{
+		ID:       pgtype.UUID{Bytes: filter.ID, Valid: true},
+		Name:     filter.Name,
+		Criteria: criteria,
+		OwnerID:  filter.OwnerID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrSavedFilterNotFound
+	}
+	if err != nil {
+		return nameConflict(err)
+	}
+
+	filter.UpdatedAt = result.UpdatedAt.Time
+	return nil
+}
+
+// Delete deletes a saved filter
+func (r *SavedFilterRepository) Delete(ctx context.Context, id uuid.UUID, ownerID string) error {
+	return r.queries.DeleteSavedFilter(ctx, DeleteSavedFilterParams{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		OwnerID: ownerID,
+	})
+}
+
+// List lists saved filters with pagination
+func (r *SavedFilterRepository) List(ctx context.Context, ownerID string, limit, offset int) ([]*domain.SavedFilter, error) {
+	// Validate parameters to prevent negative values and potential overflow
+	if limit < 0 {
+		limit = 0
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Convert to int32 (validation is done at gRPC layer)
+	results, err := r.readQueries.ListSavedFilters(ctx, ListSavedFiltersParams{
+		OwnerID: ownerID,
+		Limit:   int32(limit),
+		Offset:  int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]*domain.SavedFilter, len(results))
+	for i, result := range results {
+		filter, err := savedFilterFromDB(result.ID, result.Name, result.OwnerID, result.Criteria, result.CreatedAt, result.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = filter
+	}
+
+	return filters, nil
+}
+
+func savedFilterFromDB(id pgtype.UUID, name, ownerID string, rawCriteria []byte, createdAt, updatedAt pgtype.Timestamptz) (*domain.SavedFilter, error) {
+	filterID, err := uuid.FromBytes(id.Bytes[:])
+	if err != nil {
+		return nil, err
+	}
+
+	var criteria domain.Criteria
+	if len(rawCriteria) > 0 {
+		if err := json.Unmarshal(rawCriteria, &criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.SavedFilter{
+		ID:        filterID,
+		Name:      name,
+		OwnerID:   ownerID,
+		Criteria:  criteria,
+		CreatedAt: createdAt.Time,
+		UpdatedAt: updatedAt.Time,
+	}, nil
+}
+
+// nameConflict reports a violation of the unique constraint on the owner's
+// filter names as domain.ErrSavedFilterNameConflict, keeping the database
+// error in the chain
+func nameConflict(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "saved_filters_owner_id_name_key" {
+		return fmt.Errorf("%w: %w", domain.ErrSavedFilterNameConflict, err)
+	}
+	return err
+}
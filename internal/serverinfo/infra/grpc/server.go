@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	serverv1 "github.com/slips-ai/slips-core/gen/go/server/v1"
+	"github.com/slips-ai/slips-core/pkg/buildinfo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Optional features reported by GetServerInfo
+const (
+	FeatureCalDAV         = "caldav"
+	FeatureDigests        = "digests"
+	FeatureEncryptedNotes = "encrypted_notes"
+	FeatureFeeds          = "feeds"
+	FeatureTaskTransfers  = "task_transfers"
+	FeatureTriggers       = "triggers"
+	FeatureUsage          = "usage"
+	FeatureWebPush        = "web_push"
+	FeatureWebhooks       = "webhooks"
+	FeatureWebhooksAsync  = "webhooks_async"
+)
+
+// ServerInfoServer implements the ServerService gRPC server
+type ServerInfoServer struct {
+	serverv1.UnimplementedServerServiceServer
+	resp *serverv1.GetServerInfoResponse
+}
+
+// NewServerInfoServer creates a server reporting info and the enabled
+// features, which are fixed for the life of the process
+func NewServerInfoServer(info buildinfo.Info, features []string) *ServerInfoServer {
+	resp := &serverv1.GetServerInfoResponse{
+		Version:   info.Version,
+		GitSha:    info.GitSHA,
+		GoVersion: info.GoVersion,
+		Features:  slices.Sorted(slices.Values(features)),
+	}
+	if !info.BuildTime.IsZero() {
+		resp.BuildTime = timestamppb.New(info.BuildTime)
+	}
+	return &ServerInfoServer{resp: resp}
+}
+
+// GetServerInfo returns the build and enabled features of the server
+func (s *ServerInfoServer) GetServerInfo(ctx context.Context, req *serverv1.GetServerInfoRequest) (*serverv1.GetServerInfoResponse, error) {
+	return s.resp, nil
+}
@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	serverv1 "github.com/slips-ai/slips-core/gen/go/server/v1"
+	"github.com/slips-ai/slips-core/pkg/buildinfo"
+)
+
+func TestServerInfoServer_GetServerInfo(t *testing.T) {
+	built := time.Date(2026, 10, 16, 11, 30, 0, 0, time.UTC)
+	server := NewServerInfoServer(buildinfo.Info{
+		Version:   "v1.8.0",
+		GitSHA:    "3f0cd10abc",
+		BuildTime: built,
+		GoVersion: "go1.24.11",
+	}, []string{FeatureWebhooks, FeatureCalDAV, FeatureDigests})
+
+	resp, err := server.GetServerInfo(context.Background(), &serverv1.GetServerInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetServerInfo: %v", err)
+	}
+	if resp.Version != "v1.8.0" || resp.GitSha != "3f0cd10abc" || resp.GoVersion != "go1.24.11" {
+		t.Errorf("resp = %v, want the build", resp)
+	}
+	if !resp.BuildTime.AsTime().Equal(built) {
+		t.Errorf("build_time = %s, want %s", resp.BuildTime.AsTime(), built)
+	}
+	if want := []string{"caldav", "digests", "webhooks"}; !slices.Equal(resp.Features, want) {
+		t.Errorf("features = %v, want %v", resp.Features, want)
+	}
+
+	unknown := NewServerInfoServer(buildinfo.Info{Version: "dev"}, nil)
+	if resp, _ := unknown.GetServerInfo(context.Background(), &serverv1.GetServerInfoRequest{}); resp.BuildTime != nil {
+		t.Errorf("build_time = %v, want unset for an unknown build time", resp.BuildTime)
+	}
+}
@@ -0,0 +1,107 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/streak/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("streak-service")
+
+// Service provides streak and goal business logic
+type Service struct {
+	repo   domain.Repository
+	logger *slog.Logger
+}
+
+// NewService creates a new streak service
+func NewService(repo domain.Repository, logger *slog.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetStreaks computes the user's completion streaks and weekly goal progress
+func (s *Service) GetStreaks(ctx context.Context) (*domain.Streaks, error) {
+	ctx, span := tracer.Start(ctx, "GetStreaks")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	days, err := s.repo.ListCompletionDays(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list completion days", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	now := time.Now()
+	completedThisWeek, err := s.repo.CountCompletedSince(ctx, userID, domain.WeekStart(now))
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to count weekly completions", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	goal, err := s.repo.GetWeeklyGoal(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get weekly goal", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	current, longest := domain.ComputeStreaks(days, now)
+	streaks := &domain.Streaks{
+		CurrentDays:       current,
+		LongestDays:       longest,
+		WeeklyGoal:        goal,
+		CompletedThisWeek: completedThisWeek,
+	}
+	if len(days) > 0 {
+		streaks.LastCompletionDate = &days[0]
+	}
+
+	return streaks, nil
+}
+
+// SetWeeklyGoal sets the user's weekly completion goal; nil clears it
+func (s *Service) SetWeeklyGoal(ctx context.Context, goal *int) error {
+	ctx, span := tracer.Start(ctx, "SetWeeklyGoal", trace.WithAttributes(
+		attribute.Bool("clear", goal == nil),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	if err := s.repo.SetWeeklyGoal(ctx, userID, goal); err != nil {
+		s.logger.ErrorContext(ctx, "failed to set weekly goal", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	if goal == nil {
+		s.logger.InfoContext(ctx, "weekly goal cleared", "owner_id", userID)
+	} else {
+		s.logger.InfoContext(ctx, "weekly goal updated", "owner_id", userID, "goal", *goal)
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for streak and goal persistence
+type Repository interface {
+	// ListCompletionDays returns distinct UTC days with a completion, newest first.
+	ListCompletionDays(ctx context.Context, ownerID string) ([]time.Time, error)
+	CountCompletedSince(ctx context.Context, ownerID string, since time.Time) (int, error)
+	// GetWeeklyGoal returns nil when the user has not set a goal.
+	GetWeeklyGoal(ctx context.Context, ownerID string) (*int, error)
+	// SetWeeklyGoal stores the goal; nil clears it.
+	SetWeeklyGoal(ctx context.Context, ownerID string, goal *int) error
+}
@@ -0,0 +1,74 @@
+package domain
+
+import "time"
+
+// Streaks summarizes a user's daily completion streaks and weekly goal progress
+type Streaks struct {
+	// CurrentDays counts consecutive days with at least one completion,
+	// ending today or yesterday. It is 0 once a full day has been missed.
+	CurrentDays int
+	// LongestDays is the longest run of consecutive completion days ever.
+	LongestDays int
+	// LastCompletionDate is the most recent day with a completion, nil if none.
+	LastCompletionDate *time.Time
+	// WeeklyGoal is the target completions per week, nil when no goal is set.
+	WeeklyGoal *int
+	// CompletedThisWeek counts completions since Monday 00:00 UTC.
+	CompletedThisWeek int
+}
+
+// GoalMet reports whether the weekly goal has been reached
+func (s *Streaks) GoalMet() bool {
+	return s.WeeklyGoal != nil && s.CompletedThisWeek >= *s.WeeklyGoal
+}
+
+// ComputeStreaks derives the current and longest streaks from distinct UTC
+// completion days sorted newest first.
+func ComputeStreaks(days []time.Time, today time.Time) (current, longest int) {
+	if len(days) == 0 {
+		return 0, 0
+	}
+
+	todayDate := truncateDay(today)
+	run := 1
+	longest = 1
+	currentOpen := false
+
+	// The current streak only counts if the newest day is today or yesterday
+	if gap := daysBetween(truncateDay(days[0]), todayDate); gap <= 1 {
+		currentOpen = true
+		current = 1
+	}
+
+	for i := 1; i < len(days); i++ {
+		if daysBetween(truncateDay(days[i]), truncateDay(days[i-1])) == 1 {
+			run++
+		} else {
+			run = 1
+			currentOpen = false
+		}
+		if currentOpen {
+			current = run
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+
+	return current, longest
+}
+
+// WeekStart returns Monday 00:00 UTC of the week containing t
+func WeekStart(t time.Time) time.Time {
+	day := truncateDay(t)
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+func truncateDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestComputeStreaks_CurrentEndsToday(t *testing.T) {
+	today := time.Date(2025, 6, 15, 18, 0, 0, 0, time.UTC)
+	days := []time.Time{day(2025, 6, 15), day(2025, 6, 14), day(2025, 6, 13), day(2025, 6, 10), day(2025, 6, 9)}
+
+	current, longest := ComputeStreaks(days, today)
+	if current != 3 || longest != 3 {
+		t.Fatalf("expected current=3 longest=3, got current=%d longest=%d", current, longest)
+	}
+}
+
+func TestComputeStreaks_CurrentEndsYesterday(t *testing.T) {
+	today := day(2025, 6, 15)
+	days := []time.Time{day(2025, 6, 14), day(2025, 6, 13)}
+
+	current, _ := ComputeStreaks(days, today)
+	if current != 2 {
+		t.Fatalf("expected current=2, got %d", current)
+	}
+}
+
+func TestComputeStreaks_BrokenStreakKeepsLongest(t *testing.T) {
+	today := day(2025, 6, 15)
+	days := []time.Time{day(2025, 6, 12), day(2025, 6, 3), day(2025, 6, 2), day(2025, 6, 1), day(2025, 5, 31)}
+
+	current, longest := ComputeStreaks(days, today)
+	if current != 0 || longest != 4 {
+		t.Fatalf("expected current=0 longest=4, got current=%d longest=%d", current, longest)
+	}
+}
+
+func TestComputeStreaks_Empty(t *testing.T) {
+	current, longest := ComputeStreaks(nil, day(2025, 6, 15))
+	if current != 0 || longest != 0 {
+		t.Fatalf("expected zero streaks, got current=%d longest=%d", current, longest)
+	}
+}
+
+func TestWeekStart_ReturnsMonday(t *testing.T) {
+	// 2025-06-15 is a Sunday
+	got := WeekStart(time.Date(2025, 6, 15, 23, 0, 0, 0, time.UTC))
+	if !got.Equal(day(2025, 6, 9)) {
+		t.Fatalf("expected 2025-06-09, got %v", got)
+	}
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+
+	streakv1 "github.com/slips-ai/slips-core/gen/go/streak/v1"
+	"github.com/slips-ai/slips-core/internal/streak/application"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxWeeklyGoal bounds the weekly completion goal to a sane value
+const maxWeeklyGoal = 1000
+
+// StreakServer implements the StreakService gRPC server
+type StreakServer struct {
+	streakv1.UnimplementedStreakServiceServer
+	service *application.Service
+}
+
+// NewStreakServer creates a new streak gRPC server
+func NewStreakServer(service *application.Service) *StreakServer {
+	return &StreakServer{
+		service: service,
+	}
+}
+
+// GetStreaks returns the caller's completion streaks and weekly goal progress
+func (s *StreakServer) GetStreaks(ctx context.Context, req *streakv1.GetStreaksRequest) (*streakv1.GetStreaksResponse, error) {
+	streaks, err := s.service.GetStreaks(ctx)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to get streaks")
+	}
+
+	resp := &streakv1.GetStreaksResponse{
+		CurrentStreakDays: int32(streaks.CurrentDays),
+		LongestStreakDays: int32(streaks.LongestDays),
+		CompletedThisWeek: int32(streaks.CompletedThisWeek),
+		WeeklyGoalMet:     streaks.GoalMet(),
+	}
+	if streaks.LastCompletionDate != nil {
+		formatted := streaks.LastCompletionDate.Format("2006-01-02")
+		resp.LastCompletionDate = &formatted
+	}
+	if streaks.WeeklyGoal != nil {
+		goal := int32(*streaks.WeeklyGoal)
+		resp.WeeklyGoal = &goal
+	}
+
+	return resp, nil
+}
+
+// SetWeeklyGoal sets or clears the caller's weekly completion goal
+func (s *StreakServer) SetWeeklyGoal(ctx context.Context, req *streakv1.SetWeeklyGoalRequest) (*streakv1.SetWeeklyGoalResponse, error) {
+	if req.WeeklyGoal < 0 || req.WeeklyGoal > maxWeeklyGoal {
+		return nil, status.Errorf(codes.InvalidArgument, "weekly_goal must be between 0 and %d", maxWeeklyGoal)
+	}
+
+	var goal *int
+	if req.WeeklyGoal > 0 {
+		g := int(req.WeeklyGoal)
+		goal = &g
+	}
+
+	if err := s.service.SetWeeklyGoal(ctx, goal); err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to set weekly goal")
+	}
+
+	return &streakv1.SetWeeklyGoalResponse{}, nil
+}
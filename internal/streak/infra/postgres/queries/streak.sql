@@ -0,0 +1,22 @@
+-- name: ListCompletionDays :many
+SELECT DISTINCT (completed_at AT TIME ZONE 'UTC')::date AS day
+FROM tasks
+WHERE owner_id = $1 AND completed_at IS NOT NULL
+ORDER BY day DESC;
+
+-- name: CountCompletedSince :one
+SELECT COUNT(*)
+FROM tasks
+WHERE owner_id = $1 AND completed_at >= sqlc.arg(since)::timestamptz;
+
+-- name: GetUserGoal :one
+SELECT owner_id, weekly_completion_goal, created_at, updated_at
+FROM user_goals
+WHERE owner_id = $1;
+
+-- name: UpsertWeeklyGoal :one
+INSERT INTO user_goals (owner_id, weekly_completion_goal)
+VALUES ($1, $2)
+ON CONFLICT (owner_id) DO UPDATE
+SET weekly_completion_goal = EXCLUDED.weekly_completion_goal, updated_at = NOW()
+RETURNING owner_id, weekly_completion_goal, created_at, updated_at;
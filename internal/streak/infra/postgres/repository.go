@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// StreakRepository implements domain.Repository using PostgreSQL
+type StreakRepository struct {
+	queries     *Queries
+	readQueries *Queries
+}
+
+// NewStreakRepository creates a new streak repository.
+// All lookups run on reader; only SetWeeklyGoal needs the primary.
+func NewStreakRepository(pool DBTX, reader DBTX) *StreakRepository {
+	return &StreakRepository{
+		queries:     New(pool),
+		readQueries: New(reader),
+	}
+}
+
+// ListCompletionDays returns distinct UTC days with a completion, newest first
+func (r *StreakRepository) ListCompletionDays(ctx context.Context, ownerID string) ([]time.Time, error) {
+	results, err := r.readQueries.ListCompletionDays(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]time.Time, 0, len(results))
+	for _, result := range results {
+		if result.Valid {
+			days = append(days, result.Time)
+		}
+	}
+	return days, nil
+}
+
+// CountCompletedSince counts tasks completed at or after the given instant
+func (r *StreakRepository) CountCompletedSince(ctx context.Context, ownerID string, since time.Time) (int, error) {
+	count, err := r.readQueries.CountCompletedSince(ctx, CountCompletedSinceParams{
+		OwnerID: ownerID,
+		Since:   pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// GetWeeklyGoal returns the user's weekly completion goal, or nil if unset
+func (r *StreakRepository) GetWeeklyGoal(ctx context.Context, ownerID string) (*int, error) {
+	result, err := r.readQueries.GetUserGoal(ctx, ownerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if !result.WeeklyCompletionGoal.Valid {
+		return nil, nil
+	}
+	goal := int(result.WeeklyCompletionGoal.Int32)
+	return &goal, nil
+}
+
+// SetWeeklyGoal stores the user's weekly completion goal; nil clears it
+func (r *StreakRepository) SetWeeklyGoal(ctx context.Context, ownerID string, goal *int) error {
+	var pgGoal pgtype.Int4
+	if goal != nil {
+		pgGoal = pgtype.Int4{Int32: int32(*goal), Valid: true}
+	}
+
+	_, err := r.queries.UpsertWeeklyGoal(ctx, UpsertWeeklyGoalParams{
+		OwnerID:              ownerID,
+		WeeklyCompletionGoal: pgGoal,
+	})
+	return err
+}
@@ -2,11 +2,14 @@ package application
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"github.com/google/uuid"
 	"github.com/slips-ai/slips-core/internal/tag/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
 	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -14,22 +17,34 @@ import (
 
 var tracer = otel.Tracer("tag-service")
 
+// TaskReader summarizes the tasks carrying a tag; the task service
+// implements it
+type TaskReader interface {
+	GetTagActivity(ctx context.Context, tagID uuid.UUID, recentLimit int) (*taskdomain.TagActivity, error)
+}
+
 // Service provides tag business logic
 type Service struct {
 	repo   domain.Repository
+	tasks  TaskReader
+	events changefeed.Publisher
 	logger *slog.Logger
 }
 
-// NewService creates a new tag service
-func NewService(repo domain.Repository, logger *slog.Logger) *Service {
+// NewService creates a new tag service that publishes changes to events
+func NewService(repo domain.Repository, tasks TaskReader, events changefeed.Publisher, logger *slog.Logger) *Service {
 	return &Service{
 		repo:   repo,
+		tasks:  tasks,
+		events: events,
 		logger: logger,
 	}
 }
 
-// CreateTag creates a new tag
-func (s *Service) CreateTag(ctx context.Context, name string) (*domain.Tag, error) {
+// CreateTag creates a new tag. A non-empty clientRequestID makes the call
+// idempotent: when the user already created a tag with it, that tag is
+// returned unchanged instead.
+func (s *Service) CreateTag(ctx context.Context, name, clientRequestID string) (*domain.Tag, error) {
 	ctx, span := tracer.Start(ctx, "CreateTag", trace.WithAttributes(
 		attribute.String("name", name),
 	))
@@ -44,16 +59,59 @@ func (s *Service) CreateTag(ctx context.Context, name string) (*domain.Tag, erro
 	}
 
 	tag := domain.NewTag(name, userID)
+	tag.ClientRequestID = clientRequestID
 	if err := s.repo.Create(ctx, tag); err != nil {
 		s.logger.ErrorContext(ctx, "failed to create tag", "error", err)
 		span.RecordError(err)
 		return nil, err
 	}
 
+	s.publish(ctx, userID, changefeed.OperationUpsert, tag.ID)
+
 	s.logger.InfoContext(ctx, "tag created", "id", tag.ID, "owner_id", userID)
 	return tag, nil
 }
 
+// CreateTags gets or creates a tag for each name, such as a list pasted from
+// another app. It returns one result per name, in order, telling which tags
+// are new.
+func (s *Service) CreateTags(ctx context.Context, names []string) ([]domain.CreatedTag, error) {
+	ctx, span := tracer.Start(ctx, "CreateTags", trace.WithAttributes(
+		attribute.Int("count", len(names)),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	normalized := make([]string, len(names))
+	for i, name := range names {
+		normalized[i] = domain.NormalizeName(name)
+	}
+	results, err := s.repo.GetOrCreateMany(ctx, normalized, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create tags", "count", len(names), "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	created := 0
+	for _, result := range results {
+		if result.Created {
+			s.publish(ctx, userID, changefeed.OperationUpsert, result.Tag.ID)
+			created++
+		}
+	}
+
+	s.logger.InfoContext(ctx, "tags created", "count", len(names), "created", created, "owner_id", userID)
+	return results, nil
+}
+
 // GetTag retrieves a tag by ID
 func (s *Service) GetTag(ctx context.Context, id uuid.UUID) (*domain.Tag, error) {
 	ctx, span := tracer.Start(ctx, "GetTag", trace.WithAttributes(
@@ -79,6 +137,32 @@ func (s *Service) GetTag(ctx context.Context, id uuid.UUID) (*domain.Tag, error)
 	return tag, nil
 }
 
+// GetTagDetail retrieves a tag together with the counts of its open and
+// completed tasks and up to recentLimit of its most recently updated tasks,
+// so a tag page can be rendered from one call
+func (s *Service) GetTagDetail(ctx context.Context, id uuid.UUID, recentLimit int) (*domain.Tag, *taskdomain.TagActivity, error) {
+	ctx, span := tracer.Start(ctx, "GetTagDetail", trace.WithAttributes(
+		attribute.String("id", id.String()),
+		attribute.Int("recent_limit", recentLimit),
+	))
+	defer span.End()
+
+	tag, err := s.GetTag(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		return nil, nil, err
+	}
+
+	activity, err := s.tasks.GetTagActivity(ctx, id, recentLimit)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get tag activity", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, nil, err
+	}
+
+	return tag, activity, nil
+}
+
 // UpdateTag updates a tag
 func (s *Service) UpdateTag(ctx context.Context, id uuid.UUID, name string) (*domain.Tag, error) {
 	ctx, span := tracer.Start(ctx, "UpdateTag", trace.WithAttributes(
@@ -109,14 +193,22 @@ func (s *Service) UpdateTag(ctx context.Context, id uuid.UUID, name string) (*do
 		return nil, err
 	}
 
+	s.publish(ctx, userID, changefeed.OperationUpsert, tag.ID)
+
 	s.logger.InfoContext(ctx, "tag updated", "id", tag.ID)
 	return tag, nil
 }
 
-// DeleteTag deletes a tag
-func (s *Service) DeleteTag(ctx context.Context, id uuid.UUID) error {
+// DeleteTag deletes a tag and returns the number of tasks that carried it.
+// When reassignTo is not nil, those tasks get that tag instead, in the same
+// transaction; otherwise the tag is just removed from them. With
+// validateOnly set the request is checked and the count returned, but
+// nothing is deleted.
+func (s *Service) DeleteTag(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID, validateOnly bool) (int64, error) {
 	ctx, span := tracer.Start(ctx, "DeleteTag", trace.WithAttributes(
 		attribute.String("id", id.String()),
+		attribute.Bool("reassign", reassignTo != nil),
+		attribute.Bool("validate_only", validateOnly),
 	))
 	defer span.End()
 
@@ -125,17 +217,82 @@ func (s *Service) DeleteTag(ctx context.Context, id uuid.UUID) error {
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
 		span.RecordError(err)
-		return err
+		return 0, err
 	}
 
-	if err := s.repo.Delete(ctx, id, userID); err != nil {
+	if reassignTo != nil {
+		if *reassignTo == id {
+			return 0, domain.ErrReassignToSelf
+		}
+		if _, err := s.repo.Get(ctx, *reassignTo, userID); err != nil {
+			if errors.Is(err, domain.ErrTagNotFound) {
+				return 0, domain.ErrReassignTagNotFound
+			}
+			s.logger.ErrorContext(ctx, "failed to get tag to reassign tasks to", "id", *reassignTo, "error", err)
+			span.RecordError(err)
+			return 0, err
+		}
+	}
+
+	if validateOnly {
+		affected, err := s.repo.CountTasks(ctx, id, userID)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to count tasks of tag", "id", id, "error", err)
+			span.RecordError(err)
+			return 0, err
+		}
+		return affected, nil
+	}
+
+	affected, err := s.repo.Delete(ctx, id, userID, reassignTo, modifierFromContext(ctx))
+	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to delete tag", "id", id, "error", err)
 		span.RecordError(err)
-		return err
+		return 0, err
+	}
+
+	// Deleting a tag also removes it from its tasks; watchers drop it from
+	// their copies instead of receiving an event per task. Reassigned tasks
+	// gained a tag they cannot infer, so watchers reload tasks.
+	s.publish(ctx, userID, changefeed.OperationDelete, id)
+	if reassignTo != nil && affected > 0 {
+		s.events.Publish(ctx, changefeed.Event{
+			OwnerID:   userID,
+			Resource:  changefeed.ResourceTask,
+			Operation: changefeed.OperationResync,
+		})
 	}
 
-	s.logger.InfoContext(ctx, "tag deleted", "id", id)
-	return nil
+	s.logger.InfoContext(ctx, "tag deleted", "id", id, "affected_tasks", affected)
+	return affected, nil
+}
+
+// modifierFromContext attributes a change to the tasks of a tag to the
+// authenticated caller of ctx, the same way the task service does
+func modifierFromContext(ctx context.Context) taskdomain.Modifier {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return taskdomain.Modifier{Source: taskdomain.ChangeSourceSystem}
+	}
+	if principal.IsAgent() {
+		return taskdomain.Modifier{
+			Source:    taskdomain.ChangeSourceAgent,
+			ClientID:  principal.ClientID,
+			TokenID:   principal.TokenID,
+			TokenName: principal.TokenName,
+		}
+	}
+	return taskdomain.Modifier{Source: taskdomain.ChangeSourceUser, ClientID: principal.ClientID}
+}
+
+// publish reports a change to one of the owner's tags to watchers
+func (s *Service) publish(ctx context.Context, ownerID string, operation changefeed.Operation, id uuid.UUID) {
+	s.events.Publish(ctx, changefeed.Event{
+		OwnerID:   ownerID,
+		Resource:  changefeed.ResourceTag,
+		Operation: operation,
+		ID:        id,
+	})
 }
 
 // ListTags lists tags
@@ -0,0 +1,98 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/tag/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetTagSettings returns the caller's tag settings
+func (s *Service) GetTagSettings(ctx context.Context) (*domain.Settings, error) {
+	ctx, span := tracer.Start(ctx, "GetTagSettings")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	settings, err := s.repo.GetSettings(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get tag settings", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpdateTagSettings stores the caller's tag settings. Settings must be valid.
+func (s *Service) UpdateTagSettings(ctx context.Context, settings *domain.Settings) error {
+	ctx, span := tracer.Start(ctx, "UpdateTagSettings", trace.WithAttributes(
+		attribute.String("orphan_cleanup", string(settings.OrphanCleanup)),
+		attribute.Int("orphan_cleanup_after_days", settings.OrphanCleanupAfterDays),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	if err := s.repo.SetSettings(ctx, userID, settings); err != nil {
+		s.logger.ErrorContext(ctx, "failed to set tag settings", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "tag settings updated", "owner_id", userID,
+		"orphan_cleanup", settings.OrphanCleanup, "orphan_cleanup_after_days", settings.OrphanCleanupAfterDays)
+	return nil
+}
+
+// RunOrphanCleanup deletes tags no longer used by any task according to each
+// owner's orphan cleanup setting. It is run by the scheduled orphan tag job
+// for all users at once, so task writes never pay for the cleanup. Tags are
+// first marked with the time they were found orphaned, which is what
+// after-days policies are measured from.
+func (s *Service) RunOrphanCleanup(ctx context.Context) (*domain.OrphanCleanupReport, error) {
+	ctx, span := tracer.Start(ctx, "RunOrphanCleanup")
+	defer span.End()
+
+	orphaned, adopted, err := s.repo.MarkOrphans(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to mark orphan tags", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	deleted, err := s.repo.DeleteExpiredOrphans(ctx, time.Now())
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete orphan tags", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	report := &domain.OrphanCleanupReport{
+		Orphaned: orphaned,
+		Adopted:  adopted,
+		Deleted:  deleted,
+	}
+	span.SetAttributes(
+		attribute.Int64("orphaned", orphaned),
+		attribute.Int64("adopted", adopted),
+		attribute.Int64("deleted", deleted),
+	)
+	s.logger.InfoContext(ctx, "orphan tag cleanup finished",
+		"orphaned", orphaned, "adopted", adopted, "deleted", deleted)
+	return report, nil
+}
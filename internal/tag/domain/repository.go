@@ -2,18 +2,44 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
 )
 
 // Repository defines the interface for tag persistence
 type Repository interface {
+	// Create stores a new tag. When the owner already created a tag with
+	// the same ClientRequestID, nothing is stored and tag is replaced by the
+	// existing one.
 	Create(ctx context.Context, tag *Tag) error
+	// Get, GetByName and Update return ErrTagNotFound for tags that do not
+	// exist or belong to another owner
 	Get(ctx context.Context, id uuid.UUID, ownerID string) (*Tag, error)
 	GetByName(ctx context.Context, name, ownerID string) (*Tag, error)
 	GetOrCreate(ctx context.Context, name, ownerID string) (*Tag, error)
+	// GetOrCreateMany gets or creates a tag for each name in one round trip.
+	// It returns one result per name, in order; a repeated name resolves to
+	// the same tag and only its first occurrence can be Created.
+	GetOrCreateMany(ctx context.Context, names []string, ownerID string) ([]CreatedTag, error)
 	Update(ctx context.Context, tag *Tag) error
-	Delete(ctx context.Context, id uuid.UUID, ownerID string) error
-	DeleteOrphans(ctx context.Context, ownerID string) error
+	// Delete deletes a tag and returns the number of tasks that carried it.
+	// When reassignTo is not nil, those tasks get that tag instead in the
+	// same transaction; the ones that did not have it yet are attributed to
+	// by.
+	Delete(ctx context.Context, id uuid.UUID, ownerID string, reassignTo *uuid.UUID, by taskdomain.Modifier) (int64, error)
+	// CountTasks returns the number of tasks carrying the tag, as Delete
+	// reports it; 0 when the owner has no such tag.
+	CountTasks(ctx context.Context, id uuid.UUID, ownerID string) (int64, error)
 	List(ctx context.Context, ownerID string, limit, offset int) ([]*Tag, error)
+	// GetSettings returns the owner's tag settings, or the defaults if never set.
+	GetSettings(ctx context.Context, ownerID string) (*Settings, error)
+	SetSettings(ctx context.Context, ownerID string, settings *Settings) error
+	// MarkOrphans records when tags of any owner lost their last task and
+	// forgets it for tags that are used again. It returns both counts.
+	MarkOrphans(ctx context.Context) (orphaned, adopted int64, err error)
+	// DeleteExpiredOrphans deletes tags of any owner marked as orphan for at
+	// least MinOrphanAge whose settings no longer keep them at now.
+	DeleteExpiredOrphans(ctx context.Context, now time.Time) (int64, error)
 }
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MaxOrphanCleanupAfterDays bounds the orphan tag grace period to about ten years
+const MaxOrphanCleanupAfterDays = 3650
+
+// MinOrphanAge is how long a tag stays marked as orphan before any setting
+// lets it be deleted. Tasks get their tags before they are stored, so a tag
+// found orphaned moments ago may be about to be used.
+const MinOrphanAge = time.Hour
+
+// OrphanCleanup selects when tags no longer used by any task are deleted
+type OrphanCleanup string
+
+const (
+	// OrphanCleanupImmediate deletes orphan tags on the next cleanup run once
+	// they are MinOrphanAge old (default)
+	OrphanCleanupImmediate OrphanCleanup = "immediate"
+	// OrphanCleanupAfterDays deletes orphan tags once unused for Settings.OrphanCleanupAfterDays
+	OrphanCleanupAfterDays OrphanCleanup = "after_days"
+	// OrphanCleanupNever keeps orphan tags until the user deletes them
+	OrphanCleanupNever OrphanCleanup = "never"
+)
+
+// ErrInvalidSettings is returned for tag settings that cannot be stored
+var ErrInvalidSettings = errors.New("invalid tag settings")
+
+// Settings holds a user's tag preferences
+type Settings struct {
+	OrphanCleanup OrphanCleanup
+	// OrphanCleanupAfterDays is only used with OrphanCleanupAfterDays.
+	OrphanCleanupAfterDays int
+}
+
+// DefaultSettings returns the settings of users who never changed them
+func DefaultSettings() *Settings {
+	return &Settings{OrphanCleanup: OrphanCleanupImmediate}
+}
+
+// Validate checks that the policy is known and has a grace period when it needs one
+func (s *Settings) Validate() error {
+	switch s.OrphanCleanup {
+	case OrphanCleanupImmediate, OrphanCleanupNever:
+		if s.OrphanCleanupAfterDays != 0 {
+			return fmt.Errorf("%w: orphan cleanup days are only allowed with %q", ErrInvalidSettings, OrphanCleanupAfterDays)
+		}
+	case OrphanCleanupAfterDays:
+		if s.OrphanCleanupAfterDays < 1 || s.OrphanCleanupAfterDays > MaxOrphanCleanupAfterDays {
+			return fmt.Errorf("%w: orphan cleanup days must be between 1 and %d", ErrInvalidSettings, MaxOrphanCleanupAfterDays)
+		}
+	default:
+		return fmt.Errorf("%w: unknown orphan cleanup %q", ErrInvalidSettings, s.OrphanCleanup)
+	}
+	return nil
+}
+
+// OrphanCleanupReport summarizes one run of the orphan tag cleanup job
+type OrphanCleanupReport struct {
+	// Orphaned is the number of tags newly found without tasks.
+	Orphaned int64
+	// Adopted is the number of orphan tags that are used by a task again.
+	Adopted int64
+	// Deleted is the number of orphan tags deleted under their owner's policy.
+	Deleted int64
+}
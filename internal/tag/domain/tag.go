@@ -1,11 +1,34 @@
 package domain
 
 import (
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+	"golang.org/x/text/unicode/norm"
 )
 
+var (
+	// ErrReassignToSelf is returned when a tag's tasks are to be reassigned
+	// to the tag being deleted
+	ErrReassignToSelf = errors.New("cannot reassign tasks to the tag being deleted")
+	// ErrReassignTagNotFound is returned when the tag to reassign tasks to
+	// does not exist
+	ErrReassignTagNotFound = errors.New("tag to reassign tasks to not found")
+	// ErrTagNameConflict is returned when a tag is created or renamed to the
+	// name of another tag of its owner
+	ErrTagNameConflict = domainerrors.New(domainerrors.ErrConflict, "a tag with this name already exists")
+	// ErrTagNotFound is returned for tags that do not exist or belong to
+	// another user
+	ErrTagNotFound = domainerrors.New(domainerrors.ErrNotFound, "tag not found")
+)
+
+// MaxCreateTagsSize is the maximum number of names accepted when creating
+// tags in a batch
+const MaxCreateTagsSize = 100
+
 // Tag represents a tag entity
 type Tag struct {
 	ID        uuid.UUID
@@ -13,20 +36,40 @@ type Tag struct {
 	OwnerID   string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// ClientRequestID is the ID an offline client gave the tag when creating
+	// it, unique per owner. It is empty when none was given.
+	ClientRequestID string
 }
 
-// NewTag creates a new tag
+// CreatedTag is the tag a name resolved to when getting or creating tags in
+// a batch
+type CreatedTag struct {
+	Tag *Tag
+	// Created is true when the tag did not exist before
+	Created bool
+}
+
+// NewTag creates a new tag with the normalized name
 // Note: CreatedAt and UpdatedAt timestamps are not set here.
 // They will be populated by the database on insertion (DEFAULT NOW()).
 func NewTag(name, ownerID string) *Tag {
 	return &Tag{
 		ID:      uuid.New(),
-		Name:    name,
+		Name:    NormalizeName(name),
 		OwnerID: ownerID,
 	}
 }
 
 // Update updates the tag
 func (t *Tag) Update(name string) {
-	t.Name = name
+	t.Name = NormalizeName(name)
+}
+
+// NormalizeName returns the stored form of a tag name: in Unicode NFC, with
+// leading and trailing whitespace dropped and every other run of whitespace,
+// including tabs, newlines and ideographic spaces, turned into a single
+// space. Names pasted from elsewhere then match the tags already typed in.
+// Normalizing a normalized name returns it unchanged.
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(norm.NFC.String(name)), " ")
 }
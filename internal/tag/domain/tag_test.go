@@ -0,0 +1,20 @@
+package domain
+
+import "testing"
+
+func TestNormalizeName(t *testing.T) {
+	tests := map[string]string{
+		"work":                "work",
+		"cafe\u0301":          "caf\u00e9",
+		"  deep   work ":      "deep work",
+		"deep\twork\n":        "deep work",
+		"仕事\u3000メモ":          "仕事 メモ",
+		"\u00a0errands\u00a0": "errands",
+		"👨‍👩‍👧‍👦 family":      "👨‍👩‍👧‍👦 family",
+	}
+	for in, want := range tests {
+		if got := NormalizeName(in); got != want {
+			t.Errorf("NormalizeName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -2,16 +2,23 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	tagv1 "github.com/slips-ai/slips-core/gen/go/tag/v1"
 	"github.com/slips-ai/slips-core/internal/tag/application"
+	"github.com/slips-ai/slips-core/internal/tag/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
 	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"github.com/slips-ai/slips-core/pkg/resourcename"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// maxRecentTasks is the most recently updated tasks GetTag returns at most
+const maxRecentTasks = 50
+
 // TagServer implements the TagService gRPC server
 type TagServer struct {
 	tagv1.UnimplementedTagServiceServer
@@ -32,21 +39,53 @@ func (s *TagServer) CreateTag(ctx context.Context, req *tagv1.CreateTagRequest)
 		return nil, err
 	}
 
-	tag, err := s.service.CreateTag(ctx, req.Name)
+	if err := grpcerrors.ValidateLength(req.ClientRequestId, "client_request_id", grpcerrors.MaxClientRequestIDLength); err != nil {
+		return nil, err
+	}
+
+	tag, err := s.service.CreateTag(ctx, req.Name, req.ClientRequestId)
 	if err != nil {
 		return nil, grpcerrors.ToGRPCError(err, "failed to create tag")
 	}
 
 	return &tagv1.CreateTagResponse{
-		Tag: &tagv1.Tag{
-			Id:        tag.ID.String(),
-			Name:      tag.Name,
-			CreatedAt: timestamppb.New(tag.CreatedAt),
-			UpdatedAt: timestamppb.New(tag.UpdatedAt),
-		},
+		Tag: tagToProto(tag),
 	}, nil
 }
 
+// CreateTags gets or creates a tag for each name
+func (s *TagServer) CreateTags(ctx context.Context, req *tagv1.CreateTagsRequest) (*tagv1.CreateTagsResponse, error) {
+	// Validate input
+	if len(req.Names) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "names cannot be empty")
+	}
+	if len(req.Names) > domain.MaxCreateTagsSize {
+		return nil, status.Errorf(codes.InvalidArgument, "names must contain at most %d entries", domain.MaxCreateTagsSize)
+	}
+	for i, name := range req.Names {
+		if err := grpcerrors.ValidateTagName(name); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "names[%d]: %s", i, status.Convert(err).Message())
+		}
+	}
+
+	results, err := s.service.CreateTags(ctx, req.Names)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to create tags")
+	}
+
+	resp := &tagv1.CreateTagsResponse{
+		Results: make([]*tagv1.CreateTagsResult, len(results)),
+	}
+	for i, result := range results {
+		resp.Results[i] = &tagv1.CreateTagsResult{
+			Name:    req.Names[i],
+			Tag:     tagToProto(result.Tag),
+			Created: result.Created,
+		}
+	}
+	return resp, nil
+}
+
 // GetTag retrieves a tag by ID
 func (s *TagServer) GetTag(ctx context.Context, req *tagv1.GetTagRequest) (*tagv1.GetTagResponse, error) {
 	id, err := uuid.Parse(req.Id)
@@ -54,19 +93,76 @@ func (s *TagServer) GetTag(ctx context.Context, req *tagv1.GetTagRequest) (*tagv
 		return nil, status.Error(codes.InvalidArgument, "invalid tag ID format")
 	}
 
-	tag, err := s.service.GetTag(ctx, id)
+	if req.RecentTaskCount < 0 || req.RecentTaskCount > maxRecentTasks {
+		return nil, status.Errorf(codes.InvalidArgument, "recent_task_count must be between 0 and %d", maxRecentTasks)
+	}
+
+	if req.RecentTaskCount == 0 && !req.IncludeStats {
+		tag, err := s.service.GetTag(ctx, id)
+		if err != nil {
+			return nil, grpcerrors.ToGRPCError(err, "failed to get tag")
+		}
+		return &tagv1.GetTagResponse{
+			Tag: tagToProto(tag),
+		}, nil
+	}
+
+	tag, activity, err := s.service.GetTagDetail(ctx, id, int(req.RecentTaskCount))
 	if err != nil {
 		return nil, grpcerrors.ToGRPCError(err, "failed to get tag")
 	}
 
-	return &tagv1.GetTagResponse{
-		Tag: &tagv1.Tag{
-			Id:        tag.ID.String(),
-			Name:      tag.Name,
-			CreatedAt: timestamppb.New(tag.CreatedAt),
-			UpdatedAt: timestamppb.New(tag.UpdatedAt),
-		},
-	}, nil
+	resp := &tagv1.GetTagResponse{
+		Tag:         tagToProto(tag),
+		RecentTasks: make([]*tagv1.TagTask, len(activity.RecentTasks)),
+	}
+	for i, task := range activity.RecentTasks {
+		resp.RecentTasks[i] = tagTaskToProto(task)
+	}
+	if req.IncludeStats {
+		resp.Stats = &tagv1.TagTaskStats{
+			OpenCount:      int32(activity.OpenCount),
+			CompletedCount: int32(activity.CompletedCount),
+		}
+	}
+	return resp, nil
+}
+
+// tagToProto converts a domain tag to its protobuf form
+func tagToProto(tag *domain.Tag) *tagv1.Tag {
+	return &tagv1.Tag{
+		Id:              tag.ID.String(),
+		Name:            tag.Name,
+		CreatedAt:       timestamppb.New(tag.CreatedAt),
+		UpdatedAt:       timestamppb.New(tag.UpdatedAt),
+		ClientRequestId: tag.ClientRequestID,
+		ResourceName:    resourcename.Tag{User: tag.OwnerID, Tag: tag.ID}.String(),
+	}
+}
+
+// tagTaskToProto summarizes a task for a tag page
+func tagTaskToProto(task *taskdomain.Task) *tagv1.TagTask {
+	protoTask := &tagv1.TagTask{
+		Id:        task.ID.String(),
+		Title:     task.Title,
+		UpdatedAt: timestamppb.New(task.UpdatedAt),
+		Pinned:    task.Pinned,
+	}
+	if task.CompletedAt != nil {
+		protoTask.CompletedAt = timestamppb.New(*task.CompletedAt)
+	}
+	if task.ArchivedAt != nil {
+		protoTask.ArchivedAt = timestamppb.New(*task.ArchivedAt)
+	}
+	if task.StartDate != nil {
+		formatted := task.StartDate.Format("2006-01-02")
+		protoTask.StartDate = &formatted
+	}
+	if task.Deadline != nil {
+		formatted := task.Deadline.Format("2006-01-02")
+		protoTask.Deadline = &formatted
+	}
+	return protoTask
 }
 
 // UpdateTag updates a tag
@@ -87,12 +183,7 @@ func (s *TagServer) UpdateTag(ctx context.Context, req *tagv1.UpdateTagRequest)
 	}
 
 	return &tagv1.UpdateTagResponse{
-		Tag: &tagv1.Tag{
-			Id:        tag.ID.String(),
-			Name:      tag.Name,
-			CreatedAt: timestamppb.New(tag.CreatedAt),
-			UpdatedAt: timestamppb.New(tag.UpdatedAt),
-		},
+		Tag: tagToProto(tag),
 	}, nil
 }
 
@@ -103,11 +194,28 @@ func (s *TagServer) DeleteTag(ctx context.Context, req *tagv1.DeleteTagRequest)
 		return nil, status.Error(codes.InvalidArgument, "invalid tag ID format")
 	}
 
-	if err := s.service.DeleteTag(ctx, id); err != nil {
+	var reassignTo *uuid.UUID
+	if tasks, ok := req.Tasks.(*tagv1.DeleteTagRequest_ReassignToTagId); ok {
+		targetID, err := uuid.Parse(tasks.ReassignToTagId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid reassign_to_tag_id format")
+		}
+		reassignTo = &targetID
+	}
+
+	affected, err := s.service.DeleteTag(ctx, id, reassignTo, req.ValidateOnly)
+	switch {
+	case errors.Is(err, domain.ErrReassignToSelf):
+		return nil, status.Error(codes.InvalidArgument, "reassign_to_tag_id must differ from the deleted tag")
+	case errors.Is(err, domain.ErrReassignTagNotFound):
+		return nil, status.Error(codes.NotFound, "reassign_to_tag_id not found")
+	case err != nil:
 		return nil, grpcerrors.ToGRPCError(err, "failed to delete tag")
 	}
 
-	return &tagv1.DeleteTagResponse{}, nil
+	return &tagv1.DeleteTagResponse{
+		AffectedTaskCount: affected,
+	}, nil
 }
 
 // ListTags lists tags with pagination
@@ -140,12 +248,7 @@ func (s *TagServer) ListTags(ctx context.Context, req *tagv1.ListTagsRequest) (*
 
 	protoTags := make([]*tagv1.Tag, len(tags))
 	for i, tag := range tags {
-		protoTags[i] = &tagv1.Tag{
-			Id:        tag.ID.String(),
-			Name:      tag.Name,
-			CreatedAt: timestamppb.New(tag.CreatedAt),
-			UpdatedAt: timestamppb.New(tag.UpdatedAt),
-		}
+		protoTags[i] = tagToProto(tag)
 	}
 
 	// Note: next_page_token is not implemented yet
@@ -154,3 +257,70 @@ func (s *TagServer) ListTags(ctx context.Context, req *tagv1.ListTagsRequest) (*
 		Tags: protoTags,
 	}, nil
 }
+
+// GetTagSettings returns the caller's tag settings
+func (s *TagServer) GetTagSettings(ctx context.Context, req *tagv1.GetTagSettingsRequest) (*tagv1.GetTagSettingsResponse, error) {
+	settings, err := s.service.GetTagSettings(ctx)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to get tag settings")
+	}
+
+	return &tagv1.GetTagSettingsResponse{
+		Settings: SettingsToProto(settings),
+	}, nil
+}
+
+// UpdateTagSettings replaces the caller's tag settings
+func (s *TagServer) UpdateTagSettings(ctx context.Context, req *tagv1.UpdateTagSettingsRequest) (*tagv1.UpdateTagSettingsResponse, error) {
+	if req.Settings == nil {
+		return nil, status.Error(codes.InvalidArgument, "settings is required")
+	}
+
+	settings := SettingsFromProto(req.Settings)
+	if err := settings.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.service.UpdateTagSettings(ctx, settings); err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to update tag settings")
+	}
+
+	return &tagv1.UpdateTagSettingsResponse{
+		Settings: SettingsToProto(settings),
+	}, nil
+}
+
+// SettingsToProto converts tag settings. It is shared with the admin export.
+func SettingsToProto(settings *domain.Settings) *tagv1.TagSettings {
+	protoSettings := &tagv1.TagSettings{
+		OrphanCleanupAfterDays: int32(settings.OrphanCleanupAfterDays),
+	}
+	switch settings.OrphanCleanup {
+	case domain.OrphanCleanupImmediate:
+		protoSettings.OrphanCleanup = tagv1.OrphanTagCleanup_ORPHAN_TAG_CLEANUP_IMMEDIATE
+	case domain.OrphanCleanupAfterDays:
+		protoSettings.OrphanCleanup = tagv1.OrphanTagCleanup_ORPHAN_TAG_CLEANUP_AFTER_DAYS
+	case domain.OrphanCleanupNever:
+		protoSettings.OrphanCleanup = tagv1.OrphanTagCleanup_ORPHAN_TAG_CLEANUP_NEVER
+	}
+	return protoSettings
+}
+
+// SettingsFromProto converts request settings; unknown enum values are kept
+// as an unknown policy so Validate rejects them
+func SettingsFromProto(settings *tagv1.TagSettings) *domain.Settings {
+	converted := &domain.Settings{
+		OrphanCleanupAfterDays: int(settings.OrphanCleanupAfterDays),
+	}
+	switch settings.OrphanCleanup {
+	case tagv1.OrphanTagCleanup_ORPHAN_TAG_CLEANUP_UNSPECIFIED, tagv1.OrphanTagCleanup_ORPHAN_TAG_CLEANUP_IMMEDIATE:
+		converted.OrphanCleanup = domain.OrphanCleanupImmediate
+	case tagv1.OrphanTagCleanup_ORPHAN_TAG_CLEANUP_AFTER_DAYS:
+		converted.OrphanCleanup = domain.OrphanCleanupAfterDays
+	case tagv1.OrphanTagCleanup_ORPHAN_TAG_CLEANUP_NEVER:
+		converted.OrphanCleanup = domain.OrphanCleanupNever
+	default:
+		converted.OrphanCleanup = domain.OrphanCleanup(settings.OrphanCleanup.String())
+	}
+	return converted
+}
@@ -8,34 +8,171 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiUsageDay struct {
+	UserID      string      `json:"user_id"`
+	Day         pgtype.Date `json:"day"`
+	MethodClass string      `json:"method_class"`
+	AuthType    string      `json:"auth_type"`
+	Requests    int64       `json:"requests"`
+	RateLimited int64       `json:"rate_limited"`
+}
+
+type AppPassword struct {
+	ID           pgtype.UUID        `json:"id"`
+	UserID       string             `json:"user_id"`
+	Name         string             `json:"name"`
+	PasswordHash string             `json:"password_hash"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	LastUsedAt   pgtype.Timestamptz `json:"last_used_at"`
+}
+
+type Approval struct {
+	ID                   pgtype.UUID        `json:"id"`
+	OwnerID              string             `json:"owner_id"`
+	Action               string             `json:"action"`
+	TaskIds              []pgtype.UUID      `json:"task_ids"`
+	RequestedByTokenID   pgtype.UUID        `json:"requested_by_token_id"`
+	RequestedByTokenName string             `json:"requested_by_token_name"`
+	Status               string             `json:"status"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	DecidedAt            pgtype.Timestamptz `json:"decided_at"`
+}
+
+type ApprovalSetting struct {
+	OwnerID                    string             `json:"owner_id"`
+	RequireAgentDeleteApproval bool               `json:"require_agent_delete_approval"`
+	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type DeviceAuthorization struct {
+	DeviceCodeHash        string             `json:"device_code_hash"`
+	UserCode              string             `json:"user_code"`
+	OauthState            string             `json:"oauth_state"`
+	AuthorizationUrl      string             `json:"authorization_url"`
+	IntervalSeconds       int32              `json:"interval_seconds"`
+	ExpiresAt             pgtype.Timestamptz `json:"expires_at"`
+	LastPolledAt          pgtype.Timestamptz `json:"last_polled_at"`
+	UserID                pgtype.Text        `json:"user_id"`
+	AccessToken           pgtype.Text        `json:"access_token"`
+	AccessTokenExpiresAt  pgtype.Int8        `json:"access_token_expires_at"`
+	RefreshToken          pgtype.Text        `json:"refresh_token"`
+	RefreshTokenExpiresAt pgtype.Int8        `json:"refresh_token_expires_at"`
+	TokenType             pgtype.Text        `json:"token_type"`
+	ApprovedAt            pgtype.Timestamptz `json:"approved_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+}
+
+type DigestPreference struct {
+	OwnerID    string             `json:"owner_id"`
+	Frequency  string             `json:"frequency"`
+	Timezone   string             `json:"timezone"`
+	SendHour   int16              `json:"send_hour"`
+	Weekday    int16              `json:"weekday"`
+	LastSentAt pgtype.Timestamptz `json:"last_sent_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Feed struct {
+	ID            pgtype.UUID        `json:"id"`
+	OwnerID       string             `json:"owner_id"`
+	Name          string             `json:"name"`
+	TagID         pgtype.UUID        `json:"tag_id"`
+	SavedFilterID pgtype.UUID        `json:"saved_filter_id"`
+	TokenHash     string             `json:"token_hash"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	LastFetchedAt pgtype.Timestamptz `json:"last_fetched_at"`
+}
+
 type McpToken struct {
-	ID         pgtype.UUID      `json:"id"`
-	Token      pgtype.UUID      `json:"token"`
-	UserID     string           `json:"user_id"`
-	Name       string           `json:"name"`
-	CreatedAt  pgtype.Timestamp `json:"created_at"`
-	ExpiresAt  pgtype.Timestamp `json:"expires_at"`
-	LastUsedAt pgtype.Timestamp `json:"last_used_at"`
-	IsActive   bool             `json:"is_active"`
+	ID                  pgtype.UUID      `json:"id"`
+	Token               pgtype.UUID      `json:"token"`
+	UserID              string           `json:"user_id"`
+	Name                string           `json:"name"`
+	CreatedAt           pgtype.Timestamp `json:"created_at"`
+	ExpiresAt           pgtype.Timestamp `json:"expires_at"`
+	LastUsedAt          pgtype.Timestamp `json:"last_used_at"`
+	IsActive            bool             `json:"is_active"`
+	TokenHash           string           `json:"token_hash"`
+	RequestsPerMinute   int32            `json:"requests_per_minute"`
+	DailyMutationBudget int32            `json:"daily_mutation_budget"`
 }
 
-type Tag struct {
+type OauthState struct {
+	State               string             `json:"state"`
+	Provider            string             `json:"provider"`
+	RedirectUrl         string             `json:"redirect_url"`
+	CodeChallenge       pgtype.Text        `json:"code_challenge"`
+	CodeChallengeMethod pgtype.Text        `json:"code_challenge_method"`
+	ExpiresAt           pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+}
+
+type QueueJob struct {
+	ID          pgtype.UUID        `json:"id"`
+	Kind        string             `json:"kind"`
+	Payload     []byte             `json:"payload"`
+	Status      string             `json:"status"`
+	Attempts    int32              `json:"attempts"`
+	MaxAttempts int32              `json:"max_attempts"`
+	RunAt       pgtype.Timestamptz `json:"run_at"`
+	LockedUntil pgtype.Timestamptz `json:"locked_until"`
+	LastError   pgtype.Text        `json:"last_error"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type SavedFilter struct {
 	ID        pgtype.UUID        `json:"id"`
+	OwnerID   string             `json:"owner_id"`
 	Name      string             `json:"name"`
+	Criteria  []byte             `json:"criteria"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
-	OwnerID   string             `json:"owner_id"`
+}
+
+type Tag struct {
+	ID              pgtype.UUID        `json:"id"`
+	Name            string             `json:"name"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	OwnerID         string             `json:"owner_id"`
+	OrphanedAt      pgtype.Timestamptz `json:"orphaned_at"`
+	ClientRequestID pgtype.Text        `json:"client_request_id"`
+}
+
+type TagSetting struct {
+	OwnerID                string             `json:"owner_id"`
+	OrphanCleanup          string             `json:"orphan_cleanup"`
+	OrphanCleanupAfterDays pgtype.Int4        `json:"orphan_cleanup_after_days"`
+	CreatedAt              pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
 }
 
 type Task struct {
-	ID         pgtype.UUID        `json:"id"`
-	Title      string             `json:"title"`
-	Notes      string             `json:"notes"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	OwnerID    string             `json:"owner_id"`
-	ArchivedAt pgtype.Timestamptz `json:"archived_at"`
-	StartDate  pgtype.Date        `json:"start_date"`
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
 }
 
 type TaskChecklistItem struct {
@@ -48,19 +185,107 @@ type TaskChecklistItem struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type TaskGeofence struct {
+	TaskID       pgtype.UUID        `json:"task_id"`
+	Latitude     float64            `json:"latitude"`
+	Longitude    float64            `json:"longitude"`
+	RadiusMeters int32              `json:"radius_meters"`
+	OnArrive     bool               `json:"on_arrive"`
+	OnLeave      bool               `json:"on_leave"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+type TaskNoteRevision struct {
+	ID        pgtype.UUID        `json:"id"`
+	TaskID    pgtype.UUID        `json:"task_id"`
+	Notes     string             `json:"notes"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type TaskSetting struct {
+	OwnerID              string             `json:"owner_id"`
+	AutoArchiveAfterDays pgtype.Int4        `json:"auto_archive_after_days"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	RolloverToInbox      bool               `json:"rollover_to_inbox"`
+}
+
 type TaskTag struct {
 	TaskID    pgtype.UUID        `json:"task_id"`
 	TagID     pgtype.UUID        `json:"tag_id"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
 
+type TaskTombstone struct {
+	TaskID    pgtype.UUID        `json:"task_id"`
+	OwnerID   string             `json:"owner_id"`
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+}
+
 type User struct {
-	ID             int32            `json:"id"`
-	UserID         string           `json:"user_id"`
-	Username       pgtype.Text      `json:"username"`
-	AvatarUrl      pgtype.Text      `json:"avatar_url"`
-	CreatedAt      pgtype.Timestamp `json:"created_at"`
-	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
-	Email          pgtype.Text      `json:"email"`
-	TavilyMcpToken pgtype.Text      `json:"tavily_mcp_token"`
+	ID                    int32              `json:"id"`
+	UserID                string             `json:"user_id"`
+	Username              pgtype.Text        `json:"username"`
+	AvatarUrl             pgtype.Text        `json:"avatar_url"`
+	CreatedAt             pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt             pgtype.Timestamp   `json:"updated_at"`
+	Email                 pgtype.Text        `json:"email"`
+	TavilyMcpToken        pgtype.Text        `json:"tavily_mcp_token"`
+	ProfileSyncedAt       pgtype.Timestamptz `json:"profile_synced_at"`
+	AnonymizedAt          pgtype.Timestamptz `json:"anonymized_at"`
+	ListPageSize          int32              `json:"list_page_size"`
+	ListTagMatchAll       bool               `json:"list_tag_match_all"`
+	SearchIncludeArchived bool               `json:"search_include_archived"`
+}
+
+type UserDataKey struct {
+	UserID     string             `json:"user_id"`
+	WrappedKey string             `json:"wrapped_key"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type UserGoal struct {
+	OwnerID              string             `json:"owner_id"`
+	WeeklyCompletionGoal pgtype.Int4        `json:"weekly_completion_goal"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+}
+
+type UserOnboarding struct {
+	UserID            string             `json:"user_id"`
+	WelcomeCompleted  bool               `json:"welcome_completed"`
+	SampleDataCreated bool               `json:"sample_data_created"`
+	FeaturesToured    bool               `json:"features_toured"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+}
+
+type UserShard struct {
+	OwnerID   string             `json:"owner_id"`
+	Shard     int32              `json:"shard"`
+	Moving    bool               `json:"moving"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type WebPushSubscription struct {
+	ID         pgtype.UUID        `json:"id"`
+	OwnerID    string             `json:"owner_id"`
+	Endpoint   string             `json:"endpoint"`
+	P256dh     string             `json:"p256dh"`
+	Auth       string             `json:"auth"`
+	UserAgent  string             `json:"user_agent"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	LastUsedAt pgtype.Timestamptz `json:"last_used_at"`
+}
+
+type Webhook struct {
+	ID              pgtype.UUID        `json:"id"`
+	OwnerID         string             `json:"owner_id"`
+	Name            string             `json:"name"`
+	Secret          string             `json:"secret"`
+	Template        []byte             `json:"template"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	LastDeliveredAt pgtype.Timestamptz `json:"last_delivered_at"`
 }
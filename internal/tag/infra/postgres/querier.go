@@ -9,13 +9,36 @@ import (
 )
 
 type Querier interface {
+	// Forgets the orphan time of tags that are used by a task again.
+	ClearAdoptedOrphanTags(ctx context.Context) (int64, error)
+	// Counts the tasks carrying the owner's tag, as DeleteTag does.
+	CountTagTasks(ctx context.Context, arg CountTagTasksParams) (int64, error)
+	// Returns no row when the owner already created a tag with the same
+	// client_request_id.
 	CreateTag(ctx context.Context, arg CreateTagParams) (CreateTagRow, error)
-	DeleteOrphanTags(ctx context.Context, ownerID string) error
-	DeleteTag(ctx context.Context, arg DeleteTagParams) error
+	// Gets or creates the owner's tags with the given names in one statement.
+	// created is true for the tags this statement inserted. A name inserted
+	// concurrently by another transaction is in neither half of the result.
+	CreateTags(ctx context.Context, arg CreateTagsParams) ([]CreateTagsRow, error)
+	// Deletes tags orphaned before orphaned_before unless the owner's
+	// tag_settings keep them: 'never' keeps them for good, 'after_days' until
+	// they have been orphaned that long.
+	DeleteExpiredOrphanTags(ctx context.Context, arg DeleteExpiredOrphanTagsParams) (int64, error)
+	// Deletes the tag and counts the tasks that carried it; the count sees the
+	// task_tags rows as they were before the cascade.
+	DeleteTag(ctx context.Context, arg DeleteTagParams) (int64, error)
 	GetTag(ctx context.Context, arg GetTagParams) (GetTagRow, error)
+	GetTagByClientRequestID(ctx context.Context, arg GetTagByClientRequestIDParams) (GetTagByClientRequestIDRow, error)
 	GetTagByName(ctx context.Context, arg GetTagByNameParams) (GetTagByNameRow, error)
+	GetTagSettings(ctx context.Context, ownerID string) (TagSetting, error)
 	ListTags(ctx context.Context, arg ListTagsParams) ([]ListTagsRow, error)
+	// Records when tags lost their last task, across all owners.
+	MarkOrphanTags(ctx context.Context) (int64, error)
+	// Gives the owner's tasks carrying from_tag_id the tag to_tag_id as well and
+	// stamps the tasks that did not have it yet.
+	ReassignTagTasks(ctx context.Context, arg ReassignTagTasksParams) (int64, error)
 	UpdateTag(ctx context.Context, arg UpdateTagParams) (UpdateTagRow, error)
+	UpsertTagSettings(ctx context.Context, arg UpsertTagSettingsParams) error
 }
 
 var _ Querier = (*Queries)(nil)
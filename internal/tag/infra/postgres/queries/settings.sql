@@ -0,0 +1,12 @@
+-- name: GetTagSettings :one
+SELECT owner_id, orphan_cleanup, orphan_cleanup_after_days, created_at, updated_at
+FROM tag_settings
+WHERE owner_id = $1;
+
+-- name: UpsertTagSettings :exec
+INSERT INTO tag_settings (owner_id, orphan_cleanup, orphan_cleanup_after_days)
+VALUES ($1, $2, $3)
+ON CONFLICT (owner_id) DO UPDATE
+SET orphan_cleanup = EXCLUDED.orphan_cleanup,
+    orphan_cleanup_after_days = EXCLUDED.orphan_cleanup_after_days,
+    updated_at = NOW();
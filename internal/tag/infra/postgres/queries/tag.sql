@@ -1,38 +1,122 @@
 -- name: CreateTag :one
-INSERT INTO tags (name, owner_id)
-VALUES ($1, $2)
-RETURNING id, name, owner_id, created_at, updated_at;
+-- Returns no row when the owner already created a tag with the same
+-- client_request_id.
+INSERT INTO tags (name, owner_id, client_request_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (owner_id, client_request_id) WHERE client_request_id IS NOT NULL DO NOTHING
+RETURNING id, name, owner_id, created_at, updated_at, client_request_id;
+
+-- name: CreateTags :many
+-- Gets or creates the owner's tags with the given names in one statement.
+-- created is true for the tags this statement inserted. A name inserted
+-- concurrently by another transaction is in neither half of the result.
+WITH input AS (
+    SELECT DISTINCT unnest(sqlc.arg(names)::text[]) AS name
+), inserted AS (
+    INSERT INTO tags (name, owner_id)
+    SELECT name, sqlc.arg(owner_id) FROM input
+    ON CONFLICT (owner_id, name) DO NOTHING
+    RETURNING id, name, owner_id, created_at, updated_at, client_request_id
+)
+SELECT id, name, owner_id, created_at, updated_at, client_request_id, TRUE AS created
+FROM inserted
+UNION ALL
+SELECT t.id, t.name, t.owner_id, t.created_at, t.updated_at, t.client_request_id, FALSE AS created
+FROM tags t
+JOIN input i ON i.name = t.name
+WHERE t.owner_id = sqlc.arg(owner_id);
 
 -- name: GetTag :one
-SELECT id, name, owner_id, created_at, updated_at
+SELECT id, name, owner_id, created_at, updated_at, client_request_id
 FROM tags
 WHERE id = $1 AND owner_id = $2;
 
 -- name: GetTagByName :one
-SELECT id, name, owner_id, created_at, updated_at
+SELECT id, name, owner_id, created_at, updated_at, client_request_id
 FROM tags
 WHERE name = $1 AND owner_id = $2;
 
+-- name: GetTagByClientRequestID :one
+SELECT id, name, owner_id, created_at, updated_at, client_request_id
+FROM tags
+WHERE owner_id = $1 AND client_request_id = $2;
+
 -- name: UpdateTag :one
 UPDATE tags
 SET name = $2, updated_at = NOW()
 WHERE id = $1 AND owner_id = $3
-RETURNING id, name, owner_id, created_at, updated_at;
+RETURNING id, name, owner_id, created_at, updated_at, client_request_id;
 
--- name: DeleteTag :exec
-DELETE FROM tags
-WHERE id = $1 AND owner_id = $2;
+-- name: CountTagTasks :one
+-- Counts the tasks carrying the owner's tag, as DeleteTag does.
+SELECT COUNT(*)
+FROM task_tags tt
+JOIN tags t ON t.id = tt.tag_id
+WHERE tt.tag_id = $1 AND t.owner_id = $2;
 
--- name: DeleteOrphanTags :exec
-DELETE FROM tags
-WHERE owner_id = $1
-  AND id NOT IN (
-    SELECT DISTINCT tag_id
-    FROM task_tags
+-- name: DeleteTag :one
+-- Deletes the tag and counts the tasks that carried it; the count sees the
+-- task_tags rows as they were before the cascade.
+WITH deleted AS (
+    DELETE FROM tags
+    WHERE id = $1 AND owner_id = $2
+    RETURNING id
+)
+SELECT COUNT(*)
+FROM task_tags tt
+JOIN deleted d ON d.id = tt.tag_id;
+
+-- name: ReassignTagTasks :execrows
+-- Gives the owner's tasks carrying from_tag_id the tag to_tag_id as well and
+-- stamps the tasks that did not have it yet.
+WITH reassigned AS (
+    INSERT INTO task_tags (task_id, tag_id)
+    SELECT tt.task_id, sqlc.arg(to_tag_id)::uuid
+    FROM task_tags tt
+    JOIN tasks t ON t.id = tt.task_id
+    WHERE tt.tag_id = sqlc.arg(from_tag_id)::uuid AND t.owner_id = sqlc.arg(owner_id)
+    ON CONFLICT DO NOTHING
+    RETURNING task_id
+)
+UPDATE tasks
+SET updated_at = NOW(),
+    last_modified_source = sqlc.arg(last_modified_source), last_modified_client_id = sqlc.arg(last_modified_client_id),
+    last_modified_token_id = sqlc.arg(last_modified_token_id), last_modified_token_name = sqlc.arg(last_modified_token_name)
+FROM reassigned
+WHERE tasks.id = reassigned.task_id;
+
+-- name: MarkOrphanTags :execrows
+-- Records when tags lost their last task, across all owners.
+UPDATE tags
+SET orphaned_at = NOW()
+WHERE orphaned_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM task_tags tt WHERE tt.tag_id = tags.id);
+
+-- name: ClearAdoptedOrphanTags :execrows
+-- Forgets the orphan time of tags that are used by a task again.
+UPDATE tags
+SET orphaned_at = NULL
+WHERE orphaned_at IS NOT NULL
+  AND EXISTS (SELECT 1 FROM task_tags tt WHERE tt.tag_id = tags.id);
+
+-- name: DeleteExpiredOrphanTags :execrows
+-- Deletes tags orphaned before orphaned_before unless the owner's
+-- tag_settings keep them: 'never' keeps them for good, 'after_days' until
+-- they have been orphaned that long.
+DELETE FROM tags t
+WHERE t.orphaned_at < sqlc.arg(orphaned_before)::timestamptz
+  AND NOT EXISTS (SELECT 1 FROM task_tags tt WHERE tt.tag_id = t.id)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM tag_settings s
+    WHERE s.owner_id = t.owner_id
+      AND (s.orphan_cleanup = 'never'
+           OR (s.orphan_cleanup = 'after_days'
+               AND t.orphaned_at > sqlc.arg(now)::timestamptz - make_interval(days => s.orphan_cleanup_after_days)))
   );
 
 -- name: ListTags :many
-SELECT id, name, owner_id, created_at, updated_at
+SELECT id, name, owner_id, created_at, updated_at, client_request_id
 FROM tags
 WHERE owner_id = $1
 ORDER BY name ASC
@@ -2,33 +2,55 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/slips-ai/slips-core/internal/tag/domain"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/database"
 )
 
 // TagRepository implements domain.Repository using PostgreSQL
 type TagRepository struct {
-	queries *Queries
+	pool        database.Pool
+	queries     *Queries
+	readQueries *Queries
 }
 
-// NewTagRepository creates a new tag repository
-func NewTagRepository(pool *pgxpool.Pool) *TagRepository {
+// NewTagRepository creates a new tag repository.
+// List runs on reader, which may be a read replica router.
+func NewTagRepository(pool database.Pool, reader DBTX) *TagRepository {
 	return &TagRepository{
-		queries: New(pool),
+		pool:        pool,
+		queries:     New(pool),
+		readQueries: New(reader),
 	}
 }
 
-// Create creates a new tag
+// Create creates a new tag. When the owner already created a tag with the
+// same client request ID, tag is replaced by that tag instead.
 func (r *TagRepository) Create(ctx context.Context, tag *domain.Tag) error {
+	clientRequestID := pgtype.Text{String: tag.ClientRequestID, Valid: tag.ClientRequestID != ""}
 	result, err := r.queries.CreateTag(ctx, CreateTagParams{
-		Name:    tag.Name,
-		OwnerID: tag.OwnerID,
+		Name:            tag.Name,
+		OwnerID:         tag.OwnerID,
+		ClientRequestID: clientRequestID,
 	})
-	if err != nil {
-		return err
+	if errors.Is(err, pgx.ErrNoRows) && tag.ClientRequestID != "" {
+		existing, err := r.queries.GetTagByClientRequestID(ctx, GetTagByClientRequestIDParams{
+			OwnerID:         tag.OwnerID,
+			ClientRequestID: clientRequestID,
+		})
+		if err != nil {
+			return err
+		}
+		result = CreateTagRow(existing)
+	} else if err != nil {
+		return nameConflict(err)
 	}
 
 	tagID, err := uuid.FromBytes(result.ID.Bytes[:])
@@ -36,6 +58,7 @@ func (r *TagRepository) Create(ctx context.Context, tag *domain.Tag) error {
 		return err
 	}
 	tag.ID = tagID
+	tag.Name = result.Name
 	tag.CreatedAt = result.CreatedAt.Time
 	tag.UpdatedAt = result.UpdatedAt.Time
 	return nil
@@ -53,7 +76,7 @@ func (r *TagRepository) Get(ctx context.Context, id uuid.UUID, ownerID string) (
 		OwnerID: ownerID,
 	})
 	if err != nil {
-		return nil, err
+		return nil, tagNotFound(err)
 	}
 
 	tagID, err := uuid.FromBytes(result.ID.Bytes[:])
@@ -62,11 +85,12 @@ func (r *TagRepository) Get(ctx context.Context, id uuid.UUID, ownerID string) (
 	}
 
 	return &domain.Tag{
-		ID:        tagID,
-		Name:      result.Name,
-		OwnerID:   result.OwnerID,
-		CreatedAt: result.CreatedAt.Time,
-		UpdatedAt: result.UpdatedAt.Time,
+		ID:              tagID,
+		Name:            result.Name,
+		OwnerID:         result.OwnerID,
+		CreatedAt:       result.CreatedAt.Time,
+		UpdatedAt:       result.UpdatedAt.Time,
+		ClientRequestID: result.ClientRequestID.String,
 	}, nil
 }
 
@@ -77,7 +101,7 @@ func (r *TagRepository) GetByName(ctx context.Context, name, ownerID string) (*d
 		OwnerID: ownerID,
 	})
 	if err != nil {
-		return nil, err
+		return nil, tagNotFound(err)
 	}
 
 	tagID, err := uuid.FromBytes(result.ID.Bytes[:])
@@ -86,11 +110,12 @@ func (r *TagRepository) GetByName(ctx context.Context, name, ownerID string) (*d
 	}
 
 	return &domain.Tag{
-		ID:        tagID,
-		Name:      result.Name,
-		OwnerID:   result.OwnerID,
-		CreatedAt: result.CreatedAt.Time,
-		UpdatedAt: result.UpdatedAt.Time,
+		ID:              tagID,
+		Name:            result.Name,
+		OwnerID:         result.OwnerID,
+		CreatedAt:       result.CreatedAt.Time,
+		UpdatedAt:       result.UpdatedAt.Time,
+		ClientRequestID: result.ClientRequestID.String,
 	}, nil
 }
 
@@ -101,6 +126,9 @@ func (r *TagRepository) GetOrCreate(ctx context.Context, name, ownerID string) (
 	if err == nil {
 		return tag, nil
 	}
+	if !errors.Is(err, domain.ErrTagNotFound) {
+		return nil, err
+	}
 
 	// If tag doesn't exist, create it
 	newTag := &domain.Tag{
@@ -115,6 +143,54 @@ func (r *TagRepository) GetOrCreate(ctx context.Context, name, ownerID string) (
 	return newTag, nil
 }
 
+// GetOrCreateMany gets or creates a tag for each name with a single
+// statement. Names another transaction inserted meanwhile are looked up
+// afterwards.
+func (r *TagRepository) GetOrCreateMany(ctx context.Context, names []string, ownerID string) ([]domain.CreatedTag, error) {
+	rows, err := r.queries.CreateTags(ctx, CreateTagsParams{
+		Names:   names,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]domain.CreatedTag, len(rows))
+	for _, row := range rows {
+		tagID, err := uuid.FromBytes(row.ID.Bytes[:])
+		if err != nil {
+			return nil, err
+		}
+		byName[row.Name] = domain.CreatedTag{
+			Tag: &domain.Tag{
+				ID:              tagID,
+				Name:            row.Name,
+				OwnerID:         row.OwnerID,
+				CreatedAt:       row.CreatedAt.Time,
+				UpdatedAt:       row.UpdatedAt.Time,
+				ClientRequestID: row.ClientRequestID.String,
+			},
+			Created: row.Created,
+		}
+	}
+
+	results := make([]domain.CreatedTag, len(names))
+	for i, name := range names {
+		result, ok := byName[name]
+		if !ok {
+			tag, err := r.GetByName(ctx, name, ownerID)
+			if err != nil {
+				return nil, err
+			}
+			result = domain.CreatedTag{Tag: tag}
+		}
+		results[i] = result
+		// Later occurrences of the name refer to the tag created here
+		byName[name] = domain.CreatedTag{Tag: result.Tag}
+	}
+	return results, nil
+}
+
 // Update updates a tag
 func (r *TagRepository) Update(ctx context.Context, tag *domain.Tag) error {
 	pgID := pgtype.UUID{
@@ -128,28 +204,62 @@ func (r *TagRepository) Update(ctx context.Context, tag *domain.Tag) error {
 		OwnerID: tag.OwnerID,
 	})
 	if err != nil {
-		return err
+		return nameConflict(tagNotFound(err))
 	}
 
 	tag.UpdatedAt = result.UpdatedAt.Time
 	return nil
 }
 
-// Delete deletes a tag
-func (r *TagRepository) Delete(ctx context.Context, id uuid.UUID, ownerID string) error {
+// Delete deletes a tag and returns the number of tasks that carried it.
+// When reassignTo is set, the tasks are given that tag first, in the same
+// transaction.
+func (r *TagRepository) Delete(ctx context.Context, id uuid.UUID, ownerID string, reassignTo *uuid.UUID, by taskdomain.Modifier) (int64, error) {
 	pgID := pgtype.UUID{
 		Bytes: id,
 		Valid: true,
 	}
-	return r.queries.DeleteTag(ctx, DeleteTagParams{
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+	txQueries := r.queries.WithTx(tx)
+
+	if reassignTo != nil {
+		if _, err := txQueries.ReassignTagTasks(ctx, ReassignTagTasksParams{
+			ToTagID:               pgtype.UUID{Bytes: *reassignTo, Valid: true},
+			FromTagID:             pgID,
+			OwnerID:               ownerID,
+			LastModifiedSource:    pgtype.Text{String: string(by.Source), Valid: by.Source != ""},
+			LastModifiedClientID:  pgtype.Text{String: by.ClientID, Valid: by.ClientID != ""},
+			LastModifiedTokenID:   pgtype.UUID{Bytes: by.TokenID, Valid: by.TokenID != uuid.Nil},
+			LastModifiedTokenName: pgtype.Text{String: by.TokenName, Valid: by.TokenName != ""},
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	affected, err := txQueries.DeleteTag(ctx, DeleteTagParams{
 		ID:      pgID,
 		OwnerID: ownerID,
 	})
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return affected, nil
 }
 
-// DeleteOrphans deletes tags that are not associated with any tasks
-func (r *TagRepository) DeleteOrphans(ctx context.Context, ownerID string) error {
-	return r.queries.DeleteOrphanTags(ctx, ownerID)
+// CountTasks returns the number of tasks carrying the tag
+func (r *TagRepository) CountTasks(ctx context.Context, id uuid.UUID, ownerID string) (int64, error) {
+	return r.queries.CountTagTasks(ctx, CountTagTasksParams{
+		TagID:   pgtype.UUID{Bytes: id, Valid: true},
+		OwnerID: ownerID,
+	})
 }
 
 // List lists tags with pagination
@@ -163,7 +273,7 @@ func (r *TagRepository) List(ctx context.Context, ownerID string, limit, offset
 	}
 
 	// Convert to int32 (validation is done at gRPC layer)
-	results, err := r.queries.ListTags(ctx, ListTagsParams{
+	results, err := r.readQueries.ListTags(ctx, ListTagsParams{
 		OwnerID: ownerID,
 		Limit:   int32(limit),
 		Offset:  int32(offset),
@@ -179,13 +289,33 @@ func (r *TagRepository) List(ctx context.Context, ownerID string, limit, offset
 			return nil, err
 		}
 		tags[i] = &domain.Tag{
-			ID:        tagID,
-			Name:      result.Name,
-			OwnerID:   result.OwnerID,
-			CreatedAt: result.CreatedAt.Time,
-			UpdatedAt: result.UpdatedAt.Time,
+			ID:              tagID,
+			Name:            result.Name,
+			OwnerID:         result.OwnerID,
+			CreatedAt:       result.CreatedAt.Time,
+			UpdatedAt:       result.UpdatedAt.Time,
+			ClientRequestID: result.ClientRequestID.String,
 		}
 	}
 
 	return tags, nil
 }
+
+// tagNotFound reports a query that found no tag as domain.ErrTagNotFound
+func tagNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrTagNotFound
+	}
+	return err
+}
+
+// nameConflict reports a violation of the unique index on the owner's tag
+// names as domain.ErrTagNameConflict, keeping the database error in the
+// chain
+func nameConflict(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "idx_tags_owner_name" {
+		return fmt.Errorf("%w: %w", domain.ErrTagNameConflict, err)
+	}
+	return err
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/tag/domain"
+)
+
+// GetSettings returns the owner's tag settings, or the defaults if never set
+func (r *TagRepository) GetSettings(ctx context.Context, ownerID string) (*domain.Settings, error) {
+	result, err := r.readQueries.GetTagSettings(ctx, ownerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.DefaultSettings(), nil
+		}
+		return nil, err
+	}
+
+	return &domain.Settings{
+		OrphanCleanup:          domain.OrphanCleanup(result.OrphanCleanup),
+		OrphanCleanupAfterDays: int(result.OrphanCleanupAfterDays.Int32),
+	}, nil
+}
+
+// SetSettings stores the owner's tag settings
+func (r *TagRepository) SetSettings(ctx context.Context, ownerID string, settings *domain.Settings) error {
+	var afterDays pgtype.Int4
+	if settings.OrphanCleanup == domain.OrphanCleanupAfterDays {
+		afterDays = pgtype.Int4{Int32: int32(settings.OrphanCleanupAfterDays), Valid: true}
+	}
+
+	return r.queries.UpsertTagSettings(ctx, UpsertTagSettingsParams{
+		OwnerID:                ownerID,
+		OrphanCleanup:          string(settings.OrphanCleanup),
+		OrphanCleanupAfterDays: afterDays,
+	})
+}
+
+// MarkOrphans records when tags lost their last task and forgets it for
+// tags that are used again
+func (r *TagRepository) MarkOrphans(ctx context.Context) (int64, int64, error) {
+	orphaned, err := r.queries.MarkOrphanTags(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	adopted, err := r.queries.ClearAdoptedOrphanTags(ctx)
+	if err != nil {
+		return orphaned, 0, err
+	}
+	return orphaned, adopted, nil
+}
+
+// DeleteExpiredOrphans deletes tags marked as orphan for at least
+// domain.MinOrphanAge whose owner's settings no longer keep them at now
+func (r *TagRepository) DeleteExpiredOrphans(ctx context.Context, now time.Time) (int64, error) {
+	return r.queries.DeleteExpiredOrphanTags(ctx, DeleteExpiredOrphanTagsParams{
+		OrphanedBefore: pgtype.Timestamptz{Time: now.Add(-domain.MinOrphanAge), Valid: true},
+		Now:            pgtype.Timestamptz{Time: now, Valid: true},
+	})
+}
@@ -11,27 +11,68 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const clearAdoptedOrphanTags = `-- name: ClearAdoptedOrphanTags :execrows
+UPDATE tags
+SET orphaned_at = NULL
+WHERE orphaned_at IS NOT NULL
+  AND EXISTS (SELECT 1 FROM task_tags tt WHERE tt.tag_id = tags.id)
+`
+
+// Forgets the orphan time of tags that are used by a task again.
+func (q *Queries) ClearAdoptedOrphanTags(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, clearAdoptedOrphanTags)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countTagTasks = `-- name: CountTagTasks :one
+SELECT COUNT(*)
+FROM task_tags tt
+JOIN tags t ON t.id = tt.tag_id
+WHERE tt.tag_id = $1 AND t.owner_id = $2
+`
+
+type CountTagTasksParams struct {
+	TagID   pgtype.UUID `json:"tag_id"`
+	OwnerID string      `json:"owner_id"`
+}
+
+// Counts the tasks carrying the owner's tag, as DeleteTag does.
+func (q *Queries) CountTagTasks(ctx context.Context, arg CountTagTasksParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTagTasks, arg.TagID, arg.OwnerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createTag = `-- name: CreateTag :one
-INSERT INTO tags (name, owner_id)
-VALUES ($1, $2)
-RETURNING id, name, owner_id, created_at, updated_at
+INSERT INTO tags (name, owner_id, client_request_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (owner_id, client_request_id) WHERE client_request_id IS NOT NULL DO NOTHING
+RETURNING id, name, owner_id, created_at, updated_at, client_request_id
 `
 
 type CreateTagParams struct {
-	Name    string `json:"name"`
-	OwnerID string `json:"owner_id"`
+	Name            string      `json:"name"`
+	OwnerID         string      `json:"owner_id"`
+	ClientRequestID pgtype.Text `json:"client_request_id"`
 }
 
 type CreateTagRow struct {
-	ID        pgtype.UUID        `json:"id"`
-	Name      string             `json:"name"`
-	OwnerID   string             `json:"owner_id"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ID              pgtype.UUID        `json:"id"`
+	Name            string             `json:"name"`
+	OwnerID         string             `json:"owner_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	ClientRequestID pgtype.Text        `json:"client_request_id"`
 }
 
+// Returns no row when the owner already created a tag with the same
+// client_request_id.
 func (q *Queries) CreateTag(ctx context.Context, arg CreateTagParams) (CreateTagRow, error) {
-	row := q.db.QueryRow(ctx, createTag, arg.Name, arg.OwnerID)
+	row := q.db.QueryRow(ctx, createTag, arg.Name, arg.OwnerID, arg.ClientRequestID)
 	var i CreateTagRow
 	err := row.Scan(
 		&i.ID,
@@ -39,27 +80,114 @@ func (q *Queries) CreateTag(ctx context.Context, arg CreateTagParams) (CreateTag
 		&i.OwnerID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ClientRequestID,
 	)
 	return i, err
 }
 
-const deleteOrphanTags = `-- name: DeleteOrphanTags :exec
-DELETE FROM tags
-WHERE owner_id = $1
-  AND id NOT IN (
-    SELECT DISTINCT tag_id
-    FROM task_tags
+const createTags = `-- name: CreateTags :many
+WITH input AS (
+    SELECT DISTINCT unnest($1::text[]) AS name
+), inserted AS (
+    INSERT INTO tags (name, owner_id)
+    SELECT name, $2 FROM input
+    ON CONFLICT (owner_id, name) DO NOTHING
+    RETURNING id, name, owner_id, created_at, updated_at, client_request_id
+)
+SELECT id, name, owner_id, created_at, updated_at, client_request_id, TRUE AS created
+FROM inserted
+UNION ALL
+SELECT t.id, t.name, t.owner_id, t.created_at, t.updated_at, t.client_request_id, FALSE AS created
+FROM tags t
+JOIN input i ON i.name = t.name
+WHERE t.owner_id = $2
+`
+
+type CreateTagsParams struct {
+	Names   []string `json:"names"`
+	OwnerID string   `json:"owner_id"`
+}
+
+type CreateTagsRow struct {
+	ID              pgtype.UUID        `json:"id"`
+	Name            string             `json:"name"`
+	OwnerID         string             `json:"owner_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	ClientRequestID pgtype.Text        `json:"client_request_id"`
+	Created         bool               `json:"created"`
+}
+
+// Gets or creates the owner's tags with the given names in one statement.
+// created is true for the tags this statement inserted. A name inserted
+// concurrently by another transaction is in neither half of the result.
+func (q *Queries) CreateTags(ctx context.Context, arg CreateTagsParams) ([]CreateTagsRow, error) {
+	rows, err := q.db.Query(ctx, createTags, arg.Names, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CreateTagsRow{}
+	for rows.Next() {
+		var i CreateTagsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.OwnerID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ClientRequestID,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteExpiredOrphanTags = `-- name: DeleteExpiredOrphanTags :execrows
+DELETE FROM tags t
+WHERE t.orphaned_at < $1::timestamptz
+  AND NOT EXISTS (SELECT 1 FROM task_tags tt WHERE tt.tag_id = t.id)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM tag_settings s
+    WHERE s.owner_id = t.owner_id
+      AND (s.orphan_cleanup = 'never'
+           OR (s.orphan_cleanup = 'after_days'
+               AND t.orphaned_at > $2::timestamptz - make_interval(days => s.orphan_cleanup_after_days)))
   )
 `
 
-func (q *Queries) DeleteOrphanTags(ctx context.Context, ownerID string) error {
-	_, err := q.db.Exec(ctx, deleteOrphanTags, ownerID)
-	return err
+type DeleteExpiredOrphanTagsParams struct {
+	OrphanedBefore pgtype.Timestamptz `json:"orphaned_before"`
+	Now            pgtype.Timestamptz `json:"now"`
 }
 
-const deleteTag = `-- name: DeleteTag :exec
-DELETE FROM tags
-WHERE id = $1 AND owner_id = $2
+// Deletes tags orphaned before orphaned_before unless the owner's
+// tag_settings keep them: 'never' keeps them for good, 'after_days' until
+// they have been orphaned that long.
+func (q *Queries) DeleteExpiredOrphanTags(ctx context.Context, arg DeleteExpiredOrphanTagsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredOrphanTags, arg.OrphanedBefore, arg.Now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTag = `-- name: DeleteTag :one
+WITH deleted AS (
+    DELETE FROM tags
+    WHERE id = $1 AND owner_id = $2
+    RETURNING id
+)
+SELECT COUNT(*)
+FROM task_tags tt
+JOIN deleted d ON d.id = tt.tag_id
 `
 
 type DeleteTagParams struct {
@@ -67,13 +195,17 @@ type DeleteTagParams struct {
 	OwnerID string      `json:"owner_id"`
 }
 
-func (q *Queries) DeleteTag(ctx context.Context, arg DeleteTagParams) error {
-	_, err := q.db.Exec(ctx, deleteTag, arg.ID, arg.OwnerID)
-	return err
+// Deletes the tag and counts the tasks that carried it; the count sees the
+// task_tags rows as they were before the cascade.
+func (q *Queries) DeleteTag(ctx context.Context, arg DeleteTagParams) (int64, error) {
+	row := q.db.QueryRow(ctx, deleteTag, arg.ID, arg.OwnerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
 const getTag = `-- name: GetTag :one
-SELECT id, name, owner_id, created_at, updated_at
+SELECT id, name, owner_id, created_at, updated_at, client_request_id
 FROM tags
 WHERE id = $1 AND owner_id = $2
 `
@@ -84,11 +216,12 @@ type GetTagParams struct {
 }
 
 type GetTagRow struct {
-	ID        pgtype.UUID        `json:"id"`
-	Name      string             `json:"name"`
-	OwnerID   string             `json:"owner_id"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ID              pgtype.UUID        `json:"id"`
+	Name            string             `json:"name"`
+	OwnerID         string             `json:"owner_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	ClientRequestID pgtype.Text        `json:"client_request_id"`
 }
 
 func (q *Queries) GetTag(ctx context.Context, arg GetTagParams) (GetTagRow, error) {
@@ -100,12 +233,47 @@ func (q *Queries) GetTag(ctx context.Context, arg GetTagParams) (GetTagRow, erro
 		&i.OwnerID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ClientRequestID,
+	)
+	return i, err
+}
+
+const getTagByClientRequestID = `-- name: GetTagByClientRequestID :one
+SELECT id, name, owner_id, created_at, updated_at, client_request_id
+FROM tags
+WHERE owner_id = $1 AND client_request_id = $2
+`
+
+type GetTagByClientRequestIDParams struct {
+	OwnerID         string      `json:"owner_id"`
+	ClientRequestID pgtype.Text `json:"client_request_id"`
+}
+
+type GetTagByClientRequestIDRow struct {
+	ID              pgtype.UUID        `json:"id"`
+	Name            string             `json:"name"`
+	OwnerID         string             `json:"owner_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	ClientRequestID pgtype.Text        `json:"client_request_id"`
+}
+
+func (q *Queries) GetTagByClientRequestID(ctx context.Context, arg GetTagByClientRequestIDParams) (GetTagByClientRequestIDRow, error) {
+	row := q.db.QueryRow(ctx, getTagByClientRequestID, arg.OwnerID, arg.ClientRequestID)
+	var i GetTagByClientRequestIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.OwnerID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClientRequestID,
 	)
 	return i, err
 }
 
 const getTagByName = `-- name: GetTagByName :one
-SELECT id, name, owner_id, created_at, updated_at
+SELECT id, name, owner_id, created_at, updated_at, client_request_id
 FROM tags
 WHERE name = $1 AND owner_id = $2
 `
@@ -116,11 +284,12 @@ type GetTagByNameParams struct {
 }
 
 type GetTagByNameRow struct {
-	ID        pgtype.UUID        `json:"id"`
-	Name      string             `json:"name"`
-	OwnerID   string             `json:"owner_id"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ID              pgtype.UUID        `json:"id"`
+	Name            string             `json:"name"`
+	OwnerID         string             `json:"owner_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	ClientRequestID pgtype.Text        `json:"client_request_id"`
 }
 
 func (q *Queries) GetTagByName(ctx context.Context, arg GetTagByNameParams) (GetTagByNameRow, error) {
@@ -132,12 +301,13 @@ func (q *Queries) GetTagByName(ctx context.Context, arg GetTagByNameParams) (Get
 		&i.OwnerID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ClientRequestID,
 	)
 	return i, err
 }
 
 const listTags = `-- name: ListTags :many
-SELECT id, name, owner_id, created_at, updated_at
+SELECT id, name, owner_id, created_at, updated_at, client_request_id
 FROM tags
 WHERE owner_id = $1
 ORDER BY name ASC
@@ -151,11 +321,12 @@ type ListTagsParams struct {
 }
 
 type ListTagsRow struct {
-	ID        pgtype.UUID        `json:"id"`
-	Name      string             `json:"name"`
-	OwnerID   string             `json:"owner_id"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ID              pgtype.UUID        `json:"id"`
+	Name            string             `json:"name"`
+	OwnerID         string             `json:"owner_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	ClientRequestID pgtype.Text        `json:"client_request_id"`
 }
 
 func (q *Queries) ListTags(ctx context.Context, arg ListTagsParams) ([]ListTagsRow, error) {
@@ -173,6 +344,7 @@ func (q *Queries) ListTags(ctx context.Context, arg ListTagsParams) ([]ListTagsR
 			&i.OwnerID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ClientRequestID,
 		); err != nil {
 			return nil, err
 		}
@@ -184,11 +356,73 @@ func (q *Queries) ListTags(ctx context.Context, arg ListTagsParams) ([]ListTagsR
 	return items, nil
 }
 
+const markOrphanTags = `-- name: MarkOrphanTags :execrows
+UPDATE tags
+SET orphaned_at = NOW()
+WHERE orphaned_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM task_tags tt WHERE tt.tag_id = tags.id)
+`
+
+// Records when tags lost their last task, across all owners.
+func (q *Queries) MarkOrphanTags(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, markOrphanTags)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reassignTagTasks = `-- name: ReassignTagTasks :execrows
+WITH reassigned AS (
+    INSERT INTO task_tags (task_id, tag_id)
+    SELECT tt.task_id, $1::uuid
+    FROM task_tags tt
+    JOIN tasks t ON t.id = tt.task_id
+    WHERE tt.tag_id = $2::uuid AND t.owner_id = $3
+    ON CONFLICT DO NOTHING
+    RETURNING task_id
+)
+UPDATE tasks
+SET updated_at = NOW(),
+    last_modified_source = $4, last_modified_client_id = $5,
+    last_modified_token_id = $6, last_modified_token_name = $7
+FROM reassigned
+WHERE tasks.id = reassigned.task_id
+`
+
+type ReassignTagTasksParams struct {
+	ToTagID               pgtype.UUID `json:"to_tag_id"`
+	FromTagID             pgtype.UUID `json:"from_tag_id"`
+	OwnerID               string      `json:"owner_id"`
+	LastModifiedSource    pgtype.Text `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text `json:"last_modified_client_id"`
+	LastModifiedTokenID   pgtype.UUID `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text `json:"last_modified_token_name"`
+}
+
+// Gives the owner's tasks carrying from_tag_id the tag to_tag_id as well and
+// stamps the tasks that did not have it yet.
+func (q *Queries) ReassignTagTasks(ctx context.Context, arg ReassignTagTasksParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reassignTagTasks,
+		arg.ToTagID,
+		arg.FromTagID,
+		arg.OwnerID,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateTag = `-- name: UpdateTag :one
 UPDATE tags
 SET name = $2, updated_at = NOW()
 WHERE id = $1 AND owner_id = $3
-RETURNING id, name, owner_id, created_at, updated_at
+RETURNING id, name, owner_id, created_at, updated_at, client_request_id
 `
 
 type UpdateTagParams struct {
@@ -198,11 +432,12 @@ type UpdateTagParams struct {
 }
 
 type UpdateTagRow struct {
-	ID        pgtype.UUID        `json:"id"`
-	Name      string             `json:"name"`
-	OwnerID   string             `json:"owner_id"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ID              pgtype.UUID        `json:"id"`
+	Name            string             `json:"name"`
+	OwnerID         string             `json:"owner_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	ClientRequestID pgtype.Text        `json:"client_request_id"`
 }
 
 func (q *Queries) UpdateTag(ctx context.Context, arg UpdateTagParams) (UpdateTagRow, error) {
@@ -214,6 +449,7 @@ func (q *Queries) UpdateTag(ctx context.Context, arg UpdateTagParams) (UpdateTag
 		&i.OwnerID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ClientRequestID,
 	)
 	return i, err
 }
@@ -0,0 +1,227 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AddTagToTasks tags the caller's tasks with tagName, creating the tag if it
+// does not exist. Every task must exist; when one does not, it returns
+// domain.ErrTaskNotFound and nothing is tagged. It returns the tasks in request
+// order.
+func (s *Service) AddTagToTasks(ctx context.Context, tagName string, taskIDs []uuid.UUID) ([]*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "AddTagToTasks", trace.WithAttributes(
+		attribute.String("tag_name", tagName),
+		attribute.Int("count", len(taskIDs)),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	tagName = tagdomain.NormalizeName(tagName)
+	ids := uniqueIDs(taskIDs)
+	if err := s.requireTasks(ctx, ids, userID); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	tag, err := s.tagRepo.GetOrCreate(ctx, tagName, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get or create tag", "tag_name", tagName, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	s.publishTags(ctx, userID, []uuid.UUID{tag.ID})
+
+	changed, err := s.repo.AddTag(ctx, userID, tag.ID, ids, modifierFromContext(ctx))
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to add tag to tasks", "tag_id", tag.ID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	for _, id := range changed {
+		s.publishTask(ctx, userID, id)
+	}
+
+	s.logger.InfoContext(ctx, "tag added to tasks", "tag_id", tag.ID, "changed", len(changed))
+	tasks, err := s.tasksInOrder(ctx, ids, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// RemoveTagFromTasks removes the tag named tagName from the caller's tasks.
+// Every task must exist; when one does not, it returns domain.ErrTaskNotFound
+// and nothing is untagged. A tag that does not exist is on no task, so the tasks
+// are returned unchanged. It returns the tasks in request order.
+func (s *Service) RemoveTagFromTasks(ctx context.Context, tagName string, taskIDs []uuid.UUID) ([]*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "RemoveTagFromTasks", trace.WithAttributes(
+		attribute.String("tag_name", tagName),
+		attribute.Int("count", len(taskIDs)),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	tagName = tagdomain.NormalizeName(tagName)
+	ids := uniqueIDs(taskIDs)
+	if err := s.requireTasks(ctx, ids, userID); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	tag, err := s.tagRepo.GetByName(ctx, tagName, userID)
+	if errors.Is(err, tagdomain.ErrTagNotFound) {
+		tag = nil
+	} else if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get tag", "tag_name", tagName, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if tag != nil {
+		changed, err := s.repo.RemoveTag(ctx, userID, tag.ID, ids, modifierFromContext(ctx))
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to remove tag from tasks", "tag_id", tag.ID, "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+		for _, id := range changed {
+			s.publishTask(ctx, userID, id)
+		}
+		s.logger.InfoContext(ctx, "tag removed from tasks", "tag_id", tag.ID, "changed", len(changed))
+	}
+
+	tasks, err := s.tasksInOrder(ctx, ids, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// ArchiveTasksByTag archives all of the caller's unarchived tasks carrying
+// the tag in a single statement, such as when a project is finished, and
+// returns how many were archived. It returns tagdomain.ErrTagNotFound when
+// the caller has no such tag.
+func (s *Service) ArchiveTasksByTag(ctx context.Context, tagID uuid.UUID) (int64, error) {
+	return s.setArchivedByTag(ctx, "ArchiveTasksByTag", tagID, true)
+}
+
+// UnarchiveTasksByTag restores all of the caller's archived tasks carrying
+// the tag in a single statement and returns how many were restored. It
+// returns tagdomain.ErrTagNotFound when the caller has no such tag.
+func (s *Service) UnarchiveTasksByTag(ctx context.Context, tagID uuid.UUID) (int64, error) {
+	return s.setArchivedByTag(ctx, "UnarchiveTasksByTag", tagID, false)
+}
+
+func (s *Service) setArchivedByTag(ctx context.Context, spanName string, tagID uuid.UUID, archived bool) (int64, error) {
+	ctx, span := tracer.Start(ctx, spanName, trace.WithAttributes(
+		attribute.String("tag_id", tagID.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return 0, err
+	}
+
+	if _, err := s.tagRepo.Get(ctx, tagID, userID); err != nil {
+		if !errors.Is(err, tagdomain.ErrTagNotFound) {
+			s.logger.ErrorContext(ctx, "failed to get tag", "tag_id", tagID, "error", err)
+		}
+		span.RecordError(err)
+		return 0, err
+	}
+
+	var count int64
+	if archived {
+		count, err = s.repo.ArchiveByTag(ctx, userID, tagID, modifierFromContext(ctx))
+	} else {
+		count, err = s.repo.UnarchiveByTag(ctx, userID, tagID, modifierFromContext(ctx))
+	}
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to set archived state of tagged tasks", "tag_id", tagID, "archived", archived, "error", err)
+		span.RecordError(err)
+		return 0, err
+	}
+
+	if count > 0 {
+		s.publishTasksResync(ctx, userID)
+	}
+
+	s.logger.InfoContext(ctx, "archived state of tagged tasks set", "tag_id", tagID, "archived", archived, "count", count)
+	return count, nil
+}
+
+// requireTasks returns domain.ErrTaskNotFound unless the owner has every
+// task in ids, so a stale selection is rejected before anything changes
+func (s *Service) requireTasks(ctx context.Context, ids []uuid.UUID, ownerID string) error {
+	found, err := s.repo.GetMany(ctx, ids, ownerID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get tasks", "count", len(ids), "error", err)
+		return err
+	}
+	if len(found) != len(ids) {
+		return domain.ErrTaskNotFound
+	}
+	return nil
+}
+
+// tasksInOrder loads the owner's tasks in ids, in the order of ids
+func (s *Service) tasksInOrder(ctx context.Context, ids []uuid.UUID, ownerID string) ([]*domain.Task, error) {
+	found, err := s.repo.GetMany(ctx, ids, ownerID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get tasks", "count", len(ids), "error", err)
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*domain.Task, len(found))
+	for _, task := range found {
+		byID[task.ID] = task
+	}
+	tasks := make([]*domain.Task, 0, len(ids))
+	for _, id := range ids {
+		if task, ok := byID[id]; ok {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, nil
+}
+
+// uniqueIDs drops repeated IDs, keeping the first occurrence of each
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+)
+
+// ListDefaultsReader reads a user's defaults for omitted list parameters
+type ListDefaultsReader interface {
+	GetListDefaults(ctx context.Context, userID string) (*authdomain.ListDefaults, error)
+}
+
+// RecipientChecker tells whether the recipient of a transfer exists
+type RecipientChecker interface {
+	UserExists(ctx context.Context, userID string) (bool, error)
+}
+
+// Users is what the service reads about users
+type Users interface {
+	ListDefaultsReader
+	RecipientChecker
+}
+
+// ListDefaults returns the current user's defaults for list parameters a
+// call leaves out. Listing does not fail because of them: without a
+// profile the zero defaults apply.
+func (s *Service) ListDefaults(ctx context.Context) authdomain.ListDefaults {
+	if s.users == nil {
+		return authdomain.ListDefaults{}
+	}
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		return authdomain.ListDefaults{}
+	}
+	defaults, err := s.users.GetListDefaults(ctx, userID)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to get list defaults", "error", err, "user_id", userID)
+		return authdomain.ListDefaults{}
+	}
+	return *defaults
+}
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/internal/memory"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+func TestListTasksByFilter_SearchIncludeArchivedDefault(t *testing.T) {
+	store := memory.NewStore()
+	users := memory.NewUserRepository(store)
+	filters := memory.NewSavedFilterRepository(store)
+	service := NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), filters, users, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	if _, err := users.UpsertUser(ctx, &authdomain.User{UserID: "owner"}); err != nil {
+		t.Fatalf("upsert user: %v", err)
+	}
+	task, err := service.CreateTask(ctx, "buy milk", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if _, err := service.ArchiveTask(ctx, task.ID); err != nil {
+		t.Fatalf("archive task: %v", err)
+	}
+	search := savedfilterdomain.NewSavedFilter("milk", "owner", savedfilterdomain.Criteria{Query: "milk"})
+	if err := filters.Create(ctx, search); err != nil {
+		t.Fatalf("create filter: %v", err)
+	}
+
+	count := func() int {
+		t.Helper()
+		result, err := service.ListTasksByFilter(ctx, search.ID, 30, 0, domain.GroupByNone)
+		if err != nil {
+			t.Fatalf("list by filter: %v", err)
+		}
+		return len(result.Tasks)
+	}
+	if got := count(); got != 0 {
+		t.Errorf("search found %d tasks, want the archived task left out by default", got)
+	}
+
+	include := true
+	if _, err := users.UpdateUserListDefaults(ctx, "owner", &authdomain.ListDefaultsUpdate{SearchIncludeArchived: &include}); err != nil {
+		t.Fatalf("update list defaults: %v", err)
+	}
+	if got := count(); got != 1 {
+		t.Errorf("search found %d tasks, want the archived task with search_include_archived set", got)
+	}
+}
@@ -0,0 +1,183 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Mutation is one operation of an offline client's batch. A create takes
+// the new task's fields from Patch, where the tag names, dates and context
+// always count as set, and its checklist from ChecklistItems. An update changes
+// the fields selected by Patch.
+type Mutation struct {
+	ClientMutationID string
+	Kind             domain.MutationKind
+	// TaskID is the target task; creates use it as the new task's ID
+	TaskID uuid.UUID
+	// BaseUpdatedAt is the version the client edited; nil skips the
+	// conflict check
+	BaseUpdatedAt  *time.Time
+	Patch          TaskPatch
+	ChecklistItems []string
+}
+
+// ApplyMutations applies a batch of client mutations in order within one
+// transaction. Mutations that conflict with the server state are skipped
+// and reported in their result; other errors roll back the whole batch.
+// With validateOnly set the batch is checked and its results returned, but
+// nothing is saved; tags that do not exist yet are not created and are left
+// out of the returned tasks.
+func (s *Service) ApplyMutations(ctx context.Context, mutations []Mutation, validateOnly bool) ([]domain.MutationResult, error) {
+	ctx, span := tracer.Start(ctx, "ApplyMutations", trace.WithAttributes(
+		attribute.Int("count", len(mutations)),
+		attribute.Bool("validate_only", validateOnly),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// Check new checklists first, so a rejected batch creates no tags
+	for i, m := range mutations {
+		if m.Kind != domain.MutationCreate {
+			continue
+		}
+		if err := s.checklists.CheckChecklist(m.ChecklistItems); err != nil {
+			err = fmt.Errorf("mutation %d: %w", i, err)
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	// Convert tag names to tag IDs before the batch starts. Tags created for
+	// a batch that is rolled back stay unused and are removed by the orphan
+	// tag cleanup.
+	tagIDsByName := make(map[string]uuid.UUID)
+	resolveTags := func(tagNames []string) ([]uuid.UUID, error) {
+		tagIDs := make([]uuid.UUID, 0, len(tagNames))
+		for _, tagName := range tagNames {
+			tagName = tagdomain.NormalizeName(tagName)
+			if tagID, ok := tagIDsByName[tagName]; ok {
+				tagIDs = append(tagIDs, tagID)
+				continue
+			}
+			if validateOnly {
+				tag, err := s.tagRepo.GetByName(ctx, tagName, userID)
+				if errors.Is(err, tagdomain.ErrTagNotFound) {
+					continue
+				}
+				if err != nil {
+					s.logger.ErrorContext(ctx, "failed to get tag", "tag_name", tagName, "error", err)
+					return nil, err
+				}
+				tagIDsByName[tagName] = tag.ID
+				tagIDs = append(tagIDs, tag.ID)
+				continue
+			}
+			tag, err := s.tagRepo.GetOrCreate(ctx, tagName, userID)
+			if err != nil {
+				s.logger.ErrorContext(ctx, "failed to get or create tag", "tag_name", tagName, "error", err)
+				return nil, err
+			}
+			tagIDsByName[tagName] = tag.ID
+			tagIDs = append(tagIDs, tag.ID)
+		}
+		return tagIDs, nil
+	}
+
+	batch := make([]domain.Mutation, len(mutations))
+	for i, m := range mutations {
+		mutation := domain.Mutation{
+			ClientMutationID: m.ClientMutationID,
+			Kind:             m.Kind,
+			TaskID:           m.TaskID,
+			BaseUpdatedAt:    m.BaseUpdatedAt,
+		}
+
+		var tagIDs []uuid.UUID
+		if m.Kind == domain.MutationCreate || m.Patch.SetTagNames {
+			if tagIDs, err = resolveTags(m.Patch.TagNames); err != nil {
+				span.RecordError(err)
+				return nil, err
+			}
+		}
+
+		switch m.Kind {
+		case domain.MutationCreate:
+			var title, notes string
+			if m.Patch.Title != nil {
+				title = *m.Patch.Title
+			}
+			if m.Patch.Notes != nil {
+				notes = *m.Patch.Notes
+			}
+			task := domain.NewTask(title, notes, userID, tagIDs)
+			task.ID = m.TaskID
+			task.Checklist = newChecklist(m.ChecklistItems)
+			task.SetStartDate(m.Patch.StartDate)
+			task.SetDeadline(m.Patch.Deadline)
+			task.SetContext(m.Patch.Context)
+			mutation.Task = task
+		case domain.MutationUpdate:
+			mutation.Changes = domain.TaskChanges{
+				Title:        m.Patch.Title,
+				Notes:        m.Patch.Notes,
+				SetTagIDs:    m.Patch.SetTagNames,
+				TagIDs:       tagIDs,
+				SetStartDate: m.Patch.SetStartDate,
+				StartDate:    m.Patch.StartDate,
+				SetDeadline:  m.Patch.SetDeadline,
+				Deadline:     m.Patch.Deadline,
+				SetContext:   m.Patch.SetContext,
+				Context:      m.Patch.Context,
+			}
+		}
+		batch[i] = mutation
+	}
+
+	results, err := s.repo.ApplyMutations(ctx, userID, batch, modifierFromContext(ctx), validateOnly)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to apply mutations", "count", len(batch), "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if validateOnly {
+		return results, nil
+	}
+
+	tagIDs := make([]uuid.UUID, 0, len(tagIDsByName))
+	for _, tagID := range tagIDsByName {
+		tagIDs = append(tagIDs, tagID)
+	}
+	s.publishTags(ctx, userID, tagIDs)
+
+	conflicts := 0
+	for i, result := range results {
+		switch {
+		case !result.Applied():
+			conflicts++
+		case batch[i].Kind == domain.MutationDelete:
+			s.publishTaskDeleted(ctx, userID, result.TaskID)
+		default:
+			s.publishTask(ctx, userID, result.TaskID)
+		}
+	}
+	span.SetAttributes(attribute.Int("conflicts", conflicts))
+
+	s.logger.InfoContext(ctx, "mutations applied", "count", len(results), "conflicts", conflicts, "owner_id", userID)
+	return results, nil
+}
@@ -0,0 +1,131 @@
+package application
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/memory"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+func TestApplyMutations(t *testing.T) {
+	store := memory.NewStore()
+	service := NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	existing, err := service.CreateTask(ctx, "existing", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	others, err := service.CreateTask(auth.WithUserID(context.Background(), "other"), "others", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	stale := existing.UpdatedAt
+	if _, err := service.PatchTask(ctx, existing.ID, TaskPatch{Notes: strPtr("edited elsewhere")}); err != nil {
+		t.Fatalf("patch task: %v", err)
+	}
+
+	newID := uuid.New()
+	results, err := service.ApplyMutations(ctx, []Mutation{
+		{ClientMutationID: "1", Kind: domain.MutationCreate, TaskID: newID,
+			Patch: TaskPatch{Title: strPtr("offline"), TagNames: []string{"home"}}, ChecklistItems: []string{"step"}},
+		// Created earlier in the batch, so the client has no version yet
+		{ClientMutationID: "2", Kind: domain.MutationUpdate, TaskID: newID,
+			Patch: TaskPatch{Title: strPtr("offline, renamed")}},
+		{ClientMutationID: "3", Kind: domain.MutationUpdate, TaskID: existing.ID, BaseUpdatedAt: &stale,
+			Patch: TaskPatch{Title: strPtr("lost update")}},
+		{ClientMutationID: "4", Kind: domain.MutationCreate, TaskID: existing.ID,
+			Patch: TaskPatch{Title: strPtr("replayed create")}},
+		{ClientMutationID: "5", Kind: domain.MutationDelete, TaskID: uuid.New()},
+		// Taken by another user's task, which must not show through
+		{ClientMutationID: "6", Kind: domain.MutationCreate, TaskID: others.ID,
+			Patch: TaskPatch{Title: strPtr("probe")}},
+	}, false)
+	if err != nil {
+		t.Fatalf("apply mutations: %v", err)
+	}
+
+	want := []domain.MutationConflict{
+		domain.ConflictNone,
+		domain.ConflictNone,
+		domain.ConflictChanged,
+		domain.ConflictAlreadyExists,
+		domain.ConflictNotFound,
+		domain.ConflictNotFound,
+	}
+	if len(results) != len(want) {
+		t.Fatalf("got %d results, want %d", len(results), len(want))
+	}
+	for i, result := range results {
+		if result.Conflict != want[i] {
+			t.Errorf("result %s: conflict = %v, want %v", result.ClientMutationID, result.Conflict, want[i])
+		}
+	}
+
+	created, err := service.GetTask(ctx, newID)
+	if err != nil {
+		t.Fatalf("get created task: %v", err)
+	}
+	if created.Title != "offline, renamed" || len(created.TagIDs) != 1 || len(created.Checklist) != 1 {
+		t.Errorf("created task = %+v", created)
+	}
+	if current := results[2].Task; current == nil || current.Notes != "edited elsewhere" || current.Title != "existing" {
+		t.Errorf("conflict should report the current task, got %+v", current)
+	}
+	if results[5].Task != nil {
+		t.Errorf("create over another user's task reported %+v", results[5].Task)
+	}
+}
+
+func TestApplyMutations_ValidateOnly(t *testing.T) {
+	store := memory.NewStore()
+	service := NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	existing, err := service.CreateTask(ctx, "existing", "", []string{"work"}, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+
+	newID := uuid.New()
+	results, err := service.ApplyMutations(ctx, []Mutation{
+		{ClientMutationID: "1", Kind: domain.MutationCreate, TaskID: newID,
+			Patch: TaskPatch{Title: strPtr("offline"), TagNames: []string{"work", "new"}}},
+		{ClientMutationID: "2", Kind: domain.MutationUpdate, TaskID: newID,
+			Patch: TaskPatch{Title: strPtr("offline, renamed")}},
+		{ClientMutationID: "3", Kind: domain.MutationDelete, TaskID: existing.ID},
+	}, true)
+	if err != nil {
+		t.Fatalf("apply mutations: %v", err)
+	}
+	for _, result := range results {
+		if !result.Applied() {
+			t.Errorf("result %s: conflict = %v", result.ClientMutationID, result.Conflict)
+		}
+	}
+	if preview := results[1].Task; preview == nil || preview.Title != "offline, renamed" || len(preview.TagIDs) != 1 {
+		t.Errorf("previewed task = %+v, want the existing tag only", preview)
+	}
+
+	if _, err := service.GetTask(ctx, newID); err == nil {
+		t.Error("validate-only create was saved")
+	}
+	if _, err := service.GetTask(ctx, existing.ID); err != nil {
+		t.Errorf("validate-only delete was saved: %v", err)
+	}
+	if _, err := memory.NewTagRepository(store).GetByName(ctx, "new", "owner"); err == nil {
+		t.Error("validate-only batch created a tag")
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
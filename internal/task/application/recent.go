@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// recentPageSize is the number of tasks loaded at a time when collecting
+	// recently updated tasks
+	recentPageSize = 200
+	// maxRecentScan bounds how many recently updated tasks are considered,
+	// so a busy window cannot turn one request into a full scan
+	maxRecentScan = 2000
+)
+
+// RecentScope selects the tasks ListRecentlyUpdated considers: those
+// carrying TagID, or those matching the saved filter FilterID. Exactly one
+// must be set.
+type RecentScope struct {
+	TagID    *uuid.UUID
+	FilterID *uuid.UUID
+}
+
+// ListRecentlyUpdated returns up to limit tasks in scope updated after
+// since, most recently updated first
+func (s *Service) ListRecentlyUpdated(ctx context.Context, scope RecentScope, since time.Time, limit int) ([]*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "ListRecentlyUpdated", trace.WithAttributes(
+		attribute.Bool("tag_scope", scope.TagID != nil),
+		attribute.Bool("filter_scope", scope.FilterID != nil),
+		attribute.Int("limit", limit),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var tagIDs []uuid.UUID
+	var opts domain.ListOptions
+	if scope.FilterID != nil {
+		filter, err := s.filterRepo.Get(ctx, *scope.FilterID, userID)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to get saved filter", "filter_id", *scope.FilterID, "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+		tagIDs = filter.Criteria.TagIDs
+		opts = filterListOptions(filter.Criteria)
+	} else if scope.TagID != nil {
+		tagIDs = []uuid.UUID{*scope.TagID}
+	}
+	opts.UpdatedAfter = &since
+
+	// The list is ordered for display, not by update time, so collect the
+	// whole window before sorting it
+	var tasks []*domain.Task
+	for offset := 0; offset < maxRecentScan; offset += recentPageSize {
+		result, err := s.repo.List(ctx, userID, tagIDs, recentPageSize, offset, opts)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to list recently updated tasks", "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+		tasks = append(tasks, result.Tasks...)
+		if len(result.Tasks) < recentPageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].UpdatedAt.After(tasks[j].UpdatedAt)
+	})
+	if len(tasks) > limit {
+		tasks = tasks[:limit]
+	}
+	return tasks, nil
+}
@@ -0,0 +1,46 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// purgedRows counts the rows deleted by retention purges, by kind of data
+var purgedRows, _ = otel.Meter("task-service").Int64Counter(
+	"retention.purged",
+	metric.WithDescription("Rows deleted by data retention purges"),
+	metric.WithUnit("{row}"),
+)
+
+// RunTombstonePurge deletes the records of tasks deleted more than retention
+// ago and returns how many were deleted. It is run by the scheduled
+// retention job. Clients whose last incremental sync is older than retention
+// no longer learn of those deletions and have to sync in full. A
+// non-positive retention keeps every tombstone.
+func (s *Service) RunTombstonePurge(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, span := tracer.Start(ctx, "RunTombstonePurge", trace.WithAttributes(
+		attribute.String("retention", retention.String()),
+	))
+	defer span.End()
+
+	if retention <= 0 {
+		return 0, nil
+	}
+
+	count, err := s.repo.PurgeTombstones(ctx, time.Now().Add(-retention))
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to purge task tombstones", "error", err)
+		span.RecordError(err)
+		return 0, err
+	}
+
+	purgedRows.Add(ctx, count, metric.WithAttributes(attribute.String("data", "task_tombstones")))
+	span.SetAttributes(attribute.Int64("purged", count))
+	s.logger.InfoContext(ctx, "task tombstone purge finished", "retention", retention, "purged", count)
+	return count, nil
+}
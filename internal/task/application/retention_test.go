@@ -0,0 +1,50 @@
+package application
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/memory"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+func TestRunTombstonePurge(t *testing.T) {
+	store := memory.NewStore()
+	repo := memory.NewTaskRepository(store)
+	service := NewService(repo, memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	task, err := service.CreateTask(ctx, "gone", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if err := service.DeleteTask(ctx, task.ID); err != nil {
+		t.Fatalf("delete task: %v", err)
+	}
+	since := time.Now().Add(-time.Minute)
+
+	for _, retention := range []time.Duration{0, time.Hour} {
+		purged, err := service.RunTombstonePurge(context.Background(), retention)
+		if err != nil || purged != 0 {
+			t.Fatalf("RunTombstonePurge(%s) = %d, %v; want nothing purged", retention, purged, err)
+		}
+	}
+	if tombstones, _ := repo.ListTombstones(ctx, "owner", since, nil, 10); len(tombstones) != 1 {
+		t.Fatalf("tombstones = %v, want the deleted task", tombstones)
+	}
+
+	time.Sleep(time.Millisecond)
+	purged, err := service.RunTombstonePurge(context.Background(), time.Nanosecond)
+	if err != nil || purged != 1 {
+		t.Fatalf("RunTombstonePurge() = %d, %v; want 1 purged", purged, err)
+	}
+	if tombstones, _ := repo.ListTombstones(ctx, "owner", since, nil, 10); len(tombstones) != 0 {
+		t.Errorf("tombstones after purge = %v", tombstones)
+	}
+}
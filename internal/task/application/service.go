@@ -2,15 +2,18 @@ package application
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	savedfilterdomain "github.com/slips-ai/slips-core/internal/savedfilter/domain"
 	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
 	"github.com/slips-ai/slips-core/internal/task/domain"
 	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -20,22 +23,41 @@ var tracer = otel.Tracer("task-service")
 
 // Service provides task business logic
 type Service struct {
-	repo    domain.Repository
-	tagRepo tagdomain.Repository
-	logger  *slog.Logger
+	repo       domain.Repository
+	tagRepo    tagdomain.Repository
+	filterRepo savedfilterdomain.Repository
+	users      Users
+	events     changefeed.Feed
+	checklists domain.ChecklistLimits
+	logger     *slog.Logger
 }
 
-// NewService creates a new task service
-func NewService(repo domain.Repository, tagRepo tagdomain.Repository, logger *slog.Logger) *Service {
+// NewService creates a new task service. Changes are published to events,
+// which also serves WatchChanges, and checklists are kept within
+// checklists. users may be nil, leaving every user with the zero list
+// defaults and transfers without a check that the recipient exists.
+func NewService(repo domain.Repository, tagRepo tagdomain.Repository, filterRepo savedfilterdomain.Repository, users Users, events changefeed.Feed, checklists domain.ChecklistLimits, logger *slog.Logger) *Service {
 	return &Service{
-		repo:    repo,
-		tagRepo: tagRepo,
-		logger:  logger,
+		repo:       repo,
+		tagRepo:    tagRepo,
+		filterRepo: filterRepo,
+		users:      users,
+		events:     events,
+		checklists: checklists,
+		logger:     logger,
 	}
 }
 
-// CreateTask creates a new task
-func (s *Service) CreateTask(ctx context.Context, title, notes string, tagNames []string, startDate *time.Time, checklistItems []string) (*domain.Task, error) {
+// ChecklistLimits returns the limits checklists are kept within
+func (s *Service) ChecklistLimits() domain.ChecklistLimits {
+	return s.checklists
+}
+
+// CreateTask creates a new task. taskContext is a normalized GTD context,
+// or empty for none. A non-empty clientRequestID makes the call idempotent:
+// when the user already created a task with it, that task is returned
+// unchanged instead.
+func (s *Service) CreateTask(ctx context.Context, title, notes string, tagNames []string, startDate, deadline *time.Time, taskContext string, checklistItems []string, clientRequestID string) (*domain.Task, error) {
 	ctx, span := tracer.Start(ctx, "CreateTask", trace.WithAttributes(
 		attribute.String("title", title),
 	))
@@ -49,10 +71,15 @@ func (s *Service) CreateTask(ctx context.Context, title, notes string, tagNames
 		return nil, err
 	}
 
+	if err := s.checklists.CheckChecklist(checklistItems); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	// Convert tag names to tag IDs (create tags if they don't exist)
 	tagIDs := make([]uuid.UUID, 0, len(tagNames))
 	for _, tagName := range tagNames {
-		tag, err := s.tagRepo.GetOrCreate(ctx, tagName, userID)
+		tag, err := s.tagRepo.GetOrCreate(ctx, tagdomain.NormalizeName(tagName), userID)
 		if err != nil {
 			s.logger.ErrorContext(ctx, "failed to get or create tag", "tag_name", tagName, "error", err)
 			span.RecordError(err)
@@ -62,17 +89,14 @@ func (s *Service) CreateTask(ctx context.Context, title, notes string, tagNames
 	}
 
 	task := domain.NewTask(title, notes, userID, tagIDs)
-	task.Checklist = make([]domain.ChecklistItem, 0, len(checklistItems))
-	for i, content := range checklistItems {
-		task.Checklist = append(task.Checklist, domain.ChecklistItem{
-			Content:   content,
-			Completed: false,
-			SortOrder: int32(i),
-		})
-	}
+	task.Checklist = newChecklist(checklistItems)
+	task.ClientRequestID = clientRequestID
+	task.LastModifiedBy = modifierFromContext(ctx)
 
 	// Set start date if provided; nil means inbox
 	task.SetStartDate(startDate)
+	task.SetDeadline(deadline)
+	task.SetContext(taskContext)
 
 	if err := s.repo.Create(ctx, task); err != nil {
 		s.logger.ErrorContext(ctx, "failed to create task", "error", err)
@@ -80,12 +104,52 @@ func (s *Service) CreateTask(ctx context.Context, title, notes string, tagNames
 		return nil, err
 	}
 
+	s.publishTags(ctx, userID, tagIDs)
+	s.publishTask(ctx, userID, task.ID)
+
 	s.logger.InfoContext(ctx, "task created", "id", task.ID, "owner_id", userID)
 	return task, nil
 }
 
+// newChecklist builds the unsaved checklist of a new task, in the given order
+func newChecklist(contents []string) []domain.ChecklistItem {
+	checklist := make([]domain.ChecklistItem, 0, len(contents))
+	for i, content := range contents {
+		checklist = append(checklist, domain.ChecklistItem{
+			Content:   content,
+			Completed: false,
+			SortOrder: int32(i),
+		})
+	}
+	return checklist
+}
+
+// modifierFromContext attributes a change to the authenticated caller of ctx.
+// Calls without a principal, e.g. from scheduled jobs, are made by the
+// system.
+func modifierFromContext(ctx context.Context) domain.Modifier {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.Modifier{Source: domain.ChangeSourceSystem}
+	}
+	if principal.IsAgent() {
+		return domain.Modifier{
+			Source:    domain.ChangeSourceAgent,
+			ClientID:  principal.ClientID,
+			TokenID:   principal.TokenID,
+			TokenName: principal.TokenName,
+		}
+	}
+	return domain.Modifier{Source: domain.ChangeSourceUser, ClientID: principal.ClientID}
+}
+
 // GetTask retrieves a task by ID
 func (s *Service) GetTask(ctx context.Context, id uuid.UUID) (*domain.Task, error) {
+	return s.getTask(ctx, id, domain.LoadOptions{})
+}
+
+// getTask retrieves a task by ID without the parts load leaves out
+func (s *Service) getTask(ctx context.Context, id uuid.UUID, load domain.LoadOptions) (*domain.Task, error) {
 	ctx, span := tracer.Start(ctx, "GetTask", trace.WithAttributes(
 		attribute.String("id", id.String()),
 	))
@@ -99,7 +163,7 @@ func (s *Service) GetTask(ctx context.Context, id uuid.UUID) (*domain.Task, erro
 		return nil, err
 	}
 
-	task, err := s.repo.Get(ctx, id, userID)
+	task, err := s.repo.GetPartial(ctx, id, userID, load)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to get task", "id", id, "error", err)
 		span.RecordError(err)
@@ -109,11 +173,152 @@ func (s *Service) GetTask(ctx context.Context, id uuid.UUID) (*domain.Task, erro
 	return task, nil
 }
 
+// ViewTask retrieves a task for the user to look at and records the view,
+// like MarkTaskViewed. The task is returned as it was before the view, so
+// its IsNew and UpdatedSinceViewed still tell what the user has not seen.
+// Reads by agents and the system are not views. load leaves out parts of
+// the task the caller does not show.
+func (s *Service) ViewTask(ctx context.Context, id uuid.UUID, load domain.LoadOptions) (*domain.Task, error) {
+	task, err := s.getTask(ctx, id, load)
+	if err != nil {
+		return nil, err
+	}
+
+	if modifierFromContext(ctx).Source == domain.ChangeSourceUser {
+		if err := s.repo.MarkViewed(ctx, id, task.OwnerID); err != nil {
+			// Showing the task matters more than the view time
+			s.logger.WarnContext(ctx, "failed to record task view", "id", id, "error", err)
+		}
+	}
+	return task, nil
+}
+
+// BatchGetTasks retrieves multiple tasks by ID.
+// It returns the tasks that were found and the IDs that were not, in request order.
+func (s *Service) BatchGetTasks(ctx context.Context, ids []uuid.UUID) ([]*domain.Task, []uuid.UUID, error) {
+	ctx, span := tracer.Start(ctx, "BatchGetTasks", trace.WithAttributes(
+		attribute.Int("count", len(ids)),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, nil, err
+	}
+
+	found, err := s.repo.GetMany(ctx, ids, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to batch get tasks", "count", len(ids), "error", err)
+		span.RecordError(err)
+		return nil, nil, err
+	}
+
+	byID := make(map[uuid.UUID]*domain.Task, len(found))
+	for _, task := range found {
+		byID[task.ID] = task
+	}
+
+	tasks := make([]*domain.Task, 0, len(found))
+	missing := make([]uuid.UUID, 0)
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if task, ok := byID[id]; ok {
+			tasks = append(tasks, task)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	return tasks, missing, nil
+}
+
+// StreamTasks walks all of the caller's tasks in ID order and passes them to
+// send in chunks of up to chunkSize. The next chunk is loaded only after send
+// returns, so a slow receiver throttles the walk instead of tasks piling up
+// in memory.
+func (s *Service) StreamTasks(ctx context.Context, chunkSize int, opts domain.ScanOptions, send func([]*domain.Task) error) error {
+	ctx, span := tracer.Start(ctx, "StreamTasks", trace.WithAttributes(
+		attribute.Int("chunk_size", chunkSize),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	var after uuid.UUID
+	streamed := 0
+	for {
+		tasks, err := s.repo.ListAfter(ctx, userID, after, chunkSize, opts)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to list tasks for stream", "streamed", streamed, "error", err)
+			span.RecordError(err)
+			return err
+		}
+		if len(tasks) == 0 {
+			break
+		}
+		if err := send(tasks); err != nil {
+			span.RecordError(err)
+			return err
+		}
+		streamed += len(tasks)
+		if len(tasks) < chunkSize {
+			break
+		}
+		after = tasks[len(tasks)-1].ID
+	}
+
+	span.SetAttributes(attribute.Int("streamed", streamed))
+	return nil
+}
+
+// TaskPatch lists the task fields changed by PatchTask. Title and Notes are
+// left unchanged when nil, the other fields when their Set flag is false.
+type TaskPatch struct {
+	Title        *string
+	Notes        *string
+	SetTagNames  bool
+	TagNames     []string
+	SetStartDate bool
+	StartDate    *time.Time // nil moves the task to the inbox
+	SetDeadline  bool
+	Deadline     *time.Time // nil clears the deadline
+	SetContext   bool
+	Context      string // normalized; empty clears the context
+}
+
 // UpdateTask updates a task
-func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, title, notes string, tagNames []string, startDateProvided bool, startDate *time.Time) (*domain.Task, error) {
-	ctx, span := tracer.Start(ctx, "UpdateTask", trace.WithAttributes(
+func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, title, notes string, tagNames []string, startDateProvided bool, startDate *time.Time, deadlineProvided bool, deadline *time.Time, contextProvided bool, taskContext string) (*domain.Task, error) {
+	return s.PatchTask(ctx, id, TaskPatch{
+		Title:        &title,
+		Notes:        &notes,
+		SetTagNames:  true,
+		TagNames:     tagNames,
+		SetStartDate: startDateProvided,
+		StartDate:    startDate,
+		SetDeadline:  deadlineProvided,
+		Deadline:     deadline,
+		SetContext:   contextProvided,
+		Context:      taskContext,
+	})
+}
+
+// PatchTask updates the fields of a task selected by patch
+func (s *Service) PatchTask(ctx context.Context, id uuid.UUID, patch TaskPatch) (*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "PatchTask", trace.WithAttributes(
 		attribute.String("id", id.String()),
-		attribute.String("title", title),
 	))
 	defer span.End()
 
@@ -132,24 +337,39 @@ func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, title, notes str
 		return nil, err
 	}
 
-	// Convert tag names to tag IDs (create tags if they don't exist)
-	tagIDs := make([]uuid.UUID, 0, len(tagNames))
-	for _, tagName := range tagNames {
-		tag, err := s.tagRepo.GetOrCreate(ctx, tagName, userID)
-		if err != nil {
-			s.logger.ErrorContext(ctx, "failed to get or create tag", "tag_name", tagName, "error", err)
-			span.RecordError(err)
-			return nil, err
+	title, notes, tagIDs := task.Title, task.Notes, task.TagIDs
+	if patch.Title != nil {
+		title = *patch.Title
+	}
+	if patch.Notes != nil {
+		notes = *patch.Notes
+	}
+	if patch.SetTagNames {
+		// Convert tag names to tag IDs (create tags if they don't exist)
+		tagIDs = make([]uuid.UUID, 0, len(patch.TagNames))
+		for _, tagName := range patch.TagNames {
+			tag, err := s.tagRepo.GetOrCreate(ctx, tagdomain.NormalizeName(tagName), userID)
+			if err != nil {
+				s.logger.ErrorContext(ctx, "failed to get or create tag", "tag_name", tagName, "error", err)
+				span.RecordError(err)
+				return nil, err
+			}
+			tagIDs = append(tagIDs, tag.ID)
 		}
-		tagIDs = append(tagIDs, tag.ID)
 	}
 
 	task.Update(title, notes, tagIDs)
 
-	// Update start date only when provided in request.
-	if startDateProvided {
-		task.SetStartDate(startDate)
+	if patch.SetStartDate {
+		task.SetStartDate(patch.StartDate)
+	}
+	if patch.SetDeadline {
+		task.SetDeadline(patch.Deadline)
 	}
+	if patch.SetContext {
+		task.SetContext(patch.Context)
+	}
+	task.LastModifiedBy = modifierFromContext(ctx)
 
 	if err := s.repo.Update(ctx, task); err != nil {
 		s.logger.ErrorContext(ctx, "failed to update task", "id", id, "error", err)
@@ -157,11 +377,10 @@ func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, title, notes str
 		return nil, err
 	}
 
-	// Clean up orphaned tags
-	if err := s.tagRepo.DeleteOrphans(ctx, userID); err != nil {
-		s.logger.WarnContext(ctx, "failed to clean up orphan tags", "error", err)
-		// Don't fail the update if tag cleanup fails
+	if patch.SetTagNames {
+		s.publishTags(ctx, userID, tagIDs)
 	}
+	s.publishTask(ctx, userID, task.ID)
 
 	s.logger.InfoContext(ctx, "task updated", "id", task.ID)
 	return task, nil
@@ -188,23 +407,59 @@ func (s *Service) DeleteTask(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
-	// Clean up orphaned tags
-	if err := s.tagRepo.DeleteOrphans(ctx, userID); err != nil {
-		s.logger.WarnContext(ctx, "failed to clean up orphan tags", "error", err)
-		// Don't fail the delete if tag cleanup fails
-	}
+	s.publishTaskDeleted(ctx, userID, id)
 
 	s.logger.InfoContext(ctx, "task deleted", "id", id)
 	return nil
 }
 
-// ListTasks lists tasks
-func (s *Service) ListTasks(ctx context.Context, filterTagIDs []uuid.UUID, limit, offset int, includeArchived, archivedOnly bool) ([]*domain.Task, error) {
+// PreviewDeleteTask returns the caller's task DeleteTask would delete
+// without deleting it, or nil when there is no such task
+func (s *Service) PreviewDeleteTask(ctx context.Context, id uuid.UUID) (*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "PreviewDeleteTask", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	task, err := s.repo.Get(ctx, id, userID)
+	if errors.Is(err, domain.ErrTaskNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get task", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	return task, nil
+}
+
+// ListTasks lists tasks.
+// When deadlineApproaching is set, only tasks whose deadline is overdue or falls
+// within domain.DeadlineApproachingDays of today are returned.
+func (s *Service) ListTasks(ctx context.Context, filterTagIDs []uuid.UUID, limit, offset int, opts domain.ListOptions, deadlineApproaching bool) (*domain.ListResult, error) {
 	ctx, span := tracer.Start(ctx, "ListTasks", trace.WithAttributes(
 		attribute.Int("limit", limit),
 		attribute.Int("offset", offset),
-		attribute.Bool("include_archived", includeArchived),
-		attribute.Bool("archived_only", archivedOnly),
+		attribute.Bool("include_archived", opts.IncludeArchived),
+		attribute.Bool("archived_only", opts.ArchivedOnly),
+		attribute.Bool("tag_match_all", opts.TagMatchAll),
+		attribute.Int("exclude_tag_count", len(opts.ExcludeTagIDs)),
+		attribute.Bool("untagged_only", opts.UntaggedOnly),
+		attribute.Bool("inbox_only", opts.InboxOnly),
+		attribute.StringSlice("contexts", opts.Contexts),
+		attribute.Int("group_by", int(opts.GroupBy)),
+		attribute.Bool("updated_after_set", opts.UpdatedAfter != nil),
+		attribute.Bool("archived_range_set", opts.ArchivedAfter != nil || opts.ArchivedBefore != nil),
+		attribute.Int("order", int(opts.Order)),
+		attribute.Bool("deadline_approaching", deadlineApproaching),
 	))
 	defer span.End()
 
@@ -216,19 +471,101 @@ func (s *Service) ListTasks(ctx context.Context, filterTagIDs []uuid.UUID, limit
 		return nil, err
 	}
 
-	opts := domain.ListOptions{
-		IncludeArchived: includeArchived,
-		ArchivedOnly:    archivedOnly,
+	if deadlineApproaching {
+		opts.DeadlineBefore = deadlineApproachingCutoff(time.Now())
 	}
 
-	tasks, err := s.repo.List(ctx, userID, filterTagIDs, limit, offset, opts)
+	result, err := s.repo.List(ctx, userID, filterTagIDs, limit, offset, opts)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to list tasks", "error", err)
 		span.RecordError(err)
 		return nil, err
 	}
 
-	return tasks, nil
+	// Incremental sync: include deletions since the same point in time, a
+	// page at a time. Fetch one extra to learn whether another page follows.
+	if opts.UpdatedAfter != nil {
+		tombstones, err := s.repo.ListTombstones(ctx, userID, *opts.UpdatedAfter, opts.DeletedTasksAfter, limit+1)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to list task tombstones", "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+		if len(tombstones) > limit {
+			tombstones = tombstones[:limit]
+			result.MoreDeletedTasks = true
+		}
+		result.DeletedTasks = tombstones
+	}
+
+	return result, nil
+}
+
+// ListTasksByFilter lists tasks matching the criteria of a saved filter
+func (s *Service) ListTasksByFilter(ctx context.Context, filterID uuid.UUID, limit, offset int, groupBy domain.GroupBy) (*domain.ListResult, error) {
+	ctx, span := tracer.Start(ctx, "ListTasksByFilter", trace.WithAttributes(
+		attribute.String("filter_id", filterID.String()),
+		attribute.Int("limit", limit),
+		attribute.Int("offset", offset),
+		attribute.Int("group_by", int(groupBy)),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	filter, err := s.filterRepo.Get(ctx, filterID, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get saved filter", "filter_id", filterID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	opts := filterListOptions(filter.Criteria)
+	opts.GroupBy = groupBy
+	// A search leaves archived tasks out unless the filter or the user's
+	// defaults take them in
+	if opts.Query != "" && !opts.IncludeArchived {
+		opts.IncludeArchived = s.ListDefaults(ctx).SearchIncludeArchived
+	}
+
+	result, err := s.repo.List(ctx, userID, filter.Criteria.TagIDs, limit, offset, opts)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list tasks by filter", "filter_id", filterID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// filterListOptions returns the list options selecting the tasks that match
+// a saved filter, apart from its tags
+func filterListOptions(criteria savedfilterdomain.Criteria) domain.ListOptions {
+	opts := domain.ListOptions{
+		IncludeArchived: criteria.IncludeArchived,
+		StartDateFrom:   criteria.StartDateFrom,
+		StartDateTo:     criteria.StartDateTo,
+		Query:           criteria.Query,
+		Contexts:        criteria.Contexts,
+	}
+	if criteria.DeadlineApproaching {
+		opts.DeadlineBefore = deadlineApproachingCutoff(time.Now())
+	}
+	return opts
+}
+
+// deadlineApproachingCutoff returns the last deadline date, in UTC, that counts
+// as approaching relative to now.
+func deadlineApproachingCutoff(now time.Time) *time.Time {
+	year, month, day := now.UTC().Date()
+	cutoff := time.Date(year, month, day+domain.DeadlineApproachingDays, 0, 0, 0, 0, time.UTC)
+	return &cutoff
 }
 
 // ArchiveTask archives a task
@@ -246,13 +583,15 @@ func (s *Service) ArchiveTask(ctx context.Context, id uuid.UUID) (*domain.Task,
 		return nil, err
 	}
 
-	task, err := s.repo.Archive(ctx, id, userID)
+	task, err := s.repo.Archive(ctx, id, userID, modifierFromContext(ctx))
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to archive task", "id", id, "error", err)
 		span.RecordError(err)
 		return nil, err
 	}
 
+	s.publishTask(ctx, userID, id)
+
 	s.logger.InfoContext(ctx, "task archived", "id", id)
 	return task, nil
 }
@@ -272,19 +611,421 @@ func (s *Service) UnarchiveTask(ctx context.Context, id uuid.UUID) (*domain.Task
 		return nil, err
 	}
 
-	task, err := s.repo.Unarchive(ctx, id, userID)
+	task, err := s.repo.Unarchive(ctx, id, userID, modifierFromContext(ctx))
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to unarchive task", "id", id, "error", err)
 		span.RecordError(err)
 		return nil, err
 	}
 
+	s.publishTask(ctx, userID, id)
+
 	s.logger.InfoContext(ctx, "task unarchived", "id", id)
 	return task, nil
 }
 
-// AddChecklistItem adds a checklist item to a task.
-func (s *Service) AddChecklistItem(ctx context.Context, taskID uuid.UUID, content string) (*domain.ChecklistItem, error) {
+// TogglePinTask flips the pinned flag of a task
+func (s *Service) TogglePinTask(ctx context.Context, id uuid.UUID) (*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "TogglePinTask", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	task, err := s.repo.TogglePin(ctx, id, userID, modifierFromContext(ctx))
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to toggle task pin", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.publishTask(ctx, userID, id)
+
+	s.logger.InfoContext(ctx, "task pin toggled", "id", id, "pinned", task.Pinned)
+	return task, nil
+}
+
+// SetTaskGeofence sets the geofence mobile clients remind the owner of a
+// task at, or removes it when geofence is nil
+func (s *Service) SetTaskGeofence(ctx context.Context, id uuid.UUID, geofence *domain.Geofence) (*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "SetTaskGeofence", trace.WithAttributes(
+		attribute.String("id", id.String()),
+		attribute.Bool("clear", geofence == nil),
+	))
+	defer span.End()
+
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if geofence != nil {
+		if err := geofence.Validate(); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	task, err := s.repo.SetGeofence(ctx, id, userID, geofence, modifierFromContext(ctx))
+	if err != nil {
+		if !errors.Is(err, domain.ErrTaskNotFound) {
+			s.logger.ErrorContext(ctx, "failed to set task geofence", "id", id, "error", err)
+		}
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.publishTask(ctx, userID, id)
+
+	s.logger.InfoContext(ctx, "task geofence set", "id", id, "cleared", geofence == nil)
+	return task, nil
+}
+
+// CompleteTask marks a task as completed
+func (s *Service) CompleteTask(ctx context.Context, id uuid.UUID) (*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "CompleteTask", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	task, err := s.repo.Complete(ctx, id, userID, modifierFromContext(ctx))
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to complete task", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.publishTask(ctx, userID, id)
+
+	s.logger.InfoContext(ctx, "task completed", "id", id)
+	return task, nil
+}
+
+// ReopenTask marks a completed task as open again
+func (s *Service) ReopenTask(ctx context.Context, id uuid.UUID) (*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "ReopenTask", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	task, err := s.repo.Reopen(ctx, id, userID, modifierFromContext(ctx))
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to reopen task", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.publishTask(ctx, userID, id)
+
+	s.logger.InfoContext(ctx, "task reopened", "id", id)
+	return task, nil
+}
+
+// ArchiveCompletedTasks archives all of the user's completed tasks in one statement.
+// When olderThanDays is positive, only tasks completed at least that many days ago are archived.
+func (s *Service) ArchiveCompletedTasks(ctx context.Context, olderThanDays int) (int64, error) {
+	ctx, span := tracer.Start(ctx, "ArchiveCompletedTasks", trace.WithAttributes(
+		attribute.Int("older_than_days", olderThanDays),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return 0, err
+	}
+
+	var completedBefore *time.Time
+	if olderThanDays > 0 {
+		cutoff := time.Now().AddDate(0, 0, -olderThanDays)
+		completedBefore = &cutoff
+	}
+
+	count, err := s.repo.ArchiveCompleted(ctx, userID, completedBefore, modifierFromContext(ctx))
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to archive completed tasks", "error", err)
+		span.RecordError(err)
+		return 0, err
+	}
+
+	if count > 0 {
+		s.publishTasksResync(ctx, userID)
+	}
+
+	s.logger.InfoContext(ctx, "completed tasks archived", "count", count, "older_than_days", olderThanDays)
+	return count, nil
+}
+
+// RolloverOverdueTasks moves the caller's open tasks that started before
+// today to today, or to the inbox when the caller's settings ask for it, so
+// a day can be planned from a clean slate. It returns the moved tasks.
+func (s *Service) RolloverOverdueTasks(ctx context.Context, today time.Time) ([]*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "RolloverOverdueTasks", trace.WithAttributes(
+		attribute.String("today", today.Format(time.DateOnly)),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	settings, err := s.repo.GetSettings(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get task settings", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	tasks, err := s.repo.RolloverTasks(ctx, userID, today, settings.RolloverToInbox, modifierFromContext(ctx))
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to roll over tasks", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if len(tasks) > 0 {
+		s.publishTasksResync(ctx, userID)
+	}
+
+	s.logger.InfoContext(ctx, "overdue tasks rolled over", "count", len(tasks), "to_inbox", settings.RolloverToInbox)
+	return tasks, nil
+}
+
+// GetTaskStats returns activity statistics for the last `days` days along with
+// per-tag counts and the current backlog size
+func (s *Service) GetTaskStats(ctx context.Context, days int, bucket domain.StatsBucket) (*domain.Stats, error) {
+	ctx, span := tracer.Start(ctx, "GetTaskStats", trace.WithAttributes(
+		attribute.Int("days", days),
+		attribute.String("bucket", bucket.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// Start the window at UTC midnight so the oldest bucket is complete
+	year, month, day := time.Now().UTC().Date()
+	since := time.Date(year, month, day-days+1, 0, 0, 0, 0, time.UTC)
+
+	stats, err := s.repo.GetStats(ctx, userID, since, bucket)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get task stats", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetTagActivity counts the caller's open and completed tasks carrying tagID
+// and returns up to recentLimit of them, most recently updated first
+func (s *Service) GetTagActivity(ctx context.Context, tagID uuid.UUID, recentLimit int) (*domain.TagActivity, error) {
+	ctx, span := tracer.Start(ctx, "GetTagActivity", trace.WithAttributes(
+		attribute.String("tag_id", tagID.String()),
+		attribute.Int("recent_limit", recentLimit),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	activity, err := s.repo.GetTagActivity(ctx, userID, tagID, recentLimit)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get tag activity", "tag_id", tagID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+// GenerateWeeklyReview bundles stale, undated, completed-this-week and overdue
+// tasks for a guided review. Open tasks not updated for staleDays days are stale.
+// Weeks start on Monday (UTC).
+func (s *Service) GenerateWeeklyReview(ctx context.Context, staleDays int) (*domain.WeeklyReview, error) {
+	ctx, span := tracer.Start(ctx, "GenerateWeeklyReview", trace.WithAttributes(
+		attribute.Int("stale_days", staleDays),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	year, month, day := now.Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	daysSinceMonday := (int(today.Weekday()) + 6) % 7
+
+	review, err := s.repo.GetWeeklyReview(ctx, userID, domain.ReviewOptions{
+		StaleBefore: now.AddDate(0, 0, -staleDays),
+		WeekStart:   today.AddDate(0, 0, -daysSinceMonday),
+		Today:       today,
+	})
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to generate weekly review", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// MarkTaskViewed records that the caller opened a task, so ListStaleTasks
+// does not report tasks the user keeps looking at. Viewing is not a change:
+// updated_at stays and no change is published.
+func (s *Service) MarkTaskViewed(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "MarkTaskViewed", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	if err := s.repo.MarkViewed(ctx, id, userID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to mark task viewed", "id", id, "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// ListStaleTasks returns up to limit of the caller's open tasks that were
+// neither updated nor viewed in the last thresholdDays days, longest
+// inactive first, so the backlog can be pruned or rescheduled.
+func (s *Service) ListStaleTasks(ctx context.Context, thresholdDays, limit int) ([]*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "ListStaleTasks", trace.WithAttributes(
+		attribute.Int("threshold_days", thresholdDays),
+		attribute.Int("limit", limit),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	tasks, err := s.repo.ListStale(ctx, userID, time.Now().AddDate(0, 0, -thresholdDays), limit)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list stale tasks", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// GetDigest collects the caller's tasks for an email digest: open tasks for
+// today, overdue tasks and tasks completed within the options' range.
+func (s *Service) GetDigest(ctx context.Context, opts domain.DigestOptions) (*domain.Digest, error) {
+	ctx, span := tracer.Start(ctx, "GetDigest", trace.WithAttributes(
+		attribute.String("today", opts.Today.Format(time.DateOnly)),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	digest, err := s.repo.GetDigest(ctx, userID, opts)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get digest tasks", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return digest, nil
+}
+
+// GetCounters counts the caller's inbox, today, overdue and unread tasks
+// for badges. today is the caller's local date.
+func (s *Service) GetCounters(ctx context.Context, today time.Time) (*domain.Counters, error) {
+	ctx, span := tracer.Start(ctx, "GetCounters", trace.WithAttributes(
+		attribute.String("today", today.Format(time.DateOnly)),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	counters, err := s.repo.GetCounters(ctx, userID, today)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get task counters", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return counters, nil
+}
+
+// AddChecklistItem adds a checklist item to a task and returns it together
+// with the number of items the task has now. A task already at the item
+// limit gets no more items.
+func (s *Service) AddChecklistItem(ctx context.Context, taskID uuid.UUID, content string) (*domain.ChecklistItem, int, error) {
 	ctx, span := tracer.Start(ctx, "AddChecklistItem", trace.WithAttributes(
 		attribute.String("task_id", taskID.String()),
 	))
@@ -294,17 +1035,33 @@ func (s *Service) AddChecklistItem(ctx context.Context, taskID uuid.UUID, conten
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
 		span.RecordError(err)
-		return nil, err
+		return nil, 0, err
+	}
+
+	if err := s.checklists.CheckContent(content); err != nil {
+		span.RecordError(err)
+		return nil, 0, err
+	}
+	existing, err := s.repo.ListChecklistItems(ctx, taskID, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list checklist items", "task_id", taskID, "error", err)
+		span.RecordError(err)
+		return nil, 0, err
+	}
+	if err := s.checklists.CheckCount(len(existing) + 1); err != nil {
+		span.RecordError(err)
+		return nil, 0, err
 	}
 
 	item, err := s.repo.AddChecklistItem(ctx, taskID, userID, content)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to add checklist item", "task_id", taskID, "error", err)
 		span.RecordError(err)
-		return nil, err
+		return nil, 0, err
 	}
 
-	return item, nil
+	s.publishChecklistItem(ctx, userID, item)
+	return item, len(existing) + 1, nil
 }
 
 // UpdateChecklistItemContent updates checklist item text.
@@ -321,6 +1078,11 @@ func (s *Service) UpdateChecklistItemContent(ctx context.Context, itemID uuid.UU
 		return nil, err
 	}
 
+	if err := s.checklists.CheckContent(content); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	item, err := s.repo.UpdateChecklistItemContent(ctx, itemID, userID, content)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to update checklist item", "item_id", itemID, "error", err)
@@ -328,6 +1090,7 @@ func (s *Service) UpdateChecklistItemContent(ctx context.Context, itemID uuid.UU
 		return nil, err
 	}
 
+	s.publishChecklistItem(ctx, userID, item)
 	return item, nil
 }
 
@@ -353,6 +1116,7 @@ func (s *Service) SetChecklistItemCompleted(ctx context.Context, itemID uuid.UUI
 		return nil, err
 	}
 
+	s.publishChecklistItem(ctx, userID, item)
 	return item, nil
 }
 
@@ -376,6 +1140,12 @@ func (s *Service) DeleteChecklistItem(ctx context.Context, itemID uuid.UUID) err
 		return err
 	}
 
+	s.events.Publish(ctx, changefeed.Event{
+		OwnerID:   userID,
+		Resource:  changefeed.ResourceChecklistItem,
+		Operation: changefeed.OperationDelete,
+		ID:        itemID,
+	})
 	return nil
 }
 
@@ -434,5 +1204,74 @@ func (s *Service) ReorderChecklistItems(ctx context.Context, taskID uuid.UUID, i
 		return nil, err
 	}
 
+	for i := range items {
+		s.publishChecklistItem(ctx, userID, &items[i])
+	}
 	return items, nil
 }
+
+// ListNoteRevisions returns the previous versions of a task's notes, newest first
+func (s *Service) ListNoteRevisions(ctx context.Context, taskID uuid.UUID) ([]domain.NoteRevision, error) {
+	ctx, span := tracer.Start(ctx, "ListNoteRevisions", trace.WithAttributes(
+		attribute.String("task_id", taskID.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// Report missing tasks as not found rather than as an empty history
+	if _, err := s.repo.Get(ctx, taskID, userID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to get task for note revisions", "task_id", taskID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	revisions, err := s.repo.ListNoteRevisions(ctx, taskID, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list note revisions", "task_id", taskID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// RestoreNoteRevision replaces a task's notes with those of a revision. The
+// notes being replaced are kept as a new revision, so a restore can itself
+// be undone.
+func (s *Service) RestoreNoteRevision(ctx context.Context, taskID, revisionID uuid.UUID) (*domain.Task, error) {
+	ctx, span := tracer.Start(ctx, "RestoreNoteRevision", trace.WithAttributes(
+		attribute.String("task_id", taskID.String()),
+		attribute.String("revision_id", revisionID.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	revision, err := s.repo.GetNoteRevision(ctx, revisionID, taskID, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get note revision", "task_id", taskID, "revision_id", revisionID, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	task, err := s.PatchTask(ctx, taskID, TaskPatch{Notes: &revision.Notes})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "note revision restored", "task_id", taskID, "revision_id", revisionID)
+	return task, nil
+}
@@ -0,0 +1,472 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/memory"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+func TestLastModifiedBy(t *testing.T) {
+	store := memory.NewStore()
+	service := NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	phone := auth.WithPrincipal(context.Background(), &auth.Principal{
+		UserID: "owner", Credential: auth.CredentialJWT, ClientID: "phone",
+	})
+	tokenID := uuid.New()
+	agent := auth.WithPrincipal(context.Background(), &auth.Principal{
+		UserID: "owner", Credential: auth.CredentialMCPToken, TokenID: tokenID, TokenName: "Xbot",
+	})
+	byAgent := domain.Modifier{Source: domain.ChangeSourceAgent, TokenID: tokenID, TokenName: "Xbot"}
+
+	task, err := service.CreateTask(phone, "task", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if want := (domain.Modifier{Source: domain.ChangeSourceUser, ClientID: "phone"}); task.LastModifiedBy != want {
+		t.Errorf("after create LastModifiedBy = %+v, want %+v", task.LastModifiedBy, want)
+	}
+
+	if _, err := service.PatchTask(agent, task.ID, TaskPatch{Notes: strPtr("from the agent")}); err != nil {
+		t.Fatalf("patch task: %v", err)
+	}
+	got, err := service.GetTask(phone, task.ID)
+	if err != nil {
+		t.Fatalf("get task: %v", err)
+	}
+	if got.LastModifiedBy != byAgent {
+		t.Errorf("after agent update LastModifiedBy = %+v, want %+v", got.LastModifiedBy, byAgent)
+	}
+	if got.CreatedBy != task.LastModifiedBy {
+		t.Errorf("after agent update CreatedBy = %+v, want the phone", got.CreatedBy)
+	}
+
+	drafted, err := service.CreateTask(agent, "drafted", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task as agent: %v", err)
+	}
+	if drafted.CreatedBy != byAgent {
+		t.Errorf("agent task CreatedBy = %+v, want %+v", drafted.CreatedBy, byAgent)
+	}
+
+	completed, err := service.CompleteTask(phone, task.ID)
+	if err != nil {
+		t.Fatalf("complete task: %v", err)
+	}
+	if completed.LastModifiedBy.ClientID != "phone" {
+		t.Errorf("after complete LastModifiedBy = %+v, want the phone", completed.LastModifiedBy)
+	}
+
+	// Calls without a principal, like scheduled jobs, are made by the system
+	if _, err := service.ArchiveTask(auth.WithUserID(context.Background(), "owner"), task.ID); err != nil {
+		t.Fatalf("archive task: %v", err)
+	}
+	got, err = service.GetTask(phone, task.ID)
+	if err != nil {
+		t.Fatalf("get task: %v", err)
+	}
+	if got.LastModifiedBy.Source != domain.ChangeSourceSystem {
+		t.Errorf("after job LastModifiedBy = %+v, want the system", got.LastModifiedBy)
+	}
+}
+
+func TestChecklistLimits(t *testing.T) {
+	store := memory.NewStore()
+	limits := domain.ChecklistLimits{MaxItems: 3, MaxItemLength: 5}
+	service := NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), limits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	if _, err := service.CreateTask(ctx, "task", "", nil, nil, nil, "", []string{"a", "b", "c", "d"}, ""); !errors.Is(err, domain.ErrTooManyChecklistItems) {
+		t.Errorf("create with 4 items: err = %v, want ErrTooManyChecklistItems", err)
+	}
+	if _, err := service.CreateTask(ctx, "task", "", nil, nil, nil, "", []string{"a", "toolong"}, ""); !errors.Is(err, domain.ErrChecklistItemTooLong) {
+		t.Errorf("create with a long item: err = %v, want ErrChecklistItemTooLong", err)
+	}
+
+	// Length is counted in characters, so five multi-byte ones fit
+	task, err := service.CreateTask(ctx, "task", "", nil, nil, nil, "", []string{"a", strings.Repeat("\u00e9", 5)}, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+
+	item, count, err := service.AddChecklistItem(ctx, task.ID, "c")
+	if err != nil {
+		t.Fatalf("add third item: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("count after adding = %d, want 3", count)
+	}
+	if _, _, err := service.AddChecklistItem(ctx, task.ID, "d"); !errors.Is(err, domain.ErrTooManyChecklistItems) {
+		t.Errorf("add fourth item: err = %v, want ErrTooManyChecklistItems", err)
+	}
+	if _, err := service.UpdateChecklistItemContent(ctx, item.ID, "toolong"); !errors.Is(err, domain.ErrChecklistItemTooLong) {
+		t.Errorf("update to a long item: err = %v, want ErrChecklistItemTooLong", err)
+	}
+
+	got, err := service.GetTask(ctx, task.ID)
+	if err != nil {
+		t.Fatalf("get task: %v", err)
+	}
+	if len(got.Checklist) != 3 || got.Checklist[2].Content != "c" {
+		t.Errorf("checklist = %+v, want the 3 accepted items", got.Checklist)
+	}
+}
+
+func TestListTasks_Contexts(t *testing.T) {
+	store := memory.NewStore()
+	service := NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	ids := make(map[string]uuid.UUID)
+	for title, taskContext := range map[string]string{"mow lawn": "@home", "buy milk": "@errands", "file taxes": ""} {
+		task, err := service.CreateTask(ctx, title, "", nil, nil, nil, taskContext, nil, "")
+		if err != nil {
+			t.Fatalf("create task: %v", err)
+		}
+		ids[title] = task.ID
+	}
+
+	list := func(contexts ...string) []string {
+		t.Helper()
+		result, err := service.ListTasks(ctx, nil, 10, 0, domain.ListOptions{Contexts: contexts}, false)
+		if err != nil {
+			t.Fatalf("list tasks: %v", err)
+		}
+		titles := make([]string, 0, len(result.Tasks))
+		for _, task := range result.Tasks {
+			titles = append(titles, task.Title)
+		}
+		slices.Sort(titles)
+		return titles
+	}
+
+	if got := list(); len(got) != 3 {
+		t.Errorf("unfiltered list = %v, want all 3 tasks", got)
+	}
+	if got := list("@home", "@errands"); !slices.Equal(got, []string{"buy milk", "mow lawn"}) {
+		t.Errorf("list(@home, @errands) = %v, want [buy milk mow lawn]", got)
+	}
+
+	// Moving a task to another context moves it between filtered lists
+	if _, err := service.PatchTask(ctx, ids["mow lawn"], TaskPatch{SetContext: true, Context: "@low-energy"}); err != nil {
+		t.Fatalf("patch task: %v", err)
+	}
+	if got := list("@home"); len(got) != 0 {
+		t.Errorf("list(@home) after patch = %v, want none", got)
+	}
+	if got := list("@low-energy"); !slices.Equal(got, []string{"mow lawn"}) {
+		t.Errorf("list(@low-energy) = %v, want [mow lawn]", got)
+	}
+}
+
+func TestRolloverOverdueTasks(t *testing.T) {
+	store := memory.NewStore()
+	repo := memory.NewTaskRepository(store)
+	service := NewService(repo, memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	today := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	yesterday := today.AddDate(0, 0, -1)
+	tomorrow := today.AddDate(0, 0, 1)
+
+	create := func(title string, startDate *time.Time) *domain.Task {
+		t.Helper()
+		task, err := service.CreateTask(ctx, title, "", nil, startDate, nil, "", nil, "")
+		if err != nil {
+			t.Fatalf("create task: %v", err)
+		}
+		return task
+	}
+	overdue := create("overdue", &yesterday)
+	done := create("done", &yesterday)
+	if _, err := service.CompleteTask(ctx, done.ID); err != nil {
+		t.Fatalf("complete task: %v", err)
+	}
+	scheduled := create("scheduled", &tomorrow)
+	inbox := create("inbox", nil)
+
+	moved, err := service.RolloverOverdueTasks(ctx, today)
+	if err != nil {
+		t.Fatalf("rollover: %v", err)
+	}
+	if len(moved) != 1 || moved[0].ID != overdue.ID {
+		t.Fatalf("rollover moved %d tasks, want only the overdue one", len(moved))
+	}
+	if moved[0].StartDate == nil || !moved[0].StartDate.Equal(today) {
+		t.Errorf("rolled over start date = %v, want %v", moved[0].StartDate, today)
+	}
+	for _, untouched := range []*domain.Task{done, scheduled, inbox} {
+		got, err := service.GetTask(ctx, untouched.ID)
+		if err != nil {
+			t.Fatalf("get task: %v", err)
+		}
+		if (got.StartDate == nil) != (untouched.StartDate == nil) ||
+			(got.StartDate != nil && !got.StartDate.Equal(*untouched.StartDate)) {
+			t.Errorf("task %q start date changed to %v", got.Title, got.StartDate)
+		}
+	}
+
+	// With the inbox preference, rolled over tasks lose their start date
+	toInbox := true
+	if _, _, err := service.UpdateTaskSettings(ctx, domain.SettingsUpdate{RolloverToInbox: &toInbox}, false); err != nil {
+		t.Fatalf("set rollover preference: %v", err)
+	}
+	moved, err = service.RolloverOverdueTasks(ctx, tomorrow.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("rollover: %v", err)
+	}
+	if len(moved) != 2 {
+		t.Fatalf("rollover to inbox moved %d tasks, want 2", len(moved))
+	}
+	for _, task := range moved {
+		if task.StartDate != nil {
+			t.Errorf("task %q start date = %v, want inbox", task.Title, task.StartDate)
+		}
+	}
+}
+
+func TestAddAndRemoveTagFromTasks(t *testing.T) {
+	store := memory.NewStore()
+	tagRepo := memory.NewTagRepository(store)
+	service := NewService(memory.NewTaskRepository(store), tagRepo, memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	tagged, err := service.CreateTask(ctx, "tagged", "", []string{"q3-planning"}, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	untagged, err := service.CreateTask(ctx, "untagged", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+
+	// An unknown ID fails the whole request
+	if _, err := service.AddTagToTasks(ctx, "later", []uuid.UUID{untagged.ID, uuid.New()}); err == nil {
+		t.Fatal("tagging an unknown task succeeded")
+	}
+	if _, err := tagRepo.GetByName(ctx, "later", "owner"); err == nil {
+		t.Error("failed request created its tag")
+	}
+
+	tasks, err := service.AddTagToTasks(ctx, "q3-planning", []uuid.UUID{untagged.ID, tagged.ID, untagged.ID})
+	if err != nil {
+		t.Fatalf("add tag: %v", err)
+	}
+	if len(tasks) != 2 || tasks[0].ID != untagged.ID || tasks[1].ID != tagged.ID {
+		t.Fatalf("add tag returned %d tasks, want both in request order", len(tasks))
+	}
+	tag, err := tagRepo.GetByName(ctx, "q3-planning", "owner")
+	if err != nil {
+		t.Fatalf("get tag: %v", err)
+	}
+	if !slices.Equal(tasks[0].TagIDs, []uuid.UUID{tag.ID}) {
+		t.Errorf("tag IDs after add = %v, want [%s]", tasks[0].TagIDs, tag.ID)
+	}
+	if !tasks[1].UpdatedAt.Equal(tagged.UpdatedAt) {
+		t.Error("adding a tag the task already had changed updated_at")
+	}
+
+	tasks, err = service.RemoveTagFromTasks(ctx, "q3-planning", []uuid.UUID{tagged.ID, untagged.ID})
+	if err != nil {
+		t.Fatalf("remove tag: %v", err)
+	}
+	for _, task := range tasks {
+		if len(task.TagIDs) != 0 {
+			t.Errorf("task %q still has tags %v", task.Title, task.TagIDs)
+		}
+	}
+
+	// Removing a tag that does not exist changes nothing
+	if _, err := service.RemoveTagFromTasks(ctx, "never-created", []uuid.UUID{tagged.ID}); err != nil {
+		t.Errorf("remove unknown tag: %v", err)
+	}
+}
+
+func TestArchiveAndUnarchiveTasksByTag(t *testing.T) {
+	store := memory.NewStore()
+	tagRepo := memory.NewTagRepository(store)
+	service := NewService(memory.NewTaskRepository(store), tagRepo, memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	open, err := service.CreateTask(ctx, "open", "", []string{"launch"}, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	done, err := service.CreateTask(ctx, "done", "", []string{"launch"}, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if _, err := service.CompleteTask(ctx, done.ID); err != nil {
+		t.Fatalf("complete task: %v", err)
+	}
+	other, err := service.CreateTask(ctx, "other", "", []string{"errands"}, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	tag, err := tagRepo.GetByName(ctx, "launch", "owner")
+	if err != nil {
+		t.Fatalf("get tag: %v", err)
+	}
+
+	if _, err := service.ArchiveTasksByTag(ctx, uuid.New()); !errors.Is(err, tagdomain.ErrTagNotFound) {
+		t.Errorf("archive by unknown tag: err = %v, want ErrTagNotFound", err)
+	}
+	if _, err := service.ArchiveTasksByTag(auth.WithUserID(context.Background(), "intruder"), tag.ID); !errors.Is(err, tagdomain.ErrTagNotFound) {
+		t.Errorf("archive by another user's tag: err = %v, want ErrTagNotFound", err)
+	}
+
+	count, err := service.ArchiveTasksByTag(ctx, tag.ID)
+	if err != nil {
+		t.Fatalf("archive by tag: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("archived %d tasks, want 2", count)
+	}
+	for _, id := range []uuid.UUID{open.ID, done.ID} {
+		task, err := service.GetTask(ctx, id)
+		if err != nil {
+			t.Fatalf("get task: %v", err)
+		}
+		if task.ArchivedAt == nil {
+			t.Errorf("task %q was not archived", task.Title)
+		}
+	}
+	if task, err := service.GetTask(ctx, other.ID); err != nil || task.ArchivedAt != nil {
+		t.Errorf("task without the tag was archived (err %v)", err)
+	}
+	if count, err := service.ArchiveTasksByTag(ctx, tag.ID); err != nil || count != 0 {
+		t.Errorf("archiving again = %d, %v; want 0, nil", count, err)
+	}
+
+	count, err = service.UnarchiveTasksByTag(ctx, tag.ID)
+	if err != nil {
+		t.Fatalf("unarchive by tag: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("unarchived %d tasks, want 2", count)
+	}
+	task, err := service.GetTask(ctx, done.ID)
+	if err != nil {
+		t.Fatalf("get task: %v", err)
+	}
+	if task.ArchivedAt != nil || task.CompletedAt == nil {
+		t.Errorf("restored task archived_at = %v, completed_at = %v; want restored and still completed", task.ArchivedAt, task.CompletedAt)
+	}
+}
+
+func TestGetCounters(t *testing.T) {
+	store := memory.NewStore()
+	service := NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{
+		UserID: "owner", Credential: auth.CredentialJWT, ClientID: "phone",
+	})
+	agent := auth.WithPrincipal(context.Background(), &auth.Principal{
+		UserID: "owner", Credential: auth.CredentialMCPToken,
+	})
+
+	today := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	yesterday := today.AddDate(0, 0, -1)
+	tomorrow := today.AddDate(0, 0, 1)
+
+	// Inbox, added by an agent and not viewed yet
+	if _, err := service.CreateTask(agent, "from the agent", "", nil, nil, nil, "", nil, ""); err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if _, err := service.CreateTask(ctx, "starts today", "", nil, &today, nil, "", nil, ""); err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	// Started earlier but overdue, so counted as overdue only
+	if _, err := service.CreateTask(ctx, "overdue", "", nil, &yesterday, &yesterday, "", nil, ""); err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if _, err := service.CreateTask(ctx, "later", "", nil, &tomorrow, nil, "", nil, ""); err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	done, err := service.CreateTask(ctx, "done", "", nil, nil, &yesterday, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if _, err := service.CompleteTask(ctx, done.ID); err != nil {
+		t.Fatalf("complete task: %v", err)
+	}
+
+	counters, err := service.GetCounters(ctx, today)
+	if err != nil {
+		t.Fatalf("get counters: %v", err)
+	}
+	want := domain.Counters{Inbox: 1, Today: 1, Overdue: 1, Unread: 1}
+	if *counters != want {
+		t.Errorf("counters = %+v, want %+v", *counters, want)
+	}
+}
+
+func TestViewTask(t *testing.T) {
+	store := memory.NewStore()
+	service := NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	phone := auth.WithPrincipal(context.Background(), &auth.Principal{
+		UserID: "owner", Credential: auth.CredentialJWT, ClientID: "phone",
+	})
+	agent := auth.WithPrincipal(context.Background(), &auth.Principal{
+		UserID: "owner", Credential: auth.CredentialMCPToken,
+	})
+
+	task, err := service.CreateTask(agent, "from the agent", "", nil, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+
+	// The agent reading the task is not the user looking at it
+	if _, err := service.ViewTask(agent, task.ID, domain.LoadOptions{}); err != nil {
+		t.Fatalf("view task as agent: %v", err)
+	}
+	viewed, err := service.ViewTask(phone, task.ID, domain.LoadOptions{})
+	if err != nil {
+		t.Fatalf("view task: %v", err)
+	}
+	if !viewed.IsNew() {
+		t.Error("first view did not report the task as new")
+	}
+
+	got, err := service.GetTask(phone, task.ID)
+	if err != nil {
+		t.Fatalf("get task: %v", err)
+	}
+	if got.LastViewedAt == nil || got.IsNew() {
+		t.Errorf("after view LastViewedAt = %v, IsNew = %v", got.LastViewedAt, got.IsNew())
+	}
+	if !got.UpdatedAt.Equal(task.UpdatedAt) {
+		t.Errorf("viewing changed updated_at from %v to %v", task.UpdatedAt, got.UpdatedAt)
+	}
+
+	if _, err := service.PatchTask(agent, task.ID, TaskPatch{Notes: strPtr("more")}); err != nil {
+		t.Fatalf("patch task: %v", err)
+	}
+	got, err = service.GetTask(phone, task.ID)
+	if err != nil {
+		t.Fatalf("get task: %v", err)
+	}
+	if !got.UpdatedSinceViewed() {
+		t.Error("agent change after the view was not reported")
+	}
+}
@@ -0,0 +1,152 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetTaskSettings returns the caller's task settings
+func (s *Service) GetTaskSettings(ctx context.Context) (*domain.Settings, error) {
+	ctx, span := tracer.Start(ctx, "GetTaskSettings")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	settings, err := s.repo.GetSettings(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get task settings", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpdateTaskSettings applies update to the caller's task settings and
+// returns them together with how many completed tasks the next auto-archive
+// run would archive under the new threshold. With validateOnly set nothing
+// is saved, so clients can preview a threshold before enabling it.
+func (s *Service) UpdateTaskSettings(ctx context.Context, update domain.SettingsUpdate, validateOnly bool) (*domain.Settings, int64, error) {
+	ctx, span := tracer.Start(ctx, "UpdateTaskSettings", trace.WithAttributes(
+		attribute.Bool("disable_auto_archive", update.AutoArchiveAfterDays == nil),
+		attribute.Bool("validate_only", validateOnly),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, 0, err
+	}
+
+	var pending int64
+	if days := update.AutoArchiveAfterDays; days != nil {
+		policy := domain.AutoArchivePolicy{OwnerID: userID, AfterDays: *days}
+		cutoff := policy.Cutoff(time.Now())
+		pending, err = s.repo.CountArchivable(ctx, userID, &cutoff)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to count archivable tasks", "error", err)
+			span.RecordError(err)
+			return nil, 0, err
+		}
+	}
+
+	if validateOnly {
+		current, err := s.repo.GetSettings(ctx, userID)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to get task settings", "error", err)
+			span.RecordError(err)
+			return nil, 0, err
+		}
+		return update.Apply(*current), pending, nil
+	}
+
+	settings, err := s.repo.UpdateSettings(ctx, userID, update)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to update task settings", "error", err)
+		span.RecordError(err)
+		return nil, 0, err
+	}
+
+	s.logger.InfoContext(ctx, "task settings updated", "owner_id", userID,
+		"auto_archive_after_days", settings.AutoArchiveAfterDays, "rollover_to_inbox", settings.RolloverToInbox)
+	return settings, pending, nil
+}
+
+// RunAutoArchive archives the completed tasks of every user with an
+// auto-archive policy once they are older than the user's threshold. It is
+// run by the scheduled auto-archive job, outside of any request. In a dry run
+// matching tasks are only counted and logged. A failing user does not stop
+// the run; all failures are returned together with the partial report.
+func (s *Service) RunAutoArchive(ctx context.Context, dryRun bool) (*domain.AutoArchiveReport, error) {
+	ctx, span := tracer.Start(ctx, "RunAutoArchive", trace.WithAttributes(
+		attribute.Bool("dry_run", dryRun),
+	))
+	defer span.End()
+
+	policies, err := s.repo.ListAutoArchivePolicies(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list auto-archive policies", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	report := &domain.AutoArchiveReport{DryRun: dryRun}
+	var errs []error
+	now := time.Now()
+	for _, policy := range policies {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		// Scope the user's queries like a request would, so row-level
+		// security applies when it is enabled
+		userCtx := auth.WithUserID(ctx, policy.OwnerID)
+		cutoff := policy.Cutoff(now)
+
+		var count int64
+		if dryRun {
+			count, err = s.repo.CountArchivable(userCtx, policy.OwnerID, &cutoff)
+		} else {
+			count, err = s.repo.ArchiveCompleted(userCtx, policy.OwnerID, &cutoff, domain.Modifier{Source: domain.ChangeSourceSystem})
+		}
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to auto-archive tasks", "owner_id", policy.OwnerID, "error", err)
+			span.RecordError(err)
+			errs = append(errs, err)
+			continue
+		}
+
+		report.Users++
+		report.Tasks += count
+		if count > 0 && !dryRun {
+			s.publishTasksResync(ctx, policy.OwnerID)
+		}
+		if count > 0 {
+			s.logger.InfoContext(ctx, "auto-archived completed tasks",
+				"owner_id", policy.OwnerID, "after_days", policy.AfterDays, "count", count, "dry_run", dryRun)
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("users", report.Users),
+		attribute.Int64("tasks", report.Tasks),
+	)
+	s.logger.InfoContext(ctx, "auto-archive run finished",
+		"users", report.Users, "tasks", report.Tasks, "dry_run", dryRun, "failures", len(errs))
+	return report, errors.Join(errs...)
+}
@@ -0,0 +1,98 @@
+package application
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/slips-ai/slips-core/internal/memory"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+func TestRunAutoArchive(t *testing.T) {
+	store := memory.NewStore()
+	repo := memory.NewTaskRepository(store)
+	service := NewService(repo, memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	completeTask := func(owner string) *domain.Task {
+		t.Helper()
+		ctx := auth.WithUserID(context.Background(), owner)
+		task, err := service.CreateTask(ctx, "done", "", nil, nil, nil, "", nil, "")
+		if err != nil {
+			t.Fatalf("create task: %v", err)
+		}
+		if _, err := service.CompleteTask(ctx, task.ID); err != nil {
+			t.Fatalf("complete task: %v", err)
+		}
+		return task
+	}
+	enabled := completeTask("enabled")
+	optedOut := completeTask("opted-out")
+
+	// A zero-day threshold makes every completed task eligible immediately
+	days := 0
+	if _, err := repo.UpdateSettings(context.Background(), "enabled", domain.SettingsUpdate{AutoArchiveAfterDays: &days}); err != nil {
+		t.Fatalf("set auto-archive: %v", err)
+	}
+
+	report, err := service.RunAutoArchive(context.Background(), true)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if report.Users != 1 || report.Tasks != 1 || !report.DryRun {
+		t.Errorf("dry run report = %+v, want 1 user and 1 task", report)
+	}
+	if task, _ := repo.Get(context.Background(), enabled.ID, "enabled"); task.IsArchived() {
+		t.Error("dry run archived a task")
+	}
+
+	report, err = service.RunAutoArchive(context.Background(), false)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if report.Users != 1 || report.Tasks != 1 {
+		t.Errorf("report = %+v, want 1 user and 1 task", report)
+	}
+	if task, _ := repo.Get(context.Background(), enabled.ID, "enabled"); !task.IsArchived() {
+		t.Error("task of user with auto-archive enabled was not archived")
+	}
+	if task, _ := repo.Get(context.Background(), optedOut.ID, "opted-out"); task.IsArchived() {
+		t.Error("task of user without auto-archive was archived")
+	}
+}
+
+func TestUpdateTaskSettings_KeepsUnsetRolloverPreference(t *testing.T) {
+	store := memory.NewStore()
+	service := NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	toInbox := true
+	if _, _, err := service.UpdateTaskSettings(ctx, domain.SettingsUpdate{RolloverToInbox: &toInbox}, false); err != nil {
+		t.Fatalf("set rollover preference: %v", err)
+	}
+
+	days := 30
+	preview, _, err := service.UpdateTaskSettings(ctx, domain.SettingsUpdate{AutoArchiveAfterDays: &days}, true)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if !preview.RolloverToInbox || preview.AutoArchiveAfterDays == nil || *preview.AutoArchiveAfterDays != 30 {
+		t.Errorf("preview = %+v, want 30 days and the inbox preference kept", preview)
+	}
+
+	settings, _, err := service.UpdateTaskSettings(ctx, domain.SettingsUpdate{AutoArchiveAfterDays: &days}, false)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if !settings.RolloverToInbox {
+		t.Error("updating the auto-archive threshold reset the rollover preference")
+	}
+	if stored, _ := service.GetTaskSettings(ctx); !stored.RolloverToInbox || stored.AutoArchiveAfterDays == nil {
+		t.Errorf("stored settings = %+v", stored)
+	}
+}
@@ -0,0 +1,84 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/database"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TransferTasks hands the caller's tasks ids, with their tags and
+// checklists, to toUserID
+func (s *Service) TransferTasks(ctx context.Context, ids []uuid.UUID, toUserID string) (*domain.TransferResult, error) {
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		return nil, err
+	}
+	return s.TransferOwnership(ctx, userID, toUserID, ids, modifierFromContext(ctx))
+}
+
+// TransferOwnership hands the tasks ids of fromUserID to toUserID in one
+// transaction, attributed to by. The tasks keep their IDs, checklists, note
+// revisions and client request IDs, unless the recipient already used a
+// request ID; their tags are matched by name and created when missing. The
+// previous owner's clients see the tasks go. Both users must live on the
+// same shard. Callers check that the transfer is allowed.
+func (s *Service) TransferOwnership(ctx context.Context, fromUserID, toUserID string, ids []uuid.UUID, by domain.Modifier) (*domain.TransferResult, error) {
+	ctx, span := tracer.Start(ctx, "TransferOwnership", trace.WithAttributes(
+		attribute.String("from_user_id", fromUserID),
+		attribute.String("to_user_id", toUserID),
+		attribute.Int("tasks", len(ids)),
+	))
+	defer span.End()
+
+	if fromUserID == toUserID {
+		return nil, domain.ErrTransferToSelf
+	}
+	if s.users != nil {
+		exists, err := s.users.UserExists(database.WithSessionUser(ctx, toUserID), toUserID)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to check recipient", "to_user_id", toUserID, "error", err)
+			span.RecordError(err)
+			return nil, err
+		}
+		if !exists {
+			span.RecordError(domain.ErrRecipientNotFound)
+			return nil, domain.ErrRecipientNotFound
+		}
+	}
+
+	ids = uniqueIDs(ids)
+	// The transfer writes rows of both users, which the owner isolation
+	// policies would reject; its queries check the sender's ownership
+	createdTags, err := s.repo.Transfer(database.WithSessionUser(ctx, ""), fromUserID, toUserID, ids, by)
+	if err != nil {
+		if !errors.Is(err, domain.ErrTaskNotFound) && !errors.Is(err, domain.ErrTransferConflict) {
+			s.logger.ErrorContext(ctx, "failed to transfer tasks", "from_user_id", fromUserID, "to_user_id", toUserID, "error", err)
+		}
+		span.RecordError(err)
+		return nil, err
+	}
+	result := &domain.TransferResult{
+		FromUserID:  fromUserID,
+		ToUserID:    toUserID,
+		TaskIDs:     ids,
+		CreatedTags: len(createdTags),
+	}
+
+	s.publishTags(ctx, toUserID, createdTags)
+	for _, id := range ids {
+		s.publishTaskDeleted(ctx, fromUserID, id)
+		s.publishTask(ctx, toUserID, id)
+	}
+
+	s.logger.WarnContext(ctx, "audit", "event", "tasks.transferred",
+		"from_user_id", fromUserID, "to_user_id", toUserID, "source", by.Source, "client_id", by.ClientID,
+		"tasks", len(result.TaskIDs), "created_tags", result.CreatedTags)
+	return result, nil
+}
@@ -0,0 +1,98 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/internal/memory"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+func TestTransferTasks(t *testing.T) {
+	store := memory.NewStore()
+	users := memory.NewUserRepository(store)
+	tagRepo := memory.NewTagRepository(store)
+	service := NewService(memory.NewTaskRepository(store), tagRepo, memory.NewSavedFilterRepository(store), users, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ada := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "ada", Credential: auth.CredentialJWT, ClientID: "phone"})
+	team := auth.WithUserID(context.Background(), "team")
+
+	for _, ctx := range []context.Context{ada, team} {
+		userID, _ := auth.GetUserID(ctx)
+		if _, err := users.UpsertUser(ctx, &authdomain.User{UserID: userID}); err != nil {
+			t.Fatalf("upsert user: %v", err)
+		}
+	}
+	if _, err := service.CreateTask(team, "existing", "", []string{"work"}, nil, nil, "", nil, ""); err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	handed, err := service.CreateTask(ada, "handed", "draft", []string{"work", "launch"}, nil, nil, "", []string{"outline", "review"}, "req-1")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if _, err := service.UpdateTask(ada, handed.ID, "handed", "final", []string{"work", "launch"}, false, nil, false, nil, false, ""); err != nil {
+		t.Fatalf("update task: %v", err)
+	}
+	kept, err := service.CreateTask(ada, "kept", "", []string{"launch"}, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+
+	result, err := service.TransferTasks(ada, []uuid.UUID{handed.ID, handed.ID}, "team")
+	if err != nil {
+		t.Fatalf("TransferTasks() error = %v", err)
+	}
+	if !slices.Equal(result.TaskIDs, []uuid.UUID{handed.ID}) || result.CreatedTags != 1 {
+		t.Errorf("result = %+v, want the handed task and the launch tag created", result)
+	}
+
+	if _, err := service.GetTask(ada, handed.ID); !errors.Is(err, domain.ErrTaskNotFound) {
+		t.Errorf("original after transfer error = %v, want ErrTaskNotFound", err)
+	}
+	if _, err := service.GetTask(ada, kept.ID); err != nil {
+		t.Errorf("task left out of the transfer: %v", err)
+	}
+	got, err := service.GetTask(team, handed.ID)
+	if err != nil {
+		t.Fatalf("get transferred task: %v", err)
+	}
+	if got.OwnerID != "team" || got.Notes != "final" || got.ClientRequestID != "req-1" || len(got.Checklist) != 2 {
+		t.Errorf("transferred task = %+v", got)
+	}
+	if got.LastModifiedBy.ClientID != "phone" {
+		t.Errorf("transferred task modified by %+v, want ada's phone", got.LastModifiedBy)
+	}
+	revisions, err := service.ListNoteRevisions(team, handed.ID)
+	if err != nil || len(revisions) != 1 || revisions[0].Notes != "draft" {
+		t.Errorf("transferred note revisions = %+v, %v, want the draft", revisions, err)
+	}
+	work, err := tagRepo.GetByName(team, "work", "team")
+	if err != nil {
+		t.Fatalf("get tag: %v", err)
+	}
+	launch, err := tagRepo.GetByName(team, "launch", "team")
+	if err != nil {
+		t.Fatalf("get tag: %v", err)
+	}
+	if len(got.TagIDs) != 2 || !slices.Contains(got.TagIDs, work.ID) || !slices.Contains(got.TagIDs, launch.ID) {
+		t.Errorf("transferred tags = %v, want the recipient's work and launch", got.TagIDs)
+	}
+
+	if _, err := service.TransferTasks(ada, []uuid.UUID{handed.ID}, "team"); !errors.Is(err, domain.ErrTaskNotFound) {
+		t.Errorf("transferring a moved task error = %v, want ErrTaskNotFound", err)
+	}
+	if _, err := service.TransferTasks(ada, []uuid.UUID{kept.ID}, "ada"); !errors.Is(err, domain.ErrTransferToSelf) {
+		t.Errorf("transfer to self error = %v, want ErrTransferToSelf", err)
+	}
+	if _, err := service.TransferTasks(ada, []uuid.UUID{kept.ID}, "nobody"); !errors.Is(err, domain.ErrRecipientNotFound) {
+		t.Errorf("transfer to a missing user error = %v, want ErrRecipientNotFound", err)
+	}
+}
@@ -0,0 +1,61 @@
+package application
+
+import (
+	"context"
+
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListTriggerEvents returns a page of the caller's trigger events of kind,
+// newest first, for automation tools that poll. cursor is empty for the
+// first page, or the token returned with the previous page to continue with
+// older events. The returned token is empty after the last page. A limit
+// outside 1..MaxTriggerPageSize falls back to the default or the maximum.
+func (s *Service) ListTriggerEvents(ctx context.Context, kind domain.TriggerKind, cursor string, limit int) ([]domain.TriggerEvent, string, error) {
+	ctx, span := tracer.Start(ctx, "ListTriggerEvents", trace.WithAttributes(
+		attribute.Int("kind", int(kind)),
+		attribute.Int("limit", limit),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+
+	var after *domain.TriggerCursor
+	if cursor != "" {
+		after, err = domain.ParseTriggerCursor(cursor)
+		if err != nil {
+			span.RecordError(err)
+			return nil, "", err
+		}
+	}
+	switch {
+	case limit <= 0:
+		limit = domain.DefaultTriggerPageSize
+	case limit > domain.MaxTriggerPageSize:
+		limit = domain.MaxTriggerPageSize
+	}
+
+	// Fetch one extra event to learn whether another page follows
+	events, err := s.repo.ListTriggerEvents(ctx, userID, kind, after, limit+1)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list trigger events", "kind", kind, "error", err)
+		span.RecordError(err)
+		return nil, "", err
+	}
+
+	next := ""
+	if len(events) > limit {
+		events = events[:limit]
+		next = events[limit-1].Cursor().Encode()
+	}
+	return events, next, nil
+}
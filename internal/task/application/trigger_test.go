@@ -0,0 +1,95 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/slips-ai/slips-core/internal/memory"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+func TestListTriggerEvents(t *testing.T) {
+	store := memory.NewStore()
+	service := NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	var titles []string
+	var last *domain.Task
+	for _, title := range []string{"first", "second", "third"} {
+		task, err := service.CreateTask(ctx, title, "", []string{"home"}, nil, nil, "", nil, "")
+		if err != nil {
+			t.Fatalf("create task: %v", err)
+		}
+		titles = append(titles, title)
+		last = task
+	}
+
+	// New tasks page newest first until the cursor runs out
+	page, next, err := service.ListTriggerEvents(ctx, domain.TriggerTaskCreated, "", 2)
+	if err != nil {
+		t.Fatalf("list first page: %v", err)
+	}
+	if len(page) != 2 || page[0].Task.Title != "third" || page[1].Task.Title != "second" || next == "" {
+		t.Fatalf("first page = %d events starting %q, next %q; want third, second and a cursor", len(page), page[0].Task.Title, next)
+	}
+	page, next, err = service.ListTriggerEvents(ctx, domain.TriggerTaskCreated, next, 2)
+	if err != nil {
+		t.Fatalf("list second page: %v", err)
+	}
+	if len(page) != 1 || page[0].Task.Title != "first" || next != "" {
+		t.Fatalf("second page = %d events, next %q; want only first and no cursor", len(page), next)
+	}
+
+	// Completing again after reopening is a new event
+	if _, err := service.CompleteTask(ctx, last.ID); err != nil {
+		t.Fatalf("complete task: %v", err)
+	}
+	completed, _, err := service.ListTriggerEvents(ctx, domain.TriggerTaskCompleted, "", 0)
+	if err != nil || len(completed) != 1 {
+		t.Fatalf("list completed = %d events, %v; want 1", len(completed), err)
+	}
+	if _, err := service.ReopenTask(ctx, last.ID); err != nil {
+		t.Fatalf("reopen task: %v", err)
+	}
+	if _, err := service.CompleteTask(ctx, last.ID); err != nil {
+		t.Fatalf("complete task again: %v", err)
+	}
+	recompleted, _, err := service.ListTriggerEvents(ctx, domain.TriggerTaskCompleted, "", 0)
+	if err != nil || len(recompleted) != 1 {
+		t.Fatalf("list completed again = %d events, %v; want 1", len(recompleted), err)
+	}
+	if recompleted[0].DedupID() == completed[0].DedupID() {
+		t.Errorf("completing again kept dedup ID %q", completed[0].DedupID())
+	}
+
+	// Adding a tag is one event; tags already on the task keep theirs
+	before, _, err := service.ListTriggerEvents(ctx, domain.TriggerTagAdded, "", 0)
+	if err != nil || len(before) != len(titles) {
+		t.Fatalf("list tag added = %d events, %v; want %d", len(before), err, len(titles))
+	}
+	if _, err := service.PatchTask(ctx, last.ID, TaskPatch{SetTagNames: true, TagNames: []string{"home", "work"}}); err != nil {
+		t.Fatalf("patch task: %v", err)
+	}
+	after, _, err := service.ListTriggerEvents(ctx, domain.TriggerTagAdded, "", 0)
+	if err != nil || len(after) != len(titles)+1 {
+		t.Fatalf("list tag added = %d events, %v; want %d", len(after), err, len(titles)+1)
+	}
+	if after[0].TagName != "work" || after[0].TaskID != last.ID {
+		t.Errorf("newest tag added = %q on %s, want work on %s", after[0].TagName, after[0].TaskID, last.ID)
+	}
+	for i, event := range before {
+		if after[i+1].DedupID() != event.DedupID() {
+			t.Errorf("event %d dedup ID = %q, want unchanged %q", i, after[i+1].DedupID(), event.DedupID())
+		}
+	}
+
+	if _, _, err := service.ListTriggerEvents(ctx, domain.TriggerTaskCreated, "not-a-cursor", 0); !errors.Is(err, domain.ErrInvalidTriggerCursor) {
+		t.Errorf("bad cursor: err = %v, want ErrInvalidTriggerCursor", err)
+	}
+}
@@ -0,0 +1,156 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	tagdomain "github.com/slips-ai/slips-core/internal/tag/domain"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+// Change is a change event with the current state of an upserted resource.
+// Exactly one of Task, Tag and ChecklistItem is set for upserts; none is set
+// for deletes and resyncs.
+type Change struct {
+	changefeed.Event
+	Task          *domain.Task
+	Tag           *tagdomain.Tag
+	ChecklistItem *domain.ChecklistItem
+}
+
+// WatchChanges passes the changes to the caller's tasks, tags and checklist
+// items to send until ctx is done, send fails or the feed ends the watch.
+// ready is called once the subscription is active, so the caller can sync
+// without missing changes made in between. Resources deleted before their
+// upsert is sent are skipped; their delete follows.
+func (s *Service) WatchChanges(ctx context.Context, ready func() error, send func(Change) error) error {
+	ctx, span := tracer.Start(ctx, "WatchChanges")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	sub := s.events.Subscribe(userID)
+	defer sub.Close()
+	if err := ready(); err != nil {
+		return err
+	}
+
+	for {
+		var event changefeed.Event
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-sub.Events():
+			if !ok {
+				s.logger.InfoContext(ctx, "change watch ended by feed", "owner_id", userID, "reason", sub.Err())
+				return sub.Err()
+			}
+			event = e
+		}
+
+		change, err := s.loadChange(ctx, event)
+		if errors.Is(err, domainerrors.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to load changed resource", "resource", event.Resource, "id", event.ID, "error", err)
+			span.RecordError(err)
+			return err
+		}
+		if err := send(change); err != nil {
+			return err
+		}
+	}
+}
+
+// loadChange attaches the current state of the resource of an upsert event
+func (s *Service) loadChange(ctx context.Context, event changefeed.Event) (Change, error) {
+	change := Change{Event: event}
+	if event.Operation != changefeed.OperationUpsert {
+		return change, nil
+	}
+
+	var err error
+	switch event.Resource {
+	case changefeed.ResourceTask:
+		change.Task, err = s.repo.Get(ctx, event.ID, event.OwnerID)
+	case changefeed.ResourceTag:
+		change.Tag, err = s.tagRepo.Get(ctx, event.ID, event.OwnerID)
+	case changefeed.ResourceChecklistItem:
+		var items []domain.ChecklistItem
+		items, err = s.repo.ListChecklistItems(ctx, event.TaskID, event.OwnerID)
+		for i := range items {
+			if items[i].ID == event.ID {
+				change.ChecklistItem = &items[i]
+			}
+		}
+		if err == nil && change.ChecklistItem == nil {
+			err = domain.ErrChecklistItemNotFound
+		}
+	}
+	return change, err
+}
+
+// publishTask reports a created or changed task to watchers
+func (s *Service) publishTask(ctx context.Context, ownerID string, id uuid.UUID) {
+	s.events.Publish(ctx, changefeed.Event{
+		OwnerID:   ownerID,
+		Resource:  changefeed.ResourceTask,
+		Operation: changefeed.OperationUpsert,
+		ID:        id,
+	})
+}
+
+// publishTaskDeleted reports a deleted task to watchers
+func (s *Service) publishTaskDeleted(ctx context.Context, ownerID string, id uuid.UUID) {
+	s.events.Publish(ctx, changefeed.Event{
+		OwnerID:   ownerID,
+		Resource:  changefeed.ResourceTask,
+		Operation: changefeed.OperationDelete,
+		ID:        id,
+	})
+}
+
+// publishTasksResync tells watchers to reload the owner's tasks after a bulk
+// change that is not reported per task
+func (s *Service) publishTasksResync(ctx context.Context, ownerID string) {
+	s.events.Publish(ctx, changefeed.Event{
+		OwnerID:   ownerID,
+		Resource:  changefeed.ResourceTask,
+		Operation: changefeed.OperationResync,
+	})
+}
+
+// publishTags reports tags that may have been created while resolving tag
+// names to watchers
+func (s *Service) publishTags(ctx context.Context, ownerID string, ids []uuid.UUID) {
+	for _, id := range ids {
+		s.events.Publish(ctx, changefeed.Event{
+			OwnerID:   ownerID,
+			Resource:  changefeed.ResourceTag,
+			Operation: changefeed.OperationUpsert,
+			ID:        id,
+		})
+	}
+}
+
+// publishChecklistItem reports a created or changed checklist item to watchers
+func (s *Service) publishChecklistItem(ctx context.Context, ownerID string, item *domain.ChecklistItem) {
+	s.events.Publish(ctx, changefeed.Event{
+		OwnerID:   ownerID,
+		Resource:  changefeed.ResourceChecklistItem,
+		Operation: changefeed.OperationUpsert,
+		ID:        item.ID,
+		TaskID:    item.TaskID,
+	})
+}
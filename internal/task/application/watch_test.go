@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/slips-ai/slips-core/internal/memory"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+)
+
+func TestWatchChanges(t *testing.T) {
+	store := memory.NewStore()
+	hub := changefeed.NewHub()
+	service := NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, hub, domain.DefaultChecklistLimits,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	ready := make(chan struct{})
+	changes := make(chan Change, changefeed.SubscriptionBuffer)
+	done := make(chan error, 1)
+	go func() {
+		done <- service.WatchChanges(ctx, func() error {
+			close(ready)
+			return nil
+		}, func(change Change) error {
+			changes <- change
+			return nil
+		})
+	}()
+	<-ready
+
+	task, err := service.CreateTask(ctx, "task", "", []string{"home"}, nil, nil, "", nil, "")
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if change := <-changes; change.Tag == nil || change.Tag.Name != "home" {
+		t.Errorf("first change = %+v, want the created tag", change)
+	}
+	if change := <-changes; change.Task == nil || change.Task.ID != task.ID {
+		t.Errorf("second change = %+v, want the created task", change)
+	}
+
+	if err := service.DeleteTask(ctx, task.ID); err != nil {
+		t.Fatalf("delete task: %v", err)
+	}
+	if change := <-changes; change.Operation != changefeed.OperationDelete || change.ID != task.ID {
+		t.Errorf("third change = %+v, want the task delete", change)
+	}
+
+	hub.Close()
+	if err := <-done; !errors.Is(err, changefeed.ErrClosed) {
+		t.Errorf("WatchChanges() = %v, want ErrClosed", err)
+	}
+}
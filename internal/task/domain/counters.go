@@ -0,0 +1,15 @@
+package domain
+
+// Counters are the task counts shown on app icon badges and sidebars. Only
+// open tasks are counted.
+type Counters struct {
+	// Inbox counts tasks without a start date
+	Inbox int64
+	// Today counts tasks starting on or before today or due today, and
+	// Overdue those due before today, the same way the digest lists them
+	Today   int64
+	Overdue int64
+	// Unread counts tasks an agent or the system added or changed since the
+	// owner last viewed them
+	Unread int64
+}
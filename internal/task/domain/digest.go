@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// MaxDigestSectionSize caps the number of tasks returned per digest section
+const MaxDigestSectionSize = 50
+
+// DigestOptions defines the reference points used to build an email digest
+type DigestOptions struct {
+	// Today is the recipient's local date. Open tasks starting on or before
+	// it or due on it are listed for today; those with an earlier deadline
+	// are overdue instead.
+	Today time.Time
+	// CompletedFrom and CompletedTo bound the completions listed; the range
+	// is half-open.
+	CompletedFrom time.Time
+	CompletedTo   time.Time
+}
+
+// Digest bundles the task sections of an email digest
+type Digest struct {
+	Today     []*Task
+	Overdue   []*Task
+	Completed []*Task
+}
+
+// Empty reports whether the digest has no tasks to report
+func (d *Digest) Empty() bool {
+	return len(d.Today) == 0 && len(d.Overdue) == 0 && len(d.Completed) == 0
+}
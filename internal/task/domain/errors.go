@@ -1,7 +1,26 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
 
 var (
-	ErrInvalidChecklistOrder = errors.New("invalid checklist item order")
+	ErrInvalidChecklistOrder  = errors.New("invalid checklist item order")
+	ErrInvalidTriggerCursor   = errors.New("invalid trigger cursor")
+	ErrInvalidTombstoneCursor = errors.New("invalid deleted tasks page token")
+	ErrInvalidContext         = errors.New("context must be a single word of at most 64 characters")
+	ErrTooManyChecklistItems  = errors.New("too many checklist items")
+	ErrChecklistItemTooLong   = errors.New("checklist item is too long")
+	ErrInvalidGeofence        = errors.New("geofence needs valid coordinates, a radius of 50 to 50000 meters and arrive or leave set")
+	// ErrTaskNotFound is returned for tasks that do not exist or belong to
+	// another user
+	ErrTaskNotFound = domainerrors.New(domainerrors.ErrNotFound, "task not found")
+	// ErrChecklistItemNotFound is returned for checklist items that do not
+	// exist or whose task belongs to another user
+	ErrChecklistItemNotFound = domainerrors.New(domainerrors.ErrNotFound, "checklist item not found")
+	// ErrNoteRevisionNotFound is returned for note revisions that do not
+	// exist or belong to another task
+	ErrNoteRevisionNotFound = domainerrors.New(domainerrors.ErrNotFound, "note revision not found")
 )
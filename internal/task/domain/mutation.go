@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxMutationBatchSize is the maximum number of mutations applied in one batch
+const MaxMutationBatchSize = 100
+
+// MutationKind selects the operation of a Mutation
+type MutationKind int
+
+const (
+	// MutationCreate creates a task with a client-generated ID
+	MutationCreate MutationKind = iota + 1
+	// MutationUpdate changes the fields selected by Mutation.Changes
+	MutationUpdate
+	// MutationDelete deletes a task
+	MutationDelete
+)
+
+// Mutation is one operation of an offline client's batch
+type Mutation struct {
+	// ClientMutationID is chosen by the client to match results to operations
+	ClientMutationID string
+	Kind             MutationKind
+	// TaskID is the target task; creates use it as the new task's ID
+	TaskID uuid.UUID
+	// BaseUpdatedAt is the UpdatedAt of the task version the client edited.
+	// Updates and deletes conflict when the task changed since; nil skips
+	// the check and the mutation wins.
+	BaseUpdatedAt *time.Time
+	// Task is the task to create, with TaskID as its ID
+	Task *Task
+	// Changes lists the fields changed by an update
+	Changes TaskChanges
+}
+
+// IsStale reports whether a task last updated at updatedAt has changed since
+// the version the mutation was based on
+func (m Mutation) IsStale(updatedAt time.Time) bool {
+	return m.BaseUpdatedAt != nil && !m.BaseUpdatedAt.Equal(updatedAt)
+}
+
+// TaskChanges lists the task fields changed by an update mutation. Title and
+// Notes are left unchanged when nil, the other fields when their Set flag is
+// false.
+type TaskChanges struct {
+	Title        *string
+	Notes        *string
+	SetTagIDs    bool
+	TagIDs       []uuid.UUID
+	SetStartDate bool
+	StartDate    *time.Time // nil moves the task to the inbox
+	SetDeadline  bool
+	Deadline     *time.Time // nil clears the deadline
+	SetContext   bool
+	Context      string // empty clears the context
+}
+
+// Apply changes the fields of t selected by c
+func (c TaskChanges) Apply(t *Task) {
+	title, notes, tagIDs := t.Title, t.Notes, t.TagIDs
+	if c.Title != nil {
+		title = *c.Title
+	}
+	if c.Notes != nil {
+		notes = *c.Notes
+	}
+	if c.SetTagIDs {
+		tagIDs = c.TagIDs
+	}
+	t.Update(title, notes, tagIDs)
+
+	if c.SetStartDate {
+		t.SetStartDate(c.StartDate)
+	}
+	if c.SetDeadline {
+		t.SetDeadline(c.Deadline)
+	}
+	if c.SetContext {
+		t.SetContext(c.Context)
+	}
+}
+
+// MutationConflict explains why a mutation was not applied
+type MutationConflict int
+
+const (
+	// ConflictNone means the mutation was applied
+	ConflictNone MutationConflict = iota
+	// ConflictAlreadyExists means a create used the ID of an existing task
+	// of the owner
+	ConflictAlreadyExists
+	// ConflictNotFound means the task does not exist, e.g. it was deleted,
+	// or a create used the ID of another owner's task
+	ConflictNotFound
+	// ConflictChanged means the task was modified after BaseUpdatedAt
+	ConflictChanged
+	// ConflictPendingApproval means a delete waits for the user to approve
+	// it, since it was made with an MCP token
+	ConflictPendingApproval
+)
+
+// MutationResult is the outcome of one Mutation
+type MutationResult struct {
+	ClientMutationID string
+	TaskID           uuid.UUID
+	Conflict         MutationConflict
+	// Task is the task after an applied create or update, or the current
+	// version of a conflicting one so the client can merge. It is nil after
+	// a delete and when the task does not exist.
+	Task *Task
+}
+
+// Applied reports whether the mutation was applied
+func (r MutationResult) Applied() bool {
+	return r.Conflict == ConflictNone
+}
@@ -2,29 +2,246 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// DeadlineApproachingDays is the window, in days from today, within which a
+// deadline is considered approaching. Overdue deadlines are always included.
+const DeadlineApproachingDays = 3
+
+// GroupBy selects how listed tasks are grouped for section headers
+type GroupBy int
+
+const (
+	// GroupByNone disables grouping
+	GroupByNone GroupBy = iota
+	// GroupByStartDate groups tasks by start date; inbox tasks share an empty key
+	GroupByStartDate
+	// GroupByDeadline groups tasks by deadline; tasks without one share an empty key
+	GroupByDeadline
+	// GroupByTag groups tasks by tag ID; a task with several tags is in
+	// each of their groups, and untagged tasks share an empty key
+	GroupByTag
+)
+
+// ListOrder selects the order of listed tasks
+type ListOrder int
+
+const (
+	// ListOrderDefault lists pinned tasks first, then the newest
+	ListOrderDefault ListOrder = iota
+	// ListOrderArchivedDesc lists the most recently archived tasks first;
+	// tasks that are not archived come last
+	ListOrderArchivedDesc
+	// ListOrderArchivedAsc lists the earliest archived tasks first; tasks
+	// that are not archived come last
+	ListOrderArchivedAsc
+)
+
+// TaskGroup describes a group of tasks sharing the same key.
+// Count covers the full result set, not just the current page.
+type TaskGroup struct {
+	Key   string // "YYYY-MM-DD" or a tag ID, or empty for tasks without one
+	Count int
+}
+
+// ListResult is a page of tasks with metadata about the full result set
+type ListResult struct {
+	Tasks []*Task
+	// TotalSize is the number of tasks matching the filters across all pages.
+	TotalSize int
+	// Groups lists the groups represented on this page, in page order.
+	Groups []TaskGroup
+	// DeletedTasks lists tasks deleted after ListOptions.UpdatedAfter,
+	// oldest first and at most a page of them. It is only populated when
+	// UpdatedAfter is set.
+	DeletedTasks []Tombstone
+	// MoreDeletedTasks is set when more deletions follow the last of
+	// DeletedTasks; list again from its cursor to get them.
+	MoreDeletedTasks bool
+}
+
+// MaxBatchGetSize is the maximum number of task IDs accepted by a batch lookup
+const MaxBatchGetSize = 100
+
+// MaxBulkTagSize is the maximum number of task IDs accepted when adding a
+// tag to or removing it from many tasks at once
+const MaxBulkTagSize = 100
+
+// MaxTransferSize is the maximum number of task IDs a user can transfer at
+// once; operators can transfer all of a user's tasks
+const MaxTransferSize = 100
+
 // ListOptions defines options for listing tasks
 type ListOptions struct {
 	IncludeArchived bool
 	ArchivedOnly    bool
+	// TagMatchAll requires a task to carry every filter tag instead of any of them.
+	TagMatchAll bool
+	// ExcludeTagIDs drops tasks carrying any of these tags.
+	ExcludeTagIDs []uuid.UUID
+	// UntaggedOnly restricts results to tasks without tags.
+	UntaggedOnly bool
+	// DeadlineBefore restricts results to tasks with a deadline on or before this date.
+	DeadlineBefore *time.Time
+	// StartDateFrom and StartDateTo restrict results to tasks whose start date
+	// falls within the inclusive range. Either bound may be nil.
+	StartDateFrom *time.Time
+	StartDateTo   *time.Time
+	// InboxOnly restricts results to tasks without a start date.
+	InboxOnly bool
+	// Query restricts results to tasks whose title or notes contain the text.
+	Query string
+	// Contexts restricts results to tasks in any of these normalized
+	// contexts.
+	Contexts []string
+	// UpdatedAfter restricts results to tasks modified after this instant.
+	UpdatedAfter *time.Time
+	// ArchivedAfter and ArchivedBefore restrict results to tasks archived
+	// at or after ArchivedAfter and before ArchivedBefore. Either bound may
+	// be nil; setting one leaves out tasks that are not archived.
+	ArchivedAfter  *time.Time
+	ArchivedBefore *time.Time
+	// Order selects the order of the results.
+	Order ListOrder
+	// GroupBy selects the grouping reported in ListResult.Groups.
+	GroupBy GroupBy
+	// DeletedTasksAfter resumes ListResult.DeletedTasks after a tombstone
+	// returned with a previous page.
+	DeletedTasksAfter *TombstoneCursor
+	// Load leaves out parts of the listed tasks.
+	Load LoadOptions
+}
+
+// LoadOptions leaves out parts of a task that a caller does not show, such
+// as a watch app listing titles, saving the queries that load them
+type LoadOptions struct {
+	// SkipNotes leaves Notes empty
+	SkipNotes bool
+	// SkipTags leaves TagIDs empty
+	SkipTags bool
+	// SkipChecklist leaves the checklist and its counts empty
+	SkipChecklist bool
+}
+
+// ScanOptions selects the tasks visited by Repository.ListAfter
+type ScanOptions struct {
+	IncludeArchived bool
+	ArchivedOnly    bool
 }
 
 // Repository defines the interface for task persistence
 type Repository interface {
+	// Create stores a new task, attributed to task.LastModifiedBy. When the
+	// owner already created a task with the same ClientRequestID, nothing is
+	// stored and task is replaced by the existing one.
 	Create(ctx context.Context, task *Task) error
 	Get(ctx context.Context, id uuid.UUID, ownerID string) (*Task, error)
+	// GetPartial is Get without the parts of the task load leaves out.
+	GetPartial(ctx context.Context, id uuid.UUID, ownerID string, load LoadOptions) (*Task, error)
+	GetMany(ctx context.Context, ids []uuid.UUID, ownerID string) ([]*Task, error)
+	// Update saves the task, attributed to task.LastModifiedBy. When its
+	// notes change, the previous notes are kept as a NoteRevision, up to
+	// MaxNoteRevisions per task.
 	Update(ctx context.Context, task *Task) error
 	Delete(ctx context.Context, id uuid.UUID, ownerID string) error
-	List(ctx context.Context, ownerID string, filterTagIDs []uuid.UUID, limit, offset int, opts ListOptions) ([]*Task, error)
-	Archive(ctx context.Context, id uuid.UUID, ownerID string) (*Task, error)
-	Unarchive(ctx context.Context, id uuid.UUID, ownerID string) (*Task, error)
+	// ListTombstones lists up to limit tombstones of tasks deleted after
+	// deletedAfter, oldest first, starting after the after cursor when set.
+	ListTombstones(ctx context.Context, ownerID string, deletedAfter time.Time, after *TombstoneCursor, limit int) ([]Tombstone, error)
+	// PurgeTombstones deletes the tombstones of every owner recorded before
+	// deletedBefore and returns how many were deleted.
+	PurgeTombstones(ctx context.Context, deletedBefore time.Time) (int64, error)
+	// Restore writes a backup into its owner in one transaction. The tags
+	// are matched to the owner's by name, creating the missing ones, and
+	// the tasks are inserted as they are, with their IDs, timestamps,
+	// state, checklists, geofences and note revisions. Callers give the
+	// tasks fresh IDs.
+	Restore(ctx context.Context, restore *Restore) (*RestoredTags, error)
+	// Transfer gives the tasks ids of fromOwnerID, with their checklists and
+	// note revisions, to toOwnerID in one transaction. The tasks keep their
+	// IDs and are attributed to by; their tags are matched to the
+	// recipient's by name, and the missing ones are created in the same
+	// transaction. It returns the IDs of the created tags. The previous
+	// owner gets tombstones for the tasks. It returns ErrTaskNotFound when
+	// one of the tasks is missing and ErrTransferConflict when their tags
+	// change meanwhile. ids must not repeat.
+	Transfer(ctx context.Context, fromOwnerID, toOwnerID string, ids []uuid.UUID, by Modifier) ([]uuid.UUID, error)
+	// ApplyMutations applies an offline batch in order within one
+	// transaction. Conflicting mutations are skipped and reported in their
+	// result; any other error rolls back the whole batch. Applied creates
+	// and updates are attributed to by. With validateOnly set the results
+	// are those of applying the batch, but nothing is kept.
+	ApplyMutations(ctx context.Context, ownerID string, mutations []Mutation, by Modifier, validateOnly bool) ([]MutationResult, error)
+	List(ctx context.Context, ownerID string, filterTagIDs []uuid.UUID, limit, offset int, opts ListOptions) (*ListResult, error)
+	// ListAfter returns up to limit full tasks (with checklists) whose ID
+	// sorts after after, in ID order. Passing the last returned ID walks all
+	// of an owner's tasks without the cost and drift of offset paging.
+	ListAfter(ctx context.Context, ownerID string, after uuid.UUID, limit int, opts ScanOptions) ([]*Task, error)
+	// ListTriggerEvents returns up to limit events of kind, newest first,
+	// starting after after when it is not nil. Each event carries its task
+	// with tags and checklist.
+	ListTriggerEvents(ctx context.Context, ownerID string, kind TriggerKind, after *TriggerCursor, limit int) ([]TriggerEvent, error)
+	// Archive, Unarchive, TogglePin, Complete and Reopen change the task's
+	// state and attribute the change to by.
+	Archive(ctx context.Context, id uuid.UUID, ownerID string, by Modifier) (*Task, error)
+	Unarchive(ctx context.Context, id uuid.UUID, ownerID string, by Modifier) (*Task, error)
+	TogglePin(ctx context.Context, id uuid.UUID, ownerID string, by Modifier) (*Task, error)
+	Complete(ctx context.Context, id uuid.UUID, ownerID string, by Modifier) (*Task, error)
+	Reopen(ctx context.Context, id uuid.UUID, ownerID string, by Modifier) (*Task, error)
+	ArchiveCompleted(ctx context.Context, ownerID string, completedBefore *time.Time, by Modifier) (int64, error)
+	// CountArchivable counts the tasks ArchiveCompleted would archive.
+	CountArchivable(ctx context.Context, ownerID string, completedBefore *time.Time) (int64, error)
+	// ArchiveByTag and UnarchiveByTag archive or restore every task of the
+	// owner carrying tagID in a single statement, attributing the change to
+	// by. They return the number of tasks that changed.
+	ArchiveByTag(ctx context.Context, ownerID string, tagID uuid.UUID, by Modifier) (int64, error)
+	UnarchiveByTag(ctx context.Context, ownerID string, tagID uuid.UUID, by Modifier) (int64, error)
+	// RolloverTasks moves the owner's open tasks that started before today
+	// to today, or to the inbox when toInbox is set, and returns them.
+	RolloverTasks(ctx context.Context, ownerID string, today time.Time, toInbox bool, by Modifier) ([]*Task, error)
+	// AddTag and RemoveTag add tagID to or remove it from the owner's
+	// tasks in taskIDs in a single statement, attributing the change to by.
+	// They return the IDs of the tasks that changed; tasks that already had
+	// or lacked the tag are left alone.
+	AddTag(ctx context.Context, ownerID string, tagID uuid.UUID, taskIDs []uuid.UUID, by Modifier) ([]uuid.UUID, error)
+	RemoveTag(ctx context.Context, ownerID string, tagID uuid.UUID, taskIDs []uuid.UUID, by Modifier) ([]uuid.UUID, error)
+	// SetGeofence sets the geofence of a task, or removes it when geofence is
+	// nil, and returns the updated task.
+	SetGeofence(ctx context.Context, id uuid.UUID, ownerID string, geofence *Geofence, by Modifier) (*Task, error)
+	// MarkViewed records that the owner opened a task, without changing it.
+	MarkViewed(ctx context.Context, id uuid.UUID, ownerID string) error
+	// ListStale returns up to limit open tasks neither updated nor viewed
+	// since inactiveBefore, longest inactive first.
+	ListStale(ctx context.Context, ownerID string, inactiveBefore time.Time, limit int) ([]*Task, error)
+	GetStats(ctx context.Context, ownerID string, since time.Time, bucket StatsBucket) (*Stats, error)
+	// GetTagActivity counts the owner's open and completed tasks carrying
+	// tagID and returns up to recentLimit of them, most recently updated
+	// first.
+	GetTagActivity(ctx context.Context, ownerID string, tagID uuid.UUID, recentLimit int) (*TagActivity, error)
+	GetWeeklyReview(ctx context.Context, ownerID string, opts ReviewOptions) (*WeeklyReview, error)
+	GetDigest(ctx context.Context, ownerID string, opts DigestOptions) (*Digest, error)
+	// GetCounters counts the owner's open tasks for badges in a single
+	// aggregate query; today is the owner's local date.
+	GetCounters(ctx context.Context, ownerID string, today time.Time) (*Counters, error)
 	ListChecklistItems(ctx context.Context, taskID uuid.UUID, ownerID string) ([]ChecklistItem, error)
 	AddChecklistItem(ctx context.Context, taskID uuid.UUID, ownerID, content string) (*ChecklistItem, error)
 	UpdateChecklistItemContent(ctx context.Context, itemID uuid.UUID, ownerID, content string) (*ChecklistItem, error)
 	SetChecklistItemCompleted(ctx context.Context, itemID uuid.UUID, ownerID string, completed bool) (*ChecklistItem, error)
 	DeleteChecklistItem(ctx context.Context, itemID uuid.UUID, ownerID string) error
 	ReorderChecklistItems(ctx context.Context, taskID uuid.UUID, ownerID string, itemIDs []uuid.UUID) error
+	// ListNoteRevisions returns the note revisions of a task, newest first.
+	ListNoteRevisions(ctx context.Context, taskID uuid.UUID, ownerID string) ([]NoteRevision, error)
+	// ListNoteRevisionsForTasks returns the note revisions of the owner's
+	// tasks among taskIDs, grouped by task and newest first within a task.
+	ListNoteRevisionsForTasks(ctx context.Context, taskIDs []uuid.UUID, ownerID string) ([]NoteRevision, error)
+	GetNoteRevision(ctx context.Context, id, taskID uuid.UUID, ownerID string) (*NoteRevision, error)
+	// GetSettings returns the owner's task settings, or the defaults if never set.
+	GetSettings(ctx context.Context, ownerID string) (*Settings, error)
+	// UpdateSettings applies update to the owner's task settings in one
+	// write and returns the stored settings.
+	UpdateSettings(ctx context.Context, ownerID string, update SettingsUpdate) (*Settings, error)
+	// ListAutoArchivePolicies returns every owner with auto-archiving enabled.
+	ListAutoArchivePolicies(ctx context.Context) ([]AutoArchivePolicy, error)
 }
@@ -0,0 +1,25 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Restore is a backup of one owner's tasks, written by Repository.Restore
+type Restore struct {
+	OwnerID string
+	// Tags maps the tag IDs of the backup to their normalized names. The
+	// owner's tags with these names are reused and the missing ones created.
+	Tags map[uuid.UUID]string
+	// Tasks carry fresh task and checklist item IDs and the backup's tag IDs
+	Tasks []*Task
+	// NoteRevisions belong to Tasks by their fresh IDs
+	NoteRevisions []NoteRevision
+	// Settings replace the owner's task settings; nil keeps them
+	Settings *Settings
+}
+
+// RestoredTags describes how the tags of a Restore were matched
+type RestoredTags struct {
+	// IDs maps the tag IDs of the backup to the owner's tags
+	IDs map[uuid.UUID]uuid.UUID
+	// Created counts the tags the owner did not have yet
+	Created int
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// MaxReviewSectionSize caps the number of tasks returned per weekly review section
+const MaxReviewSectionSize = 50
+
+// ReviewOptions defines the reference points used to build a weekly review
+type ReviewOptions struct {
+	// StaleBefore marks open tasks not updated since this instant as stale.
+	StaleBefore time.Time
+	// WeekStart is the beginning of the current review week.
+	WeekStart time.Time
+	// Today is the current date; open tasks with an earlier deadline are overdue.
+	Today time.Time
+}
+
+// WeeklyReview bundles the task sections of a guided weekly review
+type WeeklyReview struct {
+	WeekStart         time.Time
+	StaleTasks        []*Task
+	UndatedTasks      []*Task
+	CompletedThisWeek []*Task
+	OverdueTasks      []*Task
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxNoteRevisions is the number of note revisions kept per task; older ones
+// are dropped when a new one is recorded
+const MaxNoteRevisions = 20
+
+// NoteRevision is a snapshot of a task's notes taken just before an update
+// replaced them
+type NoteRevision struct {
+	ID        uuid.UUID
+	TaskID    uuid.UUID
+	Notes     string
+	CreatedAt time.Time
+}
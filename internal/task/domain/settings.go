@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+// MaxAutoArchiveAfterDays bounds the auto-archive threshold to about ten years
+const MaxAutoArchiveAfterDays = 3650
+
+// Settings holds a user's task preferences. The zero value is the default
+// for users who never changed them.
+type Settings struct {
+	// AutoArchiveAfterDays archives completed tasks this many days after
+	// completion. Nil disables auto-archiving.
+	AutoArchiveAfterDays *int
+	// RolloverToInbox sends tasks rolled over by RolloverOverdueTasks to
+	// the inbox instead of today.
+	RolloverToInbox bool
+}
+
+// SettingsUpdate changes a user's task settings. AutoArchiveAfterDays
+// always replaces the threshold, nil disabling it; a nil RolloverToInbox
+// keeps the stored preference.
+type SettingsUpdate struct {
+	AutoArchiveAfterDays *int
+	RolloverToInbox      *bool
+}
+
+// Apply returns current with the update applied
+func (u SettingsUpdate) Apply(current Settings) *Settings {
+	current.AutoArchiveAfterDays = u.AutoArchiveAfterDays
+	if u.RolloverToInbox != nil {
+		current.RolloverToInbox = *u.RolloverToInbox
+	}
+	return &current
+}
+
+// AutoArchivePolicy is the auto-archive threshold of one user
+type AutoArchivePolicy struct {
+	OwnerID   string
+	AfterDays int
+}
+
+// Cutoff returns the completion instant at or before which tasks are archived
+func (p AutoArchivePolicy) Cutoff(now time.Time) time.Time {
+	return now.AddDate(0, 0, -p.AfterDays)
+}
+
+// AutoArchiveReport summarizes one run of the auto-archive job
+type AutoArchiveReport struct {
+	// DryRun is set when tasks were only counted, not archived.
+	DryRun bool
+	// Users is the number of users with a policy that were processed.
+	Users int
+	// Tasks is the number of tasks archived, or that would have been in a dry run.
+	Tasks int64
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatsBucket selects the time granularity of activity statistics
+type StatsBucket int
+
+const (
+	// StatsBucketDay groups activity by UTC calendar day
+	StatsBucketDay StatsBucket = iota
+	// StatsBucketWeek groups activity by ISO week (starting Monday, UTC)
+	StatsBucketWeek
+)
+
+// String returns the PostgreSQL date_trunc field name for the bucket
+func (b StatsBucket) String() string {
+	if b == StatsBucketWeek {
+		return "week"
+	}
+	return "day"
+}
+
+// ActivityCount holds task activity within one time bucket
+type ActivityCount struct {
+	BucketStart time.Time
+	Created     int
+	Completed   int
+	Archived    int
+}
+
+// TagStats holds task counts for a single tag
+type TagStats struct {
+	TagID          uuid.UUID
+	TagName        string
+	OpenCount      int
+	CompletedCount int
+}
+
+// TagActivity summarizes the tasks carrying a tag
+type TagActivity struct {
+	OpenCount      int
+	CompletedCount int
+	// RecentTasks are the most recently updated tasks carrying the tag,
+	// most recent first.
+	RecentTasks []*Task
+}
+
+// Stats summarizes a user's task activity
+type Stats struct {
+	// Activity lists buckets with at least one event, oldest first.
+	Activity []ActivityCount
+	Tags     []TagStats
+	// BacklogSize is the number of open (not completed, not archived) tasks.
+	BacklogSize int
+}
@@ -1,23 +1,136 @@
 package domain
 
 import (
+	"fmt"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
 
 // Task represents a task entity
 type Task struct {
-	ID         uuid.UUID
-	Title      string
-	Notes      string
-	TagIDs     []uuid.UUID
-	Checklist  []ChecklistItem
-	OwnerID    string
-	ArchivedAt *time.Time
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	StartDate  *time.Time
+	ID          uuid.UUID
+	Title       string
+	Notes       string
+	TagIDs      []uuid.UUID
+	Checklist   []ChecklistItem
+	OwnerID     string
+	ArchivedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	StartDate   *time.Time
+	Deadline    *time.Time
+	Pinned      bool
+	CompletedAt *time.Time
+	// Context is the GTD context of the task, such as "@home" or
+	// "@low-energy", in the form returned by NormalizeContext. It is empty
+	// when the task has none.
+	Context string
+	// ClientRequestID is the ID an offline client gave the task when creating
+	// it, unique per owner. It is empty when none was given.
+	ClientRequestID string
+	// LastModifiedBy is the caller that last created or changed the task. It
+	// is zero for tasks not changed since this was first recorded.
+	LastModifiedBy Modifier
+	// CreatedBy is the caller that created the task. It is zero for tasks
+	// created before this was recorded.
+	CreatedBy Modifier
+	// LastViewedAt is when the owner last opened the task. It is nil when
+	// the task was never viewed.
+	LastViewedAt *time.Time
+	// Geofence is the place the owner wants to be reminded of the task at.
+	// It is nil when the task has none.
+	Geofence *Geofence
+	// ChecklistTotal and ChecklistCompleted summarize the checklist when the
+	// items themselves are not loaded, e.g. in list results.
+	ChecklistTotal     int
+	ChecklistCompleted int
+}
+
+// MaxContextLength is the maximum length of a task context, including its
+// leading "@"
+const MaxContextLength = 64
+
+// NormalizeContext returns the canonical form of a GTD context: trimmed,
+// lower case and prefixed with "@", so "Home" and "@home" name the same
+// context. An empty context stays empty. Contexts are single words;
+// ErrInvalidContext is returned for ones containing whitespace or longer
+// than MaxContextLength.
+func NormalizeContext(context string) (string, error) {
+	context = strings.TrimSpace(context)
+	if context == "" {
+		return "", nil
+	}
+	context = "@" + strings.ToLower(strings.TrimPrefix(context, "@"))
+	if context == "@" || len(context) > MaxContextLength || strings.IndexFunc(context, unicode.IsSpace) >= 0 {
+		return "", ErrInvalidContext
+	}
+	return context, nil
+}
+
+// Geofence is a circle around a place. Mobile clients register it with the
+// device's location services to remind the owner of the task on arriving at
+// or leaving the place; the server only stores and syncs it.
+type Geofence struct {
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters int
+	// OnArrive and OnLeave select when the reminder fires; at least one is
+	// set
+	OnArrive bool
+	OnLeave  bool
+}
+
+const (
+	// MinGeofenceRadius and MaxGeofenceRadius bound Geofence.RadiusMeters.
+	// Devices cannot tell smaller circles apart reliably, and larger ones
+	// are no longer a place.
+	MinGeofenceRadius = 50
+	MaxGeofenceRadius = 50_000
+)
+
+// Validate returns ErrInvalidGeofence for coordinates outside their range,
+// a radius outside MinGeofenceRadius and MaxGeofenceRadius, or a geofence
+// firing neither on arrival nor on leaving
+func (g Geofence) Validate() error {
+	if g.Latitude < -90 || g.Latitude > 90 || g.Longitude < -180 || g.Longitude > 180 {
+		return ErrInvalidGeofence
+	}
+	if g.RadiusMeters < MinGeofenceRadius || g.RadiusMeters > MaxGeofenceRadius {
+		return ErrInvalidGeofence
+	}
+	if !g.OnArrive && !g.OnLeave {
+		return ErrInvalidGeofence
+	}
+	return nil
+}
+
+// ChangeSource is the kind of caller that changed a task
+type ChangeSource string
+
+const (
+	// ChangeSourceUser is a user signed in on one of their devices
+	ChangeSourceUser ChangeSource = "user"
+	// ChangeSourceAgent is an AI agent acting for the user with an MCP token
+	ChangeSourceAgent ChangeSource = "agent"
+	// ChangeSourceSystem is the server itself, e.g. a scheduled job
+	ChangeSourceSystem ChangeSource = "system"
+)
+
+// Modifier identifies the caller behind a change, so sync clients can tell
+// their own writes from those of other devices and agents
+type Modifier struct {
+	Source ChangeSource
+	// ClientID is the device or app instance the caller identified itself
+	// as; empty when it sent none
+	ClientID string
+	// TokenID and TokenName identify the MCP token an agent authenticated
+	// with; zero for other sources
+	TokenID   uuid.UUID
+	TokenName string
 }
 
 // ChecklistItem represents a single checklist row for a task.
@@ -31,18 +144,66 @@ type ChecklistItem struct {
 	UpdatedAt time.Time
 }
 
+// DefaultChecklistLimits are the checklist limits used unless configured
+// otherwise
+var DefaultChecklistLimits = ChecklistLimits{MaxItems: 200, MaxItemLength: 1000}
+
+// ChecklistLimits bounds the checklist of a task, so that a single task
+// stays cheap to load
+type ChecklistLimits struct {
+	// MaxItems is the number of items a task may have
+	MaxItems int
+	// MaxItemLength bounds the content of each item, in characters
+	MaxItemLength int
+}
+
+// CheckCount returns ErrTooManyChecklistItems when a task with count items
+// is over the limit
+func (l ChecklistLimits) CheckCount(count int) error {
+	if count > l.MaxItems {
+		return fmt.Errorf("%w: a task can have at most %d", ErrTooManyChecklistItems, l.MaxItems)
+	}
+	return nil
+}
+
+// CheckContent returns ErrChecklistItemTooLong when content is over the
+// limit. Characters are counted, not bytes, as the database counts them.
+func (l ChecklistLimits) CheckContent(content string) error {
+	if utf8.RuneCountInString(content) > l.MaxItemLength {
+		return fmt.Errorf("%w: at most %d characters are allowed", ErrChecklistItemTooLong, l.MaxItemLength)
+	}
+	return nil
+}
+
+// CheckChecklist checks the item contents of a new checklist against the
+// limits, reporting the index of the first item that is too long
+func (l ChecklistLimits) CheckChecklist(contents []string) error {
+	if err := l.CheckCount(len(contents)); err != nil {
+		return err
+	}
+	for i, content := range contents {
+		if err := l.CheckContent(content); err != nil {
+			return fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewTask creates a new task
 // Note: CreatedAt and UpdatedAt timestamps are not set here.
 // They will be populated by the database on insertion (DEFAULT NOW()).
 func NewTask(title, notes, ownerID string, tagIDs []uuid.UUID) *Task {
 	return &Task{
-		ID:         uuid.New(),
-		Title:      title,
-		Notes:      notes,
-		TagIDs:     tagIDs,
-		OwnerID:    ownerID,
-		ArchivedAt: nil,
-		StartDate:  nil,
+		ID:          uuid.New(),
+		Title:       title,
+		Notes:       notes,
+		TagIDs:      tagIDs,
+		OwnerID:     ownerID,
+		ArchivedAt:  nil,
+		StartDate:   nil,
+		Deadline:    nil,
+		Pinned:      false,
+		CompletedAt: nil,
 	}
 }
 
@@ -69,8 +230,67 @@ func (t *Task) IsArchived() bool {
 	return t.ArchivedAt != nil
 }
 
+// ChecklistProgress returns the number of completed and total checklist items.
+// Loaded items take precedence over the stored summary counts.
+func (t *Task) ChecklistProgress() (completed, total int) {
+	if len(t.Checklist) == 0 {
+		return t.ChecklistCompleted, t.ChecklistTotal
+	}
+	for _, item := range t.Checklist {
+		if item.Completed {
+			completed++
+		}
+	}
+	return completed, len(t.Checklist)
+}
+
 // SetStartDate sets or clears the start date for the task.
 // A nil date means the task belongs to inbox.
 func (t *Task) SetStartDate(date *time.Time) {
 	t.StartDate = date
 }
+
+// SetDeadline sets or clears the deadline for the task.
+// Unlike the start date, the deadline does not affect when a task appears;
+// it only marks when the task must be done. A nil date means no deadline.
+func (t *Task) SetDeadline(date *time.Time) {
+	t.Deadline = date
+}
+
+// SetContext sets or clears the GTD context of the task. The context must be
+// normalized with NormalizeContext; an empty context clears it.
+func (t *Task) SetContext(context string) {
+	t.Context = context
+}
+
+// IsNew reports whether the task was added by an agent or the system and
+// the owner has not viewed it yet. Tasks the owner created are never new.
+func (t *Task) IsNew() bool {
+	return t.LastViewedAt == nil && t.changedByOthers()
+}
+
+// UpdatedSinceViewed reports whether an agent or the system changed the
+// task after the owner last viewed it
+func (t *Task) UpdatedSinceViewed() bool {
+	return t.LastViewedAt != nil && t.UpdatedAt.After(*t.LastViewedAt) && t.changedByOthers()
+}
+
+// changedByOthers reports whether the latest change was not made by the
+// owner on one of their devices
+func (t *Task) changedByOthers() bool {
+	return t.LastModifiedBy.Source == ChangeSourceAgent || t.LastModifiedBy.Source == ChangeSourceSystem
+}
+
+// DaysRemaining returns the number of whole days between now and the deadline.
+// The value is negative when the deadline has passed and nil when no deadline is set.
+func (t *Task) DaysRemaining(now time.Time) *int {
+	if t.Deadline == nil {
+		return nil
+	}
+	year, month, day := now.UTC().Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	year, month, day = t.Deadline.UTC().Date()
+	deadline := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	days := int(deadline.Sub(today).Hours() / 24)
+	return &days
+}
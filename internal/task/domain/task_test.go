@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -34,3 +36,154 @@ func TestSetStartDate_SwitchFromDateToInbox(t *testing.T) {
 		t.Fatalf("expected date=nil after clearing, got %v", task.StartDate)
 	}
 }
+
+func TestDaysRemaining_NilWithoutDeadline(t *testing.T) {
+	task := NewTask("t", "", "owner", nil)
+
+	if days := task.DaysRemaining(time.Now()); days != nil {
+		t.Fatalf("expected nil days remaining, got %d", *days)
+	}
+}
+
+func TestDaysRemaining_CountsWholeDays(t *testing.T) {
+	task := NewTask("t", "", "owner", nil)
+	d := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+	task.SetDeadline(&d)
+
+	cases := []struct {
+		now  time.Time
+		want int
+	}{
+		{time.Date(2025, 6, 12, 23, 59, 0, 0, time.UTC), 3},
+		{time.Date(2025, 6, 15, 8, 0, 0, 0, time.UTC), 0},
+		{time.Date(2025, 6, 17, 1, 0, 0, 0, time.UTC), -2},
+	}
+	for _, tc := range cases {
+		days := task.DaysRemaining(tc.now)
+		if days == nil || *days != tc.want {
+			t.Fatalf("now=%v: expected %d days remaining, got %v", tc.now, tc.want, days)
+		}
+	}
+}
+
+func TestSetDeadline_DoesNotAffectStartDate(t *testing.T) {
+	task := NewTask("t", "", "owner", nil)
+	d := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+	task.SetDeadline(&d)
+
+	if task.StartDate != nil {
+		t.Fatalf("expected start date to remain nil (inbox), got %v", task.StartDate)
+	}
+}
+
+func TestChecklistProgress_PrefersLoadedItems(t *testing.T) {
+	task := NewTask("t", "", "owner", nil)
+	task.ChecklistTotal = 5
+	task.ChecklistCompleted = 1
+
+	completed, total := task.ChecklistProgress()
+	if completed != 1 || total != 5 {
+		t.Fatalf("expected summary counts 1/5, got %d/%d", completed, total)
+	}
+
+	task.Checklist = []ChecklistItem{{Completed: true}, {Completed: true}, {Completed: false}}
+	completed, total = task.ChecklistProgress()
+	if completed != 2 || total != 3 {
+		t.Fatalf("expected loaded counts 2/3, got %d/%d", completed, total)
+	}
+}
+
+func TestNormalizeContext(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "", want: ""},
+		{in: "  ", want: ""},
+		{in: "@home", want: "@home"},
+		{in: "Home", want: "@home"},
+		{in: " @Low-Energy ", want: "@low-energy"},
+		{in: "@", wantErr: true},
+		{in: "@at home", wantErr: true},
+		{in: "@" + strings.Repeat("a", MaxContextLength), wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeContext(tt.in)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidContext) {
+				t.Errorf("NormalizeContext(%q) error = %v, want ErrInvalidContext", tt.in, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("NormalizeContext(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestGeofenceValidate(t *testing.T) {
+	valid := Geofence{Latitude: 52.52, Longitude: 13.405, RadiusMeters: 200, OnArrive: true}
+	tests := []struct {
+		name    string
+		change  func(g *Geofence)
+		wantErr bool
+	}{
+		{name: "valid", change: func(g *Geofence) {}},
+		{name: "leave only", change: func(g *Geofence) { g.OnArrive, g.OnLeave = false, true }},
+		{name: "smallest radius", change: func(g *Geofence) { g.RadiusMeters = MinGeofenceRadius }},
+		{name: "largest radius", change: func(g *Geofence) { g.RadiusMeters = MaxGeofenceRadius }},
+		{name: "latitude out of range", change: func(g *Geofence) { g.Latitude = 90.5 }, wantErr: true},
+		{name: "longitude out of range", change: func(g *Geofence) { g.Longitude = -180.5 }, wantErr: true},
+		{name: "radius too small", change: func(g *Geofence) { g.RadiusMeters = MinGeofenceRadius - 1 }, wantErr: true},
+		{name: "radius too large", change: func(g *Geofence) { g.RadiusMeters = MaxGeofenceRadius + 1 }, wantErr: true},
+		{name: "no trigger", change: func(g *Geofence) { g.OnArrive = false }, wantErr: true},
+	}
+	for _, tt := range tests {
+		geofence := valid
+		tt.change(&geofence)
+		err := geofence.Validate()
+		if tt.wantErr && !errors.Is(err, ErrInvalidGeofence) {
+			t.Errorf("%s: Validate() error = %v, want ErrInvalidGeofence", tt.name, err)
+		}
+		if !tt.wantErr && err != nil {
+			t.Errorf("%s: Validate() error = %v", tt.name, err)
+		}
+	}
+}
+
+func TestViewIndicators(t *testing.T) {
+	viewedAt := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	before, after := viewedAt.Add(-time.Hour), viewedAt.Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		source      ChangeSource
+		viewed      bool
+		updatedAt   time.Time
+		wantNew     bool
+		wantUpdated bool
+	}{
+		{name: "own task never viewed", source: ChangeSourceUser, updatedAt: before},
+		{name: "agent task never viewed", source: ChangeSourceAgent, updatedAt: before, wantNew: true},
+		{name: "agent change before view", source: ChangeSourceAgent, viewed: true, updatedAt: before},
+		{name: "agent change after view", source: ChangeSourceAgent, viewed: true, updatedAt: after, wantUpdated: true},
+		{name: "system change after view", source: ChangeSourceSystem, viewed: true, updatedAt: after, wantUpdated: true},
+		{name: "own change after view", source: ChangeSourceUser, viewed: true, updatedAt: after},
+		{name: "legacy task", updatedAt: after},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &Task{UpdatedAt: tt.updatedAt, LastModifiedBy: Modifier{Source: tt.source}}
+			if tt.viewed {
+				task.LastViewedAt = &viewedAt
+			}
+			if got := task.IsNew(); got != tt.wantNew {
+				t.Errorf("IsNew() = %v, want %v", got, tt.wantNew)
+			}
+			if got := task.UpdatedSinceViewed(); got != tt.wantUpdated {
+				t.Errorf("UpdatedSinceViewed() = %v, want %v", got, tt.wantUpdated)
+			}
+		})
+	}
+}
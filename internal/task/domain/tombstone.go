@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tombstone records the deletion of a task for incremental sync
+type Tombstone struct {
+	TaskID    uuid.UUID
+	DeletedAt time.Time
+}
+
+// Cursor returns the position just past the tombstone
+func (t Tombstone) Cursor() TombstoneCursor {
+	return TombstoneCursor{DeletedAt: t.DeletedAt, TaskID: t.TaskID}
+}
+
+// TombstoneCursor is a position in the oldest-first order of tombstones.
+// Listing from a cursor returns the tombstones after it, i.e. newer ones.
+type TombstoneCursor struct {
+	DeletedAt time.Time
+	TaskID    uuid.UUID
+}
+
+// Encode returns the cursor as an opaque URL-safe token
+func (c TombstoneCursor) Encode() string {
+	raw := c.DeletedAt.UTC().Format(time.RFC3339Nano) + "|" + c.TaskID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseTombstoneCursor decodes a token returned by TombstoneCursor.Encode
+func ParseTombstoneCursor(token string) (*TombstoneCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidTombstoneCursor
+	}
+	deletedAt, taskID, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, ErrInvalidTombstoneCursor
+	}
+	cursor := &TombstoneCursor{}
+	if cursor.DeletedAt, err = time.Parse(time.RFC3339Nano, deletedAt); err != nil {
+		return nil, ErrInvalidTombstoneCursor
+	}
+	if cursor.TaskID, err = uuid.Parse(taskID); err != nil {
+		return nil, ErrInvalidTombstoneCursor
+	}
+	return cursor, nil
+}
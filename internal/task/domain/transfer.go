@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+var (
+	// ErrTransferToSelf is returned for transfers to the owner the tasks
+	// already belong to
+	ErrTransferToSelf = errors.New("tasks cannot be transferred to their owner")
+	// ErrRecipientNotFound is returned for transfers to a user that does
+	// not exist
+	ErrRecipientNotFound = domainerrors.New(domainerrors.ErrNotFound, "recipient not found")
+	// ErrTransferConflict is returned when the tags of a transferred task
+	// changed while the transfer was prepared; retrying succeeds
+	ErrTransferConflict = errors.New("tasks changed during the transfer, retry")
+)
+
+// TransferResult describes tasks handed from one owner to another. The
+// tasks keep their IDs.
+type TransferResult struct {
+	FromUserID string
+	ToUserID   string
+	TaskIDs    []uuid.UUID
+	// CreatedTags counts the tags the new owner did not have yet; the
+	// others were matched by name
+	CreatedTags int
+}
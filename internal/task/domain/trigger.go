@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultTriggerPageSize is the number of trigger events in a page when
+	// the caller does not choose
+	DefaultTriggerPageSize = 50
+	// MaxTriggerPageSize is the maximum number of trigger events in a page
+	MaxTriggerPageSize = 100
+)
+
+// TriggerKind selects the events polled by automation tools
+type TriggerKind int
+
+const (
+	// TriggerTaskCreated fires once per created task
+	TriggerTaskCreated TriggerKind = iota + 1
+	// TriggerTaskCompleted fires each time a task is completed
+	TriggerTaskCompleted
+	// TriggerTagAdded fires each time a tag is added to a task
+	TriggerTagAdded
+)
+
+// TriggerEvent is an occurrence of a trigger. Events are listed newest
+// first, ordered by OccurredAt, TaskID and TagID.
+type TriggerEvent struct {
+	Kind       TriggerKind
+	OccurredAt time.Time
+	TaskID     uuid.UUID
+	// TagID and TagName are set for TriggerTagAdded only
+	TagID   uuid.UUID
+	TagName string
+	// Task is the current state of the task
+	Task *Task
+}
+
+// DedupID identifies the event for clients that deduplicate polled items.
+// A task completed or tagged again after being reopened or untagged yields
+// a new ID.
+func (e TriggerEvent) DedupID() string {
+	switch e.Kind {
+	case TriggerTaskCompleted:
+		return fmt.Sprintf("%s:%d", e.TaskID, e.OccurredAt.UnixMicro())
+	case TriggerTagAdded:
+		return fmt.Sprintf("%s:%s:%d", e.TaskID, e.TagID, e.OccurredAt.UnixMicro())
+	default:
+		return e.TaskID.String()
+	}
+}
+
+// Cursor returns the position just past the event
+func (e TriggerEvent) Cursor() TriggerCursor {
+	return TriggerCursor{OccurredAt: e.OccurredAt, TaskID: e.TaskID, TagID: e.TagID}
+}
+
+// TriggerCursor is a position in the newest-first order of trigger events.
+// Listing from a cursor returns the events after it, i.e. older ones.
+type TriggerCursor struct {
+	OccurredAt time.Time
+	TaskID     uuid.UUID
+	TagID      uuid.UUID
+}
+
+// Encode returns the cursor as an opaque URL-safe token
+func (c TriggerCursor) Encode() string {
+	raw := c.OccurredAt.UTC().Format(time.RFC3339Nano) + "|" + c.TaskID.String() + "|" + c.TagID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseTriggerCursor decodes a token returned by TriggerCursor.Encode
+func ParseTriggerCursor(token string) (*TriggerCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidTriggerCursor
+	}
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 3 {
+		return nil, ErrInvalidTriggerCursor
+	}
+	occurredAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, ErrInvalidTriggerCursor
+	}
+	taskID, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, ErrInvalidTriggerCursor
+	}
+	tagID, err := uuid.Parse(parts[2])
+	if err != nil {
+		return nil, ErrInvalidTriggerCursor
+	}
+	return &TriggerCursor{OccurredAt: occurredAt, TaskID: taskID, TagID: tagID}, nil
+}
@@ -4,28 +4,66 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	tagv1 "github.com/slips-ai/slips-core/gen/go/tag/v1"
 	taskv1 "github.com/slips-ai/slips-core/gen/go/task/v1"
+	approvaldomain "github.com/slips-ai/slips-core/internal/approval/domain"
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
 	"github.com/slips-ai/slips-core/internal/task/application"
 	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
 	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"github.com/slips-ai/slips-core/pkg/resourcename"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// defaultPageSize is the List page size when neither the request nor
+	// the user's defaults set one
+	defaultPageSize = 30
+	// maxPageSize bounds List page sizes
+	maxPageSize = 100
+	// defaultStreamChunkSize is the StreamTasks chunk size when none is requested
+	defaultStreamChunkSize = 100
+	// maxStreamChunkSize bounds the size of a single StreamTasks message
+	maxStreamChunkSize = 500
+)
+
+// DeletionApprover holds back task deletions made with an MCP token until
+// the user approves them; the approval service implements it
+type DeletionApprover interface {
+	// RequiresDeleteApproval reports whether deletions by the caller must
+	// wait for approval
+	RequiresDeleteApproval(ctx context.Context) (bool, error)
+	// RequestDeletion records a pending approval for deleting the tasks
+	RequestDeletion(ctx context.Context, taskIDs []uuid.UUID) (*approvaldomain.Approval, error)
+}
+
 // TaskServer implements the TaskService gRPC server
 type TaskServer struct {
 	taskv1.UnimplementedTaskServiceServer
-	service *application.Service
+	service  *application.Service
+	approver DeletionApprover
+	// allowTransfers lets users give their tasks to other users
+	allowTransfers bool
 }
 
-// NewTaskServer creates a new task gRPC server
-func NewTaskServer(service *application.Service) *TaskServer {
+// NewTaskServer creates a new task gRPC server. TransferTasks is refused
+// unless allowTransfers is set.
+func NewTaskServer(service *application.Service, approver DeletionApprover, allowTransfers bool) *TaskServer {
 	return &TaskServer{
-		service: service,
+		service:        service,
+		approver:       approver,
+		allowTransfers: allowTransfers,
 	}
 }
 
@@ -46,9 +84,9 @@ func (s *TaskServer) CreateTask(ctx context.Context, req *taskv1.CreateTaskReque
 		if err := grpcerrors.ValidateNotEmpty(content, fieldName); err != nil {
 			return nil, err
 		}
-		if err := grpcerrors.ValidateLength(content, fieldName, grpcerrors.MaxChecklistItemLength); err != nil {
-			return nil, err
-		}
+	}
+	if err := grpcerrors.ValidateLength(req.ClientRequestId, "client_request_id", grpcerrors.MaxClientRequestIDLength); err != nil {
+		return nil, err
 	}
 
 	// Parse and validate start_date
@@ -56,14 +94,22 @@ func (s *TaskServer) CreateTask(ctx context.Context, req *taskv1.CreateTaskReque
 	if err != nil {
 		return nil, err
 	}
+	deadline, err := parseDeadline(req.Deadline)
+	if err != nil {
+		return nil, err
+	}
+	taskContext, err := parseContext(req.Context)
+	if err != nil {
+		return nil, err
+	}
 
-	task, err := s.service.CreateTask(ctx, req.Title, req.Notes, req.TagNames, startDate, req.ChecklistItems)
+	task, err := s.service.CreateTask(ctx, req.Title, req.Notes, req.TagNames, startDate, deadline, taskContext, req.ChecklistItems, req.ClientRequestId)
 	if err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to create task")
+		return nil, toGRPCError(err, "failed to create task")
 	}
 
 	return &taskv1.CreateTaskResponse{
-		Task: taskToProto(task),
+		Task: TaskToProto(task),
 	}, nil
 }
 
@@ -74,13 +120,53 @@ func (s *TaskServer) GetTask(ctx context.Context, req *taskv1.GetTaskRequest) (*
 		return nil, status.Error(codes.InvalidArgument, "invalid task ID format")
 	}
 
-	task, err := s.service.GetTask(ctx, id)
+	readMask, err := parseReadMask(req.ReadMask)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.service.ViewTask(ctx, id, readMask.load())
 	if err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to get task")
+		return nil, toGRPCError(err, "failed to get task")
 	}
 
 	return &taskv1.GetTaskResponse{
-		Task: taskToProto(task),
+		Task: readMask.apply(TaskToProto(task)),
+	}, nil
+}
+
+// BatchGetTasks retrieves multiple tasks by ID
+func (s *TaskServer) BatchGetTasks(ctx context.Context, req *taskv1.BatchGetTasksRequest) (*taskv1.BatchGetTasksResponse, error) {
+	if len(req.Ids) > domain.MaxBatchGetSize {
+		return nil, status.Errorf(codes.InvalidArgument, "ids must contain at most %d entries", domain.MaxBatchGetSize)
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.Ids))
+	for _, idStr := range req.Ids {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid task ID format: %s", idStr)
+		}
+		ids = append(ids, id)
+	}
+
+	tasks, missing, err := s.service.BatchGetTasks(ctx, ids)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to batch get tasks")
+	}
+
+	protoTasks := make([]*taskv1.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = TaskToProto(task)
+	}
+	missingIDs := make([]string, len(missing))
+	for i, id := range missing {
+		missingIDs[i] = id.String()
+	}
+
+	return &taskv1.BatchGetTasksResponse{
+		Tasks:      protoTasks,
+		MissingIds: missingIDs,
 	}, nil
 }
 
@@ -115,13 +201,34 @@ func (s *TaskServer) UpdateTask(ctx context.Context, req *taskv1.UpdateTaskReque
 		startDate = date
 	}
 
-	task, err := s.service.UpdateTask(ctx, id, req.Title, req.Notes, req.TagNames, startDateProvided, startDate)
+	// Same semantics for deadline: absent means "no change", empty string clears it.
+	var deadlineProvided bool
+	var deadline *time.Time
+	if req.Deadline != nil {
+		deadlineProvided = true
+		date, err := parseDeadline(req.Deadline)
+		if err != nil {
+			return nil, err
+		}
+		deadline = date
+	}
+
+	// Same for context: absent means "no change", empty string clears it.
+	var taskContext string
+	if req.Context != nil {
+		taskContext, err = parseContext(*req.Context)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	task, err := s.service.UpdateTask(ctx, id, req.Title, req.Notes, req.TagNames, startDateProvided, startDate, deadlineProvided, deadline, req.Context != nil, taskContext)
 	if err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to update task")
+		return nil, toGRPCError(err, "failed to update task")
 	}
 
 	return &taskv1.UpdateTaskResponse{
-		Task: taskToProto(task),
+		Task: TaskToProto(task),
 	}, nil
 }
 
@@ -132,13 +239,52 @@ func (s *TaskServer) DeleteTask(ctx context.Context, req *taskv1.DeleteTaskReque
 		return nil, status.Error(codes.InvalidArgument, "invalid task ID format")
 	}
 
+	if req.ValidateOnly {
+		task, err := s.service.PreviewDeleteTask(ctx, id)
+		if err != nil {
+			return nil, toGRPCError(err, "failed to get task")
+		}
+		resp := &taskv1.DeleteTaskResponse{}
+		if task != nil {
+			resp.Task = TaskToProto(task)
+		}
+		return resp, nil
+	}
+
+	approvalID, err := requestDeletion(ctx, s.approver, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	if approvalID != "" {
+		return &taskv1.DeleteTaskResponse{ApprovalId: approvalID}, nil
+	}
+
 	if err := s.service.DeleteTask(ctx, id); err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to delete task")
+		return nil, toGRPCError(err, "failed to delete task")
 	}
 
 	return &taskv1.DeleteTaskResponse{}, nil
 }
 
+// requestDeletion holds back deleting taskIDs when the caller needs the
+// user's approval for it and returns the approval's ID. An empty ID means
+// the tasks may be deleted right away.
+func requestDeletion(ctx context.Context, approver DeletionApprover, taskIDs []uuid.UUID) (string, error) {
+	required, err := approver.RequiresDeleteApproval(ctx)
+	if err != nil {
+		return "", grpcerrors.ToGRPCError(err, "failed to get approval settings")
+	}
+	if !required {
+		return "", nil
+	}
+
+	approval, err := approver.RequestDeletion(ctx, taskIDs)
+	if err != nil {
+		return "", grpcerrors.ToGRPCError(err, "failed to request approval")
+	}
+	return approval.ID.String(), nil
+}
+
 // ListTasks lists tasks with pagination
 func (s *TaskServer) ListTasks(ctx context.Context, req *taskv1.ListTasksRequest) (*taskv1.ListTasksResponse, error) {
 	// Reject page_token if provided (not yet implemented)
@@ -146,10 +292,12 @@ func (s *TaskServer) ListTasks(ctx context.Context, req *taskv1.ListTasksRequest
 		return nil, status.Errorf(codes.Unimplemented, "page_token is not supported yet")
 	}
 
-	pageSize := int(req.PageSize)
-	if pageSize <= 0 || pageSize > 100 {
-		pageSize = 30
+	// The user's defaults fill in what the request leaves out
+	var defaults authdomain.ListDefaults
+	if !pageSizeSet(req.PageSize) || req.TagMatchMode == taskv1.TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED {
+		defaults = s.service.ListDefaults(ctx)
 	}
+	pageSize := listPageSize(req.PageSize, defaults)
 
 	// Always return the first page (offset 0) until pagination tokens are implemented
 	offset := 0
@@ -172,29 +320,247 @@ func (s *TaskServer) ListTasks(ctx context.Context, req *taskv1.ListTasksRequest
 		filterTagIDs = append(filterTagIDs, tagID)
 	}
 
+	excludeTagIDs := make([]uuid.UUID, 0, len(req.ExcludeTagIds))
+	for _, tagIDStr := range req.ExcludeTagIds {
+		tagID, err := uuid.Parse(tagIDStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tag ID format: %s", tagIDStr)
+		}
+		excludeTagIDs = append(excludeTagIDs, tagID)
+	}
+
+	untaggedOnly := req.UntaggedOnly != nil && *req.UntaggedOnly
+	if untaggedOnly && len(filterTagIDs) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "untagged_only cannot be combined with filter_tag_ids")
+	}
+
+	startDateFrom, err := parseDateFilter(req.StartDateFrom, "start_date_from")
+	if err != nil {
+		return nil, err
+	}
+	startDateTo, err := parseDateFilter(req.StartDateTo, "start_date_to")
+	if err != nil {
+		return nil, err
+	}
+	if startDateFrom != nil && startDateTo != nil && startDateTo.Before(*startDateFrom) {
+		return nil, status.Error(codes.InvalidArgument, "start_date_to must not be before start_date_from")
+	}
+	inboxOnly := req.InboxOnly != nil && *req.InboxOnly
+	if inboxOnly && (startDateFrom != nil || startDateTo != nil) {
+		return nil, status.Error(codes.InvalidArgument, "inbox_only cannot be combined with a start_date range")
+	}
+	contexts, err := parseContextFilter(req.Contexts)
+	if err != nil {
+		return nil, err
+	}
+	readMask, err := parseReadMask(req.ReadMask)
+	if err != nil {
+		return nil, err
+	}
+
+	tagMatchAll := req.TagMatchMode == taskv1.TagMatchMode_TAG_MATCH_MODE_ALL
+	if req.TagMatchMode == taskv1.TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED {
+		tagMatchAll = defaults.TagMatchAll
+	}
+
 	// Parse archive filter options
-	includeArchived := req.IncludeArchived != nil && *req.IncludeArchived
-	archivedOnly := req.ArchivedOnly != nil && *req.ArchivedOnly
+	opts := domain.ListOptions{
+		IncludeArchived: req.IncludeArchived != nil && *req.IncludeArchived,
+		ArchivedOnly:    req.ArchivedOnly != nil && *req.ArchivedOnly,
+		TagMatchAll:     tagMatchAll,
+		ExcludeTagIDs:   excludeTagIDs,
+		UntaggedOnly:    untaggedOnly,
+		StartDateFrom:   startDateFrom,
+		StartDateTo:     startDateTo,
+		InboxOnly:       inboxOnly,
+		Contexts:        contexts,
+		GroupBy:         groupByFromProto(req.GroupBy),
+		Order:           orderFromProto(req.OrderBy),
+		Load:            readMask.load(),
+	}
+	if req.UpdatedAfter != nil {
+		if err := req.UpdatedAfter.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid updated_after timestamp")
+		}
+		updatedAfter := req.UpdatedAfter.AsTime()
+		opts.UpdatedAfter = &updatedAfter
+	}
+	if req.ArchivedAfter != nil {
+		if err := req.ArchivedAfter.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid archived_after timestamp")
+		}
+		archivedAfter := req.ArchivedAfter.AsTime()
+		opts.ArchivedAfter = &archivedAfter
+	}
+	if req.ArchivedBefore != nil {
+		if err := req.ArchivedBefore.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid archived_before timestamp")
+		}
+		archivedBefore := req.ArchivedBefore.AsTime()
+		opts.ArchivedBefore = &archivedBefore
+	}
+	if opts.ArchivedAfter != nil && opts.ArchivedBefore != nil && !opts.ArchivedBefore.After(*opts.ArchivedAfter) {
+		return nil, status.Error(codes.InvalidArgument, "archived_before must be after archived_after")
+	}
+	// An archive range only matches archived tasks
+	if (opts.ArchivedAfter != nil || opts.ArchivedBefore != nil) && !opts.IncludeArchived {
+		opts.ArchivedOnly = true
+	}
+	if req.DeletedTasksPageToken != "" {
+		if opts.UpdatedAfter == nil {
+			return nil, status.Error(codes.InvalidArgument, "deleted_tasks_page_token requires updated_after")
+		}
+		after, err := domain.ParseTombstoneCursor(req.DeletedTasksPageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid deleted_tasks_page_token")
+		}
+		opts.DeletedTasksAfter = after
+	}
+	deadlineApproaching := req.DeadlineApproaching != nil && *req.DeadlineApproaching
 
-	tasks, err := s.service.ListTasks(ctx, filterTagIDs, pageSize, offset, includeArchived, archivedOnly)
+	result, err := s.service.ListTasks(ctx, filterTagIDs, pageSize, offset, opts, deadlineApproaching)
 	if err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to list tasks")
+		return nil, toGRPCError(err, "failed to list tasks")
 	}
 
-	protoTasks := make([]*taskv1.Task, len(tasks))
-	for i, task := range tasks {
-		protoTasks[i] = taskToProto(task)
+	protoTasks := make([]*taskv1.Task, len(result.Tasks))
+	for i, task := range result.Tasks {
+		protoTasks[i] = readMask.apply(TaskToProto(task))
+	}
+
+	deletedTasks := make([]*taskv1.DeletedTask, len(result.DeletedTasks))
+	for i, tombstone := range result.DeletedTasks {
+		deletedTasks[i] = &taskv1.DeletedTask{
+			Id:        tombstone.TaskID.String(),
+			DeletedAt: timestamppb.New(tombstone.DeletedAt),
+		}
+	}
+	nextDeletedTasksPageToken := ""
+	if result.MoreDeletedTasks {
+		nextDeletedTasksPageToken = result.DeletedTasks[len(result.DeletedTasks)-1].Cursor().Encode()
 	}
 
 	// Note: next_page_token is not implemented yet
 	// Future implementation would return a token when len(tasks) == pageSize
 	return &taskv1.ListTasksResponse{
-		Tasks: protoTasks,
+		Tasks:                     protoTasks,
+		TotalSize:                 int32(result.TotalSize),
+		Groups:                    groupsToProto(result.Groups),
+		DeletedTasks:              deletedTasks,
+		NextDeletedTasksPageToken: nextDeletedTasksPageToken,
+	}, nil
+}
+
+// StreamTasks sends all of the caller's tasks in chunks. Send blocks while
+// the client's flow control window is full, which paces the repository reads.
+func (s *TaskServer) StreamTasks(req *taskv1.StreamTasksRequest, stream grpc.ServerStreamingServer[taskv1.StreamTasksResponse]) error {
+	chunkSize := int(req.ChunkSize)
+	switch {
+	case chunkSize < 0 || chunkSize > maxStreamChunkSize:
+		return status.Errorf(codes.InvalidArgument, "chunk_size must be between 0 and %d", maxStreamChunkSize)
+	case chunkSize == 0:
+		chunkSize = defaultStreamChunkSize
+	}
+
+	opts := domain.ScanOptions{
+		IncludeArchived: req.GetIncludeArchived(),
+		ArchivedOnly:    req.GetArchivedOnly(),
+	}
+
+	var sendErr error
+	err := s.service.StreamTasks(stream.Context(), chunkSize, opts, func(tasks []*domain.Task) error {
+		sendErr = stream.Send(&taskv1.StreamTasksResponse{Tasks: TasksToProto(tasks)})
+		return sendErr
+	})
+	switch {
+	case err == nil:
+		return nil
+	case sendErr != nil:
+		// Already a status error from the transport
+		return sendErr
+	case stream.Context().Err() != nil:
+		return status.FromContextError(stream.Context().Err()).Err()
+	default:
+		return toGRPCError(err, "failed to stream tasks")
+	}
+}
+
+// WatchChanges streams the caller's changes until the client cancels. A
+// watcher that falls too far behind is ended with ABORTED and should
+// reconnect and reload.
+func (s *TaskServer) WatchChanges(req *taskv1.WatchChangesRequest, stream grpc.ServerStreamingServer[taskv1.WatchChangesResponse]) error {
+	var sendErr error
+	err := s.service.WatchChanges(stream.Context(), func() error {
+		sendErr = stream.SendHeader(metadata.MD{})
+		return sendErr
+	}, func(change application.Change) error {
+		sendErr = stream.Send(changeToProto(change))
+		return sendErr
+	})
+	switch {
+	case sendErr != nil:
+		// Already a status error from the transport
+		return sendErr
+	case stream.Context().Err() != nil:
+		return status.FromContextError(stream.Context().Err()).Err()
+	case errors.Is(err, changefeed.ErrOverflow):
+		return status.Error(codes.Aborted, "watcher fell behind; reload and watch again")
+	case errors.Is(err, changefeed.ErrClosed):
+		return status.Error(codes.Unavailable, "server is shutting down")
+	default:
+		return toGRPCError(err, "failed to watch changes")
+	}
+}
+
+// ListTasksByFilter lists tasks matching a saved filter
+func (s *TaskServer) ListTasksByFilter(ctx context.Context, req *taskv1.ListTasksByFilterRequest) (*taskv1.ListTasksByFilterResponse, error) {
+	filterID, err := uuid.Parse(req.FilterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid filter ID format")
+	}
+
+	// Reject page_token if provided (not yet implemented)
+	if req.PageToken != "" {
+		return nil, status.Errorf(codes.Unimplemented, "page_token is not supported yet")
+	}
+
+	var defaults authdomain.ListDefaults
+	if !pageSizeSet(req.PageSize) {
+		defaults = s.service.ListDefaults(ctx)
+	}
+	pageSize := listPageSize(req.PageSize, defaults)
+
+	// Always return the first page (offset 0) until pagination tokens are implemented
+	offset := 0
+
+	// Validate int32 bounds at gRPC layer before calling repository
+	if err := grpcerrors.ValidateInt32Range(pageSize, "limit"); err != nil {
+		return nil, err
+	}
+	if err := grpcerrors.ValidateInt32Range(offset, "offset"); err != nil {
+		return nil, err
+	}
+
+	result, err := s.service.ListTasksByFilter(ctx, filterID, pageSize, offset, groupByFromProto(req.GroupBy))
+	if err != nil {
+		return nil, toGRPCError(err, "failed to list tasks by filter")
+	}
+
+	protoTasks := make([]*taskv1.Task, len(result.Tasks))
+	for i, task := range result.Tasks {
+		protoTasks[i] = TaskToProto(task)
+	}
+
+	return &taskv1.ListTasksByFilterResponse{
+		Tasks:     protoTasks,
+		TotalSize: int32(result.TotalSize),
+		Groups:    groupsToProto(result.Groups),
 	}, nil
 }
 
-// taskToProto converts a domain Task to a proto Task
-func taskToProto(task *domain.Task) *taskv1.Task {
+// TaskToProto converts a domain Task to a proto Task.
+// It is shared with other servers that embed tasks in their responses.
+func TaskToProto(task *domain.Task) *taskv1.Task {
 	tagIDs := make([]string, len(task.TagIDs))
 	for i, tagID := range task.TagIDs {
 		tagIDs[i] = tagID.String()
@@ -206,27 +572,106 @@ func taskToProto(task *domain.Task) *taskv1.Task {
 	}
 
 	protoTask := &taskv1.Task{
-		Id:             task.ID.String(),
-		Title:          task.Title,
-		Notes:          task.Notes,
-		CreatedAt:      timestamppb.New(task.CreatedAt),
-		UpdatedAt:      timestamppb.New(task.UpdatedAt),
-		TagIds:         tagIDs,
-		ChecklistItems: checklistItems,
+		Id:                 task.ID.String(),
+		ResourceName:       resourcename.Task{User: task.OwnerID, Task: task.ID}.String(),
+		Title:              task.Title,
+		Notes:              task.Notes,
+		CreatedAt:          timestamppb.New(task.CreatedAt),
+		UpdatedAt:          timestamppb.New(task.UpdatedAt),
+		TagIds:             tagIDs,
+		ChecklistItems:     checklistItems,
+		Pinned:             task.Pinned,
+		ClientRequestId:    task.ClientRequestID,
+		Context:            task.Context,
+		IsNew:              task.IsNew(),
+		UpdatedSinceViewed: task.UpdatedSinceViewed(),
 	}
 
+	checklistCompleted, checklistTotal := task.ChecklistProgress()
+	protoTask.ChecklistTotalCount = int32(checklistTotal)
+	protoTask.ChecklistCompletedCount = int32(checklistCompleted)
+
 	if task.ArchivedAt != nil {
 		protoTask.ArchivedAt = timestamppb.New(*task.ArchivedAt)
 	}
 
+	if task.CompletedAt != nil {
+		protoTask.CompletedAt = timestamppb.New(*task.CompletedAt)
+	}
+
+	if task.LastViewedAt != nil {
+		protoTask.LastViewedAt = timestamppb.New(*task.LastViewedAt)
+	}
+
 	if task.StartDate != nil {
 		formatted := task.StartDate.Format("2006-01-02")
 		protoTask.StartDate = &formatted
 	}
 
+	if task.Deadline != nil {
+		formatted := task.Deadline.Format("2006-01-02")
+		protoTask.Deadline = &formatted
+		if days := task.DaysRemaining(time.Now()); days != nil {
+			remaining := int32(*days)
+			protoTask.DaysRemaining = &remaining
+		}
+	}
+
+	protoTask.LastModifiedBy = modifierToProto(task.LastModifiedBy)
+	protoTask.CreatedBy = modifierToProto(task.CreatedBy)
+
+	if task.Geofence != nil {
+		protoTask.Geofence = &taskv1.Geofence{
+			Latitude:     task.Geofence.Latitude,
+			Longitude:    task.Geofence.Longitude,
+			RadiusMeters: int32(task.Geofence.RadiusMeters),
+			OnArrive:     task.Geofence.OnArrive,
+			OnLeave:      task.Geofence.OnLeave,
+		}
+	}
+
 	return protoTask
 }
 
+// modifierToProto converts a modifier, returning nil when it was not
+// recorded
+func modifierToProto(by domain.Modifier) *taskv1.TaskModifier {
+	if by.Source == "" {
+		return nil
+	}
+	modifier := &taskv1.TaskModifier{
+		Source:    changeSourceToProto(by.Source),
+		ClientId:  by.ClientID,
+		TokenName: by.TokenName,
+	}
+	if by.TokenID != uuid.Nil {
+		modifier.TokenId = by.TokenID.String()
+	}
+	return modifier
+}
+
+func changeSourceToProto(source domain.ChangeSource) taskv1.ChangeSource {
+	switch source {
+	case domain.ChangeSourceUser:
+		return taskv1.ChangeSource_CHANGE_SOURCE_USER
+	case domain.ChangeSourceAgent:
+		return taskv1.ChangeSource_CHANGE_SOURCE_AGENT
+	case domain.ChangeSourceSystem:
+		return taskv1.ChangeSource_CHANGE_SOURCE_SYSTEM
+	default:
+		return taskv1.ChangeSource_CHANGE_SOURCE_UNSPECIFIED
+	}
+}
+
+// TasksToProto converts a slice of domain Tasks to proto Tasks
+func TasksToProto(tasks []*domain.Task) []*taskv1.Task {
+	protoTasks := make([]*taskv1.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = TaskToProto(task)
+	}
+	return protoTasks
+}
+
 func checklistItemToProto(item *domain.ChecklistItem) *taskv1.ChecklistItem {
 	return &taskv1.ChecklistItem{
 		Id:        item.ID.String(),
@@ -239,6 +684,98 @@ func checklistItemToProto(item *domain.ChecklistItem) *taskv1.ChecklistItem {
 	}
 }
 
+// NoteRevisionToProto converts a note revision. It is shared with the admin
+// export.
+func NoteRevisionToProto(revision *domain.NoteRevision) *taskv1.NoteRevision {
+	return &taskv1.NoteRevision{
+		Id:        revision.ID.String(),
+		TaskId:    revision.TaskID.String(),
+		Notes:     revision.Notes,
+		CreatedAt: timestamppb.New(revision.CreatedAt),
+	}
+}
+
+// SettingsToProto converts task settings. It is shared with the admin
+// export.
+func SettingsToProto(settings *domain.Settings) *taskv1.TaskSettings {
+	protoSettings := &taskv1.TaskSettings{
+		RolloverToInbox: settings.RolloverToInbox,
+	}
+	if settings.AutoArchiveAfterDays != nil {
+		days := int32(*settings.AutoArchiveAfterDays)
+		protoSettings.AutoArchiveAfterDays = &days
+	}
+	return protoSettings
+}
+
+// taskReadMask holds the Task fields a read_mask returns; nil returns all
+type taskReadMask map[string]bool
+
+// parseReadMask validates a Task read mask. Only top-level fields can be
+// selected, and the id and resource name are always returned.
+func parseReadMask(mask *fieldmaskpb.FieldMask) (taskReadMask, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+
+	fields := (&taskv1.Task{}).ProtoReflect().Descriptor().Fields()
+	paths := taskReadMask{"id": true, "resource_name": true}
+	for _, path := range mask.Paths {
+		if fields.ByName(protoreflect.Name(path)) == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported read_mask path %q", path)
+		}
+		paths[path] = true
+	}
+	return paths, nil
+}
+
+// load leaves out the parts of a task that the mask does not return
+func (m taskReadMask) load() domain.LoadOptions {
+	if m == nil {
+		return domain.LoadOptions{}
+	}
+	return domain.LoadOptions{
+		SkipNotes:     !m["notes"],
+		SkipTags:      !m["tag_ids"],
+		SkipChecklist: !m["checklist_items"] && !m["checklist_total_count"] && !m["checklist_completed_count"],
+	}
+}
+
+// apply clears the fields of task that the mask does not return
+func (m taskReadMask) apply(task *taskv1.Task) *taskv1.Task {
+	if m == nil {
+		return task
+	}
+	message := task.ProtoReflect()
+	fields := message.Descriptor().Fields()
+	for i := range fields.Len() {
+		if field := fields.Get(i); !m[string(field.Name())] {
+			message.Clear(field)
+		}
+	}
+	return task
+}
+
+// parseToday parses the caller's local date, defaulting to the UTC date of
+// now. Dates more than a day away from it cannot be anyone's today, since
+// time zones are less than a day from UTC.
+func parseToday(datePtr *string, now time.Time) (time.Time, error) {
+	utcToday := now.UTC().Truncate(24 * time.Hour)
+	if datePtr == nil || *datePtr == "" {
+		return utcToday, nil
+	}
+
+	parsed, err := time.Parse("2006-01-02", *datePtr)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid today format: expected YYYY-MM-DD")
+	}
+	if parsed.Before(utcToday.AddDate(0, 0, -1)) || parsed.After(utcToday.AddDate(0, 0, 1)) {
+		return time.Time{}, status.Error(codes.InvalidArgument, "today must be within a day of the current UTC date")
+	}
+
+	return parsed, nil
+}
+
 // parseStartDateForCreate parses and validates optional start_date for create requests.
 // nil means inbox.
 func parseStartDateForCreate(datePtr *string) (*time.Time, error) {
@@ -269,6 +806,99 @@ func parseStartDateForUpdate(datePtr *string) (*time.Time, error) {
 	return &parsed, nil
 }
 
+// parseDeadline parses and validates an optional deadline.
+// nil or empty string means no deadline.
+func parseDeadline(datePtr *string) (*time.Time, error) {
+	if datePtr == nil || *datePtr == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse("2006-01-02", *datePtr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid deadline format: expected YYYY-MM-DD")
+	}
+
+	return &parsed, nil
+}
+
+// parseContext normalizes a task context; an empty context means none
+func parseContext(value string) (string, error) {
+	normalized, err := domain.NormalizeContext(value)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	return normalized, nil
+}
+
+// parseContextFilter normalizes the contexts of a list filter. Unlike a
+// task's context, a filter entry cannot be empty.
+func parseContextFilter(values []string) ([]string, error) {
+	contexts := make([]string, 0, len(values))
+	for _, value := range values {
+		normalized, err := parseContext(value)
+		if err != nil {
+			return nil, err
+		}
+		if normalized == "" {
+			return nil, status.Error(codes.InvalidArgument, "contexts cannot contain empty values")
+		}
+		contexts = append(contexts, normalized)
+	}
+	return contexts, nil
+}
+
+// groupByFromProto maps the proto grouping enum to the domain value.
+// Unknown values disable grouping.
+func groupByFromProto(groupBy taskv1.TaskGroupBy) domain.GroupBy {
+	switch groupBy {
+	case taskv1.TaskGroupBy_TASK_GROUP_BY_START_DATE:
+		return domain.GroupByStartDate
+	case taskv1.TaskGroupBy_TASK_GROUP_BY_DEADLINE:
+		return domain.GroupByDeadline
+	case taskv1.TaskGroupBy_TASK_GROUP_BY_TAG:
+		return domain.GroupByTag
+	default:
+		return domain.GroupByNone
+	}
+}
+
+func orderFromProto(orderBy taskv1.TaskOrderBy) domain.ListOrder {
+	switch orderBy {
+	case taskv1.TaskOrderBy_TASK_ORDER_BY_ARCHIVED_AT_DESC:
+		return domain.ListOrderArchivedDesc
+	case taskv1.TaskOrderBy_TASK_ORDER_BY_ARCHIVED_AT_ASC:
+		return domain.ListOrderArchivedAsc
+	default:
+		return domain.ListOrderDefault
+	}
+}
+
+func groupsToProto(groups []domain.TaskGroup) []*taskv1.TaskGroup {
+	protoGroups := make([]*taskv1.TaskGroup, len(groups))
+	for i, group := range groups {
+		protoGroups[i] = &taskv1.TaskGroup{
+			Key:   group.Key,
+			Count: int32(group.Count),
+		}
+	}
+	return protoGroups
+}
+
+// parseDateFilter parses an optional YYYY-MM-DD list filter bound.
+// nil or empty string means the bound is not set.
+func parseDateFilter(datePtr *string, fieldName string) (*time.Time, error) {
+	if datePtr == nil || *datePtr == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse("2006-01-02", *datePtr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s format: expected YYYY-MM-DD", fieldName)
+	}
+
+	return &parsed, nil
+}
+
 // ArchiveTask archives a task
 func (s *TaskServer) ArchiveTask(ctx context.Context, req *taskv1.ArchiveTaskRequest) (*taskv1.ArchiveTaskResponse, error) {
 	id, err := uuid.Parse(req.Id)
@@ -278,11 +908,11 @@ func (s *TaskServer) ArchiveTask(ctx context.Context, req *taskv1.ArchiveTaskReq
 
 	task, err := s.service.ArchiveTask(ctx, id)
 	if err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to archive task")
+		return nil, toGRPCError(err, "failed to archive task")
 	}
 
 	return &taskv1.ArchiveTaskResponse{
-		Task: taskToProto(task),
+		Task: TaskToProto(task),
 	}, nil
 }
 
@@ -295,33 +925,416 @@ func (s *TaskServer) UnarchiveTask(ctx context.Context, req *taskv1.UnarchiveTas
 
 	task, err := s.service.UnarchiveTask(ctx, id)
 	if err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to unarchive task")
+		return nil, toGRPCError(err, "failed to unarchive task")
 	}
 
 	return &taskv1.UnarchiveTaskResponse{
-		Task: taskToProto(task),
+		Task: TaskToProto(task),
 	}, nil
 }
 
-// AddChecklistItem creates a checklist item for a task.
-func (s *TaskServer) AddChecklistItem(ctx context.Context, req *taskv1.AddChecklistItemRequest) (*taskv1.AddChecklistItemResponse, error) {
-	taskID, err := uuid.Parse(req.TaskId)
+// TogglePinTask pins or unpins a task
+func (s *TaskServer) TogglePinTask(ctx context.Context, req *taskv1.TogglePinTaskRequest) (*taskv1.TogglePinTaskResponse, error) {
+	id, err := uuid.Parse(req.Id)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid task ID format")
 	}
-	if err := grpcerrors.ValidateNotEmpty(req.Content, "content"); err != nil {
+
+	task, err := s.service.TogglePinTask(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to toggle task pin")
+	}
+
+	return &taskv1.TogglePinTaskResponse{
+		Task: TaskToProto(task),
+	}, nil
+}
+
+// CompleteTask marks a task as completed
+func (s *TaskServer) CompleteTask(ctx context.Context, req *taskv1.CompleteTaskRequest) (*taskv1.CompleteTaskResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task ID format")
+	}
+
+	task, err := s.service.CompleteTask(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to complete task")
+	}
+
+	return &taskv1.CompleteTaskResponse{
+		Task: TaskToProto(task),
+	}, nil
+}
+
+// ReopenTask marks a completed task as open again
+func (s *TaskServer) ReopenTask(ctx context.Context, req *taskv1.ReopenTaskRequest) (*taskv1.ReopenTaskResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task ID format")
+	}
+
+	task, err := s.service.ReopenTask(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to reopen task")
+	}
+
+	return &taskv1.ReopenTaskResponse{
+		Task: TaskToProto(task),
+	}, nil
+}
+
+// ArchiveCompletedTasks archives all completed tasks, optionally only older ones
+func (s *TaskServer) ArchiveCompletedTasks(ctx context.Context, req *taskv1.ArchiveCompletedTasksRequest) (*taskv1.ArchiveCompletedTasksResponse, error) {
+	olderThanDays := 0
+	if req.OlderThanDays != nil {
+		if *req.OlderThanDays < 0 {
+			return nil, status.Error(codes.InvalidArgument, "older_than_days must not be negative")
+		}
+		olderThanDays = int(*req.OlderThanDays)
+	}
+
+	count, err := s.service.ArchiveCompletedTasks(ctx, olderThanDays)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to archive completed tasks")
+	}
+
+	return &taskv1.ArchiveCompletedTasksResponse{
+		ArchivedCount: count,
+	}, nil
+}
+
+// ArchiveTasksByTag archives every task with a tag
+func (s *TaskServer) ArchiveTasksByTag(ctx context.Context, req *taskv1.ArchiveTasksByTagRequest) (*taskv1.ArchiveTasksByTagResponse, error) {
+	tagID, err := uuid.Parse(req.TagId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tag ID format")
+	}
+
+	count, err := s.service.ArchiveTasksByTag(ctx, tagID)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to archive tasks with tag")
+	}
+
+	return &taskv1.ArchiveTasksByTagResponse{
+		ArchivedCount: count,
+	}, nil
+}
+
+// UnarchiveTasksByTag restores every archived task with a tag
+func (s *TaskServer) UnarchiveTasksByTag(ctx context.Context, req *taskv1.UnarchiveTasksByTagRequest) (*taskv1.UnarchiveTasksByTagResponse, error) {
+	tagID, err := uuid.Parse(req.TagId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tag ID format")
+	}
+
+	count, err := s.service.UnarchiveTasksByTag(ctx, tagID)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to unarchive tasks with tag")
+	}
+
+	return &taskv1.UnarchiveTasksByTagResponse{
+		UnarchivedCount: count,
+	}, nil
+}
+
+// GetCounters counts the caller's open tasks for badges
+func (s *TaskServer) GetCounters(ctx context.Context, req *taskv1.GetCountersRequest) (*taskv1.GetCountersResponse, error) {
+	today, err := parseToday(req.Today, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	counters, err := s.service.GetCounters(ctx, today)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to get counters")
+	}
+
+	return &taskv1.GetCountersResponse{
+		InboxCount:   counters.Inbox,
+		TodayCount:   counters.Today,
+		OverdueCount: counters.Overdue,
+		UnreadCount:  counters.Unread,
+	}, nil
+}
+
+// RolloverOverdueTasks moves the caller's tasks that started before today
+// to today, or to the inbox
+func (s *TaskServer) RolloverOverdueTasks(ctx context.Context, req *taskv1.RolloverOverdueTasksRequest) (*taskv1.RolloverOverdueTasksResponse, error) {
+	today, err := parseToday(req.Today, time.Now())
+	if err != nil {
 		return nil, err
 	}
-	if err := grpcerrors.ValidateLength(req.Content, "content", grpcerrors.MaxChecklistItemLength); err != nil {
+
+	tasks, err := s.service.RolloverOverdueTasks(ctx, today)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to roll over tasks")
+	}
+
+	return &taskv1.RolloverOverdueTasksResponse{
+		Tasks: TasksToProto(tasks),
+	}, nil
+}
+
+// GetTaskSettings returns the caller's task settings
+func (s *TaskServer) GetTaskSettings(ctx context.Context, req *taskv1.GetTaskSettingsRequest) (*taskv1.GetTaskSettingsResponse, error) {
+	settings, err := s.service.GetTaskSettings(ctx)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to get task settings")
+	}
+
+	return &taskv1.GetTaskSettingsResponse{
+		Settings: SettingsToProto(settings),
+	}, nil
+}
+
+// UpdateTaskSettings sets or disables the caller's auto-archive threshold
+// and, when rollover_to_inbox is set, the rollover preference
+func (s *TaskServer) UpdateTaskSettings(ctx context.Context, req *taskv1.UpdateTaskSettingsRequest) (*taskv1.UpdateTaskSettingsResponse, error) {
+	if req.AutoArchiveAfterDays < 0 || req.AutoArchiveAfterDays > domain.MaxAutoArchiveAfterDays {
+		return nil, status.Errorf(codes.InvalidArgument, "auto_archive_after_days must be between 0 and %d", domain.MaxAutoArchiveAfterDays)
+	}
+
+	update := domain.SettingsUpdate{RolloverToInbox: req.RolloverToInbox}
+	if req.AutoArchiveAfterDays > 0 {
+		days := int(req.AutoArchiveAfterDays)
+		update.AutoArchiveAfterDays = &days
+	}
+
+	settings, pending, err := s.service.UpdateTaskSettings(ctx, update, req.ValidateOnly)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to update task settings")
+	}
+
+	return &taskv1.UpdateTaskSettingsResponse{
+		Settings:                SettingsToProto(settings),
+		AutoArchivePendingCount: pending,
+	}, nil
+}
+
+// GetTaskStats returns task activity statistics for the caller
+func (s *TaskServer) GetTaskStats(ctx context.Context, req *taskv1.GetTaskStatsRequest) (*taskv1.GetTaskStatsResponse, error) {
+	days := int(req.Days)
+	if days < 0 || days > 365 {
+		return nil, status.Error(codes.InvalidArgument, "days must be between 0 and 365, where 0 uses the default of 30")
+	}
+	if days == 0 {
+		days = 30
+	}
+
+	bucket := domain.StatsBucketDay
+	if req.Bucket == taskv1.StatsBucket_STATS_BUCKET_WEEK {
+		bucket = domain.StatsBucketWeek
+	}
+
+	stats, err := s.service.GetTaskStats(ctx, days, bucket)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to get task stats")
+	}
+
+	activity := make([]*taskv1.ActivityBucket, len(stats.Activity))
+	for i, a := range stats.Activity {
+		activity[i] = &taskv1.ActivityBucket{
+			BucketStart:    a.BucketStart.Format("2006-01-02"),
+			CreatedCount:   int32(a.Created),
+			CompletedCount: int32(a.Completed),
+			ArchivedCount:  int32(a.Archived),
+		}
+	}
+	tagStats := make([]*taskv1.TagStats, len(stats.Tags))
+	for i, t := range stats.Tags {
+		tagStats[i] = &taskv1.TagStats{
+			TagId:          t.TagID.String(),
+			TagName:        t.TagName,
+			OpenCount:      int32(t.OpenCount),
+			CompletedCount: int32(t.CompletedCount),
+		}
+	}
+
+	return &taskv1.GetTaskStatsResponse{
+		Activity:    activity,
+		TagStats:    tagStats,
+		BacklogSize: int32(stats.BacklogSize),
+	}, nil
+}
+
+// GenerateWeeklyReview builds the caller's weekly review
+func (s *TaskServer) GenerateWeeklyReview(ctx context.Context, req *taskv1.GenerateWeeklyReviewRequest) (*taskv1.GenerateWeeklyReviewResponse, error) {
+	staleDays := int(req.StaleDays)
+	if staleDays < 0 || staleDays > 365 {
+		return nil, status.Error(codes.InvalidArgument, "stale_days must be between 0 and 365, where 0 uses the default of 14")
+	}
+	if staleDays == 0 {
+		staleDays = 14
+	}
+
+	review, err := s.service.GenerateWeeklyReview(ctx, staleDays)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to generate weekly review")
+	}
+
+	return &taskv1.GenerateWeeklyReviewResponse{
+		WeekStart:         timestamppb.New(review.WeekStart),
+		StaleTasks:        TasksToProto(review.StaleTasks),
+		UndatedTasks:      TasksToProto(review.UndatedTasks),
+		CompletedThisWeek: TasksToProto(review.CompletedThisWeek),
+		OverdueTasks:      TasksToProto(review.OverdueTasks),
+	}, nil
+}
+
+// ListStaleTasks lists the caller's open tasks left untouched for a while
+func (s *TaskServer) ListStaleTasks(ctx context.Context, req *taskv1.ListStaleTasksRequest) (*taskv1.ListStaleTasksResponse, error) {
+	thresholdDays := int(req.ThresholdDays)
+	if thresholdDays < 0 || thresholdDays > 3650 {
+		return nil, status.Error(codes.InvalidArgument, "threshold_days must be between 0 and 3650, where 0 uses the default of 30")
+	}
+	if thresholdDays == 0 {
+		thresholdDays = 30
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 50
+	}
+
+	tasks, err := s.service.ListStaleTasks(ctx, thresholdDays, pageSize)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to list stale tasks")
+	}
+
+	return &taskv1.ListStaleTasksResponse{
+		Tasks: TasksToProto(tasks),
+	}, nil
+}
+
+// MarkTaskViewed records that the caller opened a task
+func (s *TaskServer) MarkTaskViewed(ctx context.Context, req *taskv1.MarkTaskViewedRequest) (*taskv1.MarkTaskViewedResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task ID format")
+	}
+
+	if err := s.service.MarkTaskViewed(ctx, id); err != nil {
+		return nil, toGRPCError(err, "failed to mark task viewed")
+	}
+
+	return &taskv1.MarkTaskViewedResponse{}, nil
+}
+
+// SetTaskGeofence sets or removes the geofence of a task
+func (s *TaskServer) SetTaskGeofence(ctx context.Context, req *taskv1.SetTaskGeofenceRequest) (*taskv1.SetTaskGeofenceResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task ID format")
+	}
+
+	var geofence *domain.Geofence
+	if g := req.Geofence; g != nil {
+		geofence = &domain.Geofence{
+			Latitude:     g.Latitude,
+			Longitude:    g.Longitude,
+			RadiusMeters: int(g.RadiusMeters),
+			OnArrive:     g.OnArrive,
+			OnLeave:      g.OnLeave,
+		}
+	}
+
+	task, err := s.service.SetTaskGeofence(ctx, id, geofence)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to set task geofence")
+	}
+
+	return &taskv1.SetTaskGeofenceResponse{
+		Task: TaskToProto(task),
+	}, nil
+}
+
+// AddTagToTasks tags many tasks at once
+func (s *TaskServer) AddTagToTasks(ctx context.Context, req *taskv1.AddTagToTasksRequest) (*taskv1.AddTagToTasksResponse, error) {
+	ids, err := parseBulkTagRequest(req.TagName, req.TaskIds)
+	if err != nil {
 		return nil, err
 	}
 
-	item, err := s.service.AddChecklistItem(ctx, taskID, req.Content)
+	tasks, err := s.service.AddTagToTasks(ctx, req.TagName, ids)
 	if err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to add checklist item")
+		return nil, toGRPCError(err, "failed to add tag to tasks")
 	}
 
-	return &taskv1.AddChecklistItemResponse{Item: checklistItemToProto(item)}, nil
+	protoTasks := make([]*taskv1.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = TaskToProto(task)
+	}
+	return &taskv1.AddTagToTasksResponse{
+		Tasks: protoTasks,
+	}, nil
+}
+
+// RemoveTagFromTasks untags many tasks at once
+func (s *TaskServer) RemoveTagFromTasks(ctx context.Context, req *taskv1.RemoveTagFromTasksRequest) (*taskv1.RemoveTagFromTasksResponse, error) {
+	ids, err := parseBulkTagRequest(req.TagName, req.TaskIds)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.service.RemoveTagFromTasks(ctx, req.TagName, ids)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to remove tag from tasks")
+	}
+
+	protoTasks := make([]*taskv1.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = TaskToProto(task)
+	}
+	return &taskv1.RemoveTagFromTasksResponse{
+		Tasks: protoTasks,
+	}, nil
+}
+
+// parseBulkTagRequest validates the tag name and parses the task IDs of an
+// AddTagToTasks or RemoveTagFromTasks request
+func parseBulkTagRequest(tagName string, taskIDs []string) ([]uuid.UUID, error) {
+	if err := grpcerrors.ValidateTagName(tagName); err != nil {
+		return nil, err
+	}
+	if len(taskIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task_ids cannot be empty")
+	}
+	if len(taskIDs) > domain.MaxBulkTagSize {
+		return nil, status.Errorf(codes.InvalidArgument, "task_ids must contain at most %d entries", domain.MaxBulkTagSize)
+	}
+
+	ids := make([]uuid.UUID, 0, len(taskIDs))
+	for _, idStr := range taskIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid task ID format: %s", idStr)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// AddChecklistItem creates a checklist item for a task.
+func (s *TaskServer) AddChecklistItem(ctx context.Context, req *taskv1.AddChecklistItemRequest) (*taskv1.AddChecklistItemResponse, error) {
+	taskID, err := uuid.Parse(req.TaskId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task ID format")
+	}
+	if err := grpcerrors.ValidateNotEmpty(req.Content, "content"); err != nil {
+		return nil, err
+	}
+
+	item, count, err := s.service.AddChecklistItem(ctx, taskID, req.Content)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to add checklist item")
+	}
+
+	return &taskv1.AddChecklistItemResponse{
+		Item:                checklistItemToProto(item),
+		ChecklistTotalCount: int32(count),
+		MaxChecklistItems:   int32(s.service.ChecklistLimits().MaxItems),
+	}, nil
 }
 
 // UpdateChecklistItem updates checklist item content.
@@ -333,13 +1346,10 @@ func (s *TaskServer) UpdateChecklistItem(ctx context.Context, req *taskv1.Update
 	if err := grpcerrors.ValidateNotEmpty(req.Content, "content"); err != nil {
 		return nil, err
 	}
-	if err := grpcerrors.ValidateLength(req.Content, "content", grpcerrors.MaxChecklistItemLength); err != nil {
-		return nil, err
-	}
 
 	item, err := s.service.UpdateChecklistItemContent(ctx, itemID, req.Content)
 	if err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to update checklist item")
+		return nil, toGRPCError(err, "failed to update checklist item")
 	}
 
 	return &taskv1.UpdateChecklistItemResponse{Item: checklistItemToProto(item)}, nil
@@ -354,7 +1364,7 @@ func (s *TaskServer) SetChecklistItemCompleted(ctx context.Context, req *taskv1.
 
 	item, err := s.service.SetChecklistItemCompleted(ctx, itemID, req.Completed)
 	if err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to set checklist item completion")
+		return nil, toGRPCError(err, "failed to set checklist item completion")
 	}
 
 	return &taskv1.SetChecklistItemCompletedResponse{Item: checklistItemToProto(item)}, nil
@@ -368,7 +1378,7 @@ func (s *TaskServer) DeleteChecklistItem(ctx context.Context, req *taskv1.Delete
 	}
 
 	if err := s.service.DeleteChecklistItem(ctx, itemID); err != nil {
-		return nil, grpcerrors.ToGRPCError(err, "failed to delete checklist item")
+		return nil, toGRPCError(err, "failed to delete checklist item")
 	}
 
 	return &taskv1.DeleteChecklistItemResponse{}, nil
@@ -398,7 +1408,7 @@ func (s *TaskServer) ReorderChecklistItems(ctx context.Context, req *taskv1.Reor
 		if errors.Is(err, domain.ErrInvalidChecklistOrder) {
 			return nil, status.Error(codes.InvalidArgument, "item_ids must include all checklist item IDs exactly once")
 		}
-		return nil, grpcerrors.ToGRPCError(err, "failed to reorder checklist items")
+		return nil, toGRPCError(err, "failed to reorder checklist items")
 	}
 
 	protoItems := make([]*taskv1.ChecklistItem, len(items))
@@ -408,3 +1418,412 @@ func (s *TaskServer) ReorderChecklistItems(ctx context.Context, req *taskv1.Reor
 
 	return &taskv1.ReorderChecklistItemsResponse{Items: protoItems}, nil
 }
+
+// ListNoteRevisions lists previous versions of a task's notes
+func (s *TaskServer) ListNoteRevisions(ctx context.Context, req *taskv1.ListNoteRevisionsRequest) (*taskv1.ListNoteRevisionsResponse, error) {
+	taskID, err := uuid.Parse(req.TaskId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task ID format")
+	}
+
+	revisions, err := s.service.ListNoteRevisions(ctx, taskID)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to list note revisions")
+	}
+
+	protoRevisions := make([]*taskv1.NoteRevision, len(revisions))
+	for i := range revisions {
+		protoRevisions[i] = NoteRevisionToProto(&revisions[i])
+	}
+
+	return &taskv1.ListNoteRevisionsResponse{Revisions: protoRevisions}, nil
+}
+
+// RestoreNoteRevision replaces a task's notes with a previous version
+func (s *TaskServer) RestoreNoteRevision(ctx context.Context, req *taskv1.RestoreNoteRevisionRequest) (*taskv1.RestoreNoteRevisionResponse, error) {
+	taskID, err := uuid.Parse(req.TaskId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task ID format")
+	}
+	revisionID, err := uuid.Parse(req.RevisionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid revision ID format")
+	}
+
+	task, err := s.service.RestoreNoteRevision(ctx, taskID, revisionID)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to restore note revision")
+	}
+
+	return &taskv1.RestoreNoteRevisionResponse{
+		Task: TaskToProto(task),
+	}, nil
+}
+
+// ApplyMutations applies a batch of mutations queued by an offline client
+func (s *TaskServer) ApplyMutations(ctx context.Context, req *taskv1.ApplyMutationsRequest) (*taskv1.ApplyMutationsResponse, error) {
+	if len(req.Mutations) > domain.MaxMutationBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "mutations must contain at most %d entries", domain.MaxMutationBatchSize)
+	}
+
+	mutations := make([]application.Mutation, len(req.Mutations))
+	for i, protoMutation := range req.Mutations {
+		mutation, err := mutationFromProto(protoMutation)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "mutations[%d]: %s", i, status.Convert(err).Message())
+		}
+		mutations[i] = mutation
+	}
+
+	// Deletes that need the user's approval are held back together in one
+	// approval and the rest of the batch is applied
+	var deleteIDs []uuid.UUID
+	for _, mutation := range mutations {
+		if mutation.Kind == domain.MutationDelete {
+			deleteIDs = append(deleteIDs, mutation.TaskID)
+		}
+	}
+	holdDeletes := false
+	if len(deleteIDs) > 0 {
+		required, err := s.approver.RequiresDeleteApproval(ctx)
+		if err != nil {
+			return nil, grpcerrors.ToGRPCError(err, "failed to get approval settings")
+		}
+		holdDeletes = required
+	}
+
+	applied := mutations
+	if holdDeletes {
+		applied = slices.DeleteFunc(slices.Clone(mutations), func(mutation application.Mutation) bool {
+			return mutation.Kind == domain.MutationDelete
+		})
+	}
+
+	results, err := s.service.ApplyMutations(ctx, applied, req.ValidateOnly)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to apply mutations")
+	}
+
+	// The approval is only requested once the rest of the batch is in, so a
+	// rejected batch leaves no approval behind
+	var approvalID string
+	if holdDeletes && !req.ValidateOnly {
+		approval, err := s.approver.RequestDeletion(ctx, deleteIDs)
+		if err != nil {
+			return nil, grpcerrors.ToGRPCError(err, "failed to request approval")
+		}
+		approvalID = approval.ID.String()
+	}
+
+	resp := &taskv1.ApplyMutationsResponse{
+		Results: make([]*taskv1.TaskMutationResult, len(mutations)),
+	}
+	next := 0
+	for i, mutation := range mutations {
+		if holdDeletes && mutation.Kind == domain.MutationDelete {
+			resp.Results[i] = mutationResultToProto(domain.MutationResult{
+				ClientMutationID: mutation.ClientMutationID,
+				TaskID:           mutation.TaskID,
+				Conflict:         domain.ConflictPendingApproval,
+			})
+			resp.Results[i].ApprovalId = approvalID
+			resp.ConflictCount++
+			continue
+		}
+		result := results[next]
+		next++
+		resp.Results[i] = mutationResultToProto(result)
+		if !result.Applied() {
+			resp.ConflictCount++
+		}
+	}
+	return resp, nil
+}
+
+// TransferTasks hands the user's tasks to another user
+func (s *TaskServer) TransferTasks(ctx context.Context, req *taskv1.TransferTasksRequest) (*taskv1.TransferTasksResponse, error) {
+	if !s.allowTransfers {
+		return nil, status.Error(codes.FailedPrecondition, "transferring tasks is disabled on this server")
+	}
+	if err := grpcerrors.ValidateNotEmpty(req.ToUserId, "to_user_id"); err != nil {
+		return nil, err
+	}
+	if len(req.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids is required")
+	}
+	if len(req.Ids) > domain.MaxTransferSize {
+		return nil, status.Errorf(codes.InvalidArgument, "ids must contain at most %d entries", domain.MaxTransferSize)
+	}
+
+	ids := make([]uuid.UUID, len(req.Ids))
+	for i, idStr := range req.Ids {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid task ID format: %s", idStr)
+		}
+		ids[i] = id
+	}
+
+	result, err := s.service.TransferTasks(ctx, ids, req.ToUserId)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to transfer tasks")
+	}
+
+	taskIDs := make([]string, len(result.TaskIDs))
+	for i, id := range result.TaskIDs {
+		taskIDs[i] = id.String()
+	}
+	return &taskv1.TransferTasksResponse{
+		TaskIds:         taskIDs,
+		CreatedTagCount: int32(result.CreatedTags),
+	}, nil
+}
+
+// mutationFromProto validates a client mutation and converts it for the
+// service. Fields are validated like the matching single-task RPC.
+func mutationFromProto(m *taskv1.TaskMutation) (application.Mutation, error) {
+	mutation := application.Mutation{ClientMutationID: m.ClientMutationId}
+
+	switch op := m.Operation.(type) {
+	case *taskv1.TaskMutation_Create:
+		create := op.Create
+		id, err := uuid.Parse(create.Id)
+		if err != nil {
+			return mutation, status.Error(codes.InvalidArgument, "invalid task ID format")
+		}
+		if err := grpcerrors.ValidateNotEmpty(create.Title, "title"); err != nil {
+			return mutation, err
+		}
+		if err := grpcerrors.ValidateLength(create.Title, "title", grpcerrors.MaxTitleLength); err != nil {
+			return mutation, err
+		}
+		if err := grpcerrors.ValidateLength(create.Notes, "notes", grpcerrors.MaxNotesLength); err != nil {
+			return mutation, err
+		}
+		for i, content := range create.ChecklistItems {
+			fieldName := fmt.Sprintf("checklist_items[%d]", i)
+			if err := grpcerrors.ValidateNotEmpty(content, fieldName); err != nil {
+				return mutation, err
+			}
+		}
+		startDate, err := parseStartDateForCreate(create.StartDate)
+		if err != nil {
+			return mutation, err
+		}
+		deadline, err := parseDeadline(create.Deadline)
+		if err != nil {
+			return mutation, err
+		}
+		taskContext, err := parseContext(create.Context)
+		if err != nil {
+			return mutation, err
+		}
+
+		mutation.Kind = domain.MutationCreate
+		mutation.TaskID = id
+		mutation.Patch = application.TaskPatch{
+			Title:     &create.Title,
+			Notes:     &create.Notes,
+			TagNames:  create.TagNames,
+			StartDate: startDate,
+			Deadline:  deadline,
+			Context:   taskContext,
+		}
+		mutation.ChecklistItems = create.ChecklistItems
+	case *taskv1.TaskMutation_Update:
+		update := op.Update
+		id, err := uuid.Parse(update.Id)
+		if err != nil {
+			return mutation, status.Error(codes.InvalidArgument, "invalid task ID format")
+		}
+		if update.Title != nil {
+			if err := grpcerrors.ValidateNotEmpty(*update.Title, "title"); err != nil {
+				return mutation, err
+			}
+			if err := grpcerrors.ValidateLength(*update.Title, "title", grpcerrors.MaxTitleLength); err != nil {
+				return mutation, err
+			}
+		}
+		if update.Notes != nil {
+			if err := grpcerrors.ValidateLength(*update.Notes, "notes", grpcerrors.MaxNotesLength); err != nil {
+				return mutation, err
+			}
+		}
+		baseUpdatedAt, err := parseBaseUpdatedAt(update.BaseUpdatedAt)
+		if err != nil {
+			return mutation, err
+		}
+		startDate, err := parseStartDateForUpdate(update.StartDate)
+		if err != nil {
+			return mutation, err
+		}
+		deadline, err := parseDeadline(update.Deadline)
+		if err != nil {
+			return mutation, err
+		}
+		var taskContext string
+		if update.Context != nil {
+			if taskContext, err = parseContext(*update.Context); err != nil {
+				return mutation, err
+			}
+		}
+
+		mutation.Kind = domain.MutationUpdate
+		mutation.TaskID = id
+		mutation.BaseUpdatedAt = baseUpdatedAt
+		mutation.Patch = application.TaskPatch{
+			Title:        update.Title,
+			Notes:        update.Notes,
+			SetTagNames:  update.ReplaceTagNames,
+			TagNames:     update.TagNames,
+			SetStartDate: update.StartDate != nil,
+			StartDate:    startDate,
+			SetDeadline:  update.Deadline != nil,
+			Deadline:     deadline,
+			SetContext:   update.Context != nil,
+			Context:      taskContext,
+		}
+	case *taskv1.TaskMutation_Delete:
+		id, err := uuid.Parse(op.Delete.Id)
+		if err != nil {
+			return mutation, status.Error(codes.InvalidArgument, "invalid task ID format")
+		}
+		baseUpdatedAt, err := parseBaseUpdatedAt(op.Delete.BaseUpdatedAt)
+		if err != nil {
+			return mutation, err
+		}
+
+		mutation.Kind = domain.MutationDelete
+		mutation.TaskID = id
+		mutation.BaseUpdatedAt = baseUpdatedAt
+	default:
+		return mutation, status.Error(codes.InvalidArgument, "operation is required")
+	}
+	return mutation, nil
+}
+
+// toGRPCError maps checklist limit failures to status codes and defers
+// everything else to grpcerrors.ToGRPCError
+func toGRPCError(err error, defaultMsg string) error {
+	if errors.Is(err, domain.ErrTooManyChecklistItems) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, domain.ErrChecklistItemTooLong) || errors.Is(err, domain.ErrTransferToSelf) || errors.Is(err, domain.ErrInvalidGeofence) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, domain.ErrTransferConflict) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return grpcerrors.ToGRPCError(err, defaultMsg)
+}
+
+// parseBaseUpdatedAt converts the optional version a mutation is based on
+func parseBaseUpdatedAt(ts *timestamppb.Timestamp) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid base_updated_at")
+	}
+	baseUpdatedAt := ts.AsTime()
+	return &baseUpdatedAt, nil
+}
+
+func mutationResultToProto(result domain.MutationResult) *taskv1.TaskMutationResult {
+	protoResult := &taskv1.TaskMutationResult{
+		ClientMutationId: result.ClientMutationID,
+		TaskId:           result.TaskID.String(),
+		Applied:          result.Applied(),
+		Conflict:         mutationConflictToProto(result.Conflict),
+	}
+	if result.Task != nil {
+		protoResult.Task = TaskToProto(result.Task)
+	}
+	return protoResult
+}
+
+func changeToProto(change application.Change) *taskv1.WatchChangesResponse {
+	resp := &taskv1.WatchChangesResponse{
+		Resource:  changeResourceToProto(change.Resource),
+		Operation: changeOperationToProto(change.Operation),
+	}
+	if change.ID != uuid.Nil {
+		resp.Id = change.ID.String()
+	}
+	if change.TaskID != uuid.Nil {
+		resp.TaskId = change.TaskID.String()
+	}
+	switch {
+	case change.Task != nil:
+		resp.ResourceValue = &taskv1.WatchChangesResponse_Task{Task: TaskToProto(change.Task)}
+	case change.Tag != nil:
+		resp.ResourceValue = &taskv1.WatchChangesResponse_Tag{Tag: &tagv1.Tag{
+			Id:              change.Tag.ID.String(),
+			Name:            change.Tag.Name,
+			CreatedAt:       timestamppb.New(change.Tag.CreatedAt),
+			UpdatedAt:       timestamppb.New(change.Tag.UpdatedAt),
+			ClientRequestId: change.Tag.ClientRequestID,
+		}}
+	case change.ChecklistItem != nil:
+		resp.ResourceValue = &taskv1.WatchChangesResponse_ChecklistItem{ChecklistItem: checklistItemToProto(change.ChecklistItem)}
+	}
+	return resp
+}
+
+func changeResourceToProto(resource changefeed.Resource) taskv1.ChangeResource {
+	switch resource {
+	case changefeed.ResourceTask:
+		return taskv1.ChangeResource_CHANGE_RESOURCE_TASK
+	case changefeed.ResourceTag:
+		return taskv1.ChangeResource_CHANGE_RESOURCE_TAG
+	case changefeed.ResourceChecklistItem:
+		return taskv1.ChangeResource_CHANGE_RESOURCE_CHECKLIST_ITEM
+	default:
+		return taskv1.ChangeResource_CHANGE_RESOURCE_UNSPECIFIED
+	}
+}
+
+func changeOperationToProto(operation changefeed.Operation) taskv1.ChangeOperation {
+	switch operation {
+	case changefeed.OperationUpsert:
+		return taskv1.ChangeOperation_CHANGE_OPERATION_UPSERT
+	case changefeed.OperationDelete:
+		return taskv1.ChangeOperation_CHANGE_OPERATION_DELETE
+	case changefeed.OperationResync:
+		return taskv1.ChangeOperation_CHANGE_OPERATION_RESYNC
+	default:
+		return taskv1.ChangeOperation_CHANGE_OPERATION_UNSPECIFIED
+	}
+}
+
+func mutationConflictToProto(conflict domain.MutationConflict) taskv1.MutationConflict {
+	switch conflict {
+	case domain.ConflictAlreadyExists:
+		return taskv1.MutationConflict_MUTATION_CONFLICT_ALREADY_EXISTS
+	case domain.ConflictNotFound:
+		return taskv1.MutationConflict_MUTATION_CONFLICT_NOT_FOUND
+	case domain.ConflictChanged:
+		return taskv1.MutationConflict_MUTATION_CONFLICT_CHANGED
+	case domain.ConflictPendingApproval:
+		return taskv1.MutationConflict_MUTATION_CONFLICT_PENDING_APPROVAL
+	default:
+		return taskv1.MutationConflict_MUTATION_CONFLICT_UNSPECIFIED
+	}
+}
+
+// pageSizeSet reports whether a requested page size is usable; other values
+// count as omitted
+func pageSizeSet(pageSize int32) bool {
+	return pageSize > 0 && pageSize <= maxPageSize
+}
+
+// listPageSize returns the requested page size, else the user's default
+func listPageSize(requested int32, defaults authdomain.ListDefaults) int {
+	if pageSizeSet(requested) {
+		return int(requested)
+	}
+	if defaults.PageSize > 0 {
+		return defaults.PageSize
+	}
+	return defaultPageSize
+}
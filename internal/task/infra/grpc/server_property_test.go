@@ -4,8 +4,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/task/domain"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"pgregory.net/rapid"
 )
 
@@ -105,6 +108,67 @@ func TestUpdateTask_ValidationCondition_StartDatePresence(t *testing.T) {
 	}
 }
 
+func TestParseDateFilter_ErrorNamesField(t *testing.T) {
+	_, err := parseDateFilter(strPtr("2025/01/01"), "start_date_from")
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected INVALID_ARGUMENT, got: %v", err)
+	}
+	if st.Message() != "invalid start_date_from format: expected YYYY-MM-DD" {
+		t.Errorf("unexpected message: %q", st.Message())
+	}
+
+	date, err := parseDateFilter(nil, "start_date_to")
+	if err != nil || date != nil {
+		t.Fatalf("expected unset bound for nil input, got %v, %v", date, err)
+	}
+}
+
+func TestReadMask(t *testing.T) {
+	if _, err := parseReadMask(&fieldmaskpb.FieldMask{Paths: []string{"title", "checklist_items.content"}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("nested path: err = %v, want INVALID_ARGUMENT", err)
+	}
+
+	all, err := parseReadMask(nil)
+	if err != nil || all != nil {
+		t.Fatalf("parseReadMask(nil) = %v, %v; want every field", all, err)
+	}
+	if all.load() != (domain.LoadOptions{}) {
+		t.Errorf("empty mask skips %+v, want nothing", all.load())
+	}
+
+	mask, err := parseReadMask(&fieldmaskpb.FieldMask{Paths: []string{"title", "start_date", "checklist_total_count"}})
+	if err != nil {
+		t.Fatalf("parseReadMask() error = %v", err)
+	}
+	if want := (domain.LoadOptions{SkipNotes: true, SkipTags: true}); mask.load() != want {
+		t.Errorf("load() = %+v, want %+v", mask.load(), want)
+	}
+
+	startDate := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	task := &domain.Task{
+		ID:             uuid.New(),
+		OwnerID:        "owner",
+		Title:          "title",
+		Notes:          "notes",
+		TagIDs:         []uuid.UUID{uuid.New()},
+		StartDate:      &startDate,
+		ChecklistTotal: 3,
+		Pinned:         true,
+		CreatedAt:      startDate,
+	}
+	got := mask.apply(TaskToProto(task))
+	if got.Id != task.ID.String() || got.Title != "title" || got.GetStartDate() != "2026-03-10" || got.ChecklistTotalCount != 3 {
+		t.Errorf("masked task lost selected fields: %v", got)
+	}
+	if got.ResourceName != "users/owner/tasks/"+task.ID.String() {
+		t.Errorf("resource_name = %q", got.ResourceName)
+	}
+	if got.Notes != "" || len(got.TagIds) != 0 || got.Pinned || got.CreatedAt != nil {
+		t.Errorf("masked task kept other fields: %v", got)
+	}
+}
+
 // Helper function for test
 func strPtr(s string) *string {
 	return &s
@@ -0,0 +1,623 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	taskv2 "github.com/slips-ai/slips-core/gen/go/task/v2"
+	authdomain "github.com/slips-ai/slips-core/internal/auth/domain"
+	"github.com/slips-ai/slips-core/internal/task/application"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"github.com/slips-ai/slips-core/pkg/resourcename"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// TaskServerV2 implements the task.v2 TaskService gRPC server. It adapts the
+// v2 surface to the same application service that backs TaskServer.
+type TaskServerV2 struct {
+	taskv2.UnimplementedTaskServiceServer
+	service  *application.Service
+	approver DeletionApprover
+}
+
+// NewTaskServerV2 creates a new v2 task gRPC server
+func NewTaskServerV2(service *application.Service, approver DeletionApprover) *TaskServerV2 {
+	return &TaskServerV2{
+		service:  service,
+		approver: approver,
+	}
+}
+
+// CreateTask creates a new task
+func (s *TaskServerV2) CreateTask(ctx context.Context, req *taskv2.CreateTaskRequest) (*taskv2.CreateTaskResponse, error) {
+	if req.Task == nil {
+		return nil, status.Error(codes.InvalidArgument, "task is required")
+	}
+	if err := validateTitleAndNotes(req.Task); err != nil {
+		return nil, err
+	}
+	for i, content := range req.ChecklistItems {
+		fieldName := fmt.Sprintf("checklist_items[%d]", i)
+		if err := grpcerrors.ValidateNotEmpty(content, fieldName); err != nil {
+			return nil, err
+		}
+	}
+
+	startDate, err := startDateFromV2(req.Task)
+	if err != nil {
+		return nil, err
+	}
+	deadline, err := dateFromV2(req.Task.Deadline, "task.deadline")
+	if err != nil {
+		return nil, err
+	}
+	taskContext, err := parseContext(req.Task.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.service.CreateTask(ctx, req.Task.Title, req.Task.Notes, req.TagNames, startDate, deadline, taskContext, req.ChecklistItems, "")
+	if err != nil {
+		return nil, toGRPCError(err, "failed to create task")
+	}
+
+	return &taskv2.CreateTaskResponse{Task: TaskToProtoV2(task)}, nil
+}
+
+// GetTask retrieves a task by name
+func (s *TaskServerV2) GetTask(ctx context.Context, req *taskv2.GetTaskRequest) (*taskv2.GetTaskResponse, error) {
+	id, err := parseTaskName(ctx, req.Name, "name")
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.service.ViewTask(ctx, id, domain.LoadOptions{})
+	if err != nil {
+		return nil, toGRPCError(err, "failed to get task")
+	}
+
+	return &taskv2.GetTaskResponse{Task: TaskToProtoV2(task)}, nil
+}
+
+// UpdateTask updates the task fields selected by the update mask
+func (s *TaskServerV2) UpdateTask(ctx context.Context, req *taskv2.UpdateTaskRequest) (*taskv2.UpdateTaskResponse, error) {
+	if req.Task == nil {
+		return nil, status.Error(codes.InvalidArgument, "task is required")
+	}
+	id, err := parseTaskName(ctx, req.Task.Name, "task.name")
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := taskPatchFromV2(req)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.service.PatchTask(ctx, id, patch)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to update task")
+	}
+
+	return &taskv2.UpdateTaskResponse{Task: TaskToProtoV2(task)}, nil
+}
+
+// DeleteTask deletes a task
+func (s *TaskServerV2) DeleteTask(ctx context.Context, req *taskv2.DeleteTaskRequest) (*taskv2.DeleteTaskResponse, error) {
+	id, err := parseTaskName(ctx, req.Name, "name")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ValidateOnly {
+		task, err := s.service.PreviewDeleteTask(ctx, id)
+		if err != nil {
+			return nil, toGRPCError(err, "failed to get task")
+		}
+		resp := &taskv2.DeleteTaskResponse{}
+		if task != nil {
+			resp.Task = TaskToProtoV2(task)
+		}
+		return resp, nil
+	}
+
+	approvalID, err := requestDeletion(ctx, s.approver, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	if approvalID != "" {
+		return &taskv2.DeleteTaskResponse{ApprovalId: approvalID}, nil
+	}
+
+	if err := s.service.DeleteTask(ctx, id); err != nil {
+		return nil, toGRPCError(err, "failed to delete task")
+	}
+
+	return &taskv2.DeleteTaskResponse{}, nil
+}
+
+// ListTasks lists tasks
+func (s *TaskServerV2) ListTasks(ctx context.Context, req *taskv2.ListTasksRequest) (*taskv2.ListTasksResponse, error) {
+	// Reject page_token if provided (not yet implemented)
+	if req.PageToken != "" {
+		return nil, status.Errorf(codes.Unimplemented, "page_token is not supported yet")
+	}
+
+	// The user's defaults fill in what the request leaves out
+	var defaults authdomain.ListDefaults
+	if !pageSizeSet(req.PageSize) || req.TagMatchMode == taskv2.TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED {
+		defaults = s.service.ListDefaults(ctx)
+	}
+	pageSize := listPageSize(req.PageSize, defaults)
+
+	tagIDs := make([]uuid.UUID, 0, len(req.Tags))
+	for i, name := range req.Tags {
+		tagID, err := parseTagName(ctx, name, fmt.Sprintf("tags[%d]", i))
+		if err != nil {
+			return nil, err
+		}
+		tagIDs = append(tagIDs, tagID)
+	}
+
+	contexts, err := parseContextFilter(req.Contexts)
+	if err != nil {
+		return nil, err
+	}
+
+	tagMatchAll := req.TagMatchMode == taskv2.TagMatchMode_TAG_MATCH_MODE_ALL
+	if req.TagMatchMode == taskv2.TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED {
+		tagMatchAll = defaults.TagMatchAll
+	}
+
+	opts := domain.ListOptions{
+		TagMatchAll: tagMatchAll,
+		Contexts:    contexts,
+	}
+	switch req.ArchiveFilter {
+	case taskv2.ArchiveFilter_ARCHIVE_FILTER_INCLUDE:
+		opts.IncludeArchived = true
+	case taskv2.ArchiveFilter_ARCHIVE_FILTER_ONLY:
+		opts.ArchivedOnly = true
+	}
+	switch req.Schedule {
+	case taskv2.Schedule_SCHEDULE_INBOX:
+		opts.InboxOnly = true
+	case taskv2.Schedule_SCHEDULE_DATED:
+		// Any start date: the earliest representable date is a lower bound for all of them
+		from := time.Time{}
+		opts.StartDateFrom = &from
+	}
+
+	result, err := s.service.ListTasks(ctx, tagIDs, pageSize, 0, opts, false)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to list tasks")
+	}
+
+	return &taskv2.ListTasksResponse{
+		Tasks:     TasksToProtoV2(result.Tasks),
+		TotalSize: int32(result.TotalSize),
+	}, nil
+}
+
+// ArchiveTask archives a task
+func (s *TaskServerV2) ArchiveTask(ctx context.Context, req *taskv2.ArchiveTaskRequest) (*taskv2.ArchiveTaskResponse, error) {
+	id, err := parseTaskName(ctx, req.Name, "name")
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.service.ArchiveTask(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to archive task")
+	}
+
+	return &taskv2.ArchiveTaskResponse{Task: TaskToProtoV2(task)}, nil
+}
+
+// UnarchiveTask unarchives a task
+func (s *TaskServerV2) UnarchiveTask(ctx context.Context, req *taskv2.UnarchiveTaskRequest) (*taskv2.UnarchiveTaskResponse, error) {
+	id, err := parseTaskName(ctx, req.Name, "name")
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.service.UnarchiveTask(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to unarchive task")
+	}
+
+	return &taskv2.UnarchiveTaskResponse{Task: TaskToProtoV2(task)}, nil
+}
+
+// CompleteTask marks a task as completed
+func (s *TaskServerV2) CompleteTask(ctx context.Context, req *taskv2.CompleteTaskRequest) (*taskv2.CompleteTaskResponse, error) {
+	id, err := parseTaskName(ctx, req.Name, "name")
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.service.CompleteTask(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to complete task")
+	}
+
+	return &taskv2.CompleteTaskResponse{Task: TaskToProtoV2(task)}, nil
+}
+
+// ReopenTask marks a completed task as open again
+func (s *TaskServerV2) ReopenTask(ctx context.Context, req *taskv2.ReopenTaskRequest) (*taskv2.ReopenTaskResponse, error) {
+	id, err := parseTaskName(ctx, req.Name, "name")
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.service.ReopenTask(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to reopen task")
+	}
+
+	return &taskv2.ReopenTaskResponse{Task: TaskToProtoV2(task)}, nil
+}
+
+// CreateChecklistItem appends a checklist item to a task
+func (s *TaskServerV2) CreateChecklistItem(ctx context.Context, req *taskv2.CreateChecklistItemRequest) (*taskv2.CreateChecklistItemResponse, error) {
+	taskID, err := parseTaskName(ctx, req.Parent, "parent")
+	if err != nil {
+		return nil, err
+	}
+	if req.ChecklistItem == nil {
+		return nil, status.Error(codes.InvalidArgument, "checklist_item is required")
+	}
+	if err := validateChecklistContent(req.ChecklistItem.Content); err != nil {
+		return nil, err
+	}
+
+	item, _, err := s.service.AddChecklistItem(ctx, taskID, req.ChecklistItem.Content)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to add checklist item")
+	}
+
+	if req.ChecklistItem.Completed {
+		item, err = s.service.SetChecklistItemCompleted(ctx, item.ID, true)
+		if err != nil {
+			return nil, toGRPCError(err, "failed to set checklist item completion")
+		}
+	}
+
+	return &taskv2.CreateChecklistItemResponse{ChecklistItem: checklistItemToProtoV2(callerID(ctx), item)}, nil
+}
+
+// UpdateChecklistItem updates the checklist item fields selected by the update mask
+func (s *TaskServerV2) UpdateChecklistItem(ctx context.Context, req *taskv2.UpdateChecklistItemRequest) (*taskv2.UpdateChecklistItemResponse, error) {
+	if req.ChecklistItem == nil {
+		return nil, status.Error(codes.InvalidArgument, "checklist_item is required")
+	}
+	_, itemID, err := parseChecklistItemName(ctx, req.ChecklistItem.Name, "checklist_item.name")
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := maskPaths(req.UpdateMask, "content", "completed")
+	if err != nil {
+		return nil, err
+	}
+
+	var item *domain.ChecklistItem
+	if paths["content"] {
+		if err := validateChecklistContent(req.ChecklistItem.Content); err != nil {
+			return nil, err
+		}
+		item, err = s.service.UpdateChecklistItemContent(ctx, itemID, req.ChecklistItem.Content)
+		if err != nil {
+			return nil, toGRPCError(err, "failed to update checklist item")
+		}
+	}
+	if paths["completed"] {
+		item, err = s.service.SetChecklistItemCompleted(ctx, itemID, req.ChecklistItem.Completed)
+		if err != nil {
+			return nil, toGRPCError(err, "failed to set checklist item completion")
+		}
+	}
+
+	return &taskv2.UpdateChecklistItemResponse{ChecklistItem: checklistItemToProtoV2(callerID(ctx), item)}, nil
+}
+
+// DeleteChecklistItem deletes a checklist item
+func (s *TaskServerV2) DeleteChecklistItem(ctx context.Context, req *taskv2.DeleteChecklistItemRequest) (*taskv2.DeleteChecklistItemResponse, error) {
+	_, itemID, err := parseChecklistItemName(ctx, req.Name, "name")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.service.DeleteChecklistItem(ctx, itemID); err != nil {
+		return nil, toGRPCError(err, "failed to delete checklist item")
+	}
+
+	return &taskv2.DeleteChecklistItemResponse{}, nil
+}
+
+// TaskToProtoV2 converts a domain Task to a v2 proto Task
+func TaskToProtoV2(task *domain.Task) *taskv2.Task {
+	tags := make([]string, len(task.TagIDs))
+	for i, tagID := range task.TagIDs {
+		tags[i] = resourcename.Tag{User: task.OwnerID, Tag: tagID}.String()
+	}
+
+	checklistItems := make([]*taskv2.ChecklistItem, len(task.Checklist))
+	for i := range task.Checklist {
+		checklistItems[i] = checklistItemToProtoV2(task.OwnerID, &task.Checklist[i])
+	}
+
+	protoTask := &taskv2.Task{
+		Name:               resourcename.Task{User: task.OwnerID, Task: task.ID}.String(),
+		Title:              task.Title,
+		Notes:              task.Notes,
+		Tags:               tags,
+		Schedule:           taskv2.Schedule_SCHEDULE_INBOX,
+		Pinned:             task.Pinned,
+		State:              taskv2.TaskState_TASK_STATE_OPEN,
+		Archived:           task.IsArchived(),
+		ChecklistItems:     checklistItems,
+		CreateTime:         timestamppb.New(task.CreatedAt),
+		UpdateTime:         timestamppb.New(task.UpdatedAt),
+		Context:            task.Context,
+		New:                task.IsNew(),
+		UpdatedSinceViewed: task.UpdatedSinceViewed(),
+	}
+
+	checklistCompleted, checklistTotal := task.ChecklistProgress()
+	protoTask.ChecklistTotalCount = int32(checklistTotal)
+	protoTask.ChecklistCompletedCount = int32(checklistCompleted)
+
+	if task.StartDate != nil {
+		protoTask.Schedule = taskv2.Schedule_SCHEDULE_DATED
+		protoTask.StartDate = dateToV2(*task.StartDate)
+	}
+
+	if task.Deadline != nil {
+		protoTask.Deadline = dateToV2(*task.Deadline)
+		if days := task.DaysRemaining(time.Now()); days != nil {
+			protoTask.DaysRemaining = wrapperspb.Int32(int32(*days))
+		}
+	}
+
+	if task.CompletedAt != nil {
+		protoTask.State = taskv2.TaskState_TASK_STATE_COMPLETED
+		protoTask.CompleteTime = timestamppb.New(*task.CompletedAt)
+	}
+
+	if task.ArchivedAt != nil {
+		protoTask.ArchiveTime = timestamppb.New(*task.ArchivedAt)
+	}
+
+	if task.LastViewedAt != nil {
+		protoTask.ViewTime = timestamppb.New(*task.LastViewedAt)
+	}
+
+	return protoTask
+}
+
+// TasksToProtoV2 converts a slice of domain Tasks to v2 proto Tasks
+func TasksToProtoV2(tasks []*domain.Task) []*taskv2.Task {
+	protoTasks := make([]*taskv2.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = TaskToProtoV2(task)
+	}
+	return protoTasks
+}
+
+func checklistItemToProtoV2(ownerID string, item *domain.ChecklistItem) *taskv2.ChecklistItem {
+	return &taskv2.ChecklistItem{
+		Name:       resourcename.ChecklistItem{User: ownerID, Task: item.TaskID, Item: item.ID}.String(),
+		Content:    item.Content,
+		Completed:  item.Completed,
+		SortOrder:  item.SortOrder,
+		CreateTime: timestamppb.New(item.CreatedAt),
+		UpdateTime: timestamppb.New(item.UpdatedAt),
+	}
+}
+
+// taskPatchFromV2 maps an UpdateTaskRequest and its update mask to a
+// TaskPatch, validating only the selected fields
+func taskPatchFromV2(req *taskv2.UpdateTaskRequest) (application.TaskPatch, error) {
+	var patch application.TaskPatch
+
+	paths, err := maskPaths(req.UpdateMask, "title", "notes", "schedule", "start_date", "deadline", "context", "tag_names")
+	if err != nil {
+		return patch, err
+	}
+
+	if paths["title"] {
+		if err := grpcerrors.ValidateNotEmpty(req.Task.Title, "task.title"); err != nil {
+			return patch, err
+		}
+		if err := grpcerrors.ValidateLength(req.Task.Title, "task.title", grpcerrors.MaxTitleLength); err != nil {
+			return patch, err
+		}
+		patch.Title = &req.Task.Title
+	}
+	if paths["notes"] {
+		if err := grpcerrors.ValidateLength(req.Task.Notes, "task.notes", grpcerrors.MaxNotesLength); err != nil {
+			return patch, err
+		}
+		patch.Notes = &req.Task.Notes
+	}
+	if paths["tag_names"] {
+		patch.SetTagNames = true
+		patch.TagNames = req.TagNames
+	}
+	// schedule and start_date describe the same column, so either path
+	// applies both of them
+	if paths["schedule"] || paths["start_date"] {
+		startDate, err := startDateFromV2(req.Task)
+		if err != nil {
+			return patch, err
+		}
+		patch.SetStartDate = true
+		patch.StartDate = startDate
+	}
+	if paths["deadline"] {
+		deadline, err := dateFromV2(req.Task.Deadline, "task.deadline")
+		if err != nil {
+			return patch, err
+		}
+		patch.SetDeadline = true
+		patch.Deadline = deadline
+	}
+	if paths["context"] {
+		taskContext, err := parseContext(req.Task.Context)
+		if err != nil {
+			return patch, err
+		}
+		patch.SetContext = true
+		patch.Context = taskContext
+	}
+
+	return patch, nil
+}
+
+// maskPaths validates an update mask against the supported paths and
+// returns the selected ones. An empty mask is rejected.
+func maskPaths(mask *fieldmaskpb.FieldMask, supported ...string) (map[string]bool, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask must not be empty")
+	}
+
+	allowed := make(map[string]bool, len(supported))
+	for _, path := range supported {
+		allowed[path] = true
+	}
+
+	paths := make(map[string]bool, len(mask.Paths))
+	for _, path := range mask.Paths {
+		if !allowed[path] {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update_mask path %q", path)
+		}
+		paths[path] = true
+	}
+	return paths, nil
+}
+
+// startDateFromV2 resolves the schedule and start_date of task to a start
+// date. nil means inbox.
+func startDateFromV2(task *taskv2.Task) (*time.Time, error) {
+	switch task.Schedule {
+	case taskv2.Schedule_SCHEDULE_UNSPECIFIED, taskv2.Schedule_SCHEDULE_INBOX:
+		if task.StartDate != nil {
+			return nil, status.Error(codes.InvalidArgument, "task.start_date requires schedule SCHEDULE_DATED")
+		}
+		return nil, nil
+	case taskv2.Schedule_SCHEDULE_DATED:
+		if task.StartDate == nil {
+			return nil, status.Error(codes.InvalidArgument, "task.start_date is required for schedule SCHEDULE_DATED")
+		}
+		return dateFromV2(task.StartDate, "task.start_date")
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown task.schedule %d", task.Schedule)
+	}
+}
+
+// dateFromV2 converts an optional proto Date to a UTC midnight time.
+// nil means the date is not set.
+func dateFromV2(date *taskv2.Date, fieldName string) (*time.Time, error) {
+	if date == nil {
+		return nil, nil
+	}
+
+	parsed := time.Date(int(date.Year), time.Month(date.Month), int(date.Day), 0, 0, 0, 0, time.UTC)
+	// time.Date normalizes out-of-range values, so a round trip detects them
+	if date.Year < 1 || parsed.Year() != int(date.Year) || parsed.Month() != time.Month(date.Month) || parsed.Day() != int(date.Day) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %04d-%02d-%02d is not a calendar date", fieldName, date.Year, date.Month, date.Day)
+	}
+
+	return &parsed, nil
+}
+
+func dateToV2(t time.Time) *taskv2.Date {
+	year, month, day := t.Date()
+	return &taskv2.Date{Year: int32(year), Month: int32(month), Day: int32(day)}
+}
+
+// parseTaskName parses a users/{user}/tasks/{task} resource name of one of
+// the caller's tasks
+func parseTaskName(ctx context.Context, name, fieldName string) (uuid.UUID, error) {
+	parsed, err := resourcename.ParseTask(name)
+	if err != nil {
+		return uuid.Nil, invalidResourceName(fieldName, err)
+	}
+	if err := checkNameOwner(ctx, parsed.User, fieldName); err != nil {
+		return uuid.Nil, err
+	}
+	return parsed.Task, nil
+}
+
+// parseChecklistItemName parses a
+// users/{user}/tasks/{task}/checklistItems/{item} resource name of an item
+// of one of the caller's tasks
+func parseChecklistItemName(ctx context.Context, name, fieldName string) (taskID, itemID uuid.UUID, err error) {
+	parsed, err := resourcename.ParseChecklistItem(name)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, invalidResourceName(fieldName, err)
+	}
+	if err := checkNameOwner(ctx, parsed.User, fieldName); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return parsed.Task, parsed.Item, nil
+}
+
+// parseTagName parses a users/{user}/tags/{tag} resource name of one of the
+// caller's tags
+func parseTagName(ctx context.Context, name, fieldName string) (uuid.UUID, error) {
+	parsed, err := resourcename.ParseTag(name)
+	if err != nil {
+		return uuid.Nil, invalidResourceName(fieldName, err)
+	}
+	if err := checkNameOwner(ctx, parsed.User, fieldName); err != nil {
+		return uuid.Nil, err
+	}
+	return parsed.Tag, nil
+}
+
+func invalidResourceName(fieldName string, err error) error {
+	return status.Errorf(codes.InvalidArgument, "invalid %s: %v", fieldName, err)
+}
+
+// checkNameOwner rejects names scoped to a user other than the caller with
+// NotFound, as the resources of other users are reported everywhere. A
+// missing caller is left for the service to report.
+func checkNameOwner(ctx context.Context, user, fieldName string) error {
+	userID, err := auth.GetUserID(ctx)
+	if err != nil || resourcename.OwnedBy(user, userID) {
+		return nil
+	}
+	return status.Errorf(codes.NotFound, "%s not found", fieldName)
+}
+
+// callerID returns the authenticated caller's user ID, or "" when there is
+// none, for naming resources returned to them
+func callerID(ctx context.Context) string {
+	userID, _ := auth.GetUserID(ctx)
+	return userID
+}
+
+func validateTitleAndNotes(task *taskv2.Task) error {
+	if err := grpcerrors.ValidateNotEmpty(task.Title, "task.title"); err != nil {
+		return err
+	}
+	if err := grpcerrors.ValidateLength(task.Title, "task.title", grpcerrors.MaxTitleLength); err != nil {
+		return err
+	}
+	return grpcerrors.ValidateLength(task.Notes, "task.notes", grpcerrors.MaxNotesLength)
+}
+
+func validateChecklistContent(content string) error {
+	return grpcerrors.ValidateNotEmpty(content, "checklist_item.content")
+}
@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	taskv2 "github.com/slips-ai/slips-core/gen/go/task/v2"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestParseResourceNames(t *testing.T) {
+	ctx := auth.WithUserID(context.Background(), "user-1")
+	taskID, itemID := uuid.New(), uuid.New()
+
+	for _, name := range []string{
+		"users/user-1/tasks/" + taskID.String(),
+		"users/-/tasks/" + taskID.String(),
+		"tasks/" + taskID.String(),
+	} {
+		got, err := parseTaskName(ctx, name, "name")
+		if err != nil || got != taskID {
+			t.Fatalf("parseTaskName(%q) = %v, %v; want %v", name, got, err, taskID)
+		}
+	}
+
+	gotTask, gotItem, err := parseChecklistItemName(ctx, "users/user-1/tasks/"+taskID.String()+"/checklistItems/"+itemID.String(), "name")
+	if err != nil || gotTask != taskID || gotItem != itemID {
+		t.Fatalf("parseChecklistItemName() = %v, %v, %v", gotTask, gotItem, err)
+	}
+
+	for _, name := range []string{
+		"",
+		taskID.String(),
+		"tags/" + taskID.String(),
+		"tasks/not-a-uuid",
+		"tasks/" + taskID.String() + "/checklistItems/" + itemID.String(),
+	} {
+		_, err := parseTaskName(ctx, name, "name")
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("parseTaskName(%q) error = %v, want InvalidArgument", name, err)
+		}
+	}
+
+	if _, err := parseTagName(ctx, "users/user-2/tags/"+taskID.String(), "tags[0]"); status.Code(err) != codes.NotFound {
+		t.Errorf("parseTagName() of another user's tag error = %v, want NotFound", err)
+	}
+}
+
+func TestDateFromV2_RejectsNonCalendarDates(t *testing.T) {
+	got, err := dateFromV2(&taskv2.Date{Year: 2024, Month: 2, Day: 29}, "task.deadline")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for _, date := range []*taskv2.Date{
+		{Year: 2025, Month: 2, Day: 29},
+		{Year: 2025, Month: 13, Day: 1},
+		{Year: 2025, Month: 1, Day: 0},
+		{},
+	} {
+		if _, err := dateFromV2(date, "task.deadline"); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("dateFromV2(%v) error = %v, want InvalidArgument", date, err)
+		}
+	}
+}
+
+func TestTaskPatchFromV2_AppliesOnlyMaskedFields(t *testing.T) {
+	req := &taskv2.UpdateTaskRequest{
+		Task: &taskv2.Task{
+			Title:     "",
+			Notes:     "new notes",
+			Schedule:  taskv2.Schedule_SCHEDULE_DATED,
+			StartDate: &taskv2.Date{Year: 2025, Month: 6, Day: 1},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"notes", "start_date"}},
+	}
+
+	patch, err := taskPatchFromV2(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if patch.Title != nil {
+		t.Errorf("title is not masked but was patched to %q", *patch.Title)
+	}
+	if patch.Notes == nil || *patch.Notes != "new notes" {
+		t.Errorf("notes = %v, want %q", patch.Notes, "new notes")
+	}
+	if !patch.SetStartDate || patch.StartDate == nil || patch.StartDate.Format("2006-01-02") != "2025-06-01" {
+		t.Errorf("start date = %v (set %v), want 2025-06-01", patch.StartDate, patch.SetStartDate)
+	}
+	if patch.SetTagNames || patch.SetDeadline {
+		t.Errorf("unmasked fields were patched: %+v", patch)
+	}
+
+	for _, paths := range [][]string{nil, {"pinned"}} {
+		req.UpdateMask = &fieldmaskpb.FieldMask{Paths: paths}
+		if _, err := taskPatchFromV2(req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("mask %v: error = %v, want InvalidArgument", paths, err)
+		}
+	}
+}
+
+func TestTaskToProtoV2_Schedule(t *testing.T) {
+	task := domain.NewTask("title", "", "owner", nil)
+	if got := TaskToProtoV2(task); got.Schedule != taskv2.Schedule_SCHEDULE_INBOX || got.StartDate != nil {
+		t.Errorf("inbox task: schedule %v, start date %v", got.Schedule, got.StartDate)
+	}
+
+	start := time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)
+	task.SetStartDate(&start)
+	task.CompletedAt = &start
+	got := TaskToProtoV2(task)
+	if got.Schedule != taskv2.Schedule_SCHEDULE_DATED || got.StartDate.GetDay() != 9 {
+		t.Errorf("dated task: schedule %v, start date %v", got.Schedule, got.StartDate)
+	}
+	if got.State != taskv2.TaskState_TASK_STATE_COMPLETED || got.CompleteTime == nil {
+		t.Errorf("completed task: state %v, complete time %v", got.State, got.CompleteTime)
+	}
+	if got.Name != "users/owner/tasks/"+task.ID.String() {
+		t.Errorf("name = %q", got.Name)
+	}
+}
@@ -0,0 +1,219 @@
+// Package http serves polling triggers over plain HTTP, for automation
+// tools such as Zapier and IFTTT that cannot call gRPC.
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/slips-ai/slips-core/internal/task/application"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+)
+
+// NextCursorHeader carries the cursor of the next, older page of events; it
+// is absent after the last page
+const NextCursorHeader = "X-Slips-Next-Cursor"
+
+// triggers maps the path name of each trigger to its kind
+var triggers = map[string]domain.TriggerKind{
+	"new_task":       domain.TriggerTaskCreated,
+	"task_completed": domain.TriggerTaskCompleted,
+	"tag_added":      domain.TriggerTagAdded,
+}
+
+// TriggerHandler serves GET /v1/triggers/{trigger}
+type TriggerHandler struct {
+	service *application.Service
+	tokens  auth.MCPTokenValidator
+	logger  *slog.Logger
+}
+
+// NewTriggerHandler creates a trigger handler that authenticates callers
+// with MCP tokens
+func NewTriggerHandler(service *application.Service, tokens auth.MCPTokenValidator, logger *slog.Logger) http.Handler {
+	h := &TriggerHandler{
+		service: service,
+		tokens:  tokens,
+		logger:  logger,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/triggers/{trigger}", h.list)
+	return mux
+}
+
+// list responds with a page of trigger events, newest first, as a JSON array
+func (h *TriggerHandler) list(w http.ResponseWriter, r *http.Request) {
+	kind, ok := triggers[r.PathValue("trigger")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "unknown trigger")
+		return
+	}
+
+	token, err := auth.ExtractMCPToken(r.Header.Get("Authorization"))
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "missing or malformed MCP token")
+		return
+	}
+	ctx := r.Context()
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ctx = auth.WithSourceIP(ctx, host)
+	}
+	info, err := h.tokens.ValidateToken(ctx, token)
+	if err != nil {
+		var blocked *auth.MCPTokenBlockedError
+		if errors.As(err, &blocked) {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(blocked.RetryAfter.Seconds()))))
+			writeError(w, http.StatusTooManyRequests, "too many failed MCP token attempts")
+			return
+		}
+		if !errors.Is(err, auth.ErrMCPTokenRejected) {
+			h.logger.ErrorContext(ctx, "failed to validate MCP token", "error", err)
+			writeError(w, http.StatusInternalServerError, "failed to validate MCP token")
+			return
+		}
+		writeError(w, http.StatusUnauthorized, "invalid MCP token")
+		return
+	}
+	clientID := r.Header.Get(auth.ClientIDHeader)
+	if utf8.RuneCountInString(clientID) > auth.MaxClientIDLength {
+		writeError(w, http.StatusBadRequest, auth.ClientIDHeader+" must be at most "+strconv.Itoa(auth.MaxClientIDLength)+" characters")
+		return
+	}
+	ctx = auth.WithPrincipal(ctx, &auth.Principal{
+		UserID:      info.UserID,
+		Credential:  auth.CredentialMCPToken,
+		Scopes:      auth.MCPTokenScopes,
+		ClientID:    clientID,
+		TokenID:     info.TokenID,
+		TokenName:   info.Name,
+		TokenLimits: info.Limits,
+	})
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			writeError(w, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+	}
+
+	events, next, err := h.service.ListTriggerEvents(ctx, kind, r.URL.Query().Get("cursor"), limit)
+	switch {
+	case errors.Is(err, domain.ErrInvalidTriggerCursor):
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	case err != nil:
+		h.logger.ErrorContext(ctx, "failed to list trigger events", "trigger", r.PathValue("trigger"), "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to list events")
+		return
+	}
+
+	items := make([]eventJSON, len(events))
+	for i, event := range events {
+		items[i] = eventToJSON(event)
+	}
+	if next != "" {
+		w.Header().Set(NextCursorHeader, next)
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+// eventJSON is a trigger event as polled by automation tools. ID is the
+// deduplication key; Meta repeats it in the shape IFTTT expects.
+type eventJSON struct {
+	ID         string    `json:"id"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Meta       metaJSON  `json:"meta"`
+	Task       taskJSON  `json:"task"`
+	Tag        *tagJSON  `json:"tag,omitempty"`
+}
+
+type metaJSON struct {
+	ID        string `json:"id"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+type taskJSON struct {
+	ID          string          `json:"id"`
+	Title       string          `json:"title"`
+	Notes       string          `json:"notes"`
+	TagIDs      []string        `json:"tag_ids"`
+	Checklist   []checklistJSON `json:"checklist"`
+	StartDate   string          `json:"start_date,omitempty"`
+	Deadline    string          `json:"deadline,omitempty"`
+	Pinned      bool            `json:"pinned"`
+	Archived    bool            `json:"archived"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+type checklistJSON struct {
+	Content   string `json:"content"`
+	Completed bool   `json:"completed"`
+}
+
+type tagJSON struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func eventToJSON(event domain.TriggerEvent) eventJSON {
+	task := event.Task
+	item := eventJSON{
+		ID:         event.DedupID(),
+		OccurredAt: event.OccurredAt.UTC(),
+		Meta:       metaJSON{ID: event.DedupID(), Timestamp: event.OccurredAt.Unix()},
+		Task: taskJSON{
+			ID:          task.ID.String(),
+			Title:       task.Title,
+			Notes:       task.Notes,
+			TagIDs:      make([]string, len(task.TagIDs)),
+			Checklist:   make([]checklistJSON, len(task.Checklist)),
+			StartDate:   formatDate(task.StartDate),
+			Deadline:    formatDate(task.Deadline),
+			Pinned:      task.Pinned,
+			Archived:    task.ArchivedAt != nil,
+			CompletedAt: task.CompletedAt,
+			CreatedAt:   task.CreatedAt.UTC(),
+			UpdatedAt:   task.UpdatedAt.UTC(),
+		},
+	}
+	for i, tagID := range task.TagIDs {
+		item.Task.TagIDs[i] = tagID.String()
+	}
+	for i, checklistItem := range task.Checklist {
+		item.Task.Checklist[i] = checklistJSON{Content: checklistItem.Content, Completed: checklistItem.Completed}
+	}
+	if event.Kind == domain.TriggerTagAdded {
+		item.Tag = &tagJSON{ID: event.TagID.String(), Name: event.TagName}
+	}
+	return item
+}
+
+// formatDate renders a calendar date as "YYYY-MM-DD", or "" when unset
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]string{"error": message})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
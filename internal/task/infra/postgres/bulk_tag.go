@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// AddTag adds a tag to the owner's tasks in taskIDs in a single statement
+// and returns the IDs of the tasks that did not carry it yet
+func (r *TaskRepository) AddTag(ctx context.Context, ownerID string, tagID uuid.UUID, taskIDs []uuid.UUID, by domain.Modifier) ([]uuid.UUID, error) {
+	pgIDs := uuidsToPgUUIDs(taskIDs)
+	if len(pgIDs) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	changed, err := r.queries.AddTagToTasks(ctx, AddTagToTasksParams{
+		TagID:                 pgtype.UUID{Bytes: tagID, Valid: true},
+		TaskIds:               pgIDs,
+		OwnerID:               ownerID,
+		LastModifiedSource:    textFromString(string(by.Source)),
+		LastModifiedClientID:  textFromString(by.ClientID),
+		LastModifiedTokenID:   nullableUUID(by.TokenID),
+		LastModifiedTokenName: textFromString(by.TokenName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgUUIDsToUUIDs(changed), nil
+}
+
+// RemoveTag removes a tag from the owner's tasks in taskIDs in a single
+// statement and returns the IDs of the tasks that carried it
+func (r *TaskRepository) RemoveTag(ctx context.Context, ownerID string, tagID uuid.UUID, taskIDs []uuid.UUID, by domain.Modifier) ([]uuid.UUID, error) {
+	pgIDs := uuidsToPgUUIDs(taskIDs)
+	if len(pgIDs) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	changed, err := r.queries.RemoveTagFromTasks(ctx, RemoveTagFromTasksParams{
+		TaskIds:               pgIDs,
+		OwnerID:               ownerID,
+		TagID:                 pgtype.UUID{Bytes: tagID, Valid: true},
+		LastModifiedSource:    textFromString(string(by.Source)),
+		LastModifiedClientID:  textFromString(by.ClientID),
+		LastModifiedTokenID:   nullableUUID(by.TokenID),
+		LastModifiedTokenName: textFromString(by.TokenName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pgUUIDsToUUIDs(changed), nil
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// SetGeofence sets the geofence of a task, or removes it when geofence is
+// nil. The task is stamped as updated by by, so clients pick the change up
+// in their next incremental sync.
+func (r *TaskRepository) SetGeofence(ctx context.Context, id uuid.UUID, ownerID string, geofence *domain.Geofence, by domain.Modifier) (*domain.Task, error) {
+	pgID := pgtype.UUID{Bytes: id, Valid: true}
+
+	var task *domain.Task
+	err := r.withTx(ctx, func(q *Queries) error {
+		rows, err := q.TouchTask(ctx, TouchTaskParams{
+			ID:                    pgID,
+			OwnerID:               ownerID,
+			LastModifiedSource:    textFromString(string(by.Source)),
+			LastModifiedClientID:  textFromString(by.ClientID),
+			LastModifiedTokenID:   nullableUUID(by.TokenID),
+			LastModifiedTokenName: textFromString(by.TokenName),
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return domain.ErrTaskNotFound
+		}
+
+		if err := writeGeofence(ctx, q, pgID, geofence); err != nil {
+			return err
+		}
+		task, err = r.get(ctx, q, id, ownerID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// writeGeofence stores geofence for a task through q, removing the stored
+// one when geofence is nil
+func writeGeofence(ctx context.Context, q *Queries, taskID pgtype.UUID, geofence *domain.Geofence) error {
+	if geofence == nil {
+		return q.DeleteTaskGeofence(ctx, taskID)
+	}
+	return q.UpsertTaskGeofence(ctx, UpsertTaskGeofenceParams{
+		TaskID:       taskID,
+		Latitude:     geofence.Latitude,
+		Longitude:    geofence.Longitude,
+		RadiusMeters: int32(geofence.RadiusMeters),
+		OnArrive:     geofence.OnArrive,
+		OnLeave:      geofence.OnLeave,
+	})
+}
+
+// geofencesForTasks loads the geofences of the owner's tasks through q,
+// keyed by task ID. Tasks without one are missing from the map.
+func geofencesForTasks(ctx context.Context, q *Queries, ownerID string, taskIDs []pgtype.UUID) (map[uuid.UUID]*domain.Geofence, error) {
+	geofences := make(map[uuid.UUID]*domain.Geofence)
+	if len(taskIDs) == 0 {
+		return geofences, nil
+	}
+
+	rows, err := q.ListTaskGeofences(ctx, ListTaskGeofencesParams{
+		TaskIds: taskIDs,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		geofences[uuid.UUID(row.TaskID.Bytes)] = &domain.Geofence{
+			Latitude:     row.Latitude,
+			Longitude:    row.Longitude,
+			RadiusMeters: int(row.RadiusMeters),
+			OnArrive:     row.OnArrive,
+			OnLeave:      row.OnLeave,
+		}
+	}
+	return geofences, nil
+}
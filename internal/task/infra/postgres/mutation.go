@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// errValidateOnly rolls back the transaction of a batch applied with
+// validateOnly once every mutation has run
+var errValidateOnly = errors.New("validate only")
+
+// ApplyMutations applies an offline batch in order within one transaction.
+// Updates and deletes lock their task row before comparing versions, so a
+// concurrent write either lands before the check or waits for the batch.
+// With validateOnly set the transaction is rolled back after the last
+// mutation.
+func (r *TaskRepository) ApplyMutations(ctx context.Context, ownerID string, mutations []domain.Mutation, by domain.Modifier, validateOnly bool) ([]domain.MutationResult, error) {
+	results := make([]domain.MutationResult, len(mutations))
+	err := r.withTx(ctx, func(txQueries *Queries) error {
+		for i, mutation := range mutations {
+			result, err := r.applyMutation(ctx, txQueries, ownerID, mutation, by)
+			if err != nil {
+				return err
+			}
+			results[i] = result
+		}
+		if validateOnly {
+			return errValidateOnly
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errValidateOnly) {
+		return nil, err
+	}
+	return results, nil
+}
+
+// applyMutation applies a single mutation of a batch through txQueries
+func (r *TaskRepository) applyMutation(ctx context.Context, txQueries *Queries, ownerID string, mutation domain.Mutation, by domain.Modifier) (domain.MutationResult, error) {
+	result := domain.MutationResult{
+		ClientMutationID: mutation.ClientMutationID,
+		TaskID:           mutation.TaskID,
+	}
+	pgID := pgtype.UUID{Bytes: mutation.TaskID, Valid: true}
+
+	if mutation.Kind == domain.MutationCreate {
+		task := mutation.Task
+		notes, err := r.notes.seal(ctx, ownerID, task.Notes)
+		if err != nil {
+			return result, err
+		}
+		row, err := txQueries.CreateTaskWithID(ctx, CreateTaskWithIDParams{
+			ID:                    pgID,
+			Title:                 task.Title,
+			Notes:                 notes,
+			OwnerID:               ownerID,
+			StartDate:             timeToPgDate(task.StartDate),
+			Deadline:              timeToPgDate(task.Deadline),
+			LastModifiedSource:    textFromString(string(by.Source)),
+			LastModifiedClientID:  textFromString(by.ClientID),
+			LastModifiedTokenID:   nullableUUID(by.TokenID),
+			LastModifiedTokenName: textFromString(by.TokenName),
+			Context:               textFromString(task.Context),
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			// The ID is taken. Another owner's task is reported like a
+			// missing one, so IDs cannot be probed.
+			result.Task, err = r.getIfExists(ctx, txQueries, mutation.TaskID, ownerID)
+			if result.Task != nil {
+				result.Conflict = domain.ConflictAlreadyExists
+			} else {
+				result.Conflict = domain.ConflictNotFound
+			}
+			return result, err
+		}
+		if err != nil {
+			return result, err
+		}
+		if err := r.createChildren(ctx, txQueries, task, CreateTaskRow(row)); err != nil {
+			return result, err
+		}
+		result.Task = task
+		return result, nil
+	}
+
+	updatedAt, err := txQueries.GetTaskUpdatedAtForUpdate(ctx, GetTaskUpdatedAtForUpdateParams{
+		ID:      pgID,
+		OwnerID: ownerID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		result.Conflict = domain.ConflictNotFound
+		return result, nil
+	}
+	if err != nil {
+		return result, err
+	}
+	if mutation.IsStale(updatedAt.Time) {
+		result.Conflict = domain.ConflictChanged
+		result.Task, err = r.get(ctx, txQueries, mutation.TaskID, ownerID)
+		return result, err
+	}
+
+	if mutation.Kind == domain.MutationDelete {
+		return result, txQueries.DeleteTask(ctx, DeleteTaskParams{
+			ID:      pgID,
+			OwnerID: ownerID,
+		})
+	}
+
+	task, err := r.get(ctx, txQueries, mutation.TaskID, ownerID)
+	if err != nil {
+		return result, err
+	}
+	mutation.Changes.Apply(task)
+	task.LastModifiedBy = by
+	notes, err := r.notes.seal(ctx, ownerID, task.Notes)
+	if err != nil {
+		return result, err
+	}
+	if err := r.update(ctx, txQueries, task, notes); err != nil {
+		return result, err
+	}
+	result.Task = task
+	return result, nil
+}
+
+// getIfExists is get, returning nil when the task does not exist or belongs
+// to another owner
+func (r *TaskRepository) getIfExists(ctx context.Context, q *Queries, id uuid.UUID, ownerID string) (*domain.Task, error) {
+	task, err := r.get(ctx, q, id, ownerID)
+	if errors.Is(err, domain.ErrTaskNotFound) {
+		return nil, nil
+	}
+	return task, err
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// snapshotNotes records the stored notes of task as a revision when the
+// update is about to change them, then drops revisions beyond
+// domain.MaxNoteRevisions. Notes are compared in plaintext because sealing
+// the same notes twice gives different ciphertexts; the revision keeps the
+// stored form. Empty notes are not recorded.
+func (r *TaskRepository) snapshotNotes(ctx context.Context, txQueries *Queries, task *domain.Task) error {
+	pgID := pgtype.UUID{Bytes: task.ID, Valid: true}
+	stored, err := txQueries.GetTaskNotesForUpdate(ctx, GetTaskNotesForUpdateParams{
+		ID:      pgID,
+		OwnerID: task.OwnerID,
+	})
+	if err != nil {
+		return taskNotFound(err)
+	}
+	if stored == "" {
+		return nil
+	}
+
+	previous, err := r.notes.open(ctx, task.OwnerID, stored)
+	if err != nil {
+		return err
+	}
+	if previous == task.Notes {
+		return nil
+	}
+
+	if err := txQueries.CreateTaskNoteRevision(ctx, CreateTaskNoteRevisionParams{
+		TaskID: pgID,
+		Notes:  stored,
+	}); err != nil {
+		return err
+	}
+	return txQueries.PruneTaskNoteRevisions(ctx, PruneTaskNoteRevisionsParams{
+		TaskID: pgID,
+		Keep:   domain.MaxNoteRevisions,
+	})
+}
+
+// ListNoteRevisions returns the note revisions of a task, newest first
+func (r *TaskRepository) ListNoteRevisions(ctx context.Context, taskID uuid.UUID, ownerID string) ([]domain.NoteRevision, error) {
+	rows, err := r.readQueries.ListTaskNoteRevisions(ctx, ListTaskNoteRevisionsParams{
+		TaskID:  pgtype.UUID{Bytes: taskID, Valid: true},
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]domain.NoteRevision, 0, len(rows))
+	for _, row := range rows {
+		revision, err := r.noteRevisionFromDB(ctx, row, ownerID)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// ListNoteRevisionsForTasks returns the note revisions of the owner's tasks
+// among taskIDs, grouped by task and newest first within a task
+func (r *TaskRepository) ListNoteRevisionsForTasks(ctx context.Context, taskIDs []uuid.UUID, ownerID string) ([]domain.NoteRevision, error) {
+	if len(taskIDs) == 0 {
+		return []domain.NoteRevision{}, nil
+	}
+	rows, err := r.readQueries.ListTaskNoteRevisionsForTasks(ctx, ListTaskNoteRevisionsForTasksParams{
+		TaskIds: uuidsToPgUUIDs(taskIDs),
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]domain.NoteRevision, 0, len(rows))
+	for _, row := range rows {
+		revision, err := r.noteRevisionFromDB(ctx, row, ownerID)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// GetNoteRevision returns one note revision of a task
+func (r *TaskRepository) GetNoteRevision(ctx context.Context, id, taskID uuid.UUID, ownerID string) (*domain.NoteRevision, error) {
+	row, err := r.queries.GetTaskNoteRevision(ctx, GetTaskNoteRevisionParams{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		TaskID:  pgtype.UUID{Bytes: taskID, Valid: true},
+		OwnerID: ownerID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrNoteRevisionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := r.noteRevisionFromDB(ctx, row, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+func (r *TaskRepository) noteRevisionFromDB(ctx context.Context, row TaskNoteRevision, ownerID string) (domain.NoteRevision, error) {
+	id, err := uuid.FromBytes(row.ID.Bytes[:])
+	if err != nil {
+		return domain.NoteRevision{}, err
+	}
+	taskID, err := uuid.FromBytes(row.TaskID.Bytes[:])
+	if err != nil {
+		return domain.NoteRevision{}, err
+	}
+	notes, err := r.notes.open(ctx, ownerID, row.Notes)
+	if err != nil {
+		return domain.NoteRevision{}, err
+	}
+
+	return domain.NoteRevision{
+		ID:        id,
+		TaskID:    taskID,
+		Notes:     notes,
+		CreatedAt: row.CreatedAt.Time,
+	}, nil
+}
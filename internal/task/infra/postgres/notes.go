@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/slips-ai/slips-core/pkg/envelope"
+)
+
+// errNotesKeyringMissing is returned when a stored note is encrypted but the
+// repository was created without a keyring
+var errNotesKeyringMissing = errors.New("task notes are encrypted but no encryption keys are configured")
+
+// notesCipher encrypts task notes with a per-user data key. Data keys are
+// stored in user_data_keys wrapped with the keyring and cached unwrapped,
+// since they never change once created.
+type notesCipher struct {
+	keyring *envelope.Keyring
+	queries *Queries
+	// encrypt seals notes on write; when false existing ciphertext is still
+	// opened so the option can be turned off without losing data
+	encrypt bool
+
+	mu   sync.Mutex
+	keys map[string][]byte
+}
+
+// notesAAD binds encrypted notes to their owner
+func notesAAD(ownerID string) string {
+	return "tasks.notes:" + ownerID
+}
+
+// dataKeyAAD binds a wrapped data key to its user
+func dataKeyAAD(userID string) string {
+	return "user_data_keys:" + userID
+}
+
+// seal encrypts notes for ownerID when encryption is enabled. Notes stored
+// in plaintext are escaped, so notes typed to look encrypted read back as
+// typed.
+func (c *notesCipher) seal(ctx context.Context, ownerID, notes string) (string, error) {
+	if c == nil || !c.encrypt || notes == "" {
+		return envelope.Escape(notes), nil
+	}
+	key, err := c.dataKey(ctx, ownerID, true)
+	if err != nil {
+		return "", err
+	}
+	return envelope.SealWithDataKey(key, notes, notesAAD(ownerID))
+}
+
+// open decrypts notes written by seal; plaintext notes pass through
+// unescaped
+func (c *notesCipher) open(ctx context.Context, ownerID, notes string) (string, error) {
+	if !envelope.IsSealedWithDataKey(notes) {
+		return envelope.Unescape(notes), nil
+	}
+	if c == nil {
+		return "", errNotesKeyringMissing
+	}
+	key, err := c.dataKey(ctx, ownerID, false)
+	if err != nil {
+		return "", err
+	}
+	return envelope.OpenWithDataKey(key, notes, notesAAD(ownerID))
+}
+
+// dataKey returns the unwrapped data key of userID, generating and storing
+// one first when create is set
+func (c *notesCipher) dataKey(ctx context.Context, userID string, create bool) ([]byte, error) {
+	c.mu.Lock()
+	key, ok := c.keys[userID]
+	c.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	// Data keys are looked up on the primary so a key created moments ago is
+	// always visible
+	wrapped, err := c.queries.GetUserDataKey(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) && create {
+		wrapped, err = c.createDataKey(ctx, userID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load data key of user %s: %w", userID, err)
+	}
+
+	encoded, err := c.keyring.Decrypt(ctx, wrapped, dataKeyAAD(userID))
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key of user %s: %w", userID, err)
+	}
+	key, err = base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode data key of user %s: %w", userID, err)
+	}
+
+	c.mu.Lock()
+	c.keys[userID] = key
+	c.mu.Unlock()
+	return key, nil
+}
+
+// createDataKey stores a new wrapped data key for userID and returns the
+// stored one, which belongs to a concurrent writer when it won the race
+func (c *notesCipher) createDataKey(ctx context.Context, userID string) (string, error) {
+	key, err := envelope.GenerateDataKey()
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := c.keyring.Encrypt(ctx, base64.StdEncoding.EncodeToString(key), dataKeyAAD(userID))
+	if err != nil {
+		return "", err
+	}
+	if err := c.queries.CreateUserDataKey(ctx, CreateUserDataKeyParams{
+		UserID:     userID,
+		WrappedKey: wrapped,
+	}); err != nil {
+		return "", err
+	}
+	return c.queries.GetUserDataKey(ctx, userID)
+}
+
+// RotateDataKeys re-wraps every data key that is not wrapped with the primary
+// key and returns how many were rewritten. The data keys themselves, and so
+// the notes encrypted with them, are unchanged.
+func (r *TaskRepository) RotateDataKeys(ctx context.Context) (int, error) {
+	if r.notes == nil {
+		return 0, errors.New("encryption is not configured")
+	}
+	keyring := r.notes.keyring
+
+	rows, err := r.queries.ListUserDataKeys(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rotated := 0
+	for _, row := range rows {
+		if !keyring.NeedsRotation(row.WrappedKey) {
+			continue
+		}
+		aad := dataKeyAAD(row.UserID)
+		key, err := keyring.Decrypt(ctx, row.WrappedKey, aad)
+		if err != nil {
+			return rotated, fmt.Errorf("unwrap data key of user %s: %w", row.UserID, err)
+		}
+		wrapped, err := keyring.Encrypt(ctx, key, aad)
+		if err != nil {
+			return rotated, err
+		}
+		n, err := r.queries.ReplaceUserDataKey(ctx, ReplaceUserDataKeyParams{
+			NewKey: wrapped,
+			UserID: row.UserID,
+			OldKey: row.WrappedKey,
+		})
+		if err != nil {
+			return rotated, err
+		}
+		rotated += int(n)
+	}
+	return rotated, nil
+}
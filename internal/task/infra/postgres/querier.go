@@ -12,22 +12,139 @@ import (
 
 type Querier interface {
 	AddChecklistItem(ctx context.Context, arg AddChecklistItemParams) (TaskChecklistItem, error)
+	// Adds tag_id to the owner's tasks in task_ids and stamps the tasks that
+	// did not carry it yet.
+	AddTagToTasks(ctx context.Context, arg AddTagToTasksParams) ([]pgtype.UUID, error)
+	ArchiveCompletedTasks(ctx context.Context, arg ArchiveCompletedTasksParams) (int64, error)
 	ArchiveTask(ctx context.Context, arg ArchiveTaskParams) (ArchiveTaskRow, error)
-	CreateChecklistItemWithSortOrder(ctx context.Context, arg CreateChecklistItemWithSortOrderParams) (TaskChecklistItem, error)
+	ArchiveTasksByTag(ctx context.Context, arg ArchiveTasksByTagParams) (int64, error)
+	CompleteTask(ctx context.Context, arg CompleteTaskParams) (CompleteTaskRow, error)
+	CountArchivableCompletedTasks(ctx context.Context, arg CountArchivableCompletedTasksParams) (int64, error)
+	CountBacklogTasks(ctx context.Context, ownerID string) (int64, error)
+	CountChecklistItemsForTasks(ctx context.Context, taskIds []pgtype.UUID) ([]CountChecklistItemsForTasksRow, error)
+	CountTagTasks(ctx context.Context, arg CountTagTasksParams) (CountTagTasksRow, error)
+	CreateChecklistItems(ctx context.Context, arg CreateChecklistItemsParams) ([]TaskChecklistItem, error)
+	// Returns no row when the owner already created a task with the same
+	// client_request_id.
 	CreateTask(ctx context.Context, arg CreateTaskParams) (CreateTaskRow, error)
-	CreateTaskTag(ctx context.Context, arg CreateTaskTagParams) error
+	CreateTaskNoteRevision(ctx context.Context, arg CreateTaskNoteRevisionParams) error
+	CreateTaskTags(ctx context.Context, arg CreateTaskTagsParams) error
+	// Inserts a task with a client-generated ID. No row is returned when the ID
+	// is already taken.
+	CreateTaskWithID(ctx context.Context, arg CreateTaskWithIDParams) (CreateTaskWithIDRow, error)
+	CreateUserDataKey(ctx context.Context, arg CreateUserDataKeyParams) error
 	DeleteChecklistItem(ctx context.Context, arg DeleteChecklistItemParams) (int64, error)
+	// Deletes the task and records a tombstone in the same statement.
 	DeleteTask(ctx context.Context, arg DeleteTaskParams) error
-	DeleteTaskTags(ctx context.Context, taskID pgtype.UUID) error
+	DeleteTaskGeofence(ctx context.Context, taskID pgtype.UUID) error
+	DeleteTaskTagsNotIn(ctx context.Context, arg DeleteTaskTagsNotInParams) error
+	GetTagTaskCounts(ctx context.Context, ownerID string) ([]GetTagTaskCountsRow, error)
 	GetTask(ctx context.Context, arg GetTaskParams) (GetTaskRow, error)
+	// Counts created, completed and archived tasks per day or week bucket (UTC).
+	GetTaskActivityCounts(ctx context.Context, arg GetTaskActivityCountsParams) ([]GetTaskActivityCountsRow, error)
+	// Counts the owner's open tasks for badges. Today and overdue match
+	// ListTodayTaskIDs and ListOverdueTaskIDs; unread tasks were added or
+	// changed by an agent or the system since the owner last viewed them.
+	GetTaskCounters(ctx context.Context, arg GetTaskCountersParams) (GetTaskCountersRow, error)
+	GetTaskIDByClientRequestID(ctx context.Context, arg GetTaskIDByClientRequestIDParams) (pgtype.UUID, error)
+	GetTaskNoteRevision(ctx context.Context, arg GetTaskNoteRevisionParams) (TaskNoteRevision, error)
+	// Locks the task row so concurrent updates snapshot notes one at a time.
+	GetTaskNotesForUpdate(ctx context.Context, arg GetTaskNotesForUpdateParams) (string, error)
+	GetTaskSettings(ctx context.Context, ownerID string) (TaskSetting, error)
 	GetTaskTagIDs(ctx context.Context, taskID pgtype.UUID) ([]pgtype.UUID, error)
+	GetTaskTagIDsForTasks(ctx context.Context, taskIds []pgtype.UUID) ([]GetTaskTagIDsForTasksRow, error)
+	// Locks the task row so its version cannot change before the mutation is written.
+	GetTaskUpdatedAtForUpdate(ctx context.Context, arg GetTaskUpdatedAtForUpdateParams) (pgtype.Timestamptz, error)
+	GetTasksByIDs(ctx context.Context, arg GetTasksByIDsParams) ([]GetTasksByIDsRow, error)
+	GetUserDataKey(ctx context.Context, userID string) (string, error)
+	ListAutoArchivePolicies(ctx context.Context) ([]ListAutoArchivePoliciesRow, error)
 	ListChecklistItems(ctx context.Context, arg ListChecklistItemsParams) ([]TaskChecklistItem, error)
+	ListChecklistItemsForTasks(ctx context.Context, arg ListChecklistItemsForTasksParams) ([]TaskChecklistItem, error)
+	ListCompletedTaskEvents(ctx context.Context, arg ListCompletedTaskEventsParams) ([]ListCompletedTaskEventsRow, error)
+	ListCompletedTaskIDsBetween(ctx context.Context, arg ListCompletedTaskIDsBetweenParams) ([]pgtype.UUID, error)
+	ListCompletedTaskIDsSince(ctx context.Context, arg ListCompletedTaskIDsSinceParams) ([]pgtype.UUID, error)
+	ListCreatedTaskEvents(ctx context.Context, arg ListCreatedTaskEventsParams) ([]ListCreatedTaskEventsRow, error)
+	// Lists open tasks neither updated nor viewed since inactive_before, longest
+	// inactive first.
+	ListInactiveTaskIDs(ctx context.Context, arg ListInactiveTaskIDsParams) ([]pgtype.UUID, error)
+	ListOverdueTaskIDs(ctx context.Context, arg ListOverdueTaskIDsParams) ([]pgtype.UUID, error)
+	// Lists the owner's tasks carrying tag_id, most recently updated first.
+	ListRecentTagTaskIDs(ctx context.Context, arg ListRecentTagTaskIDsParams) ([]pgtype.UUID, error)
+	ListStaleTaskIDs(ctx context.Context, arg ListStaleTaskIDsParams) ([]pgtype.UUID, error)
+	ListTagAddedEvents(ctx context.Context, arg ListTagAddedEventsParams) ([]ListTagAddedEventsRow, error)
+	ListTaskGeofences(ctx context.Context, arg ListTaskGeofencesParams) ([]TaskGeofence, error)
+	ListTaskNoteRevisions(ctx context.Context, arg ListTaskNoteRevisionsParams) ([]TaskNoteRevision, error)
+	ListTaskNoteRevisionsForTasks(ctx context.Context, arg ListTaskNoteRevisionsForTasksParams) ([]TaskNoteRevision, error)
+	ListTaskTombstones(ctx context.Context, arg ListTaskTombstonesParams) ([]ListTaskTombstonesRow, error)
+	// Lists a page of the tasks matching the filters. Every row carries the
+	// counts across all pages: the total, the task's start date and deadline
+	// groups and, with group_by_tag, the groups of its tags and the untagged
+	// group. A page past the end returns the last match alone, with a position
+	// at or before page_offset, so that the total is still known.
 	ListTasks(ctx context.Context, arg ListTasksParams) ([]ListTasksRow, error)
+	// Walks the owner's tasks in ID order. A page is short only at the end, even
+	// when tasks are deleted while they are walked.
+	ListTasksAfter(ctx context.Context, arg ListTasksAfterParams) ([]ListTasksAfterRow, error)
+	ListTodayTaskIDs(ctx context.Context, arg ListTodayTaskIDsParams) ([]pgtype.UUID, error)
+	ListTransferNoteRevisions(ctx context.Context, taskIds []pgtype.UUID) ([]ListTransferNoteRevisionsRow, error)
+	ListUndatedTaskIDs(ctx context.Context, arg ListUndatedTaskIDsParams) ([]pgtype.UUID, error)
+	ListUserDataKeys(ctx context.Context) ([]ListUserDataKeysRow, error)
+	// Locks the owner's tasks among ids so they cannot change while they are
+	// handed to another owner.
+	LockTransferTasks(ctx context.Context, arg LockTransferTasksParams) ([]pgtype.UUID, error)
+	// Maps the tags of the tasks in task_ids, owned by from_owner_id, to the
+	// tags of to_owner_id with the same names, creating the missing ones in the
+	// same statement. created is true for the tags this statement inserted.
+	// tag_id is NULL for a name another transaction inserted meanwhile.
+	MapTransferTags(ctx context.Context, arg MapTransferTagsParams) ([]MapTransferTagsRow, error)
+	// Records that the owner opened a task. updated_at is left alone, since
+	// viewing does not change the task.
+	MarkTaskViewed(ctx context.Context, arg MarkTaskViewedParams) (int64, error)
+	PruneTaskNoteRevisions(ctx context.Context, arg PruneTaskNoteRevisionsParams) error
+	// Deletes the tombstones of every owner recorded before deleted_before.
+	PurgeTaskTombstones(ctx context.Context, deletedBefore pgtype.Timestamptz) (int64, error)
+	// Records the tasks in task_ids as deleted for owner_id, their previous
+	// owner, replacing tombstones left by an earlier owner.
+	RecordTransferTombstones(ctx context.Context, arg RecordTransferTombstonesParams) error
+	// Replaces the tags of the tasks in task_ids by their counterparts, given
+	// pairwise in old_tag_ids and new_tag_ids.
+	RemapTaskTags(ctx context.Context, arg RemapTaskTagsParams) error
+	// Removes tag_id from the owner's tasks in task_ids and stamps the tasks
+	// that carried it.
+	RemoveTagFromTasks(ctx context.Context, arg RemoveTagFromTasksParams) ([]pgtype.UUID, error)
+	ReopenTask(ctx context.Context, arg ReopenTaskParams) (ReopenTaskRow, error)
 	ReorderChecklistItems(ctx context.Context, arg ReorderChecklistItemsParams) error
+	ReplaceUserDataKey(ctx context.Context, arg ReplaceUserDataKeyParams) (int64, error)
+	// Inserts a checklist item from a backup with its ID, state and timestamps.
+	RestoreChecklistItem(ctx context.Context, arg RestoreChecklistItemParams) error
+	// Inserts a note revision from a backup with its creation time.
+	RestoreNoteRevision(ctx context.Context, arg RestoreNoteRevisionParams) error
+	// Inserts a task from a backup with its ID, timestamps and state.
+	RestoreTask(ctx context.Context, arg RestoreTaskParams) error
+	// Gets or creates the owner's tags with the given names in one statement.
+	// created is true for the tags this statement inserted. tag_id is NULL for
+	// a name another transaction inserted meanwhile.
+	RestoreTags(ctx context.Context, arg RestoreTagsParams) ([]RestoreTagsRow, error)
+	// Moves the owner's open tasks that started before today to
+	// new_start_date, or to the inbox when it is NULL.
+	RolloverTasks(ctx context.Context, arg RolloverTasksParams) ([]RolloverTasksRow, error)
 	SetChecklistItemCompleted(ctx context.Context, arg SetChecklistItemCompletedParams) (TaskChecklistItem, error)
+	TogglePinTask(ctx context.Context, arg TogglePinTaskParams) (TogglePinTaskRow, error)
+	// Records a change to a part of a task kept outside the tasks row, so
+	// incremental syncs pick the task up again.
+	TouchTask(ctx context.Context, arg TouchTaskParams) (int64, error)
+	// Gives the task to to_owner_id with its notes sealed for them. The client
+	// request ID is dropped when the recipient already used it.
+	TransferTask(ctx context.Context, arg TransferTaskParams) error
 	UnarchiveTask(ctx context.Context, arg UnarchiveTaskParams) (UnarchiveTaskRow, error)
+	UnarchiveTasksByTag(ctx context.Context, arg UnarchiveTasksByTagParams) (int64, error)
 	UpdateChecklistItemContent(ctx context.Context, arg UpdateChecklistItemContentParams) (TaskChecklistItem, error)
+	UpdateNoteRevisionNotes(ctx context.Context, arg UpdateNoteRevisionNotesParams) error
 	UpdateTask(ctx context.Context, arg UpdateTaskParams) (UpdateTaskRow, error)
+	UpsertTaskGeofence(ctx context.Context, arg UpsertTaskGeofenceParams) error
+	// Sets both task settings of the owner in one statement. A NULL
+	// rollover_to_inbox keeps the stored preference, false for a new row.
+	UpsertTaskSettings(ctx context.Context, arg UpsertTaskSettingsParams) (TaskSetting, error)
 }
 
 var _ Querier = (*Queries)(nil)
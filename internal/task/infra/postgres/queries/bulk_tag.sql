@@ -0,0 +1,37 @@
+-- name: AddTagToTasks :many
+-- Adds tag_id to the owner's tasks in task_ids and stamps the tasks that
+-- did not carry it yet.
+WITH tagged AS (
+    INSERT INTO task_tags (task_id, tag_id)
+    SELECT t.id, sqlc.arg(tag_id)::uuid
+    FROM tasks t
+    WHERE t.id = ANY(sqlc.arg(task_ids)::uuid[]) AND t.owner_id = sqlc.arg(owner_id)
+    ON CONFLICT DO NOTHING
+    RETURNING task_id
+)
+UPDATE tasks
+SET updated_at = NOW(),
+    last_modified_source = sqlc.arg(last_modified_source), last_modified_client_id = sqlc.arg(last_modified_client_id),
+    last_modified_token_id = sqlc.arg(last_modified_token_id), last_modified_token_name = sqlc.arg(last_modified_token_name)
+FROM tagged
+WHERE tasks.id = tagged.task_id
+RETURNING tasks.id;
+
+-- name: RemoveTagFromTasks :many
+-- Removes tag_id from the owner's tasks in task_ids and stamps the tasks
+-- that carried it.
+WITH untagged AS (
+    DELETE FROM task_tags tt
+    USING tasks t
+    WHERE tt.task_id = t.id
+      AND t.id = ANY(sqlc.arg(task_ids)::uuid[]) AND t.owner_id = sqlc.arg(owner_id)
+      AND tt.tag_id = sqlc.arg(tag_id)::uuid
+    RETURNING tt.task_id
+)
+UPDATE tasks
+SET updated_at = NOW(),
+    last_modified_source = sqlc.arg(last_modified_source), last_modified_client_id = sqlc.arg(last_modified_client_id),
+    last_modified_token_id = sqlc.arg(last_modified_token_id), last_modified_token_name = sqlc.arg(last_modified_token_name)
+FROM untagged
+WHERE tasks.id = untagged.task_id
+RETURNING tasks.id;
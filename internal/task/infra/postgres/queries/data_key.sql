@@ -0,0 +1,19 @@
+-- name: GetUserDataKey :one
+SELECT wrapped_key
+FROM user_data_keys
+WHERE user_id = $1;
+
+-- name: CreateUserDataKey :exec
+INSERT INTO user_data_keys (user_id, wrapped_key)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO NOTHING;
+
+-- name: ListUserDataKeys :many
+SELECT user_id, wrapped_key
+FROM user_data_keys
+ORDER BY user_id ASC;
+
+-- name: ReplaceUserDataKey :execrows
+UPDATE user_data_keys
+SET wrapped_key = sqlc.arg(new_key), updated_at = NOW()
+WHERE user_id = sqlc.arg(user_id) AND wrapped_key = sqlc.arg(old_key);
@@ -0,0 +1,29 @@
+-- name: ListTaskGeofences :many
+SELECT g.*
+FROM task_geofences g
+JOIN tasks t ON g.task_id = t.id
+WHERE g.task_id = ANY(sqlc.arg(task_ids)::uuid[]) AND t.owner_id = sqlc.arg(owner_id);
+
+-- name: UpsertTaskGeofence :exec
+INSERT INTO task_geofences (task_id, latitude, longitude, radius_meters, on_arrive, on_leave)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (task_id) DO UPDATE
+SET latitude = EXCLUDED.latitude,
+    longitude = EXCLUDED.longitude,
+    radius_meters = EXCLUDED.radius_meters,
+    on_arrive = EXCLUDED.on_arrive,
+    on_leave = EXCLUDED.on_leave,
+    updated_at = NOW();
+
+-- name: DeleteTaskGeofence :exec
+DELETE FROM task_geofences
+WHERE task_id = $1;
+
+-- name: TouchTask :execrows
+-- Records a change to a part of a task kept outside the tasks row, so
+-- incremental syncs pick the task up again.
+UPDATE tasks
+SET updated_at = NOW(),
+    last_modified_source = $3, last_modified_client_id = $4,
+    last_modified_token_id = $5, last_modified_token_name = $6
+WHERE id = $1 AND owner_id = $2;
@@ -0,0 +1,16 @@
+-- name: CreateTaskWithID :one
+-- Inserts a task with a client-generated ID. No row is returned when the ID
+-- is already taken.
+INSERT INTO tasks (id, title, notes, owner_id, start_date, deadline, last_modified_source, last_modified_client_id, context,
+                   last_modified_token_id, last_modified_token_name,
+                   created_by_source, created_by_client_id, created_by_token_id, created_by_token_name)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $7, $8, $10, $11)
+ON CONFLICT (id) DO NOTHING
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name;
+
+-- name: GetTaskUpdatedAtForUpdate :one
+-- Locks the task row so its version cannot change before the mutation is written.
+SELECT updated_at
+FROM tasks
+WHERE id = $1 AND owner_id = $2
+FOR UPDATE;
@@ -0,0 +1,41 @@
+-- name: GetTaskNotesForUpdate :one
+-- Locks the task row so concurrent updates snapshot notes one at a time.
+SELECT notes
+FROM tasks
+WHERE id = $1 AND owner_id = $2
+FOR UPDATE;
+
+-- name: CreateTaskNoteRevision :exec
+INSERT INTO task_note_revisions (task_id, notes)
+VALUES ($1, $2);
+
+-- name: PruneTaskNoteRevisions :exec
+DELETE FROM task_note_revisions
+WHERE task_id = sqlc.arg(task_id)
+  AND id NOT IN (
+    SELECT id
+    FROM task_note_revisions
+    WHERE task_id = sqlc.arg(task_id)
+    ORDER BY created_at DESC, id DESC
+    LIMIT sqlc.arg(keep)
+  );
+
+-- name: ListTaskNoteRevisions :many
+SELECT r.*
+FROM task_note_revisions r
+JOIN tasks t ON r.task_id = t.id
+WHERE r.task_id = sqlc.arg(task_id) AND t.owner_id = sqlc.arg(owner_id)
+ORDER BY r.created_at DESC, r.id DESC;
+
+-- name: GetTaskNoteRevision :one
+SELECT r.*
+FROM task_note_revisions r
+JOIN tasks t ON r.task_id = t.id
+WHERE r.id = sqlc.arg(id) AND r.task_id = sqlc.arg(task_id) AND t.owner_id = sqlc.arg(owner_id);
+
+-- name: ListTaskNoteRevisionsForTasks :many
+SELECT r.*
+FROM task_note_revisions r
+JOIN tasks t ON r.task_id = t.id
+WHERE r.task_id = ANY(sqlc.arg(task_ids)::uuid[]) AND t.owner_id = sqlc.arg(owner_id)
+ORDER BY r.task_id ASC, r.created_at DESC, r.id DESC;
@@ -0,0 +1,21 @@
+-- name: RestoreTags :many
+-- Gets or creates the owner's tags with the given names in one statement.
+-- created is true for the tags this statement inserted. tag_id is NULL for
+-- a name another transaction inserted meanwhile.
+WITH input AS (
+    SELECT DISTINCT unnest(sqlc.arg(names)::text[]) AS name
+), inserted AS (
+    INSERT INTO tags (name, owner_id)
+    SELECT name, sqlc.arg(owner_id) FROM input
+    ON CONFLICT (owner_id, name) DO NOTHING
+    RETURNING id, name
+)
+SELECT n.name, COALESCE(i.id, t.id) AS tag_id, (i.id IS NOT NULL)::boolean AS created
+FROM input n
+LEFT JOIN inserted i ON i.name = n.name
+LEFT JOIN tags t ON t.owner_id = sqlc.arg(owner_id) AND t.name = n.name;
+
+-- name: RestoreNoteRevision :exec
+-- Inserts a note revision from a backup with its creation time.
+INSERT INTO task_note_revisions (task_id, notes, created_at)
+VALUES ($1, $2, $3);
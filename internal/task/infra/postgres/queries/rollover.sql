@@ -0,0 +1,11 @@
+-- name: RolloverTasks :many
+-- Moves the owner's open tasks that started before today to
+-- new_start_date, or to the inbox when it is NULL.
+UPDATE tasks
+SET start_date = sqlc.narg(new_start_date)::date, updated_at = NOW(),
+    last_modified_source = sqlc.arg(last_modified_source), last_modified_client_id = sqlc.arg(last_modified_client_id),
+    last_modified_token_id = sqlc.arg(last_modified_token_id), last_modified_token_name = sqlc.arg(last_modified_token_name)
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at IS NULL AND archived_at IS NULL
+  AND start_date < sqlc.arg(today)::date
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name;
@@ -0,0 +1,21 @@
+-- name: GetTaskSettings :one
+SELECT owner_id, auto_archive_after_days, created_at, updated_at, rollover_to_inbox
+FROM task_settings
+WHERE owner_id = $1;
+
+-- name: ListAutoArchivePolicies :many
+SELECT owner_id, auto_archive_after_days
+FROM task_settings
+WHERE auto_archive_after_days IS NOT NULL
+ORDER BY owner_id ASC;
+
+-- name: UpsertTaskSettings :one
+-- Sets both task settings of the owner in one statement. A NULL
+-- rollover_to_inbox keeps the stored preference, false for a new row.
+INSERT INTO task_settings (owner_id, auto_archive_after_days, rollover_to_inbox)
+VALUES ($1, $2, COALESCE(sqlc.narg(rollover_to_inbox)::boolean, FALSE))
+ON CONFLICT (owner_id) DO UPDATE
+SET auto_archive_after_days = EXCLUDED.auto_archive_after_days,
+    rollover_to_inbox = COALESCE(sqlc.narg(rollover_to_inbox)::boolean, task_settings.rollover_to_inbox),
+    updated_at = NOW()
+RETURNING owner_id, auto_archive_after_days, created_at, updated_at, rollover_to_inbox;
@@ -0,0 +1,18 @@
+-- name: ListInactiveTaskIDs :many
+-- Lists open tasks neither updated nor viewed since inactive_before, longest
+-- inactive first.
+SELECT id
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at IS NULL AND archived_at IS NULL
+  AND updated_at < sqlc.arg(inactive_before)::timestamptz
+  AND (last_viewed_at IS NULL OR last_viewed_at < sqlc.arg(inactive_before)::timestamptz)
+ORDER BY GREATEST(updated_at, last_viewed_at) ASC, id ASC
+LIMIT sqlc.arg(max_results);
+
+-- name: MarkTaskViewed :execrows
+-- Records that the owner opened a task. updated_at is left alone, since
+-- viewing does not change the task.
+UPDATE tasks
+SET last_viewed_at = NOW()
+WHERE id = $1 AND owner_id = $2;
@@ -0,0 +1,15 @@
+-- name: CountTagTasks :one
+SELECT COUNT(*) FILTER (WHERE t.completed_at IS NULL AND t.archived_at IS NULL) AS open_count,
+       COUNT(*) FILTER (WHERE t.completed_at IS NOT NULL) AS completed_count
+FROM task_tags tt
+JOIN tasks t ON t.id = tt.task_id
+WHERE tt.tag_id = $1 AND t.owner_id = $2;
+
+-- name: ListRecentTagTaskIDs :many
+-- Lists the owner's tasks carrying tag_id, most recently updated first.
+SELECT t.id
+FROM task_tags tt
+JOIN tasks t ON t.id = tt.task_id
+WHERE tt.tag_id = sqlc.arg(tag_id) AND t.owner_id = sqlc.arg(owner_id)
+ORDER BY t.updated_at DESC, t.id DESC
+LIMIT sqlc.arg(max_results);
@@ -1,16 +1,21 @@
 -- name: CreateTask :one
-INSERT INTO tasks (title, notes, owner_id, start_date)
-VALUES ($1, $2, $3, $4)
-RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date;
+-- Returns no row when the owner already created a task with the same
+-- client_request_id.
+INSERT INTO tasks (title, notes, owner_id, start_date, deadline, client_request_id, last_modified_source, last_modified_client_id, context,
+                   last_modified_token_id, last_modified_token_name,
+                   created_by_source, created_by_client_id, created_by_token_id, created_by_token_name)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $7, $8, $10, $11)
+ON CONFLICT (owner_id, client_request_id) WHERE client_request_id IS NOT NULL DO NOTHING
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name;
 
--- name: CreateTaskTag :exec
+-- name: CreateTaskTags :exec
 INSERT INTO task_tags (task_id, tag_id)
-VALUES ($1, $2)
+SELECT sqlc.arg(task_id), unnest(sqlc.arg(tag_ids)::uuid[])
 ON CONFLICT DO NOTHING;
 
--- name: DeleteTaskTags :exec
+-- name: DeleteTaskTagsNotIn :exec
 DELETE FROM task_tags
-WHERE task_id = $1;
+WHERE task_id = sqlc.arg(task_id) AND NOT (tag_id = ANY(sqlc.arg(tag_ids)::uuid[]));
 
 -- name: GetTaskTagIDs :many
 SELECT tag_id
@@ -18,49 +23,252 @@ FROM task_tags
 WHERE task_id = $1;
 
 -- name: GetTask :one
-SELECT id, title, notes, owner_id, archived_at, created_at, updated_at, start_date
+SELECT id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
 FROM tasks
 WHERE id = $1 AND owner_id = $2;
 
+-- name: GetTaskIDByClientRequestID :one
+SELECT id
+FROM tasks
+WHERE owner_id = $1 AND client_request_id = $2;
+
+-- name: GetTasksByIDs :many
+SELECT id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
+FROM tasks
+WHERE id = ANY(sqlc.arg(ids)::uuid[]) AND owner_id = sqlc.arg(owner_id);
+
+-- name: ListTasksAfter :many
+-- Walks the owner's tasks in ID order. A page is short only at the end, even
+-- when tasks are deleted while they are walked.
+SELECT id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND id > sqlc.arg(after_id)
+  AND (sqlc.arg(archived_only)::boolean = FALSE OR archived_at IS NOT NULL)
+  AND (sqlc.arg(include_archived)::boolean = TRUE OR sqlc.arg(archived_only)::boolean = TRUE OR archived_at IS NULL)
+ORDER BY id ASC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListCreatedTaskEvents :many
+SELECT id, created_at
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND (sqlc.narg(before_at)::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg(before_at)::timestamptz, sqlc.arg(before_task_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListCompletedTaskEvents :many
+SELECT id, completed_at
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at IS NOT NULL
+  AND (sqlc.narg(before_at)::timestamptz IS NULL
+       OR (completed_at, id) < (sqlc.narg(before_at)::timestamptz, sqlc.arg(before_task_id)::uuid))
+ORDER BY completed_at DESC, id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListTagAddedEvents :many
+SELECT tt.task_id, tt.tag_id, tt.created_at, tg.name
+FROM task_tags tt
+JOIN tasks t ON t.id = tt.task_id
+JOIN tags tg ON tg.id = tt.tag_id
+WHERE t.owner_id = sqlc.arg(owner_id)
+  AND (sqlc.narg(before_at)::timestamptz IS NULL
+       OR (tt.created_at, tt.task_id, tt.tag_id) < (sqlc.narg(before_at)::timestamptz, sqlc.arg(before_task_id)::uuid, sqlc.arg(before_tag_id)::uuid))
+ORDER BY tt.created_at DESC, tt.task_id DESC, tt.tag_id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: GetTaskTagIDsForTasks :many
+SELECT task_id, tag_id
+FROM task_tags
+WHERE task_id = ANY(sqlc.arg(task_ids)::uuid[]);
+
 -- name: UpdateTask :one
 UPDATE tasks
-SET title = $2, notes = $3, updated_at = NOW(), start_date = $5
+SET title = $2, notes = $3, updated_at = NOW(), start_date = $5, deadline = $6,
+    last_modified_source = $7, last_modified_client_id = $8, context = $9,
+    last_modified_token_id = $10, last_modified_token_name = $11
 WHERE id = $1 AND owner_id = $4
-RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date;
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name;
 
 -- name: DeleteTask :exec
-DELETE FROM tasks
-WHERE id = $1 AND owner_id = $2;
+-- Deletes the task and records a tombstone in the same statement.
+WITH deleted AS (
+    DELETE FROM tasks
+    WHERE tasks.id = $1 AND tasks.owner_id = $2
+    RETURNING tasks.id, tasks.owner_id
+)
+INSERT INTO task_tombstones (task_id, owner_id)
+SELECT deleted.id, deleted.owner_id FROM deleted
+ON CONFLICT (task_id) DO UPDATE SET owner_id = EXCLUDED.owner_id, deleted_at = NOW();
+
+-- name: ListTaskTombstones :many
+SELECT task_id, deleted_at
+FROM task_tombstones
+WHERE owner_id = sqlc.arg(owner_id) AND deleted_at > sqlc.arg(deleted_after)
+  AND (sqlc.narg(after_at)::timestamptz IS NULL
+       OR (deleted_at, task_id) > (sqlc.narg(after_at)::timestamptz, sqlc.arg(after_task_id)::uuid))
+ORDER BY deleted_at ASC, task_id ASC
+LIMIT sqlc.arg(page_limit);
+
+-- name: PurgeTaskTombstones :execrows
+-- Deletes the tombstones of every owner recorded before deleted_before.
+DELETE FROM task_tombstones
+WHERE deleted_at < sqlc.arg(deleted_before);
 
 -- name: ListTasks :many
-SELECT DISTINCT t.id, t.title, t.notes, t.owner_id, t.archived_at, t.created_at, t.updated_at, t.start_date
-FROM tasks t
-LEFT JOIN task_tags tt ON t.id = tt.task_id
-WHERE t.owner_id = $1
-  AND (sqlc.narg('filter_tag_ids')::uuid[] IS NULL
-       OR tt.tag_id = ANY(sqlc.narg('filter_tag_ids')::uuid[]))
-  AND (
-    (sqlc.narg('archived_only')::boolean = TRUE AND t.archived_at IS NOT NULL) OR
-    (sqlc.narg('archived_only')::boolean = FALSE AND (
-      sqlc.narg('include_archived')::boolean = TRUE OR
-      (sqlc.narg('include_archived')::boolean = FALSE AND t.archived_at IS NULL)
-    )) OR
-    (sqlc.narg('archived_only')::boolean IS NULL AND sqlc.narg('include_archived')::boolean IS NULL AND t.archived_at IS NULL)
-  )
-ORDER BY t.created_at DESC
-LIMIT $2 OFFSET $3;
+-- Lists a page of the tasks matching the filters. Every row carries the
+-- counts across all pages: the total, the task's start date and deadline
+-- groups and, with group_by_tag, the groups of its tags and the untagged
+-- group. A page past the end returns the last match alone, with a position
+-- at or before page_offset, so that the total is still known.
+WITH matched AS (
+  SELECT t.id, t.title, t.notes, t.owner_id, t.archived_at, t.created_at, t.updated_at, t.start_date, t.deadline, t.pinned, t.completed_at, t.client_request_id, t.last_modified_source, t.last_modified_client_id, t.context, t.last_viewed_at, t.last_modified_token_id, t.last_modified_token_name, t.created_by_source, t.created_by_client_id, t.created_by_token_id, t.created_by_token_name,
+         COUNT(*) OVER () AS total_count,
+         COUNT(*) OVER (PARTITION BY t.start_date) AS start_date_group_count,
+         COUNT(*) OVER (PARTITION BY t.deadline) AS deadline_group_count,
+         ROW_NUMBER() OVER (ORDER BY CASE WHEN sqlc.narg('order_by')::text = 'archived_at_desc' THEN t.archived_at END DESC NULLS LAST,
+                                     CASE WHEN sqlc.narg('order_by')::text = 'archived_at_asc' THEN t.archived_at END ASC NULLS LAST,
+                                     t.pinned DESC, t.created_at DESC) AS position
+  FROM tasks t
+  WHERE t.owner_id = $1
+    AND (sqlc.narg('filter_tag_ids')::uuid[] IS NULL
+         OR (sqlc.narg('tag_match_all')::boolean IS NOT TRUE AND EXISTS (
+               SELECT 1 FROM task_tags tt
+               WHERE tt.task_id = t.id AND tt.tag_id = ANY(sqlc.narg('filter_tag_ids')::uuid[])))
+         OR (sqlc.narg('tag_match_all')::boolean = TRUE AND (
+               SELECT COUNT(DISTINCT tt.tag_id) FROM task_tags tt
+               WHERE tt.task_id = t.id AND tt.tag_id = ANY(sqlc.narg('filter_tag_ids')::uuid[])
+             ) = cardinality(sqlc.narg('filter_tag_ids')::uuid[])))
+    AND (sqlc.narg('exclude_tag_ids')::uuid[] IS NULL
+         OR NOT EXISTS (
+               SELECT 1 FROM task_tags tt
+               WHERE tt.task_id = t.id AND tt.tag_id = ANY(sqlc.narg('exclude_tag_ids')::uuid[])))
+    AND (sqlc.narg('untagged_only')::boolean IS NOT TRUE
+         OR NOT EXISTS (SELECT 1 FROM task_tags tt WHERE tt.task_id = t.id))
+    AND (
+      (sqlc.narg('archived_only')::boolean = TRUE AND t.archived_at IS NOT NULL) OR
+      (sqlc.narg('archived_only')::boolean = FALSE AND (
+        sqlc.narg('include_archived')::boolean = TRUE OR
+        (sqlc.narg('include_archived')::boolean = FALSE AND t.archived_at IS NULL)
+      )) OR
+      (sqlc.narg('archived_only')::boolean IS NULL AND sqlc.narg('include_archived')::boolean IS NULL AND t.archived_at IS NULL)
+    )
+    AND (sqlc.narg('deadline_before')::date IS NULL
+         OR (t.deadline IS NOT NULL AND t.deadline <= sqlc.narg('deadline_before')::date))
+    AND (sqlc.narg('start_date_from')::date IS NULL
+         OR (t.start_date IS NOT NULL AND t.start_date >= sqlc.narg('start_date_from')::date))
+    AND (sqlc.narg('start_date_to')::date IS NULL
+         OR (t.start_date IS NOT NULL AND t.start_date <= sqlc.narg('start_date_to')::date))
+    AND (sqlc.narg('inbox_only')::boolean IS NOT TRUE OR t.start_date IS NULL)
+    AND (sqlc.narg('updated_after')::timestamptz IS NULL OR t.updated_at > sqlc.narg('updated_after')::timestamptz)
+    AND (sqlc.narg('contexts')::text[] IS NULL OR t.context = ANY(sqlc.narg('contexts')::text[]))
+    AND (sqlc.narg('archived_after')::timestamptz IS NULL OR t.archived_at >= sqlc.narg('archived_after')::timestamptz)
+    AND (sqlc.narg('archived_before')::timestamptz IS NULL OR t.archived_at < sqlc.narg('archived_before')::timestamptz)
+    AND (sqlc.narg('query')::text IS NULL
+         OR strpos(lower(t.title), lower(sqlc.narg('query')::text)) > 0
+         -- encrypted notes (enc:d1: prefix) are not searchable
+         OR (t.notes NOT LIKE 'enc:d1:%' AND strpos(lower(t.notes), lower(sqlc.narg('query')::text)) > 0))
+),
+tag_counts AS (
+  SELECT tt.tag_id, COUNT(*) AS task_count
+  FROM matched m
+  JOIN task_tags tt ON tt.task_id = m.id
+  WHERE sqlc.arg(group_by_tag)::boolean
+  GROUP BY tt.tag_id
+)
+SELECT m.id, m.title, m.notes, m.owner_id, m.archived_at, m.created_at, m.updated_at, m.start_date, m.deadline, m.pinned, m.completed_at, m.client_request_id, m.last_modified_source, m.last_modified_client_id, m.context, m.last_viewed_at, m.last_modified_token_id, m.last_modified_token_name, m.created_by_source, m.created_by_client_id, m.created_by_token_id, m.created_by_token_name,
+       m.total_count, m.start_date_group_count, m.deadline_group_count, m.position,
+       (SELECT COUNT(*) FROM matched u
+        WHERE sqlc.arg(group_by_tag)::boolean
+          AND NOT EXISTS (SELECT 1 FROM task_tags tt WHERE tt.task_id = u.id)) AS untagged_count,
+       ARRAY(SELECT tc.tag_id FROM task_tags tt JOIN tag_counts tc ON tc.tag_id = tt.tag_id
+             WHERE tt.task_id = m.id ORDER BY tc.tag_id)::uuid[] AS group_tag_ids,
+       ARRAY(SELECT tc.task_count FROM task_tags tt JOIN tag_counts tc ON tc.tag_id = tt.tag_id
+             WHERE tt.task_id = m.id ORDER BY tc.tag_id)::bigint[] AS group_tag_counts
+FROM matched m
+WHERE (m.position > sqlc.arg(page_offset)::bigint AND m.position <= sqlc.arg(page_offset)::bigint + sqlc.arg(page_limit)::bigint)
+   OR (m.position = m.total_count AND (m.total_count <= sqlc.arg(page_offset)::bigint OR sqlc.arg(page_limit)::bigint = 0))
+ORDER BY m.position;
 
 -- name: ArchiveTask :one
 UPDATE tasks
-SET archived_at = NOW(), updated_at = NOW()
+SET archived_at = NOW(), updated_at = NOW(),
+    last_modified_source = $3, last_modified_client_id = $4,
+    last_modified_token_id = $5, last_modified_token_name = $6
 WHERE id = $1 AND owner_id = $2
-RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date;
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name;
 
 -- name: UnarchiveTask :one
 UPDATE tasks
-SET archived_at = NULL, updated_at = NOW()
+SET archived_at = NULL, updated_at = NOW(),
+    last_modified_source = $3, last_modified_client_id = $4,
+    last_modified_token_id = $5, last_modified_token_name = $6
+WHERE id = $1 AND owner_id = $2
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name;
+
+-- name: CompleteTask :one
+UPDATE tasks
+SET completed_at = COALESCE(completed_at, NOW()), updated_at = NOW(),
+    last_modified_source = $3, last_modified_client_id = $4,
+    last_modified_token_id = $5, last_modified_token_name = $6
+WHERE id = $1 AND owner_id = $2
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name;
+
+-- name: ReopenTask :one
+UPDATE tasks
+SET completed_at = NULL, updated_at = NOW(),
+    last_modified_source = $3, last_modified_client_id = $4,
+    last_modified_token_id = $5, last_modified_token_name = $6
 WHERE id = $1 AND owner_id = $2
-RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date;
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name;
+
+-- name: ArchiveCompletedTasks :execrows
+UPDATE tasks
+SET archived_at = NOW(), updated_at = NOW(),
+    last_modified_source = sqlc.arg(last_modified_source), last_modified_client_id = sqlc.arg(last_modified_client_id),
+    last_modified_token_id = sqlc.arg(last_modified_token_id), last_modified_token_name = sqlc.arg(last_modified_token_name)
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at IS NOT NULL
+  AND archived_at IS NULL
+  AND (sqlc.narg('completed_before')::timestamptz IS NULL
+       OR completed_at <= sqlc.narg('completed_before')::timestamptz);
+
+-- name: CountArchivableCompletedTasks :one
+SELECT COUNT(*)
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at IS NOT NULL
+  AND archived_at IS NULL
+  AND (sqlc.narg('completed_before')::timestamptz IS NULL
+       OR completed_at <= sqlc.narg('completed_before')::timestamptz);
+
+-- name: ArchiveTasksByTag :execrows
+UPDATE tasks
+SET archived_at = NOW(), updated_at = NOW(),
+    last_modified_source = sqlc.arg(last_modified_source), last_modified_client_id = sqlc.arg(last_modified_client_id),
+    last_modified_token_id = sqlc.arg(last_modified_token_id), last_modified_token_name = sqlc.arg(last_modified_token_name)
+WHERE owner_id = sqlc.arg(owner_id)
+  AND archived_at IS NULL
+  AND id IN (SELECT task_id FROM task_tags WHERE tag_id = sqlc.arg(tag_id));
+
+-- name: UnarchiveTasksByTag :execrows
+UPDATE tasks
+SET archived_at = NULL, updated_at = NOW(),
+    last_modified_source = sqlc.arg(last_modified_source), last_modified_client_id = sqlc.arg(last_modified_client_id),
+    last_modified_token_id = sqlc.arg(last_modified_token_id), last_modified_token_name = sqlc.arg(last_modified_token_name)
+WHERE owner_id = sqlc.arg(owner_id)
+  AND archived_at IS NOT NULL
+  AND id IN (SELECT task_id FROM task_tags WHERE tag_id = sqlc.arg(tag_id));
+
+-- name: TogglePinTask :one
+UPDATE tasks
+SET pinned = NOT pinned, updated_at = NOW(),
+    last_modified_source = $3, last_modified_client_id = $4,
+    last_modified_token_id = $5, last_modified_token_name = $6
+WHERE id = $1 AND owner_id = $2
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name;
 
 -- name: ListChecklistItems :many
 SELECT ci.*
@@ -69,6 +277,13 @@ JOIN tasks t ON ci.task_id = t.id
 WHERE ci.task_id = sqlc.arg(task_id) AND t.owner_id = sqlc.arg(owner_id)
 ORDER BY ci.sort_order ASC, ci.created_at ASC;
 
+-- name: ListChecklistItemsForTasks :many
+SELECT ci.*
+FROM task_checklist_items ci
+JOIN tasks t ON ci.task_id = t.id
+WHERE ci.task_id = ANY(sqlc.arg(task_ids)::uuid[]) AND t.owner_id = sqlc.arg(owner_id)
+ORDER BY ci.task_id, ci.sort_order ASC, ci.created_at ASC;
+
 -- name: AddChecklistItem :one
 INSERT INTO task_checklist_items (task_id, content, completed, sort_order)
 SELECT sqlc.arg(task_id), sqlc.arg(content), FALSE,
@@ -77,11 +292,11 @@ FROM tasks
 WHERE id = sqlc.arg(task_id) AND owner_id = sqlc.arg(owner_id)
 RETURNING *;
 
--- name: CreateChecklistItemWithSortOrder :one
+-- name: CreateChecklistItems :many
 INSERT INTO task_checklist_items (task_id, content, completed, sort_order)
-SELECT sqlc.arg(task_id), sqlc.arg(content), FALSE, sqlc.arg(sort_order)
-FROM tasks
-WHERE id = sqlc.arg(task_id) AND owner_id = sqlc.arg(owner_id)
+SELECT t.id, unnest(sqlc.arg(contents)::text[]), FALSE, unnest(sqlc.arg(sort_orders)::int[])
+FROM tasks t
+WHERE t.id = sqlc.arg(task_id) AND t.owner_id = sqlc.arg(owner_id)
 RETURNING *;
 
 -- name: UpdateChecklistItemContent :one
@@ -117,3 +332,127 @@ FROM unnest(sqlc.arg(item_ids)::uuid[]) WITH ORDINALITY AS ordered(id, ord)
 JOIN tasks t ON t.id = sqlc.arg(task_id) AND t.owner_id = sqlc.arg(owner_id)
 WHERE ci.task_id = sqlc.arg(task_id)
   AND ci.id = ordered.id;
+
+-- name: RestoreTask :exec
+-- Inserts a task from a backup with its ID, timestamps and state.
+INSERT INTO tasks (id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21);
+
+-- name: RestoreChecklistItem :exec
+-- Inserts a checklist item from a backup with its ID, state and timestamps.
+INSERT INTO task_checklist_items (id, task_id, content, completed, sort_order, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetTaskActivityCounts :many
+-- Counts created, completed and archived tasks per day or week bucket (UTC).
+SELECT activity.kind::text AS kind,
+       activity.bucket_start::date AS bucket_start,
+       COUNT(*) AS count
+FROM (
+    SELECT 'created' AS kind, date_trunc(sqlc.arg(bucket)::text, c.created_at AT TIME ZONE 'UTC') AS bucket_start
+    FROM tasks c
+    WHERE c.owner_id = sqlc.arg(owner_id) AND c.created_at >= sqlc.arg(since)::timestamptz
+    UNION ALL
+    SELECT 'completed', date_trunc(sqlc.arg(bucket)::text, d.completed_at AT TIME ZONE 'UTC')
+    FROM tasks d
+    WHERE d.owner_id = sqlc.arg(owner_id) AND d.completed_at >= sqlc.arg(since)::timestamptz
+    UNION ALL
+    SELECT 'archived', date_trunc(sqlc.arg(bucket)::text, a.archived_at AT TIME ZONE 'UTC')
+    FROM tasks a
+    WHERE a.owner_id = sqlc.arg(owner_id) AND a.archived_at >= sqlc.arg(since)::timestamptz
+) AS activity
+GROUP BY activity.kind, activity.bucket_start
+ORDER BY activity.bucket_start ASC;
+
+-- name: GetTagTaskCounts :many
+SELECT tg.id AS tag_id,
+       tg.name AS tag_name,
+       COUNT(*) FILTER (WHERE t.completed_at IS NULL AND t.archived_at IS NULL) AS open_count,
+       COUNT(*) FILTER (WHERE t.completed_at IS NOT NULL) AS completed_count
+FROM tags tg
+JOIN task_tags tt ON tt.tag_id = tg.id
+JOIN tasks t ON t.id = tt.task_id AND t.owner_id = tg.owner_id
+WHERE tg.owner_id = $1
+GROUP BY tg.id, tg.name
+ORDER BY tg.name ASC;
+
+-- name: CountBacklogTasks :one
+SELECT COUNT(*)
+FROM tasks
+WHERE owner_id = $1 AND completed_at IS NULL AND archived_at IS NULL;
+
+-- name: GetTaskCounters :one
+-- Counts the owner's open tasks for badges. Today and overdue match
+-- ListTodayTaskIDs and ListOverdueTaskIDs; unread tasks were added or
+-- changed by an agent or the system since the owner last viewed them.
+SELECT COUNT(*) FILTER (WHERE start_date IS NULL) AS inbox_count,
+       COUNT(*) FILTER (WHERE (start_date <= sqlc.arg(today)::date OR deadline = sqlc.arg(today)::date)
+                          AND (deadline IS NULL OR deadline >= sqlc.arg(today)::date)) AS today_count,
+       COUNT(*) FILTER (WHERE deadline < sqlc.arg(today)::date) AS overdue_count,
+       COUNT(*) FILTER (WHERE last_modified_source IN ('agent', 'system')
+                          AND (last_viewed_at IS NULL OR updated_at > last_viewed_at)) AS unread_count
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at IS NULL AND archived_at IS NULL;
+
+-- name: ListStaleTaskIDs :many
+SELECT id
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at IS NULL AND archived_at IS NULL
+  AND updated_at < sqlc.arg(updated_before)::timestamptz
+ORDER BY updated_at ASC
+LIMIT sqlc.arg(max_results);
+
+-- name: ListUndatedTaskIDs :many
+SELECT id
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at IS NULL AND archived_at IS NULL
+  AND start_date IS NULL AND deadline IS NULL
+ORDER BY created_at ASC
+LIMIT sqlc.arg(max_results);
+
+-- name: ListCompletedTaskIDsSince :many
+SELECT id
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at >= sqlc.arg(completed_since)::timestamptz
+ORDER BY completed_at DESC
+LIMIT sqlc.arg(max_results);
+
+-- name: ListOverdueTaskIDs :many
+SELECT id
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at IS NULL AND archived_at IS NULL
+  AND deadline < sqlc.arg(today)::date
+ORDER BY deadline ASC
+LIMIT sqlc.arg(max_results);
+
+-- name: ListTodayTaskIDs :many
+SELECT id
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at IS NULL AND archived_at IS NULL
+  AND (start_date <= sqlc.arg(today)::date OR deadline = sqlc.arg(today)::date)
+  AND (deadline IS NULL OR deadline >= sqlc.arg(today)::date)
+ORDER BY pinned DESC, deadline ASC NULLS LAST, created_at ASC
+LIMIT sqlc.arg(max_results);
+
+-- name: ListCompletedTaskIDsBetween :many
+SELECT id
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id)
+  AND completed_at >= sqlc.arg(completed_from)::timestamptz
+  AND completed_at < sqlc.arg(completed_to)::timestamptz
+ORDER BY completed_at DESC
+LIMIT sqlc.arg(max_results);
+
+-- name: CountChecklistItemsForTasks :many
+SELECT task_id,
+       COUNT(*) AS total_count,
+       COUNT(*) FILTER (WHERE completed) AS completed_count
+FROM task_checklist_items
+WHERE task_id = ANY(sqlc.arg(task_ids)::uuid[])
+GROUP BY task_id;
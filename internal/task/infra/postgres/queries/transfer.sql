@@ -0,0 +1,67 @@
+-- name: LockTransferTasks :many
+-- Locks the owner's tasks among ids so they cannot change while they are
+-- handed to another owner.
+SELECT id
+FROM tasks
+WHERE owner_id = sqlc.arg(owner_id) AND id = ANY(sqlc.arg(ids)::uuid[])
+ORDER BY id ASC
+FOR UPDATE;
+
+-- name: MapTransferTags :many
+-- Maps the tags of the tasks in task_ids, owned by from_owner_id, to the
+-- tags of to_owner_id with the same names, creating the missing ones in the
+-- same statement. created is true for the tags this statement inserted.
+-- tag_id is NULL for a name another transaction inserted meanwhile.
+WITH source AS (
+    SELECT DISTINCT t.id, t.name
+    FROM tags t
+    JOIN task_tags tt ON tt.tag_id = t.id
+    WHERE tt.task_id = ANY(sqlc.arg(task_ids)::uuid[]) AND t.owner_id = sqlc.arg(from_owner_id)
+), inserted AS (
+    INSERT INTO tags (name, owner_id)
+    SELECT name, sqlc.arg(to_owner_id) FROM source
+    ON CONFLICT (owner_id, name) DO NOTHING
+    RETURNING id, name
+)
+SELECT s.id AS source_id, COALESCE(i.id, t.id) AS tag_id, (i.id IS NOT NULL)::boolean AS created
+FROM source s
+LEFT JOIN inserted i ON i.name = s.name
+LEFT JOIN tags t ON t.owner_id = sqlc.arg(to_owner_id) AND t.name = s.name;
+
+-- name: TransferTask :exec
+-- Gives the task to to_owner_id with its notes sealed for them. The client
+-- request ID is dropped when the recipient already used it.
+UPDATE tasks
+SET owner_id = sqlc.arg(to_owner_id), notes = sqlc.arg(notes), updated_at = NOW(),
+    client_request_id = CASE WHEN EXISTS (
+        SELECT 1 FROM tasks other
+        WHERE other.owner_id = sqlc.arg(to_owner_id) AND other.client_request_id = tasks.client_request_id
+    ) THEN NULL ELSE tasks.client_request_id END,
+    last_modified_source = sqlc.arg(last_modified_source), last_modified_client_id = sqlc.arg(last_modified_client_id),
+    last_modified_token_id = sqlc.arg(last_modified_token_id), last_modified_token_name = sqlc.arg(last_modified_token_name)
+WHERE id = sqlc.arg(id) AND owner_id = sqlc.arg(from_owner_id);
+
+-- name: RemapTaskTags :exec
+-- Replaces the tags of the tasks in task_ids by their counterparts, given
+-- pairwise in old_tag_ids and new_tag_ids.
+UPDATE task_tags tt
+SET tag_id = m.new_tag_id
+FROM unnest(sqlc.arg(old_tag_ids)::uuid[], sqlc.arg(new_tag_ids)::uuid[]) AS m(old_tag_id, new_tag_id)
+WHERE tt.task_id = ANY(sqlc.arg(task_ids)::uuid[]) AND tt.tag_id = m.old_tag_id;
+
+-- name: ListTransferNoteRevisions :many
+SELECT id, notes
+FROM task_note_revisions
+WHERE task_id = ANY(sqlc.arg(task_ids)::uuid[]);
+
+-- name: UpdateNoteRevisionNotes :exec
+UPDATE task_note_revisions
+SET notes = $2
+WHERE id = $1;
+
+-- name: RecordTransferTombstones :exec
+-- Records the tasks in task_ids as deleted for owner_id, their previous
+-- owner, replacing tombstones left by an earlier owner.
+INSERT INTO task_tombstones (task_id, owner_id)
+SELECT unnest(sqlc.arg(task_ids)::uuid[]), sqlc.arg(owner_id)
+ON CONFLICT (task_id) DO UPDATE SET owner_id = EXCLUDED.owner_id, deleted_at = NOW();
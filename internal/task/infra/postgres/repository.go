@@ -2,49 +2,120 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/database"
+	"github.com/slips-ai/slips-core/pkg/envelope"
 )
 
 // TaskRepository implements domain.Repository using PostgreSQL
 type TaskRepository struct {
-	pool    *pgxpool.Pool
-	queries *Queries
+	pool        database.Pool
+	queries     *Queries
+	readQueries *Queries
+	// notes decrypts encrypted task notes; nil when no keyring is configured
+	notes *notesCipher
 }
 
-// NewTaskRepository creates a new task repository
-func NewTaskRepository(pool *pgxpool.Pool) *TaskRepository {
-	return &TaskRepository{
-		pool:    pool,
-		queries: New(pool),
+// NewTaskRepository creates a new task repository.
+// List, batch and stats queries go through reader, which may route them to
+// read replicas; pass the pool itself when no replicas are configured. Get
+// stays on the primary because services use it for read-modify-write. With
+// shards both are the shard router.
+// Notes are encrypted with per-user data keys wrapped by keyring when
+// encryptNotes is set; keyring may be nil when encryption is not configured.
+func NewTaskRepository(pool database.Pool, reader DBTX, keyring *envelope.Keyring, encryptNotes bool) *TaskRepository {
+	r := &TaskRepository{
+		pool:        pool,
+		queries:     New(pool),
+		readQueries: New(reader),
 	}
+	if keyring != nil {
+		r.notes = &notesCipher{
+			keyring: keyring,
+			queries: r.queries,
+			encrypt: encryptNotes,
+			keys:    make(map[string][]byte),
+		}
+	}
+	return r
 }
 
-// Create creates a new task
-func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
+// withTx runs fn with transaction-bound queries, committing when fn succeeds
+// and rolling back otherwise so multi-statement writes are applied atomically.
+func (r *TaskRepository) withTx(ctx context.Context, fn func(q *Queries) error) error {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback(ctx)
 
-	txQueries := r.queries.WithTx(tx)
+	if err := fn(r.queries.WithTx(tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
 
-	result, err := txQueries.CreateTask(ctx, CreateTaskParams{
-		Title:     task.Title,
-		Notes:     task.Notes,
-		OwnerID:   task.OwnerID,
-		StartDate: timeToPgDate(task.StartDate),
+// Create creates a new task. When the owner already created a task with the
+// same client request ID, task is replaced by that task instead.
+func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
+	notes, err := r.notes.seal(ctx, task.OwnerID, task.Notes)
+	if err != nil {
+		return err
+	}
+
+	return r.withTx(ctx, func(txQueries *Queries) error {
+		result, err := txQueries.CreateTask(ctx, CreateTaskParams{
+			Title:                 task.Title,
+			Notes:                 notes,
+			OwnerID:               task.OwnerID,
+			StartDate:             timeToPgDate(task.StartDate),
+			Deadline:              timeToPgDate(task.Deadline),
+			ClientRequestID:       textFromString(task.ClientRequestID),
+			LastModifiedSource:    textFromString(string(task.LastModifiedBy.Source)),
+			LastModifiedClientID:  textFromString(task.LastModifiedBy.ClientID),
+			LastModifiedTokenID:   nullableUUID(task.LastModifiedBy.TokenID),
+			LastModifiedTokenName: textFromString(task.LastModifiedBy.TokenName),
+			Context:               textFromString(task.Context),
+		})
+		if errors.Is(err, pgx.ErrNoRows) && task.ClientRequestID != "" {
+			return r.loadByClientRequestID(ctx, txQueries, task)
+		}
+		if err != nil {
+			return err
+		}
+		return r.createChildren(ctx, txQueries, task, result)
+	})
+}
+
+// loadByClientRequestID replaces task with the task its owner created
+// earlier under the same client request ID
+func (r *TaskRepository) loadByClientRequestID(ctx context.Context, q *Queries, task *domain.Task) error {
+	id, err := q.GetTaskIDByClientRequestID(ctx, GetTaskIDByClientRequestIDParams{
+		OwnerID:         task.OwnerID,
+		ClientRequestID: textFromString(task.ClientRequestID),
 	})
 	if err != nil {
 		return err
 	}
+	existing, err := r.get(ctx, q, id.Bytes, task.OwnerID)
+	if err != nil {
+		return err
+	}
+	*task = *existing
+	return nil
+}
 
+// createChildren fills task from its inserted row and inserts its tag
+// associations and checklist items
+func (r *TaskRepository) createChildren(ctx context.Context, txQueries *Queries, task *domain.Task, result CreateTaskRow) error {
 	taskID, err := uuid.FromBytes(result.ID.Bytes[:])
 	if err != nil {
 		return err
@@ -57,21 +128,34 @@ func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
 	} else {
 		task.ArchivedAt = nil
 	}
+	if result.CompletedAt.Valid {
+		task.CompletedAt = &result.CompletedAt.Time
+	} else {
+		task.CompletedAt = nil
+	}
+	if result.LastViewedAt.Valid {
+		task.LastViewedAt = &result.LastViewedAt.Time
+	} else {
+		task.LastViewedAt = nil
+	}
 	task.StartDate = pgDateToTime(result.StartDate)
-
-	// Create task_tags associations
-	for _, tagID := range task.TagIDs {
-		pgTaskID := pgtype.UUID{
-			Bytes: taskID,
-			Valid: true,
-		}
-		pgTagID := pgtype.UUID{
-			Bytes: tagID,
-			Valid: true,
-		}
-		err := txQueries.CreateTaskTag(ctx, CreateTaskTagParams{
+	task.Deadline = pgDateToTime(result.Deadline)
+	task.Pinned = result.Pinned
+	task.ClientRequestID = result.ClientRequestID.String
+	task.Context = result.Context.String
+	task.LastModifiedBy = modifierFromDB(result.LastModifiedSource, result.LastModifiedClientID, result.LastModifiedTokenID, result.LastModifiedTokenName)
+	task.CreatedBy = modifierFromDB(result.CreatedBySource, result.CreatedByClientID, result.CreatedByTokenID, result.CreatedByTokenName)
+
+	// Create task_tags associations and checklist items with one
+	// statement each
+	pgTaskID := pgtype.UUID{
+		Bytes: taskID,
+		Valid: true,
+	}
+	if len(task.TagIDs) > 0 {
+		err := txQueries.CreateTaskTags(ctx, CreateTaskTagsParams{
 			TaskID: pgTaskID,
-			TagID:  pgTagID,
+			TagIds: uuidsToPgUUIDs(task.TagIDs),
 		})
 		if err != nil {
 			return err
@@ -79,45 +163,69 @@ func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
 	}
 
 	createdChecklist := make([]domain.ChecklistItem, 0, len(task.Checklist))
-	for _, item := range task.Checklist {
-		row, err := txQueries.CreateChecklistItemWithSortOrder(ctx, CreateChecklistItemWithSortOrderParams{
-			TaskID:    pgtype.UUID{Bytes: taskID, Valid: true},
-			OwnerID:   task.OwnerID,
-			Content:   item.Content,
-			SortOrder: item.SortOrder,
+	if len(task.Checklist) > 0 {
+		contents := make([]string, len(task.Checklist))
+		sortOrders := make([]int32, len(task.Checklist))
+		for i, item := range task.Checklist {
+			contents[i] = item.Content
+			sortOrders[i] = item.SortOrder
+		}
+		rows, err := txQueries.CreateChecklistItems(ctx, CreateChecklistItemsParams{
+			Contents:   contents,
+			SortOrders: sortOrders,
+			TaskID:     pgTaskID,
+			OwnerID:    task.OwnerID,
 		})
 		if err != nil {
 			return err
 		}
 
-		createdItem, err := checklistItemFromDB(row)
-		if err != nil {
-			return err
+		for _, row := range rows {
+			createdItem, err := checklistItemFromDB(row)
+			if err != nil {
+				return err
+			}
+			createdChecklist = append(createdChecklist, createdItem)
 		}
-		createdChecklist = append(createdChecklist, createdItem)
+		// RETURNING order is not guaranteed
+		sort.SliceStable(createdChecklist, func(i, j int) bool {
+			return createdChecklist[i].SortOrder < createdChecklist[j].SortOrder
+		})
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return err
-	}
 	task.Checklist = createdChecklist
-
 	return nil
 }
 
 // Get retrieves a task by ID
 func (r *TaskRepository) Get(ctx context.Context, id uuid.UUID, ownerID string) (*domain.Task, error) {
+	return r.get(ctx, r.queries, id, ownerID)
+}
+
+// GetPartial retrieves a task without the parts load leaves out, skipping
+// their queries
+func (r *TaskRepository) GetPartial(ctx context.Context, id uuid.UUID, ownerID string, load domain.LoadOptions) (*domain.Task, error) {
+	return r.getPartial(ctx, r.queries, id, ownerID, load)
+}
+
+// get loads a task with its tags and checklist through q
+func (r *TaskRepository) get(ctx context.Context, q *Queries, id uuid.UUID, ownerID string) (*domain.Task, error) {
+	return r.getPartial(ctx, q, id, ownerID, domain.LoadOptions{})
+}
+
+// getPartial loads a task through q, with the parts load does not leave out
+func (r *TaskRepository) getPartial(ctx context.Context, q *Queries, id uuid.UUID, ownerID string, load domain.LoadOptions) (*domain.Task, error) {
 	pgID := pgtype.UUID{
 		Bytes: id,
 		Valid: true,
 	}
 
-	result, err := r.queries.GetTask(ctx, GetTaskParams{
+	result, err := q.GetTask(ctx, GetTaskParams{
 		ID:      pgID,
 		OwnerID: ownerID,
 	})
 	if err != nil {
-		return nil, err
+		return nil, taskNotFound(err)
 	}
 
 	taskID, err := uuid.FromBytes(result.ID.Bytes[:])
@@ -126,74 +234,347 @@ func (r *TaskRepository) Get(ctx context.Context, id uuid.UUID, ownerID string)
 	}
 
 	// Get task tag IDs
-	pgTagIDs, err := r.queries.GetTaskTagIDs(ctx, pgID)
-	if err != nil {
-		return nil, err
+	tagIDs := []uuid.UUID{}
+	if !load.SkipTags {
+		pgTagIDs, err := q.GetTaskTagIDs(ctx, pgID)
+		if err != nil {
+			return nil, err
+		}
+
+		tagIDs = make([]uuid.UUID, len(pgTagIDs))
+		for i, pgTagID := range pgTagIDs {
+			tagID, err := uuid.FromBytes(pgTagID.Bytes[:])
+			if err != nil {
+				return nil, err
+			}
+			tagIDs[i] = tagID
+		}
 	}
 
-	tagIDs := make([]uuid.UUID, len(pgTagIDs))
-	for i, pgTagID := range pgTagIDs {
-		tagID, err := uuid.FromBytes(pgTagID.Bytes[:])
+	var notes string
+	if !load.SkipNotes {
+		notes, err = r.notes.open(ctx, result.OwnerID, result.Notes)
 		if err != nil {
 			return nil, err
 		}
-		tagIDs[i] = tagID
 	}
-
 	task := &domain.Task{
-		ID:        taskID,
-		Title:     result.Title,
-		Notes:     result.Notes,
-		TagIDs:    tagIDs,
-		OwnerID:   result.OwnerID,
-		CreatedAt: result.CreatedAt.Time,
-		UpdatedAt: result.UpdatedAt.Time,
-		StartDate: pgDateToTime(result.StartDate),
+		ID:              taskID,
+		Title:           result.Title,
+		Notes:           notes,
+		TagIDs:          tagIDs,
+		OwnerID:         result.OwnerID,
+		CreatedAt:       result.CreatedAt.Time,
+		UpdatedAt:       result.UpdatedAt.Time,
+		StartDate:       pgDateToTime(result.StartDate),
+		Deadline:        pgDateToTime(result.Deadline),
+		Pinned:          result.Pinned,
+		ClientRequestID: result.ClientRequestID.String,
+		Context:         result.Context.String,
+		LastModifiedBy:  modifierFromDB(result.LastModifiedSource, result.LastModifiedClientID, result.LastModifiedTokenID, result.LastModifiedTokenName),
+		CreatedBy:       modifierFromDB(result.CreatedBySource, result.CreatedByClientID, result.CreatedByTokenID, result.CreatedByTokenName),
 	}
-	checklistItems, err := r.ListChecklistItems(ctx, id, ownerID)
+	if !load.SkipChecklist {
+		checklistItems, err := loadChecklistItems(ctx, q, id, ownerID)
+		if err != nil {
+			return nil, err
+		}
+		task.Checklist = checklistItems
+	}
+	geofences, err := geofencesForTasks(ctx, q, ownerID, []pgtype.UUID{pgID})
 	if err != nil {
 		return nil, err
 	}
-	task.Checklist = checklistItems
+	task.Geofence = geofences[taskID]
 	if result.ArchivedAt.Valid {
 		task.ArchivedAt = &result.ArchivedAt.Time
 	}
+	if result.CompletedAt.Valid {
+		task.CompletedAt = &result.CompletedAt.Time
+	}
+	if result.LastViewedAt.Valid {
+		task.LastViewedAt = &result.LastViewedAt.Time
+	}
 	return task, nil
 }
 
+// GetMany retrieves the tasks with the given IDs owned by ownerID.
+// IDs that do not exist or belong to another owner are silently skipped.
+func (r *TaskRepository) GetMany(ctx context.Context, ids []uuid.UUID, ownerID string) ([]*domain.Task, error) {
+	pgIDs := uuidsToPgUUIDs(ids)
+	if len(pgIDs) == 0 {
+		return []*domain.Task{}, nil
+	}
+
+	results, err := r.readQueries.GetTasksByIDs(ctx, GetTasksByIDsParams{
+		Ids:     pgIDs,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.tasksFromRows(ctx, ownerID, results)
+}
+
+// tasksFromRows converts rows of the owner's tasks, loading their tags and
+// checklist items in one round trip each
+func (r *TaskRepository) tasksFromRows(ctx context.Context, ownerID string, results []GetTasksByIDsRow) ([]*domain.Task, error) {
+	if len(results) == 0 {
+		return []*domain.Task{}, nil
+	}
+	pgIDs := make([]pgtype.UUID, len(results))
+	for i, result := range results {
+		pgIDs[i] = result.ID
+	}
+
+	tagIDsByTask, err := r.tagIDsForTasks(ctx, pgIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	checklistRows, err := r.readQueries.ListChecklistItemsForTasks(ctx, ListChecklistItemsForTasksParams{
+		TaskIds: pgIDs,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	checklistByTask := make(map[uuid.UUID][]domain.ChecklistItem)
+	for _, row := range checklistRows {
+		item, err := checklistItemFromDB(row)
+		if err != nil {
+			return nil, err
+		}
+		checklistByTask[item.TaskID] = append(checklistByTask[item.TaskID], item)
+	}
+	geofences, err := geofencesForTasks(ctx, r.readQueries, ownerID, pgIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*domain.Task, len(results))
+	for i, result := range results {
+		taskID, err := uuid.FromBytes(result.ID.Bytes[:])
+		if err != nil {
+			return nil, err
+		}
+
+		tagIDs := tagIDsByTask[taskID]
+		if tagIDs == nil {
+			tagIDs = []uuid.UUID{}
+		}
+		checklist := checklistByTask[taskID]
+		if checklist == nil {
+			checklist = []domain.ChecklistItem{}
+		}
+
+		notes, err := r.notes.open(ctx, result.OwnerID, result.Notes)
+		if err != nil {
+			return nil, err
+		}
+		task := &domain.Task{
+			ID:              taskID,
+			Title:           result.Title,
+			Notes:           notes,
+			TagIDs:          tagIDs,
+			Checklist:       checklist,
+			OwnerID:         result.OwnerID,
+			CreatedAt:       result.CreatedAt.Time,
+			UpdatedAt:       result.UpdatedAt.Time,
+			StartDate:       pgDateToTime(result.StartDate),
+			Deadline:        pgDateToTime(result.Deadline),
+			Pinned:          result.Pinned,
+			ClientRequestID: result.ClientRequestID.String,
+			Context:         result.Context.String,
+			LastModifiedBy:  modifierFromDB(result.LastModifiedSource, result.LastModifiedClientID, result.LastModifiedTokenID, result.LastModifiedTokenName),
+			CreatedBy:       modifierFromDB(result.CreatedBySource, result.CreatedByClientID, result.CreatedByTokenID, result.CreatedByTokenName),
+			Geofence:        geofences[taskID],
+		}
+		if result.ArchivedAt.Valid {
+			task.ArchivedAt = &result.ArchivedAt.Time
+		}
+		if result.CompletedAt.Valid {
+			task.CompletedAt = &result.CompletedAt.Time
+		}
+		if result.LastViewedAt.Valid {
+			task.LastViewedAt = &result.LastViewedAt.Time
+		}
+		tasks[i] = task
+	}
+
+	return tasks, nil
+}
+
+// ListAfter returns the next page of an owner's tasks in ID order
+func (r *TaskRepository) ListAfter(ctx context.Context, ownerID string, after uuid.UUID, limit int, opts domain.ScanOptions) ([]*domain.Task, error) {
+	if limit <= 0 {
+		return []*domain.Task{}, nil
+	}
+
+	rows, err := r.readQueries.ListTasksAfter(ctx, ListTasksAfterParams{
+		OwnerID:         ownerID,
+		AfterID:         pgtype.UUID{Bytes: after, Valid: true},
+		ArchivedOnly:    opts.ArchivedOnly,
+		IncludeArchived: opts.IncludeArchived,
+		PageLimit:       int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]GetTasksByIDsRow, len(rows))
+	for i, row := range rows {
+		results[i] = GetTasksByIDsRow(row)
+	}
+	return r.tasksFromRows(ctx, ownerID, results)
+}
+
+// ListTriggerEvents returns a page of an owner's trigger events, newest first
+func (r *TaskRepository) ListTriggerEvents(ctx context.Context, ownerID string, kind domain.TriggerKind, after *domain.TriggerCursor, limit int) ([]domain.TriggerEvent, error) {
+	if limit <= 0 {
+		return []domain.TriggerEvent{}, nil
+	}
+
+	var beforeAt pgtype.Timestamptz
+	var beforeTaskID, beforeTagID pgtype.UUID
+	if after != nil {
+		beforeAt = pgtype.Timestamptz{Time: after.OccurredAt, Valid: true}
+		beforeTaskID = pgtype.UUID{Bytes: after.TaskID, Valid: true}
+		beforeTagID = pgtype.UUID{Bytes: after.TagID, Valid: true}
+	}
+
+	var events []domain.TriggerEvent
+	switch kind {
+	case domain.TriggerTaskCreated:
+		rows, err := r.readQueries.ListCreatedTaskEvents(ctx, ListCreatedTaskEventsParams{
+			OwnerID:      ownerID,
+			BeforeAt:     beforeAt,
+			BeforeTaskID: beforeTaskID,
+			PageLimit:    int32(limit),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			events = append(events, domain.TriggerEvent{Kind: kind, OccurredAt: row.CreatedAt.Time, TaskID: uuid.UUID(row.ID.Bytes)})
+		}
+	case domain.TriggerTaskCompleted:
+		rows, err := r.readQueries.ListCompletedTaskEvents(ctx, ListCompletedTaskEventsParams{
+			OwnerID:      ownerID,
+			BeforeAt:     beforeAt,
+			BeforeTaskID: beforeTaskID,
+			PageLimit:    int32(limit),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			events = append(events, domain.TriggerEvent{Kind: kind, OccurredAt: row.CompletedAt.Time, TaskID: uuid.UUID(row.ID.Bytes)})
+		}
+	case domain.TriggerTagAdded:
+		rows, err := r.readQueries.ListTagAddedEvents(ctx, ListTagAddedEventsParams{
+			OwnerID:      ownerID,
+			BeforeAt:     beforeAt,
+			BeforeTaskID: beforeTaskID,
+			BeforeTagID:  beforeTagID,
+			PageLimit:    int32(limit),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			events = append(events, domain.TriggerEvent{
+				Kind:       kind,
+				OccurredAt: row.CreatedAt.Time,
+				TaskID:     uuid.UUID(row.TaskID.Bytes),
+				TagID:      uuid.UUID(row.TagID.Bytes),
+				TagName:    row.Name,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unknown trigger kind %d", kind)
+	}
+
+	ids := make([]uuid.UUID, len(events))
+	for i, event := range events {
+		ids[i] = event.TaskID
+	}
+	tasks, err := r.GetMany(ctx, ids, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*domain.Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = task
+	}
+
+	// A task deleted between the two queries drops its events
+	loaded := make([]domain.TriggerEvent, 0, len(events))
+	for _, event := range events {
+		if event.Task = byID[event.TaskID]; event.Task != nil {
+			loaded = append(loaded, event)
+		}
+	}
+	return loaded, nil
+}
+
 // Update updates a task
 func (r *TaskRepository) Update(ctx context.Context, task *domain.Task) error {
+	notes, err := r.notes.seal(ctx, task.OwnerID, task.Notes)
+	if err != nil {
+		return err
+	}
+
+	return r.withTx(ctx, func(txQueries *Queries) error {
+		return r.update(ctx, txQueries, task, notes)
+	})
+}
+
+// update saves task with its already sealed notes and replaces its tag
+// associations through the transaction-bound txQueries
+func (r *TaskRepository) update(ctx context.Context, txQueries *Queries, task *domain.Task, notes string) error {
 	pgID := pgtype.UUID{
 		Bytes: task.ID,
 		Valid: true,
 	}
+	if err := r.snapshotNotes(ctx, txQueries, task); err != nil {
+		return err
+	}
 
-	result, err := r.queries.UpdateTask(ctx, UpdateTaskParams{
-		ID:        pgID,
-		Title:     task.Title,
-		Notes:     task.Notes,
-		OwnerID:   task.OwnerID,
-		StartDate: timeToPgDate(task.StartDate),
+	result, err := txQueries.UpdateTask(ctx, UpdateTaskParams{
+		ID:                    pgID,
+		Title:                 task.Title,
+		Notes:                 notes,
+		OwnerID:               task.OwnerID,
+		StartDate:             timeToPgDate(task.StartDate),
+		Deadline:              timeToPgDate(task.Deadline),
+		LastModifiedSource:    textFromString(string(task.LastModifiedBy.Source)),
+		LastModifiedClientID:  textFromString(task.LastModifiedBy.ClientID),
+		LastModifiedTokenID:   nullableUUID(task.LastModifiedBy.TokenID),
+		LastModifiedTokenName: textFromString(task.LastModifiedBy.TokenName),
+		Context:               textFromString(task.Context),
 	})
 	if err != nil {
-		return err
+		return taskNotFound(err)
 	}
 
-	// Delete existing task_tags associations
-	err = r.queries.DeleteTaskTags(ctx, pgID)
+	// Replace task_tags associations, keeping the ones that stay. An
+	// empty (not nil) array makes the delete remove every association.
+	tagIDs := uuidsToPgUUIDs(task.TagIDs)
+	if tagIDs == nil {
+		tagIDs = []pgtype.UUID{}
+	}
+	err = txQueries.DeleteTaskTagsNotIn(ctx, DeleteTaskTagsNotInParams{
+		TaskID: pgID,
+		TagIds: tagIDs,
+	})
 	if err != nil {
 		return err
 	}
-
-	// Create new task_tags associations
-	for _, tagID := range task.TagIDs {
-		pgTagID := pgtype.UUID{
-			Bytes: tagID,
-			Valid: true,
-		}
-		err := r.queries.CreateTaskTag(ctx, CreateTaskTagParams{
+	if len(tagIDs) > 0 {
+		err = txQueries.CreateTaskTags(ctx, CreateTaskTagsParams{
 			TaskID: pgID,
-			TagID:  pgTagID,
+			TagIds: tagIDs,
 		})
 		if err != nil {
 			return err
@@ -201,10 +582,12 @@ func (r *TaskRepository) Update(ctx context.Context, task *domain.Task) error {
 	}
 
 	task.UpdatedAt = result.UpdatedAt.Time
+	task.LastModifiedBy = modifierFromDB(result.LastModifiedSource, result.LastModifiedClientID, result.LastModifiedTokenID, result.LastModifiedTokenName)
+	task.CreatedBy = modifierFromDB(result.CreatedBySource, result.CreatedByClientID, result.CreatedByTokenID, result.CreatedByTokenName)
 	return nil
 }
 
-// Delete deletes a task
+// Delete deletes a task and records a tombstone for sync clients
 func (r *TaskRepository) Delete(ctx context.Context, id uuid.UUID, ownerID string) error {
 	pgID := pgtype.UUID{
 		Bytes: id,
@@ -216,8 +599,59 @@ func (r *TaskRepository) Delete(ctx context.Context, id uuid.UUID, ownerID strin
 	})
 }
 
+// ListTombstones lists a page of tasks deleted after the given instant
+func (r *TaskRepository) ListTombstones(ctx context.Context, ownerID string, deletedAfter time.Time, after *domain.TombstoneCursor, limit int) ([]domain.Tombstone, error) {
+	if limit <= 0 {
+		return []domain.Tombstone{}, nil
+	}
+
+	var afterAt pgtype.Timestamptz
+	var afterTaskID pgtype.UUID
+	if after != nil {
+		afterAt = pgtype.Timestamptz{Time: after.DeletedAt, Valid: true}
+		afterTaskID = pgtype.UUID{Bytes: after.TaskID, Valid: true}
+	}
+
+	results, err := r.readQueries.ListTaskTombstones(ctx, ListTaskTombstonesParams{
+		OwnerID: ownerID,
+		DeletedAfter: pgtype.Timestamptz{
+			Time:  deletedAfter,
+			Valid: true,
+		},
+		AfterAt:     afterAt,
+		AfterTaskID: afterTaskID,
+		PageLimit:   int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tombstones := make([]domain.Tombstone, len(results))
+	for i, result := range results {
+		taskID, err := uuid.FromBytes(result.TaskID.Bytes[:])
+		if err != nil {
+			return nil, err
+		}
+		tombstones[i] = domain.Tombstone{
+			TaskID:    taskID,
+			DeletedAt: result.DeletedAt.Time,
+		}
+	}
+
+	return tombstones, nil
+}
+
+// PurgeTombstones deletes the tombstones of every owner recorded before
+// deletedBefore
+func (r *TaskRepository) PurgeTombstones(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	return r.queries.PurgeTaskTombstones(ctx, pgtype.Timestamptz{
+		Time:  deletedBefore,
+		Valid: true,
+	})
+}
+
 // List lists tasks with pagination
-func (r *TaskRepository) List(ctx context.Context, ownerID string, filterTagIDs []uuid.UUID, limit, offset int, opts domain.ListOptions) ([]*domain.Task, error) {
+func (r *TaskRepository) List(ctx context.Context, ownerID string, filterTagIDs []uuid.UUID, limit, offset int, opts domain.ListOptions) (*domain.ListResult, error) {
 	// Validate parameters to prevent negative values and potential overflow
 	if limit < 0 {
 		limit = 0
@@ -226,24 +660,22 @@ func (r *TaskRepository) List(ctx context.Context, ownerID string, filterTagIDs
 		offset = 0
 	}
 
-	// Convert filterTagIDs to pgtype.UUID slice
-	var pgFilterTagIDs []pgtype.UUID
-	if len(filterTagIDs) > 0 {
-		pgFilterTagIDs = make([]pgtype.UUID, len(filterTagIDs))
-		for i, tagID := range filterTagIDs {
-			pgFilterTagIDs[i] = pgtype.UUID{
-				Bytes: tagID,
-				Valid: true,
-			}
-		}
-	}
-
 	// Convert to int32 (validation is done at gRPC layer)
-	results, err := r.queries.ListTasks(ctx, ListTasksParams{
+	params := ListTasksParams{
 		OwnerID:      ownerID,
-		Limit:        int32(limit),
-		Offset:       int32(offset),
-		FilterTagIds: pgFilterTagIDs,
+		PageLimit:    int64(limit),
+		PageOffset:   int64(offset),
+		GroupByTag:   opts.GroupBy == domain.GroupByTag,
+		FilterTagIds: uuidsToPgUUIDs(filterTagIDs),
+		TagMatchAll: pgtype.Bool{
+			Bool:  opts.TagMatchAll,
+			Valid: true,
+		},
+		ExcludeTagIds: uuidsToPgUUIDs(opts.ExcludeTagIDs),
+		UntaggedOnly: pgtype.Bool{
+			Bool:  opts.UntaggedOnly,
+			Valid: true,
+		},
 		IncludeArchived: pgtype.Bool{
 			Bool:  opts.IncludeArchived,
 			Valid: true,
@@ -252,162 +684,580 @@ func (r *TaskRepository) List(ctx context.Context, ownerID string, filterTagIDs
 			Bool:  opts.ArchivedOnly,
 			Valid: true,
 		},
-	})
+		DeadlineBefore: timeToPgDate(opts.DeadlineBefore),
+		StartDateFrom:  timeToPgDate(opts.StartDateFrom),
+		StartDateTo:    timeToPgDate(opts.StartDateTo),
+		InboxOnly: pgtype.Bool{
+			Bool:  opts.InboxOnly,
+			Valid: true,
+		},
+		UpdatedAfter:   timeToPgTimestamptz(opts.UpdatedAfter),
+		Contexts:       contextsParam(opts.Contexts),
+		ArchivedAfter:  timeToPgTimestamptz(opts.ArchivedAfter),
+		ArchivedBefore: timeToPgTimestamptz(opts.ArchivedBefore),
+		Query: pgtype.Text{
+			String: opts.Query,
+			Valid:  opts.Query != "",
+		},
+		OrderBy: orderByParam(opts.Order),
+	}
+	rows, err := r.readQueries.ListTasks(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
-	tasks := make([]*domain.Task, len(results))
+	// Every row carries the counts across all pages; a page past the end
+	// holds only the last match, which is not part of the page
+	listResult := &domain.ListResult{}
+	tagGroupCounts := make(map[string]int)
+	results := make([]ListTasksRow, 0, len(rows))
+	for _, row := range rows {
+		listResult.TotalSize = int(row.TotalCount)
+		tagGroupCounts[""] = int(row.UntaggedCount)
+		for i, tagID := range row.GroupTagIds {
+			tagGroupCounts[uuid.UUID(tagID.Bytes).String()] = int(row.GroupTagCounts[i])
+		}
+		if row.Position > params.PageOffset && row.Position <= params.PageOffset+params.PageLimit {
+			results = append(results, row)
+		}
+	}
+	listResult.Tasks = make([]*domain.Task, len(results))
+	seenGroups := make(map[string]struct{})
+
+	// Load tag IDs and checklist counts for the whole page in constant queries
+	pgTaskIDs := make([]pgtype.UUID, len(results))
 	for i, result := range results {
-		taskID, err := uuid.FromBytes(result.ID.Bytes[:])
+		pgTaskIDs[i] = result.ID
+	}
+	var tagIDsByTask map[uuid.UUID][]uuid.UUID
+	if !opts.Load.SkipTags || opts.GroupBy == domain.GroupByTag {
+		tagIDsByTask, err = r.tagIDsForTasks(ctx, pgTaskIDs)
 		if err != nil {
 			return nil, err
 		}
-
-		// Get task tag IDs
-		pgTaskID := pgtype.UUID{
-			Bytes: taskID,
-			Valid: true,
+	}
+	countsByTask := make(map[uuid.UUID]CountChecklistItemsForTasksRow)
+	if !opts.Load.SkipChecklist {
+		checklistCounts, err := r.readQueries.CountChecklistItemsForTasks(ctx, pgTaskIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range checklistCounts {
+			countsByTask[uuid.UUID(row.TaskID.Bytes)] = row
 		}
-		pgTagIDs, err := r.queries.GetTaskTagIDs(ctx, pgTaskID)
+	}
+	geofences, err := geofencesForTasks(ctx, r.readQueries, ownerID, pgTaskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, result := range results {
+		taskID, err := uuid.FromBytes(result.ID.Bytes[:])
 		if err != nil {
 			return nil, err
 		}
 
-		tagIDs := make([]uuid.UUID, len(pgTagIDs))
-		for j, pgTagID := range pgTagIDs {
-			tagID, err := uuid.FromBytes(pgTagID.Bytes[:])
+		tagIDs := tagIDsByTask[taskID]
+		if tagIDs == nil || opts.Load.SkipTags {
+			// Loaded for grouping only
+			tagIDs = []uuid.UUID{}
+		}
+		checklistCounts := countsByTask[taskID]
+
+		var notes string
+		if !opts.Load.SkipNotes {
+			notes, err = r.notes.open(ctx, result.OwnerID, result.Notes)
 			if err != nil {
 				return nil, err
 			}
-			tagIDs[j] = tagID
 		}
-
 		task := &domain.Task{
-			ID:        taskID,
-			Title:     result.Title,
-			Notes:     result.Notes,
-			TagIDs:    tagIDs,
-			OwnerID:   result.OwnerID,
-			CreatedAt: result.CreatedAt.Time,
-			UpdatedAt: result.UpdatedAt.Time,
-			StartDate: pgDateToTime(result.StartDate),
+			ID:                 taskID,
+			Title:              result.Title,
+			Notes:              notes,
+			TagIDs:             tagIDs,
+			ChecklistTotal:     int(checklistCounts.TotalCount),
+			ChecklistCompleted: int(checklistCounts.CompletedCount),
+			OwnerID:            result.OwnerID,
+			CreatedAt:          result.CreatedAt.Time,
+			UpdatedAt:          result.UpdatedAt.Time,
+			StartDate:          pgDateToTime(result.StartDate),
+			Deadline:           pgDateToTime(result.Deadline),
+			Pinned:             result.Pinned,
+			ClientRequestID:    result.ClientRequestID.String,
+			Context:            result.Context.String,
+			LastModifiedBy:     modifierFromDB(result.LastModifiedSource, result.LastModifiedClientID, result.LastModifiedTokenID, result.LastModifiedTokenName),
+			CreatedBy:          modifierFromDB(result.CreatedBySource, result.CreatedByClientID, result.CreatedByTokenID, result.CreatedByTokenName),
+			Geofence:           geofences[taskID],
 		}
 		if result.ArchivedAt.Valid {
 			task.ArchivedAt = &result.ArchivedAt.Time
 		}
-		tasks[i] = task
+		if result.CompletedAt.Valid {
+			task.CompletedAt = &result.CompletedAt.Time
+		}
+		if result.LastViewedAt.Valid {
+			task.LastViewedAt = &result.LastViewedAt.Time
+		}
+		listResult.Tasks[i] = task
+
+		var groupDate pgtype.Date
+		var groupCount int64
+		switch opts.GroupBy {
+		case domain.GroupByTag:
+			keys := []string{""}
+			if groupTagIDs := tagIDsByTask[taskID]; len(groupTagIDs) > 0 {
+				keys = keys[:0]
+				for _, tagID := range groupTagIDs {
+					keys = append(keys, tagID.String())
+				}
+			}
+			for _, key := range keys {
+				if _, ok := seenGroups[key]; !ok {
+					seenGroups[key] = struct{}{}
+					listResult.Groups = append(listResult.Groups, domain.TaskGroup{Key: key, Count: tagGroupCounts[key]})
+				}
+			}
+			continue
+		case domain.GroupByStartDate:
+			groupDate, groupCount = result.StartDate, result.StartDateGroupCount
+		case domain.GroupByDeadline:
+			groupDate, groupCount = result.Deadline, result.DeadlineGroupCount
+		default:
+			continue
+		}
+		key := ""
+		if groupDate.Valid {
+			key = groupDate.Time.Format("2006-01-02")
+		}
+		if _, ok := seenGroups[key]; !ok {
+			seenGroups[key] = struct{}{}
+			listResult.Groups = append(listResult.Groups, domain.TaskGroup{Key: key, Count: int(groupCount)})
+		}
 	}
 
-	return tasks, nil
+	return listResult, nil
 }
 
 // Archive archives a task by setting archived_at to current timestamp
-func (r *TaskRepository) Archive(ctx context.Context, id uuid.UUID, ownerID string) (*domain.Task, error) {
+func (r *TaskRepository) Archive(ctx context.Context, id uuid.UUID, ownerID string, by domain.Modifier) (*domain.Task, error) {
 	pgID := pgtype.UUID{
 		Bytes: id,
 		Valid: true,
 	}
 
 	result, err := r.queries.ArchiveTask(ctx, ArchiveTaskParams{
-		ID:      pgID,
-		OwnerID: ownerID,
+		ID:                    pgID,
+		OwnerID:               ownerID,
+		LastModifiedSource:    textFromString(string(by.Source)),
+		LastModifiedClientID:  textFromString(by.ClientID),
+		LastModifiedTokenID:   nullableUUID(by.TokenID),
+		LastModifiedTokenName: textFromString(by.TokenName),
 	})
 	if err != nil {
-		return nil, err
+		return nil, taskNotFound(err)
+	}
+	return r.mutatedTask(ctx, GetTasksByIDsRow(result))
+}
+
+// Unarchive unarchives a task by setting archived_at to NULL
+func (r *TaskRepository) Unarchive(ctx context.Context, id uuid.UUID, ownerID string, by domain.Modifier) (*domain.Task, error) {
+	pgID := pgtype.UUID{
+		Bytes: id,
+		Valid: true,
+	}
+
+	result, err := r.queries.UnarchiveTask(ctx, UnarchiveTaskParams{
+		ID:                    pgID,
+		OwnerID:               ownerID,
+		LastModifiedSource:    textFromString(string(by.Source)),
+		LastModifiedClientID:  textFromString(by.ClientID),
+		LastModifiedTokenID:   nullableUUID(by.TokenID),
+		LastModifiedTokenName: textFromString(by.TokenName),
+	})
+	if err != nil {
+		return nil, taskNotFound(err)
+	}
+	return r.mutatedTask(ctx, GetTasksByIDsRow(result))
+}
+
+// TogglePin flips the pinned flag of a task and returns the updated task
+func (r *TaskRepository) TogglePin(ctx context.Context, id uuid.UUID, ownerID string, by domain.Modifier) (*domain.Task, error) {
+	pgID := pgtype.UUID{
+		Bytes: id,
+		Valid: true,
+	}
+
+	result, err := r.queries.TogglePinTask(ctx, TogglePinTaskParams{
+		ID:                    pgID,
+		OwnerID:               ownerID,
+		LastModifiedSource:    textFromString(string(by.Source)),
+		LastModifiedClientID:  textFromString(by.ClientID),
+		LastModifiedTokenID:   nullableUUID(by.TokenID),
+		LastModifiedTokenName: textFromString(by.TokenName),
+	})
+	if err != nil {
+		return nil, taskNotFound(err)
+	}
+	return r.mutatedTask(ctx, GetTasksByIDsRow(result))
+}
+
+// Complete marks a task as completed. Completing an already completed task keeps
+// its original completion time.
+func (r *TaskRepository) Complete(ctx context.Context, id uuid.UUID, ownerID string, by domain.Modifier) (*domain.Task, error) {
+	pgID := pgtype.UUID{
+		Bytes: id,
+		Valid: true,
+	}
+
+	result, err := r.queries.CompleteTask(ctx, CompleteTaskParams{
+		ID:                    pgID,
+		OwnerID:               ownerID,
+		LastModifiedSource:    textFromString(string(by.Source)),
+		LastModifiedClientID:  textFromString(by.ClientID),
+		LastModifiedTokenID:   nullableUUID(by.TokenID),
+		LastModifiedTokenName: textFromString(by.TokenName),
+	})
+	if err != nil {
+		return nil, taskNotFound(err)
+	}
+	return r.mutatedTask(ctx, GetTasksByIDsRow(result))
+}
+
+// Reopen marks a completed task as open again
+func (r *TaskRepository) Reopen(ctx context.Context, id uuid.UUID, ownerID string, by domain.Modifier) (*domain.Task, error) {
+	pgID := pgtype.UUID{
+		Bytes: id,
+		Valid: true,
 	}
 
+	result, err := r.queries.ReopenTask(ctx, ReopenTaskParams{
+		ID:                    pgID,
+		OwnerID:               ownerID,
+		LastModifiedSource:    textFromString(string(by.Source)),
+		LastModifiedClientID:  textFromString(by.ClientID),
+		LastModifiedTokenID:   nullableUUID(by.TokenID),
+		LastModifiedTokenName: textFromString(by.TokenName),
+	})
+	if err != nil {
+		return nil, taskNotFound(err)
+	}
+	return r.mutatedTask(ctx, GetTasksByIDsRow(result))
+}
+
+// mutatedTask converts the row a task mutation returns, reading the task's
+// tags and geofence from the primary so that they reflect the write
+func (r *TaskRepository) mutatedTask(ctx context.Context, result GetTasksByIDsRow) (*domain.Task, error) {
 	taskID, err := uuid.FromBytes(result.ID.Bytes[:])
 	if err != nil {
 		return nil, err
 	}
 
-	// Get task tag IDs
-	pgTagIDs, err := r.queries.GetTaskTagIDs(ctx, pgID)
+	pgTagIDs, err := r.queries.GetTaskTagIDs(ctx, result.ID)
 	if err != nil {
 		return nil, err
 	}
-
 	tagIDs := make([]uuid.UUID, len(pgTagIDs))
 	for i, pgTagID := range pgTagIDs {
-		tagID, err := uuid.FromBytes(pgTagID.Bytes[:])
-		if err != nil {
-			return nil, err
-		}
-		tagIDs[i] = tagID
+		tagIDs[i] = uuid.UUID(pgTagID.Bytes)
+	}
+	geofences, err := geofencesForTasks(ctx, r.queries, result.OwnerID, []pgtype.UUID{result.ID})
+	if err != nil {
+		return nil, err
 	}
 
+	notes, err := r.notes.open(ctx, result.OwnerID, result.Notes)
+	if err != nil {
+		return nil, err
+	}
 	task := &domain.Task{
-		ID:        taskID,
-		Title:     result.Title,
-		Notes:     result.Notes,
-		TagIDs:    tagIDs,
-		OwnerID:   result.OwnerID,
-		CreatedAt: result.CreatedAt.Time,
-		UpdatedAt: result.UpdatedAt.Time,
-		StartDate: pgDateToTime(result.StartDate),
+		ID:              taskID,
+		Title:           result.Title,
+		Notes:           notes,
+		TagIDs:          tagIDs,
+		OwnerID:         result.OwnerID,
+		CreatedAt:       result.CreatedAt.Time,
+		UpdatedAt:       result.UpdatedAt.Time,
+		StartDate:       pgDateToTime(result.StartDate),
+		Deadline:        pgDateToTime(result.Deadline),
+		Pinned:          result.Pinned,
+		ClientRequestID: result.ClientRequestID.String,
+		Context:         result.Context.String,
+		LastModifiedBy:  modifierFromDB(result.LastModifiedSource, result.LastModifiedClientID, result.LastModifiedTokenID, result.LastModifiedTokenName),
+		CreatedBy:       modifierFromDB(result.CreatedBySource, result.CreatedByClientID, result.CreatedByTokenID, result.CreatedByTokenName),
+		Geofence:        geofences[taskID],
 	}
 	if result.ArchivedAt.Valid {
 		task.ArchivedAt = &result.ArchivedAt.Time
 	}
+	if result.CompletedAt.Valid {
+		task.CompletedAt = &result.CompletedAt.Time
+	}
+	if result.LastViewedAt.Valid {
+		task.LastViewedAt = &result.LastViewedAt.Time
+	}
 	return task, nil
 }
 
-// Unarchive unarchives a task by setting archived_at to NULL
-func (r *TaskRepository) Unarchive(ctx context.Context, id uuid.UUID, ownerID string) (*domain.Task, error) {
-	pgID := pgtype.UUID{
-		Bytes: id,
-		Valid: true,
+// ArchiveCompleted archives all completed, unarchived tasks of an owner.
+// When completedBefore is set, only tasks completed at or before it are archived.
+func (r *TaskRepository) ArchiveCompleted(ctx context.Context, ownerID string, completedBefore *time.Time, by domain.Modifier) (int64, error) {
+	return r.queries.ArchiveCompletedTasks(ctx, ArchiveCompletedTasksParams{
+		LastModifiedSource:    textFromString(string(by.Source)),
+		LastModifiedClientID:  textFromString(by.ClientID),
+		LastModifiedTokenID:   nullableUUID(by.TokenID),
+		LastModifiedTokenName: textFromString(by.TokenName),
+		OwnerID:               ownerID,
+		CompletedBefore:       timeToPgTimestamptz(completedBefore),
+	})
+}
+
+// CountArchivable counts the tasks ArchiveCompleted would archive
+func (r *TaskRepository) CountArchivable(ctx context.Context, ownerID string, completedBefore *time.Time) (int64, error) {
+	return r.readQueries.CountArchivableCompletedTasks(ctx, CountArchivableCompletedTasksParams{
+		OwnerID:         ownerID,
+		CompletedBefore: timeToPgTimestamptz(completedBefore),
+	})
+}
+
+// ArchiveByTag archives the owner's unarchived tasks carrying tagID
+func (r *TaskRepository) ArchiveByTag(ctx context.Context, ownerID string, tagID uuid.UUID, by domain.Modifier) (int64, error) {
+	return r.queries.ArchiveTasksByTag(ctx, ArchiveTasksByTagParams{
+		LastModifiedSource:    textFromString(string(by.Source)),
+		LastModifiedClientID:  textFromString(by.ClientID),
+		LastModifiedTokenID:   nullableUUID(by.TokenID),
+		LastModifiedTokenName: textFromString(by.TokenName),
+		OwnerID:               ownerID,
+		TagID:                 pgtype.UUID{Bytes: tagID, Valid: true},
+	})
+}
+
+// UnarchiveByTag restores the owner's archived tasks carrying tagID
+func (r *TaskRepository) UnarchiveByTag(ctx context.Context, ownerID string, tagID uuid.UUID, by domain.Modifier) (int64, error) {
+	return r.queries.UnarchiveTasksByTag(ctx, UnarchiveTasksByTagParams{
+		LastModifiedSource:    textFromString(string(by.Source)),
+		LastModifiedClientID:  textFromString(by.ClientID),
+		LastModifiedTokenID:   nullableUUID(by.TokenID),
+		LastModifiedTokenName: textFromString(by.TokenName),
+		OwnerID:               ownerID,
+		TagID:                 pgtype.UUID{Bytes: tagID, Valid: true},
+	})
+}
+
+// GetStats computes activity counts since the given instant, per-tag counts and
+// the current backlog size using aggregate queries.
+func (r *TaskRepository) GetStats(ctx context.Context, ownerID string, since time.Time, bucket domain.StatsBucket) (*domain.Stats, error) {
+	activityRows, err := r.readQueries.GetTaskActivityCounts(ctx, GetTaskActivityCountsParams{
+		Bucket:  bucket.String(),
+		OwnerID: ownerID,
+		Since: pgtype.Timestamptz{
+			Time:  since,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	result, err := r.queries.UnarchiveTask(ctx, UnarchiveTaskParams{
-		ID:      pgID,
+	stats := &domain.Stats{
+		Activity: []domain.ActivityCount{},
+		Tags:     []domain.TagStats{},
+	}
+
+	// Rows are ordered by bucket, so consecutive rows with the same date merge
+	for _, row := range activityRows {
+		if !row.BucketStart.Valid {
+			continue
+		}
+		n := len(stats.Activity)
+		if n == 0 || !stats.Activity[n-1].BucketStart.Equal(row.BucketStart.Time) {
+			stats.Activity = append(stats.Activity, domain.ActivityCount{BucketStart: row.BucketStart.Time})
+			n++
+		}
+		switch row.Kind {
+		case "created":
+			stats.Activity[n-1].Created = int(row.Count)
+		case "completed":
+			stats.Activity[n-1].Completed = int(row.Count)
+		case "archived":
+			stats.Activity[n-1].Archived = int(row.Count)
+		}
+	}
+
+	tagRows, err := r.readQueries.GetTagTaskCounts(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range tagRows {
+		tagID, err := uuid.FromBytes(row.TagID.Bytes[:])
+		if err != nil {
+			return nil, err
+		}
+		stats.Tags = append(stats.Tags, domain.TagStats{
+			TagID:          tagID,
+			TagName:        row.TagName,
+			OpenCount:      int(row.OpenCount),
+			CompletedCount: int(row.CompletedCount),
+		})
+	}
+
+	backlog, err := r.readQueries.CountBacklogTasks(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	stats.BacklogSize = int(backlog)
+
+	return stats, nil
+}
+
+// GetWeeklyReview collects the task IDs for each review section and loads all
+// referenced tasks with a single batched lookup.
+func (r *TaskRepository) GetWeeklyReview(ctx context.Context, ownerID string, opts domain.ReviewOptions) (*domain.WeeklyReview, error) {
+	staleIDs, err := r.readQueries.ListStaleTaskIDs(ctx, ListStaleTaskIDsParams{
+		OwnerID:       ownerID,
+		UpdatedBefore: pgtype.Timestamptz{Time: opts.StaleBefore, Valid: true},
+		MaxResults:    domain.MaxReviewSectionSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	undatedIDs, err := r.readQueries.ListUndatedTaskIDs(ctx, ListUndatedTaskIDsParams{
+		OwnerID:    ownerID,
+		MaxResults: domain.MaxReviewSectionSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	completedIDs, err := r.readQueries.ListCompletedTaskIDsSince(ctx, ListCompletedTaskIDsSinceParams{
+		OwnerID:        ownerID,
+		CompletedSince: pgtype.Timestamptz{Time: opts.WeekStart, Valid: true},
+		MaxResults:     domain.MaxReviewSectionSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	overdueIDs, err := r.readQueries.ListOverdueTaskIDs(ctx, ListOverdueTaskIDsParams{
+		OwnerID:    ownerID,
+		Today:      pgtype.Date{Time: opts.Today, Valid: true},
+		MaxResults: domain.MaxReviewSectionSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sections, err := r.loadSections(ctx, ownerID, staleIDs, undatedIDs, completedIDs, overdueIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.WeeklyReview{
+		WeekStart:         opts.WeekStart,
+		StaleTasks:        sections[0],
+		UndatedTasks:      sections[1],
+		CompletedThisWeek: sections[2],
+		OverdueTasks:      sections[3],
+	}, nil
+}
+
+// GetCounters counts the owner's open tasks for badges in a single query
+func (r *TaskRepository) GetCounters(ctx context.Context, ownerID string, today time.Time) (*domain.Counters, error) {
+	row, err := r.readQueries.GetTaskCounters(ctx, GetTaskCountersParams{
+		Today:   pgtype.Date{Time: today, Valid: true},
 		OwnerID: ownerID,
 	})
 	if err != nil {
 		return nil, err
 	}
+	return &domain.Counters{
+		Inbox:   row.InboxCount,
+		Today:   row.TodayCount,
+		Overdue: row.OverdueCount,
+		Unread:  row.UnreadCount,
+	}, nil
+}
 
-	taskID, err := uuid.FromBytes(result.ID.Bytes[:])
+// GetDigest collects the task IDs for each digest section and loads all
+// referenced tasks with a single batched lookup.
+func (r *TaskRepository) GetDigest(ctx context.Context, ownerID string, opts domain.DigestOptions) (*domain.Digest, error) {
+	today := pgtype.Date{Time: opts.Today, Valid: true}
+	todayIDs, err := r.readQueries.ListTodayTaskIDs(ctx, ListTodayTaskIDsParams{
+		OwnerID:    ownerID,
+		Today:      today,
+		MaxResults: domain.MaxDigestSectionSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	overdueIDs, err := r.readQueries.ListOverdueTaskIDs(ctx, ListOverdueTaskIDsParams{
+		OwnerID:    ownerID,
+		Today:      today,
+		MaxResults: domain.MaxDigestSectionSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	completedIDs, err := r.readQueries.ListCompletedTaskIDsBetween(ctx, ListCompletedTaskIDsBetweenParams{
+		OwnerID:       ownerID,
+		CompletedFrom: pgtype.Timestamptz{Time: opts.CompletedFrom, Valid: true},
+		CompletedTo:   pgtype.Timestamptz{Time: opts.CompletedTo, Valid: true},
+		MaxResults:    domain.MaxDigestSectionSize,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Get task tag IDs
-	pgTagIDs, err := r.queries.GetTaskTagIDs(ctx, pgID)
+	sections, err := r.loadSections(ctx, ownerID, todayIDs, overdueIDs, completedIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	tagIDs := make([]uuid.UUID, len(pgTagIDs))
-	for i, pgTagID := range pgTagIDs {
-		tagID, err := uuid.FromBytes(pgTagID.Bytes[:])
-		if err != nil {
-			return nil, err
+	return &domain.Digest{
+		Today:     sections[0],
+		Overdue:   sections[1],
+		Completed: sections[2],
+	}, nil
+}
+
+// loadSections loads the tasks of several ID lists with one lookup,
+// preserving the order of each list
+func (r *TaskRepository) loadSections(ctx context.Context, ownerID string, sections ...[]pgtype.UUID) ([][]*domain.Task, error) {
+	var allIDs []uuid.UUID
+	for _, section := range sections {
+		for _, pgID := range section {
+			allIDs = append(allIDs, pgID.Bytes)
 		}
-		tagIDs[i] = tagID
 	}
 
-	task := &domain.Task{
-		ID:        taskID,
-		Title:     result.Title,
-		Notes:     result.Notes,
-		TagIDs:    tagIDs,
-		OwnerID:   result.OwnerID,
-		CreatedAt: result.CreatedAt.Time,
-		UpdatedAt: result.UpdatedAt.Time,
-		StartDate: pgDateToTime(result.StartDate),
+	tasks, err := r.GetMany(ctx, allIDs, ownerID)
+	if err != nil {
+		return nil, err
 	}
-	if result.ArchivedAt.Valid {
-		task.ArchivedAt = &result.ArchivedAt.Time
+	byID := make(map[uuid.UUID]*domain.Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = task
 	}
-	return task, nil
+
+	result := make([][]*domain.Task, len(sections))
+	for i, ids := range sections {
+		result[i] = make([]*domain.Task, 0, len(ids))
+		for _, pgID := range ids {
+			if task, ok := byID[uuid.UUID(pgID.Bytes)]; ok {
+				result[i] = append(result[i], task)
+			}
+		}
+	}
+	return result, nil
 }
 
 // ListChecklistItems lists checklist items for a task.
 func (r *TaskRepository) ListChecklistItems(ctx context.Context, taskID uuid.UUID, ownerID string) ([]domain.ChecklistItem, error) {
+	return loadChecklistItems(ctx, r.queries, taskID, ownerID)
+}
+
+// loadChecklistItems loads a task's checklist through q
+func loadChecklistItems(ctx context.Context, q *Queries, taskID uuid.UUID, ownerID string) ([]domain.ChecklistItem, error) {
 	pgTaskID := pgtype.UUID{Bytes: taskID, Valid: true}
-	rows, err := r.queries.ListChecklistItems(ctx, ListChecklistItemsParams{
+	rows, err := q.ListChecklistItems(ctx, ListChecklistItemsParams{
 		TaskID:  pgTaskID,
 		OwnerID: ownerID,
 	})
@@ -435,7 +1285,7 @@ func (r *TaskRepository) AddChecklistItem(ctx context.Context, taskID uuid.UUID,
 		Content: content,
 	})
 	if err != nil {
-		return nil, err
+		return nil, taskNotFound(err)
 	}
 
 	item, err := checklistItemFromDB(row)
@@ -454,7 +1304,7 @@ func (r *TaskRepository) UpdateChecklistItemContent(ctx context.Context, itemID
 		OwnerID: ownerID,
 	})
 	if err != nil {
-		return nil, err
+		return nil, checklistItemNotFound(err)
 	}
 
 	item, err := checklistItemFromDB(row)
@@ -473,7 +1323,7 @@ func (r *TaskRepository) SetChecklistItemCompleted(ctx context.Context, itemID u
 		OwnerID:   ownerID,
 	})
 	if err != nil {
-		return nil, err
+		return nil, checklistItemNotFound(err)
 	}
 
 	item, err := checklistItemFromDB(row)
@@ -494,7 +1344,7 @@ func (r *TaskRepository) DeleteChecklistItem(ctx context.Context, itemID uuid.UU
 		return err
 	}
 	if rowsAffected == 0 {
-		return pgx.ErrNoRows
+		return domain.ErrChecklistItemNotFound
 	}
 
 	return nil
@@ -535,6 +1385,75 @@ func checklistItemFromDB(row TaskChecklistItem) (domain.ChecklistItem, error) {
 	}, nil
 }
 
+// tagIDsForTasks loads the tag IDs of several tasks with a single query
+func (r *TaskRepository) tagIDsForTasks(ctx context.Context, taskIDs []pgtype.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	tagIDsByTask := make(map[uuid.UUID][]uuid.UUID)
+	if len(taskIDs) == 0 {
+		return tagIDsByTask, nil
+	}
+
+	rows, err := r.readQueries.GetTaskTagIDsForTasks(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		taskID, err := uuid.FromBytes(row.TaskID.Bytes[:])
+		if err != nil {
+			return nil, err
+		}
+		tagID, err := uuid.FromBytes(row.TagID.Bytes[:])
+		if err != nil {
+			return nil, err
+		}
+		tagIDsByTask[taskID] = append(tagIDsByTask[taskID], tagID)
+	}
+	return tagIDsByTask, nil
+}
+
+// taskNotFound reports a query that found no task as domain.ErrTaskNotFound
+func taskNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrTaskNotFound
+	}
+	return err
+}
+
+// checklistItemNotFound reports a query that found no checklist item as
+// domain.ErrChecklistItemNotFound
+func checklistItemNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrChecklistItemNotFound
+	}
+	return err
+}
+
+// uuidsToPgUUIDs converts IDs to a de-duplicated pgtype.UUID slice.
+// It returns nil for an empty input so the query treats the filter as unset.
+func uuidsToPgUUIDs(ids []uuid.UUID) []pgtype.UUID {
+	if len(ids) == 0 {
+		return nil
+	}
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	result := make([]pgtype.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, pgtype.UUID{Bytes: id, Valid: true})
+	}
+	return result
+}
+
+// pgUUIDsToUUIDs converts IDs returned by a query to uuid.UUIDs
+func pgUUIDsToUUIDs(ids []pgtype.UUID) []uuid.UUID {
+	result := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		result[i] = uuid.UUID(id.Bytes)
+	}
+	return result
+}
+
 // pgDateToTime converts a pgtype.Date to *time.Time.
 // Returns nil if the date is not valid.
 func pgDateToTime(d pgtype.Date) *time.Time {
@@ -545,6 +1464,61 @@ func pgDateToTime(d pgtype.Date) *time.Time {
 	return nil
 }
 
+// timeToPgTimestamptz converts a *time.Time to pgtype.Timestamptz.
+// Returns an invalid (NULL) value if t is nil.
+func timeToPgTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// nullableUUID converts an ID to pgtype.UUID, mapping uuid.Nil to NULL
+func nullableUUID(id uuid.UUID) pgtype.UUID {
+	return pgtype.UUID{Bytes: id, Valid: id != uuid.Nil}
+}
+
+// textFromString converts a string to pgtype.Text, mapping empty to NULL
+func textFromString(s string) pgtype.Text {
+	if s == "" {
+		return pgtype.Text{Valid: false}
+	}
+	return pgtype.Text{String: s, Valid: true}
+}
+
+// contextsParam converts a context filter, mapping an empty filter to NULL
+// so that it matches every task
+func contextsParam(contexts []string) []string {
+	if len(contexts) == 0 {
+		return nil
+	}
+	return contexts
+}
+
+// orderByParam converts a list order to the order_by value ListTasks
+// understands, NULL for the default order
+func orderByParam(order domain.ListOrder) pgtype.Text {
+	switch order {
+	case domain.ListOrderArchivedDesc:
+		return pgtype.Text{String: "archived_at_desc", Valid: true}
+	case domain.ListOrderArchivedAsc:
+		return pgtype.Text{String: "archived_at_asc", Valid: true}
+	default:
+		return pgtype.Text{}
+	}
+}
+
+// modifierFromDB converts the last_modified_* or created_by_* columns of a
+// task row
+func modifierFromDB(source, clientID pgtype.Text, tokenID pgtype.UUID, tokenName pgtype.Text) domain.Modifier {
+	return domain.Modifier{
+		Source:    domain.ChangeSource(source.String),
+		ClientID:  clientID.String,
+		TokenID:   uuid.UUID(tokenID.Bytes),
+		TokenName: tokenName.String,
+	}
+}
+
 // timeToPgDate converts a *time.Time to pgtype.Date.
 // Returns an invalid pgtype.Date if the time is nil.
 func timeToPgDate(t *time.Time) pgtype.Date {
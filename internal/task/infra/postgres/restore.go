@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// Restore writes a backup into its owner in one transaction. Notes are
+// sealed before the transaction starts; the tags are matched and created by
+// one statement, and names another transaction inserted meanwhile are
+// looked up again afterwards.
+func (r *TaskRepository) Restore(ctx context.Context, restore *domain.Restore) (*domain.RestoredTags, error) {
+	notes := make([]string, len(restore.Tasks))
+	for i, task := range restore.Tasks {
+		sealed, err := r.notes.seal(ctx, restore.OwnerID, task.Notes)
+		if err != nil {
+			return nil, err
+		}
+		notes[i] = sealed
+	}
+	revisionNotes := make([]string, len(restore.NoteRevisions))
+	for i, revision := range restore.NoteRevisions {
+		sealed, err := r.notes.seal(ctx, restore.OwnerID, revision.Notes)
+		if err != nil {
+			return nil, err
+		}
+		revisionNotes[i] = sealed
+	}
+
+	var restored *domain.RestoredTags
+	err := r.withTx(ctx, func(txQueries *Queries) error {
+		var err error
+		restored, err = matchRestoredTags(ctx, txQueries, restore.OwnerID, restore.Tags)
+		if err != nil {
+			return err
+		}
+
+		for i, task := range restore.Tasks {
+			pgTaskID := pgtype.UUID{
+				Bytes: task.ID,
+				Valid: true,
+			}
+			err := txQueries.RestoreTask(ctx, RestoreTaskParams{
+				ID:                    pgTaskID,
+				Title:                 task.Title,
+				Notes:                 notes[i],
+				OwnerID:               restore.OwnerID,
+				ArchivedAt:            timeToPgTimestamptz(task.ArchivedAt),
+				CreatedAt:             timeToPgTimestamptz(&task.CreatedAt),
+				UpdatedAt:             timeToPgTimestamptz(&task.UpdatedAt),
+				StartDate:             timeToPgDate(task.StartDate),
+				Deadline:              timeToPgDate(task.Deadline),
+				Pinned:                task.Pinned,
+				CompletedAt:           timeToPgTimestamptz(task.CompletedAt),
+				LastModifiedSource:    textFromString(string(task.LastModifiedBy.Source)),
+				LastModifiedClientID:  textFromString(task.LastModifiedBy.ClientID),
+				LastModifiedTokenID:   nullableUUID(task.LastModifiedBy.TokenID),
+				LastModifiedTokenName: textFromString(task.LastModifiedBy.TokenName),
+				Context:               textFromString(task.Context),
+				LastViewedAt:          timeToPgTimestamptz(task.LastViewedAt),
+				CreatedBySource:       textFromString(string(task.CreatedBy.Source)),
+				CreatedByClientID:     textFromString(task.CreatedBy.ClientID),
+				CreatedByTokenID:      nullableUUID(task.CreatedBy.TokenID),
+				CreatedByTokenName:    textFromString(task.CreatedBy.TokenName),
+			})
+			if err != nil {
+				return err
+			}
+
+			tagIDs := make([]uuid.UUID, 0, len(task.TagIDs))
+			for _, tagID := range task.TagIDs {
+				if restoredID, ok := restored.IDs[tagID]; ok {
+					tagIDs = append(tagIDs, restoredID)
+				}
+			}
+			if len(tagIDs) > 0 {
+				err := txQueries.CreateTaskTags(ctx, CreateTaskTagsParams{
+					TaskID: pgTaskID,
+					TagIds: uuidsToPgUUIDs(tagIDs),
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, item := range task.Checklist {
+				err := txQueries.RestoreChecklistItem(ctx, RestoreChecklistItemParams{
+					ID: pgtype.UUID{
+						Bytes: item.ID,
+						Valid: true,
+					},
+					TaskID:    pgTaskID,
+					Content:   item.Content,
+					Completed: item.Completed,
+					SortOrder: item.SortOrder,
+					CreatedAt: timeToPgTimestamptz(&item.CreatedAt),
+					UpdatedAt: timeToPgTimestamptz(&item.UpdatedAt),
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			if task.Geofence != nil {
+				if err := writeGeofence(ctx, txQueries, pgTaskID, task.Geofence); err != nil {
+					return err
+				}
+			}
+		}
+
+		for i, revision := range restore.NoteRevisions {
+			err := txQueries.RestoreNoteRevision(ctx, RestoreNoteRevisionParams{
+				TaskID:    pgtype.UUID{Bytes: revision.TaskID, Valid: true},
+				Notes:     revisionNotes[i],
+				CreatedAt: timeToPgTimestamptz(&revision.CreatedAt),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if settings := restore.Settings; settings != nil {
+			var days pgtype.Int4
+			if settings.AutoArchiveAfterDays != nil {
+				days = pgtype.Int4{Int32: int32(*settings.AutoArchiveAfterDays), Valid: true}
+			}
+			_, err := txQueries.UpsertTaskSettings(ctx, UpsertTaskSettingsParams{
+				OwnerID:              restore.OwnerID,
+				AutoArchiveAfterDays: days,
+				RolloverToInbox:      pgtype.Bool{Bool: settings.RolloverToInbox, Valid: true},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// matchRestoredTags gets or creates the owner's tags named in tags and maps the
+// backup's tag IDs to them
+func matchRestoredTags(ctx context.Context, txQueries *Queries, ownerID string, tags map[uuid.UUID]string) (*domain.RestoredTags, error) {
+	restored := &domain.RestoredTags{IDs: make(map[uuid.UUID]uuid.UUID, len(tags))}
+	if len(tags) == 0 {
+		return restored, nil
+	}
+
+	names := make([]string, 0, len(tags))
+	for _, name := range tags {
+		names = append(names, name)
+	}
+	byName := make(map[string]uuid.UUID, len(names))
+	// A second statement sees the names another transaction committed
+	// while the first one ran
+	for attempt := 0; attempt < 2 && len(names) > 0; attempt++ {
+		rows, err := txQueries.RestoreTags(ctx, RestoreTagsParams{
+			Names:   names,
+			OwnerID: ownerID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		names = names[:0]
+		for _, row := range rows {
+			if !row.TagID.Valid {
+				names = append(names, row.Name)
+				continue
+			}
+			byName[row.Name] = row.TagID.Bytes
+			if row.Created {
+				restored.Created++
+			}
+		}
+	}
+	if len(names) > 0 {
+		return nil, errors.New("tags changed during the restore")
+	}
+
+	for id, name := range tags {
+		restored.IDs[id] = byName[name]
+	}
+	return restored, nil
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// RolloverTasks moves the owner's open tasks that started before today to
+// today, or to the inbox when toInbox is set, in a single statement. It
+// returns the moved tasks.
+func (r *TaskRepository) RolloverTasks(ctx context.Context, ownerID string, today time.Time, toInbox bool, by domain.Modifier) ([]*domain.Task, error) {
+	var newStartDate pgtype.Date
+	if !toInbox {
+		newStartDate = timeToPgDate(&today)
+	}
+
+	results, err := r.queries.RolloverTasks(ctx, RolloverTasksParams{
+		NewStartDate:          newStartDate,
+		LastModifiedSource:    textFromString(string(by.Source)),
+		LastModifiedClientID:  textFromString(by.ClientID),
+		LastModifiedTokenID:   nullableUUID(by.TokenID),
+		LastModifiedTokenName: textFromString(by.TokenName),
+		OwnerID:               ownerID,
+		Today:                 timeToPgDate(&today),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return []*domain.Task{}, nil
+	}
+
+	pgIDs := make([]pgtype.UUID, len(results))
+	for i, result := range results {
+		pgIDs[i] = result.ID
+	}
+
+	// Rolling over does not touch tags or checklists, so the replica serves
+	// them
+	tagIDsByTask, err := r.tagIDsForTasks(ctx, pgIDs)
+	if err != nil {
+		return nil, err
+	}
+	checklistRows, err := r.readQueries.ListChecklistItemsForTasks(ctx, ListChecklistItemsForTasksParams{
+		TaskIds: pgIDs,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	checklistByTask := make(map[uuid.UUID][]domain.ChecklistItem)
+	for _, row := range checklistRows {
+		item, err := checklistItemFromDB(row)
+		if err != nil {
+			return nil, err
+		}
+		checklistByTask[item.TaskID] = append(checklistByTask[item.TaskID], item)
+	}
+
+	tasks := make([]*domain.Task, len(results))
+	for i, result := range results {
+		taskID := uuid.UUID(result.ID.Bytes)
+
+		tagIDs := tagIDsByTask[taskID]
+		if tagIDs == nil {
+			tagIDs = []uuid.UUID{}
+		}
+		checklist := checklistByTask[taskID]
+		if checklist == nil {
+			checklist = []domain.ChecklistItem{}
+		}
+
+		notes, err := r.notes.open(ctx, result.OwnerID, result.Notes)
+		if err != nil {
+			return nil, err
+		}
+		task := &domain.Task{
+			ID:              taskID,
+			Title:           result.Title,
+			Notes:           notes,
+			TagIDs:          tagIDs,
+			Checklist:       checklist,
+			OwnerID:         result.OwnerID,
+			CreatedAt:       result.CreatedAt.Time,
+			UpdatedAt:       result.UpdatedAt.Time,
+			StartDate:       pgDateToTime(result.StartDate),
+			Deadline:        pgDateToTime(result.Deadline),
+			Pinned:          result.Pinned,
+			ClientRequestID: result.ClientRequestID.String,
+			Context:         result.Context.String,
+			LastModifiedBy:  modifierFromDB(result.LastModifiedSource, result.LastModifiedClientID, result.LastModifiedTokenID, result.LastModifiedTokenName),
+			CreatedBy:       modifierFromDB(result.CreatedBySource, result.CreatedByClientID, result.CreatedByTokenID, result.CreatedByTokenName),
+		}
+		if result.ArchivedAt.Valid {
+			task.ArchivedAt = &result.ArchivedAt.Time
+		}
+		if result.CompletedAt.Valid {
+			task.CompletedAt = &result.CompletedAt.Time
+		}
+		if result.LastViewedAt.Valid {
+			task.LastViewedAt = &result.LastViewedAt.Time
+		}
+		tasks[i] = task
+	}
+	return tasks, nil
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// GetSettings returns the owner's task settings, or the defaults if never set
+func (r *TaskRepository) GetSettings(ctx context.Context, ownerID string) (*domain.Settings, error) {
+	result, err := r.readQueries.GetTaskSettings(ctx, ownerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domain.Settings{}, nil
+		}
+		return nil, err
+	}
+
+	return settingsFromRow(result), nil
+}
+
+// UpdateSettings applies update to the owner's task settings in one
+// statement and returns the stored settings
+func (r *TaskRepository) UpdateSettings(ctx context.Context, ownerID string, update domain.SettingsUpdate) (*domain.Settings, error) {
+	var days pgtype.Int4
+	if update.AutoArchiveAfterDays != nil {
+		days = pgtype.Int4{Int32: int32(*update.AutoArchiveAfterDays), Valid: true}
+	}
+	var toInbox pgtype.Bool
+	if update.RolloverToInbox != nil {
+		toInbox = pgtype.Bool{Bool: *update.RolloverToInbox, Valid: true}
+	}
+
+	result, err := r.queries.UpsertTaskSettings(ctx, UpsertTaskSettingsParams{
+		OwnerID:              ownerID,
+		AutoArchiveAfterDays: days,
+		RolloverToInbox:      toInbox,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return settingsFromRow(result), nil
+}
+
+// ListAutoArchivePolicies returns every owner with auto-archiving enabled.
+// It runs on the primary so a job never acts on a replica's stale settings.
+func (r *TaskRepository) ListAutoArchivePolicies(ctx context.Context) ([]domain.AutoArchivePolicy, error) {
+	rows, err := r.queries.ListAutoArchivePolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]domain.AutoArchivePolicy, 0, len(rows))
+	for _, row := range rows {
+		if !row.AutoArchiveAfterDays.Valid {
+			continue
+		}
+		policies = append(policies, domain.AutoArchivePolicy{
+			OwnerID:   row.OwnerID,
+			AfterDays: int(row.AutoArchiveAfterDays.Int32),
+		})
+	}
+	return policies, nil
+}
+
+func settingsFromRow(row TaskSetting) *domain.Settings {
+	settings := &domain.Settings{RolloverToInbox: row.RolloverToInbox}
+	if row.AutoArchiveAfterDays.Valid {
+		days := int(row.AutoArchiveAfterDays.Int32)
+		settings.AutoArchiveAfterDays = &days
+	}
+	return settings
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// MarkViewed records that the owner opened a task, without bumping its
+// updated_at. It returns domain.ErrTaskNotFound if the task does not exist.
+func (r *TaskRepository) MarkViewed(ctx context.Context, id uuid.UUID, ownerID string) error {
+	rows, err := r.queries.MarkTaskViewed(ctx, MarkTaskViewedParams{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrTaskNotFound
+	}
+	return nil
+}
+
+// ListStale returns up to limit open tasks neither updated nor viewed since
+// inactiveBefore, longest inactive first
+func (r *TaskRepository) ListStale(ctx context.Context, ownerID string, inactiveBefore time.Time, limit int) ([]*domain.Task, error) {
+	ids, err := r.readQueries.ListInactiveTaskIDs(ctx, ListInactiveTaskIDsParams{
+		OwnerID:        ownerID,
+		InactiveBefore: pgtype.Timestamptz{Time: inactiveBefore, Valid: true},
+		MaxResults:     int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sections, err := r.loadSections(ctx, ownerID, ids)
+	if err != nil {
+		return nil, err
+	}
+	return sections[0], nil
+}
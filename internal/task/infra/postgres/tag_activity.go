@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+)
+
+// GetTagActivity counts the owner's open and completed tasks carrying a tag
+// and loads up to recentLimit of them, most recently updated first
+func (r *TaskRepository) GetTagActivity(ctx context.Context, ownerID string, tagID uuid.UUID, recentLimit int) (*domain.TagActivity, error) {
+	pgTagID := pgtype.UUID{Bytes: tagID, Valid: true}
+	counts, err := r.readQueries.CountTagTasks(ctx, CountTagTasksParams{
+		TagID:   pgTagID,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	activity := &domain.TagActivity{
+		OpenCount:      int(counts.OpenCount),
+		CompletedCount: int(counts.CompletedCount),
+		RecentTasks:    []*domain.Task{},
+	}
+	if recentLimit <= 0 {
+		return activity, nil
+	}
+
+	ids, err := r.readQueries.ListRecentTagTaskIDs(ctx, ListRecentTagTaskIDsParams{
+		TagID:      pgTagID,
+		OwnerID:    ownerID,
+		MaxResults: int32(recentLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sections, err := r.loadSections(ctx, ownerID, ids)
+	if err != nil {
+		return nil, err
+	}
+	activity.RecentTasks = sections[0]
+	return activity, nil
+}
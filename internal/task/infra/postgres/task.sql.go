@@ -41,31 +41,94 @@ func (q *Queries) AddChecklistItem(ctx context.Context, arg AddChecklistItemPara
 	return i, err
 }
 
+const archiveCompletedTasks = `-- name: ArchiveCompletedTasks :execrows
+UPDATE tasks
+SET archived_at = NOW(), updated_at = NOW(),
+    last_modified_source = $1, last_modified_client_id = $2,
+    last_modified_token_id = $3, last_modified_token_name = $4
+WHERE owner_id = $5
+  AND completed_at IS NOT NULL
+  AND archived_at IS NULL
+  AND ($6::timestamptz IS NULL
+       OR completed_at <= $6::timestamptz)
+`
+
+type ArchiveCompletedTasksParams struct {
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	OwnerID               string             `json:"owner_id"`
+	CompletedBefore       pgtype.Timestamptz `json:"completed_before"`
+}
+
+func (q *Queries) ArchiveCompletedTasks(ctx context.Context, arg ArchiveCompletedTasksParams) (int64, error) {
+	result, err := q.db.Exec(ctx, archiveCompletedTasks,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
+		arg.OwnerID,
+		arg.CompletedBefore,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const archiveTask = `-- name: ArchiveTask :one
 UPDATE tasks
-SET archived_at = NOW(), updated_at = NOW()
+SET archived_at = NOW(), updated_at = NOW(),
+    last_modified_source = $3, last_modified_client_id = $4,
+    last_modified_token_id = $5, last_modified_token_name = $6
 WHERE id = $1 AND owner_id = $2
-RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
 `
 
 type ArchiveTaskParams struct {
-	ID      pgtype.UUID `json:"id"`
-	OwnerID string      `json:"owner_id"`
+	ID                    pgtype.UUID `json:"id"`
+	OwnerID               string      `json:"owner_id"`
+	LastModifiedSource    pgtype.Text `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text `json:"last_modified_client_id"`
+	LastModifiedTokenID   pgtype.UUID `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text `json:"last_modified_token_name"`
 }
 
 type ArchiveTaskRow struct {
-	ID         pgtype.UUID        `json:"id"`
-	Title      string             `json:"title"`
-	Notes      string             `json:"notes"`
-	OwnerID    string             `json:"owner_id"`
-	ArchivedAt pgtype.Timestamptz `json:"archived_at"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	StartDate  pgtype.Date        `json:"start_date"`
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
 }
 
 func (q *Queries) ArchiveTask(ctx context.Context, arg ArchiveTaskParams) (ArchiveTaskRow, error) {
-	row := q.db.QueryRow(ctx, archiveTask, arg.ID, arg.OwnerID)
+	row := q.db.QueryRow(ctx, archiveTask,
+		arg.ID,
+		arg.OwnerID,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
+	)
 	var i ArchiveTaskRow
 	err := row.Scan(
 		&i.ID,
@@ -76,75 +139,319 @@ func (q *Queries) ArchiveTask(ctx context.Context, arg ArchiveTaskParams) (Archi
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.StartDate,
+		&i.Deadline,
+		&i.Pinned,
+		&i.CompletedAt,
+		&i.ClientRequestID,
+		&i.LastModifiedSource,
+		&i.LastModifiedClientID,
+		&i.Context,
+		&i.LastViewedAt,
+		&i.LastModifiedTokenID,
+		&i.LastModifiedTokenName,
+		&i.CreatedBySource,
+		&i.CreatedByClientID,
+		&i.CreatedByTokenID,
+		&i.CreatedByTokenName,
 	)
 	return i, err
 }
 
-const createChecklistItemWithSortOrder = `-- name: CreateChecklistItemWithSortOrder :one
-INSERT INTO task_checklist_items (task_id, content, completed, sort_order)
-SELECT $1, $2, FALSE, $3
-FROM tasks
-WHERE id = $1 AND owner_id = $4
-RETURNING id, task_id, content, completed, sort_order, created_at, updated_at
+const archiveTasksByTag = `-- name: ArchiveTasksByTag :execrows
+UPDATE tasks
+SET archived_at = NOW(), updated_at = NOW(),
+    last_modified_source = $1, last_modified_client_id = $2,
+    last_modified_token_id = $3, last_modified_token_name = $4
+WHERE owner_id = $5
+  AND archived_at IS NULL
+  AND id IN (SELECT task_id FROM task_tags WHERE tag_id = $6)
 `
 
-type CreateChecklistItemWithSortOrderParams struct {
-	TaskID    pgtype.UUID `json:"task_id"`
-	Content   string      `json:"content"`
-	SortOrder int32       `json:"sort_order"`
-	OwnerID   string      `json:"owner_id"`
+type ArchiveTasksByTagParams struct {
+	LastModifiedSource    pgtype.Text `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text `json:"last_modified_client_id"`
+	LastModifiedTokenID   pgtype.UUID `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text `json:"last_modified_token_name"`
+	OwnerID               string      `json:"owner_id"`
+	TagID                 pgtype.UUID `json:"tag_id"`
 }
 
-func (q *Queries) CreateChecklistItemWithSortOrder(ctx context.Context, arg CreateChecklistItemWithSortOrderParams) (TaskChecklistItem, error) {
-	row := q.db.QueryRow(ctx, createChecklistItemWithSortOrder,
-		arg.TaskID,
-		arg.Content,
-		arg.SortOrder,
+func (q *Queries) ArchiveTasksByTag(ctx context.Context, arg ArchiveTasksByTagParams) (int64, error) {
+	result, err := q.db.Exec(ctx, archiveTasksByTag,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
 		arg.OwnerID,
+		arg.TagID,
 	)
-	var i TaskChecklistItem
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const completeTask = `-- name: CompleteTask :one
+UPDATE tasks
+SET completed_at = COALESCE(completed_at, NOW()), updated_at = NOW(),
+    last_modified_source = $3, last_modified_client_id = $4,
+    last_modified_token_id = $5, last_modified_token_name = $6
+WHERE id = $1 AND owner_id = $2
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
+`
+
+type CompleteTaskParams struct {
+	ID                    pgtype.UUID `json:"id"`
+	OwnerID               string      `json:"owner_id"`
+	LastModifiedSource    pgtype.Text `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text `json:"last_modified_client_id"`
+	LastModifiedTokenID   pgtype.UUID `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text `json:"last_modified_token_name"`
+}
+
+type CompleteTaskRow struct {
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
+}
+
+func (q *Queries) CompleteTask(ctx context.Context, arg CompleteTaskParams) (CompleteTaskRow, error) {
+	row := q.db.QueryRow(ctx, completeTask,
+		arg.ID,
+		arg.OwnerID,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
+	)
+	var i CompleteTaskRow
 	err := row.Scan(
 		&i.ID,
-		&i.TaskID,
-		&i.Content,
-		&i.Completed,
-		&i.SortOrder,
+		&i.Title,
+		&i.Notes,
+		&i.OwnerID,
+		&i.ArchivedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.StartDate,
+		&i.Deadline,
+		&i.Pinned,
+		&i.CompletedAt,
+		&i.ClientRequestID,
+		&i.LastModifiedSource,
+		&i.LastModifiedClientID,
+		&i.Context,
+		&i.LastViewedAt,
+		&i.LastModifiedTokenID,
+		&i.LastModifiedTokenName,
+		&i.CreatedBySource,
+		&i.CreatedByClientID,
+		&i.CreatedByTokenID,
+		&i.CreatedByTokenName,
 	)
 	return i, err
 }
 
+const countArchivableCompletedTasks = `-- name: CountArchivableCompletedTasks :one
+SELECT COUNT(*)
+FROM tasks
+WHERE owner_id = $1
+  AND completed_at IS NOT NULL
+  AND archived_at IS NULL
+  AND ($2::timestamptz IS NULL
+       OR completed_at <= $2::timestamptz)
+`
+
+type CountArchivableCompletedTasksParams struct {
+	OwnerID         string             `json:"owner_id"`
+	CompletedBefore pgtype.Timestamptz `json:"completed_before"`
+}
+
+func (q *Queries) CountArchivableCompletedTasks(ctx context.Context, arg CountArchivableCompletedTasksParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countArchivableCompletedTasks, arg.OwnerID, arg.CompletedBefore)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countBacklogTasks = `-- name: CountBacklogTasks :one
+SELECT COUNT(*)
+FROM tasks
+WHERE owner_id = $1 AND completed_at IS NULL AND archived_at IS NULL
+`
+
+func (q *Queries) CountBacklogTasks(ctx context.Context, ownerID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countBacklogTasks, ownerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countChecklistItemsForTasks = `-- name: CountChecklistItemsForTasks :many
+SELECT task_id,
+       COUNT(*) AS total_count,
+       COUNT(*) FILTER (WHERE completed) AS completed_count
+FROM task_checklist_items
+WHERE task_id = ANY($1::uuid[])
+GROUP BY task_id
+`
+
+type CountChecklistItemsForTasksRow struct {
+	TaskID         pgtype.UUID `json:"task_id"`
+	TotalCount     int64       `json:"total_count"`
+	CompletedCount int64       `json:"completed_count"`
+}
+
+func (q *Queries) CountChecklistItemsForTasks(ctx context.Context, taskIds []pgtype.UUID) ([]CountChecklistItemsForTasksRow, error) {
+	rows, err := q.db.Query(ctx, countChecklistItemsForTasks, taskIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountChecklistItemsForTasksRow{}
+	for rows.Next() {
+		var i CountChecklistItemsForTasksRow
+		if err := rows.Scan(&i.TaskID, &i.TotalCount, &i.CompletedCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createChecklistItems = `-- name: CreateChecklistItems :many
+INSERT INTO task_checklist_items (task_id, content, completed, sort_order)
+SELECT t.id, unnest($1::text[]), FALSE, unnest($2::int[])
+FROM tasks t
+WHERE t.id = $3 AND t.owner_id = $4
+RETURNING id, task_id, content, completed, sort_order, created_at, updated_at
+`
+
+type CreateChecklistItemsParams struct {
+	Contents   []string    `json:"contents"`
+	SortOrders []int32     `json:"sort_orders"`
+	TaskID     pgtype.UUID `json:"task_id"`
+	OwnerID    string      `json:"owner_id"`
+}
+
+func (q *Queries) CreateChecklistItems(ctx context.Context, arg CreateChecklistItemsParams) ([]TaskChecklistItem, error) {
+	rows, err := q.db.Query(ctx, createChecklistItems,
+		arg.Contents,
+		arg.SortOrders,
+		arg.TaskID,
+		arg.OwnerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaskChecklistItem{}
+	for rows.Next() {
+		var i TaskChecklistItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.Content,
+			&i.Completed,
+			&i.SortOrder,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createTask = `-- name: CreateTask :one
-INSERT INTO tasks (title, notes, owner_id, start_date)
-VALUES ($1, $2, $3, $4)
-RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date
+INSERT INTO tasks (title, notes, owner_id, start_date, deadline, client_request_id, last_modified_source, last_modified_client_id, context,
+                   last_modified_token_id, last_modified_token_name,
+                   created_by_source, created_by_client_id, created_by_token_id, created_by_token_name)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $7, $8, $10, $11)
+ON CONFLICT (owner_id, client_request_id) WHERE client_request_id IS NOT NULL DO NOTHING
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
 `
 
 type CreateTaskParams struct {
-	Title     string      `json:"title"`
-	Notes     string      `json:"notes"`
-	OwnerID   string      `json:"owner_id"`
-	StartDate pgtype.Date `json:"start_date"`
+	Title                 string      `json:"title"`
+	Notes                 string      `json:"notes"`
+	OwnerID               string      `json:"owner_id"`
+	StartDate             pgtype.Date `json:"start_date"`
+	Deadline              pgtype.Date `json:"deadline"`
+	ClientRequestID       pgtype.Text `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text `json:"last_modified_client_id"`
+	Context               pgtype.Text `json:"context"`
+	LastModifiedTokenID   pgtype.UUID `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text `json:"last_modified_token_name"`
 }
 
 type CreateTaskRow struct {
-	ID         pgtype.UUID        `json:"id"`
-	Title      string             `json:"title"`
-	Notes      string             `json:"notes"`
-	OwnerID    string             `json:"owner_id"`
-	ArchivedAt pgtype.Timestamptz `json:"archived_at"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	StartDate  pgtype.Date        `json:"start_date"`
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
 }
 
+// Returns no row when the owner already created a task with the same
+// client_request_id.
 func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (CreateTaskRow, error) {
 	row := q.db.QueryRow(ctx, createTask,
 		arg.Title,
 		arg.Notes,
 		arg.OwnerID,
 		arg.StartDate,
+		arg.Deadline,
+		arg.ClientRequestID,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.Context,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
 	)
 	var i CreateTaskRow
 	err := row.Scan(
@@ -156,23 +463,37 @@ func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (CreateT
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.StartDate,
+		&i.Deadline,
+		&i.Pinned,
+		&i.CompletedAt,
+		&i.ClientRequestID,
+		&i.LastModifiedSource,
+		&i.LastModifiedClientID,
+		&i.Context,
+		&i.LastViewedAt,
+		&i.LastModifiedTokenID,
+		&i.LastModifiedTokenName,
+		&i.CreatedBySource,
+		&i.CreatedByClientID,
+		&i.CreatedByTokenID,
+		&i.CreatedByTokenName,
 	)
 	return i, err
 }
 
-const createTaskTag = `-- name: CreateTaskTag :exec
+const createTaskTags = `-- name: CreateTaskTags :exec
 INSERT INTO task_tags (task_id, tag_id)
-VALUES ($1, $2)
+SELECT $1, unnest($2::uuid[])
 ON CONFLICT DO NOTHING
 `
 
-type CreateTaskTagParams struct {
-	TaskID pgtype.UUID `json:"task_id"`
-	TagID  pgtype.UUID `json:"tag_id"`
+type CreateTaskTagsParams struct {
+	TaskID pgtype.UUID   `json:"task_id"`
+	TagIds []pgtype.UUID `json:"tag_ids"`
 }
 
-func (q *Queries) CreateTaskTag(ctx context.Context, arg CreateTaskTagParams) error {
-	_, err := q.db.Exec(ctx, createTaskTag, arg.TaskID, arg.TagID)
+func (q *Queries) CreateTaskTags(ctx context.Context, arg CreateTaskTagsParams) error {
+	_, err := q.db.Exec(ctx, createTaskTags, arg.TaskID, arg.TagIds)
 	return err
 }
 
@@ -198,8 +519,14 @@ func (q *Queries) DeleteChecklistItem(ctx context.Context, arg DeleteChecklistIt
 }
 
 const deleteTask = `-- name: DeleteTask :exec
-DELETE FROM tasks
-WHERE id = $1 AND owner_id = $2
+WITH deleted AS (
+    DELETE FROM tasks
+    WHERE tasks.id = $1 AND tasks.owner_id = $2
+    RETURNING tasks.id, tasks.owner_id
+)
+INSERT INTO task_tombstones (task_id, owner_id)
+SELECT deleted.id, deleted.owner_id FROM deleted
+ON CONFLICT (task_id) DO UPDATE SET owner_id = EXCLUDED.owner_id, deleted_at = NOW()
 `
 
 type DeleteTaskParams struct {
@@ -207,23 +534,74 @@ type DeleteTaskParams struct {
 	OwnerID string      `json:"owner_id"`
 }
 
+// Deletes the task and records a tombstone in the same statement.
 func (q *Queries) DeleteTask(ctx context.Context, arg DeleteTaskParams) error {
 	_, err := q.db.Exec(ctx, deleteTask, arg.ID, arg.OwnerID)
 	return err
 }
 
-const deleteTaskTags = `-- name: DeleteTaskTags :exec
+const deleteTaskTagsNotIn = `-- name: DeleteTaskTagsNotIn :exec
 DELETE FROM task_tags
-WHERE task_id = $1
+WHERE task_id = $1 AND NOT (tag_id = ANY($2::uuid[]))
 `
 
-func (q *Queries) DeleteTaskTags(ctx context.Context, taskID pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteTaskTags, taskID)
+type DeleteTaskTagsNotInParams struct {
+	TaskID pgtype.UUID   `json:"task_id"`
+	TagIds []pgtype.UUID `json:"tag_ids"`
+}
+
+func (q *Queries) DeleteTaskTagsNotIn(ctx context.Context, arg DeleteTaskTagsNotInParams) error {
+	_, err := q.db.Exec(ctx, deleteTaskTagsNotIn, arg.TaskID, arg.TagIds)
 	return err
 }
 
+const getTagTaskCounts = `-- name: GetTagTaskCounts :many
+SELECT tg.id AS tag_id,
+       tg.name AS tag_name,
+       COUNT(*) FILTER (WHERE t.completed_at IS NULL AND t.archived_at IS NULL) AS open_count,
+       COUNT(*) FILTER (WHERE t.completed_at IS NOT NULL) AS completed_count
+FROM tags tg
+JOIN task_tags tt ON tt.tag_id = tg.id
+JOIN tasks t ON t.id = tt.task_id AND t.owner_id = tg.owner_id
+WHERE tg.owner_id = $1
+GROUP BY tg.id, tg.name
+ORDER BY tg.name ASC
+`
+
+type GetTagTaskCountsRow struct {
+	TagID          pgtype.UUID `json:"tag_id"`
+	TagName        string      `json:"tag_name"`
+	OpenCount      int64       `json:"open_count"`
+	CompletedCount int64       `json:"completed_count"`
+}
+
+func (q *Queries) GetTagTaskCounts(ctx context.Context, ownerID string) ([]GetTagTaskCountsRow, error) {
+	rows, err := q.db.Query(ctx, getTagTaskCounts, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTagTaskCountsRow{}
+	for rows.Next() {
+		var i GetTagTaskCountsRow
+		if err := rows.Scan(
+			&i.TagID,
+			&i.TagName,
+			&i.OpenCount,
+			&i.CompletedCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTask = `-- name: GetTask :one
-SELECT id, title, notes, owner_id, archived_at, created_at, updated_at, start_date
+SELECT id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
 FROM tasks
 WHERE id = $1 AND owner_id = $2
 `
@@ -234,14 +612,28 @@ type GetTaskParams struct {
 }
 
 type GetTaskRow struct {
-	ID         pgtype.UUID        `json:"id"`
-	Title      string             `json:"title"`
-	Notes      string             `json:"notes"`
-	OwnerID    string             `json:"owner_id"`
-	ArchivedAt pgtype.Timestamptz `json:"archived_at"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	StartDate  pgtype.Date        `json:"start_date"`
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
 }
 
 func (q *Queries) GetTask(ctx context.Context, arg GetTaskParams) (GetTaskRow, error) {
@@ -256,10 +648,135 @@ func (q *Queries) GetTask(ctx context.Context, arg GetTaskParams) (GetTaskRow, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.StartDate,
+		&i.Deadline,
+		&i.Pinned,
+		&i.CompletedAt,
+		&i.ClientRequestID,
+		&i.LastModifiedSource,
+		&i.LastModifiedClientID,
+		&i.Context,
+		&i.LastViewedAt,
+		&i.LastModifiedTokenID,
+		&i.LastModifiedTokenName,
+		&i.CreatedBySource,
+		&i.CreatedByClientID,
+		&i.CreatedByTokenID,
+		&i.CreatedByTokenName,
+	)
+	return i, err
+}
+
+const getTaskActivityCounts = `-- name: GetTaskActivityCounts :many
+SELECT activity.kind::text AS kind,
+       activity.bucket_start::date AS bucket_start,
+       COUNT(*) AS count
+FROM (
+    SELECT 'created' AS kind, date_trunc($1::text, c.created_at AT TIME ZONE 'UTC') AS bucket_start
+    FROM tasks c
+    WHERE c.owner_id = $2 AND c.created_at >= $3::timestamptz
+    UNION ALL
+    SELECT 'completed', date_trunc($1::text, d.completed_at AT TIME ZONE 'UTC')
+    FROM tasks d
+    WHERE d.owner_id = $2 AND d.completed_at >= $3::timestamptz
+    UNION ALL
+    SELECT 'archived', date_trunc($1::text, a.archived_at AT TIME ZONE 'UTC')
+    FROM tasks a
+    WHERE a.owner_id = $2 AND a.archived_at >= $3::timestamptz
+) AS activity
+GROUP BY activity.kind, activity.bucket_start
+ORDER BY activity.bucket_start ASC
+`
+
+type GetTaskActivityCountsParams struct {
+	Bucket  string             `json:"bucket"`
+	OwnerID string             `json:"owner_id"`
+	Since   pgtype.Timestamptz `json:"since"`
+}
+
+type GetTaskActivityCountsRow struct {
+	Kind        string      `json:"kind"`
+	BucketStart pgtype.Date `json:"bucket_start"`
+	Count       int64       `json:"count"`
+}
+
+// Counts created, completed and archived tasks per day or week bucket (UTC).
+func (q *Queries) GetTaskActivityCounts(ctx context.Context, arg GetTaskActivityCountsParams) ([]GetTaskActivityCountsRow, error) {
+	rows, err := q.db.Query(ctx, getTaskActivityCounts, arg.Bucket, arg.OwnerID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTaskActivityCountsRow{}
+	for rows.Next() {
+		var i GetTaskActivityCountsRow
+		if err := rows.Scan(&i.Kind, &i.BucketStart, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskCounters = `-- name: GetTaskCounters :one
+SELECT COUNT(*) FILTER (WHERE start_date IS NULL) AS inbox_count,
+       COUNT(*) FILTER (WHERE (start_date <= $1::date OR deadline = $1::date)
+                          AND (deadline IS NULL OR deadline >= $1::date)) AS today_count,
+       COUNT(*) FILTER (WHERE deadline < $1::date) AS overdue_count,
+       COUNT(*) FILTER (WHERE last_modified_source IN ('agent', 'system')
+                          AND (last_viewed_at IS NULL OR updated_at > last_viewed_at)) AS unread_count
+FROM tasks
+WHERE owner_id = $2
+  AND completed_at IS NULL AND archived_at IS NULL
+`
+
+type GetTaskCountersParams struct {
+	Today   pgtype.Date `json:"today"`
+	OwnerID string      `json:"owner_id"`
+}
+
+type GetTaskCountersRow struct {
+	InboxCount   int64 `json:"inbox_count"`
+	TodayCount   int64 `json:"today_count"`
+	OverdueCount int64 `json:"overdue_count"`
+	UnreadCount  int64 `json:"unread_count"`
+}
+
+// Counts the owner's open tasks for badges. Today and overdue match
+// ListTodayTaskIDs and ListOverdueTaskIDs; unread tasks were added or
+// changed by an agent or the system since the owner last viewed them.
+func (q *Queries) GetTaskCounters(ctx context.Context, arg GetTaskCountersParams) (GetTaskCountersRow, error) {
+	row := q.db.QueryRow(ctx, getTaskCounters, arg.Today, arg.OwnerID)
+	var i GetTaskCountersRow
+	err := row.Scan(
+		&i.InboxCount,
+		&i.TodayCount,
+		&i.OverdueCount,
+		&i.UnreadCount,
 	)
 	return i, err
 }
 
+const getTaskIDByClientRequestID = `-- name: GetTaskIDByClientRequestID :one
+SELECT id
+FROM tasks
+WHERE owner_id = $1 AND client_request_id = $2
+`
+
+type GetTaskIDByClientRequestIDParams struct {
+	OwnerID         string      `json:"owner_id"`
+	ClientRequestID pgtype.Text `json:"client_request_id"`
+}
+
+func (q *Queries) GetTaskIDByClientRequestID(ctx context.Context, arg GetTaskIDByClientRequestIDParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, getTaskIDByClientRequestID, arg.OwnerID, arg.ClientRequestID)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getTaskTagIDs = `-- name: GetTaskTagIDs :many
 SELECT tag_id
 FROM task_tags
@@ -286,37 +803,27 @@ func (q *Queries) GetTaskTagIDs(ctx context.Context, taskID pgtype.UUID) ([]pgty
 	return items, nil
 }
 
-const listChecklistItems = `-- name: ListChecklistItems :many
-SELECT ci.id, ci.task_id, ci.content, ci.completed, ci.sort_order, ci.created_at, ci.updated_at
-FROM task_checklist_items ci
-JOIN tasks t ON ci.task_id = t.id
-WHERE ci.task_id = $1 AND t.owner_id = $2
-ORDER BY ci.sort_order ASC, ci.created_at ASC
+const getTaskTagIDsForTasks = `-- name: GetTaskTagIDsForTasks :many
+SELECT task_id, tag_id
+FROM task_tags
+WHERE task_id = ANY($1::uuid[])
 `
 
-type ListChecklistItemsParams struct {
-	TaskID  pgtype.UUID `json:"task_id"`
-	OwnerID string      `json:"owner_id"`
+type GetTaskTagIDsForTasksRow struct {
+	TaskID pgtype.UUID `json:"task_id"`
+	TagID  pgtype.UUID `json:"tag_id"`
 }
 
-func (q *Queries) ListChecklistItems(ctx context.Context, arg ListChecklistItemsParams) ([]TaskChecklistItem, error) {
-	rows, err := q.db.Query(ctx, listChecklistItems, arg.TaskID, arg.OwnerID)
+func (q *Queries) GetTaskTagIDsForTasks(ctx context.Context, taskIds []pgtype.UUID) ([]GetTaskTagIDsForTasksRow, error) {
+	rows, err := q.db.Query(ctx, getTaskTagIDsForTasks, taskIds)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []TaskChecklistItem{}
+	items := []GetTaskTagIDsForTasksRow{}
 	for rows.Next() {
-		var i TaskChecklistItem
-		if err := rows.Scan(
-			&i.ID,
-			&i.TaskID,
-			&i.Content,
-			&i.Completed,
-			&i.SortOrder,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
+		var i GetTaskTagIDsForTasksRow
+		if err := rows.Scan(&i.TaskID, &i.TagID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -327,61 +834,51 @@ func (q *Queries) ListChecklistItems(ctx context.Context, arg ListChecklistItems
 	return items, nil
 }
 
-const listTasks = `-- name: ListTasks :many
-SELECT DISTINCT t.id, t.title, t.notes, t.owner_id, t.archived_at, t.created_at, t.updated_at, t.start_date
-FROM tasks t
-LEFT JOIN task_tags tt ON t.id = tt.task_id
-WHERE t.owner_id = $1
-  AND ($4::uuid[] IS NULL
-       OR tt.tag_id = ANY($4::uuid[]))
-  AND (
-    ($5::boolean = TRUE AND t.archived_at IS NOT NULL) OR
-    ($5::boolean = FALSE AND (
-      $6::boolean = TRUE OR
-      ($6::boolean = FALSE AND t.archived_at IS NULL)
-    )) OR
-    ($5::boolean IS NULL AND $6::boolean IS NULL AND t.archived_at IS NULL)
-  )
-ORDER BY t.created_at DESC
-LIMIT $2 OFFSET $3
+const getTasksByIDs = `-- name: GetTasksByIDs :many
+SELECT id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
+FROM tasks
+WHERE id = ANY($1::uuid[]) AND owner_id = $2
 `
 
-type ListTasksParams struct {
-	OwnerID         string        `json:"owner_id"`
-	Limit           int32         `json:"limit"`
-	Offset          int32         `json:"offset"`
-	FilterTagIds    []pgtype.UUID `json:"filter_tag_ids"`
-	ArchivedOnly    pgtype.Bool   `json:"archived_only"`
-	IncludeArchived pgtype.Bool   `json:"include_archived"`
+type GetTasksByIDsParams struct {
+	Ids     []pgtype.UUID `json:"ids"`
+	OwnerID string        `json:"owner_id"`
 }
 
-type ListTasksRow struct {
-	ID         pgtype.UUID        `json:"id"`
-	Title      string             `json:"title"`
-	Notes      string             `json:"notes"`
-	OwnerID    string             `json:"owner_id"`
-	ArchivedAt pgtype.Timestamptz `json:"archived_at"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	StartDate  pgtype.Date        `json:"start_date"`
+type GetTasksByIDsRow struct {
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
 }
 
-func (q *Queries) ListTasks(ctx context.Context, arg ListTasksParams) ([]ListTasksRow, error) {
-	rows, err := q.db.Query(ctx, listTasks,
-		arg.OwnerID,
-		arg.Limit,
-		arg.Offset,
-		arg.FilterTagIds,
-		arg.ArchivedOnly,
-		arg.IncludeArchived,
-	)
+func (q *Queries) GetTasksByIDs(ctx context.Context, arg GetTasksByIDsParams) ([]GetTasksByIDsRow, error) {
+	rows, err := q.db.Query(ctx, getTasksByIDs, arg.Ids, arg.OwnerID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListTasksRow{}
+	items := []GetTasksByIDsRow{}
 	for rows.Next() {
-		var i ListTasksRow
+		var i GetTasksByIDsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
@@ -391,6 +888,20 @@ func (q *Queries) ListTasks(ctx context.Context, arg ListTasksParams) ([]ListTas
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.StartDate,
+			&i.Deadline,
+			&i.Pinned,
+			&i.CompletedAt,
+			&i.ClientRequestID,
+			&i.LastModifiedSource,
+			&i.LastModifiedClientID,
+			&i.Context,
+			&i.LastViewedAt,
+			&i.LastModifiedTokenID,
+			&i.LastModifiedTokenName,
+			&i.CreatedBySource,
+			&i.CreatedByClientID,
+			&i.CreatedByTokenID,
+			&i.CreatedByTokenName,
 		); err != nil {
 			return nil, err
 		}
@@ -402,25 +913,1007 @@ func (q *Queries) ListTasks(ctx context.Context, arg ListTasksParams) ([]ListTas
 	return items, nil
 }
 
-const reorderChecklistItems = `-- name: ReorderChecklistItems :exec
-UPDATE task_checklist_items ci
-SET sort_order = (ordered.ord - 1)::int,
-    updated_at = NOW()
-FROM unnest($2::uuid[]) WITH ORDINALITY AS ordered(id, ord)
-JOIN tasks t ON t.id = $1 AND t.owner_id = $3
-WHERE ci.task_id = $1
-  AND ci.id = ordered.id
+const listChecklistItems = `-- name: ListChecklistItems :many
+SELECT ci.id, ci.task_id, ci.content, ci.completed, ci.sort_order, ci.created_at, ci.updated_at
+FROM task_checklist_items ci
+JOIN tasks t ON ci.task_id = t.id
+WHERE ci.task_id = $1 AND t.owner_id = $2
+ORDER BY ci.sort_order ASC, ci.created_at ASC
 `
 
-type ReorderChecklistItemsParams struct {
-	TaskID  pgtype.UUID   `json:"task_id"`
-	ItemIds []pgtype.UUID `json:"item_ids"`
-	OwnerID string        `json:"owner_id"`
+type ListChecklistItemsParams struct {
+	TaskID  pgtype.UUID `json:"task_id"`
+	OwnerID string      `json:"owner_id"`
 }
 
-func (q *Queries) ReorderChecklistItems(ctx context.Context, arg ReorderChecklistItemsParams) error {
-	_, err := q.db.Exec(ctx, reorderChecklistItems, arg.TaskID, arg.ItemIds, arg.OwnerID)
-	return err
+func (q *Queries) ListChecklistItems(ctx context.Context, arg ListChecklistItemsParams) ([]TaskChecklistItem, error) {
+	rows, err := q.db.Query(ctx, listChecklistItems, arg.TaskID, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaskChecklistItem{}
+	for rows.Next() {
+		var i TaskChecklistItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.Content,
+			&i.Completed,
+			&i.SortOrder,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChecklistItemsForTasks = `-- name: ListChecklistItemsForTasks :many
+SELECT ci.id, ci.task_id, ci.content, ci.completed, ci.sort_order, ci.created_at, ci.updated_at
+FROM task_checklist_items ci
+JOIN tasks t ON ci.task_id = t.id
+WHERE ci.task_id = ANY($1::uuid[]) AND t.owner_id = $2
+ORDER BY ci.task_id, ci.sort_order ASC, ci.created_at ASC
+`
+
+type ListChecklistItemsForTasksParams struct {
+	TaskIds []pgtype.UUID `json:"task_ids"`
+	OwnerID string        `json:"owner_id"`
+}
+
+func (q *Queries) ListChecklistItemsForTasks(ctx context.Context, arg ListChecklistItemsForTasksParams) ([]TaskChecklistItem, error) {
+	rows, err := q.db.Query(ctx, listChecklistItemsForTasks, arg.TaskIds, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaskChecklistItem{}
+	for rows.Next() {
+		var i TaskChecklistItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.Content,
+			&i.Completed,
+			&i.SortOrder,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCompletedTaskEvents = `-- name: ListCompletedTaskEvents :many
+SELECT id, completed_at
+FROM tasks
+WHERE owner_id = $1
+  AND completed_at IS NOT NULL
+  AND ($2::timestamptz IS NULL
+       OR (completed_at, id) < ($2::timestamptz, $3::uuid))
+ORDER BY completed_at DESC, id DESC
+LIMIT $4
+`
+
+type ListCompletedTaskEventsParams struct {
+	OwnerID      string             `json:"owner_id"`
+	BeforeAt     pgtype.Timestamptz `json:"before_at"`
+	BeforeTaskID pgtype.UUID        `json:"before_task_id"`
+	PageLimit    int32              `json:"page_limit"`
+}
+
+type ListCompletedTaskEventsRow struct {
+	ID          pgtype.UUID        `json:"id"`
+	CompletedAt pgtype.Timestamptz `json:"completed_at"`
+}
+
+func (q *Queries) ListCompletedTaskEvents(ctx context.Context, arg ListCompletedTaskEventsParams) ([]ListCompletedTaskEventsRow, error) {
+	rows, err := q.db.Query(ctx, listCompletedTaskEvents,
+		arg.OwnerID,
+		arg.BeforeAt,
+		arg.BeforeTaskID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCompletedTaskEventsRow{}
+	for rows.Next() {
+		var i ListCompletedTaskEventsRow
+		if err := rows.Scan(&i.ID, &i.CompletedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCompletedTaskIDsBetween = `-- name: ListCompletedTaskIDsBetween :many
+SELECT id
+FROM tasks
+WHERE owner_id = $1
+  AND completed_at >= $2::timestamptz
+  AND completed_at < $3::timestamptz
+ORDER BY completed_at DESC
+LIMIT $4
+`
+
+type ListCompletedTaskIDsBetweenParams struct {
+	OwnerID       string             `json:"owner_id"`
+	CompletedFrom pgtype.Timestamptz `json:"completed_from"`
+	CompletedTo   pgtype.Timestamptz `json:"completed_to"`
+	MaxResults    int32              `json:"max_results"`
+}
+
+func (q *Queries) ListCompletedTaskIDsBetween(ctx context.Context, arg ListCompletedTaskIDsBetweenParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listCompletedTaskIDsBetween,
+		arg.OwnerID,
+		arg.CompletedFrom,
+		arg.CompletedTo,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCompletedTaskIDsSince = `-- name: ListCompletedTaskIDsSince :many
+SELECT id
+FROM tasks
+WHERE owner_id = $1
+  AND completed_at >= $2::timestamptz
+ORDER BY completed_at DESC
+LIMIT $3
+`
+
+type ListCompletedTaskIDsSinceParams struct {
+	OwnerID        string             `json:"owner_id"`
+	CompletedSince pgtype.Timestamptz `json:"completed_since"`
+	MaxResults     int32              `json:"max_results"`
+}
+
+func (q *Queries) ListCompletedTaskIDsSince(ctx context.Context, arg ListCompletedTaskIDsSinceParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listCompletedTaskIDsSince, arg.OwnerID, arg.CompletedSince, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCreatedTaskEvents = `-- name: ListCreatedTaskEvents :many
+SELECT id, created_at
+FROM tasks
+WHERE owner_id = $1
+  AND ($2::timestamptz IS NULL
+       OR (created_at, id) < ($2::timestamptz, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4
+`
+
+type ListCreatedTaskEventsParams struct {
+	OwnerID      string             `json:"owner_id"`
+	BeforeAt     pgtype.Timestamptz `json:"before_at"`
+	BeforeTaskID pgtype.UUID        `json:"before_task_id"`
+	PageLimit    int32              `json:"page_limit"`
+}
+
+type ListCreatedTaskEventsRow struct {
+	ID        pgtype.UUID        `json:"id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) ListCreatedTaskEvents(ctx context.Context, arg ListCreatedTaskEventsParams) ([]ListCreatedTaskEventsRow, error) {
+	rows, err := q.db.Query(ctx, listCreatedTaskEvents,
+		arg.OwnerID,
+		arg.BeforeAt,
+		arg.BeforeTaskID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCreatedTaskEventsRow{}
+	for rows.Next() {
+		var i ListCreatedTaskEventsRow
+		if err := rows.Scan(&i.ID, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOverdueTaskIDs = `-- name: ListOverdueTaskIDs :many
+SELECT id
+FROM tasks
+WHERE owner_id = $1
+  AND completed_at IS NULL AND archived_at IS NULL
+  AND deadline < $2::date
+ORDER BY deadline ASC
+LIMIT $3
+`
+
+type ListOverdueTaskIDsParams struct {
+	OwnerID    string      `json:"owner_id"`
+	Today      pgtype.Date `json:"today"`
+	MaxResults int32       `json:"max_results"`
+}
+
+func (q *Queries) ListOverdueTaskIDs(ctx context.Context, arg ListOverdueTaskIDsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listOverdueTaskIDs, arg.OwnerID, arg.Today, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStaleTaskIDs = `-- name: ListStaleTaskIDs :many
+SELECT id
+FROM tasks
+WHERE owner_id = $1
+  AND completed_at IS NULL AND archived_at IS NULL
+  AND updated_at < $2::timestamptz
+ORDER BY updated_at ASC
+LIMIT $3
+`
+
+type ListStaleTaskIDsParams struct {
+	OwnerID       string             `json:"owner_id"`
+	UpdatedBefore pgtype.Timestamptz `json:"updated_before"`
+	MaxResults    int32              `json:"max_results"`
+}
+
+func (q *Queries) ListStaleTaskIDs(ctx context.Context, arg ListStaleTaskIDsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listStaleTaskIDs, arg.OwnerID, arg.UpdatedBefore, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTagAddedEvents = `-- name: ListTagAddedEvents :many
+SELECT tt.task_id, tt.tag_id, tt.created_at, tg.name
+FROM task_tags tt
+JOIN tasks t ON t.id = tt.task_id
+JOIN tags tg ON tg.id = tt.tag_id
+WHERE t.owner_id = $1
+  AND ($2::timestamptz IS NULL
+       OR (tt.created_at, tt.task_id, tt.tag_id) < ($2::timestamptz, $3::uuid, $4::uuid))
+ORDER BY tt.created_at DESC, tt.task_id DESC, tt.tag_id DESC
+LIMIT $5
+`
+
+type ListTagAddedEventsParams struct {
+	OwnerID      string             `json:"owner_id"`
+	BeforeAt     pgtype.Timestamptz `json:"before_at"`
+	BeforeTaskID pgtype.UUID        `json:"before_task_id"`
+	BeforeTagID  pgtype.UUID        `json:"before_tag_id"`
+	PageLimit    int32              `json:"page_limit"`
+}
+
+type ListTagAddedEventsRow struct {
+	TaskID    pgtype.UUID        `json:"task_id"`
+	TagID     pgtype.UUID        `json:"tag_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	Name      string             `json:"name"`
+}
+
+func (q *Queries) ListTagAddedEvents(ctx context.Context, arg ListTagAddedEventsParams) ([]ListTagAddedEventsRow, error) {
+	rows, err := q.db.Query(ctx, listTagAddedEvents,
+		arg.OwnerID,
+		arg.BeforeAt,
+		arg.BeforeTaskID,
+		arg.BeforeTagID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTagAddedEventsRow{}
+	for rows.Next() {
+		var i ListTagAddedEventsRow
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.TagID,
+			&i.CreatedAt,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTaskTombstones = `-- name: ListTaskTombstones :many
+SELECT task_id, deleted_at
+FROM task_tombstones
+WHERE owner_id = $1 AND deleted_at > $2
+  AND ($3::timestamptz IS NULL
+       OR (deleted_at, task_id) > ($3::timestamptz, $4::uuid))
+ORDER BY deleted_at ASC, task_id ASC
+LIMIT $5
+`
+
+type ListTaskTombstonesParams struct {
+	OwnerID      string             `json:"owner_id"`
+	DeletedAfter pgtype.Timestamptz `json:"deleted_after"`
+	AfterAt      pgtype.Timestamptz `json:"after_at"`
+	AfterTaskID  pgtype.UUID        `json:"after_task_id"`
+	PageLimit    int32              `json:"page_limit"`
+}
+
+type ListTaskTombstonesRow struct {
+	TaskID    pgtype.UUID        `json:"task_id"`
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+}
+
+func (q *Queries) ListTaskTombstones(ctx context.Context, arg ListTaskTombstonesParams) ([]ListTaskTombstonesRow, error) {
+	rows, err := q.db.Query(ctx, listTaskTombstones,
+		arg.OwnerID,
+		arg.DeletedAfter,
+		arg.AfterAt,
+		arg.AfterTaskID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTaskTombstonesRow{}
+	for rows.Next() {
+		var i ListTaskTombstonesRow
+		if err := rows.Scan(&i.TaskID, &i.DeletedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasks = `-- name: ListTasks :many
+WITH matched AS (
+  SELECT t.id, t.title, t.notes, t.owner_id, t.archived_at, t.created_at, t.updated_at, t.start_date, t.deadline, t.pinned, t.completed_at, t.client_request_id, t.last_modified_source, t.last_modified_client_id, t.context, t.last_viewed_at, t.last_modified_token_id, t.last_modified_token_name, t.created_by_source, t.created_by_client_id, t.created_by_token_id, t.created_by_token_name,
+         COUNT(*) OVER () AS total_count,
+         COUNT(*) OVER (PARTITION BY t.start_date) AS start_date_group_count,
+         COUNT(*) OVER (PARTITION BY t.deadline) AS deadline_group_count,
+         ROW_NUMBER() OVER (ORDER BY CASE WHEN $2::text = 'archived_at_desc' THEN t.archived_at END DESC NULLS LAST,
+                                     CASE WHEN $2::text = 'archived_at_asc' THEN t.archived_at END ASC NULLS LAST,
+                                     t.pinned DESC, t.created_at DESC) AS position
+  FROM tasks t
+  WHERE t.owner_id = $1
+    AND ($3::uuid[] IS NULL
+         OR ($4::boolean IS NOT TRUE AND EXISTS (
+               SELECT 1 FROM task_tags tt
+               WHERE tt.task_id = t.id AND tt.tag_id = ANY($3::uuid[])))
+         OR ($4::boolean = TRUE AND (
+               SELECT COUNT(DISTINCT tt.tag_id) FROM task_tags tt
+               WHERE tt.task_id = t.id AND tt.tag_id = ANY($3::uuid[])
+             ) = cardinality($3::uuid[])))
+    AND ($5::uuid[] IS NULL
+         OR NOT EXISTS (
+               SELECT 1 FROM task_tags tt
+               WHERE tt.task_id = t.id AND tt.tag_id = ANY($5::uuid[])))
+    AND ($6::boolean IS NOT TRUE
+         OR NOT EXISTS (SELECT 1 FROM task_tags tt WHERE tt.task_id = t.id))
+    AND (
+      ($7::boolean = TRUE AND t.archived_at IS NOT NULL) OR
+      ($7::boolean = FALSE AND (
+        $8::boolean = TRUE OR
+        ($8::boolean = FALSE AND t.archived_at IS NULL)
+      )) OR
+      ($7::boolean IS NULL AND $8::boolean IS NULL AND t.archived_at IS NULL)
+    )
+    AND ($9::date IS NULL
+         OR (t.deadline IS NOT NULL AND t.deadline <= $9::date))
+    AND ($10::date IS NULL
+         OR (t.start_date IS NOT NULL AND t.start_date >= $10::date))
+    AND ($11::date IS NULL
+         OR (t.start_date IS NOT NULL AND t.start_date <= $11::date))
+    AND ($12::boolean IS NOT TRUE OR t.start_date IS NULL)
+    AND ($13::timestamptz IS NULL OR t.updated_at > $13::timestamptz)
+    AND ($14::text[] IS NULL OR t.context = ANY($14::text[]))
+    AND ($15::timestamptz IS NULL OR t.archived_at >= $15::timestamptz)
+    AND ($16::timestamptz IS NULL OR t.archived_at < $16::timestamptz)
+    AND ($17::text IS NULL
+         OR strpos(lower(t.title), lower($17::text)) > 0
+         -- encrypted notes (enc:d1: prefix) are not searchable
+         OR (t.notes NOT LIKE 'enc:d1:%' AND strpos(lower(t.notes), lower($17::text)) > 0))
+),
+tag_counts AS (
+  SELECT tt.tag_id, COUNT(*) AS task_count
+  FROM matched m
+  JOIN task_tags tt ON tt.task_id = m.id
+  WHERE $18::boolean
+  GROUP BY tt.tag_id
+)
+SELECT m.id, m.title, m.notes, m.owner_id, m.archived_at, m.created_at, m.updated_at, m.start_date, m.deadline, m.pinned, m.completed_at, m.client_request_id, m.last_modified_source, m.last_modified_client_id, m.context, m.last_viewed_at, m.last_modified_token_id, m.last_modified_token_name, m.created_by_source, m.created_by_client_id, m.created_by_token_id, m.created_by_token_name,
+       m.total_count, m.start_date_group_count, m.deadline_group_count, m.position,
+       (SELECT COUNT(*) FROM matched u
+        WHERE $18::boolean
+          AND NOT EXISTS (SELECT 1 FROM task_tags tt WHERE tt.task_id = u.id)) AS untagged_count,
+       ARRAY(SELECT tc.tag_id FROM task_tags tt JOIN tag_counts tc ON tc.tag_id = tt.tag_id
+             WHERE tt.task_id = m.id ORDER BY tc.tag_id)::uuid[] AS group_tag_ids,
+       ARRAY(SELECT tc.task_count FROM task_tags tt JOIN tag_counts tc ON tc.tag_id = tt.tag_id
+             WHERE tt.task_id = m.id ORDER BY tc.tag_id)::bigint[] AS group_tag_counts
+FROM matched m
+WHERE (m.position > $19::bigint AND m.position <= $19::bigint + $20::bigint)
+   OR (m.position = m.total_count AND (m.total_count <= $19::bigint OR $20::bigint = 0))
+ORDER BY m.position
+`
+
+type ListTasksParams struct {
+	OwnerID         string             `json:"owner_id"`
+	OrderBy         pgtype.Text        `json:"order_by"`
+	FilterTagIds    []pgtype.UUID      `json:"filter_tag_ids"`
+	TagMatchAll     pgtype.Bool        `json:"tag_match_all"`
+	ExcludeTagIds   []pgtype.UUID      `json:"exclude_tag_ids"`
+	UntaggedOnly    pgtype.Bool        `json:"untagged_only"`
+	ArchivedOnly    pgtype.Bool        `json:"archived_only"`
+	IncludeArchived pgtype.Bool        `json:"include_archived"`
+	DeadlineBefore  pgtype.Date        `json:"deadline_before"`
+	StartDateFrom   pgtype.Date        `json:"start_date_from"`
+	StartDateTo     pgtype.Date        `json:"start_date_to"`
+	InboxOnly       pgtype.Bool        `json:"inbox_only"`
+	UpdatedAfter    pgtype.Timestamptz `json:"updated_after"`
+	Contexts        []string           `json:"contexts"`
+	ArchivedAfter   pgtype.Timestamptz `json:"archived_after"`
+	ArchivedBefore  pgtype.Timestamptz `json:"archived_before"`
+	Query           pgtype.Text        `json:"query"`
+	GroupByTag      bool               `json:"group_by_tag"`
+	PageOffset      int64              `json:"page_offset"`
+	PageLimit       int64              `json:"page_limit"`
+}
+
+type ListTasksRow struct {
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
+	TotalCount            int64              `json:"total_count"`
+	StartDateGroupCount   int64              `json:"start_date_group_count"`
+	DeadlineGroupCount    int64              `json:"deadline_group_count"`
+	Position              int64              `json:"position"`
+	UntaggedCount         int64              `json:"untagged_count"`
+	GroupTagIds           []pgtype.UUID      `json:"group_tag_ids"`
+	GroupTagCounts        []int64            `json:"group_tag_counts"`
+}
+
+// Lists a page of the tasks matching the filters. Every row carries the
+// counts across all pages: the total, the task's start date and deadline
+// groups and, with group_by_tag, the groups of its tags and the untagged
+// group. A page past the end returns the last match alone, with a position
+// at or before page_offset, so that the total is still known.
+func (q *Queries) ListTasks(ctx context.Context, arg ListTasksParams) ([]ListTasksRow, error) {
+	rows, err := q.db.Query(ctx, listTasks,
+		arg.OwnerID,
+		arg.OrderBy,
+		arg.FilterTagIds,
+		arg.TagMatchAll,
+		arg.ExcludeTagIds,
+		arg.UntaggedOnly,
+		arg.ArchivedOnly,
+		arg.IncludeArchived,
+		arg.DeadlineBefore,
+		arg.StartDateFrom,
+		arg.StartDateTo,
+		arg.InboxOnly,
+		arg.UpdatedAfter,
+		arg.Contexts,
+		arg.ArchivedAfter,
+		arg.ArchivedBefore,
+		arg.Query,
+		arg.GroupByTag,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTasksRow{}
+	for rows.Next() {
+		var i ListTasksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Notes,
+			&i.OwnerID,
+			&i.ArchivedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartDate,
+			&i.Deadline,
+			&i.Pinned,
+			&i.CompletedAt,
+			&i.ClientRequestID,
+			&i.LastModifiedSource,
+			&i.LastModifiedClientID,
+			&i.Context,
+			&i.LastViewedAt,
+			&i.LastModifiedTokenID,
+			&i.LastModifiedTokenName,
+			&i.CreatedBySource,
+			&i.CreatedByClientID,
+			&i.CreatedByTokenID,
+			&i.CreatedByTokenName,
+			&i.TotalCount,
+			&i.StartDateGroupCount,
+			&i.DeadlineGroupCount,
+			&i.Position,
+			&i.UntaggedCount,
+			&i.GroupTagIds,
+			&i.GroupTagCounts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksAfter = `-- name: ListTasksAfter :many
+SELECT id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
+FROM tasks
+WHERE owner_id = $1
+  AND id > $2
+  AND ($3::boolean = FALSE OR archived_at IS NOT NULL)
+  AND ($4::boolean = TRUE OR $3::boolean = TRUE OR archived_at IS NULL)
+ORDER BY id ASC
+LIMIT $5
+`
+
+type ListTasksAfterParams struct {
+	OwnerID         string      `json:"owner_id"`
+	AfterID         pgtype.UUID `json:"after_id"`
+	ArchivedOnly    bool        `json:"archived_only"`
+	IncludeArchived bool        `json:"include_archived"`
+	PageLimit       int32       `json:"page_limit"`
+}
+
+type ListTasksAfterRow struct {
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
+}
+
+// Walks the owner's tasks in ID order. A page is short only at the end, even
+// when tasks are deleted while they are walked.
+func (q *Queries) ListTasksAfter(ctx context.Context, arg ListTasksAfterParams) ([]ListTasksAfterRow, error) {
+	rows, err := q.db.Query(ctx, listTasksAfter,
+		arg.OwnerID,
+		arg.AfterID,
+		arg.ArchivedOnly,
+		arg.IncludeArchived,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTasksAfterRow{}
+	for rows.Next() {
+		var i ListTasksAfterRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Notes,
+			&i.OwnerID,
+			&i.ArchivedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartDate,
+			&i.Deadline,
+			&i.Pinned,
+			&i.CompletedAt,
+			&i.ClientRequestID,
+			&i.LastModifiedSource,
+			&i.LastModifiedClientID,
+			&i.Context,
+			&i.LastViewedAt,
+			&i.LastModifiedTokenID,
+			&i.LastModifiedTokenName,
+			&i.CreatedBySource,
+			&i.CreatedByClientID,
+			&i.CreatedByTokenID,
+			&i.CreatedByTokenName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTodayTaskIDs = `-- name: ListTodayTaskIDs :many
+SELECT id
+FROM tasks
+WHERE owner_id = $1
+  AND completed_at IS NULL AND archived_at IS NULL
+  AND (start_date <= $2::date OR deadline = $2::date)
+  AND (deadline IS NULL OR deadline >= $2::date)
+ORDER BY pinned DESC, deadline ASC NULLS LAST, created_at ASC
+LIMIT $3
+`
+
+type ListTodayTaskIDsParams struct {
+	OwnerID    string      `json:"owner_id"`
+	Today      pgtype.Date `json:"today"`
+	MaxResults int32       `json:"max_results"`
+}
+
+func (q *Queries) ListTodayTaskIDs(ctx context.Context, arg ListTodayTaskIDsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listTodayTaskIDs, arg.OwnerID, arg.Today, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUndatedTaskIDs = `-- name: ListUndatedTaskIDs :many
+SELECT id
+FROM tasks
+WHERE owner_id = $1
+  AND completed_at IS NULL AND archived_at IS NULL
+  AND start_date IS NULL AND deadline IS NULL
+ORDER BY created_at ASC
+LIMIT $2
+`
+
+type ListUndatedTaskIDsParams struct {
+	OwnerID    string `json:"owner_id"`
+	MaxResults int32  `json:"max_results"`
+}
+
+func (q *Queries) ListUndatedTaskIDs(ctx context.Context, arg ListUndatedTaskIDsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listUndatedTaskIDs, arg.OwnerID, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeTaskTombstones = `-- name: PurgeTaskTombstones :execrows
+DELETE FROM task_tombstones
+WHERE deleted_at < $1
+`
+
+// Deletes the tombstones of every owner recorded before deleted_before.
+func (q *Queries) PurgeTaskTombstones(ctx context.Context, deletedBefore pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTaskTombstones, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reopenTask = `-- name: ReopenTask :one
+UPDATE tasks
+SET completed_at = NULL, updated_at = NOW(),
+    last_modified_source = $3, last_modified_client_id = $4,
+    last_modified_token_id = $5, last_modified_token_name = $6
+WHERE id = $1 AND owner_id = $2
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
+`
+
+type ReopenTaskParams struct {
+	ID                    pgtype.UUID `json:"id"`
+	OwnerID               string      `json:"owner_id"`
+	LastModifiedSource    pgtype.Text `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text `json:"last_modified_client_id"`
+	LastModifiedTokenID   pgtype.UUID `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text `json:"last_modified_token_name"`
+}
+
+type ReopenTaskRow struct {
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
+}
+
+func (q *Queries) ReopenTask(ctx context.Context, arg ReopenTaskParams) (ReopenTaskRow, error) {
+	row := q.db.QueryRow(ctx, reopenTask,
+		arg.ID,
+		arg.OwnerID,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
+	)
+	var i ReopenTaskRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Notes,
+		&i.OwnerID,
+		&i.ArchivedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartDate,
+		&i.Deadline,
+		&i.Pinned,
+		&i.CompletedAt,
+		&i.ClientRequestID,
+		&i.LastModifiedSource,
+		&i.LastModifiedClientID,
+		&i.Context,
+		&i.LastViewedAt,
+		&i.LastModifiedTokenID,
+		&i.LastModifiedTokenName,
+		&i.CreatedBySource,
+		&i.CreatedByClientID,
+		&i.CreatedByTokenID,
+		&i.CreatedByTokenName,
+	)
+	return i, err
+}
+
+const reorderChecklistItems = `-- name: ReorderChecklistItems :exec
+UPDATE task_checklist_items ci
+SET sort_order = (ordered.ord - 1)::int,
+    updated_at = NOW()
+FROM unnest($2::uuid[]) WITH ORDINALITY AS ordered(id, ord)
+JOIN tasks t ON t.id = $1 AND t.owner_id = $3
+WHERE ci.task_id = $1
+  AND ci.id = ordered.id
+`
+
+type ReorderChecklistItemsParams struct {
+	TaskID  pgtype.UUID   `json:"task_id"`
+	ItemIds []pgtype.UUID `json:"item_ids"`
+	OwnerID string        `json:"owner_id"`
+}
+
+func (q *Queries) ReorderChecklistItems(ctx context.Context, arg ReorderChecklistItemsParams) error {
+	_, err := q.db.Exec(ctx, reorderChecklistItems, arg.TaskID, arg.ItemIds, arg.OwnerID)
+	return err
+}
+
+const restoreChecklistItem = `-- name: RestoreChecklistItem :exec
+INSERT INTO task_checklist_items (id, task_id, content, completed, sort_order, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type RestoreChecklistItemParams struct {
+	ID        pgtype.UUID        `json:"id"`
+	TaskID    pgtype.UUID        `json:"task_id"`
+	Content   string             `json:"content"`
+	Completed bool               `json:"completed"`
+	SortOrder int32              `json:"sort_order"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+// Inserts a checklist item from a backup with its ID, state and timestamps.
+func (q *Queries) RestoreChecklistItem(ctx context.Context, arg RestoreChecklistItemParams) error {
+	_, err := q.db.Exec(ctx, restoreChecklistItem,
+		arg.ID,
+		arg.TaskID,
+		arg.Content,
+		arg.Completed,
+		arg.SortOrder,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const restoreTask = `-- name: RestoreTask :exec
+INSERT INTO tasks (id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
+`
+
+type RestoreTaskParams struct {
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
+}
+
+// Inserts a task from a backup with its ID, timestamps and state.
+func (q *Queries) RestoreTask(ctx context.Context, arg RestoreTaskParams) error {
+	_, err := q.db.Exec(ctx, restoreTask,
+		arg.ID,
+		arg.Title,
+		arg.Notes,
+		arg.OwnerID,
+		arg.ArchivedAt,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.StartDate,
+		arg.Deadline,
+		arg.Pinned,
+		arg.CompletedAt,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.Context,
+		arg.LastViewedAt,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
+		arg.CreatedBySource,
+		arg.CreatedByClientID,
+		arg.CreatedByTokenID,
+		arg.CreatedByTokenName,
+	)
+	return err
 }
 
 const setChecklistItemCompleted = `-- name: SetChecklistItemCompleted :one
@@ -454,31 +1947,138 @@ func (q *Queries) SetChecklistItemCompleted(ctx context.Context, arg SetChecklis
 	return i, err
 }
 
+const togglePinTask = `-- name: TogglePinTask :one
+UPDATE tasks
+SET pinned = NOT pinned, updated_at = NOW(),
+    last_modified_source = $3, last_modified_client_id = $4,
+    last_modified_token_id = $5, last_modified_token_name = $6
+WHERE id = $1 AND owner_id = $2
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
+`
+
+type TogglePinTaskParams struct {
+	ID                    pgtype.UUID `json:"id"`
+	OwnerID               string      `json:"owner_id"`
+	LastModifiedSource    pgtype.Text `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text `json:"last_modified_client_id"`
+	LastModifiedTokenID   pgtype.UUID `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text `json:"last_modified_token_name"`
+}
+
+type TogglePinTaskRow struct {
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
+}
+
+func (q *Queries) TogglePinTask(ctx context.Context, arg TogglePinTaskParams) (TogglePinTaskRow, error) {
+	row := q.db.QueryRow(ctx, togglePinTask,
+		arg.ID,
+		arg.OwnerID,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
+	)
+	var i TogglePinTaskRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Notes,
+		&i.OwnerID,
+		&i.ArchivedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartDate,
+		&i.Deadline,
+		&i.Pinned,
+		&i.CompletedAt,
+		&i.ClientRequestID,
+		&i.LastModifiedSource,
+		&i.LastModifiedClientID,
+		&i.Context,
+		&i.LastViewedAt,
+		&i.LastModifiedTokenID,
+		&i.LastModifiedTokenName,
+		&i.CreatedBySource,
+		&i.CreatedByClientID,
+		&i.CreatedByTokenID,
+		&i.CreatedByTokenName,
+	)
+	return i, err
+}
+
 const unarchiveTask = `-- name: UnarchiveTask :one
 UPDATE tasks
-SET archived_at = NULL, updated_at = NOW()
+SET archived_at = NULL, updated_at = NOW(),
+    last_modified_source = $3, last_modified_client_id = $4,
+    last_modified_token_id = $5, last_modified_token_name = $6
 WHERE id = $1 AND owner_id = $2
-RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
 `
 
 type UnarchiveTaskParams struct {
-	ID      pgtype.UUID `json:"id"`
-	OwnerID string      `json:"owner_id"`
+	ID                    pgtype.UUID `json:"id"`
+	OwnerID               string      `json:"owner_id"`
+	LastModifiedSource    pgtype.Text `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text `json:"last_modified_client_id"`
+	LastModifiedTokenID   pgtype.UUID `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text `json:"last_modified_token_name"`
 }
 
 type UnarchiveTaskRow struct {
-	ID         pgtype.UUID        `json:"id"`
-	Title      string             `json:"title"`
-	Notes      string             `json:"notes"`
-	OwnerID    string             `json:"owner_id"`
-	ArchivedAt pgtype.Timestamptz `json:"archived_at"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	StartDate  pgtype.Date        `json:"start_date"`
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
 }
 
 func (q *Queries) UnarchiveTask(ctx context.Context, arg UnarchiveTaskParams) (UnarchiveTaskRow, error) {
-	row := q.db.QueryRow(ctx, unarchiveTask, arg.ID, arg.OwnerID)
+	row := q.db.QueryRow(ctx, unarchiveTask,
+		arg.ID,
+		arg.OwnerID,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
+	)
 	var i UnarchiveTaskRow
 	err := row.Scan(
 		&i.ID,
@@ -489,10 +2089,58 @@ func (q *Queries) UnarchiveTask(ctx context.Context, arg UnarchiveTaskParams) (U
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.StartDate,
+		&i.Deadline,
+		&i.Pinned,
+		&i.CompletedAt,
+		&i.ClientRequestID,
+		&i.LastModifiedSource,
+		&i.LastModifiedClientID,
+		&i.Context,
+		&i.LastViewedAt,
+		&i.LastModifiedTokenID,
+		&i.LastModifiedTokenName,
+		&i.CreatedBySource,
+		&i.CreatedByClientID,
+		&i.CreatedByTokenID,
+		&i.CreatedByTokenName,
 	)
 	return i, err
 }
 
+const unarchiveTasksByTag = `-- name: UnarchiveTasksByTag :execrows
+UPDATE tasks
+SET archived_at = NULL, updated_at = NOW(),
+    last_modified_source = $1, last_modified_client_id = $2,
+    last_modified_token_id = $3, last_modified_token_name = $4
+WHERE owner_id = $5
+  AND archived_at IS NOT NULL
+  AND id IN (SELECT task_id FROM task_tags WHERE tag_id = $6)
+`
+
+type UnarchiveTasksByTagParams struct {
+	LastModifiedSource    pgtype.Text `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text `json:"last_modified_client_id"`
+	LastModifiedTokenID   pgtype.UUID `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text `json:"last_modified_token_name"`
+	OwnerID               string      `json:"owner_id"`
+	TagID                 pgtype.UUID `json:"tag_id"`
+}
+
+func (q *Queries) UnarchiveTasksByTag(ctx context.Context, arg UnarchiveTasksByTagParams) (int64, error) {
+	result, err := q.db.Exec(ctx, unarchiveTasksByTag,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
+		arg.OwnerID,
+		arg.TagID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateChecklistItemContent = `-- name: UpdateChecklistItemContent :one
 UPDATE task_checklist_items ci
 SET content = $1, updated_at = NOW()
@@ -526,28 +2174,50 @@ func (q *Queries) UpdateChecklistItemContent(ctx context.Context, arg UpdateChec
 
 const updateTask = `-- name: UpdateTask :one
 UPDATE tasks
-SET title = $2, notes = $3, updated_at = NOW(), start_date = $5
+SET title = $2, notes = $3, updated_at = NOW(), start_date = $5, deadline = $6,
+    last_modified_source = $7, last_modified_client_id = $8, context = $9,
+    last_modified_token_id = $10, last_modified_token_name = $11
 WHERE id = $1 AND owner_id = $4
-RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date
+RETURNING id, title, notes, owner_id, archived_at, created_at, updated_at, start_date, deadline, pinned, completed_at, client_request_id, last_modified_source, last_modified_client_id, context, last_viewed_at, last_modified_token_id, last_modified_token_name, created_by_source, created_by_client_id, created_by_token_id, created_by_token_name
 `
 
 type UpdateTaskParams struct {
-	ID        pgtype.UUID `json:"id"`
-	Title     string      `json:"title"`
-	Notes     string      `json:"notes"`
-	OwnerID   string      `json:"owner_id"`
-	StartDate pgtype.Date `json:"start_date"`
+	ID                    pgtype.UUID `json:"id"`
+	Title                 string      `json:"title"`
+	Notes                 string      `json:"notes"`
+	OwnerID               string      `json:"owner_id"`
+	StartDate             pgtype.Date `json:"start_date"`
+	Deadline              pgtype.Date `json:"deadline"`
+	LastModifiedSource    pgtype.Text `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text `json:"last_modified_client_id"`
+	Context               pgtype.Text `json:"context"`
+	LastModifiedTokenID   pgtype.UUID `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text `json:"last_modified_token_name"`
 }
 
 type UpdateTaskRow struct {
-	ID         pgtype.UUID        `json:"id"`
-	Title      string             `json:"title"`
-	Notes      string             `json:"notes"`
-	OwnerID    string             `json:"owner_id"`
-	ArchivedAt pgtype.Timestamptz `json:"archived_at"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	StartDate  pgtype.Date        `json:"start_date"`
+	ID                    pgtype.UUID        `json:"id"`
+	Title                 string             `json:"title"`
+	Notes                 string             `json:"notes"`
+	OwnerID               string             `json:"owner_id"`
+	ArchivedAt            pgtype.Timestamptz `json:"archived_at"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	StartDate             pgtype.Date        `json:"start_date"`
+	Deadline              pgtype.Date        `json:"deadline"`
+	Pinned                bool               `json:"pinned"`
+	CompletedAt           pgtype.Timestamptz `json:"completed_at"`
+	ClientRequestID       pgtype.Text        `json:"client_request_id"`
+	LastModifiedSource    pgtype.Text        `json:"last_modified_source"`
+	LastModifiedClientID  pgtype.Text        `json:"last_modified_client_id"`
+	Context               pgtype.Text        `json:"context"`
+	LastViewedAt          pgtype.Timestamptz `json:"last_viewed_at"`
+	LastModifiedTokenID   pgtype.UUID        `json:"last_modified_token_id"`
+	LastModifiedTokenName pgtype.Text        `json:"last_modified_token_name"`
+	CreatedBySource       pgtype.Text        `json:"created_by_source"`
+	CreatedByClientID     pgtype.Text        `json:"created_by_client_id"`
+	CreatedByTokenID      pgtype.UUID        `json:"created_by_token_id"`
+	CreatedByTokenName    pgtype.Text        `json:"created_by_token_name"`
 }
 
 func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (UpdateTaskRow, error) {
@@ -557,6 +2227,12 @@ func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (UpdateT
 		arg.Notes,
 		arg.OwnerID,
 		arg.StartDate,
+		arg.Deadline,
+		arg.LastModifiedSource,
+		arg.LastModifiedClientID,
+		arg.Context,
+		arg.LastModifiedTokenID,
+		arg.LastModifiedTokenName,
 	)
 	var i UpdateTaskRow
 	err := row.Scan(
@@ -568,6 +2244,20 @@ func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (UpdateT
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.StartDate,
+		&i.Deadline,
+		&i.Pinned,
+		&i.CompletedAt,
+		&i.ClientRequestID,
+		&i.LastModifiedSource,
+		&i.LastModifiedClientID,
+		&i.Context,
+		&i.LastViewedAt,
+		&i.LastModifiedTokenID,
+		&i.LastModifiedTokenName,
+		&i.CreatedBySource,
+		&i.CreatedByClientID,
+		&i.CreatedByTokenID,
+		&i.CreatedByTokenName,
 	)
 	return i, err
 }
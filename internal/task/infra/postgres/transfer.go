@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/pkg/database"
+)
+
+// Transfer gives the tasks ids of fromOwnerID to toOwnerID in one
+// transaction. The rows change owner in place, so the tasks keep their IDs,
+// checklists and note revisions; notes and revisions are sealed again for
+// the recipient, tags are matched by name and created in the same
+// transaction, and the previous owner gets tombstones. Both owners must live
+// on the same shard, or database.ErrOwnersApart is returned. ids must not
+// repeat.
+func (r *TaskRepository) Transfer(ctx context.Context, fromOwnerID, toOwnerID string, ids []uuid.UUID, by domain.Modifier) ([]uuid.UUID, error) {
+	ctx, err := database.Colocate(ctx, r.pool, fromOwnerID, toOwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	pgIDs := uuidsToPgUUIDs(ids)
+	var createdTags []uuid.UUID
+	err = r.withTx(ctx, func(txQueries *Queries) error {
+		locked, err := txQueries.LockTransferTasks(ctx, LockTransferTasksParams{
+			OwnerID: fromOwnerID,
+			Ids:     pgIDs,
+		})
+		if err != nil {
+			return err
+		}
+		if len(locked) < len(ids) {
+			return domain.ErrTaskNotFound
+		}
+
+		mapped, err := txQueries.MapTransferTags(ctx, MapTransferTagsParams{
+			TaskIds:     pgIDs,
+			FromOwnerID: fromOwnerID,
+			ToOwnerID:   toOwnerID,
+		})
+		if err != nil {
+			return err
+		}
+		createdTags = nil
+		tagIDs := make(map[uuid.UUID]uuid.UUID, len(mapped))
+		oldTagIDs := make([]pgtype.UUID, 0, len(mapped))
+		newTagIDs := make([]pgtype.UUID, 0, len(mapped))
+		for _, row := range mapped {
+			if !row.TagID.Valid {
+				// The recipient created a tag of the same name meanwhile
+				return domain.ErrTransferConflict
+			}
+			tagIDs[row.SourceID.Bytes] = row.TagID.Bytes
+			oldTagIDs = append(oldTagIDs, row.SourceID)
+			newTagIDs = append(newTagIDs, row.TagID)
+			if row.Created {
+				createdTags = append(createdTags, row.TagID.Bytes)
+			}
+		}
+
+		for _, pgID := range locked {
+			task, err := r.getPartial(ctx, txQueries, pgID.Bytes, fromOwnerID, domain.LoadOptions{SkipChecklist: true})
+			if err != nil {
+				return err
+			}
+			for _, tagID := range task.TagIDs {
+				if _, ok := tagIDs[tagID]; !ok {
+					return domain.ErrTransferConflict
+				}
+			}
+			notes, err := r.notes.seal(ctx, toOwnerID, task.Notes)
+			if err != nil {
+				return err
+			}
+			err = txQueries.TransferTask(ctx, TransferTaskParams{
+				ToOwnerID:             toOwnerID,
+				Notes:                 notes,
+				LastModifiedSource:    textFromString(string(by.Source)),
+				LastModifiedClientID:  textFromString(by.ClientID),
+				LastModifiedTokenID:   nullableUUID(by.TokenID),
+				LastModifiedTokenName: textFromString(by.TokenName),
+				ID:                    pgID,
+				FromOwnerID:           fromOwnerID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		err = txQueries.RemapTaskTags(ctx, RemapTaskTagsParams{
+			OldTagIds: oldTagIDs,
+			NewTagIds: newTagIDs,
+			TaskIds:   pgIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := r.resealNoteRevisions(ctx, txQueries, pgIDs, fromOwnerID, toOwnerID); err != nil {
+			return err
+		}
+
+		return txQueries.RecordTransferTombstones(ctx, RecordTransferTombstonesParams{
+			TaskIds: pgIDs,
+			OwnerID: fromOwnerID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return createdTags, nil
+}
+
+// resealNoteRevisions seals the note revisions of the tasks taskIDs, sealed
+// for fromOwnerID, again for toOwnerID
+func (r *TaskRepository) resealNoteRevisions(ctx context.Context, txQueries *Queries, taskIDs []pgtype.UUID, fromOwnerID, toOwnerID string) error {
+	revisions, err := txQueries.ListTransferNoteRevisions(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+	for _, revision := range revisions {
+		notes, err := r.notes.open(ctx, fromOwnerID, revision.Notes)
+		if err != nil {
+			return err
+		}
+		if notes, err = r.notes.seal(ctx, toOwnerID, notes); err != nil {
+			return err
+		}
+		err = txQueries.UpdateNoteRevisionNotes(ctx, UpdateNoteRevisionNotesParams{
+			ID:    revision.ID,
+			Notes: notes,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
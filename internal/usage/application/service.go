@@ -0,0 +1,156 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/usage/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("usage-service")
+
+// purgedRows counts the rows deleted by retention purges, by kind of data
+var purgedRows, _ = otel.Meter("usage-service").Int64Counter(
+	"retention.purged",
+	metric.WithDescription("Rows deleted by data retention purges"),
+	metric.WithUnit("{row}"),
+)
+
+// Service counts API calls per user and day and serves the counts back to
+// the users. Calls are counted in memory and added to the stored counts by
+// Flush, so counting does not cost a database write per call.
+type Service struct {
+	repo   domain.Repository
+	logger *slog.Logger
+	now    func() time.Time
+
+	mu      sync.Mutex
+	pending map[domain.Key]domain.Counts
+}
+
+// NewService creates a new usage service
+func NewService(repo domain.Repository, logger *slog.Logger) *Service {
+	return &Service{
+		repo:    repo,
+		logger:  logger,
+		now:     time.Now,
+		pending: make(map[domain.Key]domain.Counts),
+	}
+}
+
+// Record counts a call of userID in a method class made with a credential
+// type; rateLimited marks calls rejected by a rate limit
+func (s *Service) Record(userID, methodClass, authType string, rateLimited bool) {
+	key := domain.Key{
+		UserID:      userID,
+		Day:         domain.Truncate(s.now()),
+		MethodClass: methodClass,
+		AuthType:    authType,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counts := s.pending[key]
+	counts.Requests++
+	if rateLimited {
+		counts.RateLimited++
+	}
+	s.pending[key] = counts
+}
+
+// Flush adds the calls counted since the last flush to the stored counts.
+// When that fails they are kept for the next flush.
+func (s *Service) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[domain.Key]domain.Counts)
+	s.mu.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	ctx, span := tracer.Start(ctx, "Flush", trace.WithAttributes(
+		attribute.Int("keys", len(pending)),
+	))
+	defer span.End()
+
+	if err := s.repo.AddUsage(ctx, pending); err != nil {
+		s.logger.ErrorContext(ctx, "failed to store API usage", "keys", len(pending), "error", err)
+		span.RecordError(err)
+		s.restore(pending)
+		return err
+	}
+	return nil
+}
+
+// restore adds counts that could not be stored back to the pending ones
+func (s *Service) restore(counts map[domain.Key]domain.Counts) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, count := range counts {
+		pending := s.pending[key]
+		pending.Requests += count.Requests
+		pending.RateLimited += count.RateLimited
+		s.pending[key] = pending
+	}
+}
+
+// GetMyUsage returns the current user's usage from one UTC day to another,
+// both inclusive. Calls made since the last flush are not included yet.
+func (s *Service) GetMyUsage(ctx context.Context, from, to time.Time) ([]*domain.Day, error) {
+	ctx, span := tracer.Start(ctx, "GetMyUsage", trace.WithAttributes(
+		attribute.String("from", from.Format(time.DateOnly)),
+		attribute.String("to", to.Format(time.DateOnly)),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	days, err := s.repo.ListUsage(ctx, userID, domain.Truncate(from), domain.Truncate(to))
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list API usage", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	return days, nil
+}
+
+// RunUsagePurge deletes the usage of days that ended more than retention
+// ago and returns how many rows were deleted. It is run by the scheduled
+// retention job. A non-positive retention keeps all usage.
+func (s *Service) RunUsagePurge(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, span := tracer.Start(ctx, "RunUsagePurge", trace.WithAttributes(
+		attribute.String("retention", retention.String()),
+	))
+	defer span.End()
+
+	if retention <= 0 {
+		return 0, nil
+	}
+
+	before := domain.Truncate(s.now().Add(-retention))
+	count, err := s.repo.PurgeUsage(ctx, before)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to purge API usage", "error", err)
+		span.RecordError(err)
+		return 0, err
+	}
+
+	purgedRows.Add(ctx, count, metric.WithAttributes(attribute.String("data", "api_usage")))
+	span.SetAttributes(attribute.Int64("purged", count))
+	s.logger.InfoContext(ctx, "API usage purge finished", "retention", retention, "purged", count)
+	return count, nil
+}
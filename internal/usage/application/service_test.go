@@ -0,0 +1,97 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/slips-ai/slips-core/internal/memory"
+	"github.com/slips-ai/slips-core/internal/usage/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+)
+
+// failingRepository fails AddUsage while fail is set
+type failingRepository struct {
+	domain.Repository
+	fail bool
+}
+
+func (r *failingRepository) AddUsage(ctx context.Context, counts map[domain.Key]domain.Counts) error {
+	if r.fail {
+		return errors.New("database unavailable")
+	}
+	return r.Repository.AddUsage(ctx, counts)
+}
+
+func TestService_RecordAndFlush(t *testing.T) {
+	repo := &failingRepository{Repository: memory.NewUsageRepository(memory.NewStore())}
+	service := NewService(repo, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	now := time.Date(2026, 10, 16, 23, 59, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+	ctx := auth.WithUserID(context.Background(), "owner")
+
+	service.Record("owner", domain.MethodClassRead, auth.CredentialJWT, false)
+	service.Record("owner", domain.MethodClassWrite, auth.CredentialMCPToken, false)
+	service.Record("owner", domain.MethodClassWrite, auth.CredentialMCPToken, true)
+	service.Record("other", domain.MethodClassRead, auth.CredentialJWT, false)
+
+	repo.fail = true
+	if err := service.Flush(context.Background()); err == nil {
+		t.Fatal("Flush succeeded while the repository fails")
+	}
+	repo.fail = false
+	now = now.Add(2 * time.Minute)
+	service.Record("owner", domain.MethodClassRead, auth.CredentialJWT, false)
+	if err := service.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	days, err := service.GetMyUsage(ctx, now.AddDate(0, 0, -1), now)
+	if err != nil {
+		t.Fatalf("GetMyUsage: %v", err)
+	}
+	got := make(map[string]domain.Counts)
+	for _, day := range days {
+		got[day.Day.Format(time.DateOnly)+" "+day.MethodClass+" "+day.AuthType] = day.Counts
+	}
+	want := map[string]domain.Counts{
+		"2026-10-16 read jwt":        {Requests: 1},
+		"2026-10-16 write mcp_token": {Requests: 2, RateLimited: 1},
+		"2026-10-17 read jwt":        {Requests: 1},
+	}
+	if len(got) != len(want) {
+		t.Errorf("usage = %v, want %v", got, want)
+	}
+	for key, counts := range want {
+		if got[key] != counts {
+			t.Errorf("%s = %+v, want %+v after the failed flush was retried", key, got[key], counts)
+		}
+	}
+}
+
+func TestService_RunUsagePurge(t *testing.T) {
+	service := NewService(memory.NewUsageRepository(memory.NewStore()), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	for _, day := range []time.Time{now.AddDate(0, 0, -3), now.AddDate(0, 0, -2), now} {
+		service.now = func() time.Time { return day }
+		service.Record("owner", domain.MethodClassRead, auth.CredentialJWT, false)
+	}
+	if err := service.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	service.now = func() time.Time { return now }
+
+	if purged, err := service.RunUsagePurge(context.Background(), 0); err != nil || purged != 0 {
+		t.Errorf("purge without retention = %d, %v, want nothing purged", purged, err)
+	}
+	purged, err := service.RunUsagePurge(context.Background(), 48*time.Hour)
+	if err != nil {
+		t.Fatalf("RunUsagePurge: %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("purged = %d, want only the day that ended more than 48h ago", purged)
+	}
+}
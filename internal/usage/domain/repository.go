@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for usage persistence
+type Repository interface {
+	// AddUsage adds counts to the stored counts of their keys.
+	AddUsage(ctx context.Context, counts map[Key]Counts) error
+	// ListUsage returns the usage of a user from one UTC day to another,
+	// both inclusive, ordered by day, method class and credential type.
+	ListUsage(ctx context.Context, userID string, from, to time.Time) ([]*Day, error)
+	// PurgeUsage deletes the usage of days before a UTC day and returns how
+	// many rows were deleted.
+	PurgeUsage(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// Method classes a call is counted under
+const (
+	// MethodClassRead is for RPCs that only read data
+	MethodClassRead = "read"
+	// MethodClassWrite is for RPCs that may change data
+	MethodClassWrite = "write"
+)
+
+// Key identifies the counts of one user on one UTC day for one method class
+// and credential type, such as auth.CredentialMCPToken
+type Key struct {
+	UserID      string
+	Day         time.Time
+	MethodClass string
+	AuthType    string
+}
+
+// Counts are the calls counted under a Key
+type Counts struct {
+	Requests int64
+	// RateLimited counts the requests rejected by a rate limit
+	RateLimited int64
+}
+
+// Day is a user's usage on one UTC day for one method class and credential
+// type
+type Day struct {
+	Day         time.Time
+	MethodClass string
+	AuthType    string
+	Counts
+}
+
+// Truncate returns the UTC day t falls on
+func Truncate(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/slips-ai/slips-core/internal/usage/application"
+	"github.com/slips-ai/slips-core/internal/usage/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor counts the calls of authenticated users with
+// service. It must run after authentication and before the rate limits
+// whose rejections it counts.
+func UnaryServerInterceptor(service *application.Service) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		record(ctx, service, info.FullMethod, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor; a stream counts as one call when it ends
+func StreamServerInterceptor(service *application.Service) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		err := handler(srv, ss)
+		record(ss.Context(), service, info.FullMethod, err)
+		return err
+	}
+}
+
+// record counts a finished call; calls to public methods have no user and
+// are not counted
+func record(ctx context.Context, service *application.Service, fullMethod string, err error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return
+	}
+	methodClass := domain.MethodClassRead
+	if ratelimit.IsMutation(fullMethod) {
+		methodClass = domain.MethodClassWrite
+	}
+	service.Record(principal.UserID, methodClass, principal.Credential, status.Code(err) == codes.ResourceExhausted)
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	usagev1 "github.com/slips-ai/slips-core/gen/go/usage/v1"
+	"github.com/slips-ai/slips-core/internal/usage/application"
+	"github.com/slips-ai/slips-core/internal/usage/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultUsageDays is the number of days GetMyUsage covers by default
+	defaultUsageDays = 30
+	// maxUsageDays bounds the number of days GetMyUsage covers
+	maxUsageDays = 90
+)
+
+// UsageServer implements the UsageService gRPC server
+type UsageServer struct {
+	usagev1.UnimplementedUsageServiceServer
+	service *application.Service
+	now     func() time.Time
+}
+
+// NewUsageServer creates a new usage gRPC server
+func NewUsageServer(service *application.Service) *UsageServer {
+	return &UsageServer{
+		service: service,
+		now:     time.Now,
+	}
+}
+
+// GetMyUsage returns the caller's daily request counts
+func (s *UsageServer) GetMyUsage(ctx context.Context, req *usagev1.GetMyUsageRequest) (*usagev1.GetMyUsageResponse, error) {
+	to := domain.Truncate(s.now())
+	if req.ToDate != nil {
+		parsed, err := parseDate(*req.ToDate, "to_date")
+		if err != nil {
+			return nil, err
+		}
+		to = parsed
+	}
+	from := to.AddDate(0, 0, -(defaultUsageDays - 1))
+	if req.FromDate != nil {
+		parsed, err := parseDate(*req.FromDate, "from_date")
+		if err != nil {
+			return nil, err
+		}
+		from = parsed
+	}
+	if to.Before(from) {
+		return nil, status.Error(codes.InvalidArgument, "to_date must not be before from_date")
+	}
+	if days := int(to.Sub(from).Hours()/24) + 1; days > maxUsageDays {
+		return nil, status.Errorf(codes.InvalidArgument, "from_date to to_date must span at most %d days", maxUsageDays)
+	}
+
+	days, err := s.service.GetMyUsage(ctx, from, to)
+	if err != nil {
+		return nil, grpcerrors.ToGRPCError(err, "failed to get usage")
+	}
+
+	records := make([]*usagev1.UsageRecord, len(days))
+	for i, day := range days {
+		records[i] = &usagev1.UsageRecord{
+			Date:        day.Day.Format("2006-01-02"),
+			MethodClass: methodClassToProto(day.MethodClass),
+			AuthType:    authTypeToProto(day.AuthType),
+			Requests:    day.Requests,
+			RateLimited: day.RateLimited,
+		}
+	}
+	return &usagev1.GetMyUsageResponse{Records: records}, nil
+}
+
+func parseDate(date, fieldName string) (time.Time, error) {
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid %s format: expected YYYY-MM-DD", fieldName)
+	}
+	return parsed, nil
+}
+
+func methodClassToProto(methodClass string) usagev1.MethodClass {
+	switch methodClass {
+	case domain.MethodClassRead:
+		return usagev1.MethodClass_METHOD_CLASS_READ
+	case domain.MethodClassWrite:
+		return usagev1.MethodClass_METHOD_CLASS_WRITE
+	default:
+		return usagev1.MethodClass_METHOD_CLASS_UNSPECIFIED
+	}
+}
+
+func authTypeToProto(authType string) usagev1.AuthType {
+	switch authType {
+	case auth.CredentialJWT:
+		return usagev1.AuthType_AUTH_TYPE_APP
+	case auth.CredentialMCPToken:
+		return usagev1.AuthType_AUTH_TYPE_AGENT
+	default:
+		return usagev1.AuthType_AUTH_TYPE_UNSPECIFIED
+	}
+}
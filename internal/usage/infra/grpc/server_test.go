@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	usagev1 "github.com/slips-ai/slips-core/gen/go/usage/v1"
+	"github.com/slips-ai/slips-core/internal/memory"
+	"github.com/slips-ai/slips-core/internal/usage/application"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUsage_CountsCallsByClassAndCredential(t *testing.T) {
+	service := application.NewService(memory.NewUsageRepository(memory.NewStore()), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	interceptor := UnaryServerInterceptor(service)
+	app := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "owner", Credential: auth.CredentialJWT})
+	agent := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "owner", Credential: auth.CredentialMCPToken})
+
+	for _, call := range []struct {
+		ctx    context.Context
+		method string
+		err    error
+	}{
+		{app, "/task.v1.TaskService/ListTasks", nil},
+		{agent, "/task.v1.TaskService/CreateTask", nil},
+		{agent, "/task.v1.TaskService/CreateTask", status.Error(codes.ResourceExhausted, "MCP token rate limit exceeded")},
+		{context.Background(), "/auth.v1.AuthService/GetAuthorizationURL", nil},
+	} {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, call.err }
+		_, _ = interceptor(call.ctx, nil, &grpc.UnaryServerInfo{FullMethod: call.method}, handler)
+	}
+	if err := service.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	resp, err := NewUsageServer(service).GetMyUsage(app, &usagev1.GetMyUsageRequest{})
+	if err != nil {
+		t.Fatalf("GetMyUsage: %v", err)
+	}
+	if len(resp.Records) != 2 {
+		t.Fatalf("records = %v, want one read by the app and one write by the agent", resp.Records)
+	}
+	read, write := resp.Records[0], resp.Records[1]
+	if read.MethodClass != usagev1.MethodClass_METHOD_CLASS_READ || read.AuthType != usagev1.AuthType_AUTH_TYPE_APP || read.Requests != 1 {
+		t.Errorf("first record = %v, want 1 read by the app", read)
+	}
+	if write.MethodClass != usagev1.MethodClass_METHOD_CLASS_WRITE || write.AuthType != usagev1.AuthType_AUTH_TYPE_AGENT ||
+		write.Requests != 2 || write.RateLimited != 1 {
+		t.Errorf("second record = %v, want 2 writes by the agent, 1 rate limited", write)
+	}
+	if today := time.Now().UTC().Format("2006-01-02"); read.Date != today {
+		t.Errorf("date = %s, want %s", read.Date, today)
+	}
+}
+
+func TestGetMyUsage_ValidatesRange(t *testing.T) {
+	server := NewUsageServer(application.NewService(memory.NewUsageRepository(memory.NewStore()), slog.New(slog.NewTextHandler(io.Discard, nil))))
+	ctx := auth.WithUserID(context.Background(), "owner")
+	date := func(s string) *string { return &s }
+
+	for _, req := range []*usagev1.GetMyUsageRequest{
+		{FromDate: date("2026-10-17"), ToDate: date("2026-10-16")},
+		{FromDate: date("2026-07-18"), ToDate: date("2026-10-16")},
+		{ToDate: date("16.10.2026")},
+	} {
+		if _, err := server.GetMyUsage(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetMyUsage(%v) = %v, want InvalidArgument", req, err)
+		}
+	}
+	if _, err := server.GetMyUsage(ctx, &usagev1.GetMyUsageRequest{FromDate: date("2026-07-19"), ToDate: date("2026-10-16")}); err != nil {
+		t.Errorf("GetMyUsage over 90 days: %v", err)
+	}
+}
@@ -0,0 +1,22 @@
+-- name: AddAPIUsage :exec
+INSERT INTO api_usage_days (user_id, day, method_class, auth_type, requests, rate_limited)
+SELECT
+    unnest(sqlc.arg(user_ids)::text[]),
+    unnest(sqlc.arg(days)::date[]),
+    unnest(sqlc.arg(method_classes)::text[]),
+    unnest(sqlc.arg(auth_types)::text[]),
+    unnest(sqlc.arg(requests)::bigint[]),
+    unnest(sqlc.arg(rate_limited)::bigint[])
+ON CONFLICT (user_id, day, method_class, auth_type) DO UPDATE
+SET requests = api_usage_days.requests + EXCLUDED.requests,
+    rate_limited = api_usage_days.rate_limited + EXCLUDED.rate_limited;
+
+-- name: ListAPIUsage :many
+SELECT user_id, day, method_class, auth_type, requests, rate_limited
+FROM api_usage_days
+WHERE user_id = $1 AND day BETWEEN sqlc.arg(from_day)::date AND sqlc.arg(to_day)::date
+ORDER BY day, method_class, auth_type;
+
+-- name: PurgeAPIUsage :execrows
+DELETE FROM api_usage_days
+WHERE day < sqlc.arg(before)::date;
@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/slips-ai/slips-core/internal/usage/domain"
+)
+
+// UsageRepository implements domain.Repository using PostgreSQL
+type UsageRepository struct {
+	queries *Queries
+}
+
+// NewUsageRepository creates a new usage repository
+func NewUsageRepository(pool DBTX) *UsageRepository {
+	return &UsageRepository{
+		queries: New(pool),
+	}
+}
+
+// AddUsage adds counts to the stored counts of their keys in one statement
+func (r *UsageRepository) AddUsage(ctx context.Context, counts map[domain.Key]domain.Counts) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	arg := AddAPIUsageParams{
+		UserIds:       make([]string, 0, len(counts)),
+		Days:          make([]pgtype.Date, 0, len(counts)),
+		MethodClasses: make([]string, 0, len(counts)),
+		AuthTypes:     make([]string, 0, len(counts)),
+		Requests:      make([]int64, 0, len(counts)),
+		RateLimited:   make([]int64, 0, len(counts)),
+	}
+	for key, count := range counts {
+		arg.UserIds = append(arg.UserIds, key.UserID)
+		arg.Days = append(arg.Days, pgtype.Date{Time: key.Day, Valid: true})
+		arg.MethodClasses = append(arg.MethodClasses, key.MethodClass)
+		arg.AuthTypes = append(arg.AuthTypes, key.AuthType)
+		arg.Requests = append(arg.Requests, count.Requests)
+		arg.RateLimited = append(arg.RateLimited, count.RateLimited)
+	}
+	return r.queries.AddAPIUsage(ctx, arg)
+}
+
+// ListUsage returns the usage of a user from one UTC day to another
+func (r *UsageRepository) ListUsage(ctx context.Context, userID string, from, to time.Time) ([]*domain.Day, error) {
+	results, err := r.queries.ListAPIUsage(ctx, ListAPIUsageParams{
+		UserID:  userID,
+		FromDay: pgtype.Date{Time: from, Valid: true},
+		ToDay:   pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]*domain.Day, 0, len(results))
+	for _, result := range results {
+		days = append(days, &domain.Day{
+			Day:         result.Day.Time,
+			MethodClass: result.MethodClass,
+			AuthType:    result.AuthType,
+			Counts: domain.Counts{
+				Requests:    result.Requests,
+				RateLimited: result.RateLimited,
+			},
+		})
+	}
+	return days, nil
+}
+
+// PurgeUsage deletes the usage of days before a UTC day
+func (r *UsageRepository) PurgeUsage(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.PurgeAPIUsage(ctx, pgtype.Date{Time: before, Valid: true})
+}
@@ -0,0 +1,74 @@
+package application
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxTrackedWebhooks is the number of buckets kept before idle ones are
+// dropped
+const maxTrackedWebhooks = 10000
+
+// rateLimiter is a token bucket per webhook. Buckets live in process
+// memory, so each server instance enforces the limit separately.
+type rateLimiter struct {
+	mu      sync.Mutex
+	rate    float64 // tokens per second
+	burst   float64
+	buckets map[uuid.UUID]*bucket
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter allows perMinute events per minute on average and up to
+// burst at once
+func newRateLimiter(perMinute, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:    float64(perMinute) / 60,
+		burst:   float64(burst),
+		buckets: make(map[uuid.UUID]*bucket),
+	}
+}
+
+// allow takes a token from the webhook's bucket if one is available
+func (l *rateLimiter) allow(id uuid.UUID, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[id]
+	if !ok {
+		if len(l.buckets) >= maxTrackedWebhooks {
+			l.dropFull(now)
+		}
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[id] = b
+	}
+
+	b.tokens = min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// retryAfter is how long an exhausted bucket takes to regain a token
+func (l *rateLimiter) retryAfter() time.Duration {
+	return time.Duration(float64(time.Second) / l.rate)
+}
+
+// dropFull forgets buckets that have refilled completely, since a new
+// bucket starts full anyway. Callers must hold l.mu.
+func (l *rateLimiter) dropFull(now time.Time) {
+	for id, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, id)
+		}
+	}
+}
@@ -0,0 +1,473 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/internal/webhook/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"github.com/slips-ai/slips-core/pkg/queue"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("webhook-service")
+
+// MaxDeliveryIDLength bounds the delivery ID senders may attach to make
+// retries idempotent
+const MaxDeliveryIDLength = 200
+
+// maxRenderedTags bounds the tags a delivery can add, since a template can
+// expand a payload array to many tags
+const maxRenderedTags = 20
+
+// DeliveryJobKind is the queue job kind of deliveries queued by
+// QueueDelivery
+const DeliveryJobKind = "webhook.deliver"
+
+// Enqueuer queues jobs; *queue.Queue implements it
+type Enqueuer interface {
+	Enqueue(ctx context.Context, kind string, payload []byte) (uuid.UUID, error)
+}
+
+// queuedDelivery is the payload of a DeliveryJobKind job
+type queuedDelivery struct {
+	WebhookID  uuid.UUID            `json:"webhook_id"`
+	DeliveryID string               `json:"delivery_id"`
+	Task       *domain.RenderedTask `json:"task"`
+}
+
+// TaskCreator creates the tasks of deliveries; the task service implements it
+type TaskCreator interface {
+	CreateTask(ctx context.Context, title, notes string, tagNames []string, startDate, deadline *time.Time, taskContext string, checklistItems []string, clientRequestID string) (*taskdomain.Task, error)
+}
+
+// Service provides webhook business logic
+type Service struct {
+	repo    domain.Repository
+	tasks   TaskCreator
+	queue   Enqueuer
+	limiter *rateLimiter
+	logger  *slog.Logger
+}
+
+// NewService creates a new webhook service. Each webhook accepts perMinute
+// deliveries per minute on average and up to burst at once. queue may be
+// nil when deliveries are not queued.
+func NewService(repo domain.Repository, tasks TaskCreator, queue Enqueuer, perMinute, burst int, logger *slog.Logger) *Service {
+	return &Service{
+		repo:    repo,
+		tasks:   tasks,
+		queue:   queue,
+		limiter: newRateLimiter(perMinute, burst),
+		logger:  logger,
+	}
+}
+
+// CreateWebhook creates a webhook with a new secret
+func (s *Service) CreateWebhook(ctx context.Context, name string, template domain.Template) (*domain.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "CreateWebhook", trace.WithAttributes(
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+	existing, err := s.repo.List(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list webhooks", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if len(existing) >= domain.MaxWebhooksPerUser {
+		return nil, domain.ErrTooManyWebhooks
+	}
+
+	webhook, err := domain.NewWebhook(name, userID, template)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to generate webhook secret", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, webhook); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create webhook", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "webhook created", "id", webhook.ID, "owner_id", userID)
+	return webhook, nil
+}
+
+// GetWebhook retrieves a webhook by ID
+func (s *Service) GetWebhook(ctx context.Context, id uuid.UUID) (*domain.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "GetWebhook", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	webhook, err := s.repo.Get(ctx, id, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get webhook", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// UpdateWebhook replaces the name and template of a webhook
+func (s *Service) UpdateWebhook(ctx context.Context, id uuid.UUID, name string, template domain.Template) (*domain.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "UpdateWebhook", trace.WithAttributes(
+		attribute.String("id", id.String()),
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+	webhook, err := s.repo.Get(ctx, id, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get webhook for update", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	webhook.Update(name, template)
+	if err := s.repo.Update(ctx, webhook); err != nil {
+		s.logger.ErrorContext(ctx, "failed to update webhook", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "webhook updated", "id", webhook.ID)
+	return webhook, nil
+}
+
+// RotateWebhookSecret replaces the secret of a webhook and returns the
+// webhook with the new secret
+func (s *Service) RotateWebhookSecret(ctx context.Context, id uuid.UUID) (*domain.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "RotateWebhookSecret", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	webhook, err := s.repo.Get(ctx, id, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get webhook for secret rotation", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := webhook.RotateSecret(); err != nil {
+		s.logger.ErrorContext(ctx, "failed to generate webhook secret", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, webhook); err != nil {
+		s.logger.ErrorContext(ctx, "failed to store rotated webhook secret", "id", id, "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "webhook secret rotated", "id", webhook.ID)
+	return webhook, nil
+}
+
+// DeleteWebhook deletes a webhook; its URL stops accepting deliveries
+func (s *Service) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "DeleteWebhook", trace.WithAttributes(
+		attribute.String("id", id.String()),
+	))
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, userID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete webhook", "id", id, "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "webhook deleted", "id", id)
+	return nil
+}
+
+// ListWebhooks lists the caller's webhooks
+func (s *Service) ListWebhooks(ctx context.Context) ([]*domain.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "ListWebhooks")
+	defer span.End()
+
+	// Extract user ID from context
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get user ID from context", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	webhooks, err := s.repo.List(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list webhooks", "error", err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// RetryAfter is how long a rate limited sender should wait before retrying
+func (s *Service) RetryAfter() time.Duration {
+	return s.limiter.retryAfter()
+}
+
+// Delivery is a request received on a webhook URL
+type Delivery struct {
+	WebhookID uuid.UUID
+	Body      []byte
+	// Signature is the "sha256=<hex>" HMAC of Body; Bearer is the secret
+	// itself, for senders that cannot sign
+	Signature string
+	Bearer    string
+	// ID optionally identifies the delivery; a retried delivery with the
+	// same ID returns the task created the first time
+	ID string
+}
+
+// Deliver authenticates a delivery and creates a task for the webhook's
+// owner from its JSON body. Unknown webhooks fail with
+// domain.ErrWebhookNotFound.
+func (s *Service) Deliver(ctx context.Context, delivery Delivery) (*taskdomain.Task, error) {
+	ctx, span := tracer.Start(ctx, "Deliver", trace.WithAttributes(
+		attribute.String("webhook_id", delivery.WebhookID.String()),
+	))
+	defer span.End()
+
+	webhook, rendered, err := s.accept(ctx, delivery)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	task, err := s.createTask(ctx, webhook, rendered, delivery.ID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return task, nil
+}
+
+// QueueDelivery authenticates and validates a delivery like Deliver, then
+// queues the task creation and returns the job ID. The task is created by a
+// queue worker through RunQueuedDelivery, at least once; deliveries without
+// an ID get one so retries of the job do not create duplicates.
+func (s *Service) QueueDelivery(ctx context.Context, delivery Delivery) (uuid.UUID, error) {
+	ctx, span := tracer.Start(ctx, "QueueDelivery", trace.WithAttributes(
+		attribute.String("webhook_id", delivery.WebhookID.String()),
+	))
+	defer span.End()
+
+	if s.queue == nil {
+		return uuid.Nil, errors.New("webhook deliveries are not queued")
+	}
+	webhook, rendered, err := s.accept(ctx, delivery)
+	if err != nil {
+		span.RecordError(err)
+		return uuid.Nil, err
+	}
+
+	deliveryID := delivery.ID
+	if deliveryID == "" {
+		deliveryID = "queued:" + uuid.NewString()
+	}
+	payload, err := json.Marshal(queuedDelivery{WebhookID: webhook.ID, DeliveryID: deliveryID, Task: rendered})
+	if err != nil {
+		span.RecordError(err)
+		return uuid.Nil, err
+	}
+	jobID, err := s.queue.Enqueue(ctx, DeliveryJobKind, payload)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to queue webhook delivery", "webhook_id", webhook.ID, "error", err)
+		span.RecordError(err)
+		return uuid.Nil, err
+	}
+
+	s.logger.InfoContext(ctx, "webhook delivery queued", "webhook_id", webhook.ID, "job_id", jobID, "owner_id", webhook.OwnerID)
+	return jobID, nil
+}
+
+// RunQueuedDelivery is the queue handler of DeliveryJobKind jobs. Deliveries
+// to webhooks deleted in the meantime fail permanently.
+func (s *Service) RunQueuedDelivery(ctx context.Context, job *queue.Job) error {
+	ctx, span := tracer.Start(ctx, "RunQueuedDelivery", trace.WithAttributes(
+		attribute.String("job_id", job.ID.String()),
+	))
+	defer span.End()
+
+	var queued queuedDelivery
+	if err := json.Unmarshal(job.Payload, &queued); err != nil {
+		span.RecordError(err)
+		return queue.Permanent(fmt.Errorf("decode queued delivery: %w", err))
+	}
+	webhook, err := s.repo.GetForDelivery(ctx, queued.WebhookID)
+	if errors.Is(err, domain.ErrWebhookNotFound) {
+		s.logger.WarnContext(ctx, "queued delivery to deleted webhook", "webhook_id", queued.WebhookID, "job_id", job.ID)
+		return queue.Permanent(err)
+	}
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get webhook of queued delivery", "webhook_id", queued.WebhookID, "error", err)
+		span.RecordError(err)
+		return err
+	}
+
+	if _, err := s.createTask(ctx, webhook, queued.Task, queued.DeliveryID); err != nil {
+		span.RecordError(err)
+		return err
+	}
+	return nil
+}
+
+// accept authenticates a delivery, applies the webhook's rate limit and
+// renders the task it creates
+func (s *Service) accept(ctx context.Context, delivery Delivery) (*domain.Webhook, *domain.RenderedTask, error) {
+	webhook, err := s.repo.GetForDelivery(ctx, delivery.WebhookID)
+	if err != nil {
+		s.logger.WarnContext(ctx, "delivery to unknown webhook", "webhook_id", delivery.WebhookID, "error", err)
+		return nil, nil, err
+	}
+	if err := webhook.Authenticate(delivery.Body, delivery.Signature, delivery.Bearer); err != nil {
+		s.logger.WarnContext(ctx, "rejected unauthenticated webhook delivery", "webhook_id", webhook.ID)
+		return nil, nil, err
+	}
+	if !s.limiter.allow(webhook.ID, time.Now()) {
+		s.logger.WarnContext(ctx, "webhook delivery rate limited", "webhook_id", webhook.ID, "owner_id", webhook.OwnerID)
+		return nil, nil, domain.ErrRateLimited
+	}
+
+	if utf8.RuneCountInString(delivery.ID) > MaxDeliveryIDLength {
+		return nil, nil, fmt.Errorf("%w: delivery ID exceeds %d characters", domain.ErrInvalidPayload, MaxDeliveryIDLength)
+	}
+	payload, err := decodePayload(delivery.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	rendered, err := webhook.Template.Render(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := validateRendered(rendered); err != nil {
+		return nil, nil, err
+	}
+	return webhook, rendered, nil
+}
+
+// createTask creates the rendered task as the webhook's owner
+func (s *Service) createTask(ctx context.Context, webhook *domain.Webhook, rendered *domain.RenderedTask, deliveryID string) (*taskdomain.Task, error) {
+	// Create the task as the owner, attributed to the webhook
+	ctx = auth.WithPrincipal(ctx, &auth.Principal{
+		UserID:     webhook.OwnerID,
+		Credential: auth.CredentialWebhook,
+		ClientID:   "webhook:" + webhook.ID.String(),
+	})
+	var clientRequestID string
+	if deliveryID != "" {
+		clientRequestID = "webhook:" + webhook.ID.String() + ":" + deliveryID
+	}
+	task, err := s.tasks.CreateTask(ctx, rendered.Title, rendered.Notes, rendered.TagNames, rendered.StartDate, rendered.Deadline, "", nil, clientRequestID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create task from webhook delivery", "webhook_id", webhook.ID, "error", err)
+		return nil, err
+	}
+
+	if err := s.repo.MarkDelivered(ctx, webhook.ID, time.Now()); err != nil {
+		// The task exists; a stale last delivery time is not worth failing for
+		s.logger.WarnContext(ctx, "failed to record webhook delivery", "webhook_id", webhook.ID, "error", err)
+	}
+
+	s.logger.InfoContext(ctx, "task created from webhook", "webhook_id", webhook.ID, "task_id", task.ID, "owner_id", webhook.OwnerID)
+	return task, nil
+}
+
+// decodePayload parses a delivery body holding a single JSON value. Numbers
+// keep their original text so templates render them unchanged.
+func decodePayload(body []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var payload any
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, fmt.Errorf("%w: body is not JSON: %v", domain.ErrInvalidPayload, err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("%w: body holds more than one JSON value", domain.ErrInvalidPayload)
+	}
+	return payload, nil
+}
+
+// validateRendered applies the limits CreateTask requests are held to
+func validateRendered(task *domain.RenderedTask) error {
+	if len(task.Title) > grpcerrors.MaxTitleLength {
+		return fmt.Errorf("%w: title exceeds %d characters", domain.ErrInvalidPayload, grpcerrors.MaxTitleLength)
+	}
+	if len(task.Notes) > grpcerrors.MaxNotesLength {
+		return fmt.Errorf("%w: notes exceed %d characters", domain.ErrInvalidPayload, grpcerrors.MaxNotesLength)
+	}
+	if len(task.TagNames) > maxRenderedTags {
+		return fmt.Errorf("%w: more than %d tags", domain.ErrInvalidPayload, maxRenderedTags)
+	}
+	for _, name := range task.TagNames {
+		if err := grpcerrors.ValidateTagName(name); err != nil {
+			return fmt.Errorf("%w: tag %q is invalid", domain.ErrInvalidPayload, name)
+		}
+	}
+	return nil
+}
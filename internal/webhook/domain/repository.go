@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for webhook persistence
+type Repository interface {
+	Create(ctx context.Context, webhook *Webhook) error
+	// Get, GetForDelivery and Update return ErrWebhookNotFound for webhooks
+	// that do not exist or, except for GetForDelivery, belong to another
+	// owner
+	Get(ctx context.Context, id uuid.UUID, ownerID string) (*Webhook, error)
+	// GetForDelivery retrieves a webhook by ID alone, for deliveries that
+	// are authenticated with its secret rather than as its owner
+	GetForDelivery(ctx context.Context, id uuid.UUID) (*Webhook, error)
+	// Update stores the name, template and secret of a webhook
+	Update(ctx context.Context, webhook *Webhook) error
+	Delete(ctx context.Context, id uuid.UUID, ownerID string) error
+	// List returns the owner's webhooks ordered by name
+	List(ctx context.Context, ownerID string) ([]*Webhook, error)
+	MarkDelivered(ctx context.Context, id uuid.UUID, at time.Time) error
+}
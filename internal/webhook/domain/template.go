@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxTemplateLength bounds each template string
+const MaxTemplateLength = 2000
+
+// MaxTagTemplates bounds the tag templates of a webhook
+const MaxTagTemplates = 10
+
+// dateLayout is the task date format; payload dates may also be RFC 3339
+const dateLayout = "2006-01-02"
+
+var (
+	// ErrInvalidTemplate is returned for templates that cannot be stored
+	ErrInvalidTemplate = errors.New("invalid webhook template")
+	// ErrInvalidPayload is returned for deliveries whose body is not JSON or
+	// does not render to a valid task
+	ErrInvalidPayload = errors.New("invalid webhook payload")
+)
+
+// Template maps a delivery payload to the fields of the task it creates.
+// Each field is text with {{path}} placeholders, where path names a value
+// of the JSON payload by dot-separated object keys and array indexes, e.g.
+// "{{issue.title}}" or "{{labels.0}}". Missing values render as empty
+// text, strings as-is and other values as JSON.
+type Template struct {
+	Title string `json:"title"`
+	Notes string `json:"notes,omitempty"`
+	// TagNames each render to one tag name. A template that is a single
+	// placeholder naming an array adds a tag for each element.
+	TagNames []string `json:"tag_names,omitempty"`
+	// StartDate and Deadline must render to "YYYY-MM-DD", an RFC 3339
+	// timestamp or nothing
+	StartDate string `json:"start_date,omitempty"`
+	Deadline  string `json:"deadline,omitempty"`
+}
+
+// DefaultTemplate reads the title and notes from top-level "title" and
+// "notes" keys, for webhooks created without a template
+func DefaultTemplate() Template {
+	return Template{
+		Title: "{{title}}",
+		Notes: "{{notes}}",
+	}
+}
+
+// Validate checks that every template parses and a title is mapped
+func (t Template) Validate() error {
+	if strings.TrimSpace(t.Title) == "" {
+		return fmt.Errorf("%w: title template is required", ErrInvalidTemplate)
+	}
+	if len(t.TagNames) > MaxTagTemplates {
+		return fmt.Errorf("%w: at most %d tag templates are allowed", ErrInvalidTemplate, MaxTagTemplates)
+	}
+	fields := map[string]string{
+		"title":      t.Title,
+		"notes":      t.Notes,
+		"start_date": t.StartDate,
+		"deadline":   t.Deadline,
+	}
+	for i, tag := range t.TagNames {
+		fields[fmt.Sprintf("tag_names[%d]", i)] = tag
+	}
+	for field, text := range fields {
+		if len(text) > MaxTemplateLength {
+			return fmt.Errorf("%w: %s exceeds %d characters", ErrInvalidTemplate, field, MaxTemplateLength)
+		}
+		if _, err := parseTemplate(text); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrInvalidTemplate, field, err)
+		}
+	}
+	return nil
+}
+
+// RenderedTask holds the task fields produced from a payload
+type RenderedTask struct {
+	Title     string
+	Notes     string
+	TagNames  []string
+	StartDate *time.Time
+	Deadline  *time.Time
+}
+
+// Render maps a decoded JSON payload to task fields. It fails with
+// ErrInvalidPayload when the title is empty or a date does not parse.
+func (t Template) Render(payload any) (*RenderedTask, error) {
+	var task RenderedTask
+	var err error
+	if task.Title, err = renderText(t.Title, payload); err != nil {
+		return nil, err
+	}
+	task.Title = strings.TrimSpace(task.Title)
+	if task.Title == "" {
+		return nil, fmt.Errorf("%w: title rendered empty", ErrInvalidPayload)
+	}
+	if task.Notes, err = renderText(t.Notes, payload); err != nil {
+		return nil, err
+	}
+	for _, tag := range t.TagNames {
+		names, err := renderList(tag, payload)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if name = strings.TrimSpace(name); name != "" {
+				task.TagNames = append(task.TagNames, name)
+			}
+		}
+	}
+	if task.StartDate, err = renderDate(t.StartDate, payload, "start_date"); err != nil {
+		return nil, err
+	}
+	if task.Deadline, err = renderDate(t.Deadline, payload, "deadline"); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// segment is literal text or, when path is set, a placeholder
+type segment struct {
+	text string
+	path []string
+}
+
+// parseTemplate splits text into literal and placeholder segments
+func parseTemplate(text string) ([]segment, error) {
+	var segments []segment
+	for text != "" {
+		start := strings.Index(text, "{{")
+		if start < 0 {
+			segments = append(segments, segment{text: text})
+			break
+		}
+		if start > 0 {
+			segments = append(segments, segment{text: text[:start]})
+		}
+		end := strings.Index(text[start:], "}}")
+		if end < 0 {
+			return nil, errors.New("unclosed {{")
+		}
+		path := strings.TrimSpace(text[start+2 : start+end])
+		if path == "" {
+			return nil, errors.New("empty placeholder")
+		}
+		segments = append(segments, segment{path: strings.Split(path, ".")})
+		text = text[start+end+2:]
+	}
+	return segments, nil
+}
+
+// renderText substitutes the placeholders of text with payload values
+func renderText(text string, payload any) (string, error) {
+	segments, err := parseTemplate(text)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	var b strings.Builder
+	for _, seg := range segments {
+		if seg.path == nil {
+			b.WriteString(seg.text)
+			continue
+		}
+		b.WriteString(formatValue(lookup(payload, seg.path)))
+	}
+	return b.String(), nil
+}
+
+// renderList renders text, expanding a lone placeholder that names an array
+// to one value per element
+func renderList(text string, payload any) ([]string, error) {
+	segments, err := parseTemplate(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	if len(segments) == 1 && segments[0].path != nil {
+		if elements, ok := lookup(payload, segments[0].path).([]any); ok {
+			values := make([]string, len(elements))
+			for i, element := range elements {
+				values[i] = formatValue(element)
+			}
+			return values, nil
+		}
+	}
+	value, err := renderText(text, payload)
+	if err != nil {
+		return nil, err
+	}
+	return []string{value}, nil
+}
+
+// renderDate renders text and parses it as a date; empty text means no date
+func renderDate(text string, payload any, field string) (*time.Time, error) {
+	value, err := renderText(text, payload)
+	if err != nil {
+		return nil, err
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	if date, err := time.Parse(dateLayout, value); err == nil {
+		return &date, nil
+	}
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		date := time.Date(ts.Year(), ts.Month(), ts.Day(), 0, 0, 0, 0, time.UTC)
+		return &date, nil
+	}
+	return nil, fmt.Errorf("%w: %s %q is not a YYYY-MM-DD date or RFC 3339 timestamp", ErrInvalidPayload, field, value)
+}
+
+// lookup follows path through objects and arrays, returning nil when a step
+// is missing
+func lookup(value any, path []string) any {
+	for _, key := range path {
+		switch v := value.(type) {
+		case map[string]any:
+			value = v[key]
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil
+			}
+			value = v[i]
+		default:
+			return nil
+		}
+	}
+	return value
+}
+
+// formatValue renders strings as-is, nothing for null and JSON otherwise
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(encoded)
+	}
+}
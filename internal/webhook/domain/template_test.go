@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func decode(t *testing.T, payload string) any {
+	t.Helper()
+	decoder := json.NewDecoder(strings.NewReader(payload))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	return v
+}
+
+func TestRender_MapsNestedFields(t *testing.T) {
+	template := Template{
+		Title:     "PR #{{pull_request.number}}: {{pull_request.title}}",
+		Notes:     "{{pull_request.url}}{{missing.key}}",
+		TagNames:  []string{"github", "{{labels}}", "{{labels.9}}"},
+		StartDate: "{{opened_at}}",
+		Deadline:  "{{due}}",
+	}
+	payload := decode(t, `{
+		"pull_request": {"number": 42, "title": "Fix login", "url": "https://example.com/42"},
+		"labels": ["bug", "urgent"],
+		"opened_at": "2026-03-01T18:30:00+02:00",
+		"due": "2026-03-05"
+	}`)
+
+	task, err := template.Render(payload)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if task.Title != "PR #42: Fix login" {
+		t.Errorf("Title = %q", task.Title)
+	}
+	if task.Notes != "https://example.com/42" {
+		t.Errorf("Notes = %q, want missing values to render empty", task.Notes)
+	}
+	if want := []string{"github", "bug", "urgent"}; !slices.Equal(task.TagNames, want) {
+		t.Errorf("TagNames = %v, want %v", task.TagNames, want)
+	}
+	if want := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC); task.StartDate == nil || !task.StartDate.Equal(want) {
+		t.Errorf("StartDate = %v, want %v", task.StartDate, want)
+	}
+	if want := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC); task.Deadline == nil || !task.Deadline.Equal(want) {
+		t.Errorf("Deadline = %v, want %v", task.Deadline, want)
+	}
+}
+
+func TestRender_RejectsEmptyTitleAndBadDates(t *testing.T) {
+	payload := decode(t, `{"title": "  ", "due": "next week"}`)
+
+	if _, err := DefaultTemplate().Render(payload); !errors.Is(err, ErrInvalidPayload) {
+		t.Errorf("empty title: err = %v, want ErrInvalidPayload", err)
+	}
+	if _, err := (Template{Title: "t", Deadline: "{{due}}"}).Render(payload); !errors.Is(err, ErrInvalidPayload) {
+		t.Errorf("bad deadline: err = %v, want ErrInvalidPayload", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template Template
+		wantErr  bool
+	}{
+		{"default", DefaultTemplate(), false},
+		{"literal title", Template{Title: "From Shortcuts"}, false},
+		{"missing title", Template{Notes: "{{notes}}"}, true},
+		{"unclosed placeholder", Template{Title: "{{title"}, true},
+		{"empty placeholder", Template{Title: "{{ }}"}, true},
+		{"bad tag template", Template{Title: "t", TagNames: []string{"{{x"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.template.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidTemplate) {
+				t.Errorf("Validate() error = %v, want ErrInvalidTemplate", err)
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	webhook, err := NewWebhook("zap", "owner", DefaultTemplate())
+	if err != nil {
+		t.Fatalf("NewWebhook() error = %v", err)
+	}
+	body := []byte(`{"title":"x"}`)
+
+	if err := webhook.Authenticate(body, webhook.Sign(body), ""); err != nil {
+		t.Errorf("valid signature: %v", err)
+	}
+	if err := webhook.Authenticate(body, "", webhook.Secret); err != nil {
+		t.Errorf("secret as bearer: %v", err)
+	}
+	if err := webhook.Authenticate([]byte(`{"title":"y"}`), webhook.Sign(body), ""); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("signature of another body: err = %v, want ErrInvalidSignature", err)
+	}
+	if err := webhook.Authenticate(body, "", ""); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("unsigned: err = %v, want ErrInvalidSignature", err)
+	}
+
+	oldSignature := webhook.Sign(body)
+	if err := webhook.RotateSecret(); err != nil {
+		t.Fatalf("RotateSecret() error = %v", err)
+	}
+	if err := webhook.Authenticate(body, oldSignature, ""); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("old secret after rotation: err = %v, want ErrInvalidSignature", err)
+	}
+}
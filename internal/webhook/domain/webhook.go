@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+// MaxWebhooksPerUser bounds how many webhooks a user can create
+const MaxWebhooksPerUser = 25
+
+// secretPrefix marks webhook secrets so they are recognisable when leaked
+const secretPrefix = "whsec_"
+
+// signaturePrefix precedes the hex HMAC in a delivery signature
+const signaturePrefix = "sha256="
+
+var (
+	// ErrInvalidSignature is returned for deliveries not signed with the
+	// webhook's secret
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrRateLimited is returned for deliveries over the webhook's rate limit
+	ErrRateLimited = errors.New("webhook rate limit exceeded")
+	// ErrTooManyWebhooks is returned when a user already has
+	// MaxWebhooksPerUser webhooks
+	ErrTooManyWebhooks = domainerrors.New(domainerrors.ErrQuotaExceeded, fmt.Sprintf("at most %d webhooks are allowed", MaxWebhooksPerUser))
+	// ErrWebhookNotFound is returned for webhooks that do not exist or
+	// belong to another user
+	ErrWebhookNotFound = domainerrors.New(domainerrors.ErrNotFound, "webhook not found")
+)
+
+// Webhook is an inbound URL that creates a task for its owner from every
+// signed JSON payload it receives
+type Webhook struct {
+	ID      uuid.UUID
+	OwnerID string
+	Name    string
+	// Secret signs deliveries. It is only shown to the owner when the
+	// webhook is created and when it is rotated.
+	Secret          string
+	Template        Template
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	LastDeliveredAt *time.Time
+}
+
+// NewWebhook creates a webhook with a new secret
+// Note: CreatedAt and UpdatedAt timestamps are not set here.
+// They will be populated by the database on insertion (DEFAULT NOW()).
+func NewWebhook(name, ownerID string, template Template) (*Webhook, error) {
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, err
+	}
+	return &Webhook{
+		ID:       uuid.New(),
+		OwnerID:  ownerID,
+		Name:     name,
+		Secret:   secret,
+		Template: template,
+	}, nil
+}
+
+// Update replaces the name and template of the webhook
+func (w *Webhook) Update(name string, template Template) {
+	w.Name = name
+	w.Template = template
+}
+
+// RotateSecret replaces the secret; deliveries signed with the old one are
+// rejected from then on
+func (w *Webhook) RotateSecret() error {
+	secret, err := generateSecret()
+	if err != nil {
+		return err
+	}
+	w.Secret = secret
+	return nil
+}
+
+// Sign returns the signature of body under the webhook's secret, in the
+// "sha256=<hex>" form senders put in the signature header
+func (w *Webhook) Sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(w.Secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Authenticate checks a delivery. signature is the "sha256=<hex>" HMAC of
+// body; senders that cannot compute one may instead present the secret
+// itself as bearer. Comparisons run in constant time.
+func (w *Webhook) Authenticate(body []byte, signature, bearer string) error {
+	switch {
+	case signature != "":
+		if hmac.Equal([]byte(strings.ToLower(signature)), []byte(w.Sign(body))) {
+			return nil
+		}
+	case bearer != "":
+		if subtle.ConstantTimeCompare([]byte(bearer), []byte(w.Secret)) == 1 {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// generateSecret returns a random secret with 256 bits of entropy
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
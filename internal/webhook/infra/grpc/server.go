@@ -0,0 +1,193 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	webhookv1 "github.com/slips-ai/slips-core/gen/go/webhook/v1"
+	"github.com/slips-ai/slips-core/internal/webhook/application"
+	"github.com/slips-ai/slips-core/internal/webhook/domain"
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WebhookServer implements the WebhookService gRPC server
+type WebhookServer struct {
+	webhookv1.UnimplementedWebhookServiceServer
+	service *application.Service
+	baseURL string
+}
+
+// NewWebhookServer creates a new webhook gRPC server. baseURL is the public
+// URL deliveries are served on; webhook URLs are empty without it.
+func NewWebhookServer(service *application.Service, baseURL string) *WebhookServer {
+	return &WebhookServer{
+		service: service,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// CreateWebhook creates a new webhook
+func (s *WebhookServer) CreateWebhook(ctx context.Context, req *webhookv1.CreateWebhookRequest) (*webhookv1.CreateWebhookResponse, error) {
+	if err := validateName(req.Name); err != nil {
+		return nil, err
+	}
+
+	template := domain.DefaultTemplate()
+	if req.Template != nil {
+		template = templateFromProto(req.Template)
+	}
+
+	webhook, err := s.service.CreateWebhook(ctx, req.Name, template)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to create webhook")
+	}
+
+	return &webhookv1.CreateWebhookResponse{
+		Webhook: s.webhookToProto(webhook),
+		Secret:  webhook.Secret,
+	}, nil
+}
+
+// GetWebhook retrieves a webhook by ID
+func (s *WebhookServer) GetWebhook(ctx context.Context, req *webhookv1.GetWebhookRequest) (*webhookv1.GetWebhookResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid webhook ID format")
+	}
+
+	webhook, err := s.service.GetWebhook(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to get webhook")
+	}
+
+	return &webhookv1.GetWebhookResponse{
+		Webhook: s.webhookToProto(webhook),
+	}, nil
+}
+
+// UpdateWebhook updates a webhook
+func (s *WebhookServer) UpdateWebhook(ctx context.Context, req *webhookv1.UpdateWebhookRequest) (*webhookv1.UpdateWebhookResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid webhook ID format")
+	}
+
+	if err := validateName(req.Name); err != nil {
+		return nil, err
+	}
+	if req.Template == nil {
+		return nil, status.Error(codes.InvalidArgument, "template is required")
+	}
+
+	webhook, err := s.service.UpdateWebhook(ctx, id, req.Name, templateFromProto(req.Template))
+	if err != nil {
+		return nil, toGRPCError(err, "failed to update webhook")
+	}
+
+	return &webhookv1.UpdateWebhookResponse{
+		Webhook: s.webhookToProto(webhook),
+	}, nil
+}
+
+// RotateWebhookSecret replaces the secret of a webhook
+func (s *WebhookServer) RotateWebhookSecret(ctx context.Context, req *webhookv1.RotateWebhookSecretRequest) (*webhookv1.RotateWebhookSecretResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid webhook ID format")
+	}
+
+	webhook, err := s.service.RotateWebhookSecret(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to rotate webhook secret")
+	}
+
+	return &webhookv1.RotateWebhookSecretResponse{
+		Webhook: s.webhookToProto(webhook),
+		Secret:  webhook.Secret,
+	}, nil
+}
+
+// DeleteWebhook deletes a webhook
+func (s *WebhookServer) DeleteWebhook(ctx context.Context, req *webhookv1.DeleteWebhookRequest) (*webhookv1.DeleteWebhookResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid webhook ID format")
+	}
+
+	if err := s.service.DeleteWebhook(ctx, id); err != nil {
+		return nil, toGRPCError(err, "failed to delete webhook")
+	}
+
+	return &webhookv1.DeleteWebhookResponse{}, nil
+}
+
+// ListWebhooks lists the caller's webhooks
+func (s *WebhookServer) ListWebhooks(ctx context.Context, req *webhookv1.ListWebhooksRequest) (*webhookv1.ListWebhooksResponse, error) {
+	webhooks, err := s.service.ListWebhooks(ctx)
+	if err != nil {
+		return nil, toGRPCError(err, "failed to list webhooks")
+	}
+
+	protoWebhooks := make([]*webhookv1.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		protoWebhooks[i] = s.webhookToProto(webhook)
+	}
+
+	return &webhookv1.ListWebhooksResponse{
+		Webhooks: protoWebhooks,
+	}, nil
+}
+
+// toGRPCError maps template failures to InvalidArgument and defers
+// everything else to grpcerrors.ToGRPCError
+func toGRPCError(err error, defaultMsg string) error {
+	if errors.Is(err, domain.ErrInvalidTemplate) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return grpcerrors.ToGRPCError(err, defaultMsg)
+}
+
+func validateName(name string) error {
+	if err := grpcerrors.ValidateNotEmpty(name, "name"); err != nil {
+		return err
+	}
+	return grpcerrors.ValidateLength(name, "name", grpcerrors.MaxWebhookNameLength)
+}
+
+func templateFromProto(t *webhookv1.WebhookTemplate) domain.Template {
+	return domain.Template{
+		Title:     t.Title,
+		Notes:     t.Notes,
+		TagNames:  t.TagNames,
+		StartDate: t.StartDate,
+		Deadline:  t.Deadline,
+	}
+}
+
+func (s *WebhookServer) webhookToProto(webhook *domain.Webhook) *webhookv1.Webhook {
+	protoWebhook := &webhookv1.Webhook{
+		Id:   webhook.ID.String(),
+		Name: webhook.Name,
+		Template: &webhookv1.WebhookTemplate{
+			Title:     webhook.Template.Title,
+			Notes:     webhook.Template.Notes,
+			TagNames:  webhook.Template.TagNames,
+			StartDate: webhook.Template.StartDate,
+			Deadline:  webhook.Template.Deadline,
+		},
+		CreatedAt: timestamppb.New(webhook.CreatedAt),
+		UpdatedAt: timestamppb.New(webhook.UpdatedAt),
+	}
+	if s.baseURL != "" {
+		protoWebhook.Url = s.baseURL + "/webhooks/" + webhook.ID.String()
+	}
+	if webhook.LastDeliveredAt != nil {
+		protoWebhook.LastDeliveredAt = timestamppb.New(*webhook.LastDeliveredAt)
+	}
+	return protoWebhook
+}
@@ -0,0 +1,134 @@
+// Package http serves webhook deliveries over plain HTTP, for automation
+// tools that cannot call gRPC.
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/webhook/application"
+	"github.com/slips-ai/slips-core/internal/webhook/domain"
+)
+
+const (
+	// SignatureHeader carries the "sha256=<hex>" HMAC of the request body,
+	// keyed with the webhook secret
+	SignatureHeader = "X-Slips-Signature"
+	// DeliveryIDHeader optionally identifies a delivery; retries with the
+	// same ID return the task created by the first attempt
+	DeliveryIDHeader = "X-Slips-Delivery-Id"
+)
+
+// Handler serves POST /webhooks/{id}
+type Handler struct {
+	service     *application.Service
+	maxBodySize int64
+	async       bool
+	logger      *slog.Logger
+}
+
+// NewHandler creates a delivery handler that rejects bodies larger than
+// maxBodySize bytes. With async, valid deliveries are queued and answered
+// with 202 Accepted and the job ID instead of the created task.
+func NewHandler(service *application.Service, maxBodySize int64, async bool, logger *slog.Logger) http.Handler {
+	h := &Handler{
+		service:     service,
+		maxBodySize: maxBodySize,
+		async:       async,
+		logger:      logger,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /webhooks/{id}", h.deliver)
+	return mux
+}
+
+// deliver creates a task from the request body, or queues its creation, and
+// responds with the task or job ID
+func (h *Handler) deliver(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, "webhook not found")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodySize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "body exceeds "+strconv.FormatInt(h.maxBodySize, 10)+" bytes")
+			return
+		}
+		writeError(w, http.StatusBadRequest, "failed to read body")
+		return
+	}
+
+	delivery := application.Delivery{
+		WebhookID: id,
+		Body:      body,
+		Signature: r.Header.Get(SignatureHeader),
+		Bearer:    bearerToken(r),
+		ID:        r.Header.Get(DeliveryIDHeader),
+	}
+
+	if h.async {
+		jobID, err := h.service.QueueDelivery(r.Context(), delivery)
+		if err != nil {
+			h.writeDeliveryError(w, r, id, err)
+			return
+		}
+		writeJSON(w, http.StatusAccepted, map[string]string{"job_id": jobID.String()})
+		return
+	}
+
+	task, err := h.service.Deliver(r.Context(), delivery)
+	if err != nil {
+		h.writeDeliveryError(w, r, id, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]string{"task_id": task.ID.String()})
+}
+
+// writeDeliveryError responds to a failed delivery
+func (h *Handler) writeDeliveryError(w http.ResponseWriter, r *http.Request, id uuid.UUID, err error) {
+	switch {
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		writeError(w, http.StatusNotFound, "webhook not found")
+	case errors.Is(err, domain.ErrInvalidSignature):
+		writeError(w, http.StatusUnauthorized, "missing or invalid signature")
+	case errors.Is(err, domain.ErrRateLimited):
+		retryAfter := int(math.Ceil(h.service.RetryAfter().Seconds()))
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		writeError(w, http.StatusTooManyRequests, "rate limit exceeded")
+	case errors.Is(err, domain.ErrInvalidPayload):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		h.logger.ErrorContext(r.Context(), "webhook delivery failed", "webhook_id", id, "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to create task")
+	}
+}
+
+// bearerToken returns the token of an "Authorization: Bearer" header, or ""
+func bearerToken(r *http.Request) string {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]string{"error": message})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,197 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/internal/memory"
+	taskapp "github.com/slips-ai/slips-core/internal/task/application"
+	taskdomain "github.com/slips-ai/slips-core/internal/task/domain"
+	"github.com/slips-ai/slips-core/internal/webhook/application"
+	"github.com/slips-ai/slips-core/internal/webhook/domain"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"github.com/slips-ai/slips-core/pkg/changefeed"
+	"github.com/slips-ai/slips-core/pkg/queue"
+)
+
+func TestHandler_Deliver(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	store := memory.NewStore()
+	tasks := taskapp.NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), taskdomain.DefaultChecklistLimits, logger)
+	service := application.NewService(memory.NewWebhookRepository(store), tasks, nil, 60, 3, logger)
+	handler := NewHandler(service, 1024, false, logger)
+
+	owner := auth.WithUserID(context.Background(), "owner")
+	webhook, err := service.CreateWebhook(owner, "zap", domain.Template{
+		Title:    "{{issue.title}}",
+		TagNames: []string{"{{issue.labels}}"},
+	})
+	if err != nil {
+		t.Fatalf("create webhook: %v", err)
+	}
+
+	deliver := func(path, body string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	url := "/webhooks/" + webhook.ID.String()
+	body := `{"issue": {"title": "Broken build", "labels": ["ci"]}}`
+	signed := http.Header{SignatureHeader: {webhook.Sign([]byte(body))}}
+
+	rec := deliver(url, body, signed)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("signed delivery: status %d, body %s", rec.Code, rec.Body)
+	}
+	var created struct {
+		TaskID string `json:"task_id"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	task, err := tasks.GetTask(owner, uuid.MustParse(created.TaskID))
+	if err != nil {
+		t.Fatalf("get created task: %v", err)
+	}
+	if task.Title != "Broken build" || len(task.TagIDs) != 1 {
+		t.Errorf("created task = %q with %d tags, want the mapped title and one tag", task.Title, len(task.TagIDs))
+	}
+	if want := "webhook:" + webhook.ID.String(); task.LastModifiedBy.ClientID != want {
+		t.Errorf("LastModifiedBy.ClientID = %q, want %q", task.LastModifiedBy.ClientID, want)
+	}
+
+	// A retry with the same delivery ID returns the same task
+	withID := http.Header{SignatureHeader: signed[SignatureHeader], DeliveryIDHeader: {"evt-1"}}
+	first := deliver(url, body, withID)
+	retry := deliver(url, body, withID)
+	if first.Body.String() != retry.Body.String() {
+		t.Errorf("retried delivery created another task: %s then %s", first.Body, retry.Body)
+	}
+
+	tests := []struct {
+		name   string
+		path   string
+		body   string
+		header http.Header
+		want   int
+	}{
+		{"unknown webhook", "/webhooks/" + uuid.NewString(), body, signed, http.StatusNotFound},
+		{"unsigned", url, body, nil, http.StatusUnauthorized},
+		{"wrong signature", url, `{"issue": {"title": "Other"}}`, signed, http.StatusUnauthorized},
+		{"rate limited", url, body, signed, http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rec := deliver(tt.path, tt.body, tt.header); rec.Code != tt.want {
+				t.Errorf("status %d, want %d (body %s)", rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+}
+
+func TestHandler_RejectsBadPayloads(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	store := memory.NewStore()
+	tasks := taskapp.NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), taskdomain.DefaultChecklistLimits, logger)
+	service := application.NewService(memory.NewWebhookRepository(store), tasks, nil, 600, 100, logger)
+	handler := NewHandler(service, 64, false, logger)
+
+	webhook, err := service.CreateWebhook(auth.WithUserID(context.Background(), "owner"), "shortcut", domain.DefaultTemplate())
+	if err != nil {
+		t.Fatalf("create webhook: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"not JSON", `title=x`, http.StatusBadRequest},
+		{"no title", `{"notes": "n"}`, http.StatusBadRequest},
+		{"too large", `{"title": "` + strings.Repeat("x", 100) + `"}`, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/"+webhook.ID.String(), strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer "+webhook.Secret)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("status %d, want %d (body %s)", rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+}
+
+func TestHandler_DeliverAsync(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	store := memory.NewStore()
+	tasks := taskapp.NewService(memory.NewTaskRepository(store), memory.NewTagRepository(store), memory.NewSavedFilterRepository(store), nil, changefeed.NewHub(), taskdomain.DefaultChecklistLimits, logger)
+	jobs := queue.NewMemoryStore()
+	workQueue := queue.New(jobs, queue.Options{MaxAttempts: 3, Lease: time.Minute}, logger)
+	service := application.NewService(memory.NewWebhookRepository(store), tasks, workQueue, 60, 3, logger)
+	handler := NewHandler(service, 1024, true, logger)
+
+	owner := auth.WithUserID(context.Background(), "owner")
+	webhook, err := service.CreateWebhook(owner, "zap", domain.Template{Title: "{{title}}"})
+	if err != nil {
+		t.Fatalf("create webhook: %v", err)
+	}
+
+	deliver := func(body string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/webhooks/"+webhook.ID.String(), strings.NewReader(body))
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	body := `{"title": "Queued"}`
+
+	// Authentication and rendering still fail the request itself
+	if rec := deliver(body, nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("unsigned delivery: status %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	rec := deliver(body, http.Header{SignatureHeader: {webhook.Sign([]byte(body))}})
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("signed delivery: status %d, body %s", rec.Code, rec.Body)
+	}
+	var accepted struct {
+		JobID string `json:"job_id"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &accepted); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	claimed, err := jobs.Claim(context.Background(), []string{application.DeliveryJobKind}, 1, time.Minute)
+	if err != nil || len(claimed) != 1 || claimed[0].ID.String() != accepted.JobID {
+		t.Fatalf("claimed %v, %v; want the queued job %s", claimed, err, accepted.JobID)
+	}
+	// Running the job twice, as after a lost lease, creates one task
+	for i := 0; i < 2; i++ {
+		if err := service.RunQueuedDelivery(context.Background(), claimed[0]); err != nil {
+			t.Fatalf("run queued delivery: %v", err)
+		}
+	}
+	list, err := tasks.ListTasks(owner, nil, 100, 0, taskdomain.ListOptions{}, false)
+	if err != nil {
+		t.Fatalf("list tasks: %v", err)
+	}
+	if len(list.Tasks) != 1 || list.Tasks[0].Title != "Queued" {
+		t.Errorf("tasks = %+v, want the one queued task", list.Tasks)
+	}
+}
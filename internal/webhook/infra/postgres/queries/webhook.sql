@@ -0,0 +1,45 @@
+-- name: CreateWebhook :one
+INSERT INTO webhooks (id, owner_id, name, secret, template)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, owner_id, name, secret, template, created_at, updated_at, last_delivered_at;
+
+-- name: GetWebhook :one
+SELECT id, owner_id, name, secret, template, created_at, updated_at, last_delivered_at
+FROM webhooks
+WHERE id = $1 AND owner_id = $2;
+
+-- name: GetWebhookByID :one
+SELECT id, owner_id, name, secret, template, created_at, updated_at, last_delivered_at
+FROM webhooks
+WHERE id = $1;
+
+-- name: ReplaceWebhookSecret :execrows
+UPDATE webhooks
+SET secret = sqlc.arg(new_secret)
+WHERE id = sqlc.arg(id) AND secret = sqlc.arg(old_secret);
+
+-- name: UpdateWebhook :one
+UPDATE webhooks
+SET name = $2, secret = $3, template = $4, updated_at = NOW()
+WHERE id = $1 AND owner_id = $5
+RETURNING id, owner_id, name, secret, template, created_at, updated_at, last_delivered_at;
+
+-- name: DeleteWebhook :exec
+DELETE FROM webhooks
+WHERE id = $1 AND owner_id = $2;
+
+-- name: ListWebhookSecrets :many
+SELECT id, secret
+FROM webhooks
+ORDER BY id ASC;
+
+-- name: ListWebhooks :many
+SELECT id, owner_id, name, secret, template, created_at, updated_at, last_delivered_at
+FROM webhooks
+WHERE owner_id = $1
+ORDER BY name ASC, created_at ASC;
+
+-- name: MarkWebhookDelivered :exec
+UPDATE webhooks
+SET last_delivered_at = $2
+WHERE id = $1;
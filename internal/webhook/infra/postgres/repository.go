@@ -0,0 +1,245 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/slips-ai/slips-core/internal/webhook/domain"
+	"github.com/slips-ai/slips-core/pkg/envelope"
+)
+
+// WebhookRepository implements domain.Repository using PostgreSQL
+type WebhookRepository struct {
+	queries *Queries
+	// keyring encrypts webhook secrets at rest; nil stores them in plaintext
+	keyring *envelope.Keyring
+}
+
+// NewWebhookRepository creates a new webhook repository. Secrets are
+// encrypted with keyring when it is not nil.
+func NewWebhookRepository(pool *pgxpool.Pool, keyring *envelope.Keyring) *WebhookRepository {
+	return &WebhookRepository{
+		queries: New(pool),
+		keyring: keyring,
+	}
+}
+
+// Create creates a new webhook
+func (r *WebhookRepository) Create(ctx context.Context, webhook *domain.Webhook) error {
+	template, err := json.Marshal(webhook.Template)
+	if err != nil {
+		return err
+	}
+	secret, err := r.sealSecret(ctx, webhook.ID, webhook.Secret)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.queries.CreateWebhook(ctx, CreateWebhookParams{
+		ID:       pgtype.UUID{Bytes: webhook.ID, Valid: true},
+		OwnerID:  webhook.OwnerID,
+		Name:     webhook.Name,
+		Secret:   secret,
+		Template: template,
+	})
+	if err != nil {
+		return err
+	}
+
+	webhook.CreatedAt = result.CreatedAt.Time
+	webhook.UpdatedAt = result.UpdatedAt.Time
+	return nil
+}
+
+// Get retrieves a webhook by ID
+func (r *WebhookRepository) Get(ctx context.Context, id uuid.UUID, ownerID string) (*domain.Webhook, error) {
+	result, err := r.queries.GetWebhook(ctx, GetWebhookParams{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, webhookNotFound(err)
+	}
+
+	return r.toDomain(ctx, result)
+}
+
+// GetForDelivery retrieves a webhook by ID regardless of its owner
+func (r *WebhookRepository) GetForDelivery(ctx context.Context, id uuid.UUID) (*domain.Webhook, error) {
+	result, err := r.queries.GetWebhookByID(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if err != nil {
+		return nil, webhookNotFound(err)
+	}
+
+	return r.toDomain(ctx, result)
+}
+
+// Update updates the name, template and secret of a webhook
+func (r *WebhookRepository) Update(ctx context.Context, webhook *domain.Webhook) error {
+	template, err := json.Marshal(webhook.Template)
+	if err != nil {
+		return err
+	}
+	secret, err := r.sealSecret(ctx, webhook.ID, webhook.Secret)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.queries.UpdateWebhook(ctx, UpdateWebhookParams{
+		ID:       pgtype.UUID{Bytes: webhook.ID, Valid: true},
+		Name:     webhook.Name,
+		Secret:   secret,
+		Template: template,
+		OwnerID:  webhook.OwnerID,
+	})
+	if err != nil {
+		return webhookNotFound(err)
+	}
+
+	webhook.UpdatedAt = result.UpdatedAt.Time
+	return nil
+}
+
+// Delete deletes a webhook
+func (r *WebhookRepository) Delete(ctx context.Context, id uuid.UUID, ownerID string) error {
+	return r.queries.DeleteWebhook(ctx, DeleteWebhookParams{
+		ID:      pgtype.UUID{Bytes: id, Valid: true},
+		OwnerID: ownerID,
+	})
+}
+
+// List lists the owner's webhooks ordered by name
+func (r *WebhookRepository) List(ctx context.Context, ownerID string) ([]*domain.Webhook, error) {
+	results, err := r.queries.ListWebhooks(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*domain.Webhook, len(results))
+	for i, result := range results {
+		webhook, err := r.toDomain(ctx, result)
+		if err != nil {
+			return nil, err
+		}
+		webhooks[i] = webhook
+	}
+	return webhooks, nil
+}
+
+// MarkDelivered records the time of the latest delivery
+func (r *WebhookRepository) MarkDelivered(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.queries.MarkWebhookDelivered(ctx, MarkWebhookDeliveredParams{
+		ID:              pgtype.UUID{Bytes: id, Valid: true},
+		LastDeliveredAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+}
+
+// RotateSecrets re-encrypts every webhook secret that is stored in
+// plaintext or under a key other than the primary key, and returns how many
+// were rewritten. Secrets changed concurrently are skipped and picked up by
+// the next run.
+func (r *WebhookRepository) RotateSecrets(ctx context.Context) (int, error) {
+	if r.keyring == nil {
+		return 0, errors.New("encryption is not configured")
+	}
+
+	rows, err := r.queries.ListWebhookSecrets(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rotated := 0
+	for _, row := range rows {
+		if !r.keyring.NeedsRotation(row.Secret) {
+			continue
+		}
+		id := uuid.UUID(row.ID.Bytes)
+		secret, err := r.keyring.Decrypt(ctx, row.Secret, secretAAD(id))
+		if err != nil {
+			return rotated, fmt.Errorf("decrypt secret of webhook %s: %w", id, err)
+		}
+		sealed, err := r.sealSecret(ctx, id, secret)
+		if err != nil {
+			return rotated, err
+		}
+		n, err := r.queries.ReplaceWebhookSecret(ctx, ReplaceWebhookSecretParams{
+			NewSecret: sealed,
+			ID:        row.ID,
+			OldSecret: row.Secret,
+		})
+		if err != nil {
+			return rotated, err
+		}
+		rotated += int(n)
+	}
+	return rotated, nil
+}
+
+// webhookNotFound reports a missing webhook row as domain.ErrWebhookNotFound
+func webhookNotFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrWebhookNotFound
+	}
+	return err
+}
+
+// secretAAD binds an encrypted secret to its webhook, so a value copied to
+// another row fails to decrypt
+func secretAAD(id uuid.UUID) string {
+	return "webhooks.secret:" + id.String()
+}
+
+// sealSecret encrypts a secret for storage if encryption is enabled
+func (r *WebhookRepository) sealSecret(ctx context.Context, id uuid.UUID, secret string) (string, error) {
+	if r.keyring == nil {
+		return secret, nil
+	}
+	return r.keyring.Encrypt(ctx, secret, secretAAD(id))
+}
+
+// toDomain converts a row, decrypting its secret. Plaintext secrets
+// written before encryption was enabled pass through.
+func (r *WebhookRepository) toDomain(ctx context.Context, row Webhook) (*domain.Webhook, error) {
+	id, err := uuid.FromBytes(row.ID.Bytes[:])
+	if err != nil {
+		return nil, err
+	}
+
+	var template domain.Template
+	if len(row.Template) > 0 {
+		if err := json.Unmarshal(row.Template, &template); err != nil {
+			return nil, err
+		}
+	}
+
+	secret := row.Secret
+	if envelope.IsEncrypted(secret) {
+		if r.keyring == nil {
+			return nil, errors.New("webhook secret is encrypted but no encryption keys are configured")
+		}
+		if secret, err = r.keyring.Decrypt(ctx, secret, secretAAD(id)); err != nil {
+			return nil, err
+		}
+	}
+
+	webhook := &domain.Webhook{
+		ID:        id,
+		OwnerID:   row.OwnerID,
+		Name:      row.Name,
+		Secret:    secret,
+		Template:  template,
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+	if row.LastDeliveredAt.Valid {
+		webhook.LastDeliveredAt = &row.LastDeliveredAt.Time
+	}
+	return webhook, nil
+}
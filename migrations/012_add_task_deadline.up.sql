@@ -0,0 +1,6 @@
+-- Add deadline column (date only, no timezone).
+-- start_date controls when a task appears; deadline controls when it must be done.
+ALTER TABLE tasks ADD COLUMN deadline DATE;
+
+-- Create index for filtering/sorting by deadline
+CREATE INDEX idx_tasks_deadline ON tasks(owner_id, deadline) WHERE deadline IS NOT NULL;
@@ -0,0 +1,5 @@
+-- Add pinned flag so owners can keep important tasks at the top of their lists
+ALTER TABLE tasks ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Create index for ordering pinned tasks first
+CREATE INDEX idx_tasks_owner_pinned ON tasks(owner_id, pinned DESC, created_at DESC);
@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_saved_filters_owner_id;
+
+-- Drop saved_filters table
+DROP TABLE IF EXISTS saved_filters;
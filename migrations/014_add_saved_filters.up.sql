@@ -0,0 +1,13 @@
+-- Create saved_filters table for persisting named task filters (smart lists)
+CREATE TABLE IF NOT EXISTS saved_filters (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    criteria JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (owner_id, name)
+);
+
+-- Create index on owner_id for listing a user's filters
+CREATE INDEX IF NOT EXISTS idx_saved_filters_owner_id ON saved_filters(owner_id);
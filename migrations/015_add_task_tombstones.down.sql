@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_tasks_owner_updated_at;
+DROP INDEX IF EXISTS idx_task_tombstones_owner_deleted_at;
+
+-- Drop task_tombstones table
+DROP TABLE IF EXISTS task_tombstones;
@@ -0,0 +1,12 @@
+-- Record deleted tasks so polling clients can sync deletions
+CREATE TABLE IF NOT EXISTS task_tombstones (
+    task_id UUID PRIMARY KEY,
+    owner_id VARCHAR(255) NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create index for fetching a user's deletions since a point in time
+CREATE INDEX IF NOT EXISTS idx_task_tombstones_owner_deleted_at ON task_tombstones(owner_id, deleted_at);
+
+-- Create index for fetching a user's changes since a point in time
+CREATE INDEX IF NOT EXISTS idx_tasks_owner_updated_at ON tasks(owner_id, updated_at);
@@ -0,0 +1,5 @@
+-- Add completed_at column; NULL means the task is still open
+ALTER TABLE tasks ADD COLUMN completed_at TIMESTAMP WITH TIME ZONE;
+
+-- Create index for finding a user's completed tasks
+CREATE INDEX idx_tasks_owner_completed_at ON tasks(owner_id, completed_at) WHERE completed_at IS NOT NULL;
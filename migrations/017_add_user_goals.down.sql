@@ -0,0 +1,2 @@
+-- Drop user_goals table
+DROP TABLE IF EXISTS user_goals;
@@ -0,0 +1,7 @@
+-- Create user_goals table for per-user completion goals
+CREATE TABLE IF NOT EXISTS user_goals (
+    owner_id VARCHAR(255) PRIMARY KEY,
+    weekly_completion_goal INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
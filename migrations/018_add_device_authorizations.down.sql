@@ -0,0 +1,2 @@
+-- Drop device_authorizations table
+DROP TABLE IF EXISTS device_authorizations;
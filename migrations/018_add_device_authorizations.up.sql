@@ -0,0 +1,22 @@
+-- Pending OAuth device authorizations. A row is created when a device starts
+-- the flow, receives tokens once the user completes OAuth in a browser, and
+-- is deleted when the device collects them or the code expires.
+CREATE TABLE IF NOT EXISTS device_authorizations (
+    device_code_hash VARCHAR(64) PRIMARY KEY,
+    user_code VARCHAR(16) NOT NULL UNIQUE,
+    oauth_state VARCHAR(512) NOT NULL UNIQUE,
+    authorization_url TEXT NOT NULL,
+    interval_seconds INTEGER NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_polled_at TIMESTAMP WITH TIME ZONE,
+    user_id VARCHAR(255),
+    access_token TEXT,
+    access_token_expires_at BIGINT,
+    refresh_token TEXT,
+    refresh_token_expires_at BIGINT,
+    token_type VARCHAR(32),
+    approved_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_device_authorizations_expires_at ON device_authorizations(expires_at);
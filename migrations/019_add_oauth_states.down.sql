@@ -0,0 +1,2 @@
+-- Drop oauth_states table
+DROP TABLE IF EXISTS oauth_states;
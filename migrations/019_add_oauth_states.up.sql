@@ -0,0 +1,14 @@
+-- OAuth states issued by GetAuthorizationURL and StartDeviceAuthorization,
+-- with the PKCE challenge of logins started by public clients. HandleCallback
+-- deletes the row, so each state completes at most one login.
+CREATE TABLE IF NOT EXISTS oauth_states (
+    state VARCHAR(512) PRIMARY KEY,
+    provider VARCHAR(64) NOT NULL,
+    redirect_url TEXT NOT NULL DEFAULT '',
+    code_challenge VARCHAR(128),
+    code_challenge_method VARCHAR(16),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_oauth_states_expires_at ON oauth_states(expires_at);
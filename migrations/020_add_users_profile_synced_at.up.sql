@@ -0,0 +1,2 @@
+-- Track when a user's profile was last refreshed from Identra
+ALTER TABLE users ADD COLUMN IF NOT EXISTS profile_synced_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,2 @@
+-- Drop user_onboarding table
+DROP TABLE IF EXISTS user_onboarding;
@@ -0,0 +1,10 @@
+-- Create user_onboarding table so clients can coordinate first-run
+-- experiences across devices
+CREATE TABLE IF NOT EXISTS user_onboarding (
+    user_id VARCHAR(255) PRIMARY KEY,
+    welcome_completed BOOLEAN NOT NULL DEFAULT FALSE,
+    sample_data_created BOOLEAN NOT NULL DEFAULT FALSE,
+    features_toured BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
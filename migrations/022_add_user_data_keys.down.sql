@@ -0,0 +1,2 @@
+-- Drop user_data_keys table
+DROP TABLE IF EXISTS user_data_keys;
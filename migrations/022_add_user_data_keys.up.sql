@@ -0,0 +1,8 @@
+-- Create user_data_keys table holding per-user data keys, each wrapped with
+-- the server key encryption key, used for field-level encryption
+CREATE TABLE IF NOT EXISTS user_data_keys (
+    user_id VARCHAR(255) PRIMARY KEY,
+    wrapped_key TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
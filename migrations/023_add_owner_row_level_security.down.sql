@@ -0,0 +1,12 @@
+-- Drop owner isolation policies
+DROP POLICY IF EXISTS task_checklist_items_owner_isolation ON task_checklist_items;
+ALTER TABLE task_checklist_items NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE task_checklist_items DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tags_owner_isolation ON tags;
+ALTER TABLE tags NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE tags DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tasks_owner_isolation ON tasks;
+ALTER TABLE tasks NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE tasks DISABLE ROW LEVEL SECURITY;
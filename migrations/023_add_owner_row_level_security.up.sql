@@ -0,0 +1,30 @@
+-- Owner isolation policies, enforced for sessions that set app.user_id
+-- (database.row_level_security). Sessions without it, such as migrations,
+-- background jobs and admin queries, see every row, so the policies are a
+-- no-op until the option is enabled. FORCE applies them to the table owner
+-- too; superusers and BYPASSRLS roles are never restricted.
+ALTER TABLE tasks ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tasks FORCE ROW LEVEL SECURITY;
+CREATE POLICY tasks_owner_isolation ON tasks
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR owner_id = current_setting('app.user_id', true))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR owner_id = current_setting('app.user_id', true));
+
+ALTER TABLE tags ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tags FORCE ROW LEVEL SECURITY;
+CREATE POLICY tags_owner_isolation ON tags
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR owner_id = current_setting('app.user_id', true))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR owner_id = current_setting('app.user_id', true));
+
+-- Checklist items have no owner column; the subquery on tasks is itself
+-- filtered by tasks_owner_isolation
+ALTER TABLE task_checklist_items ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_checklist_items FORCE ROW LEVEL SECURITY;
+CREATE POLICY task_checklist_items_owner_isolation ON task_checklist_items
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR EXISTS (SELECT 1 FROM tasks t WHERE t.id = task_checklist_items.task_id))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR EXISTS (SELECT 1 FROM tasks t WHERE t.id = task_checklist_items.task_id));
@@ -0,0 +1,2 @@
+-- Drop index used to walk all tasks of an owner in ID order
+DROP INDEX IF EXISTS idx_tasks_owner_id_id;
@@ -0,0 +1,2 @@
+-- Index used to walk all tasks of an owner in ID order (StreamTasks)
+CREATE INDEX IF NOT EXISTS idx_tasks_owner_id_id ON tasks(owner_id, id);
@@ -0,0 +1,2 @@
+-- Drop task_settings table
+DROP TABLE IF EXISTS task_settings;
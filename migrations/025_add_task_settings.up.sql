@@ -0,0 +1,12 @@
+-- Per-user task preferences. A missing row or NULL column means the
+-- preference is off, so auto-archiving is opt-in.
+CREATE TABLE IF NOT EXISTS task_settings (
+    owner_id VARCHAR(255) PRIMARY KEY,
+    auto_archive_after_days INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Index used by the auto-archive job to find users with a policy
+CREATE INDEX IF NOT EXISTS idx_task_settings_auto_archive
+    ON task_settings(owner_id) WHERE auto_archive_after_days IS NOT NULL;
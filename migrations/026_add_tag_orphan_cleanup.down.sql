@@ -0,0 +1,4 @@
+-- Drop tag_settings table and orphan tracking
+DROP TABLE IF EXISTS tag_settings;
+DROP INDEX IF EXISTS idx_tags_orphaned_at;
+ALTER TABLE tags DROP COLUMN IF EXISTS orphaned_at;
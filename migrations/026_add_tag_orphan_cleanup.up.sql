@@ -0,0 +1,16 @@
+-- Orphan tags are deleted by a background job instead of on every task
+-- write. orphaned_at records when the job first saw a tag without tasks so
+-- users can keep unused tags for a while.
+ALTER TABLE tags ADD COLUMN IF NOT EXISTS orphaned_at TIMESTAMP WITH TIME ZONE;
+CREATE INDEX IF NOT EXISTS idx_tags_orphaned_at ON tags(orphaned_at) WHERE orphaned_at IS NOT NULL;
+
+-- Per-user tag preferences; a missing row keeps the defaults
+CREATE TABLE IF NOT EXISTS tag_settings (
+    owner_id VARCHAR(255) PRIMARY KEY,
+    orphan_cleanup VARCHAR(16) NOT NULL DEFAULT 'immediate'
+        CHECK (orphan_cleanup IN ('immediate', 'after_days', 'never')),
+    orphan_cleanup_after_days INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (orphan_cleanup <> 'after_days' OR orphan_cleanup_after_days > 0)
+);
@@ -0,0 +1,2 @@
+-- Drop task_note_revisions table together with its policy
+DROP TABLE IF EXISTS task_note_revisions;
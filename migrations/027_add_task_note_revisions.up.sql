@@ -0,0 +1,20 @@
+-- Snapshots of task notes taken before they are overwritten, newest kept
+-- per task. Notes are stored as in tasks.notes, encrypted or not.
+CREATE TABLE IF NOT EXISTS task_note_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    notes TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_note_revisions_task_id_created_at
+    ON task_note_revisions(task_id, created_at DESC);
+
+-- Same owner isolation as task_checklist_items (database.row_level_security)
+ALTER TABLE task_note_revisions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_note_revisions FORCE ROW LEVEL SECURITY;
+CREATE POLICY task_note_revisions_owner_isolation ON task_note_revisions
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR EXISTS (SELECT 1 FROM tasks t WHERE t.id = task_note_revisions.task_id))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR EXISTS (SELECT 1 FROM tasks t WHERE t.id = task_note_revisions.task_id));
@@ -0,0 +1,5 @@
+-- Drop client request IDs
+DROP INDEX IF EXISTS idx_tags_owner_client_request_id;
+ALTER TABLE tags DROP COLUMN IF EXISTS client_request_id;
+DROP INDEX IF EXISTS idx_tasks_owner_client_request_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS client_request_id;
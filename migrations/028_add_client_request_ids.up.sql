@@ -0,0 +1,9 @@
+-- Offline clients tag the resources they create with their own ID, so a
+-- retried create returns the existing row instead of inserting a duplicate
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS client_request_id VARCHAR(255);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tasks_owner_client_request_id
+    ON tasks(owner_id, client_request_id) WHERE client_request_id IS NOT NULL;
+
+ALTER TABLE tags ADD COLUMN IF NOT EXISTS client_request_id VARCHAR(255);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_owner_client_request_id
+    ON tags(owner_id, client_request_id) WHERE client_request_id IS NOT NULL;
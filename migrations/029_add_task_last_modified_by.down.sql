@@ -0,0 +1,3 @@
+-- Drop last-writer metadata
+ALTER TABLE tasks DROP COLUMN IF EXISTS last_modified_client_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS last_modified_source;
@@ -0,0 +1,5 @@
+-- Record who made the last change to a task: the kind of caller ('user',
+-- 'agent' or 'system') and the device or app instance it identified itself
+-- as. NULL for changes made before this migration.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS last_modified_source VARCHAR(16);
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS last_modified_client_id VARCHAR(255);
@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_webhooks_owner_id;
+
+-- Drop webhooks table
+DROP TABLE IF EXISTS webhooks;
@@ -0,0 +1,16 @@
+-- Inbound webhooks create tasks from HTTP requests sent by automation tools.
+-- The secret signs deliveries; it is encrypted when encryption keys are
+-- configured. template maps payload fields to task fields.
+CREATE TABLE IF NOT EXISTS webhooks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    secret TEXT NOT NULL,
+    template JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_delivered_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create index on owner_id for listing a user's webhooks
+CREATE INDEX IF NOT EXISTS idx_webhooks_owner_id ON webhooks(owner_id);
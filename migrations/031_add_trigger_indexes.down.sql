@@ -0,0 +1,3 @@
+-- Drop indexes used to poll automation triggers
+DROP INDEX IF EXISTS idx_task_tags_created_at;
+DROP INDEX IF EXISTS idx_tasks_owner_created_at_id;
@@ -0,0 +1,3 @@
+-- Indexes used to poll automation triggers newest first
+CREATE INDEX IF NOT EXISTS idx_tasks_owner_created_at_id ON tasks(owner_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_task_tags_created_at ON task_tags(created_at DESC);
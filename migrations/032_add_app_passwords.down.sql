@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_app_passwords_user_id;
+
+-- Drop app_passwords table
+DROP TABLE IF EXISTS app_passwords;
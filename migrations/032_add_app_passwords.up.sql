@@ -0,0 +1,13 @@
+-- App passwords let CalDAV clients such as iOS Reminders sign in with HTTP
+-- Basic authentication. Only the SHA-256 hash of each password is stored.
+CREATE TABLE IF NOT EXISTS app_passwords (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    password_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create index on user_id for listing a user's app passwords
+CREATE INDEX IF NOT EXISTS idx_app_passwords_user_id ON app_passwords(user_id);
@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_feeds_owner_id;
+
+-- Drop feeds table
+DROP TABLE IF EXISTS feeds;
@@ -0,0 +1,19 @@
+-- Feeds publish recent changes to the tasks of a tag or saved filter as
+-- RSS or JSON Feed. Readers authenticate with a token in the feed URL; only
+-- its SHA-256 hash is stored. Exactly one of tag_id and saved_filter_id is
+-- set. They are not foreign keys: a feed whose source is deleted stops
+-- serving until its owner deletes it.
+CREATE TABLE IF NOT EXISTS feeds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    tag_id UUID,
+    saved_filter_id UUID,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_fetched_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT feeds_source_check CHECK (num_nonnulls(tag_id, saved_filter_id) = 1)
+);
+
+-- Create index on owner_id for listing a user's feeds
+CREATE INDEX IF NOT EXISTS idx_feeds_owner_id ON feeds(owner_id);
@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_digest_preferences_enabled;
+
+-- Drop digest_preferences table
+DROP TABLE IF EXISTS digest_preferences;
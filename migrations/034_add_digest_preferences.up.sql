@@ -0,0 +1,18 @@
+-- Per-user email digest preferences. Digests are opt-in: a missing row or
+-- frequency 'off' sends nothing. send_hour and weekday are local to
+-- timezone, an IANA zone name; weekday counts from Sunday (0).
+CREATE TABLE IF NOT EXISTS digest_preferences (
+    owner_id VARCHAR(255) PRIMARY KEY,
+    frequency VARCHAR(16) NOT NULL DEFAULT 'off'
+        CHECK (frequency IN ('off', 'daily', 'weekly')),
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    send_hour SMALLINT NOT NULL DEFAULT 7 CHECK (send_hour BETWEEN 0 AND 23),
+    weekday SMALLINT NOT NULL DEFAULT 1 CHECK (weekday BETWEEN 0 AND 6),
+    last_sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Index used by the digest job to find users who turned digests on
+CREATE INDEX IF NOT EXISTS idx_digest_preferences_enabled
+    ON digest_preferences(owner_id) WHERE frequency <> 'off';
@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_web_push_subscriptions_owner_id;
+
+-- Drop web push subscriptions table
+DROP TABLE IF EXISTS web_push_subscriptions;
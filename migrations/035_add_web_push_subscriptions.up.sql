@@ -0,0 +1,17 @@
+-- Web Push subscriptions registered by browsers running the web client. An
+-- endpoint is unique to a browser profile, so registering it again, also as
+-- another user after switching accounts, replaces the stored keys and owner.
+CREATE TABLE IF NOT EXISTS web_push_subscriptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id VARCHAR(255) NOT NULL,
+    endpoint TEXT NOT NULL UNIQUE,
+    p256dh VARCHAR(128) NOT NULL,
+    auth VARCHAR(64) NOT NULL,
+    user_agent VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create index on owner_id for delivering to and listing a user's
+-- subscriptions
+CREATE INDEX IF NOT EXISTS idx_web_push_subscriptions_owner_id ON web_push_subscriptions(owner_id);
@@ -0,0 +1,3 @@
+-- Drop task contexts
+DROP INDEX IF EXISTS idx_tasks_owner_context;
+ALTER TABLE tasks DROP COLUMN IF EXISTS context;
@@ -0,0 +1,5 @@
+-- GTD context of a task, such as '@home' or '@low-energy': where the task
+-- can be done or how much energy it takes. NULL when the task has none.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS context VARCHAR(64);
+CREATE INDEX IF NOT EXISTS idx_tasks_owner_context
+    ON tasks(owner_id, context) WHERE context IS NOT NULL;
@@ -0,0 +1,2 @@
+-- Drop rollover preference
+ALTER TABLE task_settings DROP COLUMN IF EXISTS rollover_to_inbox;
@@ -0,0 +1,3 @@
+-- Where the daily rollover moves open tasks whose start date has passed:
+-- to today by default, or back to the inbox
+ALTER TABLE task_settings ADD COLUMN IF NOT EXISTS rollover_to_inbox BOOLEAN NOT NULL DEFAULT FALSE;
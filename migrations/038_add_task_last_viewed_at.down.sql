@@ -0,0 +1,2 @@
+-- Drop task view times
+ALTER TABLE tasks DROP COLUMN IF EXISTS last_viewed_at;
@@ -0,0 +1,4 @@
+-- When the owner last opened a task, reported by clients through
+-- MarkTaskViewed. Viewing does not change updated_at; stale task detection
+-- looks at both. NULL when the task was never viewed.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS last_viewed_at TIMESTAMPTZ;
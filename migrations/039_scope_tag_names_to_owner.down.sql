@@ -0,0 +1,4 @@
+-- Make tag names unique across all users again; fails if two users share a
+-- tag name
+DROP INDEX IF EXISTS idx_tags_owner_name;
+ALTER TABLE tags ADD CONSTRAINT tags_name_key UNIQUE (name);
@@ -0,0 +1,5 @@
+-- Tag names were unique across all users, so two users could not both have
+-- a tag called "work". Make them unique per owner instead, which also gives
+-- CreateTags a conflict target for its batch get-or-create.
+ALTER TABLE tags DROP CONSTRAINT IF EXISTS tags_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_owner_name ON tags(owner_id, name);
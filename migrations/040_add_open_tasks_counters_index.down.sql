@@ -0,0 +1,2 @@
+-- Drop the open tasks index used by GetTaskCounters
+DROP INDEX IF EXISTS idx_tasks_owner_open;
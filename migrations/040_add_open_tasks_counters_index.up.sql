@@ -0,0 +1,5 @@
+-- Index covering the columns GetTaskCounters looks at, so the badge counts
+-- clients poll for are read from the open tasks of an owner only
+CREATE INDEX IF NOT EXISTS idx_tasks_owner_open ON tasks(owner_id)
+    INCLUDE (start_date, deadline, last_modified_source, last_viewed_at, updated_at)
+    WHERE completed_at IS NULL AND archived_at IS NULL;
@@ -0,0 +1,4 @@
+-- Restore lookups by token value
+CREATE INDEX IF NOT EXISTS idx_mcp_tokens_token ON mcp_tokens(token);
+DROP INDEX IF EXISTS idx_mcp_tokens_token_hash;
+ALTER TABLE mcp_tokens DROP COLUMN IF EXISTS token_hash;
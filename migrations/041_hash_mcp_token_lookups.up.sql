@@ -0,0 +1,8 @@
+-- Look MCP tokens up by the hex SHA-256 of their value rather than the value
+-- itself, matching domain.HashToken
+ALTER TABLE mcp_tokens ADD COLUMN IF NOT EXISTS token_hash VARCHAR(64);
+UPDATE mcp_tokens SET token_hash = encode(sha256(convert_to(token::text, 'UTF8')), 'hex')
+    WHERE token_hash IS NULL;
+ALTER TABLE mcp_tokens ALTER COLUMN token_hash SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_mcp_tokens_token_hash ON mcp_tokens(token_hash);
+DROP INDEX IF EXISTS idx_mcp_tokens_token;
@@ -0,0 +1,3 @@
+-- When an operator replaced the user's profile with a pseudonym; logins no
+-- longer restore the profile of an anonymized user
+ALTER TABLE users ADD COLUMN IF NOT EXISTS anonymized_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,7 @@
+-- Drop agent attribution and creator metadata
+ALTER TABLE tasks DROP COLUMN IF EXISTS created_by_token_name;
+ALTER TABLE tasks DROP COLUMN IF EXISTS created_by_token_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS created_by_client_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS created_by_source;
+ALTER TABLE tasks DROP COLUMN IF EXISTS last_modified_token_name;
+ALTER TABLE tasks DROP COLUMN IF EXISTS last_modified_token_id;
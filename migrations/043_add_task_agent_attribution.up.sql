@@ -0,0 +1,9 @@
+-- Record the MCP token behind agent changes to a task, and who created it.
+-- Token names are copied, so the attribution survives renaming or deleting
+-- the token. NULL for changes made before this migration.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS last_modified_token_id UUID;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS last_modified_token_name VARCHAR(255);
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS created_by_source VARCHAR(16);
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS created_by_client_id VARCHAR(255);
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS created_by_token_id UUID;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS created_by_token_name VARCHAR(255);
@@ -0,0 +1,3 @@
+-- Drop per-token limits
+ALTER TABLE mcp_tokens DROP COLUMN IF EXISTS daily_mutation_budget;
+ALTER TABLE mcp_tokens DROP COLUMN IF EXISTS requests_per_minute;
@@ -0,0 +1,6 @@
+-- Per-token limits that keep a misbehaving agent from flooding its user's
+-- account; 0 means unlimited
+ALTER TABLE mcp_tokens ADD COLUMN IF NOT EXISTS requests_per_minute INTEGER NOT NULL DEFAULT 0
+    CHECK (requests_per_minute >= 0);
+ALTER TABLE mcp_tokens ADD COLUMN IF NOT EXISTS daily_mutation_budget INTEGER NOT NULL DEFAULT 0
+    CHECK (daily_mutation_budget >= 0);
@@ -0,0 +1,4 @@
+-- Drop approvals and their settings
+DROP TABLE IF EXISTS approval_settings;
+DROP INDEX IF EXISTS idx_approvals_owner_id_created_at;
+DROP TABLE IF EXISTS approvals;
@@ -0,0 +1,25 @@
+-- Task deletions requested with an MCP token wait here for the user to
+-- approve or reject them when the user asked for it in approval_settings
+CREATE TABLE IF NOT EXISTS approvals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id VARCHAR(255) NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    task_ids UUID[] NOT NULL,
+    requested_by_token_id UUID,
+    requested_by_token_name VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    decided_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create index for listing a user's approvals, newest first
+CREATE INDEX IF NOT EXISTS idx_approvals_owner_id_created_at ON approvals(owner_id, created_at DESC);
+
+-- Per-user approval preferences; a missing row keeps approvals off
+CREATE TABLE IF NOT EXISTS approval_settings (
+    owner_id VARCHAR(255) PRIMARY KEY,
+    require_agent_delete_approval BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,2 @@
+-- Drop shard placements
+DROP TABLE IF EXISTS user_shards;
@@ -0,0 +1,9 @@
+-- Owners moved off the shard picked by the hash of their ID. Only the
+-- primary's table is read; shards get it too since every database shares
+-- the same migrations.
+CREATE TABLE IF NOT EXISTS user_shards (
+    owner_id VARCHAR(255) PRIMARY KEY,
+    shard INTEGER NOT NULL CHECK (shard >= 0),
+    moving BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,4 @@
+-- Drop the work queue
+DROP INDEX IF EXISTS idx_queue_jobs_dead_updated_at;
+DROP INDEX IF EXISTS idx_queue_jobs_pending_run_at;
+DROP TABLE IF EXISTS queue_jobs;
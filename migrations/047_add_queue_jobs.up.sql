@@ -0,0 +1,25 @@
+-- Durable work queue shared by every instance. Workers claim due jobs with
+-- FOR UPDATE SKIP LOCKED and lease them until locked_until; completed jobs
+-- are deleted and jobs out of attempts stay here as dead letters.
+CREATE TABLE IF NOT EXISTS queue_jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kind VARCHAR(64) NOT NULL,
+    payload BYTEA NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL CHECK (max_attempts > 0),
+    run_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMP WITH TIME ZONE,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Index used by workers to find due jobs
+CREATE INDEX IF NOT EXISTS idx_queue_jobs_pending_run_at
+    ON queue_jobs(run_at) WHERE status = 'pending';
+
+-- Index used to list dead letters, most recent first
+CREATE INDEX IF NOT EXISTS idx_queue_jobs_dead_updated_at
+    ON queue_jobs(updated_at DESC) WHERE status = 'dead';
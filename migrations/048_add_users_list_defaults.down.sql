@@ -0,0 +1,3 @@
+ALTER TABLE users DROP COLUMN IF EXISTS search_include_archived;
+ALTER TABLE users DROP COLUMN IF EXISTS list_tag_match_all;
+ALTER TABLE users DROP COLUMN IF EXISTS list_page_size;
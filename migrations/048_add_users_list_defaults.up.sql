@@ -0,0 +1,7 @@
+-- A user's defaults for list parameters that requests omit: the ListTasks
+-- page size (0 uses the server default), whether filter tags must all match,
+-- and whether text searches include archived tasks
+ALTER TABLE users ADD COLUMN IF NOT EXISTS list_page_size INTEGER NOT NULL DEFAULT 0
+    CHECK (list_page_size BETWEEN 0 AND 100);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS list_tag_match_all BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS search_include_archived BOOLEAN NOT NULL DEFAULT FALSE;
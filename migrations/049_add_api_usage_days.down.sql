@@ -0,0 +1,3 @@
+-- Drop per-user API usage
+DROP INDEX IF EXISTS idx_api_usage_days_day;
+DROP TABLE IF EXISTS api_usage_days;
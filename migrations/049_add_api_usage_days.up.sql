@@ -0,0 +1,15 @@
+-- Per-user request counts per UTC day, by method class ('read' or 'write')
+-- and the credential the calls were made with ('jwt' or 'mcp_token')
+CREATE TABLE IF NOT EXISTS api_usage_days (
+    user_id VARCHAR(255) NOT NULL,
+    day DATE NOT NULL,
+    method_class VARCHAR(16) NOT NULL,
+    auth_type VARCHAR(32) NOT NULL,
+    requests BIGINT NOT NULL DEFAULT 0,
+    -- Requests rejected with RESOURCE_EXHAUSTED by a rate limit
+    rate_limited BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, day, method_class, auth_type)
+);
+
+-- Create index for purging old days
+CREATE INDEX IF NOT EXISTS idx_api_usage_days_day ON api_usage_days(day);
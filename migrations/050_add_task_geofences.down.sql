@@ -0,0 +1,2 @@
+-- Drop task_geofences table together with its policy
+DROP TABLE IF EXISTS task_geofences;
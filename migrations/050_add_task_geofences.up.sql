@@ -0,0 +1,23 @@
+-- Optional geofence of a task: a circle around a place that mobile clients
+-- register with the device's location services to remind the owner on
+-- arriving at or leaving it. Triggering happens on the device; the server
+-- only stores and syncs the circle.
+CREATE TABLE IF NOT EXISTS task_geofences (
+    task_id UUID PRIMARY KEY REFERENCES tasks(id) ON DELETE CASCADE,
+    latitude DOUBLE PRECISION NOT NULL CHECK (latitude BETWEEN -90 AND 90),
+    longitude DOUBLE PRECISION NOT NULL CHECK (longitude BETWEEN -180 AND 180),
+    radius_meters INTEGER NOT NULL CHECK (radius_meters > 0),
+    on_arrive BOOLEAN NOT NULL,
+    on_leave BOOLEAN NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (on_arrive OR on_leave)
+);
+
+-- Same owner isolation as task_checklist_items (database.row_level_security)
+ALTER TABLE task_geofences ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_geofences FORCE ROW LEVEL SECURITY;
+CREATE POLICY task_geofences_owner_isolation ON task_geofences
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR EXISTS (SELECT 1 FROM tasks t WHERE t.id = task_geofences.task_id))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR EXISTS (SELECT 1 FROM tasks t WHERE t.id = task_geofences.task_id));
@@ -0,0 +1,36 @@
+-- Drop the owner isolation policies of migration 051
+DROP POLICY IF EXISTS approval_settings_owner_isolation ON approval_settings;
+ALTER TABLE approval_settings NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE approval_settings DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS approvals_owner_isolation ON approvals;
+ALTER TABLE approvals NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE approvals DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS saved_filters_owner_isolation ON saved_filters;
+ALTER TABLE saved_filters NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE saved_filters DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS user_goals_owner_isolation ON user_goals;
+ALTER TABLE user_goals NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE user_goals DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS user_data_keys_owner_isolation ON user_data_keys;
+ALTER TABLE user_data_keys NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE user_data_keys DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tag_settings_owner_isolation ON tag_settings;
+ALTER TABLE tag_settings NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE tag_settings DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS task_settings_owner_isolation ON task_settings;
+ALTER TABLE task_settings NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE task_settings DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS task_tombstones_owner_isolation ON task_tombstones;
+ALTER TABLE task_tombstones NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE task_tombstones DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS task_tags_owner_isolation ON task_tags;
+ALTER TABLE task_tags NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE task_tags DISABLE ROW LEVEL SECURITY;
@@ -0,0 +1,74 @@
+-- Owner isolation policies for the remaining tables that live on their
+-- owner's shard, alike those of migration 023. task_tags has no owner
+-- column; the subquery on tasks is itself filtered by tasks_owner_isolation.
+ALTER TABLE task_tags ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_tags FORCE ROW LEVEL SECURITY;
+CREATE POLICY task_tags_owner_isolation ON task_tags
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR EXISTS (SELECT 1 FROM tasks t WHERE t.id = task_tags.task_id))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR EXISTS (SELECT 1 FROM tasks t WHERE t.id = task_tags.task_id));
+
+ALTER TABLE task_tombstones ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_tombstones FORCE ROW LEVEL SECURITY;
+CREATE POLICY task_tombstones_owner_isolation ON task_tombstones
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR owner_id = current_setting('app.user_id', true))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR owner_id = current_setting('app.user_id', true));
+
+ALTER TABLE task_settings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_settings FORCE ROW LEVEL SECURITY;
+CREATE POLICY task_settings_owner_isolation ON task_settings
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR owner_id = current_setting('app.user_id', true))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR owner_id = current_setting('app.user_id', true));
+
+ALTER TABLE tag_settings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tag_settings FORCE ROW LEVEL SECURITY;
+CREATE POLICY tag_settings_owner_isolation ON tag_settings
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR owner_id = current_setting('app.user_id', true))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR owner_id = current_setting('app.user_id', true));
+
+ALTER TABLE user_data_keys ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_data_keys FORCE ROW LEVEL SECURITY;
+CREATE POLICY user_data_keys_owner_isolation ON user_data_keys
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR user_id = current_setting('app.user_id', true))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR user_id = current_setting('app.user_id', true));
+
+ALTER TABLE user_goals ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_goals FORCE ROW LEVEL SECURITY;
+CREATE POLICY user_goals_owner_isolation ON user_goals
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR owner_id = current_setting('app.user_id', true))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR owner_id = current_setting('app.user_id', true));
+
+ALTER TABLE saved_filters ENABLE ROW LEVEL SECURITY;
+ALTER TABLE saved_filters FORCE ROW LEVEL SECURITY;
+CREATE POLICY saved_filters_owner_isolation ON saved_filters
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR owner_id = current_setting('app.user_id', true))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR owner_id = current_setting('app.user_id', true));
+
+ALTER TABLE approvals ENABLE ROW LEVEL SECURITY;
+ALTER TABLE approvals FORCE ROW LEVEL SECURITY;
+CREATE POLICY approvals_owner_isolation ON approvals
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR owner_id = current_setting('app.user_id', true))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR owner_id = current_setting('app.user_id', true));
+
+ALTER TABLE approval_settings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE approval_settings FORCE ROW LEVEL SECURITY;
+CREATE POLICY approval_settings_owner_isolation ON approval_settings
+    USING (COALESCE(current_setting('app.user_id', true), '') = ''
+           OR owner_id = current_setting('app.user_id', true))
+    WITH CHECK (COALESCE(current_setting('app.user_id', true), '') = ''
+                OR owner_id = current_setting('app.user_id', true));
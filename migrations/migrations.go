@@ -0,0 +1,11 @@
+// Package migrations embeds the SQL schema migrations so they ship with the
+// binary. Files keep the golang-migrate naming format (NNN_name.up.sql /
+// NNN_name.down.sql).
+package migrations
+
+import "embed"
+
+// FS contains the up and down migration files
+//
+//go:embed *.sql
+var FS embed.FS
@@ -7,18 +7,33 @@ import (
 
 type contextKey string
 
-const userIDKey contextKey = "user_id"
+const (
+	userIDKey     contextKey = "user_id"
+	userIDSlotKey contextKey = "user_id_slot"
+	sourceIPKey   contextKey = "source_ip"
+)
 
 var (
 	// ErrMissingUserID is returned when user ID is not found in context
 	ErrMissingUserID = errors.New("user ID not found in context")
 )
 
-// WithUserID adds user ID to context
+// WithUserID adds user ID to context. If an enclosing caller registered a
+// slot with WithUserIDSlot, the user ID is also stored there.
 func WithUserID(ctx context.Context, userID string) context.Context {
+	if slot, ok := ctx.Value(userIDSlotKey).(*string); ok {
+		*slot = userID
+	}
 	return context.WithValue(ctx, userIDKey, userID)
 }
 
+// WithUserIDSlot returns a context in which WithUserID also writes the user
+// ID to slot. It lets interceptors that run before authentication, such as
+// access logging, learn who made the request once the handler returns.
+func WithUserIDSlot(ctx context.Context, slot *string) context.Context {
+	return context.WithValue(ctx, userIDSlotKey, slot)
+}
+
 // GetUserID extracts user ID from context
 func GetUserID(ctx context.Context) (string, error) {
 	userID, ok := ctx.Value(userIDKey).(string)
@@ -27,3 +42,15 @@ func GetUserID(ctx context.Context) (string, error) {
 	}
 	return userID, nil
 }
+
+// WithSourceIP records the client IP of a request that does not arrive over
+// gRPC, such as an HTTP trigger poll, for MCPTokenGuard
+func WithSourceIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, sourceIPKey, ip)
+}
+
+// SourceIPFromContext returns the client IP recorded by WithSourceIP
+func SourceIPFromContext(ctx context.Context) (string, bool) {
+	ip, ok := ctx.Value(sourceIPKey).(string)
+	return ip, ok && ip != ""
+}
@@ -39,3 +39,14 @@ func TestGetUserID_EmptyUserID(t *testing.T) {
 		t.Fatalf("expected ErrMissingUserID for empty user ID, got %v", err)
 	}
 }
+
+func TestWithUserIDSlot(t *testing.T) {
+	var slot string
+	ctx := WithUserIDSlot(context.Background(), &slot)
+
+	WithUserID(ctx, "test-user-123")
+
+	if slot != "test-user-123" {
+		t.Fatalf("expected slot to receive the user ID, got %q", slot)
+	}
+}
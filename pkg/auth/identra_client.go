@@ -97,6 +97,22 @@ func (c *IdentraClient) RefreshToken(ctx context.Context, refreshToken string) (
 	return resp, nil
 }
 
+// GetCurrentUserLoginInfo returns what Identra knows about the owner of an
+// access token
+func (c *IdentraClient) GetCurrentUserLoginInfo(ctx context.Context, accessToken string) (*identra_v1.GetCurrentUserLoginInfoResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetCurrentUserLoginInfo(ctx, &identra_v1.GetCurrentUserLoginInfoRequest{
+		AccessToken: accessToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user login info: %w", err)
+	}
+
+	return resp, nil
+}
+
 // Close closes the gRPC connection
 func (c *IdentraClient) Close() error {
 	if c.conn != nil {
@@ -2,24 +2,68 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"strings"
 
+	"github.com/slips-ai/slips-core/pkg/grpcerrors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
-// isAuthServicePublicMethod checks if the method is a public Auth Service method that doesn't require authentication
-func isAuthServicePublicMethod(fullMethod string) bool {
-	publicMethods := []string{
-		"/auth.v1.AuthService/GetAuthorizationURL",
-		"/auth.v1.AuthService/HandleCallback",
-		"/auth.v1.AuthService/RefreshToken",
+// RefreshTokenMethod is the token refresh RPC. Deployments that only allow
+// refreshes from authenticated clients drop it from the public methods.
+const RefreshTokenMethod = "/auth.v1.AuthService/RefreshToken"
+
+// DefaultPublicMethods are the methods served without authentication: the
+// OAuth browser and device login flows, token refresh, server info and the
+// standard gRPC health service
+var DefaultPublicMethods = []string{
+	"/auth.v1.AuthService/GetAuthorizationURL",
+	"/auth.v1.AuthService/HandleCallback",
+	RefreshTokenMethod,
+	"/auth.v1.AuthService/StartDeviceAuthorization",
+	"/auth.v1.AuthService/GetDeviceVerificationURL",
+	"/auth.v1.AuthService/PollDeviceAuthorization",
+	"/server.v1.ServerService/GetServerInfo",
+	"/grpc.health.v1.Health/",
+}
+
+// PublicMethods is an allowlist of methods that skip authentication. An
+// entry is either a full method name ("/pkg.Service/Method") or, when it
+// ends in "/", a service prefix matching every method of that service.
+type PublicMethods struct {
+	methods  map[string]struct{}
+	services []string
+}
+
+// NewPublicMethods builds an allowlist from method names and service prefixes
+func NewPublicMethods(entries []string) *PublicMethods {
+	p := &PublicMethods{methods: make(map[string]struct{}, len(entries))}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case entry == "":
+		case strings.HasSuffix(entry, "/"):
+			p.services = append(p.services, entry)
+		default:
+			p.methods[entry] = struct{}{}
+		}
 	}
+	return p
+}
 
-	for _, method := range publicMethods {
-		if fullMethod == method {
+// Allows reports whether fullMethod may be called without authentication
+func (p *PublicMethods) Allows(fullMethod string) bool {
+	if p == nil {
+		return false
+	}
+	if _, ok := p.methods[fullMethod]; ok {
+		return true
+	}
+	for _, service := range p.services {
+		if strings.HasPrefix(fullMethod, service) {
 			return true
 		}
 	}
@@ -71,85 +115,157 @@ func UnaryServerInterceptor(validator *JWTValidator) grpc.UnaryServerInterceptor
 			return nil, status.Errorf(codes.Unauthenticated, "invalid token claims: %v", err)
 		}
 
-		// Add user ID to context
-		ctx = WithUserID(ctx, userID)
+		clientID, err := clientIDFromMetadata(md)
+		if err != nil {
+			return nil, err
+		}
+
+		// Add the principal and its user ID to context
+		ctx = WithPrincipal(ctx, &Principal{UserID: userID, Credential: CredentialJWT, AccessToken: tokenString, Scopes: claims.Scopes(), ClientID: clientID})
 
 		// Call the handler
 		return handler(ctx, req)
 	}
 }
 
-// UnaryServerInterceptorWithMCP returns a gRPC unary interceptor that supports both JWT and MCP token authentication
-func UnaryServerInterceptorWithMCP(jwtValidator *JWTValidator, mcpValidator MCPTokenValidator) grpc.UnaryServerInterceptor {
+// UnaryServerInterceptorWithMCP returns a gRPC unary interceptor that supports both JWT and MCP token authentication.
+// Methods allowed by public are served without credentials.
+func UnaryServerInterceptorWithMCP(jwtValidator *JWTValidator, mcpValidator MCPTokenValidator, public *PublicMethods) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (resp interface{}, err error) {
-		// Skip authentication for specific Auth Service methods
-		if isAuthServicePublicMethod(info.FullMethod) {
+		// Skip authentication for allowlisted methods (login flow, health checks)
+		if public.Allows(info.FullMethod) {
 			return handler(ctx, req)
 		}
 
-		// Recover from panics during authentication and convert to 401
-		defer func() {
-			if r := recover(); r != nil {
-				err = status.Errorf(codes.Unauthenticated, "authentication error: %v", r)
-			}
-		}()
+		principal, err := authenticateWithMCP(ctx, jwtValidator, mcpValidator)
+		if err != nil {
+			return nil, err
+		}
 
-		// Extract metadata from context
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		// Add the principal and its user ID to context
+		ctx = WithPrincipal(ctx, principal)
+
+		// Call the handler
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptorWithMCP is the streaming counterpart of
+// UnaryServerInterceptorWithMCP. Credentials are checked once, when the
+// stream is opened.
+func StreamServerInterceptorWithMCP(jwtValidator *JWTValidator, mcpValidator MCPTokenValidator, public *PublicMethods) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// Skip authentication for allowlisted methods (e.g. health Watch)
+		if public.Allows(info.FullMethod) {
+			return handler(srv, ss)
 		}
 
-		// Get authorization header
-		authHeaders := md.Get("authorization")
-		if len(authHeaders) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+		principal, err := authenticateWithMCP(ss.Context(), jwtValidator, mcpValidator)
+		if err != nil {
+			return err
 		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: WithPrincipal(ss.Context(), principal)})
+	}
+}
 
-		authHeader := authHeaders[0]
-		var userID string
+// authenticateWithMCP validates the JWT or MCP token in the authorization
+// metadata of ctx and returns the authenticated principal
+func authenticateWithMCP(ctx context.Context, jwtValidator *JWTValidator, mcpValidator MCPTokenValidator) (principal *Principal, err error) {
+	// Recover from panics during authentication and convert to 401
+	defer func() {
+		if r := recover(); r != nil {
+			principal = nil
+			err = status.Errorf(codes.Unauthenticated, "authentication error: %v", r)
+		}
+	}()
 
-		// Try to determine the token type based on the prefix
-		if strings.HasPrefix(authHeader, "Bearer ") {
-			// JWT token
-			tokenString, err := ExtractBearerToken(authHeader)
-			if err != nil {
-				return nil, status.Error(codes.Unauthenticated, err.Error())
-			}
+	// Extract metadata from context
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
 
-			claims, err := jwtValidator.ValidateToken(tokenString)
-			if err != nil {
-				return nil, status.Errorf(codes.Unauthenticated, "invalid JWT token: %v", err)
-			}
+	// Get authorization header
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
 
-			userID, err = ExtractUserID(claims)
-			if err != nil {
-				return nil, status.Errorf(codes.Unauthenticated, "invalid token claims: %v", err)
-			}
-		} else if strings.HasPrefix(authHeader, "MCP-Token ") {
-			// MCP token
-			token, err := ExtractMCPToken(authHeader)
-			if err != nil {
-				return nil, status.Errorf(codes.Unauthenticated, "invalid MCP token format: %v", err)
-			}
+	authHeader := authHeaders[0]
 
-			userID, err = mcpValidator.ValidateToken(ctx, token)
-			if err != nil {
-				return nil, status.Errorf(codes.Unauthenticated, "invalid MCP token: %v", err)
-			}
-		} else {
-			return nil, status.Error(codes.Unauthenticated, "unsupported authentication scheme (expected 'Bearer' or 'MCP-Token')")
+	clientID, err := clientIDFromMetadata(md)
+	if err != nil {
+		return nil, err
+	}
+
+	// Try to determine the token type based on the prefix
+	if strings.HasPrefix(authHeader, "Bearer ") {
+		// JWT token
+		tokenString, err := ExtractBearerToken(authHeader)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		claims, err := jwtValidator.ValidateToken(tokenString)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid JWT token: %v", err)
 		}
 
-		// Add user ID to context
-		ctx = WithUserID(ctx, userID)
+		userID, err := ExtractUserID(claims)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid token claims: %v", err)
+		}
+		return &Principal{UserID: userID, Credential: CredentialJWT, AccessToken: tokenString, Scopes: claims.Scopes(), ClientID: clientID}, nil
+	} else if strings.HasPrefix(authHeader, "MCP-Token ") {
+		// MCP token
+		token, err := ExtractMCPToken(authHeader)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid MCP token format: %v", err)
+		}
 
-		// Call the handler
-		return handler(ctx, req)
+		info, err := mcpValidator.ValidateToken(ctx, token)
+		if err != nil {
+			var blocked *MCPTokenBlockedError
+			if errors.As(err, &blocked) {
+				return nil, status.Error(codes.ResourceExhausted, blocked.Error())
+			}
+			if !errors.Is(err, ErrMCPTokenRejected) {
+				return nil, grpcerrors.ToGRPCError(err, "failed to validate MCP token")
+			}
+			// The reason stays in the server log; telling unknown tokens
+			// from revoked ones would help guessing
+			return nil, status.Error(codes.Unauthenticated, "invalid MCP token")
+		}
+		return &Principal{
+			UserID:      info.UserID,
+			Credential:  CredentialMCPToken,
+			Scopes:      MCPTokenScopes,
+			ClientID:    clientID,
+			TokenID:     info.TokenID,
+			TokenName:   info.Name,
+			TokenLimits: info.Limits,
+		}, nil
 	}
+	return nil, status.Error(codes.Unauthenticated, "unsupported authentication scheme (expected 'Bearer' or 'MCP-Token')")
+}
+
+// contextStream overrides the context of a server stream so handlers see
+// values added by stream interceptors
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
 }
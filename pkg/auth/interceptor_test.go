@@ -2,6 +2,8 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -99,7 +101,9 @@ func TestUnaryServerInterceptor_ValidToken(t *testing.T) {
 	t.Skip("Full integration test requires JWKS server setup")
 }
 
-func TestIsAuthServicePublicMethod(t *testing.T) {
+func TestDefaultPublicMethods(t *testing.T) {
+	public := NewPublicMethods(DefaultPublicMethods)
+
 	tests := []struct {
 		name       string
 		fullMethod string
@@ -135,13 +139,23 @@ func TestIsAuthServicePublicMethod(t *testing.T) {
 			fullMethod: "/mcptoken.v1.MCPTokenService/CreateMCPToken",
 			want:       false,
 		},
+		{
+			name:       "Health check is public",
+			fullMethod: "/grpc.health.v1.Health/Check",
+			want:       true,
+		},
+		{
+			name:       "Health watch is public",
+			fullMethod: "/grpc.health.v1.Health/Watch",
+			want:       true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := isAuthServicePublicMethod(tt.fullMethod)
+			got := public.Allows(tt.fullMethod)
 			if got != tt.want {
-				t.Errorf("isAuthServicePublicMethod(%q) = %v, want %v", tt.fullMethod, got, tt.want)
+				t.Errorf("Allows(%q) = %v, want %v", tt.fullMethod, got, tt.want)
 			}
 		})
 	}
@@ -154,7 +168,7 @@ func TestUnaryServerInterceptorWithMCP_PublicMethod(t *testing.T) {
 	// Create mock MCP validator
 	mockMCPValidator := &mockMCPTokenValidator{}
 
-	interceptor := UnaryServerInterceptorWithMCP(jwtValidator, mockMCPValidator)
+	interceptor := UnaryServerInterceptorWithMCP(jwtValidator, mockMCPValidator, NewPublicMethods(DefaultPublicMethods))
 
 	// Create context without any authorization header (should still succeed for public methods)
 	ctx := context.Background()
@@ -174,11 +188,27 @@ func TestUnaryServerInterceptorWithMCP_PublicMethod(t *testing.T) {
 	}
 }
 
+func TestUnaryServerInterceptorWithMCP_HealthCheckSkipsAuth(t *testing.T) {
+	interceptor := UnaryServerInterceptorWithMCP(&JWTValidator{}, &mockMCPTokenValidator{}, NewPublicMethods(DefaultPublicMethods))
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/grpc.health.v1.Health/Check",
+	}
+
+	resp, err := interceptor(context.Background(), nil, info, mockHandler)
+	if err != nil {
+		t.Fatalf("expected no error for health check, got: %v", err)
+	}
+	if resp != "success" {
+		t.Errorf("expected 'success' response, got: %v", resp)
+	}
+}
+
 func TestUnaryServerInterceptorWithMCP_NonPublicMethod_MissingAuth(t *testing.T) {
 	jwtValidator := &JWTValidator{}
 	mockMCPValidator := &mockMCPTokenValidator{}
 
-	interceptor := UnaryServerInterceptorWithMCP(jwtValidator, mockMCPValidator)
+	interceptor := UnaryServerInterceptorWithMCP(jwtValidator, mockMCPValidator, NewPublicMethods(DefaultPublicMethods))
 
 	// Create context without authorization header
 	ctx := context.Background()
@@ -206,8 +236,8 @@ func TestUnaryServerInterceptorWithMCP_NonPublicMethod_MissingAuth(t *testing.T)
 // mockMCPTokenValidator is a simple mock for testing
 type mockMCPTokenValidator struct{}
 
-func (m *mockMCPTokenValidator) ValidateToken(ctx context.Context, token uuid.UUID) (string, error) {
-	return "test-user-id", nil
+func (m *mockMCPTokenValidator) ValidateToken(ctx context.Context, token uuid.UUID) (*MCPTokenInfo, error) {
+	return &MCPTokenInfo{UserID: "test-user-id", TokenID: token, Name: "test-token"}, nil
 }
 
 func TestUnaryServerInterceptor_PanicRecovery(t *testing.T) {
@@ -244,7 +274,7 @@ func TestUnaryServerInterceptorWithMCP_PanicRecovery(t *testing.T) {
 	var jwtValidator *JWTValidator
 	mockMCPValidator := &mockMCPTokenValidator{}
 
-	interceptor := UnaryServerInterceptorWithMCP(jwtValidator, mockMCPValidator)
+	interceptor := UnaryServerInterceptorWithMCP(jwtValidator, mockMCPValidator, NewPublicMethods(DefaultPublicMethods))
 
 	// Create context with JWT Bearer token (will trigger panic in validator)
 	md := metadata.New(map[string]string{"authorization": "Bearer some-token"})
@@ -273,3 +303,138 @@ func TestUnaryServerInterceptorWithMCP_PanicRecovery(t *testing.T) {
 	// Error message should indicate it's an authentication error
 	t.Logf("Got error message: %s", st.Message())
 }
+
+func TestPublicMethods_Custom(t *testing.T) {
+	public := NewPublicMethods([]string{"/metrics.v1.Metrics/", " /auth.v1.AuthService/HandleCallback ", ""})
+
+	tests := []struct {
+		fullMethod string
+		want       bool
+	}{
+		{"/metrics.v1.Metrics/Scrape", true},
+		{"/auth.v1.AuthService/HandleCallback", true},
+		{"/auth.v1.AuthService/RefreshToken", false},
+		{"/metrics.v1.MetricsAdmin/Reset", false},
+	}
+	for _, tt := range tests {
+		if got := public.Allows(tt.fullMethod); got != tt.want {
+			t.Errorf("Allows(%q) = %v, want %v", tt.fullMethod, got, tt.want)
+		}
+	}
+}
+
+func TestUnaryServerInterceptorWithMCP_RefreshTokenRequiresAuthWhenNotPublic(t *testing.T) {
+	var methods []string
+	for _, method := range DefaultPublicMethods {
+		if method != RefreshTokenMethod {
+			methods = append(methods, method)
+		}
+	}
+	interceptor := UnaryServerInterceptorWithMCP(&JWTValidator{}, &mockMCPTokenValidator{}, NewPublicMethods(methods))
+
+	info := &grpc.UnaryServerInfo{FullMethod: RefreshTokenMethod}
+	_, err := interceptor(context.Background(), nil, info, mockHandler)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated for RefreshToken without credentials, got %v", err)
+	}
+}
+
+// fakeServerStream is a grpc.ServerStream that only carries a context
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptorWithMCP(t *testing.T) {
+	interceptor := StreamServerInterceptorWithMCP(&JWTValidator{}, &mockMCPTokenValidator{}, NewPublicMethods(DefaultPublicMethods))
+
+	var gotUserID string
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		gotUserID, _ = GetUserID(ss.Context())
+		return nil
+	}
+
+	// Health Watch is public and needs no credentials
+	watch := &grpc.StreamServerInfo{FullMethod: "/grpc.health.v1.Health/Watch", IsServerStream: true}
+	if err := interceptor(nil, &fakeServerStream{ctx: context.Background()}, watch, handler); err != nil {
+		t.Fatalf("public stream rejected: %v", err)
+	}
+
+	stream := &grpc.StreamServerInfo{FullMethod: "/task.v1.TaskService/StreamTasks", IsServerStream: true}
+	err := interceptor(nil, &fakeServerStream{ctx: context.Background()}, stream, handler)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("stream without credentials error = %v, want Unauthenticated", err)
+	}
+
+	md := metadata.New(map[string]string{"authorization": "MCP-Token " + uuid.NewString()})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	if err := interceptor(nil, &fakeServerStream{ctx: ctx}, stream, handler); err != nil {
+		t.Fatalf("stream with MCP token rejected: %v", err)
+	}
+	if gotUserID == "" {
+		t.Error("handler context is missing the authenticated user ID")
+	}
+}
+
+func TestUnaryServerInterceptorWithMCP_ClientID(t *testing.T) {
+	interceptor := UnaryServerInterceptorWithMCP(&JWTValidator{}, &mockMCPTokenValidator{}, NewPublicMethods(DefaultPublicMethods))
+	info := &grpc.UnaryServerInfo{FullMethod: "/task.v1.TaskService/CreateTask"}
+
+	var got *Principal
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got, _ = PrincipalFromContext(ctx)
+		return nil, nil
+	}
+
+	token := uuid.New()
+	md := metadata.New(map[string]string{
+		"authorization": "MCP-Token " + token.String(),
+		ClientIDHeader:  "laptop-1",
+	})
+	if _, err := interceptor(metadata.NewIncomingContext(context.Background(), md), nil, info, handler); err != nil {
+		t.Fatalf("call with client ID rejected: %v", err)
+	}
+	if got == nil || got.ClientID != "laptop-1" || got.Credential != CredentialMCPToken {
+		t.Errorf("principal = %+v, want MCP token principal with client ID laptop-1", got)
+	}
+	if got != nil && (got.TokenID != token || got.TokenName != "test-token") {
+		t.Errorf("principal token = %v %q, want the validated token", got.TokenID, got.TokenName)
+	}
+	if got != nil && !got.HasScope(ScopeTasksWrite) {
+		t.Errorf("principal scopes = %v, want the MCP token scopes", got.Scopes)
+	}
+
+	md.Set(ClientIDHeader, strings.Repeat("x", MaxClientIDLength+1))
+	_, err := interceptor(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("overlong client ID error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestUnaryServerInterceptorWithMCP_ValidatorFailure(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"rejected token", ErrMCPTokenRejected, codes.Unauthenticated},
+		{"validator failure", errors.New("database unavailable"), codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := &stubMCPValidator{err: tt.err}
+			interceptor := UnaryServerInterceptorWithMCP(nil, validator, NewPublicMethods(DefaultPublicMethods))
+
+			md := metadata.New(map[string]string{"authorization": "MCP-Token " + uuid.NewString()})
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/task.v1.TaskService/CreateTask"}, mockHandler)
+			if code := status.Code(err); code != tt.want {
+				t.Errorf("code = %v, want %v", code, tt.want)
+			}
+		})
+	}
+}
@@ -31,6 +31,15 @@ type Claims struct {
 	jwt.RegisteredClaims
 	Type   string `json:"typ,omitempty"`     // Token type: "access" or "refresh"
 	UserID string `json:"user_id,omitempty"` // User ID (Identra user_id)
+	Scope  string `json:"scope,omitempty"`   // Space-separated OAuth scopes, if the token is scoped
+}
+
+// Scopes returns the token's scopes, or AllScopes when it is not scoped
+func (c *Claims) Scopes() []string {
+	if strings.TrimSpace(c.Scope) == "" {
+		return AllScopes
+	}
+	return strings.Fields(c.Scope)
 }
 
 // JWTValidator validates Identra JWTs using JWKS
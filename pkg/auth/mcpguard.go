@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MCPTokenBlockedError is returned by MCPTokenGuard while the source of a
+// request is blocked after too many failed validations
+type MCPTokenBlockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *MCPTokenBlockedError) Error() string {
+	return fmt.Sprintf("too many failed MCP token attempts, retry in %s", e.RetryAfter.Round(time.Second))
+}
+
+// maxTrackedSources is the number of failure records kept before stale ones
+// are dropped
+const maxTrackedSources = 100000
+
+// mcpTokenPrefixLength is the number of leading token characters whose
+// failures are tracked together, catching guesses spread over many IPs
+// around a partially known token
+const mcpTokenPrefixLength = 8
+
+// MCPGuardOptions controls how MCPTokenGuard slows down and blocks sources
+// of failed validations
+type MCPGuardOptions struct {
+	// FreeAttempts is the number of failures a source may have before
+	// validations are delayed
+	FreeAttempts int
+	// BaseDelay is the delay after FreeAttempts failures; it doubles with
+	// every further failure up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// BlockAfter failures block the source for BlockDuration; 0 never
+	// blocks
+	BlockAfter    int
+	BlockDuration time.Duration
+	// Window is how long failures are remembered after the last one
+	Window time.Duration
+	// MinFailureTime pads failed validations to at least this long, so the
+	// response time does not tell unknown tokens from revoked ones
+	MinFailureTime time.Duration
+	// SourceIP returns the client IP of a gRPC request, for requests
+	// without one recorded by WithSourceIP. Requests without a client IP
+	// are tracked by token prefix only.
+	SourceIP func(ctx context.Context) (string, bool)
+}
+
+// MCPTokenGuard wraps an MCPTokenValidator with brute-force protection. It
+// counts validations failing with ErrMCPTokenRejected per source IP and per token prefix in process
+// memory, delays validations from sources with repeated failures, blocks
+// them for a while past a threshold and logs audit events for both.
+type MCPTokenGuard struct {
+	validator MCPTokenValidator
+	opts      MCPGuardOptions
+	logger    *slog.Logger
+	now       func() time.Time
+	sleep     func(ctx context.Context, d time.Duration) error
+
+	mu      sync.Mutex
+	sources map[string]*failureRecord
+}
+
+type failureRecord struct {
+	failures     int
+	lastFailure  time.Time
+	blockedUntil time.Time
+}
+
+// NewMCPTokenGuard creates a guard in front of validator
+func NewMCPTokenGuard(validator MCPTokenValidator, opts MCPGuardOptions, logger *slog.Logger) *MCPTokenGuard {
+	return &MCPTokenGuard{
+		validator: validator,
+		opts:      opts,
+		logger:    logger,
+		now:       time.Now,
+		sleep:     sleepContext,
+		sources:   make(map[string]*failureRecord),
+	}
+}
+
+// ValidateToken implements MCPTokenValidator
+func (g *MCPTokenGuard) ValidateToken(ctx context.Context, token uuid.UUID) (*MCPTokenInfo, error) {
+	start := g.now()
+	keys := g.keys(ctx, token)
+
+	failures, retryAfter := g.check(keys, start)
+	if retryAfter > 0 {
+		return nil, &MCPTokenBlockedError{RetryAfter: retryAfter}
+	}
+	if delay := g.delay(failures); delay > 0 {
+		if err := g.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+
+	info, err := g.validator.ValidateToken(ctx, token)
+	if err == nil {
+		return info, nil
+	}
+	// Only rejected tokens are guesses; failures of the validator, such as
+	// an unreachable database, are passed on
+	if ctx.Err() != nil || !errors.Is(err, ErrMCPTokenRejected) {
+		return nil, err
+	}
+
+	g.recordFailure(ctx, keys, err, g.now())
+	if pad := g.opts.MinFailureTime - g.now().Sub(start); pad > 0 {
+		_ = g.sleep(ctx, pad)
+	}
+	return nil, ErrMCPTokenRejected
+}
+
+// keys returns the failure record keys of a validation: the source IP, when
+// known, and the token prefix
+func (g *MCPTokenGuard) keys(ctx context.Context, token uuid.UUID) []string {
+	keys := []string{"prefix:" + token.String()[:mcpTokenPrefixLength]}
+	ip, ok := SourceIPFromContext(ctx)
+	if !ok && g.opts.SourceIP != nil {
+		ip, ok = g.opts.SourceIP(ctx)
+	}
+	if ok {
+		keys = append(keys, "ip:"+ip)
+	}
+	return keys
+}
+
+// check returns the highest failure count among keys and, when one of them
+// is blocked, how long the block lasts
+func (g *MCPTokenGuard) check(keys []string, now time.Time) (int, time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	failures := 0
+	var retryAfter time.Duration
+	for _, key := range keys {
+		record, ok := g.sources[key]
+		if !ok || g.stale(record, now) {
+			continue
+		}
+		failures = max(failures, record.failures)
+		retryAfter = max(retryAfter, record.blockedUntil.Sub(now))
+	}
+	return failures, retryAfter
+}
+
+// delay is the wait before validating for a source with the given number of
+// failures
+func (g *MCPTokenGuard) delay(failures int) time.Duration {
+	excess := failures - g.opts.FreeAttempts
+	if excess < 0 || g.opts.BaseDelay <= 0 {
+		return 0
+	}
+	delay := g.opts.BaseDelay
+	for i := 0; i < excess && delay < g.opts.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, g.opts.MaxDelay)
+}
+
+// recordFailure counts a failed validation against keys, blocks those
+// reaching BlockAfter and logs the audit events
+func (g *MCPTokenGuard) recordFailure(ctx context.Context, keys []string, reason error, now time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.logger.WarnContext(ctx, "audit", "event", "mcp_token.validation_failed", "sources", keys, "reason", reason)
+	for _, key := range keys {
+		record, ok := g.sources[key]
+		if !ok || g.stale(record, now) {
+			if !ok && len(g.sources) >= maxTrackedSources {
+				g.dropStale(now)
+			}
+			record = &failureRecord{}
+			g.sources[key] = record
+		}
+		record.failures++
+		record.lastFailure = now
+		if g.opts.BlockAfter > 0 && record.failures >= g.opts.BlockAfter && !now.Before(record.blockedUntil) {
+			record.blockedUntil = now.Add(g.opts.BlockDuration)
+			g.logger.WarnContext(ctx, "audit", "event", "mcp_token.source_blocked", "source", key,
+				"failures", record.failures, "blocked_until", record.blockedUntil)
+		}
+	}
+}
+
+// stale reports whether a record is neither blocked nor has failed within
+// the window. Callers must hold g.mu.
+func (g *MCPTokenGuard) stale(record *failureRecord, now time.Time) bool {
+	return !now.Before(record.blockedUntil) && now.Sub(record.lastFailure) >= g.opts.Window
+}
+
+// dropStale forgets stale records. Callers must hold g.mu.
+func (g *MCPTokenGuard) dropStale(now time.Time) {
+	for key, record := range g.sources {
+		if g.stale(record, now) {
+			delete(g.sources, key)
+		}
+	}
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// stubMCPValidator accepts only its token and rejects others, or fails
+// with err when set
+type stubMCPValidator struct {
+	valid uuid.UUID
+	err   error
+	calls int
+}
+
+func (v *stubMCPValidator) ValidateToken(ctx context.Context, token uuid.UUID) (*MCPTokenInfo, error) {
+	v.calls++
+	if v.err != nil {
+		return nil, v.err
+	}
+	if token != v.valid {
+		return nil, ErrMCPTokenRejected
+	}
+	return &MCPTokenInfo{UserID: "user-1", TokenID: token}, nil
+}
+
+// newTestGuard returns a guard on a fake clock that records its sleeps
+// instead of waiting
+func newTestGuard(validator MCPTokenValidator, opts MCPGuardOptions) (*MCPTokenGuard, *time.Time, *[]time.Duration) {
+	guard := NewMCPTokenGuard(validator, opts, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	var sleeps []time.Duration
+	guard.now = func() time.Time { return now }
+	guard.sleep = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return nil
+	}
+	return guard, &now, &sleeps
+}
+
+func TestMCPTokenGuard_UniformErrorAndPadding(t *testing.T) {
+	validator := &stubMCPValidator{valid: uuid.New()}
+	guard, _, sleeps := newTestGuard(validator, MCPGuardOptions{FreeAttempts: 5, Window: time.Minute, MinFailureTime: 100 * time.Millisecond})
+
+	if _, err := guard.ValidateToken(context.Background(), uuid.New()); !errors.Is(err, ErrMCPTokenRejected) {
+		t.Fatalf("error = %v, want ErrMCPTokenRejected", err)
+	}
+	if len(*sleeps) != 1 || (*sleeps)[0] != 100*time.Millisecond {
+		t.Errorf("sleeps = %v, want the failure padded to 100ms", *sleeps)
+	}
+
+	info, err := guard.ValidateToken(context.Background(), validator.valid)
+	if err != nil || info.UserID != "user-1" {
+		t.Errorf("valid token: got %+v, %v", info, err)
+	}
+}
+
+func TestMCPTokenGuard_EscalatesAndBlocksPerIP(t *testing.T) {
+	validator := &stubMCPValidator{valid: uuid.New()}
+	guard, now, sleeps := newTestGuard(validator, MCPGuardOptions{
+		FreeAttempts:  2,
+		BaseDelay:     100 * time.Millisecond,
+		MaxDelay:      time.Second,
+		BlockAfter:    5,
+		BlockDuration: 10 * time.Minute,
+		Window:        15 * time.Minute,
+	})
+	ctx := WithSourceIP(context.Background(), "203.0.113.7")
+
+	for i := 0; i < 5; i++ {
+		if _, err := guard.ValidateToken(ctx, uuid.New()); !errors.Is(err, ErrMCPTokenRejected) {
+			t.Fatalf("attempt %d: error = %v, want ErrMCPTokenRejected", i, err)
+		}
+	}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond}
+	if len(*sleeps) != len(want) {
+		t.Fatalf("sleeps = %v, want %v", *sleeps, want)
+	}
+	for i := range want {
+		if (*sleeps)[i] != want[i] {
+			t.Errorf("sleeps = %v, want %v", *sleeps, want)
+		}
+	}
+
+	// Blocked sources are refused without a lookup, even with a valid token
+	calls := validator.calls
+	var blocked *MCPTokenBlockedError
+	if _, err := guard.ValidateToken(ctx, validator.valid); !errors.As(err, &blocked) || blocked.RetryAfter != 10*time.Minute {
+		t.Fatalf("error = %v, want a 10m block", err)
+	}
+	if validator.calls != calls {
+		t.Error("blocked request reached the validator")
+	}
+
+	// Other IPs are unaffected, and the block ends
+	if _, err := guard.ValidateToken(WithSourceIP(context.Background(), "203.0.113.8"), validator.valid); err != nil {
+		t.Errorf("other IP: unexpected error: %v", err)
+	}
+	*now = now.Add(10 * time.Minute)
+	if _, err := guard.ValidateToken(ctx, validator.valid); err != nil {
+		t.Errorf("after the block: unexpected error: %v", err)
+	}
+}
+
+func TestMCPTokenGuard_TracksTokenPrefix(t *testing.T) {
+	validator := &stubMCPValidator{valid: uuid.MustParse("0123abcd-0000-4000-8000-000000000001")}
+	guard, _, _ := newTestGuard(validator, MCPGuardOptions{BlockAfter: 3, BlockDuration: time.Minute, Window: time.Minute})
+
+	// Guesses around a known prefix from different IPs add up
+	for i, ip := range []string{"203.0.113.1", "203.0.113.2", "203.0.113.3"} {
+		guess := uuid.MustParse("0123abcd-0000-4000-8000-00000000010" + string(rune('0'+i)))
+		if _, err := guard.ValidateToken(WithSourceIP(context.Background(), ip), guess); !errors.Is(err, ErrMCPTokenRejected) {
+			t.Fatalf("guess %d: error = %v", i, err)
+		}
+	}
+	var blocked *MCPTokenBlockedError
+	if _, err := guard.ValidateToken(WithSourceIP(context.Background(), "203.0.113.4"), validator.valid); !errors.As(err, &blocked) {
+		t.Errorf("error = %v, want the prefix blocked", err)
+	}
+}
+
+func TestMCPTokenGuard_PassesOnValidatorFailures(t *testing.T) {
+	unavailable := errors.New("database unavailable")
+	validator := &stubMCPValidator{valid: uuid.New(), err: unavailable}
+	guard, _, sleeps := newTestGuard(validator, MCPGuardOptions{BlockAfter: 1, BlockDuration: time.Minute, Window: time.Minute, MinFailureTime: 100 * time.Millisecond})
+
+	ctx := WithSourceIP(context.Background(), "203.0.113.1")
+	for i := range 3 {
+		if _, err := guard.ValidateToken(ctx, validator.valid); !errors.Is(err, unavailable) {
+			t.Fatalf("attempt %d: error = %v, want the validator's error", i, err)
+		}
+	}
+	if len(*sleeps) != 0 {
+		t.Errorf("sleeps = %v, want failures of the validator neither delayed nor padded", *sleeps)
+	}
+
+	validator.err = nil
+	if _, err := guard.ValidateToken(ctx, validator.valid); err != nil {
+		t.Errorf("unexpected error: %v, want failures of the validator not counted", err)
+	}
+}
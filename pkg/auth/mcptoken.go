@@ -9,15 +9,34 @@ import (
 	"github.com/google/uuid"
 )
 
-var (
-	ErrInvalidMCPToken  = errors.New("invalid MCP token format")
-	ErrMCPTokenNotFound = errors.New("MCP token not found")
-)
+var ErrInvalidMCPToken = errors.New("invalid MCP token format")
+
+// ErrMCPTokenRejected is returned by MCPTokenValidator implementations for a
+// token that does not exist, is inactive or is expired. The cases are not
+// told apart, so a guess reveals nothing about existing tokens. Other errors
+// are failures of the validator itself.
+var ErrMCPTokenRejected = errors.New("invalid MCP token")
+
+// MCPTokenInfo describes a validated MCP token
+type MCPTokenInfo struct {
+	UserID  string
+	TokenID uuid.UUID
+	Name    string
+	Limits  MCPTokenLimits
+}
+
+// MCPTokenLimits are the usage limits the user set on an MCP token. Zero
+// means unlimited.
+type MCPTokenLimits struct {
+	RequestsPerMinute   int
+	DailyMutationBudget int
+}
 
 // MCPTokenValidator validates MCP tokens
 type MCPTokenValidator interface {
-	// ValidateToken validates an MCP token and returns the associated user ID
-	ValidateToken(ctx context.Context, token uuid.UUID) (string, error)
+	// ValidateToken validates an MCP token and returns the user it acts for
+	// and the token's ID and name
+	ValidateToken(ctx context.Context, token uuid.UUID) (*MCPTokenInfo, error)
 }
 
 // ExtractMCPToken extracts MCP token from authorization header
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrPermissionDenied is returned when a principal does not satisfy the
+// policy rule of the method it called
+var ErrPermissionDenied = errors.New("permission denied")
+
+// Rule is the authorization requirement of a method. The caller needs at
+// least one of Roles (when set) and every one of Scopes.
+type Rule struct {
+	Roles  []string
+	Scopes []string
+}
+
+// Policy maps methods to rules. Keys are full method names
+// ("/pkg.Service/Method") or service prefixes ending in "/"; a method rule
+// takes precedence over its service's rule. Methods without a rule only
+// require authentication.
+type Policy struct {
+	methods  map[string]Rule
+	services map[string]Rule
+}
+
+// NewPolicy creates a policy from rules keyed by method or service
+func NewPolicy(rules map[string]Rule) *Policy {
+	p := &Policy{
+		methods:  make(map[string]Rule),
+		services: make(map[string]Rule),
+	}
+	for key, rule := range rules {
+		if strings.HasSuffix(key, "/") {
+			p.services[key] = rule
+		} else {
+			p.methods[key] = rule
+		}
+	}
+	return p
+}
+
+// rule returns the rule for fullMethod, if any
+func (p *Policy) rule(fullMethod string) (Rule, bool) {
+	if rule, ok := p.methods[fullMethod]; ok {
+		return rule, true
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		rule, ok := p.services[fullMethod[:i+1]]
+		return rule, ok
+	}
+	return Rule{}, false
+}
+
+// Authorize checks principal against the rule for fullMethod
+func (p *Policy) Authorize(fullMethod string, principal *Principal) error {
+	rule, ok := p.rule(fullMethod)
+	if !ok {
+		return nil
+	}
+
+	if len(rule.Roles) > 0 {
+		var granted bool
+		for _, role := range rule.Roles {
+			if principal.HasRole(role) {
+				granted = true
+				break
+			}
+		}
+		if !granted {
+			return fmt.Errorf("%w: requires role %s", ErrPermissionDenied, strings.Join(rule.Roles, " or "))
+		}
+	}
+	for _, scope := range rule.Scopes {
+		if !principal.HasScope(scope) {
+			return fmt.Errorf("%w: credential lacks scope %s", ErrPermissionDenied, scope)
+		}
+	}
+	return nil
+}
+
+// RoleResolver returns the roles held by a user
+type RoleResolver func(ctx context.Context, userID string) ([]string, error)
+
+// StaticRoles grants role to every user in userIDs
+func StaticRoles(role string, userIDs []string) RoleResolver {
+	members := make(map[string]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		if id != "" {
+			members[id] = struct{}{}
+		}
+	}
+	return func(_ context.Context, userID string) ([]string, error) {
+		if _, ok := members[userID]; ok {
+			return []string{role}, nil
+		}
+		return nil, nil
+	}
+}
+
+// UnaryAuthorizationInterceptor evaluates policy after authentication. It
+// attaches the user's roles to the principal, so handlers can check them
+// with HasRole, and rejects calls that do not satisfy the method's rule.
+// Calls without a principal (public methods) are passed through unless
+// their method has a rule.
+func UnaryAuthorizationInterceptor(policy *Policy, roles RoleResolver) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, err := authorize(ctx, policy, roles, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthorizationInterceptor is the streaming counterpart of
+// UnaryAuthorizationInterceptor
+func StreamAuthorizationInterceptor(policy *Policy, roles RoleResolver) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authorize(ss.Context(), policy, roles, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize resolves the roles of the principal in ctx and checks it against
+// the rule for fullMethod, returning the context to pass to the handler
+func authorize(ctx context.Context, policy *Policy, roles RoleResolver, fullMethod string) (context.Context, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		if _, hasRule := policy.rule(fullMethod); hasRule {
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+		return ctx, nil
+	}
+
+	if roles != nil {
+		granted, err := roles(ctx, principal.UserID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to resolve roles")
+		}
+		// Copy so the authentication layer's principal is not mutated
+		withRoles := *principal
+		withRoles.Roles = granted
+		principal = &withRoles
+		ctx = WithPrincipal(ctx, principal)
+	}
+
+	if err := policy.Authorize(fullMethod, principal); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return ctx, nil
+}
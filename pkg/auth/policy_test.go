@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPolicyAuthorize(t *testing.T) {
+	policy := NewPolicy(map[string]Rule{
+		"/admin.v1.AdminService/":             {Roles: []string{RoleAdmin}},
+		"/admin.v1.AdminService/GetLogLevel":  {Roles: []string{RoleAdmin, "operator"}},
+		"/task.v1.TaskService/ExportTasks":    {Scopes: []string{"tasks:export"}},
+		"/task.v1.TaskService/DeleteAllTasks": {Roles: []string{RoleAdmin}, Scopes: []string{"tasks:write"}},
+		"/task.v1.TaskService/UpdateTask":     {Scopes: []string{ScopeTasksWrite}},
+	})
+
+	admin := &Principal{UserID: "a", Roles: []string{RoleAdmin}}
+	operator := &Principal{UserID: "o", Roles: []string{"operator"}}
+	user := &Principal{UserID: "u"}
+	scoped := &Principal{UserID: "s", Scopes: []string{"tasks:read"}, Roles: []string{RoleAdmin}}
+	agent := &Principal{UserID: "m", Credential: CredentialMCPToken, Scopes: MCPTokenScopes}
+
+	tests := []struct {
+		name      string
+		method    string
+		principal *Principal
+		wantErr   bool
+	}{
+		{"method without rule", "/task.v1.TaskService/ListTasks", user, false},
+		{"service rule grants admin", "/admin.v1.AdminService/ListUsers", admin, false},
+		{"service rule rejects user", "/admin.v1.AdminService/ListUsers", user, true},
+		{"method rule overrides service rule", "/admin.v1.AdminService/GetLogLevel", operator, false},
+		{"service rule still applies to other methods", "/admin.v1.AdminService/ListUsers", operator, true},
+		{"unscoped credential is denied scoped method", "/task.v1.TaskService/ExportTasks", user, true},
+		{"unscoped admin is denied scoped method", "/task.v1.TaskService/DeleteAllTasks", admin, true},
+		{"MCP token lacks scope", "/task.v1.TaskService/ExportTasks", agent, true},
+		{"scoped credential lacks scope", "/task.v1.TaskService/ExportTasks", scoped, true},
+		{"role without scope", "/task.v1.TaskService/DeleteAllTasks", scoped, true},
+		{"MCP token has scope", "/task.v1.TaskService/UpdateTask", agent, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Authorize(tt.method, tt.principal)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Authorize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrPermissionDenied) {
+				t.Errorf("expected ErrPermissionDenied, got %v", err)
+			}
+		})
+	}
+}
+
+func TestUnaryAuthorizationInterceptor(t *testing.T) {
+	policy := NewPolicy(map[string]Rule{
+		"/admin.v1.AdminService/": {Roles: []string{RoleAdmin}},
+	})
+	interceptor := UnaryAuthorizationInterceptor(policy, StaticRoles(RoleAdmin, []string{"admin-1"}))
+	adminInfo := &grpc.UnaryServerInfo{FullMethod: "/admin.v1.AdminService/ListUsers"}
+
+	t.Run("admin is allowed and sees its role", func(t *testing.T) {
+		ctx := WithPrincipal(context.Background(), &Principal{UserID: "admin-1", Credential: CredentialJWT})
+		_, err := interceptor(ctx, nil, adminInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+			if !HasRole(ctx, RoleAdmin) {
+				t.Error("handler context is missing the admin role")
+			}
+			return "success", nil
+		})
+		if err != nil {
+			t.Fatalf("expected admin to be allowed, got %v", err)
+		}
+	})
+
+	t.Run("other users are denied", func(t *testing.T) {
+		ctx := WithPrincipal(context.Background(), &Principal{UserID: "user-1", Credential: CredentialMCPToken})
+		_, err := interceptor(ctx, nil, adminInfo, mockHandler)
+		if status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("unauthenticated call to ruled method", func(t *testing.T) {
+		_, err := interceptor(context.Background(), nil, adminInfo, mockHandler)
+		if status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("expected Unauthenticated, got %v", err)
+		}
+	})
+
+	t.Run("public method without principal passes", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/auth.v1.AuthService/HandleCallback"}
+		if _, err := interceptor(context.Background(), nil, info, mockHandler); err != nil {
+			t.Fatalf("expected public method to pass, got %v", err)
+		}
+	})
+}
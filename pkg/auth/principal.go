@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Credential types a principal can authenticate with
+const (
+	CredentialJWT      = "jwt"
+	CredentialMCPToken = "mcp_token"
+	// CredentialWebhook is a delivery to an inbound webhook, verified with
+	// the webhook's secret; it acts for the webhook's owner
+	CredentialWebhook = "webhook"
+	// CredentialAppPassword is an app password sent by a CalDAV client with
+	// HTTP Basic authentication
+	CredentialAppPassword = "app_password"
+	// CredentialFeedToken is the token in a feed URL; it can only read the
+	// feed's tasks
+	CredentialFeedToken = "feed_token"
+)
+
+// ClientIDHeader is the metadata key clients set to identify the device or
+// app instance making the call, e.g. a per-install UUID
+const ClientIDHeader = "x-client-id"
+
+// MaxClientIDLength is the maximum length of a client ID, in characters
+const MaxClientIDLength = 255
+
+// RoleAdmin is granted to the users listed in auth.admin_user_ids
+const RoleAdmin = "admin"
+
+// Scopes policy rules can require
+const (
+	// ScopeTasksRead reads tasks and what organizes them: tags, saved
+	// filters, checklists and streaks
+	ScopeTasksRead = "tasks:read"
+	// ScopeTasksWrite changes them
+	ScopeTasksWrite = "tasks:write"
+	// ScopeAccount manages the account: the profile and settings,
+	// credentials such as MCP tokens and app passwords, integrations, and
+	// decisions an agent must not make for the user, such as approvals and
+	// transfers
+	ScopeAccount = "account"
+)
+
+// MCPTokenScopes are the scopes of CredentialMCPToken principals: agents
+// read and change the user's tasks. Callers must not modify it.
+var MCPTokenScopes = []string{ScopeTasksRead, ScopeTasksWrite}
+
+// AllScopes are the scopes of JWTs that do not name theirs: the user's own
+// apps may do everything. Callers must not modify it.
+var AllScopes = []string{ScopeTasksRead, ScopeTasksWrite, ScopeAccount}
+
+// Principal is the authenticated caller of an RPC
+type Principal struct {
+	UserID     string
+	Credential string
+	// AccessToken is the raw JWT for CredentialJWT principals, used to call
+	// Identra on the user's behalf
+	AccessToken string
+	// Scopes lists what the credential may be used for. A credential without
+	// scopes is denied every method that requires one.
+	Scopes []string
+	// Roles are granted to the user by the authorization layer, independent
+	// of the credential used
+	Roles []string
+	// ClientID is the device or app instance the caller identified itself
+	// as with ClientIDHeader. It is not authenticated and may be empty.
+	ClientID string
+	// TokenID and TokenName identify the token of CredentialMCPToken
+	// principals, so agent changes can be attributed to it
+	TokenID   uuid.UUID
+	TokenName string
+	// TokenLimits are the usage limits of the token of CredentialMCPToken
+	// principals
+	TokenLimits MCPTokenLimits
+}
+
+// HasScope reports whether the credential grants scope
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+// IsAgent reports whether the principal is an agent acting for the user
+// with an MCP token, so that its changes are attributed to the token
+func (p *Principal) IsAgent() bool {
+	return p.Credential == CredentialMCPToken
+}
+
+// HasRole reports whether the user holds role
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// clientIDFromMetadata returns the client ID sent in md, or "" if none
+func clientIDFromMetadata(md metadata.MD) (string, error) {
+	values := md.Get(ClientIDHeader)
+	if len(values) == 0 {
+		return "", nil
+	}
+	if utf8.RuneCountInString(values[0]) > MaxClientIDLength {
+		return "", status.Errorf(codes.InvalidArgument, "%s must be at most %d characters", ClientIDHeader, MaxClientIDLength)
+	}
+	return values[0], nil
+}
+
+const principalKey contextKey = "principal"
+
+// WithPrincipal adds the principal, and its user ID, to the context
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	ctx = WithUserID(ctx, principal.UserID)
+	return context.WithValue(ctx, principalKey, principal)
+}
+
+// PrincipalFromContext returns the authenticated principal, if any
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey).(*Principal)
+	return principal, ok
+}
+
+// HasRole reports whether the authenticated caller holds role
+func HasRole(ctx context.Context, role string) bool {
+	principal, ok := PrincipalFromContext(ctx)
+	return ok && principal.HasRole(role)
+}
@@ -0,0 +1,88 @@
+// Package buildinfo describes the running binary. The release build sets
+// the variables with -ldflags, e.g.
+//
+//	go build -ldflags "-X github.com/slips-ai/slips-core/pkg/buildinfo.Version=v1.8.0 \
+//	  -X github.com/slips-ai/slips-core/pkg/buildinfo.GitSHA=$(git rev-parse HEAD) \
+//	  -X github.com/slips-ai/slips-core/pkg/buildinfo.BuildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Without them, the commit and its time recorded by the Go toolchain are
+// used when the binary was built from a git checkout.
+package buildinfo
+
+import (
+	"context"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Set with -ldflags -X at build time
+var (
+	// Version is the release version
+	Version = "dev"
+	// GitSHA is the commit the binary was built from
+	GitSHA = ""
+	// BuildDate is the RFC 3339 time of the build
+	BuildDate = ""
+)
+
+// Info is the build information of the running binary
+type Info struct {
+	Version   string
+	GitSHA    string
+	BuildTime time.Time // zero when unknown
+	GoVersion string
+}
+
+// Get returns the build information, falling back to the VCS information
+// embedded by the Go toolchain for values not set at build time
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		GitSHA:    GitSHA,
+		GoVersion: runtime.Version(),
+	}
+	if t, err := time.Parse(time.RFC3339, BuildDate); err == nil {
+		info.BuildTime = t
+	}
+
+	if build, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range build.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.GitSHA == "" {
+					info.GitSHA = setting.Value
+				}
+			case "vcs.time":
+				if t, err := time.Parse(time.RFC3339, setting.Value); err == nil && info.BuildTime.IsZero() {
+					info.BuildTime = t
+				}
+			}
+		}
+	}
+	return info
+}
+
+// RegisterMetric reports info as the build_info gauge of the OpenTelemetry
+// global meter: always 1, with the build as attributes, so dashboards can
+// tell which versions are deployed
+func RegisterMetric(info Info) error {
+	attrs := metric.WithAttributes(
+		attribute.String("version", info.Version),
+		attribute.String("git_sha", info.GitSHA),
+		attribute.String("go_version", info.GoVersion),
+	)
+	_, err := otel.Meter("slips-core").Int64ObservableGauge(
+		"build_info",
+		metric.WithDescription("Build of the running server, as attributes; always 1"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			o.Observe(1, attrs)
+			return nil
+		}),
+	)
+	return err
+}
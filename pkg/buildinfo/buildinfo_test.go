@@ -0,0 +1,33 @@
+package buildinfo
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestGet_UsesLinkerValues(t *testing.T) {
+	defer func(version, sha, date string) { Version, GitSHA, BuildDate = version, sha, date }(Version, GitSHA, BuildDate)
+	Version, GitSHA, BuildDate = "v1.8.0", "3f0cd10abc", "2026-10-16T11:30:00Z"
+
+	info := Get()
+	if info.Version != "v1.8.0" || info.GitSHA != "3f0cd10abc" {
+		t.Errorf("info = %+v, want the linker values", info)
+	}
+	if want := time.Date(2026, 10, 16, 11, 30, 0, 0, time.UTC); !info.BuildTime.Equal(want) {
+		t.Errorf("BuildTime = %s, want %s", info.BuildTime, want)
+	}
+	if info.GoVersion != runtime.Version() {
+		t.Errorf("GoVersion = %q, want %q", info.GoVersion, runtime.Version())
+	}
+}
+
+func TestGet_IgnoresMalformedBuildDate(t *testing.T) {
+	defer func(date string) { BuildDate = date }(BuildDate)
+	BuildDate = "yesterday"
+
+	// Test binaries carry no VCS information, so nothing fills the gap
+	if info := Get(); !info.BuildTime.IsZero() {
+		t.Errorf("BuildTime = %s, want zero", info.BuildTime)
+	}
+}
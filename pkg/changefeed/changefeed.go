@@ -0,0 +1,210 @@
+// Package changefeed delivers notifications about changed resources to the
+// streams watching them, so clients can stay current without polling.
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// SubscriptionBuffer is the number of events a subscription holds for a
+// subscriber that has not received them yet
+const SubscriptionBuffer = 64
+
+var (
+	// ErrOverflow ends a subscription whose subscriber fell more than
+	// SubscriptionBuffer events behind
+	ErrOverflow = errors.New("change feed subscriber fell behind")
+	// ErrClosed ends the subscriptions of a feed that is shutting down
+	ErrClosed = errors.New("change feed closed")
+)
+
+// Resource is the kind of resource an event is about
+type Resource int
+
+const (
+	// ResourceAll is used by resync events covering every kind of resource
+	ResourceAll Resource = iota
+	// ResourceTask is a task
+	ResourceTask
+	// ResourceTag is a tag
+	ResourceTag
+	// ResourceChecklistItem is a checklist item of a task
+	ResourceChecklistItem
+)
+
+// Operation is what happened to the resource
+type Operation int
+
+const (
+	// OperationUpsert means the resource was created or changed
+	OperationUpsert Operation = iota + 1
+	// OperationDelete means the resource was deleted
+	OperationDelete
+	// OperationResync means changes to resources of the kind were not
+	// reported individually, e.g. after a bulk update, so subscribers must
+	// reload them
+	OperationResync
+)
+
+// Event reports a change to one of an owner's resources. It carries IDs
+// only; subscribers load the current state of upserted resources.
+type Event struct {
+	OwnerID   string    `json:"owner_id"`
+	Resource  Resource  `json:"resource"`
+	Operation Operation `json:"operation"`
+	// ID is the changed resource; zero for resync events
+	ID uuid.UUID `json:"id,omitempty"`
+	// TaskID is the task a checklist item belongs to, when known
+	TaskID uuid.UUID `json:"task_id,omitempty"`
+}
+
+// Publisher reports changes after they have been committed. Publishing is
+// best effort: failures are logged by the publisher and never fail the
+// write that caused the event.
+type Publisher interface {
+	Publish(ctx context.Context, event Event)
+}
+
+// Feed is a Publisher that can also be watched
+type Feed interface {
+	Publisher
+	// Subscribe starts delivering the owner's events to a new subscription
+	Subscribe(ownerID string) *Subscription
+}
+
+// Subscription receives the events of one owner
+type Subscription struct {
+	hub     *Hub
+	ownerID string
+	events  chan Event
+	err     error
+}
+
+// Events returns the channel events are delivered on. It is closed when
+// the subscription ends; Err then tells why.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Err returns ErrOverflow or ErrClosed once Events is closed by the feed,
+// and nil while the subscription is active or after Close
+func (s *Subscription) Err() error {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	return s.err
+}
+
+// Close ends the subscription. It is safe to call more than once.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s, nil)
+}
+
+// Hub delivers events to the subscriptions of this process. It is the Feed
+// of a single instance; PostgresFeed uses it to fan out events received
+// from other instances.
+type Hub struct {
+	mu     sync.Mutex
+	subs   map[string]map[*Subscription]struct{}
+	closed bool
+}
+
+// NewHub creates a hub without subscriptions
+func NewHub() *Hub {
+	return &Hub{subs: make(map[string]map[*Subscription]struct{})}
+}
+
+// Publish delivers event to the owner's subscriptions without blocking.
+// A subscription without room for the event is ended with ErrOverflow.
+func (h *Hub) Publish(ctx context.Context, event Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs[event.OwnerID] {
+		h.deliver(sub, event)
+	}
+}
+
+// Resync tells every subscription of resource's kind, across owners, to
+// reload, e.g. after events may have been lost
+func (h *Hub) Resync(resource Resource) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ownerID, subs := range h.subs {
+		for sub := range subs {
+			h.deliver(sub, Event{OwnerID: ownerID, Resource: resource, Operation: OperationResync})
+		}
+	}
+}
+
+// Subscribe starts delivering the owner's events to a new subscription.
+// Subscribing to a closed hub returns a subscription that has already
+// ended with ErrClosed.
+func (h *Hub) Subscribe(ownerID string) *Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := &Subscription{
+		hub:     h,
+		ownerID: ownerID,
+		events:  make(chan Event, SubscriptionBuffer),
+	}
+	if h.closed {
+		sub.err = ErrClosed
+		close(sub.events)
+		return sub
+	}
+	if h.subs[ownerID] == nil {
+		h.subs[ownerID] = make(map[*Subscription]struct{})
+	}
+	h.subs[ownerID][sub] = struct{}{}
+	return sub
+}
+
+// Close ends every subscription with ErrClosed so watch streams finish
+// before the server stops
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for _, subs := range h.subs {
+		for sub := range subs {
+			h.remove(sub, ErrClosed)
+		}
+	}
+}
+
+// deliver queues event on sub, ending it when its buffer is full. Callers
+// must hold h.mu.
+func (h *Hub) deliver(sub *Subscription, event Event) {
+	select {
+	case sub.events <- event:
+	default:
+		h.remove(sub, ErrOverflow)
+	}
+}
+
+// remove ends sub with err unless it has already ended. Callers must hold
+// h.mu.
+func (h *Hub) remove(sub *Subscription, err error) {
+	subs, ok := h.subs[sub.ownerID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.subs, sub.ownerID)
+	}
+	sub.err = err
+	close(sub.events)
+}
@@ -0,0 +1,75 @@
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestHub_DeliversToOwner(t *testing.T) {
+	hub := NewHub()
+	mine := hub.Subscribe("owner")
+	defer mine.Close()
+	other := hub.Subscribe("other")
+	defer other.Close()
+
+	event := Event{OwnerID: "owner", Resource: ResourceTask, Operation: OperationUpsert, ID: uuid.New()}
+	hub.Publish(context.Background(), event)
+
+	select {
+	case got := <-mine.Events():
+		if got != event {
+			t.Errorf("got %+v, want %+v", got, event)
+		}
+	default:
+		t.Fatal("owner's subscription did not receive the event")
+	}
+	select {
+	case got := <-other.Events():
+		t.Errorf("other owner received %+v", got)
+	default:
+	}
+}
+
+func TestHub_OverflowEndsSubscription(t *testing.T) {
+	hub := NewHub()
+	sub := hub.Subscribe("owner")
+
+	for i := 0; i <= SubscriptionBuffer; i++ {
+		hub.Publish(context.Background(), Event{OwnerID: "owner", Resource: ResourceTag, Operation: OperationDelete, ID: uuid.New()})
+	}
+
+	received := 0
+	for range sub.Events() {
+		received++
+	}
+	if received != SubscriptionBuffer {
+		t.Errorf("received %d events before the end, want %d", received, SubscriptionBuffer)
+	}
+	if !errors.Is(sub.Err(), ErrOverflow) {
+		t.Errorf("Err() = %v, want ErrOverflow", sub.Err())
+	}
+	sub.Close() // already ended; must not panic
+}
+
+func TestHub_Close(t *testing.T) {
+	hub := NewHub()
+	sub := hub.Subscribe("owner")
+	hub.Resync(ResourceAll)
+	hub.Close()
+
+	if got := <-sub.Events(); got.Operation != OperationResync || got.OwnerID != "owner" {
+		t.Errorf("got %+v, want a resync event for owner", got)
+	}
+	if _, ok := <-sub.Events(); ok {
+		t.Fatal("events channel still open after Close")
+	}
+	if !errors.Is(sub.Err(), ErrClosed) {
+		t.Errorf("Err() = %v, want ErrClosed", sub.Err())
+	}
+	if late := hub.Subscribe("owner"); !errors.Is(late.Err(), ErrClosed) {
+		t.Errorf("subscription to closed hub Err() = %v, want ErrClosed", late.Err())
+	}
+}
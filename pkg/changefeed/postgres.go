@@ -0,0 +1,102 @@
+package changefeed
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// postgresChannel is the LISTEN/NOTIFY channel events are sent on
+const postgresChannel = "slips_changes"
+
+// listenRetryDelay is the pause before the listener reconnects after an error
+const listenRetryDelay = time.Second
+
+// PostgresFeed shares events between server instances through Postgres
+// LISTEN/NOTIFY, so a watch stream sees changes made through any instance.
+// Events are delivered to local subscriptions by Run.
+type PostgresFeed struct {
+	*Hub
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewPostgresFeed creates a feed that notifies through pool. Run must be
+// started for subscriptions to receive events.
+func NewPostgresFeed(pool *pgxpool.Pool, logger *slog.Logger) *PostgresFeed {
+	return &PostgresFeed{
+		Hub:    NewHub(),
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Publish sends event to every instance listening on the channel,
+// including this one
+func (f *PostgresFeed) Publish(ctx context.Context, event Event) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		f.logger.ErrorContext(ctx, "failed to encode change event", "error", err)
+		return
+	}
+	// The write has already committed, so do not let a cancelled request
+	// drop the notification
+	ctx = context.WithoutCancel(ctx)
+	if _, err := f.pool.Exec(ctx, "SELECT pg_notify($1, $2)", postgresChannel, string(payload)); err != nil {
+		f.logger.ErrorContext(ctx, "failed to publish change event", "resource", event.Resource, "error", err)
+	}
+}
+
+// Run listens for events until ctx is done and delivers them to local
+// subscriptions. It reconnects after errors; since notifications sent
+// while disconnected are lost, subscribers are then told to resync.
+func (f *PostgresFeed) Run(ctx context.Context) {
+	for {
+		err := f.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		f.logger.Warn("change feed listener disconnected; reconnecting", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+// listen holds a dedicated connection listening on the channel until an
+// error occurs
+func (f *PostgresFeed) listen(ctx context.Context) error {
+	pooled, err := f.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// The connection stays subscribed to the channel, so keep it out of the
+	// pool
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+postgresChannel); err != nil {
+		return err
+	}
+	// Anything sent before LISTEN took effect was missed
+	f.Resync(ResourceAll)
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		var event Event
+		if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+			f.logger.Warn("ignoring malformed change event", "error", err)
+			continue
+		}
+		f.Hub.Publish(ctx, event)
+	}
+}
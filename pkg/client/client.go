@@ -0,0 +1,262 @@
+// Package client is the Go SDK for slips-core. It dials the server with the
+// credentials and retry policy the server expects and exposes a typed client
+// for every service:
+//
+//	c, err := client.Dial("slips.example.com:443", client.WithTLS(nil), client.WithMCPToken(token))
+//	if err != nil {
+//		return err
+//	}
+//	defer c.Close()
+//
+//	for task, err := range client.All(ctx, c.Tasks.ListTasks, &taskv1.ListTasksRequest{PageSize: 100}, (*taskv1.ListTasksResponse).GetTasks) {
+//		...
+//	}
+//
+// The package is versioned with the server: pkg/client at tag v1.8.0 speaks
+// the API of server v1.8.0.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	adminv1 "github.com/slips-ai/slips-core/gen/go/admin/v1"
+	approvalv1 "github.com/slips-ai/slips-core/gen/go/approval/v1"
+	authv1 "github.com/slips-ai/slips-core/gen/go/auth/v1"
+	caldavv1 "github.com/slips-ai/slips-core/gen/go/caldav/v1"
+	digestv1 "github.com/slips-ai/slips-core/gen/go/digest/v1"
+	feedv1 "github.com/slips-ai/slips-core/gen/go/feed/v1"
+	mcptokenv1 "github.com/slips-ai/slips-core/gen/go/mcptoken/v1"
+	notificationv1 "github.com/slips-ai/slips-core/gen/go/notification/v1"
+	savedfilterv1 "github.com/slips-ai/slips-core/gen/go/savedfilter/v1"
+	serverv1 "github.com/slips-ai/slips-core/gen/go/server/v1"
+	streakv1 "github.com/slips-ai/slips-core/gen/go/streak/v1"
+	tagv1 "github.com/slips-ai/slips-core/gen/go/tag/v1"
+	taskv1 "github.com/slips-ai/slips-core/gen/go/task/v1"
+	taskv2 "github.com/slips-ai/slips-core/gen/go/task/v2"
+	usagev1 "github.com/slips-ai/slips-core/gen/go/usage/v1"
+	webhookv1 "github.com/slips-ai/slips-core/gen/go/webhook/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// Client holds a connection to slips-core and a client for each service
+// on it. It is safe for concurrent use.
+type Client struct {
+	conn *grpc.ClientConn
+
+	Admin        adminv1.AdminServiceClient
+	Approvals    approvalv1.ApprovalServiceClient
+	Auth         authv1.AuthServiceClient
+	CalDAV       caldavv1.CalDAVServiceClient
+	Digests      digestv1.DigestServiceClient
+	Feeds        feedv1.FeedServiceClient
+	MCPTokens    mcptokenv1.MCPTokenServiceClient
+	Notification notificationv1.NotificationServiceClient
+	SavedFilters savedfilterv1.SavedFilterServiceClient
+	Server       serverv1.ServerServiceClient
+	Streaks      streakv1.StreakServiceClient
+	Tags         tagv1.TagServiceClient
+	Tasks        taskv1.TaskServiceClient
+	TasksV2      taskv2.TaskServiceClient
+	Usage        usagev1.UsageServiceClient
+	Webhooks     webhookv1.WebhookServiceClient
+}
+
+// Option configures Dial
+type Option func(*options)
+
+type options struct {
+	tls         *tls.Config
+	creds       *tokenCredentials
+	retry       RetryPolicy
+	gzip        bool
+	maxRecvSize int
+	dialOpts    []grpc.DialOption
+}
+
+// WithTLS connects using TLS. A nil config uses the system roots.
+func WithTLS(cfg *tls.Config) Option {
+	return func(o *options) {
+		if cfg == nil {
+			cfg = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		o.tls = cfg
+	}
+}
+
+// WithMCPToken authenticates every call with an MCP token
+func WithMCPToken(token string) Option {
+	return func(o *options) {
+		o.creds = &tokenCredentials{scheme: SchemeMCPToken, source: StaticToken(token)}
+	}
+}
+
+// WithAccessToken authenticates every call with a fixed JWT access token.
+// Use WithTokenSource for tokens that are refreshed.
+func WithAccessToken(token string) Option {
+	return WithTokenSource(StaticToken(token))
+}
+
+// WithTokenSource authenticates every call with the JWT access token
+// returned by source, which is called per RPC and may refresh the token
+func WithTokenSource(source TokenSource) Option {
+	return func(o *options) {
+		o.creds = &tokenCredentials{scheme: SchemeBearer, source: source}
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy. The zero RetryPolicy turns
+// retries off.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// WithGzip compresses requests and asks for compressed responses
+func WithGzip() Option {
+	return func(o *options) {
+		o.gzip = true
+	}
+}
+
+// WithMaxResponseSize raises the largest response the client accepts, by
+// default the 64 MiB the server sends at most (server.max_send_msg_size)
+func WithMaxResponseSize(bytes int) Option {
+	return func(o *options) {
+		o.maxRecvSize = bytes
+	}
+}
+
+// WithDialOptions passes further options to grpc.NewClient, e.g.
+// interceptors
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOpts = append(o.dialOpts, opts...)
+	}
+}
+
+// DefaultMaxResponseSize matches the default server.max_send_msg_size
+const DefaultMaxResponseSize = 64 << 20
+
+// Dial creates a client for the server at addr. Like grpc.NewClient it does
+// not connect until the first call.
+func Dial(addr string, opts ...Option) (*Client, error) {
+	o := options{retry: DefaultRetryPolicy, maxRecvSize: DefaultMaxResponseSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	transport := insecure.NewCredentials()
+	if o.tls != nil {
+		transport = credentials.NewTLS(o.tls)
+	}
+	callOpts := []grpc.CallOption{grpc.MaxCallRecvMsgSize(o.maxRecvSize)}
+	if o.gzip {
+		callOpts = append(callOpts, grpc.UseCompressor(gzip.Name))
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transport),
+		grpc.WithDefaultCallOptions(callOpts...),
+	}
+	if o.creds != nil {
+		o.creds.requireTLS = o.tls != nil
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(o.creds))
+	}
+	if o.retry.MaxAttempts > 1 {
+		serviceConfig, err := o.retry.serviceConfig()
+		if err != nil {
+			return nil, err
+		}
+		dialOpts = append(dialOpts, grpc.WithDefaultServiceConfig(serviceConfig))
+	}
+
+	conn, err := grpc.NewClient(addr, append(dialOpts, o.dialOpts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for %s: %w", addr, err)
+	}
+	return New(conn), nil
+}
+
+// New wraps an existing connection, e.g. one shared with other clients.
+// Close closes conn.
+func New(conn *grpc.ClientConn) *Client {
+	return &Client{
+		conn:         conn,
+		Admin:        adminv1.NewAdminServiceClient(conn),
+		Approvals:    approvalv1.NewApprovalServiceClient(conn),
+		Auth:         authv1.NewAuthServiceClient(conn),
+		CalDAV:       caldavv1.NewCalDAVServiceClient(conn),
+		Digests:      digestv1.NewDigestServiceClient(conn),
+		Feeds:        feedv1.NewFeedServiceClient(conn),
+		MCPTokens:    mcptokenv1.NewMCPTokenServiceClient(conn),
+		Notification: notificationv1.NewNotificationServiceClient(conn),
+		SavedFilters: savedfilterv1.NewSavedFilterServiceClient(conn),
+		Server:       serverv1.NewServerServiceClient(conn),
+		Streaks:      streakv1.NewStreakServiceClient(conn),
+		Tags:         tagv1.NewTagServiceClient(conn),
+		Tasks:        taskv1.NewTaskServiceClient(conn),
+		TasksV2:      taskv2.NewTaskServiceClient(conn),
+		Usage:        usagev1.NewUsageServiceClient(conn),
+		Webhooks:     webhookv1.NewWebhookServiceClient(conn),
+	}
+}
+
+// Conn returns the underlying connection
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Close closes the connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Authentication schemes of the authorization metadata
+const (
+	SchemeBearer   = "Bearer"
+	SchemeMCPToken = "MCP-Token"
+)
+
+// TokenSource returns the token to send with a call
+type TokenSource func(ctx context.Context) (string, error)
+
+// StaticToken is a TokenSource that always returns token
+func StaticToken(token string) TokenSource {
+	return func(ctx context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+// AuthorizationHeader formats the authorization metadata value the server
+// expects, e.g. for clients that set metadata themselves
+func AuthorizationHeader(scheme, token string) string {
+	return scheme + " " + token
+}
+
+// tokenCredentials attaches "authorization: <scheme> <token>" to each call
+type tokenCredentials struct {
+	scheme     string
+	source     TokenSource
+	requireTLS bool
+}
+
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := c.source(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s token: %w", c.scheme, err)
+	}
+	if token == "" {
+		return nil, errors.New("empty " + c.scheme + " token")
+	}
+	return map[string]string{"authorization": AuthorizationHeader(c.scheme, token)}, nil
+}
+
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	tagv1 "github.com/slips-ai/slips-core/gen/go/tag/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// tagServer serves ListTags in pages of two over five tags, failing the
+// first failures calls with Unavailable
+type tagServer struct {
+	tagv1.UnimplementedTagServiceServer
+	failures int
+	calls    int
+	auth     []string
+}
+
+func (s *tagServer) ListTags(ctx context.Context, req *tagv1.ListTagsRequest) (*tagv1.ListTagsResponse, error) {
+	s.calls++
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.auth = append(s.auth, md.Get("authorization")...)
+	if s.failures > 0 {
+		s.failures--
+		return nil, status.Error(codes.Unavailable, "server is starting")
+	}
+
+	start, _ := strconv.Atoi(req.PageToken)
+	resp := &tagv1.ListTagsResponse{}
+	for i := start; i < min(start+2, 5); i++ {
+		resp.Tags = append(resp.Tags, &tagv1.Tag{Id: strconv.Itoa(i)})
+	}
+	if start+2 < 5 {
+		resp.NextPageToken = strconv.Itoa(start + 2)
+	}
+	return resp, nil
+}
+
+func dialTest(t *testing.T, srv *tagServer, opts ...Option) *Client {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	tagv1.RegisterTagServiceServer(server, srv)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	opts = append(opts, WithDialOptions(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	})))
+	c, err := Dial("passthrough:///bufnet", opts...)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func TestAll_FollowsPageTokens(t *testing.T) {
+	srv := &tagServer{}
+	c := dialTest(t, srv, WithMCPToken("8c6e0a36-8d4b-4f0e-9b59-1d2c7d3e4f50"))
+
+	req := &tagv1.ListTagsRequest{PageSize: 2}
+	tags, err := Collect(All(context.Background(), c.Tags.ListTags, req, (*tagv1.ListTagsResponse).GetTags))
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	var ids []string
+	for _, tag := range tags {
+		ids = append(ids, tag.Id)
+	}
+	if got := len(ids); got != 5 || ids[0] != "0" || ids[4] != "4" {
+		t.Errorf("ids = %v, want 0 through 4", ids)
+	}
+	if srv.calls != 3 {
+		t.Errorf("calls = %d, want 3 pages", srv.calls)
+	}
+	if req.PageToken != "" {
+		t.Errorf("request page token = %q, want the caller's request unchanged", req.PageToken)
+	}
+	for _, auth := range srv.auth {
+		if auth != "MCP-Token 8c6e0a36-8d4b-4f0e-9b59-1d2c7d3e4f50" {
+			t.Errorf("authorization = %q, want the MCP token", auth)
+		}
+	}
+}
+
+func TestDial_RetriesUnavailable(t *testing.T) {
+	fast := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Codes: []codes.Code{codes.Unavailable}}
+
+	srv := &tagServer{failures: 2}
+	c := dialTest(t, srv, WithRetryPolicy(fast), WithAccessToken("jwt"))
+	if _, err := c.Tags.ListTags(context.Background(), &tagv1.ListTagsRequest{}); err != nil {
+		t.Fatalf("ListTags: %v", err)
+	}
+	if srv.calls != 3 || srv.auth[0] != "Bearer jwt" {
+		t.Errorf("calls = %d with authorization %v, want 3 with the bearer token", srv.calls, srv.auth)
+	}
+
+	srv = &tagServer{failures: 1}
+	c = dialTest(t, srv, WithRetryPolicy(RetryPolicy{}))
+	if _, err := c.Tags.ListTags(context.Background(), &tagv1.ListTagsRequest{}); status.Code(err) != codes.Unavailable {
+		t.Errorf("error = %v without retries, want Unavailable", err)
+	}
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"iter"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// PageRequest is a List request paged with page_token
+type PageRequest interface {
+	proto.Message
+	GetPageToken() string
+}
+
+// PageResponse is a List response carrying next_page_token
+type PageResponse interface {
+	GetNextPageToken() string
+}
+
+// All calls list with req, then with each next_page_token until a response
+// has none, and yields the items extracted from each page by items. The
+// sequence stops after yielding an error. req is not modified.
+//
+//	tags := client.All(ctx, c.Tags.ListTags, &tagv1.ListTagsRequest{PageSize: 200}, (*tagv1.ListTagsResponse).GetTags)
+func All[Req PageRequest, Resp PageResponse, Item any](
+	ctx context.Context,
+	list func(context.Context, Req, ...grpc.CallOption) (Resp, error),
+	req Req,
+	items func(Resp) []Item,
+	opts ...grpc.CallOption,
+) iter.Seq2[Item, error] {
+	return func(yield func(Item, error) bool) {
+		for page, err := range Pages(ctx, list, req, opts...) {
+			if err != nil {
+				var zero Item
+				yield(zero, err)
+				return
+			}
+			for _, item := range items(page) {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Pages is All yielding whole responses, e.g. to read total_size or the
+// groups of each page
+func Pages[Req PageRequest, Resp PageResponse](
+	ctx context.Context,
+	list func(context.Context, Req, ...grpc.CallOption) (Resp, error),
+	req Req,
+	opts ...grpc.CallOption,
+) iter.Seq2[Resp, error] {
+	return func(yield func(Resp, error) bool) {
+		req := proto.Clone(req).(Req)
+		field := req.ProtoReflect().Descriptor().Fields().ByName("page_token")
+		for {
+			resp, err := list(ctx, req, opts...)
+			if err != nil {
+				yield(resp, err)
+				return
+			}
+			if !yield(resp, nil) {
+				return
+			}
+			next := resp.GetNextPageToken()
+			if next == "" {
+				return
+			}
+			req.ProtoReflect().Set(field, protoreflect.ValueOfString(next))
+		}
+	}
+}
+
+// Collect reads every item of seq, stopping at the first error
+func Collect[Item any](seq iter.Seq2[Item, error]) ([]Item, error) {
+	var all []Item
+	for item, err := range seq {
+		if err != nil {
+			return all, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
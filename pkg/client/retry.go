@@ -0,0 +1,80 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// RetryPolicy retries calls that fail with one of Codes, using the gRPC
+// retry support. gRPC caps MaxAttempts at 5.
+type RetryPolicy struct {
+	// MaxAttempts counts the first attempt; below 2 means no retries
+	MaxAttempts int
+	// InitialBackoff is the upper bound of the randomized wait before the
+	// first retry; it grows by Multiplier with every further attempt up to
+	// MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Codes          []codes.Code
+}
+
+// DefaultRetryPolicy retries Unavailable, which the server returns while it
+// is starting or when the connection drops, up to 4 times over about 5
+// seconds. Unavailable from the server means the call was not served, but
+// a call cut off by a dropped connection may have been, so a retried
+// mutation can run twice.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Multiplier:     2,
+	Codes:          []codes.Code{codes.Unavailable},
+}
+
+// serviceConfig returns the gRPC service config applying the policy to
+// every method
+func (p RetryPolicy) serviceConfig() (string, error) {
+	if len(p.Codes) == 0 {
+		return "", errors.New("retry policy: no status codes to retry")
+	}
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	config := map[string]any{
+		"methodConfig": []any{map[string]any{
+			"name": []any{map[string]any{}},
+			"retryPolicy": map[string]any{
+				"maxAttempts":          p.MaxAttempts,
+				"initialBackoff":       seconds(durationOr(p.InitialBackoff, DefaultRetryPolicy.InitialBackoff)),
+				"maxBackoff":           seconds(durationOr(p.MaxBackoff, DefaultRetryPolicy.MaxBackoff)),
+				"backoffMultiplier":    multiplier,
+				"retryableStatusCodes": p.Codes,
+			},
+		}},
+	}
+	b, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("retry policy: %w", err)
+	}
+	return string(b), nil
+}
+
+// seconds formats d as a protobuf JSON duration, e.g. "0.200s"
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3fs", d.Seconds())
+}
+
+// durationOr is d, or fallback when d is not positive
+func durationOr(d, fallback time.Duration) time.Duration {
+	if d <= 0 {
+		return fallback
+	}
+	return d
+}
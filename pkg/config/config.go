@@ -1,25 +1,145 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"log"
+	"net/netip"
+	"net/url"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/slips-ai/slips-core/pkg/secrets"
 	"github.com/spf13/viper"
 )
 
+// Storage backends selectable with the top-level "storage" key
+const (
+	// StoragePostgres persists data in PostgreSQL (default)
+	StoragePostgres = "postgres"
+	// StorageMemory keeps data in process memory; it is lost on shutdown.
+	// Intended for local development and integration tests.
+	StorageMemory = "memory"
+)
+
+// Mail providers selectable with "mail.provider"
+const (
+	// MailProviderSMTP sends through an SMTP relay
+	MailProviderSMTP = "smtp"
+	// MailProviderSES sends with the Amazon SES API
+	MailProviderSES = "ses"
+)
+
 // Config holds the application configuration
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Tracing  TracingConfig  `mapstructure:"tracing"`
-	Auth     AuthConfig     `mapstructure:"auth"`
+	Storage    string           `mapstructure:"storage"`
+	Server     ServerConfig     `mapstructure:"server"`
+	Database   DatabaseConfig   `mapstructure:"database"`
+	Tracing    TracingConfig    `mapstructure:"tracing"`
+	Auth       AuthConfig       `mapstructure:"auth"`
+	Logging    LoggingConfig    `mapstructure:"logging"`
+	Secrets    SecretsConfig    `mapstructure:"secrets"`
+	Encryption EncryptionConfig `mapstructure:"encryption"`
+	Jobs       JobsConfig       `mapstructure:"jobs"`
+	Queue      QueueConfig      `mapstructure:"queue"`
+	Checklists ChecklistsConfig `mapstructure:"checklists"`
+	Webhooks   WebhooksConfig   `mapstructure:"webhooks"`
+	Feeds      FeedsConfig      `mapstructure:"feeds"`
+	Usage      UsageConfig      `mapstructure:"usage"`
+	Transfers  TransfersConfig  `mapstructure:"transfers"`
+	Mail       MailConfig       `mapstructure:"mail"`
+	WebPush    WebPushConfig    `mapstructure:"web_push"`
 }
 
 // ServerConfig holds server configuration
 type ServerConfig struct {
 	GRPCPort int `mapstructure:"grpc_port"`
+	// HTTPPort serves webhook deliveries, automation triggers, CalDAV and
+	// feeds over plain HTTP; 0 disables them
+	HTTPPort int `mapstructure:"http_port"`
+	// UnixSocket, when set, is a socket path served in addition to the TCP
+	// port, for sidecars and local agents
+	UnixSocket string `mapstructure:"unix_socket"`
+	// UnixSocketMode is the octal permission mode of the socket file
+	UnixSocketMode string `mapstructure:"unix_socket_mode"`
+	// Reflection registers the gRPC reflection service used by grpcurl and
+	// similar tools. It defaults to on, except when ENV=production.
+	Reflection bool `mapstructure:"reflection"`
+	// ShutdownTimeout bounds how long shutdown waits for in-flight RPCs and
+	// background workers before cancelling them; 0 waits indefinitely
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
+	// DefaultTimeout bounds unary RPCs sent without a deadline, and
+	// MaxTimeout rejects unary RPCs asking for a longer one; 0 disables
+	// either. Streaming RPCs are not bounded.
+	DefaultTimeout time.Duration `mapstructure:"default_timeout"`
+	MaxTimeout     time.Duration `mapstructure:"max_timeout"`
+
+	// Message size limits in bytes; 0 keeps the gRPC defaults (4 MiB
+	// receive, unlimited send)
+	MaxRecvMsgSize int `mapstructure:"max_recv_msg_size"`
+	MaxSendMsgSize int `mapstructure:"max_send_msg_size"`
+	// MaxConcurrentStreams limits streams per connection; 0 is unlimited
+	MaxConcurrentStreams uint32          `mapstructure:"max_concurrent_streams"`
+	Keepalive            KeepaliveConfig `mapstructure:"keepalive"`
+	// GzipLevel is the compression level of gzip responses, from 1 (fastest)
+	// to 9 (smallest); 0 keeps the gzip default. Responses are compressed
+	// only for clients that send gzip requests.
+	GzipLevel int `mapstructure:"gzip_level"`
+	// RequestSize limits request messages below MaxRecvMsgSize, so
+	// oversized requests get a descriptive error instead of a transport
+	// failure
+	RequestSize RequestSizeConfig `mapstructure:"request_size"`
+}
+
+// RequestSizeConfig holds per-method request message size limits
+type RequestSizeConfig struct {
+	// MaxBytes is the limit of methods without an override; 0 disables it
+	MaxBytes int `mapstructure:"max_bytes"`
+	// Methods overrides MaxBytes for individual methods
+	Methods []MethodSizeLimit `mapstructure:"methods"`
+}
+
+// MethodSizeLimit is the request size limit of one method
+type MethodSizeLimit struct {
+	// Method is a full gRPC method name, e.g. "/task.v1.TaskService/ApplyMutations"
+	Method   string `mapstructure:"method"`
+	MaxBytes int    `mapstructure:"max_bytes"`
+}
+
+// Limits returns the per-method overrides keyed by method name
+func (c RequestSizeConfig) Limits() map[string]int {
+	limits := make(map[string]int, len(c.Methods))
+	for _, m := range c.Methods {
+		limits[m.Method] = m.MaxBytes
+	}
+	return limits
+}
+
+// KeepaliveConfig holds gRPC keepalive and connection age settings. Zero
+// durations keep the gRPC defaults.
+type KeepaliveConfig struct {
+	// Time is how long a connection may be idle before the server pings
+	// the client, and Timeout how long it waits for the ping ack
+	Time    time.Duration `mapstructure:"time"`
+	Timeout time.Duration `mapstructure:"timeout"`
+	// MinTime is the shortest client ping interval tolerated; clients
+	// pinging more often are disconnected
+	MinTime time.Duration `mapstructure:"min_time"`
+	// PermitWithoutStream allows client pings on connections without
+	// active RPCs
+	PermitWithoutStream bool `mapstructure:"permit_without_stream"`
+	// MaxConnectionIdle closes connections without RPCs after this long
+	MaxConnectionIdle time.Duration `mapstructure:"max_connection_idle"`
+	// MaxConnectionAge closes connections after this long, so clients
+	// reconnect and rebalance; MaxConnectionAgeGrace lets in-flight RPCs
+	// finish first
+	MaxConnectionAge      time.Duration `mapstructure:"max_connection_age"`
+	MaxConnectionAgeGrace time.Duration `mapstructure:"max_connection_age_grace"`
 }
 
 // DatabaseConfig holds database configuration
@@ -30,6 +150,44 @@ type DatabaseConfig struct {
 	Password string `mapstructure:"password"`
 	DBName   string `mapstructure:"dbname"`
 	SSLMode  string `mapstructure:"sslmode"`
+	// AutoMigrate applies pending embedded migrations at startup
+	AutoMigrate bool `mapstructure:"auto_migrate"`
+	// Replicas lists postgres:// URLs of read replicas that serve list,
+	// search and stats queries. SLIPS_DATABASE_REPLICAS accepts a
+	// comma-separated list.
+	Replicas []string `mapstructure:"replicas"`
+	// Shards lists postgres:// URLs of further databases that hold user
+	// data. Each owner's tasks, tags, settings and approvals live on one
+	// shard picked by a hash of the owner ID; the primary is shard 0 and
+	// keeps users, tokens and other directory data. Shards cannot be
+	// combined with replicas. SLIPS_DATABASE_SHARDS accepts a
+	// comma-separated list.
+	Shards []string `mapstructure:"shards"`
+	// ShardPlacementTTL is how long an instance caches where a moved owner
+	// lives; moving an owner waits this long before copying their data
+	ShardPlacementTTL time.Duration `mapstructure:"shard_placement_ttl"`
+	// Pool settings apply to the primary and every replica pool.
+	// Zero values keep the pgx defaults.
+	MaxConns          int32         `mapstructure:"max_conns"`
+	MinConns          int32         `mapstructure:"min_conns"`
+	MaxConnLifetime   time.Duration `mapstructure:"max_conn_lifetime"`
+	HealthCheckPeriod time.Duration `mapstructure:"health_check_period"`
+	// ConnectRetries is how many times slipsctl and the migrate command retry
+	// the initial connection to the primary, waiting ConnectBackoff before the
+	// first retry and doubling the wait up to ConnectMaxBackoff. 0 fails on
+	// the first error. The server retries its startup dependencies with the
+	// same backoff for as long as it takes.
+	ConnectRetries    int           `mapstructure:"connect_retries"`
+	ConnectBackoff    time.Duration `mapstructure:"connect_backoff"`
+	ConnectMaxBackoff time.Duration `mapstructure:"connect_max_backoff"`
+	// QueryTimeout bounds every SQL statement; 0 disables it
+	QueryTimeout time.Duration `mapstructure:"query_timeout"`
+	// SlowQueryThreshold logs statements that take at least this long; 0 disables it
+	SlowQueryThreshold time.Duration `mapstructure:"slow_query_threshold"`
+	// RowLevelSecurity sets app.user_id on every connection handed to an
+	// authenticated request so the owner policies on tasks, tags and
+	// checklist items reject rows of other users
+	RowLevelSecurity bool `mapstructure:"row_level_security"`
 }
 
 // TracingConfig holds tracing configuration
@@ -39,43 +197,470 @@ type TracingConfig struct {
 	Endpoint    string `mapstructure:"endpoint"`
 }
 
+// SecretsConfig controls secret manager references (vault:, awssm:, gcpsm:)
+// used in place of credentials such as database.password
+type SecretsConfig struct {
+	// RefreshInterval is how often referenced secrets are re-read so rotated
+	// values are picked up; 0 disables renewal
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+// JobsConfig configures the periodic background jobs run by every instance
+type JobsConfig struct {
+	AutoArchive AutoArchiveJobConfig `mapstructure:"auto_archive"`
+	OrphanTags  OrphanTagsJobConfig  `mapstructure:"orphan_tags"`
+	Digests     DigestsJobConfig     `mapstructure:"digests"`
+	Retention   RetentionJobConfig   `mapstructure:"retention"`
+}
+
+// QueueConfig configures the durable work queue whose jobs, such as
+// queued webhook deliveries, are shared by every instance
+type QueueConfig struct {
+	// Workers is the number of jobs an instance runs at once; 0 leaves the
+	// jobs to other instances
+	Workers int `mapstructure:"workers"`
+	// PollInterval is how often idle workers look for due jobs
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Lease bounds one attempt; a job whose worker does not finish within
+	// it is run again
+	Lease time.Duration `mapstructure:"lease"`
+	// MaxAttempts is the number of attempts before a job is kept as a dead
+	// letter for slipsctl queue to inspect and requeue
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// Backoff is the wait before the first retry, doubled for every further
+	// one up to MaxBackoff
+	Backoff    time.Duration `mapstructure:"backoff"`
+	MaxBackoff time.Duration `mapstructure:"max_backoff"`
+}
+
+// AutoArchiveJobConfig configures the job that archives completed tasks of
+// users who enabled auto-archiving in their task settings
+type AutoArchiveJobConfig struct {
+	// Interval is how often the job runs; 0 disables it
+	Interval time.Duration `mapstructure:"interval"`
+	// DryRun only logs how many tasks would be archived
+	DryRun bool `mapstructure:"dry_run"`
+}
+
+// OrphanTagsJobConfig configures the job that deletes tags no longer used by
+// any task according to each user's orphan cleanup setting
+type OrphanTagsJobConfig struct {
+	// Interval is how often the job runs; 0 disables it and orphan tags
+	// are kept
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// DigestsJobConfig configures the job that emails digests to users who
+// turned them on. It only runs when mail.provider is set.
+type DigestsJobConfig struct {
+	// Interval is how often the job looks for digests due; 0 disables it.
+	// Digests go out up to one interval after their scheduled hour.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// RetentionJobConfig configures the job that purges data older than the
+// deployment's retention periods
+type RetentionJobConfig struct {
+	// Interval is how often the job runs; 0 disables it and everything is
+	// kept
+	Interval time.Duration `mapstructure:"interval"`
+	// TaskTombstones is how long records of deleted tasks are kept for
+	// incremental sync; 0 keeps them forever. Clients that have not synced
+	// for longer have to sync in full.
+	TaskTombstones time.Duration `mapstructure:"task_tombstones"`
+	// APIUsage is how long the per-user daily request counts are kept; 0
+	// keeps them forever
+	APIUsage time.Duration `mapstructure:"api_usage"`
+}
+
+// EncryptionConfig configures envelope encryption of user secrets at rest.
+// With no keys, user secrets are stored in plaintext.
+type EncryptionConfig struct {
+	// PrimaryKey is the ID of the key new values are encrypted with; it
+	// defaults to the only key when a single one is configured
+	PrimaryKey string `mapstructure:"primary_key"`
+	// Keys lists every key that may still be needed for decryption
+	Keys []EncryptionKeyConfig `mapstructure:"keys"`
+	// TaskNotes encrypts task notes with a per-user data key wrapped by the
+	// primary key. Server-side search no longer matches note contents.
+	TaskNotes bool `mapstructure:"task_notes"`
+}
+
+// EncryptionKeyConfig is a key encryption key; exactly one of Local and
+// AWSKMS is set
+type EncryptionKeyConfig struct {
+	ID string `mapstructure:"id"`
+	// Local is a base64 encoded 32-byte AES key, usually a secret reference
+	Local string `mapstructure:"local"`
+	// AWSKMS is the ID, ARN or alias of an AWS KMS key
+	AWSKMS string `mapstructure:"aws_kms"`
+}
+
+// Enabled reports whether user secrets are encrypted
+func (c *EncryptionConfig) Enabled() bool {
+	return len(c.Keys) > 0
+}
+
+// ChecklistsConfig bounds task checklists, so a single task cannot grow
+// large enough to slow down loading it
+type ChecklistsConfig struct {
+	// MaxItems is the number of checklist items a task may have
+	MaxItems int `mapstructure:"max_items"`
+	// MaxItemLength bounds the content of each item, in characters
+	MaxItemLength int `mapstructure:"max_item_length"`
+}
+
+// WebhooksConfig configures inbound webhooks, which create tasks from HTTP
+// requests sent by automation tools
+type WebhooksConfig struct {
+	// BaseURL is the public URL of server.http_port, e.g.
+	// https://hooks.example.com, used to build the URLs shown to users
+	BaseURL string `mapstructure:"base_url"`
+	// RateLimit is the number of deliveries per minute each webhook
+	// accepts on average, and RateBurst how many it accepts at once
+	RateLimit int `mapstructure:"rate_limit"`
+	RateBurst int `mapstructure:"rate_burst"`
+	// MaxBodySize bounds delivery payloads, in bytes
+	MaxBodySize int64 `mapstructure:"max_body_size"`
+	// Async queues deliveries once they are authenticated and validated
+	// and answers 202 Accepted; the task is created by a queue worker,
+	// retried on failure
+	Async bool `mapstructure:"async"`
+}
+
+// FeedsConfig configures RSS and JSON feeds of recent task changes
+type FeedsConfig struct {
+	// BaseURL is the public URL of server.http_port, used to build the feed
+	// URLs shown to users
+	BaseURL string `mapstructure:"base_url"`
+	// MaxItems is the number of tasks in a feed, and Window how far back
+	// their changes go
+	MaxItems int           `mapstructure:"max_items"`
+	Window   time.Duration `mapstructure:"window"`
+}
+
+// UsageConfig configures the per-user request counts served by
+// UsageService.GetMyUsage
+type UsageConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// FlushInterval is how often an instance adds the calls it counted to
+	// the stored counts, and so how far GetMyUsage lags behind
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+}
+
+// TransfersConfig configures handing tasks to other users. Operators can
+// always transfer tasks through AdminService.TransferOwnership.
+type TransfersConfig struct {
+	// AllowUsers lets users give their own tasks to any other user with
+	// TaskService.TransferTasks
+	AllowUsers bool `mapstructure:"allow_users"`
+}
+
+// MailConfig configures outgoing email. With no provider, features that
+// send email are disabled.
+type MailConfig struct {
+	// Provider is "smtp" or "ses"
+	Provider string `mapstructure:"provider"`
+	// From is the sender, e.g. "Slips <digest@example.com>"
+	From string     `mapstructure:"from"`
+	SMTP SMTPConfig `mapstructure:"smtp"`
+	SES  SESConfig  `mapstructure:"ses"`
+}
+
+// SMTPConfig configures delivery through an SMTP relay
+type SMTPConfig struct {
+	Host string `mapstructure:"host"`
+	Port int    `mapstructure:"port"`
+	// Username and Password authenticate over TLS; leave the username empty
+	// for relays that need no authentication. Password may be a secret
+	// reference.
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	// ImplicitTLS connects with TLS from the start (port 465) instead of
+	// upgrading with STARTTLS
+	ImplicitTLS bool `mapstructure:"implicit_tls"`
+}
+
+// SESConfig configures delivery with Amazon SES, using the default AWS
+// credential chain
+type SESConfig struct {
+	// Region overrides the default AWS region
+	Region string `mapstructure:"region"`
+}
+
+// WebPushConfig configures Web Push notifications for the web client. With
+// no key, browsers cannot subscribe.
+type WebPushConfig struct {
+	// VAPIDPrivateKey is the base64url encoded P-256 private key push
+	// requests are signed with; it may be a secret reference. Changing it
+	// invalidates every existing subscription.
+	VAPIDPrivateKey string `mapstructure:"vapid_private_key"`
+	// Subject is a mailto: or https: URL push services can use to contact
+	// the operator
+	Subject string `mapstructure:"subject"`
+}
+
+// LoggingConfig holds logging configuration
+type LoggingConfig struct {
+	AccessLog AccessLogConfig `mapstructure:"access_log"`
+}
+
+// AccessLogConfig controls the per-RPC access log
+type AccessLogConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// SampleRate is the fraction of successful RPCs logged (0-1); failed
+	// RPCs are always logged
+	SampleRate float64 `mapstructure:"sample_rate"`
+}
+
 // AuthConfig holds authentication configuration
 type AuthConfig struct {
 	IdentraGRPCEndpoint string      `mapstructure:"identra_grpc_endpoint"`
 	ExpectedIssuer      string      `mapstructure:"expected_issuer"`
 	OAuth               OAuthConfig `mapstructure:"oauth"`
+	// AdminUserIDs lists the user IDs allowed to call AdminService.
+	// SLIPS_AUTH_ADMIN_USER_IDS accepts a comma-separated list.
+	AdminUserIDs []string `mapstructure:"admin_user_ids"`
+	// PublicMethods lists extra gRPC methods ("/pkg.Service/Method") or
+	// services ("/pkg.Service/") served without authentication, on top of
+	// the login flow, token refresh and health checks
+	PublicMethods []string `mapstructure:"public_methods"`
+	// RequireAuthForRefresh removes RefreshToken from the public methods
+	RequireAuthForRefresh bool `mapstructure:"require_auth_for_refresh"`
+	// ProfileRefreshInterval re-syncs a profile from Identra when
+	// GetUserProfile finds it older than this; zero disables it
+	ProfileRefreshInterval time.Duration `mapstructure:"profile_refresh_interval"`
+	// RateLimit throttles the unauthenticated AuthService methods per
+	// client IP
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+	// MCPTokenGuard slows down and blocks sources of failed MCP token
+	// validations
+	MCPTokenGuard MCPTokenGuardConfig `mapstructure:"mcp_token_guard"`
+	// ProfileCache keeps GetUserProfile responses in process memory
+	ProfileCache ProfileCacheConfig `mapstructure:"profile_cache"`
+}
+
+// ProfileCacheConfig holds the per-user cache of GetUserProfile. Writes
+// through this instance invalidate its entry at once; other instances serve
+// their copy for up to TTL.
+type ProfileCacheConfig struct {
+	Enabled    bool          `mapstructure:"enabled"`
+	TTL        time.Duration `mapstructure:"ttl"`
+	MaxEntries int           `mapstructure:"max_entries"`
+}
+
+// MCPTokenGuardConfig holds the brute-force protection of MCP tokens.
+// Failures are counted per client IP and per token prefix, in process
+// memory.
+type MCPTokenGuardConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// FreeAttempts failures pass without delay; after that validations
+	// wait BaseDelay, doubling per failure up to MaxDelay
+	FreeAttempts int           `mapstructure:"free_attempts"`
+	BaseDelay    time.Duration `mapstructure:"base_delay"`
+	MaxDelay     time.Duration `mapstructure:"max_delay"`
+	// BlockAfter failures block the source for BlockDuration; 0 never
+	// blocks
+	BlockAfter    int           `mapstructure:"block_after"`
+	BlockDuration time.Duration `mapstructure:"block_duration"`
+	// Window is how long failures are remembered after the last one
+	Window time.Duration `mapstructure:"window"`
+	// MinFailureTime pads failed validations so their timing is uniform
+	MinFailureTime time.Duration `mapstructure:"min_failure_time"`
+}
+
+// RateLimitConfig holds the per-IP limits of the public auth methods
+type RateLimitConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Requests is the number of calls of each method one IP may make per
+	// Window
+	Requests int           `mapstructure:"requests"`
+	Window   time.Duration `mapstructure:"window"`
+	// Store is "memory", limiting each instance separately, or "redis",
+	// sharing the counters of all instances through RedisAddr
+	Store     string `mapstructure:"store"`
+	RedisAddr string `mapstructure:"redis_addr"`
+	// RedisUsername selects an ACL user; empty authenticates as the default
+	// user with RedisPassword alone
+	RedisUsername string `mapstructure:"redis_username"`
+	// RedisPassword may be a secret reference
+	RedisPassword string `mapstructure:"redis_password"`
+	// RedisDB is the logical database holding the counters
+	RedisDB int `mapstructure:"redis_db"`
+	// RedisTLS connects with TLS, verifying the server against the system
+	// roots or the PEM certificates in RedisCAFile
+	RedisTLS    bool   `mapstructure:"redis_tls"`
+	RedisCAFile string `mapstructure:"redis_ca_file"`
+	// BanList rejects IPs or CIDR ranges outright; AllowList exempts them
+	// from the limit
+	BanList   []string `mapstructure:"ban_list"`
+	AllowList []string `mapstructure:"allow_list"`
+	// TrustedProxies are the IPs or CIDR ranges of load balancers whose
+	// x-forwarded-for header is used as the client IP
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
 }
 
 // OAuthConfig holds OAuth-specific configuration
 type OAuthConfig struct {
 	Provider    string `mapstructure:"provider"`
 	RedirectURL string `mapstructure:"redirect_url"`
+	// StateTTL is how long a browser login may take between
+	// GetAuthorizationURL and HandleCallback
+	StateTTL time.Duration    `mapstructure:"state_ttl"`
+	Device   DeviceFlowConfig `mapstructure:"device"`
 }
 
-// Load loads configuration from file and environment
+// DeviceFlowConfig configures the OAuth device authorization flow used by
+// clients without a browser (CLI, TV)
+type DeviceFlowConfig struct {
+	// VerificationURL is the web page where users enter the user code
+	VerificationURL string `mapstructure:"verification_url"`
+	// CodeTTL is how long device and user codes stay valid
+	CodeTTL time.Duration `mapstructure:"code_ttl"`
+	// PollInterval is the minimum time devices must wait between polls
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+}
+
+// OverlayPath returns the profile overlay of configPath for env, e.g.
+// config.staging.yaml for config.yaml and "staging"
+func OverlayPath(configPath, env string) string {
+	ext := filepath.Ext(configPath)
+	return strings.TrimSuffix(configPath, ext) + "." + env + ext
+}
+
+// Load loads configuration from file and environment. When the ENV
+// environment variable names a profile and configPath has an overlay for it
+// (see OverlayPath), the overlay is merged over the file: its keys replace
+// the file's and the rest are kept. Environment variables override both.
 func Load(configPath string) (*Config, error) {
 	v := viper.New()
 
 	// Set defaults
+	v.SetDefault("storage", StoragePostgres)
 	v.SetDefault("server.grpc_port", 9090)
+	v.SetDefault("server.http_port", 0)
+	v.SetDefault("server.unix_socket", "")
+	v.SetDefault("server.unix_socket_mode", "0660")
+	v.SetDefault("server.reflection", os.Getenv("ENV") != "production")
+	v.SetDefault("server.shutdown_timeout", "30s")
+	v.SetDefault("server.default_timeout", "30s")
+	v.SetDefault("server.max_timeout", "5m")
+	v.SetDefault("server.max_recv_msg_size", 16<<20)
+	v.SetDefault("server.max_send_msg_size", 64<<20)
+	v.SetDefault("server.max_concurrent_streams", 0)
+	v.SetDefault("server.gzip_level", 0)
+	v.SetDefault("server.request_size.max_bytes", 2<<20)
+	v.SetDefault("server.keepalive.time", "1m")
+	v.SetDefault("server.keepalive.timeout", "20s")
+	v.SetDefault("server.keepalive.min_time", "10s")
+	v.SetDefault("server.keepalive.permit_without_stream", true)
+	v.SetDefault("server.keepalive.max_connection_idle", 0)
+	v.SetDefault("server.keepalive.max_connection_age", 0)
+	v.SetDefault("server.keepalive.max_connection_age_grace", 0)
 	v.SetDefault("database.host", "localhost")
 	v.SetDefault("database.port", 5432)
 	v.SetDefault("database.user", "postgres")
 	v.SetDefault("database.password", "postgres")
 	v.SetDefault("database.dbname", "slips")
 	v.SetDefault("database.sslmode", "disable")
+	v.SetDefault("database.auto_migrate", false)
+	v.SetDefault("database.max_conns", 20)
+	v.SetDefault("database.min_conns", 2)
+	v.SetDefault("database.max_conn_lifetime", "1h")
+	v.SetDefault("database.health_check_period", "30s")
+	v.SetDefault("database.connect_retries", 10)
+	v.SetDefault("database.connect_backoff", "1s")
+	v.SetDefault("database.connect_max_backoff", "30s")
+	v.SetDefault("database.query_timeout", "10s")
+	v.SetDefault("database.slow_query_threshold", "500ms")
+	v.SetDefault("database.row_level_security", false)
+	v.SetDefault("database.shard_placement_ttl", "30s")
+	v.SetDefault("logging.access_log.enabled", false)
+	v.SetDefault("logging.access_log.sample_rate", 1.0)
+	v.SetDefault("secrets.refresh_interval", "5m")
+	v.SetDefault("encryption.task_notes", false)
+	v.SetDefault("jobs.auto_archive.interval", "1h")
+	v.SetDefault("jobs.auto_archive.dry_run", false)
+	v.SetDefault("jobs.orphan_tags.interval", "10m")
+	v.SetDefault("jobs.digests.interval", "5m")
+	v.SetDefault("jobs.retention.interval", "1h")
+	v.SetDefault("jobs.retention.task_tombstones", "0")
+	v.SetDefault("jobs.retention.api_usage", "0")
+	v.SetDefault("queue.workers", 4)
+	v.SetDefault("queue.poll_interval", "1s")
+	v.SetDefault("queue.lease", "5m")
+	v.SetDefault("queue.max_attempts", 8)
+	v.SetDefault("queue.backoff", "10s")
+	v.SetDefault("queue.max_backoff", "1h")
+	v.SetDefault("checklists.max_items", 200)
+	v.SetDefault("checklists.max_item_length", 1000)
+	v.SetDefault("webhooks.base_url", "")
+	v.SetDefault("webhooks.rate_limit", 30)
+	v.SetDefault("webhooks.rate_burst", 10)
+	v.SetDefault("webhooks.max_body_size", 64<<10)
+	v.SetDefault("webhooks.async", false)
+	v.SetDefault("feeds.base_url", "")
+	v.SetDefault("feeds.max_items", 50)
+	v.SetDefault("feeds.window", "720h")
+	v.SetDefault("usage.enabled", true)
+	v.SetDefault("usage.flush_interval", "1m")
+	v.SetDefault("transfers.allow_users", false)
+	v.SetDefault("mail.provider", "")
+	v.SetDefault("mail.from", "")
+	v.SetDefault("mail.smtp.host", "")
+	v.SetDefault("mail.smtp.port", 587)
+	v.SetDefault("mail.smtp.username", "")
+	v.SetDefault("mail.smtp.password", "")
+	v.SetDefault("mail.smtp.implicit_tls", false)
+	v.SetDefault("mail.ses.region", "")
+	v.SetDefault("web_push.vapid_private_key", "")
+	v.SetDefault("web_push.subject", "")
 	v.SetDefault("tracing.enabled", true)
 	v.SetDefault("tracing.service_name", "slips-core")
 	v.SetDefault("tracing.endpoint", "localhost:4317")
 	v.SetDefault("auth.identra_grpc_endpoint", "localhost:8080")
 	v.SetDefault("auth.expected_issuer", "identra")
+	v.SetDefault("auth.profile_refresh_interval", "0s")
+	v.SetDefault("auth.oauth.state_ttl", "10m")
+	v.SetDefault("auth.oauth.device.code_ttl", "10m")
+	v.SetDefault("auth.oauth.device.poll_interval", "5s")
+	v.SetDefault("auth.rate_limit.enabled", true)
+	v.SetDefault("auth.rate_limit.requests", 20)
+	v.SetDefault("auth.rate_limit.window", "1m")
+	v.SetDefault("auth.rate_limit.store", "memory")
+	v.SetDefault("auth.mcp_token_guard.enabled", true)
+	v.SetDefault("auth.mcp_token_guard.free_attempts", 5)
+	v.SetDefault("auth.mcp_token_guard.base_delay", "250ms")
+	v.SetDefault("auth.mcp_token_guard.max_delay", "5s")
+	v.SetDefault("auth.mcp_token_guard.block_after", 20)
+	v.SetDefault("auth.mcp_token_guard.block_duration", "15m")
+	v.SetDefault("auth.mcp_token_guard.window", "15m")
+	v.SetDefault("auth.mcp_token_guard.min_failure_time", "100ms")
+	v.SetDefault("auth.profile_cache.enabled", true)
+	v.SetDefault("auth.profile_cache.ttl", "1m")
+	v.SetDefault("auth.profile_cache.max_entries", 10000)
 
 	// Read from config file if provided
+	var overlay string
 	if configPath != "" {
 		v.SetConfigFile(configPath)
 		if err := v.ReadInConfig(); err != nil {
 			return nil, fmt.Errorf("failed to read config file: %w", err)
 		}
+
+		// Merge the overlay of the environment's profile, if there is one
+		if env := os.Getenv("ENV"); env != "" {
+			path := OverlayPath(configPath, env)
+			if _, err := os.Stat(path); err == nil {
+				v.SetConfigFile(path)
+				if err := v.MergeInConfig(); err != nil {
+					return nil, fmt.Errorf("failed to read config overlay: %w", err)
+				}
+				overlay = path
+			} else if !errors.Is(err, fs.ErrNotExist) {
+				return nil, fmt.Errorf("failed to read config overlay: %w", err)
+			}
+		}
 	}
 
 	// Override with environment variables
@@ -86,17 +671,124 @@ func Load(configPath string) (*Config, error) {
 
 	// Explicitly bind nested config keys to environment variables
 	// This is required for viper to properly handle nested structures
+	_ = v.BindEnv("storage")
 	_ = v.BindEnv("database.password")
 	_ = v.BindEnv("database.host")
 	_ = v.BindEnv("database.port")
 	_ = v.BindEnv("database.user")
 	_ = v.BindEnv("database.dbname")
 	_ = v.BindEnv("database.sslmode")
+	_ = v.BindEnv("database.auto_migrate")
+	_ = v.BindEnv("database.replicas")
+	_ = v.BindEnv("database.shards")
+	_ = v.BindEnv("database.shard_placement_ttl")
+	_ = v.BindEnv("database.max_conns")
+	_ = v.BindEnv("database.min_conns")
+	_ = v.BindEnv("database.max_conn_lifetime")
+	_ = v.BindEnv("database.health_check_period")
+	_ = v.BindEnv("database.connect_retries")
+	_ = v.BindEnv("database.connect_backoff")
+	_ = v.BindEnv("database.connect_max_backoff")
+	_ = v.BindEnv("database.query_timeout")
+	_ = v.BindEnv("database.slow_query_threshold")
+	_ = v.BindEnv("database.row_level_security")
 	_ = v.BindEnv("auth.identra_grpc_endpoint")
 	_ = v.BindEnv("auth.expected_issuer")
+	_ = v.BindEnv("auth.profile_refresh_interval")
 	_ = v.BindEnv("auth.oauth.provider")
 	_ = v.BindEnv("auth.oauth.redirect_url")
+	_ = v.BindEnv("auth.oauth.state_ttl")
+	_ = v.BindEnv("auth.oauth.device.verification_url")
+	_ = v.BindEnv("auth.oauth.device.code_ttl")
+	_ = v.BindEnv("auth.oauth.device.poll_interval")
+	_ = v.BindEnv("auth.admin_user_ids")
+	_ = v.BindEnv("auth.public_methods")
+	_ = v.BindEnv("auth.require_auth_for_refresh")
+	_ = v.BindEnv("auth.rate_limit.enabled")
+	_ = v.BindEnv("auth.rate_limit.requests")
+	_ = v.BindEnv("auth.rate_limit.window")
+	_ = v.BindEnv("auth.rate_limit.store")
+	_ = v.BindEnv("auth.rate_limit.redis_addr")
+	_ = v.BindEnv("auth.rate_limit.redis_username")
+	_ = v.BindEnv("auth.rate_limit.redis_password")
+	_ = v.BindEnv("auth.rate_limit.redis_db")
+	_ = v.BindEnv("auth.rate_limit.redis_tls")
+	_ = v.BindEnv("auth.rate_limit.redis_ca_file")
+	_ = v.BindEnv("auth.rate_limit.ban_list")
+	_ = v.BindEnv("auth.rate_limit.allow_list")
+	_ = v.BindEnv("auth.rate_limit.trusted_proxies")
+	_ = v.BindEnv("auth.mcp_token_guard.enabled")
+	_ = v.BindEnv("auth.mcp_token_guard.free_attempts")
+	_ = v.BindEnv("auth.mcp_token_guard.base_delay")
+	_ = v.BindEnv("auth.mcp_token_guard.max_delay")
+	_ = v.BindEnv("auth.mcp_token_guard.block_after")
+	_ = v.BindEnv("auth.mcp_token_guard.block_duration")
+	_ = v.BindEnv("auth.mcp_token_guard.window")
+	_ = v.BindEnv("auth.mcp_token_guard.min_failure_time")
+	_ = v.BindEnv("auth.profile_cache.enabled")
+	_ = v.BindEnv("auth.profile_cache.ttl")
+	_ = v.BindEnv("auth.profile_cache.max_entries")
 	_ = v.BindEnv("server.grpc_port")
+	_ = v.BindEnv("server.http_port")
+	_ = v.BindEnv("server.unix_socket")
+	_ = v.BindEnv("server.unix_socket_mode")
+	_ = v.BindEnv("server.reflection")
+	_ = v.BindEnv("server.shutdown_timeout")
+	_ = v.BindEnv("server.default_timeout")
+	_ = v.BindEnv("server.max_timeout")
+	_ = v.BindEnv("server.max_recv_msg_size")
+	_ = v.BindEnv("server.max_send_msg_size")
+	_ = v.BindEnv("server.max_concurrent_streams")
+	_ = v.BindEnv("server.gzip_level")
+	_ = v.BindEnv("server.request_size.max_bytes")
+	_ = v.BindEnv("server.keepalive.time")
+	_ = v.BindEnv("server.keepalive.timeout")
+	_ = v.BindEnv("server.keepalive.min_time")
+	_ = v.BindEnv("server.keepalive.permit_without_stream")
+	_ = v.BindEnv("server.keepalive.max_connection_idle")
+	_ = v.BindEnv("server.keepalive.max_connection_age")
+	_ = v.BindEnv("server.keepalive.max_connection_age_grace")
+	_ = v.BindEnv("logging.access_log.enabled")
+	_ = v.BindEnv("logging.access_log.sample_rate")
+	_ = v.BindEnv("secrets.refresh_interval")
+	_ = v.BindEnv("encryption.primary_key")
+	_ = v.BindEnv("encryption.task_notes")
+	_ = v.BindEnv("jobs.auto_archive.interval")
+	_ = v.BindEnv("jobs.auto_archive.dry_run")
+	_ = v.BindEnv("jobs.orphan_tags.interval")
+	_ = v.BindEnv("jobs.digests.interval")
+	_ = v.BindEnv("jobs.retention.interval")
+	_ = v.BindEnv("jobs.retention.task_tombstones")
+	_ = v.BindEnv("jobs.retention.api_usage")
+	_ = v.BindEnv("queue.workers")
+	_ = v.BindEnv("queue.poll_interval")
+	_ = v.BindEnv("queue.lease")
+	_ = v.BindEnv("queue.max_attempts")
+	_ = v.BindEnv("queue.backoff")
+	_ = v.BindEnv("queue.max_backoff")
+	_ = v.BindEnv("checklists.max_items")
+	_ = v.BindEnv("checklists.max_item_length")
+	_ = v.BindEnv("webhooks.base_url")
+	_ = v.BindEnv("webhooks.rate_limit")
+	_ = v.BindEnv("webhooks.rate_burst")
+	_ = v.BindEnv("webhooks.max_body_size")
+	_ = v.BindEnv("webhooks.async")
+	_ = v.BindEnv("feeds.base_url")
+	_ = v.BindEnv("feeds.max_items")
+	_ = v.BindEnv("feeds.window")
+	_ = v.BindEnv("usage.enabled")
+	_ = v.BindEnv("usage.flush_interval")
+	_ = v.BindEnv("transfers.allow_users")
+	_ = v.BindEnv("mail.provider")
+	_ = v.BindEnv("mail.from")
+	_ = v.BindEnv("mail.smtp.host")
+	_ = v.BindEnv("mail.smtp.port")
+	_ = v.BindEnv("mail.smtp.username")
+	_ = v.BindEnv("mail.smtp.password")
+	_ = v.BindEnv("mail.smtp.implicit_tls")
+	_ = v.BindEnv("mail.ses.region")
+	_ = v.BindEnv("web_push.vapid_private_key")
+	_ = v.BindEnv("web_push.subject")
 	_ = v.BindEnv("tracing.enabled")
 	_ = v.BindEnv("tracing.service_name")
 	_ = v.BindEnv("tracing.endpoint")
@@ -106,15 +798,83 @@ func Load(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Log configuration (excluding sensitive data)
+	log.Printf("[CONFIG] File: %s", configPath)
+	if overlay != "" {
+		log.Printf("[CONFIG] Overlay: %s (ENV=%s)", overlay, os.Getenv("ENV"))
+	}
+	log.Printf("[CONFIG] Storage: %s", cfg.Storage)
 	log.Printf("[CONFIG] GRPC Port: %d", cfg.Server.GRPCPort)
+	if cfg.Server.HTTPPort != 0 {
+		log.Printf("[CONFIG] HTTP Port: %d", cfg.Server.HTTPPort)
+	}
+	if cfg.Server.UnixSocket != "" {
+		log.Printf("[CONFIG] GRPC Unix Socket: %s (mode %s)", cfg.Server.UnixSocket, cfg.Server.UnixSocketMode)
+	}
+	log.Printf("[CONFIG] GRPC Reflection: %t", cfg.Server.Reflection)
+	log.Printf("[CONFIG] Shutdown Timeout: %s", cfg.Server.ShutdownTimeout)
+	log.Printf("[CONFIG] RPC Timeouts: default=%s max=%s", cfg.Server.DefaultTimeout, cfg.Server.MaxTimeout)
+	log.Printf("[CONFIG] GRPC Limits: max_recv_msg_size=%d max_send_msg_size=%d max_concurrent_streams=%d",
+		cfg.Server.MaxRecvMsgSize, cfg.Server.MaxSendMsgSize, cfg.Server.MaxConcurrentStreams)
+	log.Printf("[CONFIG] GRPC Gzip Level: %d", cfg.Server.GzipLevel)
+	log.Printf("[CONFIG] GRPC Request Size: max_bytes=%d (%d method overrides)", cfg.Server.RequestSize.MaxBytes, len(cfg.Server.RequestSize.Methods))
+	log.Printf("[CONFIG] GRPC Keepalive: time=%s timeout=%s min_time=%s max_connection_age=%s",
+		cfg.Server.Keepalive.Time, cfg.Server.Keepalive.Timeout, cfg.Server.Keepalive.MinTime, cfg.Server.Keepalive.MaxConnectionAge)
 	log.Printf("[CONFIG] Database Host: %s:%d", cfg.Database.Host, cfg.Database.Port)
 	log.Printf("[CONFIG] Database Name: %s", cfg.Database.DBName)
+	log.Printf("[CONFIG] Database Auto Migrate: %t", cfg.Database.AutoMigrate)
+	log.Printf("[CONFIG] Database Read Replicas: %d", len(cfg.Database.Replicas))
+	log.Printf("[CONFIG] Database Shards: %d", len(cfg.Database.Shards)+1)
+	log.Printf("[CONFIG] Database Pool: max_conns=%d min_conns=%d max_conn_lifetime=%s health_check_period=%s",
+		cfg.Database.MaxConns, cfg.Database.MinConns, cfg.Database.MaxConnLifetime, cfg.Database.HealthCheckPeriod)
+	log.Printf("[CONFIG] Database Connect Retries: %d (backoff %s, max %s)",
+		cfg.Database.ConnectRetries, cfg.Database.ConnectBackoff, cfg.Database.ConnectMaxBackoff)
+	log.Printf("[CONFIG] Database Query Timeout: %s, Slow Query Threshold: %s",
+		cfg.Database.QueryTimeout, cfg.Database.SlowQueryThreshold)
+	log.Printf("[CONFIG] Database Row Level Security: %t", cfg.Database.RowLevelSecurity)
 	log.Printf("[CONFIG] Tracing Enabled: %t", cfg.Tracing.Enabled)
+	log.Printf("[CONFIG] Access Log Enabled: %t (sample rate %g)", cfg.Logging.AccessLog.Enabled, cfg.Logging.AccessLog.SampleRate)
+	log.Printf("[CONFIG] Secrets Refresh Interval: %s", cfg.Secrets.RefreshInterval)
+	log.Printf("[CONFIG] Encryption Enabled: %t (primary key %q, %d keys, task notes %t)", cfg.Encryption.Enabled(), cfg.Encryption.PrimaryKey, len(cfg.Encryption.Keys), cfg.Encryption.TaskNotes)
+	log.Printf("[CONFIG] Auto-Archive Job: interval=%s dry_run=%t", cfg.Jobs.AutoArchive.Interval, cfg.Jobs.AutoArchive.DryRun)
+	log.Printf("[CONFIG] Orphan Tags Job: interval=%s", cfg.Jobs.OrphanTags.Interval)
+	log.Printf("[CONFIG] Digests Job: interval=%s", cfg.Jobs.Digests.Interval)
+	log.Printf("[CONFIG] Retention Job: interval=%s task_tombstones=%s api_usage=%s",
+		cfg.Jobs.Retention.Interval, cfg.Jobs.Retention.TaskTombstones, cfg.Jobs.Retention.APIUsage)
+	log.Printf("[CONFIG] Queue: workers=%d poll_interval=%s lease=%s max_attempts=%d backoff=%s max_backoff=%s",
+		cfg.Queue.Workers, cfg.Queue.PollInterval, cfg.Queue.Lease, cfg.Queue.MaxAttempts, cfg.Queue.Backoff, cfg.Queue.MaxBackoff)
+	log.Printf("[CONFIG] Checklists: max_items=%d max_item_length=%d", cfg.Checklists.MaxItems, cfg.Checklists.MaxItemLength)
+	log.Printf("[CONFIG] Webhooks: base_url=%q rate_limit=%d/min burst=%d max_body_size=%d async=%t",
+		cfg.Webhooks.BaseURL, cfg.Webhooks.RateLimit, cfg.Webhooks.RateBurst, cfg.Webhooks.MaxBodySize, cfg.Webhooks.Async)
+	log.Printf("[CONFIG] Feeds: base_url=%q max_items=%d window=%s", cfg.Feeds.BaseURL, cfg.Feeds.MaxItems, cfg.Feeds.Window)
+	log.Printf("[CONFIG] Usage: enabled=%t flush_interval=%s", cfg.Usage.Enabled, cfg.Usage.FlushInterval)
+	log.Printf("[CONFIG] Transfers: allow_users=%t", cfg.Transfers.AllowUsers)
+	if cfg.Mail.Provider != "" {
+		log.Printf("[CONFIG] Mail: provider=%s from=%q", cfg.Mail.Provider, cfg.Mail.From)
+	}
+	log.Printf("[CONFIG] Web Push Enabled: %t", cfg.WebPush.VAPIDPrivateKey != "")
 	log.Printf("[CONFIG] Auth Identra Endpoint: %s", cfg.Auth.IdentraGRPCEndpoint)
 	log.Printf("[CONFIG] Auth Expected Issuer: %s", cfg.Auth.ExpectedIssuer)
+	log.Printf("[CONFIG] Auth Profile Refresh Interval: %s", cfg.Auth.ProfileRefreshInterval)
 	log.Printf("[CONFIG] OAuth Provider: %s", cfg.Auth.OAuth.Provider)
 	log.Printf("[CONFIG] OAuth Redirect URL: %s", cfg.Auth.OAuth.RedirectURL)
+	log.Printf("[CONFIG] OAuth State TTL: %s", cfg.Auth.OAuth.StateTTL)
+	log.Printf("[CONFIG] OAuth Device Verification URL: %s (code ttl %s, poll interval %s)",
+		cfg.Auth.OAuth.Device.VerificationURL, cfg.Auth.OAuth.Device.CodeTTL, cfg.Auth.OAuth.Device.PollInterval)
+	log.Printf("[CONFIG] Admin Users: %d configured", len(cfg.Auth.AdminUserIDs))
+	log.Printf("[CONFIG] Auth Extra Public Methods: %v (require auth for refresh: %t)", cfg.Auth.PublicMethods, cfg.Auth.RequireAuthForRefresh)
+	log.Printf("[CONFIG] Auth Rate Limit: enabled=%t requests=%d window=%s store=%s banned=%d allowed=%d trusted_proxies=%d",
+		cfg.Auth.RateLimit.Enabled, cfg.Auth.RateLimit.Requests, cfg.Auth.RateLimit.Window, cfg.Auth.RateLimit.Store,
+		len(cfg.Auth.RateLimit.BanList), len(cfg.Auth.RateLimit.AllowList), len(cfg.Auth.RateLimit.TrustedProxies))
+	log.Printf("[CONFIG] MCP Token Guard: enabled=%t free_attempts=%d max_delay=%s block_after=%d block_duration=%s",
+		cfg.Auth.MCPTokenGuard.Enabled, cfg.Auth.MCPTokenGuard.FreeAttempts, cfg.Auth.MCPTokenGuard.MaxDelay,
+		cfg.Auth.MCPTokenGuard.BlockAfter, cfg.Auth.MCPTokenGuard.BlockDuration)
+	log.Printf("[CONFIG] Profile Cache: enabled=%t ttl=%s max_entries=%d",
+		cfg.Auth.ProfileCache.Enabled, cfg.Auth.ProfileCache.TTL, cfg.Auth.ProfileCache.MaxEntries)
 
 	// Also log environment variable status for OAuth redirect URL
 	if envVal := os.Getenv("SLIPS_AUTH_OAUTH_REDIRECT_URL"); envVal != "" {
@@ -126,6 +886,307 @@ func Load(configPath string) (*Config, error) {
 	return &cfg, nil
 }
 
+// ValidationError lists every problem Validate found
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Problems) == 1 {
+		return "invalid config: " + e.Problems[0]
+	}
+	return fmt.Sprintf("invalid config, %d problems:\n  - %s", len(e.Problems), strings.Join(e.Problems, "\n  - "))
+}
+
+// Validate checks required fields, ranges, URL formats and options that
+// cannot be combined, and returns a *ValidationError listing every problem
+// so they can all be fixed at once. It also fills in values derived from
+// other fields, such as the primary encryption key. Load calls it.
+func (c *Config) Validate() error {
+	var problems []string
+	problem := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	switch c.Storage {
+	case StoragePostgres:
+		if c.Database.Host == "" || c.Database.User == "" || c.Database.DBName == "" {
+			problem("database.host, database.user and database.dbname are required with the postgres storage")
+		}
+		if !validPort(c.Database.Port) {
+			problem("database.port must be between 1 and 65535, got %d", c.Database.Port)
+		}
+	case StorageMemory:
+	default:
+		problem("invalid storage %q: expected %q or %q", c.Storage, StoragePostgres, StorageMemory)
+	}
+	switch c.Database.SSLMode {
+	case "disable", "allow", "prefer", "require", "verify-ca", "verify-full":
+	default:
+		problem("database.sslmode must be one of disable, allow, prefer, require, verify-ca and verify-full, got %q", c.Database.SSLMode)
+	}
+
+	if c.Database.MaxConns < 0 || c.Database.MinConns < 0 {
+		problem("database.max_conns and database.min_conns must not be negative")
+	}
+	if c.Database.MaxConns > 0 && c.Database.MinConns > c.Database.MaxConns {
+		problem("database.min_conns (%d) must not exceed database.max_conns (%d)", c.Database.MinConns, c.Database.MaxConns)
+	}
+
+	// The URLs may contain credentials, so only report their position
+	for i, replica := range c.Database.Replicas {
+		if !validDatabaseURL(replica) {
+			problem("database.replicas[%d] must be a postgres:// URL or a secret reference", i)
+		}
+	}
+	for i, shard := range c.Database.Shards {
+		if !validDatabaseURL(shard) {
+			problem("database.shards[%d] must be a postgres:// URL or a secret reference", i)
+		}
+	}
+	if len(c.Database.Shards) > 0 && len(c.Database.Replicas) > 0 {
+		problem("database.shards cannot be combined with database.replicas")
+	}
+	if len(c.Database.Shards) > 0 && c.Database.ShardPlacementTTL <= 0 {
+		problem("database.shard_placement_ttl must be positive")
+	}
+
+	if c.Database.ConnectRetries < 0 {
+		problem("database.connect_retries must not be negative")
+	}
+	if c.Database.ConnectBackoff <= 0 || c.Database.ConnectMaxBackoff < 0 {
+		problem("database.connect_backoff must be positive and database.connect_max_backoff must not be negative")
+	}
+
+	// Malformed references would otherwise only fail when they are resolved
+	for key, value := range map[string]string{
+		"database.password":              c.Database.Password,
+		"mail.smtp.password":             c.Mail.SMTP.Password,
+		"auth.rate_limit.redis_password": c.Auth.RateLimit.RedisPassword,
+		"web_push.vapid_private_key":     c.WebPush.VAPIDPrivateKey,
+	} {
+		if _, _, err := secrets.ParseReference(value); err != nil {
+			problem("%s: %v", key, err)
+		}
+	}
+
+	if err := c.Encryption.validate(); err != nil {
+		problem("%v", err)
+	}
+
+	if c.Auth.IdentraGRPCEndpoint == "" || c.Auth.ExpectedIssuer == "" {
+		problem("auth.identra_grpc_endpoint and auth.expected_issuer are required")
+	}
+
+	if c.Auth.ProfileRefreshInterval < 0 {
+		problem("auth.profile_refresh_interval must not be negative")
+	}
+
+	if c.Auth.OAuth.StateTTL <= 0 {
+		problem("auth.oauth.state_ttl must be positive")
+	}
+
+	if c.Auth.OAuth.Device.CodeTTL <= 0 || c.Auth.OAuth.Device.PollInterval <= 0 {
+		problem("auth.oauth.device.code_ttl and auth.oauth.device.poll_interval must be positive")
+	}
+
+	for _, method := range c.Auth.PublicMethods {
+		if !strings.HasPrefix(method, "/") || !strings.Contains(method[1:], "/") {
+			problem("auth.public_methods: %q must be a full method name such as /task.v1.TaskService/ListTasks or a service such as /task.v1.TaskService/", method)
+		}
+	}
+
+	if rl := c.Auth.RateLimit; rl.Enabled {
+		if rl.Requests <= 0 || rl.Window <= 0 {
+			problem("auth.rate_limit.requests and auth.rate_limit.window must be positive")
+		}
+		switch rl.Store {
+		case "memory":
+		case "redis":
+			if rl.RedisAddr == "" {
+				problem("auth.rate_limit.redis_addr is required with the redis store")
+			}
+			if rl.RedisDB < 0 {
+				problem("auth.rate_limit.redis_db must not be negative")
+			}
+			if rl.RedisCAFile != "" && !rl.RedisTLS {
+				problem("auth.rate_limit.redis_ca_file requires auth.rate_limit.redis_tls")
+			}
+		default:
+			problem("auth.rate_limit.store must be memory or redis, got %q", rl.Store)
+		}
+	}
+	for key, list := range map[string][]string{
+		"auth.rate_limit.ban_list":        c.Auth.RateLimit.BanList,
+		"auth.rate_limit.allow_list":      c.Auth.RateLimit.AllowList,
+		"auth.rate_limit.trusted_proxies": c.Auth.RateLimit.TrustedProxies,
+	} {
+		for _, entry := range list {
+			if !validPrefix(entry) {
+				problem("%s: %q must be an IP address or a CIDR range such as 10.0.0.0/8", key, entry)
+			}
+		}
+	}
+
+	if g := c.Auth.MCPTokenGuard; g.Enabled {
+		if g.FreeAttempts < 0 || g.BlockAfter < 0 || g.BaseDelay < 0 || g.MaxDelay < 0 || g.MinFailureTime < 0 {
+			problem("auth.mcp_token_guard limits and delays must not be negative")
+		}
+		if g.Window <= 0 || (g.BlockAfter > 0 && g.BlockDuration <= 0) {
+			problem("auth.mcp_token_guard.window and, with block_after, block_duration must be positive")
+		}
+	}
+	if pc := c.Auth.ProfileCache; pc.Enabled && (pc.TTL <= 0 || pc.MaxEntries <= 0) {
+		problem("auth.profile_cache.ttl and max_entries must be positive")
+	}
+
+	for key, value := range map[string]string{
+		"auth.oauth.redirect_url":            c.Auth.OAuth.RedirectURL,
+		"auth.oauth.device.verification_url": c.Auth.OAuth.Device.VerificationURL,
+		"webhooks.base_url":                  c.Webhooks.BaseURL,
+		"feeds.base_url":                     c.Feeds.BaseURL,
+	} {
+		if value != "" && !validHTTPURL(value) {
+			problem("%s must be an absolute http:// or https:// URL, got %q", key, value)
+		}
+	}
+
+	if !validPort(c.Server.GRPCPort) {
+		problem("server.grpc_port must be between 1 and 65535, got %d", c.Server.GRPCPort)
+	}
+	if c.Server.HTTPPort != 0 && !validPort(c.Server.HTTPPort) {
+		problem("server.http_port must be between 1 and 65535, or 0 to disable HTTP, got %d", c.Server.HTTPPort)
+	}
+	if c.Server.HTTPPort != 0 && c.Server.HTTPPort == c.Server.GRPCPort {
+		problem("server.http_port and server.grpc_port must differ, both are %d", c.Server.GRPCPort)
+	}
+
+	if c.Server.ShutdownTimeout < 0 {
+		problem("server.shutdown_timeout must not be negative")
+	}
+
+	if c.Server.DefaultTimeout < 0 || c.Server.MaxTimeout < 0 {
+		problem("server.default_timeout and server.max_timeout must not be negative")
+	}
+	if c.Server.MaxTimeout > 0 && c.Server.DefaultTimeout > c.Server.MaxTimeout {
+		problem("server.default_timeout (%s) must not exceed server.max_timeout (%s)", c.Server.DefaultTimeout, c.Server.MaxTimeout)
+	}
+
+	if _, err := c.Server.SocketFileMode(); err != nil {
+		problem("%v", err)
+	}
+
+	if c.Server.MaxRecvMsgSize < 0 || c.Server.MaxSendMsgSize < 0 {
+		problem("server.max_recv_msg_size and server.max_send_msg_size must not be negative")
+	}
+
+	if c.Server.GzipLevel < 0 || c.Server.GzipLevel > 9 {
+		problem("server.gzip_level must be between 0 and 9")
+	}
+
+	if c.Server.RequestSize.MaxBytes < 0 {
+		problem("server.request_size.max_bytes must not be negative")
+	}
+	for _, m := range c.Server.RequestSize.Methods {
+		if !strings.HasPrefix(m.Method, "/") || m.MaxBytes < 0 {
+			problem("server.request_size.methods: %q must be a full method name such as /task.v1.TaskService/ApplyMutations with a non-negative max_bytes", m.Method)
+		}
+	}
+
+	if c.Tracing.Enabled && c.Tracing.Endpoint == "" {
+		problem("tracing.endpoint is required when tracing.enabled is true")
+	}
+
+	if rate := c.Logging.AccessLog.SampleRate; rate < 0 || rate > 1 {
+		problem("logging.access_log.sample_rate must be between 0 and 1, got %g", rate)
+	}
+
+	if c.Jobs.AutoArchive.Interval < 0 || c.Jobs.OrphanTags.Interval < 0 || c.Jobs.Digests.Interval < 0 {
+		problem("jobs.auto_archive.interval, jobs.orphan_tags.interval and jobs.digests.interval must not be negative")
+	}
+	if c.Jobs.Retention.Interval < 0 || c.Jobs.Retention.TaskTombstones < 0 || c.Jobs.Retention.APIUsage < 0 {
+		problem("jobs.retention.interval, jobs.retention.task_tombstones and jobs.retention.api_usage must not be negative")
+	}
+
+	if q := c.Queue; q.Workers < 0 || q.PollInterval <= 0 || q.Lease <= 0 || q.MaxAttempts <= 0 || q.Backoff < 0 || q.MaxBackoff < 0 {
+		problem("queue.poll_interval, queue.lease and queue.max_attempts must be positive and queue.workers, queue.backoff and queue.max_backoff must not be negative")
+	}
+
+	if c.Checklists.MaxItems <= 0 || c.Checklists.MaxItemLength <= 0 {
+		problem("checklists.max_items and checklists.max_item_length must be positive")
+	}
+
+	if c.Webhooks.RateLimit <= 0 || c.Webhooks.RateBurst <= 0 || c.Webhooks.MaxBodySize <= 0 {
+		problem("webhooks.rate_limit, webhooks.rate_burst and webhooks.max_body_size must be positive")
+	}
+
+	if c.Feeds.MaxItems <= 0 || c.Feeds.Window <= 0 {
+		problem("feeds.max_items and feeds.window must be positive")
+	}
+
+	if c.Usage.Enabled && c.Usage.FlushInterval <= 0 {
+		problem("usage.flush_interval must be positive when usage is enabled")
+	}
+
+	switch c.Mail.Provider {
+	case "":
+	case MailProviderSMTP:
+		if c.Mail.SMTP.Host == "" {
+			problem("mail.smtp.host is required for the smtp mail provider")
+		}
+		if !validPort(c.Mail.SMTP.Port) {
+			problem("mail.smtp.port must be between 1 and 65535, got %d", c.Mail.SMTP.Port)
+		}
+	case MailProviderSES:
+	default:
+		problem("invalid mail.provider %q: expected %q or %q", c.Mail.Provider, MailProviderSMTP, MailProviderSES)
+	}
+	if c.Mail.Provider != "" && c.Mail.From == "" {
+		problem("mail.from is required when mail.provider is set")
+	}
+
+	if c.WebPush.VAPIDPrivateKey != "" &&
+		!strings.HasPrefix(c.WebPush.Subject, "mailto:") && !strings.HasPrefix(c.WebPush.Subject, "https://") {
+		problem("web_push.subject must be a mailto: or https: URL when web_push.vapid_private_key is set")
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// validPort reports whether port is a TCP port number
+func validPort(port int) bool {
+	return port >= 1 && port <= 65535
+}
+
+// validHTTPURL reports whether value is an absolute http or https URL
+func validHTTPURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// validDatabaseURL reports whether value is a postgres URL or a secret
+// reference resolved to one at startup
+func validDatabaseURL(value string) bool {
+	if secrets.IsReference(value) {
+		return true
+	}
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "postgres" || u.Scheme == "postgresql") && u.Host != ""
+}
+
+// validPrefix reports whether value is an IP address or CIDR range
+func validPrefix(value string) bool {
+	if _, err := netip.ParsePrefix(value); err == nil {
+		return true
+	}
+	_, err := netip.ParseAddr(value)
+	return err == nil
+}
+
 // DatabaseURL returns the database connection string
 // WARNING: This contains the password in plaintext. Never log or expose this value.
 // Use SafeDatabaseURL() for logging purposes.
@@ -143,3 +1204,47 @@ func (c *DatabaseConfig) SafeDatabaseURL() string {
 		c.User, c.Host, c.Port, c.DBName, c.SSLMode,
 	)
 }
+
+// SocketFileMode parses UnixSocketMode
+func (c *ServerConfig) SocketFileMode() (os.FileMode, error) {
+	mode, err := strconv.ParseUint(c.UnixSocketMode, 8, 32)
+	if err != nil || mode > 0o777 {
+		return 0, fmt.Errorf("server.unix_socket_mode must be an octal permission such as 0660, got %q", c.UnixSocketMode)
+	}
+	return os.FileMode(mode), nil
+}
+
+// validate checks the key list and defaults PrimaryKey to a single key
+func (c *EncryptionConfig) validate() error {
+	if !c.Enabled() {
+		if c.PrimaryKey != "" {
+			return fmt.Errorf("encryption.primary_key %q is set but encryption.keys is empty", c.PrimaryKey)
+		}
+		if c.TaskNotes {
+			return fmt.Errorf("encryption.task_notes requires encryption.keys")
+		}
+		return nil
+	}
+
+	seen := make(map[string]bool, len(c.Keys))
+	for i, key := range c.Keys {
+		if key.ID == "" || strings.Contains(key.ID, ":") {
+			return fmt.Errorf("encryption.keys[%d].id must be set and must not contain ':'", i)
+		}
+		if seen[key.ID] {
+			return fmt.Errorf("encryption.keys[%d].id %q is used twice", i, key.ID)
+		}
+		seen[key.ID] = true
+		if (key.Local == "") == (key.AWSKMS == "") {
+			return fmt.Errorf("encryption.keys[%d] (%s) must set exactly one of local and aws_kms", i, key.ID)
+		}
+	}
+
+	if c.PrimaryKey == "" && len(c.Keys) == 1 {
+		c.PrimaryKey = c.Keys[0].ID
+	}
+	if !seen[c.PrimaryKey] {
+		return fmt.Errorf("encryption.primary_key %q must name one of encryption.keys", c.PrimaryKey)
+	}
+	return nil
+}
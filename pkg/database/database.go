@@ -0,0 +1,332 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/multitracer"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/slips-ai/slips-core/pkg/config"
+	"github.com/slips-ai/slips-core/pkg/secrets"
+)
+
+// Option configures Open
+type Option func(*options)
+
+type options struct {
+	password    func() string
+	sessionUser func(context.Context) string
+	shardOwner  func(context.Context) string
+	deferred    bool
+}
+
+// WithPasswordSource makes new primary connections ask source for the
+// password, so a rotated secret is used without restarting. Existing
+// connections keep working until the pool recycles them.
+func WithPasswordSource(source func() string) Option {
+	return func(o *options) {
+		o.password = source
+	}
+}
+
+// WithShardOwner tells Open who owns the data a request works on when
+// shards are configured, normally the authenticated user. Without it only
+// contexts carrying WithSessionUser or WithShard can reach user data.
+func WithShardOwner(ownerID func(context.Context) string) Option {
+	return func(o *options) {
+		o.shardOwner = ownerID
+	}
+}
+
+// WithDeferredConnect makes Open return without waiting for the primary,
+// replicas or shards. Pools connect on first use; Ping reports when the
+// databases become reachable.
+func WithDeferredConnect() Option {
+	return func(o *options) {
+		o.deferred = true
+	}
+}
+
+// ResolveSecrets replaces secret references (see package secrets) in the
+// password, replica and shard URLs of cfg with their values. The returned
+// Value tracks the password so it can be refreshed and passed to
+// WithPasswordSource; replica and shard URLs are resolved once.
+func ResolveSecrets(ctx context.Context, resolver *secrets.Resolver, cfg *config.DatabaseConfig) (*secrets.Value, error) {
+	password, err := secrets.NewValue(ctx, resolver, cfg.Password)
+	if err != nil {
+		return nil, fmt.Errorf("resolve database password: %w", err)
+	}
+	cfg.Password = password.Get()
+
+	for i, replica := range cfg.Replicas {
+		resolved, err := resolver.Resolve(ctx, replica)
+		if err != nil {
+			return nil, fmt.Errorf("resolve replica %d: %w", i, err)
+		}
+		cfg.Replicas[i] = resolved
+	}
+	for i, shard := range cfg.Shards {
+		resolved, err := resolver.Resolve(ctx, shard)
+		if err != nil {
+			return nil, fmt.Errorf("resolve shard %d: %w", i+1, err)
+		}
+		cfg.Shards[i] = resolved
+	}
+	return password, nil
+}
+
+// DBTX is the statement interface generated by sqlc. Pools, ReadRouter and
+// ShardRouter implement it.
+type DBTX interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// DB holds the primary connection pool and the pools of any configured read
+// replicas or shards.
+//
+// Writes and reads that must observe the caller's own writes (point lookups
+// used in read-modify-write flows, token validation) go to Primary. Heavy
+// list, search and stats queries can be sent to Reader instead. User data
+// goes through Data and DataReader, which route to the owner's shard when
+// shards are configured.
+type DB struct {
+	Primary  *pgxpool.Pool
+	replicas []*pgxpool.Pool
+	reader   *ReadRouter
+	shards   []*pgxpool.Pool
+	router   *ShardRouter
+}
+
+// Open connects to the primary database and to every replica in
+// cfg.Replicas. The primary must become reachable within cfg.ConnectRetries
+// retries, unless WithDeferredConnect is given; an unreachable replica is
+// logged and kept, since reads fall back to the primary until it recovers.
+func Open(ctx context.Context, cfg config.DatabaseConfig, logger *slog.Logger, opts ...Option) (*DB, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var primary *pgxpool.Pool
+	var err error
+	if o.deferred {
+		primary, err = newPool(ctx, cfg.DatabaseURL(), cfg, o, logger)
+		if err != nil {
+			return nil, fmt.Errorf("connect to database: %w", err)
+		}
+	} else {
+		primary, err = connectPrimary(ctx, cfg, o, logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	db := &DB{Primary: primary}
+	for i, dsn := range cfg.Replicas {
+		// Replica URLs carry their own credentials
+		replica, err := newPool(ctx, dsn, cfg, options{sessionUser: o.sessionUser}, logger)
+		if err != nil {
+			db.Close()
+			// The DSN may contain credentials, so only report its position
+			return nil, fmt.Errorf("connect to replica %d: %w", i, err)
+		}
+		if !o.deferred {
+			if err := replica.Ping(ctx); err != nil {
+				logger.WarnContext(ctx, "read replica is unreachable, reads will fall back to the primary",
+					"replica", i, "host", replica.Config().ConnConfig.Host, "error", err)
+			}
+		}
+		db.replicas = append(db.replicas, replica)
+	}
+
+	db.reader = NewReadRouter(primary, db.replicas, logger)
+
+	if len(cfg.Shards) > 0 {
+		shards := []*pgxpool.Pool{primary}
+		for i, dsn := range cfg.Shards {
+			// Shard URLs carry their own credentials
+			shard, err := newPool(ctx, dsn, cfg, options{sessionUser: o.sessionUser}, logger)
+			if err != nil {
+				db.Close()
+				// The DSN may contain credentials, so only report its position
+				return nil, fmt.Errorf("connect to shard %d: %w", i+1, err)
+			}
+			db.shards = append(db.shards, shard)
+			shards = append(shards, shard)
+		}
+		ownerID := o.shardOwner
+		if ownerID == nil {
+			ownerID = func(context.Context) string { return "" }
+		}
+		db.router = NewShardRouter(shards, NewPlacements(primary), ownerID, cfg.ShardPlacementTTL)
+	}
+	return db, nil
+}
+
+// connectPrimary creates the primary pool and pings it, retrying with
+// exponential backoff so the service can start before Postgres is ready
+// (e.g. under docker-compose or during a Kubernetes rollout).
+func connectPrimary(ctx context.Context, cfg config.DatabaseConfig, o options, logger *slog.Logger) (*pgxpool.Pool, error) {
+	for attempt := 0; ; attempt++ {
+		pool, err := newPool(ctx, cfg.DatabaseURL(), cfg, o, logger)
+		if err != nil {
+			// A malformed URL will not fix itself
+			return nil, fmt.Errorf("connect to database: %w", err)
+		}
+		err = pool.Ping(ctx)
+		if err == nil {
+			return pool, nil
+		}
+		pool.Close()
+
+		if attempt >= cfg.ConnectRetries {
+			return nil, fmt.Errorf("ping database after %d attempt(s): %w", attempt+1, err)
+		}
+
+		delay := retryDelay(attempt, cfg.ConnectBackoff, cfg.ConnectMaxBackoff)
+		logger.WarnContext(ctx, "database not reachable, retrying",
+			"attempt", attempt+1, "max_attempts", cfg.ConnectRetries+1, "retry_in", delay, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryDelay returns the wait before retry number attempt+1: initial doubled
+// per attempt and capped at maxDelay (when maxDelay is positive)
+func retryDelay(attempt int, initial, maxDelay time.Duration) time.Duration {
+	delay := initial
+	for i := 0; i < attempt; i++ {
+		if (maxDelay > 0 && delay >= maxDelay) || delay > math.MaxInt64/2 {
+			break
+		}
+		delay *= 2
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// newPool creates a pool for url with the pool settings, query timeout,
+// slow query logging and per-statement spans from cfg applied. When
+// o.password is set, every new connection uses its current result; when
+// o.sessionUser is set, every acquired connection gets app.user_id.
+func newPool(ctx context.Context, url string, cfg config.DatabaseConfig, o options, logger *slog.Logger) (*pgxpool.Pool, error) {
+	poolConfig, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		return nil, err
+	}
+	applyPoolSettings(poolConfig, cfg)
+	if o.password != nil {
+		poolConfig.BeforeConnect = func(ctx context.Context, connConfig *pgx.ConnConfig) error {
+			connConfig.Password = o.password()
+			return nil
+		}
+	}
+	if o.sessionUser != nil {
+		poolConfig.PrepareConn = prepareSessionUser(o.sessionUser)
+	}
+
+	// The span tracer runs first so slow query logs carry the trace ID of
+	// the statement span
+	tracers := []pgx.QueryTracer{newSpanTracer()}
+	if tracer := newQueryTracer(cfg.QueryTimeout, cfg.SlowQueryThreshold, logger); tracer != nil {
+		tracers = append(tracers, tracer)
+	}
+	poolConfig.ConnConfig.Tracer = multitracer.New(tracers...)
+
+	return pgxpool.NewWithConfig(ctx, poolConfig)
+}
+
+// applyPoolSettings overrides the pgx defaults with the configured values.
+// Unset (zero) values leave the defaults in place.
+func applyPoolSettings(poolConfig *pgxpool.Config, cfg config.DatabaseConfig) {
+	if cfg.MaxConns > 0 {
+		poolConfig.MaxConns = cfg.MaxConns
+	}
+	if cfg.MinConns > 0 {
+		poolConfig.MinConns = cfg.MinConns
+	}
+	if cfg.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = cfg.MaxConnLifetime
+	}
+	if cfg.HealthCheckPeriod > 0 {
+		poolConfig.HealthCheckPeriod = cfg.HealthCheckPeriod
+	}
+}
+
+// Reader returns the router for read-only queries. Without replicas it
+// sends every query to the primary.
+func (db *DB) Reader() *ReadRouter {
+	return db.reader
+}
+
+// Data returns where user data is written: the shard router when shards
+// are configured, otherwise the primary
+func (db *DB) Data() Pool {
+	if db.router != nil {
+		return db.router
+	}
+	return db.Primary
+}
+
+// DataReader returns where user data is read from: the shard router when
+// shards are configured, otherwise the reader
+func (db *DB) DataReader() DBTX {
+	if db.router != nil {
+		return db.router
+	}
+	return db.reader
+}
+
+// Shards returns the router over the primary and the shards, or nil when
+// shards are not configured
+func (db *DB) Shards() *ShardRouter {
+	return db.router
+}
+
+// ForEachShard calls fn once per shard with a context pinned to it, or once
+// with ctx when shards are not configured. Background jobs that scan every
+// owner run through it.
+func (db *DB) ForEachShard(ctx context.Context, fn func(ctx context.Context) error) error {
+	if db.router == nil {
+		return fn(ctx)
+	}
+	return db.router.ForEachShard(ctx, fn)
+}
+
+// Ping checks that the primary and every shard are reachable. Replicas are
+// left out since reads fall back to the primary.
+func (db *DB) Ping(ctx context.Context) error {
+	if err := db.Primary.Ping(ctx); err != nil {
+		return fmt.Errorf("ping database: %w", err)
+	}
+	for i, shard := range db.shards {
+		if err := shard.Ping(ctx); err != nil {
+			return fmt.Errorf("ping shard %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// Close closes the primary, replica and shard pools
+func (db *DB) Close() {
+	for _, replica := range db.replicas {
+		replica.Close()
+	}
+	for _, shard := range db.shards {
+		shard.Close()
+	}
+	db.Primary.Close()
+}
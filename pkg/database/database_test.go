@@ -0,0 +1,48 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/slips-ai/slips-core/pkg/config"
+)
+
+func TestApplyPoolSettings(t *testing.T) {
+	poolConfig, err := pgxpool.ParseConfig("postgres://u:p@localhost:5432/slips")
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	defaultLifetime := poolConfig.MaxConnLifetime
+
+	applyPoolSettings(poolConfig, config.DatabaseConfig{
+		MaxConns:          25,
+		MinConns:          5,
+		HealthCheckPeriod: 10 * time.Second,
+	})
+
+	if poolConfig.MaxConns != 25 || poolConfig.MinConns != 5 {
+		t.Fatalf("expected max/min conns 25/5, got %d/%d", poolConfig.MaxConns, poolConfig.MinConns)
+	}
+	if poolConfig.HealthCheckPeriod != 10*time.Second {
+		t.Fatalf("expected health check period 10s, got %s", poolConfig.HealthCheckPeriod)
+	}
+	if poolConfig.MaxConnLifetime != defaultLifetime {
+		t.Fatalf("expected unset lifetime to keep the default %s, got %s", defaultLifetime, poolConfig.MaxConnLifetime)
+	}
+}
+
+func TestRetryDelay_DoublesUpToMax(t *testing.T) {
+	want := []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second,
+	}
+	for attempt, expected := range want {
+		if got := retryDelay(attempt, time.Second, 10*time.Second); got != expected {
+			t.Errorf("retryDelay(%d) = %s, want %s", attempt, got, expected)
+		}
+	}
+
+	if got := retryDelay(50, time.Second, 0); got <= 0 {
+		t.Errorf("expected uncapped delay to stay positive, got %s", got)
+	}
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/pgx/v5" // registers the pgx5:// driver
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/slips-ai/slips-core/migrations"
+)
+
+// NewMigrator creates a migrator for the embedded migrations against the
+// database at databaseURL (a postgres:// connection string).
+// The caller must Close the returned migrator.
+//
+// The migrator holds a PostgreSQL advisory lock while changing the schema,
+// so several replicas starting at once apply each migration exactly once.
+func NewMigrator(databaseURL string) (*migrate.Migrate, error) {
+	source, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load embedded migrations: %w", err)
+	}
+
+	m, err := migrate.NewWithSourceInstance("iofs", source, migrateURL(databaseURL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize migrator: %w", err)
+	}
+	return m, nil
+}
+
+// Migrate applies all pending embedded migrations
+func Migrate(databaseURL string, logger *slog.Logger) error {
+	m, err := NewMigrator(databaseURL)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	version, dirty, err := m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return fmt.Errorf("failed to read migration version: %w", err)
+	}
+	logger.Info("Database schema up to date", "version", version, "dirty", dirty)
+	return nil
+}
+
+// migrateURL rewrites a postgres:// connection string to the scheme used by
+// the golang-migrate pgx/v5 driver
+func migrateURL(databaseURL string) string {
+	for _, scheme := range []string{"postgres://", "postgresql://"} {
+		if rest, ok := strings.CutPrefix(databaseURL, scheme); ok {
+			return "pgx5://" + rest
+		}
+	}
+	return databaseURL
+}
@@ -0,0 +1,38 @@
+package database
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/slips-ai/slips-core/migrations"
+)
+
+func TestMigrateURL_RewritesPostgresScheme(t *testing.T) {
+	cases := map[string]string{
+		"postgres://u:p@localhost:5432/slips?sslmode=disable":   "pgx5://u:p@localhost:5432/slips?sslmode=disable",
+		"postgresql://u:p@localhost:5432/slips?sslmode=disable": "pgx5://u:p@localhost:5432/slips?sslmode=disable",
+		"pgx5://u:p@localhost:5432/slips":                       "pgx5://u:p@localhost:5432/slips",
+	}
+	for in, want := range cases {
+		if got := migrateURL(in); got != want {
+			t.Errorf("migrateURL(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestEmbeddedMigrations_EveryUpHasDown(t *testing.T) {
+	ups, err := fs.Glob(migrations.FS, "*.up.sql")
+	if err != nil {
+		t.Fatalf("glob: %v", err)
+	}
+	if len(ups) == 0 {
+		t.Fatal("expected embedded up migrations")
+	}
+	for _, up := range ups {
+		down := strings.TrimSuffix(up, ".up.sql") + ".down.sql"
+		if _, err := fs.Stat(migrations.FS, down); err != nil {
+			t.Errorf("missing down migration for %s", up)
+		}
+	}
+}
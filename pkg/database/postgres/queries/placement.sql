@@ -0,0 +1,10 @@
+-- name: GetPlacement :one
+SELECT shard, moving
+FROM user_shards
+WHERE owner_id = $1;
+
+-- name: UpsertPlacement :exec
+INSERT INTO user_shards (owner_id, shard, moving)
+VALUES ($1, $2, $3)
+ON CONFLICT (owner_id) DO UPDATE
+SET shard = EXCLUDED.shard, moving = EXCLUDED.moving, updated_at = NOW();
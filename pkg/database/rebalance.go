@@ -0,0 +1,202 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	databasepg "github.com/slips-ai/slips-core/pkg/database/postgres"
+)
+
+// shardedTable is a table holding user data, with the condition selecting
+// the rows of the owner passed as $1
+type shardedTable struct {
+	name  string
+	owner string
+}
+
+// shardedTables lists the tables that live on the shard of their owner,
+// parents before the tables referencing them. Each needs an owner isolation
+// policy in the migrations too.
+var shardedTables = []shardedTable{
+	{"tasks", "owner_id = $1"},
+	{"tags", "owner_id = $1"},
+	{"task_tags", "task_id IN (SELECT id FROM tasks WHERE owner_id = $1)"},
+	{"task_checklist_items", "task_id IN (SELECT id FROM tasks WHERE owner_id = $1)"},
+	{"task_note_revisions", "task_id IN (SELECT id FROM tasks WHERE owner_id = $1)"},
+	{"task_geofences", "task_id IN (SELECT id FROM tasks WHERE owner_id = $1)"},
+	{"task_tombstones", "owner_id = $1"},
+	{"task_settings", "owner_id = $1"},
+	{"tag_settings", "owner_id = $1"},
+	{"user_data_keys", "user_id = $1"},
+	{"user_goals", "owner_id = $1"},
+	{"saved_filters", "owner_id = $1"},
+	{"approvals", "owner_id = $1"},
+	{"approval_settings", "owner_id = $1"},
+}
+
+// Placements stores the placements of moved owners in the user_shards
+// table of the primary
+type Placements struct {
+	queries *databasepg.Queries
+}
+
+// NewPlacements creates a placement store on the primary
+func NewPlacements(primary *pgxpool.Pool) *Placements {
+	return &Placements{queries: databasepg.New(primary)}
+}
+
+// GetPlacement implements PlacementStore
+func (p *Placements) GetPlacement(ctx context.Context, ownerID string) (Placement, bool, error) {
+	row, err := p.queries.GetPlacement(ctx, ownerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Placement{}, false, nil
+	}
+	if err != nil {
+		return Placement{}, false, err
+	}
+	return Placement{Shard: int(row.Shard), Moving: row.Moving}, true, nil
+}
+
+// SetPlacement implements PlacementStore
+func (p *Placements) SetPlacement(ctx context.Context, ownerID string, placement Placement) error {
+	return p.queries.UpsertPlacement(ctx, databasepg.UpsertPlacementParams{
+		OwnerID: ownerID,
+		Shard:   int32(placement.Shard),
+		Moving:  placement.Moving,
+	})
+}
+
+// MoveOwner moves an owner's data from the shard it lives on to shard to.
+// It marks the owner moving and waits settle, the shard_placement_ttl of
+// the running instances, so they stop using the old shard for the owner.
+// It then copies the owner's rows to the target in one transaction, records
+// the new placement and deletes the rows from the old shard. Requests of
+// the owner fail with ErrOwnerMoving meanwhile. A move that fails before
+// the new placement is recorded leaves the owner on the old shard.
+func (r *ShardRouter) MoveOwner(ctx context.Context, ownerID string, to int, settle time.Duration, logger *slog.Logger) error {
+	if to < 0 || to >= len(r.shards) {
+		return fmt.Errorf("shard %d out of range [0, %d)", to, len(r.shards))
+	}
+	current, err := r.Locate(ctx, ownerID)
+	if err != nil {
+		return fmt.Errorf("look up shard placement: %w", err)
+	}
+	from := current.Shard
+	if from == to && !current.Moving {
+		return nil
+	}
+
+	if err := r.placements.SetPlacement(ctx, ownerID, Placement{Shard: from, Moving: true}); err != nil {
+		return fmt.Errorf("mark owner moving: %w", err)
+	}
+	logger.InfoContext(ctx, "owner marked moving, waiting for instances to notice", "owner_id", ownerID,
+		"from", from, "to", to, "wait", settle)
+	select {
+	case <-ctx.Done():
+		return errors.Join(ctx.Err(), r.restorePlacement(ownerID, from))
+	case <-time.After(settle):
+	}
+
+	if from != to {
+		if err := copyOwner(ctx, r.shards[from], r.shards[to], ownerID); err != nil {
+			return errors.Join(fmt.Errorf("copy owner data: %w", err), r.restorePlacement(ownerID, from))
+		}
+	}
+	if err := r.placements.SetPlacement(ctx, ownerID, Placement{Shard: to}); err != nil {
+		return errors.Join(fmt.Errorf("record new placement: %w", err), r.restorePlacement(ownerID, from))
+	}
+	logger.InfoContext(ctx, "owner moved", "owner_id", ownerID, "from", from, "to", to)
+
+	if from != to {
+		// The owner is live on the target now; leftovers on the old shard are
+		// never read and are replaced on the next move back
+		if err := deleteOwner(ctx, r.shards[from], ownerID); err != nil {
+			return fmt.Errorf("delete owner data from shard %d: %w", from, err)
+		}
+	}
+	return nil
+}
+
+// restorePlacement puts an owner back on shard after a failed move. It uses
+// a fresh context, since the move's context may be the cause of the failure.
+func (r *ShardRouter) restorePlacement(ownerID string, shard int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := r.placements.SetPlacement(ctx, ownerID, Placement{Shard: shard}); err != nil {
+		return fmt.Errorf("restore placement on shard %d: %w", shard, err)
+	}
+	return nil
+}
+
+// copyOwner copies an owner's rows from src to dst in one transaction on
+// dst, replacing rows the owner left on dst in an earlier move
+func copyOwner(ctx context.Context, src, dst *pgxpool.Pool, ownerID string) error {
+	tx, err := dst.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := deleteOwnerTx(ctx, tx, ownerID); err != nil {
+		return err
+	}
+	for _, table := range shardedTables {
+		rows, err := src.Query(ctx, "SELECT * FROM "+table.name+" WHERE "+table.owner, ownerID)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", table.name, err)
+		}
+		var columns []string
+		for _, field := range rows.FieldDescriptions() {
+			columns = append(columns, field.Name)
+		}
+		var values [][]any
+		for rows.Next() {
+			row, err := rows.Values()
+			if err != nil {
+				rows.Close()
+				return fmt.Errorf("read %s: %w", table.name, err)
+			}
+			values = append(values, row)
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("read %s: %w", table.name, err)
+		}
+		if len(values) == 0 {
+			continue
+		}
+		if _, err := tx.CopyFrom(ctx, pgx.Identifier{table.name}, columns, pgx.CopyFromRows(values)); err != nil {
+			return fmt.Errorf("write %s: %w", table.name, err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// deleteOwner deletes an owner's rows from a shard in one transaction
+func deleteOwner(ctx context.Context, pool *pgxpool.Pool, ownerID string) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := deleteOwnerTx(ctx, tx, ownerID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// deleteOwnerTx deletes an owner's rows, children before their parents
+func deleteOwnerTx(ctx context.Context, tx pgx.Tx, ownerID string) error {
+	for i := len(shardedTables) - 1; i >= 0; i-- {
+		table := shardedTables[i]
+		if _, err := tx.Exec(ctx, "DELETE FROM "+table.name+" WHERE "+table.owner, ownerID); err != nil {
+			return fmt.Errorf("delete %s: %w", table.name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ReadRouter spreads read-only queries across read replicas in round-robin
+// order and retries them on the primary when a replica fails.
+//
+// It implements the DBTX interface generated by sqlc, so a repository can
+// build its read Queries from it. Replicas may lag behind the primary; only
+// use it for queries that tolerate slightly stale data.
+type ReadRouter struct {
+	primary  *pgxpool.Pool
+	replicas []*pgxpool.Pool
+	next     atomic.Uint64
+	logger   *slog.Logger
+}
+
+// NewReadRouter creates a router over the given replicas. With no replicas
+// every query goes to the primary.
+func NewReadRouter(primary *pgxpool.Pool, replicas []*pgxpool.Pool, logger *slog.Logger) *ReadRouter {
+	return &ReadRouter{
+		primary:  primary,
+		replicas: replicas,
+		logger:   logger,
+	}
+}
+
+// Exec always runs on the primary; statements sent through Exec are not
+// guaranteed to be read-only.
+func (r *ReadRouter) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return r.primary.Exec(ctx, sql, args...)
+}
+
+// Query runs on the next replica, falling back to the primary on failure
+func (r *ReadRouter) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	replica := r.pick()
+	if replica == nil {
+		return r.primary.Query(ctx, sql, args...)
+	}
+
+	rows, err := replica.Query(ctx, sql, args...)
+	if err == nil || !shouldFallback(ctx, err) {
+		return rows, err
+	}
+	r.logFallback(ctx, replica, err)
+	return r.primary.Query(ctx, sql, args...)
+}
+
+// QueryRow runs on the next replica. Errors surface when the row is
+// scanned, so the fallback to the primary happens in Scan.
+func (r *ReadRouter) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	replica := r.pick()
+	if replica == nil {
+		return r.primary.QueryRow(ctx, sql, args...)
+	}
+
+	return &fallbackRow{
+		router:  r,
+		replica: replica,
+		row:     replica.QueryRow(ctx, sql, args...),
+		ctx:     ctx,
+		sql:     sql,
+		args:    args,
+	}
+}
+
+// pick returns the next replica in round-robin order, or nil without replicas
+func (r *ReadRouter) pick() *pgxpool.Pool {
+	if len(r.replicas) == 0 {
+		return nil
+	}
+	n := r.next.Add(1) - 1
+	return r.replicas[n%uint64(len(r.replicas))]
+}
+
+func (r *ReadRouter) logFallback(ctx context.Context, replica *pgxpool.Pool, err error) {
+	r.logger.WarnContext(ctx, "read replica query failed, retrying on primary",
+		"host", replica.Config().ConnConfig.Host, "error", err)
+}
+
+// fallbackRow retries a single-row query on the primary when the replica fails
+type fallbackRow struct {
+	router  *ReadRouter
+	replica *pgxpool.Pool
+	row     pgx.Row
+	ctx     context.Context
+	sql     string
+	args    []any
+}
+
+func (f *fallbackRow) Scan(dest ...any) error {
+	err := f.row.Scan(dest...)
+	if err == nil || !shouldFallback(f.ctx, err) {
+		return err
+	}
+	f.router.logFallback(f.ctx, f.replica, err)
+	return f.router.primary.QueryRow(f.ctx, f.sql, f.args...).Scan(dest...)
+}
+
+// shouldFallback reports whether a failed replica read is worth retrying on
+// the primary. Missing rows, cancelled requests and errors in the query
+// itself would fail the same way there. Connection failures, replicas that
+// are shutting down or still starting (class 57) and queries cancelled by
+// recovery conflicts (40001) are retried.
+func shouldFallback(ctx context.Context, err error) bool {
+	if errors.Is(err, pgx.ErrNoRows) || ctx.Err() != nil {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return strings.HasPrefix(pgErr.Code, "57") || pgErr.Code == "40001"
+	}
+	return true
+}
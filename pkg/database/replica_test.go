@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func TestShouldFallback(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{"no rows", context.Background(), pgx.ErrNoRows, false},
+		{"wrapped no rows", context.Background(), fmt.Errorf("get: %w", pgx.ErrNoRows), false},
+		{"cancelled request", cancelled, errors.New("conn closed"), false},
+		{"syntax error", context.Background(), &pgconn.PgError{Code: "42601"}, false},
+		{"connection refused", context.Background(), errors.New("dial tcp: connection refused"), true},
+		{"replica starting up", context.Background(), &pgconn.PgError{Code: "57P03"}, true},
+		{"recovery conflict", context.Background(), &pgconn.PgError{Code: "40001"}, true},
+	}
+	for _, tc := range cases {
+		if got := shouldFallback(tc.ctx, tc.err); got != tc.want {
+			t.Errorf("%s: shouldFallback = %t, want %t", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestReadRouter_PicksReplicasRoundRobin(t *testing.T) {
+	// pgxpool connects lazily, so these pools never touch the network
+	newPool := func(host string) *pgxpool.Pool {
+		pool, err := pgxpool.New(context.Background(), "postgres://u:p@"+host+":5432/slips")
+		if err != nil {
+			t.Fatalf("new pool: %v", err)
+		}
+		t.Cleanup(pool.Close)
+		return pool
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	if got := NewReadRouter(newPool("primary"), nil, logger).pick(); got != nil {
+		t.Fatal("expected no replica without replicas configured")
+	}
+
+	a, b := newPool("replica-a"), newPool("replica-b")
+	router := NewReadRouter(newPool("primary"), []*pgxpool.Pool{a, b}, logger)
+	want := []*pgxpool.Pool{a, b, a, b}
+	for i, expected := range want {
+		if got := router.pick(); got != expected {
+			t.Fatalf("pick %d: got %s, want %s", i, got.Config().ConnConfig.Host, expected.Config().ConnConfig.Host)
+		}
+	}
+}
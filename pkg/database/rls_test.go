@@ -0,0 +1,29 @@
+package database
+
+import (
+	"context"
+	"testing"
+)
+
+type testUserKey struct{}
+
+func TestSessionUser(t *testing.T) {
+	userID := func(ctx context.Context) string {
+		id, _ := ctx.Value(testUserKey{}).(string)
+		return id
+	}
+
+	ctx := context.Background()
+	if got := sessionUser(ctx, userID); got != "" {
+		t.Errorf("sessionUser without a user = %q, want empty", got)
+	}
+
+	ctx = context.WithValue(ctx, testUserKey{}, "admin")
+	if got := sessionUser(ctx, userID); got != "admin" {
+		t.Errorf("sessionUser = %q, want admin", got)
+	}
+
+	if got := sessionUser(WithSessionUser(ctx, "user-1"), userID); got != "user-1" {
+		t.Errorf("sessionUser with override = %q, want user-1", got)
+	}
+}
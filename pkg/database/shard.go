@@ -0,0 +1,269 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+)
+
+// ErrNoShard is returned for statements sent through a ShardRouter whose
+// context names neither an owner nor a shard
+var ErrNoShard = errors.New("no owner or shard to route the query to")
+
+// ErrOwnerMoving is returned for statements of an owner whose data is being
+// moved to another shard; they succeed again once the move finished
+var ErrOwnerMoving = domainerrors.New(domainerrors.ErrUnavailable, "data is being moved, retry later")
+
+// ErrOwnersApart is returned by Colocate for owners whose data lives on
+// different shards
+var ErrOwnersApart = domainerrors.New(domainerrors.ErrFailedPrecondition, "the users live on different shards, move one to the other's shard first")
+
+// maxCachedPlacements is the number of placements a ShardRouter keeps
+// before it starts over with an empty cache
+const maxCachedPlacements = 100000
+
+// Pool is the statement and transaction interface shared by *pgxpool.Pool
+// and ShardRouter
+type Pool interface {
+	DBTX
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// ShardOf returns the shard an owner's data lives on unless it was moved:
+// the FNV-1a hash of the owner ID modulo count
+func ShardOf(ownerID string, count int) int {
+	h := fnv.New32a()
+	h.Write([]byte(ownerID))
+	return int(h.Sum32() % uint32(count))
+}
+
+type shardKey struct{}
+
+// WithShard returns a context whose statements without an owner, such as
+// the queries of background jobs, run on the given shard
+func WithShard(ctx context.Context, shard int) context.Context {
+	return context.WithValue(ctx, shardKey{}, shard)
+}
+
+// Placement is where an owner's data lives when it was moved off the shard
+// picked by ShardOf. Moving is set while the data is being copied.
+type Placement struct {
+	Shard  int
+	Moving bool
+}
+
+// PlacementStore records the placements of moved owners
+type PlacementStore interface {
+	GetPlacement(ctx context.Context, ownerID string) (Placement, bool, error)
+	SetPlacement(ctx context.Context, ownerID string, placement Placement) error
+}
+
+type cachedPlacement struct {
+	placement Placement
+	found     bool
+	expires   time.Time
+}
+
+// ShardRouter sends each statement to the shard of the owner it runs for:
+// the user set with WithSessionUser, or else the authenticated user. Owners
+// live on the shard picked by ShardOf unless the placement store records
+// another one. Statements without an owner run on the shard set with
+// WithShard and fail with ErrNoShard without one.
+//
+// A transaction stays on the shard it began on, so it must not span owners.
+type ShardRouter struct {
+	shards     []*pgxpool.Pool
+	placements PlacementStore
+	ownerID    func(context.Context) string
+	ttl        time.Duration
+	now        func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedPlacement
+}
+
+// NewShardRouter creates a router over shards, where shards[0] is the
+// primary. ownerID returns the authenticated user of a context. Placements
+// are cached for ttl, so a moved owner may reach their previous shard for
+// up to ttl after the move.
+func NewShardRouter(shards []*pgxpool.Pool, placements PlacementStore, ownerID func(context.Context) string, ttl time.Duration) *ShardRouter {
+	return &ShardRouter{
+		shards:     shards,
+		placements: placements,
+		ownerID:    ownerID,
+		ttl:        ttl,
+		now:        time.Now,
+		cache:      make(map[string]cachedPlacement),
+	}
+}
+
+// Exec runs on the shard of the context's owner
+func (r *ShardRouter) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	pool, err := r.pool(ctx)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	return pool.Exec(ctx, sql, args...)
+}
+
+// Query runs on the shard of the context's owner
+func (r *ShardRouter) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	pool, err := r.pool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pool.Query(ctx, sql, args...)
+}
+
+// QueryRow runs on the shard of the context's owner. Routing errors surface
+// when the row is scanned.
+func (r *ShardRouter) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	pool, err := r.pool(ctx)
+	if err != nil {
+		return errRow{err: err}
+	}
+	return pool.QueryRow(ctx, sql, args...)
+}
+
+// Begin starts a transaction on the shard of the context's owner
+func (r *ShardRouter) Begin(ctx context.Context) (pgx.Tx, error) {
+	pool, err := r.pool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pool.Begin(ctx)
+}
+
+// Count returns the number of shards, including the primary
+func (r *ShardRouter) Count() int {
+	return len(r.shards)
+}
+
+// Locate returns the shard an owner's data lives on, bypassing the cache
+func (r *ShardRouter) Locate(ctx context.Context, ownerID string) (Placement, error) {
+	placement, found, err := r.placements.GetPlacement(ctx, ownerID)
+	if err != nil {
+		return Placement{}, err
+	}
+	if !found {
+		return Placement{Shard: ShardOf(ownerID, len(r.shards))}, nil
+	}
+	return placement, nil
+}
+
+// ForEachShard calls fn once per shard, in order, with a context pinned to
+// that shard by WithShard. It is meant for background jobs that scan every
+// owner. A failing shard does not stop the others; their errors are joined.
+func (r *ShardRouter) ForEachShard(ctx context.Context, fn func(ctx context.Context) error) error {
+	var errs []error
+	for i := range r.shards {
+		if err := fn(WithShard(ctx, i)); err != nil {
+			errs = append(errs, fmt.Errorf("shard %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Colocate returns a context for a transaction that moves rows between
+// owners, such as handing tasks to another user. Its statements run on the
+// shard of the owners, which must all live on the same one, and without
+// row-level security restrictions, which would hide all but one owner's
+// rows. pool is the pool the transaction runs on; without shards every
+// owner lives on it.
+func Colocate(ctx context.Context, pool Pool, ownerIDs ...string) (context.Context, error) {
+	ctx = WithSessionUser(ctx, "")
+	router, ok := pool.(*ShardRouter)
+	if !ok {
+		return ctx, nil
+	}
+	shard := -1
+	for _, ownerID := range ownerIDs {
+		owned, err := router.resolve(ctx, ownerID)
+		if err != nil {
+			return nil, err
+		}
+		if shard >= 0 && owned != shard {
+			return nil, ErrOwnersApart
+		}
+		shard = owned
+	}
+	if shard < 0 {
+		return nil, ErrNoShard
+	}
+	return WithShard(ctx, shard), nil
+}
+
+// pool returns the pool of the shard ctx routes to
+func (r *ShardRouter) pool(ctx context.Context) (*pgxpool.Pool, error) {
+	shard, err := r.route(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.shards[shard], nil
+}
+
+// route returns the shard ctx routes to
+func (r *ShardRouter) route(ctx context.Context) (int, error) {
+	if owner := sessionUser(ctx, r.ownerID); owner != "" {
+		return r.resolve(ctx, owner)
+	}
+	if shard, ok := ctx.Value(shardKey{}).(int); ok {
+		if shard < 0 || shard >= len(r.shards) {
+			return 0, fmt.Errorf("shard %d out of range [0, %d)", shard, len(r.shards))
+		}
+		return shard, nil
+	}
+	return 0, ErrNoShard
+}
+
+// resolve returns the shard of an owner, looking up moved owners through
+// the placement cache
+func (r *ShardRouter) resolve(ctx context.Context, ownerID string) (int, error) {
+	now := r.now()
+	r.mu.Lock()
+	cached, ok := r.cache[ownerID]
+	r.mu.Unlock()
+
+	if !ok || !now.Before(cached.expires) {
+		placement, found, err := r.placements.GetPlacement(ctx, ownerID)
+		if err != nil {
+			return 0, fmt.Errorf("look up shard placement: %w", err)
+		}
+		cached = cachedPlacement{placement: placement, found: found, expires: now.Add(r.ttl)}
+
+		r.mu.Lock()
+		if len(r.cache) >= maxCachedPlacements {
+			clear(r.cache)
+		}
+		r.cache[ownerID] = cached
+		r.mu.Unlock()
+	}
+
+	if !cached.found {
+		return ShardOf(ownerID, len(r.shards)), nil
+	}
+	if cached.placement.Moving {
+		return 0, ErrOwnerMoving
+	}
+	if cached.placement.Shard < 0 || cached.placement.Shard >= len(r.shards) {
+		return 0, fmt.Errorf("owner placed on unknown shard %d", cached.placement.Shard)
+	}
+	return cached.placement.Shard, nil
+}
+
+// errRow is a pgx.Row whose Scan fails with err
+type errRow struct {
+	err error
+}
+
+func (r errRow) Scan(dest ...any) error {
+	return r.err
+}
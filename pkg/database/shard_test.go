@@ -0,0 +1,184 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// stubPlacements is an in-memory PlacementStore counting lookups
+type stubPlacements struct {
+	placements map[string]Placement
+	lookups    int
+}
+
+func (s *stubPlacements) GetPlacement(ctx context.Context, ownerID string) (Placement, bool, error) {
+	s.lookups++
+	placement, ok := s.placements[ownerID]
+	return placement, ok, nil
+}
+
+func (s *stubPlacements) SetPlacement(ctx context.Context, ownerID string, placement Placement) error {
+	s.placements[ownerID] = placement
+	return nil
+}
+
+type ownerKey struct{}
+
+func newTestShardRouter(t *testing.T, count int, placements PlacementStore) *ShardRouter {
+	var shards []*pgxpool.Pool
+	for i := 0; i < count; i++ {
+		// pgxpool connects lazily, so these pools never touch the network
+		pool, err := pgxpool.New(context.Background(), fmt.Sprintf("postgres://u:p@shard-%d:5432/slips", i))
+		if err != nil {
+			t.Fatalf("new pool: %v", err)
+		}
+		t.Cleanup(pool.Close)
+		shards = append(shards, pool)
+	}
+	ownerID := func(ctx context.Context) string {
+		owner, _ := ctx.Value(ownerKey{}).(string)
+		return owner
+	}
+	return NewShardRouter(shards, placements, ownerID, time.Minute)
+}
+
+func TestShardOf(t *testing.T) {
+	// Placement must never change between releases, or owners would lose
+	// their data
+	for owner, want := range map[string]int{
+		"user-1":           0,
+		"user-2":           1,
+		"auth0|5f2b8c9d1e": 2,
+	} {
+		if got := ShardOf(owner, 4); got != want {
+			t.Errorf("ShardOf(%q, 4) = %d, want %d", owner, got, want)
+		}
+	}
+
+	seen := make(map[int]bool)
+	for i := 0; i < 100; i++ {
+		shard := ShardOf(fmt.Sprintf("user-%d", i), 4)
+		if shard < 0 || shard >= 4 {
+			t.Fatalf("ShardOf out of range: %d", shard)
+		}
+		seen[shard] = true
+	}
+	if len(seen) != 4 {
+		t.Errorf("100 owners used %d of 4 shards", len(seen))
+	}
+}
+
+func TestShardRouter_Route(t *testing.T) {
+	placements := &stubPlacements{placements: map[string]Placement{
+		"moved":  {Shard: 2},
+		"moving": {Shard: 1, Moving: true},
+	}}
+	router := newTestShardRouter(t, 3, placements)
+	ctx := context.Background()
+
+	cases := []struct {
+		name    string
+		ctx     context.Context
+		want    int
+		wantErr error
+	}{
+		{"authenticated owner", context.WithValue(ctx, ownerKey{}, "user-1"), ShardOf("user-1", 3), nil},
+		{"session user wins", WithSessionUser(context.WithValue(ctx, ownerKey{}, "user-1"), "user-2"), ShardOf("user-2", 3), nil},
+		{"owner wins over pinned shard", WithShard(context.WithValue(ctx, ownerKey{}, "user-1"), 2), ShardOf("user-1", 3), nil},
+		{"moved owner", context.WithValue(ctx, ownerKey{}, "moved"), 2, nil},
+		{"moving owner", context.WithValue(ctx, ownerKey{}, "moving"), 0, ErrOwnerMoving},
+		{"pinned shard", WithShard(ctx, 1), 1, nil},
+		{"all users pinned", WithSessionUser(WithShard(ctx, 2), ""), 2, nil},
+		{"no owner", ctx, 0, ErrNoShard},
+	}
+	for _, tc := range cases {
+		got, err := router.route(tc.ctx)
+		if !errors.Is(err, tc.wantErr) || (err == nil && got != tc.want) {
+			t.Errorf("%s: route = %d, %v; want %d, %v", tc.name, got, err, tc.want, tc.wantErr)
+		}
+	}
+
+	if _, err := router.route(WithShard(ctx, 3)); err == nil {
+		t.Error("expected an error for a shard out of range")
+	}
+}
+
+func TestShardRouter_CachesPlacements(t *testing.T) {
+	placements := &stubPlacements{placements: map[string]Placement{}}
+	router := newTestShardRouter(t, 2, placements)
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	router.now = func() time.Time { return now }
+	ctx := context.WithValue(context.Background(), ownerKey{}, "user-1")
+
+	for i := 0; i < 3; i++ {
+		if _, err := router.route(ctx); err != nil {
+			t.Fatalf("route: %v", err)
+		}
+	}
+	if placements.lookups != 1 {
+		t.Fatalf("lookups = %d, want 1", placements.lookups)
+	}
+
+	// A move shows up once the cached placement expires
+	other := 1 - ShardOf("user-1", 2)
+	placements.placements["user-1"] = Placement{Shard: other}
+	now = now.Add(time.Minute)
+	if got, err := router.route(ctx); err != nil || got != other {
+		t.Errorf("route after expiry = %d, %v; want %d", got, err, other)
+	}
+}
+
+func TestShardRouter_ForEachShard(t *testing.T) {
+	router := newTestShardRouter(t, 3, &stubPlacements{placements: map[string]Placement{}})
+
+	var visited []int
+	err := router.ForEachShard(context.Background(), func(ctx context.Context) error {
+		shard, err := router.route(ctx)
+		if err != nil {
+			return err
+		}
+		visited = append(visited, shard)
+		if shard == 1 {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	if len(visited) != 3 || visited[0] != 0 || visited[2] != 2 {
+		t.Errorf("visited %v, want every shard in order", visited)
+	}
+	if err == nil {
+		t.Error("expected the failure of shard 1 to be reported")
+	}
+}
+
+func TestColocate(t *testing.T) {
+	router := newTestShardRouter(t, 3, &stubPlacements{placements: map[string]Placement{
+		"alice": {Shard: 2},
+		"bob":   {Shard: 2},
+		"carol": {Shard: 0},
+		"dave":  {Shard: 2, Moving: true},
+	}})
+
+	ctx, err := Colocate(context.Background(), router, "alice", "bob")
+	if err != nil {
+		t.Fatalf("Colocate(alice, bob): %v", err)
+	}
+	if shard, err := router.route(ctx); err != nil || shard != 2 {
+		t.Errorf("route = %d, %v; want the shared shard 2", shard, err)
+	}
+	if user, ok := ctx.Value(sessionUserKey{}).(string); !ok || user != "" {
+		t.Errorf("session user = %q, want row-level security lifted", user)
+	}
+
+	if _, err := Colocate(context.Background(), router, "alice", "carol"); !errors.Is(err, ErrOwnersApart) {
+		t.Errorf("Colocate(alice, carol) error = %v, want ErrOwnersApart", err)
+	}
+	if _, err := Colocate(context.Background(), router, "alice", "dave"); !errors.Is(err, ErrOwnerMoving) {
+		t.Errorf("Colocate(alice, dave) error = %v, want ErrOwnerMoving", err)
+	}
+}
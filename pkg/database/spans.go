@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// spanTracer creates a client span for every SQL statement as a child of the
+// span in the query context, so RPC latency can be attributed to individual
+// queries. Spans carry the statement text but never its arguments; sqlc
+// queries only use bind parameters, so the text holds no user data.
+type spanTracer struct {
+	tracer oteltrace.Tracer
+}
+
+type spanKey struct{}
+
+func newSpanTracer() *spanTracer {
+	return &spanTracer{
+		tracer: otel.Tracer("pgx"),
+	}
+}
+
+func (t *spanTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	name := queryName(data.SQL)
+	attrs := []attribute.KeyValue{
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation.name", name),
+		attribute.String("db.query.text", sanitizeStatement(data.SQL)),
+	}
+	if conn != nil {
+		connConfig := conn.Config()
+		attrs = append(attrs,
+			attribute.String("db.namespace", connConfig.Database),
+			attribute.String("server.address", connConfig.Host),
+			attribute.Int("server.port", int(connConfig.Port)),
+		)
+	}
+
+	ctx, span := t.tracer.Start(ctx, name,
+		oteltrace.WithSpanKind(oteltrace.SpanKindClient),
+		oteltrace.WithAttributes(attrs...),
+	)
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+func (t *spanTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span, ok := ctx.Value(spanKey{}).(oteltrace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	// A missing row is an expected outcome, not a failed query
+	if data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows) {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+		return
+	}
+	span.SetAttributes(attribute.Int64("db.response.rows_affected", data.CommandTag.RowsAffected()))
+}
+
+// sanitizeStatement drops the sqlc "-- name:" header and collapses
+// whitespace so statements are compact in trace views
+func sanitizeStatement(sql string) string {
+	sql = strings.TrimSpace(sql)
+	if strings.HasPrefix(sql, "-- name: ") {
+		_, sql, _ = strings.Cut(sql, "\n")
+	}
+	return strings.Join(strings.Fields(sql), " ")
+}
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSpanTracer_CreatesChildSpanPerStatement(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := &spanTracer{tracer: provider.Tracer("test")}
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "CreateTask")
+	queryCtx := tracer.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{
+		SQL:  "-- name: CreateTask :one\nINSERT INTO tasks (title)\n  VALUES ($1)",
+		Args: []any{"private title"},
+	})
+	tracer.TraceQueryEnd(queryCtx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("INSERT 0 1")})
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected statement and parent spans, got %d", len(spans))
+	}
+	stmt := spans[0]
+	if stmt.Name() != "CreateTask" || stmt.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Fatalf("expected a CreateTask child span of the RPC span, got %q with parent %s", stmt.Name(), stmt.Parent().SpanID())
+	}
+	for _, attr := range stmt.Attributes() {
+		value := attr.Value.Emit()
+		if strings.Contains(value, "private title") {
+			t.Fatalf("query arguments must not be recorded, got %s=%s", attr.Key, value)
+		}
+		if attr.Key == "db.query.text" && value != "INSERT INTO tasks (title) VALUES ($1)" {
+			t.Fatalf("unexpected sanitized statement %q", value)
+		}
+	}
+}
+
+func TestSpanTracer_NoRowsIsNotAnError(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := &spanTracer{tracer: provider.Tracer("test")}
+
+	ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "-- name: GetTask :one\nSELECT 1"})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: pgx.ErrNoRows})
+
+	spans := recorder.Ended()
+	if len(spans) != 1 || len(spans[0].Events()) != 0 {
+		t.Fatalf("expected one span without recorded errors, got %d spans", len(spans))
+	}
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// queryTracer enforces a per-statement timeout and logs statements that run
+// longer than a threshold. pgx executes each statement with the context
+// returned by TraceQueryStart, so the deadline applies to every query issued
+// by the repositories, including those inside transactions.
+type queryTracer struct {
+	timeout       time.Duration
+	slowThreshold time.Duration
+	logger        *slog.Logger
+}
+
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	start  time.Time
+	sql    string
+	cancel context.CancelFunc
+}
+
+// newQueryTracer returns nil when both the timeout and the slow query
+// threshold are disabled
+func newQueryTracer(timeout, slowThreshold time.Duration, logger *slog.Logger) *queryTracer {
+	if timeout <= 0 && slowThreshold <= 0 {
+		return nil
+	}
+	return &queryTracer{
+		timeout:       timeout,
+		slowThreshold: slowThreshold,
+		logger:        logger,
+	}
+}
+
+func (t *queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	qt := &queryTrace{start: time.Now(), sql: data.SQL}
+	if t.timeout > 0 {
+		ctx, qt.cancel = context.WithTimeout(ctx, t.timeout)
+	}
+	return context.WithValue(ctx, queryTraceKey{}, qt)
+}
+
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	qt, ok := ctx.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		return
+	}
+	if qt.cancel != nil {
+		qt.cancel()
+	}
+
+	elapsed := time.Since(qt.start)
+	if t.slowThreshold <= 0 || elapsed < t.slowThreshold {
+		return
+	}
+
+	// Arguments are never logged; they may contain user content
+	attrs := []any{
+		"query", queryName(qt.sql),
+		"duration", elapsed,
+		"threshold", t.slowThreshold,
+	}
+	if data.Err != nil {
+		attrs = append(attrs, "error", data.Err)
+	}
+	t.logger.WarnContext(ctx, "slow query", attrs...)
+}
+
+// queryName returns the sqlc query name from the "-- name: X :kind" header,
+// or the first line of the statement for queries not generated by sqlc
+func queryName(sql string) string {
+	sql = strings.TrimSpace(sql)
+	if rest, ok := strings.CutPrefix(sql, "-- name: "); ok {
+		if fields := strings.Fields(rest); len(fields) > 0 {
+			return fields[0]
+		}
+	}
+
+	firstLine, _, _ := strings.Cut(sql, "\n")
+	const maxLen = 120
+	if len(firstLine) > maxLen {
+		firstLine = firstLine[:maxLen] + "..."
+	}
+	return firstLine
+}
@@ -0,0 +1,60 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func TestQueryName(t *testing.T) {
+	cases := map[string]string{
+		"-- name: ListTasks :many\nSELECT * FROM tasks": "ListTasks",
+		"\n-- name: GetTag :one\nSELECT 1":              "GetTag",
+		"begin":                                         "begin",
+		"SELECT 1\nFROM dual":                           "SELECT 1",
+	}
+	for sql, want := range cases {
+		if got := queryName(sql); got != want {
+			t.Errorf("queryName(%q) = %q, want %q", sql, got, want)
+		}
+	}
+}
+
+func TestQueryTracer_AppliesTimeoutAndLogsSlowQueries(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	tracer := newQueryTracer(time.Minute, time.Millisecond, logger)
+
+	ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{
+		SQL:  "-- name: ListTasks :many\nSELECT * FROM tasks WHERE owner_id = $1",
+		Args: []any{"secret-owner"},
+	})
+	if _, ok := ctx.Deadline(); !ok {
+		t.Fatal("expected the query context to carry a deadline")
+	}
+
+	time.Sleep(2 * time.Millisecond)
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+
+	if ctx.Err() == nil {
+		t.Fatal("expected the query context to be cancelled when the query ends")
+	}
+	out := buf.String()
+	if !strings.Contains(out, "slow query") || !strings.Contains(out, "query=ListTasks") {
+		t.Fatalf("expected a slow query log for ListTasks, got %q", out)
+	}
+	if strings.Contains(out, "secret-owner") {
+		t.Fatalf("query arguments must not be logged, got %q", out)
+	}
+}
+
+func TestNewQueryTracer_DisabledReturnsNil(t *testing.T) {
+	if tracer := newQueryTracer(0, 0, slog.Default()); tracer != nil {
+		t.Fatal("expected no tracer when timeout and threshold are disabled")
+	}
+}
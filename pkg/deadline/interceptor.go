@@ -0,0 +1,50 @@
+// Package deadline bounds how long unary RPCs may run, so a slow query
+// cannot hold a database connection for as long as a client is willing to
+// wait.
+package deadline
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Options controls the deadlines enforced by UnaryServerInterceptor
+type Options struct {
+	// Default is the timeout of RPCs sent without a deadline; 0 leaves them
+	// unbounded
+	Default time.Duration
+	// Max is the longest deadline accepted; RPCs asking for more are
+	// rejected. 0 accepts any deadline.
+	Max time.Duration
+}
+
+// UnaryServerInterceptor returns a gRPC unary interceptor that applies
+// opts.Default to RPCs without a deadline and rejects RPCs whose deadline is
+// further away than opts.Max with InvalidArgument. Streaming RPCs are
+// long-lived by design and are not bounded.
+func UnaryServerInterceptor(opts Options) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		deadline, ok := ctx.Deadline()
+		switch {
+		case !ok && opts.Default > 0:
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, opts.Default)
+			defer cancel()
+		case ok && opts.Max > 0:
+			if timeout := time.Until(deadline); timeout > opts.Max {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"deadline of %s exceeds the maximum of %s", timeout.Round(time.Second), opts.Max)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,72 @@
+package deadline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// deadlineHandler returns the time left until the deadline the handler sees
+func deadlineHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return time.Duration(0), nil
+	}
+	return time.Until(deadline), nil
+}
+
+func TestUnaryServerInterceptor_AppliesDefault(t *testing.T) {
+	interceptor := UnaryServerInterceptor(Options{Default: 30 * time.Second, Max: 5 * time.Minute})
+
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, deadlineHandler)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if left := resp.(time.Duration); left <= 0 || left > 30*time.Second {
+		t.Errorf("handler deadline in %s, want within 30s", left)
+	}
+}
+
+func TestUnaryServerInterceptor_KeepsClientDeadline(t *testing.T) {
+	interceptor := UnaryServerInterceptor(Options{Default: 30 * time.Second, Max: 5 * time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, deadlineHandler)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if left := resp.(time.Duration); left <= 30*time.Second {
+		t.Errorf("handler deadline in %s, want the client's 2m", left)
+	}
+}
+
+func TestUnaryServerInterceptor_RejectsLongDeadline(t *testing.T) {
+	interceptor := UnaryServerInterceptor(Options{Default: 30 * time.Second, Max: 5 * time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, deadlineHandler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestUnaryServerInterceptor_Disabled(t *testing.T) {
+	interceptor := UnaryServerInterceptor(Options{})
+
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, deadlineHandler)
+	if err != nil || resp.(time.Duration) != 0 {
+		t.Errorf("got deadline in %v, %v; want none", resp, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	if _, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, deadlineHandler); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+// Package domainerrors classifies the errors domain packages return, so
+// grpcerrors.ToGRPCError can map them to a status code without knowing each
+// service's errors.
+package domainerrors
+
+import "errors"
+
+// Kinds of domain errors. Every Error matches exactly one of them with
+// errors.Is.
+var (
+	// ErrNotFound is the kind of errors for resources that do not exist or
+	// belong to another user
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is the kind of errors for resources that clash with an
+	// existing one, e.g. by name
+	ErrConflict = errors.New("conflict")
+	// ErrQuotaExceeded is the kind of errors for users at a per-user limit,
+	// which they get below by deleting something
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	// ErrPermissionDenied is the kind of errors for callers not allowed to
+	// do what they asked
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrUnavailable is the kind of errors for requests that fail for a
+	// while and succeed when retried later
+	ErrUnavailable = errors.New("unavailable")
+	// ErrFailedPrecondition is the kind of errors for requests that cannot
+	// succeed until something else is done first
+	ErrFailedPrecondition = errors.New("failed precondition")
+)
+
+// Error is a domain error of a kind. Its message is returned to clients, so
+// it must not contain internal details.
+type Error struct {
+	kind    error
+	message string
+}
+
+// New creates an error of kind, one of the kinds above
+func New(kind error, message string) error {
+	return &Error{kind: kind, message: message}
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Unwrap returns the kind, so errors.Is(err, ErrNotFound) and the like
+// match
+func (e *Error) Unwrap() error {
+	return e.kind
+}
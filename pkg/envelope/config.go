@@ -0,0 +1,48 @@
+package envelope
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slips-ai/slips-core/pkg/config"
+	"github.com/slips-ai/slips-core/pkg/secrets"
+)
+
+// NewKeyringFromConfig builds the keyring described by cfg, resolving local
+// keys through resolver. It returns nil when encryption is disabled.
+func NewKeyringFromConfig(ctx context.Context, cfg config.EncryptionConfig, resolver *secrets.Resolver) (*Keyring, error) {
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	var primary KeyWrapper
+	var others []KeyWrapper
+	for _, keyCfg := range cfg.Keys {
+		var key KeyWrapper
+		switch {
+		case keyCfg.Local != "":
+			encoded, err := resolver.Resolve(ctx, keyCfg.Local)
+			if err != nil {
+				return nil, fmt.Errorf("resolve encryption key %s: %w", keyCfg.ID, err)
+			}
+			local, err := NewLocalKey(keyCfg.ID, encoded)
+			if err != nil {
+				return nil, err
+			}
+			key = local
+		default:
+			kmsKey, err := NewAWSKMSKey(ctx, keyCfg.ID, keyCfg.AWSKMS)
+			if err != nil {
+				return nil, fmt.Errorf("create KMS client for encryption key %s: %w", keyCfg.ID, err)
+			}
+			key = kmsKey
+		}
+
+		if keyCfg.ID == cfg.PrimaryKey {
+			primary = key
+		} else {
+			others = append(others, key)
+		}
+	}
+	return NewKeyring(primary, others...)
+}
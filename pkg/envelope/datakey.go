@@ -0,0 +1,56 @@
+package envelope
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// dataKeyPrefix marks values sealed directly with a data key
+const dataKeyPrefix = "enc:d1:"
+
+// GenerateDataKey returns a random key for SealWithDataKey. Callers that
+// seal many values of one owner store it wrapped with Keyring.Encrypt.
+func GenerateDataKey() ([]byte, error) {
+	key := make([]byte, dataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// SealWithDataKey encrypts plaintext with a data key obtained from
+// GenerateDataKey. The empty string is returned unchanged.
+func SealWithDataKey(key []byte, plaintext, aad string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	sealed, err := seal(key, []byte(plaintext), []byte(aad))
+	if err != nil {
+		return "", err
+	}
+	return dataKeyPrefix + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// OpenWithDataKey reverses SealWithDataKey. Values that are not sealed are
+// returned as Unescape returns them.
+func OpenWithDataKey(key []byte, value, aad string) (string, error) {
+	if !IsSealedWithDataKey(value) {
+		return Unescape(value), nil
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(value, dataKeyPrefix))
+	if err != nil {
+		return "", errors.New("envelope: malformed ciphertext")
+	}
+	plaintext, err := open(key, sealed, []byte(aad))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// IsSealedWithDataKey reports whether value was produced by SealWithDataKey
+func IsSealedWithDataKey(value string) bool {
+	return strings.HasPrefix(value, dataKeyPrefix)
+}
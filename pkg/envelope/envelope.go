@@ -0,0 +1,208 @@
+// Package envelope encrypts individual values with envelope encryption.
+//
+// Every value gets a fresh 256-bit data key. The value is sealed with the
+// data key using AES-GCM and the data key is wrapped by a key encryption key
+// (KEK), which is either a local AES key or a cloud KMS key. The result is
+// a self-describing string:
+//
+//	enc:v1:<kek id>:<wrapped data key>:<nonce and ciphertext>
+//
+// A Keyring encrypts with its primary KEK and decrypts with any KEK it
+// holds, so keys are rotated by adding a new primary and re-encrypting the
+// stored values while the old key is still configured. Strings without the
+// prefix are treated as plaintext. Plaintext stored where encrypted values
+// may also be found goes through Escape, so text that merely starts like an
+// encrypted value is not taken for one.
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix marks values produced by Encrypt
+const prefix = "enc:v1:"
+
+// reservedPrefix starts every encrypted form, current and future
+const reservedPrefix = "enc:"
+
+// plainPrefix marks plaintext that Escape kept from looking encrypted
+const plainPrefix = "enc:p0:"
+
+const dataKeySize = 32
+
+// ErrUnknownKey is returned when a value was encrypted with a KEK that is
+// not in the keyring
+var ErrUnknownKey = errors.New("envelope: value was encrypted with an unknown key")
+
+// KeyWrapper protects data keys with a key encryption key
+type KeyWrapper interface {
+	// ID identifies the KEK in encrypted values; it must not contain ':'
+	ID() string
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// Keyring encrypts with a primary KEK and decrypts with any of its KEKs
+type Keyring struct {
+	primary KeyWrapper
+	keys    map[string]KeyWrapper
+}
+
+// NewKeyring creates a keyring that encrypts with primary. others are only
+// used to decrypt values written before a rotation.
+func NewKeyring(primary KeyWrapper, others ...KeyWrapper) (*Keyring, error) {
+	k := &Keyring{primary: primary, keys: make(map[string]KeyWrapper)}
+	for _, key := range append([]KeyWrapper{primary}, others...) {
+		if key.ID() == "" || strings.Contains(key.ID(), ":") {
+			return nil, fmt.Errorf("envelope: invalid key id %q", key.ID())
+		}
+		if _, ok := k.keys[key.ID()]; ok {
+			return nil, fmt.Errorf("envelope: duplicate key id %q", key.ID())
+		}
+		k.keys[key.ID()] = key
+	}
+	return k, nil
+}
+
+// PrimaryKeyID returns the ID of the KEK new values are encrypted with
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primary.ID()
+}
+
+// Encrypt seals plaintext. aad binds the value to its context, such as the
+// column and row it is stored in, so it cannot be moved to another row.
+// The empty string is returned unchanged.
+func (k *Keyring) Encrypt(ctx context.Context, plaintext, aad string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	sealed, err := seal(dataKey, []byte(plaintext), []byte(aad))
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := k.primary.Wrap(ctx, dataKey)
+	if err != nil {
+		return "", fmt.Errorf("envelope: wrap data key: %w", err)
+	}
+
+	return prefix + k.primary.ID() + ":" +
+		base64.RawURLEncoding.EncodeToString(wrapped) + ":" +
+		base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value produced by Encrypt with the same aad. Values that
+// are not encrypted are returned as Unescape returns them.
+func (k *Keyring) Decrypt(ctx context.Context, value, aad string) (string, error) {
+	if !IsEncrypted(value) {
+		return Unescape(value), nil
+	}
+
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if len(parts) != 3 {
+		return "", errors.New("envelope: malformed value")
+	}
+	key, ok := k.keys[parts[0]]
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownKey, parts[0])
+	}
+	wrapped, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", errors.New("envelope: malformed data key")
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", errors.New("envelope: malformed ciphertext")
+	}
+
+	dataKey, err := key.Unwrap(ctx, wrapped)
+	if err != nil {
+		return "", fmt.Errorf("envelope: unwrap data key: %w", err)
+	}
+	plaintext, err := open(dataKey, sealed, []byte(aad))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation reports whether value should be re-encrypted: it is
+// plaintext or was encrypted with a KEK other than the primary
+func (k *Keyring) NeedsRotation(value string) bool {
+	if value == "" {
+		return false
+	}
+	if !IsEncrypted(value) {
+		return true
+	}
+	return !strings.HasPrefix(value, prefix+k.primary.ID()+":")
+}
+
+// IsEncrypted reports whether value was produced by Encrypt
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Escape prepares plaintext to be stored unencrypted next to encrypted
+// values. Text starting with the prefix reserved for encrypted values is
+// marked, anything else is returned unchanged.
+func Escape(plaintext string) string {
+	if strings.HasPrefix(plaintext, reservedPrefix) {
+		return plainPrefix + plaintext
+	}
+	return plaintext
+}
+
+// Unescape reverses Escape on a stored value that is not encrypted
+func Unescape(value string) string {
+	return strings.TrimPrefix(value, plainPrefix)
+}
+
+// seal encrypts plaintext with AES-GCM and prepends the random nonce
+func seal(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+// open reverses seal
+func open(key, sealed, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("envelope: ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, errors.New("envelope: decryption failed")
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,178 @@
+package envelope
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newTestKey(t *testing.T, id string, fill byte) *LocalKey {
+	t.Helper()
+	key, err := NewLocalKey(id, base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(fill), 32))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	ctx := context.Background()
+	keyring, err := NewKeyring(newTestKey(t, "k1", 'a'))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted, err := keyring.Encrypt(ctx, "tvly-secret", "users.tavily_mcp_token:u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsEncrypted(encrypted) || strings.Contains(encrypted, "tvly-secret") {
+		t.Fatalf("value is not encrypted: %q", encrypted)
+	}
+
+	decrypted, err := keyring.Decrypt(ctx, encrypted, "users.tavily_mcp_token:u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decrypted != "tvly-secret" {
+		t.Errorf("Decrypt = %q, want tvly-secret", decrypted)
+	}
+
+	// The value cannot be moved to another row
+	if _, err := keyring.Decrypt(ctx, encrypted, "users.tavily_mcp_token:u2"); err == nil {
+		t.Error("Decrypt with different aad succeeded")
+	}
+}
+
+func TestDecryptPlaintextAndEmpty(t *testing.T) {
+	ctx := context.Background()
+	keyring, err := NewKeyring(newTestKey(t, "k1", 'a'))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := keyring.Decrypt(ctx, "legacy", "aad"); err != nil || got != "legacy" {
+		t.Errorf("Decrypt(plaintext) = %q, %v", got, err)
+	}
+	if got, err := keyring.Encrypt(ctx, "", "aad"); err != nil || got != "" {
+		t.Errorf("Encrypt(\"\") = %q, %v", got, err)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	ctx := context.Background()
+	keyring, err := NewKeyring(newTestKey(t, "k1", 'a'))
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := GenerateDataKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, plaintext := range []string{"plain", "enc:v1:k1:not:encrypted", "enc:d1:typed by a user", "enc:p0:already marked"} {
+		stored := Escape(plaintext)
+		if IsEncrypted(stored) || IsSealedWithDataKey(stored) {
+			t.Errorf("Escape(%q) = %q looks encrypted", plaintext, stored)
+		}
+		if got, err := keyring.Decrypt(ctx, stored, "aad"); err != nil || got != plaintext {
+			t.Errorf("Decrypt(Escape(%q)) = %q, %v", plaintext, got, err)
+		}
+		if got, err := OpenWithDataKey(key, stored, "aad"); err != nil || got != plaintext {
+			t.Errorf("OpenWithDataKey(Escape(%q)) = %q, %v", plaintext, got, err)
+		}
+	}
+	if got := Escape("plain"); got != "plain" {
+		t.Errorf("Escape(plain) = %q, want it unchanged", got)
+	}
+}
+
+func TestRotation(t *testing.T) {
+	ctx := context.Background()
+	oldKey := newTestKey(t, "old", 'a')
+	newKey := newTestKey(t, "new", 'b')
+
+	before, err := NewKeyring(oldKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := before.Encrypt(ctx, "secret", "aad")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	after, err := NewKeyring(newKey, oldKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !after.NeedsRotation(encrypted) {
+		t.Error("value under the old key does not need rotation")
+	}
+	if !after.NeedsRotation("plaintext") {
+		t.Error("plaintext value does not need rotation")
+	}
+	decrypted, err := after.Decrypt(ctx, encrypted, "aad")
+	if err != nil || decrypted != "secret" {
+		t.Fatalf("Decrypt after rotation = %q, %v", decrypted, err)
+	}
+	rotated, err := after.Encrypt(ctx, decrypted, "aad")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.NeedsRotation(rotated) {
+		t.Error("re-encrypted value still needs rotation")
+	}
+
+	// Once the old key is removed its values can no longer be read
+	newOnly, err := NewKeyring(newKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newOnly.Decrypt(ctx, encrypted, "aad"); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Decrypt with removed key error = %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestNewLocalKeyValidation(t *testing.T) {
+	if _, err := NewLocalKey("k", "not base64!"); err == nil {
+		t.Error("invalid base64 accepted")
+	}
+	if _, err := NewLocalKey("k", base64.StdEncoding.EncodeToString([]byte("short"))); err == nil {
+		t.Error("short key accepted")
+	}
+}
+
+func TestDataKey(t *testing.T) {
+	key, err := GenerateDataKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sealed, err := SealWithDataKey(key, "my notes", "tasks.notes:u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsSealedWithDataKey(sealed) || IsEncrypted(sealed) {
+		t.Fatalf("unexpected sealed form %q", sealed)
+	}
+	opened, err := OpenWithDataKey(key, sealed, "tasks.notes:u1")
+	if err != nil || opened != "my notes" {
+		t.Fatalf("OpenWithDataKey = %q, %v", opened, err)
+	}
+	if _, err := OpenWithDataKey(key, sealed, "tasks.notes:u2"); err == nil {
+		t.Error("OpenWithDataKey with different aad succeeded")
+	}
+
+	other, err := GenerateDataKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenWithDataKey(other, sealed, "tasks.notes:u1"); err == nil {
+		t.Error("OpenWithDataKey with another key succeeded")
+	}
+	if got, err := OpenWithDataKey(key, "plain notes", "aad"); err != nil || got != "plain notes" {
+		t.Errorf("OpenWithDataKey(plaintext) = %q, %v", got, err)
+	}
+}
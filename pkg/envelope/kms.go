@@ -0,0 +1,55 @@
+package envelope
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+)
+
+// AWSKMSKey wraps data keys with an AWS KMS key, so the KEK never leaves KMS
+type AWSKMSKey struct {
+	id     string
+	keyARN string
+	client *kms.Client
+}
+
+// NewAWSKMSKey creates a KEK backed by the KMS key with the given ID, ARN or
+// alias, using the default AWS credential chain and region
+func NewAWSKMSKey(ctx context.Context, id, keyARN string) (*AWSKMSKey, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AWSKMSKey{id: id, keyARN: keyARN, client: kms.NewFromConfig(cfg)}, nil
+}
+
+// ID returns the key's identifier
+func (k *AWSKMSKey) ID() string {
+	return k.id
+}
+
+// Wrap encrypts a data key with KMS
+func (k *AWSKMSKey) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	out, err := k.client.Encrypt(ctx, &kms.EncryptInput{
+		KeyId:     aws.String(k.keyARN),
+		Plaintext: dataKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.CiphertextBlob, nil
+}
+
+// Unwrap decrypts a data key with KMS
+func (k *AWSKMSKey) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	out, err := k.client.Decrypt(ctx, &kms.DecryptInput{
+		KeyId:          aws.String(k.keyARN),
+		CiphertextBlob: wrapped,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Plaintext, nil
+}
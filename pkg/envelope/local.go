@@ -0,0 +1,41 @@
+package envelope
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+)
+
+// LocalKey wraps data keys with an AES-256 key held by the server, usually
+// loaded from a secret manager
+type LocalKey struct {
+	id  string
+	key []byte
+}
+
+// NewLocalKey creates a KEK from a base64 encoded 32-byte key
+func NewLocalKey(id, encoded string) (*LocalKey, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("envelope: key %q is not valid base64: %w", id, err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("envelope: key %q must be 32 bytes, got %d", id, len(key))
+	}
+	return &LocalKey{id: id, key: key}, nil
+}
+
+// ID returns the key's identifier
+func (k *LocalKey) ID() string {
+	return k.id
+}
+
+// Wrap encrypts a data key with AES-GCM
+func (k *LocalKey) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	return seal(k.key, dataKey, []byte(k.id))
+}
+
+// Unwrap decrypts a data key wrapped by Wrap
+func (k *LocalKey) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	return open(k.key, wrapped, []byte(k.id))
+}
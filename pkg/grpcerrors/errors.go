@@ -3,9 +3,10 @@ package grpcerrors
 import (
 	"errors"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
-	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -15,38 +16,65 @@ const (
 	MaxTitleLength = 500
 	// MaxNotesLength is the maximum allowed length for task notes
 	MaxNotesLength = 50000
-	// MaxTagNameLength is the maximum allowed length for tag names
+	// MaxTagNameLength is the maximum allowed length for tag names, in
+	// characters rather than bytes
 	MaxTagNameLength = 100
-	// MaxChecklistItemLength is the maximum allowed length for checklist item text
-	MaxChecklistItemLength = 1000
+	// MaxSavedFilterNameLength is the maximum allowed length for saved filter names
+	MaxSavedFilterNameLength = 255
+	// MaxFilterQueryLength is the maximum allowed length for filter text queries
+	MaxFilterQueryLength = 500
+	// MaxClientRequestIDLength is the maximum allowed length for client request IDs
+	MaxClientRequestIDLength = 255
+	// MaxWebhookNameLength is the maximum allowed length for webhook names
+	MaxWebhookNameLength = 255
+	// MaxAppPasswordNameLength is the maximum allowed length for app password names
+	MaxAppPasswordNameLength = 255
+	// MaxFeedNameLength is the maximum allowed length for feed names
+	MaxFeedNameLength = 255
+	// MaxUserAgentLength is the maximum allowed length for push subscription user agents
+	MaxUserAgentLength = 255
 )
 
-// ToGRPCError converts an error to an appropriate gRPC status error
-// Note: This includes the original error which may contain sensitive info.
-// Use with caution in production and ensure detailed errors are logged server-side.
+// ToGRPCError converts an error to an appropriate gRPC status error.
+// Domain errors are returned with their own message and the code of their
+// kind; other errors, including database errors that repositories did not
+// translate, are Internal with defaultMsg, so internal details do not leak.
+// Detailed errors should be logged server-side.
 func ToGRPCError(err error, defaultMsg string) error {
 	if err == nil {
 		return nil
 	}
 
-	// Check for not found errors
-	if errors.Is(err, pgx.ErrNoRows) {
-		return status.Errorf(codes.NotFound, "%s", defaultMsg)
-	}
-
-	// Check for unique constraint violations
-	var pgErr *pgconn.PgError
-	if errors.As(err, &pgErr) {
-		// 23505 is the PostgreSQL error code for unique_violation
-		if pgErr.Code == "23505" {
-			return status.Errorf(codes.AlreadyExists, "%s: duplicate entry", defaultMsg)
-		}
+	var domainErr *domainerrors.Error
+	if errors.As(err, &domainErr) {
+		return status.Error(domainCode(domainErr), domainErr.Error())
 	}
 
 	// Default to internal error - don't leak internal details
 	return status.Errorf(codes.Internal, "%s", defaultMsg)
 }
 
+// domainCode returns the status code for the kind of err. Quotas map to
+// FailedPrecondition rather than ResourceExhausted, which the server keeps
+// for rate limits that pass by waiting.
+func domainCode(err *domainerrors.Error) codes.Code {
+	switch {
+	case errors.Is(err, domainerrors.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, domainerrors.ErrConflict):
+		return codes.AlreadyExists
+	case errors.Is(err, domainerrors.ErrQuotaExceeded):
+		return codes.FailedPrecondition
+	case errors.Is(err, domainerrors.ErrPermissionDenied):
+		return codes.PermissionDenied
+	case errors.Is(err, domainerrors.ErrUnavailable):
+		return codes.Unavailable
+	case errors.Is(err, domainerrors.ErrFailedPrecondition):
+		return codes.FailedPrecondition
+	}
+	return codes.Internal
+}
+
 // ValidateNotEmpty validates that a string is not empty
 func ValidateNotEmpty(value, fieldName string) error {
 	if strings.TrimSpace(value) == "" {
@@ -63,19 +91,26 @@ func ValidateLength(value, fieldName string, maxLength int) error {
 	return nil
 }
 
-// ValidateTagName validates tag name requirements
+// ValidateTagName validates tag name requirements. The length is counted in
+// code points, as the database counts it, so CJK names get the full
+// MaxTagNameLength. Whitespace is allowed since tag names are normalized
+// before they are stored; other control characters are not. Format
+// characters such as zero width joiners and variation selectors are allowed
+// so emoji sequences can be used.
 func ValidateTagName(name string) error {
 	if err := ValidateNotEmpty(name, "name"); err != nil {
 		return err
 	}
-	if err := ValidateLength(name, "name", MaxTagNameLength); err != nil {
-		return err
+	if utf8.RuneCountInString(name) > MaxTagNameLength {
+		return status.Errorf(codes.InvalidArgument, "name exceeds maximum length of %d characters", MaxTagNameLength)
 	}
 	// Check for control characters and other invalid characters
-	for i, r := range name {
-		if r < 32 || r == 127 {
-			return status.Errorf(codes.InvalidArgument, "name contains invalid character at position %d", i)
+	position := 0
+	for _, r := range name {
+		if r == utf8.RuneError || (unicode.IsControl(r) && !unicode.IsSpace(r)) {
+			return status.Errorf(codes.InvalidArgument, "name contains invalid character at position %d", position)
 		}
+		position++
 	}
 	return nil
 }
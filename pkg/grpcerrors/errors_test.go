@@ -0,0 +1,68 @@
+package grpcerrors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/slips-ai/slips-core/pkg/domainerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateTagName(t *testing.T) {
+	tests := []struct {
+		name    string
+		tagName string
+		wantErr bool
+	}{
+		{name: "ascii", tagName: "work"},
+		{name: "cjk at the limit", tagName: strings.Repeat("仕", MaxTagNameLength)},
+		{name: "cjk over the limit", tagName: strings.Repeat("仕", MaxTagNameLength+1), wantErr: true},
+		{name: "zwj emoji sequence", tagName: "👨‍👩‍👧‍👦 family"},
+		{name: "emoji with variation selector", tagName: "❤️"},
+		{name: "subdivision flag", tagName: "🏴󠁧󠁢󠁳󠁣󠁴󠁿"},
+		{name: "whitespace is normalized later", tagName: "deep\twork"},
+		{name: "blank", tagName: " \t ", wantErr: true},
+		{name: "control character", tagName: "a\x00b", wantErr: true},
+		{name: "c1 control character", tagName: "a\u009bb", wantErr: true},
+		{name: "delete", tagName: "a\x7fb", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTagName(tt.tagName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateTagName(%q) error = %v, wantErr %v", tt.tagName, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestToGRPCError(t *testing.T) {
+	taskNotFound := domainerrors.New(domainerrors.ErrNotFound, "task not found")
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{name: "domain error keeps its message", err: fmt.Errorf("get: %w", taskNotFound), code: codes.NotFound, message: "task not found"},
+		{name: "conflict", err: domainerrors.New(domainerrors.ErrConflict, "a tag with this name already exists"), code: codes.AlreadyExists, message: "a tag with this name already exists"},
+		{name: "quota", err: fmt.Errorf("create: %w", domainerrors.New(domainerrors.ErrQuotaExceeded, "at most 25 feeds are allowed")), code: codes.FailedPrecondition, message: "at most 25 feeds are allowed"},
+		{name: "permission", err: domainerrors.New(domainerrors.ErrPermissionDenied, "admin access required"), code: codes.PermissionDenied, message: "admin access required"},
+		{name: "unavailable", err: fmt.Errorf("update: %w", domainerrors.New(domainerrors.ErrUnavailable, "data is being moved, retry later")), code: codes.Unavailable, message: "data is being moved, retry later"},
+		{name: "failed precondition", err: domainerrors.New(domainerrors.ErrFailedPrecondition, "move one user first"), code: codes.FailedPrecondition, message: "move one user first"},
+		{name: "database errors are not mapped", err: pgx.ErrNoRows, code: codes.Internal, message: "failed to get"},
+		{name: "other errors do not leak", err: errors.New("connection refused"), code: codes.Internal, message: "failed to get"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(ToGRPCError(tt.err, "failed to get"))
+			if st.Code() != tt.code || st.Message() != tt.message {
+				t.Errorf("ToGRPCError(%v) = %v %q, want %v %q", tt.err, st.Code(), st.Message(), tt.code, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Package jobs runs periodic background jobs, such as retention policies,
+// next to the gRPC server.
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Job is a unit of background work run on a fixed interval
+type Job struct {
+	// Name identifies the job in logs
+	Name string
+	// Interval is the time between runs; a non-positive interval disables the job
+	Interval time.Duration
+	// Run performs one run. Errors are logged and the job stays scheduled.
+	Run func(ctx context.Context) error
+}
+
+// Runner starts tracked goroutines; *shutdown.Coordinator implements it
+type Runner interface {
+	Go(fn func(ctx context.Context)) bool
+}
+
+// Scheduler runs registered jobs on their interval until stopped.
+// Runs of the same job never overlap; a run that takes longer than the
+// interval delays the next one.
+type Scheduler struct {
+	logger *slog.Logger
+	jobs   []Job
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewScheduler creates a scheduler without jobs
+func NewScheduler(logger *slog.Logger) *Scheduler {
+	return &Scheduler{
+		logger: logger,
+		stop:   make(chan struct{}),
+	}
+}
+
+// Register adds a job. It must be called before Start.
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs each enabled job in a goroutine started by runner. The first
+// run happens one interval after Start, so restarts do not trigger a burst
+// of work.
+func (s *Scheduler) Start(runner Runner) {
+	for _, job := range s.jobs {
+		if job.Interval <= 0 {
+			s.logger.Info("background job disabled", "job", job.Name)
+			continue
+		}
+		if !runner.Go(func(ctx context.Context) { s.loop(ctx, job) }) {
+			s.logger.Warn("background job not started, shutting down", "job", job.Name)
+		}
+	}
+}
+
+// Stop prevents further runs. A run in progress finishes with the context
+// provided by the runner, so a shutdown coordinator can wait for it within
+// its drain timeout.
+func (s *Scheduler) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.run(ctx, job)
+		}
+	}
+}
+
+// run performs one run of job, turning a panic into a logged error so one
+// faulty job cannot take down the server
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	start := time.Now()
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		return job.Run(ctx)
+	}()
+
+	if err != nil {
+		s.logger.ErrorContext(ctx, "background job failed", "job", job.Name, "duration", time.Since(start), "error", err)
+		return
+	}
+	s.logger.DebugContext(ctx, "background job finished", "job", job.Name, "duration", time.Since(start))
+}
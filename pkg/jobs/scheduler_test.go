@@ -0,0 +1,80 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// goRunner tracks goroutines like shutdown.Coordinator, without a drain timeout
+type goRunner struct {
+	wg sync.WaitGroup
+}
+
+func (r *goRunner) Go(fn func(ctx context.Context)) bool {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		fn(context.Background())
+	}()
+	return true
+}
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestSchedulerRunsJobsUntilStopped(t *testing.T) {
+	s := NewScheduler(discardLogger())
+
+	var runs atomic.Int32
+	s.Register(Job{
+		Name:     "counter",
+		Interval: 5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			if runs.Add(1) == 1 {
+				return errors.New("first run fails")
+			}
+			return nil
+		},
+	})
+	s.Register(Job{
+		Name:     "panics",
+		Interval: 5 * time.Millisecond,
+		Run:      func(ctx context.Context) error { panic("boom") },
+	})
+	s.Register(Job{
+		Name:     "disabled",
+		Interval: 0,
+		Run: func(ctx context.Context) error {
+			t.Error("disabled job ran")
+			return nil
+		},
+	})
+
+	runner := &goRunner{}
+	s.Start(runner)
+
+	deadline := time.Now().Add(time.Second)
+	for runs.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := runs.Load(); got < 3 {
+		t.Fatalf("job ran %d times, want at least 3 despite errors and a panicking neighbour", got)
+	}
+
+	s.Stop()
+	s.Stop() // idempotent
+	runner.wg.Wait()
+
+	stopped := runs.Load()
+	time.Sleep(20 * time.Millisecond)
+	if got := runs.Load(); got != stopped {
+		t.Errorf("job ran %d more times after Stop", got-stopped)
+	}
+}
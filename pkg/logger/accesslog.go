@@ -0,0 +1,151 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// AccessLogOptions controls which RPCs the access log interceptor records
+type AccessLogOptions struct {
+	// SampleRate is the fraction of successful RPCs that are logged, from 0
+	// to 1. Failed RPCs are always logged.
+	SampleRate float64
+}
+
+// AccessLogInterceptor returns a gRPC unary interceptor that logs one line per
+// RPC with its method, user, peer, duration, status code and request size.
+// Install it before the auth interceptor so rejected requests are logged too.
+func AccessLogInterceptor(logger *slog.Logger, opts AccessLogOptions) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+
+		// The auth interceptor fills in the user ID once it has authenticated
+		var userID string
+		resp, err := handler(auth.WithUserIDSlot(ctx, &userID), req)
+
+		code := status.Code(err)
+		if code == codes.OK && !sampled(opts.SampleRate) {
+			return resp, err
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", info.FullMethod),
+			slog.String("code", code.String()),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if userID != "" {
+			attrs = append(attrs, slog.String("user_id", userID))
+		}
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			attrs = append(attrs, slog.String("peer", p.Addr.String()))
+		}
+		if msg, ok := req.(proto.Message); ok {
+			attrs = append(attrs, slog.Int("request_size", proto.Size(msg)))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+		}
+
+		logger.LogAttrs(ctx, accessLogLevel(code), "rpc", attrs...)
+		return resp, err
+	}
+}
+
+// StreamAccessLogInterceptor is the streaming counterpart of
+// AccessLogInterceptor. It logs once the stream ends, with the number of
+// messages sent instead of the request size.
+func StreamAccessLogInterceptor(logger *slog.Logger, opts AccessLogOptions) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+
+		var userID string
+		stream := &countingStream{ServerStream: ss, ctx: auth.WithUserIDSlot(ss.Context(), &userID)}
+		err := handler(srv, stream)
+
+		code := status.Code(err)
+		if code == codes.OK && !sampled(opts.SampleRate) {
+			return err
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", info.FullMethod),
+			slog.String("code", code.String()),
+			slog.Duration("duration", time.Since(start)),
+			slog.Int("messages_sent", stream.sent),
+		}
+		if userID != "" {
+			attrs = append(attrs, slog.String("user_id", userID))
+		}
+		if p, ok := peer.FromContext(ss.Context()); ok && p.Addr != nil {
+			attrs = append(attrs, slog.String("peer", p.Addr.String()))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+		}
+
+		logger.LogAttrs(ss.Context(), accessLogLevel(code), "rpc", attrs...)
+		return err
+	}
+}
+
+// countingStream counts sent messages and carries the user ID slot
+type countingStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent int
+}
+
+func (s *countingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *countingStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+	}
+	return err
+}
+
+// sampled reports whether a successful RPC should be logged
+func sampled(rate float64) bool {
+	if rate >= 1 {
+		return true
+	}
+	if rate <= 0 {
+		return false
+	}
+	return rand.Float64() < rate
+}
+
+// accessLogLevel logs server-side failures as errors, client errors as
+// warnings and successful calls as info
+func accessLogLevel(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		return slog.LevelInfo
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded, codes.Unimplemented:
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
+	}
+}
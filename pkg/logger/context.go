@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// contextHandler adds trace_id, span_id and user_id from the record's
+// context, and mcp_token_id and mcp_token_name for agents calling with an MCP
+// token, so logs written with the *Context methods can be correlated with
+// traces without passing these fields at every call site. Attributes that the
+// call site already set take precedence; the admin service, for example,
+// logs the target user as user_id.
+type contextHandler struct {
+	slog.Handler
+}
+
+func newContextHandler(handler slog.Handler) slog.Handler {
+	return &contextHandler{Handler: handler}
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if ctx == nil {
+		return h.Handler.Handle(ctx, record)
+	}
+
+	var attrs []slog.Attr
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		attrs = append(attrs,
+			slog.String("trace_id", spanContext.TraceID().String()),
+			slog.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+	if userID, err := auth.GetUserID(ctx); err == nil {
+		attrs = append(attrs, slog.String("user_id", userID))
+	}
+	if principal, ok := auth.PrincipalFromContext(ctx); ok && principal.Credential == auth.CredentialMCPToken {
+		attrs = append(attrs,
+			slog.String("mcp_token_id", principal.TokenID.String()),
+			slog.String("mcp_token_name", principal.TokenName),
+		)
+	}
+	if len(attrs) == 0 {
+		return h.Handler.Handle(ctx, record)
+	}
+
+	present := make(map[string]bool, record.NumAttrs())
+	record.Attrs(func(attr slog.Attr) bool {
+		present[attr.Key] = true
+		return true
+	})
+
+	record = record.Clone()
+	for _, attr := range attrs {
+		if !present[attr.Key] {
+			record.AddAttrs(attr)
+		}
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
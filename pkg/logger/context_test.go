@@ -0,0 +1,81 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestContextHandler_AddsTraceAndUser(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(newContextHandler(slog.NewTextHandler(&buf, nil)))
+
+	provider := sdktrace.NewTracerProvider()
+	ctx, span := provider.Tracer("test").Start(context.Background(), "rpc")
+	defer span.End()
+	ctx = auth.WithUserID(ctx, "user-1")
+
+	log.InfoContext(ctx, "task created")
+
+	out := buf.String()
+	for _, want := range []string{
+		"trace_id=" + span.SpanContext().TraceID().String(),
+		"span_id=" + span.SpanContext().SpanID().String(),
+		"user_id=user-1",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in %q", want, out)
+		}
+	}
+}
+
+func TestContextHandler_AddsMCPToken(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(newContextHandler(slog.NewTextHandler(&buf, nil)))
+	tokenID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{
+		UserID:     "user-1",
+		Credential: auth.CredentialMCPToken,
+		TokenID:    tokenID,
+		TokenName:  "Xbot",
+	})
+
+	log.WarnContext(ctx, "audit", "event", "task.deleted")
+
+	out := buf.String()
+	for _, want := range []string{"user_id=user-1", "mcp_token_id=" + tokenID.String(), "mcp_token_name=Xbot"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in %q", want, out)
+		}
+	}
+}
+
+func TestContextHandler_KeepsExplicitAttributes(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(newContextHandler(slog.NewTextHandler(&buf, nil)))
+	ctx := auth.WithUserID(context.Background(), "admin-1")
+
+	log.InfoContext(ctx, "admin exported user data", "user_id", "target-1")
+
+	out := buf.String()
+	if strings.Count(out, "user_id=") != 1 || !strings.Contains(out, "user_id=target-1") {
+		t.Fatalf("expected only the explicit user_id, got %q", out)
+	}
+}
+
+func TestContextHandler_NoContextValues(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(newContextHandler(slog.NewTextHandler(&buf, nil)))
+
+	log.Info("starting")
+
+	if strings.Contains(buf.String(), "trace_id") || strings.Contains(buf.String(), "user_id") {
+		t.Fatalf("expected no correlation attributes without context values, got %q", buf.String())
+	}
+}
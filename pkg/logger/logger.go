@@ -1,28 +1,64 @@
 package logger
 
 import (
+	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 
 	"github.com/lmittmann/tint"
 )
 
-// New creates a new structured logger with tint handler
+// level is shared by every logger created with New so the minimum level can
+// be changed at runtime without a restart
+var level = new(slog.LevelVar)
+
+// New creates a new structured logger with tint handler.
+// It starts at debug level in development and info level otherwise, and
+// adds trace_id, span_id and user_id from the context to every record.
 func New(isDevelopment bool) *slog.Logger {
 	var handler slog.Handler
 
 	if isDevelopment {
+		level.Set(slog.LevelDebug)
 		// Use tint for colorful development logs
 		handler = tint.NewHandler(os.Stdout, &tint.Options{
-			Level:      slog.LevelDebug,
+			Level:      level,
 			TimeFormat: "15:04:05",
 		})
 	} else {
+		level.Set(slog.LevelInfo)
 		// Use JSON for production
 		handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-			Level: slog.LevelInfo,
+			Level: level,
 		})
 	}
 
-	return slog.New(handler)
+	return slog.New(newContextHandler(handler))
+}
+
+// Level returns the current minimum log level
+func Level() slog.Level {
+	return level.Level()
+}
+
+// SetLevel changes the minimum log level of all loggers created with New
+func SetLevel(l slog.Level) {
+	level.Set(l)
+}
+
+// ParseLevel parses "debug", "info", "warn" or "error" (case-insensitive)
+func ParseLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return 0, fmt.Errorf("unknown log level %q: expected debug, info, warn or error", s)
+	}
 }
@@ -0,0 +1,43 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+)
+
+func TestSetLevel_AppliesToExistingLoggers(t *testing.T) {
+	log := New(false)
+	t.Cleanup(func() { SetLevel(slog.LevelInfo) })
+
+	if log.Enabled(context.Background(), slog.LevelDebug) {
+		t.Fatal("expected debug to be disabled in production")
+	}
+
+	SetLevel(slog.LevelDebug)
+	if !log.Enabled(context.Background(), slog.LevelDebug) {
+		t.Fatal("expected debug to be enabled after SetLevel")
+	}
+	if Level() != slog.LevelDebug {
+		t.Fatalf("expected Level() to report debug, got %s", Level())
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	cases := map[string]slog.Level{
+		"debug":   slog.LevelDebug,
+		"INFO":    slog.LevelInfo,
+		" warn ":  slog.LevelWarn,
+		"warning": slog.LevelWarn,
+		"error":   slog.LevelError,
+	}
+	for in, want := range cases {
+		got, err := ParseLevel(in)
+		if err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %s, %v; want %s", in, got, err, want)
+		}
+	}
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Error("expected an error for an unknown level")
+	}
+}
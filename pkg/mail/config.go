@@ -0,0 +1,39 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slips-ai/slips-core/pkg/config"
+)
+
+// NewSenderFromConfig builds the sender described by cfg. smtpPassword
+// returns the current SMTP password, so a rotated secret is picked up
+// without a restart. It returns nil when email is disabled.
+func NewSenderFromConfig(ctx context.Context, cfg config.MailConfig, smtpPassword func() string) (Sender, error) {
+	switch cfg.Provider {
+	case "":
+		return nil, nil
+	case config.MailProviderSMTP:
+		sender, err := NewSMTPSender(SMTPOptions{
+			Host:        cfg.SMTP.Host,
+			Port:        cfg.SMTP.Port,
+			Username:    cfg.SMTP.Username,
+			Password:    smtpPassword,
+			ImplicitTLS: cfg.SMTP.ImplicitTLS,
+			From:        cfg.From,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return sender, nil
+	case config.MailProviderSES:
+		sender, err := NewSESSender(ctx, cfg.SES.Region, cfg.From)
+		if err != nil {
+			return nil, fmt.Errorf("create SES client: %w", err)
+		}
+		return sender, nil
+	default:
+		return nil, fmt.Errorf("unknown mail provider %q", cfg.Provider)
+	}
+}
@@ -0,0 +1,177 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	netmail "net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+var testMessage = &Message{
+	To:      "ada@example.com",
+	Subject: "Your digest — Monday",
+	Text:    "Today (1)\n- Buy milk\n",
+}
+
+// checkMessage parses an encoded message and checks its headers and body
+func checkMessage(t *testing.T, data []byte) {
+	t.Helper()
+	parsed, err := netmail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	if got := parsed.Header.Get("From"); got != `"Slips" <digest@example.com>` {
+		t.Errorf("From = %q", got)
+	}
+	if got := parsed.Header.Get("To"); got != "<ada@example.com>" {
+		t.Errorf("To = %q", got)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil || subject != testMessage.Subject {
+		t.Errorf("Subject = %q (%v)", subject, err)
+	}
+	if !strings.HasSuffix(parsed.Header.Get("Message-ID"), "@example.com>") {
+		t.Errorf("Message-ID = %q", parsed.Header.Get("Message-ID"))
+	}
+	body, err := io.ReadAll(quotedprintable.NewReader(parsed.Body))
+	if err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if got := strings.ReplaceAll(string(body), "\r\n", "\n"); got != testMessage.Text {
+		t.Errorf("body = %q, want %q", got, testMessage.Text)
+	}
+}
+
+func TestSESSender(t *testing.T) {
+	var request sesRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			t.Error("request is not signed")
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		if strings.Contains(request.Destination.ToAddresses[0], "bounce") {
+			w.Header().Set("X-Amzn-ErrorType", "MessageRejected")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"Email address is not verified."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"MessageId":"1"}`))
+	}))
+	defer server.Close()
+
+	credentials := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+	})
+	sender, err := newSESSender(server.URL, "eu-west-1", "Slips <digest@example.com>", credentials)
+	if err != nil {
+		t.Fatalf("new sender: %v", err)
+	}
+
+	if err := sender.Send(context.Background(), testMessage); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if got := request.Destination.ToAddresses; len(got) != 1 || got[0] != "ada@example.com" {
+		t.Errorf("ToAddresses = %v", got)
+	}
+	checkMessage(t, request.Content.Raw.Data)
+
+	err = sender.Send(context.Background(), &Message{To: "bounce@example.com", Subject: "x", Text: "x"})
+	if err == nil || !strings.Contains(err.Error(), "not verified") {
+		t.Errorf("rejected send: err = %v", err)
+	}
+}
+
+func TestSMTPSender(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer listener.Close()
+
+	type delivery struct {
+		from, to string
+		data     []byte
+	}
+	delivered := make(chan delivery, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+		var d delivery
+		reply("220 localhost ESMTP")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.TrimSpace(line)
+			switch {
+			case strings.HasPrefix(command, "EHLO"):
+				reply("250 localhost")
+			case strings.HasPrefix(command, "MAIL FROM:"):
+				d.from = command
+				reply("250 OK")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				d.to = command
+				reply("250 OK")
+			case command == "DATA":
+				reply("354 Go ahead")
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if line == ".\r\n" {
+						break
+					}
+					d.data = append(d.data, strings.TrimPrefix(line, ".")...)
+				}
+				reply("250 Queued")
+			case command == "QUIT":
+				reply("221 Bye")
+				delivered <- d
+				return
+			default:
+				reply("502 Unsupported")
+			}
+		}
+	}()
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	sender, err := NewSMTPSender(SMTPOptions{Host: "127.0.0.1", Port: port, From: "Slips <digest@example.com>"})
+	if err != nil {
+		t.Fatalf("new sender: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := sender.Send(ctx, testMessage); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	d := <-delivered
+	if d.from != "MAIL FROM:<digest@example.com>" && !strings.HasPrefix(d.from, "MAIL FROM:<digest@example.com> ") {
+		t.Errorf("envelope sender = %q", d.from)
+	}
+	if d.to != "RCPT TO:<ada@example.com>" {
+		t.Errorf("envelope recipient = %q", d.to)
+	}
+	checkMessage(t, d.data)
+}
@@ -0,0 +1,133 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+)
+
+// sesEndpoint is the SES v2 SendEmail endpoint of a region
+const sesEndpoint = "https://email.%s.amazonaws.com/v2/email/outbound-emails"
+
+// SESSender delivers messages with the Amazon SES v2 SendEmail API
+type SESSender struct {
+	endpoint    string
+	region      string
+	from        *mail.Address
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	client      *http.Client
+}
+
+// NewSESSender creates an SES sender using the default AWS credential chain.
+// An empty region uses the default region (AWS_REGION, shared config).
+func NewSESSender(ctx context.Context, region, from string) (*SESSender, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if region != "" {
+		cfg.Region = region
+	}
+	if cfg.Region == "" {
+		return nil, errors.New("no AWS region configured for SES")
+	}
+	return newSESSender(fmt.Sprintf(sesEndpoint, cfg.Region), cfg.Region, from, cfg.Credentials)
+}
+
+func newSESSender(endpoint, region, from string, credentials aws.CredentialsProvider) (*SESSender, error) {
+	address, err := parseFrom(from)
+	if err != nil {
+		return nil, err
+	}
+	return &SESSender{
+		endpoint:    endpoint,
+		region:      region,
+		from:        address,
+		credentials: credentials,
+		signer:      v4.NewSigner(),
+		client:      &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+type sesRequest struct {
+	FromEmailAddress string         `json:"FromEmailAddress"`
+	Destination      sesDestination `json:"Destination"`
+	Content          sesContent     `json:"Content"`
+}
+
+type sesDestination struct {
+	ToAddresses []string `json:"ToAddresses"`
+}
+
+type sesContent struct {
+	Raw struct {
+		// Data is base64 encoded by encoding/json
+		Data []byte `json:"Data"`
+	} `json:"Raw"`
+}
+
+// Send delivers msg as a raw MIME message
+func (s *SESSender) Send(ctx context.Context, msg *Message) error {
+	now := time.Now()
+	data, err := encode(msg, s.from, now)
+	if err != nil {
+		return err
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return err
+	}
+
+	request := sesRequest{
+		FromEmailAddress: s.from.String(),
+		Destination:      sesDestination{ToAddresses: []string{to.Address}},
+	}
+	request.Content.Raw.Data = data
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	credentials, err := s.credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieve AWS credentials: %w", err)
+	}
+	payloadHash := sha256.Sum256(body)
+	if err := s.signer.SignHTTP(ctx, credentials, req, hex.EncodeToString(payloadHash[:]), "ses", s.region, now); err != nil {
+		return fmt.Errorf("sign SES request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send SES request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	var failure struct {
+		Message string `json:"message"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&failure)
+	return fmt.Errorf("SES SendEmail: %s (%s): %s", resp.Status, resp.Header.Get("X-Amzn-ErrorType"), failure.Message)
+}
@@ -0,0 +1,110 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SMTPOptions configures an SMTP sender
+type SMTPOptions struct {
+	Host string
+	Port int
+	// Username and Password authenticate with PLAIN auth, which is only
+	// attempted over TLS; an empty username skips authentication
+	Username string
+	Password func() string
+	// ImplicitTLS connects with TLS from the start, as on port 465, instead
+	// of upgrading with STARTTLS when the server offers it
+	ImplicitTLS bool
+	From        string
+}
+
+// SMTPSender delivers messages to an SMTP relay, one connection per message
+type SMTPSender struct {
+	opts SMTPOptions
+	from *mail.Address
+}
+
+// NewSMTPSender creates an SMTP sender
+func NewSMTPSender(opts SMTPOptions) (*SMTPSender, error) {
+	from, err := parseFrom(opts.From)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Password == nil {
+		opts.Password = func() string { return "" }
+	}
+	return &SMTPSender{opts: opts, from: from}, nil
+}
+
+// Send delivers msg. The context bounds the whole SMTP conversation.
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	data, err := encode(msg, s.from, time.Now())
+	if err != nil {
+		return err
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(s.opts.Host, strconv.Itoa(s.opts.Port))
+	var conn net.Conn
+	if s.opts.ImplicitTLS {
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: s.opts.Host}}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("connect to SMTP server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.opts.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if !s.opts.ImplicitTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: s.opts.Host}); err != nil {
+				return fmt.Errorf("SMTP STARTTLS: %w", err)
+			}
+		}
+	}
+	if s.opts.Username != "" {
+		auth := smtp.PlainAuth("", s.opts.Username, s.opts.Password(), s.opts.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP authentication: %w", err)
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("SMTP MAIL FROM: %w", err)
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("SMTP RCPT TO: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("write SMTP message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("SMTP DATA: %w", err)
+	}
+	return client.Quit()
+}
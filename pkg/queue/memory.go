@@ -0,0 +1,138 @@
+package queue
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// MemoryStore keeps jobs in process memory, for single-instance development
+// and tests; jobs are lost on restart
+type MemoryStore struct {
+	now func() time.Time
+
+	mu   sync.Mutex
+	jobs map[uuid.UUID]*memoryJob
+	// failed records when dead letters failed, for ListDead ordering
+	failed map[uuid.UUID]time.Time
+}
+
+type memoryJob struct {
+	job         Job
+	lockedUntil time.Time
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		now:    time.Now,
+		jobs:   make(map[uuid.UUID]*memoryJob),
+		failed: make(map[uuid.UUID]time.Time),
+	}
+}
+
+// Enqueue implements Store
+func (s *MemoryStore) Enqueue(ctx context.Context, job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.ID] = &memoryJob{job: *job}
+	return nil
+}
+
+// Claim implements Store
+func (s *MemoryStore) Claim(ctx context.Context, kinds []string, limit int, lease time.Duration) ([]*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var due []*memoryJob
+	for _, j := range s.jobs {
+		if j.job.Status == StatusPending && slices.Contains(kinds, j.job.Kind) &&
+			!j.job.RunAt.After(now) && !j.lockedUntil.After(now) {
+			due = append(due, j)
+		}
+	}
+	slices.SortFunc(due, func(a, b *memoryJob) int { return a.job.RunAt.Compare(b.job.RunAt) })
+
+	var claimed []*Job
+	for _, j := range due[:min(limit, len(due))] {
+		j.job.Attempts++
+		j.lockedUntil = now.Add(lease)
+		job := j.job
+		claimed = append(claimed, &job)
+	}
+	return claimed, nil
+}
+
+// Complete implements Store
+func (s *MemoryStore) Complete(ctx context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.jobs, id)
+	delete(s.failed, id)
+	return nil
+}
+
+// Retry implements Store
+func (s *MemoryStore) Retry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j, ok := s.jobs[id]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	j.job.RunAt = runAt
+	j.job.LastError = lastError
+	j.lockedUntil = time.Time{}
+	return nil
+}
+
+// Bury implements Store
+func (s *MemoryStore) Bury(ctx context.Context, id uuid.UUID, lastError string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j, ok := s.jobs[id]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	j.job.Status = StatusDead
+	j.job.LastError = lastError
+	j.lockedUntil = time.Time{}
+	s.failed[id] = s.now()
+	return nil
+}
+
+// ListDead implements Store
+func (s *MemoryStore) ListDead(ctx context.Context, limit int) ([]*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var dead []*Job
+	for _, j := range s.jobs {
+		if j.job.Status == StatusDead {
+			job := j.job
+			dead = append(dead, &job)
+		}
+	}
+	slices.SortFunc(dead, func(a, b *Job) int { return s.failed[b.ID].Compare(s.failed[a.ID]) })
+	return dead[:min(limit, len(dead))], nil
+}
+
+// Requeue implements Store
+func (s *MemoryStore) Requeue(ctx context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j, ok := s.jobs[id]
+	if !ok || j.job.Status != StatusDead {
+		return ErrNotDead
+	}
+	j.job.Status = StatusPending
+	j.job.Attempts = 0
+	j.job.RunAt = s.now()
+	delete(s.failed, id)
+	return nil
+}
@@ -0,0 +1,109 @@
+package queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	queuepg "github.com/slips-ai/slips-core/pkg/queue/postgres"
+)
+
+// PostgresStore keeps jobs in the queue_jobs table. Claims lock rows with
+// FOR UPDATE SKIP LOCKED, so workers of every instance share the table
+// without waiting on each other.
+type PostgresStore struct {
+	queries *queuepg.Queries
+}
+
+// NewPostgresStore creates a store on pool
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{queries: queuepg.New(pool)}
+}
+
+// Enqueue implements Store
+func (s *PostgresStore) Enqueue(ctx context.Context, job *Job) error {
+	return s.queries.EnqueueJob(ctx, queuepg.EnqueueJobParams{
+		ID:          pgtype.UUID{Bytes: job.ID, Valid: true},
+		Kind:        job.Kind,
+		Payload:     job.Payload,
+		MaxAttempts: int32(job.MaxAttempts),
+		RunAt:       pgtype.Timestamptz{Time: job.RunAt, Valid: true},
+	})
+}
+
+// Claim implements Store
+func (s *PostgresStore) Claim(ctx context.Context, kinds []string, limit int, lease time.Duration) ([]*Job, error) {
+	rows, err := s.queries.ClaimJobs(ctx, queuepg.ClaimJobsParams{
+		LeaseSeconds: lease.Seconds(),
+		Kinds:        kinds,
+		MaxJobs:      int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jobsFromDB(rows), nil
+}
+
+// Complete implements Store
+func (s *PostgresStore) Complete(ctx context.Context, id uuid.UUID) error {
+	return s.queries.DeleteJob(ctx, pgtype.UUID{Bytes: id, Valid: true})
+}
+
+// Retry implements Store
+func (s *PostgresStore) Retry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error {
+	return s.queries.RetryJob(ctx, queuepg.RetryJobParams{
+		ID:        pgtype.UUID{Bytes: id, Valid: true},
+		RunAt:     pgtype.Timestamptz{Time: runAt, Valid: true},
+		LastError: pgtype.Text{String: lastError, Valid: true},
+	})
+}
+
+// Bury implements Store
+func (s *PostgresStore) Bury(ctx context.Context, id uuid.UUID, lastError string) error {
+	return s.queries.BuryJob(ctx, queuepg.BuryJobParams{
+		ID:        pgtype.UUID{Bytes: id, Valid: true},
+		LastError: pgtype.Text{String: lastError, Valid: true},
+	})
+}
+
+// ListDead implements Store
+func (s *PostgresStore) ListDead(ctx context.Context, limit int) ([]*Job, error) {
+	rows, err := s.queries.ListDeadJobs(ctx, int32(limit))
+	if err != nil {
+		return nil, err
+	}
+	return jobsFromDB(rows), nil
+}
+
+// Requeue implements Store
+func (s *PostgresStore) Requeue(ctx context.Context, id uuid.UUID) error {
+	requeued, err := s.queries.RequeueDeadJob(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if err != nil {
+		return err
+	}
+	if requeued == 0 {
+		return ErrNotDead
+	}
+	return nil
+}
+
+// jobsFromDB converts queue_jobs rows to jobs
+func jobsFromDB(rows []queuepg.QueueJob) []*Job {
+	jobs := make([]*Job, len(rows))
+	for i, row := range rows {
+		jobs[i] = &Job{
+			ID:          uuid.UUID(row.ID.Bytes),
+			Kind:        row.Kind,
+			Payload:     row.Payload,
+			Status:      Status(row.Status),
+			Attempts:    int(row.Attempts),
+			MaxAttempts: int(row.MaxAttempts),
+			RunAt:       row.RunAt.Time,
+			LastError:   row.LastError.String,
+			CreatedAt:   row.CreatedAt.Time,
+		}
+	}
+	return jobs
+}
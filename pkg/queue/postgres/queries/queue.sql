@@ -0,0 +1,47 @@
+-- name: EnqueueJob :exec
+INSERT INTO queue_jobs (id, kind, payload, max_attempts, run_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ClaimJobs :many
+-- Leases due pending jobs of the given kinds, counting an attempt for each.
+-- SKIP LOCKED lets workers of every instance claim different jobs without
+-- waiting on each other.
+UPDATE queue_jobs
+SET attempts = attempts + 1,
+    locked_until = NOW() + make_interval(secs => sqlc.arg(lease_seconds)::float8),
+    updated_at = NOW()
+WHERE id IN (
+    SELECT id FROM queue_jobs
+    WHERE status = 'pending' AND kind = ANY(sqlc.arg(kinds)::text[]) AND run_at <= NOW()
+      AND (locked_until IS NULL OR locked_until <= NOW())
+    ORDER BY run_at
+    LIMIT sqlc.arg(max_jobs)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: DeleteJob :exec
+DELETE FROM queue_jobs
+WHERE id = $1;
+
+-- name: RetryJob :exec
+UPDATE queue_jobs
+SET run_at = $2, last_error = $3, locked_until = NULL, updated_at = NOW()
+WHERE id = $1;
+
+-- name: BuryJob :exec
+UPDATE queue_jobs
+SET status = 'dead', last_error = $2, locked_until = NULL, updated_at = NOW()
+WHERE id = $1;
+
+-- name: ListDeadJobs :many
+SELECT *
+FROM queue_jobs
+WHERE status = 'dead'
+ORDER BY updated_at DESC
+LIMIT $1;
+
+-- name: RequeueDeadJob :execrows
+UPDATE queue_jobs
+SET status = 'pending', attempts = 0, run_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND status = 'dead';
@@ -0,0 +1,295 @@
+// Package queue is a durable work queue shared by every server instance.
+//
+// Workers claim jobs with a lease. A job whose worker dies is claimed again
+// once its lease expires, so handlers run at least once and must be
+// idempotent. A job that keeps failing is retried with exponential backoff
+// and kept as a dead letter after its last attempt, until an operator
+// requeues it.
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotDead is returned when requeueing a job that is not a dead letter
+var ErrNotDead = errors.New("job is not a dead letter")
+
+// Status is the state of a job
+type Status string
+
+const (
+	// StatusPending jobs wait for a worker, or are being run by one
+	StatusPending Status = "pending"
+	// StatusDead jobs failed their last attempt or failed permanently
+	StatusDead Status = "dead"
+)
+
+// Job is a unit of work. Completed jobs are deleted.
+type Job struct {
+	ID          uuid.UUID
+	Kind        string
+	Payload     []byte
+	Status      Status
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time
+	LastError   string
+	CreatedAt   time.Time
+}
+
+// Store persists jobs
+type Store interface {
+	// Enqueue stores a new pending job
+	Enqueue(ctx context.Context, job *Job) error
+	// Claim leases up to limit pending jobs of the given kinds that are due
+	// and not leased, counting an attempt for each. Concurrent claims never
+	// return the same job while its lease lasts.
+	Claim(ctx context.Context, kinds []string, limit int, lease time.Duration) ([]*Job, error)
+	// Complete deletes a job
+	Complete(ctx context.Context, id uuid.UUID) error
+	// Retry releases a job's lease and schedules its next attempt
+	Retry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error
+	// Bury releases a job's lease and keeps it as a dead letter
+	Bury(ctx context.Context, id uuid.UUID, lastError string) error
+	// ListDead lists dead letters, most recently failed first
+	ListDead(ctx context.Context, limit int) ([]*Job, error)
+	// Requeue makes a dead letter pending again with fresh attempts, or
+	// fails with ErrNotDead
+	Requeue(ctx context.Context, id uuid.UUID) error
+}
+
+// Handler runs a job. A returned error schedules a retry unless it is
+// Permanent or the job has no attempts left.
+type Handler func(ctx context.Context, job *Job) error
+
+// permanentError marks a failure that retrying cannot fix
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so the job becomes a dead letter without retries,
+// e.g. when the resource it refers to was deleted
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// Options controls how a Queue runs jobs
+type Options struct {
+	// Workers is the number of jobs an instance runs at once; 0 only
+	// enqueues, leaving the jobs to other instances
+	Workers int
+	// PollInterval is how long an idle worker waits before looking for
+	// jobs again
+	PollInterval time.Duration
+	// Lease is how long a claimed job is reserved for its worker, and so
+	// the time limit of one attempt
+	Lease time.Duration
+	// MaxAttempts is the number of attempts before a job is a dead letter
+	MaxAttempts int
+	// Backoff is the wait before the first retry; it doubles with every
+	// further attempt up to MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// Runner starts tracked goroutines; *shutdown.Coordinator implements it
+type Runner interface {
+	Go(fn func(ctx context.Context)) bool
+}
+
+// Queue enqueues jobs and runs them with the registered handlers
+type Queue struct {
+	store    Store
+	opts     Options
+	logger   *slog.Logger
+	now      func() time.Time
+	handlers map[string]Handler
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// New creates a queue without handlers
+func New(store Store, opts Options, logger *slog.Logger) *Queue {
+	return &Queue{
+		store:    store,
+		opts:     opts,
+		logger:   logger,
+		now:      time.Now,
+		handlers: make(map[string]Handler),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Handle registers the handler of a job kind. It must be called before
+// Start; instances only claim jobs of kinds they handle.
+func (q *Queue) Handle(kind string, handler Handler) {
+	q.handlers[kind] = handler
+}
+
+// Enqueue stores a job for the handler of kind and returns its ID
+func (q *Queue) Enqueue(ctx context.Context, kind string, payload []byte) (uuid.UUID, error) {
+	job := &Job{
+		ID:          uuid.New(),
+		Kind:        kind,
+		Payload:     payload,
+		Status:      StatusPending,
+		MaxAttempts: q.opts.MaxAttempts,
+		RunAt:       q.now(),
+		CreatedAt:   q.now(),
+	}
+	if err := q.store.Enqueue(ctx, job); err != nil {
+		return uuid.Nil, err
+	}
+	q.logger.DebugContext(ctx, "job enqueued", "job_id", job.ID, "kind", kind)
+	return job.ID, nil
+}
+
+// Start runs Options.Workers workers in goroutines started by runner
+func (q *Queue) Start(runner Runner) {
+	if q.opts.Workers <= 0 || len(q.handlers) == 0 {
+		q.logger.Info("work queue workers disabled")
+		return
+	}
+	for i := 0; i < q.opts.Workers; i++ {
+		if !runner.Go(q.work) {
+			q.logger.Warn("work queue worker not started, shutting down")
+			return
+		}
+	}
+}
+
+// Stop prevents further claims. Jobs in progress finish with the context
+// provided by the runner; jobs cut short are retried after their lease.
+func (q *Queue) Stop() {
+	q.stopOnce.Do(func() { close(q.stop) })
+}
+
+// work claims and runs jobs until stopped, waiting PollInterval whenever no
+// job is due
+func (q *Queue) work(ctx context.Context) {
+	kinds := make([]string, 0, len(q.handlers))
+	for kind := range q.handlers {
+		kinds = append(kinds, kind)
+	}
+
+	for {
+		select {
+		case <-q.stop:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		ran, err := q.runNext(ctx, kinds)
+		if err != nil {
+			q.logger.ErrorContext(ctx, "failed to claim jobs", "error", err)
+		}
+		if ran {
+			continue
+		}
+
+		timer := time.NewTimer(q.opts.PollInterval)
+		select {
+		case <-q.stop:
+			timer.Stop()
+			return
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// runNext claims one job and runs it, reporting whether there was one
+func (q *Queue) runNext(ctx context.Context, kinds []string) (bool, error) {
+	jobs, err := q.store.Claim(ctx, kinds, 1, q.opts.Lease)
+	if err != nil || len(jobs) == 0 {
+		return false, err
+	}
+	q.run(ctx, jobs[0])
+	return true, nil
+}
+
+// run runs a claimed job and records the outcome. Attempts are bounded by
+// the lease, so the job is not claimed again while it still runs.
+func (q *Queue) run(ctx context.Context, job *Job) {
+	logger := q.logger.With("job_id", job.ID, "kind", job.Kind, "attempt", job.Attempts)
+
+	var err error
+	if job.Attempts > job.MaxAttempts {
+		// The worker of the last attempt died before recording its outcome
+		err = Permanent(errors.New("attempts exhausted by expired leases"))
+	} else {
+		runCtx, cancel := context.WithTimeout(ctx, q.opts.Lease)
+		err = q.call(runCtx, job)
+		cancel()
+	}
+
+	// Record the outcome even when shutdown cancelled ctx
+	ctx = context.WithoutCancel(ctx)
+	if err == nil {
+		if err := q.store.Complete(ctx, job.ID); err != nil {
+			logger.ErrorContext(ctx, "failed to complete job", "error", err)
+			return
+		}
+		logger.DebugContext(ctx, "job completed")
+		return
+	}
+
+	var permanent *permanentError
+	if errors.As(err, &permanent) || job.Attempts >= job.MaxAttempts {
+		if buryErr := q.store.Bury(ctx, job.ID, err.Error()); buryErr != nil {
+			logger.ErrorContext(ctx, "failed to bury job", "error", buryErr)
+			return
+		}
+		logger.ErrorContext(ctx, "job failed, kept as dead letter", "error", err)
+		return
+	}
+
+	runAt := q.now().Add(q.backoff(job.Attempts))
+	if retryErr := q.store.Retry(ctx, job.ID, runAt, err.Error()); retryErr != nil {
+		logger.ErrorContext(ctx, "failed to schedule job retry", "error", retryErr)
+		return
+	}
+	logger.WarnContext(ctx, "job failed, retrying", "retry_at", runAt, "error", err)
+}
+
+// call runs the handler of job, turning a panic into an error so one faulty
+// job cannot take down the server
+func (q *Queue) call(ctx context.Context, job *Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	handler, ok := q.handlers[job.Kind]
+	if !ok {
+		return Permanent(fmt.Errorf("no handler for job kind %q", job.Kind))
+	}
+	return handler(ctx, job)
+}
+
+// backoff is the wait after failed attempt number attempt
+func (q *Queue) backoff(attempt int) time.Duration {
+	delay := q.opts.Backoff
+	for i := 1; i < attempt && delay < q.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if q.opts.MaxBackoff > 0 {
+		delay = min(delay, q.opts.MaxBackoff)
+	}
+	return delay
+}
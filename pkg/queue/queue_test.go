@@ -0,0 +1,220 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func newTestQueue(t *testing.T, maxAttempts int) (*Queue, *MemoryStore, *time.Time) {
+	t.Helper()
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	q := New(store, Options{
+		Workers:     1,
+		Lease:       time.Minute,
+		MaxAttempts: maxAttempts,
+		Backoff:     10 * time.Second,
+		MaxBackoff:  time.Minute,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	q.now = func() time.Time { return now }
+	return q, store, &now
+}
+
+func TestQueue_CompletesJob(t *testing.T) {
+	q, store, _ := newTestQueue(t, 3)
+	ctx := context.Background()
+
+	var payload string
+	q.Handle("test", func(ctx context.Context, job *Job) error {
+		payload = string(job.Payload)
+		return nil
+	})
+	id, err := q.Enqueue(ctx, "test", []byte("hello"))
+	if err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	if ran, err := q.runNext(ctx, []string{"test"}); !ran || err != nil {
+		t.Fatalf("runNext = %v, %v; want a job run", ran, err)
+	}
+	if payload != "hello" {
+		t.Errorf("payload = %q, want hello", payload)
+	}
+	if _, ok := store.jobs[id]; ok {
+		t.Error("expected the completed job to be deleted")
+	}
+	if ran, _ := q.runNext(ctx, []string{"test"}); ran {
+		t.Error("expected no job left")
+	}
+}
+
+func TestQueue_RetriesWithBackoffThenBuries(t *testing.T) {
+	q, store, now := newTestQueue(t, 3)
+	ctx := context.Background()
+
+	calls := 0
+	q.Handle("test", func(ctx context.Context, job *Job) error {
+		calls++
+		return errors.New("unavailable")
+	})
+	id, err := q.Enqueue(ctx, "test", nil)
+	if err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	for attempt, wait := range []time.Duration{10 * time.Second, 20 * time.Second} {
+		if ran, err := q.runNext(ctx, []string{"test"}); !ran || err != nil {
+			t.Fatalf("attempt %d: runNext = %v, %v", attempt+1, ran, err)
+		}
+		// The retry is not due before its backoff
+		if ran, _ := q.runNext(ctx, []string{"test"}); ran {
+			t.Fatalf("attempt %d: job retried before its backoff", attempt+1)
+		}
+		*now = now.Add(wait)
+	}
+	if ran, err := q.runNext(ctx, []string{"test"}); !ran || err != nil {
+		t.Fatalf("last attempt: runNext = %v, %v", ran, err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+
+	dead, _ := store.ListDead(ctx, 10)
+	if len(dead) != 1 || dead[0].ID != id || dead[0].LastError != "unavailable" {
+		t.Fatalf("dead letters = %+v, want the failed job", dead)
+	}
+	*now = now.Add(time.Hour)
+	if ran, _ := q.runNext(ctx, []string{"test"}); ran {
+		t.Error("expected a dead letter not to be claimed")
+	}
+}
+
+func TestQueue_PermanentErrorBuriesImmediately(t *testing.T) {
+	q, store, _ := newTestQueue(t, 5)
+	ctx := context.Background()
+
+	q.Handle("test", func(ctx context.Context, job *Job) error {
+		return Permanent(errors.New("webhook deleted"))
+	})
+	if _, err := q.Enqueue(ctx, "test", nil); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	q.runNext(ctx, []string{"test"})
+
+	dead, _ := store.ListDead(ctx, 10)
+	if len(dead) != 1 || dead[0].Attempts != 1 {
+		t.Fatalf("dead letters = %+v, want the job after one attempt", dead)
+	}
+}
+
+func TestQueue_RecoversPanics(t *testing.T) {
+	q, store, _ := newTestQueue(t, 1)
+	ctx := context.Background()
+
+	q.Handle("test", func(ctx context.Context, job *Job) error {
+		panic("boom")
+	})
+	if _, err := q.Enqueue(ctx, "test", nil); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	q.runNext(ctx, []string{"test"})
+
+	dead, _ := store.ListDead(ctx, 10)
+	if len(dead) != 1 || dead[0].LastError != "panic: boom" {
+		t.Fatalf("dead letters = %+v, want the panicking job", dead)
+	}
+}
+
+func TestQueue_ExpiredLeaseIsClaimedAgain(t *testing.T) {
+	q, store, now := newTestQueue(t, 1)
+	ctx := context.Background()
+
+	q.Handle("test", func(ctx context.Context, job *Job) error { return nil })
+	id, err := q.Enqueue(ctx, "test", nil)
+	if err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	// A worker claims the job and dies without recording an outcome
+	if jobs, _ := store.Claim(ctx, []string{"test"}, 1, time.Minute); len(jobs) != 1 {
+		t.Fatalf("claimed %d jobs, want 1", len(jobs))
+	}
+	if jobs, _ := store.Claim(ctx, []string{"test"}, 1, time.Minute); len(jobs) != 0 {
+		t.Fatal("expected a leased job not to be claimed again")
+	}
+
+	// Its only attempt is used up, so the next claim buries it
+	*now = now.Add(time.Minute)
+	if ran, _ := q.runNext(ctx, []string{"test"}); !ran {
+		t.Fatal("expected the job to be claimed after its lease expired")
+	}
+	dead, _ := store.ListDead(ctx, 10)
+	if len(dead) != 1 || dead[0].ID != id {
+		t.Fatalf("dead letters = %+v, want the abandoned job", dead)
+	}
+}
+
+func TestQueue_Requeue(t *testing.T) {
+	q, store, _ := newTestQueue(t, 1)
+	ctx := context.Background()
+
+	fail := true
+	q.Handle("test", func(ctx context.Context, job *Job) error {
+		if fail {
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+	id, err := q.Enqueue(ctx, "test", nil)
+	if err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	if err := store.Requeue(ctx, id); !errors.Is(err, ErrNotDead) {
+		t.Fatalf("requeue pending job = %v, want ErrNotDead", err)
+	}
+	q.runNext(ctx, []string{"test"})
+	if err := store.Requeue(ctx, id); err != nil {
+		t.Fatalf("requeue: %v", err)
+	}
+
+	fail = false
+	if ran, _ := q.runNext(ctx, []string{"test"}); !ran {
+		t.Fatal("expected the requeued job to run")
+	}
+	if _, ok := store.jobs[id]; ok {
+		t.Error("expected the requeued job to complete")
+	}
+}
+
+func TestQueue_ClaimsOnlyHandledKinds(t *testing.T) {
+	q, _, _ := newTestQueue(t, 1)
+	ctx := context.Background()
+
+	if _, err := q.Enqueue(ctx, "other", nil); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	if ran, _ := q.runNext(ctx, []string{"test"}); ran {
+		t.Error("expected a job of another kind to be left alone")
+	}
+}
+
+func TestQueue_Backoff(t *testing.T) {
+	q, _, _ := newTestQueue(t, 10)
+	for attempt, want := range map[int]time.Duration{
+		1: 10 * time.Second,
+		2: 20 * time.Second,
+		3: 40 * time.Second,
+		4: time.Minute,
+		9: time.Minute,
+	} {
+		if got := q.backoff(attempt); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
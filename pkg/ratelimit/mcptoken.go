@@ -0,0 +1,127 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// readOnlyPrefixes are the method name prefixes of RPCs that only read
+// data. Every other RPC counts against the daily mutation budget.
+var readOnlyPrefixes = []string{"Get", "BatchGet", "List", "Stream", "Watch", "Export", "Preview", "Generate"}
+
+// IsMutation reports whether a full method name ("/pkg.Service/Method")
+// names an RPC that may change data
+func IsMutation(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// mcpTokenLimiter enforces the limits users set on their MCP tokens
+type mcpTokenLimiter struct {
+	store  Store
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// limit counts a call made with an MCP token against the token's limits.
+// It returns a ResourceExhausted error and how long to wait when the token
+// is over one of them. Calls without an MCP token, or made while the store
+// fails, are not limited.
+func (l *mcpTokenLimiter) limit(ctx context.Context, fullMethod string) (time.Duration, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.Credential != auth.CredentialMCPToken {
+		return 0, nil
+	}
+	limits := principal.TokenLimits
+	key := "mcp_token:" + principal.TokenID.String()
+
+	if limits.RequestsPerMinute > 0 {
+		count, resetIn, err := l.store.Incr(ctx, key+"|requests", time.Minute)
+		if err != nil {
+			l.logger.ErrorContext(ctx, "failed to count MCP token request", "method", fullMethod, "error", err)
+			return 0, nil
+		}
+		if count > int64(limits.RequestsPerMinute) {
+			l.logger.WarnContext(ctx, "MCP token rate limited", "method", fullMethod, "count", count)
+			return resetIn, status.Errorf(codes.ResourceExhausted, "MCP token rate limit exceeded, retry in %ds", seconds(resetIn))
+		}
+	}
+
+	if limits.DailyMutationBudget > 0 && IsMutation(fullMethod) {
+		now := l.now().UTC()
+		day := now.Format(time.DateOnly)
+		midnight := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+		count, resetIn, err := l.store.Incr(ctx, key+"|mutations|"+day, midnight.Sub(now))
+		if err != nil {
+			l.logger.ErrorContext(ctx, "failed to count MCP token mutation", "method", fullMethod, "error", err)
+			return 0, nil
+		}
+		if count > int64(limits.DailyMutationBudget) {
+			l.logger.WarnContext(ctx, "MCP token mutation budget exhausted", "method", fullMethod, "count", count)
+			return resetIn, status.Errorf(codes.ResourceExhausted, "MCP token daily mutation budget exhausted, retry in %ds", seconds(resetIn))
+		}
+	}
+	return 0, nil
+}
+
+// MCPTokenUnaryServerInterceptor returns a gRPC unary interceptor enforcing
+// the per-minute request limit and daily mutation budget of the caller's
+// MCP token. It must run after authentication. Calls over a limit fail with
+// ResourceExhausted and a retry-after header in seconds.
+func MCPTokenUnaryServerInterceptor(store Store, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	limiter := &mcpTokenLimiter{store: store, logger: logger, now: time.Now}
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if retryAfter, err := limiter.limit(ctx, info.FullMethod); err != nil {
+			_ = grpc.SetHeader(ctx, retryAfterHeader(retryAfter))
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// MCPTokenStreamServerInterceptor is the streaming counterpart of
+// MCPTokenUnaryServerInterceptor; opening a stream counts as one request
+func MCPTokenStreamServerInterceptor(store Store, logger *slog.Logger) grpc.StreamServerInterceptor {
+	limiter := &mcpTokenLimiter{store: store, logger: logger, now: time.Now}
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if retryAfter, err := limiter.limit(ss.Context(), info.FullMethod); err != nil {
+			_ = ss.SetHeader(retryAfterHeader(retryAfter))
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func retryAfterHeader(d time.Duration) metadata.MD {
+	return metadata.Pairs("retry-after", strconv.Itoa(seconds(d)))
+}
+
+// seconds rounds d up to whole seconds
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slips-ai/slips-core/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func mcpTokenContext(limits auth.MCPTokenLimits) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{
+		UserID:      "user-1",
+		Credential:  auth.CredentialMCPToken,
+		TokenID:     uuid.New(),
+		TokenLimits: limits,
+	})
+}
+
+func TestIsMutation(t *testing.T) {
+	for method, want := range map[string]bool{
+		"/task.v1.TaskService/CreateTask":           true,
+		"/task.v1.TaskService/ApplyMutations":       true,
+		"/task.v1.TaskService/MarkTaskViewed":       true,
+		"/task.v1.TaskService/GetTask":              false,
+		"/task.v1.TaskService/BatchGetTasks":        false,
+		"/task.v1.TaskService/ListTasks":            false,
+		"/task.v1.TaskService/StreamTasks":          false,
+		"/task.v1.TaskService/GenerateWeeklyReview": false,
+	} {
+		if got := IsMutation(method); got != want {
+			t.Errorf("IsMutation(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
+
+func TestMCPTokenLimiter_RequestsPerMinute(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	interceptor := MCPTokenUnaryServerInterceptor(NewMemoryStore(), logger)
+	info := &grpc.UnaryServerInfo{FullMethod: "/task.v1.TaskService/ListTasks"}
+
+	ctx := mcpTokenContext(auth.MCPTokenLimits{RequestsPerMinute: 2})
+	for i := 0; i < 2; i++ {
+		if _, err := interceptor(ctx, nil, info, mockHandler); err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i, err)
+		}
+	}
+	if _, err := interceptor(ctx, nil, info, mockHandler); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("third request error = %v, want ResourceExhausted", err)
+	}
+
+	// Other tokens, unlimited tokens and other credentials are unaffected
+	if _, err := interceptor(mcpTokenContext(auth.MCPTokenLimits{RequestsPerMinute: 2}), nil, info, mockHandler); err != nil {
+		t.Errorf("other token: unexpected error: %v", err)
+	}
+	unlimited := mcpTokenContext(auth.MCPTokenLimits{})
+	jwt := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "user-1", Credential: auth.CredentialJWT})
+	for i := 0; i < 5; i++ {
+		if _, err := interceptor(unlimited, nil, info, mockHandler); err != nil {
+			t.Fatalf("unlimited token: unexpected error: %v", err)
+		}
+		if _, err := interceptor(jwt, nil, info, mockHandler); err != nil {
+			t.Fatalf("JWT: unexpected error: %v", err)
+		}
+	}
+}
+
+func TestMCPTokenLimiter_DailyMutationBudget(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Date(2026, 10, 16, 23, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+	limiter := &mcpTokenLimiter{store: store, logger: slog.New(slog.NewTextHandler(io.Discard, nil)), now: func() time.Time { return now }}
+
+	ctx := mcpTokenContext(auth.MCPTokenLimits{DailyMutationBudget: 1})
+	if _, err := limiter.limit(ctx, "/task.v1.TaskService/CreateTask"); err != nil {
+		t.Fatalf("first mutation: unexpected error: %v", err)
+	}
+	retryAfter, err := limiter.limit(ctx, "/task.v1.TaskService/UpdateTask")
+	if status.Code(err) != codes.ResourceExhausted || retryAfter != time.Hour {
+		t.Fatalf("second mutation = %v, %v; want ResourceExhausted until midnight", retryAfter, err)
+	}
+	if _, err := limiter.limit(ctx, "/task.v1.TaskService/ListTasks"); err != nil {
+		t.Errorf("read after the budget is spent: unexpected error: %v", err)
+	}
+
+	// The budget starts over at midnight UTC
+	now = now.Add(time.Hour)
+	if _, err := limiter.limit(ctx, "/task.v1.TaskService/CreateTask"); err != nil {
+		t.Errorf("mutation on the next day: unexpected error: %v", err)
+	}
+}
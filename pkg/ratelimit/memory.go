@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// maxTrackedKeys is the number of windows kept before expired ones are
+// dropped
+const maxTrackedKeys = 100000
+
+// MemoryStore keeps counters in process memory, so each server instance
+// enforces the limit separately
+type MemoryStore struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	windows map[string]*window
+}
+
+type window struct {
+	count int64
+	reset time.Time
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		now:     time.Now,
+		windows: make(map[string]*window),
+	}
+}
+
+// Incr implements Store
+func (s *MemoryStore) Incr(ctx context.Context, key string, length time.Duration) (int64, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	w, ok := s.windows[key]
+	if !ok || !now.Before(w.reset) {
+		if !ok && len(s.windows) >= maxTrackedKeys {
+			s.dropExpired(now)
+		}
+		w = &window{reset: now.Add(length)}
+		s.windows[key] = w
+	}
+	w.count++
+	return w.count, w.reset.Sub(now), nil
+}
+
+// dropExpired forgets windows that have ended. Callers must hold s.mu.
+func (s *MemoryStore) dropExpired(now time.Time) {
+	for key, w := range s.windows {
+		if !now.Before(w.reset) {
+			delete(s.windows, key)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Package ratelimit throttles unauthenticated RPCs, such as the login flow
+// and token refresh, per client IP address, and calls made with MCP tokens
+// per the limits users set on them. Counters live in a Store, in process
+// memory or in Redis when several instances must share them.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Store counts requests per key in fixed windows
+type Store interface {
+	// Incr counts a request for key in its current window of the given
+	// length, starting a new window when there is none, and returns the
+	// count including this request and the time left in the window
+	Incr(ctx context.Context, key string, window time.Duration) (count int64, resetIn time.Duration, err error)
+}
+
+// Options controls which RPCs are throttled and how
+type Options struct {
+	// Methods lists the full method names limited per client IP; other
+	// methods pass through
+	Methods []string
+	// Requests is the number of calls of one method each IP may make per
+	// Window
+	Requests int
+	Window   time.Duration
+	// BanList rejects matching clients on the limited methods outright, and
+	// AllowList exempts matching clients from the limit
+	BanList   []netip.Prefix
+	AllowList []netip.Prefix
+	// TrustedProxies are peers, such as load balancers, whose
+	// x-forwarded-for header names the client
+	TrustedProxies []netip.Prefix
+}
+
+// ParsePrefixes parses IP addresses and CIDR ranges, such as "203.0.113.7"
+// and "10.0.0.0/8"
+func ParsePrefixes(entries []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR range %q: %w", entry, err)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP address %q: %w", entry, err)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+// UnaryServerInterceptor returns a gRPC unary interceptor that rejects
+// banned clients with PermissionDenied and clients over the limit with
+// ResourceExhausted and a retry-after header in seconds. When the store
+// fails the request is let through, so an unavailable Redis does not lock
+// everyone out of logging in.
+func UnaryServerInterceptor(store Store, opts Options, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	limited := make(map[string]struct{}, len(opts.Methods))
+	for _, method := range opts.Methods {
+		limited[method] = struct{}{}
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if _, ok := limited[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		ip, ok := ClientIP(ctx, opts.TrustedProxies)
+		if !ok {
+			return handler(ctx, req)
+		}
+		if contains(opts.BanList, ip) {
+			logger.WarnContext(ctx, "banned client rejected", "ip", ip, "method", info.FullMethod)
+			return nil, status.Error(codes.PermissionDenied, "requests from this address are not allowed")
+		}
+		if contains(opts.AllowList, ip) {
+			return handler(ctx, req)
+		}
+
+		count, resetIn, err := store.Incr(ctx, ip.String()+"|"+info.FullMethod, opts.Window)
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to count request for rate limiting", "ip", ip, "method", info.FullMethod, "error", err)
+			return handler(ctx, req)
+		}
+		if count > int64(opts.Requests) {
+			seconds := int(math.Ceil(resetIn.Seconds()))
+			_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(seconds)))
+			logger.WarnContext(ctx, "client rate limited", "ip", ip, "method", info.FullMethod, "count", count)
+			return nil, status.Errorf(codes.ResourceExhausted, "too many requests, retry in %ds", seconds)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ClientIP returns the address of the client of an RPC; it is false for
+// peers without one, such as Unix socket clients. When the peer is a trusted
+// proxy, it is the right-most address of x-forwarded-for that is not a
+// trusted proxy itself, since proxies append the address they saw.
+func ClientIP(ctx context.Context, trustedProxies []netip.Prefix) (netip.Addr, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return netip.Addr{}, false
+	}
+	addrPort, err := netip.ParseAddrPort(p.Addr.String())
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	ip := addrPort.Addr().Unmap()
+	if !contains(trustedProxies, ip) {
+		return ip, true
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var hops []string
+	for _, value := range md.Get("x-forwarded-for") {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		ip = hop.Unmap()
+		if !contains(trustedProxies, ip) {
+			break
+		}
+	}
+	return ip, true
+}
+
+func contains(prefixes []netip.Prefix, ip netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
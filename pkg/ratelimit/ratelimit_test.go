@@ -0,0 +1,130 @@
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const callbackMethod = "/auth.v1.AuthService/HandleCallback"
+
+func mockHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	return "success", nil
+}
+
+func peerContext(addr string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: net.TCPAddrFromAddrPort(netip.MustParseAddrPort(addr))})
+}
+
+func mustPrefixes(t *testing.T, entries ...string) []netip.Prefix {
+	t.Helper()
+	prefixes, err := ParsePrefixes(entries)
+	if err != nil {
+		t.Fatalf("ParsePrefixes(%v): %v", entries, err)
+	}
+	return prefixes
+}
+
+func TestUnaryServerInterceptor_LimitsPerIP(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	interceptor := UnaryServerInterceptor(NewMemoryStore(), Options{
+		Methods:  []string{callbackMethod},
+		Requests: 2,
+		Window:   time.Minute,
+	}, logger)
+	info := &grpc.UnaryServerInfo{FullMethod: callbackMethod}
+
+	ctx := peerContext("203.0.113.7:4000")
+	for i := 0; i < 2; i++ {
+		if _, err := interceptor(ctx, nil, info, mockHandler); err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i, err)
+		}
+	}
+	if _, err := interceptor(ctx, nil, info, mockHandler); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("third request error = %v, want ResourceExhausted", err)
+	}
+
+	// Another client and an unlimited method are unaffected
+	if _, err := interceptor(peerContext("203.0.113.8:4000"), nil, info, mockHandler); err != nil {
+		t.Errorf("other client: unexpected error: %v", err)
+	}
+	if _, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/task.v1.TaskService/ListTasks"}, mockHandler); err != nil {
+		t.Errorf("unlimited method: unexpected error: %v", err)
+	}
+}
+
+func TestUnaryServerInterceptor_BanAndAllowLists(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	interceptor := UnaryServerInterceptor(NewMemoryStore(), Options{
+		Methods:   []string{callbackMethod},
+		Requests:  1,
+		Window:    time.Minute,
+		BanList:   mustPrefixes(t, "198.51.100.0/24"),
+		AllowList: mustPrefixes(t, "192.0.2.10"),
+	}, logger)
+	info := &grpc.UnaryServerInfo{FullMethod: callbackMethod}
+
+	if _, err := interceptor(peerContext("198.51.100.20:4000"), nil, info, mockHandler); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("banned client error = %v, want PermissionDenied", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := interceptor(peerContext("192.0.2.10:4000"), nil, info, mockHandler); err != nil {
+			t.Errorf("allowed client request %d: unexpected error: %v", i, err)
+		}
+	}
+}
+
+func TestClientIP_TrustedProxies(t *testing.T) {
+	proxies := mustPrefixes(t, "10.0.0.0/8")
+
+	ctx := metadata.NewIncomingContext(peerContext("10.0.0.5:4000"),
+		metadata.Pairs("x-forwarded-for", "1.2.3.4, 203.0.113.7, 10.0.0.9"))
+	if ip, ok := ClientIP(ctx, proxies); !ok || ip.String() != "203.0.113.7" {
+		t.Errorf("behind proxy: ClientIP() = %v, %v; want 203.0.113.7", ip, ok)
+	}
+
+	// The header of an untrusted peer is ignored, since anyone can send it
+	ctx = metadata.NewIncomingContext(peerContext("203.0.113.9:4000"),
+		metadata.Pairs("x-forwarded-for", "1.2.3.4"))
+	if ip, ok := ClientIP(ctx, proxies); !ok || ip.String() != "203.0.113.9" {
+		t.Errorf("untrusted peer: ClientIP() = %v, %v; want 203.0.113.9", ip, ok)
+	}
+}
+
+func TestMemoryStore_WindowResets(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	for want := int64(1); want <= 3; want++ {
+		count, resetIn, err := store.Incr(context.Background(), "key", time.Minute)
+		if err != nil || count != want || resetIn != time.Minute {
+			t.Fatalf("Incr() = %d, %s, %v; want %d, 1m", count, resetIn, err, want)
+		}
+	}
+
+	now = now.Add(time.Minute)
+	if count, _, _ := store.Incr(context.Background(), "key", time.Minute); count != 1 {
+		t.Errorf("count after the window = %d, want 1", count)
+	}
+}
+
+func TestParsePrefixes(t *testing.T) {
+	prefixes := mustPrefixes(t, "203.0.113.7", "10.1.2.3/8", "2001:db8::/32", " ")
+	if len(prefixes) != 3 || prefixes[0].Bits() != 32 || prefixes[1].String() != "10.0.0.0/8" {
+		t.Errorf("ParsePrefixes() = %v", prefixes)
+	}
+	if _, err := ParsePrefixes([]string{"not-an-ip"}); err == nil {
+		t.Error("expected an error for an invalid entry")
+	}
+}
@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// incrScript increments a counter, starts its window on the first request
+// and returns the count and the milliseconds left in the window
+var incrScript = redis.NewScript(`local count = redis.call('INCR', KEYS[1])
+if count == 1 then redis.call('PEXPIRE', KEYS[1], ARGV[1]) end
+return {count, redis.call('PTTL', KEYS[1])}`)
+
+// redisKeyPrefix namespaces the counters in a shared Redis
+const redisKeyPrefix = "slips:ratelimit:"
+
+// RedisOptions locates and authenticates the Redis server of a RedisStore
+type RedisOptions struct {
+	// Addr is "host:port"
+	Addr string
+	// Username selects an ACL user; leave it empty to authenticate as the
+	// default user with Password alone
+	Username string
+	Password string
+	// DB is the logical database holding the counters
+	DB int
+	// TLS connects with TLS when it is not nil
+	TLS *tls.Config
+}
+
+// RedisStore keeps counters in Redis, so every server instance enforces
+// one shared limit
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore creates a store for the Redis server of opts. Connections
+// are opened on first use.
+func NewRedisStore(opts RedisOptions) *RedisStore {
+	return &RedisStore{client: redis.NewClient(&redis.Options{
+		Addr:      opts.Addr,
+		Username:  opts.Username,
+		Password:  opts.Password,
+		DB:        opts.DB,
+		TLSConfig: opts.TLS,
+	})}
+}
+
+// Incr implements Store
+func (s *RedisStore) Incr(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	values, err := incrScript.Run(ctx, s.client, []string{redisKeyPrefix + key}, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, fmt.Errorf("redis: %w", err)
+	}
+	if len(values) != 2 {
+		return 0, 0, fmt.Errorf("unexpected redis reply %v", values)
+	}
+	return values[0], time.Duration(max(values[1], 0)) * time.Millisecond, nil
+}
+
+// Close closes the connections
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,140 @@
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// readCommand reads one command sent by the client, an array of bulk strings
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		header, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(header[1:]))
+		if err != nil {
+			return nil, err
+		}
+		arg := make([]byte, size+2)
+		if _, err := io.ReadFull(r, arg); err != nil {
+			return nil, err
+		}
+		args[i] = string(arg[:size])
+	}
+	return args, nil
+}
+
+// fakeRedis answers each command with its reply in replies, or an unknown
+// command error, and records the commands
+func fakeRedis(t *testing.T, replies map[string]string) (string, <-chan []string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen on loopback: %v", err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+
+	commands := make(chan []string, 20)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for {
+			args, err := readCommand(r)
+			if err != nil {
+				return
+			}
+			select {
+			case commands <- args:
+			default:
+			}
+			reply, ok := replies[strings.ToUpper(args[0])]
+			if !ok {
+				reply = "-ERR unknown command '" + args[0] + "'\r\n"
+			}
+			if _, err := conn.Write([]byte(reply)); err != nil {
+				return
+			}
+		}
+	}()
+	return ln.Addr().String(), commands
+}
+
+// nextCommand returns the next recorded command called name
+func nextCommand(t *testing.T, commands <-chan []string, name string) []string {
+	t.Helper()
+	for {
+		select {
+		case args := <-commands:
+			if strings.EqualFold(args[0], name) {
+				return args
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no %s command", name)
+		}
+	}
+}
+
+func TestRedisStore_Incr(t *testing.T) {
+	addr, commands := fakeRedis(t, map[string]string{
+		"AUTH":    "+OK\r\n",
+		"SELECT":  "+OK\r\n",
+		"EVALSHA": "-NOSCRIPT No matching script\r\n",
+		"EVAL":    "*2\r\n:3\r\n:42000\r\n",
+	})
+	store := NewRedisStore(RedisOptions{Addr: addr, Username: "slips", Password: "secret", DB: 2})
+	defer store.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	count, resetIn, err := store.Incr(ctx, "203.0.113.7|/auth.v1.AuthService/HandleCallback", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 3 || resetIn != 42*time.Second {
+		t.Errorf("Incr() = %d, %s; want 3, 42s", count, resetIn)
+	}
+
+	if auth := nextCommand(t, commands, "AUTH"); strings.Join(auth[1:], " ") != "slips secret" {
+		t.Errorf("AUTH command = %q", auth)
+	}
+	if sel := nextCommand(t, commands, "SELECT"); sel[1] != "2" {
+		t.Errorf("SELECT command = %q", sel)
+	}
+	eval := nextCommand(t, commands, "EVAL")
+	if eval[3] != "slips:ratelimit:203.0.113.7|/auth.v1.AuthService/HandleCallback" || eval[4] != "60000" {
+		t.Errorf("EVAL command = %q", eval)
+	}
+}
+
+func TestRedisStore_ErrorReply(t *testing.T) {
+	addr, _ := fakeRedis(t, map[string]string{
+		"EVALSHA": "-NOSCRIPT No matching script\r\n",
+		"EVAL":    "-ERR scripting is disabled\r\n",
+	})
+	store := NewRedisStore(RedisOptions{Addr: addr})
+	defer store.Close()
+
+	if _, _, err := store.Incr(context.Background(), "key", time.Minute); err == nil || !strings.Contains(err.Error(), "scripting is disabled") {
+		t.Errorf("error = %v, want the redis error", err)
+	}
+}
@@ -0,0 +1,94 @@
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exempt reports whether method is served before the gate is ready: health
+// checks, which report the state, and reflection
+func exempt(method string) bool {
+	return strings.HasPrefix(method, "/grpc.health.v1.Health/") ||
+		strings.HasPrefix(method, "/grpc.reflection.")
+}
+
+// notReady is the error of RPCs rejected by the gate; clients retry
+// UNAVAILABLE
+func notReady(g *Gate) error {
+	return status.Errorf(codes.Unavailable, "server is %s", g.State())
+}
+
+// UnaryServerInterceptor returns a gRPC unary interceptor that rejects RPCs
+// with Unavailable until g is ready. Health checks and reflection are
+// always served.
+func UnaryServerInterceptor(g *Gate) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !exempt(info.FullMethod) && g.State() == StateStarting {
+			return nil, notReady(g)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor
+func StreamServerInterceptor(g *Gate) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !exempt(info.FullMethod) && g.State() == StateStarting {
+			return notReady(g)
+		}
+		return handler(srv, ss)
+	}
+}
+
+// Middleware responds 503 Service Unavailable to HTTP requests until g is
+// ready
+func Middleware(g *Gate, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if g.State() == StateStarting {
+			w.Header().Set("Retry-After", "5")
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "server is starting"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// LiveHandler serves the liveness probe: 200 for as long as the process
+// serves HTTP, whatever the state of its dependencies
+func LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+}
+
+// ReadyHandler serves the readiness probe: 200 with the Status when g is
+// ready, 503 otherwise. Check errors are only logged, since they can name
+// internal hosts.
+func ReadyHandler(g *Gate) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := g.Status()
+		code := http.StatusOK
+		if status.State != StateReady {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, status)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
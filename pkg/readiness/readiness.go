@@ -0,0 +1,184 @@
+// Package readiness gates a server on its dependencies. The server listens
+// right away and reports live, but only becomes ready, and only serves
+// requests, once every dependency check has passed. Failing checks are
+// retried with backoff instead of exiting, so a rollout waits for a
+// database or identity provider that is briefly unavailable.
+package readiness
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// State is the lifecycle state of the server
+type State string
+
+const (
+	// StateStarting means some dependency checks have not passed yet
+	StateStarting State = "starting"
+	// StateReady means every dependency check passed
+	StateReady State = "ready"
+	// StateStopping means shutdown has begun
+	StateStopping State = "stopping"
+)
+
+// Check verifies that a dependency is available. It runs until it returns
+// nil, so it must be safe to repeat.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Options controls how failing checks are retried
+type Options struct {
+	// Backoff is the wait before the first retry; it doubles with every
+	// further attempt up to MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// Status is a snapshot of the gate
+type Status struct {
+	State State `json:"state"`
+	// Checks maps each check to "ok" or "pending"
+	Checks map[string]string `json:"checks"`
+}
+
+// Gate tracks the state of the server and the checks it waits for
+type Gate struct {
+	opts    Options
+	logger  *slog.Logger
+	checks  []Check
+	onReady []func()
+
+	mu     sync.Mutex
+	state  State
+	passed map[string]bool
+}
+
+// New creates a gate in StateStarting without checks
+func New(opts Options, logger *slog.Logger) *Gate {
+	return &Gate{
+		opts:   opts,
+		logger: logger,
+		state:  StateStarting,
+		passed: make(map[string]bool),
+	}
+}
+
+// Add registers a check. It must be called before Run.
+func (g *Gate) Add(name string, run func(ctx context.Context) error) {
+	g.checks = append(g.checks, Check{Name: name, Run: run})
+}
+
+// OnReady registers fn to run once every check has passed, e.g. to start
+// background workers. It must be called before Run; fn is not called if
+// shutdown begins first.
+func (g *Gate) OnReady(fn func()) {
+	g.onReady = append(g.onReady, fn)
+}
+
+// Run runs every check concurrently, retrying failures, until all have
+// passed or ctx is cancelled, and then makes the gate ready
+func (g *Gate) Run(ctx context.Context) {
+	start := time.Now()
+	var wg sync.WaitGroup
+	for _, check := range g.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			g.runCheck(ctx, check)
+		}()
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	g.mu.Lock()
+	if g.state != StateStarting {
+		g.mu.Unlock()
+		return
+	}
+	g.state = StateReady
+	g.mu.Unlock()
+
+	g.logger.Info("server ready", "duration", time.Since(start))
+	for _, fn := range g.onReady {
+		fn()
+	}
+}
+
+// runCheck retries check until it passes or ctx is cancelled
+func (g *Gate) runCheck(ctx context.Context, check Check) {
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		err := check.Run(ctx)
+		if err == nil {
+			g.mu.Lock()
+			g.passed[check.Name] = true
+			g.mu.Unlock()
+			g.logger.Info("dependency ready", "check", check.Name, "attempts", attempt+1, "duration", time.Since(start))
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		delay := g.backoff(attempt)
+		g.logger.Warn("dependency not ready, retrying",
+			"check", check.Name, "attempt", attempt+1, "retry_in", delay, "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// backoff is the wait after failed attempt number attempt+1
+func (g *Gate) backoff(attempt int) time.Duration {
+	delay := g.opts.Backoff
+	for i := 0; i < attempt && (g.opts.MaxBackoff <= 0 || delay < g.opts.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if g.opts.MaxBackoff > 0 {
+		delay = min(delay, g.opts.MaxBackoff)
+	}
+	return delay
+}
+
+// Stop moves the gate to StateStopping, so the server is no longer ready
+func (g *Gate) Stop() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.state = StateStopping
+}
+
+// State returns the current state
+func (g *Gate) State() State {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.state
+}
+
+// Ready reports whether the gate is in StateReady
+func (g *Gate) Ready() bool {
+	return g.State() == StateReady
+}
+
+// Status returns the state and which checks have passed
+func (g *Gate) Status() Status {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	status := Status{State: g.state, Checks: make(map[string]string, len(g.checks))}
+	for _, check := range g.checks {
+		status.Checks[check.Name] = "pending"
+		if g.passed[check.Name] {
+			status.Checks[check.Name] = "ok"
+		}
+	}
+	return status
+}
@@ -0,0 +1,149 @@
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestGate() *Gate {
+	return New(Options{Backoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond},
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestGate_RetriesUntilReady(t *testing.T) {
+	g := newTestGate()
+	var attempts atomic.Int32
+	g.Add("database", func(ctx context.Context) error {
+		if attempts.Add(1) < 3 {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+	g.Add("jwks", func(ctx context.Context) error { return nil })
+	readyCalls := 0
+	g.OnReady(func() { readyCalls++ })
+
+	if g.State() != StateStarting {
+		t.Fatalf("state = %s, want starting", g.State())
+	}
+	g.Run(context.Background())
+
+	if !g.Ready() || readyCalls != 1 {
+		t.Fatalf("state = %s after %d OnReady calls, want ready after 1", g.State(), readyCalls)
+	}
+	if attempts.Load() != 3 {
+		t.Errorf("database attempts = %d, want 3", attempts.Load())
+	}
+	if st := g.Status(); st.Checks["database"] != "ok" || st.Checks["jwks"] != "ok" {
+		t.Errorf("checks = %v, want both ok", st.Checks)
+	}
+}
+
+func TestGate_StopsRetryingOnCancel(t *testing.T) {
+	g := newTestGate()
+	g.Add("database", func(ctx context.Context) error { return errors.New("connection refused") })
+	g.OnReady(func() { t.Error("OnReady called without the checks passing") })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	g.Run(ctx)
+
+	if g.State() != StateStarting {
+		t.Errorf("state = %s, want starting", g.State())
+	}
+	if st := g.Status(); st.Checks["database"] != "pending" {
+		t.Errorf("database check = %q, want pending", st.Checks["database"])
+	}
+}
+
+func TestGate_StopBeforeReady(t *testing.T) {
+	g := newTestGate()
+	g.Add("database", func(ctx context.Context) error { return nil })
+	g.OnReady(func() { t.Error("OnReady called after Stop") })
+
+	g.Stop()
+	g.Run(context.Background())
+	if g.State() != StateStopping {
+		t.Errorf("state = %s, want stopping", g.State())
+	}
+}
+
+func TestGate_Backoff(t *testing.T) {
+	g := New(Options{Backoff: time.Second, MaxBackoff: 5 * time.Second}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := g.backoff(attempt); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	g := newTestGate()
+	g.Add("database", func(ctx context.Context) error { return nil })
+	interceptor := UnaryServerInterceptor(g)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	call := func(method string) error {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	if err := call("/task.v1.TaskService/ListTasks"); status.Code(err) != codes.Unavailable {
+		t.Errorf("RPC while starting: error = %v, want Unavailable", err)
+	}
+	if err := call("/grpc.health.v1.Health/Check"); err != nil {
+		t.Errorf("health check while starting: %v", err)
+	}
+
+	g.Run(context.Background())
+	if err := call("/task.v1.TaskService/ListTasks"); err != nil {
+		t.Errorf("RPC once ready: %v", err)
+	}
+}
+
+func TestHTTPHandlers(t *testing.T) {
+	g := newTestGate()
+	g.Add("database", func(ctx context.Context) error { return nil })
+	app := Middleware(g, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	serve := func(h http.Handler) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		return rec
+	}
+	if rec := serve(LiveHandler()); rec.Code != http.StatusOK {
+		t.Errorf("livez while starting: status %d, want 200", rec.Code)
+	}
+	if rec := serve(ReadyHandler(g)); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("readyz while starting: status %d, want 503", rec.Code)
+	}
+	if rec := serve(app); rec.Code != http.StatusServiceUnavailable || rec.Header().Get("Retry-After") == "" {
+		t.Errorf("request while starting: status %d, want 503 with Retry-After", rec.Code)
+	}
+
+	g.Run(context.Background())
+	rec := serve(ReadyHandler(g))
+	var st Status
+	if err := json.Unmarshal(rec.Body.Bytes(), &st); err != nil {
+		t.Fatalf("decode readyz: %v", err)
+	}
+	if rec.Code != http.StatusOK || st.State != StateReady || st.Checks["database"] != "ok" {
+		t.Errorf("readyz once ready: status %d, body %s", rec.Code, rec.Body)
+	}
+	if rec := serve(app); rec.Code != http.StatusNoContent {
+		t.Errorf("request once ready: status %d, want 204", rec.Code)
+	}
+}
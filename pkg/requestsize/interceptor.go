@@ -0,0 +1,178 @@
+// Package requestsize enforces per-method request message size limits below
+// the transport maximum. Oversized requests fail with an InvalidArgument
+// error naming the largest field, instead of the opaque ResourceExhausted
+// of the transport, and request sizes are recorded as a histogram.
+package requestsize
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Options controls the limits enforced by the interceptors
+type Options struct {
+	// MaxBytes is the largest request message accepted; 0 accepts any size
+	MaxBytes int
+	// Methods overrides MaxBytes for full method names
+	// ("/pkg.Service/Method"), such as batch RPCs that carry many tasks
+	Methods map[string]int
+}
+
+func (o Options) limit(fullMethod string) int {
+	if limit, ok := o.Methods[fullMethod]; ok {
+		return limit
+	}
+	return o.MaxBytes
+}
+
+// checker checks and records the size of request messages
+type checker struct {
+	opts Options
+	hist metric.Int64Histogram
+}
+
+func newChecker(opts Options) *checker {
+	// The global meter is a no-op until a meter provider is installed, so
+	// creating the histogram cannot fail in a way worth reporting
+	hist, _ := otel.Meter("grpc-server").Int64Histogram(
+		"rpc.server.request.size",
+		metric.WithDescription("Size of gRPC request messages"),
+		metric.WithUnit("By"),
+	)
+	return &checker{opts: opts, hist: hist}
+}
+
+func (c *checker) check(ctx context.Context, fullMethod string, req interface{}) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	size := proto.Size(msg)
+	c.hist.Record(ctx, int64(size), metric.WithAttributes(attribute.String("rpc.method", fullMethod)))
+
+	limit := c.opts.limit(fullMethod)
+	if limit <= 0 || size <= limit {
+		return nil
+	}
+	text := fmt.Sprintf("request of %d bytes exceeds the limit of %d bytes", size, limit)
+	if path, fieldSize := largestField(msg.ProtoReflect(), ""); path != "" {
+		text += fmt.Sprintf("; its largest field is %s with %d bytes", path, fieldSize)
+	}
+	return status.Error(codes.InvalidArgument, text)
+}
+
+// UnaryServerInterceptor returns a gRPC unary interceptor that rejects
+// requests larger than the method's limit with InvalidArgument
+func UnaryServerInterceptor(opts Options) grpc.UnaryServerInterceptor {
+	c := newChecker(opts)
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := c.check(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor; it checks every message the client sends
+func StreamServerInterceptor(opts Options) grpc.StreamServerInterceptor {
+	c := newChecker(opts)
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &checkedStream{ServerStream: ss, checker: c, method: info.FullMethod})
+	}
+}
+
+// checkedStream checks the messages received on a stream
+type checkedStream struct {
+	grpc.ServerStream
+	checker *checker
+	method  string
+}
+
+func (s *checkedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.checker.check(s.Context(), s.method, m)
+}
+
+// largestField finds the largest string, bytes or repeated scalar field of
+// msg, descending into nested messages, and returns its path such as
+// "mutations[2].create.notes" and its size in bytes
+func largestField(msg protoreflect.Message, prefix string) (string, int) {
+	var bestPath string
+	var bestSize int
+	consider := func(path string, size int) {
+		if size > bestSize {
+			bestPath, bestSize = path, size
+		}
+	}
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		path := prefix + string(fd.Name())
+		switch {
+		case fd.IsMap():
+			size := 0
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				size += valueSize(fd.MapKey(), k.Value()) + valueSize(fd.MapValue(), mv)
+				return true
+			})
+			consider(path, size)
+		case fd.IsList() && isMessage(fd):
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				consider(largestField(list.Get(i).Message(), fmt.Sprintf("%s[%d].", path, i)))
+			}
+		case fd.IsList():
+			list := v.List()
+			size := 0
+			for i := 0; i < list.Len(); i++ {
+				size += valueSize(fd, list.Get(i))
+			}
+			consider(path, size)
+		case isMessage(fd):
+			consider(largestField(v.Message(), path+"."))
+		default:
+			consider(path, valueSize(fd, v))
+		}
+		return true
+	})
+	return bestPath, bestSize
+}
+
+func isMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
+
+// valueSize approximates the encoded size of a single value
+func valueSize(fd protoreflect.FieldDescriptor, v protoreflect.Value) int {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return len(v.String())
+	case protoreflect.BytesKind:
+		return len(v.Bytes())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return proto.Size(v.Message().Interface())
+	default:
+		return 8
+	}
+}
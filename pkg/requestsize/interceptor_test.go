@@ -0,0 +1,74 @@
+package requestsize
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	taskv1 "github.com/slips-ai/slips-core/gen/go/task/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const createTaskMethod = "/task.v1.TaskService/CreateTask"
+
+func mockHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	return "success", nil
+}
+
+func TestUnaryServerInterceptor_AcceptsSmallRequest(t *testing.T) {
+	interceptor := UnaryServerInterceptor(Options{MaxBytes: 1024})
+
+	req := &taskv1.CreateTaskRequest{Title: "Buy milk"}
+	resp, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: createTaskMethod}, mockHandler)
+	if err != nil || resp != "success" {
+		t.Fatalf("got %v, %v; want success", resp, err)
+	}
+}
+
+func TestUnaryServerInterceptor_RejectsLargeRequest(t *testing.T) {
+	interceptor := UnaryServerInterceptor(Options{MaxBytes: 1024})
+
+	req := &taskv1.CreateTaskRequest{
+		Title:          "Big",
+		Notes:          strings.Repeat("n", 800),
+		ChecklistItems: []string{strings.Repeat("c", 300), strings.Repeat("c", 300)},
+	}
+	_, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: createTaskMethod}, mockHandler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("error = %v, want InvalidArgument", err)
+	}
+	if msg := status.Convert(err).Message(); !strings.Contains(msg, "largest field is notes with 800 bytes") {
+		t.Errorf("message %q does not name the largest field", msg)
+	}
+}
+
+func TestUnaryServerInterceptor_MethodOverride(t *testing.T) {
+	interceptor := UnaryServerInterceptor(Options{
+		MaxBytes: 1024,
+		Methods:  map[string]int{createTaskMethod: 4096},
+	})
+
+	req := &taskv1.CreateTaskRequest{Title: "Big", Notes: strings.Repeat("n", 2000)}
+	if _, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: createTaskMethod}, mockHandler); err != nil {
+		t.Errorf("unexpected error with a raised limit: %v", err)
+	}
+	if _, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/task.v1.TaskService/UpdateTask"}, mockHandler); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("error = %v for another method, want InvalidArgument", err)
+	}
+}
+
+func TestLargestField_NestedMessages(t *testing.T) {
+	req := &taskv1.ApplyMutationsRequest{
+		Mutations: []*taskv1.TaskMutation{
+			{Operation: &taskv1.TaskMutation_Create{Create: &taskv1.CreateTaskMutation{Title: "small"}}},
+			{Operation: &taskv1.TaskMutation_Create{Create: &taskv1.CreateTaskMutation{Notes: strings.Repeat("n", 500)}}},
+		},
+	}
+
+	path, size := largestField(req.ProtoReflect(), "")
+	if path != "mutations[1].create.notes" || size != 500 {
+		t.Errorf("largestField() = %q, %d; want mutations[1].create.notes, 500", path, size)
+	}
+}
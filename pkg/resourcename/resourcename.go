@@ -0,0 +1,181 @@
+// Package resourcename parses and formats the resource names of the API.
+// Every resource is scoped by the user owning it:
+//
+//	users/{user}/tasks/{task}
+//	users/{user}/tasks/{task}/checklistItems/{item}
+//	users/{user}/tags/{tag}
+//	users/{user}/savedFilters/{filter}
+//
+// where {task}, {item}, {tag} and {filter} are UUIDs. Names sent by clients may use
+// "-" as {user} to stand for the caller, or leave out the users/{user}
+// parent altogether as the first v2 clients did.
+package resourcename
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Collection IDs of the resource names
+const (
+	UserCollection          = "users"
+	TaskCollection          = "tasks"
+	ChecklistItemCollection = "checklistItems"
+	TagCollection           = "tags"
+	SavedFilterCollection   = "savedFilters"
+)
+
+// AnyUser stands for the caller in place of a user ID
+const AnyUser = "-"
+
+// ErrInvalid is returned when a name does not match the expected pattern
+var ErrInvalid = errors.New("invalid resource name")
+
+// Task is the name of a task
+type Task struct {
+	// User is the owner's user ID; empty or AnyUser when the name leaves it
+	// to the caller
+	User string
+	Task uuid.UUID
+}
+
+// String formats the name as users/{user}/tasks/{task}
+func (n Task) String() string {
+	return userPrefix(n.User) + TaskCollection + "/" + n.Task.String()
+}
+
+// ChecklistItem is the name of a checklist item of a task
+type ChecklistItem struct {
+	User string
+	Task uuid.UUID
+	Item uuid.UUID
+}
+
+// Parent returns the name of the task the item belongs to
+func (n ChecklistItem) Parent() Task {
+	return Task{User: n.User, Task: n.Task}
+}
+
+// String formats the name as users/{user}/tasks/{task}/checklistItems/{item}
+func (n ChecklistItem) String() string {
+	return n.Parent().String() + "/" + ChecklistItemCollection + "/" + n.Item.String()
+}
+
+// Tag is the name of a tag
+type Tag struct {
+	User string
+	Tag  uuid.UUID
+}
+
+// String formats the name as users/{user}/tags/{tag}
+func (n Tag) String() string {
+	return userPrefix(n.User) + TagCollection + "/" + n.Tag.String()
+}
+
+// SavedFilter is the name of a saved filter
+type SavedFilter struct {
+	User   string
+	Filter uuid.UUID
+}
+
+// String formats the name as users/{user}/savedFilters/{filter}
+func (n SavedFilter) String() string {
+	return userPrefix(n.User) + SavedFilterCollection + "/" + n.Filter.String()
+}
+
+// ParseTask parses a users/{user}/tasks/{task} name
+func ParseTask(name string) (Task, error) {
+	user, ids, err := parse(name, TaskCollection)
+	if err != nil {
+		return Task{}, err
+	}
+	return Task{User: user, Task: ids[0]}, nil
+}
+
+// ParseChecklistItem parses a users/{user}/tasks/{task}/checklistItems/{item}
+// name
+func ParseChecklistItem(name string) (ChecklistItem, error) {
+	user, ids, err := parse(name, TaskCollection, ChecklistItemCollection)
+	if err != nil {
+		return ChecklistItem{}, err
+	}
+	return ChecklistItem{User: user, Task: ids[0], Item: ids[1]}, nil
+}
+
+// ParseTag parses a users/{user}/tags/{tag} name
+func ParseTag(name string) (Tag, error) {
+	user, ids, err := parse(name, TagCollection)
+	if err != nil {
+		return Tag{}, err
+	}
+	return Tag{User: user, Tag: ids[0]}, nil
+}
+
+// ParseSavedFilter parses a users/{user}/savedFilters/{filter} name
+func ParseSavedFilter(name string) (SavedFilter, error) {
+	user, ids, err := parse(name, SavedFilterCollection)
+	if err != nil {
+		return SavedFilter{}, err
+	}
+	return SavedFilter{User: user, Filter: ids[0]}, nil
+}
+
+// OwnedBy reports whether a name's user segment allows the resource to
+// belong to userID
+func OwnedBy(user, userID string) bool {
+	return user == "" || user == AnyUser || user == userID
+}
+
+// userPrefix formats the users/{user}/ parent. User IDs are path escaped,
+// since identity providers may put slashes in them.
+func userPrefix(user string) string {
+	if user == "" {
+		user = AnyUser
+	}
+	return UserCollection + "/" + url.PathEscape(user) + "/"
+}
+
+// parse splits a name made of an optional users/{user} parent and the given
+// collections, each followed by a UUID, and returns the user and the UUIDs
+// in order
+func parse(name string, collections ...string) (string, []uuid.UUID, error) {
+	segments := strings.Split(name, "/")
+
+	var user string
+	if len(segments) == 2*len(collections)+2 && segments[0] == UserCollection {
+		unescaped, err := url.PathUnescape(segments[1])
+		if err != nil || unescaped == "" {
+			return "", nil, invalid(collections)
+		}
+		user = unescaped
+		segments = segments[2:]
+	}
+	if len(segments) != 2*len(collections) {
+		return "", nil, invalid(collections)
+	}
+
+	ids := make([]uuid.UUID, len(collections))
+	for i, collection := range collections {
+		if segments[2*i] != collection {
+			return "", nil, invalid(collections)
+		}
+		id, err := uuid.Parse(segments[2*i+1])
+		if err != nil {
+			return "", nil, invalid(collections)
+		}
+		ids[i] = id
+	}
+	return user, ids, nil
+}
+
+func invalid(collections []string) error {
+	pattern := UserCollection + "/{user}"
+	for _, collection := range collections {
+		pattern += "/" + collection + "/{id}"
+	}
+	return fmt.Errorf("%w: expected %s", ErrInvalid, pattern)
+}
@@ -0,0 +1,95 @@
+package resourcename
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestRoundTrip(t *testing.T) {
+	taskID, itemID := uuid.New(), uuid.New()
+
+	task := Task{User: "user-1", Task: taskID}
+	if got, want := task.String(), "users/user-1/tasks/"+taskID.String(); got != want {
+		t.Fatalf("Task.String() = %q, want %q", got, want)
+	}
+	if got, err := ParseTask(task.String()); err != nil || got != task {
+		t.Errorf("ParseTask(%q) = %v, %v", task, got, err)
+	}
+
+	item := ChecklistItem{User: "auth0|a/b", Task: taskID, Item: itemID}
+	if got, err := ParseChecklistItem(item.String()); err != nil || got != item {
+		t.Errorf("ParseChecklistItem(%q) = %v, %v", item, got, err)
+	}
+	if got := item.Parent(); got.Task != taskID || got.User != item.User {
+		t.Errorf("Parent() = %v", got)
+	}
+
+	tag := Tag{User: "user-1", Tag: taskID}
+	if got, err := ParseTag(tag.String()); err != nil || got != tag {
+		t.Errorf("ParseTag(%q) = %v, %v", tag, got, err)
+	}
+
+	filter := SavedFilter{User: "user-1", Filter: itemID}
+	if got, want := filter.String(), "users/user-1/savedFilters/"+itemID.String(); got != want {
+		t.Fatalf("SavedFilter.String() = %q, want %q", got, want)
+	}
+	if got, err := ParseSavedFilter(filter.String()); err != nil || got != filter {
+		t.Errorf("ParseSavedFilter(%q) = %v, %v", filter, got, err)
+	}
+}
+
+func TestParseTask_UnscopedAndWildcard(t *testing.T) {
+	taskID := uuid.New()
+
+	for name, wantUser := range map[string]string{
+		"tasks/" + taskID.String():             "",
+		"users/-/tasks/" + taskID.String():     AnyUser,
+		"users/u%2F1/tasks/" + taskID.String(): "u/1",
+	} {
+		got, err := ParseTask(name)
+		if err != nil || got.Task != taskID || got.User != wantUser {
+			t.Errorf("ParseTask(%q) = %v, %v; want user %q", name, got, err, wantUser)
+		}
+	}
+
+	if got := (Task{Task: taskID}).String(); got != "users/-/tasks/"+taskID.String() {
+		t.Errorf("unscoped Task.String() = %q", got)
+	}
+}
+
+func TestParseTask_Invalid(t *testing.T) {
+	taskID, itemID := uuid.New(), uuid.New()
+
+	for _, name := range []string{
+		"",
+		taskID.String(),
+		"tags/" + taskID.String(),
+		"tasks/not-a-uuid",
+		"users//tasks/" + taskID.String(),
+		"accounts/u/tasks/" + taskID.String(),
+		"users/u/tasks/" + taskID.String() + "/checklistItems/" + itemID.String(),
+		"tasks/" + taskID.String() + "/checklistItems/" + itemID.String(),
+	} {
+		if _, err := ParseTask(name); !errors.Is(err, ErrInvalid) {
+			t.Errorf("ParseTask(%q) error = %v, want ErrInvalid", name, err)
+		}
+	}
+}
+
+func TestOwnedBy(t *testing.T) {
+	for _, tt := range []struct {
+		user string
+		want bool
+	}{
+		{"", true},
+		{AnyUser, true},
+		{"user-1", true},
+		{"user-2", false},
+	} {
+		if got := OwnedBy(tt.user, "user-1"); got != tt.want {
+			t.Errorf("OwnedBy(%q) = %v, want %v", tt.user, got, tt.want)
+		}
+	}
+}
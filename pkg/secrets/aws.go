@@ -0,0 +1,39 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+// AWSProvider reads secrets from AWS Secrets Manager
+type AWSProvider struct {
+	client *secretsmanager.Client
+}
+
+// NewAWSProvider creates an AWS Secrets Manager provider using the default
+// credential chain and region (AWS_REGION, shared config, instance roles)
+func NewAWSProvider(ctx context.Context) (Provider, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &AWSProvider{client: secretsmanager.NewFromConfig(cfg)}, nil
+}
+
+// Fetch returns the current value of the secret with the given name or ARN
+func (p *AWSProvider) Fetch(ctx context.Context, path string) (map[string]string, string, error) {
+	out, err := p.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(path),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if out.SecretString == nil {
+		return nil, "", errors.New("binary secrets are not supported")
+	}
+	return nil, *out.SecretString, nil
+}
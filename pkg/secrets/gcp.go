@@ -0,0 +1,72 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2/google"
+)
+
+const gcpSecretManagerURL = "https://secretmanager.googleapis.com/v1/"
+
+// GCPProvider reads secrets from GCP Secret Manager over its REST API
+type GCPProvider struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewGCPProvider creates a GCP Secret Manager provider using Application
+// Default Credentials
+func NewGCPProvider(ctx context.Context) (Provider, error) {
+	client, err := google.DefaultClient(ctx, "https://www.googleapis.com/auth/cloud-platform")
+	if err != nil {
+		return nil, err
+	}
+	return &GCPProvider{client: client, baseURL: gcpSecretManagerURL}, nil
+}
+
+// Fetch accesses a secret version. path is a resource name such as
+// "projects/p/secrets/s/versions/3"; without a version the latest is used.
+func (p *GCPProvider) Fetch(ctx context.Context, path string) (map[string]string, string, error) {
+	path = strings.Trim(path, "/")
+	if !strings.Contains(path, "/versions/") {
+		path += "/versions/latest"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+path+":access", nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("secret manager returned %s", resp.Status)
+	}
+
+	var payload struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, "", fmt.Errorf("decode secret manager response: %w", err)
+	}
+	data, err := base64.StdEncoding.DecodeString(payload.Payload.Data)
+	if err != nil {
+		return nil, "", fmt.Errorf("decode secret payload: %w", err)
+	}
+	return nil, string(data), nil
+}
@@ -0,0 +1,183 @@
+// Package secrets resolves secret references in configuration values.
+//
+// A configuration value that starts with a provider scheme is fetched from
+// that provider instead of being used literally:
+//
+//	vault:secret/data/slips#db_password        HashiCorp Vault (KV v2)
+//	awssm:slips/prod#db_password               AWS Secrets Manager
+//	gcpsm:projects/p/secrets/slips-db/versions/latest   GCP Secret Manager
+//
+// The part after '#' selects a key from a JSON object secret; it is required
+// for Vault and optional for the cloud providers, whose secrets may also be
+// plain strings. Values without a known scheme are returned unchanged, so
+// existing plaintext and environment-variable configuration keeps working.
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Provider fetches the secret stored at path. It returns either the secret's
+// decoded fields or its raw payload (leaving fields nil).
+type Provider interface {
+	Fetch(ctx context.Context, path string) (map[string]string, string, error)
+}
+
+// Reference is a parsed secret reference
+type Reference struct {
+	Scheme string
+	Path   string
+	Key    string
+}
+
+// String returns the reference in its configuration form
+func (r Reference) String() string {
+	if r.Key == "" {
+		return r.Scheme + ":" + r.Path
+	}
+	return r.Scheme + ":" + r.Path + "#" + r.Key
+}
+
+// Supported reference schemes
+const (
+	SchemeVault = "vault"
+	SchemeAWS   = "awssm"
+	SchemeGCP   = "gcpsm"
+)
+
+// ParseReference parses value as a secret reference. ok is false when value
+// is a literal rather than a reference.
+func ParseReference(value string) (ref Reference, ok bool, err error) {
+	scheme, rest, found := strings.Cut(value, ":")
+	if !found {
+		return Reference{}, false, nil
+	}
+	switch scheme {
+	case SchemeVault, SchemeAWS, SchemeGCP:
+	default:
+		return Reference{}, false, nil
+	}
+
+	path, key, _ := strings.Cut(rest, "#")
+	if path == "" {
+		return Reference{}, true, fmt.Errorf("secret reference %q has no path", value)
+	}
+	if scheme == SchemeVault && key == "" {
+		return Reference{}, true, fmt.Errorf("vault reference %q must select a key with #key", value)
+	}
+	return Reference{Scheme: scheme, Path: path, Key: key}, true, nil
+}
+
+// IsReference reports whether value refers to a secret manager
+func IsReference(value string) bool {
+	_, ok, _ := ParseReference(value)
+	return ok
+}
+
+// Resolver resolves references using lazily created providers, so cloud
+// credentials are only loaded when a reference actually needs them
+type Resolver struct {
+	mu        sync.Mutex
+	providers map[string]Provider
+	factories map[string]func(ctx context.Context) (Provider, error)
+}
+
+// NewResolver creates a resolver for the Vault, AWS and GCP providers.
+// Vault is configured with the standard VAULT_ADDR, VAULT_TOKEN and
+// VAULT_NAMESPACE environment variables; the cloud providers use their
+// default credential chains.
+func NewResolver() *Resolver {
+	return &Resolver{
+		providers: make(map[string]Provider),
+		factories: map[string]func(ctx context.Context) (Provider, error){
+			SchemeVault: func(ctx context.Context) (Provider, error) { return NewVaultProviderFromEnv() },
+			SchemeAWS:   NewAWSProvider,
+			SchemeGCP:   NewGCPProvider,
+		},
+	}
+}
+
+// NewResolverWithProviders creates a resolver that uses the given providers
+// by scheme. It is mainly useful in tests.
+func NewResolverWithProviders(providers map[string]Provider) *Resolver {
+	r := &Resolver{
+		providers: make(map[string]Provider, len(providers)),
+		factories: map[string]func(ctx context.Context) (Provider, error){},
+	}
+	for scheme, provider := range providers {
+		r.providers[scheme] = provider
+	}
+	return r
+}
+
+// Resolve returns the secret value for a reference, or value itself when it
+// is not a reference
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	ref, ok, err := ParseReference(value)
+	if err != nil || !ok {
+		return value, err
+	}
+
+	provider, err := r.provider(ctx, ref.Scheme)
+	if err != nil {
+		return "", fmt.Errorf("%s secrets: %w", ref.Scheme, err)
+	}
+
+	fields, raw, err := provider.Fetch(ctx, ref.Path)
+	if err != nil {
+		return "", fmt.Errorf("fetch secret %s: %w", ref, err)
+	}
+	return selectKey(ref, fields, raw)
+}
+
+func (r *Resolver) provider(ctx context.Context, scheme string) (Provider, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if provider, ok := r.providers[scheme]; ok {
+		return provider, nil
+	}
+	factory, ok := r.factories[scheme]
+	if !ok {
+		return nil, fmt.Errorf("no provider configured")
+	}
+	provider, err := factory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.providers[scheme] = provider
+	return provider, nil
+}
+
+// selectKey picks ref.Key from the secret. Providers return either decoded
+// fields (Vault) or the raw payload, which is decoded as a JSON object when a
+// key is requested.
+func selectKey(ref Reference, fields map[string]string, raw string) (string, error) {
+	if ref.Key == "" {
+		return raw, nil
+	}
+	if fields == nil {
+		var object map[string]any
+		if err := json.Unmarshal([]byte(raw), &object); err != nil {
+			return "", fmt.Errorf("secret %s is not a JSON object, cannot select key %q", ref, ref.Key)
+		}
+		fields = make(map[string]string, len(object))
+		for k, v := range object {
+			if s, ok := v.(string); ok {
+				fields[k] = s
+			} else {
+				fields[k] = fmt.Sprint(v)
+			}
+		}
+	}
+
+	value, ok := fields[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("secret %s has no key %q", ref, ref.Key)
+	}
+	return value, nil
+}
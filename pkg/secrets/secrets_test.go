@@ -0,0 +1,120 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeProvider struct {
+	fields map[string]string
+	raw    string
+	err    error
+	calls  int
+}
+
+func (p *fakeProvider) Fetch(ctx context.Context, path string) (map[string]string, string, error) {
+	p.calls++
+	return p.fields, p.raw, p.err
+}
+
+func TestParseReference(t *testing.T) {
+	ref, ok, err := ParseReference("vault:secret/data/slips#db_password")
+	if err != nil || !ok {
+		t.Fatalf("expected a vault reference, got ok=%t err=%v", ok, err)
+	}
+	if ref.Scheme != SchemeVault || ref.Path != "secret/data/slips" || ref.Key != "db_password" {
+		t.Fatalf("unexpected reference %+v", ref)
+	}
+
+	for _, literal := range []string{"postgres", "p@ss:word", "postgres://u:p@host/db", ""} {
+		if _, ok, _ := ParseReference(literal); ok {
+			t.Errorf("expected %q to be a literal", literal)
+		}
+	}
+
+	if _, _, err := ParseReference("vault:secret/data/slips"); err == nil {
+		t.Error("expected vault reference without key to fail")
+	}
+	if _, _, err := ParseReference("awssm:#key"); err == nil {
+		t.Error("expected reference without path to fail")
+	}
+}
+
+func TestResolver_SelectsJSONKey(t *testing.T) {
+	aws := &fakeProvider{raw: `{"db_password":"s3cret","port":5432}`}
+	resolver := NewResolverWithProviders(map[string]Provider{SchemeAWS: aws})
+
+	got, err := resolver.Resolve(context.Background(), "awssm:slips/prod#db_password")
+	if err != nil || got != "s3cret" {
+		t.Fatalf("expected s3cret, got %q (%v)", got, err)
+	}
+	if _, err := resolver.Resolve(context.Background(), "awssm:slips/prod#missing"); err == nil {
+		t.Fatal("expected missing key to fail")
+	}
+
+	plain := &fakeProvider{raw: "whole-secret"}
+	resolver = NewResolverWithProviders(map[string]Provider{SchemeGCP: plain})
+	got, err = resolver.Resolve(context.Background(), "gcpsm:projects/p/secrets/db")
+	if err != nil || got != "whole-secret" {
+		t.Fatalf("expected the raw secret, got %q (%v)", got, err)
+	}
+
+	got, err = resolver.Resolve(context.Background(), "literal-password")
+	if err != nil || got != "literal-password" {
+		t.Fatalf("expected literals to pass through, got %q (%v)", got, err)
+	}
+}
+
+func TestVaultProvider_ReadsKVv2(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/data/slips" || r.Header.Get("X-Vault-Token") != "token" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		_, _ = io.WriteString(w, `{"data":{"data":{"db_password":"from-vault"},"metadata":{"version":2}}}`)
+	}))
+	defer server.Close()
+
+	resolver := NewResolverWithProviders(map[string]Provider{
+		SchemeVault: NewVaultProvider(server.URL, "token", ""),
+	})
+	got, err := resolver.Resolve(context.Background(), "vault:secret/data/slips#db_password")
+	if err != nil || got != "from-vault" {
+		t.Fatalf("expected from-vault, got %q (%v)", got, err)
+	}
+
+	resolver = NewResolverWithProviders(map[string]Provider{
+		SchemeVault: NewVaultProvider(server.URL, "wrong", ""),
+	})
+	if _, err := resolver.Resolve(context.Background(), "vault:secret/data/slips#db_password"); err == nil {
+		t.Fatal("expected a rejected token to fail")
+	}
+}
+
+func TestValue_RefreshKeepsPreviousOnError(t *testing.T) {
+	provider := &fakeProvider{raw: "v1"}
+	resolver := NewResolverWithProviders(map[string]Provider{SchemeAWS: provider})
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	value, err := NewValue(context.Background(), resolver, "awssm:db")
+	if err != nil || value.Get() != "v1" {
+		t.Fatalf("expected v1, got %q (%v)", value.Get(), err)
+	}
+
+	provider.raw = "v2"
+	value.refresh(context.Background(), logger)
+	if value.Get() != "v2" {
+		t.Fatalf("expected rotated value v2, got %q", value.Get())
+	}
+
+	provider.err = errors.New("unavailable")
+	value.refresh(context.Background(), logger)
+	if value.Get() != "v2" {
+		t.Fatalf("expected previous value to be kept, got %q", value.Get())
+	}
+}
@@ -0,0 +1,77 @@
+package secrets
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Value is a configuration value that may be a secret reference. References
+// are re-resolved periodically by Run so rotated secrets are picked up
+// without a restart; literal values never change.
+type Value struct {
+	resolver *Resolver
+	raw      string
+
+	mu      sync.RWMutex
+	current string
+}
+
+// NewValue resolves raw once and returns a Value holding the result
+func NewValue(ctx context.Context, resolver *Resolver, raw string) (*Value, error) {
+	resolved, err := resolver.Resolve(ctx, raw)
+	if err != nil {
+		return nil, err
+	}
+	return &Value{
+		resolver: resolver,
+		raw:      raw,
+		current:  resolved,
+	}, nil
+}
+
+// Get returns the most recently resolved value
+func (v *Value) Get() string {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.current
+}
+
+// Run re-resolves the reference every interval until ctx is done. Failures
+// are logged and the previous value is kept. It returns immediately for
+// literal values or a non-positive interval.
+func (v *Value) Run(ctx context.Context, interval time.Duration, logger *slog.Logger) {
+	if !IsReference(v.raw) || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			v.refresh(ctx, logger)
+		}
+	}
+}
+
+func (v *Value) refresh(ctx context.Context, logger *slog.Logger) {
+	resolved, err := v.resolver.Resolve(ctx, v.raw)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to refresh secret, keeping previous value", "secret", v.raw, "error", err)
+		return
+	}
+
+	v.mu.Lock()
+	changed := resolved != v.current
+	v.current = resolved
+	v.mu.Unlock()
+
+	if changed {
+		logger.InfoContext(ctx, "secret rotated", "secret", v.raw)
+	}
+}
@@ -0,0 +1,91 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// VaultProvider reads KV v2 secrets over the Vault HTTP API
+type VaultProvider struct {
+	addr      string
+	token     string
+	namespace string
+	client    *http.Client
+}
+
+// NewVaultProvider creates a Vault provider for the server at addr
+func NewVaultProvider(addr, token, namespace string) *VaultProvider {
+	return &VaultProvider{
+		addr:      strings.TrimRight(addr, "/"),
+		token:     token,
+		namespace: namespace,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// NewVaultProviderFromEnv creates a Vault provider from VAULT_ADDR,
+// VAULT_TOKEN and the optional VAULT_NAMESPACE
+func NewVaultProviderFromEnv() (*VaultProvider, error) {
+	addr := os.Getenv("VAULT_ADDR")
+	token := os.Getenv("VAULT_TOKEN")
+	if addr == "" || token == "" {
+		return nil, errors.New("VAULT_ADDR and VAULT_TOKEN must be set")
+	}
+	return NewVaultProvider(addr, token, os.Getenv("VAULT_NAMESPACE")), nil
+}
+
+// Fetch reads the KV v2 secret at path, e.g. "secret/data/slips"
+func (p *VaultProvider) Fetch(ctx context.Context, path string) (map[string]string, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.addr+"/v1/"+strings.TrimLeft(path, "/"), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	if p.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("vault returned %s", resp.Status)
+	}
+
+	// KV v2 nests the secret under data.data
+	var payload struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, "", fmt.Errorf("decode vault response: %w", err)
+	}
+	if payload.Data.Data == nil {
+		return nil, "", errors.New("vault response has no data; is the path a KV v2 secret (secret/data/...)?")
+	}
+
+	fields := make(map[string]string, len(payload.Data.Data))
+	for k, v := range payload.Data.Data {
+		if s, ok := v.(string); ok {
+			fields[k] = s
+		} else {
+			fields[k] = fmt.Sprint(v)
+		}
+	}
+	return fields, "", nil
+}
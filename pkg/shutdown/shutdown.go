@@ -0,0 +1,135 @@
+// Package shutdown coordinates a bounded graceful shutdown of the gRPC
+// server and the background work started on behalf of requests.
+package shutdown
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Server is the part of *grpc.Server used during shutdown
+type Server interface {
+	GracefulStop()
+	Stop()
+}
+
+// HealthServer is the part of *health.Server used during shutdown
+type HealthServer interface {
+	Shutdown()
+}
+
+// Coordinator tracks background workers and stops the server within a drain
+// timeout. Workers started with Go receive a context that is cancelled if
+// they are still running when the timeout expires.
+type Coordinator struct {
+	timeout time.Duration
+	logger  *slog.Logger
+
+	workerCtx    context.Context
+	cancelWorker context.CancelFunc
+
+	mu      sync.Mutex
+	closed  bool
+	workers sync.WaitGroup
+
+	once sync.Once
+	done chan struct{}
+}
+
+// New creates a coordinator that allows timeout for draining RPCs and
+// workers. A non-positive timeout waits indefinitely.
+func New(timeout time.Duration, logger *slog.Logger) *Coordinator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Coordinator{
+		timeout:      timeout,
+		logger:       logger,
+		workerCtx:    ctx,
+		cancelWorker: cancel,
+		done:         make(chan struct{}),
+	}
+}
+
+// Go runs fn in a tracked goroutine. Once shutdown has finished draining
+// RPCs no new workers are accepted and Go reports false.
+func (c *Coordinator) Go(fn func(ctx context.Context)) bool {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return false
+	}
+	c.workers.Add(1)
+	c.mu.Unlock()
+
+	go func() {
+		defer c.workers.Done()
+		fn(c.workerCtx)
+	}()
+	return true
+}
+
+// Shutdown marks the service NOT_SERVING, stops the server gracefully and
+// waits for workers. If RPCs are still running when the drain timeout
+// expires the server is stopped forcibly; workers still running after that
+// have their context cancelled. Only the first call has any effect; later
+// calls wait for it to finish.
+func (c *Coordinator) Shutdown(health HealthServer, server Server) {
+	c.once.Do(func() {
+		defer close(c.done)
+		c.shutdown(health, server)
+	})
+	<-c.done
+}
+
+func (c *Coordinator) shutdown(health HealthServer, server Server) {
+	start := time.Now()
+	ctx := context.Background()
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	// Load balancers and probes see NOT_SERVING before connections drain
+	health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		c.logger.Warn("drain timeout exceeded, closing remaining connections", "timeout", c.timeout)
+		server.Stop()
+		<-stopped
+	}
+
+	// Every RPC has returned, so nothing can start new workers
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+
+	finished := make(chan struct{})
+	go func() {
+		c.workers.Wait()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+	case <-ctx.Done():
+		c.logger.Warn("drain timeout exceeded, cancelling background workers", "timeout", c.timeout)
+		c.cancelWorker()
+		<-finished
+	}
+	c.cancelWorker()
+
+	c.logger.Info("shutdown complete", "duration", time.Since(start))
+}
+
+// Done is closed when Shutdown has finished
+func (c *Coordinator) Done() <-chan struct{} {
+	return c.done
+}
@@ -0,0 +1,104 @@
+package shutdown
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeHealth struct {
+	shutdown atomic.Bool
+}
+
+func (h *fakeHealth) Shutdown() { h.shutdown.Store(true) }
+
+// fakeServer blocks GracefulStop until release is closed or Stop is called
+type fakeServer struct {
+	release chan struct{}
+	stopped atomic.Bool
+}
+
+func newFakeServer() *fakeServer {
+	return &fakeServer{release: make(chan struct{})}
+}
+
+func (s *fakeServer) GracefulStop() { <-s.release }
+
+func (s *fakeServer) Stop() {
+	s.stopped.Store(true)
+	close(s.release)
+}
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestShutdownWaitsForWorkers(t *testing.T) {
+	c := New(time.Second, discardLogger())
+
+	var finished atomic.Bool
+	unblock := make(chan struct{})
+	c.Go(func(ctx context.Context) {
+		<-unblock
+		finished.Store(true)
+	})
+
+	server := newFakeServer()
+	close(server.release)
+	health := &fakeHealth{}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(unblock)
+	}()
+	c.Shutdown(health, server)
+
+	if !health.shutdown.Load() {
+		t.Error("health server was not shut down")
+	}
+	if server.stopped.Load() {
+		t.Error("server was stopped forcibly despite draining in time")
+	}
+	if !finished.Load() {
+		t.Error("Shutdown returned before the worker finished")
+	}
+	select {
+	case <-c.Done():
+	default:
+		t.Error("Done is not closed after Shutdown")
+	}
+}
+
+func TestShutdownForcesStopAfterTimeout(t *testing.T) {
+	c := New(20*time.Millisecond, discardLogger())
+
+	var cancelled atomic.Bool
+	c.Go(func(ctx context.Context) {
+		<-ctx.Done()
+		cancelled.Store(true)
+	})
+
+	server := newFakeServer()
+	c.Shutdown(&fakeHealth{}, server)
+
+	if !server.stopped.Load() {
+		t.Error("server was not stopped after the drain timeout")
+	}
+	if !cancelled.Load() {
+		t.Error("worker context was not cancelled after the drain timeout")
+	}
+}
+
+func TestGoAfterShutdown(t *testing.T) {
+	c := New(time.Second, discardLogger())
+	server := newFakeServer()
+	close(server.release)
+	c.Shutdown(&fakeHealth{}, server)
+
+	if c.Go(func(context.Context) { t.Error("worker ran after shutdown") }) {
+		t.Error("Go accepted a worker after shutdown")
+	}
+}
@@ -20,42 +20,79 @@ func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		tracer := otel.Tracer("grpc-server")
-
-		// Extract trace context from metadata
-		md, _ := metadata.FromIncomingContext(ctx)
-		carrier := &metadataCarrier{md: md}
-		ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
-
-		// Start span
-		ctx, span := tracer.Start(ctx, info.FullMethod,
-			trace.WithSpanKind(trace.SpanKindServer),
-			trace.WithAttributes(
-				attribute.String("rpc.system", "grpc"),
-				attribute.String("rpc.method", info.FullMethod),
-			),
-		)
+		ctx, span := startServerSpan(ctx, info.FullMethod)
 		defer span.End()
 
 		// Call handler
 		resp, err := handler(ctx, req)
+		endServerSpan(span, err)
+		return resp, err
+	}
+}
 
-		// Record error if any
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-			st, _ := status.FromError(err)
-			span.SetAttributes(
-				attribute.String("rpc.grpc.status_code", st.Code().String()),
-			)
-		} else {
-			span.SetStatus(codes.Ok, "")
-		}
+// StreamServerInterceptor returns a gRPC stream server interceptor with
+// tracing. The span covers the whole stream.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+		defer span.End()
 
-		return resp, err
+		err := handler(srv, &tracedStream{ServerStream: ss, ctx: ctx})
+		endServerSpan(span, err)
+		return err
 	}
 }
 
+// startServerSpan starts the server span of an RPC as a child of the trace
+// context propagated in the incoming metadata
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	tracer := otel.Tracer("grpc-server")
+
+	// Extract trace context from metadata
+	md, _ := metadata.FromIncomingContext(ctx)
+	carrier := &metadataCarrier{md: md}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+
+	// Start span
+	return tracer.Start(ctx, fullMethod,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.method", fullMethod),
+		),
+	)
+}
+
+// endServerSpan records the outcome of an RPC on its span
+func endServerSpan(span trace.Span, err error) {
+	// Record error if any
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		st, _ := status.FromError(err)
+		span.SetAttributes(
+			attribute.String("rpc.grpc.status_code", st.Code().String()),
+		)
+	} else {
+		span.SetStatus(codes.Ok, "")
+	}
+}
+
+// tracedStream carries the span context to stream handlers
+type tracedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tracedStream) Context() context.Context {
+	return s.ctx
+}
+
 // metadataCarrier adapts metadata.MD to propagation.TextMapCarrier
 type metadataCarrier struct {
 	md metadata.MD
@@ -0,0 +1,23 @@
+package webpush
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slips-ai/slips-core/pkg/config"
+	"github.com/slips-ai/slips-core/pkg/secrets"
+)
+
+// NewSenderFromConfig builds the sender described by cfg, resolving the
+// VAPID private key through resolver. It returns nil when web push is
+// disabled.
+func NewSenderFromConfig(ctx context.Context, cfg config.WebPushConfig, resolver *secrets.Resolver) (*Sender, error) {
+	if cfg.VAPIDPrivateKey == "" {
+		return nil, nil
+	}
+	privateKey, err := resolver.Resolve(ctx, cfg.VAPIDPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("resolve VAPID private key: %w", err)
+	}
+	return NewSender(privateKey, cfg.Subject, nil)
+}
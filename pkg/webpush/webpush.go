@@ -0,0 +1,358 @@
+// Package webpush delivers Web Push messages (RFC 8030) to browser
+// subscriptions. Payloads are encrypted for the subscription (RFC 8291) and
+// requests are signed with the server's VAPID key (RFC 8292).
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// MaxPayloadSize is the largest payload every push service accepts: a
+	// single 4096 byte record less the encryption header, the AES-GCM tag
+	// and the padding delimiter
+	MaxPayloadSize = recordSize - headerSize - 16 - 1
+
+	recordSize        = 4096
+	headerSize        = 16 + 4 + 1 + 65
+	maxEndpointLength = 2048
+	// tokenLifetime is how long a VAPID token is valid; RFC 8292 allows at
+	// most 24 hours
+	tokenLifetime = 12 * time.Hour
+	// defaultTimeout bounds a delivery when the caller's context does not
+	defaultTimeout = 30 * time.Second
+)
+
+var (
+	// ErrInvalidSubscription is returned for subscriptions whose endpoint
+	// or keys are malformed
+	ErrInvalidSubscription = errors.New("invalid push subscription")
+	// ErrGone is returned when the push service reports the subscription
+	// expired or was unsubscribed; it should be deleted
+	ErrGone = errors.New("push subscription is gone")
+	// ErrPayloadTooLarge is returned for payloads over MaxPayloadSize
+	ErrPayloadTooLarge = errors.New("push payload too large")
+)
+
+// Urgency tells the push service how soon to wake the device (RFC 8030
+// section 5.3)
+type Urgency string
+
+// Urgencies, from least to most urgent
+const (
+	UrgencyVeryLow Urgency = "very-low"
+	UrgencyLow     Urgency = "low"
+	UrgencyNormal  Urgency = "normal"
+	UrgencyHigh    Urgency = "high"
+)
+
+// Subscription is a browser's PushSubscription as returned by its toJSON
+// method. P256dh and Auth are base64url encoded, with or without padding.
+type Subscription struct {
+	Endpoint string
+	P256dh   string
+	Auth     string
+}
+
+// Validate checks the endpoint is an HTTPS URL on a public host name and the
+// keys decode to a P-256 public key and a 16 byte secret. IP addresses,
+// localhost and single label names, which only resolve inside the server's
+// network, are refused.
+func (s Subscription) Validate() error {
+	if len(s.Endpoint) > maxEndpointLength {
+		return fmt.Errorf("%w: endpoint is longer than %d bytes", ErrInvalidSubscription, maxEndpointLength)
+	}
+	endpoint, err := url.Parse(s.Endpoint)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		return fmt.Errorf("%w: endpoint must be an https URL", ErrInvalidSubscription)
+	}
+	host := strings.TrimSuffix(strings.ToLower(endpoint.Hostname()), ".")
+	if _, err := netip.ParseAddr(host); err == nil || !strings.Contains(host, ".") || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: endpoint must be on a push service's host name", ErrInvalidSubscription)
+	}
+	if _, _, err := s.keys(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// keys decodes the subscription's public key and authentication secret
+func (s Subscription) keys() (*ecdh.PublicKey, []byte, error) {
+	raw, err := decode(s.P256dh)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: p256dh is not base64url", ErrInvalidSubscription)
+	}
+	publicKey, err := ecdh.P256().NewPublicKey(raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: p256dh is not a P-256 public key", ErrInvalidSubscription)
+	}
+	secret, err := decode(s.Auth)
+	if err != nil || len(secret) != 16 {
+		return nil, nil, fmt.Errorf("%w: auth must be a 16 byte base64url secret", ErrInvalidSubscription)
+	}
+	return publicKey, secret, nil
+}
+
+// Options control how the push service handles a message
+type Options struct {
+	// TTL is how long the push service keeps the message for a device that
+	// is offline; 0 delivers it only if the device is reachable now
+	TTL time.Duration
+	// Urgency defaults to normal when empty
+	Urgency Urgency
+	// Topic, when set, replaces an undelivered message with the same topic
+	Topic string
+}
+
+// Sender delivers messages signed with a VAPID key. It is safe for
+// concurrent use.
+type Sender struct {
+	key       *ecdsa.PrivateKey
+	publicKey string
+	subject   string
+	client    *http.Client
+	now       func() time.Time
+}
+
+// NewSender creates a sender from a base64url encoded P-256 private key, as
+// printed by `web-push generate-vapid-keys`. subject is a mailto: or https:
+// URL push services can use to contact the operator. A nil client uses one
+// that only connects to public addresses.
+func NewSender(privateKey, subject string, client *http.Client) (*Sender, error) {
+	raw, err := decode(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode VAPID private key: %w", err)
+	}
+	ecdhKey, err := ecdh.P256().NewPrivateKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+	if !strings.HasPrefix(subject, "mailto:") && !strings.HasPrefix(subject, "https://") {
+		return nil, fmt.Errorf("VAPID subject %q must be a mailto: or https: URL", subject)
+	}
+	if client == nil {
+		client = publicClient()
+	}
+
+	// The uncompressed public key is 0x04 || X || Y
+	public := ecdhKey.PublicKey().Bytes()
+	key := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(public[1:33]),
+			Y:     new(big.Int).SetBytes(public[33:]),
+		},
+		D: new(big.Int).SetBytes(raw),
+	}
+	return &Sender{
+		key:       key,
+		publicKey: base64.RawURLEncoding.EncodeToString(public),
+		subject:   subject,
+		client:    client,
+		now:       time.Now,
+	}, nil
+}
+
+// publicClient returns a client that only connects to public addresses and
+// does not follow redirects, so a subscription cannot make the server call
+// into its own network, even through a host name resolving there
+func publicClient() *http.Client {
+	dialer := &net.Dialer{Timeout: defaultTimeout, Control: dialPublic}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Transport: transport,
+		Timeout:   defaultTimeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// dialPublic refuses connections to loopback, private, link-local and other
+// addresses that are not public unicast ones. It runs after the host name
+// is resolved, for every address tried.
+func dialPublic(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	addr := addrPort.Addr().Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || sharedAddressSpace.Contains(addr) {
+		return fmt.Errorf("%w: %s is not a public address", ErrInvalidSubscription, addr)
+	}
+	return nil
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which
+// netip.Addr.IsPrivate does not cover
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// PublicKey returns the base64url encoded VAPID public key, which browsers
+// need as the applicationServerKey when subscribing
+func (s *Sender) PublicKey() string {
+	return s.publicKey
+}
+
+// Send encrypts payload for sub and posts it to the push service. It
+// returns ErrGone when the subscription no longer exists.
+func (s *Sender) Send(ctx context.Context, sub Subscription, payload []byte, opts Options) error {
+	if len(payload) > MaxPayloadSize {
+		return ErrPayloadTooLarge
+	}
+	if err := sub.Validate(); err != nil {
+		return err
+	}
+	body, err := encrypt(sub, payload)
+	if err != nil {
+		return err
+	}
+	authorization, err := s.authorization(sub.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(opts.TTL/time.Second)))
+	urgency := opts.Urgency
+	if urgency == "" {
+		urgency = UrgencyNormal
+	}
+	req.Header.Set("Urgency", string(urgency))
+	if opts.Topic != "" {
+		req.Header.Set("Topic", opts.Topic)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("deliver push message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrGone
+	default:
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("push service returned %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+}
+
+// authorization returns the VAPID Authorization header for endpoint. The
+// token's audience is the push service's origin.
+func (s *Sender) authorization(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": s.now().Add(tokenLifetime).Unix(),
+		"sub": s.subject,
+	}).SignedString(s.key)
+	if err != nil {
+		return "", fmt.Errorf("sign VAPID token: %w", err)
+	}
+	return "vapid t=" + token + ", k=" + s.publicKey, nil
+}
+
+// encrypt encodes payload with the aes128gcm content coding (RFC 8188) as a
+// single record, keyed for the subscription as RFC 8291 describes
+func encrypt(sub Subscription, payload []byte) ([]byte, error) {
+	uaPublic, authSecret, err := sub.keys()
+	if err != nil {
+		return nil, err
+	}
+
+	// A fresh key pair and salt per message
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	sharedSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	asPublic := asPrivate.PublicKey().Bytes()
+
+	keyInfo := "WebPush: info\x00" + string(uaPublic.Bytes()) + string(asPublic)
+	ikm, err := hkdf.Key(sha256.New, sharedSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, headerSize)
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, recordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+
+	// 0x02 marks the last record; no further padding is added
+	plaintext := append(append([]byte{}, payload...), 0x02)
+	return gcm.Seal(header, nonce, plaintext, nil), nil
+}
+
+// decode accepts base64url with or without padding, since browsers and
+// key generators differ
+func decode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
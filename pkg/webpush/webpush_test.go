@@ -0,0 +1,238 @@
+package webpush
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// browser is the user agent side of a subscription
+type browser struct {
+	key  *ecdh.PrivateKey
+	auth []byte
+}
+
+func newBrowser(t *testing.T) *browser {
+	t.Helper()
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate browser key: %v", err)
+	}
+	auth := make([]byte, 16)
+	if _, err := rand.Read(auth); err != nil {
+		t.Fatalf("generate auth secret: %v", err)
+	}
+	return &browser{key: key, auth: auth}
+}
+
+func (b *browser) subscription(endpoint string) Subscription {
+	return Subscription{
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(b.key.PublicKey().Bytes()),
+		Auth:     base64.URLEncoding.EncodeToString(b.auth),
+	}
+}
+
+// decrypt reverses encrypt as a browser would
+func (b *browser) decrypt(t *testing.T, body []byte) []byte {
+	t.Helper()
+	if len(body) < headerSize {
+		t.Fatalf("body is %d bytes, shorter than the header", len(body))
+	}
+	salt, rs, idlen := body[:16], binary.BigEndian.Uint32(body[16:20]), int(body[20])
+	if rs != recordSize || idlen != 65 {
+		t.Fatalf("record size %d, key ID length %d", rs, idlen)
+	}
+	asPublic, err := ecdh.P256().NewPublicKey(body[21 : 21+idlen])
+	if err != nil {
+		t.Fatalf("parse server key: %v", err)
+	}
+	shared, err := b.key.ECDH(asPublic)
+	if err != nil {
+		t.Fatalf("derive shared secret: %v", err)
+	}
+
+	keyInfo := "WebPush: info\x00" + string(b.key.PublicKey().Bytes()) + string(asPublic.Bytes())
+	ikm, _ := hkdf.Key(sha256.New, shared, b.auth, keyInfo, 32)
+	prk, _ := hkdf.Extract(sha256.New, ikm, salt)
+	cek, _ := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	nonce, _ := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	block, _ := aes.NewCipher(cek)
+	gcm, _ := cipher.NewGCM(block)
+	plaintext, err := gcm.Open(nil, nonce, body[21+idlen:], nil)
+	if err != nil {
+		t.Fatalf("decrypt: %v", err)
+	}
+	if len(plaintext) == 0 || plaintext[len(plaintext)-1] != 0x02 {
+		t.Fatalf("plaintext does not end with the last record delimiter")
+	}
+	return plaintext[:len(plaintext)-1]
+}
+
+func TestSender(t *testing.T) {
+	vapidKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate VAPID key: %v", err)
+	}
+
+	var gone bool
+	var received []byte
+	var header http.Header
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if gone {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		header = r.Header
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	// The test certificate names example.com; connect it to the server
+	const origin = "https://example.com"
+	client := server.Client()
+	transport := client.Transport.(*http.Transport)
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+
+	sender, err := NewSender(base64.RawURLEncoding.EncodeToString(vapidKey.Bytes()), "mailto:ops@example.com", client)
+	if err != nil {
+		t.Fatalf("new sender: %v", err)
+	}
+	if want := base64.RawURLEncoding.EncodeToString(vapidKey.PublicKey().Bytes()); sender.PublicKey() != want {
+		t.Errorf("PublicKey() = %q, want %q", sender.PublicKey(), want)
+	}
+
+	b := newBrowser(t)
+	sub := b.subscription(origin + "/push/abc")
+	payload := []byte(`{"title":"Buy milk"}`)
+	if err := sender.Send(context.Background(), sub, payload, Options{TTL: time.Hour, Topic: "reminder"}); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	if got := b.decrypt(t, received); string(got) != string(payload) {
+		t.Errorf("payload = %q, want %q", got, payload)
+	}
+	for name, want := range map[string]string{
+		"Content-Encoding": "aes128gcm",
+		"TTL":              "3600",
+		"Urgency":          "normal",
+		"Topic":            "reminder",
+	} {
+		if got := header.Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+
+	// The VAPID token is signed with the key it names and scoped to the push
+	// service's origin
+	token, key, ok := strings.Cut(strings.TrimPrefix(header.Get("Authorization"), "vapid t="), ", k=")
+	if !ok || key != sender.PublicKey() {
+		t.Fatalf("Authorization = %q", header.Get("Authorization"))
+	}
+	raw, _ := base64.RawURLEncoding.DecodeString(key)
+	publicKey := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(raw[1:33]),
+		Y:     new(big.Int).SetBytes(raw[33:]),
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) { return publicKey, nil },
+		jwt.WithValidMethods([]string{"ES256"}), jwt.WithAudience(origin)); err != nil {
+		t.Fatalf("verify VAPID token: %v", err)
+	}
+	if claims["sub"] != "mailto:ops@example.com" {
+		t.Errorf("sub = %v", claims["sub"])
+	}
+
+	gone = true
+	if err := sender.Send(context.Background(), sub, payload, Options{}); !errors.Is(err, ErrGone) {
+		t.Errorf("send to expired subscription = %v, want ErrGone", err)
+	}
+	if err := sender.Send(context.Background(), sub, make([]byte, MaxPayloadSize+1), Options{}); !errors.Is(err, ErrPayloadTooLarge) {
+		t.Errorf("send oversized payload = %v, want ErrPayloadTooLarge", err)
+	}
+}
+
+func TestSubscription_Validate(t *testing.T) {
+	valid := newBrowser(t).subscription("https://push.example.com/abc")
+
+	tests := []struct {
+		name   string
+		mutate func(*Subscription)
+	}{
+		{"plain http endpoint", func(s *Subscription) { s.Endpoint = "http://push.example.com/abc" }},
+		{"relative endpoint", func(s *Subscription) { s.Endpoint = "/abc" }},
+		{"IPv4 endpoint", func(s *Subscription) { s.Endpoint = "https://169.254.169.254/latest" }},
+		{"IPv6 endpoint", func(s *Subscription) { s.Endpoint = "https://[::1]:8443/abc" }},
+		{"localhost endpoint", func(s *Subscription) { s.Endpoint = "https://localhost/abc" }},
+		{"single label endpoint", func(s *Subscription) { s.Endpoint = "https://redis:6379/abc" }},
+		{"short public key", func(s *Subscription) { s.P256dh = s.P256dh[:20] }},
+		{"short auth secret", func(s *Subscription) { s.Auth = "c2hvcnQ" }},
+		{"undecodable auth secret", func(s *Subscription) { s.Auth = "not base64!" }},
+	}
+
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("valid subscription: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := valid
+			tt.mutate(&sub)
+			if err := sub.Validate(); !errors.Is(err, ErrInvalidSubscription) {
+				t.Errorf("Validate() = %v, want ErrInvalidSubscription", err)
+			}
+		})
+	}
+}
+
+func TestDialPublic(t *testing.T) {
+	tests := []struct {
+		address string
+		public  bool
+	}{
+		{"142.250.74.42:443", true},
+		{"[2a00:1450:4001:82b::200a]:443", true},
+		{"127.0.0.1:443", false},
+		{"[::1]:443", false},
+		{"10.1.2.3:443", false},
+		{"172.16.0.1:443", false},
+		{"192.168.1.10:443", false},
+		{"169.254.169.254:80", false},
+		{"100.64.0.1:443", false},
+		{"[fd00::1]:443", false},
+		{"[fe80::1]:443", false},
+		{"[::ffff:10.0.0.1]:443", false},
+		{"0.0.0.0:443", false},
+	}
+	for _, tt := range tests {
+		err := dialPublic("tcp", tt.address, nil)
+		if tt.public && err != nil {
+			t.Errorf("dialPublic(%s) = %v, want it allowed", tt.address, err)
+		}
+		if !tt.public && !errors.Is(err, ErrInvalidSubscription) {
+			t.Errorf("dialPublic(%s) = %v, want ErrInvalidSubscription", tt.address, err)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// TypeScript client for slips-core. The service and message code under
+// ./gen is generated from api/proto with `make sdk-ts`; this file adds the
+// transport, authentication, retries and pagination.
+//
+//   const client = createSlipsClient({
+//     baseUrl: "https://slips.example.com",
+//     auth: { mcpToken: process.env.SLIPS_TOKEN },
+//   });
+//   for await (const task of all(client.tasks.listTasks, { pageSize: 100 }, (res) => res.tasks)) {
+//     console.log(task.title);
+//   }
+
+import {
+  Code,
+  ConnectError,
+  createPromiseClient,
+  type Interceptor,
+  type PromiseClient,
+  type Transport,
+} from "@connectrpc/connect";
+import { createGrpcTransport } from "@connectrpc/connect-node";
+
+import { AdminService } from "./gen/admin/v1/admin_connect";
+import { ApprovalService } from "./gen/approval/v1/approval_connect";
+import { AuthService } from "./gen/auth/v1/auth_connect";
+import { CalDAVService } from "./gen/caldav/v1/caldav_connect";
+import { DigestService } from "./gen/digest/v1/digest_connect";
+import { FeedService } from "./gen/feed/v1/feed_connect";
+import { MCPTokenService } from "./gen/mcptoken/v1/mcptoken_connect";
+import { NotificationService } from "./gen/notification/v1/notification_connect";
+import { SavedFilterService } from "./gen/savedfilter/v1/savedfilter_connect";
+import { ServerService } from "./gen/server/v1/server_connect";
+import { StreakService } from "./gen/streak/v1/streak_connect";
+import { TagService } from "./gen/tag/v1/tag_connect";
+import { TaskService } from "./gen/task/v1/task_connect";
+import { TaskService as TaskServiceV2 } from "./gen/task/v2/task_connect";
+import { UsageService } from "./gen/usage/v1/usage_connect";
+import { WebhookService } from "./gen/webhook/v1/webhook_connect";
+
+/** Credentials sent as the authorization metadata of every call */
+export type Auth =
+  | { mcpToken: string }
+  // A JWT access token, or a function returning the current one, e.g.
+  // after a refresh
+  | { accessToken: string | (() => string | Promise<string>) };
+
+/** Retries calls that fail with one of codes, with randomized backoff */
+export interface RetryPolicy {
+  /** Counts the first attempt; below 2 means no retries */
+  maxAttempts: number;
+  initialBackoffMs: number;
+  maxBackoffMs: number;
+  multiplier: number;
+  codes: Code[];
+}
+
+/**
+ * Retries Unavailable, which the server returns while it is starting or
+ * when the connection drops, up to 4 times over about 5 seconds. Unavailable
+ * from the server means the call was not served, but a call cut off by a
+ * dropped connection may have been, so a retried mutation can run twice.
+ */
+export const defaultRetryPolicy: RetryPolicy = {
+  maxAttempts: 5,
+  initialBackoffMs: 200,
+  maxBackoffMs: 2000,
+  multiplier: 2,
+  codes: [Code.Unavailable],
+};
+
+export interface ClientOptions {
+  /** e.g. "https://slips.example.com" or "http://localhost:9090" */
+  baseUrl: string;
+  auth?: Auth;
+  /** Defaults to defaultRetryPolicy; null turns retries off */
+  retry?: RetryPolicy | null;
+  /** Added after the authentication and retry interceptors */
+  interceptors?: Interceptor[];
+}
+
+/** Formats the authorization metadata value the server expects */
+export function authorizationHeader(scheme: "Bearer" | "MCP-Token", token: string): string {
+  return `${scheme} ${token}`;
+}
+
+/** Sets the authorization metadata of every call */
+export function authInterceptor(auth: Auth): Interceptor {
+  return (next) => async (req) => {
+    if ("mcpToken" in auth) {
+      req.header.set("authorization", authorizationHeader("MCP-Token", auth.mcpToken));
+    } else {
+      const token = typeof auth.accessToken === "function" ? await auth.accessToken() : auth.accessToken;
+      req.header.set("authorization", authorizationHeader("Bearer", token));
+    }
+    return await next(req);
+  };
+}
+
+/** Retries unary calls according to policy; streams are not retried */
+export function retryInterceptor(policy: RetryPolicy): Interceptor {
+  return (next) => async (req) => {
+    if (req.stream) {
+      return await next(req);
+    }
+    let backoff = policy.initialBackoffMs;
+    for (let attempt = 1; ; attempt++) {
+      try {
+        return await next(req);
+      } catch (err) {
+        const code = ConnectError.from(err).code;
+        if (attempt >= policy.maxAttempts || !policy.codes.includes(code) || req.signal.aborted) {
+          throw err;
+        }
+      }
+      await sleep(Math.random() * backoff, req.signal);
+      backoff = Math.min(backoff * policy.multiplier, policy.maxBackoffMs);
+    }
+  };
+}
+
+function sleep(ms: number, signal: AbortSignal): Promise<void> {
+  return new Promise((resolve) => {
+    const timer = setTimeout(resolve, ms);
+    signal.addEventListener("abort", () => {
+      clearTimeout(timer);
+      resolve();
+    }, { once: true });
+  });
+}
+
+/** Creates a gRPC transport for Node.js with the options' interceptors */
+export function createTransport(options: ClientOptions): Transport {
+  const interceptors: Interceptor[] = [];
+  if (options.auth) {
+    interceptors.push(authInterceptor(options.auth));
+  }
+  const retry = options.retry === undefined ? defaultRetryPolicy : options.retry;
+  if (retry && retry.maxAttempts > 1) {
+    interceptors.push(retryInterceptor(retry));
+  }
+  interceptors.push(...(options.interceptors ?? []));
+  return createGrpcTransport({ baseUrl: options.baseUrl, httpVersion: "2", interceptors });
+}
+
+/** A client for every slips-core service */
+export interface SlipsClient {
+  admin: PromiseClient<typeof AdminService>;
+  approvals: PromiseClient<typeof ApprovalService>;
+  auth: PromiseClient<typeof AuthService>;
+  caldav: PromiseClient<typeof CalDAVService>;
+  digests: PromiseClient<typeof DigestService>;
+  feeds: PromiseClient<typeof FeedService>;
+  mcpTokens: PromiseClient<typeof MCPTokenService>;
+  notification: PromiseClient<typeof NotificationService>;
+  savedFilters: PromiseClient<typeof SavedFilterService>;
+  server: PromiseClient<typeof ServerService>;
+  streaks: PromiseClient<typeof StreakService>;
+  tags: PromiseClient<typeof TagService>;
+  tasks: PromiseClient<typeof TaskService>;
+  tasksV2: PromiseClient<typeof TaskServiceV2>;
+  usage: PromiseClient<typeof UsageService>;
+  webhooks: PromiseClient<typeof WebhookService>;
+}
+
+/** Creates clients sharing one transport */
+export function createSlipsClient(options: ClientOptions | { transport: Transport }): SlipsClient {
+  const transport = "transport" in options ? options.transport : createTransport(options);
+  return {
+    admin: createPromiseClient(AdminService, transport),
+    approvals: createPromiseClient(ApprovalService, transport),
+    auth: createPromiseClient(AuthService, transport),
+    caldav: createPromiseClient(CalDAVService, transport),
+    digests: createPromiseClient(DigestService, transport),
+    feeds: createPromiseClient(FeedService, transport),
+    mcpTokens: createPromiseClient(MCPTokenService, transport),
+    notification: createPromiseClient(NotificationService, transport),
+    savedFilters: createPromiseClient(SavedFilterService, transport),
+    server: createPromiseClient(ServerService, transport),
+    streaks: createPromiseClient(StreakService, transport),
+    tags: createPromiseClient(TagService, transport),
+    tasks: createPromiseClient(TaskService, transport),
+    tasksV2: createPromiseClient(TaskServiceV2, transport),
+    usage: createPromiseClient(UsageService, transport),
+    webhooks: createPromiseClient(WebhookService, transport),
+  };
+}
+
+/**
+ * Calls list with req, then with each nextPageToken until a response has
+ * none, and yields whole responses, e.g. to read totalSize
+ */
+export async function* pages<Req extends { pageToken?: string }, Res extends { nextPageToken: string }>(
+  list: (req: Req) => Promise<Res>,
+  req: Req,
+): AsyncGenerator<Res> {
+  let pageToken = req.pageToken ?? "";
+  for (;;) {
+    const res = await list({ ...req, pageToken });
+    yield res;
+    if (res.nextPageToken === "") {
+      return;
+    }
+    pageToken = res.nextPageToken;
+  }
+}
+
+/** pages yielding the items extracted from each response by items */
+export async function* all<Req extends { pageToken?: string }, Res extends { nextPageToken: string }, Item>(
+  list: (req: Req) => Promise<Res>,
+  req: Req,
+  items: (res: Res) => Item[],
+): AsyncGenerator<Item> {
+  for await (const res of pages(list, req)) {
+    yield* items(res);
+  }
+}